@@ -24,7 +24,12 @@ The backup command:
   - Discovers workload resources based on --includes/--exclude filters
   - For each workload, identifies and backs up referenced dependencies
   - Strips cluster-specific metadata for portability
+  - Masks Secret values and kubeconfig credentials held in ConfigMaps
+    (--include-secret-values keeps Secret values); masked resources are
+    skipped by restore
   - Organizes backups by namespace: $output-dir/$namespace/$GVR-$name.yaml
+  - With --archive, writes a single .tar.gz with a manifest.yaml recording the
+    cluster version, timestamp, resource types and counts, and file checksums
 
 Examples:
   # Backup all notebooks to /tmp/backup
@@ -51,6 +56,9 @@ const cmdExample = `
   # Backup to stdout
   odh-cli backup > backup.yaml
 
+  # Backup to an archive to ship to support
+  odh-cli backup --archive /tmp/backup.tar.gz
+
   # Backup with verbose output
   odh-cli backup --output-dir /backup -v
 
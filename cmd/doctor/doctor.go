@@ -0,0 +1,47 @@
+package doctor
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/cmd/doctor/health"
+	"github.com/opendatahub-io/odh-cli/cmd/doctor/permissions"
+)
+
+const (
+	cmdName  = "doctor"
+	cmdShort = "Diagnose the CLI environment and the runtime health of the cluster"
+)
+
+const cmdLong = `
+Diagnose the environment the CLI runs in before anything is attempted against
+the cluster, and the runtime health of the deployed components.
+
+Available subcommands:
+  health       Check the runtime health of the deployed components
+  permissions  Check the RBAC permissions the checks and migrations need
+`
+
+// AddCommand adds the doctor command to the root command.
+func AddCommand(root *cobra.Command, flags *genericclioptions.ConfigFlags) {
+	streams := genericiooptions.IOStreams{
+		In:     root.InOrStdin(),
+		Out:    root.OutOrStdout(),
+		ErrOut: root.ErrOrStderr(),
+	}
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+
+	health.AddCommand(cmd, flags, streams)
+	permissions.AddCommand(cmd, flags, streams)
+
+	root.AddCommand(cmd)
+}
@@ -0,0 +1,83 @@
+package health
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/doctor"
+)
+
+const (
+	cmdName  = "health"
+	cmdShort = "Check the runtime health of the deployed components"
+)
+
+const cmdLong = `
+Check the operational health of the deployed OpenShift AI components, as
+opposed to their upgrade readiness checked by lint:
+
+  health.deployment.ready       component Deployments have their replicas ready
+  health.platform.conditions    DataScienceCluster and DSCInitialization report
+                                no failing status conditions
+  health.pod.crashloops         no pod is crash looping or restarted in the last hour
+  health.webhook.certificates   admission webhook certificates do not expire
+                                within 30 days
+  health.route.admitted         Routes are admitted by a router
+
+Deployments, pods and Routes are read from the applications namespace of the
+DSCInitialization.
+
+The command exits with code 3 when a health check fails and with code 5 when a
+check could not be executed.
+
+Supported output formats:
+  - table: human-readable report (default)
+  - json : the results as JSON
+  - yaml : the results as YAML
+`
+
+const cmdExample = `
+  # Check the health of the deployed components
+  kubectl odh doctor health
+
+  # Check only the pods and list the crash looping ones
+  kubectl odh doctor health --checks 'health.pod.*' -v
+
+  # Export the results as JSON
+  kubectl odh doctor health -o json
+`
+
+// AddCommand adds the health subcommand to the doctor command.
+func AddCommand(
+	parent *cobra.Command,
+	flags *genericclioptions.ConfigFlags,
+	streams genericiooptions.IOStreams,
+) {
+	command := doctor.NewHealthCommand(streams, flags)
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			//nolint:wrapcheck // Errors from Complete and Validate are already contextualized
+			if err := command.Complete(); err != nil {
+				return err
+			}
+			//nolint:wrapcheck // Errors from Validate are already contextualized
+			if err := command.Validate(); err != nil {
+				return err
+			}
+
+			return command.Run(cmd.Context())
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+	parent.AddCommand(cmd)
+}
@@ -0,0 +1,77 @@
+package permissions
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/doctor"
+)
+
+const (
+	cmdName  = "permissions"
+	cmdShort = "Check the RBAC permissions the checks and migrations need"
+)
+
+const cmdLong = `
+Evaluate, with SelfSubjectAccessReviews, whether the current user has every
+permission the registered lint checks and migrations need, and report each
+verb and resource as granted or denied together with the checks and
+migrations requiring it.
+
+Checks need get and list on the resources they read; some also need write
+permissions for --fix. Migrations need the permissions of their prepare, run
+and rollback phases. Permissions are evaluated cluster-wide.
+
+The command exits with code 3 when a permission is denied.
+
+Supported output formats:
+  - table: human-readable report (default)
+  - json : the report as JSON
+  - yaml : the report as YAML
+`
+
+const cmdExample = `
+  # Check the permissions of all checks and migrations
+  kubectl odh doctor permissions
+
+  # Check only the permissions of the workload checks and the RHBOK migration
+  kubectl odh doctor permissions --checks 'workloads.*' --migrations 'kueue.rhbok.*'
+
+  # Export the report as JSON
+  kubectl odh doctor permissions -o json
+`
+
+// AddCommand adds the permissions subcommand to the doctor command.
+func AddCommand(
+	parent *cobra.Command,
+	flags *genericclioptions.ConfigFlags,
+	streams genericiooptions.IOStreams,
+) {
+	command := doctor.NewPermissionsCommand(streams, flags)
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			//nolint:wrapcheck // Errors from Complete and Validate are already contextualized
+			if err := command.Complete(); err != nil {
+				return err
+			}
+			//nolint:wrapcheck // Errors from Validate are already contextualized
+			if err := command.Validate(); err != nil {
+				return err
+			}
+
+			return command.Run(cmd.Context())
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+	parent.AddCommand(cmd)
+}
@@ -0,0 +1,77 @@
+package explain
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+)
+
+const (
+	cmdName  = "explain CHECK-ID"
+	cmdShort = "Print the documentation of a lint check"
+)
+
+const cmdLong = `
+Print the documentation of a lint check: what it inspects, why it matters for
+the upgrade, example failing and passing resources, and remediation commands.
+
+The documentation is rendered from the check registry without contacting the
+cluster. The same output is printed by "kubectl odh lint --explain CHECK-ID".
+
+Supported output formats:
+  - text: sections for terminal reading (default)
+  - json: the documentation as JSON
+  - yaml: the documentation as YAML
+`
+
+const cmdExample = `
+  # Explain why workbench images block the upgrade to 3.x
+  kubectl odh lint explain workloads.notebook.impacted-workloads
+
+  # Export the documentation of a check as JSON
+  kubectl odh lint explain dependencies.trustedca.propagation -o json
+`
+
+// AddCommand adds the explain subcommand to the lint command.
+func AddCommand(
+	parent *cobra.Command,
+	streams genericiooptions.IOStreams,
+) {
+	command := lint.NewExplainCommand(streams)
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		Args:          cobra.ExactArgs(1),
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		ValidArgsFunction: func(_ *cobra.Command, args []string, _ string) ([]string, cobra.ShellCompDirective) {
+			if len(args) > 0 {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+
+			return command.CheckIDs(), cobra.ShellCompDirectiveNoFileComp
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			command.CheckID = args[0]
+
+			//nolint:wrapcheck // Errors from Complete and Validate are already contextualized
+			if err := command.Complete(); err != nil {
+				return err
+			}
+			//nolint:wrapcheck // Errors from Validate are already contextualized
+			if err := command.Validate(); err != nil {
+				return err
+			}
+
+			return command.Run(cmd.Context())
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+	parent.AddCommand(cmd)
+}
@@ -0,0 +1,77 @@
+package gitops
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+)
+
+const (
+	cmdName  = "gitops-comment"
+	cmdShort = "Comment lint findings for the manifests changed by a pull request"
+)
+
+const cmdLong = `
+Run the lint checks (or, with --target-version, the upgrade readiness checks)
+and post the findings impacting objects declared in the manifests changed by a
+GitHub pull request or GitLab merge request as a comment on it.
+
+The changed files are listed with the provider API and read from the checkout
+given with --repo-dir (default: the current directory). Findings are matched to
+the declared objects by kind, namespace and name; a manifest without a
+namespace matches the object in any namespace.
+
+The comment is updated in place on later runs, so a CI job can run this on
+every push without piling up comments.
+
+The API token is read from ODH_GITOPS_TOKEN, or from GITHUB_TOKEN or
+GITLAB_TOKEN depending on the provider. GitHub Enterprise and self-managed
+GitLab are detected from the pull request URL.
+`
+
+const cmdExample = `
+  # Comment on a GitHub pull request from a CI job running in its checkout
+  kubectl odh lint gitops-comment --pr https://github.com/org/gitops/pull/42
+
+  # Comment upgrade readiness findings on a GitLab merge request
+  kubectl odh lint gitops-comment --pr https://gitlab.example.com/org/gitops/-/merge_requests/7 --target-version 3.0.0
+
+  # Print the comment without posting it
+  kubectl odh lint gitops-comment --pr https://github.com/org/gitops/pull/42 --repo-dir ./gitops --dry-run
+`
+
+// AddCommand adds the gitops-comment subcommand to the lint command.
+func AddCommand(
+	parent *cobra.Command,
+	flags *genericclioptions.ConfigFlags,
+	streams genericiooptions.IOStreams,
+) {
+	command := lint.NewGitOpsCommentCommand(streams, flags)
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			//nolint:wrapcheck // Errors from Complete and Validate are already contextualized
+			if err := command.Complete(); err != nil {
+				return err
+			}
+			//nolint:wrapcheck // Errors from Validate are already contextualized
+			if err := command.Validate(); err != nil {
+				return err
+			}
+
+			return command.Run(cmd.Context())
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+	parent.AddCommand(cmd)
+}
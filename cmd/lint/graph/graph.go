@@ -0,0 +1,70 @@
+package graph
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+)
+
+const (
+	cmdName  = "graph"
+	cmdShort = "Export the lint check dependency graph"
+)
+
+const cmdLong = `
+Export the lint check registry as a dependency/applicability graph.
+
+The graph links check groups to their checks, and each check to the resource
+types it reads and the version gate under which it runs. It is rendered from
+the registry without contacting the cluster, and is useful for documentation
+and for spotting coverage gaps per component.
+
+Supported output formats:
+  - dot : Graphviz DOT (render with "dot -Tsvg")
+  - json: nodes and edges as JSON
+`
+
+const cmdExample = `
+  # Render the full check graph as SVG
+  kubectl odh lint graph --output dot | dot -Tsvg > lint-graph.svg
+
+  # Export the graph as JSON
+  kubectl odh lint graph -o json
+
+  # Graph only the workload checks
+  kubectl odh lint graph --checks "workloads.*"
+`
+
+// AddCommand adds the graph subcommand to the lint command.
+func AddCommand(
+	parent *cobra.Command,
+	streams genericiooptions.IOStreams,
+) {
+	command := lint.NewGraphCommand(streams)
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			//nolint:wrapcheck // Errors from Complete and Validate are already contextualized
+			if err := command.Complete(); err != nil {
+				return err
+			}
+			//nolint:wrapcheck // Errors from Validate are already contextualized
+			if err := command.Validate(); err != nil {
+				return err
+			}
+
+			return command.Run(cmd.Context())
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+	parent.AddCommand(cmd)
+}
@@ -0,0 +1,85 @@
+package history
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+)
+
+const (
+	cmdName  = "history [NAME]"
+	cmdShort = "List or fetch the lint runs recorded in the cluster"
+)
+
+const cmdLong = `
+List the lint runs recorded in the cluster with "lint --record", or fetch the
+results of one of them.
+
+Each run invoked with --record is stored as a ConfigMap named
+odh-lint-report-<timestamp> in the operator namespace (--record-namespace),
+labeled with the run timestamp and the cluster and target versions, and
+holding the results as JSON. Recording runs in the cluster keeps an audit
+trail of the assessments made before and during upgrades, readable by anyone
+with access to the namespace.
+
+Without arguments, the recorded runs are listed newest first with their
+versions and the number of failing checks. With the name of a run, its results
+are printed as a table (impacted objects with --verbose), or as the original
+JSON or YAML result list.
+`
+
+const cmdExample = `
+  # Record the upgrade assessment in the cluster
+  kubectl odh lint --target-version 3.0 --record
+
+  # List the recorded runs
+  kubectl odh lint history
+
+  # Print the results of a recorded run
+  kubectl odh lint history odh-lint-report-20260301t100000z --verbose
+
+  # Fetch the results of a recorded run as JSON, e.g. to diff them with a later run
+  kubectl odh lint history odh-lint-report-20260301t100000z -o json > results.json
+  kubectl odh lint --target-version 3.0 --diff results.json
+`
+
+// AddCommand adds the history subcommand to the lint command.
+func AddCommand(
+	parent *cobra.Command,
+	flags *genericclioptions.ConfigFlags,
+	streams genericiooptions.IOStreams,
+) {
+	command := lint.NewHistoryCommand(streams, flags)
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		Args:          cobra.MaximumNArgs(1),
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 1 {
+				command.Name = args[0]
+			}
+
+			//nolint:wrapcheck // Errors from Complete and Validate are already contextualized
+			if err := command.Complete(); err != nil {
+				return err
+			}
+			//nolint:wrapcheck // Errors from Validate are already contextualized
+			if err := command.Validate(); err != nil {
+				return err
+			}
+
+			return command.Run(cmd.Context())
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+	parent.AddCommand(cmd)
+}
@@ -8,6 +8,13 @@ import (
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 
+	"github.com/opendatahub-io/odh-cli/cmd/lint/explain"
+	"github.com/opendatahub-io/odh-cli/cmd/lint/gitops"
+	"github.com/opendatahub-io/odh-cli/cmd/lint/graph"
+	"github.com/opendatahub-io/odh-cli/cmd/lint/history"
+	"github.com/opendatahub-io/odh-cli/cmd/lint/list"
+	"github.com/opendatahub-io/odh-cli/cmd/lint/object"
+	"github.com/opendatahub-io/odh-cli/cmd/lint/query"
 	lintpkg "github.com/opendatahub-io/odh-cli/pkg/lint"
 )
 
@@ -36,6 +43,13 @@ Each issue is reported with:
   - Detailed description of the problem
   - Remediation guidance for fixing the issue
 
+Exit codes:
+  0  no findings failing the run
+  2  advisory findings (with --fail-on advisory)
+  3  blocking findings
+  4  the run could not be carried out
+  5  some checks failed to execute
+
 Examples:
   # Validate current cluster state
   kubectl odh lint
@@ -46,8 +60,23 @@ Examples:
   # Validate with JSON output
   kubectl odh lint -o json
 
+  # Show a table and write JSON results for CI in the same run
+  kubectl odh lint -o table -o json=results.json
+
   # Validate only component checks
   kubectl odh lint --checks "components"
+
+  # Export the check dependency graph
+  kubectl odh lint graph --output dot
+
+  # List the checks that would run for an upgrade to 3.0
+  kubectl odh lint list --current-version 2.25 --target-version 3.0
+
+  # Explain what a check inspects and how to remediate its findings
+  kubectl odh lint explain workloads.notebook.impacted-workloads
+
+  # Check whether a single workbench is ready for the upgrade to 3.0
+  kubectl odh lint object notebook/my-ns/my-workbench --target-version 3.0
 `
 const cmdExample = `
   # Validate current cluster state
@@ -56,12 +85,85 @@ const cmdExample = `
   # Assess upgrade readiness for version 3.0
   kubectl odh lint --target-version 3.0
 
+  # Assess upgrade readiness for 3.0 and 3.1 in one run
+  kubectl odh lint --target-version 3.0,3.1
+
+  # Assess upgrade readiness for every supported newer release
+  kubectl odh lint --all-supported-targets -o yaml
+
   # Output results in JSON format
   kubectl odh lint -o json
 
+  # Print a table and save JSON and YAML artifacts in a single run
+  kubectl odh lint -o table -o json=results.json -o yaml=results.yaml
+
+  # Save a JUnit XML report for Jenkins or GitLab pipelines
+  kubectl odh lint --target-version 3.0 -o table -o junit=lint-report.xml
+
+  # Save a standalone HTML report to attach to a change ticket
+  kubectl odh lint --target-version 3.0 -o table -o html=upgrade-report.html
+
+  # Write the upgrade assessment as Markdown for a runbook kept in Git
+  kubectl odh lint --target-version 3.0 -o markdown=runbook/assessment.md
+
   # Run only dashboard-related checks
   kubectl odh lint --checks "*dashboard*"
 
+  # Show which discovered resource types and components no check covered
+  kubectl odh lint --coverage
+
+  # Add owning teams and deadlines to impacted objects, plus a per-team rollup
+  kubectl odh lint --target-version 3.1 --verbose --assignments owners.yaml
+
+  # Apply the environment's lint policy: impact overrides, disabled checks and check parameters
+  kubectl odh lint --target-version 3.0 --config odh-lint.yaml
+
+  # Show one row per check with custom columns (built-in names or NAME:JQ-EXPRESSION)
+  kubectl odh lint --columns 'CHECK,STATUS,IMPACT,COUNT,NAMESPACES:[.impactedObjects[]?.metadata.namespace] | unique | join(",")'
+
+  # Record the run in a local history database, then query findings for a namespace
+  kubectl odh lint --db ~/.odh/history.db
+  kubectl odh lint query --db ~/.odh/history.db -n team-a --since 2026-03-01
+
+  # Record the run in the cluster for auditability, then list the recorded runs
+  kubectl odh lint --target-version 3.0 --record
+  kubectl odh lint history
+
+  # Preview which workload checks an upgrade run would execute, without running them
+  kubectl odh lint --target-version 3.1 --plan --checks 'workloads.*'
+
+  # Share anonymized check statistics (preview them first with 'kubectl odh telemetry preview')
+  kubectl odh lint --telemetry --telemetry-endpoint https://telemetry.example.com/v1/reports
+
+  # Print a table for humans and write a small JSON summary for the CI gate
+  kubectl odh lint --target-version 3.0 --summary-file summary.json
+
+  # From a CronJob: write Prometheus metrics for the node_exporter textfile collector
+  kubectl odh lint --metrics-file /var/lib/node_exporter/textfile/odh_lint.prom
+
+  # From a CronJob: push Prometheus metrics to a Pushgateway
+  kubectl odh lint --pushgateway-url http://pushgateway.monitoring.svc:9091
+
+  # Preview, then apply, the automatic fixes of failing upgrade checks
+  kubectl odh lint --target-version 3.0 --fix --dry-run
+  kubectl odh lint --target-version 3.0 --fix
+
+  # Save the results, remediate, then show only what changed since
+  kubectl odh lint --target-version 3.0 --save results.json
+  kubectl odh lint --target-version 3.0 --diff results.json
+
+  # Re-run the checks while remediating, printing only the checks whose results changed
+  kubectl odh lint --target-version 3.0 --watch --watch-interval 2m
+
+  # Assess upgrade readiness from a backup, without cluster access
+  kubectl odh lint --from-backup /tmp/backup --current-version 2.25 --target-version 3.0
+
+  # Run only the checks that apply to one workbench
+  kubectl odh lint object notebook/my-ns/my-workbench --target-version 3.0
+
+  # Print the documentation of a check
+  kubectl odh lint --explain workloads.notebook.impacted-workloads
+
   # Check upgrade readiness to version 3.1
   kubectl odh lint --target-version 3.1
 `
@@ -120,5 +222,13 @@ func AddCommand(root *cobra.Command, flags *genericclioptions.ConfigFlags) {
 	// Register flags using AddFlags method
 	command.AddFlags(cmd.Flags())
 
+	explain.AddCommand(cmd, streams)
+	gitops.AddCommand(cmd, flags, streams)
+	graph.AddCommand(cmd, streams)
+	history.AddCommand(cmd, flags, streams)
+	list.AddCommand(cmd, flags, streams)
+	object.AddCommand(cmd, flags, streams)
+	query.AddCommand(cmd, flags, streams)
+
 	root.AddCommand(cmd)
 }
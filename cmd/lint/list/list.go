@@ -0,0 +1,78 @@
+package list
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+)
+
+const (
+	cmdName  = "list"
+	cmdShort = "List the lint checks and whether they apply"
+)
+
+const cmdLong = `
+List the registered lint checks with their ID, group, kind, type and description.
+
+With --current-version (and optionally --target-version), CanApply is evaluated
+for each check to show whether it would run for that upgrade. Without --cluster
+no API calls are made: checks whose applicability depends on cluster state, such
+as a component being Managed, are reported as unknown. With --cluster the
+versions default to the ones detected from the cluster, and applicability is
+evaluated against it.
+
+Supported output formats:
+  - table: human-readable table (default)
+  - json : the check list as JSON
+  - yaml : the check list as YAML
+`
+
+const cmdExample = `
+  # List all checks
+  kubectl odh lint list
+
+  # Show which checks would run for an upgrade from 2.25 to 3.0
+  kubectl odh lint list --current-version 2.25 --target-version 3.0
+
+  # Evaluate the workload checks against the live cluster
+  kubectl odh lint list --cluster --target-version 3.0 --checks "workloads.*"
+
+  # Export the check list as JSON
+  kubectl odh lint list -o json
+`
+
+// AddCommand adds the list subcommand to the lint command.
+func AddCommand(
+	parent *cobra.Command,
+	flags *genericclioptions.ConfigFlags,
+	streams genericiooptions.IOStreams,
+) {
+	command := lint.NewListCommand(streams, flags)
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			//nolint:wrapcheck // Errors from Complete and Validate are already contextualized
+			if err := command.Complete(); err != nil {
+				return err
+			}
+			//nolint:wrapcheck // Errors from Validate are already contextualized
+			if err := command.Validate(); err != nil {
+				return err
+			}
+
+			return command.Run(cmd.Context())
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+	parent.AddCommand(cmd)
+}
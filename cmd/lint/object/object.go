@@ -0,0 +1,83 @@
+package object
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+)
+
+const (
+	cmdName  = "object KIND/NAMESPACE/NAME"
+	cmdShort = "Run the checks that apply to a single workload object"
+)
+
+const cmdLong = `
+Run only the workload checks that apply to a single named object and report
+their outcome for it: the fastest way to find out whether a given workbench,
+model or pipeline server will break with an upgrade.
+
+The kind is resolved case-insensitively from its name or plural resource name,
+optionally qualified with the API group (e.g. notebook, notebooks or
+notebooks.kubeflow.org). The object is fetched from the cluster and the
+workload checks reading its kind are run with listings of that kind restricted
+to the object; the resources it depends on, such as ImageStreams or hardware
+profiles, are read from the cluster as usual.
+
+Each routed check is reported as impacted (with its impact, message and
+remediation), ok, skipped (not applicable to the versions or cluster) or error.
+
+Supported output formats:
+  - table: human-readable report (default)
+  - json : the object report as JSON
+  - yaml : the object report as YAML
+`
+
+const cmdExample = `
+  # Will my workbench break when upgrading to 3.0?
+  kubectl odh lint object notebook/my-ns/my-workbench --target-version 3.0
+
+  # Lint a single InferenceService against the installed version
+  kubectl odh lint object inferenceservice/models/fraud-detection
+
+  # Export the report of a RayCluster as JSON
+  kubectl odh lint object raycluster/team-a/training --target-version 3.0 -o json
+`
+
+// AddCommand adds the object subcommand to the lint command.
+func AddCommand(
+	parent *cobra.Command,
+	flags *genericclioptions.ConfigFlags,
+	streams genericiooptions.IOStreams,
+) {
+	command := lint.NewObjectCommand(streams, flags)
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		Args:          cobra.ExactArgs(1),
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			command.Object = args[0]
+
+			//nolint:wrapcheck // Errors from Complete and Validate are already contextualized
+			if err := command.Complete(); err != nil {
+				return err
+			}
+			//nolint:wrapcheck // Errors from Validate are already contextualized
+			if err := command.Validate(); err != nil {
+				return err
+			}
+
+			return command.Run(cmd.Context())
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+	parent.AddCommand(cmd)
+}
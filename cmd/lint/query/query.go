@@ -0,0 +1,84 @@
+package query
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+)
+
+const (
+	cmdName  = "query"
+	cmdShort = "Query the lint run history database"
+)
+
+const cmdLong = `
+Query the run history recorded with "lint --db".
+
+Each lint run invoked with --db stores its metadata (timestamp, cluster and
+target versions) and findings in a local database. This command reads that
+database without contacting the cluster.
+
+By default it lists the findings (failing and warning checks) of the recorded
+runs, one row per impacted object. Use --namespace to restrict the findings to
+objects in a namespace, --since to restrict the runs to a time window, and
+--check to restrict the checks by ID.
+
+With --flipped, it lists instead the checks whose status changed between
+consecutive runs, e.g. a check that started failing after an upgrade or
+passed once a finding was remediated.
+`
+
+const cmdExample = `
+  # Record lint runs in a history database
+  kubectl odh lint --db ~/.odh/history.db
+
+  # Findings for namespace team-a since March 1st
+  kubectl odh lint query --db ~/.odh/history.db --namespace team-a --since 2026-03-01
+
+  # Checks that flipped state between runs
+  kubectl odh lint query --db ~/.odh/history.db --flipped
+
+  # Workload findings as JSON
+  kubectl odh lint query --db ~/.odh/history.db --check "workloads.*" -o json
+`
+
+// AddCommand adds the query subcommand to the lint command.
+// The namespace filter is read from the global --namespace flag.
+func AddCommand(
+	parent *cobra.Command,
+	flags *genericclioptions.ConfigFlags,
+	streams genericiooptions.IOStreams,
+) {
+	command := lint.NewQueryCommand(streams)
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if flags.Namespace != nil {
+				command.Namespace = *flags.Namespace
+			}
+
+			//nolint:wrapcheck // Errors from Complete and Validate are already contextualized
+			if err := command.Complete(); err != nil {
+				return err
+			}
+			//nolint:wrapcheck // Errors from Validate are already contextualized
+			if err := command.Validate(); err != nil {
+				return err
+			}
+
+			return command.Run(cmd.Context())
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+	parent.AddCommand(cmd)
+}
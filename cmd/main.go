@@ -1,20 +1,32 @@
 package main
 
 import (
+	"context"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/spf13/cobra"
 
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 
+	"github.com/opendatahub-io/odh-cli/cmd/doctor"
 	"github.com/opendatahub-io/odh-cli/cmd/lint"
+	"github.com/opendatahub-io/odh-cli/cmd/remediation"
+	"github.com/opendatahub-io/odh-cli/cmd/restore"
+	"github.com/opendatahub-io/odh-cli/cmd/rules"
+	"github.com/opendatahub-io/odh-cli/cmd/selftest"
+	"github.com/opendatahub-io/odh-cli/cmd/snapshot"
+	"github.com/opendatahub-io/odh-cli/cmd/supportbundle"
+	"github.com/opendatahub-io/odh-cli/cmd/telemetry"
 	"github.com/opendatahub-io/odh-cli/cmd/version"
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
 )
 
 func main() {
 	flags := genericclioptions.NewConfigFlags(true).WithDeprecatedPasswordFlag()
 
-	cmd := &cobra.Command{
+	rootCmd := &cobra.Command{
 		Use:   "kubectl-odh",
 		Short: "kubectl plugin for ODH/RHOAI",
 	}
@@ -23,15 +35,31 @@ func main() {
 	// This exposes standard authentication flags: --server, --username, --password,
 	// --token, --kubeconfig, --context, --cluster, --certificate-authority,
 	// --client-certificate, --client-key, --insecure-skip-tls-verify, etc.
-	flags.AddFlags(cmd.PersistentFlags())
+	flags.AddFlags(rootCmd.PersistentFlags())
 
-	version.AddCommand(cmd, flags)
-	lint.AddCommand(cmd, flags)
+	version.AddCommand(rootCmd, flags)
+	doctor.AddCommand(rootCmd, flags)
+	lint.AddCommand(rootCmd, flags)
+	remediation.AddCommand(rootCmd, flags)
+	restore.AddCommand(rootCmd, flags)
+	rules.AddCommand(rootCmd, flags)
+	selftest.AddCommand(rootCmd, flags)
+	snapshot.AddCommand(rootCmd, flags)
+	supportbundle.AddCommand(rootCmd, flags)
+	telemetry.AddCommand(rootCmd, flags)
 
-	if err := cmd.Execute(); err != nil {
+	// Cancel the command context on Ctrl-C so commands stop and run their cleanup
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+
+	err := rootCmd.ExecuteContext(ctx)
+
+	stop()
+
+	if err != nil {
+		// Exit with the code of the outcome of the run, see cmd.ExitCode
 		if _, writeErr := os.Stderr.WriteString(err.Error() + "\n"); writeErr != nil {
-			os.Exit(1)
+			os.Exit(cmd.ExitCodeError)
 		}
-		os.Exit(1)
+		os.Exit(cmd.ExitCode(err))
 	}
 }
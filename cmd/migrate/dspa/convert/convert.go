@@ -0,0 +1,81 @@
+package convert
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate"
+)
+
+const (
+	cmdName  = "convert"
+	cmdShort = "Convert DataSciencePipelinesApplications from v1alpha1 to v1"
+)
+
+const cmdLong = `
+Convert DataSciencePipelinesApplication (DSPA) resources from the v1alpha1 to the v1 schema.
+
+The conversion removes fields that no longer exist in v1 (dspVersion, mlpipelineUI,
+Tekton-only apiServer settings, InstructLab managedPipelines) and moves renamed fields
+to their v1 location. Each object's changes and a YAML diff are printed to stderr, and
+the converted object is validated against the v1 schema of the DSPA CRD.
+
+File mode (--filename):
+  Converts manifests from a file, a directory, a glob pattern or stdin ('-') and
+  writes the v1 manifests to stdout. Multi-document files and List objects are supported.
+  Use --crd-file or --skip-validation to run without cluster access.
+
+Cluster mode (default):
+  Converts all DSPAs in the cluster (or in --namespace) and updates them through
+  the v1 API, which also migrates their stored version to v1.
+`
+
+const cmdExample = `
+  # Preview the conversion of all DSPAs in the cluster
+  kubectl odh migrate dspa convert --dry-run
+
+  # Convert DSPAs in a single namespace without prompting
+  kubectl odh migrate dspa convert -n my-project --yes
+
+  # Convert manifests from a GitOps repository offline
+  kubectl odh migrate dspa convert -f dspa.yaml --crd-file dspa-crd.yaml > dspa-v1.yaml
+
+  # Convert every manifest of a GitOps directory read from stdin
+  cat gitops/pipelines/*.yaml | kubectl odh migrate dspa convert -f - --skip-validation
+`
+
+// AddCommand adds the convert subcommand to the dspa command.
+func AddCommand(
+	parent *cobra.Command,
+	flags *genericclioptions.ConfigFlags,
+	streams genericiooptions.IOStreams,
+) {
+	command := migrate.NewDSPAConvertCommand(streams)
+	command.ConfigFlags = flags
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			//nolint:wrapcheck // Errors from Complete and Validate are already contextualized
+			if err := command.Complete(); err != nil {
+				return err
+			}
+			//nolint:wrapcheck // Errors from Validate are already contextualized
+			if err := command.Validate(); err != nil {
+				return err
+			}
+
+			return command.Run(cmd.Context())
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+	parent.AddCommand(cmd)
+}
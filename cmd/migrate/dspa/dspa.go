@@ -0,0 +1,41 @@
+package dspa
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/cmd/migrate/dspa/convert"
+)
+
+const (
+	cmdName  = "dspa"
+	cmdShort = "Manage DataSciencePipelinesApplication migrations"
+)
+
+const cmdLong = `
+Manage migrations of DataSciencePipelinesApplication (DSPA) resources.
+
+Available subcommands:
+  convert  Convert DSPA resources from v1alpha1 to v1
+`
+
+// AddCommand adds the dspa command to the migrate command.
+func AddCommand(
+	parent *cobra.Command,
+	flags *genericclioptions.ConfigFlags,
+	streams genericiooptions.IOStreams,
+) {
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+
+	convert.AddCommand(cmd, flags, streams)
+
+	parent.AddCommand(cmd)
+}
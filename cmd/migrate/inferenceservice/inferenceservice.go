@@ -0,0 +1,45 @@
+package inferenceservice
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/cmd/migrate/inferenceservice/shadow"
+	"github.com/opendatahub-io/odh-cli/cmd/migrate/inferenceservice/toraw"
+)
+
+const (
+	cmdName  = "inferenceservice"
+	cmdShort = "Manage InferenceService migrations"
+)
+
+const cmdLong = `
+Manage migrations of KServe InferenceService resources.
+
+Available subcommands:
+  shadow  Mirror traffic from a Serverless InferenceService to its RawDeployment replacement
+  to-raw  Migrate Serverless InferenceServices to RawDeployment mode
+`
+
+// AddCommand adds the inferenceservice command to the migrate command.
+func AddCommand(
+	parent *cobra.Command,
+	flags *genericclioptions.ConfigFlags,
+	streams genericiooptions.IOStreams,
+) {
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Aliases:       []string{"isvc"},
+		Short:         cmdShort,
+		Long:          cmdLong,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+
+	shadow.AddCommand(cmd, flags, streams)
+	toraw.AddCommand(cmd, flags, streams)
+
+	parent.AddCommand(cmd)
+}
@@ -0,0 +1,83 @@
+package shadow
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate"
+)
+
+const (
+	cmdName  = "shadow"
+	cmdShort = "Mirror traffic from a Serverless InferenceService to its RawDeployment replacement"
+)
+
+const cmdLong = `
+Set up temporary traffic mirroring from a Serverless InferenceService (--from) to the
+RawDeployment InferenceService that replaces it (--to), before cutting over.
+
+The command creates a Gateway API HTTPRoute named <from>-shadow for the hostname of the
+source InferenceService. The route serves requests from the source predictor and mirrors
+--percent of them to the target predictor; mirrored responses are discarded, so clients
+are unaffected.
+
+With --probe-count, the command also sends requests to both endpoints and prints their
+success rates and average latencies side by side. Use -o json or -o yaml to write the
+comparison in a machine-readable format.
+
+Remove the route with --remove once the RawDeployment path is validated.
+`
+
+const cmdExample = `
+  # Preview the shadow route for an InferenceService
+  kubectl odh migrate inferenceservice shadow --from my-model --to my-model-raw -n my-project --dry-run
+
+  # Mirror 50% of the traffic and compare both endpoints over 20 requests
+  kubectl odh migrate isvc shadow --from my-model --to my-model-raw -n my-project --percent 50 --probe-count 20
+
+  # Write the endpoint comparison as JSON
+  kubectl odh migrate isvc shadow --from my-model --to my-model-raw -n my-project --probe-count 20 -o json
+
+  # Compare predictions using a sample request body
+  kubectl odh migrate isvc shadow --from my-model --to my-model-raw -n my-project \
+    --probe-count 20 --probe-path '/v1/models/{name}:predict' --probe-payload request.json
+
+  # Remove the shadow route
+  kubectl odh migrate isvc shadow --from my-model -n my-project --remove
+`
+
+// AddCommand adds the shadow subcommand to the inferenceservice command.
+func AddCommand(
+	parent *cobra.Command,
+	flags *genericclioptions.ConfigFlags,
+	streams genericiooptions.IOStreams,
+) {
+	command := migrate.NewISVCShadowCommand(streams)
+	command.ConfigFlags = flags
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			//nolint:wrapcheck // Errors from Complete and Validate are already contextualized
+			if err := command.Complete(); err != nil {
+				return err
+			}
+			//nolint:wrapcheck // Errors from Validate are already contextualized
+			if err := command.Validate(); err != nil {
+				return err
+			}
+
+			return command.Run(cmd.Context())
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+	parent.AddCommand(cmd)
+}
@@ -0,0 +1,86 @@
+package toraw
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate"
+)
+
+const (
+	cmdName  = "to-raw"
+	cmdShort = "Migrate Serverless InferenceServices to RawDeployment mode"
+)
+
+const cmdLong = `
+Migrate KServe InferenceServices annotated with serving.kserve.io/deploymentMode=Serverless
+to the RawDeployment mode, as KServe Serverless is removed in OpenShift AI 3.x.
+
+KServe does not allow changing the deployment mode of an existing InferenceService, so
+each one is saved to --backup-dir, deleted and re-created in RawDeployment mode. The
+command then waits for the new predictor pods to be ready before migrating the next one;
+each model is unavailable in between.
+
+The conversion:
+  - sets serving.kserve.io/deploymentMode=RawDeployment
+  - moves the Knative min/max scale annotations to .spec.predictor.minReplicas/maxReplicas
+  - drops Knative and Istio sidecar annotations, which RawDeployment ignores
+  - labels the InferenceService networking.kserve.io/visibility=exposed to keep its external
+    route, unless it was cluster-local (networking.knative.dev/visibility=cluster-local)
+  - drops .spec.predictor.canaryTrafficPercent, as canary rollouts require Serverless
+
+By default all namespaces are migrated; use --namespace and --name to restrict the scope.
+To avoid downtime, consider 'migrate inferenceservice shadow' to validate a RawDeployment
+copy first.
+
+To roll back an InferenceService, delete its RawDeployment replacement and run
+'migrate restore-snapshot' on the backup directory.
+`
+
+const cmdExample = `
+  # Preview the conversion of all Serverless InferenceServices
+  kubectl odh migrate inferenceservice to-raw --dry-run
+
+  # Migrate the InferenceServices of a namespace
+  kubectl odh migrate isvc to-raw -n my-project
+
+  # Migrate a single InferenceService without confirmation
+  kubectl odh migrate isvc to-raw -n my-project --name my-model --yes
+`
+
+// AddCommand adds the to-raw subcommand to the inferenceservice command.
+// The namespace scope is read from the global --namespace flag.
+func AddCommand(
+	parent *cobra.Command,
+	flags *genericclioptions.ConfigFlags,
+	streams genericiooptions.IOStreams,
+) {
+	command := migrate.NewISVCToRawCommand(streams)
+	command.ConfigFlags = flags
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			//nolint:wrapcheck // Errors from Complete and Validate are already contextualized
+			if err := command.Complete(); err != nil {
+				return err
+			}
+			//nolint:wrapcheck // Errors from Validate are already contextualized
+			if err := command.Validate(); err != nil {
+				return err
+			}
+
+			return command.Run(cmd.Context())
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+	parent.AddCommand(cmd)
+}
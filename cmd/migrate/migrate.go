@@ -6,8 +6,14 @@ import (
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 
+	"github.com/opendatahub-io/odh-cli/cmd/migrate/dspa"
+	"github.com/opendatahub-io/odh-cli/cmd/migrate/inferenceservice"
 	"github.com/opendatahub-io/odh-cli/cmd/migrate/list"
+	"github.com/opendatahub-io/odh-cli/cmd/migrate/modelmesh"
+	"github.com/opendatahub-io/odh-cli/cmd/migrate/notebook"
 	"github.com/opendatahub-io/odh-cli/cmd/migrate/prepare"
+	"github.com/opendatahub-io/odh-cli/cmd/migrate/raycluster"
+	"github.com/opendatahub-io/odh-cli/cmd/migrate/restoresnapshot"
 	"github.com/opendatahub-io/odh-cli/cmd/migrate/run"
 )
 
@@ -22,15 +28,29 @@ The migrate command manages cluster migrations for OpenShift AI components.
 Use 'migrate list' to see available migrations filtered by version compatibility.
 Use 'migrate prepare' to backup resources before migration.
 Use 'migrate run' to execute one or more migrations sequentially.
+Use 'migrate restore-snapshot' to reapply the safety snapshot taken before a migration.
+Use 'migrate dspa convert' to convert DataSciencePipelinesApplications to v1.
+Use 'migrate inferenceservice shadow' to mirror traffic to a RawDeployment InferenceService before cutover.
+Use 'migrate inferenceservice to-raw' to migrate Serverless InferenceServices to RawDeployment mode.
+Use 'migrate modelmesh' to migrate ModelMesh InferenceServices to RawDeployment mode.
+Use 'migrate notebook pin-digests' to pin custom workbench images with floating tags to digests.
+Use 'migrate raycluster refresh-certs' to regenerate stale RayCluster oauth-proxy certificates.
+Use 'migrate raycluster rollback' to restore RayClusters from the backup taken before the upgrade.
 
 Migrations are version-aware and only execute when applicable to the current
 cluster state. Each migration can be run in dry-run mode to preview changes
 before applying them.
 
 Available subcommands:
-  list     List available migrations for a target version
-  prepare  Execute preparation steps (backups) for migrations
-  run      Execute one or more migrations
+  list              List available migrations for a target version
+  prepare           Execute preparation steps (backups) for migrations
+  run               Execute one or more migrations
+  restore-snapshot  Reapply the resources saved in a migration safety snapshot
+  dspa              Convert DataSciencePipelinesApplication resources
+  inferenceservice  Migrate InferenceServices from Serverless to RawDeployment mode
+  modelmesh         Migrate ModelMesh InferenceServices to RawDeployment mode
+  notebook          Pin custom workbench images to digests before upgrading
+  raycluster        Regenerate RayCluster oauth-proxy certificates or roll RayClusters back
 `
 
 const cmdExample = `
@@ -49,6 +69,21 @@ const cmdExample = `
   # Run migration in dry-run mode (preview changes only)
   kubectl odh migrate run --migration kueue.rhbok.migrate --target-version 3.0.0 --dry-run
 
+  # Preview DataSciencePipelinesApplication v1alpha1 to v1 conversion
+  kubectl odh migrate dspa convert --dry-run
+
+  # Preview the migration of Serverless InferenceServices to RawDeployment mode
+  kubectl odh migrate inferenceservice to-raw --dry-run
+
+  # Preview the migration of ModelMesh InferenceServices to RawDeployment mode
+  kubectl odh migrate modelmesh --dry-run
+
+  # Print patches pinning custom workbench images with floating tags to digests
+  kubectl odh migrate notebook pin-digests
+
+  # Preview the regeneration of RayCluster oauth-proxy certificates
+  kubectl odh migrate raycluster refresh-certs --dry-run
+
   # Run multiple migrations sequentially
   kubectl odh migrate run --migration kueue.rhbok.migrate --migration other.migration --target-version 3.0.0 --yes
 `
@@ -73,6 +108,12 @@ func AddCommand(root *cobra.Command, flags *genericclioptions.ConfigFlags) {
 	list.AddCommand(cmd, flags, streams)
 	prepare.AddCommand(cmd, flags, streams)
 	run.AddCommand(cmd, flags, streams)
+	restoresnapshot.AddCommand(cmd, flags, streams)
+	dspa.AddCommand(cmd, flags, streams)
+	inferenceservice.AddCommand(cmd, flags, streams)
+	modelmesh.AddCommand(cmd, flags, streams)
+	notebook.AddCommand(cmd, flags, streams)
+	raycluster.AddCommand(cmd, flags, streams)
 
 	root.AddCommand(cmd)
 }
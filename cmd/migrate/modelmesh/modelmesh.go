@@ -0,0 +1,91 @@
+package modelmesh
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate"
+)
+
+const (
+	cmdName  = "modelmesh"
+	cmdShort = "Migrate ModelMesh InferenceServices to KServe RawDeployment mode"
+)
+
+const cmdLong = `
+Migrate KServe InferenceServices annotated with serving.kserve.io/deploymentMode=ModelMesh
+to the RawDeployment mode, as ModelMesh is removed in OpenShift AI 3.x.
+
+Each InferenceService is served by the multi-model ServingRuntime named in
+.spec.predictor.model.runtime or, when unset, by the first one of its namespace
+auto-selecting its model format. Each ServingRuntime in use gets a single-model copy
+named <runtime>-raw:
+  - .spec.multiModel is set to false
+  - the ModelMesh adapter fields (grpcEndpoint, grpcDataEndpoint, builtInAdapter) are dropped
+  - the containers are kept unchanged: review their arguments, as single-model runtimes
+    load the model from /mnt/models
+
+KServe does not allow changing the deployment mode of an existing InferenceService, so
+each one is saved to --backup-dir along with its ServingRuntime, deleted and re-created:
+  - serving.kserve.io/deploymentMode=RawDeployment
+  - .spec.predictor.model.runtime set to the single-model copy
+  - networking.kserve.io/visibility=exposed when the runtime had enable-route=true
+  - security.opendatahub.io/enable-auth=true when the runtime had enable-auth=true
+
+The command waits for the new predictor pods to be ready before migrating the next
+InferenceService; each model is unavailable in between. The ModelMesh ServingRuntimes
+are kept and can be deleted once no ModelMesh InferenceService uses them.
+
+By default all namespaces are migrated; use --namespace and --name to restrict the scope.
+
+To roll back an InferenceService, delete its RawDeployment replacement and run
+'migrate restore-snapshot' on the backup directory.
+`
+
+const cmdExample = `
+  # Preview the conversion of all ModelMesh InferenceServices
+  kubectl odh migrate modelmesh --dry-run
+
+  # Migrate the ModelMesh InferenceServices of a namespace
+  kubectl odh migrate modelmesh -n my-project
+
+  # Migrate a single InferenceService without confirmation
+  kubectl odh migrate modelmesh -n my-project --name my-model --yes
+`
+
+// AddCommand adds the modelmesh subcommand to the migrate command.
+// The namespace scope is read from the global --namespace flag.
+func AddCommand(
+	parent *cobra.Command,
+	flags *genericclioptions.ConfigFlags,
+	streams genericiooptions.IOStreams,
+) {
+	command := migrate.NewModelMeshCommand(streams)
+	command.ConfigFlags = flags
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			//nolint:wrapcheck // Errors from Complete and Validate are already contextualized
+			if err := command.Complete(); err != nil {
+				return err
+			}
+			//nolint:wrapcheck // Errors from Validate are already contextualized
+			if err := command.Validate(); err != nil {
+				return err
+			}
+
+			return command.Run(cmd.Context())
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+	parent.AddCommand(cmd)
+}
@@ -0,0 +1,42 @@
+package notebook
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/cmd/migrate/notebook/pindigests"
+)
+
+const (
+	cmdName  = "notebook"
+	cmdShort = "Manage Notebook (workbench) migrations"
+)
+
+const cmdLong = `
+Manage migrations of Notebook (workbench) resources.
+
+Available subcommands:
+  pin-digests  Pin custom workbench images with floating tags to their current digests
+`
+
+// AddCommand adds the notebook command to the migrate command.
+func AddCommand(
+	parent *cobra.Command,
+	flags *genericclioptions.ConfigFlags,
+	streams genericiooptions.IOStreams,
+) {
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Aliases:       []string{"notebooks", "workbench"},
+		Short:         cmdShort,
+		Long:          cmdLong,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+
+	pindigests.AddCommand(cmd, flags, streams)
+
+	parent.AddCommand(cmd)
+}
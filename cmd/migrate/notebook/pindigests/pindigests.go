@@ -0,0 +1,73 @@
+package pindigests
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate"
+)
+
+const (
+	cmdName  = "pin-digests"
+	cmdShort = "Pin custom workbench images with floating tags to their current digests"
+)
+
+const cmdLong = `
+Pin workbenches that run custom images by tag (e.g. :latest) to the digest the registry
+currently serves for that tag, so restarting workbenches during the upgrade cannot pull
+a different image.
+
+Custom images are those the lint impacted workloads check classifies CUSTOM: images that
+do not resolve to an OpenShift AI notebook ImageStream. Images already referenced by
+digest are left alone. Digests are resolved through the registry API, anonymously or with
+the credentials of --registry-config.
+
+The command does not change the cluster. It prints one 'kubectl patch' command per
+workbench to stdout; each patch tests the current image first, so it fails rather than
+pin a workbench whose image changed in the meantime. Review and run them before upgrading.
+`
+
+const cmdExample = `
+  # Print digest pinning patches for all workbenches
+  kubectl odh migrate notebook pin-digests
+
+  # Pin the workbenches of one project using private registry credentials, then apply
+  kubectl odh migrate notebook pin-digests -n my-project --registry-config ~/.docker/config.json > pin.sh
+  sh pin.sh
+`
+
+// AddCommand adds the pin-digests subcommand to the notebook command.
+func AddCommand(
+	parent *cobra.Command,
+	flags *genericclioptions.ConfigFlags,
+	streams genericiooptions.IOStreams,
+) {
+	command := migrate.NewNotebookPinDigestsCommand(streams)
+	command.ConfigFlags = flags
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			//nolint:wrapcheck // Errors from Complete and Validate are already contextualized
+			if err := command.Complete(); err != nil {
+				return err
+			}
+			//nolint:wrapcheck // Errors from Validate are already contextualized
+			if err := command.Validate(); err != nil {
+				return err
+			}
+
+			return command.Run(cmd.Context())
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+	parent.AddCommand(cmd)
+}
@@ -0,0 +1,48 @@
+package raycluster
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/cmd/migrate/raycluster/refreshcerts"
+	"github.com/opendatahub-io/odh-cli/cmd/migrate/raycluster/rollback"
+	"github.com/opendatahub-io/odh-cli/cmd/migrate/raycluster/status"
+)
+
+const (
+	cmdName  = "raycluster"
+	cmdShort = "Manage RayCluster migrations"
+)
+
+const cmdLong = `
+Manage migrations of RayCluster resources.
+
+Available subcommands:
+  refresh-certs  Regenerate the oauth-proxy serving certificates and restart head pods
+  rollback       Restore RayClusters from the backup taken before the upgrade
+  status         Show the RayCluster outcomes recorded in a migration state file
+`
+
+// AddCommand adds the raycluster command to the migrate command.
+func AddCommand(
+	parent *cobra.Command,
+	flags *genericclioptions.ConfigFlags,
+	streams genericiooptions.IOStreams,
+) {
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Aliases:       []string{"rayclusters"},
+		Short:         cmdShort,
+		Long:          cmdLong,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+
+	refreshcerts.AddCommand(cmd, flags, streams)
+	rollback.AddCommand(cmd, flags, streams)
+	status.AddCommand(cmd, streams)
+
+	parent.AddCommand(cmd)
+}
@@ -0,0 +1,108 @@
+package refreshcerts
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate"
+)
+
+const (
+	cmdName  = "refresh-certs"
+	cmdShort = "Regenerate RayCluster oauth-proxy serving certificates"
+)
+
+const cmdLong = `
+Regenerate the oauth-proxy TLS certificates of RayClusters. RayClusters upgraded in place
+can keep stale serving certificate Secrets, making their dashboard route answer 502.
+
+For each RayCluster, the Secrets named by the service.beta.openshift.io/serving-cert-secret-name
+annotation of its Services are deleted, and the command waits for the service CA operator
+to regenerate them. The head pods of the cluster are then deleted for KubeRay to re-create
+them with the new certificates, which interrupts the work running on the cluster.
+
+Before asking for confirmation, the Ray dashboard of each RayCluster is queried through
+its dashboard route for active (pending or running) jobs and alive actors, and the prompt
+lists the clusters whose work would be interrupted. Use --skip-busy to leave those
+clusters, and clusters whose dashboard cannot be queried, untouched. For a single --name
+cluster, --dashboard-url queries the dashboard through a port-forward instead of the route.
+
+RayClusters are refreshed one at a time by default; use --max-parallel to refresh several
+concurrently. Progress lines are prefixed with the cluster name, a failed cluster is reported
+and the others refreshed, and a summary closes the run. On Ctrl+C no further cluster is
+started, and the clusters in progress are refreshed to completion.
+
+By default all namespaces are refreshed; use --namespace and --name to restrict the scope.
+Use --dry-run to list the Secrets and head pods of each RayCluster.
+
+The same step is available to 'migrate run' as the ray.refresh-certs.migrate migration.
+
+Use --output json or --output yaml to also write a report to stdout, with the status,
+duration, dashboard URL and error of each RayCluster, for automation. The progress log
+is still written to stderr.
+
+Use --state-file to record the outcome of each RayCluster as it completes. After a failed
+or interrupted run, re-run with --resume and the same --state-file to skip the clusters
+already refreshed; 'migrate raycluster status' prints the recorded outcomes.
+`
+
+const cmdExample = `
+  # List the Secrets and head pods that would be refreshed in all namespaces
+  kubectl odh migrate raycluster refresh-certs --dry-run
+
+  # Refresh the certificates of all RayClusters, 10 at a time
+  kubectl odh migrate raycluster refresh-certs --max-parallel 10
+
+  # Refresh the certificates of a single RayCluster without confirmation
+  kubectl odh migrate raycluster refresh-certs -n my-project --name my-cluster --yes
+
+  # Refresh only the RayClusters without active jobs or alive actors
+  kubectl odh migrate raycluster refresh-certs --skip-busy --yes
+
+  # Query the dashboard through a port-forward to the head service
+  kubectl port-forward -n my-project svc/my-cluster-head-svc 8265:8265 &
+  kubectl odh migrate raycluster refresh-certs -n my-project --name my-cluster --dashboard-url http://localhost:8265
+
+  # Write a JSON report of the run for automation
+  kubectl odh migrate raycluster refresh-certs --yes -o json > report.json
+
+  # Resume a failed run, skipping the RayClusters it completed
+  kubectl odh migrate raycluster refresh-certs --yes --state-file ray-state.json --resume
+`
+
+// AddCommand adds the refresh-certs subcommand to the raycluster command.
+// The namespace scope is read from the global --namespace flag.
+func AddCommand(
+	parent *cobra.Command,
+	flags *genericclioptions.ConfigFlags,
+	streams genericiooptions.IOStreams,
+) {
+	command := migrate.NewRayClusterRefreshCertsCommand(streams)
+	command.ConfigFlags = flags
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			//nolint:wrapcheck // Errors from Complete and Validate are already contextualized
+			if err := command.Complete(); err != nil {
+				return err
+			}
+			//nolint:wrapcheck // Errors from Validate are already contextualized
+			if err := command.Validate(); err != nil {
+				return err
+			}
+
+			return command.Run(cmd.Context())
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+	parent.AddCommand(cmd)
+}
@@ -0,0 +1,91 @@
+package rollback
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate"
+)
+
+const (
+	cmdName  = "rollback"
+	cmdShort = "Restore RayClusters from the backup taken before the upgrade"
+)
+
+const cmdLong = `
+Restore RayClusters from the backup taken before the upgrade, undoing their migration.
+
+The RayClusters are read from the backup directory given with --backup-dir, in the layout
+written by 'kubectl odh backup'; when the directory has a rhoai-2.x subdirectory, that
+subdirectory is read. For each backed up RayCluster:
+
+  1. The migrated RayCluster is deleted, and the command waits until it is gone
+  2. The RayCluster is re-created from the backup
+  3. Its CodeFlare ServiceAccounts (owned by the cluster or named <cluster>-oauth-proxy)
+     that are missing from the cluster are re-created from the backup
+  4. The command waits until the ray-dashboard-<cluster> Route is admitted
+
+RayClusters are rolled back one at a time; a failed cluster is reported and the next one
+rolled back. By default all namespaces are rolled back; use --namespace and --name to
+restrict the scope. Use --dry-run to list the changes per RayCluster. Running Ray jobs are
+interrupted.
+
+Use --output json or --output yaml to also write a report to stdout, with the status,
+duration, dashboard URL and error of each RayCluster, for automation. The progress log
+is still written to stderr.
+
+Use --state-file to record the outcome of each RayCluster as it completes. After a failed
+or interrupted run, re-run with --resume and the same --state-file to skip the clusters
+already restored; 'migrate raycluster status' prints the recorded outcomes.
+`
+
+const cmdExample = `
+  # List the RayClusters that would be restored from a backup
+  kubectl odh migrate raycluster rollback --backup-dir ./backup --dry-run
+
+  # Restore a single RayCluster without confirmation
+  kubectl odh migrate raycluster rollback --backup-dir ./backup -n my-project --name my-cluster --yes
+
+  # Write a JSON report of the run for automation
+  kubectl odh migrate raycluster rollback --backup-dir ./backup --yes -o json > report.json
+
+  # Resume a failed run, skipping the RayClusters it completed
+  kubectl odh migrate raycluster rollback --backup-dir ./backup --yes --state-file ray-state.json --resume
+`
+
+// AddCommand adds the rollback subcommand to the raycluster command.
+// The namespace scope is read from the global --namespace flag.
+func AddCommand(
+	parent *cobra.Command,
+	flags *genericclioptions.ConfigFlags,
+	streams genericiooptions.IOStreams,
+) {
+	command := migrate.NewRayClusterRollbackCommand(streams)
+	command.ConfigFlags = flags
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			//nolint:wrapcheck // Errors from Complete and Validate are already contextualized
+			if err := command.Complete(); err != nil {
+				return err
+			}
+			//nolint:wrapcheck // Errors from Validate are already contextualized
+			if err := command.Validate(); err != nil {
+				return err
+			}
+
+			return command.Run(cmd.Context())
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+	parent.AddCommand(cmd)
+}
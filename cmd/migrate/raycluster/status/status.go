@@ -0,0 +1,63 @@
+package status
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate"
+)
+
+const (
+	cmdName  = "status"
+	cmdShort = "Show the RayCluster outcomes recorded in a migration state file"
+)
+
+const cmdLong = `
+Show the contents of the state file written by 'migrate raycluster refresh-certs' and
+'migrate raycluster rollback' with --state-file: the last outcome of each RayCluster per
+operation, with the time it was recorded and the error of failed clusters.
+
+Clusters recorded as Succeeded are skipped when the command is re-run with --resume.
+The state file is read locally; no cluster access is needed.
+`
+
+const cmdExample = `
+  # Show the recorded outcomes
+  kubectl odh migrate raycluster status --state-file ray-state.json
+
+  # Show the recorded outcomes as JSON
+  kubectl odh migrate raycluster status --state-file ray-state.json -o json
+`
+
+// AddCommand adds the status subcommand to the raycluster command.
+func AddCommand(
+	parent *cobra.Command,
+	streams genericiooptions.IOStreams,
+) {
+	command := migrate.NewRayClusterStatusCommand(streams)
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			//nolint:wrapcheck // Errors from Complete and Validate are already contextualized
+			if err := command.Complete(); err != nil {
+				return err
+			}
+			//nolint:wrapcheck // Errors from Validate are already contextualized
+			if err := command.Validate(); err != nil {
+				return err
+			}
+
+			return command.Run(cmd.Context())
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+	parent.AddCommand(cmd)
+}
@@ -0,0 +1,72 @@
+package restoresnapshot
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate"
+)
+
+const (
+	cmdName  = "restore-snapshot <dir>"
+	cmdShort = "Reapply the resources saved in a migration safety snapshot"
+)
+
+const cmdLong = `
+Reapply the resources saved in a safety snapshot taken by 'migrate run'.
+
+Before each migration mutates the cluster, 'migrate run' saves the DataScienceCluster,
+the DSCInitialization and the resources the migration changes to a timestamped
+subdirectory of --backup-dir, and prints its path.
+
+Existing resources get their labels, annotations and content (everything except
+status and server-managed metadata) replaced by the snapshot. Resources deleted
+since the snapshot are re-created.
+`
+
+const cmdExample = `
+  # Show what a snapshot would restore
+  kubectl odh migrate restore-snapshot backup-migrate-snapshots/snapshot-20260101-120000-kueue.rhbok.migrate --dry-run
+
+  # Restore a snapshot without confirmation prompts
+  kubectl odh migrate restore-snapshot backup-migrate-snapshots/snapshot-20260101-120000-kueue.rhbok.migrate --yes
+`
+
+// AddCommand adds the restore-snapshot subcommand to the migrate command.
+func AddCommand(
+	parent *cobra.Command,
+	flags *genericclioptions.ConfigFlags,
+	streams genericiooptions.IOStreams,
+) {
+	command := migrate.NewRestoreSnapshotCommand(streams)
+	command.ConfigFlags = flags
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		Args:          cobra.ExactArgs(1),
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			command.Dir = args[0]
+
+			//nolint:wrapcheck // Errors from Complete and Validate are already contextualized
+			if err := command.Complete(); err != nil {
+				return err
+			}
+			//nolint:wrapcheck // Errors from Validate are already contextualized
+			if err := command.Validate(); err != nil {
+				return err
+			}
+
+			return command.Run(cmd.Context())
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+	parent.AddCommand(cmd)
+}
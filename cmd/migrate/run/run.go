@@ -18,10 +18,32 @@ const cmdLong = `
 Execute one or more migrations sequentially for OpenShift AI components.
 
 Migrations are executed in the order specified. If any migration fails, execution
-stops immediately. Each migration can require user confirmation unless --yes is specified.
+stops immediately. With --all, every migration applicable to an upgrade from the current
+to the target version is executed instead, ordered so each migration runs after the ones it
+depends on; migrations that must be selected explicitly, such as ray.refresh-certs.migrate,
+are not included. Each migration can require user confirmation unless --yes is specified.
+
+When several migrations run, a summary of each one is printed at the end.
 
 Use --dry-run to preview changes without applying them.
+
+Before each migration mutates the cluster, the DataScienceCluster, the DSCInitialization
+and the resources the migration changes are saved to a timestamped subdirectory of
+--backup-dir. Use 'migrate restore-snapshot <dir>' to reapply them.
+
 Use 'migrate prepare' to backup resources before running migrations.
+
+For change control, --plan <file> dry-runs the migrations and writes a plan listing them in
+order, the resources each may change with their resourceVersion, and the expected outcome of
+each step, without changing the cluster. --apply <file> executes the migrations of a reviewed
+plan, and refuses to start when the cluster version or any planned resource changed since the
+plan was generated.
+
+--rollback reverts the migrations given with --migration, in reverse order, restoring the
+resources saved by 'migrate prepare' in the --restore-from directory. For kueue.rhbok.migrate,
+the Red Hat Build of Kueue Subscription and CSV are removed, the DataScienceCluster Kueue
+managementState is set back to Managed, and the backed up ClusterQueues and LocalQueues that are
+missing are re-created. Migrations without a rollback refuse it.
 `
 
 const cmdExample = `
@@ -34,9 +56,19 @@ const cmdExample = `
   # Run migration without confirmation prompts
   kubectl odh migrate run --migration kueue.rhbok.migrate --target-version 3.0.0 --yes
 
+  # Run every migration applicable to the upgrade, in dependency order
+  kubectl odh migrate run --all --target-version 3.0.0 --dry-run
+
   # Run multiple migrations sequentially
   kubectl odh migrate run -m kueue.rhbok.migrate -m other.migration --target-version 3.0.0
 
+  # Write a plan for review, then execute exactly the reviewed migrations
+  kubectl odh migrate run --migration kueue.rhbok.migrate --target-version 3.0.0 --plan plan.yaml
+  kubectl odh migrate run --apply plan.yaml --yes
+
+  # Roll back the RHBOK migration from the backup of 'migrate prepare'
+  kubectl odh migrate run --migration kueue.rhbok.migrate --rollback --restore-from ./backup-migrate-20260101-120000
+
   # Typical workflow: prepare first, then run
   kubectl odh migrate prepare --migration kueue.rhbok.migrate --target-version 3.0.0
   kubectl odh migrate run --migration kueue.rhbok.migrate --target-version 3.0.0 --yes
@@ -0,0 +1,43 @@
+package remediation
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/cmd/remediation/status"
+)
+
+const (
+	cmdName  = "remediation"
+	cmdShort = "Track remediation of lint findings"
+)
+
+const cmdLong = `
+Track the remediation of findings reported by "lint".
+
+Available subcommands:
+  status  Re-evaluate the findings of a previous lint report and show which were fixed
+`
+
+// AddCommand adds the remediation command to the root command.
+func AddCommand(root *cobra.Command, flags *genericclioptions.ConfigFlags) {
+	streams := genericiooptions.IOStreams{
+		In:     root.InOrStdin(),
+		Out:    root.OutOrStdout(),
+		ErrOut: root.ErrOrStderr(),
+	}
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+
+	status.AddCommand(cmd, flags, streams)
+
+	root.AddCommand(cmd)
+}
@@ -0,0 +1,74 @@
+package status
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+)
+
+const (
+	cmdName  = "status"
+	cmdShort = "Show which findings of a previous lint report were fixed"
+)
+
+const cmdLong = `
+Re-evaluate the findings of a previous lint report and show, per finding,
+whether it was fixed or persists.
+
+Only the checks that produced findings in the baseline report are run again,
+which is much faster than a full lint run. The report must be a lint JSON or
+YAML output; an upgrade assessment (run with --target-version) is re-evaluated
+against the same target version.
+
+Each finding (an impacted object, or a failing condition for checks that do
+not report objects) is listed with one of the statuses:
+  - fixed         : no longer reported
+  - persisting    : still reported
+  - new           : reported now but not in the baseline, e.g. introduced by a fix
+  - not-applicable: the check no longer applies, so the finding was not re-evaluated
+`
+
+const cmdExample = `
+  # Save a baseline, apply fixes, then check which findings were resolved
+  kubectl odh lint --target-version 3.0 -o json=first-run.json
+  kubectl odh remediation status --baseline first-run.json
+
+  # Save the remediation status as JSON while printing the table
+  kubectl odh remediation status --baseline first-run.json -o table -o json=status.json
+`
+
+// AddCommand adds the status subcommand to the remediation command.
+func AddCommand(
+	parent *cobra.Command,
+	flags *genericclioptions.ConfigFlags,
+	streams genericiooptions.IOStreams,
+) {
+	command := lint.NewRemediationStatusCommand(streams, flags)
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			//nolint:wrapcheck // Errors from Complete and Validate are already contextualized
+			if err := command.Complete(); err != nil {
+				return err
+			}
+			//nolint:wrapcheck // Errors from Validate are already contextualized
+			if err := command.Validate(); err != nil {
+				return err
+			}
+
+			return command.Run(cmd.Context())
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+	parent.AddCommand(cmd)
+}
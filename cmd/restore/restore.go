@@ -0,0 +1,88 @@
+package restore
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	restorepkg "github.com/opendatahub-io/odh-cli/pkg/restore"
+)
+
+const (
+	cmdName  = "restore <dir|archive>"
+	cmdShort = "Restore the resources of a backup directory or archive"
+)
+
+const cmdLong = `
+Restores the resources of a directory written by 'backup --output-dir', or of
+an archive written by 'backup --archive'. Archives are verified against the
+checksums of their manifest.yaml before anything is restored.
+
+The restore command:
+  - Reads every resource of the $dir/$namespace/$GVR-$name.yaml layout
+  - Filters them by --namespaces, --selector and --includes/--exclude
+  - Restores namespaces first, then CRDs, then core resources (ConfigMaps,
+    Secrets, PVCs, ...), then custom resources
+  - Strips cluster-specific metadata before writing
+
+With --mode create (the default), resources that already exist are left
+unchanged. With --mode apply, resources are server-side applied and take
+ownership of conflicting fields.
+
+A resource that fails to restore is reported and the restore continues; the
+command fails if any resource could not be restored.
+`
+
+const cmdExample = `
+  # Show what a backup would restore, in order
+  kubectl odh restore /tmp/backup --dry-run
+
+  # Restore the notebooks of one namespace
+  kubectl odh restore /tmp/backup --namespaces team-a --includes notebooks.kubeflow.org --yes
+
+  # Restore a backup archive
+  kubectl odh restore backup.tar.gz --yes
+
+  # Reapply a backup over existing resources
+  kubectl odh restore /tmp/backup --mode apply
+`
+
+// AddCommand adds the restore command to the root command.
+func AddCommand(root *cobra.Command, flags *genericclioptions.ConfigFlags) {
+	streams := genericiooptions.IOStreams{
+		In:     root.InOrStdin(),
+		Out:    root.OutOrStdout(),
+		ErrOut: root.ErrOrStderr(),
+	}
+
+	command := restorepkg.NewCommand(streams)
+	command.ConfigFlags = flags
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		Args:          cobra.ExactArgs(1),
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			command.Dir = args[0]
+
+			//nolint:wrapcheck // Errors from Complete and Validate are already contextualized
+			if err := command.Complete(); err != nil {
+				return err
+			}
+			//nolint:wrapcheck // Errors from Validate are already contextualized
+			if err := command.Validate(); err != nil {
+				return err
+			}
+
+			return command.Run(cmd.Context())
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+	root.AddCommand(cmd)
+}
@@ -0,0 +1,52 @@
+package rules
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/cmd/rules/show"
+	"github.com/opendatahub-io/odh-cli/cmd/rules/update"
+)
+
+const (
+	cmdName  = "rules"
+	cmdShort = "Manage the compatibility rules bundle"
+)
+
+const cmdLong = `
+Manage the compatibility data used by lint checks and migrations (such as the
+RHBOK operator support matrix).
+
+The data is built into the binary and can be replaced by a newer signed bundle,
+so disconnected environments can pick up compatibility updates without a new
+CLI release. Installed bundles are stored in $ODH_CONFIG_DIR/rules, or in
+<user config dir>/odh/rules when ODH_CONFIG_DIR is not set.
+
+Available subcommands:
+  update  Install a signed rules bundle from a file or URL
+  show    Show the installed bundle and the effective compatibility data
+`
+
+// AddCommand adds the rules command to the root command.
+func AddCommand(root *cobra.Command, _ *genericclioptions.ConfigFlags) {
+	streams := genericiooptions.IOStreams{
+		In:     root.InOrStdin(),
+		Out:    root.OutOrStdout(),
+		ErrOut: root.ErrOrStderr(),
+	}
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+
+	update.AddCommand(cmd, streams)
+	show.AddCommand(cmd, streams)
+
+	root.AddCommand(cmd)
+}
@@ -0,0 +1,50 @@
+package show
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/rules"
+)
+
+const (
+	cmdName  = "show"
+	cmdShort = "Show the installed bundle and the effective compatibility data"
+)
+
+const cmdExample = `
+  # Show the effective compatibility data
+  kubectl odh rules show
+
+  # Show it as JSON
+  kubectl odh rules show -o json
+`
+
+// AddCommand adds the show subcommand to the rules command.
+func AddCommand(parent *cobra.Command, streams genericiooptions.IOStreams) {
+	command := rules.NewShowCommand(streams)
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Example:       cmdExample,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			//nolint:wrapcheck // Errors from Complete and Validate are already contextualized
+			if err := command.Complete(); err != nil {
+				return err
+			}
+			//nolint:wrapcheck // Errors from Validate are already contextualized
+			if err := command.Validate(); err != nil {
+				return err
+			}
+
+			return command.Run(cmd.Context())
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+	parent.AddCommand(cmd)
+}
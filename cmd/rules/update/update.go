@@ -0,0 +1,65 @@
+package update
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/rules"
+)
+
+const (
+	cmdName  = "update"
+	cmdShort = "Install a signed rules bundle from a file or URL"
+)
+
+const cmdLong = `
+Install a compatibility rules bundle.
+
+A bundle is a tar.gz archive containing bundle.yaml and its detached ed25519
+signature bundle.yaml.sig. The signature is verified against the public key built
+into the binary (or --public-key), and bundles with a schema version newer than
+this CLI supports are rejected. Installing a bundle older than the installed one
+requires --allow-downgrade.
+
+The key the bundle was verified with is saved next to it, and the installed
+bundle is verified again against that key each time it is loaded, so a bundle
+modified after installation is rejected.
+`
+
+const cmdExample = `
+  # Install a bundle copied into a disconnected environment
+  kubectl odh rules update --from odh-rules-2026.10.1.tar.gz
+
+  # Download and install a bundle on a connected workstation
+  kubectl odh rules update --from-url https://example.com/odh-rules-2026.10.1.tar.gz
+`
+
+// AddCommand adds the update subcommand to the rules command.
+func AddCommand(parent *cobra.Command, streams genericiooptions.IOStreams) {
+	command := rules.NewUpdateCommand(streams)
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			//nolint:wrapcheck // Errors from Complete and Validate are already contextualized
+			if err := command.Complete(); err != nil {
+				return err
+			}
+			//nolint:wrapcheck // Errors from Validate are already contextualized
+			if err := command.Validate(); err != nil {
+				return err
+			}
+
+			return command.Run(cmd.Context())
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+	parent.AddCommand(cmd)
+}
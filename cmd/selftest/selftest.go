@@ -0,0 +1,72 @@
+package selftest
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/selftest"
+)
+
+const (
+	cmdName  = "selftest"
+	cmdShort = "Run the check suite against simulated clusters"
+)
+
+const cmdLong = `
+Run the full lint check suite against in-memory simulated clusters seeded with
+built-in fixtures for representative cluster states, and verify that every check
+executes and that the table, JSON and YAML outputs render and parse back.
+
+No cluster access is needed, so this is a fast smoke test after installing a new
+CLI version, including in locked-down environments.
+
+Scenarios:
+  installed-3x     RHOAI 3.0 cluster in lint mode
+  upgrade-ready    RHOAI 2.25 cluster prepared for an upgrade to 3.0
+  upgrade-blocked  RHOAI 2.25 cluster with deprecated components and workloads
+`
+
+const cmdExample = `
+  # Run all scenarios
+  kubectl odh selftest
+
+  # Run one scenario and show its lint output
+  kubectl odh selftest --scenario upgrade-blocked --verbose
+`
+
+// AddCommand adds the selftest command to the root command.
+func AddCommand(root *cobra.Command, _ *genericclioptions.ConfigFlags) {
+	streams := genericiooptions.IOStreams{
+		In:     root.InOrStdin(),
+		Out:    root.OutOrStdout(),
+		ErrOut: root.ErrOrStderr(),
+	}
+
+	command := selftest.NewCommand(streams)
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			//nolint:wrapcheck // Errors from Complete and Validate are already contextualized
+			if err := command.Complete(); err != nil {
+				return err
+			}
+			//nolint:wrapcheck // Errors from Validate are already contextualized
+			if err := command.Validate(); err != nil {
+				return err
+			}
+
+			return command.Run(cmd.Context())
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+	root.AddCommand(cmd)
+}
@@ -0,0 +1,69 @@
+package create
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+)
+
+const (
+	cmdName  = "create <file>"
+	cmdShort = "Write a snapshot archive of the objects the lint checks read"
+)
+
+const cmdLong = `
+List the resources the selected lint checks read and write them, with a
+snapshot.yaml manifest, to a gzip-compressed tarball (.tar.gz or .tgz).
+
+Run the checks against the snapshot later with "lint --from-snapshot <file>".
+`
+
+const cmdExample = `
+  # Snapshot the resources of all checks
+  kubectl odh snapshot create cluster.tar.gz
+
+  # Snapshot the resources of the workload checks only
+  kubectl odh snapshot create workloads.tar.gz --checks 'workloads.*'
+
+  # Reproduce the run later
+  kubectl odh lint --from-snapshot cluster.tar.gz --target-version 3.0
+`
+
+// AddCommand adds the create subcommand to the snapshot command.
+func AddCommand(
+	parent *cobra.Command,
+	flags *genericclioptions.ConfigFlags,
+	streams genericiooptions.IOStreams,
+) {
+	command := lint.NewSnapshotCreateCommand(streams, flags)
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		Args:          cobra.ExactArgs(1),
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			command.Output = args[0]
+
+			//nolint:wrapcheck // Errors from Complete and Validate are already contextualized
+			if err := command.Complete(); err != nil {
+				return err
+			}
+			//nolint:wrapcheck // Errors from Validate are already contextualized
+			if err := command.Validate(); err != nil {
+				return err
+			}
+
+			return command.Run(cmd.Context())
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+	parent.AddCommand(cmd)
+}
@@ -0,0 +1,50 @@
+package snapshot
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/cmd/snapshot/create"
+)
+
+const (
+	cmdName  = "snapshot"
+	cmdShort = "Capture cluster state for reproducible lint runs"
+)
+
+const cmdLong = `
+Capture the objects the lint checks read into a snapshot archive, so a lint run
+can be reproduced later without cluster access with "lint --from-snapshot".
+
+A snapshot holds exactly the resource types the selected checks declare, the
+platform resources version detection reads, and the discovered workload types,
+with a snapshot.yaml manifest recording the CLI and cluster versions, the checks
+and the object count of each resource type. Secret values are redacted; their
+keys are kept.
+
+Available subcommands:
+  create  Write a snapshot archive of the cluster
+`
+
+// AddCommand adds the snapshot command to the root command.
+func AddCommand(root *cobra.Command, flags *genericclioptions.ConfigFlags) {
+	streams := genericiooptions.IOStreams{
+		In:     root.InOrStdin(),
+		Out:    root.OutOrStdout(),
+		ErrOut: root.ErrOrStderr(),
+	}
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+
+	create.AddCommand(cmd, flags, streams)
+
+	root.AddCommand(cmd)
+}
@@ -0,0 +1,81 @@
+package supportbundle
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/supportbundle"
+)
+
+const (
+	cmdName  = "support-bundle"
+	cmdShort = "Collect a redacted cluster snapshot for troubleshooting"
+)
+
+const cmdLong = `
+Collect what support needs to troubleshoot an OpenShift AI installation into a
+single gzip-compressed tarball:
+
+  - resources/     the DataScienceCluster and DSCInitialization
+  - logs/          the last --log-lines lines of each operator container
+  - deployments/   the rollout status of the Deployments of the applications
+                   and operator namespaces
+  - events/        the Events of those namespaces last seen within --events-since
+  - lint.json      the results of all lint checks
+  - bundle.yaml    the CLI and cluster versions and anything that could not be
+                   collected
+
+Credentials found in logs, Event messages and lint output are redacted, and the
+managed fields and last-applied configuration are stripped from resources.
+What cannot be collected is reported as a warning; the rest of the bundle is
+still written.
+`
+
+const cmdExample = `
+  # Collect a bundle to odh-support-bundle-<timestamp>.tar.gz
+  kubectl odh support-bundle
+
+  # Collect more log lines to a chosen file
+  kubectl odh support-bundle -o case-01234.tar.gz --log-lines 2000
+
+  # Collect without running the lint checks
+  kubectl odh support-bundle --skip-lint
+`
+
+// AddCommand adds the support-bundle command to the root command.
+func AddCommand(root *cobra.Command, flags *genericclioptions.ConfigFlags) {
+	streams := genericiooptions.IOStreams{
+		In:     root.InOrStdin(),
+		Out:    root.OutOrStdout(),
+		ErrOut: root.ErrOrStderr(),
+	}
+
+	command := supportbundle.NewCommand(streams, flags)
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		Args:          cobra.NoArgs,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			//nolint:wrapcheck // Errors from Complete and Validate are already contextualized
+			if err := command.Complete(); err != nil {
+				return err
+			}
+			//nolint:wrapcheck // Errors from Validate are already contextualized
+			if err := command.Validate(); err != nil {
+				return err
+			}
+
+			return command.Run(cmd.Context())
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+	root.AddCommand(cmd)
+}
@@ -0,0 +1,63 @@
+package preview
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+)
+
+const (
+	cmdName  = "preview"
+	cmdShort = "Print the telemetry report a lint run would send"
+)
+
+const cmdLong = `
+Run the lint checks exactly as "lint --telemetry" does and print, as JSON, the
+report that would be sent. Nothing is sent.
+
+Use the same --target-version, --checks and --config as the lint run to preview.
+`
+
+const cmdExample = `
+  # Preview the report of a lint run
+  kubectl odh telemetry preview
+
+  # Preview the report of an upgrade assessment
+  kubectl odh telemetry preview --target-version 3.0
+`
+
+// AddCommand adds the preview subcommand to the telemetry command.
+func AddCommand(
+	parent *cobra.Command,
+	flags *genericclioptions.ConfigFlags,
+	streams genericiooptions.IOStreams,
+) {
+	command := lint.NewTelemetryPreviewCommand(streams, flags)
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			//nolint:wrapcheck // Errors from Complete and Validate are already contextualized
+			if err := command.Complete(); err != nil {
+				return err
+			}
+			//nolint:wrapcheck // Errors from Validate are already contextualized
+			if err := command.Validate(); err != nil {
+				return err
+			}
+
+			return command.Run(cmd.Context())
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+	parent.AddCommand(cmd)
+}
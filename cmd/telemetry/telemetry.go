@@ -0,0 +1,51 @@
+package telemetry
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/cmd/telemetry/preview"
+)
+
+const (
+	cmdName  = "telemetry"
+	cmdShort = "Inspect the opt-in anonymized lint telemetry"
+)
+
+const cmdLong = `
+Inspect the anonymized statistics "lint --telemetry" sends.
+
+Telemetry is off unless "lint --telemetry" is set. A report contains the IDs of
+the executed checks with their pass/fail/error counts, a cluster size bucket
+(by node count), and the CLI, cluster and target versions. Object names,
+namespaces, messages and cluster identifiers are never collected, nor are the
+checks of out-of-tree plugins and the declarative checks of --checks-dir.
+
+Reports are posted to --telemetry-endpoint, or to $ODH_TELEMETRY_ENDPOINT.
+
+Available subcommands:
+  preview  Run the lint checks and print the report that would be sent
+`
+
+// AddCommand adds the telemetry command to the root command.
+func AddCommand(root *cobra.Command, flags *genericclioptions.ConfigFlags) {
+	streams := genericiooptions.IOStreams{
+		In:     root.InOrStdin(),
+		Out:    root.OutOrStdout(),
+		ErrOut: root.ErrOrStderr(),
+	}
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+
+	preview.AddCommand(cmd, flags, streams)
+
+	root.AddCommand(cmd)
+}
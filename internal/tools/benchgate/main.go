@@ -0,0 +1,99 @@
+// Command benchgate compares go test -bench results against a stored baseline and fails when
+// the median time or allocations per operation of a benchmark regressed beyond a threshold.
+//
+//	go run ./internal/tools/benchgate -baseline benchmarks/baseline.txt -current bench_output.txt
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+)
+
+const defaultThreshold = 0.2
+
+func main() {
+	baselinePath := flag.String("baseline", "benchmarks/baseline.txt", "go test -bench output of the baseline")
+	currentPath := flag.String("current", "bench_output.txt", "go test -bench output of the current run")
+	threshold := flag.Float64("threshold", defaultThreshold, "relative increase reported as a regression (0.2 = 20%)")
+	flag.Parse()
+
+	regressed, err := run(os.Stdout, *baselinePath, *currentPath, *threshold)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "benchgate: %v\n", err)
+		os.Exit(2)
+	}
+
+	if regressed {
+		os.Exit(1)
+	}
+}
+
+// run compares the results files, writes the comparison to out and returns whether any
+// benchmark regressed.
+func run(out io.Writer, baselinePath string, currentPath string, threshold float64) (bool, error) {
+	baseline, err := parseFile(baselinePath)
+	if err != nil {
+		return false, err
+	}
+
+	current, err := parseFile(currentPath)
+	if err != nil {
+		return false, err
+	}
+
+	if len(current) == 0 {
+		return false, fmt.Errorf("no benchmark results in %s", currentPath)
+	}
+
+	cmp := Compare(baseline, current, threshold)
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "BENCHMARK\tMETRIC\tBASELINE\tCURRENT\tDELTA\t")
+
+	for _, d := range cmp.Deltas {
+		marker := ""
+		if d.Change() > threshold {
+			marker = "REGRESSION"
+		}
+
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%.0f\t%.0f\t%+.1f%%\t%s\n", d.Name, d.Metric, d.Baseline, d.Current, d.Change()*100, marker)
+	}
+
+	if err := w.Flush(); err != nil {
+		return false, fmt.Errorf("writing comparison: %w", err)
+	}
+
+	for _, name := range cmp.Missing {
+		_, _ = fmt.Fprintf(out, "Missing from current run: %s\n", name)
+	}
+
+	for _, name := range cmp.Added {
+		_, _ = fmt.Fprintf(out, "Not in baseline: %s\n", name)
+	}
+
+	if len(cmp.Regressions) > 0 {
+		_, _ = fmt.Fprintf(out, "\n%d metric(s) regressed by more than %.0f%%\n", len(cmp.Regressions), threshold*100)
+
+		return true, nil
+	}
+
+	return false, nil
+}
+
+func parseFile(path string) (Results, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening %s: %w", path, err)
+	}
+	defer func() { _ = f.Close() }()
+
+	results, err := Parse(f)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+
+	return results, nil
+}
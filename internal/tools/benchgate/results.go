@@ -0,0 +1,171 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	// metricTime is the unit of the time per operation reported by every benchmark.
+	metricTime = "ns/op"
+
+	// metricAllocs is the unit of the allocations per operation reported with -benchmem.
+	metricAllocs = "allocs/op"
+)
+
+// procsSuffix is the -GOMAXPROCS suffix go test appends to benchmark names.
+var procsSuffix = regexp.MustCompile(`-\d+$`)
+
+// Results are the samples of each metric of each benchmark of a go test -bench run, keyed by
+// "<package>.<benchmark>" and unit.
+type Results map[string]map[string][]float64
+
+// Parse reads the output of go test -bench. Runs repeated with -count add samples.
+func Parse(r io.Reader) (Results, error) {
+	results := make(Results)
+	pkg := ""
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if name, ok := strings.CutPrefix(line, "pkg: "); ok {
+			pkg = strings.TrimSpace(name)
+
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) < 4 || !strings.HasPrefix(fields[0], "Benchmark") || len(fields)%2 != 0 {
+			continue
+		}
+
+		// Name, iterations, then value/unit pairs.
+		if _, err := strconv.Atoi(fields[1]); err != nil {
+			continue
+		}
+
+		name := procsSuffix.ReplaceAllString(fields[0], "")
+		if pkg != "" {
+			name = pkg + "." + name
+		}
+
+		if results[name] == nil {
+			results[name] = make(map[string][]float64)
+		}
+
+		for i := 2; i < len(fields); i += 2 {
+			value, err := strconv.ParseFloat(fields[i], 64)
+			if err != nil {
+				return nil, fmt.Errorf("parsing %s of %s: %w", fields[i+1], name, err)
+			}
+
+			results[name][fields[i+1]] = append(results[name][fields[i+1]], value)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading benchmark results: %w", err)
+	}
+
+	return results, nil
+}
+
+// Names returns the benchmark names of the results, sorted.
+func (r Results) Names() []string {
+	names := make([]string, 0, len(r))
+	for name := range r {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+// median returns the median of samples, which must not be empty.
+func median(samples []float64) float64 {
+	sorted := slices.Clone(samples)
+	slices.Sort(sorted)
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+
+	return sorted[mid]
+}
+
+// Delta is the change of a metric of a benchmark between the baseline and the current run.
+type Delta struct {
+	Name     string
+	Metric   string
+	Baseline float64
+	Current  float64
+}
+
+// Change returns the relative change from the baseline, e.g. 0.1 for 10% slower.
+func (d Delta) Change() float64 {
+	if d.Baseline == 0 {
+		return 0
+	}
+
+	return (d.Current - d.Baseline) / d.Baseline
+}
+
+// Comparison is the outcome of comparing a run against the baseline.
+type Comparison struct {
+	// Deltas are the compared metrics of the benchmarks present in both runs.
+	Deltas []Delta
+
+	// Regressions are the deltas above the threshold.
+	Regressions []Delta
+
+	// Missing are the baseline benchmarks absent from the current run.
+	Missing []string
+
+	// Added are the current benchmarks absent from the baseline.
+	Added []string
+}
+
+// Compare compares the median time and allocations per operation of current against
+// baseline. A metric that grew by more than threshold, e.g. 0.2 for 20%, is a regression.
+func Compare(baseline Results, current Results, threshold float64) Comparison {
+	var cmp Comparison
+
+	for _, name := range baseline.Names() {
+		metrics, ok := current[name]
+		if !ok {
+			cmp.Missing = append(cmp.Missing, name)
+
+			continue
+		}
+
+		for _, metric := range []string{metricTime, metricAllocs} {
+			base, cur := baseline[name][metric], metrics[metric]
+			if len(base) == 0 || len(cur) == 0 {
+				continue
+			}
+
+			d := Delta{Name: name, Metric: metric, Baseline: median(base), Current: median(cur)}
+			cmp.Deltas = append(cmp.Deltas, d)
+
+			if d.Change() > threshold {
+				cmp.Regressions = append(cmp.Regressions, d)
+			}
+		}
+	}
+
+	for _, name := range current.Names() {
+		if _, ok := baseline[name]; !ok {
+			cmp.Added = append(cmp.Added, name)
+		}
+	}
+
+	return cmp
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+const baselineOutput = `goos: linux
+goarch: amd64
+pkg: github.com/opendatahub-io/odh-cli/pkg/lint
+cpu: Intel(R) Xeon(R) Processor
+BenchmarkLint_UpgradeMode/1k-8         	      28	  40000000 ns/op	21864722 B/op	  100000 allocs/op
+BenchmarkLint_UpgradeMode/1k-8         	      28	  44000000 ns/op	21864722 B/op	  100000 allocs/op
+BenchmarkLint_UpgradeMode/1k-8         	      28	  42000000 ns/op	21864722 B/op	  100000 allocs/op
+BenchmarkOutput/json/1k-8              	     213	   5000000 ns/op	 1825704 B/op	    3564 allocs/op
+PASS
+ok  	github.com/opendatahub-io/odh-cli/pkg/lint	2.290s
+`
+
+func TestParse(t *testing.T) {
+	g := NewWithT(t)
+
+	results, err := Parse(strings.NewReader(baselineOutput))
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(results.Names()).To(Equal([]string{
+		"github.com/opendatahub-io/odh-cli/pkg/lint.BenchmarkLint_UpgradeMode/1k",
+		"github.com/opendatahub-io/odh-cli/pkg/lint.BenchmarkOutput/json/1k",
+	}))
+	g.Expect(results["github.com/opendatahub-io/odh-cli/pkg/lint.BenchmarkLint_UpgradeMode/1k"][metricTime]).
+		To(Equal([]float64{40000000, 44000000, 42000000}))
+}
+
+func TestCompare(t *testing.T) {
+	const lintBench = "github.com/opendatahub-io/odh-cli/pkg/lint.BenchmarkLint_UpgradeMode/1k"
+
+	baseline, err := Parse(strings.NewReader(baselineOutput))
+	NewWithT(t).Expect(err).ToNot(HaveOccurred())
+
+	t.Run("within threshold", func(t *testing.T) {
+		g := NewWithT(t)
+
+		current, err := Parse(strings.NewReader(strings.ReplaceAll(baselineOutput, "42000000 ns/op", "46000000 ns/op")))
+		g.Expect(err).ToNot(HaveOccurred())
+
+		cmp := Compare(baseline, current, 0.2)
+
+		g.Expect(cmp.Regressions).To(BeEmpty())
+		g.Expect(cmp.Deltas).To(HaveLen(4))
+	})
+
+	t.Run("median regressed", func(t *testing.T) {
+		g := NewWithT(t)
+
+		slower := strings.NewReplacer("40000000 ns/op", "60000000 ns/op", "42000000 ns/op", "61000000 ns/op")
+
+		current, err := Parse(strings.NewReader(slower.Replace(baselineOutput)))
+		g.Expect(err).ToNot(HaveOccurred())
+
+		cmp := Compare(baseline, current, 0.2)
+
+		g.Expect(cmp.Regressions).To(ConsistOf(Delta{
+			Name: lintBench, Metric: metricTime, Baseline: 42000000, Current: 60000000,
+		}))
+	})
+
+	t.Run("allocations regressed", func(t *testing.T) {
+		g := NewWithT(t)
+
+		current, err := Parse(strings.NewReader(strings.ReplaceAll(baselineOutput, "3564 allocs/op", "5000 allocs/op")))
+		g.Expect(err).ToNot(HaveOccurred())
+
+		cmp := Compare(baseline, current, 0.2)
+
+		g.Expect(cmp.Regressions).To(HaveLen(1))
+		g.Expect(cmp.Regressions[0].Metric).To(Equal(metricAllocs))
+	})
+
+	t.Run("missing and added benchmarks", func(t *testing.T) {
+		g := NewWithT(t)
+
+		current, err := Parse(strings.NewReader(strings.ReplaceAll(baselineOutput, "BenchmarkOutput/json", "BenchmarkOutput/yaml")))
+		g.Expect(err).ToNot(HaveOccurred())
+
+		cmp := Compare(baseline, current, 0.2)
+
+		g.Expect(cmp.Regressions).To(BeEmpty())
+		g.Expect(cmp.Missing).To(Equal([]string{"github.com/opendatahub-io/odh-cli/pkg/lint.BenchmarkOutput/json/1k"}))
+		g.Expect(cmp.Added).To(Equal([]string{"github.com/opendatahub-io/odh-cli/pkg/lint.BenchmarkOutput/yaml/1k"}))
+	})
+}
+
+func TestRun(t *testing.T) {
+	g := NewWithT(t)
+
+	dir := t.TempDir()
+	baselinePath := filepath.Join(dir, "baseline.txt")
+	currentPath := filepath.Join(dir, "current.txt")
+
+	g.Expect(os.WriteFile(baselinePath, []byte(baselineOutput), 0o600)).To(Succeed())
+	g.Expect(os.WriteFile(currentPath, []byte(strings.ReplaceAll(baselineOutput, "100000 allocs/op", "150000 allocs/op")), 0o600)).
+		To(Succeed())
+
+	var out bytes.Buffer
+
+	regressed, err := run(&out, baselinePath, currentPath, 0.2)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(regressed).To(BeTrue())
+	g.Expect(out.String()).To(ContainSubstring("REGRESSION"))
+	g.Expect(out.String()).To(ContainSubstring("1 metric(s) regressed by more than 20%"))
+
+	_, err = run(&out, baselinePath, filepath.Join(dir, "missing.txt"), 0.2)
+	g.Expect(err).To(HaveOccurred())
+}
@@ -0,0 +1,155 @@
+package backup
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// maxArchiveEntrySize bounds the size of a single extracted file, as a guard against
+// decompression bombs in archives received from elsewhere.
+const maxArchiveEntrySize = 256 << 20
+
+// WriteArchive writes the regular files under dir to w as a gzip-compressed tarball, with
+// paths relative to dir, in lexical order.
+func WriteArchive(w io.Writer, dir string) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return fmt.Errorf("resolving %s: %w", path, err)
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return fmt.Errorf("reading %s: %w", path, err)
+		}
+
+		return writeArchiveEntry(tw, path, filepath.ToSlash(rel), info)
+	})
+	if err != nil {
+		return fmt.Errorf("archiving %s: %w", dir, err)
+	}
+
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("closing archive: %w", err)
+	}
+
+	if err := gz.Close(); err != nil {
+		return fmt.Errorf("closing archive compression: %w", err)
+	}
+
+	return nil
+}
+
+func writeArchiveEntry(tw *tar.Writer, path string, name string, info fs.FileInfo) error {
+	header := &tar.Header{
+		Name:    name,
+		Mode:    filePermissions,
+		Size:    info.Size(),
+		ModTime: info.ModTime(),
+		Format:  tar.FormatPAX,
+	}
+
+	if err := tw.WriteHeader(header); err != nil {
+		return fmt.Errorf("writing header of %s: %w", name, err)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("opening %s: %w", path, err)
+	}
+
+	defer func() { _ = f.Close() }()
+
+	if _, err := io.Copy(tw, f); err != nil {
+		return fmt.Errorf("writing %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// ExtractArchive extracts the regular files of a gzip-compressed tarball written by
+// WriteArchive into dir. Entries escaping dir are rejected.
+func ExtractArchive(r io.Reader, dir string) error {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return fmt.Errorf("reading archive compression: %w", err)
+	}
+
+	defer func() { _ = gz.Close() }()
+
+	tr := tar.NewReader(gz)
+
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("reading archive: %w", err)
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		name := filepath.FromSlash(header.Name)
+		if !filepath.IsLocal(name) {
+			return fmt.Errorf("archive entry %q escapes the extraction directory", header.Name)
+		}
+
+		if err := extractArchiveEntry(tr, filepath.Join(dir, name), header); err != nil {
+			return err
+		}
+	}
+}
+
+func extractArchiveEntry(r io.Reader, path string, header *tar.Header) error {
+	if header.Size > maxArchiveEntrySize {
+		return fmt.Errorf("archive entry %q is larger than %d bytes", header.Name, maxArchiveEntrySize)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), dirPermissions); err != nil {
+		return fmt.Errorf("creating directory for %s: %w", header.Name, err)
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, filePermissions)
+	if err != nil {
+		return fmt.Errorf("creating %s: %w", header.Name, err)
+	}
+
+	if _, err := io.Copy(f, io.LimitReader(r, header.Size)); err != nil {
+		_ = f.Close()
+
+		return fmt.Errorf("extracting %s: %w", header.Name, err)
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("closing %s: %w", header.Name, err)
+	}
+
+	return nil
+}
+
+// IsArchivePath reports whether path names a gzip-compressed tarball (.tar.gz or .tgz).
+func IsArchivePath(path string) bool {
+	return strings.HasSuffix(path, ".tar.gz") || strings.HasSuffix(path, ".tgz")
+}
@@ -0,0 +1,64 @@
+package backup_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/backup"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestArchive_RoundTrip(t *testing.T) {
+	g := NewWithT(t)
+
+	src := t.TempDir()
+	g.Expect(os.MkdirAll(filepath.Join(src, "ns1"), 0o750)).To(Succeed())
+	g.Expect(os.WriteFile(filepath.Join(src, "ns1", "a.yaml"), []byte("a: 1\n"), 0o600)).To(Succeed())
+	g.Expect(os.WriteFile(filepath.Join(src, "manifest.yaml"), []byte("m: 2\n"), 0o600)).To(Succeed())
+
+	var buf bytes.Buffer
+	g.Expect(backup.WriteArchive(&buf, src)).To(Succeed())
+
+	dst := t.TempDir()
+	g.Expect(backup.ExtractArchive(&buf, dst)).To(Succeed())
+
+	data, err := os.ReadFile(filepath.Join(dst, "ns1", "a.yaml"))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(data)).To(Equal("a: 1\n"))
+
+	data, err = os.ReadFile(filepath.Join(dst, "manifest.yaml"))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(data)).To(Equal("m: 2\n"))
+}
+
+func TestExtractArchive_RejectsEscapingEntries(t *testing.T) {
+	g := NewWithT(t)
+
+	var buf bytes.Buffer
+
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	g.Expect(tw.WriteHeader(&tar.Header{Name: "../evil.yaml", Mode: 0o600, Size: 1, Typeflag: tar.TypeReg})).To(Succeed())
+	_, err := tw.Write([]byte("x"))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(tw.Close()).To(Succeed())
+	g.Expect(gz.Close()).To(Succeed())
+
+	dir := t.TempDir()
+	g.Expect(backup.ExtractArchive(&buf, filepath.Join(dir, "out"))).To(MatchError(ContainSubstring("escapes the extraction directory")))
+	g.Expect(filepath.Join(dir, "evil.yaml")).ToNot(BeAnExistingFile())
+}
+
+func TestIsArchivePath(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(backup.IsArchivePath("out.tar.gz")).To(BeTrue())
+	g.Expect(backup.IsArchivePath("out.tgz")).To(BeTrue())
+	g.Expect(backup.IsArchivePath("out.tar.zst")).To(BeFalse())
+	g.Expect(backup.IsArchivePath("out")).To(BeFalse())
+}
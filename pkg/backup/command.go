@@ -2,10 +2,12 @@ package backup
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"runtime"
+	"time"
 
 	"github.com/spf13/pflag"
 	"golang.org/x/sync/errgroup"
@@ -16,9 +18,12 @@ import (
 
 	"github.com/opendatahub-io/odh-cli/pkg/backup/dependencies"
 	"github.com/opendatahub-io/odh-cli/pkg/backup/dependencies/dspa"
+	"github.com/opendatahub-io/odh-cli/pkg/backup/dependencies/inferenceservices"
 	"github.com/opendatahub-io/odh-cli/pkg/backup/dependencies/notebooks"
 	"github.com/opendatahub-io/odh-cli/pkg/backup/pipeline"
+	"github.com/opendatahub-io/odh-cli/pkg/redact"
 	"github.com/opendatahub-io/odh-cli/pkg/util/kube"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
 )
 
 const (
@@ -26,6 +31,10 @@ const (
 	dirPermissions = 0o755
 	// File permissions for backup YAML files.
 	filePermissions = 0o644
+	// File permissions for backup archives, which may contain Secrets.
+	archivePermissions = 0o600
+	// Default depth of transitive dependency resolution.
+	defaultMaxDepth = 3
 )
 
 // Command handles the backup operation.
@@ -33,13 +42,18 @@ type Command struct {
 	*SharedOptions
 
 	OutputDir    string
+	Archive      string
 	StripFields  []string
 	Includes     []string
 	Excludes     []string
 	MaxWorkers   int
+	MaxDepth     int
 	Dependencies bool
 	DryRun       bool
 
+	// IncludeSecretValues writes Secret data values instead of masking them.
+	IncludeSecretValues bool
+
 	depRegistry *dependencies.Registry
 }
 
@@ -48,12 +62,14 @@ func NewCommand(streams genericiooptions.IOStreams) *Command {
 	return &Command{
 		SharedOptions: NewSharedOptions(streams),
 		Dependencies:  true,
+		MaxDepth:      defaultMaxDepth,
 	}
 }
 
 // AddFlags adds flags to the command.
 func (c *Command) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&c.OutputDir, "output-dir", "", "Output directory for backups (if not specified, dumps to stdout)")
+	fs.StringVar(&c.Archive, "archive", "", "Write the backup to a gzip-compressed tarball (.tar.gz or .tgz) with a manifest.yaml of resource counts and checksums, instead of a directory")
 	fs.StringArrayVar(&c.StripFields, "strip", nil, "Field paths to strip (repeatable, e.g., --strip .status)")
 	fs.StringArrayVar(&c.Includes, "includes", nil, "Workload types to include (repeatable, e.g., --includes notebooks.kubeflow.org)")
 	fs.StringArrayVar(&c.Excludes, "exclude", nil, "Workload types to exclude (repeatable)")
@@ -68,6 +84,10 @@ func (c *Command) AddFlags(fs *pflag.FlagSet) {
 
 	// Dependency resolution
 	fs.BoolVar(&c.Dependencies, "dependencies", true, "Resolve and backup workload dependencies (ConfigMaps, PVCs, Secrets)")
+	fs.IntVar(&c.MaxDepth, "max-depth", c.MaxDepth, "Maximum depth of transitive dependency resolution (1 = direct dependencies only)")
+
+	// Redaction
+	fs.BoolVar(&c.IncludeSecretValues, "include-secret-values", false, "Write Secret data values instead of masking them (objects annotated "+redact.AnnotationRedact+"=false are never masked)")
 }
 
 // Complete populates derived values and performs setup.
@@ -103,6 +123,7 @@ func (c *Command) Complete() error {
 	if c.Dependencies {
 		c.depRegistry.MustRegister(notebooks.NewResolver())
 		c.depRegistry.MustRegister(dspa.NewResolver())
+		c.depRegistry.MustRegister(inferenceservices.NewResolver())
 	}
 
 	return nil
@@ -114,6 +135,18 @@ func (c *Command) Validate() error {
 		return err
 	}
 
+	if c.MaxDepth < 1 {
+		return fmt.Errorf("--max-depth must be at least 1, got %d", c.MaxDepth)
+	}
+
+	if c.Archive != "" && c.OutputDir != "" {
+		return errors.New("--archive and --output-dir are mutually exclusive")
+	}
+
+	if c.Archive != "" && !IsArchivePath(c.Archive) {
+		return fmt.Errorf("--archive %s: use a .tar.gz or .tgz file name", c.Archive)
+	}
+
 	return nil
 }
 
@@ -122,6 +155,20 @@ func (c *Command) Run(ctx context.Context) error {
 	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
 	defer cancel()
 
+	// An archive is staged in a temporary directory; dry-run shows paths inside the archive
+	if c.Archive != "" && c.DryRun {
+		c.OutputDir = c.Archive
+	} else if c.Archive != "" {
+		staging, err := os.MkdirTemp("", "odh-backup-")
+		if err != nil {
+			return fmt.Errorf("creating staging directory: %w", err)
+		}
+
+		defer func() { _ = os.RemoveAll(staging) }()
+
+		c.OutputDir = staging
+	}
+
 	if c.OutputDir != "" {
 		if err := os.MkdirAll(c.OutputDir, dirPermissions); err != nil {
 			return fmt.Errorf("creating output directory: %w", err)
@@ -157,6 +204,7 @@ func (c *Command) Run(ctx context.Context) error {
 		DepRegistry: c.depRegistry,
 		Verbose:     c.Verbose,
 		IO:          c.IO,
+		MaxDepth:    c.MaxDepth,
 	}
 
 	writer := &pipeline.WriterStage{
@@ -175,6 +223,8 @@ func (c *Command) Run(ctx context.Context) error {
 
 	if c.DryRun {
 		c.IO.Errorf("Dry-run complete (no files written)")
+	} else if c.Archive != "" {
+		return c.writeArchive(ctx)
 	} else if c.OutputDir == "" && c.Verbose {
 		c.IO.Errorf("Backup complete (stdout)")
 	} else {
@@ -184,6 +234,45 @@ func (c *Command) Run(ctx context.Context) error {
 	return nil
 }
 
+// writeArchive writes the manifest of the staged backup and archives it to --archive.
+func (c *Command) writeArchive(ctx context.Context) error {
+	manifest, err := BuildManifest(c.OutputDir)
+	if err != nil {
+		return fmt.Errorf("building manifest: %w", err)
+	}
+
+	manifest.CreatedAt = time.Now().UTC()
+
+	if clusterVersion, err := version.Detect(ctx, c.Client); err == nil {
+		manifest.ClusterVersion = clusterVersion.String()
+	} else if c.Verbose {
+		c.IO.Errorf("Warning: detecting cluster version: %v", err)
+	}
+
+	if err := WriteManifest(c.OutputDir, manifest); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(c.Archive, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, archivePermissions)
+	if err != nil {
+		return fmt.Errorf("creating archive: %w", err)
+	}
+
+	if err := WriteArchive(f, c.OutputDir); err != nil {
+		_ = f.Close()
+
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("closing archive: %w", err)
+	}
+
+	c.IO.Errorf("Backup complete: %s (%d files)", c.Archive, len(manifest.Files))
+
+	return nil
+}
+
 // runPipeline executes the three-stage pipeline for a workload type.
 func (c *Command) runPipeline(
 	ctx context.Context,
@@ -250,7 +339,8 @@ func (c *Command) resolveWorkloadGVRs() []schema.GroupVersionResource {
 	return result
 }
 
-// writeResource strips fields and writes a resource to the output directory or stdout.
+// writeResource strips fields, redacts credentials and writes a resource to the output
+// directory or stdout.
 func (c *Command) writeResource(
 	gvr schema.GroupVersionResource,
 	obj *unstructured.Unstructured,
@@ -260,6 +350,8 @@ func (c *Command) writeResource(
 		return fmt.Errorf("stripping fields: %w", err)
 	}
 
+	stripped = redact.Object(stripped, redact.Options{IncludeSecretValues: c.IncludeSecretValues})
+
 	// Dry-run mode: Log what would be written without actually writing
 	if c.DryRun {
 		return c.logDryRunResource(gvr, stripped)
@@ -253,3 +253,113 @@ func TestNormalModeStillWorks(t *testing.T) {
 	_, err = os.Stat(expectedFile)
 	g.Expect(err).ToNot(HaveOccurred(), "Normal mode should create files")
 }
+
+func TestValidateArchive(t *testing.T) {
+	g := NewWithT(t)
+
+	cmd := NewCommand(genericiooptions.IOStreams{})
+	cmd.Archive = "backup.zip"
+
+	g.Expect(cmd.Validate()).To(MatchError(ContainSubstring("use a .tar.gz or .tgz file name")))
+
+	cmd.Archive = "backup.tar.gz"
+	cmd.OutputDir = "/tmp/backup"
+
+	g.Expect(cmd.Validate()).To(MatchError(ContainSubstring("mutually exclusive")))
+
+	cmd.OutputDir = ""
+	g.Expect(cmd.Validate()).To(Succeed())
+}
+
+func TestWriteArchive(t *testing.T) {
+	g := NewWithT(t)
+
+	cmd := NewCommand(genericiooptions.IOStreams{Out: &bytes.Buffer{}, ErrOut: &bytes.Buffer{}})
+	cmd.Archive = filepath.Join(t.TempDir(), "backup.tar.gz")
+	cmd.OutputDir = t.TempDir()
+
+	g.Expect(cmd.Complete()).To(Succeed())
+
+	obj := &unstructured.Unstructured{}
+	obj.SetNamespace("test-namespace")
+	obj.SetName("test-notebook")
+	obj.SetAPIVersion("kubeflow.org/v1")
+	obj.SetKind("Notebook")
+
+	gvr := schema.GroupVersionResource{Group: "kubeflow.org", Version: "v1", Resource: "notebooks"}
+	g.Expect(cmd.writeResource(gvr, obj)).To(Succeed())
+	g.Expect(cmd.writeArchive(t.Context())).To(Succeed())
+
+	dir := t.TempDir()
+	manifest, err := ExtractVerifiedArchive(cmd.Archive, dir)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(manifest.CreatedAt).ToNot(BeZero())
+	g.Expect(manifest.Resources).To(Equal([]ManifestResource{
+		{Group: "kubeflow.org", Version: "v1", Resource: "notebooks", Count: 1},
+	}))
+	g.Expect(manifest.Files).To(ConsistOf(HaveField("Path", "test-namespace/notebooks.kubeflow.org-test-notebook.yaml")))
+}
+
+func TestValidateRejectsMaxDepthBelowOne(t *testing.T) {
+	g := NewWithT(t)
+
+	cmd := NewCommand(genericiooptions.IOStreams{})
+	g.Expect(cmd.MaxDepth).To(Equal(defaultMaxDepth))
+
+	cmd.MaxDepth = 0
+
+	err := cmd.Validate()
+
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(err.Error()).To(ContainSubstring("--max-depth"))
+}
+
+func TestWriteResourceRedactsSecrets(t *testing.T) {
+	secretGVR := schema.GroupVersionResource{Version: "v1", Resource: "secrets"}
+
+	newSecret := func() *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{Object: map[string]any{
+			"data": map[string]any{"AWS_SECRET_ACCESS_KEY": "c2VjcmV0"},
+		}}
+		obj.SetAPIVersion("v1")
+		obj.SetKind("Secret")
+		obj.SetNamespace("test-namespace")
+		obj.SetName("aws-connection")
+
+		return obj
+	}
+
+	readSecret := func(g Gomega, dir string) string {
+		data, err := os.ReadFile(filepath.Join(dir, "test-namespace", "secrets-aws-connection.yaml"))
+		g.Expect(err).ToNot(HaveOccurred())
+
+		return string(data)
+	}
+
+	t.Run("masks values by default", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cmd := NewCommand(genericiooptions.IOStreams{Out: os.Stdout, ErrOut: os.Stderr})
+		cmd.OutputDir = t.TempDir()
+
+		g.Expect(cmd.Complete()).To(Succeed())
+		g.Expect(cmd.writeResource(secretGVR, newSecret())).To(Succeed())
+
+		content := readSecret(g, cmd.OutputDir)
+		g.Expect(content).To(ContainSubstring(`AWS_SECRET_ACCESS_KEY: ""`))
+		g.Expect(content).To(ContainSubstring(`backup.opendatahub.io/redacted: "true"`))
+	})
+
+	t.Run("keeps values with --include-secret-values", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cmd := NewCommand(genericiooptions.IOStreams{Out: os.Stdout, ErrOut: os.Stderr})
+		cmd.OutputDir = t.TempDir()
+		cmd.IncludeSecretValues = true
+
+		g.Expect(cmd.Complete()).To(Succeed())
+		g.Expect(cmd.writeResource(secretGVR, newSecret())).To(Succeed())
+
+		g.Expect(readSecret(g, cmd.OutputDir)).To(ContainSubstring("AWS_SECRET_ACCESS_KEY: c2VjcmV0"))
+	})
+}
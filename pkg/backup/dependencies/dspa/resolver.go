@@ -3,6 +3,7 @@ package dspa
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -18,6 +19,10 @@ const (
 	trustedCABundleName = "trusted-ca-bundle"
 	mariaDBPVCPrefix    = "mariadb-"
 	minioPVCPrefix      = "minio-"
+	//nolint:gosec // False positive - Secret name prefix, not hardcoded credentials
+	mariaDBSecretPrefix = "ds-pipeline-db-"
+	//nolint:gosec // False positive - Secret name prefix, not hardcoded credentials
+	minioSecretPrefix = "ds-pipeline-s3-"
 	//nolint:gosec // False positive - JQ path string, not hardcoded credentials
 	pathExternalS3Creds = ".spec.objectStorage.externalStorage.s3CredentialsSecret.secretName"
 	//nolint:gosec // False positive - JQ path string, not hardcoded credentials
@@ -29,6 +34,7 @@ const (
 	pathCABundle           = ".spec.apiServer.cABundle.configMapName"
 	pathCustomServerConfig = ".spec.apiServer.customServerConfigMap.name"
 	pathCustomKFPLauncher  = ".spec.apiServer.customKfpLauncherConfigMap"
+	pathUIConfig           = ".spec.mlpipelineUI.configMap"
 	pathDeployMariaDB      = ".spec.database.mariaDB.deploy"
 	pathDeployMinio        = ".spec.objectStorage.minio.deploy"
 )
@@ -58,7 +64,7 @@ func (r *Resolver) Resolve(
 	var deps []dependencies.Dependency
 
 	// Resolve Secrets
-	secretDeps, err := r.resolveSecrets(ctx, c, namespace, obj)
+	secretDeps, err := r.resolveSecrets(ctx, c, namespace, dspaName, obj)
 	if err != nil {
 		return nil, err
 	}
@@ -85,6 +91,7 @@ func (r *Resolver) resolveSecrets(
 	ctx context.Context,
 	c client.Reader,
 	namespace string,
+	dspaName string,
 	obj *unstructured.Unstructured,
 ) ([]dependencies.Dependency, error) {
 	var secretNames []string
@@ -94,14 +101,18 @@ func (r *Resolver) resolveSecrets(
 		secretNames = append(secretNames, name)
 	}
 
-	// Minio S3 credentials
+	// Minio S3 credentials, operator-generated when not set on a deployed Minio
 	if name := r.queryStringField(obj, pathMinioS3Creds); name != "" {
 		secretNames = append(secretNames, name)
+	} else if r.queryBoolField(obj, pathDeployMinio) {
+		secretNames = append(secretNames, minioSecretPrefix+dspaName)
 	}
 
-	// MariaDB password secret
+	// MariaDB password secret, operator-generated when not set on a deployed MariaDB
 	if name := r.queryStringField(obj, pathMariaDBPassword); name != "" {
 		secretNames = append(secretNames, name)
+	} else if r.queryBoolField(obj, pathDeployMariaDB) {
+		secretNames = append(secretNames, mariaDBSecretPrefix+dspaName)
 	}
 
 	// ExternalDB password secret
@@ -113,6 +124,9 @@ func (r *Resolver) resolveSecrets(
 		return nil, nil
 	}
 
+	slices.Sort(secretNames)
+	secretNames = slices.Compact(secretNames)
+
 	items, errors, err := kube.FetchResourcesByNameWithErrors(
 		ctx,
 		c,
@@ -172,6 +186,11 @@ func (r *Resolver) resolveConfigMaps(
 		configMapNames = append(configMapNames, name)
 	}
 
+	// Pipelines UI ConfigMap
+	if name := r.queryStringField(obj, pathUIConfig); name != "" {
+		configMapNames = append(configMapNames, name)
+	}
+
 	if len(configMapNames) == 0 {
 		return nil, nil
 	}
@@ -204,14 +223,12 @@ func (r *Resolver) resolvePVCs(
 	var pvcNames []string
 
 	// MariaDB PVC (operator-created when database deployment enabled)
-	deployMariaDB, err := jq.Query[bool](obj, pathDeployMariaDB)
-	if err == nil && deployMariaDB {
+	if r.queryBoolField(obj, pathDeployMariaDB) {
 		pvcNames = append(pvcNames, mariaDBPVCPrefix+dspaName)
 	}
 
 	// Minio PVC (operator-created when Minio deployment enabled)
-	deployMinio, err := jq.Query[bool](obj, pathDeployMinio)
-	if err == nil && deployMinio {
+	if r.queryBoolField(obj, pathDeployMinio) {
 		pvcNames = append(pvcNames, minioPVCPrefix+dspaName)
 	}
 
@@ -246,3 +263,13 @@ func (r *Resolver) queryStringField(obj *unstructured.Unstructured, path string)
 
 	return value
 }
+
+// queryBoolField queries a bool field from the object, returning false if not found or on error.
+func (r *Resolver) queryBoolField(obj *unstructured.Unstructured, path string) bool {
+	value, err := jq.Query[bool](obj, path)
+	if err != nil {
+		return false
+	}
+
+	return value
+}
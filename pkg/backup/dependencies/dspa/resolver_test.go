@@ -355,6 +355,65 @@ func TestResolverWithMariaDBNotDeployed(t *testing.T) {
 	g.Expect(deps[0].GVR).To(Equal(resources.Secret.GVR()))
 }
 
+func TestResolverWithGeneratedCredentials(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	dspaObj := createBaseDSPA("test-dspa", "default")
+	dspaObj.Object["spec"] = map[string]any{
+		"database": map[string]any{
+			"mariaDB": map[string]any{"deploy": true},
+		},
+		"objectStorage": map[string]any{
+			"minio": map[string]any{"deploy": true},
+		},
+	}
+
+	fakeClient := createFakeClient(t, dspaObj,
+		createSecret("ds-pipeline-db-test-dspa", "default"),
+		createSecret("ds-pipeline-s3-test-dspa", "default"),
+		createPVC("mariadb-test-dspa", "default"),
+		createPVC("minio-test-dspa", "default"),
+	)
+
+	resolver := dspa.NewResolver()
+
+	deps, err := resolver.Resolve(ctx, fakeClient, dspaObj)
+
+	g.Expect(err).ToNot(HaveOccurred())
+
+	var names []string
+	for _, dep := range deps {
+		g.Expect(dep.Error).ToNot(HaveOccurred())
+		names = append(names, dep.Name)
+	}
+
+	g.Expect(names).To(ConsistOf(
+		"ds-pipeline-db-test-dspa", "ds-pipeline-s3-test-dspa", "mariadb-test-dspa", "minio-test-dspa",
+	))
+}
+
+func TestResolverWithUIConfigMap(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	dspaObj := createBaseDSPA("test-dspa", "default")
+	dspaObj.Object["spec"] = map[string]any{
+		"mlpipelineUI": map[string]any{"configMap": "ui-config"},
+	}
+
+	fakeClient := createFakeClient(t, dspaObj, createConfigMap("ui-config", "default"))
+
+	resolver := dspa.NewResolver()
+
+	deps, err := resolver.Resolve(ctx, fakeClient, dspaObj)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(deps).To(HaveLen(1))
+	g.Expect(deps[0].GVR).To(Equal(resources.ConfigMap.GVR()))
+	g.Expect(deps[0].Name).To(Equal("ui-config"))
+}
+
 func createDSPAWithExternalStorage(
 	name string,
 	namespace string,
@@ -0,0 +1,362 @@
+package inferenceservices
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/backup/dependencies"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube"
+)
+
+const (
+	// storageConfigSecretName is the Secret KServe reads storage credentials from when
+	// the model storage references a key.
+	//nolint:gosec // False positive - Secret name, not hardcoded credentials
+	storageConfigSecretName = "storage-config"
+
+	// annotationConnections lists the connection Secrets attached to the InferenceService
+	// as a comma-separated list of [namespace/]name references.
+	annotationConnections = "opendatahub.io/connections"
+
+	// predictorSuffix is appended to the InferenceService name for the predictor
+	// Deployment and its HorizontalPodAutoscaler in raw deployment mode.
+	predictorSuffix = "-predictor"
+
+	// serviceAccountTokenInfix and serviceAccountDockercfgInfix mark the Secrets OpenShift
+	// generates for a ServiceAccount, which are re-created with it rather than restored.
+	serviceAccountTokenInfix     = "-token-"
+	serviceAccountDockercfgInfix = "-dockercfg-"
+
+	pathRuntime          = ".spec.predictor.model.runtime // \"\""
+	pathStorageKey       = ".spec.predictor.model.storage.key // \"\""
+	pathServiceAccount   = ".spec.predictor.serviceAccountName // \"\""
+	pathImagePullSecrets = "[(.spec.predictor.imagePullSecrets // [])[].name]"
+	pathVolumes          = ".spec.predictor.volumes // []"
+	pathContainers       = "[(.spec.predictor.containers // [])[], (.spec.predictor.model // empty)]"
+
+	// pathServiceAccountSecrets is queried on the ServiceAccount.
+	pathServiceAccountSecrets = "[(.secrets // [])[].name]"
+)
+
+// Resolver resolves dependencies for KServe InferenceServices.
+type Resolver struct{}
+
+// NewResolver creates a new InferenceService dependency resolver.
+func NewResolver() *Resolver {
+	return &Resolver{}
+}
+
+// CanHandle returns true for KServe InferenceService resources.
+func (r *Resolver) CanHandle(gvr schema.GroupVersionResource) bool {
+	return gvr.Group == resources.InferenceService.Group && gvr.Resource == resources.InferenceService.Resource
+}
+
+// Resolve finds all dependencies for an InferenceService: its ServingRuntime or
+// ClusterServingRuntime, the predictor ServiceAccount, storage and connection Secrets,
+// ConfigMaps and Secrets referenced by the predictor, and the predictor HorizontalPodAutoscaler.
+func (r *Resolver) Resolve(
+	ctx context.Context,
+	c client.Reader,
+	obj *unstructured.Unstructured,
+) ([]dependencies.Dependency, error) {
+	namespace := obj.GetNamespace()
+
+	var allDeps []dependencies.Dependency
+
+	runtimeDeps, err := r.resolveRuntime(ctx, c, namespace, obj)
+	if err != nil {
+		return nil, err
+	}
+	allDeps = append(allDeps, runtimeDeps...)
+
+	volumes, err := jq.Query[[]corev1.Volume](obj, pathVolumes)
+	if err != nil && !errors.Is(err, jq.ErrNotFound) {
+		return nil, fmt.Errorf("querying volumes: %w", err)
+	}
+
+	containers, err := jq.Query[[]corev1.Container](obj, pathContainers)
+	if err != nil && !errors.Is(err, jq.ErrNotFound) {
+		return nil, fmt.Errorf("querying containers: %w", err)
+	}
+
+	sources := sourcesOf(volumes)
+	for _, c := range containers {
+		sources = append(sources, c)
+	}
+
+	configMapDeps, err := dependencies.ResolveConfigMaps(ctx, c, namespace, sources...)
+	if err != nil {
+		return nil, fmt.Errorf("resolving ConfigMaps: %w", err)
+	}
+	allDeps = append(allDeps, configMapDeps...)
+
+	secretDeps, err := dependencies.ResolveSecrets(ctx, c, namespace, sources...)
+	if err != nil {
+		return nil, fmt.Errorf("resolving Secrets: %w", err)
+	}
+	allDeps = append(allDeps, secretDeps...)
+
+	serviceAccountDeps, serviceAccountSecrets, err := r.resolveServiceAccount(ctx, c, namespace, obj)
+	if err != nil {
+		return nil, err
+	}
+	allDeps = append(allDeps, serviceAccountDeps...)
+
+	storageDeps, err := r.resolveStorageSecrets(ctx, c, namespace, obj, secretDeps, serviceAccountSecrets)
+	if err != nil {
+		return nil, err
+	}
+	allDeps = append(allDeps, storageDeps...)
+
+	pvcDeps, err := dependencies.ResolvePVCs(ctx, c, namespace, sourcesOf(volumes)...)
+	if err != nil {
+		return nil, fmt.Errorf("resolving PVCs: %w", err)
+	}
+	allDeps = append(allDeps, pvcDeps...)
+
+	hpaDeps, err := r.resolveHPA(ctx, c, namespace, obj.GetName())
+	if err != nil {
+		return nil, err
+	}
+	allDeps = append(allDeps, hpaDeps...)
+
+	return allDeps, nil
+}
+
+// resolveRuntime finds the ServingRuntime named by the model, falling back to a
+// ClusterServingRuntime of the same name as KServe does. Runtimes KServe selects
+// automatically, without a name in the spec, cannot be resolved.
+func (r *Resolver) resolveRuntime(
+	ctx context.Context,
+	c client.Reader,
+	namespace string,
+	obj *unstructured.Unstructured,
+) ([]dependencies.Dependency, error) {
+	name, err := jq.Query[string](obj, pathRuntime)
+	if err != nil && !errors.Is(err, jq.ErrNotFound) {
+		return nil, fmt.Errorf("querying runtime: %w", err)
+	}
+
+	if name == "" {
+		return nil, nil
+	}
+
+	items, fetchErrors, err := kube.FetchResourcesByNameWithErrors(ctx, c, namespace, resources.ServingRuntime, []string{name})
+	if err != nil {
+		return nil, fmt.Errorf("fetching ServingRuntime: %w", err)
+	}
+
+	if len(items) > 0 {
+		return []dependencies.Dependency{{
+			GVR:      resources.ServingRuntime.GVR(),
+			Resource: items[0],
+			Name:     name,
+		}}, nil
+	}
+
+	clusterItems, err := kube.FetchResourcesByName(ctx, c, "", resources.ClusterServingRuntime, []string{name})
+	if err != nil {
+		return nil, fmt.Errorf("fetching ClusterServingRuntime: %w", err)
+	}
+
+	if len(clusterItems) > 0 {
+		return []dependencies.Dependency{{
+			GVR:      resources.ClusterServingRuntime.GVR(),
+			Resource: clusterItems[0],
+			Name:     name,
+		}}, nil
+	}
+
+	return []dependencies.Dependency{{
+		GVR:   resources.ServingRuntime.GVR(),
+		Name:  name,
+		Error: fetchErrors[name],
+	}}, nil
+}
+
+// resolveServiceAccount finds the ServiceAccount the predictor runs as and returns the
+// Secrets attached to it: KServe reads the storageUri credentials from them. Secrets
+// OpenShift generates for the ServiceAccount are left out. Predictors running as the
+// namespace default ServiceAccount have nothing to resolve.
+func (r *Resolver) resolveServiceAccount(
+	ctx context.Context,
+	c client.Reader,
+	namespace string,
+	obj *unstructured.Unstructured,
+) ([]dependencies.Dependency, []string, error) {
+	name, err := jq.Query[string](obj, pathServiceAccount)
+	if err != nil && !errors.Is(err, jq.ErrNotFound) {
+		return nil, nil, fmt.Errorf("querying service account: %w", err)
+	}
+
+	if name == "" {
+		return nil, nil, nil
+	}
+
+	items, fetchErrors, err := kube.FetchResourcesByNameWithErrors(ctx, c, namespace, resources.ServiceAccount, []string{name})
+	if err != nil {
+		return nil, nil, fmt.Errorf("fetching ServiceAccount: %w", err)
+	}
+
+	if len(items) == 0 {
+		return []dependencies.Dependency{{
+			GVR:   resources.ServiceAccount.GVR(),
+			Name:  name,
+			Error: fetchErrors[name],
+		}}, nil, nil
+	}
+
+	secrets, err := jq.Query[[]string](items[0], pathServiceAccountSecrets)
+	if err != nil && !errors.Is(err, jq.ErrNotFound) {
+		return nil, nil, fmt.Errorf("querying ServiceAccount secrets: %w", err)
+	}
+
+	var attached []string
+
+	for _, secret := range secrets {
+		if strings.HasPrefix(secret, name+serviceAccountTokenInfix) || strings.HasPrefix(secret, name+serviceAccountDockercfgInfix) {
+			continue
+		}
+
+		attached = append(attached, secret)
+	}
+
+	return []dependencies.Dependency{{
+		GVR:      resources.ServiceAccount.GVR(),
+		Resource: items[0],
+		Name:     name,
+	}}, attached, nil
+}
+
+// resolveStorageSecrets finds the storage-config Secret when the model storage references a key,
+// the connection Secrets attached in the InferenceService namespace, the image pull Secrets of
+// the predictor and the storage credentials of its ServiceAccount. Secrets already resolved
+// from the predictor are skipped.
+func (r *Resolver) resolveStorageSecrets(
+	ctx context.Context,
+	c client.Reader,
+	namespace string,
+	obj *unstructured.Unstructured,
+	resolved []dependencies.Dependency,
+	serviceAccountSecrets []string,
+) ([]dependencies.Dependency, error) {
+	seen := make(map[string]bool, len(resolved))
+	for _, dep := range resolved {
+		seen[dep.Name] = true
+	}
+
+	var names []string
+
+	add := func(name string) {
+		if name != "" && !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+
+	storageKey, err := jq.Query[string](obj, pathStorageKey)
+	if err != nil && !errors.Is(err, jq.ErrNotFound) {
+		return nil, fmt.Errorf("querying storage key: %w", err)
+	}
+
+	if storageKey != "" {
+		add(storageConfigSecretName)
+	}
+
+	for entry := range strings.SplitSeq(obj.GetAnnotations()[annotationConnections], ",") {
+		entry = strings.TrimSpace(entry)
+		if ns, name, qualified := strings.Cut(entry, "/"); qualified {
+			// Connections in other namespaces are not part of this namespace's backup.
+			if ns != namespace {
+				continue
+			}
+
+			entry = name
+		}
+
+		add(entry)
+	}
+
+	pullSecrets, err := jq.Query[[]string](obj, pathImagePullSecrets)
+	if err != nil && !errors.Is(err, jq.ErrNotFound) {
+		return nil, fmt.Errorf("querying image pull secrets: %w", err)
+	}
+
+	for _, name := range slices.Concat(pullSecrets, serviceAccountSecrets) {
+		add(name)
+	}
+
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	items, fetchErrors, err := kube.FetchResourcesByNameWithErrors(ctx, c, namespace, resources.Secret, names)
+	if err != nil {
+		return nil, fmt.Errorf("fetching storage Secrets: %w", err)
+	}
+
+	deps := make([]dependencies.Dependency, 0, len(names))
+
+	for _, res := range items {
+		deps = append(deps, dependencies.Dependency{
+			GVR:      resources.Secret.GVR(),
+			Resource: res,
+			Name:     res.GetName(),
+		})
+	}
+
+	for name, fetchErr := range fetchErrors {
+		deps = append(deps, dependencies.Dependency{
+			GVR:   resources.Secret.GVR(),
+			Name:  name,
+			Error: fetchErr,
+		})
+	}
+
+	return deps, nil
+}
+
+// resolveHPA finds the predictor HorizontalPodAutoscaler. Only raw deployments have one,
+// so a missing HPA is not reported.
+func (r *Resolver) resolveHPA(
+	ctx context.Context,
+	c client.Reader,
+	namespace string,
+	name string,
+) ([]dependencies.Dependency, error) {
+	items, err := kube.FetchResourcesByName(ctx, c, namespace, resources.HorizontalPodAutoscaler, []string{name + predictorSuffix})
+	if err != nil {
+		return nil, fmt.Errorf("fetching HorizontalPodAutoscaler: %w", err)
+	}
+
+	deps := make([]dependencies.Dependency, 0, len(items))
+	for _, res := range items {
+		deps = append(deps, dependencies.Dependency{
+			GVR:      resources.HorizontalPodAutoscaler.GVR(),
+			Resource: res,
+			Name:     res.GetName(),
+		})
+	}
+
+	return deps, nil
+}
+
+// sourcesOf converts volumes to resolver sources.
+func sourcesOf(volumes []corev1.Volume) []any {
+	sources := make([]any, 0, len(volumes))
+	for _, v := range volumes {
+		sources = append(sources, v)
+	}
+
+	return sources
+}
@@ -0,0 +1,286 @@
+package inferenceservices_test
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/opendatahub-io/odh-cli/pkg/backup/dependencies"
+	"github.com/opendatahub-io/odh-cli/pkg/backup/dependencies/inferenceservices"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+//nolint:gochecknoglobals // Test fixture - shared across test functions
+var listKinds = map[schema.GroupVersionResource]string{
+	resources.ConfigMap.GVR():               "ConfigMapList",
+	resources.Secret.GVR():                  "SecretList",
+	resources.ServingRuntime.GVR():          "ServingRuntimeList",
+	resources.ClusterServingRuntime.GVR():   "ClusterServingRuntimeList",
+	resources.HorizontalPodAutoscaler.GVR(): "HorizontalPodAutoscalerList",
+	resources.ServiceAccount.GVR():          "ServiceAccountList",
+}
+
+func TestResolverCanHandle(t *testing.T) {
+	g := NewWithT(t)
+
+	resolver := inferenceservices.NewResolver()
+
+	g.Expect(resolver.CanHandle(resources.InferenceService.GVR())).To(BeTrue())
+	g.Expect(resolver.CanHandle(resources.Notebook.GVR())).To(BeFalse())
+}
+
+func TestResolverWithServingRuntimeStorageAndHPA(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	isvc := createInferenceService("test-isvc", "default", "test-runtime", "test-connection")
+	runtime := createObject(resources.ServingRuntime, "test-runtime", "default")
+	storageConfig := createSecret("storage-config", "default")
+	connection := createSecret("test-connection", "default")
+	hpa := createObject(resources.HorizontalPodAutoscaler, "test-isvc-predictor", "default")
+
+	fakeClient := createFakeClient(t, isvc, runtime, storageConfig, connection, hpa)
+
+	resolver := inferenceservices.NewResolver()
+
+	deps, err := resolver.Resolve(ctx, fakeClient, isvc)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(depNames(deps)).To(ConsistOf(
+		"servingruntimes/test-runtime",
+		"secrets/storage-config",
+		"secrets/test-connection",
+		"horizontalpodautoscalers/test-isvc-predictor",
+	))
+}
+
+func TestResolverFallsBackToClusterServingRuntime(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	isvc := createInferenceService("test-isvc", "default", "vllm-runtime", "")
+	clusterRuntime := createObject(resources.ClusterServingRuntime, "vllm-runtime", "")
+
+	fakeClient := createFakeClient(t, isvc, clusterRuntime)
+
+	resolver := inferenceservices.NewResolver()
+
+	deps, err := resolver.Resolve(ctx, fakeClient, isvc)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(deps).To(HaveLen(2))
+
+	runtimeFound := false
+	for _, dep := range deps {
+		if dep.GVR == resources.ClusterServingRuntime.GVR() {
+			runtimeFound = true
+			g.Expect(dep.Resource.GetName()).To(Equal("vllm-runtime"))
+		}
+	}
+
+	g.Expect(runtimeFound).To(BeTrue())
+}
+
+func TestResolverReportsMissingDependencies(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	isvc := createInferenceService("test-isvc", "default", "missing-runtime", "shared/other-namespace")
+
+	fakeClient := createFakeClient(t, isvc)
+
+	resolver := inferenceservices.NewResolver()
+
+	deps, err := resolver.Resolve(ctx, fakeClient, isvc)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(deps).To(HaveLen(2))
+
+	for _, dep := range deps {
+		g.Expect(dep.Resource).To(BeNil())
+		g.Expect(dep.Error).To(HaveOccurred())
+	}
+
+	// The HPA is optional and the cross-namespace connection is not part of the backup.
+	g.Expect(depNames(deps)).To(ConsistOf("servingruntimes/missing-runtime", "secrets/storage-config"))
+}
+
+func TestResolverWithPredictorEnvSecret(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	isvc := createInferenceService("test-isvc", "default", "", "")
+	_ = unstructured.SetNestedSlice(isvc.Object, []any{
+		map[string]any{
+			"name": "HF_TOKEN",
+			"valueFrom": map[string]any{
+				"secretKeyRef": map[string]any{"name": "hf-token", "key": "token"},
+			},
+		},
+	}, "spec", "predictor", "model", "env")
+	unstructured.RemoveNestedField(isvc.Object, "spec", "predictor", "model", "storage")
+
+	fakeClient := createFakeClient(t, isvc, createSecret("hf-token", "default"))
+
+	resolver := inferenceservices.NewResolver()
+
+	deps, err := resolver.Resolve(ctx, fakeClient, isvc)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(depNames(deps)).To(ConsistOf("secrets/hf-token"))
+}
+
+func TestResolverWithServiceAccountStorageCredentials(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	isvc := createInferenceService("test-isvc", "default", "", "")
+	unstructured.RemoveNestedField(isvc.Object, "spec", "predictor", "model", "storage")
+	_ = unstructured.SetNestedField(isvc.Object, "models-sa", "spec", "predictor", "serviceAccountName")
+	_ = unstructured.SetNestedSlice(isvc.Object, []any{
+		map[string]any{"name": "registry-pull"},
+	}, "spec", "predictor", "imagePullSecrets")
+
+	serviceAccount := createObject(resources.ServiceAccount, "models-sa", "default")
+	serviceAccount.Object["secrets"] = []any{
+		map[string]any{"name": "s3-credentials"},
+		map[string]any{"name": "models-sa-token-abcde"},
+		map[string]any{"name": "models-sa-dockercfg-fghij"},
+	}
+
+	fakeClient := createFakeClient(t, isvc, serviceAccount,
+		createSecret("s3-credentials", "default"),
+		createSecret("registry-pull", "default"))
+
+	resolver := inferenceservices.NewResolver()
+
+	deps, err := resolver.Resolve(ctx, fakeClient, isvc)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(depNames(deps)).To(ConsistOf(
+		"serviceaccounts/models-sa",
+		"secrets/s3-credentials",
+		"secrets/registry-pull",
+	))
+
+	for _, dep := range deps {
+		g.Expect(dep.Error).ToNot(HaveOccurred())
+	}
+}
+
+func TestResolverReportsMissingServiceAccount(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	isvc := createInferenceService("test-isvc", "default", "", "")
+	unstructured.RemoveNestedField(isvc.Object, "spec", "predictor", "model", "storage")
+	_ = unstructured.SetNestedField(isvc.Object, "missing-sa", "spec", "predictor", "serviceAccountName")
+
+	fakeClient := createFakeClient(t, isvc)
+
+	resolver := inferenceservices.NewResolver()
+
+	deps, err := resolver.Resolve(ctx, fakeClient, isvc)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(deps).To(HaveLen(1))
+	g.Expect(depNames(deps)).To(ConsistOf("serviceaccounts/missing-sa"))
+	g.Expect(deps[0].Error).To(HaveOccurred())
+}
+
+func depNames(deps []dependencies.Dependency) []string {
+	names := make([]string, 0, len(deps))
+	for _, dep := range deps {
+		names = append(names, dep.GVR.Resource+"/"+dep.Name)
+	}
+
+	return names
+}
+
+func createInferenceService(
+	name string,
+	namespace string,
+	runtimeName string,
+	connections string,
+) *unstructured.Unstructured {
+	isvc := &unstructured.Unstructured{}
+	isvc.SetGroupVersionKind(resources.InferenceService.GVK())
+	isvc.SetName(name)
+	isvc.SetNamespace(namespace)
+
+	if connections != "" {
+		isvc.SetAnnotations(map[string]string{"opendatahub.io/connections": connections})
+	}
+
+	model := map[string]any{
+		"modelFormat": map[string]any{"name": "onnx"},
+		"storage": map[string]any{
+			"key":  "test-connection",
+			"path": "models/test",
+		},
+	}
+
+	if runtimeName != "" {
+		model["runtime"] = runtimeName
+	}
+
+	isvc.Object["spec"] = map[string]any{
+		"predictor": map[string]any{
+			"model": model,
+		},
+	}
+
+	return isvc
+}
+
+func createObject(
+	resourceType resources.ResourceType,
+	name string,
+	namespace string,
+) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(resourceType.GVK())
+	obj.SetName(name)
+	obj.SetNamespace(namespace)
+
+	return obj
+}
+
+func createSecret(
+	name string,
+	namespace string,
+) *unstructured.Unstructured {
+	secret := createObject(resources.Secret, name, namespace)
+
+	secret.Object["data"] = map[string]any{
+		"key": "dmFsdWU=", // base64 encoded "value"
+	}
+
+	return secret
+}
+
+func createFakeClient(
+	t *testing.T,
+	objs ...runtime.Object,
+) client.Client {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	err := corev1.AddToScheme(scheme)
+	if err != nil {
+		t.Fatalf("failed to add core v1 to scheme: %v", err)
+	}
+
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme, listKinds, objs...)
+
+	return client.NewForTesting(client.TestClientConfig{
+		Dynamic: dynamicClient,
+	})
+}
@@ -0,0 +1,234 @@
+package backup
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/yaml"
+)
+
+// ManifestFile is the name of the manifest at the root of a backup archive.
+const ManifestFile = "manifest.yaml"
+
+// ErrChecksumMismatch is returned when the files of a backup do not match its manifest.
+var ErrChecksumMismatch = errors.New("backup does not match its manifest")
+
+// Manifest describes the contents of a backup archive, so it can be inspected and its
+// integrity verified after it has been shipped.
+type Manifest struct {
+	// CreatedAt is when the backup was taken.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// ClusterVersion is the OpenShift AI version detected when the backup was taken.
+	ClusterVersion string `json:"clusterVersion,omitempty"`
+
+	// Resources lists each backed-up resource type with its object count.
+	Resources []ManifestResource `json:"resources"`
+
+	// Files lists the SHA-256 checksum of each file of the backup.
+	Files []ManifestFileChecksum `json:"files"`
+}
+
+// ManifestResource is a resource type of a backup.
+type ManifestResource struct {
+	Group    string `json:"group,omitempty"`
+	Version  string `json:"version"`
+	Resource string `json:"resource"`
+	Count    int    `json:"count"`
+}
+
+// ManifestFileChecksum is the checksum of a file of a backup, by path relative to its root.
+type ManifestFileChecksum struct {
+	Path   string `json:"path"`
+	SHA256 string `json:"sha256"`
+}
+
+// BuildManifest returns the manifest of the backup directory dir: its resource types with
+// their object counts and the checksums of its files, in path order.
+func BuildManifest(dir string) (*Manifest, error) {
+	r, err := NewReader(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &Manifest{}
+
+	for _, gvr := range r.GroupVersionResources() {
+		manifest.Resources = append(manifest.Resources, ManifestResource{
+			Group:    gvr.Group,
+			Version:  gvr.Version,
+			Resource: gvr.Resource,
+			Count:    len(r.objects[gvr.GroupResource()]),
+		})
+	}
+
+	checksums, err := checksumFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest.Files = checksums
+
+	return manifest, nil
+}
+
+// WriteManifest writes manifest to the ManifestFile of dir.
+func WriteManifest(dir string, manifest *Manifest) error {
+	data, err := yaml.Marshal(manifest)
+	if err != nil {
+		return fmt.Errorf("marshaling manifest: %w", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, ManifestFile), data, filePermissions); err != nil {
+		return fmt.Errorf("writing manifest: %w", err)
+	}
+
+	return nil
+}
+
+// ReadManifest reads the ManifestFile of dir.
+func ReadManifest(dir string) (*Manifest, error) {
+	data, err := os.ReadFile(filepath.Join(dir, ManifestFile))
+	if err != nil {
+		return nil, fmt.Errorf("reading manifest: %w", err)
+	}
+
+	var manifest Manifest
+	if err := yaml.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("parsing manifest: %w", err)
+	}
+
+	return &manifest, nil
+}
+
+// VerifyManifest checks that the files of the backup directory dir are exactly those of its
+// manifest, with the same checksums.
+func VerifyManifest(dir string, manifest *Manifest) error {
+	checksums, err := checksumFiles(dir)
+	if err != nil {
+		return err
+	}
+
+	expected := make(map[string]string, len(manifest.Files))
+	for _, f := range manifest.Files {
+		expected[f.Path] = f.SHA256
+	}
+
+	var problems []string
+
+	for _, f := range checksums {
+		sum, ok := expected[f.Path]
+
+		switch {
+		case !ok:
+			problems = append(problems, f.Path+": not in manifest")
+		case sum != f.SHA256:
+			problems = append(problems, f.Path+": checksum mismatch")
+		}
+
+		delete(expected, f.Path)
+	}
+
+	for path := range expected {
+		problems = append(problems, path+": missing")
+	}
+
+	if len(problems) > 0 {
+		slices.Sort(problems)
+
+		return fmt.Errorf("%w: %s", ErrChecksumMismatch, strings.Join(problems, ", "))
+	}
+
+	return nil
+}
+
+// checksumFiles returns the checksums of the regular files under dir but the manifest, in
+// path order.
+func checksumFiles(dir string) ([]ManifestFileChecksum, error) {
+	var checksums []ManifestFileChecksum
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return fmt.Errorf("resolving %s: %w", path, err)
+		}
+
+		rel = filepath.ToSlash(rel)
+		if rel == ManifestFile {
+			return nil
+		}
+
+		sum, err := checksumFile(path)
+		if err != nil {
+			return err
+		}
+
+		checksums = append(checksums, ManifestFileChecksum{Path: rel, SHA256: sum})
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("computing checksums of %s: %w", dir, err)
+	}
+
+	return checksums, nil
+}
+
+func checksumFile(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("opening %s: %w", path, err)
+	}
+
+	defer func() { _ = f.Close() }()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", fmt.Errorf("reading %s: %w", path, err)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// ExtractVerifiedArchive extracts the backup archive at path into dir and verifies its
+// files against its manifest.
+func ExtractVerifiedArchive(path string, dir string) (*Manifest, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening backup archive: %w", err)
+	}
+
+	defer func() { _ = f.Close() }()
+
+	if err := ExtractArchive(f, dir); err != nil {
+		return nil, fmt.Errorf("extracting backup archive %s: %w", path, err)
+	}
+
+	manifest, err := ReadManifest(dir)
+	if err != nil {
+		return nil, fmt.Errorf("backup archive %s: %w", path, err)
+	}
+
+	if err := VerifyManifest(dir, manifest); err != nil {
+		return nil, fmt.Errorf("backup archive %s: %w", path, err)
+	}
+
+	return manifest, nil
+}
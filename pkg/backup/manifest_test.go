@@ -0,0 +1,57 @@
+package backup_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/backup"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestBuildManifest(t *testing.T) {
+	g := NewWithT(t)
+
+	dir := writeBackup(t, resources.ConfigMap,
+		newResource(resources.ConfigMap, "ns1", "a", nil),
+		newResource(resources.ConfigMap, "ns2", "b", nil),
+	)
+
+	manifest, err := backup.BuildManifest(dir)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(manifest.Resources).To(Equal([]backup.ManifestResource{
+		{Version: "v1", Resource: "configmaps", Count: 2},
+	}))
+	g.Expect(manifest.Files).To(HaveLen(2))
+	g.Expect(manifest.Files[0].Path).To(Equal("ns1/configmaps-a.yaml"))
+	g.Expect(manifest.Files[0].SHA256).To(HaveLen(64))
+
+	g.Expect(backup.WriteManifest(dir, manifest)).To(Succeed())
+
+	read, err := backup.ReadManifest(dir)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(read).To(Equal(manifest))
+	g.Expect(backup.VerifyManifest(dir, read)).To(Succeed())
+}
+
+func TestVerifyManifest_DetectsTampering(t *testing.T) {
+	g := NewWithT(t)
+
+	dir := writeBackup(t, resources.ConfigMap,
+		newResource(resources.ConfigMap, "ns1", "a", nil),
+		newResource(resources.ConfigMap, "ns2", "b", nil),
+	)
+
+	manifest, err := backup.BuildManifest(dir)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(os.WriteFile(filepath.Join(dir, "ns1", "configmaps-a.yaml"), []byte("tampered"), 0o600)).To(Succeed())
+	g.Expect(os.Remove(filepath.Join(dir, "ns2", "configmaps-b.yaml"))).To(Succeed())
+	g.Expect(os.WriteFile(filepath.Join(dir, "extra.yaml"), []byte("x: 1"), 0o600)).To(Succeed())
+
+	err = backup.VerifyManifest(dir, manifest)
+	g.Expect(err).To(MatchError(backup.ErrChecksumMismatch))
+	g.Expect(err).To(MatchError(ContainSubstring("extra.yaml: not in manifest, ns1/configmaps-a.yaml: checksum mismatch, ns2/configmaps-b.yaml: missing")))
+}
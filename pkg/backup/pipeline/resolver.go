@@ -7,17 +7,26 @@ import (
 
 	"golang.org/x/sync/errgroup"
 
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
 	"github.com/opendatahub-io/odh-cli/pkg/backup/dependencies"
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
 	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
 )
 
-// ResolverStage resolves dependencies for workload instances.
+// ResolverStage resolves dependencies for workload instances. Dependencies handled by a
+// registered resolver are resolved in turn, up to MaxDepth levels; each resource is included
+// once per workload, so reference cycles end where they loop back.
 type ResolverStage struct {
 	Client      client.Client
 	DepRegistry *dependencies.Registry
 	Verbose     bool
 	IO          iostreams.Interface
+
+	// MaxDepth bounds transitive resolution: 1 resolves the direct dependencies of workloads
+	// only. Zero is treated as 1.
+	MaxDepth int
 }
 
 // Run launches N workers to resolve dependencies.
@@ -89,7 +98,11 @@ func (r *ResolverStage) resolveWorkload(
 		}, nil
 	}
 
-	deps, err := resolver.Resolve(ctx, r.Client, item.Instance)
+	visited := map[string]bool{
+		dependencyKey(item.GVR, item.Instance.GetNamespace(), item.Instance.GetName()): true,
+	}
+
+	deps, err := r.resolveDependencies(ctx, resolver, item.Instance, 1, visited)
 	if err != nil {
 		return WorkloadWithDeps{}, fmt.Errorf("resolving dependencies: %w", err)
 	}
@@ -107,6 +120,66 @@ func (r *ResolverStage) resolveWorkload(
 	}, nil
 }
 
+// resolveDependencies resolves the dependencies of obj at depth, then those of each dependency
+// handled by a registered resolver. Resources in visited are skipped.
+func (r *ResolverStage) resolveDependencies(
+	ctx context.Context,
+	resolver dependencies.Resolver,
+	obj *unstructured.Unstructured,
+	depth int,
+	visited map[string]bool,
+) ([]dependencies.Dependency, error) {
+	deps, err := resolver.Resolve(ctx, r.Client, obj)
+	if err != nil {
+		return nil, fmt.Errorf("resolving %s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
+	}
+
+	result := make([]dependencies.Dependency, 0, len(deps))
+
+	for _, dep := range deps {
+		key := dependencyKey(dep.GVR, obj.GetNamespace(), dep.Name)
+		if visited[key] {
+			continue
+		}
+
+		visited[key] = true
+
+		result = append(result, dep)
+
+		if dep.Resource == nil {
+			continue
+		}
+
+		next, err := r.DepRegistry.GetResolver(dep.GVR)
+		if err != nil {
+			continue
+		}
+
+		if depth >= max(r.MaxDepth, 1) {
+			if r.Verbose {
+				r.IO.Errorf("  Not resolving dependencies of %s %s: maximum depth %d reached",
+					r.formatResourceType(dep.GVR.Resource), dep.Name, max(r.MaxDepth, 1))
+			}
+
+			continue
+		}
+
+		nested, err := r.resolveDependencies(ctx, next, dep.Resource, depth+1, visited)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, nested...)
+	}
+
+	return result, nil
+}
+
+// dependencyKey identifies a resource among the dependencies of a workload.
+func dependencyKey(gvr schema.GroupVersionResource, namespace string, name string) string {
+	return gvr.String() + "/" + namespace + "/" + name
+}
+
 // logDependencies logs each dependency with type and name.
 func (r *ResolverStage) logDependencies(deps []dependencies.Dependency) {
 	for i := range deps {
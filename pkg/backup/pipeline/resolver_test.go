@@ -5,10 +5,12 @@ import (
 	"testing"
 	"time"
 
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/opendatahub-io/odh-cli/pkg/backup/dependencies"
 	"github.com/opendatahub-io/odh-cli/pkg/backup/pipeline"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
 	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
 
 	. "github.com/onsi/gomega"
@@ -146,3 +148,103 @@ func TestResolverStage(t *testing.T) {
 		g.Expect(err.Error()).To(ContainSubstring("resolver"))
 	})
 }
+
+// graphResolver resolves the dependencies of the objects of one resource type from a fixed
+// graph of object names to dependencies.
+type graphResolver struct {
+	gvr   schema.GroupVersionResource
+	graph map[string][]dependencies.Dependency
+}
+
+func (r *graphResolver) Resolve(
+	_ context.Context,
+	_ client.Reader,
+	obj *unstructured.Unstructured,
+) ([]dependencies.Dependency, error) {
+	return r.graph[obj.GetName()], nil
+}
+
+func (r *graphResolver) CanHandle(gvr schema.GroupVersionResource) bool {
+	return gvr == r.gvr
+}
+
+func newDependency(gvr schema.GroupVersionResource, name string) dependencies.Dependency {
+	obj := &unstructured.Unstructured{}
+	obj.SetNamespace(testNamespace)
+	obj.SetName(name)
+
+	return dependencies.Dependency{GVR: gvr, Resource: obj, Name: name}
+}
+
+func resolveOne(t *testing.T, stage *pipeline.ResolverStage, item pipeline.WorkloadItem) []string {
+	t.Helper()
+
+	input := make(chan pipeline.WorkloadItem, 1)
+	output := make(chan pipeline.WorkloadWithDeps, 1)
+
+	input <- item
+	close(input)
+
+	NewWithT(t).Expect(stage.Run(t.Context(), 1, input, output)).To(Succeed())
+	close(output)
+
+	var names []string
+	for result := range output {
+		for _, dep := range result.Dependencies {
+			names = append(names, dep.GVR.Resource+"/"+dep.Name)
+		}
+	}
+
+	return names
+}
+
+func TestResolverStageTransitiveDependencies(t *testing.T) {
+	workloadGVR := schema.GroupVersionResource{Group: "test", Version: "v1", Resource: "tests"}
+	configGVR := schema.GroupVersionResource{Group: "test", Version: "v1", Resource: "configs"}
+	secretGVR := schema.GroupVersionResource{Version: "v1", Resource: "secrets"}
+
+	newStage := func(maxDepth int) *pipeline.ResolverStage {
+		registry := dependencies.NewRegistry()
+		registry.MustRegister(&graphResolver{gvr: workloadGVR, graph: map[string][]dependencies.Dependency{
+			notebookName: {newDependency(configGVR, "config-a"), newDependency(secretGVR, "creds")},
+		}})
+		registry.MustRegister(&graphResolver{gvr: configGVR, graph: map[string][]dependencies.Dependency{
+			// config-a -> config-b -> config-a and back to the workload: both cycles end.
+			"config-a": {newDependency(configGVR, "config-b"), newDependency(secretGVR, "creds")},
+			"config-b": {newDependency(configGVR, "config-a"), newDependency(workloadGVR, notebookName), newDependency(configGVR, "config-c")},
+			"config-c": {newDependency(secretGVR, "deep")},
+		}})
+
+		return &pipeline.ResolverStage{
+			DepRegistry: registry,
+			IO:          iostreams.NewIOStreams(nil, nil, nil),
+			MaxDepth:    maxDepth,
+		}
+	}
+
+	item := pipeline.WorkloadItem{GVR: workloadGVR, Instance: createTestWorkload()}
+
+	t.Run("should resolve dependencies of dependencies once each", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(resolveOne(t, newStage(5), item)).To(Equal([]string{
+			"configs/config-a", "configs/config-b", "configs/config-c", "secrets/deep", "secrets/creds",
+		}))
+	})
+
+	t.Run("should stop at the maximum depth", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(resolveOne(t, newStage(2), item)).To(Equal([]string{
+			"configs/config-a", "configs/config-b", "secrets/creds",
+		}))
+	})
+
+	t.Run("should resolve direct dependencies only by default", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(resolveOne(t, newStage(0), item)).To(Equal([]string{
+			"configs/config-a", "secrets/creds",
+		}))
+	})
+}
@@ -0,0 +1,388 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"sigs.k8s.io/yaml"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+)
+
+var _ client.Reader = (*Reader)(nil)
+
+// Reader serves read-only access to the resources of a backup directory written by
+// WriteResourceToFile, so lint checks can run against a snapshot without cluster access.
+// Resource types absent from the backup list as empty; missing objects are NotFound.
+type Reader struct {
+	dir      string
+	versions map[schema.GroupResource]string
+	objects  map[schema.GroupResource][]*unstructured.Unstructured
+}
+
+// NewReader loads the resources of the backup directory dir. Files not following the
+// $namespace/$GVR-$name.yaml layout are ignored.
+func NewReader(dir string) (*Reader, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading backup directory: %w", err)
+	}
+
+	if !info.IsDir() {
+		return nil, fmt.Errorf("backup path %s is not a directory", dir)
+	}
+
+	r := &Reader{
+		dir:      dir,
+		versions: make(map[schema.GroupResource]string),
+		objects:  make(map[schema.GroupResource][]*unstructured.Unstructured),
+	}
+
+	err = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() || filepath.Ext(path) != ".yaml" {
+			return nil
+		}
+
+		return r.load(path)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("loading backup %s: %w", dir, err)
+	}
+
+	for gr := range r.objects {
+		sort.Slice(r.objects[gr], func(i, j int) bool {
+			a, b := r.objects[gr][i], r.objects[gr][j]
+			if a.GetNamespace() != b.GetNamespace() {
+				return a.GetNamespace() < b.GetNamespace()
+			}
+
+			return a.GetName() < b.GetName()
+		})
+	}
+
+	return r, nil
+}
+
+// load indexes the resource in path by the group and resource encoded in its file name.
+func (r *Reader) load(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", path, err)
+	}
+
+	var content map[string]any
+	if err := yaml.Unmarshal(data, &content); err != nil {
+		return fmt.Errorf("parsing %s: %w", path, err)
+	}
+
+	obj := &unstructured.Unstructured{Object: content}
+	if obj.GetName() == "" || obj.GetKind() == "" {
+		return nil
+	}
+
+	base := filepath.Base(path)
+
+	prefix, ok := strings.CutSuffix(base, "-"+obj.GetName()+".yaml")
+	if !ok || prefix == "" {
+		return nil
+	}
+
+	resource, group, _ := strings.Cut(prefix, ".")
+	gr := schema.GroupResource{Group: group, Resource: resource}
+
+	gv, err := schema.ParseGroupVersion(obj.GetAPIVersion())
+	if err != nil || gv.Group != group {
+		return nil
+	}
+
+	r.versions[gr] = gv.Version
+	r.objects[gr] = append(r.objects[gr], obj)
+
+	return nil
+}
+
+// Dir returns the backup directory the reader serves.
+func (r *Reader) Dir() string {
+	return r.dir
+}
+
+// GroupVersionResources returns the resource types present in the backup, sorted.
+func (r *Reader) GroupVersionResources() []schema.GroupVersionResource {
+	gvrs := make([]schema.GroupVersionResource, 0, len(r.versions))
+	for gr, v := range r.versions {
+		gvrs = append(gvrs, gr.WithVersion(v))
+	}
+
+	sort.Slice(gvrs, func(i, j int) bool {
+		return gvrs[i].String() < gvrs[j].String()
+	})
+
+	return gvrs
+}
+
+func (r *Reader) List(
+	ctx context.Context,
+	resourceType resources.ResourceType,
+	opts ...client.ListResourcesOption,
+) ([]*unstructured.Unstructured, error) {
+	return r.ListResources(ctx, resourceType.GVR(), opts...)
+}
+
+func (r *Reader) ListMetadata(
+	ctx context.Context,
+	resourceType resources.ResourceType,
+	opts ...client.ListResourcesOption,
+) ([]*metav1.PartialObjectMetadata, error) {
+	items, err := r.ListResources(ctx, resourceType.GVR(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*metav1.PartialObjectMetadata, 0, len(items))
+
+	for _, item := range items {
+		meta, err := toPartialObjectMetadata(item)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, meta)
+	}
+
+	return result, nil
+}
+
+func (r *Reader) ListResources(
+	_ context.Context,
+	gvr schema.GroupVersionResource,
+	opts ...client.ListResourcesOption,
+) ([]*unstructured.Unstructured, error) {
+	cfg := &client.ListResourcesConfig{}
+	util.ApplyOptions(cfg, opts...)
+
+	labelSelector, err := labels.Parse(cfg.LabelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid label selector %q: %w", cfg.LabelSelector, err)
+	}
+
+	fieldSelector, err := fields.ParseSelector(cfg.FieldSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid field selector %q: %w", cfg.FieldSelector, err)
+	}
+
+	var items []*unstructured.Unstructured
+
+	for _, obj := range r.objects[gvr.GroupResource()] {
+		if cfg.Namespace != "" && obj.GetNamespace() != cfg.Namespace {
+			continue
+		}
+
+		if !labelSelector.Matches(labels.Set(obj.GetLabels())) {
+			continue
+		}
+
+		if !fieldSelector.Matches(fields.Set{
+			"metadata.name":      obj.GetName(),
+			"metadata.namespace": obj.GetNamespace(),
+		}) {
+			continue
+		}
+
+		items = append(items, obj.DeepCopy())
+	}
+
+	return items, nil
+}
+
+func (r *Reader) Get(
+	_ context.Context,
+	gvr schema.GroupVersionResource,
+	name string,
+	opts ...client.GetOption,
+) (*unstructured.Unstructured, error) {
+	cfg := &client.GetConfig{}
+	util.ApplyOptions(cfg, opts...)
+
+	for _, obj := range r.objects[gvr.GroupResource()] {
+		if obj.GetName() == name && obj.GetNamespace() == cfg.Namespace {
+			return obj.DeepCopy(), nil
+		}
+	}
+
+	return nil, apierrors.NewNotFound(gvr.GroupResource(), name)
+}
+
+func (r *Reader) GetResource(
+	ctx context.Context,
+	resourceType resources.ResourceType,
+	name string,
+	opts ...client.GetOption,
+) (*unstructured.Unstructured, error) {
+	return r.Get(ctx, resourceType.GVR(), name, opts...)
+}
+
+func (r *Reader) GetResourceMetadata(
+	ctx context.Context,
+	resourceType resources.ResourceType,
+	name string,
+	opts ...client.GetOption,
+) (*metav1.PartialObjectMetadata, error) {
+	obj, err := r.Get(ctx, resourceType.GVR(), name, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPartialObjectMetadata(obj)
+}
+
+// OLM serves the Subscriptions and ClusterServiceVersions of the backup. OLM is reported
+// available only when the backup contains any of them.
+func (r *Reader) OLM() client.OLMReader {
+	return &olmReader{reader: r}
+}
+
+func toPartialObjectMetadata(obj *unstructured.Unstructured) (*metav1.PartialObjectMetadata, error) {
+	meta := &metav1.PartialObjectMetadata{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, meta); err != nil {
+		return nil, fmt.Errorf("converting %s/%s to metadata: %w", obj.GetNamespace(), obj.GetName(), err)
+	}
+
+	return meta, nil
+}
+
+type olmReader struct {
+	reader *Reader
+}
+
+func (o *olmReader) Available() bool {
+	return len(o.reader.objects[resources.Subscription.GVR().GroupResource()]) > 0 ||
+		len(o.reader.objects[resources.ClusterServiceVersion.GVR().GroupResource()]) > 0
+}
+
+func (o *olmReader) Subscriptions(namespace string) client.SubscriptionReader {
+	return &subscriptionReader{reader: o.reader, namespace: namespace}
+}
+
+func (o *olmReader) ClusterServiceVersions(namespace string) client.CSVReader {
+	return &csvReader{reader: o.reader, namespace: namespace}
+}
+
+type subscriptionReader struct {
+	reader    *Reader
+	namespace string
+}
+
+func (s *subscriptionReader) List(
+	ctx context.Context,
+	opts metav1.ListOptions,
+) (*operatorsv1alpha1.SubscriptionList, error) {
+	items, err := s.reader.ListResources(ctx, resources.Subscription.GVR(), listOptions(s.namespace, opts)...)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &operatorsv1alpha1.SubscriptionList{}
+
+	for _, item := range items {
+		var sub operatorsv1alpha1.Subscription
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, &sub); err != nil {
+			return nil, fmt.Errorf("converting Subscription %s/%s: %w", item.GetNamespace(), item.GetName(), err)
+		}
+
+		list.Items = append(list.Items, sub)
+	}
+
+	return list, nil
+}
+
+func (s *subscriptionReader) Get(
+	ctx context.Context,
+	name string,
+	_ metav1.GetOptions,
+) (*operatorsv1alpha1.Subscription, error) {
+	item, err := s.reader.Get(ctx, resources.Subscription.GVR(), name, client.InNamespace(s.namespace))
+	if err != nil {
+		return nil, err
+	}
+
+	var sub operatorsv1alpha1.Subscription
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, &sub); err != nil {
+		return nil, fmt.Errorf("converting Subscription %s/%s: %w", item.GetNamespace(), item.GetName(), err)
+	}
+
+	return &sub, nil
+}
+
+type csvReader struct {
+	reader    *Reader
+	namespace string
+}
+
+func (c *csvReader) List(
+	ctx context.Context,
+	opts metav1.ListOptions,
+) (*operatorsv1alpha1.ClusterServiceVersionList, error) {
+	items, err := c.reader.ListResources(ctx, resources.ClusterServiceVersion.GVR(), listOptions(c.namespace, opts)...)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &operatorsv1alpha1.ClusterServiceVersionList{}
+
+	for _, item := range items {
+		var csv operatorsv1alpha1.ClusterServiceVersion
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, &csv); err != nil {
+			return nil, fmt.Errorf("converting ClusterServiceVersion %s/%s: %w", item.GetNamespace(), item.GetName(), err)
+		}
+
+		list.Items = append(list.Items, csv)
+	}
+
+	return list, nil
+}
+
+func (c *csvReader) Get(
+	ctx context.Context,
+	name string,
+	_ metav1.GetOptions,
+) (*operatorsv1alpha1.ClusterServiceVersion, error) {
+	item, err := c.reader.Get(ctx, resources.ClusterServiceVersion.GVR(), name, client.InNamespace(c.namespace))
+	if err != nil {
+		return nil, err
+	}
+
+	var csv operatorsv1alpha1.ClusterServiceVersion
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, &csv); err != nil {
+		return nil, fmt.Errorf("converting ClusterServiceVersion %s/%s: %w", item.GetNamespace(), item.GetName(), err)
+	}
+
+	return &csv, nil
+}
+
+func listOptions(namespace string, opts metav1.ListOptions) []client.ListResourcesOption {
+	return []client.ListResourcesOption{
+		client.WithNamespace(namespace),
+		client.WithLabelSelector(opts.LabelSelector),
+		client.WithFieldSelector(opts.FieldSelector),
+	}
+}
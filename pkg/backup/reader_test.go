@@ -0,0 +1,145 @@
+package backup_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/backup"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+func newResource(rt resources.ResourceType, namespace string, name string, labels map[string]string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{}}
+	obj.SetAPIVersion(rt.APIVersion())
+	obj.SetKind(rt.Kind)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	obj.SetLabels(labels)
+
+	return obj
+}
+
+func writeBackup(t *testing.T, rt resources.ResourceType, objs ...*unstructured.Unstructured) string {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	if err := backup.WriteResourcesToDir(dir, rt.GVR(), objs); err != nil {
+		t.Fatal(err)
+	}
+
+	return dir
+}
+
+func TestReader_List(t *testing.T) {
+	g := NewWithT(t)
+
+	dir := writeBackup(t, resources.Notebook,
+		newResource(resources.Notebook, "team-a", "nb-1", map[string]string{"app": "demo"}),
+		newResource(resources.Notebook, "team-a", "nb-2", nil),
+		newResource(resources.Notebook, "team-b", "nb-1", nil),
+	)
+
+	r, err := backup.NewReader(dir)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	all, err := r.List(t.Context(), resources.Notebook)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(all).To(HaveLen(3))
+
+	inNamespace, err := r.List(t.Context(), resources.Notebook, client.WithNamespace("team-a"))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(inNamespace).To(HaveLen(2))
+
+	labeled, err := r.List(t.Context(), resources.Notebook, client.WithLabelSelector("app=demo"))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(labeled).To(HaveLen(1))
+	g.Expect(labeled[0].GetName()).To(Equal("nb-1"))
+
+	named, err := r.List(t.Context(), resources.Notebook, client.WithFieldSelector("metadata.name=nb-1"))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(named).To(HaveLen(2))
+
+	metadata, err := r.ListMetadata(t.Context(), resources.Notebook, client.WithNamespace("team-b"))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(metadata).To(HaveLen(1))
+	g.Expect(metadata[0].Name).To(Equal("nb-1"))
+
+	// Types absent from the backup list as empty.
+	absent, err := r.List(t.Context(), resources.InferenceService)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(absent).To(BeEmpty())
+
+	g.Expect(r.GroupVersionResources()).To(ConsistOf(resources.Notebook.GVR()))
+}
+
+func TestReader_Get(t *testing.T) {
+	g := NewWithT(t)
+
+	dir := writeBackup(t, resources.DataScienceCluster,
+		newResource(resources.DataScienceCluster, "", "default-dsc", nil),
+	)
+
+	r, err := backup.NewReader(dir)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	dsc, err := r.GetResource(t.Context(), resources.DataScienceCluster, "default-dsc")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dsc.GetKind()).To(Equal(resources.DataScienceCluster.Kind))
+
+	dsc.SetName("mutated")
+
+	// Returned objects are copies.
+	again, err := client.GetDataScienceCluster(t.Context(), r)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(again.GetName()).To(Equal("default-dsc"))
+
+	_, err = r.GetResource(t.Context(), resources.DataScienceCluster, "missing")
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+	_, err = r.GetResourceMetadata(t.Context(), resources.Notebook, "nb", client.InNamespace("team-a"))
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+}
+
+func TestReader_OLM(t *testing.T) {
+	g := NewWithT(t)
+
+	csv := newResource(resources.ClusterServiceVersion, "redhat-ods-operator", "rhods-operator.2.25.0",
+		map[string]string{"operators.coreos.com/rhods-operator.redhat-ods-operator": ""})
+	g.Expect(unstructured.SetNestedField(csv.Object, "2.25.0", "spec", "version")).To(Succeed())
+
+	dir := writeBackup(t, resources.ClusterServiceVersion, csv)
+
+	// Files not following the backup layout are ignored.
+	g.Expect(os.WriteFile(filepath.Join(dir, "notes.yaml"), []byte("kind: Note\n"), 0o600)).To(Succeed())
+
+	r, err := backup.NewReader(dir)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(r.OLM().Available()).To(BeTrue())
+
+	csvs, err := r.OLM().ClusterServiceVersions("").List(t.Context(), metav1.ListOptions{
+		LabelSelector: "operators.coreos.com/rhods-operator.redhat-ods-operator",
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(csvs.Items).To(HaveLen(1))
+	g.Expect(csvs.Items[0].Spec.Version.String()).To(Equal("2.25.0"))
+
+	subs, err := r.OLM().Subscriptions("").List(t.Context(), metav1.ListOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(subs.Items).To(BeEmpty())
+}
+
+func TestNewReader_MissingDirectory(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := backup.NewReader(filepath.Join(t.TempDir(), "missing"))
+	g.Expect(err).To(MatchError(ContainSubstring("reading backup directory")))
+}
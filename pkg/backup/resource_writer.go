@@ -13,29 +13,36 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
-// WriteResourceToFile writes a resource to $outputDir/$namespace/$GVR-$name.yaml.
-func WriteResourceToFile(
+// ResourceFilePath returns the path WriteResourceToFile writes obj to: $outputDir/$namespace/$GVR-$name.yaml.
+func ResourceFilePath(
 	outputDir string,
 	gvr schema.GroupVersionResource,
 	obj *unstructured.Unstructured,
-) error {
+) string {
 	namespace := obj.GetNamespace()
 	if namespace == "" {
 		namespace = "cluster-scoped"
 	}
-	name := obj.GetName()
-
-	nsDir := filepath.Join(outputDir, namespace)
-	if err := os.MkdirAll(nsDir, dirPermissions); err != nil {
-		return fmt.Errorf("creating namespace directory: %w", err)
-	}
 
 	gvrStr := gvr.Resource
 	if gvr.Group != "" {
 		gvrStr = gvr.Resource + "." + gvr.Group
 	}
-	filename := fmt.Sprintf("%s-%s.yaml", gvrStr, name)
-	filePath := filepath.Join(nsDir, filename)
+
+	return filepath.Join(outputDir, namespace, fmt.Sprintf("%s-%s.yaml", gvrStr, obj.GetName()))
+}
+
+// WriteResourceToFile writes a resource to $outputDir/$namespace/$GVR-$name.yaml.
+func WriteResourceToFile(
+	outputDir string,
+	gvr schema.GroupVersionResource,
+	obj *unstructured.Unstructured,
+) error {
+	filePath := ResourceFilePath(outputDir, gvr, obj)
+
+	if err := os.MkdirAll(filepath.Dir(filePath), dirPermissions); err != nil {
+		return fmt.Errorf("creating namespace directory: %w", err)
+	}
 
 	data, err := yaml.Marshal(obj.Object)
 	if err != nil {
@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/pflag"
+)
+
+// Exit codes of the CLI. A run with several outcomes exits with the code of the first
+// outcome that applies, in the order: execution error, blocking findings, partial check
+// failures, advisory findings.
+const (
+	// ExitCodeClean is returned when the run succeeded without findings failing the run.
+	ExitCodeClean = 0
+
+	// ExitCodeAdvisory is returned when advisory findings fail the run (--fail-on advisory).
+	ExitCodeAdvisory = 2
+
+	// ExitCodeBlocking is returned when blocking findings fail the run.
+	ExitCodeBlocking = 3
+
+	// ExitCodeError is returned when the run could not be carried out, e.g. the cluster is
+	// unreachable or the flags are invalid.
+	ExitCodeError = 4
+
+	// ExitCodePartial is returned when the run completed but some of its checks or
+	// migrations failed, so its results are incomplete.
+	ExitCodePartial = 5
+)
+
+// ExitError is an error determining the exit code of the CLI.
+type ExitError struct {
+	Code int
+	Err  error
+}
+
+// NewExitError returns an error exiting the CLI with code.
+func NewExitError(code int, err error) error {
+	return &ExitError{Code: code, Err: err}
+}
+
+func (e *ExitError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ExitError) Unwrap() error {
+	return e.Err
+}
+
+// ExitCode returns the exit code of a run that returned err: ExitCodeClean for nil, the code
+// of an ExitError in its chain, or ExitCodeError.
+func ExitCode(err error) int {
+	if err == nil {
+		return ExitCodeClean
+	}
+
+	var exitErr *ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.Code
+	}
+
+	return ExitCodeError
+}
+
+// FailOn is the lowest impact of the findings failing a run.
+type FailOn string
+
+const (
+	// FailOnNone never fails a run on findings or check failures.
+	FailOnNone FailOn = "none"
+	// FailOnBlocking fails a run on blocking findings and check failures.
+	FailOnBlocking FailOn = "blocking"
+	// FailOnAdvisory fails a run on any finding and on check failures.
+	FailOnAdvisory FailOn = "advisory"
+)
+
+// NewFailOn returns the FailOn of the legacy --fail-on-critical and --fail-on-warning flags.
+func NewFailOn(critical bool, warning bool) FailOn {
+	switch {
+	case warning:
+		return FailOnAdvisory
+	case critical:
+		return FailOnBlocking
+	default:
+		return FailOnNone
+	}
+}
+
+// Flags returns the --fail-on-critical and --fail-on-warning values equivalent to f.
+func (f FailOn) Flags() (bool, bool) {
+	return f != FailOnNone, f == FailOnAdvisory
+}
+
+// ParseFailOn parses a --fail-on value.
+func ParseFailOn(v string) (FailOn, error) {
+	switch FailOn(v) {
+	case FailOnNone, FailOnBlocking, FailOnAdvisory:
+		return FailOn(v), nil
+	default:
+		return "", fmt.Errorf("invalid --fail-on value %q (must be '%s', '%s' or '%s')",
+			v, FailOnNone, FailOnBlocking, FailOnAdvisory)
+	}
+}
+
+// FailOnVar defines a --fail-on flag setting critical and warning, the values of the legacy
+// --fail-on-critical and --fail-on-warning flags. Its default is the FailOn of their values.
+func FailOnVar(fs *pflag.FlagSet, critical *bool, warning *bool, usage string) {
+	fs.Var(&failOnValue{critical: critical, warning: warning}, "fail-on", usage)
+}
+
+// failOnValue implements pflag.Value over the legacy fail-on flags.
+type failOnValue struct {
+	critical *bool
+	warning  *bool
+}
+
+func (v *failOnValue) String() string {
+	return string(NewFailOn(*v.critical, *v.warning))
+}
+
+func (v *failOnValue) Set(s string) error {
+	failOn, err := ParseFailOn(s)
+	if err != nil {
+		return err
+	}
+
+	*v.critical, *v.warning = failOn.Flags()
+
+	return nil
+}
+
+func (v *failOnValue) Type() string {
+	return "FailOn"
+}
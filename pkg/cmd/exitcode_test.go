@@ -0,0 +1,36 @@
+package cmd_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestExitCode(t *testing.T) {
+	g := NewWithT(t)
+
+	blocking := cmd.NewExitError(cmd.ExitCodeBlocking, errors.New("blocking findings detected"))
+
+	g.Expect(cmd.ExitCode(nil)).To(Equal(cmd.ExitCodeClean))
+	g.Expect(cmd.ExitCode(errors.New("connection refused"))).To(Equal(cmd.ExitCodeError))
+	g.Expect(cmd.ExitCode(blocking)).To(Equal(cmd.ExitCodeBlocking))
+	g.Expect(cmd.ExitCode(fmt.Errorf("lint: %w", blocking))).To(Equal(cmd.ExitCodeBlocking))
+	g.Expect(blocking).To(MatchError("blocking findings detected"))
+}
+
+func TestFailOn(t *testing.T) {
+	g := NewWithT(t)
+
+	for _, failOn := range []cmd.FailOn{cmd.FailOnNone, cmd.FailOnBlocking, cmd.FailOnAdvisory} {
+		parsed, err := cmd.ParseFailOn(string(failOn))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(cmd.NewFailOn(parsed.Flags())).To(Equal(failOn))
+	}
+
+	_, err := cmd.ParseFailOn("warning")
+	g.Expect(err).To(MatchError(ContainSubstring(`invalid --fail-on value "warning"`)))
+}
@@ -0,0 +1,170 @@
+package doctor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+)
+
+var _ cmd.Command = (*HealthCommand)(nil)
+
+// DefaultHealthTimeout is the default timeout of doctor health.
+const DefaultHealthTimeout = 2 * time.Minute
+
+// HealthCommand runs the runtime health checks of the deployed components: pod readiness of
+// the component Deployments, DataScienceCluster and DSCInitialization status conditions,
+// crash looping pods, webhook certificate expiry and Route admission.
+type HealthCommand struct {
+	IO iostreams.Interface
+
+	// ConfigFlags provides access to kubeconfig and context.
+	ConfigFlags *genericclioptions.ConfigFlags
+
+	// Client is the Kubernetes client. Created from ConfigFlags when nil.
+	Client client.Client
+
+	// OutputFormat specifies the output format (table, json, yaml).
+	OutputFormat OutputFormat
+
+	// CheckSelectors selects the health checks to run.
+	CheckSelectors []string
+
+	// Verbose lists the impacted objects after the table.
+	Verbose bool
+
+	// Timeout is the maximum duration of the run.
+	Timeout time.Duration
+
+	registry *check.CheckRegistry
+}
+
+// NewHealthCommand creates a new HealthCommand over all health checks.
+func NewHealthCommand(
+	streams genericiooptions.IOStreams,
+	configFlags *genericclioptions.ConfigFlags,
+) *HealthCommand {
+	return &HealthCommand{
+		IO:             iostreams.NewIOStreams(streams.In, streams.Out, streams.ErrOut),
+		ConfigFlags:    configFlags,
+		OutputFormat:   OutputFormatTable,
+		CheckSelectors: []string{"*"},
+		Timeout:        DefaultHealthTimeout,
+		registry:       NewHealthRegistry(),
+	}
+}
+
+// AddFlags registers command-specific flags with the provided FlagSet.
+func (c *HealthCommand) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVarP((*string)(&c.OutputFormat), "output", "o", string(OutputFormatTable), flagDescHealthOutput)
+	fs.StringArrayVar(&c.CheckSelectors, "checks", []string{"*"}, flagDescHealthChecks)
+	fs.BoolVarP(&c.Verbose, "verbose", "v", false, flagDescHealthVerbose)
+	fs.DurationVar(&c.Timeout, "timeout", c.Timeout, flagDescHealthTimeout)
+}
+
+// Complete creates the Kubernetes client.
+func (c *HealthCommand) Complete() error {
+	if c.Client != nil {
+		return nil
+	}
+
+	cl, err := client.NewClient(c.ConfigFlags)
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	c.Client = cl
+
+	return nil
+}
+
+// Validate checks that all required options are valid.
+func (c *HealthCommand) Validate() error {
+	switch c.OutputFormat {
+	case OutputFormatTable, OutputFormatJSON, OutputFormatYAML:
+	default:
+		return fmt.Errorf("invalid output format: %s (must be one of: table, json, yaml)", c.OutputFormat)
+	}
+
+	if c.Timeout <= 0 {
+		return errors.New("--timeout must be positive")
+	}
+
+	return lint.ValidateCheckSelectors(c.CheckSelectors)
+}
+
+// Run executes the selected health checks and writes their results. Failing checks fail the
+// run with cmd.ExitCodeBlocking, checks that could not be executed with cmd.ExitCodePartial.
+func (c *HealthCommand) Run(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+
+	target := check.Target{
+		Client: client.NewCachingReader(c.Client),
+		IO:     c.IO,
+	}
+
+	executor := check.NewExecutor(c.registry, c.IO)
+
+	results, err := executor.ExecuteSelective(ctx, target, c.CheckSelectors, check.GroupHealth)
+	if err != nil {
+		return fmt.Errorf("executing health checks: %w", err)
+	}
+
+	switch c.OutputFormat {
+	case OutputFormatJSON:
+		err = lint.OutputJSON(c.IO.Out(), results, nil, nil)
+	case OutputFormatYAML:
+		err = lint.OutputYAML(c.IO.Out(), results, nil, nil)
+	default:
+		err = lint.OutputTable(c.IO.Out(), results, lint.TableOutputOptions{ShowImpactedObjects: c.Verbose})
+	}
+
+	if err != nil {
+		return fmt.Errorf("writing health report: %w", err)
+	}
+
+	return healthExitError(results)
+}
+
+// healthExitError returns the error determining the exit code of a doctor health run.
+func healthExitError(results []check.CheckExecution) error {
+	var unhealthy, failed int
+
+	for _, exec := range results {
+		if exec.Error != nil {
+			failed++
+
+			continue
+		}
+
+		for _, condition := range exec.Result.Status.Conditions {
+			if condition.Impact == result.ImpactBlocking {
+				unhealthy++
+
+				break
+			}
+		}
+	}
+
+	switch {
+	case unhealthy > 0:
+		return cmd.NewExitError(cmd.ExitCodeBlocking, fmt.Errorf("%d of %d health check(s) failed", unhealthy, len(results)))
+	case failed > 0:
+		return cmd.NewExitError(cmd.ExitCodePartial, fmt.Errorf("%d health check(s) failed to execute", failed))
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,96 @@
+package doctor_test
+
+import (
+	"bytes"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/doctor"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+// newHealthCommand returns a command running the platform and deployment health checks against
+// a DataScienceCluster with the given status conditions.
+func newHealthCommand(t *testing.T, out *bytes.Buffer, conditions ...any) *doctor.HealthCommand {
+	t.Helper()
+
+	dsc := testutil.NewDSC(map[string]string{"dashboard": "Managed"})
+	_ = unstructured.SetNestedSlice(dsc.Object, conditions, "status", "conditions")
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: map[schema.GroupVersionResource]string{
+			resources.DataScienceCluster.GVR(): resources.DataScienceCluster.ListKind(),
+			resources.DSCInitialization.GVR():  resources.DSCInitialization.ListKind(),
+			resources.Deployment.GVR():         resources.Deployment.ListKind(),
+		},
+		Objects: []*unstructured.Unstructured{dsc, testutil.NewDSCI("redhat-ods-applications")},
+	})
+
+	streams := genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: out, ErrOut: &bytes.Buffer{}}
+
+	command := doctor.NewHealthCommand(streams, nil)
+	command.Client, _ = target.Client.(client.Client)
+	command.CheckSelectors = []string{"health.platform.*", "health.deployment.*"}
+
+	return command
+}
+
+func TestHealthCommand(t *testing.T) {
+	t.Run("should pass on a healthy platform", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out bytes.Buffer
+
+		command := newHealthCommand(t, &out, map[string]any{"type": "Ready", "status": "True"})
+
+		g.Expect(command.Complete()).To(Succeed())
+		g.Expect(command.Validate()).To(Succeed())
+		g.Expect(command.Run(t.Context())).To(Succeed())
+		g.Expect(out.String()).To(ContainSubstring("report no failing status conditions"))
+		g.Expect(out.String()).To(ContainSubstring("Passed: 2"))
+	})
+
+	t.Run("should fail with the blocking exit code on failing checks", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out bytes.Buffer
+
+		command := newHealthCommand(t, &out,
+			map[string]any{"type": "Ready", "status": "False", "reason": "Error", "message": "Some components are not ready"})
+		command.OutputFormat = doctor.OutputFormatJSON
+
+		g.Expect(command.Complete()).To(Succeed())
+		g.Expect(command.Validate()).To(Succeed())
+
+		err := command.Run(t.Context())
+		g.Expect(err).To(MatchError("1 of 2 health check(s) failed"))
+		g.Expect(cmd.ExitCode(err)).To(Equal(cmd.ExitCodeBlocking))
+		g.Expect(out.String()).To(ContainSubstring("Ready=False (Error: Some components are not ready)"))
+	})
+
+	t.Run("should reject an invalid check selector", func(t *testing.T) {
+		g := NewWithT(t)
+
+		command := newHealthCommand(t, &bytes.Buffer{})
+		command.CheckSelectors = []string{"health.[pod"}
+
+		g.Expect(command.Validate()).To(HaveOccurred())
+	})
+
+	t.Run("should reject a non-positive timeout", func(t *testing.T) {
+		g := NewWithT(t)
+
+		command := newHealthCommand(t, &bytes.Buffer{})
+		command.Timeout = 0
+
+		g.Expect(command.Validate()).To(MatchError("--timeout must be positive"))
+	})
+}
@@ -0,0 +1,270 @@
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/action"
+	printerjson "github.com/opendatahub-io/odh-cli/pkg/printer/json"
+	"github.com/opendatahub-io/odh-cli/pkg/printer/table"
+	printeryaml "github.com/opendatahub-io/odh-cli/pkg/printer/yaml"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+)
+
+var _ cmd.Command = (*PermissionsCommand)(nil)
+
+// OutputFormat is the output format of doctor permissions.
+type OutputFormat string
+
+const (
+	OutputFormatTable OutputFormat = "table"
+	OutputFormatJSON  OutputFormat = "json"
+	OutputFormatYAML  OutputFormat = "yaml"
+)
+
+// PermissionStatus is the outcome of the access review of a permission.
+type PermissionStatus struct {
+	Verb        string   `json:"verb"`
+	Group       string   `json:"group,omitempty"`
+	Resource    string   `json:"resource"`
+	Subresource string   `json:"subresource,omitempty"`
+	Status      string   `json:"status"`
+	Reason      string   `json:"reason,omitempty"`
+	RequiredBy  []string `json:"requiredBy"`
+}
+
+// PermissionsReport is the result of doctor permissions.
+type PermissionsReport struct {
+	Permissions []PermissionStatus `json:"permissions"`
+	Granted     int                `json:"granted"`
+	Denied      int                `json:"denied"`
+}
+
+// PermissionsCommand evaluates, with SelfSubjectAccessReviews, whether the current user has
+// the permissions the registered checks and migrations need.
+type PermissionsCommand struct {
+	IO iostreams.Interface
+
+	// ConfigFlags provides access to kubeconfig and context.
+	ConfigFlags *genericclioptions.ConfigFlags
+
+	// Access reviews the permissions. Created from ConfigFlags when nil.
+	Access client.AccessReviewer
+
+	// OutputFormat specifies the output format (table, json, yaml).
+	OutputFormat OutputFormat
+
+	// CheckSelectors selects the checks whose permissions are evaluated.
+	CheckSelectors []string
+
+	// Migrations is a glob pattern selecting the migrations whose permissions are evaluated.
+	Migrations string
+
+	checks     *check.CheckRegistry
+	migrations *action.ActionRegistry
+}
+
+// NewPermissionsCommand creates a new PermissionsCommand over all lint and health checks and
+// migrations.
+func NewPermissionsCommand(
+	streams genericiooptions.IOStreams,
+	configFlags *genericclioptions.ConfigFlags,
+) *PermissionsCommand {
+	return &PermissionsCommand{
+		IO:             iostreams.NewIOStreams(streams.In, streams.Out, streams.ErrOut),
+		ConfigFlags:    configFlags,
+		OutputFormat:   OutputFormatTable,
+		CheckSelectors: []string{"*"},
+		Migrations:     "*",
+		checks:         newPermissionsRegistry(),
+		migrations:     migrate.NewRegistry(),
+	}
+}
+
+// newPermissionsRegistry creates a check registry populated with the lint and health checks.
+func newPermissionsRegistry() *check.CheckRegistry {
+	registry := lint.NewRegistry()
+
+	for _, chk := range NewHealthRegistry().ListAll() {
+		registry.MustRegister(chk)
+	}
+
+	return registry
+}
+
+// AddFlags registers command-specific flags with the provided FlagSet.
+func (c *PermissionsCommand) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVarP((*string)(&c.OutputFormat), "output", "o", string(OutputFormatTable), flagDescPermissionsOutput)
+	fs.StringArrayVar(&c.CheckSelectors, "checks", []string{"*"}, flagDescPermissionsChecks)
+	fs.StringVar(&c.Migrations, "migrations", "*", flagDescPermissionsMigrations)
+}
+
+// Complete creates the access reviewer.
+func (c *PermissionsCommand) Complete() error {
+	if c.Access != nil {
+		return nil
+	}
+
+	cl, err := client.NewClient(c.ConfigFlags)
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	c.Access = cl.Access()
+
+	return nil
+}
+
+// Validate checks that all required options are valid.
+func (c *PermissionsCommand) Validate() error {
+	switch c.OutputFormat {
+	case OutputFormatTable, OutputFormatJSON, OutputFormatYAML:
+	default:
+		return fmt.Errorf("invalid output format: %s (must be one of: table, json, yaml)", c.OutputFormat)
+	}
+
+	return lint.ValidateCheckSelectors(c.CheckSelectors)
+}
+
+// Run reviews every permission required by the selected checks and migrations and writes
+// the outcome. Denied permissions fail the run with cmd.ExitCodeBlocking.
+func (c *PermissionsCommand) Run(ctx context.Context) error {
+	required, err := c.requiredPermissions()
+	if err != nil {
+		return err
+	}
+
+	report := PermissionsReport{Permissions: make([]PermissionStatus, 0, len(required))}
+
+	for _, permission := range slices.SortedFunc(maps.Keys(required), resources.ComparePermissions) {
+		allowed, reason, err := c.Access.Review(ctx, permission)
+		if err != nil {
+			return err
+		}
+
+		status := StatusGranted
+		if allowed {
+			report.Granted++
+		} else {
+			status = StatusDenied
+			report.Denied++
+		}
+
+		report.Permissions = append(report.Permissions, PermissionStatus{
+			Verb:        permission.Verb,
+			Group:       permission.Group,
+			Resource:    permission.Resource,
+			Subresource: permission.Subresource,
+			Status:      status,
+			Reason:      reason,
+			RequiredBy:  required[permission],
+		})
+	}
+
+	switch c.OutputFormat {
+	case OutputFormatJSON:
+		err = printerjson.NewRenderer(printerjson.WithWriter[PermissionsReport](c.IO.Out())).Render(report)
+	case OutputFormatYAML:
+		err = printeryaml.NewRenderer(printeryaml.WithWriter[PermissionsReport](c.IO.Out())).Render(report)
+	default:
+		err = c.outputTable(report)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if report.Denied > 0 {
+		return cmd.NewExitError(cmd.ExitCodeBlocking,
+			fmt.Errorf("%d of %d permissions denied", report.Denied, len(report.Permissions)))
+	}
+
+	return nil
+}
+
+// requiredPermissions maps each permission required by the selected checks and migrations to
+// the IDs of the checks and migrations requiring it, in ID order.
+func (c *PermissionsCommand) requiredPermissions() (map[resources.Permission][]string, error) {
+	checks, err := c.checks.ListByPatterns(c.CheckSelectors, "")
+	if err != nil {
+		return nil, fmt.Errorf("selecting checks: %w", err)
+	}
+
+	actions, err := c.migrations.ListByPattern(c.Migrations, "")
+	if err != nil {
+		return nil, fmt.Errorf("selecting migrations: %w", err)
+	}
+
+	required := make(map[resources.Permission][]string)
+
+	add := func(id string, permissions []resources.Permission) {
+		for _, permission := range resources.UniquePermissions(permissions) {
+			required[permission] = append(required[permission], id)
+		}
+	}
+
+	for _, chk := range checks {
+		if requirer, ok := chk.(check.PermissionRequirer); ok {
+			add(chk.ID(), requirer.RequiredPermissions())
+		}
+	}
+
+	for _, act := range actions {
+		if requirer, ok := act.(action.PermissionRequirer); ok {
+			add(act.ID(), requirer.RequiredPermissions())
+		}
+	}
+
+	return required, nil
+}
+
+// permissionRow is a single row of the permissions table.
+type permissionRow struct {
+	Verb       string `mapstructure:"VERB"`
+	Resource   string `mapstructure:"RESOURCE"`
+	Status     string `mapstructure:"STATUS"`
+	RequiredBy string `mapstructure:"REQUIRED BY"`
+}
+
+func (c *PermissionsCommand) outputTable(report PermissionsReport) error {
+	renderer := table.NewRenderer(
+		table.WithWriter[permissionRow](c.IO.Out()),
+		table.WithHeaders[permissionRow]("VERB", "RESOURCE", "STATUS", "REQUIRED BY"),
+		table.WithTableOptions[permissionRow](table.DefaultTableOptions...),
+	)
+
+	for _, p := range report.Permissions {
+		permission := resources.Permission{Group: p.Group, Resource: p.Resource, Subresource: p.Subresource}
+
+		if err := renderer.Append(permissionRow{
+			Verb:       p.Verb,
+			Resource:   permission.GroupResource(),
+			Status:     p.Status,
+			RequiredBy: strings.Join(p.RequiredBy, ", "),
+		}); err != nil {
+			return fmt.Errorf("appending permission row: %w", err)
+		}
+	}
+
+	if err := renderer.Render(); err != nil {
+		return fmt.Errorf("rendering permissions table: %w", err)
+	}
+
+	c.IO.Fprintf("\n%d granted, %d denied", report.Granted, report.Denied)
+
+	return nil
+}
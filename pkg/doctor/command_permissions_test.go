@@ -0,0 +1,103 @@
+package doctor_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	kubernetesfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/doctor"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+// newPermissionsCommand returns a command whose access reviews deny deleting Secrets.
+func newPermissionsCommand(out *bytes.Buffer) *doctor.PermissionsCommand {
+	clientset := kubernetesfake.NewClientset()
+	clientset.PrependReactor("create", "selfsubjectaccessreviews",
+		func(action k8stesting.Action) (bool, runtime.Object, error) {
+			review, _ := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+
+			attributes := review.Spec.ResourceAttributes
+			review.Status.Allowed = attributes.Verb != "delete" || attributes.Resource != "secrets"
+
+			return true, review, nil
+		})
+
+	streams := genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: out, ErrOut: &bytes.Buffer{}}
+
+	command := doctor.NewPermissionsCommand(streams, nil)
+	command.Access = client.NewForTesting(client.TestClientConfig{Kubernetes: clientset}).Access()
+	command.CheckSelectors = []string{"components.codeflare.removal"}
+	command.Migrations = "ray.refresh-certs.migrate"
+
+	return command
+}
+
+func TestPermissionsCommand(t *testing.T) {
+	t.Run("should report denied permissions and fail", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out bytes.Buffer
+
+		command := newPermissionsCommand(&out)
+		command.OutputFormat = doctor.OutputFormatJSON
+
+		g.Expect(command.Complete()).To(Succeed())
+		g.Expect(command.Validate()).To(Succeed())
+
+		err := command.Run(t.Context())
+		g.Expect(err).To(MatchError("1 of 13 permissions denied"))
+		g.Expect(cmd.ExitCode(err)).To(Equal(cmd.ExitCodeBlocking))
+
+		var report doctor.PermissionsReport
+		g.Expect(json.Unmarshal(out.Bytes(), &report)).To(Succeed())
+		g.Expect(report.Granted).To(Equal(12))
+		g.Expect(report.Denied).To(Equal(1))
+		g.Expect(report.Permissions).To(ContainElement(doctor.PermissionStatus{
+			Verb:       "delete",
+			Resource:   "secrets",
+			Status:     doctor.StatusDenied,
+			RequiredBy: []string{"ray.refresh-certs.migrate"},
+		}))
+		g.Expect(report.Permissions).To(ContainElement(doctor.PermissionStatus{
+			Verb:       "update",
+			Group:      "datasciencecluster.opendatahub.io",
+			Resource:   "datascienceclusters",
+			Status:     doctor.StatusGranted,
+			RequiredBy: []string{"components.codeflare.removal"},
+		}))
+	})
+
+	t.Run("should render a table", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out bytes.Buffer
+
+		command := newPermissionsCommand(&out)
+		command.Migrations = "none"
+
+		g.Expect(command.Complete()).To(Succeed())
+		g.Expect(command.Run(t.Context())).To(Succeed())
+		g.Expect(out.String()).To(ContainSubstring("REQUIRED BY"))
+		g.Expect(out.String()).To(ContainSubstring("datascienceclusters.datasciencecluster.opendatahub.io"))
+		g.Expect(out.String()).To(ContainSubstring("components.codeflare.removal"))
+		g.Expect(out.String()).To(ContainSubstring("granted, 0 denied"))
+	})
+
+	t.Run("should reject an invalid output format", func(t *testing.T) {
+		g := NewWithT(t)
+
+		command := newPermissionsCommand(&bytes.Buffer{})
+		command.OutputFormat = "xml"
+
+		g.Expect(command.Validate()).To(MatchError(ContainSubstring("invalid output format")))
+	})
+}
@@ -0,0 +1,22 @@
+package doctor
+
+// Flag descriptions for the doctor permissions command.
+const (
+	flagDescPermissionsOutput     = "output format (table|json|yaml)"
+	flagDescPermissionsChecks     = "evaluate the permissions of the checks matching these selectors, as in 'lint --checks' (repeatable)"
+	flagDescPermissionsMigrations = "evaluate the permissions of the migrations whose ID matches this glob pattern"
+)
+
+// Flag descriptions for the doctor health command.
+const (
+	flagDescHealthOutput  = "output format (table|json|yaml)"
+	flagDescHealthChecks  = "run the health checks matching these selectors, e.g. 'health.pod.*' (repeatable)"
+	flagDescHealthVerbose = "list the impacted objects after the table"
+	flagDescHealthTimeout = "operation timeout (e.g., 1m, 5m)"
+)
+
+// Statuses of an evaluated permission.
+const (
+	StatusGranted = "granted"
+	StatusDenied  = "denied"
+)
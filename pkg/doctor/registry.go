@@ -0,0 +1,25 @@
+package doctor
+
+import (
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/health/deployment"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/health/platform"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/health/pod"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/health/route"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/health/webhook"
+)
+
+// NewHealthRegistry creates a check registry populated with the runtime health checks.
+// Shared by doctor health and doctor permissions.
+func NewHealthRegistry() *check.CheckRegistry {
+	registry := check.NewRegistry()
+
+	// Explicitly register all checks (no global state, full test isolation)
+	registry.MustRegister(deployment.NewCheck())
+	registry.MustRegister(platform.NewCheck())
+	registry.MustRegister(pod.NewCheck())
+	registry.MustRegister(route.NewCheck())
+	registry.MustRegister(webhook.NewCheck())
+
+	return registry
+}
@@ -0,0 +1,350 @@
+package lint
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/yaml"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/printer/table"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+)
+
+const (
+	// AnnotationAssignmentOwner is set on impacted objects to the owning team from --assignments.
+	AnnotationAssignmentOwner = "assignment.opendatahub.io/owner"
+
+	// AnnotationAssignmentDeadline is set on impacted objects to the remediation deadline from --assignments.
+	AnnotationAssignmentDeadline = "assignment.opendatahub.io/deadline"
+
+	// deadlineLayout is the date format of assignment deadlines.
+	deadlineLayout = time.DateOnly
+
+	// unassignedTeam is the rollup entry for impacted objects no assignment matched.
+	unassignedTeam = "(unassigned)"
+)
+
+// Assignment maps namespaces to the team that owns their remediation and its deadline.
+type Assignment struct {
+	// Team is the owning team.
+	Team string `json:"team"`
+
+	// Deadline is the remediation due date (YYYY-MM-DD). Optional.
+	Deadline string `json:"deadline,omitempty"`
+
+	// Namespaces lists namespace names or glob patterns (e.g. "team-a-*").
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// Selector is a label selector matched against namespace labels (e.g. "team=ml").
+	Selector string `json:"selector,omitempty"`
+
+	selector labels.Selector
+}
+
+// Assignments is the content of an --assignments file. The first matching assignment wins.
+type Assignments struct {
+	Assignments []Assignment `json:"assignments"`
+}
+
+// LoadAssignments reads and validates an assignments file.
+func LoadAssignments(file string) (*Assignments, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("reading assignments file: %w", err)
+	}
+
+	return ParseAssignments(data)
+}
+
+// ParseAssignments parses and validates assignments.
+func ParseAssignments(data []byte) (*Assignments, error) {
+	var a Assignments
+	if err := yaml.UnmarshalStrict(data, &a); err != nil {
+		return nil, fmt.Errorf("parsing assignments: %w", err)
+	}
+
+	for i := range a.Assignments {
+		entry := &a.Assignments[i]
+
+		if entry.Team == "" {
+			return nil, fmt.Errorf("assignment %d: team is required", i+1)
+		}
+
+		if len(entry.Namespaces) == 0 && entry.Selector == "" {
+			return nil, fmt.Errorf("assignment %d (%s): namespaces or selector is required", i+1, entry.Team)
+		}
+
+		if entry.Deadline != "" {
+			if _, err := time.Parse(deadlineLayout, entry.Deadline); err != nil {
+				return nil, fmt.Errorf("assignment %d (%s): invalid deadline %q: expected YYYY-MM-DD", i+1, entry.Team, entry.Deadline)
+			}
+		}
+
+		for _, pattern := range entry.Namespaces {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return nil, fmt.Errorf("assignment %d (%s): invalid namespace pattern %q: %w", i+1, entry.Team, pattern, err)
+			}
+		}
+
+		if entry.Selector != "" {
+			selector, err := labels.Parse(entry.Selector)
+			if err != nil {
+				return nil, fmt.Errorf("assignment %d (%s): invalid selector: %w", i+1, entry.Team, err)
+			}
+
+			entry.selector = selector
+		}
+	}
+
+	return &a, nil
+}
+
+// Match returns the first assignment matching the namespace name or labels, or nil.
+func (a *Assignments) Match(namespace string, namespaceLabels map[string]string) *Assignment {
+	for i := range a.Assignments {
+		entry := &a.Assignments[i]
+
+		for _, pattern := range entry.Namespaces {
+			if ok, _ := path.Match(pattern, namespace); ok {
+				return entry
+			}
+		}
+
+		if entry.selector != nil && entry.selector.Matches(labels.Set(namespaceLabels)) {
+			return entry
+		}
+	}
+
+	return nil
+}
+
+// Assign annotates impacted objects with the owner and deadline of their namespace's assignment.
+// Cluster-scoped objects are not assigned.
+func (a *Assignments) Assign(results []check.CheckExecution, namespaceLabels map[string]map[string]string) {
+	for _, exec := range results {
+		for i := range exec.Result.ImpactedObjects {
+			obj := &exec.Result.ImpactedObjects[i]
+			if obj.Namespace == "" {
+				continue
+			}
+
+			entry := a.Match(obj.Namespace, namespaceLabels[obj.Namespace])
+			if entry == nil {
+				continue
+			}
+
+			if obj.Annotations == nil {
+				obj.Annotations = make(map[string]string)
+			}
+
+			obj.Annotations[AnnotationAssignmentOwner] = entry.Team
+
+			if entry.Deadline != "" {
+				obj.Annotations[AnnotationAssignmentDeadline] = entry.Deadline
+			}
+		}
+	}
+}
+
+// TeamRollup summarizes the impacted objects assigned to a team.
+type TeamRollup struct {
+	Team       string
+	Deadline   string
+	Namespaces int
+	Objects    int
+	Blocking   int
+	Advisory   int
+}
+
+// BuildTeamRollup aggregates annotated impacted objects per team, sorted by deadline then team.
+// Objects without an owner are collected under an "(unassigned)" entry listed last.
+func BuildTeamRollup(results []check.CheckExecution) []TeamRollup {
+	byTeam := make(map[string]*TeamRollup)
+	namespaces := make(map[string]map[string]struct{})
+
+	for _, exec := range results {
+		impact := exec.Result.GetImpact()
+
+		for _, obj := range exec.Result.ImpactedObjects {
+			team := obj.Annotations[AnnotationAssignmentOwner]
+			if team == "" {
+				team = unassignedTeam
+			}
+
+			rollup, ok := byTeam[team]
+			if !ok {
+				rollup = &TeamRollup{Team: team, Deadline: obj.Annotations[AnnotationAssignmentDeadline]}
+				byTeam[team] = rollup
+				namespaces[team] = make(map[string]struct{})
+			}
+
+			rollup.Objects++
+
+			if impact != nil {
+				switch *impact {
+				case string(resultpkg.ImpactBlocking):
+					rollup.Blocking++
+				case string(resultpkg.ImpactAdvisory):
+					rollup.Advisory++
+				}
+			}
+
+			if obj.Namespace != "" {
+				namespaces[team][obj.Namespace] = struct{}{}
+			}
+		}
+	}
+
+	rollups := make([]TeamRollup, 0, len(byTeam))
+	for team, rollup := range byTeam {
+		rollup.Namespaces = len(namespaces[team])
+		rollups = append(rollups, *rollup)
+	}
+
+	sort.Slice(rollups, func(i, j int) bool {
+		a, b := rollups[i], rollups[j]
+
+		if (a.Team == unassignedTeam) != (b.Team == unassignedTeam) {
+			return b.Team == unassignedTeam
+		}
+
+		// Teams with a deadline come first, earliest deadline first.
+		if (a.Deadline == "") != (b.Deadline == "") {
+			return b.Deadline == ""
+		}
+
+		if a.Deadline != b.Deadline {
+			return a.Deadline < b.Deadline
+		}
+
+		return a.Team < b.Team
+	})
+
+	return rollups
+}
+
+// teamRollupRow is a single row of the per-team rollup table.
+type teamRollupRow struct {
+	Team       string `mapstructure:"TEAM"`
+	Deadline   string `mapstructure:"DEADLINE"`
+	Namespaces int    `mapstructure:"NAMESPACES"`
+	Objects    int    `mapstructure:"OBJECTS"`
+	Blocking   int    `mapstructure:"BLOCKING"`
+	Advisory   int    `mapstructure:"ADVISORY"`
+}
+
+// outputTeamRollup prints the per-team rollup section. Deadlines before now are flagged as overdue.
+func outputTeamRollup(out io.Writer, results []check.CheckExecution, now time.Time) error {
+	rollups := BuildTeamRollup(results)
+	if len(rollups) == 0 {
+		return nil
+	}
+
+	_, _ = fmt.Fprintln(out)
+	_, _ = fmt.Fprintln(out, "Remediation by Team:")
+
+	renderer := table.NewRenderer(
+		table.WithWriter[teamRollupRow](out),
+		table.WithHeaders[teamRollupRow]("TEAM", "DEADLINE", "NAMESPACES", "OBJECTS", "BLOCKING", "ADVISORY"),
+		table.WithTableOptions[teamRollupRow](table.DefaultTableOptions...),
+	)
+
+	today := now.Format(deadlineLayout)
+
+	for _, r := range rollups {
+		deadline := r.Deadline
+
+		switch {
+		case deadline == "":
+			deadline = "-"
+		case deadline < today:
+			deadline += " (overdue)"
+		}
+
+		row := teamRollupRow{
+			Team:       r.Team,
+			Deadline:   deadline,
+			Namespaces: r.Namespaces,
+			Objects:    r.Objects,
+			Blocking:   r.Blocking,
+			Advisory:   r.Advisory,
+		}
+
+		if err := renderer.Append(row); err != nil {
+			return fmt.Errorf("appending team rollup row: %w", err)
+		}
+	}
+
+	if err := renderer.Render(); err != nil {
+		return fmt.Errorf("rendering team rollup: %w", err)
+	}
+
+	return nil
+}
+
+// formatAssignment returns the owner/deadline suffix shown next to an assigned impacted object.
+func formatAssignment(annotations map[string]string) string {
+	owner := annotations[AnnotationAssignmentOwner]
+	if owner == "" {
+		return ""
+	}
+
+	parts := []string{"owner: " + owner}
+	if deadline := annotations[AnnotationAssignmentDeadline]; deadline != "" {
+		parts = append(parts, "due: "+deadline)
+	}
+
+	return " [" + strings.Join(parts, ", ") + "]"
+}
+
+// collectNamespaceLabels fetches the labels of each unique namespace referenced by impacted objects.
+// Namespaces that cannot be read are matched by name only.
+func collectNamespaceLabels(
+	ctx context.Context,
+	reader client.Reader,
+	results []check.CheckExecution,
+) map[string]map[string]string {
+	namespaceLabels := make(map[string]map[string]string)
+
+	for _, exec := range results {
+		for _, obj := range exec.Result.ImpactedObjects {
+			if obj.Namespace == "" {
+				continue
+			}
+
+			if _, done := namespaceLabels[obj.Namespace]; done {
+				continue
+			}
+
+			namespaceLabels[obj.Namespace] = nil
+
+			meta, err := reader.GetResourceMetadata(ctx, resources.Namespace, obj.Namespace)
+			if err != nil || meta == nil {
+				continue
+			}
+
+			namespaceLabels[obj.Namespace] = meta.Labels
+		}
+	}
+
+	return namespaceLabels
+}
+
+// applyAssignments annotates impacted objects with owners and deadlines when --assignments is set.
+func (c *Command) applyAssignments(ctx context.Context, results []check.CheckExecution) {
+	if c.assignments == nil {
+		return
+	}
+
+	c.assignments.Assign(results, collectNamespaceLabels(ctx, c.Reader, results))
+}
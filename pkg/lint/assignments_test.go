@@ -0,0 +1,147 @@
+package lint_test
+
+import (
+	"bytes"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+
+	. "github.com/onsi/gomega"
+)
+
+const testAssignments = `assignments:
+  - team: ml-platform
+    deadline: "2026-11-01"
+    namespaces: ["ml-*"]
+  - team: data-science
+    deadline: "2026-09-01"
+    selector: team=ds
+  - team: fallback
+    namespaces: ["*"]
+`
+
+func blockingCondition() result.Condition {
+	return result.Condition{
+		Condition: metav1.Condition{
+			Type:    "Compatible",
+			Status:  metav1.ConditionFalse,
+			Reason:  "Incompatible",
+			Message: "check failed",
+		},
+		Impact: result.ImpactBlocking,
+	}
+}
+
+func assignmentResults() []check.CheckExecution {
+	object := func(namespace string, name string) metav1.PartialObjectMetadata {
+		return metav1.PartialObjectMetadata{
+			TypeMeta:   metav1.TypeMeta{Kind: "Notebook", APIVersion: "kubeflow.org/v1"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		}
+	}
+
+	return []check.CheckExecution{{
+		Result: &result.DiagnosticResult{
+			Group: "workloads",
+			Kind:  "notebook",
+			Name:  "impacted-workloads",
+			Status: result.DiagnosticStatus{
+				Conditions: []result.Condition{blockingCondition()},
+			},
+			ImpactedObjects: []metav1.PartialObjectMetadata{
+				object("ml-train", "nb-1"),
+				object("ml-serve", "nb-2"),
+				object("analytics", "nb-3"),
+				object("other", "nb-4"),
+				{ObjectMeta: metav1.ObjectMeta{Name: "cluster-scoped"}},
+			},
+		},
+	}}
+}
+
+func TestParseAssignments_Validation(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{name: "missing team", data: "assignments:\n  - namespaces: [a]\n", wantErr: "team is required"},
+		{name: "no match criteria", data: "assignments:\n  - team: a\n", wantErr: "namespaces or selector is required"},
+		{name: "invalid deadline", data: "assignments:\n  - team: a\n    namespaces: [a]\n    deadline: next week\n", wantErr: "invalid deadline"},
+		{name: "invalid pattern", data: "assignments:\n  - team: a\n    namespaces: [\"[\"]\n", wantErr: "invalid namespace pattern"},
+		{name: "invalid selector", data: "assignments:\n  - team: a\n    selector: \"a in (\"\n", wantErr: "invalid selector"},
+		{name: "unknown field", data: "assignments:\n  - team: a\n    owner: b\n", wantErr: "unknown field"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			_, err := lint.ParseAssignments([]byte(tt.data))
+			g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+		})
+	}
+}
+
+func TestAssignments_Match(t *testing.T) {
+	g := NewWithT(t)
+
+	assignments, err := lint.ParseAssignments([]byte(testAssignments))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(assignments.Match("ml-train", nil).Team).To(Equal("ml-platform"))
+	g.Expect(assignments.Match("analytics", map[string]string{"team": "ds"}).Team).To(Equal("data-science"))
+	g.Expect(assignments.Match("analytics", nil).Team).To(Equal("fallback"))
+}
+
+func TestAssignments_AssignAndRollup(t *testing.T) {
+	g := NewWithT(t)
+
+	assignments, err := lint.ParseAssignments([]byte(testAssignments))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	results := assignmentResults()
+	assignments.Assign(results, map[string]map[string]string{
+		"analytics": {"team": "ds"},
+	})
+
+	objects := results[0].Result.ImpactedObjects
+	g.Expect(objects[0].Annotations).To(HaveKeyWithValue(lint.AnnotationAssignmentOwner, "ml-platform"))
+	g.Expect(objects[0].Annotations).To(HaveKeyWithValue(lint.AnnotationAssignmentDeadline, "2026-11-01"))
+	g.Expect(objects[2].Annotations).To(HaveKeyWithValue(lint.AnnotationAssignmentOwner, "data-science"))
+	g.Expect(objects[3].Annotations).To(HaveKeyWithValue(lint.AnnotationAssignmentOwner, "fallback"))
+	g.Expect(objects[3].Annotations).ToNot(HaveKey(lint.AnnotationAssignmentDeadline))
+	g.Expect(objects[4].Annotations).To(BeEmpty())
+
+	rollups := lint.BuildTeamRollup(results)
+	g.Expect(rollups).To(Equal([]lint.TeamRollup{
+		{Team: "data-science", Deadline: "2026-09-01", Namespaces: 1, Objects: 1, Blocking: 1},
+		{Team: "ml-platform", Deadline: "2026-11-01", Namespaces: 2, Objects: 2, Blocking: 2},
+		{Team: "fallback", Namespaces: 1, Objects: 1, Blocking: 1},
+		{Team: "(unassigned)", Objects: 1, Blocking: 1},
+	}))
+}
+
+func TestOutputTable_TeamRollup(t *testing.T) {
+	g := NewWithT(t)
+
+	assignments, err := lint.ParseAssignments([]byte(testAssignments))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	results := assignmentResults()
+	assignments.Assign(results, nil)
+
+	var buf bytes.Buffer
+	err = lint.OutputTable(&buf, results, lint.TableOutputOptions{ShowImpactedObjects: true, ShowTeamRollup: true})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	output := buf.String()
+	g.Expect(output).To(ContainSubstring("- nb-1 (Notebook) [owner: ml-platform, due: 2026-11-01]"))
+	g.Expect(output).To(ContainSubstring("- nb-4 (Notebook) [owner: fallback]"))
+	g.Expect(output).To(ContainSubstring("Remediation by Team:"))
+	g.Expect(output).To(ContainSubstring("ml-platform"))
+}
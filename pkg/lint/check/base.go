@@ -2,6 +2,8 @@ package check
 
 import (
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
 )
 
 // BaseCheck provides common check metadata and functionality through composition.
@@ -34,6 +36,29 @@ type BaseCheck struct {
 	CheckName        string
 	CheckDescription string
 	CheckRemediation string
+
+	// CheckResources lists the resource types the check reads. Optional; used to
+	// render the check dependency graph (lint graph).
+	CheckResources []resources.ResourceType
+
+	// CheckPermissions lists the permissions the check needs beyond reading CheckResources,
+	// such as the writes of its fix. Optional.
+	CheckPermissions []resources.Permission
+
+	// CheckVersionGate describes the version condition under which CanApply runs
+	// the check (e.g. check.VersionGateUpgrade2xTo3x). Empty means any version.
+	CheckVersionGate string
+
+	// CheckFlavors restricts the check to the listed management flavors (e.g. only the
+	// managed cloud service). Empty means any flavor.
+	CheckFlavors []version.Flavor
+
+	// CheckDocumentation is the structured documentation rendered by 'lint explain'.
+	CheckDocumentation Documentation
+
+	// CheckKnowledgeLinks are knowledge base (KCS) article IDs or documentation URLs support
+	// routes findings of the check to. A rules bundle may override them.
+	CheckKnowledgeLinks []string
 }
 
 // ID returns the unique identifier for this check.
@@ -77,6 +102,43 @@ func (b BaseCheck) CheckType() string {
 	return string(b.Type)
 }
 
+// Documentation returns the structured documentation of this check.
+// Required by check.Check interface.
+func (b BaseCheck) Documentation() Documentation {
+	return b.CheckDocumentation
+}
+
+// KnowledgeLinks returns the knowledge base article IDs or documentation URLs of this check.
+// Implements check.KnowledgeLinker.
+func (b BaseCheck) KnowledgeLinks() []string {
+	return b.CheckKnowledgeLinks
+}
+
+// RequiredResources returns the resource types this check reads.
+// Implements check.GraphDescriber.
+func (b BaseCheck) RequiredResources() []resources.ResourceType {
+	return b.CheckResources
+}
+
+// RequiredPermissions returns get and list on the resource types this check reads, and its
+// CheckPermissions.
+// Implements check.PermissionRequirer.
+func (b BaseCheck) RequiredPermissions() []resources.Permission {
+	return resources.UniquePermissions(append(resources.ReadPermissions(b.CheckResources...), b.CheckPermissions...))
+}
+
+// VersionGate returns the version condition under which this check applies.
+// Implements check.GraphDescriber.
+func (b BaseCheck) VersionGate() string {
+	return b.CheckVersionGate
+}
+
+// Flavors returns the management flavors this check is restricted to.
+// Implements check.FlavorGated.
+func (b BaseCheck) Flavors() []version.Flavor {
+	return b.CheckFlavors
+}
+
 // NewResult creates a DiagnosticResult initialized with this check's metadata.
 // This is the primary convenience method that eliminates result.New() boilerplate.
 //
@@ -4,9 +4,11 @@ import (
 	"context"
 
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
 )
 
-// CheckGroup classifies checks into logical groups (component, service, workload, dependency).
+// CheckGroup classifies checks into logical groups (component, service, workload, dependency,
+// health).
 type CheckGroup string
 
 const (
@@ -14,6 +16,10 @@ const (
 	GroupService    CheckGroup = "service"
 	GroupWorkload   CheckGroup = "workload"
 	GroupDependency CheckGroup = "dependency"
+
+	// GroupHealth holds the runtime health checks of doctor health. They are not registered
+	// with lint, so the group is not part of CanonicalGroupOrder.
+	GroupHealth CheckGroup = "health"
 )
 
 // CanonicalGroupOrder defines the execution order for check groups.
@@ -103,6 +109,10 @@ type Check interface {
 	// Used by validation builders to construct diagnostic results.
 	CheckType() string
 
+	// Documentation returns the structured documentation of the check: what it inspects,
+	// why it matters for the upgrade, example resources and remediation commands.
+	Documentation() Documentation
+
 	// CanApply returns whether this check should run given the check target context.
 	// The target provides access to:
 	// - CurrentVersion: the current cluster version (source for upgrades, nil for lint mode)
@@ -117,3 +127,23 @@ type Check interface {
 	// Returns DiagnosticResult following Kubernetes CR pattern with conditions
 	Validate(ctx context.Context, target Target) (*result.DiagnosticResult, error)
 }
+
+// GraphDescriber is optionally implemented by checks that declare their dependencies
+// for the check graph (lint graph). BaseCheck implements it from CheckResources and
+// CheckVersionGate, so embedding checks only need to populate those fields.
+type GraphDescriber interface {
+	// RequiredResources returns the resource types the check reads.
+	RequiredResources() []resources.ResourceType
+
+	// VersionGate returns a human-readable description of the version condition
+	// under which the check applies, or empty if it applies to any version.
+	VersionGate() string
+}
+
+// PermissionRequirer is optionally implemented by checks that declare the RBAC permissions
+// they need, evaluated by 'doctor permissions'. BaseCheck implements it from CheckResources,
+// which need get and list, and CheckPermissions.
+type PermissionRequirer interface {
+	// RequiredPermissions returns the permissions the check needs, cluster-wide.
+	RequiredPermissions() []resources.Permission
+}
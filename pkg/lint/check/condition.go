@@ -45,6 +45,15 @@ func WithRemediation(remediation string) ConditionOption {
 	}
 }
 
+// WithRemediationCommands sets machine-applicable shell commands that resolve the condition.
+// Commands are emitted verbatim by `lint --emit-remediation-script`, so they must be
+// safe to run unattended once the operator has confirmed them.
+func WithRemediationCommands(commands ...string) ConditionOption {
+	return func(c *result.Condition) {
+		c.RemediationCommands = append(c.RemediationCommands, commands...)
+	}
+}
+
 // deriveImpact derives the default impact from condition status.
 // Status=False and Status=Unknown both default to Advisory; checks that
 // truly block upgrades must explicitly opt in via WithImpact(result.ImpactBlocking).
@@ -12,6 +12,15 @@ const (
 	CheckTypeAcceleratorProfileMigration CheckType = "acceleratorprofile-migration"
 )
 
+// Version gates describing when checks apply, used by the check graph.
+const (
+	VersionGateUpgrade2xTo3x   = "upgrade 2.x -> 3.x"
+	VersionGateUpgradeWithin2x = "upgrade 2.x -> 2.y"
+	VersionGate3x              = "current or target 3.x"
+	VersionGateTarget33        = "target >= 3.3"
+	VersionGateUpgrade         = "any upgrade"
+)
+
 // Annotation keys used across multiple packages.
 const (
 	// AnnotationComponentManagementState is the management state for components.
@@ -22,4 +31,14 @@ const (
 
 	// AnnotationImpactedWorkloadCount is the count of impacted workloads.
 	AnnotationImpactedWorkloadCount = "workload.opendatahub.io/impacted-count"
+
+	// AnnotationClusterFlavor is the management flavor of the installation the check ran against.
+	AnnotationClusterFlavor = "platform.opendatahub.io/flavor"
+
+	// AnnotationImpactOverride is the impact override applied to the findings of the check by
+	// the lint configuration file.
+	AnnotationImpactOverride = "check.opendatahub.io/impact-override"
+
+	// AnnotationImpactReason is set by checks on impacted objects to why the object is impacted.
+	AnnotationImpactReason = "check.opendatahub.io/reason"
 )
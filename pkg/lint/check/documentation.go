@@ -0,0 +1,19 @@
+package check
+
+// Documentation is the structured documentation of a check, rendered by 'lint explain'.
+type Documentation struct {
+	// Inspects describes what the check reads and how it evaluates it.
+	Inspects string `json:"inspects" yaml:"inspects"`
+
+	// Rationale explains why the findings of the check matter for the upgrade.
+	Rationale string `json:"rationale" yaml:"rationale"`
+
+	// FailingExample is a YAML excerpt of a resource the check reports.
+	FailingExample string `json:"failingExample,omitempty" yaml:"failingExample,omitempty"`
+
+	// PassingExample is a YAML excerpt of the same resource once remediated.
+	PassingExample string `json:"passingExample,omitempty" yaml:"passingExample,omitempty"`
+
+	// RemediationCommands are commands that fix or investigate the findings.
+	RemediationCommands []string `json:"remediationCommands,omitempty" yaml:"remediationCommands,omitempty"`
+}
@@ -2,12 +2,21 @@ package check
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/util"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
 	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
 )
 
@@ -16,20 +25,98 @@ type CheckExecution struct {
 	Check  Check
 	Result *result.DiagnosticResult
 	Error  error
+
+	// Timing records the execution; it is also set on the Status of Result.
+	Timing *result.Timing
+
+	// target is the target the check was executed against, kept to retry the check.
+	target Target
 }
 
+// DefaultConcurrency is the default number of checks executed concurrently.
+const DefaultConcurrency = 4
+
+// ErrInvalidResult is wrapped by the error of a CheckExecution whose check returned a result
+// failing validation.
+var ErrInvalidResult = errors.New("invalid result")
+
 // Executor orchestrates check execution.
 type Executor struct {
 	registry *CheckRegistry
 	io       iostreams.Interface
+
+	// concurrency is the maximum number of checks executed concurrently.
+	concurrency int
+
+	// checkTimeout bounds the execution of each check; zero means no per-check bound.
+	checkTimeout time.Duration
+
+	// strict validates results with DiagnosticResult.ValidateStrict instead of Validate.
+	strict bool
+
+	// impactOverrides replaces the impact of the findings of checks, keyed by check ID.
+	impactOverrides map[string]ImpactOverride
+
+	// trace logs each Kubernetes API request made by a check.
+	trace bool
+}
+
+// ExecutorOption configures an Executor.
+type ExecutorOption = util.Option[Executor]
+
+// WithConcurrency sets the maximum number of checks executed concurrently.
+// Values lower than 1 execute checks sequentially.
+func WithConcurrency(n int) ExecutorOption {
+	return util.FunctionalOption[Executor](func(e *Executor) {
+		e.concurrency = max(n, 1)
+	})
+}
+
+// WithCheckTimeout bounds the execution (CanApply and Validate) of each check, so a single
+// slow check cannot use up the time of the whole run.
+func WithCheckTimeout(d time.Duration) ExecutorOption {
+	return util.FunctionalOption[Executor](func(e *Executor) {
+		e.checkTimeout = d
+	})
+}
+
+// WithStrictValidation validates check results with DiagnosticResult.ValidateStrict, so
+// results that would break serializers are reported as invalid instead of being output.
+// Strict validation is always enabled in tests.
+func WithStrictValidation() ExecutorOption {
+	return util.FunctionalOption[Executor](func(e *Executor) {
+		e.strict = true
+	})
+}
+
+// WithImpactOverrides replaces the impact of the findings of the listed checks, keyed by
+// check ID, after their results are validated.
+func WithImpactOverrides(overrides map[string]ImpactOverride) ExecutorOption {
+	return util.FunctionalOption[Executor](func(e *Executor) {
+		e.impactOverrides = overrides
+	})
+}
+
+// WithRequestTrace logs each Kubernetes API request made during a check, with the check ID,
+// status and latency, to debug slow runs.
+func WithRequestTrace() ExecutorOption {
+	return util.FunctionalOption[Executor](func(e *Executor) {
+		e.trace = true
+	})
 }
 
 // NewExecutor creates a new check executor.
-func NewExecutor(registry *CheckRegistry, io iostreams.Interface) *Executor {
-	return &Executor{
-		registry: registry,
-		io:       io,
+func NewExecutor(registry *CheckRegistry, io iostreams.Interface, opts ...ExecutorOption) *Executor {
+	e := &Executor{
+		registry:    registry,
+		io:          io,
+		concurrency: 1,
+		strict:      testing.Testing(),
 	}
+
+	util.ApplyOptions(e, opts...)
+
+	return e
 }
 
 // ExecuteAll runs all checks in the registry against the target
@@ -57,36 +144,126 @@ func (e *Executor) ExecuteSelective(
 	return e.executeChecks(ctx, target, checks), nil
 }
 
-// executeChecks runs the provided checks against the target sequentially.
+// executeChecks runs the provided checks against the target on a bounded worker pool.
+// Results are returned in the order of checks, regardless of completion order.
 func (e *Executor) executeChecks(ctx context.Context, target Target, checks []Check) []CheckExecution {
-	results := make([]CheckExecution, 0, len(checks))
+	slots := make([]*CheckExecution, len(checks))
+	sem := make(chan struct{}, max(e.concurrency, 1))
 
-	for _, check := range checks {
+	var wg sync.WaitGroup
+
+	for i, check := range checks {
 		// Check context before executing each check
 		if err := CheckContextError(ctx); err != nil {
 			// Context canceled or timed out - stop executing checks
 			break
 		}
 
-		// Filter by CanApply before executing
-		// Checks can use target.CurrentVersion, target.TargetVersion, or target.Client for filtering
-		canApply, err := check.CanApply(ctx, target)
-		if err != nil {
-			results = append(results, e.buildCanApplyError(check, err))
+		sem <- struct{}{}
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			slots[i] = e.runCheck(ctx, target, check)
+		}()
+	}
+
+	wg.Wait()
+
+	results := make([]CheckExecution, 0, len(checks))
+
+	for _, exec := range slots {
+		if exec != nil {
+			results = append(results, *exec)
+		}
+	}
+
+	return results
+}
 
-			continue
+// runCheck evaluates CanApply and executes a single check within the per-check timeout,
+// recording its timing. It returns nil when the check does not apply to the target.
+func (e *Executor) runCheck(ctx context.Context, target Target, check Check) *CheckExecution {
+	if !AppliesToFlavor(check, target.Flavor) {
+		return nil
+	}
+
+	if e.checkTimeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, e.checkTimeout)
+		defer cancel()
+	}
+
+	var apiCalls atomic.Int64
+
+	ctx = client.WithRequestObserver(ctx, e.observeRequest(check, &apiCalls))
+	startedAt := time.Now()
+
+	// Filter by CanApply before executing
+	// Checks can use target.CurrentVersion, target.TargetVersion, or target.Client for filtering
+	canApply, err := check.CanApply(ctx, target)
+	if err != nil {
+		exec := e.buildCanApplyError(check, err)
+		exec.target = target
+		applyKnowledgeLinks(exec.Result, check)
+		applyImpactOverride(exec.Result, e.impactOverrides[check.ID()])
+		applyTiming(&exec, startedAt, apiCalls.Load())
+
+		return &exec
+	}
+
+	if !canApply {
+		return nil
+	}
+
+	exec := e.executeCheck(ctx, target, check)
+	exec.target = target
+	applyKnowledgeLinks(exec.Result, check)
+	applyImpactOverride(exec.Result, e.impactOverrides[check.ID()])
+	applyTiming(&exec, startedAt, apiCalls.Load())
+
+	return &exec
+}
+
+// observeRequest returns the RequestObserver of the execution of a check: it counts the
+// requests and, with WithRequestTrace, logs them.
+func (e *Executor) observeRequest(check Check, apiCalls *atomic.Int64) client.RequestObserver {
+	return func(req *http.Request, status int, elapsed time.Duration, err error) {
+		apiCalls.Add(1)
+
+		if !e.trace || e.io == nil {
+			return
 		}
 
-		if !canApply {
-			continue
+		outcome := strconv.Itoa(status)
+		if err != nil {
+			outcome = err.Error()
 		}
 
-		// Execute check sequentially
-		exec := e.executeCheck(ctx, target, check)
-		results = append(results, exec)
+		e.io.Errorf("[trace] %s: %s %s -> %s (%s)",
+			check.ID(), req.Method, req.URL.RequestURI(), outcome, elapsed.Round(time.Millisecond))
 	}
+}
 
-	return results
+// applyTiming records the timing of an execution that started at startedAt and made
+// apiCalls Kubernetes API requests.
+func applyTiming(exec *CheckExecution, startedAt time.Time, apiCalls int64) {
+	finishedAt := time.Now()
+
+	exec.Timing = &result.Timing{
+		StartedAt:       startedAt.UTC(),
+		FinishedAt:      finishedAt.UTC(),
+		DurationSeconds: finishedAt.Sub(startedAt).Seconds(),
+		APICalls:        apiCalls,
+	}
+
+	if exec.Result != nil {
+		exec.Result.Status.Timing = exec.Timing
+	}
 }
 
 // buildCanApplyError creates a CheckExecution for a CanApply error.
@@ -147,6 +324,9 @@ func (e *Executor) executeCheck(ctx context.Context, target Target, check Check)
 		case apierrors.IsTimeout(err):
 			reason = ReasonCheckExecutionFailed
 			message = "Request timed out"
+		case errors.Is(err, context.DeadlineExceeded) || errors.Is(err, ErrCheckTimeout):
+			reason = ReasonCheckExecutionFailed
+			message = "Check execution timed out"
 		case apierrors.IsServiceUnavailable(err) || apierrors.IsServerTimeout(err):
 			reason = ReasonCheckExecutionFailed
 			message = "API server is unavailable or overloaded"
@@ -188,7 +368,12 @@ func (e *Executor) executeCheck(ctx context.Context, target Target, check Check)
 	}
 
 	// Validate the result
-	if err := checkResult.Validate(); err != nil {
+	validate := checkResult.Validate
+	if e.strict {
+		validate = checkResult.ValidateStrict
+	}
+
+	if err := validate(); err != nil {
 		invalidResult := result.New(
 			string(check.Group()),
 			check.CheckKind(),
@@ -207,10 +392,12 @@ func (e *Executor) executeCheck(ctx context.Context, target Target, check Check)
 		return CheckExecution{
 			Check:  check,
 			Result: invalidResult,
-			Error:  fmt.Errorf("invalid result from check %s: %w", check.ID(), err),
+			Error:  fmt.Errorf("%w from check %s: %w", ErrInvalidResult, check.ID(), err),
 		}
 	}
 
+	applyFlavor(checkResult, target.Flavor)
+
 	return CheckExecution{
 		Check:  check,
 		Result: checkResult,
@@ -169,6 +169,10 @@ func (c *benchmarkCheck) CheckType() string {
 	return "benchmark"
 }
 
+func (c *benchmarkCheck) Documentation() check.Documentation {
+	return check.Documentation{}
+}
+
 func (c *benchmarkCheck) CanApply(_ context.Context, _ check.Target) (bool, error) {
 	return true, nil // Always applicable
 }
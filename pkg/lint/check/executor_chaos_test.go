@@ -0,0 +1,186 @@
+//go:build chaos
+
+package check_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+// The tests in this file run the executor against a fake API server through the fault injection
+// transport, to verify that retries, partial results and timeouts hold up against a degraded
+// API server. Run them with `make chaos-test`.
+
+// listCheck passes when it can list its resource type.
+type listCheck struct {
+	check.BaseCheck
+
+	resourceType resources.ResourceType
+}
+
+func (c *listCheck) CanApply(_ context.Context, _ check.Target) (bool, error) {
+	return true, nil
+}
+
+func (c *listCheck) Validate(ctx context.Context, target check.Target) (*result.DiagnosticResult, error) {
+	if _, err := target.Client.List(ctx, c.resourceType); err != nil {
+		return nil, err
+	}
+
+	dr := c.NewResult()
+	dr.SetCondition(check.NewCondition(check.ConditionTypeValidated, metav1.ConditionTrue, check.WithReason(check.ReasonRequirementsMet)))
+
+	return dr, nil
+}
+
+func newListCheck(id string, resourceType resources.ResourceType) *listCheck {
+	return &listCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup: check.GroupComponent,
+			Kind:       id,
+			Type:       check.CheckTypeRemoval,
+			CheckID:    id,
+			CheckName:  id,
+		},
+		resourceType: resourceType,
+	}
+}
+
+// newChaosTarget returns a target whose client talks to an empty fake API server through the
+// given faults, and a counter of the requests that reached the server.
+func newChaosTarget(t *testing.T, spec string) (check.Target, *atomic.Int32) {
+	t.Helper()
+
+	var served atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		served.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"apiVersion":"v1","kind":"List","metadata":{},"items":[]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	cfg, err := client.ParseChaosConfig(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	restConfig := &rest.Config{Host: server.URL, QPS: client.DefaultQPS, Burst: client.DefaultBurst}
+	restConfig.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return client.NewChaosTransport(cfg, rt)
+	})
+
+	c, err := client.NewClientWithConfig(restConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return check.Target{Client: c}, &served
+}
+
+func newChaosExecutor(checks ...check.Check) *check.Executor {
+	registry := check.NewRegistry()
+	for _, chk := range checks {
+		registry.MustRegister(chk)
+	}
+
+	return check.NewExecutor(registry, nil, check.WithConcurrency(len(checks)), check.WithCheckTimeout(time.Second))
+}
+
+func TestChaos_ThrottlingRetriedByClient(t *testing.T) {
+	g := NewWithT(t)
+
+	target, served := newChaosTarget(t, "throttle=1,retry-after=0,limit=2,seed=1")
+	executor := newChaosExecutor(newListCheck("components.dsc", resources.DataScienceCluster))
+
+	executions := executor.ExecuteAll(t.Context(), target)
+
+	g.Expect(executions).To(HaveLen(1))
+	g.Expect(executions[0].Error).ToNot(HaveOccurred())
+	g.Expect(executions[0].Result.IsFailing()).To(BeFalse())
+	g.Expect(served.Load()).To(BeNumerically("==", 1))
+}
+
+func TestChaos_TransientFailureRecoveredByRetry(t *testing.T) {
+	g := NewWithT(t)
+
+	target, _ := newChaosTarget(t, "error=1,limit=1,seed=1")
+	executor := newChaosExecutor(newListCheck("components.dsc", resources.DataScienceCluster))
+
+	executions := executor.ExecuteAll(t.Context(), target)
+
+	g.Expect(executions).To(HaveLen(1))
+	g.Expect(check.IsTransient(executions[0].Error)).To(BeTrue())
+	g.Expect(executions[0].Result.Status.Conditions[0].Status).To(Equal(metav1.ConditionUnknown))
+
+	retried, recovered := executor.RetryUnknown(t.Context(), executions)
+
+	g.Expect(retried).To(Equal(1))
+	g.Expect(recovered).To(Equal(1))
+	g.Expect(executions[0].Result.IsFailing()).To(BeFalse())
+}
+
+func TestChaos_PartialFailuresKeepOtherResults(t *testing.T) {
+	g := NewWithT(t)
+
+	target, _ := newChaosTarget(t, "throttle=0.5,error=0.5,match=/dscinitializations,seed=1")
+	executor := newChaosExecutor(
+		newListCheck("components.dsc", resources.DataScienceCluster),
+		newListCheck("components.dsci", resources.DSCInitialization),
+	)
+
+	executions := executor.ExecuteAll(t.Context(), target)
+	g.Expect(executions).To(HaveLen(2))
+
+	retried, recovered := executor.RetryUnknown(t.Context(), executions)
+
+	g.Expect(retried).To(Equal(1))
+	g.Expect(recovered).To(BeZero())
+
+	byID := make(map[string]check.CheckExecution)
+	for _, exec := range executions {
+		byID[exec.Check.ID()] = exec
+	}
+
+	g.Expect(byID["components.dsc"].Result.IsFailing()).To(BeFalse())
+	g.Expect(check.IsTransient(byID["components.dsci"].Error)).To(BeTrue())
+	g.Expect(byID["components.dsci"].Result.Status.Conditions[0].Status).To(Equal(metav1.ConditionUnknown))
+}
+
+func TestChaos_LatencyBoundedByCheckTimeout(t *testing.T) {
+	g := NewWithT(t)
+
+	target, _ := newChaosTarget(t, "latency=1m,match=/dscinitializations")
+	executor := newChaosExecutor(
+		newListCheck("components.dsc", resources.DataScienceCluster),
+		newListCheck("components.dsci", resources.DSCInitialization),
+	)
+
+	start := time.Now()
+	executions := executor.ExecuteAll(t.Context(), target)
+
+	g.Expect(time.Since(start)).To(BeNumerically("<", 10*time.Second))
+	g.Expect(executions).To(HaveLen(2))
+
+	byID := make(map[string]check.CheckExecution)
+	for _, exec := range executions {
+		byID[exec.Check.ID()] = exec
+	}
+
+	g.Expect(byID["components.dsc"].Result.IsFailing()).To(BeFalse())
+	g.Expect(byID["components.dsci"].Result.Status.Conditions[0].Message).To(Equal("Check execution timed out"))
+}
@@ -0,0 +1,273 @@
+package check_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/rest"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+
+	. "github.com/onsi/gomega"
+)
+
+// slowCheck passes after a delay, tracking how many checks run at once.
+type slowCheck struct {
+	check.BaseCheck
+
+	delay    time.Duration
+	inFlight *atomic.Int32
+	peak     *atomic.Int32
+}
+
+func (c *slowCheck) CanApply(_ context.Context, _ check.Target) (bool, error) {
+	return true, nil
+}
+
+func (c *slowCheck) Validate(ctx context.Context, _ check.Target) (*result.DiagnosticResult, error) {
+	n := c.inFlight.Add(1)
+	defer c.inFlight.Add(-1)
+
+	for {
+		peak := c.peak.Load()
+		if n <= peak || c.peak.CompareAndSwap(peak, n) {
+			break
+		}
+	}
+
+	select {
+	case <-time.After(c.delay):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	dr := c.NewResult()
+	dr.SetCondition(check.NewCondition(check.ConditionTypeValidated, metav1.ConditionTrue, check.WithReason(check.ReasonRequirementsMet)))
+
+	return dr, nil
+}
+
+func newSlowChecks(delays ...time.Duration) []*slowCheck {
+	var inFlight, peak atomic.Int32
+
+	checks := make([]*slowCheck, 0, len(delays))
+
+	for i, delay := range delays {
+		id := "components.slow-" + string(rune('a'+i))
+		checks = append(checks, &slowCheck{
+			BaseCheck: check.BaseCheck{
+				CheckGroup: check.GroupComponent,
+				Kind:       id,
+				Type:       check.CheckTypeRemoval,
+				CheckID:    id,
+				CheckName:  id,
+			},
+			delay:    delay,
+			inFlight: &inFlight,
+			peak:     &peak,
+		})
+	}
+
+	return checks
+}
+
+func TestExecutor_ConcurrentExecutionKeepsOrder(t *testing.T) {
+	g := NewWithT(t)
+
+	// Earlier checks are slower, so they complete last.
+	checks := newSlowChecks(40*time.Millisecond, 30*time.Millisecond, 20*time.Millisecond, 10*time.Millisecond, 0)
+
+	registry := check.NewRegistry()
+	for _, chk := range checks {
+		registry.MustRegister(chk)
+	}
+
+	executor := check.NewExecutor(registry, nil, check.WithConcurrency(2))
+
+	executions, err := executor.ExecuteSelective(t.Context(), check.Target{}, []string{"*"}, check.GroupComponent)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	ids := make([]string, 0, len(executions))
+	for _, exec := range executions {
+		ids = append(ids, exec.Check.ID())
+	}
+
+	g.Expect(ids).To(Equal([]string{
+		"components.slow-a", "components.slow-b", "components.slow-c", "components.slow-d", "components.slow-e",
+	}))
+	g.Expect(checks[0].peak.Load()).To(BeNumerically("==", 2))
+}
+
+func TestExecutor_SequentialByDefault(t *testing.T) {
+	g := NewWithT(t)
+
+	checks := newSlowChecks(5*time.Millisecond, 5*time.Millisecond, 5*time.Millisecond)
+
+	registry := check.NewRegistry()
+	for _, chk := range checks {
+		registry.MustRegister(chk)
+	}
+
+	executions := check.NewExecutor(registry, nil).ExecuteAll(t.Context(), check.Target{})
+
+	g.Expect(executions).To(HaveLen(3))
+	g.Expect(checks[0].peak.Load()).To(BeNumerically("==", 1))
+}
+
+func TestExecutor_CheckTimeout(t *testing.T) {
+	g := NewWithT(t)
+
+	checks := newSlowChecks(time.Minute, 0)
+
+	registry := check.NewRegistry()
+	for _, chk := range checks {
+		registry.MustRegister(chk)
+	}
+
+	executor := check.NewExecutor(registry, nil, check.WithConcurrency(2), check.WithCheckTimeout(20*time.Millisecond))
+
+	executions := executor.ExecuteAll(t.Context(), check.Target{})
+	g.Expect(executions).To(HaveLen(2))
+
+	byID := make(map[string]check.CheckExecution)
+	for _, exec := range executions {
+		byID[exec.Check.ID()] = exec
+	}
+
+	timedOut := byID["components.slow-a"]
+	g.Expect(timedOut.Error).To(MatchError(context.DeadlineExceeded))
+	g.Expect(timedOut.Result.Status.Conditions[0].Status).To(Equal(metav1.ConditionUnknown))
+	g.Expect(timedOut.Result.Status.Conditions[0].Message).To(Equal("Check execution timed out"))
+
+	g.Expect(byID["components.slow-b"].Error).ToNot(HaveOccurred())
+}
+
+// untypedObjectCheck fails with an impacted object missing its TypeMeta, which Validate accepts.
+type untypedObjectCheck struct {
+	check.BaseCheck
+}
+
+func (c *untypedObjectCheck) CanApply(_ context.Context, _ check.Target) (bool, error) {
+	return true, nil
+}
+
+func (c *untypedObjectCheck) Validate(_ context.Context, _ check.Target) (*result.DiagnosticResult, error) {
+	dr := c.NewResult()
+	dr.SetCondition(check.NewCondition(check.ConditionTypeCompatible, metav1.ConditionFalse,
+		check.WithReason(check.ReasonWorkloadsImpacted),
+		check.WithImpact(result.ImpactBlocking)))
+	dr.ImpactedObjects = []metav1.PartialObjectMetadata{{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "obj"}}}
+
+	return dr, nil
+}
+
+func TestExecutor_StrictValidationInTests(t *testing.T) {
+	g := NewWithT(t)
+
+	registry := check.NewRegistry()
+	registry.MustRegister(&untypedObjectCheck{BaseCheck: check.BaseCheck{
+		CheckGroup: check.GroupWorkload,
+		Kind:       "untyped",
+		Type:       check.CheckTypeImpactedWorkloads,
+		CheckID:    "workloads.untyped.impacted-workloads",
+		CheckName:  "untyped",
+	}})
+
+	executions := check.NewExecutor(registry, nil).ExecuteAll(t.Context(), check.Target{})
+	g.Expect(executions).To(HaveLen(1))
+	g.Expect(executions[0].Error).To(MatchError(check.ErrInvalidResult))
+	g.Expect(executions[0].Error).To(MatchError(ContainSubstring("impacted object 0 (obj) must have apiVersion and kind set")))
+	g.Expect(executions[0].Result.Status.Conditions[0].Reason).To(Equal(check.ReasonCheckExecutionFailed))
+}
+
+// requestingCheck passes after sending requests to a Kubernetes API server.
+type requestingCheck struct {
+	check.BaseCheck
+
+	httpClient *http.Client
+	url        string
+	requests   int
+}
+
+func (c *requestingCheck) CanApply(_ context.Context, _ check.Target) (bool, error) {
+	return true, nil
+}
+
+func (c *requestingCheck) Validate(ctx context.Context, _ check.Target) (*result.DiagnosticResult, error) {
+	for range c.requests {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url+"/api/v1/pods", nil)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		_ = resp.Body.Close()
+	}
+
+	dr := c.NewResult()
+	dr.SetCondition(check.NewCondition(check.ConditionTypeValidated, metav1.ConditionTrue, check.WithReason(check.ReasonRequirementsMet)))
+
+	return dr, nil
+}
+
+func TestExecutor_Timing(t *testing.T) {
+	g := NewWithT(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	configFlags := genericclioptions.NewConfigFlags(false)
+	configFlags.APIServer = &server.URL
+
+	config, err := client.NewRESTConfig(configFlags, client.DefaultQPS, client.DefaultBurst)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	httpClient, err := rest.HTTPClientFor(config)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	registry := check.NewRegistry()
+	registry.MustRegister(&requestingCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup: check.GroupComponent,
+			Kind:       "requesting",
+			Type:       check.CheckTypeRemoval,
+			CheckID:    "components.requesting",
+			CheckName:  "requesting",
+		},
+		httpClient: httpClient,
+		url:        server.URL,
+		requests:   3,
+	})
+
+	var errOut bytes.Buffer
+
+	io := iostreams.NewIOStreams(nil, &bytes.Buffer{}, &errOut)
+	executions := check.NewExecutor(registry, io, check.WithRequestTrace()).ExecuteAll(t.Context(), check.Target{})
+
+	g.Expect(executions).To(HaveLen(1))
+
+	timing := executions[0].Timing
+	g.Expect(timing).ToNot(BeNil())
+	g.Expect(timing.APICalls).To(BeNumerically("==", 3))
+	g.Expect(timing.FinishedAt).ToNot(BeTemporally("<", timing.StartedAt))
+	g.Expect(timing.DurationSeconds).To(BeNumerically("~", timing.Duration().Seconds(), 1e-9))
+	g.Expect(executions[0].Result.Status.Timing).To(BeIdenticalTo(timing))
+
+	g.Expect(errOut.String()).To(ContainSubstring("[trace] components.requesting: GET /api/v1/pods -> 200"))
+}
@@ -0,0 +1,98 @@
+package check
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/components"
+)
+
+// Fixable is optionally implemented by checks whose remediation is mechanical and safe to apply
+// automatically (lint --fix), such as setting a component managementState on the
+// DataScienceCluster.
+type Fixable interface {
+	// Fix applies the remediation of a failing result of the check and returns the changes made.
+	// With target.DryRun, it returns the changes it would make without making them.
+	// Returning no changes means there is nothing to fix.
+	Fix(ctx context.Context, target FixTarget, dr *result.DiagnosticResult) ([]Change, error)
+}
+
+// FixTarget is the context a fix is applied in.
+type FixTarget struct {
+	// Client provides write access to the cluster.
+	Client client.Client
+
+	// DryRun reports the changes without making them.
+	DryRun bool
+}
+
+// Change is a single change made (or, in dry-run, planned) by a fix.
+type Change struct {
+	// Object identifies the changed object, e.g. "DataScienceCluster/default-dsc".
+	Object string
+
+	// Description describes the change, e.g. "spec.components.codeflare.managementState: Managed -> Removed".
+	Description string
+}
+
+func (c Change) String() string {
+	return c.Object + ": " + c.Description
+}
+
+// Revalidate executes an already executed check again against the same target, e.g. after its
+// findings were fixed. The second return value is false when the check no longer applies.
+func (e *Executor) Revalidate(ctx context.Context, exec CheckExecution) (CheckExecution, bool) {
+	results := e.executeChecks(ctx, exec.target, []Check{exec.Check})
+	if len(results) == 0 {
+		return exec, false
+	}
+
+	return results[0], true
+}
+
+// SetComponentManagementState sets .spec.components.<component>.managementState on the
+// DataScienceCluster. It returns no changes when the component is already in that state.
+func SetComponentManagementState(
+	ctx context.Context,
+	target FixTarget,
+	component string,
+	state string,
+) ([]Change, error) {
+	dsc, err := client.GetDataScienceCluster(ctx, target.Client)
+	if err != nil {
+		return nil, fmt.Errorf("getting DataScienceCluster: %w", err)
+	}
+
+	current, err := components.GetManagementState(dsc, component)
+	if err != nil {
+		return nil, fmt.Errorf("querying %s managementState: %w", component, err)
+	}
+
+	if current == state {
+		return nil, nil
+	}
+
+	change := Change{
+		Object:      resources.DataScienceCluster.Kind + "/" + dsc.GetName(),
+		Description: fmt.Sprintf("spec.components.%s.managementState: %s -> %s", component, current, state),
+	}
+
+	if target.DryRun {
+		return []Change{change}, nil
+	}
+
+	_, err = client.UpdateWithConflictRetry(ctx, target.Client, resources.DataScienceCluster.GVR(), dsc.GetName(),
+		func(obj *unstructured.Unstructured) error {
+			return unstructured.SetNestedField(obj.Object, state, "spec", "components", component, "managementState")
+		})
+	if err != nil {
+		return nil, fmt.Errorf("setting %s managementState to %s: %w", component, state, err)
+	}
+
+	return []Change{change}, nil
+}
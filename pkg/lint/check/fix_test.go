@@ -0,0 +1,87 @@
+package check_test
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/components"
+
+	. "github.com/onsi/gomega"
+)
+
+func newFixTarget(t *testing.T, states map[string]string, dryRun bool) check.FixTarget {
+	t.Helper()
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: map[schema.GroupVersionResource]string{
+			resources.DataScienceCluster.GVR(): resources.DataScienceCluster.ListKind(),
+		},
+		Objects: []*unstructured.Unstructured{testutil.NewDSC(states)},
+	})
+
+	c, ok := target.Client.(client.Client)
+	if !ok {
+		t.Fatal("test target client is not a client.Client")
+	}
+
+	return check.FixTarget{Client: c, DryRun: dryRun}
+}
+
+func managementState(t *testing.T, target check.FixTarget, component string) string {
+	t.Helper()
+
+	dsc, err := client.GetDataScienceCluster(t.Context(), target.Client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state, err := components.GetManagementState(dsc, component)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return state
+}
+
+func TestSetComponentManagementState(t *testing.T) {
+	g := NewWithT(t)
+
+	target := newFixTarget(t, map[string]string{"codeflare": "Managed"}, false)
+
+	changes, err := check.SetComponentManagementState(t.Context(), target, "codeflare", "Removed")
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(changes).To(HaveLen(1))
+	g.Expect(changes[0].String()).To(Equal(
+		"DataScienceCluster/default-dsc: spec.components.codeflare.managementState: Managed -> Removed"))
+	g.Expect(managementState(t, target, "codeflare")).To(Equal("Removed"))
+}
+
+func TestSetComponentManagementState_DryRun(t *testing.T) {
+	g := NewWithT(t)
+
+	target := newFixTarget(t, map[string]string{"codeflare": "Managed"}, true)
+
+	changes, err := check.SetComponentManagementState(t.Context(), target, "codeflare", "Removed")
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(changes).To(HaveLen(1))
+	g.Expect(managementState(t, target, "codeflare")).To(Equal("Managed"))
+}
+
+func TestSetComponentManagementState_AlreadySet(t *testing.T) {
+	g := NewWithT(t)
+
+	target := newFixTarget(t, map[string]string{"codeflare": "Removed"}, false)
+
+	changes, err := check.SetComponentManagementState(t.Context(), target, "codeflare", "Removed")
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(changes).To(BeEmpty())
+}
@@ -0,0 +1,107 @@
+package check
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+// addonRevertedRemediation is appended to the remediation of conditions whose remediation
+// commands were dropped on the managed cloud service.
+const addonRevertedRemediation = "On the managed cloud service, %s is reconciled by the OpenShift AI add-on and direct changes are reverted; " +
+	"request the change through a support case instead"
+
+// addonOwnedResources are the resources the OpenShift AI add-on reconciles on the managed
+// cloud service, so remediation commands changing them would be reverted.
+//
+//nolint:gochecknoglobals // Read-only lookup table
+var addonOwnedResources = []resources.ResourceType{
+	resources.DSCInitialization,
+}
+
+// FlavorGated is optionally implemented by checks restricted to some management flavors.
+// BaseCheck implements it from CheckFlavors.
+type FlavorGated interface {
+	// Flavors returns the flavors the check runs for; empty means any flavor.
+	Flavors() []version.Flavor
+}
+
+// AppliesToFlavor returns whether a check runs for the given management flavor.
+func AppliesToFlavor(check Check, flavor version.Flavor) bool {
+	gated, ok := check.(FlavorGated)
+	if !ok || len(gated.Flavors()) == 0 {
+		return true
+	}
+
+	return slices.Contains(gated.Flavors(), flavor)
+}
+
+// applyFlavor annotates a result with the management flavor and, on the managed cloud
+// service, drops remediation commands the add-on would revert.
+func applyFlavor(dr *result.DiagnosticResult, flavor version.Flavor) {
+	if flavor == "" {
+		return
+	}
+
+	if dr.Annotations == nil {
+		dr.Annotations = make(map[string]string)
+	}
+
+	dr.Annotations[AnnotationClusterFlavor] = string(flavor)
+
+	if flavor != version.FlavorManaged {
+		return
+	}
+
+	for i := range dr.Status.Conditions {
+		cond := &dr.Status.Conditions[i]
+
+		kept := cond.RemediationCommands[:0]
+
+		var reverted []string
+
+		for _, command := range cond.RemediationCommands {
+			if rt, ok := addonOwnedResource(command); ok {
+				if !slices.Contains(reverted, rt.Kind) {
+					reverted = append(reverted, rt.Kind)
+				}
+
+				continue
+			}
+
+			kept = append(kept, command)
+		}
+
+		if len(reverted) == 0 {
+			continue
+		}
+
+		cond.RemediationCommands = kept
+		if len(kept) == 0 {
+			cond.RemediationCommands = nil
+		}
+
+		note := fmt.Sprintf(addonRevertedRemediation, strings.Join(reverted, " and "))
+		if cond.Remediation == "" {
+			cond.Remediation = note
+		} else {
+			cond.Remediation = strings.TrimSuffix(cond.Remediation, ".") + ". " + note
+		}
+	}
+}
+
+// addonOwnedResource returns the add-on owned resource a remediation command changes, if any.
+// Commands are built by the remediation package with fully qualified resource names.
+func addonOwnedResource(command string) (resources.ResourceType, bool) {
+	for _, rt := range addonOwnedResources {
+		if strings.Contains(command, " "+rt.Resource+"."+rt.Group+" ") {
+			return rt, true
+		}
+	}
+
+	return resources.ResourceType{}, false
+}
@@ -0,0 +1,123 @@
+package check_test
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/remediation"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+
+	. "github.com/onsi/gomega"
+)
+
+// remediatingCheck fails with remediation commands patching the DSCInitialization and the DSC.
+type remediatingCheck struct {
+	check.BaseCheck
+}
+
+func (c *remediatingCheck) CanApply(_ context.Context, _ check.Target) (bool, error) {
+	return true, nil
+}
+
+func (c *remediatingCheck) Validate(_ context.Context, _ check.Target) (*result.DiagnosticResult, error) {
+	dr := c.NewResult()
+	dr.SetCondition(check.NewCondition(
+		check.ConditionTypeCompatible,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonVersionIncompatible),
+		check.WithImpact(result.ImpactBlocking),
+		check.WithRemediation("Disable ServiceMesh and CodeFlare."),
+		check.WithRemediationCommands(
+			remediation.SetField(resources.DSCInitialization, "", "default-dsci", ".spec.serviceMesh.managementState", "Removed"),
+			remediation.ComponentManagementState("default-dsc", "codeflare", "Removed"),
+		),
+	))
+
+	return dr, nil
+}
+
+func newRemediatingCheck(id string, flavors ...version.Flavor) *remediatingCheck {
+	return &remediatingCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:   check.GroupComponent,
+			Kind:         id,
+			Type:         check.CheckTypeRemoval,
+			CheckID:      id,
+			CheckName:    id,
+			CheckFlavors: flavors,
+		},
+	}
+}
+
+func TestExecutor_FlavorGate(t *testing.T) {
+	registry := check.NewRegistry()
+	registry.MustRegister(newRemediatingCheck("components.any"))
+	registry.MustRegister(newRemediatingCheck("components.managed", version.FlavorManaged))
+
+	executor := check.NewExecutor(registry, nil)
+
+	testCases := []struct {
+		flavor   version.Flavor
+		expected []string
+	}{
+		{flavor: "", expected: []string{"components.any"}},
+		{flavor: version.FlavorSelfManaged, expected: []string{"components.any"}},
+		{flavor: version.FlavorManaged, expected: []string{"components.any", "components.managed"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(string(tc.flavor), func(t *testing.T) {
+			g := NewWithT(t)
+
+			executions, err := executor.ExecuteSelective(t.Context(), check.Target{Flavor: tc.flavor}, []string{"*"}, check.GroupComponent)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			ids := make([]string, 0, len(executions))
+			for _, exec := range executions {
+				ids = append(ids, exec.Check.ID())
+			}
+
+			g.Expect(ids).To(ConsistOf(tc.expected))
+		})
+	}
+}
+
+func TestExecutor_ManagedFlavorDropsAddonRevertedRemediations(t *testing.T) {
+	g := NewWithT(t)
+
+	registry := check.NewRegistry()
+	registry.MustRegister(newRemediatingCheck("components.remediating"))
+
+	executor := check.NewExecutor(registry, nil)
+
+	executions, err := executor.ExecuteSelective(t.Context(), check.Target{Flavor: version.FlavorManaged}, []string{"*"}, check.GroupComponent)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(executions).To(HaveLen(1))
+
+	dr := executions[0].Result
+	g.Expect(dr.Annotations).To(HaveKeyWithValue(check.AnnotationClusterFlavor, "managed"))
+
+	cond := dr.Status.Conditions[0]
+	g.Expect(cond.RemediationCommands).To(HaveLen(1))
+	g.Expect(cond.RemediationCommands[0]).To(ContainSubstring("datascienceclusters"))
+	g.Expect(cond.Remediation).To(HavePrefix("Disable ServiceMesh and CodeFlare. On the managed cloud service, DSCInitialization is reconciled"))
+}
+
+func TestExecutor_SelfManagedFlavorKeepsRemediations(t *testing.T) {
+	g := NewWithT(t)
+
+	registry := check.NewRegistry()
+	registry.MustRegister(newRemediatingCheck("components.remediating"))
+
+	executor := check.NewExecutor(registry, nil)
+
+	executions, err := executor.ExecuteSelective(t.Context(), check.Target{Flavor: version.FlavorSelfManaged}, []string{"*"}, check.GroupComponent)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(executions[0].Result.Annotations).To(HaveKeyWithValue(check.AnnotationClusterFlavor, "self-managed"))
+	g.Expect(executions[0].Result.Status.Conditions[0].RemediationCommands).To(HaveLen(2))
+}
@@ -0,0 +1,127 @@
+package check
+
+import (
+	"fmt"
+	"maps"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+)
+
+// KnowledgeBaseURL is the URL prefix of knowledge base (KCS) articles referenced by ID.
+const KnowledgeBaseURL = "https://access.redhat.com/solutions/"
+
+// docsURL is the URL prefix of the product documentation guides of the last 2.x release,
+// which describe the changes the upgrade checks prepare for.
+const docsURL = "https://docs.redhat.com/en/documentation/red_hat_openshift_ai_self-managed/2.25/html/"
+
+// Product documentation guides referenced by the built-in checks as knowledge links.
+const (
+	DocsUpgrading              = docsURL + "upgrading_openshift_ai_self-managed/index"
+	DocsManaging               = docsURL + "managing_openshift_ai/index"
+	DocsServingModels          = docsURL + "serving_models/index"
+	DocsDistributedWorkloads   = docsURL + "working_with_distributed_workloads/index"
+	DocsKueueMigration         = docsURL + "managing_openshift_ai/managing-workloads-with-kueue#migrating-to-the-rhbok-operator_kueue"
+	DocsDataSciencePipelines   = docsURL + "working_with_data_science_pipelines/index"
+	DocsDataScienceProjects    = docsURL + "working_on_data_science_projects/index"
+	DocsAccelerators           = docsURL + "working_with_accelerators/index"
+	DocsMonitoringModels       = docsURL + "monitoring_data_science_models/index"
+	DocsInstallingCloudService = "https://docs.redhat.com/en/documentation/red_hat_openshift_ai_cloud_service/1/html/" +
+		"installing_and_uninstalling_openshift_ai_cloud_service/index"
+)
+
+// KnowledgeLinker is optionally implemented by checks declaring knowledge links.
+// BaseCheck implements it from CheckKnowledgeLinks.
+type KnowledgeLinker interface {
+	// KnowledgeLinks returns the knowledge base article IDs or documentation URLs of the check.
+	KnowledgeLinks() []string
+}
+
+//nolint:gochecknoglobals // Replaced by an installed rules bundle
+var (
+	knowledgeMu        sync.RWMutex
+	knowledgeOverrides map[string][]string
+)
+
+// SetKnowledgeLinkOverrides replaces the knowledge links of the listed checks, keyed by
+// check ID, e.g. with data from an installed rules bundle.
+func SetKnowledgeLinkOverrides(overrides map[string][]string) {
+	knowledgeMu.Lock()
+	defer knowledgeMu.Unlock()
+
+	knowledgeOverrides = maps.Clone(overrides)
+}
+
+// KnowledgeLinkOverrides returns the effective knowledge link overrides, keyed by check ID.
+func KnowledgeLinkOverrides() map[string][]string {
+	knowledgeMu.RLock()
+	defer knowledgeMu.RUnlock()
+
+	return maps.Clone(knowledgeOverrides)
+}
+
+// KnowledgeLinks returns the knowledge links of a check as URLs: the override for its ID if
+// any, otherwise the links it declares.
+func KnowledgeLinks(check Check) []string {
+	knowledgeMu.RLock()
+	refs, ok := knowledgeOverrides[check.ID()]
+	knowledgeMu.RUnlock()
+
+	if !ok {
+		if linker, isLinker := check.(KnowledgeLinker); isLinker {
+			refs = linker.KnowledgeLinks()
+		}
+	}
+
+	links := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		links = append(links, KnowledgeLinkURL(ref))
+	}
+
+	return links
+}
+
+// KnowledgeLinkURL expands a knowledge base article ID into its URL. URLs are returned as is.
+func KnowledgeLinkURL(ref string) string {
+	if isArticleID(ref) {
+		return KnowledgeBaseURL + ref
+	}
+
+	return ref
+}
+
+// ValidateKnowledgeLink checks that ref is a knowledge base article ID or an absolute
+// http(s) URL.
+func ValidateKnowledgeLink(ref string) error {
+	if isArticleID(ref) {
+		return nil
+	}
+
+	u, err := url.Parse(ref)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return fmt.Errorf("invalid knowledge link %q: must be a knowledge base article ID or an http(s) URL", ref)
+	}
+
+	return nil
+}
+
+// isArticleID reports whether ref is a numeric knowledge base article ID.
+func isArticleID(ref string) bool {
+	return ref != "" && strings.Trim(ref, "0123456789") == ""
+}
+
+// applyKnowledgeLinks records the knowledge links of check in the spec of its result.
+func applyKnowledgeLinks(dr *result.DiagnosticResult, check Check) {
+	if dr == nil {
+		return
+	}
+
+	links := KnowledgeLinks(check)
+	if len(links) == 0 {
+		return
+	}
+
+	dr.Spec.KnowledgeLinks = links
+}
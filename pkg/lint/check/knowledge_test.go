@@ -0,0 +1,65 @@
+package check_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestKnowledgeLinks(t *testing.T) {
+	chk := &slowCheck{BaseCheck: check.BaseCheck{
+		CheckID:             "components.example.removal",
+		CheckKnowledgeLinks: []string{"7012345", check.DocsUpgrading},
+	}}
+
+	t.Run("expands article IDs of the declared links", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(check.KnowledgeLinks(chk)).To(Equal([]string{
+			check.KnowledgeBaseURL + "7012345",
+			check.DocsUpgrading,
+		}))
+	})
+
+	t.Run("prefers overrides for the check ID", func(t *testing.T) {
+		g := NewWithT(t)
+
+		check.SetKnowledgeLinkOverrides(map[string][]string{chk.ID(): {"7099999"}})
+		t.Cleanup(func() { check.SetKnowledgeLinkOverrides(nil) })
+
+		g.Expect(check.KnowledgeLinks(chk)).To(Equal([]string{check.KnowledgeBaseURL + "7099999"}))
+		g.Expect(check.KnowledgeLinks(&slowCheck{BaseCheck: check.BaseCheck{CheckID: "components.other.removal"}})).To(BeEmpty())
+	})
+}
+
+func TestValidateKnowledgeLink(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(check.ValidateKnowledgeLink("7012345")).To(Succeed())
+	g.Expect(check.ValidateKnowledgeLink("https://example.com/kb")).To(Succeed())
+
+	for _, ref := range []string{"", "kcs-7012345", "ftp://example.com/kb", "https://", "/solutions/7012345"} {
+		g.Expect(check.ValidateKnowledgeLink(ref)).To(MatchError(ContainSubstring("invalid knowledge link")), "ref %q", ref)
+	}
+}
+
+func TestExecutor_StampsKnowledgeLinks(t *testing.T) {
+	g := NewWithT(t)
+
+	checks := newSlowChecks(0, 0)
+	checks[0].CheckKnowledgeLinks = []string{"7012345"}
+
+	registry := check.NewRegistry()
+	for _, chk := range checks {
+		registry.MustRegister(chk)
+	}
+
+	executions := check.NewExecutor(registry, nil, check.WithCheckTimeout(time.Minute)).ExecuteAll(t.Context(), check.Target{})
+	g.Expect(executions).To(HaveLen(2))
+
+	g.Expect(executions[0].Result.Spec.KnowledgeLinks).To(Equal([]string{check.KnowledgeBaseURL + "7012345"}))
+	g.Expect(executions[1].Result.Spec.KnowledgeLinks).To(BeEmpty())
+}
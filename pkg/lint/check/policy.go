@@ -0,0 +1,64 @@
+package check
+
+import (
+	"fmt"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+)
+
+// ImpactOverride is the impact the lint configuration file assigns to the findings of a check.
+type ImpactOverride string
+
+const (
+	// ImpactOverrideBlocking promotes the findings of a check to blocking.
+	ImpactOverrideBlocking ImpactOverride = "blocking"
+
+	// ImpactOverrideAdvisory downgrades the findings of a check to advisory.
+	ImpactOverrideAdvisory ImpactOverride = "advisory"
+
+	// ImpactOverrideIgnore keeps the findings of a check in the report as informational,
+	// so they no longer affect the exit code.
+	ImpactOverrideIgnore ImpactOverride = "ignore"
+)
+
+// Validate checks if the impact override is valid.
+func (o ImpactOverride) Validate() error {
+	switch o {
+	case ImpactOverrideBlocking, ImpactOverrideAdvisory, ImpactOverrideIgnore:
+		return nil
+	default:
+		return fmt.Errorf("invalid impact %q (must be one of: blocking, advisory, ignore)", o)
+	}
+}
+
+// Parameterized is implemented by checks accepting parameters from the lint configuration file.
+type Parameterized interface {
+	// SetParameters applies the parameters of the check, given as a JSON object.
+	// Unknown parameters are rejected.
+	SetParameters(data []byte) error
+}
+
+// applyImpactOverride replaces the impact of the findings of a result and records the override
+// in its annotations. Passing conditions are left unchanged.
+func applyImpactOverride(dr *result.DiagnosticResult, override ImpactOverride) {
+	if dr == nil || override == "" {
+		return
+	}
+
+	impact := result.Impact(override)
+	if override == ImpactOverrideIgnore {
+		impact = result.ImpactNone
+	}
+
+	for i := range dr.Status.Conditions {
+		if dr.Status.Conditions[i].Impact != result.ImpactNone {
+			dr.Status.Conditions[i].Impact = impact
+		}
+	}
+
+	if dr.Annotations == nil {
+		dr.Annotations = make(map[string]string)
+	}
+
+	dr.Annotations[AnnotationImpactOverride] = string(override)
+}
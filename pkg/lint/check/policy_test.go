@@ -0,0 +1,89 @@
+package check_test
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+
+	. "github.com/onsi/gomega"
+)
+
+// blockingCheck fails with a blocking finding.
+type blockingCheck struct {
+	check.BaseCheck
+}
+
+func (c *blockingCheck) CanApply(_ context.Context, _ check.Target) (bool, error) {
+	return true, nil
+}
+
+func (c *blockingCheck) Validate(_ context.Context, _ check.Target) (*result.DiagnosticResult, error) {
+	dr := c.NewResult()
+	dr.SetCondition(check.NewCondition(check.ConditionTypeCompatible, metav1.ConditionFalse,
+		check.WithReason(check.ReasonVersionIncompatible),
+		check.WithImpact(result.ImpactBlocking)))
+
+	return dr, nil
+}
+
+func TestExecutor_ImpactOverrides(t *testing.T) {
+	g := NewWithT(t)
+
+	registry := check.NewRegistry()
+
+	for _, id := range []string{"components.a.removal", "components.b.removal", "components.c.removal"} {
+		registry.MustRegister(&blockingCheck{BaseCheck: check.BaseCheck{
+			CheckGroup: check.GroupComponent,
+			Kind:       id,
+			Type:       check.CheckTypeRemoval,
+			CheckID:    id,
+			CheckName:  id,
+		}})
+	}
+
+	checks := newSlowChecks(0)
+	registry.MustRegister(checks[0])
+
+	executor := check.NewExecutor(registry, nil, check.WithImpactOverrides(map[string]check.ImpactOverride{
+		"components.a.removal": check.ImpactOverrideAdvisory,
+		"components.b.removal": check.ImpactOverrideIgnore,
+		checks[0].ID():         check.ImpactOverrideBlocking,
+	}))
+
+	byID := make(map[string]check.CheckExecution)
+	for _, exec := range executor.ExecuteAll(t.Context(), check.Target{}) {
+		byID[exec.Check.ID()] = exec
+	}
+
+	g.Expect(byID).To(HaveLen(4))
+
+	advisory := byID["components.a.removal"].Result
+	g.Expect(*advisory.GetImpact()).To(Equal(string(result.ImpactAdvisory)))
+	g.Expect(advisory.Annotations).To(HaveKeyWithValue(check.AnnotationImpactOverride, "advisory"))
+
+	ignored := byID["components.b.removal"].Result
+	g.Expect(ignored.Status.Conditions[0].Status).ToNot(Equal(metav1.ConditionTrue))
+	g.Expect(ignored.Status.Conditions[0].Impact).To(Equal(result.ImpactNone))
+	g.Expect(ignored.Annotations).To(HaveKeyWithValue(check.AnnotationImpactOverride, "ignore"))
+
+	g.Expect(*byID["components.c.removal"].Result.GetImpact()).To(Equal(string(result.ImpactBlocking)))
+	g.Expect(byID["components.c.removal"].Result.Annotations).ToNot(HaveKey(check.AnnotationImpactOverride))
+
+	// Passing conditions keep their impact
+	passing := byID[checks[0].ID()].Result
+	g.Expect(passing.Status.Conditions[0].Impact).To(Equal(result.ImpactNone))
+}
+
+func TestImpactOverride_Validate(t *testing.T) {
+	g := NewWithT(t)
+
+	for _, override := range []check.ImpactOverride{check.ImpactOverrideBlocking, check.ImpactOverrideAdvisory, check.ImpactOverrideIgnore} {
+		g.Expect(override.Validate()).To(Succeed())
+	}
+
+	g.Expect(check.ImpactOverride("critical").Validate()).To(MatchError(ContainSubstring(`invalid impact "critical"`)))
+}
@@ -2,6 +2,8 @@ package check
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 	"sync"
 )
 
@@ -63,6 +65,8 @@ func (r *CheckRegistry) ListByGroup(group CheckGroup) []Check {
 		}
 	}
 
+	sortByID(result)
+
 	return result
 }
 
@@ -83,6 +87,8 @@ func (r *CheckRegistry) ListBySelector(group CheckGroup) []Check {
 		result = append(result, check)
 	}
 
+	sortByID(result)
+
 	return result
 }
 
@@ -96,6 +102,8 @@ func (r *CheckRegistry) ListAll() []Check {
 		result = append(result, check)
 	}
 
+	sortByID(result)
+
 	return result
 }
 
@@ -139,6 +147,8 @@ func (r *CheckRegistry) ListByPatterns(
 		}
 	}
 
+	sortByID(result)
+
 	return result, nil
 }
 
@@ -150,3 +160,11 @@ func (r *CheckRegistry) ListByPattern(
 ) ([]Check, error) {
 	return r.ListByPatterns([]string{pattern}, group)
 }
+
+// sortByID sorts checks by ID, so listings (and the execution order derived from them) do not
+// depend on map iteration order.
+func sortByID(checks []Check) {
+	slices.SortFunc(checks, func(a, b Check) int {
+		return strings.Compare(a.ID(), b.ID())
+	})
+}
@@ -0,0 +1,120 @@
+// Package remediation builds machine-applicable kubectl commands that checks attach
+// to failing conditions via check.WithRemediationCommands.
+package remediation
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+)
+
+const kubectl = "kubectl"
+
+// jsonPatchOp is a single RFC 6902 JSON patch operation.
+type jsonPatchOp struct {
+	Op   string `json:"op"`
+	Path string `json:"path"`
+}
+
+// MergePatch returns a `kubectl patch --type=merge` command applying patch to the named object.
+// The patch is marshalled to JSON so callers can pass nested maps.
+func MergePatch(
+	resourceType resources.ResourceType,
+	namespace string,
+	name string,
+	patch map[string]any,
+) string {
+	data, err := json.Marshal(patch)
+	if err != nil {
+		// Patches are built from plain maps of strings; a marshal failure is a programming error.
+		panic(fmt.Sprintf("marshalling merge patch: %v", err))
+	}
+
+	return command(resourceType, namespace, "patch", name, "--type=merge", "-p", Quote(string(data)))
+}
+
+// RemoveField returns a `kubectl patch --type=json` command removing the field at the given
+// JSON pointer (e.g. /spec/apiServer/managedPipelines/instructLab) from the named object.
+func RemoveField(
+	resourceType resources.ResourceType,
+	namespace string,
+	name string,
+	pointer string,
+) string {
+	data, err := json.Marshal([]jsonPatchOp{{Op: "remove", Path: pointer}})
+	if err != nil {
+		panic(fmt.Sprintf("marshalling json patch: %v", err))
+	}
+
+	return command(resourceType, namespace, "patch", name, "--type=json", "-p", Quote(string(data)))
+}
+
+// Annotate returns a `kubectl annotate --overwrite` command setting key=value on the named object.
+func Annotate(
+	resourceType resources.ResourceType,
+	namespace string,
+	name string,
+	key string,
+	value string,
+) string {
+	return command(resourceType, namespace, "annotate", name, "--overwrite", Quote(key+"="+value))
+}
+
+// SetField returns a merge patch command setting the field at fieldPath to value.
+// fieldPath uses the same dotted notation as JQ queries (e.g. .spec.serviceMesh.managementState).
+func SetField(
+	resourceType resources.ResourceType,
+	namespace string,
+	name string,
+	fieldPath string,
+	value any,
+) string {
+	segments := strings.Split(strings.TrimPrefix(fieldPath, "."), ".")
+
+	// Build the nested patch from the innermost field outwards.
+	var patch any = value
+	for i := len(segments) - 1; i >= 0; i-- {
+		patch = map[string]any{segments[i]: patch}
+	}
+
+	//nolint:forcetypeassert // fieldPath always has at least one segment, so patch is a map.
+	return MergePatch(resourceType, namespace, name, patch.(map[string]any))
+}
+
+// ComponentManagementState returns a merge patch command setting
+// .spec.components.<component>.managementState on the DataScienceCluster.
+func ComponentManagementState(dscName string, component string, state string) string {
+	return SetField(resources.DataScienceCluster, "", dscName,
+		".spec.components."+component+".managementState", state)
+}
+
+// Quote wraps s in single quotes for POSIX shells, escaping embedded single quotes.
+func Quote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// command assembles a kubectl invocation using the fully qualified resource name so the
+// generated script is unambiguous regardless of which API groups are installed.
+func command(
+	resourceType resources.ResourceType,
+	namespace string,
+	verb string,
+	name string,
+	args ...string,
+) string {
+	resource := resourceType.Resource
+	if resourceType.Group != "" {
+		resource += "." + resourceType.Group
+	}
+
+	parts := []string{kubectl, verb, resource, Quote(name)}
+	if namespace != "" {
+		parts = append(parts, "-n", Quote(namespace))
+	}
+
+	parts = append(parts, args...)
+
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,79 @@
+package remediation_test
+
+import (
+	"testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/remediation"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	testNamespace = "team-a"
+	testDSCName   = "default-dsc"
+)
+
+func TestSetField(t *testing.T) {
+	g := NewWithT(t)
+
+	cmd := remediation.SetField(resources.DSCInitialization, "", "default-dsci",
+		".spec.serviceMesh.managementState", "Removed")
+
+	g.Expect(cmd).To(Equal(
+		`kubectl patch dscinitializations.dscinitialization.opendatahub.io 'default-dsci' --type=merge -p '{"spec":{"serviceMesh":{"managementState":"Removed"}}}'`,
+	))
+}
+
+func TestComponentManagementState(t *testing.T) {
+	g := NewWithT(t)
+
+	cmd := remediation.ComponentManagementState(testDSCName, "modelmesh", "Removed")
+
+	g.Expect(cmd).To(Equal(
+		`kubectl patch datascienceclusters.datasciencecluster.opendatahub.io 'default-dsc' --type=merge -p '{"spec":{"components":{"modelmesh":{"managementState":"Removed"}}}}'`,
+	))
+}
+
+func TestRemoveField(t *testing.T) {
+	g := NewWithT(t)
+
+	cmd := remediation.RemoveField(resources.DataSciencePipelinesApplicationV1, testNamespace, "dspa",
+		"/spec/apiServer/managedPipelines/instructLab")
+
+	g.Expect(cmd).To(Equal(
+		`kubectl patch datasciencepipelinesapplications.datasciencepipelinesapplications.opendatahub.io 'dspa' -n 'team-a' --type=json -p '[{"op":"remove","path":"/spec/apiServer/managedPipelines/instructLab"}]'`,
+	))
+}
+
+func TestAnnotate_CoreResource(t *testing.T) {
+	g := NewWithT(t)
+
+	cmd := remediation.Annotate(resources.ConfigMap, testNamespace, "inferenceservice-config",
+		"opendatahub.io/managed", "false")
+
+	g.Expect(cmd).To(Equal(
+		`kubectl annotate configmaps 'inferenceservice-config' -n 'team-a' --overwrite 'opendatahub.io/managed=false'`,
+	))
+}
+
+func TestQuote(t *testing.T) {
+	g := NewWithT(t)
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "plain", input: "abc", expected: `'abc'`},
+		{name: "empty", input: "", expected: `''`},
+		{name: "single quote", input: "it's", expected: `'it'\''s'`},
+		{name: "shell metacharacters", input: "$(rm -rf /)", expected: `'$(rm -rf /)'`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g.Expect(remediation.Quote(tt.input)).To(Equal(tt.expected))
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package result
+
+import (
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ObjectKey identifies an impacted object across check results by GVK, namespace and name.
+type ObjectKey struct {
+	schema.GroupVersionKind
+
+	Namespace string
+	Name      string
+}
+
+// ObjectKeyOf returns the key of an impacted object.
+func ObjectKeyOf(obj metav1.PartialObjectMetadata) ObjectKey {
+	return ObjectKey{
+		GroupVersionKind: obj.GroupVersionKind(),
+		Namespace:        obj.Namespace,
+		Name:             obj.Name,
+	}
+}
+
+// ObjectFinding is a finding of one check for an impacted object.
+type ObjectFinding struct {
+	// Group, Kind and Name identify the check result, as in DiagnosticResult.
+	Group string `json:"group" yaml:"group"`
+	Kind  string `json:"kind"  yaml:"kind"`
+	Name  string `json:"name"  yaml:"name"`
+
+	// Impact is the highest impact of the check result.
+	Impact Impact `json:"impact,omitempty" yaml:"impact,omitempty"`
+
+	// Message is the primary message of the check result.
+	Message string `json:"message,omitempty" yaml:"message,omitempty"`
+
+	// Remediation is the remediation guidance of the check result.
+	Remediation string `json:"remediation,omitempty" yaml:"remediation,omitempty"`
+}
+
+// ObjectRollup lists all findings reported for one impacted object, which is the unit
+// remediation is performed on.
+type ObjectRollup struct {
+	APIVersion string `json:"apiVersion,omitempty" yaml:"apiVersion,omitempty"`
+	Kind       string `json:"kind,omitempty"       yaml:"kind,omitempty"`
+	Namespace  string `json:"namespace,omitempty"  yaml:"namespace,omitempty"`
+	Name       string `json:"name"                 yaml:"name"`
+
+	// Impact is the highest impact across the findings.
+	Impact Impact `json:"impact,omitempty" yaml:"impact,omitempty"`
+
+	// Findings are the findings for the object, in result order.
+	Findings []ObjectFinding `json:"findings" yaml:"findings"`
+}
+
+// ObjectIndex indexes the impacted objects of check results by ObjectKey, so findings of
+// different checks for the same object can be reported together.
+type ObjectIndex struct {
+	objects map[ObjectKey]*ObjectRollup
+}
+
+// NewObjectIndex indexes the impacted objects of results. A check reporting the same object
+// more than once (e.g. a workload check run per instance) contributes a single finding.
+func NewObjectIndex(results []*DiagnosticResult) *ObjectIndex {
+	index := &ObjectIndex{objects: make(map[ObjectKey]*ObjectRollup)}
+
+	for _, r := range results {
+		if r == nil {
+			continue
+		}
+
+		finding := ObjectFinding{
+			Group:       r.Group,
+			Kind:        r.Kind,
+			Name:        r.Name,
+			Message:     r.GetMessage(),
+			Remediation: r.GetRemediation(),
+		}
+
+		if impact := r.GetImpact(); impact != nil {
+			finding.Impact = Impact(*impact)
+		}
+
+		for _, obj := range r.ImpactedObjects {
+			index.add(obj, finding)
+		}
+	}
+
+	return index
+}
+
+func (i *ObjectIndex) add(obj metav1.PartialObjectMetadata, finding ObjectFinding) {
+	key := ObjectKeyOf(obj)
+
+	rollup, ok := i.objects[key]
+	if !ok {
+		rollup = &ObjectRollup{
+			APIVersion: obj.APIVersion,
+			Kind:       obj.Kind,
+			Namespace:  obj.Namespace,
+			Name:       obj.Name,
+		}
+		i.objects[key] = rollup
+	}
+
+	for _, f := range rollup.Findings {
+		if f.Group == finding.Group && f.Kind == finding.Kind && f.Name == finding.Name {
+			return
+		}
+	}
+
+	rollup.Findings = append(rollup.Findings, finding)
+	rollup.Impact = highestImpact(rollup.Impact, finding.Impact)
+}
+
+// Findings returns the findings for an object, or nil if no check reported it.
+func (i *ObjectIndex) Findings(key ObjectKey) []ObjectFinding {
+	if rollup, ok := i.objects[key]; ok {
+		return rollup.Findings
+	}
+
+	return nil
+}
+
+// Rollup returns the indexed objects with at least minFindings findings, sorted by
+// namespace, kind and name.
+func (i *ObjectIndex) Rollup(minFindings int) []ObjectRollup {
+	rollups := make([]ObjectRollup, 0, len(i.objects))
+
+	for _, rollup := range i.objects {
+		if len(rollup.Findings) >= minFindings {
+			rollups = append(rollups, *rollup)
+		}
+	}
+
+	sort.Slice(rollups, func(a, b int) bool {
+		if rollups[a].Namespace != rollups[b].Namespace {
+			return rollups[a].Namespace < rollups[b].Namespace
+		}
+
+		if rollups[a].Kind != rollups[b].Kind {
+			return rollups[a].Kind < rollups[b].Kind
+		}
+
+		if rollups[a].Name != rollups[b].Name {
+			return rollups[a].Name < rollups[b].Name
+		}
+
+		return rollups[a].APIVersion < rollups[b].APIVersion
+	})
+
+	return rollups
+}
+
+// highestImpact returns the more severe of two impacts.
+func highestImpact(a Impact, b Impact) Impact {
+	switch {
+	case a == ImpactBlocking || b == ImpactBlocking:
+		return ImpactBlocking
+	case a == ImpactAdvisory || b == ImpactAdvisory:
+		return ImpactAdvisory
+	default:
+		return ImpactNone
+	}
+}
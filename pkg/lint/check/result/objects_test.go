@@ -0,0 +1,89 @@
+package result_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+
+	. "github.com/onsi/gomega"
+)
+
+func notebookObject(namespace string, name string) metav1.PartialObjectMetadata {
+	return metav1.PartialObjectMetadata{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "kubeflow.org/v1", Kind: "Notebook"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+	}
+}
+
+func finding(kind string, name string, impact result.Impact, objects ...metav1.PartialObjectMetadata) *result.DiagnosticResult {
+	status := metav1.ConditionFalse
+	if impact == result.ImpactNone {
+		status = metav1.ConditionTrue
+	}
+
+	r := result.New("workloads", kind, name, "")
+	r.Status.Conditions = []result.Condition{{
+		Condition: metav1.Condition{Type: "Validated", Status: status, Reason: "Test", Message: kind + " " + name},
+		Impact:    impact,
+	}}
+	r.ImpactedObjects = objects
+
+	return r
+}
+
+func TestObjectIndex_CombinesFindingsAcrossChecks(t *testing.T) {
+	g := NewWithT(t)
+
+	nb := notebookObject("team-a", "wb")
+
+	index := result.NewObjectIndex([]*result.DiagnosticResult{
+		finding("notebook", "image-tag-refresh", result.ImpactAdvisory, nb),
+		finding("podsecurity", "admission", result.ImpactBlocking, nb, notebookObject("team-b", "other")),
+		// A workload check run once per instance reports the object again.
+		finding("notebook", "image-tag-refresh", result.ImpactAdvisory, nb),
+	})
+
+	findings := index.Findings(result.ObjectKeyOf(nb))
+	g.Expect(findings).To(HaveLen(2))
+	g.Expect(findings[0].Kind).To(Equal("notebook"))
+	g.Expect(findings[1].Kind).To(Equal("podsecurity"))
+
+	rollup := index.Rollup(2)
+	g.Expect(rollup).To(HaveLen(1))
+	g.Expect(rollup[0].Name).To(Equal("wb"))
+	g.Expect(rollup[0].Impact).To(Equal(result.ImpactBlocking))
+
+	g.Expect(index.Rollup(1)).To(HaveLen(2))
+}
+
+func TestObjectIndex_KeysByGVK(t *testing.T) {
+	g := NewWithT(t)
+
+	nb := notebookObject("team-a", "wb")
+	isvc := metav1.PartialObjectMetadata{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "serving.kserve.io/v1beta1", Kind: "InferenceService"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "wb"},
+	}
+
+	index := result.NewObjectIndex([]*result.DiagnosticResult{
+		finding("notebook", "impacted", result.ImpactAdvisory, nb),
+		finding("kserve", "impacted", result.ImpactAdvisory, isvc),
+	})
+
+	g.Expect(index.Rollup(2)).To(BeEmpty())
+	g.Expect(index.Findings(result.ObjectKeyOf(isvc))).To(HaveLen(1))
+}
+
+func TestDiagnosticResultList_IndexObjects(t *testing.T) {
+	g := NewWithT(t)
+
+	list := result.NewDiagnosticResultList(nil, nil)
+	list.Results = append(list.Results, finding("notebook", "impacted", result.ImpactAdvisory, notebookObject("team-a", "wb")))
+
+	list.IndexObjects()
+
+	g.Expect(list.Objects).To(HaveLen(1))
+	g.Expect(list.Objects[0].Findings[0].Message).To(Equal("notebook impacted"))
+}
@@ -0,0 +1,80 @@
+package result
+
+// ComponentGate counts the findings of the checks of one component (group and kind), which
+// passes the upgrade gate when none of them is blocking.
+type ComponentGate struct {
+	Group    string `json:"group"    yaml:"group"`
+	Kind     string `json:"kind"     yaml:"kind"`
+	Checks   int    `json:"checks"   yaml:"checks"`
+	Blocking int    `json:"blocking" yaml:"blocking"`
+	Advisory int    `json:"advisory" yaml:"advisory"`
+	Passed   bool   `json:"passed"   yaml:"passed"`
+}
+
+// ReadinessSummary is the overall upgrade readiness assessment of a set of check results.
+type ReadinessSummary struct {
+	// Blocking and Advisory count the check results by their highest impact.
+	Blocking int `json:"blocking" yaml:"blocking"`
+	Advisory int `json:"advisory" yaml:"advisory"`
+
+	// Components lists the gate of each component, in result order.
+	Components []ComponentGate `json:"components" yaml:"components"`
+}
+
+// Ready returns whether no check result has a blocking finding.
+func (s *ReadinessSummary) Ready() bool {
+	return s.Blocking == 0
+}
+
+// NewReadinessSummary counts the blocking and advisory check results, overall and per component.
+func NewReadinessSummary(results []*DiagnosticResult) *ReadinessSummary {
+	summary := &ReadinessSummary{Components: make([]ComponentGate, 0)}
+	index := make(map[[2]string]int)
+
+	for _, r := range results {
+		if r == nil {
+			continue
+		}
+
+		key := [2]string{r.Group, r.Kind}
+
+		i, ok := index[key]
+		if !ok {
+			i = len(summary.Components)
+			index[key] = i
+			summary.Components = append(summary.Components, ComponentGate{Group: r.Group, Kind: r.Kind})
+		}
+
+		gate := &summary.Components[i]
+		gate.Checks++
+
+		impact := r.GetImpact()
+		if impact == nil {
+			continue
+		}
+
+		switch Impact(*impact) {
+		case ImpactBlocking:
+			gate.Blocking++
+			summary.Blocking++
+		case ImpactAdvisory:
+			gate.Advisory++
+			summary.Advisory++
+		case ImpactNone:
+		}
+	}
+
+	for i := range summary.Components {
+		summary.Components[i].Passed = summary.Components[i].Blocking == 0
+	}
+
+	return summary
+}
+
+// AssessReadiness populates Readiness and ReadinessSummary from Results, for upgrade assessments.
+func (l *DiagnosticResultList) AssessReadiness() {
+	l.ReadinessSummary = NewReadinessSummary(l.Results)
+
+	ready := l.ReadinessSummary.Ready()
+	l.Readiness = &ready
+}
@@ -0,0 +1,46 @@
+package result_test
+
+import (
+	"testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+func TestNewReadinessSummary(t *testing.T) {
+	g := NewWithT(t)
+
+	summary := result.NewReadinessSummary([]*result.DiagnosticResult{
+		finding("notebook", "image-tag-refresh", result.ImpactAdvisory),
+		finding("notebook", "storage", result.ImpactNone),
+		finding("podsecurity", "admission", result.ImpactBlocking),
+		finding("notebook", "impacted-workloads", result.ImpactBlocking),
+	})
+
+	g.Expect(summary.Ready()).To(BeFalse())
+	g.Expect(summary.Blocking).To(Equal(2))
+	g.Expect(summary.Advisory).To(Equal(1))
+	g.Expect(summary.Components).To(HaveExactElements(
+		MatchFields(IgnoreExtras, Fields{
+			"Kind": Equal("notebook"), "Checks": Equal(3), "Blocking": Equal(1), "Advisory": Equal(1), "Passed": BeFalse(),
+		}),
+		MatchFields(IgnoreExtras, Fields{
+			"Kind": Equal("podsecurity"), "Checks": Equal(1), "Blocking": Equal(1), "Passed": BeFalse(),
+		}),
+	))
+}
+
+func TestDiagnosticResultList_AssessReadiness(t *testing.T) {
+	g := NewWithT(t)
+
+	list := result.NewDiagnosticResultList(nil, nil)
+	list.Results = append(list.Results, finding("notebook", "image-tag-refresh", result.ImpactAdvisory))
+
+	list.AssessReadiness()
+
+	g.Expect(list.Readiness).To(PointTo(BeTrue()))
+	g.Expect(list.ReadinessSummary.Advisory).To(Equal(1))
+	g.Expect(list.ReadinessSummary.Components).To(HaveExactElements(HaveField("Passed", BeTrue())))
+}
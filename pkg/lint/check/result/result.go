@@ -3,7 +3,10 @@ package result
 import (
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -21,6 +24,9 @@ const (
 	errMsgConditionReasonEmpty    = "condition %q has empty reason"
 	errMsgConditionInvalidStatus  = "condition %q has invalid status (must be True, False, or Unknown)"
 	errMsgAnnotationInvalidFormat = "annotation key %q must be in domain/key format (e.g., openshiftai.io/version)"
+	errMsgObjectTypeMetaEmpty     = "impacted object %d (%s) must have apiVersion and kind set"
+	errMsgObjectNameEmpty         = "impacted object %d must have a name"
+	errMsgObjectAnnotationFormat  = "impacted object %d (%s): annotation key %q must be in domain/key format"
 )
 
 // Impact represents the upgrade impact level of a diagnostic condition.
@@ -46,6 +52,10 @@ type Condition struct {
 	// Remediation provides actionable guidance on how to resolve the condition.
 	// Set via WithRemediation option during condition creation.
 	Remediation string `json:"remediation,omitempty" yaml:"remediation,omitempty"`
+
+	// RemediationCommands lists machine-applicable shell commands that resolve the condition.
+	// Only set when the fix is unambiguous; set via WithRemediationCommands option.
+	RemediationCommands []string `json:"remediationCommands,omitempty" yaml:"remediationCommands,omitempty"`
 }
 
 // Validate ensures the condition has valid Status/Impact combination.
@@ -88,12 +98,40 @@ func (c Condition) Validate() error {
 type DiagnosticSpec struct {
 	// Description provides a detailed explanation of the check purpose and significance
 	Description string `json:"description" yaml:"description"`
+
+	// KnowledgeLinks are stable documentation URLs (e.g. knowledge base articles) for the check
+	KnowledgeLinks []string `json:"knowledgeLinks,omitempty" yaml:"knowledgeLinks,omitempty"`
 }
 
 // DiagnosticStatus contains the condition-based validation results.
 type DiagnosticStatus struct {
 	// Conditions is an array of validation conditions ordered by execution sequence
 	Conditions []Condition `json:"conditions" yaml:"conditions"`
+
+	// Timing records the execution of the check; set by the executor
+	Timing *Timing `json:"timing,omitempty" yaml:"timing,omitempty"`
+}
+
+// Timing records when a check was executed, for how long and how many Kubernetes API
+// requests it made.
+type Timing struct {
+	// StartedAt is when the execution of the check started, including CanApply
+	StartedAt time.Time `json:"startedAt" yaml:"startedAt"`
+
+	// FinishedAt is when the execution of the check finished
+	FinishedAt time.Time `json:"finishedAt" yaml:"finishedAt"`
+
+	// DurationSeconds is the time between StartedAt and FinishedAt
+	DurationSeconds float64 `json:"durationSeconds" yaml:"durationSeconds"`
+
+	// APICalls is the number of Kubernetes API requests the check made; reads served from a
+	// cache or a backup are not counted
+	APICalls int64 `json:"apiCalls" yaml:"apiCalls"`
+}
+
+// Duration returns DurationSeconds as a time.Duration.
+func (t *Timing) Duration() time.Duration {
+	return time.Duration(t.DurationSeconds * float64(time.Second))
 }
 
 // DiagnosticResult represents a diagnostic check result with flattened metadata fields.
@@ -199,6 +237,42 @@ func (r *DiagnosticResult) Validate() error {
 	return nil
 }
 
+// ValidateStrict runs Validate and checks the additional invariants downstream serializers
+// rely on: every condition has an impact consistent with its status (see Condition.Validate),
+// and impacted objects carry apiVersion, kind and name and domain-qualified annotation keys.
+// All violations are reported, not only the first.
+func (r *DiagnosticResult) ValidateStrict() error {
+	var errs []error
+
+	if err := r.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	for _, condition := range r.Status.Conditions {
+		if err := condition.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("condition %q: %w", condition.Type, err))
+		}
+	}
+
+	for i, obj := range r.ImpactedObjects {
+		if obj.Name == "" {
+			errs = append(errs, fmt.Errorf(errMsgObjectNameEmpty, i))
+		}
+
+		if obj.APIVersion == "" || obj.Kind == "" {
+			errs = append(errs, fmt.Errorf(errMsgObjectTypeMetaEmpty, i, obj.Name))
+		}
+
+		for _, key := range slices.Sorted(maps.Keys(obj.Annotations)) {
+			if !isValidAnnotationKey(key) {
+				errs = append(errs, fmt.Errorf(errMsgObjectAnnotationFormat, i, obj.Name, key))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
 // New creates a new diagnostic result.
 func New(
 	group string,
@@ -287,6 +361,22 @@ func (r *DiagnosticResult) GetRemediation() string {
 	return ""
 }
 
+// GetRemediationCommands returns the machine-applicable remediation commands of all
+// failing conditions, in condition order.
+func (r *DiagnosticResult) GetRemediationCommands() []string {
+	var commands []string
+
+	for _, cond := range r.Status.Conditions {
+		if cond.Status == metav1.ConditionTrue {
+			continue
+		}
+
+		commands = append(commands, cond.RemediationCommands...)
+	}
+
+	return commands
+}
+
 // GetStatusString returns a string representation of the overall status.
 // Pass: All conditions are True
 // Fail: Any condition is False
@@ -362,6 +452,19 @@ type DiagnosticResultList struct {
 	ClusterVersion *string             `json:"clusterVersion,omitempty" yaml:"clusterVersion,omitempty"`
 	TargetVersion  *string             `json:"targetVersion,omitempty"  yaml:"targetVersion,omitempty"`
 	Results        []*DiagnosticResult `json:"results"                  yaml:"results"`
+
+	// Flavor is the management flavor of the installation (self-managed or managed).
+	Flavor string `json:"flavor,omitempty" yaml:"flavor,omitempty"`
+
+	// Objects lists, per impacted object, the findings of all checks that reported it.
+	Objects []ObjectRollup `json:"objects,omitempty" yaml:"objects,omitempty"`
+
+	// Readiness is whether the cluster is ready for the upgrade to TargetVersion, i.e. no check
+	// has a blocking finding. It is only set for upgrade assessments.
+	Readiness *bool `json:"readiness,omitempty" yaml:"readiness,omitempty"`
+
+	// ReadinessSummary counts the blocking and advisory findings overall and per component.
+	ReadinessSummary *ReadinessSummary `json:"readinessSummary,omitempty" yaml:"readinessSummary,omitempty"`
 }
 
 // NewDiagnosticResultList creates a new list.
@@ -372,3 +475,8 @@ func NewDiagnosticResultList(clusterVersion *string, targetVersion *string) *Dia
 		Results:        make([]*DiagnosticResult, 0),
 	}
 }
+
+// IndexObjects populates Objects from the impacted objects of Results.
+func (l *DiagnosticResultList) IndexObjects() {
+	l.Objects = NewObjectIndex(l.Results).Rollup(1)
+}
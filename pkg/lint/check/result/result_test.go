@@ -849,3 +849,53 @@ func TestAddImpactedObjects(t *testing.T) {
 	g.Expect(dr.ImpactedObjects[1].Name).To(Equal("obj2"))
 	g.Expect(dr.ImpactedObjects[2].Name).To(Equal("obj3"))
 }
+
+func TestDiagnosticResult_ValidateStrict(t *testing.T) {
+	g := NewWithT(t)
+
+	newResult := func() *result.DiagnosticResult {
+		dr := result.New("workloads", "notebook", "impacted-workloads", "Validates notebooks")
+		dr.Status.Conditions = append(dr.Status.Conditions, result.Condition{
+			Condition: metav1.Condition{
+				Type:   check.ConditionTypeCompatible,
+				Status: metav1.ConditionFalse,
+				Reason: check.ReasonWorkloadsImpacted,
+			},
+			Impact: result.ImpactBlocking,
+		})
+		dr.ImpactedObjects = []metav1.PartialObjectMetadata{{
+			TypeMeta: resources.Notebook.TypeMeta(),
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   "team-a",
+				Name:        "nb",
+				Annotations: map[string]string{"check.opendatahub.io/reason": "image"},
+			},
+		}}
+
+		return dr
+	}
+
+	g.Expect(newResult().ValidateStrict()).To(Succeed())
+
+	// Validate accepts these results, but serializers and consumers do not
+	missingImpact := newResult()
+	missingImpact.Status.Conditions[0].Impact = result.ImpactNone
+	g.Expect(missingImpact.Validate()).To(Succeed())
+	g.Expect(missingImpact.ValidateStrict()).To(MatchError(ContainSubstring(`condition "Compatible": condition with Status="False" must have Impact specified`)))
+
+	missingTypeMeta := newResult()
+	missingTypeMeta.ImpactedObjects[0].TypeMeta = metav1.TypeMeta{}
+	g.Expect(missingTypeMeta.ValidateStrict()).To(MatchError("impacted object 0 (nb) must have apiVersion and kind set"))
+
+	invalidAnnotation := newResult()
+	invalidAnnotation.ImpactedObjects[0].Name = ""
+	invalidAnnotation.ImpactedObjects[0].Annotations["issues"] = "vllm"
+	err := invalidAnnotation.ValidateStrict()
+	g.Expect(err).To(MatchError(ContainSubstring("impacted object 0 must have a name")))
+	g.Expect(err).To(MatchError(ContainSubstring(`annotation key "issues" must be in domain/key format`)))
+
+	// Validate errors are included
+	emptyGroup := newResult()
+	emptyGroup.Group = ""
+	g.Expect(emptyGroup.ValidateStrict()).To(MatchError(ContainSubstring("group must not be empty")))
+}
@@ -0,0 +1,89 @@
+package check
+
+import (
+	"context"
+	"errors"
+	"net"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+)
+
+// IsTransient returns true if err is an API error that may not recur on retry: timeouts,
+// throttling, an unavailable or failing API server, and dropped connections.
+// Permission errors and invalid check results are not transient.
+func IsTransient(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	switch {
+	case apierrors.IsTimeout(err),
+		apierrors.IsServerTimeout(err),
+		apierrors.IsServiceUnavailable(err),
+		apierrors.IsTooManyRequests(err),
+		apierrors.IsInternalError(err),
+		apierrors.IsUnexpectedServerError(err):
+		return true
+	case utilnet.IsConnectionRefused(err),
+		utilnet.IsConnectionReset(err),
+		utilnet.IsProbableEOF(err):
+		return true
+	}
+
+	var netErr net.Error
+
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// isRetryable returns true if the execution returned Unknown because of a transient error.
+func isRetryable(exec CheckExecution) bool {
+	if exec.Result == nil || !IsTransient(exec.Error) {
+		return false
+	}
+
+	for _, cond := range exec.Result.Status.Conditions {
+		if cond.Status == metav1.ConditionUnknown {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RetryUnknown executes once more the checks in executions that returned Unknown because of a
+// transient API error, against the target they were executed against, and replaces their results
+// in place. Retries stop when ctx is done, so they only use the time left of the run.
+//
+// It returns the number of checks retried and the number that no longer returned a transient error.
+func (e *Executor) RetryUnknown(ctx context.Context, executions []CheckExecution) (int, int) {
+	retried := 0
+	recovered := 0
+
+	for i, exec := range executions {
+		if !isRetryable(exec) {
+			continue
+		}
+
+		if err := CheckContextError(ctx); err != nil {
+			break
+		}
+
+		retried++
+
+		retry := e.executeChecks(ctx, exec.target, []Check{exec.Check})
+		if len(retry) == 0 {
+			// The check no longer applies, or the context ended; keep the original result
+			continue
+		}
+
+		if !IsTransient(retry[0].Error) {
+			recovered++
+		}
+
+		executions[i] = retry[0]
+	}
+
+	return retried, recovered
+}
@@ -0,0 +1,135 @@
+package check_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+
+	. "github.com/onsi/gomega"
+)
+
+// flakyCheck returns the queued errors on successive calls to Validate, then passes.
+type flakyCheck struct {
+	check.BaseCheck
+
+	errs  []error
+	calls int
+}
+
+func (c *flakyCheck) CanApply(_ context.Context, _ check.Target) (bool, error) {
+	return true, nil
+}
+
+func (c *flakyCheck) Validate(_ context.Context, _ check.Target) (*result.DiagnosticResult, error) {
+	c.calls++
+
+	if len(c.errs) > 0 {
+		err := c.errs[0]
+		c.errs = c.errs[1:]
+
+		return nil, err
+	}
+
+	dr := c.NewResult()
+	dr.Status.Conditions = []result.Condition{
+		check.NewCondition(check.ConditionTypeValidated, metav1.ConditionTrue, check.WithReason(check.ReasonRequirementsMet)),
+	}
+
+	return dr, nil
+}
+
+func newFlakyCheck(id string, errs ...error) *flakyCheck {
+	return &flakyCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup: check.GroupComponent,
+			Kind:       id,
+			Type:       check.CheckTypeRemoval,
+			CheckID:    id,
+			CheckName:  id,
+		},
+		errs: errs,
+	}
+}
+
+func TestIsTransient(t *testing.T) {
+	g := NewWithT(t)
+
+	gr := schema.GroupResource{Resource: "notebooks"}
+
+	g.Expect(check.IsTransient(nil)).To(BeFalse())
+	g.Expect(check.IsTransient(apierrors.NewServiceUnavailable("busy"))).To(BeTrue())
+	g.Expect(check.IsTransient(apierrors.NewTooManyRequests("slow down", 1))).To(BeTrue())
+	g.Expect(check.IsTransient(apierrors.NewTimeoutError("timeout", 1))).To(BeTrue())
+	g.Expect(check.IsTransient(apierrors.NewForbidden(gr, "x", errors.New("denied")))).To(BeFalse())
+	g.Expect(check.IsTransient(errors.New("invalid spec"))).To(BeFalse())
+}
+
+func TestRetryUnknown(t *testing.T) {
+	g := NewWithT(t)
+
+	transient := newFlakyCheck("components.transient", apierrors.NewServiceUnavailable("busy"))
+	persistent := newFlakyCheck("components.persistent",
+		apierrors.NewTooManyRequests("slow down", 1), apierrors.NewTooManyRequests("slow down", 1))
+	denied := newFlakyCheck("components.denied", apierrors.NewForbidden(schema.GroupResource{}, "x", errors.New("denied")))
+	passing := newFlakyCheck("components.passing")
+
+	registry := check.NewRegistry()
+	for _, chk := range []check.Check{transient, persistent, denied, passing} {
+		registry.MustRegister(chk)
+	}
+
+	executor := check.NewExecutor(registry, nil)
+
+	executions, err := executor.ExecuteSelective(t.Context(), check.Target{}, []string{"*"}, check.GroupComponent)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(executions).To(HaveLen(4))
+
+	retried, recovered := executor.RetryUnknown(t.Context(), executions)
+
+	g.Expect(retried).To(Equal(2))
+	g.Expect(recovered).To(Equal(1))
+
+	byID := make(map[string]check.CheckExecution)
+	for _, exec := range executions {
+		byID[exec.Check.ID()] = exec
+	}
+
+	g.Expect(byID["components.transient"].Error).ToNot(HaveOccurred())
+	g.Expect(byID["components.transient"].Result.IsFailing()).To(BeFalse())
+	g.Expect(byID["components.persistent"].Result.IsFailing()).To(BeTrue())
+	g.Expect(byID["components.denied"].Result.IsFailing()).To(BeTrue())
+
+	// Permission errors and passing checks are not re-executed.
+	g.Expect(denied.calls).To(Equal(1))
+	g.Expect(passing.calls).To(Equal(1))
+}
+
+func TestRetryUnknown_StopsWhenContextIsDone(t *testing.T) {
+	g := NewWithT(t)
+
+	flaky := newFlakyCheck("components.flaky", apierrors.NewServiceUnavailable("busy"))
+
+	registry := check.NewRegistry()
+	registry.MustRegister(flaky)
+
+	executor := check.NewExecutor(registry, nil)
+
+	executions, err := executor.ExecuteSelective(t.Context(), check.Target{}, []string{"*"}, check.GroupComponent)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	retried, _ := executor.RetryUnknown(ctx, executions)
+
+	g.Expect(retried).To(BeZero())
+	g.Expect(flaky.calls).To(Equal(1))
+	g.Expect(executions[0].Result.IsFailing()).To(BeTrue())
+}
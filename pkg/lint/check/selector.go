@@ -12,12 +12,18 @@ const (
 	SelectorServices     = "services"
 	SelectorWorkloads    = "workloads"
 	SelectorDependencies = "dependencies"
+	SelectorHealth       = "health"
+
+	// SelectorZStream selects the z-stream upgrade profile: the checks gated on
+	// upgrades between 2.x releases.
+	SelectorZStream = "zstream"
 )
 
 // matchesPattern returns true if the check matches the selector pattern
 // Pattern can be:
 //   - Wildcard: "*" matches all checks
-//   - Group shortcut: "components", "services", "workloads", "dependencies"
+//   - Group shortcut: "components", "services", "workloads", "dependencies", "health"
+//   - Profile shortcut: "zstream"
 //   - Exact ID: "components.dashboard"
 //   - Glob pattern: "components.*", "*dashboard*", "*.dashboard"
 func matchesPattern(check Check, pattern string) (bool, error) {
@@ -36,6 +42,12 @@ func matchesPattern(check Check, pattern string) (bool, error) {
 		return check.Group() == GroupWorkload, nil
 	case SelectorDependencies:
 		return check.Group() == GroupDependency, nil
+	case SelectorHealth:
+		return check.Group() == GroupHealth, nil
+	case SelectorZStream:
+		described, ok := check.(GraphDescriber)
+
+		return ok && described.VersionGate() == VersionGateUpgradeWithin2x, nil
 	}
 
 	// Exact ID match
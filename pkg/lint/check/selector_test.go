@@ -294,3 +294,19 @@ func TestMatchesPattern_InvalidPattern(t *testing.T) {
 	g.Expect(err).To(HaveOccurred())
 	g.Expect(err.Error()).To(ContainSubstring("invalid pattern"))
 }
+
+func TestMatchesPattern_ZStreamProfile(t *testing.T) {
+	g := NewWithT(t)
+
+	zstream := newFlakyCheck("components.zstream")
+	zstream.CheckVersionGate = check.VersionGateUpgradeWithin2x
+
+	registry := check.NewRegistry()
+	registry.MustRegister(zstream)
+	registry.MustRegister(newFlakyCheck("components.other"))
+
+	results, err := registry.ListByPattern(check.SelectorZStream, "")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(results).To(HaveLen(1))
+	g.Expect(results[0].ID()).To(Equal("components.zstream"))
+}
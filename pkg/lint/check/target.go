@@ -7,6 +7,8 @@ import (
 
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
 	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+	"github.com/opendatahub-io/odh-cli/pkg/util/registry"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
 )
 
 // Target holds all context needed for executing diagnostic checks, including cluster version and optional resource.
@@ -27,6 +29,10 @@ type Target struct {
 	// Nil if no target version available
 	TargetVersion *semver.Version
 
+	// Flavor is the management flavor of the installation (self-managed or the managed cloud
+	// service). Empty when not detected, in which case flavor-gated checks do not run.
+	Flavor version.Flavor
+
 	// Resource is the specific resource being validated (optional)
 	// Only set for workload checks that operate on discovered CRs
 	// Nil for component and service checks
@@ -41,4 +47,18 @@ type Target struct {
 	// When true, checks should emit internal processing logs for troubleshooting
 	// When false, only user-facing summary information should be logged via IO
 	Debug bool
+
+	// Probe enables opt-in checks that connect to workload endpoints, e.g. the gRPC APIs of
+	// model servers (lint --probe). Checks must not send requests to workloads when false.
+	Probe bool
+
+	// ImageStreamNamespaces overrides the namespaces the out-of-the-box workbench ImageStreams
+	// are looked up in (lint --imagestream-namespace). When empty, checks use the applications
+	// namespace of the DSCInitialization.
+	ImageStreamNamespaces []string
+
+	// Registry inspects the metadata of container images in their registries, e.g. to
+	// classify custom workbench images (lint --inspect-registry). Nil when disabled; checks
+	// must not contact registries then.
+	Registry *registry.Client
 }
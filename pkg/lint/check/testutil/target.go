@@ -30,7 +30,7 @@ type TargetConfig struct {
 
 // NewTarget builds a check.Target from fake clients, reducing test boilerplate.
 // Objects are automatically registered in both the dynamic and metadata fake clients.
-func NewTarget(t *testing.T, cfg TargetConfig) check.Target {
+func NewTarget(t testing.TB, cfg TargetConfig) check.Target {
 	t.Helper()
 
 	scheme := runtime.NewScheme()
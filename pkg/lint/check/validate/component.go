@@ -11,7 +11,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
+	"github.com/opendatahub-io/odh-cli/pkg/constants"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/remediation"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
 	"github.com/opendatahub-io/odh-cli/pkg/util/components"
@@ -60,6 +62,9 @@ type ComponentRequest struct {
 	// ManagementState is the component's management state string.
 	ManagementState string
 
+	// ComponentName is the key under spec.components being validated (e.g. "codeflare").
+	ComponentName string
+
 	// Client provides read-only access to the Kubernetes API.
 	Client client.Reader
 
@@ -102,6 +107,7 @@ func (b *ComponentBuilder) WithApplicationsNamespace() *ComponentBuilder {
 
 // Removal returns a ComponentValidateFn that sets a compatibility failure condition.
 // ManagementState is automatically prepended as the first format argument.
+// A remediation command setting the component to Removed is attached automatically.
 //
 // Example:
 //
@@ -113,6 +119,9 @@ func Removal(format string, opts ...check.ConditionOption) ComponentValidateFn {
 		allOpts := append([]check.ConditionOption{
 			check.WithReason(check.ReasonVersionIncompatible),
 			check.WithMessage(format, req.ManagementState),
+			check.WithRemediationCommands(remediation.ComponentManagementState(
+				req.DSC.GetName(), req.ComponentName, constants.ManagementStateRemoved,
+			)),
 		}, opts...)
 		req.Result.SetCondition(check.NewCondition(
 			check.ConditionTypeCompatible,
@@ -198,6 +207,7 @@ func (b *ComponentBuilder) Run(
 		Result:          dr,
 		DSC:             dsc,
 		ManagementState: state,
+		ComponentName:   b.componentName,
 		Client:          b.target.Client,
 	}
 
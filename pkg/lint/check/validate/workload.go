@@ -47,12 +47,14 @@ type WorkloadConditionFn[T any] func(ctx context.Context, req *WorkloadRequest[T
 
 // WorkloadBuilder provides a fluent API for workload-based lint checks.
 // It handles resource listing, CRD-not-found handling, filtering, annotation population,
-// and auto-populating ImpactedObjects.
+// and auto-populating ImpactedObjects. Resources are listed one page at a time and filtered
+// as each page arrives, so only the matching items are held in memory.
 type WorkloadBuilder[T kube.NamespacedNamer] struct {
 	check        check.Check
 	target       check.Target
 	resourceType resources.ResourceType
-	listFn       func(ctx context.Context) ([]T, error)
+	listFn       func(ctx context.Context, fn func([]T) error, opts ...client.ListResourcesOption) error
+	listOpts     []client.ListResourcesOption
 	filterFn     func(T) (bool, error)
 }
 
@@ -67,8 +69,12 @@ func Workloads(
 		check:        c,
 		target:       target,
 		resourceType: resourceType,
-		listFn: func(ctx context.Context) ([]*unstructured.Unstructured, error) {
-			return target.Client.List(ctx, resourceType)
+		listFn: func(
+			ctx context.Context,
+			fn func([]*unstructured.Unstructured) error,
+			opts ...client.ListResourcesOption,
+		) error {
+			return client.ForEachPage(ctx, target.Client, resourceType, fn, opts...)
 		},
 	}
 }
@@ -84,12 +90,25 @@ func WorkloadsMetadata(
 		check:        c,
 		target:       target,
 		resourceType: resourceType,
-		listFn: func(ctx context.Context) ([]*metav1.PartialObjectMetadata, error) {
-			return target.Client.ListMetadata(ctx, resourceType)
+		listFn: func(
+			ctx context.Context,
+			fn func([]*metav1.PartialObjectMetadata) error,
+			opts ...client.ListResourcesOption,
+		) error {
+			return client.ForEachMetadataPage(ctx, target.Client, resourceType, fn, opts...)
 		},
 	}
 }
 
+// ListOptions narrows the listing server-side, e.g. with client.WithLabelSelector,
+// client.WithFieldSelector or client.WithNamespace, so items the check does not need are
+// never transferred. Prefer it to Filter when the condition can be expressed as a selector.
+func (b *WorkloadBuilder[T]) ListOptions(opts ...client.ListResourcesOption) *WorkloadBuilder[T] {
+	b.listOpts = append(b.listOpts, opts...)
+
+	return b
+}
+
 // Filter adds an optional predicate to select only matching items.
 // Items for which fn returns false are excluded before the validation function is called.
 // If fn returns an error, Run stops and propagates it.
@@ -116,28 +135,42 @@ func (b *WorkloadBuilder[T]) Run(
 		dr.Annotations[check.AnnotationCheckTargetVersion] = b.target.TargetVersion.String()
 	}
 
-	// List resources; treat CRD-not-found as empty list.
-	items, err := b.listFn(ctx)
-	if err != nil && !client.IsResourceTypeNotFound(err) {
-		return nil, fmt.Errorf("listing %s resources: %w", b.resourceType.Kind, err)
-	}
+	// List resources page by page, keeping only the items matching the filter;
+	// treat CRD-not-found as empty list.
+	var items []T
+
+	var filterErr error
 
-	// Apply filter if set.
-	if b.filterFn != nil {
-		filtered := make([]T, 0, len(items))
+	err := b.listFn(ctx, func(page []T) error {
+		if b.filterFn == nil {
+			items = append(items, page...)
 
-		for _, item := range items {
+			return nil
+		}
+
+		for _, item := range page {
 			match, err := b.filterFn(item)
 			if err != nil {
-				return nil, fmt.Errorf("filtering %s resources: %w", b.resourceType.Kind, err)
+				filterErr = err
+
+				return err
 			}
 
 			if match {
-				filtered = append(filtered, item)
+				items = append(items, item)
 			}
 		}
 
-		items = filtered
+		return nil
+	}, b.listOpts...)
+
+	switch {
+	case filterErr != nil:
+		return nil, fmt.Errorf("filtering %s resources: %w", b.resourceType.Kind, filterErr)
+	case err != nil && !client.IsResourceTypeNotFound(err):
+		return nil, fmt.Errorf("listing %s resources: %w", b.resourceType.Kind, err)
+	case err != nil:
+		items = nil
 	}
 
 	dr.Annotations[check.AnnotationImpactedWorkloadCount] = strconv.Itoa(len(items))
@@ -13,6 +13,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	dynamicfake "k8s.io/client-go/dynamic/fake"
 	metadatafake "k8s.io/client-go/metadata/fake"
+	k8stesting "k8s.io/client-go/testing"
 
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
@@ -174,6 +175,63 @@ func TestWorkloadBuilder_FullObjectListing_WithFilter(t *testing.T) {
 	g.Expect(dr.ImpactedObjects[0].Name).To(Equal("job-match"))
 }
 
+func TestWorkloadBuilder_ListOptions_SelectsServerSide(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	nb1 := &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.Notebook.APIVersion(),
+			"kind":       resources.Notebook.Kind,
+			"metadata": map[string]any{
+				"name":      "nb-dashboard",
+				"namespace": "ns1",
+				"labels":    map[string]any{"opendatahub.io/dashboard": "true"},
+			},
+		},
+	}
+
+	nb2 := &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.Notebook.APIVersion(),
+			"kind":       resources.Notebook.Kind,
+			"metadata":   map[string]any{"name": "nb-other", "namespace": "ns1"},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme, notebookListKinds, nb1, nb2)
+
+	c := client.NewForTesting(client.TestClientConfig{
+		Dynamic: dynamicClient,
+	})
+
+	targetVer := semver.MustParse("3.0.0")
+	target := check.Target{
+		Client:        c,
+		TargetVersion: &targetVer,
+	}
+
+	dr, err := validate.Workloads(newWorkloadTestCheck(), target, resources.Notebook).
+		ListOptions(client.WithLabelSelector("opendatahub.io/dashboard=true")).
+		Run(ctx, func(_ context.Context, req *validate.WorkloadRequest[*unstructured.Unstructured]) error {
+			g.Expect(req.Items).To(HaveLen(1))
+			g.Expect(req.Items[0].GetName()).To(Equal("nb-dashboard"))
+
+			return nil
+		})
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "1"))
+
+	// The selector is sent with the list request.
+	actions := dynamicClient.Actions()
+	g.Expect(actions).To(HaveLen(1))
+	g.Expect(actions[0]).To(BeAssignableToTypeOf(k8stesting.ListActionImpl{}))
+	g.Expect(actions[0].(k8stesting.ListActionImpl).GetListRestrictions().Labels.String()).
+		To(Equal("opendatahub.io/dashboard=true"))
+}
+
 func TestWorkloadBuilder_FilterError_Propagated(t *testing.T) {
 	g := NewWithT(t)
 	ctx := t.Context()
@@ -8,6 +8,7 @@ import (
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
 	"github.com/opendatahub-io/odh-cli/pkg/util/components"
 	"github.com/opendatahub-io/odh-cli/pkg/util/version"
@@ -15,6 +16,25 @@ import (
 
 const kind = "codeflare"
 
+var _ check.Fixable = (*RemovalCheck)(nil)
+
+// Examples rendered by 'lint explain'.
+const (
+	removalFailingExample = `apiVersion: datasciencecluster.opendatahub.io/v1
+kind: DataScienceCluster
+spec:
+  components:
+    codeflare:
+      managementState: Managed`
+
+	removalPassingExample = `apiVersion: datasciencecluster.opendatahub.io/v1
+kind: DataScienceCluster
+spec:
+  components:
+    codeflare:
+      managementState: Removed`
+)
+
 // RemovalCheck validates that CodeFlare is disabled before upgrading to 3.x.
 type RemovalCheck struct {
 	check.BaseCheck
@@ -30,6 +50,25 @@ func NewRemovalCheck() *RemovalCheck {
 			CheckName:        "Components :: CodeFlare :: Removal (3.x)",
 			CheckDescription: "Validates that CodeFlare is disabled before upgrading from RHOAI 2.x to 3.x (component will be removed)",
 			CheckRemediation: "Disable CodeFlare by setting managementState to 'Removed' in DataScienceCluster before upgrading",
+			CheckResources: []resources.ResourceType{
+				resources.DataScienceCluster,
+			},
+			// --fix sets the CodeFlare managementState
+			CheckPermissions: []resources.Permission{
+				resources.DataScienceCluster.Permission(resources.VerbUpdate),
+			},
+			CheckVersionGate:    check.VersionGateUpgrade2xTo3x,
+			CheckKnowledgeLinks: []string{check.DocsDistributedWorkloads},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "The CodeFlare managementState in the DataScienceCluster. The check only runs when upgrading from 2.x to 3.x with CodeFlare Managed.",
+				Rationale:      "RHOAI 3.x no longer ships CodeFlare. The upgrade cannot reconcile a DataScienceCluster that still manages it, and its RayCluster and AppWrapper controllers stop running.",
+				FailingExample: removalFailingExample,
+				PassingExample: removalPassingExample,
+				RemediationCommands: []string{
+					`kubectl patch datasciencecluster default-dsc --type merge -p '{"spec":{"components":{"codeflare":{"managementState":"Removed"}}}}'`,
+					"kubectl odh lint --target-version 3.0 --checks components.codeflare.removal --fix",
+				},
+			},
 		},
 	}
 }
@@ -56,3 +95,8 @@ func (c *RemovalCheck) Validate(ctx context.Context, target check.Target) (*resu
 			check.WithImpact(result.ImpactBlocking),
 			check.WithRemediation(c.CheckRemediation)))
 }
+
+// Fix disables CodeFlare by setting its managementState to Removed.
+func (c *RemovalCheck) Fix(ctx context.Context, target check.FixTarget, _ *result.DiagnosticResult) ([]check.Change, error) {
+	return check.SetComponentManagementState(ctx, target, kind, constants.ManagementStateRemoved)
+}
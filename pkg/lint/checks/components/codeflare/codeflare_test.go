@@ -12,6 +12,7 @@ import (
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/components/codeflare"
 	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
 
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
@@ -80,6 +81,9 @@ func TestCodeFlareRemovalCheck_ManagedBlocking(t *testing.T) {
 		"Message": And(ContainSubstring("enabled"), ContainSubstring("removed in RHOAI 3.x")),
 	}))
 	g.Expect(result.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactBlocking))
+	g.Expect(result.GetRemediationCommands()).To(ConsistOf(
+		`kubectl patch datascienceclusters.datasciencecluster.opendatahub.io 'default-dsc' --type=merge -p '{"spec":{"components":{"codeflare":{"managementState":"Removed"}}}}'`,
+	))
 	g.Expect(result.Annotations).To(And(
 		HaveKeyWithValue("component.opendatahub.io/management-state", "Managed"),
 		HaveKeyWithValue("check.opendatahub.io/target-version", "3.0.0"),
@@ -128,3 +132,29 @@ func TestCodeFlareRemovalCheck_Metadata(t *testing.T) {
 	g.Expect(codeflareCheck.Group()).To(Equal(check.GroupComponent))
 	g.Expect(codeflareCheck.Description()).ToNot(BeEmpty())
 }
+
+func TestCodeFlareRemovalCheck_Fix(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      listKinds,
+		Objects:        []*unstructured.Unstructured{testutil.NewDSC(map[string]string{"codeflare": "Managed"})},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	c, ok := target.Client.(client.Client)
+	g.Expect(ok).To(BeTrue())
+
+	chk := codeflare.NewRemovalCheck()
+	changes, err := chk.Fix(t.Context(), check.FixTarget{Client: c}, nil)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(changes).To(HaveLen(1))
+	g.Expect(changes[0].Description).To(Equal("spec.components.codeflare.managementState: Managed -> Removed"))
+
+	// The fixed state no longer needs the check
+	canApply, err := chk.CanApply(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeFalse())
+}
@@ -13,6 +13,36 @@ import (
 	"github.com/opendatahub-io/odh-cli/pkg/util/version"
 )
 
+// AcceleratorProfileMigrationID is the migrate action converting AcceleratorProfiles to HardwareProfiles.
+const AcceleratorProfileMigrationID = "dashboard.acceleratorprofiles.migrate"
+
+// Examples rendered by 'lint explain'.
+const (
+	acceleratorProfileFailingExample = `apiVersion: dashboard.opendatahub.io/v1
+kind: AcceleratorProfile
+metadata:
+  name: nvidia-gpu
+  namespace: redhat-ods-applications
+spec:
+  identifier: nvidia.com/gpu
+  tolerations:
+  - key: nvidia.com/gpu
+    operator: Exists
+    effect: NoSchedule`
+
+	acceleratorProfilePassingExample = `apiVersion: infrastructure.opendatahub.io/v1
+kind: HardwareProfile
+metadata:
+  name: nvidia-gpu
+  namespace: redhat-ods-applications
+spec:
+  identifiers:
+  - identifier: nvidia.com/gpu
+    displayName: GPU
+    defaultCount: 1
+    minCount: 1`
+)
+
 // AcceleratorProfileMigrationCheck detects legacy AcceleratorProfiles that will be auto-migrated to
 // HardwareProfiles (infrastructure.opendatahub.io) during upgrade to RHOAI 3.x.
 type AcceleratorProfileMigrationCheck struct {
@@ -30,6 +60,22 @@ func NewAcceleratorProfileMigrationCheck() *AcceleratorProfileMigrationCheck {
 			CheckName:        "Components :: Dashboard :: AcceleratorProfile Migration (3.x)",
 			CheckDescription: "Lists legacy AcceleratorProfiles that will be auto-migrated to HardwareProfiles (infrastructure.opendatahub.io) during upgrade",
 			CheckRemediation: "Legacy AcceleratorProfiles will be automatically migrated to HardwareProfiles (infrastructure.opendatahub.io) during upgrade - no manual action required",
+			CheckResources: []resources.ResourceType{
+				resources.AcceleratorProfile,
+			},
+			CheckVersionGate:    check.VersionGateUpgrade2xTo3x,
+			CheckKnowledgeLinks: []string{check.DocsAccelerators},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "The AcceleratorProfiles (dashboard.opendatahub.io) in the cluster, listed as metadata. The check only runs when upgrading from 2.x to 3.x and is informational.",
+				Rationale:      "RHOAI 3.x replaces AcceleratorProfiles with HardwareProfiles (infrastructure.opendatahub.io). The upgrade converts them automatically; listing them ahead of time lets you review the generated HardwareProfiles and the workloads that reference them.",
+				FailingExample: acceleratorProfileFailingExample,
+				PassingExample: acceleratorProfilePassingExample,
+				RemediationCommands: []string{
+					"kubectl get acceleratorprofiles.dashboard.opendatahub.io -A",
+					"kubectl get hardwareprofiles.infrastructure.opendatahub.io -A",
+					"kubectl odh migrate run --migration " + AcceleratorProfileMigrationID + " --dry-run",
+				},
+			},
 		},
 	}
 }
@@ -0,0 +1,210 @@
+package dashboard
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/opendatahub-io/odh-cli/pkg/constants"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+const (
+	checkTypeCustomResources = "custom-resources-migration"
+
+	// AnnotationReplacement is the 3.x resource an impacted dashboard resource must be converted to.
+	AnnotationReplacement = "check.opendatahub.io/replacement"
+
+	// labelPartOf marks the resources deployed by the operator; resources without it are user-created.
+	labelPartOf = "platform.opendatahub.io/part-of"
+)
+
+// customResourceKind is a dashboard resource type replaced in 3.x, and the resource its
+// user-created instances must be converted to.
+type customResourceKind struct {
+	resourceType resources.ResourceType
+	replacement  string
+}
+
+// customResourceKinds are the dashboard resource types the 3.x dashboard no longer reads.
+//
+//nolint:gochecknoglobals // Read-only resource mapping
+var customResourceKinds = []customResourceKind{
+	{resourceType: resources.OdhApplication, replacement: "ConsoleLink (ApplicationMenu)"},
+	{resourceType: resources.OdhDocument, replacement: "ConsoleLink (HelpMenu)"},
+	{resourceType: resources.OdhQuickStart, replacement: "ConsoleQuickStart"},
+	{resourceType: resources.AcceleratorProfile, replacement: "HardwareProfile (infrastructure.opendatahub.io)"},
+}
+
+// Examples rendered by 'lint explain'.
+const (
+	customResourcesFailingExample = `apiVersion: console.openshift.io/v1
+kind: OdhQuickStart
+metadata:
+  name: fine-tune-llm
+  namespace: redhat-ods-applications   # no platform.opendatahub.io/part-of label
+spec:
+  displayName: Fine-tune an LLM`
+
+	customResourcesPassingExample = `apiVersion: console.openshift.io/v1
+kind: ConsoleQuickStart
+metadata:
+  name: fine-tune-llm
+spec:
+  displayName: Fine-tune an LLM`
+)
+
+// CustomResourcesMigrationCheck lists the user-created OdhApplications, OdhDocuments,
+// OdhQuickStarts and AcceleratorProfiles, which the 3.x dashboard replaces with console
+// resources and HardwareProfiles.
+type CustomResourcesMigrationCheck struct {
+	check.BaseCheck
+}
+
+// NewCustomResourcesMigrationCheck creates a new CustomResourcesMigrationCheck instance.
+func NewCustomResourcesMigrationCheck() *CustomResourcesMigrationCheck {
+	return &CustomResourcesMigrationCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupComponent,
+			Kind:             constants.ComponentDashboard,
+			Type:             checkTypeCustomResources,
+			CheckID:          "components.dashboard.custom-resources-migration",
+			CheckName:        "Components :: Dashboard :: Custom Resources Migration (3.x)",
+			CheckDescription: "Lists user-created OdhApplications, OdhDocuments, OdhQuickStarts and AcceleratorProfiles that the RHOAI 3.x dashboard no longer reads and that need conversion to console resources or HardwareProfiles",
+			CheckRemediation: "Recreate user-created OdhApplications and OdhDocuments as ConsoleLinks and OdhQuickStarts as ConsoleQuickStarts, and review the HardwareProfiles generated from AcceleratorProfiles after upgrading",
+			CheckResources: []resources.ResourceType{
+				resources.OdhApplication,
+				resources.OdhDocument,
+				resources.OdhQuickStart,
+				resources.AcceleratorProfile,
+				resources.InfrastructureHardwareProfile,
+			},
+			CheckVersionGate:    check.VersionGateUpgrade2xTo3x,
+			CheckKnowledgeLinks: []string{check.DocsManaging},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "The OdhApplications, OdhDocuments, OdhQuickStarts and AcceleratorProfiles without the platform.opendatahub.io/part-of label the operator sets on the resources it deploys. AcceleratorProfiles that already have an infrastructure.opendatahub.io HardwareProfile of the same name are skipped. The check only runs when upgrading from 2.x to 3.x.",
+				Rationale:      "The 3.x dashboard replaces its own tile, documentation and tutorial CRDs with the OpenShift console ConsoleLink and ConsoleQuickStart resources, and AcceleratorProfiles with HardwareProfiles. Resources the operator deployed are converted by the upgrade; user-created ones silently disappear from the dashboard unless they are converted.",
+				FailingExample: customResourcesFailingExample,
+				PassingExample: customResourcesPassingExample,
+				RemediationCommands: []string{
+					"kubectl get odhapplications,odhdocuments,odhquickstarts,acceleratorprofiles -A -l '!platform.opendatahub.io/part-of'",
+					"kubectl get consolelinks,consolequickstarts",
+				},
+			},
+		},
+	}
+}
+
+// CanApply returns whether this check should run for the given target.
+// Only applies when upgrading from 2.x to 3.x.
+func (c *CustomResourcesMigrationCheck) CanApply(_ context.Context, target check.Target) (bool, error) {
+	return version.IsUpgradeFrom2xTo3x(target.CurrentVersion, target.TargetVersion), nil
+}
+
+// Validate executes the check against the provided target.
+func (c *CustomResourcesMigrationCheck) Validate(
+	ctx context.Context,
+	target check.Target,
+) (*result.DiagnosticResult, error) {
+	dr := c.NewResult()
+
+	if target.TargetVersion != nil {
+		dr.Annotations[check.AnnotationCheckTargetVersion] = target.TargetVersion.String()
+	}
+
+	converted, err := listConvertedProfiles(ctx, target.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	impacted := make([]metav1.PartialObjectMetadata, 0)
+	counts := make([]string, 0, len(customResourceKinds))
+
+	for _, k := range customResourceKinds {
+		items, err := target.Client.ListMetadata(ctx, k.resourceType)
+		if err != nil {
+			if client.IsResourceTypeNotFound(err) {
+				continue
+			}
+
+			return nil, fmt.Errorf("listing %s: %w", k.resourceType.Kind, err)
+		}
+
+		count := 0
+
+		for _, item := range items {
+			if _, ok := item.GetLabels()[labelPartOf]; ok {
+				continue
+			}
+
+			if k.resourceType == resources.AcceleratorProfile && converted[types.NamespacedName{Namespace: item.GetNamespace(), Name: item.GetName()}] {
+				continue
+			}
+
+			impacted = append(impacted, metav1.PartialObjectMetadata{
+				TypeMeta: k.resourceType.TypeMeta(),
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   item.GetNamespace(),
+					Name:        item.GetName(),
+					Annotations: map[string]string{AnnotationReplacement: k.replacement},
+				},
+			})
+			count++
+		}
+
+		if count > 0 {
+			counts = append(counts, fmt.Sprintf("%d %s(s)", count, k.resourceType.Kind))
+		}
+	}
+
+	dr.Annotations[check.AnnotationImpactedWorkloadCount] = strconv.Itoa(len(impacted))
+	dr.ImpactedObjects = impacted
+	dr.SetCondition(c.newCondition(len(impacted), counts))
+
+	return dr, nil
+}
+
+func (c *CustomResourcesMigrationCheck) newCondition(impacted int, counts []string) result.Condition {
+	if impacted == 0 {
+		return check.NewCondition(
+			check.ConditionTypeMigrationRequired,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonNoMigrationRequired),
+			check.WithMessage("No user-created dashboard resources need conversion - no migration required"),
+		)
+	}
+
+	return check.NewCondition(
+		check.ConditionTypeMigrationRequired,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonMigrationPending),
+		check.WithMessage("Found %d user-created dashboard resource(s) that the 3.x dashboard no longer reads: %s", impacted, strings.Join(counts, ", ")),
+		check.WithImpact(result.ImpactAdvisory),
+		check.WithRemediation(c.CheckRemediation),
+	)
+}
+
+// listConvertedProfiles returns the HardwareProfiles (infrastructure.opendatahub.io), which
+// AcceleratorProfiles of the same namespace and name have already been converted to.
+func listConvertedProfiles(ctx context.Context, r client.Reader) (map[types.NamespacedName]bool, error) {
+	profiles, err := r.ListMetadata(ctx, resources.InfrastructureHardwareProfile)
+	if err != nil && !client.IsResourceTypeNotFound(err) {
+		return nil, fmt.Errorf("listing HardwareProfiles: %w", err)
+	}
+
+	converted := make(map[types.NamespacedName]bool, len(profiles))
+
+	for _, p := range profiles {
+		converted[types.NamespacedName{Namespace: p.GetNamespace(), Name: p.GetName()}] = true
+	}
+
+	return converted, nil
+}
@@ -0,0 +1,121 @@
+package dashboard_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/components/dashboard"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+//nolint:gochecknoglobals // Test fixture - shared across test functions
+var customResourcesListKinds = map[schema.GroupVersionResource]string{
+	resources.OdhApplication.GVR():                resources.OdhApplication.ListKind(),
+	resources.OdhDocument.GVR():                   resources.OdhDocument.ListKind(),
+	resources.OdhQuickStart.GVR():                 resources.OdhQuickStart.ListKind(),
+	resources.AcceleratorProfile.GVR():            resources.AcceleratorProfile.ListKind(),
+	resources.InfrastructureHardwareProfile.GVR(): resources.InfrastructureHardwareProfile.ListKind(),
+}
+
+func createDashboardResource(rt resources.ResourceType, name string, managed bool) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(rt.GVK())
+	obj.SetNamespace(testAcceleratorProfileNamespace1)
+	obj.SetName(name)
+
+	if managed {
+		obj.SetLabels(map[string]string{"platform.opendatahub.io/part-of": "dashboard"})
+	}
+
+	return obj
+}
+
+func TestCustomResourcesMigrationCheck_Validate_NoUserResources(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: customResourcesListKinds,
+		Objects: []*unstructured.Unstructured{
+			createDashboardResource(resources.OdhApplication, "jupyter", true),
+			createDashboardResource(resources.OdhQuickStart, "create-jupyter-notebook", true),
+		},
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := dashboard.NewCustomResourcesMigrationCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":   Equal(check.ConditionTypeMigrationRequired),
+		"Status": Equal(metav1.ConditionTrue),
+		"Reason": Equal(check.ReasonNoMigrationRequired),
+	}))
+	g.Expect(dr.Annotations[check.AnnotationImpactedWorkloadCount]).To(Equal("0"))
+	g.Expect(dr.ImpactedObjects).To(BeEmpty())
+}
+
+func TestCustomResourcesMigrationCheck_Validate_UserResources(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: customResourcesListKinds,
+		Objects: []*unstructured.Unstructured{
+			createDashboardResource(resources.OdhApplication, "jupyter", true),
+			createDashboardResource(resources.OdhApplication, "my-tool", false),
+			createDashboardResource(resources.OdhDocument, "my-howto", false),
+			createDashboardResource(resources.OdhQuickStart, "fine-tune-llm", false),
+			createDashboardResource(resources.AcceleratorProfile, testAcceleratorProfile1, false),
+			// Already converted to a HardwareProfile
+			createDashboardResource(resources.AcceleratorProfile, testAcceleratorProfile2, false),
+			createDashboardResource(resources.InfrastructureHardwareProfile, testAcceleratorProfile2, false),
+		},
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := dashboard.NewCustomResourcesMigrationCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":    Equal(check.ConditionTypeMigrationRequired),
+		"Status":  Equal(metav1.ConditionFalse),
+		"Reason":  Equal(check.ReasonMigrationPending),
+		"Message": ContainSubstring("Found 4 user-created dashboard resource(s) that the 3.x dashboard no longer reads: 1 OdhApplication(s), 1 OdhDocument(s), 1 OdhQuickStart(s), 1 AcceleratorProfile(s)"),
+	}))
+	g.Expect(dr.Status.Conditions[0].Impact).To(Equal(result.ImpactAdvisory))
+	g.Expect(dr.Annotations[check.AnnotationImpactedWorkloadCount]).To(Equal("4"))
+	g.Expect(dr.ImpactedObjects).To(HaveLen(4))
+	g.Expect(dr.ImpactedObjects).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+		"TypeMeta": MatchFields(IgnoreExtras, Fields{"Kind": Equal(resources.OdhQuickStart.Kind)}),
+		"ObjectMeta": MatchFields(IgnoreExtras, Fields{
+			"Name":        Equal("fine-tune-llm"),
+			"Annotations": HaveKeyWithValue(dashboard.AnnotationReplacement, "ConsoleQuickStart"),
+		}),
+	})))
+	g.Expect(dr.ImpactedObjects).ToNot(ContainElement(MatchFields(IgnoreExtras, Fields{
+		"ObjectMeta": MatchFields(IgnoreExtras, Fields{"Name": Equal(testAcceleratorProfile2)}),
+	})))
+}
+
+func TestCustomResourcesMigrationCheck_Metadata(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := dashboard.NewCustomResourcesMigrationCheck()
+
+	g.Expect(chk.ID()).To(Equal("components.dashboard.custom-resources-migration"))
+	g.Expect(chk.Name()).To(Equal("Components :: Dashboard :: Custom Resources Migration (3.x)"))
+	g.Expect(chk.Group()).To(Equal(check.GroupComponent))
+	g.Expect(chk.Description()).ToNot(BeEmpty())
+}
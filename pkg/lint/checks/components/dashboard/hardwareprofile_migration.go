@@ -15,6 +15,31 @@ import (
 
 const hardwareProfileCheckType = "hardwareprofile-migration"
 
+// Examples rendered by 'lint explain'.
+const (
+	hardwareProfileFailingExample = `apiVersion: dashboard.opendatahub.io/v1alpha1
+kind: HardwareProfile
+metadata:
+  name: small
+  namespace: redhat-ods-applications
+spec:
+  identifiers:
+  - identifier: cpu
+    defaultCount: 2`
+
+	hardwareProfilePassingExample = `apiVersion: infrastructure.opendatahub.io/v1
+kind: HardwareProfile
+metadata:
+  name: small
+  namespace: redhat-ods-applications
+spec:
+  identifiers:
+  - identifier: cpu
+    displayName: CPU
+    defaultCount: 2
+    minCount: 1`
+)
+
 // HardwareProfileMigrationCheck detects legacy HardwareProfiles (opendatahub.io) that will be
 // auto-migrated to HardwareProfiles (infrastructure.opendatahub.io) during upgrade to RHOAI 3.x.
 type HardwareProfileMigrationCheck struct {
@@ -32,6 +57,20 @@ func NewHardwareProfileMigrationCheck() *HardwareProfileMigrationCheck {
 			CheckName:        "Components :: Dashboard :: HardwareProfile Migration (3.x)",
 			CheckDescription: "Lists legacy HardwareProfiles (opendatahub.io) that will be auto-migrated to HardwareProfiles (infrastructure.opendatahub.io) during upgrade",
 			CheckRemediation: "Legacy HardwareProfiles will be automatically migrated to HardwareProfiles (infrastructure.opendatahub.io) during upgrade - no manual action required",
+			CheckResources: []resources.ResourceType{
+				resources.HardwareProfile,
+			},
+			CheckVersionGate:    check.VersionGateUpgrade2xTo3x,
+			CheckKnowledgeLinks: []string{check.DocsAccelerators},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "The legacy HardwareProfiles of the dashboard.opendatahub.io API group, listed as metadata. The check only runs when upgrading from 2.x to 3.x and is informational.",
+				Rationale:      "RHOAI 3.x serves HardwareProfiles from infrastructure.opendatahub.io. The upgrade migrates the legacy objects automatically, but automation and GitOps repositories that create them in the old API group must be updated.",
+				FailingExample: hardwareProfileFailingExample,
+				PassingExample: hardwareProfilePassingExample,
+				RemediationCommands: []string{
+					"kubectl get hardwareprofiles.dashboard.opendatahub.io -A",
+				},
+			},
 		},
 	}
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
 	"github.com/opendatahub-io/odh-cli/pkg/util/components"
 	"github.com/opendatahub-io/odh-cli/pkg/util/version"
@@ -20,6 +21,23 @@ const (
 	checkTypeRenaming = "renaming"
 )
 
+// Examples rendered by 'lint explain'.
+const (
+	renamingFailingExample = `apiVersion: datasciencecluster.opendatahub.io/v1
+kind: DataScienceCluster
+spec:
+  components:
+    datasciencepipelines:
+      managementState: Managed`
+
+	renamingPassingExample = `apiVersion: datasciencecluster.opendatahub.io/v2
+kind: DataScienceCluster
+spec:
+  components:
+    aipipelines:
+      managementState: Managed`
+)
+
 type RenamingCheck struct {
 	check.BaseCheck
 }
@@ -34,6 +52,21 @@ func NewRenamingCheck() *RenamingCheck {
 			CheckName:        "Components :: DataSciencePipelines :: Component Renaming (3.x)",
 			CheckDescription: "Informs about DataSciencePipelines component renaming to AIPipelines in DSC v2 (RHOAI 3.x)",
 			CheckRemediation: "No action required - the component will be automatically renamed. Update any automation referencing '.spec.components.datasciencepipelines' to use '.spec.components.aipipelines' after upgrade",
+			CheckResources: []resources.ResourceType{
+				resources.DataScienceCluster,
+			},
+			CheckVersionGate:    check.VersionGateUpgrade2xTo3x,
+			CheckKnowledgeLinks: []string{check.DocsDataSciencePipelines},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "The DataSciencePipelines managementState in the DataScienceCluster. The check only runs when upgrading from 2.x to 3.x with the component Managed and is informational.",
+				Rationale:      "The DataScienceCluster v2 API of RHOAI 3.x renames the component to aipipelines. The operator converts the field during the upgrade, but scripts, GitOps manifests and policies reading or writing .spec.components.datasciencepipelines silently stop matching.",
+				FailingExample: renamingFailingExample,
+				PassingExample: renamingPassingExample,
+				RemediationCommands: []string{
+					`grep -rn "components.datasciencepipelines\|datasciencepipelines:" <gitops-repository>`,
+					"kubectl odh lint gitops --help",
+				},
+			},
 		},
 	}
 }
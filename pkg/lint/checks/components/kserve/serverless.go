@@ -9,8 +9,10 @@ import (
 
 	"github.com/opendatahub-io/odh-cli/pkg/constants"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/remediation"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
 	"github.com/opendatahub-io/odh-cli/pkg/util/components"
 	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
@@ -19,6 +21,28 @@ import (
 
 const checkType = "serverless-removal"
 
+// Examples rendered by 'lint explain'.
+const (
+	serverlessFailingExample = `apiVersion: datasciencecluster.opendatahub.io/v1
+kind: DataScienceCluster
+spec:
+  components:
+    kserve:
+      managementState: Managed
+      serving:
+        managementState: Managed`
+
+	serverlessPassingExample = `apiVersion: datasciencecluster.opendatahub.io/v1
+kind: DataScienceCluster
+spec:
+  components:
+    kserve:
+      managementState: Managed
+      defaultDeploymentMode: RawDeployment
+      serving:
+        managementState: Removed`
+)
+
 // ServerlessRemovalCheck validates that KServe serverless is disabled before upgrading to 3.x.
 type ServerlessRemovalCheck struct {
 	check.BaseCheck
@@ -34,6 +58,21 @@ func NewServerlessRemovalCheck() *ServerlessRemovalCheck {
 			CheckName:        "Components :: KServe :: Serverless Removal (3.x)",
 			CheckDescription: "Validates that KServe serverless mode is disabled before upgrading from RHOAI 2.x to 3.x (serverless support will be removed)",
 			CheckRemediation: "Disable KServe serverless mode by setting serving.managementState to 'Removed' in DataScienceCluster before upgrading",
+			CheckResources: []resources.ResourceType{
+				resources.DataScienceCluster,
+			},
+			CheckVersionGate:    check.VersionGateUpgrade2xTo3x,
+			CheckKnowledgeLinks: []string{check.DocsServingModels},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "The .spec.components.kserve.serving.managementState field of the DataScienceCluster, which enables the Knative Serving (Serverless) deployment mode. The check only runs when upgrading from 2.x to 3.x with KServe Managed.",
+				Rationale:      "RHOAI 3.x serves models only in RawDeployment mode and no longer installs or configures Knative Serving. An upgrade with serving still Managed or Unmanaged is blocked, and Serverless InferenceServices would lose their routes.",
+				FailingExample: serverlessFailingExample,
+				PassingExample: serverlessPassingExample,
+				RemediationCommands: []string{
+					"kubectl odh lint --target-version 3.0 --checks workloads.kserve.impacted-workloads --verbose",
+					`kubectl patch datasciencecluster default-dsc --type merge -p '{"spec":{"components":{"kserve":{"serving":{"managementState":"Removed"}}}}}'`,
+				},
+			},
 		},
 	}
 }
@@ -76,6 +115,10 @@ func (c *ServerlessRemovalCheck) Validate(ctx context.Context, target check.Targ
 					check.WithMessage("KServe serverless mode is enabled (state: %s) but will be removed in RHOAI 3.x", state),
 					check.WithImpact(result.ImpactBlocking),
 					check.WithRemediation(c.CheckRemediation),
+					check.WithRemediationCommands(remediation.SetField(
+						resources.DataScienceCluster, "", req.DSC.GetName(),
+						".spec.components.kserve.serving.managementState", constants.ManagementStateRemoved,
+					)),
 				))
 			default:
 				req.Result.SetCondition(check.NewCondition(
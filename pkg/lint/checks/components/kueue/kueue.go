@@ -2,6 +2,7 @@ package kueue
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -10,17 +11,44 @@ import (
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
 	"github.com/opendatahub-io/odh-cli/pkg/util/components"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube/olm"
+	kueueutil "github.com/opendatahub-io/odh-cli/pkg/util/kueue"
 	"github.com/opendatahub-io/odh-cli/pkg/util/version"
 )
 
+// ConditionTypeRHBOKCompatible reports whether an installed RHBoK operator satisfies the
+// minimum version required by the target RHOAI release.
+const ConditionTypeRHBOKCompatible = "RHBOKCompatible"
+
 const (
 	kind                       = "kueue"
 	checkTypeManagementState   = "management-state"
+	rhbokUpgradeRemediation    = "Upgrade the Red Hat Build of Kueue operator (switch its subscription to the required stable channel) before upgrading OpenShift AI"
 	managementStateRemediation = "Migrate to the RHBoK operator following https://docs.redhat.com/en/documentation/red_hat_openshift_ai_self-managed/2.25/html/managing_openshift_ai/managing-workloads-with-kueue#migrating-to-the-rhbok-operator_kueue before upgrading"
 )
 
+var _ check.Fixable = (*ManagementStateCheck)(nil)
+
+// Examples rendered by 'lint explain'.
+const (
+	managementStateFailingExample = `apiVersion: datasciencecluster.opendatahub.io/v1
+kind: DataScienceCluster
+spec:
+  components:
+    kueue:
+      managementState: Managed`
+
+	managementStatePassingExample = `apiVersion: datasciencecluster.opendatahub.io/v1
+kind: DataScienceCluster
+spec:
+  components:
+    kueue:
+      managementState: Unmanaged # Kueue provided by the Red Hat Build of Kueue operator`
+)
+
 // ManagementStateCheck validates that Kueue managed option is not used before upgrading to 3.x.
 // In RHOAI 3.x, the Managed option for Kueue is removed — users must migrate to the standalone
 // Kueue operator (RHBOK) and set managementState to Removed or Unmanaged.
@@ -38,6 +66,27 @@ func NewManagementStateCheck() *ManagementStateCheck {
 			CheckName:        "Components :: Kueue :: Management State (3.x)",
 			CheckDescription: "Validates that Kueue managementState is compatible with RHOAI 3.x (Managed option will be removed)",
 			CheckRemediation: managementStateRemediation,
+			CheckResources: []resources.ResourceType{
+				resources.DataScienceCluster,
+				resources.Subscription,
+			},
+			CheckPermissions: []resources.Permission{
+				resources.DataScienceCluster.Permission(resources.VerbUpdate),
+				resources.Subscription.Permission(resources.VerbGet),
+				resources.Subscription.Permission(resources.VerbList),
+			},
+			CheckVersionGate:    check.VersionGateUpgrade2xTo3x,
+			CheckKnowledgeLinks: []string{check.DocsKueueMigration},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "The Kueue managementState in the DataScienceCluster and, when it is Unmanaged, the version of the Red Hat Build of Kueue (RHBoK) operator Subscription. The check only runs when upgrading from 2.x to 3.x.",
+				Rationale:      "RHOAI 3.x removes the embedded Kueue: Managed is no longer a valid state, and Unmanaged requires an RHBoK operator recent enough for the target release. Without the migration, ClusterQueues, LocalQueues and queued workloads lose their controller during the upgrade.",
+				FailingExample: managementStateFailingExample,
+				PassingExample: managementStatePassingExample,
+				RemediationCommands: []string{
+					"kubectl odh migrate prepare --migration kueue.rhbok.migrate --target-version 3.0.0",
+					"kubectl odh migrate run --migration kueue.rhbok.migrate --target-version 3.0.0",
+				},
+			},
 		},
 	}
 }
@@ -62,7 +111,7 @@ func (c *ManagementStateCheck) CanApply(ctx context.Context, target check.Target
 
 func (c *ManagementStateCheck) Validate(ctx context.Context, target check.Target) (*result.DiagnosticResult, error) {
 	return validate.Component(c, target).
-		Run(ctx, func(_ context.Context, req *validate.ComponentRequest) error {
+		Run(ctx, func(ctx context.Context, req *validate.ComponentRequest) error {
 			switch req.ManagementState {
 			case constants.ManagementStateManaged:
 				req.Result.SetCondition(check.NewCondition(
@@ -82,6 +131,69 @@ func (c *ManagementStateCheck) Validate(ctx context.Context, target check.Target
 				))
 			}
 
-			return nil
+			return c.validateRHBOKVersion(ctx, req, target)
 		})
 }
+
+// Fix hands Kueue over to the RHBoK operator by setting its managementState to Unmanaged.
+// It refuses when the RHBoK operator is not installed, as Kueue workloads would then be left
+// without a controller.
+func (c *ManagementStateCheck) Fix(
+	ctx context.Context,
+	target check.FixTarget,
+	dr *result.DiagnosticResult,
+) ([]check.Change, error) {
+	if _, ok := dr.Annotations[annotationInstalledVersion]; !ok {
+		return nil, errors.New("the Red Hat Build of Kueue operator is not installed, install it before setting Kueue to Unmanaged")
+	}
+
+	return check.SetComponentManagementState(ctx, target, kind, constants.ManagementStateUnmanaged)
+}
+
+// validateRHBOKVersion checks an already-installed RHBOK operator against the support matrix
+// of the target version. A missing operator is reported by OperatorInstalledCheck instead.
+func (c *ManagementStateCheck) validateRHBOKVersion(
+	ctx context.Context,
+	req *validate.ComponentRequest,
+	target check.Target,
+) error {
+	if !req.Client.OLM().Available() {
+		return nil
+	}
+
+	info, err := olm.FindOperator(ctx, req.Client, func(sub *olm.SubscriptionInfo) bool {
+		return sub.Name == kueueutil.RHBOKSubscriptionName
+	})
+	if err != nil {
+		return fmt.Errorf("checking RHBoK operator presence: %w", err)
+	}
+
+	if !info.Found() {
+		return nil
+	}
+
+	req.Result.Annotations[annotationInstalledVersion] = info.GetVersion()
+
+	compat := kueueutil.EvaluateRHBOK(target.TargetVersion, info.GetVersion(), info.Channel)
+	if compat.Compatible {
+		req.Result.SetCondition(check.NewCondition(
+			ConditionTypeRHBOKCompatible,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonVersionCompatible),
+			check.WithMessage("%s", compat.Message),
+		))
+
+		return nil
+	}
+
+	req.Result.SetCondition(check.NewCondition(
+		ConditionTypeRHBOKCompatible,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonVersionIncompatible),
+		check.WithMessage("%s", compat.Message),
+		check.WithImpact(result.ImpactBlocking),
+		check.WithRemediation(rhbokUpgradeRemediation),
+	))
+
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
 	"github.com/opendatahub-io/odh-cli/pkg/util/components"
 	"github.com/opendatahub-io/odh-cli/pkg/util/kube/olm"
@@ -21,6 +22,25 @@ const (
 	annotationInstalledVersion = "operator.opendatahub.io/installed-version"
 )
 
+// Examples rendered by 'lint explain'.
+const (
+	operatorInstalledFailingExample = `# Kueue is Unmanaged but no kueue-operator Subscription exists
+apiVersion: datasciencecluster.opendatahub.io/v1
+kind: DataScienceCluster
+spec:
+  components:
+    kueue:
+      managementState: Unmanaged`
+
+	operatorInstalledPassingExample = `apiVersion: operators.coreos.com/v1alpha1
+kind: Subscription
+metadata:
+  name: kueue-operator
+  namespace: openshift-kueue-operator
+status:
+  installedCSV: kueue-operator.v1.1.0`
+)
+
 // OperatorInstalledCheck validates the RHBoK operator installation status against the Kueue
 // component management state:
 //   - Managed + operator present: blocking — the two cannot coexist
@@ -38,6 +58,21 @@ func NewOperatorInstalledCheck() *OperatorInstalledCheck {
 			CheckID:          "components.kueue.operator-installed",
 			CheckName:        "Components :: Kueue :: Operator Installed",
 			CheckDescription: "Validates RHBoK operator installation is consistent with Kueue management state",
+			CheckResources: []resources.ResourceType{
+				resources.DataScienceCluster,
+				resources.Subscription,
+			},
+			CheckKnowledgeLinks: []string{check.DocsKueueMigration},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "Whether a kueue-operator (Red Hat Build of Kueue) Subscription is installed, compared with the Kueue managementState in the DataScienceCluster. Runs whenever Kueue is Managed or Unmanaged.",
+				Rationale:      "The embedded Kueue and the RHBoK operator cannot run side by side: with Managed both reconcile the same CRDs, and with Unmanaged nothing runs Kueue unless RHBoK is installed. Either way workloads stop being admitted.",
+				FailingExample: operatorInstalledFailingExample,
+				PassingExample: operatorInstalledPassingExample,
+				RemediationCommands: []string{
+					"kubectl get subscriptions.operators.coreos.com -A --field-selector metadata.name=kueue-operator",
+					"kubectl odh migrate run --migration kueue.rhbok.migrate --target-version 3.0.0",
+				},
+			},
 		},
 	}
 }
@@ -3,6 +3,9 @@ package kueue_test
 import (
 	"testing"
 
+	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	operatorfake "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/clientset/versioned/fake"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -12,6 +15,7 @@ import (
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/components/kueue"
 	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
 
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
@@ -151,4 +155,159 @@ func TestManagementStateCheck_Metadata(t *testing.T) {
 	g.Expect(chk.Name()).To(Equal("Components :: Kueue :: Management State (3.x)"))
 	g.Expect(chk.Group()).To(Equal(check.GroupComponent))
 	g.Expect(chk.Description()).ToNot(BeEmpty())
+	g.Expect(chk.RequiredPermissions()).To(ContainElements(
+		resources.DataScienceCluster.Permission(resources.VerbUpdate),
+		resources.Subscription.Permission(resources.VerbGet),
+		resources.Subscription.Permission(resources.VerbList),
+	))
+}
+
+func newRHBOKSubscription(channel string, installedCSV string) *operatorsv1alpha1.Subscription {
+	return &operatorsv1alpha1.Subscription{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "kueue-operator",
+			Namespace: "openshift-kueue-operator",
+		},
+		Spec: &operatorsv1alpha1.SubscriptionSpec{
+			Channel: channel,
+		},
+		Status: operatorsv1alpha1.SubscriptionStatus{
+			InstalledCSV: installedCSV,
+		},
+	}
+}
+
+func TestManagementStateCheck_RHBOKVersion(t *testing.T) {
+	g := NewWithT(t)
+
+	testCases := []struct {
+		name           string
+		channel        string
+		installedCSV   string
+		expectedStatus metav1.ConditionStatus
+		expectedImpact resultpkg.Impact
+		expectedMsg    string
+	}{
+		{
+			name:           "supported version",
+			channel:        "stable-v1.1",
+			installedCSV:   "kueue-operator.v1.1.0",
+			expectedStatus: metav1.ConditionTrue,
+			expectedImpact: resultpkg.ImpactNone,
+			expectedMsg:    "satisfies the minimum 1.1.0",
+		},
+		{
+			name:           "version too old",
+			channel:        "stable-v1.1",
+			installedCSV:   "kueue-operator.v1.0.1",
+			expectedStatus: metav1.ConditionFalse,
+			expectedImpact: resultpkg.ImpactBlocking,
+			expectedMsg:    "upgrade RHBOK first",
+		},
+		{
+			name:           "channel too old",
+			channel:        "stable-v1.0",
+			installedCSV:   "kueue-operator.v1.1.0",
+			expectedStatus: metav1.ConditionFalse,
+			expectedImpact: resultpkg.ImpactBlocking,
+			expectedMsg:    "switch to stable-v1.1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			target := testutil.NewTarget(t, testutil.TargetConfig{
+				ListKinds:      listKinds,
+				Objects:        []*unstructured.Unstructured{testutil.NewDSC(map[string]string{"kueue": "Unmanaged"})},
+				OLM:            operatorfake.NewSimpleClientset(newRHBOKSubscription(tc.channel, tc.installedCSV)), //nolint:staticcheck // NewClientset requires generated apply configs not available in OLM
+				CurrentVersion: "2.25.0",
+				TargetVersion:  "3.0.0",
+			})
+
+			chk := kueue.NewManagementStateCheck()
+			result, err := chk.Validate(t.Context(), target)
+
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(result.Status.Conditions).To(HaveLen(2))
+			g.Expect(result.Status.Conditions[1].Condition).To(MatchFields(IgnoreExtras, Fields{
+				"Type":    Equal(kueue.ConditionTypeRHBOKCompatible),
+				"Status":  Equal(tc.expectedStatus),
+				"Message": ContainSubstring(tc.expectedMsg),
+			}))
+			g.Expect(result.Status.Conditions[1].Impact).To(Equal(tc.expectedImpact))
+			g.Expect(result.Annotations).To(HaveKeyWithValue("operator.opendatahub.io/installed-version", tc.installedCSV))
+		})
+	}
+}
+
+func TestManagementStateCheck_RHBOKNotInstalled(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      listKinds,
+		Objects:        []*unstructured.Unstructured{testutil.NewDSC(map[string]string{"kueue": "Managed"})},
+		OLM:            operatorfake.NewSimpleClientset(), //nolint:staticcheck // NewClientset requires generated apply configs not available in OLM
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	chk := kueue.NewManagementStateCheck()
+	result, err := chk.Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Status.Conditions).To(HaveLen(1))
+	g.Expect(result.Status.Conditions[0].Type).To(Equal(check.ConditionTypeCompatible))
+}
+
+func TestManagementStateCheck_Fix(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      listKinds,
+		Objects:        []*unstructured.Unstructured{testutil.NewDSC(map[string]string{"kueue": "Managed"})},
+		OLM:            operatorfake.NewSimpleClientset(newRHBOKSubscription("stable-v1.1", "kueue-operator.v1.1.0")), //nolint:staticcheck // NewClientset requires generated apply configs not available in OLM
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	chk := kueue.NewManagementStateCheck()
+	result, err := chk.Validate(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	c, ok := target.Client.(client.Client)
+	g.Expect(ok).To(BeTrue())
+
+	changes, err := chk.Fix(t.Context(), check.FixTarget{Client: c}, result)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(changes).To(HaveLen(1))
+	g.Expect(changes[0].Description).To(Equal("spec.components.kueue.managementState: Managed -> Unmanaged"))
+
+	result, err = chk.Validate(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Status.Conditions[0].Status).To(Equal(metav1.ConditionTrue))
+}
+
+func TestManagementStateCheck_Fix_RHBOKNotInstalled(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      listKinds,
+		Objects:        []*unstructured.Unstructured{testutil.NewDSC(map[string]string{"kueue": "Managed"})},
+		OLM:            operatorfake.NewSimpleClientset(), //nolint:staticcheck // NewClientset requires generated apply configs not available in OLM
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	chk := kueue.NewManagementStateCheck()
+	result, err := chk.Validate(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	c, ok := target.Client.(client.Client)
+	g.Expect(ok).To(BeTrue())
+
+	changes, err := chk.Fix(t.Context(), check.FixTarget{Client: c}, result)
+
+	g.Expect(err).To(MatchError(ContainSubstring("Kueue operator is not installed")))
+	g.Expect(changes).To(BeEmpty())
 }
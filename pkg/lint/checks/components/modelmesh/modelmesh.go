@@ -8,6 +8,7 @@ import (
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
 	"github.com/opendatahub-io/odh-cli/pkg/util/components"
 	"github.com/opendatahub-io/odh-cli/pkg/util/version"
@@ -15,6 +16,23 @@ import (
 
 const kind = "modelmeshserving"
 
+// Examples rendered by 'lint explain'.
+const (
+	removalFailingExample = `apiVersion: datasciencecluster.opendatahub.io/v1
+kind: DataScienceCluster
+spec:
+  components:
+    modelmeshserving:
+      managementState: Managed`
+
+	removalPassingExample = `apiVersion: datasciencecluster.opendatahub.io/v1
+kind: DataScienceCluster
+spec:
+  components:
+    modelmeshserving:
+      managementState: Removed`
+)
+
 // RemovalCheck validates that ModelMesh is disabled before upgrading to 3.x.
 type RemovalCheck struct {
 	check.BaseCheck
@@ -30,6 +48,21 @@ func NewRemovalCheck() *RemovalCheck {
 			CheckName:        "Components :: ModelMesh :: Removal (3.x)",
 			CheckDescription: "Validates that ModelMesh is disabled before upgrading from RHOAI 2.x to 3.x (component will be removed)",
 			CheckRemediation: "Disable ModelMesh by setting managementState to 'Removed' in DataScienceCluster before upgrading",
+			CheckResources: []resources.ResourceType{
+				resources.DataScienceCluster,
+			},
+			CheckVersionGate:    check.VersionGateUpgrade2xTo3x,
+			CheckKnowledgeLinks: []string{check.DocsServingModels},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "The ModelMesh serving managementState in the DataScienceCluster. The check only runs when upgrading from 2.x to 3.x with ModelMesh Managed.",
+				Rationale:      "ModelMesh serving is removed in RHOAI 3.x. Models served through it stop responding after the upgrade unless they are moved to KServe RawDeployment first.",
+				FailingExample: removalFailingExample,
+				PassingExample: removalPassingExample,
+				RemediationCommands: []string{
+					"kubectl odh migrate modelmesh --help",
+					`kubectl patch datasciencecluster default-dsc --type merge -p '{"spec":{"components":{"modelmeshserving":{"managementState":"Removed"}}}}'`,
+				},
+			},
 		},
 	}
 }
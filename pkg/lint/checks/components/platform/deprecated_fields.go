@@ -0,0 +1,192 @@
+package platform
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+	"github.com/opendatahub-io/odh-cli/pkg/util/zstream"
+)
+
+const (
+	kind      = "platform"
+	checkType = "deprecated-fields"
+
+	// ConditionTypeDeprecatedFieldsUnused indicates whether resources set fields deprecated by
+	// the 2.x releases an upgrade crosses.
+	ConditionTypeDeprecatedFieldsUnused = "DeprecatedFieldsUnused"
+
+	// AnnotationDeprecatedFields lists the deprecated fields an impacted resource sets.
+	AnnotationDeprecatedFields = "zstream.opendatahub.io/deprecated-fields"
+)
+
+// fieldUsage lists the deprecated fields an object sets.
+type fieldUsage struct {
+	resource resources.ResourceType
+	obj      *unstructured.Unstructured
+	fields   []string
+}
+
+// Examples rendered by 'lint explain'.
+const (
+	deprecatedFieldsFailingExample = `apiVersion: dscinitialization.opendatahub.io/v1
+kind: DSCInitialization
+spec:
+  devFlags:
+    manifestsUri: https://example.com/manifests.tar.gz # deprecated in 2.16`
+
+	deprecatedFieldsPassingExample = `apiVersion: dscinitialization.opendatahub.io/v1
+kind: DSCInitialization
+spec:
+  applicationsNamespace: redhat-ods-applications`
+)
+
+// DeprecatedFieldsCheck reports resources that set fields deprecated by a 2.x release between the
+// current and the target version, according to the z-stream support matrix. Deprecated fields keep
+// working within 2.x, so findings are advisory.
+type DeprecatedFieldsCheck struct {
+	check.BaseCheck
+}
+
+func NewDeprecatedFieldsCheck() *DeprecatedFieldsCheck {
+	return &DeprecatedFieldsCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupComponent,
+			Kind:             kind,
+			Type:             checkType,
+			CheckID:          "components.platform.deprecated-fields",
+			CheckName:        "Components :: Platform :: Deprecated Fields (2.x z-stream)",
+			CheckDescription: "Reports platform configuration fields deprecated by the 2.x releases between the current and the target version",
+			CheckRemediation: "Remove the listed fields or move to their replacement before they are removed in a later release",
+			CheckResources: []resources.ResourceType{
+				resources.DataScienceCluster,
+				resources.DSCInitialization,
+			},
+			CheckVersionGate:    check.VersionGateUpgradeWithin2x,
+			CheckKnowledgeLinks: []string{check.DocsUpgrading},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "The DataScienceCluster and DSCInitialization fields that the z-stream support matrix marks deprecated by a 2.x release between the current and the target version, such as devFlags.manifestsUri or the ModelMesh and Serverless serving fields. The check only runs for upgrades within 2.x.",
+				Rationale:      "Deprecated fields keep working within 2.x, so findings are advisory, but they are removed by a later release and block the move to 3.x. Dropping them during a z-stream upgrade spreads the work over releases.",
+				FailingExample: deprecatedFieldsFailingExample,
+				PassingExample: deprecatedFieldsPassingExample,
+				RemediationCommands: []string{
+					"kubectl get dscinitialization -o yaml",
+					"kubectl get datasciencecluster -o yaml",
+				},
+			},
+		},
+	}
+}
+
+// CanApply returns whether this check should run for the given target.
+// Only applies when upgrading between 2.x releases.
+func (c *DeprecatedFieldsCheck) CanApply(_ context.Context, target check.Target) (bool, error) {
+	return version.IsUpgradeWithin2x(target.CurrentVersion, target.TargetVersion), nil
+}
+
+// Validate executes the check against the provided target.
+func (c *DeprecatedFieldsCheck) Validate(
+	ctx context.Context,
+	target check.Target,
+) (*result.DiagnosticResult, error) {
+	dr := c.NewResult()
+
+	if target.TargetVersion != nil {
+		dr.Annotations[check.AnnotationCheckTargetVersion] = target.TargetVersion.String()
+	}
+
+	// Deprecated fields per object, in the order objects are first found.
+	var usages []*fieldUsage
+
+	byObject := make(map[string]*fieldUsage)
+
+	var messages []string
+
+	listed := make(map[resources.ResourceType][]*unstructured.Unstructured)
+
+	for _, field := range zstream.DeprecatedFieldsFor(target.CurrentVersion, target.TargetVersion) {
+		items, ok := listed[field.Resource]
+		if !ok {
+			var err error
+
+			items, err = target.Client.List(ctx, field.Resource)
+			if err != nil && !client.IsResourceTypeNotFound(err) {
+				return nil, fmt.Errorf("listing %s: %w", field.Resource.Kind, err)
+			}
+
+			listed[field.Resource] = items
+		}
+
+		used := false
+
+		for _, obj := range items {
+			if _, found, _ := unstructured.NestedFieldNoCopy(obj.Object, strings.Split(field.Path, ".")...); !found {
+				continue
+			}
+
+			used = true
+
+			key := field.Resource.Kind + "/" + obj.GetNamespace() + "/" + obj.GetName()
+
+			u, ok := byObject[key]
+			if !ok {
+				u = &fieldUsage{resource: field.Resource, obj: obj}
+				byObject[key] = u
+				usages = append(usages, u)
+			}
+
+			u.fields = append(u.fields, field.Path)
+		}
+
+		if used {
+			messages = append(messages, fmt.Sprintf("%s %s (deprecated in %s: %s)",
+				field.Resource.Kind, field.Path, field.Release, field.Message))
+		}
+	}
+
+	for _, u := range usages {
+		dr.ImpactedObjects = append(dr.ImpactedObjects, metav1.PartialObjectMetadata{
+			TypeMeta: u.resource.TypeMeta(),
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: u.obj.GetNamespace(),
+				Name:      u.obj.GetName(),
+				Annotations: map[string]string{
+					AnnotationDeprecatedFields: strings.Join(u.fields, ", "),
+				},
+			},
+		})
+	}
+
+	dr.Annotations[check.AnnotationImpactedWorkloadCount] = strconv.Itoa(len(usages))
+
+	if len(messages) == 0 {
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeDeprecatedFieldsUnused,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonConfigurationValid),
+			check.WithMessage("No fields deprecated by the target release are set"),
+		))
+
+		return dr, nil
+	}
+
+	dr.SetCondition(check.NewCondition(
+		ConditionTypeDeprecatedFieldsUnused,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonDeprecated),
+		check.WithMessage("Found %d deprecated field(s) in use: %s", len(messages), strings.Join(messages, "; ")),
+		check.WithImpact(result.ImpactAdvisory),
+		check.WithRemediation(c.CheckRemediation),
+	))
+
+	return dr, nil
+}
@@ -0,0 +1,119 @@
+package platform_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/components/platform"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+//nolint:gochecknoglobals
+var listKinds = map[schema.GroupVersionResource]string{
+	resources.DataScienceCluster.GVR(): resources.DataScienceCluster.ListKind(),
+	resources.DSCInitialization.GVR():  resources.DSCInitialization.ListKind(),
+}
+
+func newDSC(components map[string]any) *unstructured.Unstructured {
+	obj := resources.DataScienceCluster.Unstructured()
+	obj.SetName("default-dsc")
+	_ = unstructured.SetNestedMap(obj.Object, components, "spec", "components")
+
+	return &obj
+}
+
+func newDSCI(spec map[string]any) *unstructured.Unstructured {
+	obj := resources.DSCInitialization.Unstructured()
+	obj.SetName("default-dsci")
+	_ = unstructured.SetNestedMap(obj.Object, spec, "spec")
+
+	return &obj
+}
+
+func TestDeprecatedFieldsCheck_NoDeprecatedFields(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: listKinds,
+		Objects: []*unstructured.Unstructured{
+			newDSC(map[string]any{"kserve": map[string]any{"managementState": "Managed"}}),
+			newDSCI(map[string]any{"applicationsNamespace": "redhat-ods-applications"}),
+		},
+		CurrentVersion: "2.16.0",
+		TargetVersion:  "2.22.0",
+	})
+
+	dr, err := platform.NewDeprecatedFieldsCheck().Validate(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(dr.ImpactedObjects).To(BeEmpty())
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":   Equal(platform.ConditionTypeDeprecatedFieldsUnused),
+		"Status": Equal(metav1.ConditionTrue),
+	}))
+}
+
+func TestDeprecatedFieldsCheck_FieldsDeprecatedByCrossedReleases(t *testing.T) {
+	g := NewWithT(t)
+
+	dsc := newDSC(map[string]any{
+		"modelmeshserving": map[string]any{"managementState": "Managed"},
+		"kserve":           map[string]any{"serving": map[string]any{"managementState": "Managed"}},
+	})
+
+	// 2.16 -> 2.22 crosses the ModelMesh deprecation (2.19) but not the Serverless one (2.25).
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      listKinds,
+		Objects:        []*unstructured.Unstructured{dsc},
+		CurrentVersion: "2.16.0",
+		TargetVersion:  "2.22.0",
+	})
+
+	dr, err := platform.NewDeprecatedFieldsCheck().Validate(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(dr.Status.Conditions[0]).To(MatchFields(IgnoreExtras, Fields{
+		"Condition": MatchFields(IgnoreExtras, Fields{
+			"Status":  Equal(metav1.ConditionFalse),
+			"Reason":  Equal(check.ReasonDeprecated),
+			"Message": ContainSubstring("DataScienceCluster spec.components.modelmeshserving (deprecated in 2.19"),
+		}),
+		"Impact": Equal(resultpkg.ImpactAdvisory),
+	}))
+	g.Expect(dr.ImpactedObjects).To(HaveLen(1))
+	g.Expect(dr.ImpactedObjects[0].Annotations).To(HaveKeyWithValue(platform.AnnotationDeprecatedFields,
+		"spec.components.modelmeshserving"))
+
+	target = testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      listKinds,
+		Objects:        []*unstructured.Unstructured{dsc},
+		CurrentVersion: "2.16.0",
+		TargetVersion:  "2.25.0",
+	})
+
+	dr, err = platform.NewDeprecatedFieldsCheck().Validate(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(dr.ImpactedObjects).To(HaveLen(1))
+	g.Expect(dr.ImpactedObjects[0].Annotations).To(HaveKeyWithValue(platform.AnnotationDeprecatedFields,
+		"spec.components.modelmeshserving, spec.components.kserve.serving"))
+}
+
+func TestDeprecatedFieldsCheck_CanApply(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{ListKinds: listKinds, CurrentVersion: "2.25.0", TargetVersion: "3.0.0"})
+
+	canApply, err := platform.NewDeprecatedFieldsCheck().CanApply(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeFalse())
+}
@@ -0,0 +1,362 @@
+package platform
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+)
+
+const (
+	releaseSkewCheckType = "release-skew"
+
+	// ConditionTypeReleaseVersionsConsistent indicates whether the operator CSV, the
+	// DataScienceCluster status and the component images report the same release.
+	ConditionTypeReleaseVersionsConsistent = "ReleaseVersionsConsistent"
+)
+
+// operatorCSVPrefixes are the name prefixes of the ClusterServiceVersions of the platform
+// operator, e.g. "rhods-operator.2.19.0".
+//
+//nolint:gochecknoglobals
+var operatorCSVPrefixes = []string{"rhods-operator.", "opendatahub-operator."}
+
+// releaseTag matches the release version in an image tag, e.g. "v2.19.0", "2.19" or
+// "rhoai-2.19-1234", capturing the major and minor version.
+var releaseTag = regexp.MustCompile(`(?:^|[^0-9.])v?(\d+)\.(\d+)(?:\.\d+)?`)
+
+// Examples rendered by 'lint explain'.
+const (
+	releaseSkewFailingExample = `apiVersion: datasciencecluster.opendatahub.io/v1
+kind: DataScienceCluster
+status:
+  release:
+    name: OpenShift AI Self-Managed
+    version: 2.16.1 # the installed operator CSV is rhods-operator.2.19.0`
+
+	releaseSkewPassingExample = `apiVersion: datasciencecluster.opendatahub.io/v1
+kind: DataScienceCluster
+status:
+  release:
+    name: OpenShift AI Self-Managed
+    version: 2.19.0 # matches the installed operator CSV rhods-operator.2.19.0`
+)
+
+// ReleaseSkewCheck cross-references the version of the installed operator CSV, the release
+// version the DataScienceCluster reports and the release tags of the component images in the
+// applications namespace. A mismatch means a previous upgrade has not fully rolled out, and
+// upgrading on top of it compounds the skew.
+type ReleaseSkewCheck struct {
+	check.BaseCheck
+}
+
+func NewReleaseSkewCheck() *ReleaseSkewCheck {
+	return &ReleaseSkewCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupComponent,
+			Kind:             kind,
+			Type:             releaseSkewCheckType,
+			CheckID:          "components.platform.release-skew",
+			CheckName:        "Components :: Platform :: Release Version Skew",
+			CheckDescription: "Cross-references the operator CSV version, the DataScienceCluster release version and the component image tags to detect partially rolled-out installations",
+			CheckRemediation: "Wait for the operator to finish reconciling the current release, or resolve the failing CSV or Deployment rollout, before starting another upgrade",
+			CheckResources: []resources.ResourceType{
+				resources.ClusterServiceVersion,
+				resources.DataScienceCluster,
+				resources.DSCInitialization,
+				resources.Deployment,
+			},
+			CheckVersionGate:    check.VersionGateUpgrade,
+			CheckKnowledgeLinks: []string{check.DocsUpgrading},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "The ClusterServiceVersions of the rhods-operator and opendatahub-operator packages (their versions and install phase), the status.release.version of the DataScienceCluster, and the release version in the image tags of the Deployments in the applications namespace. Image tags without a version of the release's major version, such as digests, latest or upstream component versions, are ignored.",
+				Rationale:      "The operator reports the release it reconciled in the DataScienceCluster status and rolls the component Deployments to the images of that release. An operator CSV still installing or replacing, a status lagging the CSV, or Deployments running images of another release mean the previous upgrade has not completed; upgrading again leaves components on images the target operator does not manage.",
+				FailingExample: releaseSkewFailingExample,
+				PassingExample: releaseSkewPassingExample,
+				RemediationCommands: []string{
+					"kubectl get csv -A | grep -E 'rhods-operator|opendatahub-operator'",
+					"kubectl get datasciencecluster -o jsonpath='{.items[0].status.release.version}'",
+					"kubectl get deployments -n <applications-namespace> -o custom-columns=NAME:.metadata.name,IMAGES:.spec.template.spec.containers[*].image",
+				},
+			},
+		},
+	}
+}
+
+// CanApply returns whether this check should run for the given target.
+// Only applies to upgrades.
+func (c *ReleaseSkewCheck) CanApply(_ context.Context, target check.Target) (bool, error) {
+	return target.CurrentVersion != nil && target.TargetVersion != nil &&
+		target.CurrentVersion.LT(*target.TargetVersion), nil
+}
+
+// Validate executes the check against the provided target.
+func (c *ReleaseSkewCheck) Validate(
+	ctx context.Context,
+	target check.Target,
+) (*result.DiagnosticResult, error) {
+	dr := c.NewResult()
+
+	if target.TargetVersion != nil {
+		dr.Annotations[check.AnnotationCheckTargetVersion] = target.TargetVersion.String()
+	}
+
+	var skew []string
+
+	report := func(rt resources.ResourceType, namespace string, name string, reason string) {
+		skew = append(skew, reason)
+
+		dr.ImpactedObjects = append(dr.ImpactedObjects, metav1.PartialObjectMetadata{
+			TypeMeta: rt.TypeMeta(),
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   namespace,
+				Name:        name,
+				Annotations: map[string]string{check.AnnotationImpactReason: reason},
+			},
+		})
+	}
+
+	csvs, err := operatorCSVs(ctx, target.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	// The release of the installed operator; the newest one while several CSVs are installed.
+	var release *semver.Version
+
+	for _, csv := range csvs {
+		v := csv.Spec.Version.Version
+
+		if csv.Status.Phase != operatorsv1alpha1.CSVPhaseSucceeded {
+			report(resources.ClusterServiceVersion, csv.Namespace, csv.Name,
+				fmt.Sprintf("operator CSV %s is in phase %s", csv.Name, phase(csv)))
+		}
+
+		if release == nil || v.GT(*release) {
+			release = &v
+		}
+	}
+
+	if len(csvs) > 1 {
+		names := make([]string, 0, len(csvs))
+		for _, csv := range csvs {
+			names = append(names, csv.Name)
+		}
+
+		skew = append(skew, fmt.Sprintf("%d operator CSVs installed side by side (%s)", len(csvs), strings.Join(names, ", ")))
+	}
+
+	dsc, err := client.GetDataScienceCluster(ctx, target.Client)
+
+	switch {
+	case apierrors.IsNotFound(err) || client.IsResourceTypeNotFound(err):
+		dsc = nil
+	case err != nil:
+		return nil, fmt.Errorf("getting DataScienceCluster: %w", err)
+	}
+
+	if dsc != nil {
+		reported, err := jq.Query[string](dsc, `.status.release.version // ""`)
+		if err != nil {
+			return nil, fmt.Errorf("querying .status.release.version: %w", err)
+		}
+
+		dscVersion, parseErr := semver.ParseTolerant(reported)
+
+		switch {
+		case reported == "" || parseErr != nil:
+			// The operator has not reported a release yet; nothing to compare against.
+		case release == nil:
+			release = &dscVersion
+		case !dscVersion.EQ(*release):
+			report(resources.DataScienceCluster, "", dsc.GetName(),
+				fmt.Sprintf("DataScienceCluster reports release %s but the operator CSV is %s", dscVersion, release))
+		}
+	}
+
+	if release != nil {
+		outdated, err := outdatedDeployments(ctx, target.Client, *release)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, d := range outdated {
+			report(resources.Deployment, d.namespace, d.name, d.reason)
+		}
+	}
+
+	dr.Annotations[check.AnnotationImpactedWorkloadCount] = strconv.Itoa(len(dr.ImpactedObjects))
+
+	if len(skew) == 0 {
+		message := "No operator CSV or DataScienceCluster release version found"
+		if release != nil {
+			message = fmt.Sprintf("Operator CSV, DataScienceCluster and component images are consistent with release %s", release)
+		}
+
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeReleaseVersionsConsistent,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonVersionCompatible),
+			check.WithMessage("%s", message),
+		))
+
+		return dr, nil
+	}
+
+	dr.SetCondition(check.NewCondition(
+		ConditionTypeReleaseVersionsConsistent,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonVersionIncompatible),
+		check.WithMessage("Found %d release version mismatch(es) indicating a partially rolled-out installation: %s",
+			len(skew), strings.Join(skew, "; ")),
+		check.WithImpact(result.ImpactBlocking),
+		check.WithRemediation(c.CheckRemediation),
+	))
+
+	return dr, nil
+}
+
+// operatorCSVs returns the ClusterServiceVersions of the platform operator, skipping the copies
+// OLM places in the namespaces the operator watches.
+func operatorCSVs(ctx context.Context, c client.Reader) ([]operatorsv1alpha1.ClusterServiceVersion, error) {
+	if !c.OLM().Available() {
+		return nil, nil
+	}
+
+	list, err := c.OLM().ClusterServiceVersions("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("listing ClusterServiceVersions: %w", err)
+	}
+
+	var csvs []operatorsv1alpha1.ClusterServiceVersion
+
+	for _, csv := range list.Items {
+		if csv.Status.Reason == operatorsv1alpha1.CSVReasonCopied {
+			continue
+		}
+
+		if !slices.ContainsFunc(operatorCSVPrefixes, func(prefix string) bool {
+			return strings.HasPrefix(csv.Name, prefix)
+		}) {
+			continue
+		}
+
+		csvs = append(csvs, csv)
+	}
+
+	return csvs, nil
+}
+
+// phase returns the install phase of the CSV, which is empty before OLM first processed it.
+func phase(csv operatorsv1alpha1.ClusterServiceVersion) string {
+	if csv.Status.Phase == operatorsv1alpha1.CSVPhaseNone {
+		return "Pending"
+	}
+
+	return string(csv.Status.Phase)
+}
+
+// outdatedDeployment is a Deployment running images of another release.
+type outdatedDeployment struct {
+	namespace string
+	name      string
+	reason    string
+}
+
+// outdatedDeployments returns the Deployments in the applications namespace with container
+// images tagged with a release of the same major version as release but another minor version.
+func outdatedDeployments(ctx context.Context, c client.Reader, release semver.Version) ([]outdatedDeployment, error) {
+	namespace, err := client.GetApplicationsNamespace(ctx, c)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("getting applications namespace: %w", err)
+	}
+
+	deployments, err := c.List(ctx, resources.Deployment, client.WithNamespace(namespace))
+	if err != nil {
+		return nil, fmt.Errorf("listing Deployments in %s: %w", namespace, err)
+	}
+
+	var outdated []outdatedDeployment
+
+	for _, deployment := range deployments {
+		images, err := jq.Query[[]string](deployment,
+			"[(.spec.template.spec.initContainers // [])[], (.spec.template.spec.containers // [])[] | .image]")
+		if err != nil {
+			return nil, fmt.Errorf("reading images of Deployment %s/%s: %w", namespace, deployment.GetName(), err)
+		}
+
+		var mismatched []string
+
+		for _, image := range images {
+			major, minor, ok := imageRelease(image)
+			if !ok || major != release.Major || minor == release.Minor {
+				continue
+			}
+
+			mismatched = append(mismatched, image)
+		}
+
+		if len(mismatched) == 0 {
+			continue
+		}
+
+		outdated = append(outdated, outdatedDeployment{
+			namespace: namespace,
+			name:      deployment.GetName(),
+			reason: fmt.Sprintf("Deployment %s runs image(s) of another release than %d.%d: %s",
+				deployment.GetName(), release.Major, release.Minor, strings.Join(mismatched, ", ")),
+		})
+	}
+
+	return outdated, nil
+}
+
+// imageRelease returns the major and minor release version in the tag of image. Images
+// referenced by digest only, or whose tag carries no version, have none.
+func imageRelease(image string) (uint64, uint64, bool) {
+	name, _, _ := strings.Cut(image, "@")
+
+	idx := strings.LastIndex(name, ":")
+	if idx < 0 || strings.Contains(name[idx:], "/") {
+		return 0, 0, false
+	}
+
+	m := releaseTag.FindStringSubmatch(name[idx+1:])
+	if m == nil {
+		return 0, 0, false
+	}
+
+	major, err := strconv.ParseUint(m[1], 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	minor, err := strconv.ParseUint(m[2], 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	return major, minor, true
+}
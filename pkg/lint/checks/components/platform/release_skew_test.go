@@ -0,0 +1,170 @@
+package platform_test
+
+import (
+	"testing"
+
+	"github.com/blang/semver/v4"
+	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	operatorfake "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/clientset/versioned/fake"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/components/platform"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+const applicationsNamespace = "redhat-ods-applications"
+
+//nolint:gochecknoglobals
+var releaseSkewListKinds = map[schema.GroupVersionResource]string{
+	resources.DataScienceCluster.GVR(): resources.DataScienceCluster.ListKind(),
+	resources.DSCInitialization.GVR():  resources.DSCInitialization.ListKind(),
+	resources.Deployment.GVR():         resources.Deployment.ListKind(),
+}
+
+func newOperatorCSV(version string, phase operatorsv1alpha1.ClusterServiceVersionPhase) *operatorsv1alpha1.ClusterServiceVersion {
+	csv := &operatorsv1alpha1.ClusterServiceVersion{
+		ObjectMeta: metav1.ObjectMeta{Name: "rhods-operator." + version, Namespace: "redhat-ods-operator"},
+		Status:     operatorsv1alpha1.ClusterServiceVersionStatus{Phase: phase},
+	}
+	csv.Spec.Version.Version = semver.MustParse(version)
+
+	return csv
+}
+
+func newReleasedDSC(version string) *unstructured.Unstructured {
+	dsc := newDSC(map[string]any{"dashboard": map[string]any{"managementState": "Managed"}})
+	_ = unstructured.SetNestedField(dsc.Object, version, "status", "release", "version")
+
+	return dsc
+}
+
+func newDeployment(name string, images ...string) *unstructured.Unstructured {
+	containers := make([]any, 0, len(images))
+	for _, image := range images {
+		containers = append(containers, map[string]any{"name": name, "image": image})
+	}
+
+	obj := resources.Deployment.Unstructured()
+	obj.SetName(name)
+	obj.SetNamespace(applicationsNamespace)
+	_ = unstructured.SetNestedSlice(obj.Object, containers, "spec", "template", "spec", "containers")
+
+	return &obj
+}
+
+func TestReleaseSkewCheck_Consistent(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: releaseSkewListKinds,
+		Objects: []*unstructured.Unstructured{
+			testutil.NewDSCI(applicationsNamespace),
+			newReleasedDSC("2.19.0"),
+			newDeployment("rhods-dashboard", "registry.redhat.io/rhoai/odh-dashboard-rhel8:v2.19.0-12", "quay.io/oauth-proxy@sha256:abc"),
+			newDeployment("kserve-controller-manager", "quay.io/opendatahub/kserve-controller:v0.14.0"),
+		},
+		OLM:            operatorfake.NewSimpleClientset(newOperatorCSV("2.19.0", operatorsv1alpha1.CSVPhaseSucceeded)), //nolint:staticcheck // NewClientset requires generated apply configs not available in OLM
+		CurrentVersion: "2.19.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := platform.NewReleaseSkewCheck().Validate(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(dr.ImpactedObjects).To(BeEmpty())
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":    Equal(platform.ConditionTypeReleaseVersionsConsistent),
+		"Status":  Equal(metav1.ConditionTrue),
+		"Reason":  Equal(check.ReasonVersionCompatible),
+		"Message": Equal("Operator CSV, DataScienceCluster and component images are consistent with release 2.19.0"),
+	}))
+}
+
+func TestReleaseSkewCheck_DSCBehindOperator(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: releaseSkewListKinds,
+		Objects: []*unstructured.Unstructured{
+			testutil.NewDSCI(applicationsNamespace),
+			newReleasedDSC("2.16.1"),
+			newDeployment("rhods-dashboard", "registry.redhat.io/rhoai/odh-dashboard-rhel8:rhoai-2.16"),
+		},
+		OLM:            operatorfake.NewSimpleClientset(newOperatorCSV("2.19.0", operatorsv1alpha1.CSVPhaseSucceeded)), //nolint:staticcheck // NewClientset requires generated apply configs not available in OLM
+		CurrentVersion: "2.19.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := platform.NewReleaseSkewCheck().Validate(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(dr.Status.Conditions[0]).To(MatchFields(IgnoreExtras, Fields{
+		"Condition": MatchFields(IgnoreExtras, Fields{
+			"Status": Equal(metav1.ConditionFalse),
+			"Reason": Equal(check.ReasonVersionIncompatible),
+			"Message": Equal("Found 2 release version mismatch(es) indicating a partially rolled-out installation: " +
+				"DataScienceCluster reports release 2.16.1 but the operator CSV is 2.19.0; " +
+				"Deployment rhods-dashboard runs image(s) of another release than 2.19: registry.redhat.io/rhoai/odh-dashboard-rhel8:rhoai-2.16"),
+		}),
+		"Impact": Equal(resultpkg.ImpactBlocking),
+	}))
+	g.Expect(dr.ImpactedObjects).To(HaveLen(2))
+	g.Expect(dr.ImpactedObjects[0].Kind).To(Equal("DataScienceCluster"))
+	g.Expect(dr.ImpactedObjects[1].Kind).To(Equal("Deployment"))
+	g.Expect(dr.ImpactedObjects[1].Namespace).To(Equal(applicationsNamespace))
+}
+
+func TestReleaseSkewCheck_OperatorRolloutInProgress(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: releaseSkewListKinds,
+		Objects: []*unstructured.Unstructured{
+			testutil.NewDSCI(applicationsNamespace),
+			newReleasedDSC("2.19.0"),
+		},
+		//nolint:staticcheck // NewClientset requires generated apply configs not available in OLM
+		OLM: operatorfake.NewSimpleClientset(
+			newOperatorCSV("2.16.1", operatorsv1alpha1.CSVPhaseReplacing),
+			newOperatorCSV("2.19.0", operatorsv1alpha1.CSVPhaseInstalling),
+		),
+		CurrentVersion: "2.19.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := platform.NewReleaseSkewCheck().Validate(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(dr.Status.Conditions[0].Condition.Status).To(Equal(metav1.ConditionFalse))
+	g.Expect(dr.Status.Conditions[0].Condition.Message).To(And(
+		ContainSubstring("operator CSV rhods-operator.2.16.1 is in phase Replacing"),
+		ContainSubstring("operator CSV rhods-operator.2.19.0 is in phase Installing"),
+		ContainSubstring("2 operator CSVs installed side by side (rhods-operator.2.16.1, rhods-operator.2.19.0)"),
+	))
+	g.Expect(dr.ImpactedObjects).To(HaveLen(2))
+	g.Expect(dr.ImpactedObjects[0].Annotations).To(HaveKeyWithValue(check.AnnotationImpactReason,
+		"operator CSV rhods-operator.2.16.1 is in phase Replacing"))
+}
+
+func TestReleaseSkewCheck_CanApply(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := platform.NewReleaseSkewCheck()
+
+	canApply, err := chk.CanApply(t.Context(), testutil.NewTarget(t, testutil.TargetConfig{CurrentVersion: "2.19.0", TargetVersion: "3.0.0"}))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeTrue())
+
+	canApply, err = chk.CanApply(t.Context(), testutil.NewTarget(t, testutil.TargetConfig{CurrentVersion: "2.19.0"}))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeFalse())
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
 	"github.com/opendatahub-io/odh-cli/pkg/util/components"
 	"github.com/opendatahub-io/odh-cli/pkg/util/version"
@@ -17,6 +18,23 @@ import (
 
 const checkType = "deprecation"
 
+// Examples rendered by 'lint explain'.
+const (
+	deprecationFailingExample = `apiVersion: datasciencecluster.opendatahub.io/v1
+kind: DataScienceCluster
+spec:
+  components:
+    trainingoperator:
+      managementState: Managed`
+
+	deprecationPassingExample = `apiVersion: datasciencecluster.opendatahub.io/v1
+kind: DataScienceCluster
+spec:
+  components:
+    trainingoperator:
+      managementState: Removed # training jobs moved to Trainer v2`
+)
+
 type DeprecationCheck struct {
 	check.BaseCheck
 }
@@ -31,6 +49,21 @@ func NewDeprecationCheck() *DeprecationCheck {
 			CheckName:        "Components :: TrainingOperator :: Deprecation (3.3+)",
 			CheckDescription: "Validates that TrainingOperator (Kubeflow Training Operator v1) deprecation is acknowledged - will be replaced by Trainer v2 in future RHOAI releases",
 			CheckRemediation: "Plan migration from TrainingOperator (Kubeflow v1) to Trainer v2 in a future release",
+			CheckResources: []resources.ResourceType{
+				resources.DataScienceCluster,
+			},
+			CheckVersionGate:    check.VersionGateTarget33,
+			CheckKnowledgeLinks: []string{check.DocsDistributedWorkloads},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "The TrainingOperator managementState in the DataScienceCluster. The check only runs when the target version is 3.3 or later with TrainingOperator Managed, and is informational.",
+				Rationale:      "The Kubeflow Training Operator v1 is deprecated in RHOAI 3.3 and replaced by Trainer v2 in a later release. PyTorchJobs keep running for now, but pipelines and notebooks that submit them need to move to the TrainJob API before support ends.",
+				FailingExample: deprecationFailingExample,
+				PassingExample: deprecationPassingExample,
+				RemediationCommands: []string{
+					"kubectl get pytorchjobs.kubeflow.org -A",
+					"kubectl odh lint --target-version 3.3 --checks workloads.trainingoperator.impacted-workloads --verbose",
+				},
+			},
 		},
 	}
 }
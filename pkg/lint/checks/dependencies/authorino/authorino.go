@@ -0,0 +1,100 @@
+package authorino
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+const (
+	kind      = "authorino-operator"
+	checkType = "upgrade"
+)
+
+// Examples rendered by 'lint explain'.
+const (
+	upgradeFailingExample = `apiVersion: operators.coreos.com/v1alpha1
+kind: Subscription
+metadata:
+  name: authorino-operator
+  namespace: openshift-operators
+spec:
+  channel: tech-preview-v1
+status:
+  installedCSV: authorino-operator.v0.16.0`
+
+	upgradePassingExample = `# No authorino-operator Subscription on the tech-preview-v1 channel`
+)
+
+// Check validates that the tech preview Authorino Operator installed for 2.x single-model
+// serving authorization is not installed when upgrading to 3.x, as 3.x gets Authorino from
+// Red Hat Connectivity Link and the standalone operator conflicts with it.
+type Check struct {
+	check.BaseCheck
+}
+
+// NewCheck creates a new Authorino Operator upgrade check.
+func NewCheck() *Check {
+	return &Check{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupDependency,
+			Kind:             kind,
+			Type:             checkType,
+			CheckID:          "dependencies.authorino.upgrade",
+			CheckName:        "Dependencies :: Authorino :: Upgrade (3.x)",
+			CheckDescription: "Validates that the tech preview Authorino Operator used by 2.x single-model serving is not installed when upgrading to RHOAI 3.x (replaced by the Authorino shipped with Red Hat Connectivity Link)",
+			CheckRemediation: "Remove the authorino-operator Subscription and its CSV once no AuthConfig outside RHOAI depends on it, and install Red Hat Connectivity Link if model endpoints require token authentication in 3.x",
+			CheckResources: []resources.ResourceType{
+				resources.Subscription,
+			},
+			CheckVersionGate:    check.VersionGateUpgrade2xTo3x,
+			CheckKnowledgeLinks: []string{check.DocsServingModels},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "OLM Subscriptions named authorino-operator on the tech-preview-v1 channel, i.e. the Authorino Operator that 2.x requires for KServe Serverless authorization. The check only runs when upgrading from 2.x to 3.x.",
+				Rationale:      "RHOAI 3.x authorizes model endpoints through the Gateway API with the Authorino instance managed by Red Hat Connectivity Link. The 2.x tech preview operator owns the same CRDs at an older version, which blocks the installation of Connectivity Link and keeps reconciling AuthConfigs nothing routes to anymore.",
+				FailingExample: upgradeFailingExample,
+				PassingExample: upgradePassingExample,
+				RemediationCommands: []string{
+					"kubectl get authconfigs -A",
+					"kubectl delete subscription authorino-operator -n openshift-operators",
+				},
+			},
+		},
+	}
+}
+
+func (c *Check) CanApply(_ context.Context, target check.Target) (bool, error) {
+	return version.IsUpgradeFrom2xTo3x(target.CurrentVersion, target.TargetVersion), nil
+}
+
+func (c *Check) Validate(ctx context.Context, target check.Target) (*result.DiagnosticResult, error) {
+	return validate.Operator(c, target).
+		WithNames("authorino-operator").
+		WithChannels("tech-preview-v1").
+		WithConditionBuilder(func(found bool, version string) result.Condition {
+			// Inverted logic: NOT finding the operator is good.
+			if !found {
+				return check.NewCondition(
+					check.ConditionTypeCompatible,
+					metav1.ConditionTrue,
+					check.WithReason(check.ReasonVersionCompatible),
+					check.WithMessage("Tech preview Authorino Operator is not installed - ready for RHOAI 3.x upgrade"),
+				)
+			}
+
+			return check.NewCondition(
+				check.ConditionTypeCompatible,
+				metav1.ConditionFalse,
+				check.WithReason(check.ReasonVersionIncompatible),
+				check.WithMessage("Tech preview Authorino Operator (%s) is installed but no longer used by RHOAI 3.x and conflicts with Red Hat Connectivity Link. It should be removed", version),
+				check.WithRemediation(c.CheckRemediation),
+			)
+		}).
+		Run(ctx)
+}
@@ -0,0 +1,101 @@
+package authorino_test
+
+import (
+	"testing"
+
+	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	operatorfake "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/clientset/versioned/fake"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/dependencies/authorino"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+func newSubscription(channel string) *operatorsv1alpha1.Subscription {
+	return &operatorsv1alpha1.Subscription{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "authorino-operator",
+			Namespace: "openshift-operators",
+		},
+		Spec: &operatorsv1alpha1.SubscriptionSpec{
+			Channel: channel,
+		},
+		Status: operatorsv1alpha1.SubscriptionStatus{
+			InstalledCSV: "authorino-operator.v0.16.0",
+		},
+	}
+}
+
+func TestAuthorinoCheck_NotInstalled(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		OLM:           operatorfake.NewSimpleClientset(), //nolint:staticcheck // NewClientset requires generated apply configs not available in OLM
+		TargetVersion: "3.0.0",
+	})
+
+	result, err := authorino.NewCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Status.Conditions).To(HaveLen(1))
+	g.Expect(result.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":    Equal(check.ConditionTypeCompatible),
+		"Status":  Equal(metav1.ConditionTrue),
+		"Reason":  Equal(check.ReasonVersionCompatible),
+		"Message": ContainSubstring("not installed"),
+	}))
+}
+
+func TestAuthorinoCheck_TechPreviewInstalled(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		OLM:           operatorfake.NewSimpleClientset(newSubscription("tech-preview-v1")), //nolint:staticcheck // NewClientset requires generated apply configs not available in OLM
+		TargetVersion: "3.0.0",
+	})
+
+	result, err := authorino.NewCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Status.Conditions).To(HaveLen(1))
+	g.Expect(result.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":    Equal(check.ConditionTypeCompatible),
+		"Status":  Equal(metav1.ConditionFalse),
+		"Reason":  Equal(check.ReasonVersionIncompatible),
+		"Message": And(ContainSubstring("authorino-operator.v0.16.0"), ContainSubstring("should be removed")),
+	}))
+	g.Expect(result.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactAdvisory))
+	g.Expect(result.Annotations).To(HaveKeyWithValue("operator.opendatahub.io/installed-version", "authorino-operator.v0.16.0"))
+}
+
+func TestAuthorinoCheck_StableChannelIgnored(t *testing.T) {
+	g := NewWithT(t)
+
+	// The stable channel is the Authorino installed by Red Hat Connectivity Link
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		OLM:           operatorfake.NewSimpleClientset(newSubscription("stable")), //nolint:staticcheck // NewClientset requires generated apply configs not available in OLM
+		TargetVersion: "3.0.0",
+	})
+
+	result, err := authorino.NewCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Status.Conditions[0].Status).To(Equal(metav1.ConditionTrue))
+}
+
+func TestAuthorinoCheck_Metadata(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := authorino.NewCheck()
+
+	g.Expect(chk.ID()).To(Equal("dependencies.authorino.upgrade"))
+	g.Expect(chk.Name()).To(Equal("Dependencies :: Authorino :: Upgrade (3.x)"))
+	g.Expect(chk.Group()).To(Equal(check.GroupDependency))
+	g.Expect(chk.Description()).ToNot(BeEmpty())
+}
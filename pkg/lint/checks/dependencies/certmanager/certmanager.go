@@ -8,10 +8,24 @@ import (
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
 )
 
 const kind = "certmanager"
 
+// Examples rendered by 'lint explain'.
+const (
+	installedFailingExample = `# No cert-manager or openshift-cert-manager-operator Subscription in any namespace`
+
+	installedPassingExample = `apiVersion: operators.coreos.com/v1alpha1
+kind: Subscription
+metadata:
+  name: openshift-cert-manager-operator
+  namespace: cert-manager-operator
+status:
+  installedCSV: cert-manager-operator.v1.15.1`
+)
+
 // Check validates cert-manager operator installation.
 type Check struct {
 	check.BaseCheck
@@ -26,6 +40,20 @@ func NewCheck() *Check {
 			CheckID:          "dependencies.certmanager.installed",
 			CheckName:        "Dependencies :: CertManager :: Installed",
 			CheckDescription: "Reports the cert-manager operator installation status and version",
+			CheckResources: []resources.ResourceType{
+				resources.Subscription,
+			},
+			CheckKnowledgeLinks: []string{check.DocsUpgrading},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "The OLM Subscriptions named cert-manager or openshift-cert-manager-operator, and the version of the CSV they installed.",
+				Rationale:      "OpenShift AI components request serving and webhook certificates from cert-manager. Without the operator, certificate requests stay pending and components that depend on them, such as KServe and the model registry, never become ready.",
+				FailingExample: installedFailingExample,
+				PassingExample: installedPassingExample,
+				RemediationCommands: []string{
+					"kubectl get subscriptions.operators.coreos.com -A | grep cert-manager",
+					"kubectl get packagemanifests -n openshift-marketplace openshift-cert-manager-operator",
+				},
+			},
 		},
 	}
 }
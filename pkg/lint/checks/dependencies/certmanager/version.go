@@ -0,0 +1,130 @@
+package certmanager
+
+import (
+	"context"
+
+	"github.com/blang/semver/v4"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kueue"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+const checkTypeVersionRequirement = "version-requirement"
+
+// minVersion3x is the oldest cert-manager operator release supported by RHOAI 3.x.
+//
+//nolint:gochecknoglobals
+var minVersion3x = semver.MustParse("1.15.0")
+
+// Examples rendered by 'lint explain'.
+const (
+	versionFailingExample = `apiVersion: operators.coreos.com/v1alpha1
+kind: Subscription
+metadata:
+  name: openshift-cert-manager-operator
+  namespace: cert-manager-operator
+status:
+  installedCSV: cert-manager-operator.v1.13.1`
+
+	versionPassingExample = `apiVersion: operators.coreos.com/v1alpha1
+kind: Subscription
+metadata:
+  name: openshift-cert-manager-operator
+  namespace: cert-manager-operator
+status:
+  installedCSV: cert-manager-operator.v1.15.1`
+)
+
+// VersionCheck validates that the installed cert-manager operator meets the RHOAI 3.x minimum
+// version.
+type VersionCheck struct {
+	check.BaseCheck
+}
+
+// NewVersionCheck creates a new cert-manager version requirement check.
+func NewVersionCheck() *VersionCheck {
+	return &VersionCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupDependency,
+			Kind:             kind,
+			Type:             checkTypeVersionRequirement,
+			CheckID:          "dependencies.certmanager.version-requirement",
+			CheckName:        "Dependencies :: CertManager :: Version Requirement (3.x)",
+			CheckDescription: "Validates that the cert-manager operator is at least version 1.15.0 when upgrading to RHOAI 3.x",
+			CheckRemediation: "Install or upgrade the cert-manager Operator for Red Hat OpenShift to 1.15.0 or later before upgrading RHOAI",
+			CheckResources: []resources.ResourceType{
+				resources.Subscription,
+			},
+			CheckVersionGate:    check.VersionGate3x,
+			CheckKnowledgeLinks: []string{check.DocsUpgrading},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "The version of the CSV installed by the cert-manager or openshift-cert-manager-operator Subscription, compared with the 1.15.0 minimum of RHOAI 3.x. The check runs when the current or target version is 3.x.",
+				Rationale:      "The 3.x operator no longer provisions certificates itself: KServe, the model registry, Kueue and the Ray cluster webhooks request them from cert-manager using APIs of its recent releases. An older operator leaves those certificates pending after the upgrade.",
+				FailingExample: versionFailingExample,
+				PassingExample: versionPassingExample,
+				RemediationCommands: []string{
+					"kubectl get subscriptions.operators.coreos.com -A | grep cert-manager",
+					"kubectl get packagemanifests -n openshift-marketplace openshift-cert-manager-operator -o jsonpath='{.status.channels[*].currentCSV}'",
+				},
+			},
+		},
+	}
+}
+
+func (c *VersionCheck) CanApply(_ context.Context, target check.Target) (bool, error) {
+	return version.IsVersion3x(target.CurrentVersion) || version.IsVersion3x(target.TargetVersion), nil
+}
+
+func (c *VersionCheck) Validate(ctx context.Context, target check.Target) (*result.DiagnosticResult, error) {
+	return validate.Operator(c, target).
+		WithNames("cert-manager", "openshift-cert-manager-operator").
+		WithConditionBuilder(func(found bool, csv string) result.Condition {
+			if !found {
+				return check.NewCondition(
+					check.ConditionTypeCompatible,
+					metav1.ConditionFalse,
+					check.WithReason(check.ReasonResourceNotFound),
+					check.WithMessage("%s operator is not installed. RHOAI 3.x requires %s or later", kind, minVersion3x.String()),
+					check.WithImpact(result.ImpactBlocking),
+					check.WithRemediation(c.CheckRemediation),
+				)
+			}
+
+			installed, err := kueue.ParseCSVVersion(csv)
+			if err != nil {
+				return check.NewCondition(
+					check.ConditionTypeCompatible,
+					metav1.ConditionUnknown,
+					check.WithReason(check.ReasonInsufficientData),
+					check.WithMessage("Unable to determine %s operator version: %s", kind, err.Error()),
+				)
+			}
+
+			if installed.LT(minVersion3x) {
+				return check.NewCondition(
+					check.ConditionTypeCompatible,
+					metav1.ConditionFalse,
+					check.WithReason(check.ReasonVersionIncompatible),
+					check.WithMessage("%s operator %s does not meet RHOAI 3.x minimum version requirement (%s+)",
+						kind, installed.String(), minVersion3x.String()),
+					check.WithImpact(result.ImpactBlocking),
+					check.WithRemediation(c.CheckRemediation),
+				)
+			}
+
+			return check.NewCondition(
+				check.ConditionTypeCompatible,
+				metav1.ConditionTrue,
+				check.WithReason(check.ReasonVersionCompatible),
+				check.WithMessage("%s operator %s meets RHOAI 3.x minimum version requirement (%s+)",
+					kind, installed.String(), minVersion3x.String()),
+			)
+		}).
+		Run(ctx)
+}
@@ -0,0 +1,133 @@
+package certmanager_test
+
+import (
+	"testing"
+
+	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	operatorfake "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/clientset/versioned/fake"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/dependencies/certmanager"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+func newCertManagerSubscription(installedCSV string) *operatorsv1alpha1.Subscription {
+	return &operatorsv1alpha1.Subscription{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "openshift-cert-manager-operator",
+			Namespace: "cert-manager-operator",
+		},
+		Status: operatorsv1alpha1.SubscriptionStatus{
+			InstalledCSV: installedCSV,
+		},
+	}
+}
+
+func TestVersionCheck_Validate(t *testing.T) {
+	tests := []struct {
+		name            string
+		installedCSV    string
+		expectedStatus  metav1.ConditionStatus
+		expectedReason  string
+		expectedImpact  resultpkg.Impact
+		expectedMessage string
+	}{
+		{
+			name:            "NotInstalled",
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  check.ReasonResourceNotFound,
+			expectedImpact:  resultpkg.ImpactBlocking,
+			expectedMessage: "not installed",
+		},
+		{
+			name:            "BelowMinimum",
+			installedCSV:    "cert-manager-operator.v1.13.1",
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  check.ReasonVersionIncompatible,
+			expectedImpact:  resultpkg.ImpactBlocking,
+			expectedMessage: "1.13.1 does not meet RHOAI 3.x minimum version requirement (1.15.0+)",
+		},
+		{
+			name:            "AtMinimum",
+			installedCSV:    "cert-manager-operator.v1.15.0",
+			expectedStatus:  metav1.ConditionTrue,
+			expectedReason:  check.ReasonVersionCompatible,
+			expectedImpact:  resultpkg.ImpactNone,
+			expectedMessage: "1.15.0 meets RHOAI 3.x minimum version requirement",
+		},
+		{
+			name:            "UnparsableVersion",
+			installedCSV:    "cert-manager-operator",
+			expectedStatus:  metav1.ConditionUnknown,
+			expectedReason:  check.ReasonInsufficientData,
+			expectedImpact:  resultpkg.ImpactAdvisory,
+			expectedMessage: "Unable to determine",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			olm := operatorfake.NewSimpleClientset() //nolint:staticcheck // NewClientset requires generated apply configs not available in OLM
+			if tc.installedCSV != "" {
+				olm = operatorfake.NewSimpleClientset(newCertManagerSubscription(tc.installedCSV)) //nolint:staticcheck // NewClientset requires generated apply configs not available in OLM
+			}
+
+			target := testutil.NewTarget(t, testutil.TargetConfig{
+				OLM:            olm,
+				CurrentVersion: "2.25.0",
+				TargetVersion:  "3.0.0",
+			})
+
+			result, err := certmanager.NewVersionCheck().Validate(t.Context(), target)
+
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(result.Status.Conditions).To(HaveLen(1))
+			g.Expect(result.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+				"Type":    Equal(check.ConditionTypeCompatible),
+				"Status":  Equal(tc.expectedStatus),
+				"Reason":  Equal(tc.expectedReason),
+				"Message": ContainSubstring(tc.expectedMessage),
+			}))
+			g.Expect(result.Status.Conditions[0].Impact).To(Equal(tc.expectedImpact))
+		})
+	}
+}
+
+func TestVersionCheck_CanApply(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := certmanager.NewVersionCheck()
+
+	canApply, err := chk.CanApply(t.Context(), testutil.NewTarget(t, testutil.TargetConfig{
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "2.25.0",
+	}))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeFalse())
+
+	canApply, err = chk.CanApply(t.Context(), testutil.NewTarget(t, testutil.TargetConfig{
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+	}))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeTrue())
+}
+
+func TestVersionCheck_Metadata(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := certmanager.NewVersionCheck()
+
+	g.Expect(chk.ID()).To(Equal("dependencies.certmanager.version-requirement"))
+	g.Expect(chk.Name()).To(Equal("Dependencies :: CertManager :: Version Requirement (3.x)"))
+	g.Expect(chk.Group()).To(Equal(check.GroupDependency))
+	g.Expect(chk.Description()).ToNot(BeEmpty())
+}
@@ -0,0 +1,320 @@
+package etcd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+)
+
+const (
+	kind      = "etcd"
+	checkType = "object-count"
+
+	// ConditionTypeObjectCountsWithinLimits indicates whether the number of ODH custom
+	// resources per CRD is low enough for the operator to reconcile the upgrade promptly.
+	ConditionTypeObjectCountsWithinLimits = "ObjectCountsWithinLimits"
+
+	// AnnotationObjectCount is the number of custom resources of a CRD.
+	AnnotationObjectCount = "etcd.opendatahub.io/object-count"
+
+	// AnnotationMetadataBytes is the aggregate size of the metadata of the custom resources of
+	// a CRD, a lower bound of their size in etcd.
+	AnnotationMetadataBytes = "etcd.opendatahub.io/metadata-bytes"
+
+	// AnnotationThreshold is the object count above which a CRD is reported.
+	AnnotationThreshold = "etcd.opendatahub.io/threshold"
+
+	// odhCRDLabel is the label marking CRDs owned by ODH components.
+	odhCRDLabel = "platform.opendatahub.io/part-of"
+
+	// defaultThreshold is the object count per CRD above which the operator upgrade reconcile
+	// is known to slow down noticeably.
+	defaultThreshold = 10000
+)
+
+// crdUsage is the object count and metadata size of one CRD.
+type crdUsage struct {
+	name      string
+	count     int
+	bytes     int
+	threshold int
+}
+
+// Examples rendered by 'lint explain'.
+const (
+	objectCountFailingExample = `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: pipelineruns.tekton.dev
+  labels:
+    platform.opendatahub.io/part-of: datasciencepipelines
+  annotations:
+    etcd.opendatahub.io/object-count: "48210" # above the 10000 threshold`
+
+	objectCountPassingExample = `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: notebooks.kubeflow.org
+  labels:
+    platform.opendatahub.io/part-of: workbenches
+  annotations:
+    etcd.opendatahub.io/object-count: "312"`
+)
+
+// ObjectCountCheck reports the number and aggregate metadata size of ODH custom resources per
+// CRD, listed as metadata only, and warns when a CRD holds more objects than the operator can
+// reconcile promptly during an upgrade.
+type ObjectCountCheck struct {
+	check.BaseCheck
+
+	// Threshold is the object count per CRD above which the CRD is reported.
+	Threshold int
+}
+
+func NewObjectCountCheck() *ObjectCountCheck {
+	return &ObjectCountCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupDependency,
+			Kind:             kind,
+			Type:             checkType,
+			CheckID:          "dependencies.etcd.object-count",
+			CheckName:        "Dependencies :: etcd :: Object Count",
+			CheckDescription: "Reports the number and aggregate metadata size of ODH custom resources per CRD and warns when counts are high enough to slow the operator's upgrade reconcile",
+			CheckRemediation: "Prune completed or unused objects of the listed CRDs before upgrading, e.g. list the oldest with 'kubectl get <resource> -A --sort-by=.metadata.creationTimestamp' and delete those no longer needed with 'kubectl delete <resource> -n <namespace> <name>...'",
+			CheckResources: []resources.ResourceType{
+				resources.CustomResourceDefinition,
+			},
+			CheckVersionGate:    check.VersionGateUpgrade,
+			CheckKnowledgeLinks: []string{check.DocsUpgrading},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "Every CRD labelled platform.opendatahub.io/part-of: the number of its custom resources and their aggregate metadata size, listed as metadata only. The check only runs for upgrades.",
+				Rationale:      "During an upgrade the new operator re-reconciles every object of its CRDs. Tens of thousands of completed pipeline runs or stale objects make that reconcile take hours and put pressure on etcd, so the upgrade appears stuck.",
+				FailingExample: objectCountFailingExample,
+				PassingExample: objectCountPassingExample,
+				RemediationCommands: []string{
+					"kubectl get <resource> -A --sort-by=.metadata.creationTimestamp",
+					"kubectl delete <resource> -n <namespace> <name>...",
+				},
+			},
+		},
+		Threshold: defaultThreshold,
+	}
+}
+
+// objectCountParameters are the parameters of the check settable in the lint configuration file.
+type objectCountParameters struct {
+	// Threshold overrides the object count per CRD above which the CRD is reported.
+	Threshold *int `json:"threshold,omitempty"`
+}
+
+// SetParameters applies the parameters of the check from the lint configuration file.
+func (c *ObjectCountCheck) SetParameters(data []byte) error {
+	var params objectCountParameters
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&params); err != nil {
+		return fmt.Errorf("decoding parameters: %w", err)
+	}
+
+	if params.Threshold != nil {
+		if *params.Threshold <= 0 {
+			return errors.New("threshold must be positive")
+		}
+
+		c.Threshold = *params.Threshold
+	}
+
+	return nil
+}
+
+// CanApply returns whether this check should run for the given target.
+// Only applies to upgrades, where every object is reconciled by the new operator.
+func (c *ObjectCountCheck) CanApply(_ context.Context, target check.Target) (bool, error) {
+	return target.CurrentVersion != nil && target.TargetVersion != nil &&
+		target.CurrentVersion.LT(*target.TargetVersion), nil
+}
+
+// Validate executes the check against the provided target.
+func (c *ObjectCountCheck) Validate(
+	ctx context.Context,
+	target check.Target,
+) (*result.DiagnosticResult, error) {
+	dr := c.NewResult()
+
+	if target.TargetVersion != nil {
+		dr.Annotations[check.AnnotationCheckTargetVersion] = target.TargetVersion.String()
+	}
+
+	usages, err := c.usages(ctx, target.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	total := 0
+	totalBytes := 0
+
+	var exceeded []string
+
+	for _, u := range usages {
+		total += u.count
+		totalBytes += u.bytes
+
+		if u.count <= u.threshold {
+			continue
+		}
+
+		exceeded = append(exceeded, fmt.Sprintf("%s: %d objects (threshold %d)", u.name, u.count, u.threshold))
+
+		dr.ImpactedObjects = append(dr.ImpactedObjects, metav1.PartialObjectMetadata{
+			TypeMeta: resources.CustomResourceDefinition.TypeMeta(),
+			ObjectMeta: metav1.ObjectMeta{
+				Name: u.name,
+				Annotations: map[string]string{
+					AnnotationObjectCount:   strconv.Itoa(u.count),
+					AnnotationMetadataBytes: strconv.Itoa(u.bytes),
+					AnnotationThreshold:     strconv.Itoa(u.threshold),
+				},
+			},
+		})
+	}
+
+	dr.Annotations[check.AnnotationImpactedWorkloadCount] = strconv.Itoa(len(exceeded))
+
+	summary := fmt.Sprintf("%d ODH custom resource(s) across %d CRD(s), %s of metadata", total, len(usages), formatBytes(totalBytes))
+	if len(usages) > 0 {
+		summary += fmt.Sprintf("; largest: %s (%d)", usages[0].name, usages[0].count)
+	}
+
+	if len(exceeded) == 0 {
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeObjectCountsWithinLimits,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonRequirementsMet),
+			check.WithMessage("%s", summary),
+		))
+
+		return dr, nil
+	}
+
+	dr.SetCondition(check.NewCondition(
+		ConditionTypeObjectCountsWithinLimits,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonQuotaExceeded),
+		check.WithMessage("%s. Found %d CRD(s) with object counts that slow the upgrade reconcile: %s",
+			summary, len(exceeded), strings.Join(exceeded, ", ")),
+		check.WithImpact(result.ImpactAdvisory),
+		check.WithRemediation(c.CheckRemediation),
+	))
+
+	return dr, nil
+}
+
+// usages lists the objects of every established ODH CRD as metadata and returns their
+// counts and sizes, largest count first.
+func (c *ObjectCountCheck) usages(ctx context.Context, r client.Reader) ([]crdUsage, error) {
+	items, err := r.List(ctx, resources.CustomResourceDefinition, client.WithLabelSelector(odhCRDLabel))
+	if err != nil {
+		return nil, fmt.Errorf("listing ODH CustomResourceDefinitions: %w", err)
+	}
+
+	usages := make([]crdUsage, 0, len(items))
+
+	for _, item := range items {
+		var crd apiextensionsv1.CustomResourceDefinition
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, &crd); err != nil {
+			return nil, fmt.Errorf("converting CustomResourceDefinition %s: %w", item.GetName(), err)
+		}
+
+		rt, ok := storageResourceType(&crd)
+		if !ok {
+			continue
+		}
+
+		objects, err := r.ListMetadata(ctx, rt)
+		if err != nil {
+			return nil, fmt.Errorf("listing %s: %w", crd.Name, err)
+		}
+
+		u := crdUsage{name: crd.Name, count: len(objects), threshold: c.Threshold}
+
+		for _, obj := range objects {
+			data, err := json.Marshal(obj)
+			if err == nil {
+				u.bytes += len(data)
+			}
+		}
+
+		usages = append(usages, u)
+	}
+
+	sort.SliceStable(usages, func(i, j int) bool {
+		if usages[i].count != usages[j].count {
+			return usages[i].count > usages[j].count
+		}
+
+		return usages[i].name < usages[j].name
+	})
+
+	return usages, nil
+}
+
+// storageResourceType returns the resource type of the storage version of an established CRD.
+func storageResourceType(crd *apiextensionsv1.CustomResourceDefinition) (resources.ResourceType, bool) {
+	established := false
+
+	for _, cond := range crd.Status.Conditions {
+		if cond.Type == apiextensionsv1.Established && cond.Status == apiextensionsv1.ConditionTrue {
+			established = true
+		}
+	}
+
+	if !established {
+		return resources.ResourceType{}, false
+	}
+
+	for _, v := range crd.Spec.Versions {
+		if v.Storage {
+			return resources.ResourceType{
+				Group:    crd.Spec.Group,
+				Version:  v.Name,
+				Kind:     crd.Spec.Names.Kind,
+				Resource: crd.Spec.Names.Plural,
+			}, true
+		}
+	}
+
+	return resources.ResourceType{}, false
+}
+
+// formatBytes formats a byte count with a binary unit.
+func formatBytes(n int) string {
+	const unit = 1024
+
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+
+	div, exp := unit, 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGT"[exp])
+}
@@ -0,0 +1,148 @@
+package etcd_test
+
+import (
+	"fmt"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/dependencies/etcd"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+//nolint:gochecknoglobals
+var listKinds = map[schema.GroupVersionResource]string{
+	resources.CustomResourceDefinition.GVR(): resources.CustomResourceDefinition.ListKind(),
+	resources.Notebook.GVR():                 resources.Notebook.ListKind(),
+	resources.InferenceService.GVR():         resources.InferenceService.ListKind(),
+}
+
+func newCRD(rt resources.ResourceType, established string) *unstructured.Unstructured {
+	obj := resources.CustomResourceDefinition.Unstructured()
+	obj.SetName(rt.Resource + "." + rt.Group)
+	obj.SetLabels(map[string]string{"platform.opendatahub.io/part-of": "workbenches"})
+	_ = unstructured.SetNestedField(obj.Object, rt.Group, "spec", "group")
+	_ = unstructured.SetNestedField(obj.Object, rt.Kind, "spec", "names", "kind")
+	_ = unstructured.SetNestedField(obj.Object, rt.Resource, "spec", "names", "plural")
+	_ = unstructured.SetNestedSlice(obj.Object, []any{
+		map[string]any{"name": rt.Version, "served": true, "storage": true},
+	}, "spec", "versions")
+	_ = unstructured.SetNestedSlice(obj.Object, []any{
+		map[string]any{"type": "Established", "status": established},
+	}, "status", "conditions")
+
+	return &obj
+}
+
+func newObjects(rt resources.ResourceType, count int) []*unstructured.Unstructured {
+	objects := make([]*unstructured.Unstructured, 0, count)
+
+	for i := range count {
+		obj := rt.Unstructured()
+		obj.SetNamespace("team-a")
+		obj.SetName(fmt.Sprintf("%s-%d", rt.Resource, i))
+		objects = append(objects, &obj)
+	}
+
+	return objects
+}
+
+func TestObjectCountCheck(t *testing.T) {
+	g := NewWithT(t)
+
+	objects := []*unstructured.Unstructured{
+		newCRD(resources.Notebook, "True"),
+		newCRD(resources.InferenceService, "True"),
+	}
+	objects = append(objects, newObjects(resources.Notebook, 4)...)
+	objects = append(objects, newObjects(resources.InferenceService, 2)...)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      listKinds,
+		Objects:        objects,
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	chk := etcd.NewObjectCountCheck()
+	chk.Threshold = 3
+
+	dr, err := chk.Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions[0]).To(MatchFields(IgnoreExtras, Fields{
+		"Condition": MatchFields(IgnoreExtras, Fields{
+			"Type":    Equal(etcd.ConditionTypeObjectCountsWithinLimits),
+			"Status":  Equal(metav1.ConditionFalse),
+			"Message": ContainSubstring("6 ODH custom resource(s) across 2 CRD(s)"),
+		}),
+		"Impact": Equal(resultpkg.ImpactAdvisory),
+	}))
+	g.Expect(dr.ImpactedObjects).To(HaveLen(1))
+	g.Expect(dr.ImpactedObjects[0].Name).To(Equal("notebooks.kubeflow.org"))
+	g.Expect(dr.ImpactedObjects[0].Annotations).To(HaveKeyWithValue(etcd.AnnotationObjectCount, "4"))
+	g.Expect(dr.ImpactedObjects[0].Annotations).To(HaveKey(etcd.AnnotationMetadataBytes))
+}
+
+func TestObjectCountCheck_WithinLimits(t *testing.T) {
+	g := NewWithT(t)
+
+	objects := []*unstructured.Unstructured{
+		newCRD(resources.Notebook, "True"),
+		// Not established: not counted
+		newCRD(resources.InferenceService, "False"),
+	}
+	objects = append(objects, newObjects(resources.Notebook, 2)...)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      listKinds,
+		Objects:        objects,
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := etcd.NewObjectCountCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.ImpactedObjects).To(BeEmpty())
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Status":  Equal(metav1.ConditionTrue),
+		"Message": ContainSubstring("2 ODH custom resource(s) across 1 CRD(s)"),
+	}))
+}
+
+func TestObjectCountCheck_CanApply(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := etcd.NewObjectCountCheck()
+
+	canApply, err := chk.CanApply(t.Context(), testutil.NewTarget(t, testutil.TargetConfig{CurrentVersion: "2.25.0", TargetVersion: "2.25.0"}))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeFalse())
+
+	canApply, err = chk.CanApply(t.Context(), testutil.NewTarget(t, testutil.TargetConfig{CurrentVersion: "2.22.0", TargetVersion: "2.25.0"}))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeTrue())
+}
+
+func TestObjectCountCheck_SetParameters(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := etcd.NewObjectCountCheck()
+
+	g.Expect(chk.SetParameters([]byte(`{"threshold":5000}`))).To(Succeed())
+	g.Expect(chk.Threshold).To(Equal(5000))
+
+	g.Expect(chk.SetParameters([]byte(`{}`))).To(Succeed())
+	g.Expect(chk.Threshold).To(Equal(5000))
+
+	g.Expect(chk.SetParameters([]byte(`{"threshold":0}`))).To(MatchError("threshold must be positive"))
+	g.Expect(chk.SetParameters([]byte(`{"limit":10}`))).To(MatchError(ContainSubstring(`unknown field "limit"`)))
+}
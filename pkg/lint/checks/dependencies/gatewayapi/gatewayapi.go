@@ -0,0 +1,458 @@
+package gatewayapi
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/blang/semver/v4"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+const (
+	kind      = "gatewayapi"
+	checkType = "readiness"
+
+	// ConditionTypeCRDsReady reports whether the Gateway API CRDs are installed at a supported version.
+	ConditionTypeCRDsReady = "GatewayAPICRDsReady"
+
+	// ConditionTypeGatewayClassReady reports whether GatewayClasses of the OpenShift controller are accepted.
+	ConditionTypeGatewayClassReady = "GatewayClassReady"
+
+	// ConditionTypeIngressOperatorReady reports whether the cluster ingress operator can serve Gateways.
+	ConditionTypeIngressOperatorReady = "IngressOperatorReady"
+
+	// AnnotationBundleVersion is the lowest Gateway API bundle version among the installed CRDs.
+	AnnotationBundleVersion = "gatewayapi.opendatahub.io/bundle-version"
+
+	// openShiftGatewayController is the controller name of GatewayClasses reconciled by the
+	// OpenShift ingress operator, which the 3.x data plane uses.
+	openShiftGatewayController = "openshift.io/gateway-controller/v1"
+
+	annotationGatewayAPIBundleVersion = "gateway.networking.k8s.io/bundle-version"
+
+	ingressClusterOperator = "ingress"
+	ingressCapability      = "Ingress"
+
+	servedVersion = "v1"
+)
+
+//nolint:gochecknoglobals
+var (
+	// minBundleVersion is the oldest Gateway API release providing the v1 APIs used by 3.x routing.
+	minBundleVersion = semver.MustParse("1.2.0")
+
+	requiredCRDs = []string{
+		"gatewayclasses.gateway.networking.k8s.io",
+		"gateways.gateway.networking.k8s.io",
+		"httproutes.gateway.networking.k8s.io",
+	}
+)
+
+// Examples rendered by 'lint explain'.
+const (
+	readinessFailingExample = `apiVersion: gateway.networking.k8s.io/v1
+kind: GatewayClass
+metadata:
+  name: openshift-default
+spec:
+  controllerName: openshift.io/gateway-controller/v1
+status:
+  conditions:
+  - type: Accepted
+    status: "False"
+    reason: InvalidParameters`
+
+	readinessPassingExample = `apiVersion: gateway.networking.k8s.io/v1
+kind: GatewayClass
+metadata:
+  name: openshift-default
+spec:
+  controllerName: openshift.io/gateway-controller/v1
+status:
+  conditions:
+  - type: Accepted
+    status: "True"`
+)
+
+// Check validates that the cluster can run the Gateway API based data plane that RHOAI 3.x
+// uses by default for serving and auth routing: the Gateway API CRDs at a supported version,
+// a functioning GatewayClass for the OpenShift gateway controller, and an ingress operator
+// able to reconcile it.
+type Check struct {
+	check.BaseCheck
+}
+
+// NewCheck creates a new Gateway API readiness check.
+func NewCheck() *Check {
+	return &Check{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupDependency,
+			Kind:             kind,
+			Type:             checkType,
+			CheckID:          "dependencies.gatewayapi.readiness",
+			CheckName:        "Dependencies :: GatewayAPI :: Readiness (3.x)",
+			CheckDescription: "Validates the Gateway API CRDs version, the GatewayClasses of the OpenShift gateway controller and the cluster ingress operator required by the RHOAI 3.x serving and auth routing data plane",
+			CheckRemediation: "Upgrade OpenShift to a release shipping Gateway API v1.2+ (4.19+), make sure the ingress cluster operator is available and the Ingress capability is enabled, and resolve the status conditions of GatewayClasses that are not Accepted",
+			CheckResources: []resources.ResourceType{
+				resources.CustomResourceDefinition,
+				resources.GatewayClass,
+				resources.ClusterOperator,
+				resources.ClusterVersion,
+			},
+			CheckVersionGate:    check.VersionGate3x,
+			CheckKnowledgeLinks: []string{check.DocsUpgrading},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "The Gateway API CRDs and their bundle version (v1.2 or later), the GatewayClasses of the OpenShift gateway controller and their Accepted condition, and the ingress ClusterOperator and Ingress capability. The check runs when the current or target version is 3.x.",
+				Rationale:      "RHOAI 3.x routes model serving and dashboard authentication through Gateway API instead of Routes and Service Mesh. Without current CRDs, an accepted GatewayClass and a working ingress operator, InferenceServices and the dashboard are unreachable after the upgrade.",
+				FailingExample: readinessFailingExample,
+				PassingExample: readinessPassingExample,
+				RemediationCommands: []string{
+					"kubectl get crd gateways.gateway.networking.k8s.io -o jsonpath='{.metadata.annotations.gateway\\.networking\\.k8s\\.io/bundle-version}'",
+					"kubectl get gatewayclasses -o wide",
+					"kubectl get clusteroperator ingress",
+				},
+			},
+		},
+	}
+}
+
+func (c *Check) CanApply(_ context.Context, target check.Target) (bool, error) {
+	return version.IsVersion3x(target.CurrentVersion) || version.IsVersion3x(target.TargetVersion), nil
+}
+
+func (c *Check) Validate(
+	ctx context.Context,
+	target check.Target,
+) (*result.DiagnosticResult, error) {
+	dr := c.NewResult()
+
+	if target.TargetVersion != nil {
+		dr.Annotations[check.AnnotationCheckTargetVersion] = target.TargetVersion.String()
+	}
+
+	crds, err := c.crdsCondition(ctx, target.Client, dr)
+	if err != nil {
+		return nil, err
+	}
+
+	dr.SetCondition(crds)
+
+	gatewayClasses, err := c.gatewayClassCondition(ctx, target.Client, crds.Status == metav1.ConditionTrue)
+	if err != nil {
+		return nil, err
+	}
+
+	dr.SetCondition(gatewayClasses)
+
+	ingress, err := c.ingressCondition(ctx, target.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	dr.SetCondition(ingress)
+
+	return dr, nil
+}
+
+// crdsCondition verifies that the Gateway API CRDs are installed, serve v1 and come from a
+// supported Gateway API release.
+func (c *Check) crdsCondition(
+	ctx context.Context,
+	r client.Reader,
+	dr *result.DiagnosticResult,
+) (result.Condition, error) {
+	var missing, notServed, outdated []string
+
+	var lowest *semver.Version
+
+	for _, name := range requiredCRDs {
+		crd, err := r.GetResource(ctx, resources.CustomResourceDefinition, name)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				missing = append(missing, name)
+
+				continue
+			}
+
+			return result.Condition{}, fmt.Errorf("getting CRD %s: %w", name, err)
+		}
+
+		if crd == nil {
+			return check.NewCondition(
+				ConditionTypeCRDsReady,
+				metav1.ConditionUnknown,
+				check.WithReason(check.ReasonAPIAccessDenied),
+				check.WithMessage("Unable to access CRD %s - insufficient permissions", name),
+			), nil
+		}
+
+		if !servesVersion(crd, servedVersion) {
+			notServed = append(notServed, name)
+		}
+
+		// CRDs without the bundle annotation were not installed from an upstream release
+		// and cannot be compared; serving v1 is then the only requirement.
+		bundle := bundleVersion(crd)
+		if bundle == nil {
+			continue
+		}
+
+		if lowest == nil || bundle.LT(*lowest) {
+			lowest = bundle
+		}
+
+		if bundle.LT(minBundleVersion) {
+			outdated = append(outdated, fmt.Sprintf("%s (v%s)", name, bundle.String()))
+		}
+	}
+
+	if lowest != nil {
+		dr.Annotations[AnnotationBundleVersion] = "v" + lowest.String()
+	}
+
+	switch {
+	case len(missing) > 0:
+		return check.NewCondition(
+			ConditionTypeCRDsReady,
+			metav1.ConditionFalse,
+			check.WithReason(check.ReasonResourceNotFound),
+			check.WithMessage("Gateway API CRDs not installed: %s. RHOAI 3.x routes serving and auth traffic through Gateway API", strings.Join(missing, ", ")),
+			check.WithImpact(result.ImpactBlocking),
+			check.WithRemediation(c.CheckRemediation),
+		), nil
+	case len(notServed) > 0:
+		return check.NewCondition(
+			ConditionTypeCRDsReady,
+			metav1.ConditionFalse,
+			check.WithReason(check.ReasonVersionIncompatible),
+			check.WithMessage("Gateway API CRDs do not serve %s: %s", servedVersion, strings.Join(notServed, ", ")),
+			check.WithImpact(result.ImpactBlocking),
+			check.WithRemediation(c.CheckRemediation),
+		), nil
+	case len(outdated) > 0:
+		return check.NewCondition(
+			ConditionTypeCRDsReady,
+			metav1.ConditionFalse,
+			check.WithReason(check.ReasonVersionIncompatible),
+			check.WithMessage("Gateway API CRDs older than v%s: %s", minBundleVersion.String(), strings.Join(outdated, ", ")),
+			check.WithImpact(result.ImpactBlocking),
+			check.WithRemediation(c.CheckRemediation),
+		), nil
+	}
+
+	return check.NewCondition(
+		ConditionTypeCRDsReady,
+		metav1.ConditionTrue,
+		check.WithReason(check.ReasonVersionCompatible),
+		check.WithMessage("Gateway API CRDs are installed and serve %s", servedVersion),
+	), nil
+}
+
+// gatewayClassCondition verifies that the GatewayClasses of the OpenShift gateway controller
+// are accepted. When none exist yet, RHOAI 3.x creates its own on upgrade.
+func (c *Check) gatewayClassCondition(ctx context.Context, r client.Reader, crdsReady bool) (result.Condition, error) {
+	if !crdsReady {
+		return check.NewCondition(
+			ConditionTypeGatewayClassReady,
+			metav1.ConditionUnknown,
+			check.WithReason(check.ReasonDependencyUnavailable),
+			check.WithMessage("GatewayClasses not evaluated because the Gateway API CRDs are not ready"),
+		), nil
+	}
+
+	items, err := r.List(ctx, resources.GatewayClass)
+	if err != nil {
+		if client.IsResourceTypeNotFound(err) {
+			items = nil
+		} else {
+			return result.Condition{}, fmt.Errorf("listing GatewayClasses: %w", err)
+		}
+	}
+
+	var accepted, rejected []string
+
+	for _, gc := range items {
+		controller, _, _ := unstructured.NestedString(gc.Object, "spec", "controllerName")
+		if controller != openShiftGatewayController {
+			continue
+		}
+
+		if conditionStatus(gc, "Accepted") == string(metav1.ConditionTrue) {
+			accepted = append(accepted, gc.GetName())
+		} else {
+			rejected = append(rejected, gc.GetName())
+		}
+	}
+
+	switch {
+	case len(rejected) > 0:
+		return check.NewCondition(
+			ConditionTypeGatewayClassReady,
+			metav1.ConditionFalse,
+			check.WithReason(check.ReasonResourceUnavailable),
+			check.WithMessage("GatewayClass(es) of controller %s not Accepted: %s. Gateways of these classes will not be programmed", openShiftGatewayController, strings.Join(rejected, ", ")),
+			check.WithImpact(result.ImpactBlocking),
+			check.WithRemediation(c.CheckRemediation),
+		), nil
+	case len(accepted) > 0:
+		return check.NewCondition(
+			ConditionTypeGatewayClassReady,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonResourceAvailable),
+			check.WithMessage("GatewayClass(es) of controller %s Accepted: %s", openShiftGatewayController, strings.Join(accepted, ", ")),
+		), nil
+	}
+
+	return check.NewCondition(
+		ConditionTypeGatewayClassReady,
+		metav1.ConditionTrue,
+		check.WithReason(check.ReasonRequirementsMet),
+		check.WithMessage("No GatewayClass of controller %s found - RHOAI 3.x creates one during upgrade", openShiftGatewayController),
+	), nil
+}
+
+// ingressCondition verifies that the Ingress capability is enabled and the ingress cluster
+// operator, which reconciles GatewayClasses of the OpenShift controller, is available.
+func (c *Check) ingressCondition(ctx context.Context, r client.Reader) (result.Condition, error) {
+	cv, err := r.GetResource(ctx, resources.ClusterVersion, "version")
+	if err != nil && !apierrors.IsNotFound(err) && !client.IsResourceTypeNotFound(err) {
+		return result.Condition{}, fmt.Errorf("getting ClusterVersion: %w", err)
+	}
+
+	if cv != nil && !capabilityEnabled(cv, ingressCapability) {
+		return check.NewCondition(
+			ConditionTypeIngressOperatorReady,
+			metav1.ConditionFalse,
+			check.WithReason(check.ReasonDependencyUnavailable),
+			check.WithMessage("The %s cluster capability is disabled; the ingress operator required to reconcile Gateways is not installed", ingressCapability),
+			check.WithImpact(result.ImpactBlocking),
+			check.WithRemediation(c.CheckRemediation),
+		), nil
+	}
+
+	co, err := r.GetResource(ctx, resources.ClusterOperator, ingressClusterOperator)
+	if err != nil {
+		if apierrors.IsNotFound(err) || client.IsResourceTypeNotFound(err) {
+			return check.NewCondition(
+				ConditionTypeIngressOperatorReady,
+				metav1.ConditionFalse,
+				check.WithReason(check.ReasonResourceNotFound),
+				check.WithMessage("ClusterOperator %s not found", ingressClusterOperator),
+				check.WithImpact(result.ImpactBlocking),
+				check.WithRemediation(c.CheckRemediation),
+			), nil
+		}
+
+		return result.Condition{}, fmt.Errorf("getting ClusterOperator %s: %w", ingressClusterOperator, err)
+	}
+
+	if co == nil {
+		return check.NewCondition(
+			ConditionTypeIngressOperatorReady,
+			metav1.ConditionUnknown,
+			check.WithReason(check.ReasonAPIAccessDenied),
+			check.WithMessage("Unable to access ClusterOperator %s - insufficient permissions", ingressClusterOperator),
+		), nil
+	}
+
+	if conditionStatus(co, "Available") != string(metav1.ConditionTrue) {
+		return check.NewCondition(
+			ConditionTypeIngressOperatorReady,
+			metav1.ConditionFalse,
+			check.WithReason(check.ReasonResourceUnavailable),
+			check.WithMessage("ClusterOperator %s is not Available", ingressClusterOperator),
+			check.WithImpact(result.ImpactBlocking),
+			check.WithRemediation(c.CheckRemediation),
+		), nil
+	}
+
+	if conditionStatus(co, "Degraded") == string(metav1.ConditionTrue) {
+		return check.NewCondition(
+			ConditionTypeIngressOperatorReady,
+			metav1.ConditionFalse,
+			check.WithReason(check.ReasonResourceUnavailable),
+			check.WithMessage("ClusterOperator %s is Degraded; Gateways may not be programmed", ingressClusterOperator),
+			check.WithRemediation(c.CheckRemediation),
+		), nil
+	}
+
+	return check.NewCondition(
+		ConditionTypeIngressOperatorReady,
+		metav1.ConditionTrue,
+		check.WithReason(check.ReasonResourceAvailable),
+		check.WithMessage("ClusterOperator %s is Available", ingressClusterOperator),
+	), nil
+}
+
+// servesVersion reports whether a CRD serves the given version.
+func servesVersion(crd *unstructured.Unstructured, name string) bool {
+	versions, _, _ := unstructured.NestedSlice(crd.Object, "spec", "versions")
+
+	for _, v := range versions {
+		vm, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		if vm["name"] == name && vm["served"] == true {
+			return true
+		}
+	}
+
+	return false
+}
+
+// bundleVersion returns the Gateway API release a CRD was installed from, or nil if the
+// annotation is absent or not a version.
+func bundleVersion(crd *unstructured.Unstructured) *semver.Version {
+	v, err := semver.ParseTolerant(strings.TrimPrefix(crd.GetAnnotations()[annotationGatewayAPIBundleVersion], "v"))
+	if err != nil {
+		return nil
+	}
+
+	return &v
+}
+
+// capabilityEnabled reports whether a cluster capability is enabled. Clusters reporting no
+// capabilities predate capability selection, where every capability is enabled.
+func capabilityEnabled(cv *unstructured.Unstructured, capability string) bool {
+	known, found, _ := unstructured.NestedStringSlice(cv.Object, "status", "capabilities", "knownCapabilities")
+	if !found || !slices.Contains(known, capability) {
+		return true
+	}
+
+	enabled, _, _ := unstructured.NestedStringSlice(cv.Object, "status", "capabilities", "enabledCapabilities")
+
+	return slices.Contains(enabled, capability)
+}
+
+// conditionStatus returns the status of a status condition of an object, or "" if absent.
+func conditionStatus(obj *unstructured.Unstructured, conditionType string) string {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+
+	for _, cond := range conditions {
+		cm, ok := cond.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		if cm["type"] == conditionType {
+			status, _ := cm["status"].(string)
+
+			return status
+		}
+	}
+
+	return ""
+}
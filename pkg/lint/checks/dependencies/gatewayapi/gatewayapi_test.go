@@ -0,0 +1,251 @@
+package gatewayapi_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/dependencies/gatewayapi"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+//nolint:gochecknoglobals
+var listKinds = map[schema.GroupVersionResource]string{
+	resources.CustomResourceDefinition.GVR(): resources.CustomResourceDefinition.ListKind(),
+	resources.GatewayClass.GVR():             resources.GatewayClass.ListKind(),
+	resources.ClusterOperator.GVR():          resources.ClusterOperator.ListKind(),
+	resources.ClusterVersion.GVR():           resources.ClusterVersion.ListKind(),
+}
+
+func newCRD(plural string, bundleVersion string, served bool) *unstructured.Unstructured {
+	obj := resources.CustomResourceDefinition.Unstructured()
+	obj.SetName(plural + ".gateway.networking.k8s.io")
+	obj.SetAnnotations(map[string]string{"gateway.networking.k8s.io/bundle-version": bundleVersion})
+	_ = unstructured.SetNestedSlice(obj.Object, []any{
+		map[string]any{"name": "v1", "served": served, "storage": true},
+	}, "spec", "versions")
+
+	return &obj
+}
+
+func gatewayCRDs(bundleVersion string) []*unstructured.Unstructured {
+	return []*unstructured.Unstructured{
+		newCRD("gatewayclasses", bundleVersion, true),
+		newCRD("gateways", bundleVersion, true),
+		newCRD("httproutes", bundleVersion, true),
+	}
+}
+
+func withStatusConditions(obj *unstructured.Unstructured, statuses map[string]string) *unstructured.Unstructured {
+	conditions := make([]any, 0, len(statuses))
+	for conditionType, status := range statuses {
+		conditions = append(conditions, map[string]any{"type": conditionType, "status": status})
+	}
+
+	_ = unstructured.SetNestedSlice(obj.Object, conditions, "status", "conditions")
+
+	return obj
+}
+
+func newIngressOperator(available string) *unstructured.Unstructured {
+	obj := resources.ClusterOperator.Unstructured()
+	obj.SetName("ingress")
+
+	return withStatusConditions(&obj, map[string]string{"Available": available, "Degraded": "False"})
+}
+
+func newGatewayClass(name string, accepted string) *unstructured.Unstructured {
+	obj := resources.GatewayClass.Unstructured()
+	obj.SetName(name)
+	_ = unstructured.SetNestedField(obj.Object, "openshift.io/gateway-controller/v1", "spec", "controllerName")
+
+	return withStatusConditions(&obj, map[string]string{"Accepted": accepted})
+}
+
+func newClusterVersion(enabled ...string) *unstructured.Unstructured {
+	obj := resources.ClusterVersion.Unstructured()
+	obj.SetName("version")
+	_ = unstructured.SetNestedStringSlice(obj.Object, []string{"Ingress", "Console"}, "status", "capabilities", "knownCapabilities")
+	_ = unstructured.SetNestedStringSlice(obj.Object, enabled, "status", "capabilities", "enabledCapabilities")
+
+	return &obj
+}
+
+func conditionOf(dr *resultpkg.DiagnosticResult, conditionType string) resultpkg.Condition {
+	for _, c := range dr.Status.Conditions {
+		if c.Type == conditionType {
+			return c
+		}
+	}
+
+	return resultpkg.Condition{}
+}
+
+func TestGatewayAPICheck_Ready(t *testing.T) {
+	g := NewWithT(t)
+
+	objects := append(gatewayCRDs("v1.2.1"),
+		newIngressOperator("True"),
+		newClusterVersion("Ingress"),
+		newGatewayClass("data-science-gateway-class", "True"),
+	)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      listKinds,
+		Objects:        objects,
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := gatewayapi.NewCheck().Validate(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(3))
+
+	for _, c := range dr.Status.Conditions {
+		g.Expect(c.Status).To(Equal(metav1.ConditionTrue), c.Message)
+	}
+
+	g.Expect(dr.Annotations).To(HaveKeyWithValue(gatewayapi.AnnotationBundleVersion, "v1.2.1"))
+	g.Expect(conditionOf(dr, gatewayapi.ConditionTypeGatewayClassReady).Message).To(ContainSubstring("data-science-gateway-class"))
+}
+
+func TestGatewayAPICheck_MissingCRDs(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      listKinds,
+		Objects:        []*unstructured.Unstructured{newCRD("gatewayclasses", "v1.2.1", true), newIngressOperator("True")},
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := gatewayapi.NewCheck().Validate(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(conditionOf(dr, gatewayapi.ConditionTypeCRDsReady)).To(MatchFields(IgnoreExtras, Fields{
+		"Condition": MatchFields(IgnoreExtras, Fields{
+			"Status":  Equal(metav1.ConditionFalse),
+			"Reason":  Equal(check.ReasonResourceNotFound),
+			"Message": And(ContainSubstring("gateways.gateway.networking.k8s.io"), ContainSubstring("httproutes.gateway.networking.k8s.io")),
+		}),
+		"Impact": Equal(resultpkg.ImpactBlocking),
+	}))
+	g.Expect(conditionOf(dr, gatewayapi.ConditionTypeGatewayClassReady).Status).To(Equal(metav1.ConditionUnknown))
+}
+
+func TestGatewayAPICheck_OutdatedOrUnservedCRDs(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      listKinds,
+		Objects:        append(gatewayCRDs("v1.0.0"), newIngressOperator("True")),
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := gatewayapi.NewCheck().Validate(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(conditionOf(dr, gatewayapi.ConditionTypeCRDsReady).Message).To(ContainSubstring("older than v1.2.0"))
+	g.Expect(dr.Annotations).To(HaveKeyWithValue(gatewayapi.AnnotationBundleVersion, "v1.0.0"))
+
+	crds := gatewayCRDs("v1.2.1")
+	crds[2] = newCRD("httproutes", "v1.2.1", false)
+
+	target = testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      listKinds,
+		Objects:        append(crds, newIngressOperator("True")),
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err = gatewayapi.NewCheck().Validate(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(conditionOf(dr, gatewayapi.ConditionTypeCRDsReady)).To(MatchFields(IgnoreExtras, Fields{
+		"Condition": MatchFields(IgnoreExtras, Fields{
+			"Reason":  Equal(check.ReasonVersionIncompatible),
+			"Message": ContainSubstring("do not serve v1: httproutes"),
+		}),
+		"Impact": Equal(resultpkg.ImpactBlocking),
+	}))
+}
+
+func TestGatewayAPICheck_GatewayClassNotAccepted(t *testing.T) {
+	g := NewWithT(t)
+
+	objects := append(gatewayCRDs("v1.2.1"), newIngressOperator("True"), newGatewayClass("broken", "False"))
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      listKinds,
+		Objects:        objects,
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := gatewayapi.NewCheck().Validate(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(conditionOf(dr, gatewayapi.ConditionTypeGatewayClassReady)).To(MatchFields(IgnoreExtras, Fields{
+		"Condition": MatchFields(IgnoreExtras, Fields{
+			"Status":  Equal(metav1.ConditionFalse),
+			"Message": ContainSubstring("not Accepted: broken"),
+		}),
+		"Impact": Equal(resultpkg.ImpactBlocking),
+	}))
+}
+
+func TestGatewayAPICheck_IngressOperator(t *testing.T) {
+	tests := []struct {
+		name    string
+		objects []*unstructured.Unstructured
+		message string
+	}{
+		{name: "capability disabled", objects: []*unstructured.Unstructured{newClusterVersion("Console"), newIngressOperator("True")}, message: "capability is disabled"},
+		{name: "operator missing", objects: []*unstructured.Unstructured{newClusterVersion("Ingress")}, message: "not found"},
+		{name: "operator unavailable", objects: []*unstructured.Unstructured{newIngressOperator("False")}, message: "is not Available"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			target := testutil.NewTarget(t, testutil.TargetConfig{
+				ListKinds:      listKinds,
+				Objects:        append(gatewayCRDs("v1.2.1"), tt.objects...),
+				CurrentVersion: "2.25.0",
+				TargetVersion:  "3.0.0",
+			})
+
+			dr, err := gatewayapi.NewCheck().Validate(t.Context(), target)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(conditionOf(dr, gatewayapi.ConditionTypeIngressOperatorReady)).To(MatchFields(IgnoreExtras, Fields{
+				"Condition": MatchFields(IgnoreExtras, Fields{
+					"Status":  Equal(metav1.ConditionFalse),
+					"Message": ContainSubstring(tt.message),
+				}),
+				"Impact": Equal(resultpkg.ImpactBlocking),
+			}))
+		})
+	}
+}
+
+func TestGatewayAPICheck_CanApply(t *testing.T) {
+	g := NewWithT(t)
+
+	lint2x := testutil.NewTarget(t, testutil.TargetConfig{ListKinds: listKinds, CurrentVersion: "2.25.0", TargetVersion: "2.25.0"})
+	upgrade := testutil.NewTarget(t, testutil.TargetConfig{ListKinds: listKinds, CurrentVersion: "2.25.0", TargetVersion: "3.0.0"})
+
+	canApply, err := gatewayapi.NewCheck().CanApply(t.Context(), lint2x)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeFalse())
+
+	canApply, err = gatewayapi.NewCheck().CanApply(t.Context(), upgrade)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeTrue())
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
 	"github.com/opendatahub-io/odh-cli/pkg/util/version"
 )
 
@@ -20,6 +21,25 @@ const (
 //nolint:gochecknoglobals
 var minVersion = semver.MustParse("4.19.9")
 
+// Examples rendered by 'lint explain'.
+const (
+	versionRequirementFailingExample = `apiVersion: config.openshift.io/v1
+kind: ClusterVersion
+metadata:
+  name: version
+status:
+  desired:
+    version: 4.18.22`
+
+	versionRequirementPassingExample = `apiVersion: config.openshift.io/v1
+kind: ClusterVersion
+metadata:
+  name: version
+status:
+  desired:
+    version: 4.19.9`
+)
+
 // Check validates OpenShift version requirements for RHOAI 3.x upgrades.
 type Check struct {
 	check.BaseCheck
@@ -35,6 +55,21 @@ func NewCheck() *Check {
 			CheckID:          "dependencies.openshift.version-requirement",
 			CheckName:        "Dependencies :: OpenShift :: Version Requirement (3.x)",
 			CheckDescription: "Validates that OpenShift is at least version 4.19.9 when upgrading to RHOAI 3.x",
+			CheckResources: []resources.ResourceType{
+				resources.ClusterVersion,
+			},
+			CheckVersionGate:    check.VersionGate3x,
+			CheckKnowledgeLinks: []string{check.DocsUpgrading},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "The OpenShift version reported by the ClusterVersion resource, compared with the 4.19.9 minimum of RHOAI 3.x. The check runs when the current or target version is 3.x.",
+				Rationale:      "RHOAI 3.x relies on OpenShift 4.19 features such as the built-in Gateway API controller and its service mesh. The operator refuses to install on older clusters, so OpenShift must be upgraded first.",
+				FailingExample: versionRequirementFailingExample,
+				PassingExample: versionRequirementPassingExample,
+				RemediationCommands: []string{
+					"oc get clusterversion version",
+					"oc adm upgrade --to=4.19.9",
+				},
+			},
 		},
 	}
 }
@@ -0,0 +1,165 @@
+package operatorskew
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube/olm"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kueue"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+	"github.com/opendatahub-io/odh-cli/pkg/util/zstream"
+)
+
+const (
+	kind      = "operators"
+	checkType = "version-skew"
+
+	// ConditionTypeOperatorVersionsCompatible indicates whether installed dependent operators
+	// satisfy the minimum versions of the target release.
+	ConditionTypeOperatorVersionsCompatible = "OperatorVersionsCompatible"
+
+	// AnnotationRequiredVersion is the minimum operator version required by the target release.
+	AnnotationRequiredVersion = "operator.opendatahub.io/required-version"
+)
+
+// Examples rendered by 'lint explain'.
+const (
+	versionSkewFailingExample = `apiVersion: operators.coreos.com/v1alpha1
+kind: Subscription
+metadata:
+  name: serverless-operator
+  namespace: openshift-serverless
+  annotations:
+    operator.opendatahub.io/required-version: 1.35.0
+status:
+  installedCSV: serverless-operator.v1.33.2`
+
+	versionSkewPassingExample = `apiVersion: operators.coreos.com/v1alpha1
+kind: Subscription
+metadata:
+  name: serverless-operator
+  namespace: openshift-serverless
+status:
+  installedCSV: serverless-operator.v1.35.0`
+)
+
+// VersionSkewCheck compares the installed CSV versions of dependent operators (Serverless,
+// Service Mesh, Authorino) with the minimum versions required by the target 2.x release.
+// Operators that are not installed are not reported.
+type VersionSkewCheck struct {
+	check.BaseCheck
+}
+
+func NewVersionSkewCheck() *VersionSkewCheck {
+	return &VersionSkewCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupDependency,
+			Kind:             kind,
+			Type:             checkType,
+			CheckID:          "dependencies.operators.version-skew",
+			CheckName:        "Dependencies :: Operators :: Version Skew (2.x z-stream)",
+			CheckDescription: "Compares the installed versions of dependent operators with the minimum versions required by the target 2.x release",
+			CheckRemediation: "Upgrade the listed operators to at least the required version before upgrading OpenShift AI",
+			CheckResources: []resources.ResourceType{
+				resources.Subscription,
+			},
+			CheckVersionGate:    check.VersionGateUpgradeWithin2x,
+			CheckKnowledgeLinks: []string{check.DocsUpgrading},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "The installed CSV versions of the dependent operators (Serverless, Service Mesh, Authorino) against the minimum versions the z-stream support matrix lists for the target 2.x release. Operators that are not installed are not reported. The check only runs for upgrades within 2.x.",
+				Rationale:      "Each 2.x release is tested against minimum versions of the operators it configures. An older operator can reject the resources the new release creates, leaving KServe or authentication degraded after the upgrade.",
+				FailingExample: versionSkewFailingExample,
+				PassingExample: versionSkewPassingExample,
+				RemediationCommands: []string{
+					"kubectl get subscriptions.operators.coreos.com -A -o custom-columns=NAME:.metadata.name,CSV:.status.installedCSV",
+					"kubectl get installplans -A",
+				},
+			},
+		},
+	}
+}
+
+// CanApply returns whether this check should run for the given target.
+// Only applies when upgrading between 2.x releases.
+func (c *VersionSkewCheck) CanApply(_ context.Context, target check.Target) (bool, error) {
+	return version.IsUpgradeWithin2x(target.CurrentVersion, target.TargetVersion), nil
+}
+
+// Validate executes the check against the provided target.
+func (c *VersionSkewCheck) Validate(
+	ctx context.Context,
+	target check.Target,
+) (*result.DiagnosticResult, error) {
+	dr := c.NewResult()
+
+	if target.TargetVersion != nil {
+		dr.Annotations[check.AnnotationCheckTargetVersion] = target.TargetVersion.String()
+	}
+
+	var skewed []string
+
+	for _, req := range zstream.OperatorRequirementsFor(target.TargetVersion) {
+		sub, err := olm.FindOperator(ctx, target.Client, func(s *olm.SubscriptionInfo) bool {
+			return s.Name == req.Subscription
+		})
+		if err != nil {
+			return nil, fmt.Errorf("checking %s operator version: %w", req.Subscription, err)
+		}
+
+		if !sub.Found() {
+			continue
+		}
+
+		// CSV names without a parseable version cannot be judged reliably
+		installed, err := kueue.ParseCSVVersion(sub.GetVersion())
+		if err != nil || !installed.LT(req.MinVersion) {
+			continue
+		}
+
+		skewed = append(skewed, fmt.Sprintf("%s %s (requires %s for %s)",
+			req.Subscription, installed, req.MinVersion, req.Release))
+
+		dr.ImpactedObjects = append(dr.ImpactedObjects, metav1.PartialObjectMetadata{
+			TypeMeta: resources.Subscription.TypeMeta(),
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: sub.Namespace,
+				Name:      sub.Name,
+				Annotations: map[string]string{
+					AnnotationRequiredVersion: req.MinVersion.String(),
+				},
+			},
+		})
+	}
+
+	dr.Annotations[check.AnnotationImpactedWorkloadCount] = strconv.Itoa(len(skewed))
+
+	if len(skewed) == 0 {
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeOperatorVersionsCompatible,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonVersionCompatible),
+			check.WithMessage("Installed dependent operators satisfy the minimum versions of the target release"),
+		))
+
+		return dr, nil
+	}
+
+	dr.SetCondition(check.NewCondition(
+		ConditionTypeOperatorVersionsCompatible,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonVersionIncompatible),
+		check.WithMessage("Found %d dependent operator(s) older than required by the target release: %s",
+			len(skewed), strings.Join(skewed, ", ")),
+		check.WithImpact(result.ImpactBlocking),
+		check.WithRemediation(c.CheckRemediation),
+	))
+
+	return dr, nil
+}
@@ -0,0 +1,90 @@
+package operatorskew_test
+
+import (
+	"testing"
+
+	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	operatorfake "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/clientset/versioned/fake"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/dependencies/operatorskew"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+func newSubscription(name string, csv string) *operatorsv1alpha1.Subscription {
+	return &operatorsv1alpha1.Subscription{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "openshift-operators"},
+		Status:     operatorsv1alpha1.SubscriptionStatus{InstalledCSV: csv},
+	}
+}
+
+func newTarget(t *testing.T, current string, target string, subs ...runtime.Object) check.Target {
+	t.Helper()
+
+	return testutil.NewTarget(t, testutil.TargetConfig{
+		OLM:            operatorfake.NewSimpleClientset(subs...), //nolint:staticcheck // NewClientset requires generated apply configs not available in OLM
+		CurrentVersion: current,
+		TargetVersion:  target,
+	})
+}
+
+func TestVersionSkewCheck_Compatible(t *testing.T) {
+	g := NewWithT(t)
+
+	target := newTarget(t, "2.16.0", "2.19.0", newSubscription("serverless-operator", "serverless-operator.v1.35.1"))
+
+	dr, err := operatorskew.NewVersionSkewCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.ImpactedObjects).To(BeEmpty())
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":   Equal(operatorskew.ConditionTypeOperatorVersionsCompatible),
+		"Status": Equal(metav1.ConditionTrue),
+	}))
+}
+
+func TestVersionSkewCheck_OperatorTooOld(t *testing.T) {
+	g := NewWithT(t)
+
+	target := newTarget(t, "2.16.0", "2.22.0",
+		newSubscription("serverless-operator", "serverless-operator.v1.34.0"),
+		newSubscription("servicemeshoperator", "servicemeshoperator.v2.6.3"),
+	)
+
+	dr, err := operatorskew.NewVersionSkewCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions[0]).To(MatchFields(IgnoreExtras, Fields{
+		"Condition": MatchFields(IgnoreExtras, Fields{
+			"Status":  Equal(metav1.ConditionFalse),
+			"Reason":  Equal(check.ReasonVersionIncompatible),
+			"Message": ContainSubstring("serverless-operator 1.34.0 (requires 1.35.0 for 2.19)"),
+		}),
+		"Impact": Equal(resultpkg.ImpactBlocking),
+	}))
+	g.Expect(dr.ImpactedObjects).To(HaveLen(1))
+	g.Expect(dr.ImpactedObjects[0].Name).To(Equal("serverless-operator"))
+	g.Expect(dr.ImpactedObjects[0].Namespace).To(Equal("openshift-operators"))
+	g.Expect(dr.ImpactedObjects[0].Annotations).To(HaveKeyWithValue(operatorskew.AnnotationRequiredVersion, "1.35.0"))
+}
+
+func TestVersionSkewCheck_CanApply(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := operatorskew.NewVersionSkewCheck()
+
+	canApply, err := chk.CanApply(t.Context(), newTarget(t, "2.19.0", "2.22.0"))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeTrue())
+
+	canApply, err = chk.CanApply(t.Context(), newTarget(t, "2.25.0", "3.0.0"))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeFalse())
+}
@@ -0,0 +1,347 @@
+package operatorsubscription
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+const (
+	kind      = "rhoai-operator"
+	checkType = "subscription"
+
+	// operatorPackage is the OLM package of the OpenShift AI operator.
+	operatorPackage = "rhods-operator"
+
+	// ConditionTypeSubscriptionReady indicates whether the OpenShift AI operator Subscription
+	// allows OLM to upgrade the operator to the target release.
+	ConditionTypeSubscriptionReady = "SubscriptionReady"
+
+	// AnnotationSubscriptionIssues lists the blocking issues found on the Subscription.
+	AnnotationSubscriptionIssues = "operator.opendatahub.io/subscription-issues"
+)
+
+// pinnedChannel matches the channels that only carry 2.x releases, e.g. "stable-2.x" or
+// "eus-2.16", capturing the channel prefix.
+var pinnedChannel = regexp.MustCompile(`^([a-z]+)-2\.(x|\d+)$`)
+
+// Examples rendered by 'lint explain'.
+const (
+	subscriptionFailingExample = `apiVersion: operators.coreos.com/v1alpha1
+kind: Subscription
+metadata:
+  name: rhods-operator
+  namespace: redhat-ods-operator
+spec:
+  channel: stable-2.x
+  installPlanApproval: Manual
+status:
+  state: UpgradePending
+  installPlanRef:
+    name: install-abcde
+  catalogHealth:
+  - catalogSourceRef:
+      name: redhat-operators
+      namespace: openshift-marketplace
+    healthy: false`
+
+	subscriptionPassingExample = `apiVersion: operators.coreos.com/v1alpha1
+kind: Subscription
+metadata:
+  name: rhods-operator
+  namespace: redhat-ods-operator
+spec:
+  channel: stable-3.x
+  installPlanApproval: Automatic
+status:
+  state: AtLatestKnown
+  catalogHealth:
+  - catalogSourceRef:
+      name: redhat-operators
+      namespace: openshift-marketplace
+    healthy: true`
+)
+
+// issue is a Subscription configuration that blocks the upgrade, with the command that
+// resolves it.
+type issue struct {
+	message     string
+	remediation string
+}
+
+// SubscriptionCheck inspects the OLM Subscription of the OpenShift AI operator for
+// configurations that keep OLM from upgrading it to 3.x: a channel pinned to 2.x releases, a
+// pending InstallPlan awaiting manual approval, and unhealthy catalog sources.
+type SubscriptionCheck struct {
+	check.BaseCheck
+}
+
+func NewCheck() *SubscriptionCheck {
+	return &SubscriptionCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupDependency,
+			Kind:             kind,
+			Type:             checkType,
+			CheckID:          "dependencies.rhoai-operator.subscription",
+			CheckName:        "Dependencies :: OpenShift AI Operator :: Subscription Readiness (3.x)",
+			CheckDescription: "Inspects the channel, approval mode, pending InstallPlans and catalog source health of the OpenShift AI operator Subscription",
+			CheckRemediation: "Run the commands in the condition message to switch the channel, resolve the pending InstallPlan or repair the catalog source before upgrading",
+			CheckResources: []resources.ResourceType{
+				resources.Subscription,
+				resources.InstallPlan,
+			},
+			CheckVersionGate:    check.VersionGateUpgrade2xTo3x,
+			CheckKnowledgeLinks: []string{check.DocsUpgrading},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "The OLM Subscription of the rhods-operator package: its channel, its InstallPlan approval mode and the InstallPlan awaiting approval, if any, and the health OLM reports for its catalog sources.",
+				Rationale:      "OLM only upgrades the operator to releases of the subscribed channel, one InstallPlan at a time, from healthy catalogs. A channel carrying only 2.x releases, a pending InstallPlan left unapproved, or an unreachable catalog keeps the operator on 2.x.",
+				FailingExample: subscriptionFailingExample,
+				PassingExample: subscriptionPassingExample,
+				RemediationCommands: []string{
+					"kubectl get subscriptions.operators.coreos.com -A -o custom-columns=NAME:.metadata.name,CHANNEL:.spec.channel,APPROVAL:.spec.installPlanApproval,STATE:.status.state",
+					"kubectl get installplans -n redhat-ods-operator",
+					"kubectl get catalogsources -n openshift-marketplace",
+				},
+			},
+		},
+	}
+}
+
+// CanApply returns whether this check should run for the given target.
+// Only applies when upgrading from 2.x to 3.x.
+func (c *SubscriptionCheck) CanApply(_ context.Context, target check.Target) (bool, error) {
+	return version.IsUpgradeFrom2xTo3x(target.CurrentVersion, target.TargetVersion), nil
+}
+
+// Validate executes the check against the provided target.
+func (c *SubscriptionCheck) Validate(
+	ctx context.Context,
+	target check.Target,
+) (*result.DiagnosticResult, error) {
+	dr := c.NewResult()
+
+	if target.TargetVersion != nil {
+		dr.Annotations[check.AnnotationCheckTargetVersion] = target.TargetVersion.String()
+	}
+
+	sub, err := findSubscription(ctx, target.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	if sub == nil {
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeSubscriptionReady,
+			metav1.ConditionFalse,
+			check.WithReason(check.ReasonResourceNotFound),
+			check.WithMessage("No OLM Subscription found for the %s package; the operator cannot be upgraded through OLM", operatorPackage),
+			check.WithImpact(result.ImpactAdvisory),
+		))
+
+		return dr, nil
+	}
+
+	issues, err := inspect(ctx, target.Client, sub, target.TargetVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	dr.Annotations[check.AnnotationImpactedWorkloadCount] = strconv.Itoa(len(issues))
+
+	if len(issues) == 0 {
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeSubscriptionReady,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonConfigurationValid),
+			check.WithMessage("Subscription %s/%s is ready for the upgrade (channel %q, %s approval)",
+				sub.Namespace, sub.Name, sub.Spec.Channel, approval(sub)),
+		))
+
+		return dr, nil
+	}
+
+	messages := make([]string, 0, len(issues))
+	commands := make([]string, 0, len(issues))
+
+	for _, i := range issues {
+		messages = append(messages, i.message)
+		commands = append(commands, i.remediation)
+	}
+
+	dr.ImpactedObjects = append(dr.ImpactedObjects, metav1.PartialObjectMetadata{
+		TypeMeta: resources.Subscription.TypeMeta(),
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: sub.Namespace,
+			Name:      sub.Name,
+			Annotations: map[string]string{
+				AnnotationSubscriptionIssues: strings.Join(messages, "; "),
+			},
+		},
+	})
+
+	dr.SetCondition(check.NewCondition(
+		ConditionTypeSubscriptionReady,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonConfigurationInvalid),
+		check.WithMessage("Subscription %s/%s will block the upgrade: %s. To resolve: %s",
+			sub.Namespace, sub.Name, strings.Join(messages, "; "), strings.Join(commands, "; ")),
+		check.WithImpact(result.ImpactBlocking),
+		check.WithRemediation(c.CheckRemediation),
+	))
+
+	return dr, nil
+}
+
+// findSubscription returns the Subscription of the OpenShift AI operator, or nil when there
+// is none.
+func findSubscription(ctx context.Context, c client.Reader) (*operatorsv1alpha1.Subscription, error) {
+	subscriptions, err := c.OLM().Subscriptions("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing subscriptions: %w", err)
+	}
+
+	for i := range subscriptions.Items {
+		sub := &subscriptions.Items[i]
+
+		if sub.Spec == nil {
+			sub.Spec = &operatorsv1alpha1.SubscriptionSpec{}
+		}
+
+		if sub.Spec.Package == operatorPackage || sub.Name == operatorPackage {
+			return sub, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// inspect returns the issues of the Subscription that block the upgrade to targetVersion.
+func inspect(
+	ctx context.Context,
+	c client.Reader,
+	sub *operatorsv1alpha1.Subscription,
+	targetVersion *semver.Version,
+) ([]issue, error) {
+	var issues []issue
+
+	if m := pinnedChannel.FindStringSubmatch(sub.Spec.Channel); m != nil && targetVersion != nil {
+		channel := fmt.Sprintf("%s-%d.x", m[1], targetVersion.Major)
+
+		issues = append(issues, issue{
+			message: fmt.Sprintf("channel %q only carries 2.x releases", sub.Spec.Channel),
+			remediation: fmt.Sprintf(`kubectl patch subscription %s -n %s --type merge -p '{"spec":{"channel":"%s"}}'`,
+				sub.Name, sub.Namespace, channel),
+		})
+	}
+
+	pending, err := pendingInstallPlan(ctx, c, sub, targetVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	if pending != nil {
+		issues = append(issues, *pending)
+	}
+
+	for _, health := range sub.Status.CatalogHealth {
+		if health.Healthy || health.CatalogSourceRef == nil {
+			continue
+		}
+
+		ref := health.CatalogSourceRef
+
+		issues = append(issues, issue{
+			message: fmt.Sprintf("CatalogSource %s/%s is unhealthy", ref.Namespace, ref.Name),
+			remediation: fmt.Sprintf("kubectl get catalogsource %s -n %s -o jsonpath='{.status.connectionState.lastObservedState}'",
+				ref.Name, ref.Namespace),
+		})
+	}
+
+	return issues, nil
+}
+
+// pendingInstallPlan returns the issue of an InstallPlan awaiting manual approval: a plan for
+// a release older than the target must be deleted so OLM can resolve the upgrade, any other
+// one approved.
+func pendingInstallPlan(
+	ctx context.Context,
+	c client.Reader,
+	sub *operatorsv1alpha1.Subscription,
+	targetVersion *semver.Version,
+) (*issue, error) {
+	if sub.Spec.InstallPlanApproval != operatorsv1alpha1.ApprovalManual ||
+		sub.Status.State != operatorsv1alpha1.SubscriptionStateUpgradePending ||
+		sub.Status.InstallPlanRef == nil {
+		return nil, nil
+	}
+
+	name := sub.Status.InstallPlanRef.Name
+
+	plan, err := c.GetResource(ctx, resources.InstallPlan, name, client.InNamespace(sub.Namespace))
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("getting InstallPlan %s/%s: %w", sub.Namespace, name, err)
+	}
+
+	csvs, _, _ := unstructured.NestedStringSlice(plan.Object, "spec", "clusterServiceVersionNames")
+
+	if targetVersion != nil && !installsMajor(csvs, targetVersion.Major) {
+		return &issue{
+			message: fmt.Sprintf("Manual approval with stale InstallPlan %s for %s, which is not a %d.x release",
+				name, strings.Join(csvs, ", "), targetVersion.Major),
+			remediation: fmt.Sprintf("kubectl delete installplan %s -n %s", name, sub.Namespace),
+		}, nil
+	}
+
+	return &issue{
+		message: fmt.Sprintf("InstallPlan %s for %s awaits manual approval", name, strings.Join(csvs, ", ")),
+		remediation: fmt.Sprintf(`kubectl patch installplan %s -n %s --type merge -p '{"spec":{"approved":true}}'`,
+			name, sub.Namespace),
+	}, nil
+}
+
+// installsMajor reports whether any of the CSVs, named like "rhods-operator.3.0.0", is a
+// release of the major version.
+func installsMajor(csvs []string, major uint64) bool {
+	for _, csv := range csvs {
+		_, ver, ok := strings.Cut(csv, ".")
+		if !ok {
+			continue
+		}
+
+		v, err := semver.ParseTolerant(strings.TrimPrefix(ver, "v"))
+		if err == nil && v.Major == major {
+			return true
+		}
+	}
+
+	return false
+}
+
+// approval returns the InstallPlan approval mode of the Subscription, OLM defaulting to
+// Automatic.
+func approval(sub *operatorsv1alpha1.Subscription) operatorsv1alpha1.Approval {
+	if sub.Spec.InstallPlanApproval == "" {
+		return operatorsv1alpha1.ApprovalAutomatic
+	}
+
+	return sub.Spec.InstallPlanApproval
+}
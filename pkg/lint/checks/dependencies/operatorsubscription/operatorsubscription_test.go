@@ -0,0 +1,206 @@
+package operatorsubscription_test
+
+import (
+	"testing"
+
+	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	operatorfake "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/clientset/versioned/fake"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/dependencies/operatorsubscription"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+const operatorNamespace = "redhat-ods-operator"
+
+func newSubscription(channel string, approval operatorsv1alpha1.Approval) *operatorsv1alpha1.Subscription {
+	return &operatorsv1alpha1.Subscription{
+		ObjectMeta: metav1.ObjectMeta{Name: "rhods-operator", Namespace: operatorNamespace},
+		Spec: &operatorsv1alpha1.SubscriptionSpec{
+			Package:             "rhods-operator",
+			Channel:             channel,
+			InstallPlanApproval: approval,
+		},
+		Status: operatorsv1alpha1.SubscriptionStatus{
+			State: operatorsv1alpha1.SubscriptionStateAtLatest,
+			CatalogHealth: []operatorsv1alpha1.SubscriptionCatalogHealth{{
+				CatalogSourceRef: &corev1.ObjectReference{Name: "redhat-operators", Namespace: "openshift-marketplace"},
+				Healthy:          true,
+			}},
+		},
+	}
+}
+
+// withPendingInstallPlan marks the Subscription as waiting for the approval of an InstallPlan.
+func withPendingInstallPlan(sub *operatorsv1alpha1.Subscription, name string) *operatorsv1alpha1.Subscription {
+	sub.Status.State = operatorsv1alpha1.SubscriptionStateUpgradePending
+	sub.Status.InstallPlanRef = &corev1.ObjectReference{Name: name, Namespace: operatorNamespace}
+
+	return sub
+}
+
+func newInstallPlan(name string, csv string) *unstructured.Unstructured {
+	plan := resources.InstallPlan.Unstructured()
+	plan.SetNamespace(operatorNamespace)
+	plan.SetName(name)
+	plan.Object["spec"] = map[string]any{
+		"approval":                   "Manual",
+		"approved":                   false,
+		"clusterServiceVersionNames": []any{csv},
+	}
+
+	return &plan
+}
+
+func newTarget(
+	t *testing.T,
+	sub *operatorsv1alpha1.Subscription,
+	objects ...*unstructured.Unstructured,
+) check.Target {
+	t.Helper()
+
+	var subs []runtime.Object
+	if sub != nil {
+		subs = append(subs, sub)
+	}
+
+	return testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: map[schema.GroupVersionResource]string{
+			resources.InstallPlan.GVR(): resources.InstallPlan.ListKind(),
+		},
+		Objects:        objects,
+		OLM:            operatorfake.NewSimpleClientset(subs...), //nolint:staticcheck // NewClientset requires generated apply configs not available in OLM
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+	})
+}
+
+func TestSubscriptionCheck_Ready(t *testing.T) {
+	g := NewWithT(t)
+
+	target := newTarget(t, newSubscription("stable-3.x", operatorsv1alpha1.ApprovalAutomatic))
+
+	dr, err := operatorsubscription.NewCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.ImpactedObjects).To(BeEmpty())
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":    Equal(operatorsubscription.ConditionTypeSubscriptionReady),
+		"Status":  Equal(metav1.ConditionTrue),
+		"Reason":  Equal(check.ReasonConfigurationValid),
+		"Message": ContainSubstring(`channel "stable-3.x", Automatic approval`),
+	}))
+}
+
+func TestSubscriptionCheck_PinnedChannel(t *testing.T) {
+	g := NewWithT(t)
+
+	target := newTarget(t, newSubscription("stable-2.x", operatorsv1alpha1.ApprovalAutomatic))
+
+	dr, err := operatorsubscription.NewCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions[0]).To(MatchFields(IgnoreExtras, Fields{
+		"Condition": MatchFields(IgnoreExtras, Fields{
+			"Status":  Equal(metav1.ConditionFalse),
+			"Reason":  Equal(check.ReasonConfigurationInvalid),
+			"Message": ContainSubstring(`"spec":{"channel":"stable-3.x"}`),
+		}),
+		"Impact": Equal(resultpkg.ImpactBlocking),
+	}))
+	g.Expect(dr.ImpactedObjects).To(HaveLen(1))
+	g.Expect(dr.ImpactedObjects[0].Annotations).To(HaveKeyWithValue(
+		operatorsubscription.AnnotationSubscriptionIssues, `channel "stable-2.x" only carries 2.x releases`))
+}
+
+func TestSubscriptionCheck_StaleInstallPlan(t *testing.T) {
+	g := NewWithT(t)
+
+	sub := withPendingInstallPlan(newSubscription("stable-3.x", operatorsv1alpha1.ApprovalManual), "install-old")
+	target := newTarget(t, sub, newInstallPlan("install-old", "rhods-operator.2.25.1"))
+
+	dr, err := operatorsubscription.NewCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions[0].Condition.Message).To(And(
+		ContainSubstring("stale InstallPlan install-old for rhods-operator.2.25.1"),
+		ContainSubstring("kubectl delete installplan install-old -n redhat-ods-operator"),
+	))
+	g.Expect(dr.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactBlocking))
+}
+
+func TestSubscriptionCheck_InstallPlanAwaitingApproval(t *testing.T) {
+	g := NewWithT(t)
+
+	sub := withPendingInstallPlan(newSubscription("stable-3.x", operatorsv1alpha1.ApprovalManual), "install-new")
+	target := newTarget(t, sub, newInstallPlan("install-new", "rhods-operator.3.0.0"))
+
+	dr, err := operatorsubscription.NewCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions[0].Condition.Message).To(And(
+		ContainSubstring("InstallPlan install-new for rhods-operator.3.0.0 awaits manual approval"),
+		ContainSubstring(`kubectl patch installplan install-new -n redhat-ods-operator --type merge -p '{"spec":{"approved":true}}'`),
+	))
+}
+
+func TestSubscriptionCheck_UnhealthyCatalog(t *testing.T) {
+	g := NewWithT(t)
+
+	sub := newSubscription("stable-3.x", operatorsv1alpha1.ApprovalAutomatic)
+	sub.Status.CatalogHealth[0].Healthy = false
+
+	dr, err := operatorsubscription.NewCheck().Validate(t.Context(), newTarget(t, sub))
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions[0].Condition.Message).To(And(
+		ContainSubstring("CatalogSource openshift-marketplace/redhat-operators is unhealthy"),
+		ContainSubstring("kubectl get catalogsource redhat-operators -n openshift-marketplace"),
+	))
+}
+
+func TestSubscriptionCheck_NotFound(t *testing.T) {
+	g := NewWithT(t)
+
+	dr, err := operatorsubscription.NewCheck().Validate(t.Context(), newTarget(t, nil))
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions[0]).To(MatchFields(IgnoreExtras, Fields{
+		"Condition": MatchFields(IgnoreExtras, Fields{
+			"Status": Equal(metav1.ConditionFalse),
+			"Reason": Equal(check.ReasonResourceNotFound),
+		}),
+		"Impact": Equal(resultpkg.ImpactAdvisory),
+	}))
+}
+
+func TestSubscriptionCheck_CanApply(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := operatorsubscription.NewCheck()
+
+	canApply, err := chk.CanApply(t.Context(), testutil.NewTarget(t, testutil.TargetConfig{
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+	}))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeTrue())
+
+	canApply, err = chk.CanApply(t.Context(), testutil.NewTarget(t, testutil.TargetConfig{
+		CurrentVersion: "2.16.0",
+		TargetVersion:  "2.19.0",
+	}))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeFalse())
+}
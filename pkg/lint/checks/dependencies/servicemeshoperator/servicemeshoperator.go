@@ -8,6 +8,7 @@ import (
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
 	"github.com/opendatahub-io/odh-cli/pkg/util/version"
 )
 
@@ -16,6 +17,21 @@ const (
 	checkType = "upgrade"
 )
 
+// Examples rendered by 'lint explain'.
+const (
+	upgradeFailingExample = `apiVersion: operators.coreos.com/v1alpha1
+kind: Subscription
+metadata:
+  name: servicemeshoperator
+  namespace: openshift-operators
+spec:
+  channel: stable
+status:
+  installedCSV: servicemeshoperator.v2.6.5`
+
+	upgradePassingExample = `# No servicemeshoperator Subscription on the stable or v2.x channel`
+)
+
 // Check validates that Service Mesh Operator v2 is not installed when upgrading to 3.x,
 // as it is no longer required by RHOAI 3.x (OpenShift 4.19+ handles service mesh internally).
 type Check struct {
@@ -32,6 +48,21 @@ func NewCheck() *Check {
 			CheckID:          "dependencies.servicemeshoperator2.upgrade",
 			CheckName:        "Dependencies :: ServiceMeshOperator2 :: Upgrade (3.x)",
 			CheckDescription: "Validates that Service Mesh Operator v2 is not installed when upgrading to RHOAI 3.x (no longer required, OpenShift 4.19+ handles service mesh internally)",
+			CheckResources: []resources.ResourceType{
+				resources.Subscription,
+			},
+			CheckVersionGate:    check.VersionGateUpgrade2xTo3x,
+			CheckKnowledgeLinks: []string{check.DocsServingModels},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "OLM Subscriptions named servicemeshoperator on the stable or v2.x channels, i.e. an installed OpenShift Service Mesh 2 operator. The check only runs when upgrading from 2.x to 3.x.",
+				Rationale:      "RHOAI 3.x no longer uses Service Mesh 2: OpenShift 4.19 provides the mesh it needs. A leftover Service Mesh 2 operator keeps reconciling control planes and sidecars nothing uses, and can conflict with the Service Mesh 3 based Gateway API implementation.",
+				FailingExample: upgradeFailingExample,
+				PassingExample: upgradePassingExample,
+				RemediationCommands: []string{
+					"kubectl get servicemeshcontrolplanes -A",
+					"kubectl delete subscription servicemeshoperator -n openshift-operators",
+				},
+			},
 		},
 	}
 }
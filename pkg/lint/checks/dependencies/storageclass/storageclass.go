@@ -0,0 +1,136 @@
+package storageclass
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+const (
+	kind      = "storageclass"
+	checkType = "default"
+
+	// ConditionTypeDefaultStorageClass indicates whether the cluster has a single default StorageClass.
+	ConditionTypeDefaultStorageClass = "DefaultStorageClassAvailable"
+
+	annotationDefaultClass     = "storageclass.kubernetes.io/is-default-class"
+	annotationBetaDefaultClass = "storageclass.beta.kubernetes.io/is-default-class"
+)
+
+// Examples rendered by 'lint explain'.
+const (
+	defaultFailingExample = `# No StorageClass annotated with storageclass.kubernetes.io/is-default-class: "true"`
+
+	defaultPassingExample = `apiVersion: storage.k8s.io/v1
+kind: StorageClass
+metadata:
+  name: gp3-csi
+  annotations:
+    storageclass.kubernetes.io/is-default-class: "true"
+provisioner: ebs.csi.aws.com`
+)
+
+// Check validates that the cluster has a default StorageClass for the PersistentVolumeClaims
+// created by RHOAI 3.x components without an explicit storage class.
+type Check struct {
+	check.BaseCheck
+}
+
+// NewCheck creates a new default StorageClass check.
+func NewCheck() *Check {
+	return &Check{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupDependency,
+			Kind:             kind,
+			Type:             checkType,
+			CheckID:          "dependencies.storageclass.default",
+			CheckName:        "Dependencies :: StorageClass :: Default (3.x)",
+			CheckDescription: "Validates that the cluster has exactly one default StorageClass for the persistent volumes RHOAI 3.x components provision",
+			CheckRemediation: "Annotate a dynamically provisioning StorageClass with storageclass.kubernetes.io/is-default-class=true, and remove the annotation from the others",
+			CheckResources: []resources.ResourceType{
+				resources.StorageClass,
+			},
+			CheckVersionGate:    check.VersionGate3x,
+			CheckKnowledgeLinks: []string{check.DocsUpgrading},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "The StorageClasses annotated with storageclass.kubernetes.io/is-default-class (or its beta variant) set to true. The check runs when the current or target version is 3.x.",
+				Rationale:      "Workbenches, pipeline servers, the model registry database and the 3.x model cache create PersistentVolumeClaims without a storage class. Without a default StorageClass these claims stay Pending and the components never become ready; with several defaults the class each claim gets is not predictable.",
+				FailingExample: defaultFailingExample,
+				PassingExample: defaultPassingExample,
+				RemediationCommands: []string{
+					"kubectl get storageclasses",
+					"kubectl annotate storageclass <name> storageclass.kubernetes.io/is-default-class=true --overwrite",
+				},
+			},
+		},
+	}
+}
+
+func (c *Check) CanApply(_ context.Context, target check.Target) (bool, error) {
+	return version.IsVersion3x(target.CurrentVersion) || version.IsVersion3x(target.TargetVersion), nil
+}
+
+func (c *Check) Validate(
+	ctx context.Context,
+	target check.Target,
+) (*result.DiagnosticResult, error) {
+	dr := c.NewResult()
+
+	if target.TargetVersion != nil {
+		dr.Annotations[check.AnnotationCheckTargetVersion] = target.TargetVersion.String()
+	}
+
+	classes, err := target.Client.List(ctx, resources.StorageClass)
+	if err != nil && !client.IsResourceTypeNotFound(err) {
+		return nil, fmt.Errorf("listing StorageClasses: %w", err)
+	}
+
+	var defaults []string
+
+	for _, sc := range classes {
+		if kube.GetAnnotation(sc, annotationDefaultClass) == "true" || kube.GetAnnotation(sc, annotationBetaDefaultClass) == "true" {
+			defaults = append(defaults, sc.GetName())
+		}
+	}
+
+	slices.Sort(defaults)
+
+	switch len(defaults) {
+	case 0:
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeDefaultStorageClass,
+			metav1.ConditionFalse,
+			check.WithReason(check.ReasonResourceNotFound),
+			check.WithMessage("No default StorageClass found among %d StorageClass(es). RHOAI 3.x components provision PersistentVolumeClaims from the default StorageClass", len(classes)),
+			check.WithImpact(result.ImpactBlocking),
+			check.WithRemediation(c.CheckRemediation),
+		))
+	case 1:
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeDefaultStorageClass,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonRequirementsMet),
+			check.WithMessage("Default StorageClass: %s", defaults[0]),
+		))
+	default:
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeDefaultStorageClass,
+			metav1.ConditionFalse,
+			check.WithReason(check.ReasonConfigurationInvalid),
+			check.WithMessage("Found %d default StorageClasses (%s). PersistentVolumeClaims without a storage class get the most recently created one", len(defaults), strings.Join(defaults, ", ")),
+			check.WithRemediation(c.CheckRemediation),
+		))
+	}
+
+	return dr, nil
+}
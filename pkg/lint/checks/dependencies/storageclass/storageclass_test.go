@@ -0,0 +1,151 @@
+package storageclass_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/dependencies/storageclass"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+//nolint:gochecknoglobals
+var listKinds = map[schema.GroupVersionResource]string{
+	resources.StorageClass.GVR(): resources.StorageClass.ListKind(),
+}
+
+func newStorageClass(name string, annotations map[string]string) *unstructured.Unstructured {
+	obj := resources.StorageClass.Unstructured()
+	obj.SetName(name)
+	obj.SetAnnotations(annotations)
+
+	return &obj
+}
+
+func newTarget(t *testing.T, objects ...*unstructured.Unstructured) check.Target {
+	t.Helper()
+
+	return testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      listKinds,
+		Objects:        objects,
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+	})
+}
+
+func TestStorageClassCheck_Validate(t *testing.T) {
+	tests := []struct {
+		name            string
+		objects         []*unstructured.Unstructured
+		expectedStatus  metav1.ConditionStatus
+		expectedReason  string
+		expectedImpact  resultpkg.Impact
+		expectedMessage string
+	}{
+		{
+			name:            "NoStorageClasses",
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  check.ReasonResourceNotFound,
+			expectedImpact:  resultpkg.ImpactBlocking,
+			expectedMessage: "No default StorageClass found among 0 StorageClass(es)",
+		},
+		{
+			name: "NoDefault",
+			objects: []*unstructured.Unstructured{
+				newStorageClass("gp3-csi", nil),
+				newStorageClass("gp2", map[string]string{"storageclass.kubernetes.io/is-default-class": "false"}),
+			},
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  check.ReasonResourceNotFound,
+			expectedImpact:  resultpkg.ImpactBlocking,
+			expectedMessage: "No default StorageClass found among 2 StorageClass(es)",
+		},
+		{
+			name: "SingleDefault",
+			objects: []*unstructured.Unstructured{
+				newStorageClass("gp3-csi", map[string]string{"storageclass.kubernetes.io/is-default-class": "true"}),
+				newStorageClass("gp2", nil),
+			},
+			expectedStatus:  metav1.ConditionTrue,
+			expectedReason:  check.ReasonRequirementsMet,
+			expectedImpact:  resultpkg.ImpactNone,
+			expectedMessage: "Default StorageClass: gp3-csi",
+		},
+		{
+			name: "BetaAnnotation",
+			objects: []*unstructured.Unstructured{
+				newStorageClass("standard", map[string]string{"storageclass.beta.kubernetes.io/is-default-class": "true"}),
+			},
+			expectedStatus:  metav1.ConditionTrue,
+			expectedReason:  check.ReasonRequirementsMet,
+			expectedImpact:  resultpkg.ImpactNone,
+			expectedMessage: "Default StorageClass: standard",
+		},
+		{
+			name: "MultipleDefaults",
+			objects: []*unstructured.Unstructured{
+				newStorageClass("gp3-csi", map[string]string{"storageclass.kubernetes.io/is-default-class": "true"}),
+				newStorageClass("gp2", map[string]string{"storageclass.kubernetes.io/is-default-class": "true"}),
+			},
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  check.ReasonConfigurationInvalid,
+			expectedImpact:  resultpkg.ImpactAdvisory,
+			expectedMessage: "Found 2 default StorageClasses (gp2, gp3-csi)",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			result, err := storageclass.NewCheck().Validate(t.Context(), newTarget(t, tc.objects...))
+
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(result.Status.Conditions).To(HaveLen(1))
+			g.Expect(result.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+				"Type":    Equal(storageclass.ConditionTypeDefaultStorageClass),
+				"Status":  Equal(tc.expectedStatus),
+				"Reason":  Equal(tc.expectedReason),
+				"Message": ContainSubstring(tc.expectedMessage),
+			}))
+			g.Expect(result.Status.Conditions[0].Impact).To(Equal(tc.expectedImpact))
+		})
+	}
+}
+
+func TestStorageClassCheck_CanApply(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := storageclass.NewCheck()
+
+	canApply, err := chk.CanApply(t.Context(), testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      listKinds,
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "2.25.0",
+	}))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeFalse())
+
+	canApply, err = chk.CanApply(t.Context(), newTarget(t))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeTrue())
+}
+
+func TestStorageClassCheck_Metadata(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := storageclass.NewCheck()
+
+	g.Expect(chk.ID()).To(Equal("dependencies.storageclass.default"))
+	g.Expect(chk.Name()).To(Equal("Dependencies :: StorageClass :: Default (3.x)"))
+	g.Expect(chk.Group()).To(Equal(check.GroupDependency))
+	g.Expect(chk.Description()).ToNot(BeEmpty())
+}
@@ -0,0 +1,489 @@
+package trustedca
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/opendatahub-io/odh-cli/pkg/constants"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+)
+
+const (
+	kind      = "trustedca"
+	checkType = "propagation"
+
+	// ConditionTypeCABundlePropagated indicates whether the trusted CA bundle ConfigMap is
+	// present and up to date in every component namespace.
+	ConditionTypeCABundlePropagated = "CABundlePropagated"
+
+	// ConditionTypeCertificatesValid indicates whether the custom and proxy CA certificates
+	// parse and are not expired.
+	ConditionTypeCertificatesValid = "CertificatesValid"
+
+	// ConditionTypeProxyConfigured indicates whether the cluster-wide proxy trusted CA is
+	// present and reaches component namespaces.
+	ConditionTypeProxyConfigured = "ProxyConfigured"
+
+	// AnnotationProblem describes why the trusted CA bundle ConfigMap of a namespace is reported.
+	AnnotationProblem = "trustedca.opendatahub.io/problem"
+
+	// trustedCABundleConfigMap is the ConfigMap the operator creates in component namespaces.
+	trustedCABundleConfigMap = "odh-trusted-ca-bundle"
+
+	// clusterBundleKey holds the cluster trust bundle, injected by the Cluster Network Operator
+	// and including the proxy trusted CA; customBundleKey holds the DSCI customCABundle.
+	clusterBundleKey = "ca-bundle.crt"
+	customBundleKey  = "odh-ca-bundle.crt"
+
+	// injectAnnotation opts a namespace out of trusted CA bundle injection when set to "false".
+	injectAnnotation = "security.opendatahub.io/inject-trusted-ca-bundle"
+
+	// dataScienceProjectLabel marks the namespaces of data science projects, where notebooks,
+	// pipelines and model servers run.
+	dataScienceProjectLabel = "opendatahub.io/dashboard=true"
+
+	// proxyName is the name of the cluster-wide Proxy, whose trusted CA ConfigMap lives in
+	// proxyConfigNamespace.
+	proxyName            = "cluster"
+	proxyConfigNamespace = "openshift-config"
+
+	// expiryWarning is how long before expiry a certificate is reported.
+	expiryWarning = 30 * 24 * time.Hour
+
+	problemMissing       = "ConfigMap missing"
+	problemStaleCustomCA = "customCABundle out of date"
+	problemNotInjected   = "cluster CA bundle not injected"
+)
+
+// Examples rendered by 'lint explain'.
+const (
+	propagationFailingExample = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: odh-trusted-ca-bundle
+  namespace: fraud-detection
+data:
+  ca-bundle.crt: ""          # cluster bundle not injected
+  odh-ca-bundle.crt: |       # differs from the DSCInitialization customCABundle
+    -----BEGIN CERTIFICATE-----
+    ...`
+
+	propagationPassingExample = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: odh-trusted-ca-bundle
+  namespace: fraud-detection
+  labels:
+    config.openshift.io/inject-trusted-cabundle: "true"
+data:
+  ca-bundle.crt: |
+    -----BEGIN CERTIFICATE-----
+    ...
+  odh-ca-bundle.crt: |
+    -----BEGIN CERTIFICATE-----
+    ...`
+)
+
+// PropagationCheck validates that the DSCInitialization trustedCABundle and the cluster-wide
+// proxy trusted CA reach component namespaces: the odh-trusted-ca-bundle ConfigMap is present
+// and current, and the certificates parse and are not expired. Broken propagation surfaces
+// after the upgrade as TLS failures pulling models and running pipelines behind a proxy.
+type PropagationCheck struct {
+	check.BaseCheck
+}
+
+func NewPropagationCheck() *PropagationCheck {
+	return &PropagationCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupDependency,
+			Kind:             kind,
+			Type:             checkType,
+			CheckID:          "dependencies.trustedca.propagation",
+			CheckName:        "Dependencies :: Trusted CA :: Propagation",
+			CheckDescription: "Validates that the DSCInitialization trusted CA bundle and the cluster-wide proxy CA are propagated to component namespaces with valid, unexpired certificates",
+			CheckRemediation: "Set .spec.trustedCABundle.managementState to Managed in the DSCInitialization, replace expired certificates in .spec.trustedCABundle.customCABundle and the proxy trusted CA ConfigMap, and remove stale odh-trusted-ca-bundle ConfigMaps so the operator recreates them",
+			CheckResources: []resources.ResourceType{
+				resources.DSCInitialization,
+				resources.Namespace,
+				resources.ConfigMap,
+				resources.Proxy,
+			},
+			CheckKnowledgeLinks: []string{check.DocsManaging},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "The DSCInitialization trustedCABundle, the odh-trusted-ca-bundle ConfigMap in the applications namespace and in every data science project that has not opted out, the customCABundle and proxy trusted CA certificates, and the cluster-wide Proxy configuration.",
+				Rationale:      "Behind a proxy or a TLS-intercepting firewall, model servers, pipelines and workbenches only trust the corporate CA through the propagated bundle. A missing or stale bundle, or an expired certificate, surfaces after the upgrade as TLS failures pulling models and running pipeline steps.",
+				FailingExample: propagationFailingExample,
+				PassingExample: propagationPassingExample,
+				RemediationCommands: []string{
+					"kubectl get configmap -A --field-selector metadata.name=odh-trusted-ca-bundle",
+					"kubectl get dscinitialization -o jsonpath='{.items[0].spec.trustedCABundle}'",
+					"kubectl get proxy cluster -o yaml",
+					"kubectl delete configmap odh-trusted-ca-bundle -n <namespace>",
+				},
+			},
+		},
+	}
+}
+
+func (c *PropagationCheck) CanApply(_ context.Context, _ check.Target) (bool, error) {
+	return true, nil
+}
+
+// Validate executes the check against the provided target.
+func (c *PropagationCheck) Validate(ctx context.Context, target check.Target) (*result.DiagnosticResult, error) {
+	return validate.DSCI(c, target).Run(ctx, func(dr *result.DiagnosticResult, dsci *unstructured.Unstructured) error {
+		managementState, err := jq.Query[string](dsci, ".spec.trustedCABundle.managementState")
+		if err != nil && !errors.Is(err, jq.ErrNotFound) {
+			return fmt.Errorf("querying trustedCABundle managementState: %w", err)
+		}
+
+		customCABundle, err := jq.Query[string](dsci, ".spec.trustedCABundle.customCABundle")
+		if err != nil && !errors.Is(err, jq.ErrNotFound) {
+			return fmt.Errorf("querying trustedCABundle customCABundle: %w", err)
+		}
+
+		managed := managementState == constants.ManagementStateManaged
+
+		if err := c.validatePropagation(ctx, target.Client, dr, dsci, managed, customCABundle); err != nil {
+			return err
+		}
+
+		proxyBundle, err := c.validateProxy(ctx, target.Client, dr, managed)
+		if err != nil {
+			return err
+		}
+
+		c.validateCertificates(dr, map[string]string{
+			"DSCInitialization customCABundle": customCABundle,
+			"proxy trusted CA":                 proxyBundle,
+		})
+
+		return nil
+	})
+}
+
+// validatePropagation reports component namespaces whose trusted CA bundle ConfigMap is
+// missing, carries an outdated customCABundle, or lacks the injected cluster bundle.
+func (c *PropagationCheck) validatePropagation(
+	ctx context.Context,
+	r client.Reader,
+	dr *result.DiagnosticResult,
+	dsci *unstructured.Unstructured,
+	managed bool,
+	customCABundle string,
+) error {
+	if !managed {
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeCABundlePropagated,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonConfigurationUnmanaged),
+			check.WithMessage("The trusted CA bundle is not managed by the operator: component namespaces only trust the default CAs"),
+		))
+
+		return nil
+	}
+
+	namespaces, err := componentNamespaces(ctx, r, dsci)
+	if err != nil {
+		return err
+	}
+
+	for _, ns := range namespaces {
+		problem, err := bundleProblem(ctx, r, ns, customCABundle)
+		if err != nil {
+			return err
+		}
+
+		if problem == "" {
+			continue
+		}
+
+		dr.ImpactedObjects = append(dr.ImpactedObjects, metav1.PartialObjectMetadata{
+			TypeMeta: resources.ConfigMap.TypeMeta(),
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   ns,
+				Name:        trustedCABundleConfigMap,
+				Annotations: map[string]string{AnnotationProblem: problem},
+			},
+		})
+	}
+
+	dr.Annotations[check.AnnotationImpactedWorkloadCount] = strconv.Itoa(len(dr.ImpactedObjects))
+
+	if len(dr.ImpactedObjects) == 0 {
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeCABundlePropagated,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonRequirementsMet),
+			check.WithMessage("The trusted CA bundle is propagated to all %d component namespace(s)", len(namespaces)),
+		))
+
+		return nil
+	}
+
+	dr.SetCondition(check.NewCondition(
+		ConditionTypeCABundlePropagated,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonConfigurationInvalid),
+		check.WithMessage("The trusted CA bundle is missing or out of date in %d of %d component namespace(s): "+
+			"model pulls and pipeline steps there do not trust the custom or proxy CAs", len(dr.ImpactedObjects), len(namespaces)),
+		check.WithImpact(result.ImpactAdvisory),
+		check.WithRemediation("Delete the listed odh-trusted-ca-bundle ConfigMaps so the operator recreates them, "+
+			"and make sure the namespaces are not annotated "+injectAnnotation+"=false"),
+	))
+
+	return nil
+}
+
+// componentNamespaces returns the applications namespace and the data science project
+// namespaces that have not opted out of trusted CA bundle injection, sorted.
+func componentNamespaces(ctx context.Context, r client.Reader, dsci *unstructured.Unstructured) ([]string, error) {
+	names := sets.New[string]()
+
+	applicationsNamespace, err := jq.Query[string](dsci, ".spec.applicationsNamespace")
+	if err != nil && !errors.Is(err, jq.ErrNotFound) {
+		return nil, fmt.Errorf("querying applicationsNamespace: %w", err)
+	}
+
+	if applicationsNamespace != "" {
+		names.Insert(applicationsNamespace)
+	}
+
+	projects, err := r.ListMetadata(ctx, resources.Namespace, client.WithLabelSelector(dataScienceProjectLabel))
+	if err != nil {
+		return nil, fmt.Errorf("listing data science project namespaces: %w", err)
+	}
+
+	for _, ns := range projects {
+		if ns.GetAnnotations()[injectAnnotation] == "false" {
+			continue
+		}
+
+		names.Insert(ns.GetName())
+	}
+
+	return sets.List(names), nil
+}
+
+// bundleProblem returns why the trusted CA bundle ConfigMap of namespace is not usable, or
+// an empty string if it is.
+func bundleProblem(ctx context.Context, r client.Reader, namespace string, customCABundle string) (string, error) {
+	cm, err := r.GetResource(ctx, resources.ConfigMap, trustedCABundleConfigMap, client.InNamespace(namespace))
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return problemMissing, nil
+		}
+
+		return "", fmt.Errorf("getting ConfigMap %s/%s: %w", namespace, trustedCABundleConfigMap, err)
+	}
+
+	if cm == nil {
+		return "", nil
+	}
+
+	data, _, _ := unstructured.NestedStringMap(cm.Object, "data")
+
+	if strings.TrimSpace(data[customBundleKey]) != strings.TrimSpace(customCABundle) {
+		return problemStaleCustomCA, nil
+	}
+
+	if strings.TrimSpace(data[clusterBundleKey]) == "" {
+		return problemNotInjected, nil
+	}
+
+	return "", nil
+}
+
+// validateProxy reports a cluster-wide proxy whose trusted CA ConfigMap is missing or does
+// not reach component namespaces, and returns the proxy trusted CA bundle.
+func (c *PropagationCheck) validateProxy(
+	ctx context.Context,
+	r client.Reader,
+	dr *result.DiagnosticResult,
+	managed bool,
+) (string, error) {
+	proxy, err := r.GetResource(ctx, resources.Proxy, proxyName)
+	if err != nil && !apierrors.IsNotFound(err) && !client.IsResourceTypeNotFound(err) {
+		return "", fmt.Errorf("getting Proxy %s: %w", proxyName, err)
+	}
+
+	var httpProxy, httpsProxy, trustedCA string
+	if proxy != nil {
+		httpProxy, _, _ = unstructured.NestedString(proxy.Object, "spec", "httpProxy")
+		httpsProxy, _, _ = unstructured.NestedString(proxy.Object, "spec", "httpsProxy")
+		trustedCA, _, _ = unstructured.NestedString(proxy.Object, "spec", "trustedCA", "name")
+	}
+
+	if httpProxy == "" && httpsProxy == "" {
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeProxyConfigured,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonRequirementsMet),
+			check.WithMessage("No cluster-wide proxy is configured"),
+		))
+
+		return "", nil
+	}
+
+	if trustedCA == "" {
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeProxyConfigured,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonConfigurationValid),
+			check.WithMessage("A cluster-wide proxy is configured without a custom trusted CA"),
+		))
+
+		return "", nil
+	}
+
+	cm, err := r.GetResource(ctx, resources.ConfigMap, trustedCA, client.InNamespace(proxyConfigNamespace))
+	if err != nil && !apierrors.IsNotFound(err) {
+		return "", fmt.Errorf("getting ConfigMap %s/%s: %w", proxyConfigNamespace, trustedCA, err)
+	}
+
+	if apierrors.IsNotFound(err) {
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeProxyConfigured,
+			metav1.ConditionFalse,
+			check.WithReason(check.ReasonResourceNotFound),
+			check.WithMessage("The proxy trusted CA ConfigMap %s/%s does not exist: TLS connections through the proxy fail", proxyConfigNamespace, trustedCA),
+			check.WithImpact(result.ImpactAdvisory),
+			check.WithRemediation(fmt.Sprintf("Create ConfigMap %s/%s with the proxy CA certificates in key %s, or unset .spec.trustedCA of Proxy %s",
+				proxyConfigNamespace, trustedCA, clusterBundleKey, proxyName)),
+		))
+
+		return "", nil
+	}
+
+	var bundle string
+	if cm != nil {
+		bundle, _, _ = unstructured.NestedString(cm.Object, "data", clusterBundleKey)
+	}
+
+	if !managed {
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeProxyConfigured,
+			metav1.ConditionFalse,
+			check.WithReason(check.ReasonConfigurationUnmanaged),
+			check.WithMessage("A cluster-wide proxy with trusted CA %s/%s is configured but the trusted CA bundle is not managed: "+
+				"component namespaces do not trust the proxy CA", proxyConfigNamespace, trustedCA),
+			check.WithImpact(result.ImpactAdvisory),
+			check.WithRemediation("Set .spec.trustedCABundle.managementState to Managed in the DSCInitialization"),
+		))
+
+		return bundle, nil
+	}
+
+	dr.SetCondition(check.NewCondition(
+		ConditionTypeProxyConfigured,
+		metav1.ConditionTrue,
+		check.WithReason(check.ReasonConfigurationValid),
+		check.WithMessage("A cluster-wide proxy with trusted CA %s/%s is configured and propagated through the trusted CA bundle", proxyConfigNamespace, trustedCA),
+	))
+
+	return bundle, nil
+}
+
+// validateCertificates reports bundles holding certificates that do not parse, are expired,
+// or expire within expiryWarning. bundles maps a description to PEM data; empty ones are skipped.
+func (c *PropagationCheck) validateCertificates(dr *result.DiagnosticResult, bundles map[string]string) {
+	var invalid, expiring []string
+
+	count := 0
+	now := time.Now()
+
+	for _, source := range sets.List(sets.KeySet(bundles)) {
+		certs, err := parseBundle(bundles[source])
+		if err != nil {
+			invalid = append(invalid, fmt.Sprintf("%s: %v", source, err))
+
+			continue
+		}
+
+		count += len(certs)
+
+		for _, cert := range certs {
+			switch {
+			case now.After(cert.NotAfter):
+				invalid = append(invalid, fmt.Sprintf("%s: %q expired on %s", source, cert.Subject.CommonName, cert.NotAfter.Format(time.DateOnly)))
+			case now.Add(expiryWarning).After(cert.NotAfter):
+				expiring = append(expiring, fmt.Sprintf("%s: %q expires on %s", source, cert.Subject.CommonName, cert.NotAfter.Format(time.DateOnly)))
+			}
+		}
+	}
+
+	switch {
+	case len(invalid) > 0:
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeCertificatesValid,
+			metav1.ConditionFalse,
+			check.WithReason(check.ReasonConfigurationInvalid),
+			check.WithMessage("Found %d invalid or expired CA certificate(s): %s", len(invalid), strings.Join(invalid, "; ")),
+			check.WithImpact(result.ImpactBlocking),
+			check.WithRemediation("Replace the invalid or expired certificates before upgrading"),
+		))
+	case len(expiring) > 0:
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeCertificatesValid,
+			metav1.ConditionFalse,
+			check.WithReason(check.ReasonConfigurationInvalid),
+			check.WithMessage("Found %d CA certificate(s) expiring within %d days: %s",
+				len(expiring), int(expiryWarning.Hours()/24), strings.Join(expiring, "; ")),
+			check.WithImpact(result.ImpactAdvisory),
+			check.WithRemediation("Renew the expiring certificates"),
+		))
+	default:
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeCertificatesValid,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonConfigurationValid),
+			check.WithMessage("All %d custom and proxy CA certificate(s) are valid", count),
+		))
+	}
+}
+
+// parseBundle parses the certificates of a PEM bundle. Blocks other than certificates and
+// trailing data that is not PEM are errors.
+func parseBundle(bundle string) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+
+	rest := []byte(strings.TrimSpace(bundle))
+	for len(rest) > 0 {
+		var block *pem.Block
+
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return nil, errors.New("data is not PEM encoded")
+		}
+
+		if block.Type != "CERTIFICATE" {
+			return nil, fmt.Errorf("unexpected PEM block %q", block.Type)
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("parsing certificate %d: %w", len(certs)+1, err)
+		}
+
+		certs = append(certs, cert)
+		rest = []byte(strings.TrimSpace(string(rest)))
+	}
+
+	return certs, nil
+}
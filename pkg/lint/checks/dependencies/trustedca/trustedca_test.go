@@ -0,0 +1,349 @@
+package trustedca_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/dependencies/trustedca"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+const (
+	applicationsNamespace = "redhat-ods-applications"
+	projectNamespace      = "fraud-detection"
+	proxyCAConfigMap      = "user-ca-bundle"
+	clusterBundle         = "cluster-bundle"
+	day                   = 24 * time.Hour
+)
+
+//nolint:gochecknoglobals // Test fixture - shared across test functions
+var listKinds = map[schema.GroupVersionResource]string{
+	resources.DSCInitialization.GVR(): resources.DSCInitialization.ListKind(),
+	resources.Namespace.GVR():         resources.Namespace.ListKind(),
+	resources.ConfigMap.GVR():         resources.ConfigMap.ListKind(),
+	resources.Proxy.GVR():             resources.Proxy.ListKind(),
+}
+
+// newCertificate returns a PEM encoded self-signed CA certificate valid for validFor from now;
+// a negative duration yields an expired certificate.
+func newCertificate(t *testing.T, commonName string, validFor time.Duration) string {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generating key: %v", err)
+	}
+
+	notAfter := time.Now().Add(validFor)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             notAfter.Add(-365 * day),
+		NotAfter:              notAfter,
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("creating certificate: %v", err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func newDSCI(managementState string, customCABundle string) *unstructured.Unstructured {
+	dsci := testutil.NewDSCI(applicationsNamespace)
+	_ = unstructured.SetNestedMap(dsci.Object, map[string]any{
+		"managementState": managementState,
+		"customCABundle":  customCABundle,
+	}, "spec", "trustedCABundle")
+
+	return dsci
+}
+
+func newProjectNamespace(name string, annotations map[string]string) *unstructured.Unstructured {
+	ns := resources.Namespace.Unstructured()
+	ns.SetName(name)
+	ns.SetLabels(map[string]string{"opendatahub.io/dashboard": "true"})
+	ns.SetAnnotations(annotations)
+
+	return &ns
+}
+
+func newConfigMap(namespace string, name string, data map[string]any) *unstructured.Unstructured {
+	cm := resources.ConfigMap.Unstructured()
+	cm.SetNamespace(namespace)
+	cm.SetName(name)
+	cm.Object["data"] = data
+
+	return &cm
+}
+
+func newTrustedCABundle(namespace string, customCABundle string) *unstructured.Unstructured {
+	return newConfigMap(namespace, "odh-trusted-ca-bundle", map[string]any{
+		"ca-bundle.crt":     clusterBundle,
+		"odh-ca-bundle.crt": customCABundle,
+	})
+}
+
+func newProxy(trustedCA string) *unstructured.Unstructured {
+	proxy := resources.Proxy.Unstructured()
+	proxy.SetName("cluster")
+	proxy.Object["spec"] = map[string]any{
+		"httpsProxy": "http://proxy.example.com:3128",
+		"trustedCA":  map[string]any{"name": trustedCA},
+	}
+
+	return &proxy
+}
+
+func conditionStatuses(g *WithT, dr *resultpkg.DiagnosticResult) map[string]metav1.ConditionStatus {
+	statuses := make(map[string]metav1.ConditionStatus, len(dr.Status.Conditions))
+	for _, c := range dr.Status.Conditions {
+		statuses[c.Type] = c.Status
+	}
+
+	g.Expect(statuses).To(HaveLen(3))
+
+	return statuses
+}
+
+func TestPropagationCheck_Propagated(t *testing.T) {
+	g := NewWithT(t)
+
+	customCA := newCertificate(t, "corporate-root", 365*day)
+	proxyCA := newCertificate(t, "proxy-root", 365*day)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: listKinds,
+		Objects: []*unstructured.Unstructured{
+			newDSCI("Managed", customCA),
+			newProjectNamespace(projectNamespace, nil),
+			newTrustedCABundle(applicationsNamespace, customCA),
+			newTrustedCABundle(projectNamespace, customCA),
+			newProxy(proxyCAConfigMap),
+			newConfigMap("openshift-config", proxyCAConfigMap, map[string]any{"ca-bundle.crt": proxyCA}),
+		},
+	})
+
+	result, err := trustedca.NewPropagationCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.ImpactedObjects).To(BeEmpty())
+	g.Expect(conditionStatuses(g, result)).To(HaveEach(metav1.ConditionTrue))
+	g.Expect(result.Status.Conditions).To(ContainElement(HaveField("Condition.Message", ContainSubstring("all 2 component namespace(s)"))))
+	g.Expect(result.Status.Conditions).To(ContainElement(HaveField("Condition.Message", ContainSubstring("All 2 custom and proxy CA certificate(s)"))))
+}
+
+func TestPropagationCheck_NotPropagated(t *testing.T) {
+	g := NewWithT(t)
+
+	customCA := newCertificate(t, "corporate-root", 365*day)
+	previousCA := newCertificate(t, "previous-root", 365*day)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: listKinds,
+		Objects: []*unstructured.Unstructured{
+			newDSCI("Managed", customCA),
+			newProjectNamespace(projectNamespace, nil),
+			newProjectNamespace("stale-project", nil),
+			newProjectNamespace("opted-out", map[string]string{"security.opendatahub.io/inject-trusted-ca-bundle": "false"}),
+			newTrustedCABundle(applicationsNamespace, customCA),
+			newTrustedCABundle("stale-project", previousCA),
+		},
+	})
+
+	result, err := trustedca.NewPropagationCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(conditionStatuses(g, result)).To(HaveKeyWithValue(trustedca.ConditionTypeCABundlePropagated, metav1.ConditionFalse))
+	g.Expect(result.Status.Conditions).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+		"Condition": MatchFields(IgnoreExtras, Fields{
+			"Type":    Equal(trustedca.ConditionTypeCABundlePropagated),
+			"Reason":  Equal(check.ReasonConfigurationInvalid),
+			"Message": ContainSubstring("2 of 3 component namespace(s)"),
+		}),
+		"Impact": Equal(resultpkg.ImpactAdvisory),
+	})))
+	g.Expect(result.ImpactedObjects).To(ConsistOf(
+		MatchFields(IgnoreExtras, Fields{
+			"ObjectMeta": MatchFields(IgnoreExtras, Fields{
+				"Namespace":   Equal(projectNamespace),
+				"Name":        Equal("odh-trusted-ca-bundle"),
+				"Annotations": HaveKeyWithValue(trustedca.AnnotationProblem, "ConfigMap missing"),
+			}),
+		}),
+		MatchFields(IgnoreExtras, Fields{
+			"ObjectMeta": MatchFields(IgnoreExtras, Fields{
+				"Namespace":   Equal("stale-project"),
+				"Annotations": HaveKeyWithValue(trustedca.AnnotationProblem, "customCABundle out of date"),
+			}),
+		}),
+	))
+	g.Expect(result.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "2"))
+}
+
+func TestPropagationCheck_Certificates(t *testing.T) {
+	t.Run("should block on expired certificates", func(t *testing.T) {
+		g := NewWithT(t)
+
+		customCA := newCertificate(t, "corporate-root", 365*day) + newCertificate(t, "old-root", -day)
+
+		target := testutil.NewTarget(t, testutil.TargetConfig{
+			ListKinds: listKinds,
+			Objects: []*unstructured.Unstructured{
+				newDSCI("Managed", customCA),
+				newTrustedCABundle(applicationsNamespace, customCA),
+			},
+		})
+
+		result, err := trustedca.NewPropagationCheck().Validate(t.Context(), target)
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.Status.Conditions).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+			"Condition": MatchFields(IgnoreExtras, Fields{
+				"Type":    Equal(trustedca.ConditionTypeCertificatesValid),
+				"Status":  Equal(metav1.ConditionFalse),
+				"Message": ContainSubstring(`"old-root" expired`),
+			}),
+			"Impact": Equal(resultpkg.ImpactBlocking),
+		})))
+	})
+
+	t.Run("should block on certificates that do not parse", func(t *testing.T) {
+		g := NewWithT(t)
+
+		target := testutil.NewTarget(t, testutil.TargetConfig{
+			ListKinds: listKinds,
+			Objects: []*unstructured.Unstructured{
+				newDSCI("Managed", "not a certificate"),
+			},
+		})
+
+		result, err := trustedca.NewPropagationCheck().Validate(t.Context(), target)
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.Status.Conditions).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+			"Condition": MatchFields(IgnoreExtras, Fields{
+				"Type":    Equal(trustedca.ConditionTypeCertificatesValid),
+				"Message": ContainSubstring("not PEM encoded"),
+			}),
+			"Impact": Equal(resultpkg.ImpactBlocking),
+		})))
+	})
+
+	t.Run("should warn on certificates expiring soon", func(t *testing.T) {
+		g := NewWithT(t)
+
+		proxyCA := newCertificate(t, "proxy-root", 10*day)
+
+		target := testutil.NewTarget(t, testutil.TargetConfig{
+			ListKinds: listKinds,
+			Objects: []*unstructured.Unstructured{
+				newDSCI("Managed", ""),
+				newTrustedCABundle(applicationsNamespace, ""),
+				newProxy(proxyCAConfigMap),
+				newConfigMap("openshift-config", proxyCAConfigMap, map[string]any{"ca-bundle.crt": proxyCA}),
+			},
+		})
+
+		result, err := trustedca.NewPropagationCheck().Validate(t.Context(), target)
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.Status.Conditions).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+			"Condition": MatchFields(IgnoreExtras, Fields{
+				"Type":    Equal(trustedca.ConditionTypeCertificatesValid),
+				"Status":  Equal(metav1.ConditionFalse),
+				"Message": ContainSubstring(`proxy trusted CA: "proxy-root" expires`),
+			}),
+			"Impact": Equal(resultpkg.ImpactAdvisory),
+		})))
+	})
+}
+
+func TestPropagationCheck_Proxy(t *testing.T) {
+	t.Run("should report a missing proxy trusted CA ConfigMap", func(t *testing.T) {
+		g := NewWithT(t)
+
+		target := testutil.NewTarget(t, testutil.TargetConfig{
+			ListKinds: listKinds,
+			Objects: []*unstructured.Unstructured{
+				newDSCI("Managed", ""),
+				newTrustedCABundle(applicationsNamespace, ""),
+				newProxy(proxyCAConfigMap),
+			},
+		})
+
+		result, err := trustedca.NewPropagationCheck().Validate(t.Context(), target)
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.Status.Conditions).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+			"Condition": MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(trustedca.ConditionTypeProxyConfigured),
+				"Status": Equal(metav1.ConditionFalse),
+				"Reason": Equal(check.ReasonResourceNotFound),
+			}),
+			"Impact": Equal(resultpkg.ImpactAdvisory),
+		})))
+	})
+
+	t.Run("should report a proxy CA not propagated by an unmanaged bundle", func(t *testing.T) {
+		g := NewWithT(t)
+
+		proxyCA := newCertificate(t, "proxy-root", 365*day)
+
+		target := testutil.NewTarget(t, testutil.TargetConfig{
+			ListKinds: listKinds,
+			Objects: []*unstructured.Unstructured{
+				newDSCI("Removed", ""),
+				newProxy(proxyCAConfigMap),
+				newConfigMap("openshift-config", proxyCAConfigMap, map[string]any{"ca-bundle.crt": proxyCA}),
+			},
+		})
+
+		result, err := trustedca.NewPropagationCheck().Validate(t.Context(), target)
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.ImpactedObjects).To(BeEmpty())
+		g.Expect(conditionStatuses(g, result)).To(Equal(map[string]metav1.ConditionStatus{
+			trustedca.ConditionTypeCABundlePropagated: metav1.ConditionTrue,
+			trustedca.ConditionTypeProxyConfigured:    metav1.ConditionFalse,
+			trustedca.ConditionTypeCertificatesValid:  metav1.ConditionTrue,
+		}))
+		g.Expect(result.Status.Conditions).To(ContainElement(HaveField("Condition.Reason", check.ReasonConfigurationUnmanaged)))
+	})
+}
+
+func TestPropagationCheck_NoDSCI(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{ListKinds: listKinds})
+
+	result, err := trustedca.NewPropagationCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Status.Conditions).To(HaveLen(1))
+	g.Expect(result.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":   Equal(check.ConditionTypeAvailable),
+		"Status": Equal(metav1.ConditionFalse),
+		"Reason": Equal(check.ReasonResourceNotFound),
+	}))
+}
@@ -0,0 +1,159 @@
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+)
+
+const (
+	kind      = "deployment"
+	checkType = "ready"
+
+	// ConditionTypeDeploymentsReady indicates whether the component Deployments have all their
+	// replicas ready.
+	ConditionTypeDeploymentsReady = "DeploymentsReady"
+
+	// labelPartOf names the component a Deployment belongs to.
+	labelPartOf = "app.kubernetes.io/part-of"
+)
+
+// Check validates that the component Deployments in the applications namespace have all
+// their desired replicas ready.
+type Check struct {
+	check.BaseCheck
+}
+
+// NewCheck creates a new component Deployment readiness check.
+func NewCheck() *Check {
+	return &Check{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupHealth,
+			Kind:             kind,
+			Type:             checkType,
+			CheckID:          "health.deployment.ready",
+			CheckName:        "Health :: Deployment :: Ready",
+			CheckDescription: "Validates that the component Deployments in the applications namespace have all their replicas ready",
+			CheckRemediation: "Inspect the pods and events of the Deployments that are not ready",
+			CheckResources: []resources.ResourceType{
+				resources.DSCInitialization,
+				resources.Deployment,
+			},
+			CheckDocumentation: check.Documentation{
+				Inspects:  "The Deployments of the applications namespace set in the DSCInitialization, comparing their ready replicas with the desired replicas. Deployments scaled to zero are left out.",
+				Rationale: "A component whose Deployment has no or too few ready pods does not serve its API or UI, even when the DataScienceCluster still reports it as installed.",
+				RemediationCommands: []string{
+					"kubectl get deployments -n <applications-namespace>",
+					"kubectl describe deployment <name> -n <applications-namespace>",
+				},
+			},
+		},
+	}
+}
+
+// CanApply returns true: runtime health is checked regardless of the installed version.
+func (c *Check) CanApply(_ context.Context, _ check.Target) (bool, error) {
+	return true, nil
+}
+
+func (c *Check) Validate(
+	ctx context.Context,
+	target check.Target,
+) (*result.DiagnosticResult, error) {
+	dr := c.NewResult()
+
+	namespace, err := client.GetApplicationsNamespace(ctx, target.Client)
+	if err != nil {
+		return nil, fmt.Errorf("getting applications namespace: %w", err)
+	}
+
+	deployments, err := target.Client.List(ctx, resources.Deployment, client.WithNamespace(namespace))
+	if err != nil {
+		return nil, fmt.Errorf("listing Deployments in %s: %w", namespace, err)
+	}
+
+	var (
+		total    int
+		notReady []string
+	)
+
+	for _, deployment := range deployments {
+		desired, ready := replicas(deployment)
+		if desired == 0 {
+			continue
+		}
+
+		total++
+
+		if ready >= desired {
+			continue
+		}
+
+		reason := fmt.Sprintf("%d of %d replicas ready", ready, desired)
+		notReady = append(notReady, fmt.Sprintf("%s (%s)", deploymentName(deployment), reason))
+
+		dr.ImpactedObjects = append(dr.ImpactedObjects, metav1.PartialObjectMetadata{
+			TypeMeta: resources.Deployment.TypeMeta(),
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   deployment.GetNamespace(),
+				Name:        deployment.GetName(),
+				Annotations: map[string]string{check.AnnotationImpactReason: reason},
+			},
+		})
+	}
+
+	if len(notReady) == 0 {
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeDeploymentsReady,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonResourceAvailable),
+			check.WithMessage("All %d Deployment(s) in %s have their replicas ready", total, namespace),
+		))
+
+		return dr, nil
+	}
+
+	dr.SetCondition(check.NewCondition(
+		ConditionTypeDeploymentsReady,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonResourceUnavailable),
+		check.WithMessage("Found %d of %d Deployment(s) in %s not ready: %s", len(notReady), total, namespace, strings.Join(notReady, ", ")),
+		check.WithImpact(result.ImpactBlocking),
+		check.WithRemediation(c.CheckRemediation),
+	))
+
+	return dr, nil
+}
+
+// replicas returns the desired replicas of a Deployment, 1 when unset, and its ready replicas.
+func replicas(deployment *unstructured.Unstructured) (int64, int64) {
+	desired, err := jq.Query[int64](deployment, ".spec.replicas")
+	if err != nil {
+		desired = 1
+	}
+
+	ready, err := jq.Query[int64](deployment, ".status.readyReplicas")
+	if err != nil {
+		ready = 0
+	}
+
+	return desired, ready
+}
+
+// deploymentName returns the name of a Deployment, qualified with its component when labeled.
+func deploymentName(deployment *unstructured.Unstructured) string {
+	if component := deployment.GetLabels()[labelPartOf]; component != "" && component != deployment.GetName() {
+		return component + "/" + deployment.GetName()
+	}
+
+	return deployment.GetName()
+}
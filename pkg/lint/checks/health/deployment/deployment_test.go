@@ -0,0 +1,98 @@
+package deployment_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/health/deployment"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+const applicationsNamespace = "redhat-ods-applications"
+
+//nolint:gochecknoglobals
+var listKinds = map[schema.GroupVersionResource]string{
+	resources.DSCInitialization.GVR(): resources.DSCInitialization.ListKind(),
+	resources.Deployment.GVR():        resources.Deployment.ListKind(),
+}
+
+func newDeployment(name string, component string, replicas int64, ready int64) *unstructured.Unstructured {
+	obj := resources.Deployment.Unstructured()
+	obj.SetName(name)
+	obj.SetNamespace(applicationsNamespace)
+	obj.SetLabels(map[string]string{"app.kubernetes.io/part-of": component})
+	_ = unstructured.SetNestedField(obj.Object, replicas, "spec", "replicas")
+	_ = unstructured.SetNestedField(obj.Object, ready, "status", "readyReplicas")
+
+	return &obj
+}
+
+func TestCheck_Validate(t *testing.T) {
+	t.Run("should pass when all Deployments are ready", func(t *testing.T) {
+		g := NewWithT(t)
+
+		target := testutil.NewTarget(t, testutil.TargetConfig{
+			ListKinds: listKinds,
+			Objects: []*unstructured.Unstructured{
+				testutil.NewDSCI(applicationsNamespace),
+				newDeployment("odh-dashboard", "dashboard", 2, 2),
+				newDeployment("scaled-down", "kserve", 0, 0),
+			},
+		})
+
+		result, err := deployment.NewCheck().Validate(t.Context(), target)
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.Status.Conditions).To(HaveLen(1))
+		g.Expect(result.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+			"Type":    Equal(deployment.ConditionTypeDeploymentsReady),
+			"Status":  Equal(metav1.ConditionTrue),
+			"Message": Equal("All 1 Deployment(s) in redhat-ods-applications have their replicas ready"),
+		}))
+		g.Expect(result.ImpactedObjects).To(BeEmpty())
+	})
+
+	t.Run("should report Deployments missing ready replicas", func(t *testing.T) {
+		g := NewWithT(t)
+
+		target := testutil.NewTarget(t, testutil.TargetConfig{
+			ListKinds: listKinds,
+			Objects: []*unstructured.Unstructured{
+				testutil.NewDSCI(applicationsNamespace),
+				newDeployment("odh-dashboard", "dashboard", 2, 1),
+				newDeployment("kserve-controller-manager", "kserve", 1, 1),
+			},
+		})
+
+		result, err := deployment.NewCheck().Validate(t.Context(), target)
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+			"Status":  Equal(metav1.ConditionFalse),
+			"Reason":  Equal(check.ReasonResourceUnavailable),
+			"Message": Equal("Found 1 of 2 Deployment(s) in redhat-ods-applications not ready: dashboard/odh-dashboard (1 of 2 replicas ready)"),
+		}))
+		g.Expect(result.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactBlocking))
+		g.Expect(result.ImpactedObjects).To(HaveLen(1))
+		g.Expect(result.ImpactedObjects[0].Name).To(Equal("odh-dashboard"))
+		g.Expect(result.ImpactedObjects[0].Annotations).To(HaveKeyWithValue(check.AnnotationImpactReason, "1 of 2 replicas ready"))
+	})
+
+	t.Run("should fail without DSCInitialization", func(t *testing.T) {
+		g := NewWithT(t)
+
+		target := testutil.NewTarget(t, testutil.TargetConfig{ListKinds: listKinds})
+
+		_, err := deployment.NewCheck().Validate(t.Context(), target)
+		g.Expect(err).To(MatchError(ContainSubstring("getting applications namespace")))
+	})
+}
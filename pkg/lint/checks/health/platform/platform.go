@@ -0,0 +1,190 @@
+package platform
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+)
+
+const (
+	kind      = "platform"
+	checkType = "conditions"
+
+	// ConditionTypeConditionsHealthy indicates whether the DataScienceCluster and
+	// DSCInitialization report healthy status conditions.
+	ConditionTypeConditionsHealthy = "ConditionsHealthy"
+
+	// reasonRemoved is the reason of the conditions of components and services whose
+	// managementState is Removed; these are False by design.
+	reasonRemoved = "Removed"
+
+	// conditionDegraded is the status condition type whose True status is unhealthy.
+	conditionDegraded = "Degraded"
+)
+
+// Check validates that the DataScienceCluster and DSCInitialization report no failing status
+// conditions.
+type Check struct {
+	check.BaseCheck
+}
+
+// NewCheck creates a new platform status conditions check.
+func NewCheck() *Check {
+	return &Check{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupHealth,
+			Kind:             kind,
+			Type:             checkType,
+			CheckID:          "health.platform.conditions",
+			CheckName:        "Health :: Platform :: Status Conditions",
+			CheckDescription: "Validates that the DataScienceCluster and DSCInitialization report no failing status conditions",
+			CheckRemediation: "Inspect the status conditions of the DataScienceCluster and DSCInitialization and the logs of the operator",
+			CheckResources: []resources.ResourceType{
+				resources.DataScienceCluster,
+				resources.DSCInitialization,
+			},
+			CheckDocumentation: check.Documentation{
+				Inspects:  "The status conditions of the DataScienceCluster and DSCInitialization. A condition is failing when it is False, except for components and services whose managementState is Removed, or when Degraded is True.",
+				Rationale: "The operator reports reconciliation failures and unavailable components through these conditions; a failing condition means part of the platform is not deployed as configured.",
+				RemediationCommands: []string{
+					"kubectl get datasciencecluster -o jsonpath='{.items[0].status.conditions}'",
+					"kubectl get dscinitialization -o jsonpath='{.items[0].status.conditions}'",
+				},
+			},
+		},
+	}
+}
+
+// CanApply returns true: runtime health is checked regardless of the installed version.
+func (c *Check) CanApply(_ context.Context, _ check.Target) (bool, error) {
+	return true, nil
+}
+
+func (c *Check) Validate(
+	ctx context.Context,
+	target check.Target,
+) (*result.DiagnosticResult, error) {
+	dr := c.NewResult()
+
+	platformCRs := []struct {
+		resourceType resources.ResourceType
+		get          func(context.Context, client.Reader) (*unstructured.Unstructured, error)
+	}{
+		{resources.DataScienceCluster, client.GetDataScienceCluster},
+		{resources.DSCInitialization, client.GetDSCInitialization},
+	}
+
+	var (
+		found   int
+		details []string
+	)
+
+	for _, p := range platformCRs {
+		obj, err := p.get(ctx, target.Client)
+
+		switch {
+		case apierrors.IsNotFound(err) || client.IsResourceTypeNotFound(err):
+			continue
+		case err != nil:
+			return nil, fmt.Errorf("getting %s: %w", p.resourceType.Kind, err)
+		}
+
+		found++
+
+		failing, err := failingConditions(obj)
+		if err != nil {
+			return nil, fmt.Errorf("reading status conditions of %s %s: %w", p.resourceType.Kind, obj.GetName(), err)
+		}
+
+		if len(failing) == 0 {
+			continue
+		}
+
+		reason := strings.Join(failing, "; ")
+		details = append(details, fmt.Sprintf("%s %s: %s", p.resourceType.Kind, obj.GetName(), reason))
+
+		dr.ImpactedObjects = append(dr.ImpactedObjects, metav1.PartialObjectMetadata{
+			TypeMeta: p.resourceType.TypeMeta(),
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        obj.GetName(),
+				Annotations: map[string]string{check.AnnotationImpactReason: reason},
+			},
+		})
+	}
+
+	switch {
+	case found == 0:
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeConditionsHealthy,
+			metav1.ConditionFalse,
+			check.WithReason(check.ReasonResourceNotFound),
+			check.WithMessage("No DataScienceCluster or DSCInitialization found"),
+			check.WithImpact(result.ImpactBlocking),
+			check.WithRemediation("Install OpenShift AI and create a DataScienceCluster"),
+		))
+	case len(details) == 0:
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeConditionsHealthy,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonResourceAvailable),
+			check.WithMessage("DataScienceCluster and DSCInitialization report no failing status conditions"),
+		))
+	default:
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeConditionsHealthy,
+			metav1.ConditionFalse,
+			check.WithReason(check.ReasonResourceUnavailable),
+			check.WithMessage("Failing status conditions: %s", strings.Join(details, "; ")),
+			check.WithImpact(result.ImpactBlocking),
+			check.WithRemediation(c.CheckRemediation),
+		))
+	}
+
+	return dr, nil
+}
+
+// failingConditions returns the failing status conditions of obj as "Type=Status (Reason:
+// Message)".
+func failingConditions(obj *unstructured.Unstructured) ([]string, error) {
+	conditions, err := jq.Query[[]metav1.Condition](obj, ".status.conditions // []")
+	if err != nil {
+		return nil, err
+	}
+
+	var failing []string
+
+	for _, cond := range conditions {
+		unhealthy := cond.Status == metav1.ConditionFalse && cond.Reason != reasonRemoved
+		if cond.Type == conditionDegraded {
+			unhealthy = cond.Status == metav1.ConditionTrue
+		}
+
+		if !unhealthy {
+			continue
+		}
+
+		detail := cond.Reason
+		if cond.Message != "" {
+			detail = strings.TrimPrefix(detail+": "+cond.Message, ": ")
+		}
+
+		description := fmt.Sprintf("%s=%s", cond.Type, cond.Status)
+		if detail != "" {
+			description += " (" + detail + ")"
+		}
+
+		failing = append(failing, description)
+	}
+
+	return failing, nil
+}
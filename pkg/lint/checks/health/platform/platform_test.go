@@ -0,0 +1,116 @@
+package platform_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/health/platform"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+//nolint:gochecknoglobals
+var listKinds = map[schema.GroupVersionResource]string{
+	resources.DataScienceCluster.GVR(): resources.DataScienceCluster.ListKind(),
+	resources.DSCInitialization.GVR():  resources.DSCInitialization.ListKind(),
+}
+
+func withConditions(obj *unstructured.Unstructured, conditions ...map[string]any) *unstructured.Unstructured {
+	items := make([]any, 0, len(conditions))
+	for _, c := range conditions {
+		items = append(items, c)
+	}
+
+	_ = unstructured.SetNestedSlice(obj.Object, items, "status", "conditions")
+
+	return obj
+}
+
+func condition(conditionType string, status string, reason string, message string) map[string]any {
+	return map[string]any{"type": conditionType, "status": status, "reason": reason, "message": message}
+}
+
+func TestCheck_Validate(t *testing.T) {
+	t.Run("should pass with healthy and removed conditions", func(t *testing.T) {
+		g := NewWithT(t)
+
+		target := testutil.NewTarget(t, testutil.TargetConfig{
+			ListKinds: listKinds,
+			Objects: []*unstructured.Unstructured{
+				withConditions(testutil.NewDSC(nil),
+					condition("Ready", "True", "Ready", ""),
+					condition("CodeFlareReady", "False", "Removed", "Component ManagementState is set to Removed"),
+				),
+				withConditions(testutil.NewDSCI("opendatahub"),
+					condition("Available", "True", "Reconciled", ""),
+					condition("Degraded", "False", "", ""),
+				),
+			},
+		})
+
+		result, err := platform.NewCheck().Validate(t.Context(), target)
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.Status.Conditions).To(HaveLen(1))
+		g.Expect(result.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+			"Type":   Equal(platform.ConditionTypeConditionsHealthy),
+			"Status": Equal(metav1.ConditionTrue),
+		}))
+		g.Expect(result.ImpactedObjects).To(BeEmpty())
+	})
+
+	t.Run("should report failing conditions", func(t *testing.T) {
+		g := NewWithT(t)
+
+		target := testutil.NewTarget(t, testutil.TargetConfig{
+			ListKinds: listKinds,
+			Objects: []*unstructured.Unstructured{
+				withConditions(testutil.NewDSC(nil),
+					condition("Ready", "False", "NotReady", "Some components are not ready"),
+					condition("KserveReady", "False", "Error", "deployment kserve-controller-manager not ready"),
+				),
+				withConditions(testutil.NewDSCI("opendatahub"),
+					condition("Degraded", "True", "", ""),
+				),
+			},
+		})
+
+		result, err := platform.NewCheck().Validate(t.Context(), target)
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+			"Status": Equal(metav1.ConditionFalse),
+			"Reason": Equal(check.ReasonResourceUnavailable),
+			"Message": Equal("Failing status conditions: DataScienceCluster default-dsc: " +
+				"Ready=False (NotReady: Some components are not ready); " +
+				"KserveReady=False (Error: deployment kserve-controller-manager not ready); " +
+				"DSCInitialization default-dsci: Degraded=True"),
+		}))
+		g.Expect(result.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactBlocking))
+		g.Expect(result.ImpactedObjects).To(HaveLen(2))
+		g.Expect(result.ImpactedObjects[1].Kind).To(Equal(resources.DSCInitialization.Kind))
+		g.Expect(result.ImpactedObjects[1].Annotations).To(HaveKeyWithValue(check.AnnotationImpactReason, "Degraded=True"))
+	})
+
+	t.Run("should fail without platform resources", func(t *testing.T) {
+		g := NewWithT(t)
+
+		target := testutil.NewTarget(t, testutil.TargetConfig{ListKinds: listKinds})
+
+		result, err := platform.NewCheck().Validate(t.Context(), target)
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+			"Status": Equal(metav1.ConditionFalse),
+			"Reason": Equal(check.ReasonResourceNotFound),
+		}))
+	})
+}
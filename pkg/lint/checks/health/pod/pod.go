@@ -0,0 +1,175 @@
+package pod
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+)
+
+const (
+	kind      = "pod"
+	checkType = "crashloops"
+
+	// ConditionTypePodsStable indicates whether the pods in the applications namespace run
+	// without crashing.
+	ConditionTypePodsStable = "PodsStable"
+
+	waitingReasonCrashLoop = "CrashLoopBackOff"
+
+	// recentRestartWindow is how long ago a container may have terminated for its restart to be
+	// reported as recent.
+	recentRestartWindow = time.Hour
+)
+
+// containerStatus is the part of a pod container status the check reads.
+type containerStatus struct {
+	Name         string `json:"name"`
+	RestartCount int    `json:"restartCount"`
+	State        struct {
+		Waiting *struct {
+			Reason string `json:"reason"`
+		} `json:"waiting"`
+	} `json:"state"`
+	LastState struct {
+		Terminated *struct {
+			Reason     string      `json:"reason"`
+			FinishedAt metav1.Time `json:"finishedAt"`
+		} `json:"terminated"`
+	} `json:"lastState"`
+}
+
+// Check validates that no pod in the applications namespace is crash looping or has recently
+// restarted containers.
+type Check struct {
+	check.BaseCheck
+}
+
+// NewCheck creates a new pod crash loop check.
+func NewCheck() *Check {
+	return &Check{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupHealth,
+			Kind:             kind,
+			Type:             checkType,
+			CheckID:          "health.pod.crashloops",
+			CheckName:        "Health :: Pod :: Crash Loops",
+			CheckDescription: "Validates that no pod in the applications namespace is crash looping or has restarted containers in the last hour",
+			CheckRemediation: "Inspect the logs of the previous run of the restarting containers",
+			CheckResources: []resources.ResourceType{
+				resources.DSCInitialization,
+				resources.Pod,
+			},
+			CheckDocumentation: check.Documentation{
+				Inspects:  "The container and init container statuses of the pods in the applications namespace set in the DSCInitialization. Containers waiting in CrashLoopBackOff are failures; containers whose last run terminated within the last hour are warnings.",
+				Rationale: "A crash looping component is unavailable most of the time, and recent restarts point to out-of-memory kills or failing probes before the component goes down.",
+				RemediationCommands: []string{
+					"kubectl get pods -n <applications-namespace>",
+					"kubectl logs <pod> -c <container> --previous -n <applications-namespace>",
+				},
+			},
+		},
+	}
+}
+
+// CanApply returns true: runtime health is checked regardless of the installed version.
+func (c *Check) CanApply(_ context.Context, _ check.Target) (bool, error) {
+	return true, nil
+}
+
+func (c *Check) Validate(
+	ctx context.Context,
+	target check.Target,
+) (*result.DiagnosticResult, error) {
+	dr := c.NewResult()
+
+	namespace, err := client.GetApplicationsNamespace(ctx, target.Client)
+	if err != nil {
+		return nil, fmt.Errorf("getting applications namespace: %w", err)
+	}
+
+	pods, err := target.Client.List(ctx, resources.Pod, client.WithNamespace(namespace))
+	if err != nil {
+		return nil, fmt.Errorf("listing pods in %s: %w", namespace, err)
+	}
+
+	now := time.Now()
+
+	var crashLooping, restarted []string
+
+	for _, pod := range pods {
+		statuses, err := jq.Query[[]containerStatus](pod, "(.status.initContainerStatuses // []) + (.status.containerStatuses // [])")
+		if err != nil {
+			return nil, fmt.Errorf("reading container statuses of pod %s/%s: %w", namespace, pod.GetName(), err)
+		}
+
+		var reasons []string
+
+		for _, status := range statuses {
+			switch {
+			case status.State.Waiting != nil && status.State.Waiting.Reason == waitingReasonCrashLoop:
+				reasons = append(reasons, fmt.Sprintf("container %s in %s (%d restarts)", status.Name, waitingReasonCrashLoop, status.RestartCount))
+				crashLooping = append(crashLooping, pod.GetName()+"/"+status.Name)
+			case status.RestartCount > 0 && status.LastState.Terminated != nil &&
+				now.Sub(status.LastState.Terminated.FinishedAt.Time) <= recentRestartWindow:
+				reasons = append(reasons, fmt.Sprintf("container %s restarted after %s %s ago",
+					status.Name, status.LastState.Terminated.Reason, now.Sub(status.LastState.Terminated.FinishedAt.Time).Round(time.Minute)))
+				restarted = append(restarted, pod.GetName()+"/"+status.Name)
+			}
+		}
+
+		if len(reasons) > 0 {
+			appendImpactedPod(dr, pod, strings.Join(reasons, "; "))
+		}
+	}
+
+	switch {
+	case len(crashLooping) > 0:
+		dr.SetCondition(check.NewCondition(
+			ConditionTypePodsStable,
+			metav1.ConditionFalse,
+			check.WithReason(check.ReasonResourceUnavailable),
+			check.WithMessage("Found %d crash looping container(s) in %s: %s", len(crashLooping), namespace, strings.Join(crashLooping, ", ")),
+			check.WithImpact(result.ImpactBlocking),
+			check.WithRemediation(c.CheckRemediation),
+		))
+	case len(restarted) > 0:
+		dr.SetCondition(check.NewCondition(
+			ConditionTypePodsStable,
+			metav1.ConditionFalse,
+			check.WithReason(check.ReasonResourceUnavailable),
+			check.WithMessage("Found %d container(s) in %s restarted in the last hour: %s", len(restarted), namespace, strings.Join(restarted, ", ")),
+			check.WithImpact(result.ImpactAdvisory),
+			check.WithRemediation(c.CheckRemediation),
+		))
+	default:
+		dr.SetCondition(check.NewCondition(
+			ConditionTypePodsStable,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonResourceAvailable),
+			check.WithMessage("No crash looping or recently restarted containers among %d pod(s) in %s", len(pods), namespace),
+		))
+	}
+
+	return dr, nil
+}
+
+func appendImpactedPod(dr *result.DiagnosticResult, pod *unstructured.Unstructured, reason string) {
+	dr.ImpactedObjects = append(dr.ImpactedObjects, metav1.PartialObjectMetadata{
+		TypeMeta: resources.Pod.TypeMeta(),
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   pod.GetNamespace(),
+			Name:        pod.GetName(),
+			Annotations: map[string]string{check.AnnotationImpactReason: reason},
+		},
+	})
+}
@@ -0,0 +1,134 @@
+package pod_test
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/health/pod"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+const applicationsNamespace = "redhat-ods-applications"
+
+//nolint:gochecknoglobals
+var listKinds = map[schema.GroupVersionResource]string{
+	resources.DSCInitialization.GVR(): resources.DSCInitialization.ListKind(),
+	resources.Pod.GVR():               resources.Pod.ListKind(),
+}
+
+func newPod(name string, statuses ...any) *unstructured.Unstructured {
+	obj := resources.Pod.Unstructured()
+	obj.SetName(name)
+	obj.SetNamespace(applicationsNamespace)
+	_ = unstructured.SetNestedSlice(obj.Object, statuses, "status", "containerStatuses")
+
+	return &obj
+}
+
+func crashLooping(name string, restarts int64) map[string]any {
+	return map[string]any{
+		"name":         name,
+		"restartCount": restarts,
+		"state":        map[string]any{"waiting": map[string]any{"reason": "CrashLoopBackOff"}},
+	}
+}
+
+func restartedAt(name string, finishedAt time.Time) map[string]any {
+	return map[string]any{
+		"name":         name,
+		"restartCount": int64(1),
+		"state":        map[string]any{"running": map[string]any{}},
+		"lastState": map[string]any{"terminated": map[string]any{
+			"reason":     "OOMKilled",
+			"finishedAt": finishedAt.UTC().Format(time.RFC3339),
+		}},
+	}
+}
+
+func running(name string) map[string]any {
+	return map[string]any{"name": name, "restartCount": int64(0), "state": map[string]any{"running": map[string]any{}}}
+}
+
+func TestCheck_Validate(t *testing.T) {
+	t.Run("should pass with stable pods", func(t *testing.T) {
+		g := NewWithT(t)
+
+		target := testutil.NewTarget(t, testutil.TargetConfig{
+			ListKinds: listKinds,
+			Objects: []*unstructured.Unstructured{
+				testutil.NewDSCI(applicationsNamespace),
+				newPod("dashboard-1", running("dashboard")),
+				newPod("old-restart", restartedAt("manager", time.Now().Add(-3*time.Hour))),
+			},
+		})
+
+		result, err := pod.NewCheck().Validate(t.Context(), target)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		g.Expect(result.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+			"Type":    Equal(pod.ConditionTypePodsStable),
+			"Status":  Equal(metav1.ConditionTrue),
+			"Message": Equal("No crash looping or recently restarted containers among 2 pod(s) in redhat-ods-applications"),
+		}))
+		g.Expect(result.ImpactedObjects).To(BeEmpty())
+	})
+
+	t.Run("should warn about recent restarts", func(t *testing.T) {
+		g := NewWithT(t)
+
+		target := testutil.NewTarget(t, testutil.TargetConfig{
+			ListKinds: listKinds,
+			Objects: []*unstructured.Unstructured{
+				testutil.NewDSCI(applicationsNamespace),
+				newPod("kserve-1", restartedAt("manager", time.Now().Add(-10*time.Minute))),
+			},
+		})
+
+		result, err := pod.NewCheck().Validate(t.Context(), target)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		g.Expect(result.Status.Conditions[0].Condition.Status).To(Equal(metav1.ConditionFalse))
+		g.Expect(result.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactAdvisory))
+		g.Expect(result.Status.Conditions[0].Condition.Message).To(Equal(
+			"Found 1 container(s) in redhat-ods-applications restarted in the last hour: kserve-1/manager"))
+		g.Expect(result.ImpactedObjects).To(HaveLen(1))
+		g.Expect(result.ImpactedObjects[0].Annotations).To(HaveKeyWithValue(check.AnnotationImpactReason,
+			"container manager restarted after OOMKilled 10m0s ago"))
+	})
+
+	t.Run("should fail on crash looping containers", func(t *testing.T) {
+		g := NewWithT(t)
+
+		target := testutil.NewTarget(t, testutil.TargetConfig{
+			ListKinds: listKinds,
+			Objects: []*unstructured.Unstructured{
+				testutil.NewDSCI(applicationsNamespace),
+				newPod("dashboard-1", running("dashboard"), crashLooping("oauth-proxy", 7)),
+				newPod("kserve-1", restartedAt("manager", time.Now().Add(-10*time.Minute))),
+			},
+		})
+
+		result, err := pod.NewCheck().Validate(t.Context(), target)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		g.Expect(result.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+			"Status":  Equal(metav1.ConditionFalse),
+			"Reason":  Equal(check.ReasonResourceUnavailable),
+			"Message": Equal("Found 1 crash looping container(s) in redhat-ods-applications: dashboard-1/oauth-proxy"),
+		}))
+		g.Expect(result.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactBlocking))
+		g.Expect(result.ImpactedObjects).To(HaveLen(2))
+		g.Expect(result.ImpactedObjects[0].Annotations).To(HaveKeyWithValue(check.AnnotationImpactReason,
+			"container oauth-proxy in CrashLoopBackOff (7 restarts)"))
+	})
+}
@@ -0,0 +1,184 @@
+package route
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+)
+
+const (
+	kind      = "route"
+	checkType = "admitted"
+
+	// ConditionTypeRoutesAdmitted indicates whether the Routes of the applications namespace are
+	// admitted by a router.
+	ConditionTypeRoutesAdmitted = "RoutesAdmitted"
+
+	conditionAdmitted = "Admitted"
+)
+
+// routeIngress is the part of a Route ingress status the check reads.
+type routeIngress struct {
+	RouterName string             `json:"routerName"`
+	Conditions []metav1.Condition `json:"conditions"`
+}
+
+// Check validates that the Routes exposing the components in the applications namespace are
+// admitted by a router.
+type Check struct {
+	check.BaseCheck
+}
+
+// NewCheck creates a new Route availability check.
+func NewCheck() *Check {
+	return &Check{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupHealth,
+			Kind:             kind,
+			Type:             checkType,
+			CheckID:          "health.route.admitted",
+			CheckName:        "Health :: Route :: Admitted",
+			CheckDescription: "Validates that the Routes of the applications namespace are admitted by a router",
+			CheckRemediation: "Inspect the ingress status of the Routes that are not admitted, e.g. for a host already claimed by another Route",
+			CheckResources: []resources.ResourceType{
+				resources.DSCInitialization,
+				resources.Route,
+			},
+			CheckDocumentation: check.Documentation{
+				Inspects:  "The ingress status of the Routes in the applications namespace set in the DSCInitialization. A Route is available when at least one router reports it as Admitted.",
+				Rationale: "The dashboard and the component UIs are reached through these Routes; a Route no router admitted, e.g. because its host is already taken, is not reachable from outside the cluster.",
+				RemediationCommands: []string{
+					"kubectl get routes -n <applications-namespace>",
+					"kubectl get route <name> -n <applications-namespace> -o jsonpath='{.status.ingress}'",
+				},
+			},
+		},
+	}
+}
+
+// CanApply returns true: runtime health is checked regardless of the installed version.
+func (c *Check) CanApply(_ context.Context, _ check.Target) (bool, error) {
+	return true, nil
+}
+
+func (c *Check) Validate(
+	ctx context.Context,
+	target check.Target,
+) (*result.DiagnosticResult, error) {
+	dr := c.NewResult()
+
+	namespace, err := client.GetApplicationsNamespace(ctx, target.Client)
+	if err != nil {
+		return nil, fmt.Errorf("getting applications namespace: %w", err)
+	}
+
+	routes, err := target.Client.List(ctx, resources.Route, client.WithNamespace(namespace))
+	if err != nil {
+		if client.IsResourceTypeNotFound(err) {
+			dr.SetCondition(check.NewCondition(
+				ConditionTypeRoutesAdmitted,
+				metav1.ConditionTrue,
+				check.WithReason(check.ReasonRequirementsMet),
+				check.WithMessage("Routes are not available on this cluster"),
+			))
+
+			return dr, nil
+		}
+
+		return nil, fmt.Errorf("listing Routes in %s: %w", namespace, err)
+	}
+
+	var unavailable []string
+
+	for _, route := range routes {
+		reason, err := notAdmittedReason(route)
+		if err != nil {
+			return nil, fmt.Errorf("reading ingress status of Route %s/%s: %w", namespace, route.GetName(), err)
+		}
+
+		if reason == "" {
+			continue
+		}
+
+		unavailable = append(unavailable, fmt.Sprintf("%s (%s)", route.GetName(), reason))
+
+		dr.ImpactedObjects = append(dr.ImpactedObjects, metav1.PartialObjectMetadata{
+			TypeMeta: resources.Route.TypeMeta(),
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   route.GetNamespace(),
+				Name:        route.GetName(),
+				Annotations: map[string]string{check.AnnotationImpactReason: reason},
+			},
+		})
+	}
+
+	if len(unavailable) == 0 {
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeRoutesAdmitted,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonResourceAvailable),
+			check.WithMessage("All %d Route(s) in %s are admitted", len(routes), namespace),
+		))
+
+		return dr, nil
+	}
+
+	dr.SetCondition(check.NewCondition(
+		ConditionTypeRoutesAdmitted,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonResourceUnavailable),
+		check.WithMessage("Found %d of %d Route(s) in %s not admitted: %s", len(unavailable), len(routes), namespace, strings.Join(unavailable, ", ")),
+		check.WithImpact(result.ImpactBlocking),
+		check.WithRemediation(c.CheckRemediation),
+	))
+
+	return dr, nil
+}
+
+// notAdmittedReason returns why no router admitted the Route, or an empty string when one did.
+func notAdmittedReason(route *unstructured.Unstructured) (string, error) {
+	ingresses, err := jq.Query[[]routeIngress](route, ".status.ingress // []")
+	if err != nil {
+		return "", err
+	}
+
+	if len(ingresses) == 0 {
+		return "not admitted by any router", nil
+	}
+
+	var rejections []string
+
+	for _, ingress := range ingresses {
+		for _, cond := range ingress.Conditions {
+			if cond.Type != conditionAdmitted {
+				continue
+			}
+
+			if cond.Status == metav1.ConditionTrue {
+				return "", nil
+			}
+
+			rejection := fmt.Sprintf("rejected by router %s", ingress.RouterName)
+			if cond.Reason != "" {
+				rejection += ": " + cond.Reason
+			}
+
+			rejections = append(rejections, rejection)
+		}
+	}
+
+	if len(rejections) == 0 {
+		return "not admitted by any router", nil
+	}
+
+	return strings.Join(rejections, "; "), nil
+}
@@ -0,0 +1,93 @@
+package route_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/health/route"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+const applicationsNamespace = "redhat-ods-applications"
+
+//nolint:gochecknoglobals
+var listKinds = map[schema.GroupVersionResource]string{
+	resources.DSCInitialization.GVR(): resources.DSCInitialization.ListKind(),
+	resources.Route.GVR():             resources.Route.ListKind(),
+}
+
+func newRoute(name string, ingress ...any) *unstructured.Unstructured {
+	obj := resources.Route.Unstructured()
+	obj.SetName(name)
+	obj.SetNamespace(applicationsNamespace)
+
+	if len(ingress) > 0 {
+		_ = unstructured.SetNestedSlice(obj.Object, ingress, "status", "ingress")
+	}
+
+	return &obj
+}
+
+func ingress(router string, admitted string, reason string) map[string]any {
+	return map[string]any{
+		"routerName": router,
+		"conditions": []any{map[string]any{"type": "Admitted", "status": admitted, "reason": reason}},
+	}
+}
+
+func TestCheck_Validate(t *testing.T) {
+	t.Run("should pass when all Routes are admitted", func(t *testing.T) {
+		g := NewWithT(t)
+
+		target := testutil.NewTarget(t, testutil.TargetConfig{
+			ListKinds: listKinds,
+			Objects: []*unstructured.Unstructured{
+				testutil.NewDSCI(applicationsNamespace),
+				newRoute("rhods-dashboard", ingress("sharded", "False", "HostAlreadyClaimed"), ingress("default", "True", "")),
+			},
+		})
+
+		result, err := route.NewCheck().Validate(t.Context(), target)
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+			"Type":    Equal(route.ConditionTypeRoutesAdmitted),
+			"Status":  Equal(metav1.ConditionTrue),
+			"Message": Equal("All 1 Route(s) in redhat-ods-applications are admitted"),
+		}))
+	})
+
+	t.Run("should report Routes not admitted", func(t *testing.T) {
+		g := NewWithT(t)
+
+		target := testutil.NewTarget(t, testutil.TargetConfig{
+			ListKinds: listKinds,
+			Objects: []*unstructured.Unstructured{
+				testutil.NewDSCI(applicationsNamespace),
+				newRoute("rhods-dashboard", ingress("default", "False", "HostAlreadyClaimed")),
+				newRoute("pending"),
+			},
+		})
+
+		result, err := route.NewCheck().Validate(t.Context(), target)
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+			"Status": Equal(metav1.ConditionFalse),
+			"Reason": Equal(check.ReasonResourceUnavailable),
+			"Message": Equal("Found 2 of 2 Route(s) in redhat-ods-applications not admitted: " +
+				"pending (not admitted by any router), rhods-dashboard (rejected by router default: HostAlreadyClaimed)"),
+		}))
+		g.Expect(result.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactBlocking))
+		g.Expect(result.ImpactedObjects).To(HaveLen(2))
+	})
+}
@@ -0,0 +1,346 @@
+package webhook
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube"
+)
+
+const (
+	kind      = "webhook"
+	checkType = "certificates"
+
+	// ConditionTypeCertificatesValid indicates whether the certificates of the platform
+	// admission webhooks are valid and not about to expire.
+	ConditionTypeCertificatesValid = "CertificatesValid"
+
+	// webhookNameSuffix identifies the admission webhooks of the operator, whose Service is in
+	// the operator namespace rather than the applications namespace.
+	webhookNameSuffix = ".opendatahub.io"
+
+	// annotationServingCertSecret names the Secret the OpenShift service CA writes the serving
+	// certificate of a Service to.
+	annotationServingCertSecret = "service.beta.openshift.io/serving-cert-secret-name"
+
+	// expiryWarningWindow is how long before their expiry certificates are reported.
+	expiryWarningWindow = 30 * 24 * time.Hour
+)
+
+// webhookEntry is the part of an admission webhook the check reads.
+type webhookEntry struct {
+	Name         string `json:"name"`
+	ClientConfig struct {
+		CABundle string `json:"caBundle"`
+		Service  *struct {
+			Namespace string `json:"namespace"`
+			Name      string `json:"name"`
+		} `json:"service"`
+	} `json:"clientConfig"`
+}
+
+// certificate is the certificate with the earliest expiry of an object holding certificates.
+type certificate struct {
+	object   metav1.PartialObjectMetadata
+	source   string
+	notAfter time.Time
+}
+
+// Check validates that the CA bundles and serving certificates of the platform admission
+// webhooks are valid and not about to expire.
+type Check struct {
+	check.BaseCheck
+}
+
+// NewCheck creates a new webhook certificate expiry check.
+func NewCheck() *Check {
+	return &Check{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupHealth,
+			Kind:             kind,
+			Type:             checkType,
+			CheckID:          "health.webhook.certificates",
+			CheckName:        "Health :: Webhook :: Certificates",
+			CheckDescription: "Validates that the certificates of the platform admission webhooks are valid and do not expire within 30 days",
+			CheckRemediation: "Rotate the expiring webhook certificates, e.g. by deleting the serving certificate Secret so the service CA issues a new one",
+			CheckResources: []resources.ResourceType{
+				resources.DSCInitialization,
+				resources.ValidatingWebhookConfiguration,
+				resources.MutatingWebhookConfiguration,
+				resources.Service,
+				resources.Secret,
+			},
+			CheckDocumentation: check.Documentation{
+				Inspects:  "The validating and mutating admission webhooks of the operator (named *.opendatahub.io) and of the components (served from the applications namespace): the certificates of their caBundle and, for Services annotated with service.beta.openshift.io/serving-cert-secret-name, the serving certificate in that Secret.",
+				Rationale: "The API server rejects the webhook calls once a certificate expires, and with a failurePolicy of Fail every create and update of the platform resources is denied.",
+				RemediationCommands: []string{
+					"kubectl get validatingwebhookconfigurations,mutatingwebhookconfigurations",
+					"kubectl delete secret <serving-cert-secret> -n <namespace>",
+				},
+			},
+		},
+	}
+}
+
+// CanApply returns true: runtime health is checked regardless of the installed version.
+func (c *Check) CanApply(_ context.Context, _ check.Target) (bool, error) {
+	return true, nil
+}
+
+func (c *Check) Validate(
+	ctx context.Context,
+	target check.Target,
+) (*result.DiagnosticResult, error) {
+	dr := c.NewResult()
+
+	namespace, err := client.GetApplicationsNamespace(ctx, target.Client)
+	if err != nil {
+		return nil, fmt.Errorf("getting applications namespace: %w", err)
+	}
+
+	certificates, err := c.collectCertificates(ctx, target.Client, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(certificates, func(a certificate, b certificate) int {
+		return a.notAfter.Compare(b.notAfter)
+	})
+
+	now := time.Now()
+
+	var expired, expiring []string
+
+	for _, cert := range certificates {
+		var reason string
+
+		switch {
+		case !now.Before(cert.notAfter):
+			reason = fmt.Sprintf("%s expired on %s", cert.source, cert.notAfter.Format(time.DateOnly))
+			expired = append(expired, reason)
+		case cert.notAfter.Sub(now) < expiryWarningWindow:
+			reason = fmt.Sprintf("%s expires on %s", cert.source, cert.notAfter.Format(time.DateOnly))
+			expiring = append(expiring, reason)
+		default:
+			continue
+		}
+
+		cert.object.Annotations = map[string]string{check.AnnotationImpactReason: reason}
+		dr.ImpactedObjects = append(dr.ImpactedObjects, cert.object)
+	}
+
+	switch {
+	case len(expired) > 0:
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeCertificatesValid,
+			metav1.ConditionFalse,
+			check.WithReason(check.ReasonResourceUnavailable),
+			check.WithMessage("Found %d expired webhook certificate(s): %s", len(expired), strings.Join(expired, "; ")),
+			check.WithImpact(result.ImpactBlocking),
+			check.WithRemediation(c.CheckRemediation),
+		))
+	case len(expiring) > 0:
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeCertificatesValid,
+			metav1.ConditionFalse,
+			check.WithReason(check.ReasonResourceUnavailable),
+			check.WithMessage("Found %d webhook certificate(s) expiring within 30 days: %s", len(expiring), strings.Join(expiring, "; ")),
+			check.WithImpact(result.ImpactAdvisory),
+			check.WithRemediation(c.CheckRemediation),
+		))
+	default:
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeCertificatesValid,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonResourceAvailable),
+			check.WithMessage("All %d webhook certificate source(s) are valid for more than 30 days", len(certificates)),
+		))
+	}
+
+	return dr, nil
+}
+
+// collectCertificates returns the certificate with the earliest expiry of the caBundle of each
+// platform webhook configuration and of each serving certificate Secret of their Services.
+func (c *Check) collectCertificates(
+	ctx context.Context,
+	reader client.Reader,
+	namespace string,
+) ([]certificate, error) {
+	var certificates []certificate
+
+	services := map[string]bool{}
+
+	for _, rt := range []resources.ResourceType{resources.ValidatingWebhookConfiguration, resources.MutatingWebhookConfiguration} {
+		configurations, err := reader.List(ctx, rt)
+		if err != nil {
+			if client.IsResourceTypeNotFound(err) {
+				continue
+			}
+
+			return nil, fmt.Errorf("listing %ss: %w", rt.Kind, err)
+		}
+
+		for _, configuration := range configurations {
+			webhooks, err := jq.Query[[]webhookEntry](configuration, ".webhooks // []")
+			if err != nil {
+				return nil, fmt.Errorf("reading webhooks of %s %s: %w", rt.Kind, configuration.GetName(), err)
+			}
+
+			var bundle []*x509.Certificate
+
+			for _, webhook := range webhooks {
+				svc := webhook.ClientConfig.Service
+				if !strings.HasSuffix(webhook.Name, webhookNameSuffix) && (svc == nil || svc.Namespace != namespace) {
+					continue
+				}
+
+				certs, err := parseCertificates(webhook.ClientConfig.CABundle)
+				if err != nil {
+					return nil, fmt.Errorf("parsing caBundle of webhook %s in %s %s: %w", webhook.Name, rt.Kind, configuration.GetName(), err)
+				}
+
+				bundle = append(bundle, certs...)
+
+				if svc != nil && !services[svc.Namespace+"/"+svc.Name] {
+					services[svc.Namespace+"/"+svc.Name] = true
+
+					serving, err := servingCertificate(ctx, reader, svc.Namespace, svc.Name)
+					if err != nil {
+						return nil, err
+					}
+
+					if serving != nil {
+						certificates = append(certificates, *serving)
+					}
+				}
+			}
+
+			if first := earliestExpiry(bundle); first != nil {
+				certificates = append(certificates, certificate{
+					object: metav1.PartialObjectMetadata{
+						TypeMeta:   rt.TypeMeta(),
+						ObjectMeta: metav1.ObjectMeta{Name: configuration.GetName()},
+					},
+					source:   fmt.Sprintf("caBundle of %s %s", rt.Kind, configuration.GetName()),
+					notAfter: first.NotAfter,
+				})
+			}
+		}
+	}
+
+	return certificates, nil
+}
+
+// servingCertificate returns the serving certificate the OpenShift service CA issued for the
+// Service namespace/name, or nil when the Service has none.
+func servingCertificate(ctx context.Context, reader client.Reader, namespace string, name string) (*certificate, error) {
+	svc, err := reader.GetResourceMetadata(ctx, resources.Service, name, client.InNamespace(namespace))
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("getting Service %s/%s: %w", namespace, name, err)
+	}
+
+	secretName := kube.GetAnnotation(svc, annotationServingCertSecret)
+	if secretName == "" {
+		return nil, nil
+	}
+
+	secret, err := reader.GetResource(ctx, resources.Secret, secretName, client.InNamespace(namespace))
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("getting Secret %s/%s: %w", namespace, secretName, err)
+	}
+
+	data, err := jq.Query[string](secret, `.data["tls.crt"] // ""`)
+	if err != nil {
+		return nil, fmt.Errorf("reading tls.crt of Secret %s/%s: %w", namespace, secretName, err)
+	}
+
+	certs, err := parseCertificates(data)
+	if err != nil {
+		return nil, fmt.Errorf("parsing tls.crt of Secret %s/%s: %w", namespace, secretName, err)
+	}
+
+	first := earliestExpiry(certs)
+	if first == nil {
+		return nil, nil
+	}
+
+	return &certificate{
+		object: metav1.PartialObjectMetadata{
+			TypeMeta:   resources.Secret.TypeMeta(),
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: secretName},
+		},
+		source:   fmt.Sprintf("serving certificate of Service %s/%s", namespace, name),
+		notAfter: first.NotAfter,
+	}, nil
+}
+
+// parseCertificates parses the base64-encoded PEM certificates of a caBundle or Secret value.
+// Blocks other than certificates are skipped.
+func parseCertificates(encoded string) ([]*x509.Certificate, error) {
+	if encoded == "" {
+		return nil, nil
+	}
+
+	rest, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64: %w", err)
+	}
+
+	var certs []*x509.Certificate
+
+	for {
+		var block *pem.Block
+
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return certs, nil
+		}
+
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("parsing certificate %d: %w", len(certs)+1, err)
+		}
+
+		certs = append(certs, cert)
+	}
+}
+
+// earliestExpiry returns the certificate expiring first, or nil when there is none.
+func earliestExpiry(certs []*x509.Certificate) *x509.Certificate {
+	var first *x509.Certificate
+
+	for _, cert := range certs {
+		if first == nil || cert.NotAfter.Before(first.NotAfter) {
+			first = cert
+		}
+	}
+
+	return first
+}
@@ -0,0 +1,193 @@
+package webhook_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/health/webhook"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+const (
+	applicationsNamespace = "redhat-ods-applications"
+	operatorNamespace     = "redhat-ods-operator"
+	day                   = 24 * time.Hour
+)
+
+//nolint:gochecknoglobals // Test fixture - shared across test functions
+var listKinds = map[schema.GroupVersionResource]string{
+	resources.DSCInitialization.GVR():              resources.DSCInitialization.ListKind(),
+	resources.ValidatingWebhookConfiguration.GVR(): resources.ValidatingWebhookConfiguration.ListKind(),
+	resources.MutatingWebhookConfiguration.GVR():   resources.MutatingWebhookConfiguration.ListKind(),
+	resources.Service.GVR():                        resources.Service.ListKind(),
+	resources.Secret.GVR():                         resources.Secret.ListKind(),
+}
+
+// newCertificate returns a base64 encoded PEM self-signed certificate valid for validFor from
+// now; a negative duration yields an expired certificate.
+func newCertificate(t *testing.T, validFor time.Duration) string {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generating key: %v", err)
+	}
+
+	notAfter := time.Now().Add(validFor)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "webhook"},
+		NotBefore:    notAfter.Add(-365 * day),
+		NotAfter:     notAfter,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("creating certificate: %v", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func newWebhookConfiguration(rt resources.ResourceType, name string, webhookName string, namespace string, caBundle string) *unstructured.Unstructured {
+	obj := rt.Unstructured()
+	obj.SetName(name)
+	obj.Object["webhooks"] = []any{map[string]any{
+		"name": webhookName,
+		"clientConfig": map[string]any{
+			"caBundle": caBundle,
+			"service":  map[string]any{"namespace": namespace, "name": "webhook-service"},
+		},
+	}}
+
+	return &obj
+}
+
+func newServingCertificate(namespace string, tlsCrt string) []*unstructured.Unstructured {
+	svc := resources.Service.Unstructured()
+	svc.SetName("webhook-service")
+	svc.SetNamespace(namespace)
+	svc.SetAnnotations(map[string]string{"service.beta.openshift.io/serving-cert-secret-name": "webhook-cert"})
+
+	secret := resources.Secret.Unstructured()
+	secret.SetName("webhook-cert")
+	secret.SetNamespace(namespace)
+	secret.Object["data"] = map[string]any{"tls.crt": tlsCrt}
+
+	return []*unstructured.Unstructured{&svc, &secret}
+}
+
+func TestCheck_Validate(t *testing.T) {
+	t.Run("should pass with valid certificates", func(t *testing.T) {
+		g := NewWithT(t)
+
+		objects := append([]*unstructured.Unstructured{
+			testutil.NewDSCI(applicationsNamespace),
+			newWebhookConfiguration(resources.ValidatingWebhookConfiguration,
+				"validating.opendatahub.io", "operator.opendatahub.io", operatorNamespace, newCertificate(t, 365*day)),
+		}, newServingCertificate(operatorNamespace, newCertificate(t, 365*day))...)
+
+		target := testutil.NewTarget(t, testutil.TargetConfig{
+			ListKinds: listKinds,
+			Objects:   objects,
+		})
+
+		result, err := webhook.NewCheck().Validate(t.Context(), target)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		g.Expect(result.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+			"Type":    Equal(webhook.ConditionTypeCertificatesValid),
+			"Status":  Equal(metav1.ConditionTrue),
+			"Message": Equal("All 2 webhook certificate source(s) are valid for more than 30 days"),
+		}))
+		g.Expect(result.ImpactedObjects).To(BeEmpty())
+	})
+
+	t.Run("should ignore webhooks of other operators", func(t *testing.T) {
+		g := NewWithT(t)
+
+		target := testutil.NewTarget(t, testutil.TargetConfig{
+			ListKinds: listKinds,
+			Objects: []*unstructured.Unstructured{
+				testutil.NewDSCI(applicationsNamespace),
+				newWebhookConfiguration(resources.MutatingWebhookConfiguration,
+					"cert-manager-webhook", "webhook.cert-manager.io", "cert-manager", newCertificate(t, -day)),
+			},
+		})
+
+		result, err := webhook.NewCheck().Validate(t.Context(), target)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		g.Expect(result.Status.Conditions[0].Condition.Status).To(Equal(metav1.ConditionTrue))
+		g.Expect(result.ImpactedObjects).To(BeEmpty())
+	})
+
+	t.Run("should warn about certificates expiring within 30 days", func(t *testing.T) {
+		g := NewWithT(t)
+
+		target := testutil.NewTarget(t, testutil.TargetConfig{
+			ListKinds: listKinds,
+			Objects: []*unstructured.Unstructured{
+				testutil.NewDSCI(applicationsNamespace),
+				newWebhookConfiguration(resources.MutatingWebhookConfiguration,
+					"kserve-webhook", "inferenceservice.kserve-webhook-server", applicationsNamespace, newCertificate(t, 10*day)),
+			},
+		})
+
+		result, err := webhook.NewCheck().Validate(t.Context(), target)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		g.Expect(result.Status.Conditions[0].Condition.Status).To(Equal(metav1.ConditionFalse))
+		g.Expect(result.Status.Conditions[0].Condition.Message).To(HavePrefix(
+			"Found 1 webhook certificate(s) expiring within 30 days: caBundle of MutatingWebhookConfiguration kserve-webhook expires on "))
+		g.Expect(result.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactAdvisory))
+		g.Expect(result.ImpactedObjects).To(HaveLen(1))
+		g.Expect(result.ImpactedObjects[0].Name).To(Equal("kserve-webhook"))
+	})
+
+	t.Run("should fail on expired serving certificates", func(t *testing.T) {
+		g := NewWithT(t)
+
+		objects := append([]*unstructured.Unstructured{
+			testutil.NewDSCI(applicationsNamespace),
+			newWebhookConfiguration(resources.ValidatingWebhookConfiguration,
+				"validating.opendatahub.io", "operator.opendatahub.io", operatorNamespace, newCertificate(t, 365*day)),
+		}, newServingCertificate(operatorNamespace, newCertificate(t, -day))...)
+
+		target := testutil.NewTarget(t, testutil.TargetConfig{
+			ListKinds: listKinds,
+			Objects:   objects,
+		})
+
+		result, err := webhook.NewCheck().Validate(t.Context(), target)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		g.Expect(result.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+			"Status":  Equal(metav1.ConditionFalse),
+			"Reason":  Equal(check.ReasonResourceUnavailable),
+			"Message": HavePrefix("Found 1 expired webhook certificate(s): serving certificate of Service redhat-ods-operator/webhook-service expired on "),
+		}))
+		g.Expect(result.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactBlocking))
+		g.Expect(result.ImpactedObjects).To(HaveLen(1))
+		g.Expect(result.ImpactedObjects[0].Kind).To(Equal("Secret"))
+		g.Expect(result.ImpactedObjects[0].Name).To(Equal("webhook-cert"))
+	})
+}
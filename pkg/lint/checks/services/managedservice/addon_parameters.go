@@ -0,0 +1,132 @@
+package managedservice
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+const (
+	kind = "managed-service"
+
+	// notificationEmailParameter is the add-on parameter receiving upgrade and incident notifications.
+	notificationEmailParameter = "notification-email"
+)
+
+// Examples rendered by 'lint explain'.
+const (
+	addonParametersFailingExample = `apiVersion: v1
+kind: Secret
+metadata:
+  name: addon-managed-odh-parameters
+  namespace: redhat-ods-operator
+data:
+  notification-email: ""`
+
+	addonParametersPassingExample = `apiVersion: v1
+kind: Secret
+metadata:
+  name: addon-managed-odh-parameters
+  namespace: redhat-ods-operator
+data:
+  notification-email: bWwtb3BzQGV4YW1wbGUuY29t # ml-ops@example.com`
+)
+
+// AddonParametersCheck validates that the parameters of the OpenShift AI add-on are set, so
+// the cluster owner is notified of add-on upgrades and maintenance on the managed service.
+type AddonParametersCheck struct {
+	check.BaseCheck
+}
+
+// NewAddonParametersCheck creates a new add-on parameters check.
+func NewAddonParametersCheck() *AddonParametersCheck {
+	return &AddonParametersCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupService,
+			Kind:             kind,
+			Type:             "addon-parameters",
+			CheckID:          "services.managed-service.addon-parameters",
+			CheckName:        "Services :: Managed Service :: Add-on Parameters",
+			CheckDescription: "Validates that the OpenShift AI add-on parameters (notification email) are set on the managed cloud service",
+			CheckRemediation: "Set the notification email of the OpenShift AI add-on in OpenShift Cluster Manager (Add-ons tab of the cluster); the add-on parameters Secret cannot be edited directly",
+			CheckResources: []resources.ResourceType{
+				resources.Secret,
+			},
+			CheckFlavors:        []version.Flavor{version.FlavorManaged},
+			CheckKnowledgeLinks: []string{check.DocsInstallingCloudService},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "The add-on parameters Secret of the OpenShift AI add-on and its notification-email parameter. The check only runs on the managed cloud service (OSD and ROSA).",
+				Rationale:      "On the managed service, Red Hat schedules add-on upgrades and maintenance and notifies the address set in the add-on parameters. Without it the cluster owner learns about an upgrade only when workloads restart.",
+				FailingExample: addonParametersFailingExample,
+				PassingExample: addonParametersPassingExample,
+				RemediationCommands: []string{
+					"kubectl get secret addon-managed-odh-parameters -n redhat-ods-operator -o jsonpath='{.data.notification-email}' | base64 -d",
+					"ocm edit addon-installation --cluster <cluster-id> managed-odh --parameter notification-email=<address>",
+				},
+			},
+		},
+	}
+}
+
+// CanApply returns whether this check should run for the given target.
+// The flavor gate restricts it to the managed cloud service, where it always applies.
+func (c *AddonParametersCheck) CanApply(_ context.Context, _ check.Target) (bool, error) {
+	return true, nil
+}
+
+// Validate executes the check against the provided target.
+func (c *AddonParametersCheck) Validate(ctx context.Context, target check.Target) (*result.DiagnosticResult, error) {
+	dr := c.NewResult()
+
+	secret, err := target.Client.Get(ctx, resources.Secret.GVR(), version.AddonParametersSecretName,
+		client.InNamespace(version.AddonNamespace))
+
+	switch {
+	case apierrors.IsNotFound(err):
+		dr.SetCondition(check.NewCondition(
+			check.ConditionTypeConfigured,
+			metav1.ConditionFalse,
+			check.WithReason(check.ReasonResourceNotFound),
+			check.WithMessage("Add-on parameters Secret %s/%s not found", version.AddonNamespace, version.AddonParametersSecretName),
+			check.WithImpact(result.ImpactAdvisory),
+			check.WithRemediation(c.CheckRemediation),
+		))
+
+		return dr, nil
+	case err != nil:
+		return nil, fmt.Errorf("getting add-on parameters Secret: %w", err)
+	}
+
+	// Secret values are base64 encoded; an empty parameter encodes to an empty string.
+	email, _, _ := unstructured.NestedString(secret.Object, "data", notificationEmailParameter)
+	if email == "" {
+		dr.SetCondition(check.NewCondition(
+			check.ConditionTypeConfigured,
+			metav1.ConditionFalse,
+			check.WithReason(check.ReasonConfigurationInvalid),
+			check.WithMessage("Add-on parameter %q is not set: upgrade and maintenance notifications of the managed service are not delivered", notificationEmailParameter),
+			check.WithImpact(result.ImpactAdvisory),
+			check.WithRemediation(c.CheckRemediation),
+		))
+
+		return dr, nil
+	}
+
+	dr.SetCondition(check.NewCondition(
+		check.ConditionTypeConfigured,
+		metav1.ConditionTrue,
+		check.WithReason(check.ReasonConfigurationValid),
+		check.WithMessage("Add-on parameter %q is set", notificationEmailParameter),
+	))
+
+	return dr, nil
+}
@@ -0,0 +1,171 @@
+package managedservice
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+// hiveManagedLabel marks namespaces created and reconciled by Hive SyncSets on ROSA/OSD.
+const hiveManagedLabel = "hive.openshift.io/managed=true"
+
+// Examples rendered by 'lint explain'.
+const (
+	hiveNamespacesFailingExample = `apiVersion: kubeflow.org/v1
+kind: Notebook
+metadata:
+  name: analysis
+  namespace: dedicated-admin # labelled hive.openshift.io/managed=true`
+
+	hiveNamespacesPassingExample = `apiVersion: kubeflow.org/v1
+kind: Notebook
+metadata:
+  name: analysis
+  namespace: fraud-detection # data science project created from the dashboard`
+)
+
+// HiveNamespacesCheck reports OpenShift AI workloads in namespaces managed by Hive on the
+// managed cloud service. Hive reconciles those namespaces from SyncSets, so workloads in them
+// can be reverted or removed during cluster and add-on upgrades.
+type HiveNamespacesCheck struct {
+	check.BaseCheck
+
+	// workloadTypes are the workload resource types looked up in Hive-managed namespaces.
+	workloadTypes []resources.ResourceType
+}
+
+// NewHiveNamespacesCheck creates a new Hive-managed namespaces check.
+func NewHiveNamespacesCheck() *HiveNamespacesCheck {
+	workloadTypes := []resources.ResourceType{
+		resources.Notebook,
+		resources.InferenceService,
+		resources.RayCluster,
+		resources.LlamaStackDistribution,
+	}
+
+	return &HiveNamespacesCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:          check.GroupService,
+			Kind:                kind,
+			Type:                "hive-namespaces",
+			CheckID:             "services.managed-service.hive-namespaces",
+			CheckName:           "Services :: Managed Service :: Hive-managed Namespaces",
+			CheckDescription:    "Reports OpenShift AI workloads in namespaces managed by Hive on the managed cloud service, which can be reverted or removed during upgrades",
+			CheckRemediation:    "Move the listed workloads to user-created namespaces (e.g. Data Science Projects created from the dashboard) before upgrading",
+			CheckResources:      append([]resources.ResourceType{resources.Namespace}, workloadTypes...),
+			CheckFlavors:        []version.Flavor{version.FlavorManaged},
+			CheckKnowledgeLinks: []string{check.DocsInstallingCloudService},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "Namespaces labelled hive.openshift.io/managed=true and the Notebooks, InferenceServices, RayClusters and LlamaStackDistributions in them. The check only runs on the managed cloud service (OSD and ROSA).",
+				Rationale:      "Hive reconciles the namespaces it manages from SyncSets. During cluster and add-on upgrades it can revert or recreate them, taking the workloads placed there with it.",
+				FailingExample: hiveNamespacesFailingExample,
+				PassingExample: hiveNamespacesPassingExample,
+				RemediationCommands: []string{
+					"kubectl get namespaces -l hive.openshift.io/managed=true",
+					"kubectl odh backup --help",
+				},
+			},
+		},
+		workloadTypes: workloadTypes,
+	}
+}
+
+// CanApply returns whether this check should run for the given target.
+// The flavor gate restricts it to the managed cloud service, where it always applies.
+func (c *HiveNamespacesCheck) CanApply(_ context.Context, _ check.Target) (bool, error) {
+	return true, nil
+}
+
+// Validate executes the check against the provided target.
+func (c *HiveNamespacesCheck) Validate(ctx context.Context, target check.Target) (*result.DiagnosticResult, error) {
+	dr := c.NewResult()
+
+	namespaces, err := target.Client.ListMetadata(ctx, resources.Namespace, client.WithLabelSelector(hiveManagedLabel))
+	if err != nil {
+		return nil, fmt.Errorf("listing Hive-managed namespaces: %w", err)
+	}
+
+	managed := make(map[string]bool, len(namespaces))
+	for _, ns := range namespaces {
+		managed[ns.GetName()] = true
+	}
+
+	for _, rt := range c.workloadTypes {
+		if len(managed) == 0 {
+			break
+		}
+
+		objects, err := target.Client.ListMetadata(ctx, rt)
+		if err != nil {
+			if client.IsResourceTypeNotFound(err) {
+				continue
+			}
+
+			return nil, fmt.Errorf("listing %s: %w", rt.Kind, err)
+		}
+
+		for _, obj := range objects {
+			if !managed[obj.GetNamespace()] {
+				continue
+			}
+
+			dr.ImpactedObjects = append(dr.ImpactedObjects, metav1.PartialObjectMetadata{
+				TypeMeta: rt.TypeMeta(),
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: obj.GetNamespace(),
+					Name:      obj.GetName(),
+				},
+			})
+		}
+	}
+
+	dr.Annotations[check.AnnotationImpactedWorkloadCount] = strconv.Itoa(len(dr.ImpactedObjects))
+
+	if len(dr.ImpactedObjects) == 0 {
+		dr.SetCondition(check.NewCondition(
+			check.ConditionTypeCompatible,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonRequirementsMet),
+			check.WithMessage("No OpenShift AI workloads found in %d Hive-managed namespace(s)", len(managed)),
+		))
+
+		return dr, nil
+	}
+
+	dr.SetCondition(check.NewCondition(
+		check.ConditionTypeCompatible,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonWorkloadsImpacted),
+		check.WithMessage("Found %d OpenShift AI workload(s) in Hive-managed namespaces (%s), which can be reverted or removed during upgrades",
+			len(dr.ImpactedObjects), strings.Join(impactedNamespaces(dr.ImpactedObjects), ", ")),
+		check.WithImpact(result.ImpactAdvisory),
+		check.WithRemediation(c.CheckRemediation),
+	))
+
+	return dr, nil
+}
+
+// impactedNamespaces returns the distinct namespaces of objects, in order of first occurrence.
+func impactedNamespaces(objects []metav1.PartialObjectMetadata) []string {
+	seen := make(map[string]bool)
+
+	var namespaces []string
+
+	for _, obj := range objects {
+		if !seen[obj.Namespace] {
+			seen[obj.Namespace] = true
+			namespaces = append(namespaces, obj.Namespace)
+		}
+	}
+
+	return namespaces
+}
@@ -0,0 +1,170 @@
+package managedservice_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/services/managedservice"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+//nolint:gochecknoglobals // Test fixture - shared across test functions
+var listKinds = map[schema.GroupVersionResource]string{
+	resources.Secret.GVR():           resources.Secret.ListKind(),
+	resources.Namespace.GVR():        resources.Namespace.ListKind(),
+	resources.Notebook.GVR():         resources.Notebook.ListKind(),
+	resources.InferenceService.GVR(): resources.InferenceService.ListKind(),
+}
+
+func newParametersSecret(data map[string]any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.Secret.APIVersion(),
+			"kind":       resources.Secret.Kind,
+			"metadata": map[string]any{
+				"name":      version.AddonParametersSecretName,
+				"namespace": version.AddonNamespace,
+			},
+			"data": data,
+		},
+	}
+}
+
+func newNamespace(name string, labels map[string]any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.Namespace.APIVersion(),
+			"kind":       resources.Namespace.Kind,
+			"metadata": map[string]any{
+				"name":   name,
+				"labels": labels,
+			},
+		},
+	}
+}
+
+func newWorkload(rt resources.ResourceType, namespace string, name string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": rt.APIVersion(),
+			"kind":       rt.Kind,
+			"metadata": map[string]any{
+				"name":      name,
+				"namespace": namespace,
+			},
+		},
+	}
+}
+
+func TestAddonParametersCheck(t *testing.T) {
+	testCases := []struct {
+		name           string
+		objects        []*unstructured.Unstructured
+		expectedStatus metav1.ConditionStatus
+		expectedReason string
+	}{
+		{
+			name:           "secret missing",
+			expectedStatus: metav1.ConditionFalse,
+			expectedReason: check.ReasonResourceNotFound,
+		},
+		{
+			name:           "notification email not set",
+			objects:        []*unstructured.Unstructured{newParametersSecret(map[string]any{"notification-email": ""})},
+			expectedStatus: metav1.ConditionFalse,
+			expectedReason: check.ReasonConfigurationInvalid,
+		},
+		{
+			name:           "notification email set",
+			objects:        []*unstructured.Unstructured{newParametersSecret(map[string]any{"notification-email": "b3BzQGV4YW1wbGUuY29t"})},
+			expectedStatus: metav1.ConditionTrue,
+			expectedReason: check.ReasonConfigurationValid,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			target := testutil.NewTarget(t, testutil.TargetConfig{
+				ListKinds: listKinds,
+				Objects:   tc.objects,
+			})
+
+			result, err := managedservice.NewAddonParametersCheck().Validate(t.Context(), target)
+
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(result.Status.Conditions).To(HaveLen(1))
+			g.Expect(result.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(check.ConditionTypeConfigured),
+				"Status": Equal(tc.expectedStatus),
+				"Reason": Equal(tc.expectedReason),
+			}))
+		})
+	}
+}
+
+func TestHiveNamespacesCheck_WorkloadsInManagedNamespace(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: listKinds,
+		Objects: []*unstructured.Unstructured{
+			newNamespace("openshift-managed", map[string]any{"hive.openshift.io/managed": "true"}),
+			newNamespace("team-a", nil),
+			newWorkload(resources.Notebook, "openshift-managed", "wb"),
+			newWorkload(resources.Notebook, "team-a", "wb"),
+			newWorkload(resources.InferenceService, "team-a", "model"),
+		},
+	})
+
+	result, err := managedservice.NewHiveNamespacesCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Status.Conditions).To(HaveLen(1))
+	g.Expect(result.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":    Equal(check.ConditionTypeCompatible),
+		"Status":  Equal(metav1.ConditionFalse),
+		"Reason":  Equal(check.ReasonWorkloadsImpacted),
+		"Message": ContainSubstring("openshift-managed"),
+	}))
+	g.Expect(result.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactAdvisory))
+	g.Expect(result.ImpactedObjects).To(HaveLen(1))
+	g.Expect(result.ImpactedObjects[0].Name).To(Equal("wb"))
+	g.Expect(result.ImpactedObjects[0].Namespace).To(Equal("openshift-managed"))
+}
+
+func TestHiveNamespacesCheck_NoManagedNamespaces(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: listKinds,
+		Objects: []*unstructured.Unstructured{
+			newNamespace("team-a", nil),
+			newWorkload(resources.Notebook, "team-a", "wb"),
+		},
+	})
+
+	result, err := managedservice.NewHiveNamespacesCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Status.Conditions[0].Status).To(Equal(metav1.ConditionTrue))
+	g.Expect(result.ImpactedObjects).To(BeEmpty())
+}
+
+func TestManagedServiceChecks_FlavorGate(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(managedservice.NewAddonParametersCheck().Flavors()).To(ConsistOf(version.FlavorManaged))
+	g.Expect(managedservice.NewHiveNamespacesCheck().Flavors()).To(ConsistOf(version.FlavorManaged))
+}
@@ -0,0 +1,121 @@
+package monitoring
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// RenamedMetric is a metric exported on 2.x that is renamed or removed on 3.x.
+type RenamedMetric struct {
+	// Old is the 2.x metric name.
+	Old string
+
+	// New is the 3.x metric to use instead, empty when there is no replacement.
+	New string
+
+	// Note explains what changes beyond the name, e.g. the unit.
+	Note string
+}
+
+// String renders the mapping as "old -> new (note)".
+func (m RenamedMetric) String() string {
+	replacement := m.New
+	if replacement == "" {
+		replacement = "(removed)"
+	}
+
+	if m.Note != "" {
+		return m.Old + " -> " + replacement + " (" + m.Note + ")"
+	}
+
+	return m.Old + " -> " + replacement
+}
+
+// renamedMetrics are the 2.x metrics that dashboards and alerts commonly query and that are
+// no longer exported on 3.x.
+//
+//nolint:gochecknoglobals // Fixed mapping table
+var renamedMetrics = []RenamedMetric{
+	// Serverless InferenceServices were measured by the Knative queue-proxy; RawDeployment
+	// predictors export the model server metrics instead.
+	{Old: "revision_app_request_count", New: "request_predict_seconds_count"},
+	{Old: "revision_app_request_latencies", New: "request_predict_seconds", Note: "milliseconds to seconds"},
+	{Old: "revision_request_count", New: "request_predict_seconds_count"},
+	{Old: "revision_request_latencies", New: "request_predict_seconds", Note: "milliseconds to seconds"},
+
+	// ModelMesh is removed on 3.x.
+	{Old: "modelmesh_api_request_milliseconds", New: "request_predict_seconds", Note: "milliseconds to seconds"},
+	{Old: "modelmesh_invoke_model_milliseconds", New: "request_predict_seconds", Note: "milliseconds to seconds"},
+	{Old: "modelmesh_models_loaded_total"},
+	{Old: "modelmesh_instance_models_total"},
+
+	// The 2.x OpenShift AI monitoring stack is replaced on 3.x.
+	{Old: "rhods_total_users"},
+	{Old: "rhods_active_users"},
+	{Old: "rhods_aggregate_availability"},
+}
+
+// metricNamePattern matches the identifiers of a PromQL expression or dashboard definition.
+var metricNamePattern = regexp.MustCompile(`[A-Za-z_:][A-Za-z0-9_:]*`)
+
+// histogramSuffixes are appended to histogram and summary metric names by Prometheus.
+//
+//nolint:gochecknoglobals // Fixed suffix list
+var histogramSuffixes = []string{"_bucket", "_sum", "_count"}
+
+// RenamedMetrics returns the table of 2.x metrics that are renamed or removed on 3.x.
+func RenamedMetrics() []RenamedMetric {
+	return slices.Clone(renamedMetrics)
+}
+
+// FindRenamedMetrics returns the renamed metrics text queries, in table order. Histogram
+// series such as <name>_bucket match their metric.
+func FindRenamedMetrics(text string) []RenamedMetric {
+	used := make(map[string]bool)
+
+	for _, token := range metricNamePattern.FindAllString(text, -1) {
+		used[token] = true
+
+		for _, suffix := range histogramSuffixes {
+			if name, ok := strings.CutSuffix(token, suffix); ok {
+				used[name] = true
+			}
+		}
+	}
+
+	var found []RenamedMetric
+
+	for _, m := range renamedMetrics {
+		if used[m.Old] {
+			found = append(found, m)
+		}
+	}
+
+	return found
+}
+
+// mergeMetrics appends the metrics of add missing from metrics, keeping table order.
+func mergeMetrics(metrics []RenamedMetric, add []RenamedMetric) []RenamedMetric {
+	for _, m := range add {
+		if !slices.Contains(metrics, m) {
+			metrics = append(metrics, m)
+		}
+	}
+
+	slices.SortStableFunc(metrics, func(a, b RenamedMetric) int {
+		return slices.Index(renamedMetrics, a) - slices.Index(renamedMetrics, b)
+	})
+
+	return metrics
+}
+
+// formatMetrics renders metrics as a "; "-separated mapping table.
+func formatMetrics(metrics []RenamedMetric) string {
+	rows := make([]string, 0, len(metrics))
+	for _, m := range metrics {
+		rows = append(rows, m.String())
+	}
+
+	return strings.Join(rows, "; ")
+}
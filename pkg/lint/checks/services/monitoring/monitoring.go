@@ -0,0 +1,449 @@
+package monitoring
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/opendatahub-io/odh-cli/pkg/constants"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+const (
+	kind = "monitoring"
+
+	// ConditionTypeMonitoringStackCompatible indicates whether the DSCInitialization monitoring
+	// configuration carries over to the 3.x observability stack.
+	ConditionTypeMonitoringStackCompatible = "MonitoringStackCompatible"
+
+	// ConditionTypeUserWorkloadMonitoringEnabled indicates whether OpenShift user workload
+	// monitoring is enabled.
+	ConditionTypeUserWorkloadMonitoringEnabled = "UserWorkloadMonitoringEnabled"
+
+	// ConditionTypeMetricsCompatible indicates whether alerts and dashboards query metrics
+	// that are still exported on 3.x.
+	ConditionTypeMetricsCompatible = "MetricsCompatible"
+
+	// AnnotationRenamedMetrics lists the renamed metrics an impacted alert rule or dashboard
+	// queries, with their 3.x replacement.
+	AnnotationRenamedMetrics = "monitoring.opendatahub.io/renamed-metrics"
+
+	// AnnotationAlerts lists the alerts of an impacted PrometheusRule that stop firing.
+	AnnotationAlerts = "monitoring.opendatahub.io/alerts"
+
+	// clusterMonitoringNamespace and clusterMonitoringConfigMap hold the OpenShift cluster
+	// monitoring configuration, which enables user workload monitoring.
+	clusterMonitoringNamespace = "openshift-monitoring"
+	clusterMonitoringConfigMap = "cluster-monitoring-config"
+
+	// consoleDashboardLabel marks ConfigMaps holding OpenShift console monitoring dashboards.
+	consoleDashboardLabel = "console.openshift.io/dashboard=true"
+)
+
+// clusterMonitoringConfig is the part of the cluster monitoring config.yaml the check reads.
+type clusterMonitoringConfig struct {
+	EnableUserWorkload bool `json:"enableUserWorkload"`
+}
+
+// Examples rendered by 'lint explain'.
+const (
+	migrationReadinessFailingExample = `apiVersion: monitoring.coreos.com/v1
+kind: PrometheusRule
+metadata:
+  name: serving-alerts
+  namespace: fraud-detection
+spec:
+  groups:
+  - name: serving
+    rules:
+    - alert: NoModelTraffic
+      expr: sum(rate(revision_app_request_count[5m])) == 0`
+
+	migrationReadinessPassingExample = `apiVersion: monitoring.coreos.com/v1
+kind: PrometheusRule
+metadata:
+  name: serving-alerts
+  namespace: fraud-detection
+spec:
+  groups:
+  - name: serving
+    rules:
+    - alert: NoModelTraffic
+      expr: sum(rate(request_predict_seconds_count[5m])) == 0`
+)
+
+// MigrationReadinessCheck reports monitoring configuration that does not carry over to the
+// 3.x observability stack: the 2.x monitoring stack managed through DSCInitialization, user
+// workload monitoring being disabled, and alert rules and dashboards querying 2.x metric
+// names. Alerts on renamed metrics do not fail after the upgrade; they silently stop firing.
+type MigrationReadinessCheck struct {
+	check.BaseCheck
+}
+
+// NewMigrationReadinessCheck creates a new monitoring migration readiness check.
+func NewMigrationReadinessCheck() *MigrationReadinessCheck {
+	return &MigrationReadinessCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupService,
+			Kind:             kind,
+			Type:             "migration-readiness",
+			CheckID:          "services.monitoring.migration-readiness",
+			CheckName:        "Services :: Monitoring :: Migration Readiness (3.x)",
+			CheckDescription: "Reports monitoring configuration, alert rules and dashboards that do not carry over to the 3.x observability stack",
+			CheckRemediation: "Update the listed alert rules and dashboards to the 3.x metric names shown in the renamed-metrics annotation, and enable user workload monitoring before upgrading",
+			CheckResources: []resources.ResourceType{
+				resources.DSCInitialization,
+				resources.ConfigMap,
+				resources.PrometheusRule,
+				resources.GrafanaDashboard,
+			},
+			CheckVersionGate:    check.VersionGateUpgrade2xTo3x,
+			CheckKnowledgeLinks: []string{check.DocsManaging},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "The DSCInitialization monitoring stack, the enableUserWorkload setting of the cluster monitoring config, and the PrometheusRules, console dashboard ConfigMaps and GrafanaDashboards querying metrics renamed in 3.x. The check only runs when upgrading from 2.x to 3.x.",
+				Rationale:      "RHOAI 3.x replaces the 2.x monitoring stack with user workload monitoring and renames serving metrics. Alerts on the old names do not fail; they silently stop firing, and dashboards go blank.",
+				FailingExample: migrationReadinessFailingExample,
+				PassingExample: migrationReadinessPassingExample,
+				RemediationCommands: []string{
+					"kubectl odh lint --target-version 3.0 --checks services.monitoring.migration-readiness --verbose",
+					"kubectl get configmap cluster-monitoring-config -n openshift-monitoring -o jsonpath='{.data.config\\.yaml}'",
+				},
+			},
+		},
+	}
+}
+
+// CanApply returns whether this check should run for the given target.
+// This check only applies when upgrading FROM 2.x TO 3.x.
+func (c *MigrationReadinessCheck) CanApply(_ context.Context, target check.Target) (bool, error) {
+	return version.IsUpgradeFrom2xTo3x(target.CurrentVersion, target.TargetVersion), nil
+}
+
+// Validate executes the check against the provided target.
+func (c *MigrationReadinessCheck) Validate(ctx context.Context, target check.Target) (*result.DiagnosticResult, error) {
+	return validate.DSCI(c, target).Run(ctx, func(dr *result.DiagnosticResult, dsci *unstructured.Unstructured) error {
+		monitoringNamespace, err := c.validateMonitoringStack(dr, dsci)
+		if err != nil {
+			return err
+		}
+
+		if err := c.validateUserWorkloadMonitoring(ctx, target, dr); err != nil {
+			return err
+		}
+
+		return c.validateMetrics(ctx, target, dr, monitoringNamespace)
+	})
+}
+
+// validateMonitoringStack reports the 2.x monitoring stack managed through the DSCInitialization
+// and returns its namespace, whose rules are owned by the operator.
+func (c *MigrationReadinessCheck) validateMonitoringStack(
+	dr *result.DiagnosticResult,
+	dsci *unstructured.Unstructured,
+) (string, error) {
+	managementState, err := jq.Query[string](dsci, ".spec.monitoring.managementState")
+	if err != nil && !errors.Is(err, jq.ErrNotFound) {
+		return "", fmt.Errorf("querying monitoring managementState: %w", err)
+	}
+
+	namespace, err := jq.Query[string](dsci, ".spec.monitoring.namespace")
+	if err != nil && !errors.Is(err, jq.ErrNotFound) {
+		return "", fmt.Errorf("querying monitoring namespace: %w", err)
+	}
+
+	if managementState != constants.ManagementStateManaged {
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeMonitoringStackCompatible,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonConfigurationValid),
+			check.WithMessage("The OpenShift AI monitoring stack is not managed (state: %s)", stateOrUnset(managementState)),
+		))
+
+		return namespace, nil
+	}
+
+	dr.SetCondition(check.NewCondition(
+		ConditionTypeMonitoringStackCompatible,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonDeprecated),
+		check.WithMessage("The 2.x OpenShift AI monitoring stack in namespace %s is replaced by the 3.x observability stack: "+
+			"rules, scrape configurations and Alertmanager receivers added to it are not carried over", namespace),
+		check.WithImpact(result.ImpactAdvisory),
+		check.WithRemediation("Recreate custom rules as PrometheusRules in user namespaces and custom receivers in the cluster Alertmanager before upgrading"),
+	))
+
+	return namespace, nil
+}
+
+// validateUserWorkloadMonitoring reports whether OpenShift user workload monitoring, which
+// collects the 3.x model serving and workload metrics, is enabled.
+func (c *MigrationReadinessCheck) validateUserWorkloadMonitoring(
+	ctx context.Context,
+	target check.Target,
+	dr *result.DiagnosticResult,
+) error {
+	enabled, err := userWorkloadMonitoringEnabled(ctx, target.Client)
+	if err != nil {
+		return err
+	}
+
+	if enabled {
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeUserWorkloadMonitoringEnabled,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonConfigurationValid),
+			check.WithMessage("User workload monitoring is enabled"),
+		))
+
+		return nil
+	}
+
+	dr.SetCondition(check.NewCondition(
+		ConditionTypeUserWorkloadMonitoringEnabled,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonConfigurationInvalid),
+		check.WithMessage("User workload monitoring is not enabled: 3.x model serving and workload metrics are not collected and alerts on them do not fire"),
+		check.WithImpact(result.ImpactAdvisory),
+		check.WithRemediation(fmt.Sprintf("Set enableUserWorkload: true in the config.yaml of ConfigMap %s/%s",
+			clusterMonitoringNamespace, clusterMonitoringConfigMap)),
+	))
+
+	return nil
+}
+
+// userWorkloadMonitoringEnabled reads enableUserWorkload from the cluster monitoring config.
+// A missing or unreadable ConfigMap means the default: disabled.
+func userWorkloadMonitoringEnabled(ctx context.Context, r client.Reader) (bool, error) {
+	cm, err := r.GetResource(ctx, resources.ConfigMap, clusterMonitoringConfigMap, client.InNamespace(clusterMonitoringNamespace))
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("getting ConfigMap %s/%s: %w", clusterMonitoringNamespace, clusterMonitoringConfigMap, err)
+	}
+
+	if cm == nil {
+		return false, nil
+	}
+
+	data, _, _ := unstructured.NestedString(cm.Object, "data", "config.yaml")
+
+	var config clusterMonitoringConfig
+	if err := yaml.Unmarshal([]byte(data), &config); err != nil {
+		return false, fmt.Errorf("parsing ConfigMap %s/%s: %w", clusterMonitoringNamespace, clusterMonitoringConfigMap, err)
+	}
+
+	return config.EnableUserWorkload, nil
+}
+
+// validateMetrics reports the alert rules and dashboards querying renamed 2.x metrics. The
+// rules of the operator-managed monitoring namespace are replaced by the upgrade and skipped.
+func (c *MigrationReadinessCheck) validateMetrics(
+	ctx context.Context,
+	target check.Target,
+	dr *result.DiagnosticResult,
+	monitoringNamespace string,
+) error {
+	var (
+		used   []RenamedMetric
+		alerts []string
+	)
+
+	rules, err := target.Client.List(ctx, resources.PrometheusRule)
+	if err != nil && !client.IsResourceTypeNotFound(err) {
+		return fmt.Errorf("listing PrometheusRules: %w", err)
+	}
+
+	for _, rule := range rules {
+		if monitoringNamespace != "" && rule.GetNamespace() == monitoringNamespace {
+			continue
+		}
+
+		metrics, ruleAlerts := ruleMetrics(rule)
+		if len(metrics) == 0 {
+			continue
+		}
+
+		annotations := map[string]string{AnnotationRenamedMetrics: formatMetrics(metrics)}
+		if len(ruleAlerts) > 0 {
+			annotations[AnnotationAlerts] = strings.Join(ruleAlerts, ", ")
+		}
+
+		c.addImpacted(dr, resources.PrometheusRule, rule, annotations)
+
+		used = mergeMetrics(used, metrics)
+		alerts = append(alerts, ruleAlerts...)
+	}
+
+	dashboards, err := c.dashboardMetrics(ctx, target.Client)
+	if err != nil {
+		return err
+	}
+
+	for _, d := range dashboards {
+		c.addImpacted(dr, d.resource, d.obj, map[string]string{AnnotationRenamedMetrics: formatMetrics(d.metrics)})
+
+		used = mergeMetrics(used, d.metrics)
+	}
+
+	dr.Annotations[check.AnnotationImpactedWorkloadCount] = strconv.Itoa(len(dr.ImpactedObjects))
+
+	if len(used) == 0 {
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeMetricsCompatible,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonRequirementsMet),
+			check.WithMessage("No alert rules or dashboards query metrics renamed in 3.x"),
+		))
+
+		return nil
+	}
+
+	dr.Annotations[AnnotationRenamedMetrics] = formatMetrics(used)
+
+	message := fmt.Sprintf("Found %d alert rule(s) and dashboard(s) querying 2.x metric names; renamed metrics: %s",
+		len(dr.ImpactedObjects), formatMetrics(used))
+	if len(alerts) > 0 {
+		message = fmt.Sprintf("%d alert(s) will silently stop firing after the upgrade (%s). %s",
+			len(alerts), strings.Join(alerts, ", "), message)
+	}
+
+	dr.SetCondition(check.NewCondition(
+		ConditionTypeMetricsCompatible,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonWorkloadsImpacted),
+		check.WithMessage("%s", message),
+		check.WithImpact(result.ImpactAdvisory),
+		check.WithRemediation(c.CheckRemediation),
+	))
+
+	return nil
+}
+
+func (c *MigrationReadinessCheck) addImpacted(
+	dr *result.DiagnosticResult,
+	rt resources.ResourceType,
+	obj *unstructured.Unstructured,
+	annotations map[string]string,
+) {
+	dr.ImpactedObjects = append(dr.ImpactedObjects, metav1.PartialObjectMetadata{
+		TypeMeta: rt.TypeMeta(),
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   obj.GetNamespace(),
+			Name:        obj.GetName(),
+			Annotations: annotations,
+		},
+	})
+}
+
+// ruleMetrics returns the renamed metrics the rules of a PrometheusRule query, and the names
+// of its alerts that query them.
+func ruleMetrics(obj *unstructured.Unstructured) ([]RenamedMetric, []string) {
+	var (
+		metrics []RenamedMetric
+		alerts  []string
+	)
+
+	groups, _, _ := unstructured.NestedSlice(obj.Object, "spec", "groups")
+
+	for _, g := range groups {
+		group, ok := g.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		rules, _, _ := unstructured.NestedSlice(group, "rules")
+
+		for _, r := range rules {
+			rule, ok := r.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			expr, _, _ := unstructured.NestedFieldNoCopy(rule, "expr")
+
+			found := FindRenamedMetrics(fmt.Sprint(expr))
+			if len(found) == 0 {
+				continue
+			}
+
+			metrics = mergeMetrics(metrics, found)
+
+			if alert, _, _ := unstructured.NestedString(rule, "alert"); alert != "" && !slices.Contains(alerts, alert) {
+				alerts = append(alerts, alert)
+			}
+		}
+	}
+
+	return metrics, alerts
+}
+
+// dashboard is a dashboard querying renamed metrics.
+type dashboard struct {
+	resource resources.ResourceType
+	obj      *unstructured.Unstructured
+	metrics  []RenamedMetric
+}
+
+// dashboardMetrics returns the OpenShift console dashboards and GrafanaDashboards querying
+// renamed metrics.
+func (c *MigrationReadinessCheck) dashboardMetrics(ctx context.Context, r client.Reader) ([]dashboard, error) {
+	var dashboards []dashboard
+
+	consoleDashboards, err := r.List(ctx, resources.ConfigMap, client.WithLabelSelector(consoleDashboardLabel))
+	if err != nil {
+		return nil, fmt.Errorf("listing console dashboards: %w", err)
+	}
+
+	for _, cm := range consoleDashboards {
+		data, _, _ := unstructured.NestedStringMap(cm.Object, "data")
+
+		var text strings.Builder
+		for _, value := range data {
+			text.WriteString(value)
+			text.WriteString("\n")
+		}
+
+		if metrics := FindRenamedMetrics(text.String()); len(metrics) > 0 {
+			dashboards = append(dashboards, dashboard{resource: resources.ConfigMap, obj: cm, metrics: metrics})
+		}
+	}
+
+	grafanaDashboards, err := r.List(ctx, resources.GrafanaDashboard)
+	if err != nil && !client.IsResourceTypeNotFound(err) {
+		return nil, fmt.Errorf("listing GrafanaDashboards: %w", err)
+	}
+
+	for _, gd := range grafanaDashboards {
+		definition, _, _ := unstructured.NestedString(gd.Object, "spec", "json")
+
+		if metrics := FindRenamedMetrics(definition); len(metrics) > 0 {
+			dashboards = append(dashboards, dashboard{resource: resources.GrafanaDashboard, obj: gd, metrics: metrics})
+		}
+	}
+
+	return dashboards, nil
+}
+
+func stateOrUnset(state string) string {
+	if state == "" {
+		return "unset"
+	}
+
+	return state
+}
@@ -0,0 +1,202 @@
+package monitoring_test
+
+import (
+	"testing"
+
+	"github.com/blang/semver/v4"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/services/monitoring"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+const monitoringNamespace = "redhat-ods-monitoring"
+
+//nolint:gochecknoglobals // Test fixture - shared across test functions
+var listKinds = map[schema.GroupVersionResource]string{
+	resources.DSCInitialization.GVR(): resources.DSCInitialization.ListKind(),
+	resources.ConfigMap.GVR():         resources.ConfigMap.ListKind(),
+	resources.PrometheusRule.GVR():    resources.PrometheusRule.ListKind(),
+	resources.GrafanaDashboard.GVR():  resources.GrafanaDashboard.ListKind(),
+}
+
+func newDSCI(monitoringState string) *unstructured.Unstructured {
+	dsci := testutil.NewDSCI("redhat-ods-applications")
+	_ = unstructured.SetNestedMap(dsci.Object, map[string]any{
+		"managementState": monitoringState,
+		"namespace":       monitoringNamespace,
+	}, "spec", "monitoring")
+
+	return dsci
+}
+
+func newClusterMonitoringConfig(config string) *unstructured.Unstructured {
+	cm := resources.ConfigMap.Unstructured()
+	cm.SetNamespace("openshift-monitoring")
+	cm.SetName("cluster-monitoring-config")
+	cm.Object["data"] = map[string]any{"config.yaml": config}
+
+	return &cm
+}
+
+func newPrometheusRule(namespace string, name string, rules ...map[string]any) *unstructured.Unstructured {
+	items := make([]any, 0, len(rules))
+	for _, r := range rules {
+		items = append(items, r)
+	}
+
+	obj := resources.PrometheusRule.Unstructured()
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	obj.Object["spec"] = map[string]any{
+		"groups": []any{map[string]any{"name": "serving", "rules": items}},
+	}
+
+	return &obj
+}
+
+func newConsoleDashboard(name string, definition string) *unstructured.Unstructured {
+	cm := resources.ConfigMap.Unstructured()
+	cm.SetNamespace("openshift-config-managed")
+	cm.SetName(name)
+	cm.SetLabels(map[string]string{"console.openshift.io/dashboard": "true"})
+	cm.Object["data"] = map[string]any{"dashboard.json": definition}
+
+	return &cm
+}
+
+func newGrafanaDashboard(name string, definition string) *unstructured.Unstructured {
+	obj := resources.GrafanaDashboard.Unstructured()
+	obj.SetNamespace("grafana")
+	obj.SetName(name)
+	obj.Object["spec"] = map[string]any{"json": definition}
+
+	return &obj
+}
+
+func TestMigrationReadinessCheck_CanApply(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := monitoring.NewMigrationReadinessCheck()
+	current := semver.MustParse("2.25.0")
+	next := semver.MustParse("3.0.0")
+	patch := semver.MustParse("2.25.1")
+
+	canApply, err := chk.CanApply(t.Context(), check.Target{CurrentVersion: &current, TargetVersion: &next})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeTrue())
+
+	canApply, err = chk.CanApply(t.Context(), check.Target{CurrentVersion: &current, TargetVersion: &patch})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeFalse())
+}
+
+func TestMigrationReadinessCheck_Ready(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: listKinds,
+		Objects: []*unstructured.Unstructured{
+			newDSCI("Removed"),
+			newClusterMonitoringConfig("enableUserWorkload: true\n"),
+			newPrometheusRule("project", "latency", map[string]any{
+				"alert": "HighLatency",
+				"expr":  "histogram_quantile(0.99, rate(request_predict_seconds_bucket[5m])) > 1",
+			}),
+		},
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	result, err := monitoring.NewMigrationReadinessCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.ImpactedObjects).To(BeEmpty())
+	g.Expect(result.Status.Conditions).To(HaveEach(HaveField("Condition.Status", metav1.ConditionTrue)))
+	g.Expect(result.Status.Conditions).To(HaveLen(3))
+}
+
+func TestMigrationReadinessCheck_RenamedMetrics(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: listKinds,
+		Objects: []*unstructured.Unstructured{
+			newDSCI("Managed"),
+			newPrometheusRule("project", "serving-alerts",
+				map[string]any{
+					"alert": "ModelErrors",
+					"expr":  `sum(rate(revision_app_request_count{response_code_class="5xx"}[5m])) > 0`,
+				},
+				map[string]any{
+					"record": "model:latency:p99",
+					"expr":   "histogram_quantile(0.99, rate(revision_app_request_latencies_bucket[5m]))",
+				},
+			),
+			// Rules of the operator-managed monitoring namespace are replaced by the upgrade
+			newPrometheusRule(monitoringNamespace, "rhods-rules", map[string]any{
+				"alert": "RHODSDown",
+				"expr":  "rhods_aggregate_availability < 1",
+			}),
+			newConsoleDashboard("model-serving", `{"targets":[{"expr":"modelmesh_api_request_milliseconds_count"}]}`),
+			newGrafanaDashboard("users", `{"panels":[{"targets":[{"expr":"rhods_active_users"}]}]}`),
+		},
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	result, err := monitoring.NewMigrationReadinessCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Status.Conditions).To(ContainElements(
+		HaveField("Condition", MatchFields(IgnoreExtras, Fields{
+			"Type":   Equal(monitoring.ConditionTypeMonitoringStackCompatible),
+			"Status": Equal(metav1.ConditionFalse),
+		})),
+		HaveField("Condition", MatchFields(IgnoreExtras, Fields{
+			"Type":    Equal(monitoring.ConditionTypeUserWorkloadMonitoringEnabled),
+			"Status":  Equal(metav1.ConditionFalse),
+			"Message": ContainSubstring("not enabled"),
+		})),
+		HaveField("Condition", MatchFields(IgnoreExtras, Fields{
+			"Type":    Equal(monitoring.ConditionTypeMetricsCompatible),
+			"Status":  Equal(metav1.ConditionFalse),
+			"Reason":  Equal(check.ReasonWorkloadsImpacted),
+			"Message": ContainSubstring("1 alert(s) will silently stop firing after the upgrade (ModelErrors)"),
+		})),
+	))
+
+	g.Expect(result.ImpactedObjects).To(HaveLen(3))
+	g.Expect(result.ImpactedObjects[0].Name).To(Equal("serving-alerts"))
+	g.Expect(result.ImpactedObjects[0].Annotations).To(Equal(map[string]string{
+		monitoring.AnnotationRenamedMetrics: "revision_app_request_count -> request_predict_seconds_count; " +
+			"revision_app_request_latencies -> request_predict_seconds (milliseconds to seconds)",
+		monitoring.AnnotationAlerts: "ModelErrors",
+	}))
+	g.Expect(result.ImpactedObjects[1].Kind).To(Equal(resources.ConfigMap.Kind))
+	g.Expect(result.ImpactedObjects[2].Annotations).To(HaveKeyWithValue(
+		monitoring.AnnotationRenamedMetrics, "rhods_active_users -> (removed)"))
+	g.Expect(result.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "3"))
+	g.Expect(result.Annotations).To(HaveKey(monitoring.AnnotationRenamedMetrics))
+}
+
+func TestFindRenamedMetrics(t *testing.T) {
+	g := NewWithT(t)
+
+	found := monitoring.FindRenamedMetrics(
+		`sum(rate(revision_request_latencies_sum[5m])) / sum(rate(revision_request_count[5m])) + rhods_total_users_extra`)
+
+	g.Expect(found).To(HaveExactElements(
+		HaveField("Old", "revision_request_count"),
+		HaveField("Old", "revision_request_latencies"),
+	))
+	g.Expect(monitoring.FindRenamedMetrics("up == 0")).To(BeEmpty())
+}
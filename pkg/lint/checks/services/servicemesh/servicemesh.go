@@ -10,12 +10,32 @@ import (
 
 	"github.com/opendatahub-io/odh-cli/pkg/constants"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/remediation"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
 	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
 	"github.com/opendatahub-io/odh-cli/pkg/util/version"
 )
 
+// Examples rendered by 'lint explain'.
+const (
+	removalFailingExample = `apiVersion: dscinitialization.opendatahub.io/v1
+kind: DSCInitialization
+spec:
+  serviceMesh:
+    managementState: Managed
+    controlPlane:
+      name: data-science-smcp
+      namespace: istio-system`
+
+	removalPassingExample = `apiVersion: dscinitialization.opendatahub.io/v1
+kind: DSCInitialization
+spec:
+  serviceMesh:
+    managementState: Removed`
+)
+
 // RemovalCheck validates that ServiceMesh is disabled before upgrading to 3.x.
 type RemovalCheck struct {
 	check.BaseCheck
@@ -32,6 +52,21 @@ func NewRemovalCheck() *RemovalCheck {
 			CheckName:        "Services :: ServiceMesh :: Removal (3.x)",
 			CheckDescription: "Validates that ServiceMesh is disabled before upgrading from RHOAI 2.x to 3.x (no longer required, OpenShift 4.19+ handles service mesh internally)",
 			CheckRemediation: "Disable ServiceMesh by setting managementState to 'Removed' in DSCInitialization before upgrading",
+			CheckResources: []resources.ResourceType{
+				resources.DSCInitialization,
+			},
+			CheckVersionGate:    check.VersionGateUpgrade2xTo3x,
+			CheckKnowledgeLinks: []string{check.DocsServingModels},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "The .spec.serviceMesh.managementState field of the DSCInitialization. The check only runs when upgrading from 2.x to 3.x.",
+				Rationale:      "RHOAI 3.x no longer configures a Service Mesh 2 control plane: OpenShift 4.19 provides the mesh it needs. The upgrade is blocked while the DSCInitialization still manages the 2.x control plane.",
+				FailingExample: removalFailingExample,
+				PassingExample: removalPassingExample,
+				RemediationCommands: []string{
+					`kubectl patch dscinitialization default-dsci --type merge -p '{"spec":{"serviceMesh":{"managementState":"Removed"}}}'`,
+					"kubectl odh lint --target-version 3.0 --checks services.servicemesh.removal --fix",
+				},
+			},
 		},
 	}
 }
@@ -65,6 +100,10 @@ func (c *RemovalCheck) Validate(ctx context.Context, target check.Target) (*resu
 				check.WithMessage("ServiceMesh is enabled (state: %s) but is no longer required by RHOAI 3.x. OpenShift 4.19+ handles service mesh internally", managementState),
 				check.WithImpact(result.ImpactBlocking),
 				check.WithRemediation(c.CheckRemediation),
+				check.WithRemediationCommands(remediation.SetField(
+					resources.DSCInitialization, "", dsci.GetName(),
+					".spec.serviceMesh.managementState", constants.ManagementStateRemoved,
+				)),
 			))
 		default:
 			dr.SetCondition(check.NewCondition(
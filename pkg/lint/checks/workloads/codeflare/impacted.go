@@ -20,6 +20,18 @@ const kind = "codeflare"
 
 const ConditionTypeAppWrapperCompatible = "AppWrapperCompatible" //nolint:gosec // Not a credential
 
+// Examples rendered by 'lint explain'.
+const (
+	impactedFailingExample = `apiVersion: workload.codeflare.dev/v1beta2
+kind: AppWrapper
+metadata:
+  name: training-batch
+  namespace: team-a`
+
+	impactedPassingExample = `$ kubectl get appwrappers --all-namespaces
+No resources found`
+)
+
 // ImpactedWorkloadsCheck lists AppWrappers that will be impacted when CodeFlare is removed in RHOAI 3.x.
 type ImpactedWorkloadsCheck struct {
 	check.BaseCheck
@@ -35,6 +47,22 @@ func NewImpactedWorkloadsCheck() *ImpactedWorkloadsCheck {
 			CheckName:        "Workloads :: CodeFlare :: Impacted Workloads (3.x)",
 			CheckDescription: "Lists AppWrappers that will be impacted in RHOAI 3.x (CodeFlare not available)",
 			CheckRemediation: "Remove redundant AppWrapper CRs or install the AppWrapper controller separately before upgrading",
+			CheckResources: []resources.ResourceType{
+				resources.DataScienceCluster,
+				resources.AppWrapper,
+			},
+			CheckVersionGate:    check.VersionGateUpgrade2xTo3x,
+			CheckKnowledgeLinks: []string{check.DocsDistributedWorkloads},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "AppWrapper CRs in all namespaces. The check only runs when upgrading from 2.x to 3.x.",
+				Rationale:      "The AppWrapper controller is removed from OpenShift AI together with the CodeFlare operator. Existing AppWrappers stop being reconciled after the upgrade, so the workloads they wrap are neither admitted nor cleaned up.",
+				FailingExample: impactedFailingExample,
+				PassingExample: impactedPassingExample,
+				RemediationCommands: []string{
+					"kubectl get appwrappers --all-namespaces",
+					"kubectl delete appwrapper <name> -n <namespace>",
+				},
+			},
 		},
 	}
 }
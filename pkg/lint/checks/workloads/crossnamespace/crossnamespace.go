@@ -0,0 +1,163 @@
+package crossnamespace
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+const (
+	kind      = "crossnamespace"
+	checkType = "references"
+
+	// ConditionTypeReferencesLocal indicates whether workloads only reference Secrets and ConfigMaps in their own namespace.
+	ConditionTypeReferencesLocal = "ReferencesLocal"
+
+	// AnnotationReferences lists the cross-namespace references of an impacted workload and
+	// the copy mechanism each relied on.
+	AnnotationReferences = "crossnamespace.opendatahub.io/references"
+)
+
+// Examples rendered by 'lint explain'.
+const (
+	referencesFailingExample = `apiVersion: kubeflow.org/v1
+kind: Notebook
+metadata:
+  name: wb
+  namespace: team-a
+  annotations:
+    opendatahub.io/connections: shared-data/s3-credentials`
+
+	referencesPassingExample = `apiVersion: kubeflow.org/v1
+kind: Notebook
+metadata:
+  name: wb
+  namespace: team-a
+  annotations:
+    opendatahub.io/connections: team-a/s3-credentials`
+)
+
+// ReferencesCheck finds workloads referencing Secrets or ConfigMaps in other namespaces.
+// Kubernetes only resolves references within a namespace; 2.x tolerated these patterns by
+// copying the referenced objects into the workload namespace, and those copies stop in 3.x.
+type ReferencesCheck struct {
+	check.BaseCheck
+}
+
+func NewReferencesCheck() *ReferencesCheck {
+	return &ReferencesCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupWorkload,
+			Kind:             kind,
+			Type:             checkType,
+			CheckID:          "workloads.crossnamespace.references",
+			CheckName:        "Workloads :: Cross-Namespace :: Secret/ConfigMap References (3.x)",
+			CheckDescription: "Finds workloads referencing Secrets or ConfigMaps in other namespaces through patterns that 2.x tolerated by copying them, which is no longer done in RHOAI 3.x",
+			CheckRemediation: "Create the referenced Secrets and ConfigMaps in the workload namespace and reference them there before upgrading",
+			CheckResources: []resources.ResourceType{
+				resources.Notebook,
+				resources.InferenceService,
+			},
+			CheckVersionGate:    check.VersionGateUpgrade2xTo3x,
+			CheckKnowledgeLinks: []string{check.DocsDataScienceProjects},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "The opendatahub.io/connections annotation of Notebooks and InferenceServices, looking for connection Secrets qualified with another namespace. The check only runs when upgrading from 2.x to 3.x.",
+				Rationale:      "Kubernetes only resolves Secret and ConfigMap references within a namespace. In 2.x the dashboard copied connection Secrets attached from another project into the workload namespace; 3.x no longer does, so the workload loses the credentials once the copy is gone.",
+				FailingExample: referencesFailingExample,
+				PassingExample: referencesPassingExample,
+				RemediationCommands: []string{
+					"kubectl get secret s3-credentials -n shared-data -o yaml | sed 's/namespace: shared-data/namespace: team-a/' | kubectl apply -f -",
+					"kubectl annotate notebook wb -n team-a --overwrite opendatahub.io/connections=team-a/s3-credentials",
+				},
+			},
+		},
+	}
+}
+
+// CanApply returns whether this check should run for the given target.
+// Only applies when upgrading from 2.x to 3.x.
+func (c *ReferencesCheck) CanApply(_ context.Context, target check.Target) (bool, error) {
+	return version.IsUpgradeFrom2xTo3x(target.CurrentVersion, target.TargetVersion), nil
+}
+
+// Validate executes the check against the provided target.
+func (c *ReferencesCheck) Validate(
+	ctx context.Context,
+	target check.Target,
+) (*result.DiagnosticResult, error) {
+	dr := c.NewResult()
+
+	if target.TargetVersion != nil {
+		dr.Annotations[check.AnnotationCheckTargetVersion] = target.TargetVersion.String()
+	}
+
+	totalRefs := 0
+
+	for _, source := range referenceSources {
+		workloads, err := target.Client.List(ctx, source.resourceType)
+		if err != nil {
+			if client.IsResourceTypeNotFound(err) {
+				continue
+			}
+
+			return nil, fmt.Errorf("listing %s: %w", source.resourceType.Kind, err)
+		}
+
+		for _, w := range workloads {
+			refs := source.extract(w)
+			if len(refs) == 0 {
+				continue
+			}
+
+			totalRefs += len(refs)
+
+			described := make([]string, 0, len(refs))
+			for _, ref := range refs {
+				described = append(described, ref.String())
+			}
+
+			dr.ImpactedObjects = append(dr.ImpactedObjects, metav1.PartialObjectMetadata{
+				TypeMeta: source.resourceType.TypeMeta(),
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   w.GetNamespace(),
+					Name:        w.GetName(),
+					Annotations: map[string]string{AnnotationReferences: strings.Join(described, "; ")},
+				},
+			})
+		}
+	}
+
+	dr.Annotations[check.AnnotationImpactedWorkloadCount] = strconv.Itoa(len(dr.ImpactedObjects))
+	dr.SetCondition(c.newCondition(len(dr.ImpactedObjects), totalRefs))
+
+	return dr, nil
+}
+
+func (c *ReferencesCheck) newCondition(impacted int, refs int) result.Condition {
+	if impacted == 0 {
+		return check.NewCondition(
+			ConditionTypeReferencesLocal,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonRequirementsMet),
+			check.WithMessage("No workloads reference Secrets or ConfigMaps in other namespaces"),
+		)
+	}
+
+	return check.NewCondition(
+		ConditionTypeReferencesLocal,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonWorkloadsImpacted),
+		check.WithMessage("Found %d workload(s) with %d cross-namespace Secret/ConfigMap reference(s) that RHOAI 3.x no longer copies into the workload namespace", impacted, refs),
+		check.WithImpact(result.ImpactAdvisory),
+		check.WithRemediation(c.CheckRemediation),
+	)
+}
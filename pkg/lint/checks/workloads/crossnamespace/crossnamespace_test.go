@@ -0,0 +1,98 @@
+package crossnamespace_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/crossnamespace"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+//nolint:gochecknoglobals
+var listKinds = map[schema.GroupVersionResource]string{
+	resources.Notebook.GVR():         resources.Notebook.ListKind(),
+	resources.InferenceService.GVR(): resources.InferenceService.ListKind(),
+}
+
+func newWorkload(rt resources.ResourceType, name string, connections string) *unstructured.Unstructured {
+	obj := rt.Unstructured()
+	obj.SetNamespace("team-a")
+	obj.SetName(name)
+
+	if connections != "" {
+		obj.SetAnnotations(map[string]string{"opendatahub.io/connections": connections})
+	}
+
+	return &obj
+}
+
+func TestReferencesCheck_NoCrossNamespaceReferences(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: listKinds,
+		Objects: []*unstructured.Unstructured{
+			newWorkload(resources.Notebook, "plain", ""),
+			newWorkload(resources.Notebook, "local", "team-a/aws-conn,s3-conn"),
+		},
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := crossnamespace.NewReferencesCheck().Validate(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.ImpactedObjects).To(BeEmpty())
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":   Equal(crossnamespace.ConditionTypeReferencesLocal),
+		"Status": Equal(metav1.ConditionTrue),
+	}))
+}
+
+func TestReferencesCheck_CrossNamespaceReferences(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: listKinds,
+		Objects: []*unstructured.Unstructured{
+			newWorkload(resources.Notebook, "nb", "team-a/local, shared/aws-conn"),
+			newWorkload(resources.InferenceService, "isvc", "shared/s3-models,platform/minio"),
+		},
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := crossnamespace.NewReferencesCheck().Validate(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Status":  Equal(metav1.ConditionFalse),
+		"Reason":  Equal(check.ReasonWorkloadsImpacted),
+		"Message": ContainSubstring("Found 2 workload(s) with 3 cross-namespace"),
+	}))
+	g.Expect(dr.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "2"))
+
+	g.Expect(dr.ImpactedObjects).To(HaveLen(2))
+	g.Expect(dr.ImpactedObjects[0].Kind).To(Equal("Notebook"))
+	g.Expect(dr.ImpactedObjects[0].Annotations).To(HaveKeyWithValue(crossnamespace.AnnotationReferences,
+		"Secret shared/aws-conn (dashboard connection copy)"))
+	g.Expect(dr.ImpactedObjects[1].Kind).To(Equal("InferenceService"))
+	g.Expect(dr.ImpactedObjects[1].Annotations).To(HaveKeyWithValue(crossnamespace.AnnotationReferences,
+		"Secret shared/s3-models (dashboard connection copy); Secret platform/minio (dashboard connection copy)"))
+}
+
+func TestReferencesCheck_CanApply(t *testing.T) {
+	g := NewWithT(t)
+
+	lint := testutil.NewTarget(t, testutil.TargetConfig{ListKinds: listKinds, CurrentVersion: "3.0.0", TargetVersion: "3.0.0"})
+
+	canApply, err := crossnamespace.NewReferencesCheck().CanApply(t.Context(), lint)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeFalse())
+}
@@ -0,0 +1,72 @@
+package crossnamespace
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+)
+
+const (
+	// annotationConnections lists the data connections attached to a workload as a
+	// comma-separated list of [namespace/]name Secret references.
+	annotationConnections = "opendatahub.io/connections"
+
+	// mechanismConnectionCopy is the 2.x dashboard behaviour of copying connection Secrets
+	// attached from another project into the workload namespace.
+	mechanismConnectionCopy = "dashboard connection copy"
+)
+
+// reference is a Secret or ConfigMap a workload references outside of its namespace.
+type reference struct {
+	kind      string
+	namespace string
+	name      string
+	mechanism string
+}
+
+func (r reference) String() string {
+	return r.kind + " " + r.namespace + "/" + r.name + " (" + r.mechanism + ")"
+}
+
+// referenceSource extracts cross-namespace references from one kind of workload.
+type referenceSource struct {
+	resourceType resources.ResourceType
+	extract      func(obj *unstructured.Unstructured) []reference
+}
+
+// referenceSources are the workload kinds and the patterns through which 2.x tolerated
+// cross-namespace Secret and ConfigMap references.
+//
+//nolint:gochecknoglobals // Fixed set of reference patterns
+var referenceSources = []referenceSource{
+	{resourceType: resources.Notebook, extract: connectionReferences},
+	{resourceType: resources.InferenceService, extract: connectionReferences},
+}
+
+// connectionReferences returns the connection Secrets attached from other namespaces.
+func connectionReferences(obj *unstructured.Unstructured) []reference {
+	raw := obj.GetAnnotations()[annotationConnections]
+	if raw == "" {
+		return nil
+	}
+
+	var refs []reference
+
+	for entry := range strings.SplitSeq(raw, ",") {
+		namespace, name, qualified := strings.Cut(strings.TrimSpace(entry), "/")
+		if !qualified || namespace == "" || name == "" || namespace == obj.GetNamespace() {
+			continue
+		}
+
+		refs = append(refs, reference{
+			kind:      resources.Secret.Kind,
+			namespace: namespace,
+			name:      name,
+			mechanism: mechanismConnectionCopy,
+		})
+	}
+
+	return refs
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/opendatahub-io/odh-cli/pkg/constants"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/remediation"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
 	"github.com/opendatahub-io/odh-cli/pkg/resources"
@@ -26,6 +27,28 @@ const (
 	checkTypeInstructLabRemoval = "instructlab-removal"
 )
 
+// Examples rendered by 'lint explain'.
+const (
+	instructLabFailingExample = `apiVersion: datasciencepipelinesapplications.opendatahub.io/v1
+kind: DataSciencePipelinesApplication
+metadata:
+  name: dspa
+  namespace: team-a
+spec:
+  apiServer:
+    managedPipelines:
+      instructLab:
+        state: Managed`
+
+	instructLabPassingExample = `apiVersion: datasciencepipelinesapplications.opendatahub.io/v1
+kind: DataSciencePipelinesApplication
+metadata:
+  name: dspa
+  namespace: team-a
+spec:
+  apiServer: {}`
+)
+
 type InstructLabRemovalCheck struct {
 	check.BaseCheck
 }
@@ -40,6 +63,22 @@ func NewInstructLabRemovalCheck() *InstructLabRemovalCheck {
 			CheckName:        "Workloads :: DataSciencePipelines :: InstructLab ManagedPipelines Removal (3.x)",
 			CheckDescription: "Validates that DSPA objects do not use the removed InstructLab managedPipelines field before upgrading to RHOAI 3.x",
 			CheckRemediation: "Remove the '.spec.apiServer.managedPipelines.instructLab' field from affected DSPA objects before upgrading",
+			CheckResources: []resources.ResourceType{
+				resources.DataScienceCluster,
+				resources.DataSciencePipelinesApplicationV1,
+				resources.DataSciencePipelinesApplicationV1Alpha1,
+			},
+			CheckVersionGate:    check.VersionGateUpgrade2xTo3x,
+			CheckKnowledgeLinks: []string{check.DocsDataSciencePipelines},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "The .spec.apiServer.managedPipelines.instructLab field of every DataSciencePipelinesApplication, read through v1 with a fallback to v1alpha1. The check only runs when upgrading from 2.x to 3.x with DataSciencePipelines Managed.",
+				Rationale:      "The InstructLab managed pipeline is removed in RHOAI 3.x. The field is no longer part of the DSPA schema, so the pipeline it requested is not deployed and the stale field is pruned on the next update.",
+				FailingExample: instructLabFailingExample,
+				PassingExample: instructLabPassingExample,
+				RemediationCommands: []string{
+					`kubectl patch datasciencepipelinesapplication dspa -n team-a --type json -p '[{"op":"remove","path":"/spec/apiServer/managedPipelines/instructLab"}]'`,
+				},
+			},
 		},
 	}
 }
@@ -68,6 +107,7 @@ func (c *InstructLabRemovalCheck) Validate(ctx context.Context, target check.Tar
 			}
 
 			impactedDSPAs := make([]types.NamespacedName, 0)
+			commands := make([]string, 0)
 
 			for i := range dspas {
 				dspa := dspas[i]
@@ -86,6 +126,10 @@ func (c *InstructLabRemovalCheck) Validate(ctx context.Context, target check.Tar
 					Namespace: dspa.GetNamespace(),
 					Name:      dspa.GetName(),
 				})
+				commands = append(commands, remediation.RemoveField(
+					usedResourceType, dspa.GetNamespace(), dspa.GetName(),
+					"/spec/apiServer/managedPipelines/instructLab",
+				))
 			}
 
 			req.Result.Annotations[check.AnnotationImpactedWorkloadCount] = strconv.Itoa(len(impactedDSPAs))
@@ -98,6 +142,7 @@ func (c *InstructLabRemovalCheck) Validate(ctx context.Context, target check.Tar
 					check.WithMessage("Found %d DataSciencePipelinesApplication(s) with deprecated '.spec.apiServer.managedPipelines.instructLab' field - InstructLab feature was removed in RHOAI 3.x", len(impactedDSPAs)),
 					check.WithImpact(result.ImpactAdvisory),
 					check.WithRemediation(c.CheckRemediation),
+					check.WithRemediationCommands(commands...),
 				))
 
 				req.Result.SetImpactedObjects(usedResourceType, impactedDSPAs)
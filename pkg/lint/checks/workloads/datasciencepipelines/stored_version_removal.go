@@ -28,6 +28,26 @@ const (
 	msgCRDNotFound           = "DataSciencePipelinesApplication CRD not found - DataSciencePipelines may not be installed"
 )
 
+// Examples rendered by 'lint explain'.
+const (
+	storedVersionFailingExample = `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: datasciencepipelinesapplications.datasciencepipelinesapplications.opendatahub.io
+status:
+  storedVersions:
+    - v1alpha1
+    - v1`
+
+	storedVersionPassingExample = `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: datasciencepipelinesapplications.datasciencepipelinesapplications.opendatahub.io
+status:
+  storedVersions:
+    - v1`
+)
+
 // StoredVersionRemovalCheck validates that the DataSciencePipelinesApplication CRD
 // does not have v1alpha1 among its status.storedVersions, since v1alpha1 will be
 // removed in RHOAI 3.x.
@@ -45,7 +65,23 @@ func NewStoredVersionRemovalCheck() *StoredVersionRemovalCheck {
 			CheckID:          "workloads.datasciencepipelines.stored-version-removal",
 			CheckName:        "Workloads :: DataSciencePipelines :: v1alpha1 StoredVersion Removal (3.x)",
 			CheckDescription: "Validates that the DataSciencePipelinesApplication CRD does not have v1alpha1 in status.storedVersions before upgrading to RHOAI 3.x",
-			CheckRemediation: "Migrate all DataSciencePipelinesApplication resources from v1alpha1 to v1",
+			CheckRemediation: "Migrate all DataSciencePipelinesApplication resources from v1alpha1 to v1 (e.g. with 'kubectl odh migrate dspa convert')",
+			CheckResources: []resources.ResourceType{
+				resources.CustomResourceDefinition,
+				resources.DataSciencePipelinesApplicationV1Alpha1,
+			},
+			CheckVersionGate:    check.VersionGateUpgrade2xTo3x,
+			CheckKnowledgeLinks: []string{check.DocsDataSciencePipelines},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "The status.storedVersions of the DataSciencePipelinesApplication CRD. The check only runs when upgrading from 2.x to 3.x.",
+				Rationale:      "RHOAI 3.x drops v1alpha1 from the DSPA CRD. The API server refuses a CRD update that removes a version still listed in storedVersions, so the operator upgrade stalls until every object is rewritten as v1 and the stored version is pruned.",
+				FailingExample: storedVersionFailingExample,
+				PassingExample: storedVersionPassingExample,
+				RemediationCommands: []string{
+					"kubectl odh migrate dspa convert",
+					`kubectl patch crd datasciencepipelinesapplications.datasciencepipelinesapplications.opendatahub.io --subresource status --type merge -p '{"status":{"storedVersions":["v1"]}}'`,
+				},
+			},
 		},
 	}
 }
@@ -0,0 +1,215 @@
+package gpu
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+const (
+	kind      = "gpu"
+	checkType = "scheduling"
+
+	ConditionTypeGPUSchedulable = "GPUWorkloadsSchedulable"
+)
+
+// Examples rendered by 'lint explain'.
+const (
+	schedulingFailingExample = `# Node taint: nvidia.com/gpu=present:NoSchedule
+apiVersion: kubeflow.org/v1
+kind: Notebook
+metadata:
+  name: wb
+  namespace: team-a
+  annotations:
+    opendatahub.io/hardware-profile-name: gpu-small   # profile without tolerations
+spec:
+  template:
+    spec:
+      containers:
+        - name: wb
+          resources:
+            limits:
+              nvidia.com/gpu: "1"`
+
+	schedulingPassingExample = `# Node taint: nvidia.com/gpu=present:NoSchedule
+apiVersion: infrastructure.opendatahub.io/v1
+kind: HardwareProfile
+metadata:
+  name: gpu-small
+spec:
+  scheduling:
+    type: Node
+    node:
+      tolerations:
+        - key: nvidia.com/gpu
+          operator: Exists
+          effect: NoSchedule`
+)
+
+// SchedulingCheck cross-references the tolerations of GPU workloads (Notebooks, InferenceServices and
+// RayClusters) with the taints of GPU nodes, as they will be after the move to HardwareProfile-driven
+// scheduling in 3.x. Tolerations injected from a legacy profile are replaced by those of the migrated
+// HardwareProfile, so workloads relying on tolerations no profile will carry stop scheduling on GPU nodes.
+type SchedulingCheck struct {
+	check.BaseCheck
+}
+
+func NewSchedulingCheck() *SchedulingCheck {
+	return &SchedulingCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupWorkload,
+			Kind:             kind,
+			Type:             checkType,
+			CheckID:          "workloads.gpu.scheduling",
+			CheckName:        "Workloads :: GPU :: Node Taint Compatibility (3.x)",
+			CheckDescription: "Detects GPU workloads whose tolerations will not match the taints of any GPU node once scheduling is driven by HardwareProfiles instead of AcceleratorProfile-injected tolerations",
+			CheckRemediation: "Make sure the AcceleratorProfile or HardwareProfile each GPU workload references exists and carries tolerations for the GPU node taints before upgrading, or add the tolerations to the workload itself",
+			CheckResources: []resources.ResourceType{
+				resources.Node,
+				resources.Notebook,
+				resources.InferenceService,
+				resources.RayCluster,
+				resources.AcceleratorProfile,
+				resources.HardwareProfile,
+				resources.DSCInitialization,
+			},
+			CheckVersionGate:    check.VersionGateUpgrade2xTo3x,
+			CheckKnowledgeLinks: []string{check.DocsAccelerators},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "Nodes exposing GPU resources and their taints, and the pod templates of Notebooks, InferenceServices and RayClusters that request GPUs. Each workload's tolerations are recomputed as they will be after its AcceleratorProfile or HardwareProfile is migrated. The check only runs when upgrading from 2.x to 3.x.",
+				Rationale:      "In 2.x, tolerations were injected into GPU workloads from their AcceleratorProfile. In 3.x they come from the HardwareProfile instead, so a workload whose profile carries no tolerations for the GPU node taints stays Pending after its next restart.",
+				FailingExample: schedulingFailingExample,
+				PassingExample: schedulingPassingExample,
+				RemediationCommands: []string{
+					"kubectl get nodes -o custom-columns=NAME:.metadata.name,TAINTS:.spec.taints",
+					"kubectl get hardwareprofiles --all-namespaces -o yaml",
+				},
+			},
+		},
+	}
+}
+
+// CanApply returns whether this check should run for the given target.
+// Only applies when upgrading from 2.x to 3.x.
+func (c *SchedulingCheck) CanApply(_ context.Context, target check.Target) (bool, error) {
+	return version.IsUpgradeFrom2xTo3x(target.CurrentVersion, target.TargetVersion), nil
+}
+
+// Validate executes the check against the provided target.
+func (c *SchedulingCheck) Validate(
+	ctx context.Context,
+	target check.Target,
+) (*result.DiagnosticResult, error) {
+	dr := c.NewResult()
+
+	if target.TargetVersion != nil {
+		dr.Annotations[check.AnnotationCheckTargetVersion] = target.TargetVersion.String()
+	}
+
+	nodes, err := listGPUNodes(ctx, target.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(nodes) == 0 {
+		dr.Annotations[check.AnnotationImpactedWorkloadCount] = "0"
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeGPUSchedulable,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonRequirementsMet),
+			check.WithMessage("No GPU nodes found - no GPU scheduling constraints to validate"),
+		))
+
+		return dr, nil
+	}
+
+	profiles, err := newProfileResolver(ctx, target.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	total := 0
+	totalGPU := 0
+
+	for _, rt := range []resources.ResourceType{resources.Notebook, resources.InferenceService, resources.RayCluster} {
+		workloads, err := target.Client.List(ctx, rt)
+		if err != nil {
+			if client.IsResourceTypeNotFound(err) {
+				continue
+			}
+
+			return nil, fmt.Errorf("listing %s: %w", rt.Kind, err)
+		}
+
+		var impacted []types.NamespacedName
+
+		for _, w := range workloads {
+			templates, err := podTemplates(rt, w)
+			if err != nil {
+				return nil, fmt.Errorf("reading pod templates of %s %s/%s: %w", rt.Kind, w.GetNamespace(), w.GetName(), err)
+			}
+
+			ref := profiles.resolve(w)
+			schedulable, requestsGPU := true, false
+
+			for _, tpl := range templates {
+				if !tpl.requestsGPU {
+					continue
+				}
+
+				requestsGPU = true
+
+				effective := migratedTolerations(tpl.tolerations, ref, nodes)
+				if !schedulableOnAny(effective, nodes) {
+					schedulable = false
+				}
+			}
+
+			if requestsGPU {
+				totalGPU++
+			}
+
+			if !schedulable {
+				impacted = append(impacted, types.NamespacedName{Namespace: w.GetNamespace(), Name: w.GetName()})
+			}
+		}
+
+		total += len(impacted)
+		dr.AddImpactedObjects(rt, impacted)
+	}
+
+	dr.Annotations[check.AnnotationImpactedWorkloadCount] = strconv.Itoa(total)
+	dr.SetCondition(c.newCondition(total, totalGPU, len(nodes)))
+
+	return dr, nil
+}
+
+func (c *SchedulingCheck) newCondition(impacted int, gpuWorkloads int, gpuNodes int) result.Condition {
+	if impacted == 0 {
+		return check.NewCondition(
+			ConditionTypeGPUSchedulable,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonRequirementsMet),
+			check.WithMessage("All %d GPU workload(s) tolerate the taints of at least one of %d GPU node(s) after migration to HardwareProfiles", gpuWorkloads, gpuNodes),
+		)
+	}
+
+	return check.NewCondition(
+		ConditionTypeGPUSchedulable,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonWorkloadsImpacted),
+		check.WithMessage("Found %d GPU workload(s) whose pods will not tolerate the taints of any of %d GPU node(s) after AcceleratorProfile-injected tolerations are replaced by HardwareProfile scheduling", impacted, gpuNodes),
+		check.WithImpact(result.ImpactAdvisory),
+		check.WithRemediation(c.CheckRemediation),
+	)
+}
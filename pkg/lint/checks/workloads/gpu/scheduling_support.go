@@ -0,0 +1,362 @@
+package gpu
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+)
+
+const (
+	annotationHardwareProfileName      = "opendatahub.io/hardware-profile-name"
+	annotationHardwareProfileNamespace = "opendatahub.io/hardware-profile-namespace"
+)
+
+// gpuNode is a node exposing GPU resources, with the taints that repel pods without matching tolerations.
+type gpuNode struct {
+	taints []corev1.Taint
+}
+
+// podTemplate is the scheduling-relevant part of one pod template of a workload.
+type podTemplate struct {
+	tolerations []corev1.Toleration
+	requestsGPU bool
+}
+
+// isGPUResource reports whether a resource name is a GPU extended resource (e.g. nvidia.com/gpu, amd.com/gpu).
+func isGPUResource(name string) bool {
+	return strings.HasSuffix(name, "/gpu") || strings.HasPrefix(name, "gpu.intel.com/")
+}
+
+// listGPUNodes returns the nodes with allocatable GPU resources and their scheduling taints.
+func listGPUNodes(ctx context.Context, r client.Reader) ([]gpuNode, error) {
+	items, err := r.List(ctx, resources.Node)
+	if err != nil {
+		return nil, fmt.Errorf("listing Nodes: %w", err)
+	}
+
+	var nodes []gpuNode
+
+	for _, item := range items {
+		var node corev1.Node
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, &node); err != nil {
+			return nil, fmt.Errorf("converting Node %s: %w", item.GetName(), err)
+		}
+
+		hasGPU := false
+
+		for name, quantity := range node.Status.Allocatable {
+			if isGPUResource(string(name)) && !quantity.IsZero() {
+				hasGPU = true
+
+				break
+			}
+		}
+
+		if !hasGPU {
+			continue
+		}
+
+		var gn gpuNode
+
+		for _, taint := range node.Spec.Taints {
+			if taint.Effect == corev1.TaintEffectNoSchedule || taint.Effect == corev1.TaintEffectNoExecute {
+				gn.taints = append(gn.taints, taint)
+			}
+		}
+
+		nodes = append(nodes, gn)
+	}
+
+	return nodes, nil
+}
+
+// podTemplates returns the pod templates of a Notebook, InferenceService or RayCluster.
+func podTemplates(rt resources.ResourceType, obj *unstructured.Unstructured) ([]podTemplate, error) {
+	switch rt.Kind {
+	case resources.Notebook.Kind:
+		spec, _, _ := unstructured.NestedMap(obj.Object, "spec", "template", "spec")
+
+		tpl, err := podSpecTemplate(spec)
+		if err != nil {
+			return nil, err
+		}
+
+		return []podTemplate{tpl}, nil
+
+	case resources.InferenceService.Kind:
+		// The predictor inlines pod spec fields; the model container carries its own resources.
+		predictor, _, _ := unstructured.NestedMap(obj.Object, "spec", "predictor")
+
+		tpl, err := podSpecTemplate(predictor)
+		if err != nil {
+			return nil, err
+		}
+
+		if res, ok, _ := unstructured.NestedMap(predictor, "model", "resources"); ok && requestsGPU(res) {
+			tpl.requestsGPU = true
+		}
+
+		return []podTemplate{tpl}, nil
+
+	case resources.RayCluster.Kind:
+		var templates []podTemplate
+
+		if spec, ok, _ := unstructured.NestedMap(obj.Object, "spec", "headGroupSpec", "template", "spec"); ok {
+			tpl, err := podSpecTemplate(spec)
+			if err != nil {
+				return nil, err
+			}
+
+			templates = append(templates, tpl)
+		}
+
+		groups, _, _ := unstructured.NestedSlice(obj.Object, "spec", "workerGroupSpecs")
+		for _, group := range groups {
+			groupMap, ok := group.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			spec, _, _ := unstructured.NestedMap(groupMap, "template", "spec")
+
+			tpl, err := podSpecTemplate(spec)
+			if err != nil {
+				return nil, err
+			}
+
+			templates = append(templates, tpl)
+		}
+
+		return templates, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported workload kind %s", rt.Kind)
+	}
+}
+
+// podSpecTemplate reads the tolerations and GPU requests of a pod spec, ignoring unrelated fields.
+func podSpecTemplate(spec map[string]any) (podTemplate, error) {
+	var partial struct {
+		Tolerations []corev1.Toleration `json:"tolerations"`
+		Containers  []struct {
+			Resources map[string]any `json:"resources"`
+		} `json:"containers"`
+	}
+
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(spec, &partial); err != nil {
+		return podTemplate{}, fmt.Errorf("parsing pod spec: %w", err)
+	}
+
+	tpl := podTemplate{tolerations: partial.Tolerations}
+
+	for _, container := range partial.Containers {
+		if requestsGPU(container.Resources) {
+			tpl.requestsGPU = true
+		}
+	}
+
+	return tpl, nil
+}
+
+// requestsGPU reports whether a container resources block requests or limits a GPU resource.
+func requestsGPU(res map[string]any) bool {
+	for _, key := range []string{"limits", "requests"} {
+		amounts, _ := res[key].(map[string]any)
+		for name, amount := range amounts {
+			if isGPUResource(name) && fmt.Sprint(amount) != "0" {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// profileRef describes the legacy profile a workload references and the tolerations its
+// migrated HardwareProfile will carry.
+type profileRef struct {
+	referenced  bool
+	found       bool
+	tolerations []corev1.Toleration
+}
+
+// profileResolver looks up the tolerations of legacy AcceleratorProfiles and HardwareProfiles.
+type profileResolver struct {
+	appNamespace string
+	accelerators map[types.NamespacedName][]corev1.Toleration
+	hardware     map[types.NamespacedName][]corev1.Toleration
+}
+
+func newProfileResolver(ctx context.Context, r client.Reader) (*profileResolver, error) {
+	appNS, err := client.GetApplicationsNamespace(ctx, r)
+	if err != nil {
+		return nil, fmt.Errorf("getting applications namespace: %w", err)
+	}
+
+	accelerators, err := profileTolerations(ctx, r, resources.AcceleratorProfile)
+	if err != nil {
+		return nil, err
+	}
+
+	hardware, err := profileTolerations(ctx, r, resources.HardwareProfile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &profileResolver{appNamespace: appNS, accelerators: accelerators, hardware: hardware}, nil
+}
+
+// profileTolerations maps each profile of the given type to its spec.tolerations.
+func profileTolerations(
+	ctx context.Context,
+	r client.Reader,
+	rt resources.ResourceType,
+) (map[types.NamespacedName][]corev1.Toleration, error) {
+	items, err := r.List(ctx, rt)
+	if err != nil {
+		if client.IsResourceTypeNotFound(err) {
+			return map[types.NamespacedName][]corev1.Toleration{}, nil
+		}
+
+		return nil, fmt.Errorf("listing %s: %w", rt.Kind, err)
+	}
+
+	profiles := make(map[types.NamespacedName][]corev1.Toleration, len(items))
+
+	for _, item := range items {
+		var spec struct {
+			Tolerations []corev1.Toleration `json:"tolerations"`
+		}
+
+		raw, _, _ := unstructured.NestedMap(item.Object, "spec")
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw, &spec); err != nil {
+			return nil, fmt.Errorf("parsing %s %s/%s: %w", rt.Kind, item.GetNamespace(), item.GetName(), err)
+		}
+
+		profiles[types.NamespacedName{Namespace: item.GetNamespace(), Name: item.GetName()}] = spec.Tolerations
+	}
+
+	return profiles, nil
+}
+
+// resolve returns the profile a workload references. HardwareProfile references take precedence
+// over AcceleratorProfile references; profiles default to the applications namespace.
+func (p *profileResolver) resolve(w *unstructured.Unstructured) profileRef {
+	annotations := w.GetAnnotations()
+
+	lookup := func(profiles map[types.NamespacedName][]corev1.Toleration, name string, namespace string) profileRef {
+		if namespace == "" {
+			namespace = p.appNamespace
+		}
+
+		tolerations, found := profiles[types.NamespacedName{Namespace: namespace, Name: name}]
+
+		return profileRef{referenced: true, found: found, tolerations: tolerations}
+	}
+
+	if name := annotations[annotationHardwareProfileName]; name != "" {
+		return lookup(p.hardware, name, annotations[annotationHardwareProfileNamespace])
+	}
+
+	if name := annotations[validate.AnnotationAcceleratorName]; name != "" {
+		return lookup(p.accelerators, name, annotations[validate.AnnotationAcceleratorNamespace])
+	}
+
+	return profileRef{}
+}
+
+// migratedTolerations returns the tolerations a pod template will have once its profile is migrated
+// to a 3.x HardwareProfile. The profile's tolerations are re-applied from the migrated HardwareProfile.
+// When the referenced profile no longer exists, no HardwareProfile is created for it, and the
+// tolerations matching GPU taints - injected from that profile - are not carried over.
+func migratedTolerations(own []corev1.Toleration, ref profileRef, nodes []gpuNode) []corev1.Toleration {
+	if !ref.referenced {
+		return own
+	}
+
+	if ref.found {
+		return append(append([]corev1.Toleration{}, own...), ref.tolerations...)
+	}
+
+	var kept []corev1.Toleration
+
+	for _, tol := range own {
+		if !toleratesAnyGPUTaint(tol, nodes) {
+			kept = append(kept, tol)
+		}
+	}
+
+	return kept
+}
+
+func toleratesAnyGPUTaint(tol corev1.Toleration, nodes []gpuNode) bool {
+	for _, node := range nodes {
+		for _, taint := range node.taints {
+			if tolerates(tol, taint) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// schedulableOnAny reports whether the tolerations tolerate every scheduling taint of at least one GPU node.
+func schedulableOnAny(tolerations []corev1.Toleration, nodes []gpuNode) bool {
+	for _, node := range nodes {
+		if toleratesAll(tolerations, node.taints) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func toleratesAll(tolerations []corev1.Toleration, taints []corev1.Taint) bool {
+	for _, taint := range taints {
+		tolerated := false
+
+		for _, tol := range tolerations {
+			if tolerates(tol, taint) {
+				tolerated = true
+
+				break
+			}
+		}
+
+		if !tolerated {
+			return false
+		}
+	}
+
+	return true
+}
+
+// tolerates implements the Kubernetes toleration matching rules for a single taint.
+func tolerates(tol corev1.Toleration, taint corev1.Taint) bool {
+	if tol.Effect != "" && tol.Effect != taint.Effect {
+		return false
+	}
+
+	if tol.Key != "" && tol.Key != taint.Key {
+		return false
+	}
+
+	switch tol.Operator {
+	case corev1.TolerationOpExists:
+		return true
+	case corev1.TolerationOpEqual, "":
+		return tol.Key != "" && tol.Value == taint.Value
+	default:
+		return false
+	}
+}
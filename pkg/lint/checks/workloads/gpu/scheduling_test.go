@@ -0,0 +1,171 @@
+package gpu_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/gpu"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+//nolint:gochecknoglobals
+var listKinds = map[schema.GroupVersionResource]string{
+	resources.Node.GVR():               resources.Node.ListKind(),
+	resources.Notebook.GVR():           resources.Notebook.ListKind(),
+	resources.InferenceService.GVR():   resources.InferenceService.ListKind(),
+	resources.RayCluster.GVR():         resources.RayCluster.ListKind(),
+	resources.AcceleratorProfile.GVR(): resources.AcceleratorProfile.ListKind(),
+	resources.HardwareProfile.GVR():    resources.HardwareProfile.ListKind(),
+	resources.DSCInitialization.GVR():  resources.DSCInitialization.ListKind(),
+}
+
+func gpuToleration() map[string]any {
+	return map[string]any{"key": "nvidia.com/gpu", "operator": "Exists", "effect": "NoSchedule"}
+}
+
+func newGPUNode(name string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "Node",
+		"metadata":   map[string]any{"name": name},
+		"spec": map[string]any{
+			"taints": []any{map[string]any{"key": "nvidia.com/gpu", "value": "true", "effect": "NoSchedule"}},
+		},
+		"status": map[string]any{
+			"allocatable": map[string]any{"nvidia.com/gpu": "4", "cpu": "32"},
+		},
+	}}
+}
+
+func newNotebook(name string, annotations map[string]any, tolerations ...any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": resources.Notebook.APIVersion(),
+		"kind":       resources.Notebook.Kind,
+		"metadata":   map[string]any{"name": name, "namespace": "user-ns", "annotations": annotations},
+		"spec": map[string]any{
+			"template": map[string]any{
+				"spec": map[string]any{
+					"containers": []any{map[string]any{
+						"name":      name,
+						"resources": map[string]any{"limits": map[string]any{"nvidia.com/gpu": "1"}},
+					}},
+					"tolerations": tolerations,
+				},
+			},
+		},
+	}}
+}
+
+func newAcceleratorProfile(name string, tolerations ...any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": resources.AcceleratorProfile.APIVersion(),
+		"kind":       resources.AcceleratorProfile.Kind,
+		"metadata":   map[string]any{"name": name, "namespace": "redhat-ods-applications"},
+		"spec":       map[string]any{"identifier": "nvidia.com/gpu", "tolerations": tolerations},
+	}}
+}
+
+func TestSchedulingCheck_NoGPUNodes(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      listKinds,
+		Objects:        []*unstructured.Unstructured{testutil.NewDSCI("redhat-ods-applications"), newNotebook("nb", nil)},
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := gpu.NewSchedulingCheck().Validate(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":    Equal(gpu.ConditionTypeGPUSchedulable),
+		"Status":  Equal(metav1.ConditionTrue),
+		"Message": ContainSubstring("No GPU nodes found"),
+	}))
+}
+
+func TestSchedulingCheck_Workloads(t *testing.T) {
+	g := NewWithT(t)
+
+	cpuNotebook := newNotebook("cpu-only", nil)
+	_ = unstructured.SetNestedSlice(cpuNotebook.Object, []any{map[string]any{"name": "cpu-only"}}, "spec", "template", "spec", "containers")
+
+	rayCluster := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": resources.RayCluster.APIVersion(),
+		"kind":       resources.RayCluster.Kind,
+		"metadata":   map[string]any{"name": "ray", "namespace": "user-ns"},
+		"spec": map[string]any{
+			"headGroupSpec": map[string]any{"template": map[string]any{"spec": map[string]any{
+				"containers": []any{map[string]any{"name": "head"}},
+			}}},
+			"workerGroupSpecs": []any{map[string]any{"template": map[string]any{"spec": map[string]any{
+				"containers": []any{map[string]any{
+					"name":      "worker",
+					"resources": map[string]any{"requests": map[string]any{"nvidia.com/gpu": int64(1)}},
+				}},
+			}}}},
+		},
+	}}
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: listKinds,
+		Objects: []*unstructured.Unstructured{
+			testutil.NewDSCI("redhat-ods-applications"),
+			newGPUNode("gpu-1"),
+			newAcceleratorProfile("nvidia", gpuToleration()),
+			// Own toleration, no profile: keeps scheduling
+			newNotebook("own-toleration", nil, gpuToleration()),
+			// Injected from an existing profile: the migrated HardwareProfile carries the toleration
+			newNotebook("existing-profile", map[string]any{"opendatahub.io/accelerator-name": "nvidia"}, gpuToleration()),
+			// Injected from a deleted profile: no HardwareProfile is migrated, the toleration is lost
+			newNotebook("missing-profile", map[string]any{"opendatahub.io/accelerator-name": "deleted"}, gpuToleration()),
+			// No toleration at all
+			newNotebook("no-toleration", nil),
+			cpuNotebook,
+			rayCluster,
+		},
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := gpu.NewSchedulingCheck().Validate(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":   Equal(gpu.ConditionTypeGPUSchedulable),
+		"Status": Equal(metav1.ConditionFalse),
+		"Reason": Equal(check.ReasonWorkloadsImpacted),
+	}))
+	g.Expect(dr.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "3"))
+
+	names := make([]string, 0, len(dr.ImpactedObjects))
+	for _, obj := range dr.ImpactedObjects {
+		names = append(names, obj.Kind+"/"+obj.Name)
+	}
+
+	g.Expect(names).To(ConsistOf("Notebook/missing-profile", "Notebook/no-toleration", "RayCluster/ray"))
+}
+
+func TestSchedulingCheck_CanApply(t *testing.T) {
+	g := NewWithT(t)
+
+	upgrade := testutil.NewTarget(t, testutil.TargetConfig{ListKinds: listKinds, CurrentVersion: "2.25.0", TargetVersion: "3.0.0"})
+	lint := testutil.NewTarget(t, testutil.TargetConfig{ListKinds: listKinds, CurrentVersion: "3.0.0", TargetVersion: "3.0.0"})
+
+	canApply, err := gpu.NewSchedulingCheck().CanApply(t.Context(), upgrade)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeTrue())
+
+	canApply, err = gpu.NewSchedulingCheck().CanApply(t.Context(), lint)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeFalse())
+}
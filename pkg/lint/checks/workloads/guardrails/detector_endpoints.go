@@ -0,0 +1,276 @@
+package guardrails
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net"
+	"slices"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+)
+
+const (
+	annotationDetectorsUnreachable = "guardrails.opendatahub.io/detectors-unreachable"
+
+	// endpointSliceServiceLabel links an EndpointSlice to the Service it belongs to.
+	endpointSliceServiceLabel = "kubernetes.io/service-name"
+
+	serviceTypeExternalName = "ExternalName"
+)
+
+// detectorEndpoint is the service of a detector configured in the orchestrator config.yaml.
+type detectorEndpoint struct {
+	name     string
+	hostname string
+	port     string
+}
+
+// orchestratorDetectors returns the detector services of a parsed orchestrator config.yaml.
+// The detectors are read either from a map keyed by detector name, as written by the
+// orchestrator, or from a list of entries with a name.
+func orchestratorDetectors(configData map[string]any) []detectorEndpoint {
+	var detectors []detectorEndpoint
+
+	switch entries := configData["detectors"].(type) {
+	case map[string]any:
+		for _, name := range slices.Sorted(maps.Keys(entries)) {
+			if entry, ok := entries[name].(map[string]any); ok {
+				detectors = append(detectors, newDetectorEndpoint(name, entry))
+			}
+		}
+	case []any:
+		for i, item := range entries {
+			entry, ok := item.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			name, _ := entry["name"].(string)
+			if name == "" {
+				name = fmt.Sprintf("detectors[%d]", i)
+			}
+
+			detectors = append(detectors, newDetectorEndpoint(name, entry))
+		}
+	}
+
+	return detectors
+}
+
+func newDetectorEndpoint(name string, entry map[string]any) detectorEndpoint {
+	d := detectorEndpoint{name: name}
+
+	if hostname, err := jq.Query[string](entry, ".service.hostname"); err == nil {
+		d.hostname = hostname
+	}
+
+	if port, err := jq.Query[any](entry, ".service.port"); err == nil && port != nil {
+		d.port = fmt.Sprintf("%v", port)
+	}
+
+	return d
+}
+
+// detectorService returns the Service a detector hostname resolves to in the cluster: "name",
+// "name.namespace" or "name.namespace.svc[.cluster-domain]", with unqualified names resolved
+// in the orchestrator namespace. Returns false for IP addresses, localhost (the built-in
+// detectors served from the orchestrator pod) and hostnames outside the cluster.
+func detectorService(hostname string, namespace string) (string, string, bool) {
+	hostname = strings.TrimSuffix(hostname, ".")
+	if hostname == "" || hostname == "localhost" || net.ParseIP(hostname) != nil {
+		return "", "", false
+	}
+
+	labels := strings.Split(hostname, ".")
+
+	switch {
+	case len(labels) == 1:
+		return labels[0], namespace, true
+	case len(labels) == 2: //nolint:mnd // name.namespace
+		return labels[0], labels[1], true
+	case labels[2] == "svc":
+		return labels[0], labels[1], true
+	default:
+		return "", "", false
+	}
+}
+
+// validateDetectors checks that the service of each detector resolves to a Service exposing its
+// port with at least one ready endpoint. Returns the number of detectors validated, leaving out
+// the ones served outside the cluster, and a description of each detector failing.
+func (c *ImpactedWorkloadsCheck) validateDetectors(
+	ctx context.Context,
+	reader client.Reader,
+	namespace string,
+	detectors []detectorEndpoint,
+) (int, []string, error) {
+	var (
+		validated int
+		failures  []string
+	)
+
+	for _, d := range detectors {
+		if d.hostname == "" {
+			validated++
+
+			failures = append(failures, d.name+": service hostname not set")
+
+			continue
+		}
+
+		name, ns, ok := detectorService(d.hostname, namespace)
+		if !ok {
+			continue
+		}
+
+		validated++
+
+		reason, err := c.detectorServiceIssue(ctx, reader, name, ns, d.port)
+		if err != nil {
+			return 0, nil, fmt.Errorf("validating detector %s: %w", d.name, err)
+		}
+
+		if reason != "" {
+			failures = append(failures, fmt.Sprintf("%s: %s", d.name, reason))
+		}
+	}
+
+	return validated, failures, nil
+}
+
+// detectorServiceIssue returns why the Service namespace/name cannot serve a detector on port,
+// or an empty string when it can.
+func (c *ImpactedWorkloadsCheck) detectorServiceIssue(
+	ctx context.Context,
+	reader client.Reader,
+	name string,
+	namespace string,
+	port string,
+) (string, error) {
+	svc, err := reader.GetResource(ctx, resources.Service, name, client.InNamespace(namespace))
+
+	switch {
+	case apierrors.IsNotFound(err):
+		return fmt.Sprintf("Service %s/%s not found", namespace, name), nil
+	case err != nil:
+		return "", fmt.Errorf("getting Service %s/%s: %w", namespace, name, err)
+	}
+
+	// ExternalName Services are resolved by DNS and have no endpoints in the cluster.
+	if svcType, err := jq.Query[string](svc, ".spec.type"); err == nil && svcType == serviceTypeExternalName {
+		return "", nil
+	}
+
+	if port != "" && !serviceExposesPort(svc, port) {
+		return fmt.Sprintf("Service %s/%s does not expose port %s", namespace, name, port), nil
+	}
+
+	ready, err := hasReadyEndpoints(ctx, reader, name, namespace)
+	if err != nil {
+		return "", err
+	}
+
+	if !ready {
+		return fmt.Sprintf("Service %s/%s has no ready endpoints", namespace, name), nil
+	}
+
+	return "", nil
+}
+
+func serviceExposesPort(svc *unstructured.Unstructured, port string) bool {
+	ports, err := jq.Query[[]any](svc, "[.spec.ports[]?.port]")
+	if err != nil {
+		return false
+	}
+
+	return slices.ContainsFunc(ports, func(p any) bool {
+		return fmt.Sprintf("%v", p) == port
+	})
+}
+
+// hasReadyEndpoints returns whether one of the EndpointSlices of the Service namespace/name
+// holds an endpoint that is not reported as not ready.
+func hasReadyEndpoints(ctx context.Context, reader client.Reader, name string, namespace string) (bool, error) {
+	endpointSlices, err := reader.List(ctx, resources.EndpointSlice,
+		client.WithNamespace(namespace),
+		client.WithLabelSelector(endpointSliceServiceLabel+"="+name),
+	)
+	if err != nil {
+		if client.IsResourceTypeNotFound(err) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("listing EndpointSlices of Service %s/%s: %w", namespace, name, err)
+	}
+
+	for _, slice := range endpointSlices {
+		ready, err := jq.Query[bool](slice, "any(.endpoints[]?; .conditions.ready != false)")
+		if err != nil && !errors.Is(err, jq.ErrNotFound) {
+			return false, fmt.Errorf("querying endpoints of EndpointSlice %s/%s: %w", namespace, slice.GetName(), err)
+		}
+
+		if ready {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// newDetectorsCondition creates the condition reporting the detectors whose service does not
+// resolve to a ready Service, keyed by GuardrailsOrchestrator.
+func (c *ImpactedWorkloadsCheck) newDetectorsCondition(
+	validated int,
+	failures map[string][]string,
+) result.Condition {
+	if len(failures) == 0 {
+		if validated == 0 {
+			return check.NewCondition(
+				ConditionTypeDetectorsReachable,
+				metav1.ConditionTrue,
+				check.WithReason(check.ReasonRequirementsMet),
+				check.WithMessage("No in-cluster detector services configured"),
+			)
+		}
+
+		return check.NewCondition(
+			ConditionTypeDetectorsReachable,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonResourceAvailable),
+			check.WithMessage("All %d detector service(s) resolve to Services with ready endpoints", validated),
+		)
+	}
+
+	var (
+		unreachable int
+		details     []string
+	)
+
+	for _, orch := range slices.Sorted(maps.Keys(failures)) {
+		unreachable += len(failures[orch])
+
+		for _, failure := range failures[orch] {
+			details = append(details, orch+" "+failure)
+		}
+	}
+
+	return check.NewCondition(
+		ConditionTypeDetectorsReachable,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonResourceUnavailable),
+		check.WithMessage("Found %d of %d detector service(s) not reachable: %s", unreachable, validated, strings.Join(details, "; ")),
+		check.WithImpact(result.ImpactAdvisory),
+		check.WithRemediation("Deploy the detector Services referenced by the orchestrator config.yaml, or fix their hostname and port, before upgrading"),
+	)
+}
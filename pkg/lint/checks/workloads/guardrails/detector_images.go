@@ -0,0 +1,321 @@
+package guardrails
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/opendatahub-io/odh-cli/pkg/constants"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/components"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+const (
+	ConditionTypeDetectorImagesAvailable = "BuiltInDetectorImagesAvailable"
+)
+
+const (
+	checkTypeDetectorImages = "builtin-detector-images"
+
+	// The TrustyAI operator publishes the images it deploys in this ConfigMap.
+	trustyaiOperatorConfigName = "trustyai-service-operator-config"
+	builtInDetectorImageKey    = "guardrails-built-in-detector-image"
+
+	annotationDetectorImage = "guardrails.opendatahub.io/builtin-detector-image"
+
+	msgNoBuiltInDetectors     = "No GuardrailsOrchestrators enable built-in detectors"
+	msgDetectorImageUnknown   = "Unable to determine the built-in detector image from ConfigMap %s/%s: %s"
+	msgNoMirrorConfiguration  = "No image mirror configuration found - built-in detector image %s is pulled directly from its registry"
+	msgDetectorImageMirrored  = "Built-in detector image %s is covered by mirror source %s"
+	msgDetectorImageNotMirror = "Found %d GuardrailsOrchestrator(s) with built-in detectors enabled, but image mirrors are configured and none covers %s - detectors will fail to pull on air-gapped clusters after upgrade"
+)
+
+// Examples rendered by 'lint explain'.
+const (
+	detectorImagesFailingExample = `# GuardrailsOrchestrator spec.enableBuiltInDetectors: true
+# detector image: registry.redhat.io/rhoai/odh-built-in-detector-rhel9@sha256:...
+apiVersion: config.openshift.io/v1
+kind: ImageDigestMirrorSet
+spec:
+  imageDigestMirrors:
+    - source: registry.redhat.io/ubi9
+      mirrors:
+        - mirror.example.com/ubi9`
+
+	detectorImagesPassingExample = `apiVersion: config.openshift.io/v1
+kind: ImageDigestMirrorSet
+spec:
+  imageDigestMirrors:
+    - source: registry.redhat.io/rhoai
+      mirrors:
+        - mirror.example.com/rhoai`
+)
+
+// DetectorImagesCheck verifies that the built-in detector images required by
+// GuardrailsOrchestrators with enableBuiltInDetectors: true are reachable through the
+// cluster's image mirror configuration. Air-gapped clusters pull every payload image
+// through ImageDigestMirrorSet/ImageTagMirrorSet/ImageContentSourcePolicy mirrors, so a
+// detector repository that is not mirrored fails to start after the upgrade.
+type DetectorImagesCheck struct {
+	check.BaseCheck
+}
+
+func NewDetectorImagesCheck() *DetectorImagesCheck {
+	return &DetectorImagesCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupWorkload,
+			Kind:             kind,
+			Type:             checkTypeDetectorImages,
+			CheckID:          "workloads.guardrails.builtin-detector-images",
+			CheckName:        "Workloads :: Guardrails :: Built-in Detector Images (3.x)",
+			CheckDescription: "Verifies that built-in detector images required by GuardrailsOrchestrators are available through the cluster image mirror configuration",
+			CheckRemediation: "Mirror the built-in detector image repository for the target release and add it to an ImageDigestMirrorSet before upgrading",
+			CheckResources: []resources.ResourceType{
+				resources.DataScienceCluster,
+				resources.DSCInitialization,
+				resources.ConfigMap,
+				resources.GuardrailsOrchestrator,
+				resources.ImageDigestMirrorSet,
+				resources.ImageTagMirrorSet,
+				resources.ImageContentSourcePolicy,
+			},
+			CheckVersionGate:    check.VersionGateUpgrade2xTo3x,
+			CheckKnowledgeLinks: []string{check.DocsMonitoringModels},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "GuardrailsOrchestrators with enableBuiltInDetectors: true, the built-in detector image published in the trustyai-service-operator-config ConfigMap, and the sources of ImageDigestMirrorSets, ImageTagMirrorSets and ImageContentSourcePolicies. The check only runs when upgrading from 2.x to 3.x.",
+				Rationale:      "Air-gapped clusters pull every image through their mirror configuration. When mirrors are configured but none covers the built-in detector repository, the detector pods of these orchestrators cannot pull their image after the upgrade. Clusters without any mirror configuration pull directly and pass.",
+				FailingExample: detectorImagesFailingExample,
+				PassingExample: detectorImagesPassingExample,
+				RemediationCommands: []string{
+					"kubectl get configmap trustyai-service-operator-config -n <applications-namespace> -o jsonpath='{.data.guardrails-built-in-detector-image}'",
+					"kubectl get imagedigestmirrorsets -o yaml",
+				},
+			},
+		},
+	}
+}
+
+// CanApply returns whether this check should run for the given target.
+// Only applies when upgrading from 2.x to 3.x and TrustyAI is Managed.
+func (c *DetectorImagesCheck) CanApply(ctx context.Context, target check.Target) (bool, error) {
+	if !version.IsUpgradeFrom2xTo3x(target.CurrentVersion, target.TargetVersion) {
+		return false, nil
+	}
+
+	dsc, err := client.GetDataScienceCluster(ctx, target.Client)
+	if err != nil {
+		return false, fmt.Errorf("getting DataScienceCluster: %w", err)
+	}
+
+	return components.HasManagementState(dsc, "trustyai", constants.ManagementStateManaged), nil
+}
+
+// Validate executes the check against the provided target.
+func (c *DetectorImagesCheck) Validate(
+	ctx context.Context,
+	target check.Target,
+) (*result.DiagnosticResult, error) {
+	dr := c.NewResult()
+
+	if target.TargetVersion != nil {
+		dr.Annotations[check.AnnotationCheckTargetVersion] = target.TargetVersion.String()
+	}
+
+	orchestrators, err := client.List[*unstructured.Unstructured](
+		ctx, target.Client, resources.GuardrailsOrchestrator, hasBuiltInDetectors,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing GuardrailsOrchestrators: %w", err)
+	}
+
+	dr.Annotations[check.AnnotationImpactedWorkloadCount] = "0"
+
+	if len(orchestrators) == 0 {
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeDetectorImagesAvailable,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonRequirementsMet),
+			check.WithMessage(msgNoBuiltInDetectors),
+		))
+
+		return dr, nil
+	}
+
+	namespace, image, err := c.detectorImage(ctx, target.Client)
+	if err != nil {
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeDetectorImagesAvailable,
+			metav1.ConditionUnknown,
+			check.WithReason(check.ReasonInsufficientData),
+			check.WithMessage(msgDetectorImageUnknown, namespace, trustyaiOperatorConfigName, err),
+		))
+
+		return dr, nil
+	}
+
+	dr.Annotations[annotationDetectorImage] = image
+
+	sources, err := listMirrorSources(ctx, target.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sources) == 0 {
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeDetectorImagesAvailable,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonResourceAvailable),
+			check.WithMessage(msgNoMirrorConfiguration, image),
+		))
+
+		return dr, nil
+	}
+
+	if source, ok := findMirrorSource(image, sources); ok {
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeDetectorImagesAvailable,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonResourceAvailable),
+			check.WithMessage(msgDetectorImageMirrored, image, source),
+		))
+
+		return dr, nil
+	}
+
+	names := make([]types.NamespacedName, 0, len(orchestrators))
+	for _, orch := range orchestrators {
+		names = append(names, types.NamespacedName{Namespace: orch.GetNamespace(), Name: orch.GetName()})
+	}
+
+	dr.SetImpactedObjects(resources.GuardrailsOrchestrator, names)
+	dr.Annotations[check.AnnotationImpactedWorkloadCount] = strconv.Itoa(len(names))
+
+	dr.SetCondition(check.NewCondition(
+		ConditionTypeDetectorImagesAvailable,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonResourceUnavailable),
+		check.WithMessage(msgDetectorImageNotMirror, len(orchestrators), image),
+		check.WithImpact(result.ImpactAdvisory),
+		check.WithRemediation(c.CheckRemediation),
+	))
+
+	return dr, nil
+}
+
+// detectorImage reads the built-in detector image from the TrustyAI operator ConfigMap.
+// Returns the applications namespace alongside the image for error reporting.
+func (c *DetectorImagesCheck) detectorImage(
+	ctx context.Context,
+	reader client.Reader,
+) (string, string, error) {
+	namespace, err := client.GetApplicationsNamespace(ctx, reader)
+	if err != nil {
+		return "", "", fmt.Errorf("getting applications namespace: %w", err)
+	}
+
+	cm, err := reader.GetResource(ctx, resources.ConfigMap, trustyaiOperatorConfigName, client.InNamespace(namespace))
+	switch {
+	case apierrors.IsNotFound(err):
+		return namespace, "", errors.New("ConfigMap not found")
+	case err != nil:
+		return namespace, "", fmt.Errorf("getting ConfigMap: %w", err)
+	}
+
+	image, err := jq.Query[string](cm, fmt.Sprintf(".data[%q]", builtInDetectorImageKey))
+	if err != nil || image == "" {
+		return namespace, "", fmt.Errorf("key %s not set", builtInDetectorImageKey)
+	}
+
+	return namespace, image, nil
+}
+
+// hasBuiltInDetectors matches GuardrailsOrchestrators with enableBuiltInDetectors: true.
+func hasBuiltInDetectors(obj *unstructured.Unstructured) (bool, error) {
+	enabled, err := jq.Query[bool](obj, ".spec.enableBuiltInDetectors")
+	if errors.Is(err, jq.ErrNotFound) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("querying enableBuiltInDetectors: %w", err)
+	}
+
+	return enabled, nil
+}
+
+// mirrorSourceQueries maps each mirror configuration type to the JQ query returning its sources.
+//
+//nolint:gochecknoglobals
+var mirrorSourceQueries = []struct {
+	resourceType resources.ResourceType
+	query        string
+}{
+	{resources.ImageDigestMirrorSet, "[.spec.imageDigestMirrors[]?.source]"},
+	{resources.ImageTagMirrorSet, "[.spec.imageTagMirrors[]?.source]"},
+	{resources.ImageContentSourcePolicy, "[.spec.repositoryDigestMirrors[]?.source]"},
+}
+
+// listMirrorSources collects every mirrored source repository configured on the cluster.
+// Mirror CRDs that are not installed are treated as having no sources.
+func listMirrorSources(ctx context.Context, reader client.Reader) ([]string, error) {
+	var sources []string
+
+	for _, m := range mirrorSourceQueries {
+		items, err := client.List[*unstructured.Unstructured](ctx, reader, m.resourceType, nil)
+		if err != nil {
+			return nil, fmt.Errorf("listing %s: %w", m.resourceType.Kind, err)
+		}
+
+		for _, item := range items {
+			itemSources, err := jq.Query[[]string](item, m.query)
+			if err != nil {
+				return nil, fmt.Errorf("querying %s %s sources: %w", m.resourceType.Kind, item.GetName(), err)
+			}
+
+			sources = append(sources, itemSources...)
+		}
+	}
+
+	return sources, nil
+}
+
+// findMirrorSource returns the mirror source covering the image's repository.
+// A source covers a repository when it equals it or is one of its parent paths
+// (e.g. registry.redhat.io/rhoai covers registry.redhat.io/rhoai/odh-built-in-detector-rhel9).
+func findMirrorSource(image string, sources []string) (string, bool) {
+	repository := imageRepository(image)
+
+	for _, source := range sources {
+		if repository == source || strings.HasPrefix(repository, source+"/") {
+			return source, true
+		}
+	}
+
+	return "", false
+}
+
+// imageRepository strips the digest and tag from an image reference.
+func imageRepository(image string) string {
+	if idx := strings.LastIndex(image, "@"); idx != -1 {
+		image = image[:idx]
+	}
+
+	// A colon after the last slash separates the tag; before it, it is a registry port.
+	if idx := strings.LastIndex(image, ":"); idx != -1 && !strings.Contains(image[idx+1:], "/") {
+		image = image[:idx]
+	}
+
+	return image
+}
@@ -0,0 +1,182 @@
+package guardrails_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/guardrails"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+const (
+	testAppsNamespace = "redhat-ods-applications"
+	testDetectorImage = "registry.redhat.io/rhoai/odh-built-in-detector-rhel9@sha256:abc123"
+)
+
+//nolint:gochecknoglobals // Test fixture - shared across test functions.
+var detectorImagesListKinds = map[schema.GroupVersionResource]string{
+	resources.GuardrailsOrchestrator.GVR():   resources.GuardrailsOrchestrator.ListKind(),
+	resources.ConfigMap.GVR():                resources.ConfigMap.ListKind(),
+	resources.DSCInitialization.GVR():        resources.DSCInitialization.ListKind(),
+	resources.ImageDigestMirrorSet.GVR():     resources.ImageDigestMirrorSet.ListKind(),
+	resources.ImageTagMirrorSet.GVR():        resources.ImageTagMirrorSet.ListKind(),
+	resources.ImageContentSourcePolicy.GVR(): resources.ImageContentSourcePolicy.ListKind(),
+}
+
+func newTestIDMS(name string, sources ...string) *unstructured.Unstructured {
+	mirrors := make([]any, 0, len(sources))
+	for _, s := range sources {
+		mirrors = append(mirrors, map[string]any{
+			"source":  s,
+			"mirrors": []any{"mirror.example.com/" + name},
+		})
+	}
+
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.ImageDigestMirrorSet.APIVersion(),
+			"kind":       resources.ImageDigestMirrorSet.Kind,
+			"metadata": map[string]any{
+				"name": name,
+			},
+			"spec": map[string]any{
+				"imageDigestMirrors": mirrors,
+			},
+		},
+	}
+}
+
+func newDetectorImagesTarget(t *testing.T, objects ...*unstructured.Unstructured) check.Target {
+	t.Helper()
+
+	base := []*unstructured.Unstructured{
+		testutil.NewDSCI(testAppsNamespace),
+		newTestConfigMap("trustyai-service-operator-config", testAppsNamespace, map[string]any{
+			"guardrails-built-in-detector-image": testDetectorImage,
+		}),
+		newTestOrchestrator("orch-detectors", "ns1", map[string]any{"enableBuiltInDetectors": true}),
+		newTestOrchestrator("orch-plain", "ns2", map[string]any{"enableBuiltInDetectors": false}),
+	}
+
+	return testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      detectorImagesListKinds,
+		Objects:        append(base, objects...),
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+	})
+}
+
+func TestDetectorImagesCheck_NoBuiltInDetectors(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: detectorImagesListKinds,
+		Objects: []*unstructured.Unstructured{
+			newTestOrchestrator("orch", "ns1", map[string]any{"enableBuiltInDetectors": false}),
+		},
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	result, err := guardrails.NewDetectorImagesCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Status.Conditions).To(HaveLen(1))
+	g.Expect(result.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":    Equal(guardrails.ConditionTypeDetectorImagesAvailable),
+		"Status":  Equal(metav1.ConditionTrue),
+		"Message": ContainSubstring("No GuardrailsOrchestrators enable built-in detectors"),
+	}))
+}
+
+func TestDetectorImagesCheck_NoMirrorConfiguration(t *testing.T) {
+	g := NewWithT(t)
+
+	result, err := guardrails.NewDetectorImagesCheck().Validate(t.Context(), newDetectorImagesTarget(t))
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Status":  Equal(metav1.ConditionTrue),
+		"Reason":  Equal(check.ReasonResourceAvailable),
+		"Message": ContainSubstring("No image mirror configuration found"),
+	}))
+	g.Expect(result.Annotations).To(HaveKeyWithValue("guardrails.opendatahub.io/builtin-detector-image", testDetectorImage))
+}
+
+func TestDetectorImagesCheck_Mirrored(t *testing.T) {
+	g := NewWithT(t)
+
+	target := newDetectorImagesTarget(t, newTestIDMS("rhoai", "registry.redhat.io/rhoai"))
+
+	result, err := guardrails.NewDetectorImagesCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Status":  Equal(metav1.ConditionTrue),
+		"Message": ContainSubstring("covered by mirror source registry.redhat.io/rhoai"),
+	}))
+	g.Expect(result.ImpactedObjects).To(BeEmpty())
+}
+
+func TestDetectorImagesCheck_NotMirrored(t *testing.T) {
+	g := NewWithT(t)
+
+	// Mirrors exist (air-gapped cluster) but only for an unrelated repository.
+	target := newDetectorImagesTarget(t, newTestIDMS("other", "registry.redhat.io/rhoai-other"))
+
+	result, err := guardrails.NewDetectorImagesCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Status":  Equal(metav1.ConditionFalse),
+		"Reason":  Equal(check.ReasonResourceUnavailable),
+		"Message": ContainSubstring("none covers " + testDetectorImage),
+	}))
+	g.Expect(result.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactAdvisory))
+	g.Expect(result.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "1"))
+	g.Expect(result.ImpactedObjects).To(HaveLen(1))
+	g.Expect(result.ImpactedObjects[0].Name).To(Equal("orch-detectors"))
+}
+
+func TestDetectorImagesCheck_MissingOperatorConfig(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: detectorImagesListKinds,
+		Objects: []*unstructured.Unstructured{
+			testutil.NewDSCI(testAppsNamespace),
+			newTestOrchestrator("orch", "ns1", map[string]any{"enableBuiltInDetectors": true}),
+		},
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	result, err := guardrails.NewDetectorImagesCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Status":  Equal(metav1.ConditionUnknown),
+		"Reason":  Equal(check.ReasonInsufficientData),
+		"Message": ContainSubstring("ConfigMap not found"),
+	}))
+}
+
+func TestDetectorImagesCheck_Metadata(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := guardrails.NewDetectorImagesCheck()
+
+	g.Expect(chk.ID()).To(Equal("workloads.guardrails.builtin-detector-images"))
+	g.Expect(chk.Group()).To(Equal(check.GroupWorkload))
+	g.Expect(chk.Description()).ToNot(BeEmpty())
+	g.Expect(chk.Remediation()).ToNot(BeEmpty())
+}
@@ -1,9 +1,12 @@
 package guardrails
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
@@ -18,6 +21,7 @@ import (
 
 const (
 	ConditionTypeConfigurationValid = "ConfigurationValid"
+	ConditionTypeDetectorsReachable = "DetectorsReachable"
 )
 
 const (
@@ -28,10 +32,62 @@ const (
 	annotationGatewayCM          = "guardrails.opendatahub.io/gateway-configmap"
 )
 
+// Examples rendered by 'lint explain'.
+const (
+	impactedFailingExample = `apiVersion: trustyai.opendatahub.io/v1alpha1
+kind: GuardrailsOrchestrator
+metadata:
+  name: guardrails
+  namespace: team-a
+spec:
+  orchestratorConfig: fms-orchestr8-config
+  enableGuardrailsGateway: false`
+
+	impactedPassingExample = `apiVersion: trustyai.opendatahub.io/v1alpha1
+kind: GuardrailsOrchestrator
+metadata:
+  name: guardrails
+  namespace: team-a
+spec:
+  orchestratorConfig: fms-orchestr8-config      # config.yaml sets chat_generation.service and detectors
+  enableGuardrailsGateway: true
+  guardrailsGatewayConfig: guardrails-gateway-config
+  enableBuiltInDetectors: true`
+)
+
 // ImpactedWorkloadsCheck detects GuardrailsOrchestrator CRs with configuration
 // that will be impacted in a RHOAI 2.x to 3.x upgrade.
 type ImpactedWorkloadsCheck struct {
 	check.BaseCheck
+
+	// ValidateDetectors enables the validation that the service of each detector in the
+	// orchestrator config.yaml resolves to a Service of the cluster with ready endpoints.
+	ValidateDetectors bool
+}
+
+// impactedWorkloadsParameters are the parameters of the check settable in the lint
+// configuration file.
+type impactedWorkloadsParameters struct {
+	// ValidateDetectors overrides ValidateDetectors.
+	ValidateDetectors *bool `json:"validateDetectors,omitempty"`
+}
+
+// SetParameters applies the parameters of the check from the lint configuration file.
+func (c *ImpactedWorkloadsCheck) SetParameters(data []byte) error {
+	var params impactedWorkloadsParameters
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&params); err != nil {
+		return fmt.Errorf("decoding parameters: %w", err)
+	}
+
+	if params.ValidateDetectors != nil {
+		c.ValidateDetectors = *params.ValidateDetectors
+	}
+
+	return nil
 }
 
 func NewImpactedWorkloadsCheck() *ImpactedWorkloadsCheck {
@@ -44,6 +100,24 @@ func NewImpactedWorkloadsCheck() *ImpactedWorkloadsCheck {
 			CheckName:        "Workloads :: Guardrails :: Impacted Workloads (3.x)",
 			CheckDescription: "Detects GuardrailsOrchestrator CRs with configuration that will be impacted in RHOAI 3.x upgrade",
 			CheckRemediation: "Review and fix GuardrailsOrchestrator configuration before upgrading to ensure correct operation in RHOAI 3.x",
+			CheckResources: []resources.ResourceType{
+				resources.DataScienceCluster,
+				resources.GuardrailsOrchestrator,
+				resources.Service,
+				resources.EndpointSlice,
+			},
+			CheckVersionGate:    check.VersionGateUpgrade2xTo3x,
+			CheckKnowledgeLinks: []string{check.DocsMonitoringModels},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "The spec of every GuardrailsOrchestrator (orchestratorConfig, enableGuardrailsGateway, guardrailsGatewayConfig and enableBuiltInDetectors) and the ConfigMaps it references. The orchestrator ConfigMap must hold a config.yaml with chat_generation.service hostname and port and a non-empty detectors list. With the validateDetectors parameter, the service hostname and port of each detector must also resolve to a Service of the cluster exposing that port with ready endpoints; detectors served from the orchestrator pod (localhost) or outside the cluster are not validated. The check only runs when upgrading from 2.x to 3.x.",
+				Rationale:      "The 3.x TrustyAI operator deploys orchestrators from this configuration and no longer fills in defaults for it. An orchestrator missing any of these settings, or referencing a missing or incomplete ConfigMap, does not come up correctly after the upgrade.",
+				FailingExample: impactedFailingExample,
+				PassingExample: impactedPassingExample,
+				RemediationCommands: []string{
+					"kubectl get guardrailsorchestrators --all-namespaces -o yaml",
+					"kubectl get configmap fms-orchestr8-config -n team-a -o jsonpath='{.data.config\\.yaml}'",
+				},
+			},
 		},
 	}
 }
@@ -84,13 +158,40 @@ func (c *ImpactedWorkloadsCheck) Validate(
 
 	total := len(orchestrators)
 
-	var impactedCRs int
+	var (
+		impactedCRs        int
+		validatedDetectors int
+	)
+
+	detectorFailures := map[string][]string{}
 
 	for _, orch := range orchestrators {
 		cr := c.validateCR(ctx, target.Client, orch)
 
 		if len(cr.annotations) > 0 {
 			impactedCRs++
+		}
+
+		if c.ValidateDetectors {
+			validated, failures, err := c.validateDetectors(ctx, target.Client, orch.GetNamespace(), cr.detectors)
+			if err != nil {
+				return nil, fmt.Errorf("validating detectors of GuardrailsOrchestrator %s/%s: %w", orch.GetNamespace(), orch.GetName(), err)
+			}
+
+			validatedDetectors += validated
+
+			if len(failures) > 0 {
+				detectorFailures[orch.GetNamespace()+"/"+orch.GetName()] = failures
+
+				if cr.annotations == nil {
+					cr.annotations = map[string]string{}
+				}
+
+				cr.annotations[annotationDetectorsUnreachable] = strings.Join(failures, "; ")
+			}
+		}
+
+		if len(cr.annotations) > 0 {
 			c.appendImpactedObject(dr, orch, cr.annotations)
 		}
 
@@ -102,6 +203,12 @@ func (c *ImpactedWorkloadsCheck) Validate(
 		c.newConfigurationCondition(total, impactedCRs),
 	)
 
+	if c.ValidateDetectors {
+		dr.Status.Conditions = append(dr.Status.Conditions,
+			c.newDetectorsCondition(validatedDetectors, detectorFailures),
+		)
+	}
+
 	dr.Annotations[check.AnnotationImpactedWorkloadCount] = strconv.Itoa(len(dr.ImpactedObjects))
 
 	return dr, nil
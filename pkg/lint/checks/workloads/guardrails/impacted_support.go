@@ -34,6 +34,7 @@ type crResult struct {
 	orchCMName    string
 	gatewayCMName string
 	annotations   map[string]string
+	detectors     []detectorEndpoint
 }
 
 // validateCR validates a single GuardrailsOrchestrator CR and returns the
@@ -74,10 +75,12 @@ func (c *ImpactedWorkloadsCheck) validateCR(
 	}
 
 	if sr.config.orchestratorConfigName != "" {
-		orchIssues := c.validateOrchestratorConfigMap(ctx, reader, obj.GetNamespace(), sr.config.orchestratorConfigName)
+		configData, orchIssues := c.validateOrchestratorConfigMap(ctx, reader, obj.GetNamespace(), sr.config.orchestratorConfigName)
 		if len(orchIssues) > 0 {
 			cr.annotations[annotationOrchestratorCM] = strings.Join(orchIssues, "; ")
 		}
+
+		cr.detectors = orchestratorDetectors(configData)
 	}
 
 	if sr.config.gatewayConfigName != "" {
@@ -141,43 +144,43 @@ func (c *ImpactedWorkloadsCheck) checkBoolNotTrue(
 }
 
 // validateOrchestratorConfigMap validates the orchestrator ConfigMap's config.yaml content.
-// Returns a list of issues found.
+// Returns the parsed config.yaml, nil when it cannot be read, and a list of issues found.
 func (c *ImpactedWorkloadsCheck) validateOrchestratorConfigMap(
 	ctx context.Context,
 	reader client.Reader,
 	namespace string,
 	name string,
-) []string {
+) (map[string]any, []string) {
 	cm, err := reader.GetResource(ctx, resources.ConfigMap, name, client.InNamespace(namespace))
 	if err != nil {
-		return []string{"orchestrator ConfigMap not found"}
+		return nil, []string{"orchestrator ConfigMap not found"}
 	}
 
 	if cm == nil {
-		return []string{"orchestrator ConfigMap not found"}
+		return nil, []string{"orchestrator ConfigMap not found"}
 	}
 
 	// Extract config.yaml from the ConfigMap data.
 	configYAML, err := jq.Query[string](cm, ".data[\"config.yaml\"]")
 	if err != nil {
 		if errors.Is(err, jq.ErrNotFound) {
-			return []string{"orchestrator ConfigMap missing config.yaml"}
+			return nil, []string{"orchestrator ConfigMap missing config.yaml"}
 		}
 
-		return []string{fmt.Sprintf("failed to query config.yaml from orchestrator ConfigMap: %v", err)}
+		return nil, []string{fmt.Sprintf("failed to query config.yaml from orchestrator ConfigMap: %v", err)}
 	}
 
 	if configYAML == "" {
-		return []string{"orchestrator ConfigMap has empty config.yaml"}
+		return nil, []string{"orchestrator ConfigMap has empty config.yaml"}
 	}
 
 	// Parse the YAML content.
 	var configData map[string]any
 	if err := yaml.Unmarshal([]byte(configYAML), &configData); err != nil {
-		return []string{"orchestrator ConfigMap has invalid config.yaml"}
+		return nil, []string{"orchestrator ConfigMap has invalid config.yaml"}
 	}
 
-	return c.validateOrchestratorConfigData(configData)
+	return configData, c.validateOrchestratorConfigData(configData)
 }
 
 // validateOrchestratorConfigData checks the parsed config.yaml content for required fields.
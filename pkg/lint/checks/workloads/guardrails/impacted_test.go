@@ -1,6 +1,7 @@
 package guardrails_test
 
 import (
+	"maps"
 	"testing"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -478,3 +479,152 @@ func TestImpactedWorkloadsCheck_AnnotationTargetVersion(t *testing.T) {
 	g.Expect(err).ToNot(HaveOccurred())
 	g.Expect(result.Annotations).To(HaveKeyWithValue(check.AnnotationCheckTargetVersion, "3.0.0"))
 }
+
+func TestImpactedWorkloadsCheck_DetectorsReachable(t *testing.T) {
+	const detectorsConfigYAML = `chat_generation:
+  service:
+    hostname: llm-predictor.test-ns.svc.cluster.local
+    port: 8080
+detectors:
+  hap:
+    type: text_contents
+    service:
+      hostname: hap-detector
+      port: 8000
+  language:
+    type: text_contents
+    service:
+      hostname: language-detector.other-ns.svc
+      port: 8000
+  missing:
+    type: text_contents
+    service:
+      hostname: missing-detector
+      port: 8000
+  wrong-port:
+    type: text_contents
+    service:
+      hostname: hap-detector.test-ns
+      port: 9000
+  regex:
+    type: text_contents
+    service:
+      hostname: 127.0.0.1
+      port: 8080
+  external:
+    type: text_contents
+    service:
+      hostname: detector.example.com
+      port: 443
+`
+
+	listKinds := map[schema.GroupVersionResource]string{
+		resources.Service.GVR():       resources.Service.ListKind(),
+		resources.EndpointSlice.GVR(): resources.EndpointSlice.ListKind(),
+	}
+	maps.Copy(listKinds, impactedListKinds)
+
+	newService := func(name string, namespace string, port int64) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Service",
+			"metadata":   map[string]any{"name": name, "namespace": namespace},
+			"spec": map[string]any{
+				"ports": []any{map[string]any{"port": port}},
+			},
+		}}
+	}
+
+	newEndpointSlice := func(service string, namespace string, ready bool) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": resources.EndpointSlice.APIVersion(),
+			"kind":       resources.EndpointSlice.Kind,
+			"metadata": map[string]any{
+				"name":      service + "-abcde",
+				"namespace": namespace,
+				"labels":    map[string]any{"kubernetes.io/service-name": service},
+			},
+			"endpoints": []any{map[string]any{
+				"addresses":  []any{"10.0.0.1"},
+				"conditions": map[string]any{"ready": ready},
+			}},
+		}}
+	}
+
+	newTarget := func(t *testing.T) check.Target {
+		t.Helper()
+
+		return testutil.NewTarget(t, testutil.TargetConfig{
+			ListKinds: listKinds,
+			Objects: []*unstructured.Unstructured{
+				newTestOrchestrator("test-orch", "test-ns", map[string]any{
+					"orchestratorConfig":      "orch-config",
+					"enableGuardrailsGateway": true,
+					"enableBuiltInDetectors":  true,
+					"guardrailsGatewayConfig": "gateway-config",
+				}),
+				newTestConfigMap("orch-config", "test-ns", map[string]any{"config.yaml": detectorsConfigYAML}),
+				newTestConfigMap("gateway-config", "test-ns", map[string]any{"some-key": "some-value"}),
+				newService("hap-detector", "test-ns", 8000),
+				newEndpointSlice("hap-detector", "test-ns", true),
+				newService("language-detector", "other-ns", 8000),
+				newEndpointSlice("language-detector", "other-ns", false),
+			},
+			CurrentVersion: "2.17.0",
+			TargetVersion:  "3.0.0",
+		})
+	}
+
+	t.Run("should not validate detectors by default", func(t *testing.T) {
+		g := NewWithT(t)
+
+		result, err := guardrails.NewImpactedWorkloadsCheck().Validate(t.Context(), newTarget(t))
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.Status.Conditions).To(HaveLen(1))
+		g.Expect(result.ImpactedObjects).To(BeEmpty())
+	})
+
+	t.Run("should report detectors not resolving to a ready Service", func(t *testing.T) {
+		g := NewWithT(t)
+
+		chk := guardrails.NewImpactedWorkloadsCheck()
+		g.Expect(chk.SetParameters([]byte(`{"validateDetectors":true}`))).To(Succeed())
+		g.Expect(chk.ValidateDetectors).To(BeTrue())
+
+		result, err := chk.Validate(t.Context(), newTarget(t))
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.Status.Conditions).To(HaveLen(2))
+		g.Expect(result.Status.Conditions[0].Condition.Status).To(Equal(metav1.ConditionTrue))
+		g.Expect(result.Status.Conditions[1].Condition).To(MatchFields(IgnoreExtras, Fields{
+			"Type":   Equal(guardrails.ConditionTypeDetectorsReachable),
+			"Status": Equal(metav1.ConditionFalse),
+			"Reason": Equal(check.ReasonResourceUnavailable),
+		}))
+		g.Expect(result.Status.Conditions[1].Impact).To(Equal(resultpkg.ImpactAdvisory))
+		g.Expect(result.Status.Conditions[1].Condition.Message).To(And(
+			ContainSubstring("Found 3 of 4 detector service(s) not reachable"),
+			ContainSubstring("test-ns/test-orch language: Service other-ns/language-detector has no ready endpoints"),
+			ContainSubstring("missing: Service test-ns/missing-detector not found"),
+			ContainSubstring("wrong-port: Service test-ns/hap-detector does not expose port 9000"),
+		))
+		g.Expect(result.Status.Conditions[1].Condition.Message).ToNot(ContainSubstring("hap:"))
+
+		g.Expect(result.ImpactedObjects).To(HaveLen(1))
+		g.Expect(result.ImpactedObjects[0].Kind).To(Equal(resources.GuardrailsOrchestrator.Kind))
+		g.Expect(result.ImpactedObjects[0].Annotations).To(HaveKeyWithValue(
+			"guardrails.opendatahub.io/detectors-unreachable",
+			"language: Service other-ns/language-detector has no ready endpoints; "+
+				"missing: Service test-ns/missing-detector not found; "+
+				"wrong-port: Service test-ns/hap-detector does not expose port 9000",
+		))
+	})
+
+	t.Run("should reject unknown parameters", func(t *testing.T) {
+		g := NewWithT(t)
+
+		err := guardrails.NewImpactedWorkloadsCheck().SetParameters([]byte(`{"validate":true}`))
+		g.Expect(err).To(MatchError(ContainSubstring(`unknown field "validate"`)))
+	})
+}
@@ -18,6 +18,28 @@ const (
 	ConditionTypeOtelConfigCompatible = "OtelConfigCompatible"
 )
 
+// Examples rendered by 'lint explain'.
+const (
+	otelFailingExample = `apiVersion: trustyai.opendatahub.io/v1alpha1
+kind: GuardrailsOrchestrator
+metadata:
+  name: guardrails
+  namespace: team-a
+spec:
+  otelExporter:
+    otlpProtocol: grpc
+    otlpTracesEndpoint: http://traces:4317
+    enableTracing: true`
+
+	otelPassingExample = `apiVersion: trustyai.opendatahub.io/v1alpha1
+kind: GuardrailsOrchestrator
+metadata:
+  name: guardrails
+  namespace: team-a
+spec:
+  orchestratorConfig: fms-orchestr8-config`
+)
+
 // OtelMigrationCheck detects GuardrailsOrchestrator CRs using deprecated otelExporter configuration fields.
 type OtelMigrationCheck struct {
 	check.BaseCheck
@@ -32,6 +54,21 @@ func NewOtelMigrationCheck() *OtelMigrationCheck {
 			CheckID:          "workloads.guardrails.otel-config-migration",
 			CheckName:        "Workloads :: Guardrails :: OTEL Config Migration (3.x)",
 			CheckDescription: "Detects GuardrailsOrchestrator CRs using deprecated otelExporter configuration fields that need migration",
+			CheckResources: []resources.ResourceType{
+				resources.DataScienceCluster,
+				resources.GuardrailsOrchestrator,
+			},
+			CheckVersionGate:    check.VersionGateUpgrade2xTo3x,
+			CheckKnowledgeLinks: []string{check.DocsMonitoringModels},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "The .spec.otelExporter section of every GuardrailsOrchestrator. An empty otelExporter is ignored. The check only runs when upgrading from 2.x to 3.x with TrustyAI Managed.",
+				Rationale:      "The otelExporter structure changes in RHOAI 3.x. The 2.x fields are not carried over, so tracing and metrics export silently stop unless the configuration is migrated to the new format.",
+				FailingExample: otelFailingExample,
+				PassingExample: otelPassingExample,
+				RemediationCommands: []string{
+					"kubectl get guardrailsorchestrators --all-namespaces -o jsonpath='{range .items[?(@.spec.otelExporter)]}{.metadata.namespace}/{.metadata.name}{\"\\n\"}{end}'",
+				},
+			},
 		},
 	}
 }
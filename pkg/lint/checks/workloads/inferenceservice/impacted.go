@@ -0,0 +1,356 @@
+package inferenceservice
+
+import (
+	"context"
+	"fmt"
+	iolib "io"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/constants"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/components"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+const (
+	kind = "inferenceservice"
+
+	// ConditionTypeInferenceServicesCompatible indicates whether InferenceServices will be impacted by the 3.x upgrade.
+	ConditionTypeInferenceServicesCompatible = "InferenceServicesCompatible"
+
+	annotationDeploymentMode = "serving.kserve.io/deploymentMode"
+	deploymentModeModelMesh  = "ModelMesh"
+	deploymentModeServerless = "Serverless"
+
+	// Label of the ServingRuntime templates shown by the dashboard.
+	dashboardLabel = "opendatahub.io/dashboard=true"
+
+	// Annotation that indicates a Template is managed by the RHOAI operator.
+	// Templates without this annotation are user-contributed custom runtimes.
+	ootbPlatformVersionAnnotation = "platform.opendatahub.io/version"
+)
+
+// Annotations recorded on the impacted InferenceServices.
+const (
+	AnnotationStatus  = "check.opendatahub.io/status"
+	AnnotationRuntime = "check.opendatahub.io/runtime"
+	AnnotationReason  = "check.opendatahub.io/reason"
+)
+
+// Status represents the 3.x compatibility status of an InferenceService.
+type Status string
+
+const (
+	StatusGood        Status = "GOOD"
+	StatusProblematic Status = "PROBLEMATIC"
+	StatusCustom      Status = "CUSTOM"
+)
+
+// deprecatedAnnotationPrefixes are the Knative and Service Mesh annotations that have no effect
+// in 3.x, where InferenceServices are served as raw Deployments without a sidecar.
+//
+//nolint:gochecknoglobals // Read-only list of annotation prefixes
+var deprecatedAnnotationPrefixes = []string{
+	"serving.knative.openshift.io/enablePassthrough",
+	"sidecar.istio.io/inject",
+	"sidecar.istio.io/rewriteAppHTTPProbers",
+	"autoscaling.knative.dev/",
+}
+
+// Examples rendered by 'lint explain'.
+const (
+	impactedFailingExample = `apiVersion: serving.kserve.io/v1beta1
+kind: InferenceService
+metadata:
+  name: fraud-detection
+  namespace: team-a
+  annotations:
+    serving.kserve.io/deploymentMode: Serverless
+    serving.knative.openshift.io/enablePassthrough: "true"
+spec:
+  predictor:
+    model:
+      runtime: vllm-cuda-runtime`
+
+	impactedPassingExample = `apiVersion: serving.kserve.io/v1beta1
+kind: InferenceService
+metadata:
+  name: fraud-detection
+  namespace: team-a
+  annotations:
+    serving.kserve.io/deploymentMode: RawDeployment
+spec:
+  predictor:
+    model:
+      runtime: vllm-cuda-runtime  # created from the OOTB template, with its image`
+)
+
+// inferenceServiceAnalysis contains the analysis result for a single InferenceService.
+type inferenceServiceAnalysis struct {
+	Namespace string
+	Name      string
+	Runtime   string
+	Status    Status
+	Reason    string
+}
+
+// ImpactedWorkloadsCheck classifies InferenceServices by whether they keep being served in
+// RHOAI 3.x: their deployment mode, the provenance of their ServingRuntime image and the
+// Knative and Service Mesh annotations they rely on.
+type ImpactedWorkloadsCheck struct {
+	check.BaseCheck
+}
+
+func NewImpactedWorkloadsCheck() *ImpactedWorkloadsCheck {
+	// Register custom group renderer for verbose output of impacted InferenceServices.
+	// Groups InferenceServices by status for better readability.
+	check.RegisterImpactedGroupRenderer(check.GroupWorkload, kind, check.CheckTypeImpactedWorkloads, renderImpactedGroup)
+
+	return &ImpactedWorkloadsCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupWorkload,
+			Kind:             kind,
+			Type:             check.CheckTypeImpactedWorkloads,
+			CheckID:          "workloads.inferenceservice.impacted-workloads",
+			CheckName:        "Workloads :: InferenceService :: Impacted Workloads (3.x)",
+			CheckDescription: "Classifies InferenceServices by deployment mode, ServingRuntime image provenance and deprecated annotations to identify those that will not be served in RHOAI 3.x",
+			CheckRemediation: "Migrate Serverless and ModelMesh InferenceServices to RawDeployment and remove Knative and Service Mesh annotations before upgrading",
+			CheckResources: []resources.ResourceType{
+				resources.DataScienceCluster,
+				resources.DSCInitialization,
+				resources.InferenceService,
+				resources.ServingRuntime,
+				resources.Template,
+			},
+			CheckVersionGate:    check.VersionGateUpgrade2xTo3x,
+			CheckKnowledgeLinks: []string{check.DocsServingModels},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "The deployment mode and annotations of every InferenceService, and the container images of the ServingRuntime it references, matched against the ServingRuntimes of the operator-managed templates in the applications namespace. InferenceServices using Serverless or ModelMesh, or Knative and Service Mesh annotations, are incompatible; those whose runtime is missing or uses an image not shipped by a template are reported as custom. The check only runs when upgrading from 2.x to 3.x with KServe or ModelMesh Managed.",
+				Rationale:      "RHOAI 3.x serves InferenceServices as raw Deployments only: Serverless and ModelMesh models stop being served, and Knative and Service Mesh annotations silently stop taking effect. Runtimes built from custom images are not updated by the upgrade, so their owners need to verify them against the 3.x serving stack.",
+				FailingExample: impactedFailingExample,
+				PassingExample: impactedPassingExample,
+				RemediationCommands: []string{
+					"kubectl odh migrate inferenceservice to-raw --dry-run",
+					"kubectl odh lint --target-version 3.0 --checks workloads.inferenceservice.impacted-workloads -v",
+				},
+			},
+		},
+	}
+}
+
+// renderImpactedGroup renders impacted InferenceServices grouped by status.
+// Output format:
+//
+//	incompatible InferenceServices (N):
+//	  - namespace/name (runtime): reason
+func renderImpactedGroup(out iolib.Writer, objects []metav1.PartialObjectMetadata, maxDisplay int) {
+	displayed := 0
+
+	for _, status := range []Status{StatusProblematic, StatusCustom} {
+		var group []metav1.PartialObjectMetadata
+
+		for _, obj := range objects {
+			if Status(obj.Annotations[AnnotationStatus]) == status {
+				group = append(group, obj)
+			}
+		}
+
+		if len(group) == 0 {
+			continue
+		}
+
+		_, _ = fmt.Fprintf(out, "    %s (%d):\n", statusLabel(status), len(group))
+
+		for _, obj := range group {
+			if displayed >= maxDisplay {
+				_, _ = fmt.Fprintf(out, "      ... and %d more InferenceServices. Use --output json for the full list.\n",
+					len(objects)-displayed)
+
+				return
+			}
+
+			runtime := obj.Annotations[AnnotationRuntime]
+			if runtime == "" {
+				runtime = "no runtime"
+			}
+
+			_, _ = fmt.Fprintf(out, "      - %s/%s (%s): %s\n", obj.Namespace, obj.Name, runtime, obj.Annotations[AnnotationReason])
+			displayed++
+		}
+	}
+}
+
+// statusLabel returns a user-friendly label for the status.
+func statusLabel(status Status) string {
+	switch status {
+	case StatusGood:
+		return "compatible InferenceServices"
+	case StatusProblematic:
+		return "incompatible InferenceServices"
+	case StatusCustom:
+		return "custom InferenceServices"
+	}
+
+	return "InferenceServices"
+}
+
+// CanApply returns whether this check should run for the given target.
+// Only applies when upgrading FROM 2.x TO 3.x and KServe or ModelMesh is Managed.
+func (c *ImpactedWorkloadsCheck) CanApply(ctx context.Context, target check.Target) (bool, error) {
+	if !version.IsUpgradeFrom2xTo3x(target.CurrentVersion, target.TargetVersion) {
+		return false, nil
+	}
+
+	dsc, err := client.GetDataScienceCluster(ctx, target.Client)
+	if err != nil {
+		return false, fmt.Errorf("getting DataScienceCluster: %w", err)
+	}
+
+	return components.HasManagementState(dsc, constants.ComponentKServe, constants.ManagementStateManaged) ||
+		components.HasManagementState(dsc, "modelmeshserving", constants.ManagementStateManaged), nil
+}
+
+// Validate executes the check against the provided target.
+func (c *ImpactedWorkloadsCheck) Validate(
+	ctx context.Context,
+	target check.Target,
+) (*result.DiagnosticResult, error) {
+	return validate.Workloads(c, target, resources.InferenceService).
+		Run(ctx, func(ctx context.Context, req *validate.WorkloadRequest[*unstructured.Unstructured]) error {
+			return c.analyzeInferenceServices(ctx, req)
+		})
+}
+
+// analyzeInferenceServices classifies all InferenceServices and sets the condition and impacted objects.
+func (c *ImpactedWorkloadsCheck) analyzeInferenceServices(
+	ctx context.Context,
+	req *validate.WorkloadRequest[*unstructured.Unstructured],
+) error {
+	if len(req.Items) == 0 {
+		req.Result.SetCondition(check.NewCondition(
+			ConditionTypeInferenceServicesCompatible,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonVersionCompatible),
+			check.WithMessage("No InferenceServices found"),
+		))
+
+		return nil
+	}
+
+	appNS, err := client.GetApplicationsNamespace(ctx, req.Client)
+	if err != nil {
+		return fmt.Errorf("getting applications namespace: %w", err)
+	}
+
+	ootbImages, err := discoverOOTBRuntimeImages(ctx, req.Client, appNS)
+	if err != nil {
+		return fmt.Errorf("discovering OOTB ServingRuntimes: %w", err)
+	}
+
+	runtimes, err := listServingRuntimes(ctx, req.Client)
+	if err != nil {
+		return err
+	}
+
+	analyses := make([]inferenceServiceAnalysis, 0, len(req.Items))
+
+	for _, isvc := range req.Items {
+		analyses = append(analyses, analyzeInferenceService(isvc, runtimes, ootbImages))
+	}
+
+	c.setConditions(req.Result, analyses)
+	setImpactedObjects(req.Result, analyses)
+
+	return nil
+}
+
+// setConditions sets the diagnostic condition based on analysis results.
+func (c *ImpactedWorkloadsCheck) setConditions(
+	dr *result.DiagnosticResult,
+	analyses []inferenceServiceAnalysis,
+) {
+	var goodCount, customCount, problematicCount int
+
+	for _, a := range analyses {
+		switch a.Status {
+		case StatusGood:
+			goodCount++
+		case StatusCustom:
+			customCount++
+		case StatusProblematic:
+			problematicCount++
+		}
+	}
+
+	message := fmt.Sprintf(`Found %d InferenceService(s):
+  - %d compatible (OOTB runtime, RawDeployment)
+  - %d custom (user verification needed)
+  - %d incompatible (must migrate before upgrade)`,
+		len(analyses), goodCount, customCount, problematicCount)
+
+	switch {
+	case problematicCount > 0:
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeInferenceServicesCompatible,
+			metav1.ConditionFalse,
+			check.WithReason(check.ReasonWorkloadsImpacted),
+			check.WithMessage("%s", message),
+			check.WithImpact(result.ImpactBlocking),
+			check.WithRemediation(c.CheckRemediation),
+		))
+
+	case customCount > 0:
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeInferenceServicesCompatible,
+			metav1.ConditionFalse,
+			check.WithReason(check.ReasonWorkloadsImpacted),
+			check.WithMessage("%s", message),
+			check.WithImpact(result.ImpactAdvisory),
+			check.WithRemediation("Verify custom ServingRuntimes are compatible with RHOAI 3.x before upgrading"),
+		))
+
+	default:
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeInferenceServicesCompatible,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonVersionCompatible),
+			check.WithMessage("All %d InferenceService(s) use OOTB ServingRuntimes in RawDeployment mode", len(analyses)),
+		))
+	}
+}
+
+// setImpactedObjects sets the ImpactedObjects to problematic and custom InferenceServices.
+// Uses an empty slice (not nil) to prevent validate.Workloads from auto-populating.
+func setImpactedObjects(
+	dr *result.DiagnosticResult,
+	analyses []inferenceServiceAnalysis,
+) {
+	impacted := make([]metav1.PartialObjectMetadata, 0)
+
+	for _, a := range analyses {
+		if a.Status == StatusGood {
+			continue
+		}
+
+		impacted = append(impacted, metav1.PartialObjectMetadata{
+			TypeMeta: resources.InferenceService.TypeMeta(),
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: a.Namespace,
+				Name:      a.Name,
+				Annotations: map[string]string{
+					AnnotationStatus:  string(a.Status),
+					AnnotationRuntime: a.Runtime,
+					AnnotationReason:  a.Reason,
+				},
+			},
+		})
+	}
+
+	dr.ImpactedObjects = impacted
+}
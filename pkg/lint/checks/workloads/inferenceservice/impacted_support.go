@@ -0,0 +1,163 @@
+package inferenceservice
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube"
+)
+
+// analyzeInferenceService classifies an InferenceService. It is PROBLEMATIC when it uses a
+// deployment mode removed in 3.x or deprecated annotations, CUSTOM when its ServingRuntime is
+// missing or runs an image not shipped by an OOTB template, and GOOD otherwise.
+func analyzeInferenceService(
+	isvc *unstructured.Unstructured,
+	runtimes map[types.NamespacedName]*unstructured.Unstructured,
+	ootbImages map[string]struct{},
+) inferenceServiceAnalysis {
+	runtime, _ := jq.Query[string](isvc, ".spec.predictor.model.runtime")
+
+	analysis := inferenceServiceAnalysis{
+		Namespace: isvc.GetNamespace(),
+		Name:      isvc.GetName(),
+		Runtime:   runtime,
+	}
+
+	var problems []string
+
+	mode := kube.GetAnnotation(isvc, annotationDeploymentMode)
+	if mode == deploymentModeServerless || mode == deploymentModeModelMesh {
+		problems = append(problems, fmt.Sprintf("deployment mode %s is removed in 3.x", mode))
+	}
+
+	if annotations := deprecatedAnnotations(isvc); len(annotations) > 0 {
+		problems = append(problems, "annotations "+strings.Join(annotations, ", ")+" have no effect in 3.x")
+	}
+
+	if len(problems) > 0 {
+		analysis.Status = StatusProblematic
+		analysis.Reason = strings.Join(problems, "; ")
+
+		return analysis
+	}
+
+	analysis.Status, analysis.Reason = analyzeRuntime(isvc, runtime, runtimes, ootbImages)
+
+	return analysis
+}
+
+// analyzeRuntime classifies the ServingRuntime an InferenceService references by the provenance
+// of its container images.
+func analyzeRuntime(
+	isvc *unstructured.Unstructured,
+	runtime string,
+	runtimes map[types.NamespacedName]*unstructured.Unstructured,
+	ootbImages map[string]struct{},
+) (Status, string) {
+	if runtime == "" {
+		return StatusCustom, "no ServingRuntime referenced"
+	}
+
+	sr, ok := runtimes[types.NamespacedName{Namespace: isvc.GetNamespace(), Name: runtime}]
+	if !ok {
+		return StatusCustom, fmt.Sprintf("ServingRuntime %s not found", runtime)
+	}
+
+	images, err := jq.Query[[]string](sr, "[.spec.containers[]?.image]")
+	if err != nil || len(images) == 0 {
+		return StatusCustom, fmt.Sprintf("ServingRuntime %s has no container images", runtime)
+	}
+
+	for _, image := range images {
+		if _, ok := ootbImages[image]; !ok {
+			return StatusCustom, fmt.Sprintf("image %s is not shipped by an OOTB ServingRuntime template", image)
+		}
+	}
+
+	return StatusGood, ""
+}
+
+// deprecatedAnnotations returns the annotations of obj with a deprecated prefix, sorted.
+func deprecatedAnnotations(obj *unstructured.Unstructured) []string {
+	var found []string
+
+	for key := range obj.GetAnnotations() {
+		if slices.ContainsFunc(deprecatedAnnotationPrefixes, func(prefix string) bool {
+			return strings.HasPrefix(key, prefix)
+		}) {
+			found = append(found, key)
+		}
+	}
+
+	slices.Sort(found)
+
+	return found
+}
+
+// discoverOOTBRuntimeImages returns the container images of the ServingRuntimes shipped by the
+// operator-managed dashboard templates in the applications namespace.
+func discoverOOTBRuntimeImages(
+	ctx context.Context,
+	reader client.Reader,
+	appNS string,
+) (map[string]struct{}, error) {
+	templates, err := reader.List(ctx, resources.Template,
+		client.WithNamespace(appNS),
+		client.WithLabelSelector(dashboardLabel),
+	)
+	if err != nil {
+		if client.IsResourceTypeNotFound(err) {
+			return make(map[string]struct{}), nil
+		}
+
+		return nil, fmt.Errorf("listing Templates: %w", err)
+	}
+
+	images := make(map[string]struct{})
+
+	for _, tpl := range templates {
+		// Templates without the platform version annotation are user-contributed runtimes.
+		if kube.GetAnnotation(tpl, ootbPlatformVersionAnnotation) == "" {
+			continue
+		}
+
+		tplImages, err := jq.Query[[]string](tpl,
+			`[.objects[]? | select(.kind == "`+resources.ServingRuntime.Kind+`") | .spec.containers[]?.image]`)
+		if err != nil {
+			return nil, fmt.Errorf("reading images of Template %s: %w", tpl.GetName(), err)
+		}
+
+		for _, image := range tplImages {
+			images[image] = struct{}{}
+		}
+	}
+
+	return images, nil
+}
+
+// listServingRuntimes returns all ServingRuntimes indexed by namespace and name.
+func listServingRuntimes(
+	ctx context.Context,
+	reader client.Reader,
+) (map[types.NamespacedName]*unstructured.Unstructured, error) {
+	items, err := reader.List(ctx, resources.ServingRuntime)
+	if err != nil && !client.IsResourceTypeNotFound(err) {
+		return nil, fmt.Errorf("listing ServingRuntimes: %w", err)
+	}
+
+	runtimes := make(map[types.NamespacedName]*unstructured.Unstructured, len(items))
+
+	for _, sr := range items {
+		runtimes[types.NamespacedName{Namespace: sr.GetNamespace(), Name: sr.GetName()}] = sr
+	}
+
+	return runtimes, nil
+}
@@ -0,0 +1,346 @@
+package inferenceservice_test
+
+import (
+	"bytes"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/inferenceservice"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	fixtures "github.com/opendatahub-io/odh-cli/pkg/util/test/testutil"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+//nolint:gochecknoglobals
+var listKinds = map[schema.GroupVersionResource]string{
+	resources.InferenceService.GVR():   resources.InferenceService.ListKind(),
+	resources.ServingRuntime.GVR():     resources.ServingRuntime.ListKind(),
+	resources.Template.GVR():           resources.Template.ListKind(),
+	resources.DataScienceCluster.GVR(): resources.DataScienceCluster.ListKind(),
+	resources.DSCInitialization.GVR():  resources.DSCInitialization.ListKind(),
+}
+
+const (
+	applicationsNS = "redhat-ods-applications"
+
+	ootbImage   = "registry.redhat.io/rhoai/odh-vllm-cuda-rhel9@sha256:ootb123"
+	customImage = "quay.io/myorg/custom-runtime:v1.0"
+
+	runtimeName = "vllm-cuda-runtime"
+)
+
+// Helper functions to create test fixtures.
+
+func newInferenceService(name string, mode string, runtime string, annotations map[string]string) *unstructured.Unstructured {
+	metadataAnnotations := map[string]any{}
+	if mode != "" {
+		metadataAnnotations["serving.kserve.io/deploymentMode"] = mode
+	}
+
+	for k, v := range annotations {
+		metadataAnnotations[k] = v
+	}
+
+	model := map[string]any{
+		"modelFormat": map[string]any{"name": "onnx"},
+	}
+	if runtime != "" {
+		model["runtime"] = runtime
+	}
+
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.InferenceService.APIVersion(),
+			"kind":       resources.InferenceService.Kind,
+			"metadata": map[string]any{
+				"name":        name,
+				"namespace":   "team-a",
+				"annotations": metadataAnnotations,
+			},
+			"spec": map[string]any{
+				"predictor": map[string]any{
+					"model": model,
+				},
+			},
+		},
+	}
+}
+
+func newServingRuntime(name string, image string) *unstructured.Unstructured {
+	return fixtures.NewServingRuntime("team-a", name, image)
+}
+
+func newTemplate(name string, image string, managed bool) *unstructured.Unstructured {
+	template := fixtures.NewServingRuntimeTemplate(applicationsNS, name, runtimeName, image)
+	if managed {
+		template.SetAnnotations(map[string]string{"platform.opendatahub.io/version": "2.25.0"})
+	}
+
+	return template
+}
+
+func TestImpactedWorkloadsCheck_NoInferenceServices(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      listKinds,
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	result, err := inferenceservice.NewImpactedWorkloadsCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Status.Conditions).To(HaveLen(1))
+	g.Expect(result.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":    Equal(inferenceservice.ConditionTypeInferenceServicesCompatible),
+		"Status":  Equal(metav1.ConditionTrue),
+		"Message": ContainSubstring("No InferenceServices found"),
+	}))
+	g.Expect(result.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "0"))
+	g.Expect(result.Annotations).To(HaveKeyWithValue(check.AnnotationCheckTargetVersion, "3.0.0"))
+	g.Expect(result.ImpactedObjects).To(BeEmpty())
+}
+
+func TestImpactedWorkloadsCheck_Classification(t *testing.T) {
+	tests := []struct {
+		name           string
+		objects        []*unstructured.Unstructured
+		expectedStatus inferenceservice.Status
+		expectedReason string
+	}{
+		{
+			name: "RawDeployment_OOTBRuntime",
+			objects: []*unstructured.Unstructured{
+				newTemplate("vllm-cuda-runtime-template", ootbImage, true),
+				newServingRuntime(runtimeName, ootbImage),
+				newInferenceService("llm", "RawDeployment", runtimeName, nil),
+			},
+			expectedStatus: inferenceservice.StatusGood,
+		},
+		{
+			name: "Serverless",
+			objects: []*unstructured.Unstructured{
+				newTemplate("vllm-cuda-runtime-template", ootbImage, true),
+				newServingRuntime(runtimeName, ootbImage),
+				newInferenceService("llm", "Serverless", runtimeName, nil),
+			},
+			expectedStatus: inferenceservice.StatusProblematic,
+			expectedReason: "deployment mode Serverless is removed in 3.x",
+		},
+		{
+			name: "ModelMesh",
+			objects: []*unstructured.Unstructured{
+				newInferenceService("llm", "ModelMesh", "", nil),
+			},
+			expectedStatus: inferenceservice.StatusProblematic,
+			expectedReason: "deployment mode ModelMesh is removed in 3.x",
+		},
+		{
+			name: "DeprecatedAnnotations",
+			objects: []*unstructured.Unstructured{
+				newTemplate("vllm-cuda-runtime-template", ootbImage, true),
+				newServingRuntime(runtimeName, ootbImage),
+				newInferenceService("llm", "RawDeployment", runtimeName, map[string]string{
+					"sidecar.istio.io/inject":                    "true",
+					"autoscaling.knative.dev/target":             "10",
+					"serving.kserve.io/enable-prometheus-scrape": "true",
+				}),
+			},
+			expectedStatus: inferenceservice.StatusProblematic,
+			expectedReason: "annotations autoscaling.knative.dev/target, sidecar.istio.io/inject have no effect in 3.x",
+		},
+		{
+			name: "CustomImage",
+			objects: []*unstructured.Unstructured{
+				newTemplate("vllm-cuda-runtime-template", ootbImage, true),
+				newServingRuntime(runtimeName, customImage),
+				newInferenceService("llm", "RawDeployment", runtimeName, nil),
+			},
+			expectedStatus: inferenceservice.StatusCustom,
+			expectedReason: "image " + customImage + " is not shipped by an OOTB ServingRuntime template",
+		},
+		{
+			name: "UserContributedTemplate",
+			objects: []*unstructured.Unstructured{
+				newTemplate("my-runtime-template", ootbImage, false),
+				newServingRuntime(runtimeName, ootbImage),
+				newInferenceService("llm", "RawDeployment", runtimeName, nil),
+			},
+			expectedStatus: inferenceservice.StatusCustom,
+			expectedReason: "is not shipped by an OOTB ServingRuntime template",
+		},
+		{
+			name: "MissingRuntime",
+			objects: []*unstructured.Unstructured{
+				newInferenceService("llm", "RawDeployment", runtimeName, nil),
+			},
+			expectedStatus: inferenceservice.StatusCustom,
+			expectedReason: "ServingRuntime " + runtimeName + " not found",
+		},
+		{
+			name: "NoRuntime",
+			objects: []*unstructured.Unstructured{
+				newInferenceService("llm", "RawDeployment", "", nil),
+			},
+			expectedStatus: inferenceservice.StatusCustom,
+			expectedReason: "no ServingRuntime referenced",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			target := testutil.NewTarget(t, testutil.TargetConfig{
+				ListKinds:      listKinds,
+				Objects:        append(tc.objects, testutil.NewDSCI(applicationsNS)),
+				CurrentVersion: "2.25.0",
+				TargetVersion:  "3.0.0",
+			})
+
+			result, err := inferenceservice.NewImpactedWorkloadsCheck().Validate(t.Context(), target)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(result.Status.Conditions).To(HaveLen(1))
+
+			condition := result.Status.Conditions[0]
+
+			switch tc.expectedStatus {
+			case inferenceservice.StatusGood:
+				g.Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+				g.Expect(condition.Impact).To(Equal(resultpkg.ImpactNone))
+				g.Expect(result.ImpactedObjects).To(BeEmpty())
+
+				return
+			case inferenceservice.StatusProblematic:
+				g.Expect(condition.Impact).To(Equal(resultpkg.ImpactBlocking))
+			case inferenceservice.StatusCustom:
+				g.Expect(condition.Impact).To(Equal(resultpkg.ImpactAdvisory))
+			}
+
+			g.Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+			g.Expect(condition.Reason).To(Equal(check.ReasonWorkloadsImpacted))
+			g.Expect(result.ImpactedObjects).To(HaveLen(1))
+
+			obj := result.ImpactedObjects[0]
+			g.Expect(obj.Kind).To(Equal(resources.InferenceService.Kind))
+			g.Expect(obj.Name).To(Equal("llm"))
+			g.Expect(obj.Annotations).To(HaveKeyWithValue(inferenceservice.AnnotationStatus, string(tc.expectedStatus)))
+			g.Expect(obj.Annotations[inferenceservice.AnnotationReason]).To(ContainSubstring(tc.expectedReason))
+		})
+	}
+}
+
+func TestImpactedWorkloadsCheck_MixedInferenceServices(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: listKinds,
+		Objects: []*unstructured.Unstructured{
+			testutil.NewDSCI(applicationsNS),
+			newTemplate("vllm-cuda-runtime-template", ootbImage, true),
+			newServingRuntime(runtimeName, ootbImage),
+			newInferenceService("good", "RawDeployment", runtimeName, nil),
+			newInferenceService("custom", "RawDeployment", "", nil),
+			newInferenceService("serverless", "Serverless", runtimeName, nil),
+		},
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	chk := inferenceservice.NewImpactedWorkloadsCheck()
+	result, err := chk.Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactBlocking))
+	g.Expect(result.Status.Conditions[0].Message).To(And(
+		ContainSubstring("Found 3 InferenceService(s)"),
+		ContainSubstring("1 compatible"),
+		ContainSubstring("1 custom"),
+		ContainSubstring("1 incompatible"),
+	))
+	g.Expect(result.ImpactedObjects).To(HaveLen(2))
+
+	// Verbose output groups the impacted InferenceServices by status
+	renderer := check.GetImpactedGroupRenderer(chk.Group(), chk.CheckKind(), check.CheckTypeImpactedWorkloads)
+	g.Expect(renderer).ToNot(BeNil())
+
+	var buf bytes.Buffer
+	renderer(&buf, result.ImpactedObjects, 10)
+	g.Expect(buf.String()).To(MatchRegexp(`(?s)incompatible InferenceServices \(1\):\n\s+- team-a/serverless.*custom InferenceServices \(1\):\n\s+- team-a/custom \(no runtime\)`))
+}
+
+func TestImpactedWorkloadsCheck_Metadata(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := inferenceservice.NewImpactedWorkloadsCheck()
+
+	g.Expect(chk.ID()).To(Equal("workloads.inferenceservice.impacted-workloads"))
+	g.Expect(chk.Name()).To(Equal("Workloads :: InferenceService :: Impacted Workloads (3.x)"))
+	g.Expect(chk.Group()).To(Equal(check.GroupWorkload))
+	g.Expect(chk.Description()).ToNot(BeEmpty())
+}
+
+func TestImpactedWorkloadsCheck_CanApply(t *testing.T) {
+	tests := []struct {
+		name           string
+		currentVersion string
+		targetVersion  string
+		components     map[string]string
+		expected       bool
+	}{
+		{
+			name:           "LintMode_SameVersion",
+			currentVersion: "2.25.0",
+			targetVersion:  "2.25.0",
+			components:     map[string]string{"kserve": "Managed"},
+			expected:       false,
+		},
+		{
+			name:           "Upgrade2xTo3x_KServeManaged",
+			currentVersion: "2.25.0",
+			targetVersion:  "3.0.0",
+			components:     map[string]string{"kserve": "Managed"},
+			expected:       true,
+		},
+		{
+			name:           "Upgrade2xTo3x_ModelMeshManaged",
+			currentVersion: "2.25.0",
+			targetVersion:  "3.0.0",
+			components:     map[string]string{"kserve": "Removed", "modelmeshserving": "Managed"},
+			expected:       true,
+		},
+		{
+			name:           "Upgrade2xTo3x_Removed",
+			currentVersion: "2.25.0",
+			targetVersion:  "3.0.0",
+			components:     map[string]string{"kserve": "Removed"},
+			expected:       false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			target := testutil.NewTarget(t, testutil.TargetConfig{
+				ListKinds:      listKinds,
+				Objects:        []*unstructured.Unstructured{testutil.NewDSC(tc.components)},
+				CurrentVersion: tc.currentVersion,
+				TargetVersion:  tc.targetVersion,
+			})
+
+			canApply, err := inferenceservice.NewImpactedWorkloadsCheck().CanApply(t.Context(), target)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(canApply).To(Equal(tc.expected))
+		})
+	}
+}
@@ -19,6 +19,25 @@ import (
 
 const ConditionTypeISVCAcceleratorProfileCompatible = "AcceleratorProfileCompatible"
 
+// Examples rendered by 'lint explain'.
+const (
+	acceleratorFailingExample = `apiVersion: serving.kserve.io/v1beta1
+kind: InferenceService
+metadata:
+  name: llm
+  namespace: team-a
+  annotations:
+    opendatahub.io/accelerator-name: nvidia-gpu`
+
+	acceleratorPassingExample = `apiVersion: serving.kserve.io/v1beta1
+kind: InferenceService
+metadata:
+  name: llm
+  namespace: team-a
+  annotations:
+    opendatahub.io/hardware-profile-name: nvidia-gpu`
+)
+
 // AcceleratorMigrationCheck detects InferenceService CRs referencing legacy AcceleratorProfiles
 // that will be auto-migrated to HardwareProfiles (infrastructure.opendatahub.io) during RHOAI 3.x upgrade.
 type AcceleratorMigrationCheck struct {
@@ -35,6 +54,22 @@ func NewAcceleratorMigrationCheck() *AcceleratorMigrationCheck {
 			CheckName:        "Workloads :: KServe :: AcceleratorProfile Migration (3.x)",
 			CheckDescription: "Detects InferenceService CRs referencing legacy AcceleratorProfiles that will be auto-migrated to HardwareProfiles (infrastructure.opendatahub.io) during upgrade",
 			CheckRemediation: "Legacy AcceleratorProfiles will be automatically migrated to HardwareProfiles (infrastructure.opendatahub.io) during upgrade - no manual action required",
+			CheckResources: []resources.ResourceType{
+				resources.DataScienceCluster,
+				resources.InferenceService,
+				resources.AcceleratorProfile,
+			},
+			CheckVersionGate:    check.VersionGateUpgrade2xTo3x,
+			CheckKnowledgeLinks: []string{check.DocsAccelerators},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "The opendatahub.io/accelerator-name annotation of every InferenceService, and whether the referenced AcceleratorProfile still exists. The check only runs when upgrading from 2.x to 3.x with KServe or ModelMesh Managed.",
+				Rationale:      "AcceleratorProfiles are replaced by HardwareProfiles (infrastructure.opendatahub.io) in 3.x. Profiles and references are migrated automatically during the upgrade; the check is advisory so the migration can be reviewed, and a reference to a missing AcceleratorProfile has nothing to migrate to.",
+				FailingExample: acceleratorFailingExample,
+				PassingExample: acceleratorPassingExample,
+				RemediationCommands: []string{
+					"kubectl get acceleratorprofiles --all-namespaces",
+				},
+			},
 		},
 	}
 }
@@ -45,6 +45,33 @@ const (
 	runtimeCaikitTGIS       = "caikit-tgis-serving-template"
 )
 
+// Examples rendered by 'lint explain'.
+const (
+	impactedFailingExample = `apiVersion: serving.kserve.io/v1beta1
+kind: InferenceService
+metadata:
+  name: llm
+  namespace: team-a
+  annotations:
+    serving.kserve.io/deploymentMode: Serverless
+spec:
+  predictor:
+    model:
+      runtime: caikit-tgis-serving-template`
+
+	impactedPassingExample = `apiVersion: serving.kserve.io/v1beta1
+kind: InferenceService
+metadata:
+  name: llm
+  namespace: team-a
+  annotations:
+    serving.kserve.io/deploymentMode: RawDeployment
+spec:
+  predictor:
+    model:
+      runtime: vllm-runtime`
+)
+
 // ImpactedWorkloadsCheck lists InferenceServices and ServingRuntimes using deprecated deployment modes.
 type ImpactedWorkloadsCheck struct {
 	check.BaseCheck
@@ -60,6 +87,23 @@ func NewImpactedWorkloadsCheck() *ImpactedWorkloadsCheck {
 			CheckName:        "Workloads :: KServe :: Impacted Workloads (3.x)",
 			CheckDescription: "Lists InferenceServices and ServingRuntimes using deprecated deployment modes (ModelMesh, Serverless), removed ServingRuntimes, or ServingRuntimes referencing legacy AcceleratorProfiles that will be impacted in RHOAI 3.x",
 			CheckRemediation: "Migrate InferenceServices from Serverless/ModelMesh to RawDeployment mode, update ServingRuntimes to supported versions, and review AcceleratorProfile references before upgrading",
+			CheckResources: []resources.ResourceType{
+				resources.DataScienceCluster,
+				resources.InferenceService,
+				resources.ServingRuntime,
+			},
+			CheckVersionGate:    check.VersionGateUpgrade2xTo3x,
+			CheckKnowledgeLinks: []string{check.DocsServingModels},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "The serving.kserve.io/deploymentMode annotation of InferenceServices and ServingRuntimes, the ServingRuntime each InferenceService uses (ovms, caikit-standalone-serving-template and caikit-tgis-serving-template are removed), and ServingRuntimes carrying AcceleratorProfile and HardwareProfile annotations. The check only runs when upgrading from 2.x to 3.x with KServe or ModelMesh Managed.",
+				Rationale:      "RHOAI 3.x drops the Serverless and ModelMesh deployment modes and the removed ServingRuntime templates. Models deployed with them stop being served after the upgrade unless they are migrated to RawDeployment and a supported runtime first.",
+				FailingExample: impactedFailingExample,
+				PassingExample: impactedPassingExample,
+				RemediationCommands: []string{
+					"kubectl odh migrate inferenceservice to-raw --dry-run",
+					"kubectl odh migrate modelmesh --dry-run",
+				},
+			},
 		},
 	}
 }
@@ -13,6 +13,7 @@ import (
 
 	"github.com/opendatahub-io/odh-cli/pkg/constants"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/remediation"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
 	"github.com/opendatahub-io/odh-cli/pkg/resources"
@@ -51,6 +52,31 @@ type inferenceServiceConfig struct {
 	ServiceAnnotationDisallowedList []string `json:"serviceAnnotationDisallowedList"`
 }
 
+// Examples rendered by 'lint explain'.
+const (
+	configFailingExample = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: inferenceservice-config
+  namespace: redhat-ods-applications
+data:
+  inferenceService: |
+    {"serviceAnnotationDisallowedList": []}`
+
+	configPassingExample = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: inferenceservice-config
+  namespace: redhat-ods-applications
+  annotations:
+    opendatahub.io/managed: "false"
+data:
+  inferenceService: |
+    {"serviceAnnotationDisallowedList": [
+      "opendatahub.io/hardware-profile-name",
+      "opendatahub.io/hardware-profile-namespace"]}`
+)
+
 // InferenceServiceConfigCheck validates that the inferenceservice-config ConfigMap
 // has opendatahub.io/managed=false and includes hardware-profile annotations in the
 // serviceAnnotationDisallowedList before upgrading to 3.x.
@@ -68,6 +94,23 @@ func NewInferenceServiceConfigCheck() *InferenceServiceConfigCheck {
 			CheckName:        "Workloads :: KServe :: InferenceService Config Migration",
 			CheckDescription: "Validates that inferenceservice-config ConfigMap has opendatahub.io/managed=false and includes hardware-profile annotations in serviceAnnotationDisallowedList before upgrading to RHOAI 3.x",
 			CheckRemediation: "Set the annotation opendatahub.io/managed=false on the inferenceservice-config ConfigMap, and add opendatahub.io/hardware-profile-name and opendatahub.io/hardware-profile-namespace to the serviceAnnotationDisallowedList in the inferenceService data key",
+			CheckResources: []resources.ResourceType{
+				resources.DataScienceCluster,
+				resources.DSCInitialization,
+				resources.ConfigMap,
+			},
+			CheckVersionGate:    check.VersionGateUpgrade2xTo3x,
+			CheckKnowledgeLinks: []string{check.DocsServingModels},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "The inferenceservice-config ConfigMap in the applications namespace: its opendatahub.io/managed annotation and the serviceAnnotationDisallowedList in the inferenceService data key. The check only runs when upgrading from 2.x to 3.x with KServe Managed.",
+				Rationale:      "The upgrade adds hardware-profile annotations to InferenceServices. Unless KServe is told to ignore them, the annotation change propagates to the predictor pods and restarts every model. The ConfigMap must also be unmanaged so the operator does not revert the setting.",
+				FailingExample: configFailingExample,
+				PassingExample: configPassingExample,
+				RemediationCommands: []string{
+					"kubectl annotate configmap inferenceservice-config -n <applications-namespace> --overwrite opendatahub.io/managed=false",
+					"kubectl edit configmap inferenceservice-config -n <applications-namespace>",
+				},
+			},
 		},
 	}
 }
@@ -120,6 +163,10 @@ func (c *InferenceServiceConfigCheck) Validate(ctx context.Context, target check
 					check.WithMessage(msgManagedAnnotationMissing, kube.AnnotationManaged),
 					check.WithImpact(result.ImpactAdvisory),
 					check.WithRemediation(c.CheckRemediation),
+					check.WithRemediationCommands(remediation.Annotate(
+						resources.ConfigMap, req.ApplicationsNamespace, inferenceServiceConfigName,
+						kube.AnnotationManaged, "false",
+					)),
 				))
 
 				return nil
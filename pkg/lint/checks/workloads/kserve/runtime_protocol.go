@@ -0,0 +1,413 @@
+package kserve
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/constants"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/components"
+	"github.com/opendatahub-io/odh-cli/pkg/util/grpcprobe"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+const (
+	ConditionTypeRuntimeProtocolsProbed  = "RuntimeProtocolsProbed"
+	ConditionTypeRemovedEndpointsNotUsed = "RemovedEndpointsNotUsed"
+)
+
+// Annotations recorded on the probed InferenceServices.
+const (
+	AnnotationDeclaredProtocol = "serving.opendatahub.io/declared-protocol"
+	AnnotationProbedProtocol   = "serving.opendatahub.io/probed-protocol"
+	AnnotationProbedServer     = "serving.opendatahub.io/probed-server"
+	AnnotationProbeError       = "serving.opendatahub.io/probe-error"
+)
+
+// Protocols recorded by the probe.
+const (
+	ProtocolV1          = "v1"
+	ProtocolV2          = "v2"
+	ProtocolUnreachable = "unreachable"
+	ProtocolNotExposed  = "not-exposed"
+)
+
+const (
+	// sampleModelsPerRuntime bounds the InferenceServices probed per ServingRuntime.
+	sampleModelsPerRuntime = 3
+
+	// probeTimeout bounds each gRPC call.
+	probeTimeout = 10 * time.Second
+
+	// modelMeshServiceName is the Service fronting all ModelMesh models of a namespace.
+	modelMeshServiceName = "modelmesh-serving"
+)
+
+// Examples rendered by 'lint explain'.
+const (
+	protocolFailingExample = `apiVersion: serving.kserve.io/v1beta1
+kind: InferenceService
+metadata:
+  name: mnist
+  namespace: team-a
+  annotations:
+    serving.kserve.io/deploymentMode: ModelMesh
+status:
+  url: grpc://modelmesh-serving.team-a:8033`
+
+	protocolPassingExample = `apiVersion: serving.kserve.io/v1beta1
+kind: InferenceService
+metadata:
+  name: mnist
+  namespace: team-a
+  annotations:
+    serving.kserve.io/deploymentMode: RawDeployment
+    serving.opendatahub.io/probed-protocol: v2
+status:
+  url: https://mnist-team-a.apps.example.com`
+)
+
+// RuntimeProtocolCheck probes the gRPC health and KServe v2 metadata endpoints of a sample of
+// exposed InferenceServices per ServingRuntime, recording the inference protocol they serve,
+// and flags InferenceServices served through the ModelMesh endpoints removed in 3.x.
+// It only runs with lint --probe, as it sends requests to the model servers.
+type RuntimeProtocolCheck struct {
+	check.BaseCheck
+
+	// HTTPClient sends the gRPC requests; nil uses an HTTP/2 client trusting the system roots.
+	HTTPClient *http.Client
+}
+
+// probeResult is the outcome of probing one InferenceService.
+type probeResult struct {
+	obj      *unstructured.Unstructured
+	declared string
+	protocol string
+	server   string
+	err      error
+	removed  bool
+}
+
+func NewRuntimeProtocolCheck() *RuntimeProtocolCheck {
+	return &RuntimeProtocolCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupWorkload,
+			Kind:             constants.ComponentKServe,
+			Type:             check.CheckTypeImpactedWorkloads,
+			CheckID:          "workloads.kserve.runtime-protocol",
+			CheckName:        "Workloads :: KServe :: Runtime Protocol Probe",
+			CheckDescription: "Probes the gRPC health and v2 metadata endpoints of a sample of exposed InferenceServices per ServingRuntime to record the inference protocol in use, and flags InferenceServices served through ModelMesh endpoints removed in RHOAI 3.x (opt-in with --probe)",
+			CheckRemediation: "Point clients of ModelMesh InferenceServices at the per-model KServe endpoint of their RawDeployment replacement (see 'migrate modelmesh'), and move clients of v1-only runtimes to the v2 protocol where the 3.x runtime requires it",
+			CheckResources: []resources.ResourceType{
+				resources.DataScienceCluster,
+				resources.InferenceService,
+			},
+			CheckVersionGate:    check.VersionGateUpgrade2xTo3x,
+			CheckKnowledgeLinks: []string{check.DocsServingModels},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "A sample of the exposed InferenceServices of each ServingRuntime, probed on their gRPC health and KServe v2 metadata endpoints to record the protocol and server they answer with, and InferenceServices served through the shared modelmesh-serving Service. The check only runs with --probe when upgrading from 2.x to 3.x with KServe or ModelMesh Managed, because it sends requests to the model servers.",
+				Rationale:      "The modelmesh-serving Service (gRPC 8033, REST 8008) is removed in 3.x, so clients calling it lose access to their models. The recorded protocols show which clients still speak v1 to runtimes whose 3.x version requires v2.",
+				FailingExample: protocolFailingExample,
+				PassingExample: protocolPassingExample,
+				RemediationCommands: []string{
+					"kubectl odh lint --target-version 3.0 --probe --checks workloads.kserve.runtime-protocol",
+					"kubectl odh migrate modelmesh --dry-run",
+				},
+			},
+		},
+	}
+}
+
+// CanApply returns whether this check should run for the given target.
+// Only applies with --probe, when upgrading FROM 2.x TO 3.x and KServe or ModelMesh is Managed.
+func (c *RuntimeProtocolCheck) CanApply(ctx context.Context, target check.Target) (bool, error) {
+	if !target.Probe || !version.IsUpgradeFrom2xTo3x(target.CurrentVersion, target.TargetVersion) {
+		return false, nil
+	}
+
+	dsc, err := client.GetDataScienceCluster(ctx, target.Client)
+	if err != nil {
+		return false, fmt.Errorf("getting DataScienceCluster: %w", err)
+	}
+
+	return components.HasManagementState(dsc, constants.ComponentKServe, constants.ManagementStateManaged) ||
+		components.HasManagementState(dsc, "modelmeshserving", constants.ManagementStateManaged), nil
+}
+
+// Validate executes the check against the provided target.
+func (c *RuntimeProtocolCheck) Validate(
+	ctx context.Context,
+	target check.Target,
+) (*result.DiagnosticResult, error) {
+	return validate.Workloads(c, target, resources.InferenceService).
+		Run(ctx, func(ctx context.Context, req *validate.WorkloadRequest[*unstructured.Unstructured]) error {
+			results := c.probe(ctx, req)
+
+			req.Result.SetCondition(newProbedCondition(results))
+			req.Result.SetCondition(c.newRemovedEndpointsCondition(results))
+
+			req.Result.ImpactedObjects = make([]metav1.PartialObjectMetadata, 0, len(results))
+			for _, r := range results {
+				req.Result.ImpactedObjects = append(req.Result.ImpactedObjects, r.impactedObject())
+			}
+
+			return nil
+		})
+}
+
+// probe records the InferenceServices served through ModelMesh endpoints and probes a sample
+// of the others per ServingRuntime.
+func (c *RuntimeProtocolCheck) probe(
+	ctx context.Context,
+	req *validate.WorkloadRequest[*unstructured.Unstructured],
+) []probeResult {
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = grpcprobe.NewHTTPClient(probeTimeout, nil)
+	}
+
+	items := append([]*unstructured.Unstructured(nil), req.Items...)
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].GetNamespace() != items[j].GetNamespace() {
+			return items[i].GetNamespace() < items[j].GetNamespace()
+		}
+
+		return items[i].GetName() < items[j].GetName()
+	})
+
+	sampled := make(map[string]int)
+	results := make([]probeResult, 0, len(items))
+
+	for _, obj := range items {
+		r := probeResult{obj: obj, declared: declaredProtocol(obj)}
+
+		if usesModelMeshEndpoint(obj) {
+			r.removed = true
+			r.protocol = ProtocolNotExposed
+			results = append(results, r)
+
+			continue
+		}
+
+		key := obj.GetNamespace() + "/" + servingRuntime(obj)
+		if sampled[key] >= sampleModelsPerRuntime {
+			continue
+		}
+
+		sampled[key]++
+
+		endpoint, exposed := exposedEndpoint(obj)
+		if !exposed {
+			r.protocol = ProtocolNotExposed
+			results = append(results, r)
+
+			continue
+		}
+
+		r.protocol, r.server, r.err = probeEndpoint(ctx, httpClient, endpoint, obj.GetName())
+		if r.err != nil && req.IO != nil && req.Debug {
+			req.IO.Errorf("probing InferenceService %s/%s at %s: %v", obj.GetNamespace(), obj.GetName(), endpoint, r.err)
+		}
+
+		results = append(results, r)
+	}
+
+	return results
+}
+
+// probeEndpoint returns the inference protocol served at endpoint and the server name and
+// version: v2 when the v2 metadata methods answer, v1 when they are unimplemented.
+func probeEndpoint(ctx context.Context, httpClient *http.Client, endpoint string, model string) (string, string, error) {
+	health, err := grpcprobe.Health(ctx, httpClient, endpoint)
+	if err != nil && !grpcprobe.IsCode(err, grpcprobe.CodeUnimplemented) {
+		return ProtocolUnreachable, "", fmt.Errorf("health check: %w", err)
+	}
+
+	if err == nil && health != grpcprobe.HealthServing {
+		return ProtocolUnreachable, "", fmt.Errorf("health check: server is %s", health)
+	}
+
+	md, err := grpcprobe.GetServerMetadata(ctx, httpClient, endpoint)
+
+	switch {
+	case grpcprobe.IsCode(err, grpcprobe.CodeUnimplemented):
+		return ProtocolV1, "", nil
+	case err != nil:
+		return ProtocolUnreachable, "", fmt.Errorf("server metadata: %w", err)
+	}
+
+	server := strings.TrimSpace(md.Name + " " + md.Version)
+
+	if _, err := grpcprobe.GetModelMetadata(ctx, httpClient, endpoint, model); err != nil {
+		return ProtocolV2, server, fmt.Errorf("model metadata: %w", err)
+	}
+
+	return ProtocolV2, server, nil
+}
+
+func newProbedCondition(results []probeResult) result.Condition {
+	counts := make(map[string]int)
+
+	failed := 0
+
+	for _, r := range results {
+		if r.removed {
+			continue
+		}
+
+		counts[r.protocol]++
+
+		if r.err != nil {
+			failed++
+		}
+	}
+
+	probed := counts[ProtocolV1] + counts[ProtocolV2] + counts[ProtocolUnreachable]
+	summary := fmt.Sprintf("Probed %d InferenceService(s): %d serve the v2 protocol, %d the v1 protocol only, %d unreachable; %d not exposed outside the cluster",
+		probed, counts[ProtocolV2], counts[ProtocolV1], counts[ProtocolUnreachable], counts[ProtocolNotExposed])
+
+	if failed > 0 {
+		return check.NewCondition(
+			ConditionTypeRuntimeProtocolsProbed,
+			metav1.ConditionUnknown,
+			check.WithReason(check.ReasonInsufficientData),
+			check.WithMessage("%s; %d probe(s) failed, see the %s annotation of the impacted objects", summary, failed, AnnotationProbeError),
+		)
+	}
+
+	return check.NewCondition(
+		ConditionTypeRuntimeProtocolsProbed,
+		metav1.ConditionTrue,
+		check.WithReason(check.ReasonRequirementsMet),
+		check.WithMessage("%s", summary),
+	)
+}
+
+func (c *RuntimeProtocolCheck) newRemovedEndpointsCondition(results []probeResult) result.Condition {
+	removed := 0
+
+	for _, r := range results {
+		if r.removed {
+			removed++
+		}
+	}
+
+	if removed == 0 {
+		return check.NewCondition(
+			ConditionTypeRemovedEndpointsNotUsed,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonVersionCompatible),
+			check.WithMessage("No InferenceService(s) served through the ModelMesh endpoints removed in RHOAI 3.x"),
+		)
+	}
+
+	return check.NewCondition(
+		ConditionTypeRemovedEndpointsNotUsed,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonFeatureRemoved),
+		check.WithMessage("Found %d InferenceService(s) served through the %s Service (gRPC 8033, REST 8008), removed in RHOAI 3.x: their clients must move to the per-model endpoint", removed, modelMeshServiceName),
+		check.WithImpact(result.ImpactAdvisory),
+		check.WithRemediation(c.CheckRemediation),
+	)
+}
+
+func (r probeResult) impactedObject() metav1.PartialObjectMetadata {
+	annotations := map[string]string{
+		AnnotationDeclaredProtocol: r.declared,
+		AnnotationProbedProtocol:   r.protocol,
+	}
+
+	if r.server != "" {
+		annotations[AnnotationProbedServer] = r.server
+	}
+
+	if r.err != nil {
+		annotations[AnnotationProbeError] = r.err.Error()
+	}
+
+	if r.removed {
+		annotations[annotationDeploymentMode] = deploymentModeModelMesh
+	}
+
+	return metav1.PartialObjectMetadata{
+		TypeMeta: resources.InferenceService.TypeMeta(),
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   r.obj.GetNamespace(),
+			Name:        r.obj.GetName(),
+			Annotations: annotations,
+		},
+	}
+}
+
+// declaredProtocol returns the protocol version requested by an InferenceService (v1 by default).
+func declaredProtocol(obj *unstructured.Unstructured) string {
+	protocol, _, _ := unstructured.NestedString(obj.Object, "spec", "predictor", "model", "protocolVersion")
+	if protocol == "" {
+		return ProtocolV1
+	}
+
+	return protocol
+}
+
+// servingRuntime returns the ServingRuntime named by an InferenceService, or its model format
+// when the runtime is auto-selected.
+func servingRuntime(obj *unstructured.Unstructured) string {
+	runtime, _, _ := unstructured.NestedString(obj.Object, "spec", "predictor", "model", "runtime")
+	if runtime != "" {
+		return runtime
+	}
+
+	format, _, _ := unstructured.NestedString(obj.Object, "spec", "predictor", "model", "modelFormat", "name")
+
+	return "format:" + format
+}
+
+// usesModelMeshEndpoint reports whether an InferenceService is served through the shared
+// ModelMesh Service rather than a per-model endpoint.
+func usesModelMeshEndpoint(obj *unstructured.Unstructured) bool {
+	if kube.HasAnnotation(obj, annotationDeploymentMode, deploymentModeModelMesh) {
+		return true
+	}
+
+	for _, field := range [][]string{{"status", "url"}, {"status", "address", "url"}} {
+		raw, _, _ := unstructured.NestedString(obj.Object, field...)
+
+		u, err := url.Parse(raw)
+		if err == nil && strings.HasPrefix(u.Hostname(), modelMeshServiceName+".") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// exposedEndpoint returns the URL at which an InferenceService is reachable from outside the
+// cluster, i.e. its status URL unless it is a cluster-local address.
+func exposedEndpoint(obj *unstructured.Unstructured) (string, bool) {
+	raw, _, _ := unstructured.NestedString(obj.Object, "status", "url")
+
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", false
+	}
+
+	host := u.Hostname()
+	if strings.HasSuffix(host, ".svc") || strings.HasSuffix(host, ".cluster.local") {
+		return "", false
+	}
+
+	return raw, true
+}
@@ -0,0 +1,189 @@
+package kserve_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/binary"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protowire"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/kserve"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/grpcprobe"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+// newV2Server starts a gRPC model server answering the health and v2 metadata methods.
+func newV2Server(t *testing.T) (*httptest.Server, *http.Client) {
+	t.Helper()
+
+	health := protowire.AppendVarint(protowire.AppendTag(nil, 1, protowire.VarintType), 1)
+	metadata := protowire.AppendString(protowire.AppendTag(nil, 1, protowire.BytesType), "triton")
+	metadata = protowire.AppendString(protowire.AppendTag(metadata, 2, protowire.BytesType), "2.42.0")
+
+	replies := map[string][]byte{
+		grpcprobe.MethodHealthCheck:    health,
+		grpcprobe.MethodServerMetadata: metadata,
+		grpcprobe.MethodModelMetadata:  nil,
+	}
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.ReadAll(r.Body)
+
+		w.Header().Set("Content-Type", "application/grpc")
+
+		message, ok := replies[r.URL.Path]
+		if !ok {
+			w.Header().Set(http.TrailerPrefix+"Grpc-Status", strconv.Itoa(grpcprobe.CodeUnimplemented))
+
+			return
+		}
+
+		frame := make([]byte, 5, 5+len(message))
+		binary.BigEndian.PutUint32(frame[1:], uint32(len(message)))
+		_, _ = w.Write(append(frame, message...))
+
+		w.Header().Set(http.TrailerPrefix+"Grpc-Status", "0")
+	}))
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(server.Certificate())
+
+	return server, grpcprobe.NewHTTPClient(5*time.Second, &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12})
+}
+
+func newProbedInferenceService(name string, runtime string, url string, annotations map[string]any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.InferenceService.APIVersion(),
+			"kind":       resources.InferenceService.Kind,
+			"metadata": map[string]any{
+				"name":        name,
+				"namespace":   "test-ns",
+				"annotations": annotations,
+			},
+			"spec": map[string]any{
+				"predictor": map[string]any{
+					"model": map[string]any{"runtime": runtime, "protocolVersion": "v2"},
+				},
+			},
+			"status": map[string]any{"url": url},
+		},
+	}
+}
+
+func TestRuntimeProtocolCheck_CanApply_RequiresProbe(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      listKinds,
+		Objects:        []*unstructured.Unstructured{testutil.NewDSC(map[string]string{"kserve": "Managed"})},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	chk := kserve.NewRuntimeProtocolCheck()
+
+	canApply, err := chk.CanApply(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeFalse())
+
+	target.Probe = true
+
+	canApply, err = chk.CanApply(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeTrue())
+}
+
+func TestRuntimeProtocolCheck_Validate(t *testing.T) {
+	g := NewWithT(t)
+
+	server, httpClient := newV2Server(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: listKinds,
+		Objects: []*unstructured.Unstructured{
+			newProbedInferenceService("exposed", "triton", server.URL, nil),
+			newProbedInferenceService("internal", "triton", "http://internal.test-ns.svc.cluster.local", nil),
+			newProbedInferenceService("mm", "ovms", "grpc://modelmesh-serving.test-ns:8033",
+				map[string]any{annotationDeploymentMode: "ModelMesh"}),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+	target.Probe = true
+
+	chk := kserve.NewRuntimeProtocolCheck()
+	chk.HTTPClient = httpClient
+
+	result, err := chk.Validate(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.ValidateStrict()).To(Succeed())
+
+	g.Expect(result.Status.Conditions).To(HaveLen(2))
+	g.Expect(result.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":    Equal(kserve.ConditionTypeRuntimeProtocolsProbed),
+		"Status":  Equal(metav1.ConditionTrue),
+		"Message": ContainSubstring("Probed 1 InferenceService(s): 1 serve the v2 protocol, 0 the v1 protocol only, 0 unreachable; 1 not exposed"),
+	}))
+	g.Expect(result.Status.Conditions[1].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":    Equal(kserve.ConditionTypeRemovedEndpointsNotUsed),
+		"Status":  Equal(metav1.ConditionFalse),
+		"Message": ContainSubstring("Found 1 InferenceService(s) served through the modelmesh-serving Service"),
+	}))
+
+	g.Expect(result.ImpactedObjects).To(HaveLen(3))
+	g.Expect(result.ImpactedObjects[0].Name).To(Equal("exposed"))
+	g.Expect(result.ImpactedObjects[0].Annotations).To(Equal(map[string]string{
+		kserve.AnnotationDeclaredProtocol: "v2",
+		kserve.AnnotationProbedProtocol:   kserve.ProtocolV2,
+		kserve.AnnotationProbedServer:     "triton 2.42.0",
+	}))
+	g.Expect(result.ImpactedObjects[1].Annotations).To(HaveKeyWithValue(kserve.AnnotationProbedProtocol, kserve.ProtocolNotExposed))
+	g.Expect(result.ImpactedObjects[2].Name).To(Equal("mm"))
+	g.Expect(result.ImpactedObjects[2].Annotations).To(HaveKeyWithValue(annotationDeploymentMode, "ModelMesh"))
+}
+
+func TestRuntimeProtocolCheck_Validate_Unreachable(t *testing.T) {
+	g := NewWithT(t)
+
+	server, httpClient := newV2Server(t)
+	url := server.URL
+	server.Close()
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      listKinds,
+		Objects:        []*unstructured.Unstructured{newProbedInferenceService("exposed", "triton", url, nil)},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+	target.Probe = true
+
+	chk := kserve.NewRuntimeProtocolCheck()
+	chk.HTTPClient = httpClient
+
+	result, err := chk.Validate(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(result.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":   Equal(kserve.ConditionTypeRuntimeProtocolsProbed),
+		"Status": Equal(metav1.ConditionUnknown),
+	}))
+	g.Expect(result.ImpactedObjects[0].Annotations).To(HaveKeyWithValue(kserve.AnnotationProbedProtocol, kserve.ProtocolUnreachable))
+	g.Expect(result.ImpactedObjects[0].Annotations).To(HaveKey(kserve.AnnotationProbeError))
+}
@@ -26,6 +26,47 @@ const (
 	ConditionTypePostgresConfigured  = "PostgresConfigured"
 	ConditionTypeEmbeddingConfigured = "EmbeddingConfigured"
 	ConditionTypeConfigMapValid      = "ConfigMapValid"
+
+	// AnnotationIssues lists the configuration issues found on an impacted LlamaStackDistribution.
+	AnnotationIssues = "llamastack.opendatahub.io/issues"
+)
+
+// Examples rendered by 'lint explain'.
+const (
+	configFailingExample = `apiVersion: llamastack.io/v1alpha1
+kind: LlamaStackDistribution
+metadata:
+  name: lsd
+  namespace: team-a
+spec:
+  server:
+    containerSpec:
+      env:
+        - name: AWS_ACCESS_KEY_ID
+          value: AKIA...
+        - name: TELEMETRY_SINKS
+          value: otel_trace`
+
+	configPassingExample = `apiVersion: llamastack.io/v1alpha1
+kind: LlamaStackDistribution
+metadata:
+  name: lsd
+  namespace: team-a
+spec:
+  server:
+    containerSpec:
+      env:
+        - name: VLLM_URL
+          value: http://vllm.team-a.svc:8000/v1
+        - name: VLLM_EMBEDDING_URL
+          value: http://embedding.team-a.svc:8000/v1
+        - name: POSTGRES_HOST
+          value: postgres.team-a.svc
+        - name: POSTGRES_PASSWORD
+          valueFrom:
+            secretKeyRef:
+              name: postgres
+              key: password`
 )
 
 // ConfigCheck validates LlamaStackDistribution resources for 3.3 upgrade compatibility.
@@ -43,6 +84,22 @@ func NewConfigCheck() *ConfigCheck {
 			CheckName:        "Workloads :: LlamaStack :: Configuration (3.3)",
 			CheckDescription: "Validates LlamaStackDistribution resources for required configuration changes in RHOAI 3.3",
 			CheckRemediation: "Update LlamaStackDistribution CRs with required environment variables before upgrading",
+			CheckResources: []resources.ResourceType{
+				resources.DataScienceCluster,
+				resources.LlamaStackDistribution,
+			},
+			CheckVersionGate:    check.VersionGateUpgrade2xTo3x,
+			CheckKnowledgeLinks: []string{check.DocsUpgrading},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "The spec.server.containerSpec.env of every LlamaStackDistribution, looking for VLLM_URL, VLLM_EMBEDDING_URL, POSTGRES_HOST and POSTGRES_PASSWORD, the deprecated AWS Bedrock and telemetry variables, and whether the ConfigMap named in spec.server.userConfig exists and holds valid YAML. The check only runs when upgrading from 2.x to 3.x with the LlamaStack operator Managed.",
+				Rationale:      "The 3.3 distribution requires an explicit inference and embedding endpoint and PostgreSQL storage, and no longer reads the old AWS credential and telemetry variables. A distribution missing them fails to start after the upgrade, or silently loses Bedrock access and telemetry.",
+				FailingExample: configFailingExample,
+				PassingExample: configPassingExample,
+				RemediationCommands: []string{
+					"kubectl get llamastackdistributions --all-namespaces -o yaml",
+					"kubectl edit llamastackdistribution lsd -n team-a",
+				},
+			},
 		},
 	}
 }
@@ -179,7 +236,7 @@ func (c *ConfigCheck) validateDistributions(
 					Namespace: nsName.Namespace,
 					Name:      nsName.Name,
 					Annotations: map[string]string{
-						"llsd.issues": strings.Join(issues, ","),
+						AnnotationIssues: strings.Join(issues, ","),
 					},
 				},
 			})
@@ -283,6 +283,10 @@ func TestLlamaStackConfigCheck_MissingVLLMURL(t *testing.T) {
 	g.Expect(vllmCond.Status).To(Equal(metav1.ConditionFalse))
 	g.Expect(vllmCond.Impact).To(Equal(result.ImpactBlocking))
 	g.Expect(vllmCond.Message).To(ContainSubstring("missing required VLLM_URL"))
+
+	g.Expect(res.ImpactedObjects).To(HaveLen(1))
+	g.Expect(res.ImpactedObjects[0].Annotations).To(HaveKey(llamastack.AnnotationIssues))
+	g.Expect(res.ValidateStrict()).To(Succeed())
 }
 
 func TestLlamaStackConfigCheck_MissingPostgresConfig(t *testing.T) {
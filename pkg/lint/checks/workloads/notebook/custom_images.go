@@ -0,0 +1,80 @@
+package notebook
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+)
+
+// CustomImage is a workbench container image that does not resolve to an OOTB notebook ImageStream,
+// i.e. an image classified CUSTOM by the impacted workloads check.
+type CustomImage struct {
+	Namespace string
+	Name      string
+
+	// ContainerIndex is the index of the container in .spec.template.spec.containers.
+	ContainerIndex int
+	ContainerName  string
+	Image          string
+}
+
+// FindCustomImages returns the container images of notebooks that are classified CUSTOM, using the
+// same ImageStream correlation as the impacted workloads check. Infrastructure sidecars are skipped.
+func FindCustomImages(
+	ctx context.Context,
+	reader client.Reader,
+	notebooks []*unstructured.Unstructured,
+) ([]CustomImage, error) {
+	if len(notebooks) == 0 {
+		return nil, nil
+	}
+
+	c := &ImpactedWorkloadsCheck{}
+	log := debugLogger{}
+
+	ootbImages, imageStreamData, err := c.discoverImageStreams(ctx, reader, nil, log)
+	if err != nil {
+		return nil, err
+	}
+
+	var custom []CustomImage
+
+	for _, nb := range notebooks {
+		containers, err := jq.Query[[]any](nb, ".spec.template.spec.containers")
+		if err != nil {
+			continue
+		}
+
+		for i, container := range containers {
+			containerMap, ok := container.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			containerName, _ := containerMap["name"].(string)
+			image, _ := containerMap["image"].(string)
+
+			if image == "" || isInfrastructureContainer(containerName, image) {
+				continue
+			}
+
+			analysis := c.analyzeImage(ctx, reader, image, ootbImages, imageStreamData, nil, log)
+			if analysis.Status != ImageStatusCustom {
+				continue
+			}
+
+			custom = append(custom, CustomImage{
+				Namespace:      nb.GetNamespace(),
+				Name:           nb.GetName(),
+				ContainerIndex: i,
+				ContainerName:  containerName,
+				Image:          image,
+			})
+		}
+	}
+
+	return custom, nil
+}
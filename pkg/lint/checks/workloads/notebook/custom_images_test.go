@@ -0,0 +1,61 @@
+package notebook_test
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/notebook"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestFindCustomImages(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: listKinds,
+		Objects: []*unstructured.Unstructured{
+			testutil.NewDSCI(applicationsNS),
+			newImageStream(isJupyterDatascience, "jupyter"),
+			newUserContributedImageStream(isUserContributed),
+		},
+	})
+
+	notebooks := []*unstructured.Unstructured{
+		newNotebook("ns1", "ootb", jupyterCompatibleTag),
+		newNotebook("ns1", "custom", customImageTag),
+		newNotebook("ns2", "user-contributed", userContributedInternalRef),
+		newNotebookWithContainers("ns2", "sidecar", map[string]string{"oauth-proxy": oauthProxyImage}),
+	}
+
+	custom, err := notebook.FindCustomImages(t.Context(), target.Client, notebooks)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(custom).To(ConsistOf(
+		notebook.CustomImage{
+			Namespace:     "ns1",
+			Name:          "custom",
+			ContainerName: "notebook",
+			Image:         customImageTag,
+		},
+		notebook.CustomImage{
+			Namespace:     "ns2",
+			Name:          "user-contributed",
+			ContainerName: "notebook",
+			Image:         userContributedInternalRef,
+		},
+	))
+}
+
+func TestFindCustomImages_NoNotebooks(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{ListKinds: listKinds})
+
+	custom, err := notebook.FindCustomImages(t.Context(), target.Client, nil)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(custom).To(BeEmpty())
+}
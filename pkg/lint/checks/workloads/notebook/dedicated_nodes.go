@@ -0,0 +1,212 @@
+package notebook
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/constants"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/components"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+const (
+	// ConditionTypeDedicatedNodeScheduling indicates whether workbenches keep tolerating the
+	// dedicated notebook node taint after the upgrade.
+	ConditionTypeDedicatedNodeScheduling = "DedicatedNodeScheduling"
+
+	checkTypeDedicatedNodes = "dedicated-nodes"
+
+	// DedicatedNodesMigrationID is the migrate action assigning the HardwareProfile to workbenches.
+	DedicatedNodesMigrationID = "notebook.dedicated-nodes.migrate"
+)
+
+// Examples rendered by 'lint explain'.
+const (
+	dedicatedNodesFailingExample = `apiVersion: opendatahub.io/v1alpha
+kind: OdhDashboardConfig
+metadata:
+  name: odh-dashboard-config
+spec:
+  notebookController:
+    notebookTolerationSettings:
+      enabled: true
+      key: notebooks-only
+# Notebook without a HardwareProfile tolerating notebooks-only`
+
+	dedicatedNodesPassingExample = `apiVersion: kubeflow.org/v1
+kind: Notebook
+metadata:
+  name: wb
+  namespace: team-a
+  annotations:
+    opendatahub.io/hardware-profile-name: dedicated-notebooks   # tolerates notebooks-only`
+)
+
+// DedicatedNodesCheck detects workbenches relying on the toleration the 2.x dashboard injects for
+// dedicated notebook nodes ("notebook pod tolerations" setting). The 3.x dashboard no longer
+// injects it, so the workbenches need a HardwareProfile carrying the toleration to keep landing
+// on the dedicated nodes.
+type DedicatedNodesCheck struct {
+	check.BaseCheck
+}
+
+func NewDedicatedNodesCheck() *DedicatedNodesCheck {
+	return &DedicatedNodesCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupWorkload,
+			Kind:             kind,
+			Type:             checkTypeDedicatedNodes,
+			CheckID:          "workloads.notebook.dedicated-nodes",
+			CheckName:        "Workloads :: Notebook :: Dedicated Node Tolerations (3.x)",
+			CheckDescription: "Detects workbenches relying on the 2.x dashboard notebook pod toleration setting, which 3.x replaces with HardwareProfiles",
+			CheckRemediation: "Assign a HardwareProfile tolerating the dedicated node taint to the listed workbenches with 'kubectl odh migrate run --migration " + DedicatedNodesMigrationID + "'",
+			CheckResources: []resources.ResourceType{
+				resources.DataScienceCluster,
+				resources.OdhDashboardConfig,
+				resources.Notebook,
+				resources.HardwareProfile,
+				resources.InfrastructureHardwareProfile,
+			},
+			CheckVersionGate:    check.VersionGateUpgrade2xTo3x,
+			CheckKnowledgeLinks: []string{check.DocsAccelerators},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "The notebook pod toleration setting (spec.notebookController.notebookTolerationSettings) of the odh-dashboard-config OdhDashboardConfig, and the Notebooks relying on it without a HardwareProfile that carries the same toleration. The check only runs when upgrading from 2.x to 3.x with Workbenches Managed.",
+				Rationale:      "The 2.x dashboard injects a toleration into every workbench so it can run on nodes dedicated to notebooks. The 3.x dashboard no longer does; workbench scheduling comes from HardwareProfiles instead, so these workbenches stop landing on the dedicated nodes after their next restart.",
+				FailingExample: dedicatedNodesFailingExample,
+				PassingExample: dedicatedNodesPassingExample,
+				RemediationCommands: []string{
+					"kubectl odh migrate run --migration " + DedicatedNodesMigrationID + " --dry-run",
+					"kubectl odh migrate run --migration " + DedicatedNodesMigrationID,
+				},
+			},
+		},
+	}
+}
+
+// CanApply returns whether this check should run for the given target.
+// Only applies when upgrading from 2.x to 3.x and Workbenches is Managed.
+func (c *DedicatedNodesCheck) CanApply(ctx context.Context, target check.Target) (bool, error) {
+	if !version.IsUpgradeFrom2xTo3x(target.CurrentVersion, target.TargetVersion) {
+		return false, nil
+	}
+
+	dsc, err := client.GetDataScienceCluster(ctx, target.Client)
+	if err != nil {
+		return false, fmt.Errorf("getting DataScienceCluster: %w", err)
+	}
+
+	return components.HasManagementState(dsc, "workbenches", constants.ManagementStateManaged), nil
+}
+
+// Validate executes the check against the provided target.
+func (c *DedicatedNodesCheck) Validate(
+	ctx context.Context,
+	target check.Target,
+) (*result.DiagnosticResult, error) {
+	dr := c.NewResult()
+
+	if target.TargetVersion != nil {
+		dr.Annotations[check.AnnotationCheckTargetVersion] = target.TargetVersion.String()
+	}
+
+	scheduling, err := LoadDedicatedNodeScheduling(ctx, target.Client)
+	if err != nil {
+		return nil, fmt.Errorf("reading dashboard notebook toleration setting: %w", err)
+	}
+
+	var impacted []*unstructured.Unstructured
+
+	if scheduling.TolerationKey != "" {
+		impacted, err = FindDedicatedNodeNotebooks(ctx, target.Client, scheduling)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	dr.Annotations[check.AnnotationImpactedWorkloadCount] = strconv.Itoa(len(impacted))
+	dr.SetCondition(c.newCondition(scheduling, len(impacted)))
+
+	if len(impacted) > 0 {
+		dr.SetImpactedObjects(resources.Notebook, kube.ToNamespacedNames(impacted))
+	}
+
+	return dr, nil
+}
+
+// FindDedicatedNodeNotebooks returns the Notebooks that rely on the dashboard-injected toleration
+// without being scheduled with a HardwareProfile carrying it.
+func FindDedicatedNodeNotebooks(
+	ctx context.Context,
+	r client.Reader,
+	scheduling *DedicatedNodeScheduling,
+) ([]*unstructured.Unstructured, error) {
+	notebooks, err := r.List(ctx, resources.Notebook)
+	if err != nil {
+		if client.IsResourceTypeNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("listing Notebooks: %w", err)
+	}
+
+	var impacted []*unstructured.Unstructured
+
+	for _, nb := range notebooks {
+		needsMigration, err := scheduling.NeedsMigration(nb)
+		if err != nil {
+			return nil, err
+		}
+
+		if needsMigration {
+			impacted = append(impacted, nb)
+		}
+	}
+
+	return impacted, nil
+}
+
+func (c *DedicatedNodesCheck) newCondition(scheduling *DedicatedNodeScheduling, impacted int) result.Condition {
+	switch {
+	case scheduling.TolerationKey == "":
+		return check.NewCondition(
+			ConditionTypeDedicatedNodeScheduling,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonNoMigrationRequired),
+			check.WithMessage("Dashboard notebook pod toleration setting is not enabled - no migration required"),
+		)
+	case impacted == 0:
+		return check.NewCondition(
+			ConditionTypeDedicatedNodeScheduling,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonNoMigrationRequired),
+			check.WithMessage("No workbenches rely on the dashboard-injected toleration %q", scheduling.TolerationKey),
+		)
+	case len(scheduling.Profiles) == 0:
+		return check.NewCondition(
+			ConditionTypeDedicatedNodeScheduling,
+			metav1.ConditionFalse,
+			check.WithReason(check.ReasonResourceNotFound),
+			check.WithMessage("Found %d workbench(es) relying on the dashboard-injected toleration %q, but no HardwareProfile tolerates it: the workbenches may no longer be scheduled on the dedicated nodes after upgrade", impacted, scheduling.TolerationKey),
+			check.WithImpact(result.ImpactAdvisory),
+			check.WithRemediation(fmt.Sprintf("Create a HardwareProfile with a toleration for the %q taint and a node selector for the dedicated notebook nodes, then run 'kubectl odh migrate run --migration %s'", scheduling.TolerationKey, DedicatedNodesMigrationID)),
+		)
+	default:
+		return check.NewCondition(
+			ConditionTypeDedicatedNodeScheduling,
+			metav1.ConditionFalse,
+			check.WithReason(check.ReasonMigrationPending),
+			check.WithMessage("Found %d workbench(es) relying on the dashboard-injected toleration %q that are not scheduled with HardwareProfile %s", impacted, scheduling.TolerationKey, scheduling.Profiles[0]),
+			check.WithImpact(result.ImpactAdvisory),
+			check.WithRemediation(c.CheckRemediation),
+		)
+	}
+}
@@ -0,0 +1,201 @@
+package notebook
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+)
+
+const (
+	// DashboardConfigName is the name of the OdhDashboardConfig in the applications namespace.
+	DashboardConfigName = "odh-dashboard-config"
+
+	// AnnotationHardwareProfileName references the HardwareProfile a workbench is scheduled with.
+	AnnotationHardwareProfileName = "opendatahub.io/hardware-profile-name"
+
+	// AnnotationHardwareProfileNamespace is the namespace of the referenced HardwareProfile.
+	// The applications namespace is used when it is not set.
+	AnnotationHardwareProfileNamespace = "opendatahub.io/hardware-profile-namespace"
+)
+
+// DedicatedNodeScheduling is the 2.x dashboard "notebook pod tolerations" setting, which adds a
+// toleration for a taint of dedicated notebook nodes to every workbench the dashboard starts, and
+// the HardwareProfiles that carry that toleration once the dashboard no longer injects it in 3.x.
+type DedicatedNodeScheduling struct {
+	// TolerationKey is the taint key tolerated by workbench pods. Empty when the setting is disabled.
+	TolerationKey string
+
+	// Profiles are the HardwareProfiles tolerating TolerationKey, sorted by namespace and name.
+	// Legacy profiles are included, as they are migrated to infrastructure.opendatahub.io with
+	// the same name during the upgrade.
+	Profiles []types.NamespacedName
+
+	appNamespace string
+}
+
+// LoadDedicatedNodeScheduling reads the notebook toleration setting from the OdhDashboardConfig
+// and, when it is enabled, the HardwareProfiles tolerating its key.
+func LoadDedicatedNodeScheduling(ctx context.Context, r client.Reader) (*DedicatedNodeScheduling, error) {
+	appNS, err := client.GetApplicationsNamespace(ctx, r)
+	if err != nil {
+		return nil, fmt.Errorf("getting applications namespace: %w", err)
+	}
+
+	s := &DedicatedNodeScheduling{appNamespace: appNS}
+
+	config, err := r.GetResource(ctx, resources.OdhDashboardConfig, DashboardConfigName, client.InNamespace(appNS))
+	if err != nil {
+		if apierrors.IsNotFound(err) || client.IsResourceTypeNotFound(err) {
+			return s, nil
+		}
+
+		return nil, fmt.Errorf("getting OdhDashboardConfig %s/%s: %w", appNS, DashboardConfigName, err)
+	}
+
+	enabled, _, _ := unstructured.NestedBool(config.Object,
+		"spec", "notebookController", "notebookTolerationSettings", "enabled")
+	if !enabled {
+		return s, nil
+	}
+
+	s.TolerationKey, _, _ = unstructured.NestedString(config.Object,
+		"spec", "notebookController", "notebookTolerationSettings", "key")
+	if s.TolerationKey == "" {
+		return s, nil
+	}
+
+	profiles := make(map[types.NamespacedName]bool)
+
+	for _, profile := range []struct {
+		rt   resources.ResourceType
+		path []string
+	}{
+		{rt: resources.InfrastructureHardwareProfile, path: []string{"spec", "scheduling", "node", "tolerations"}},
+		{rt: resources.HardwareProfile, path: []string{"spec", "tolerations"}},
+	} {
+		items, err := r.List(ctx, profile.rt)
+		if err != nil {
+			if client.IsResourceTypeNotFound(err) {
+				continue
+			}
+
+			return nil, fmt.Errorf("listing %s: %w", profile.rt.APIVersion(), err)
+		}
+
+		for _, item := range items {
+			tolerations, err := parseTolerations(item.Object, profile.path...)
+			if err != nil {
+				return nil, fmt.Errorf("parsing HardwareProfile %s/%s: %w", item.GetNamespace(), item.GetName(), err)
+			}
+
+			if s.tolerates(tolerations) {
+				profiles[types.NamespacedName{Namespace: item.GetNamespace(), Name: item.GetName()}] = true
+			}
+		}
+	}
+
+	for ref := range profiles {
+		s.Profiles = append(s.Profiles, ref)
+	}
+
+	slices.SortFunc(s.Profiles, func(a, b types.NamespacedName) int {
+		return strings.Compare(a.String(), b.String())
+	})
+
+	return s, nil
+}
+
+// ReliesOnToleration reports whether the pod template of nb tolerates the dedicated node taint.
+func (s *DedicatedNodeScheduling) ReliesOnToleration(nb *unstructured.Unstructured) (bool, error) {
+	if s.TolerationKey == "" {
+		return false, nil
+	}
+
+	tolerations, err := parseTolerations(nb.Object, "spec", "template", "spec", "tolerations")
+	if err != nil {
+		return false, fmt.Errorf("parsing Notebook %s/%s: %w", nb.GetNamespace(), nb.GetName(), err)
+	}
+
+	return s.tolerates(tolerations), nil
+}
+
+// ProfileReference returns the HardwareProfile nb is scheduled with, if any.
+func (s *DedicatedNodeScheduling) ProfileReference(nb *unstructured.Unstructured) (types.NamespacedName, bool) {
+	annotations := nb.GetAnnotations()
+
+	name := annotations[AnnotationHardwareProfileName]
+	if name == "" {
+		return types.NamespacedName{}, false
+	}
+
+	namespace := annotations[AnnotationHardwareProfileNamespace]
+	if namespace == "" {
+		namespace = s.appNamespace
+	}
+
+	return types.NamespacedName{Namespace: namespace, Name: name}, true
+}
+
+// NeedsMigration reports whether nb relies on the dashboard-injected toleration without being
+// scheduled with a HardwareProfile that carries it.
+func (s *DedicatedNodeScheduling) NeedsMigration(nb *unstructured.Unstructured) (bool, error) {
+	relies, err := s.ReliesOnToleration(nb)
+	if err != nil || !relies {
+		return false, err
+	}
+
+	ref, ok := s.ProfileReference(nb)
+
+	return !ok || !slices.Contains(s.Profiles, ref), nil
+}
+
+// tolerates reports whether tolerations include one for the dedicated node taint key.
+func (s *DedicatedNodeScheduling) tolerates(tolerations []corev1.Toleration) bool {
+	for _, toleration := range tolerations {
+		if toleration.Key == s.TolerationKey {
+			return true
+		}
+
+		// An empty key with operator Exists tolerates every taint
+		if toleration.Key == "" && toleration.Operator == corev1.TolerationOpExists {
+			return true
+		}
+	}
+
+	return false
+}
+
+func parseTolerations(obj map[string]any, path ...string) ([]corev1.Toleration, error) {
+	raw, found, err := unstructured.NestedSlice(obj, path...)
+	if err != nil || !found {
+		return nil, err
+	}
+
+	tolerations := make([]corev1.Toleration, 0, len(raw))
+
+	for _, item := range raw {
+		entry, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		var toleration corev1.Toleration
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(entry, &toleration); err != nil {
+			return nil, fmt.Errorf("parsing toleration: %w", err)
+		}
+
+		tolerations = append(tolerations, toleration)
+	}
+
+	return tolerations, nil
+}
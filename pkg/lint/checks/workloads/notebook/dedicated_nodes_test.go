@@ -0,0 +1,192 @@
+package notebook_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/notebook"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+const (
+	dedicatedNodesAppNamespace = "redhat-ods-applications"
+	dedicatedNodesTaintKey     = "NotebooksOnly"
+)
+
+//nolint:gochecknoglobals
+var dedicatedNodesListKinds = map[schema.GroupVersionResource]string{
+	resources.Notebook.GVR():                      resources.Notebook.ListKind(),
+	resources.OdhDashboardConfig.GVR():            resources.OdhDashboardConfig.ListKind(),
+	resources.HardwareProfile.GVR():               resources.HardwareProfile.ListKind(),
+	resources.InfrastructureHardwareProfile.GVR(): resources.InfrastructureHardwareProfile.ListKind(),
+	resources.DSCInitialization.GVR():             resources.DSCInitialization.ListKind(),
+	resources.DataScienceCluster.GVR():            resources.DataScienceCluster.ListKind(),
+}
+
+func newDashboardConfig(enabled bool) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.OdhDashboardConfig.APIVersion(),
+			"kind":       resources.OdhDashboardConfig.Kind,
+			"metadata": map[string]any{
+				"name":      notebook.DashboardConfigName,
+				"namespace": dedicatedNodesAppNamespace,
+			},
+			"spec": map[string]any{
+				"notebookController": map[string]any{
+					"notebookTolerationSettings": map[string]any{
+						"enabled": enabled,
+						"key":     dedicatedNodesTaintKey,
+					},
+				},
+			},
+		},
+	}
+}
+
+func newTolerationProfile(name string, key string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.InfrastructureHardwareProfile.APIVersion(),
+			"kind":       resources.InfrastructureHardwareProfile.Kind,
+			"metadata": map[string]any{
+				"name":      name,
+				"namespace": dedicatedNodesAppNamespace,
+			},
+			"spec": map[string]any{
+				"scheduling": map[string]any{
+					"type": "Node",
+					"node": map[string]any{
+						"tolerations": []any{
+							map[string]any{"key": key, "operator": "Exists", "effect": "NoSchedule"},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newTolerationNotebook(name string, tolerationKey string, profile string) *unstructured.Unstructured {
+	nb := &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.Notebook.APIVersion(),
+			"kind":       resources.Notebook.Kind,
+			"metadata": map[string]any{
+				"name":      name,
+				"namespace": "user-ns",
+			},
+			"spec": map[string]any{
+				"template": map[string]any{
+					"spec": map[string]any{
+						"containers": []any{map[string]any{"name": name, "image": "workbench:latest"}},
+					},
+				},
+			},
+		},
+	}
+
+	if tolerationKey != "" {
+		_ = unstructured.SetNestedSlice(nb.Object, []any{
+			map[string]any{"key": tolerationKey, "operator": "Exists", "effect": "NoSchedule"},
+		}, "spec", "template", "spec", "tolerations")
+	}
+
+	if profile != "" {
+		nb.SetAnnotations(map[string]string{notebook.AnnotationHardwareProfileName: profile})
+	}
+
+	return nb
+}
+
+func TestDedicatedNodesCheck_SettingDisabled(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: dedicatedNodesListKinds,
+		Objects: []*unstructured.Unstructured{
+			testutil.NewDSCI(dedicatedNodesAppNamespace),
+			newDashboardConfig(false),
+			newTolerationNotebook("nb", dedicatedNodesTaintKey, ""),
+		},
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	result, err := notebook.NewDedicatedNodesCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Status.Conditions).To(HaveLen(1))
+	g.Expect(result.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":   Equal(notebook.ConditionTypeDedicatedNodeScheduling),
+		"Status": Equal(metav1.ConditionTrue),
+		"Reason": Equal(check.ReasonNoMigrationRequired),
+	}))
+	g.Expect(result.ImpactedObjects).To(BeEmpty())
+}
+
+func TestDedicatedNodesCheck_NoHardwareProfile(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: dedicatedNodesListKinds,
+		Objects: []*unstructured.Unstructured{
+			testutil.NewDSCI(dedicatedNodesAppNamespace),
+			newDashboardConfig(true),
+			newTolerationProfile("gpu", "nvidia.com/gpu"),
+			newTolerationNotebook("nb", dedicatedNodesTaintKey, ""),
+		},
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	result, err := notebook.NewDedicatedNodesCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Status":  Equal(metav1.ConditionFalse),
+		"Reason":  Equal(check.ReasonResourceNotFound),
+		"Message": ContainSubstring("no HardwareProfile tolerates it"),
+	}))
+	g.Expect(result.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "1"))
+}
+
+func TestDedicatedNodesCheck_MigrationPending(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: dedicatedNodesListKinds,
+		Objects: []*unstructured.Unstructured{
+			testutil.NewDSCI(dedicatedNodesAppNamespace),
+			newDashboardConfig(true),
+			newTolerationProfile("dedicated", dedicatedNodesTaintKey),
+			newTolerationNotebook("unassigned", dedicatedNodesTaintKey, ""),
+			newTolerationNotebook("migrated", dedicatedNodesTaintKey, "dedicated"),
+			newTolerationNotebook("other-profile", dedicatedNodesTaintKey, "small"),
+			newTolerationNotebook("shared-nodes", "", ""),
+		},
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	result, err := notebook.NewDedicatedNodesCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Status":  Equal(metav1.ConditionFalse),
+		"Reason":  Equal(check.ReasonMigrationPending),
+		"Message": ContainSubstring("redhat-ods-applications/dedicated"),
+	}))
+	g.Expect(result.ImpactedObjects).To(ConsistOf(
+		MatchFields(IgnoreExtras, Fields{"ObjectMeta": MatchFields(IgnoreExtras, Fields{"Name": Equal("unassigned")})}),
+		MatchFields(IgnoreExtras, Fields{"ObjectMeta": MatchFields(IgnoreExtras, Fields{"Name": Equal("other-profile")})}),
+	))
+}
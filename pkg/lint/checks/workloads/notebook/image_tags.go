@@ -0,0 +1,166 @@
+package notebook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+	"github.com/opendatahub-io/odh-cli/pkg/util/zstream"
+)
+
+const (
+	// ConditionTypeImageTagsSupported indicates whether workbench image tags are still shipped by the target release.
+	ConditionTypeImageTagsSupported = "ImageTagsSupported"
+
+	checkTypeImageTagRefresh = "image-tag-refresh"
+
+	// annotationLastImageSelection is the "imagestream:tag" a workbench was created from in the dashboard.
+	annotationLastImageSelection = "notebooks.opendatahub.io/last-image-selection"
+)
+
+// Examples rendered by 'lint explain'.
+const (
+	imageTagFailingExample = `# Upgrading from 2.21 to 2.22, which stops shipping tag 2023.2
+apiVersion: kubeflow.org/v1
+kind: Notebook
+metadata:
+  name: wb
+  namespace: team-a
+  annotations:
+    notebooks.opendatahub.io/last-image-selection: s2i-generic-data-science-notebook:2023.2`
+
+	imageTagPassingExample = `apiVersion: kubeflow.org/v1
+kind: Notebook
+metadata:
+  name: wb
+  namespace: team-a
+  annotations:
+    notebooks.opendatahub.io/last-image-selection: s2i-generic-data-science-notebook:2025.1`
+)
+
+// ImageTagRefreshCheck reports workbenches running image tags that a 2.x release between the current
+// and the target version stops shipping. Running workbenches keep their image until they restart;
+// afterwards the tag may no longer resolve and no longer receives security fixes.
+type ImageTagRefreshCheck struct {
+	check.BaseCheck
+}
+
+func NewImageTagRefreshCheck() *ImageTagRefreshCheck {
+	return &ImageTagRefreshCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupWorkload,
+			Kind:             kind,
+			Type:             checkTypeImageTagRefresh,
+			CheckID:          "workloads.notebook.image-tag-refresh",
+			CheckName:        "Workloads :: Notebook :: Image Tag Refresh (2.x z-stream)",
+			CheckDescription: "Reports workbenches using image tags that the target 2.x release no longer ships",
+			CheckRemediation: "Select a newer image version for the listed workbenches in the dashboard before or after upgrading",
+			CheckResources: []resources.ResourceType{
+				resources.Notebook,
+			},
+			CheckVersionGate:    check.VersionGateUpgradeWithin2x,
+			CheckKnowledgeLinks: []string{check.DocsDataScienceProjects},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "The image tag of every Notebook, taken from the notebooks.opendatahub.io/last-image-selection annotation or the first container image, against the tags removed by the 2.x releases between the current and the target version. The check only runs for upgrades within 2.x.",
+				Rationale:      "Running workbenches keep their image until they restart. Afterwards a removed tag may no longer resolve, and it no longer receives security fixes.",
+				FailingExample: imageTagFailingExample,
+				PassingExample: imageTagPassingExample,
+			},
+		},
+	}
+}
+
+// CanApply returns whether this check should run for the given target.
+// Only applies when upgrading between 2.x releases.
+func (c *ImageTagRefreshCheck) CanApply(_ context.Context, target check.Target) (bool, error) {
+	return version.IsUpgradeWithin2x(target.CurrentVersion, target.TargetVersion), nil
+}
+
+// Validate executes the check against the provided target.
+func (c *ImageTagRefreshCheck) Validate(
+	ctx context.Context,
+	target check.Target,
+) (*result.DiagnosticResult, error) {
+	removed := zstream.RemovedImageTagsFor(target.CurrentVersion, target.TargetVersion)
+
+	return validate.Workloads(c, target, resources.Notebook).
+		Filter(func(nb *unstructured.Unstructured) (bool, error) {
+			tag, err := workbenchImageTag(nb)
+			if err != nil {
+				return false, err
+			}
+
+			_, ok := removed[tag]
+
+			return ok, nil
+		}).
+		Run(ctx, func(_ context.Context, req *validate.WorkloadRequest[*unstructured.Unstructured]) error {
+			for _, nb := range req.Items {
+				tag, _ := workbenchImageTag(nb)
+
+				req.Result.ImpactedObjects = append(req.Result.ImpactedObjects, metav1.PartialObjectMetadata{
+					TypeMeta: resources.Notebook.TypeMeta(),
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: nb.GetNamespace(),
+						Name:      nb.GetName(),
+						Annotations: map[string]string{
+							"check.opendatahub.io/image-tag":  tag,
+							"check.opendatahub.io/removed-in": removed[tag].String(),
+						},
+					},
+				})
+			}
+
+			req.Result.SetCondition(c.newCondition(len(req.Items)))
+
+			return nil
+		})
+}
+
+func (c *ImageTagRefreshCheck) newCondition(impacted int) result.Condition {
+	if impacted == 0 {
+		return check.NewCondition(
+			ConditionTypeImageTagsSupported,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonVersionCompatible),
+			check.WithMessage("No workbenches use image tags removed by the target release"),
+		)
+	}
+
+	return check.NewCondition(
+		ConditionTypeImageTagsSupported,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonWorkloadsImpacted),
+		check.WithMessage("Found %d workbench(es) using image tags removed by the target release", impacted),
+		check.WithImpact(result.ImpactAdvisory),
+		check.WithRemediation(c.CheckRemediation),
+	)
+}
+
+// workbenchImageTag returns the image tag of a workbench: the tag selected in the dashboard, or the
+// tag of the workbench container image.
+func workbenchImageTag(nb *unstructured.Unstructured) (string, error) {
+	if selection := nb.GetAnnotations()[annotationLastImageSelection]; selection != "" {
+		return parseImageReference(selection).Tag, nil
+	}
+
+	image, err := jq.Query[string](nb, ".spec.template.spec.containers[0].image")
+	if err != nil {
+		if errors.Is(err, jq.ErrNotFound) {
+			return "", nil
+		}
+
+		return "", fmt.Errorf("reading image of Notebook %s/%s: %w", nb.GetNamespace(), nb.GetName(), err)
+	}
+
+	return parseImageReference(image).Tag, nil
+}
@@ -0,0 +1,92 @@
+package notebook_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/notebook"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+func newWorkbench(name string, selection string, image string) *unstructured.Unstructured {
+	obj := resources.Notebook.Unstructured()
+	obj.SetNamespace("team-a")
+	obj.SetName(name)
+
+	if selection != "" {
+		obj.SetAnnotations(map[string]string{"notebooks.opendatahub.io/last-image-selection": selection})
+	}
+
+	_ = unstructured.SetNestedSlice(obj.Object, []any{
+		map[string]any{"name": name, "image": image},
+	}, "spec", "template", "spec", "containers")
+
+	return &obj
+}
+
+func TestImageTagRefreshCheck(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: map[schema.GroupVersionResource]string{
+			resources.Notebook.GVR(): resources.Notebook.ListKind(),
+		},
+		Objects: []*unstructured.Unstructured{
+			newWorkbench("selected-old", "s2i-minimal-notebook:2023.2", "image-registry.openshift-image-registry.svc:5000/redhat-ods-applications/s2i-minimal-notebook@sha256:abc"),
+			newWorkbench("image-old", "", "image-registry.openshift-image-registry.svc:5000/redhat-ods-applications/s2i-generic-data-science-notebook:2023.2"),
+			newWorkbench("current", "s2i-minimal-notebook:2025.1", "quay.io/example/minimal:2025.1"),
+			newWorkbench("already-removed", "s2i-minimal-notebook:2023.1", "quay.io/example/minimal:2023.1"),
+		},
+		CurrentVersion: "2.19.0",
+		TargetVersion:  "2.22.0",
+	})
+
+	dr, err := notebook.NewImageTagRefreshCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Status.Conditions[0]).To(MatchFields(IgnoreExtras, Fields{
+		"Condition": MatchFields(IgnoreExtras, Fields{
+			"Type":    Equal(notebook.ConditionTypeImageTagsSupported),
+			"Status":  Equal(metav1.ConditionFalse),
+			"Message": ContainSubstring("Found 2 workbench(es)"),
+		}),
+		"Impact": Equal(resultpkg.ImpactAdvisory),
+	}))
+	g.Expect(dr.ImpactedObjects).To(HaveLen(2))
+	g.Expect(dr.ImpactedObjects).To(ConsistOf(
+		MatchFields(IgnoreExtras, Fields{
+			"ObjectMeta": MatchFields(IgnoreExtras, Fields{
+				"Name":        Equal("selected-old"),
+				"Annotations": HaveKeyWithValue("check.opendatahub.io/removed-in", "2.22"),
+			}),
+		}),
+		MatchFields(IgnoreExtras, Fields{
+			"ObjectMeta": MatchFields(IgnoreExtras, Fields{
+				"Name":        Equal("image-old"),
+				"Annotations": HaveKeyWithValue("check.opendatahub.io/image-tag", "2023.2"),
+			}),
+		}),
+	))
+}
+
+func TestImageTagRefreshCheck_CanApply(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := notebook.NewImageTagRefreshCheck()
+
+	canApply, err := chk.CanApply(t.Context(), testutil.NewTarget(t, testutil.TargetConfig{CurrentVersion: "2.19.0", TargetVersion: "2.22.0"}))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeTrue())
+
+	canApply, err = chk.CanApply(t.Context(), testutil.NewTarget(t, testutil.TargetConfig{CurrentVersion: "2.22.0", TargetVersion: "2.22.0"}))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeFalse())
+}
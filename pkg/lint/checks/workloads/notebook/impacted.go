@@ -1,15 +1,21 @@
 package notebook
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	iolib "io"
+	"maps"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/duration"
 
 	"github.com/opendatahub-io/odh-cli/pkg/constants"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
@@ -43,6 +49,17 @@ const (
 	// Annotation that indicates an ImageStream is managed by the RHOAI operator.
 	// ImageStreams without this annotation are user-contributed custom images.
 	ootbPlatformVersionAnnotation = "platform.opendatahub.io/version"
+
+	// Annotations the Kubeflow notebook controller sets on stopped workbenches and on
+	// workbenches with recorded activity.
+	kubeflowStoppedAnnotation      = "kubeflow-resource-stopped"
+	kubeflowLastActivityAnnotation = "notebooks.kubeflow.org/last-activity"
+
+	// Annotations of the impacted notebooks giving whether they are running and their last
+	// activity, as an RFC 3339 timestamp and as its age at the time of the check.
+	annotationRunning         = "check.opendatahub.io/running"
+	annotationLastActivity    = "check.opendatahub.io/last-activity"
+	annotationLastActivityAge = "check.opendatahub.io/last-activity-age"
 )
 
 // ImageStatus represents the compatibility status of a notebook's image.
@@ -67,6 +84,7 @@ const (
 
 // ootbImageStream represents a discovered OOTB ImageStream with its notebook type.
 type ootbImageStream struct {
+	Namespace             string
 	Name                  string
 	Type                  NotebookType
 	DockerImageRepository string // .status.dockerImageRepository for path-based matching
@@ -79,6 +97,9 @@ type notebookAnalysis struct {
 	Status    ImageStatus
 	Reason    string
 	ImageRef  string // Primary container image reference (for image-centric grouping)
+
+	Running      bool       // Not stopped through the Kubeflow stop annotation
+	LastActivity *time.Time // Last activity recorded by the notebook controller, if any
 }
 
 // imageAnalysis contains the analysis result for a single container image.
@@ -99,16 +120,73 @@ type imageRef struct {
 
 // ootbImageInput bundles parameters for OOTB image analysis.
 type ootbImageInput struct {
+	Namespace       string       // Namespace of the resolved ImageStream
 	ImageStreamName string       // Resolved ImageStream name
 	Tag             string       // Image tag
 	SHA             string       // Image SHA digest
 	Type            NotebookType // Notebook type (jupyter, rstudio, codeserver)
 }
 
+// Examples rendered by 'lint explain'.
+const (
+	impactedFailingExample = `apiVersion: kubeflow.org/v1
+kind: Notebook
+metadata:
+  name: code
+  namespace: team-a
+spec:
+  template:
+    spec:
+      containers:
+        - name: code
+          image: image-registry.openshift-image-registry.svc:5000/redhat-ods-applications/code-server-notebook:2024.2`
+
+	impactedPassingExample = `apiVersion: kubeflow.org/v1
+kind: Notebook
+metadata:
+  name: code
+  namespace: team-a
+spec:
+  template:
+    spec:
+      containers:
+        - name: code
+          image: image-registry.openshift-image-registry.svc:5000/redhat-ods-applications/code-server-notebook:2025.2`
+)
+
 // ImpactedWorkloadsCheck identifies Notebook (workbench) instances that will not work in RHOAI 3.x
 // due to nginx compatibility requirements in non-Jupyter images.
 type ImpactedWorkloadsCheck struct {
 	check.BaseCheck
+
+	// OnlyRunning restricts the analysis to running workbenches, leaving out the ones stopped
+	// through the Kubeflow stop annotation.
+	OnlyRunning bool
+}
+
+// impactedWorkloadsParameters are the parameters of the check settable in the lint
+// configuration file.
+type impactedWorkloadsParameters struct {
+	// OnlyRunning overrides OnlyRunning.
+	OnlyRunning *bool `json:"onlyRunning,omitempty"`
+}
+
+// SetParameters applies the parameters of the check from the lint configuration file.
+func (c *ImpactedWorkloadsCheck) SetParameters(data []byte) error {
+	var params impactedWorkloadsParameters
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&params); err != nil {
+		return fmt.Errorf("decoding parameters: %w", err)
+	}
+
+	if params.OnlyRunning != nil {
+		c.OnlyRunning = *params.OnlyRunning
+	}
+
+	return nil
 }
 
 func NewImpactedWorkloadsCheck() *ImpactedWorkloadsCheck {
@@ -125,6 +203,24 @@ func NewImpactedWorkloadsCheck() *ImpactedWorkloadsCheck {
 			CheckName:        "Workloads :: Notebook :: Impacted Workloads (3.x)",
 			CheckDescription: "Identifies Notebook (workbench) instances with images that will not work in RHOAI 3.x",
 			CheckRemediation: "Update workbenches with incompatible images to use 2025.2+ versions before upgrading",
+			CheckResources: []resources.ResourceType{
+				resources.DataScienceCluster,
+				resources.DSCInitialization,
+				resources.Notebook,
+				resources.ImageStream,
+				resources.ImageStreamTag,
+			},
+			CheckVersionGate:    check.VersionGateUpgrade2xTo3x,
+			CheckKnowledgeLinks: []string{check.DocsDataScienceProjects},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "The container images of every Notebook, matched against the out-of-the-box workbench ImageStreams of the applications namespace (or the --imagestream-namespace namespaces) by reference, digest or repository. Non-Jupyter images (code-server, RStudio) must be tag 2025.2 or later, or for RStudio built from rhoai-2.25 or later; images not found in any ImageStream are reported as custom, unless --inspect-registry classifies them from the labels and environment of their image config. Impacted workbenches are annotated with whether they are running and their last activity; the onlyRunning parameter leaves out stopped workbenches. The check only runs when upgrading from 2.x to 3.x with Workbenches Managed.",
+				Rationale:      "Non-Jupyter workbench images need the nginx fix shipped with the 2025.2 images to work in RHOAI 3.x. Older code-server and RStudio workbenches do not start correctly after the upgrade, and custom images need to be verified by their owners.",
+				FailingExample: impactedFailingExample,
+				PassingExample: impactedPassingExample,
+				RemediationCommands: []string{
+					"kubectl odh lint --target-version 3.0 --checks workloads.notebook.impacted-workloads -v",
+				},
+			},
 		},
 	}
 }
@@ -141,7 +237,7 @@ type imageGroup struct {
 //
 //	image: registry/path:tag (N notebooks)
 //	  - namespace/name
-//	  - namespace/name
+//	  - namespace/name (stopped)
 func renderNotebookImpactedGroup(out iolib.Writer, objects []metav1.PartialObjectMetadata, maxDisplay int) {
 	// Group notebooks by image reference, preserving insertion order.
 	var groups []imageGroup
@@ -161,6 +257,10 @@ func renderNotebookImpactedGroup(out iolib.Writer, objects []metav1.PartialObjec
 			name = obj.Namespace + "/" + name
 		}
 
+		if obj.Annotations[annotationRunning] == "false" {
+			name += " (stopped)"
+		}
+
 		if idx, ok := imageIndex[imageRef]; ok {
 			groups[idx].notebooks = append(groups[idx].notebooks, name)
 		} else {
@@ -203,6 +303,29 @@ func renderNotebookImpactedGroup(out iolib.Writer, objects []metav1.PartialObjec
 	}
 }
 
+// isStopped returns whether the notebook is stopped through the Kubeflow stop annotation.
+func isStopped(nb *unstructured.Unstructured) bool {
+	_, stopped := nb.GetAnnotations()[kubeflowStoppedAnnotation]
+
+	return stopped
+}
+
+// lastActivity returns the last activity the notebook controller recorded for the notebook,
+// or nil when none or an unparsable one is recorded.
+func lastActivity(nb *unstructured.Unstructured) *time.Time {
+	value, ok := nb.GetAnnotations()[kubeflowLastActivityAnnotation]
+	if !ok {
+		return nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil
+	}
+
+	return &t
+}
+
 // imageStatusLabel returns a user-friendly label for the image status.
 func imageStatusLabel(status string) string {
 	switch ImageStatus(status) {
@@ -241,43 +364,55 @@ func (c *ImpactedWorkloadsCheck) Validate(
 ) (*result.DiagnosticResult, error) {
 	return validate.Workloads(c, target, resources.Notebook).
 		Run(ctx, func(ctx context.Context, req *validate.WorkloadRequest[*unstructured.Unstructured]) error {
-			return c.analyzeNotebooks(ctx, req)
+			return c.analyzeNotebooks(ctx, req, target)
 		})
 }
 
-// analyzeNotebooks performs image compatibility analysis on all notebooks.
+// analyzeNotebooks performs image compatibility analysis on all notebooks. OOTB ImageStreams
+// are looked up in the target ImageStreamNamespaces, or in the applications namespace when
+// empty, and images not found in them are inspected in the target Registry when set.
 func (c *ImpactedWorkloadsCheck) analyzeNotebooks(
 	ctx context.Context,
 	req *validate.WorkloadRequest[*unstructured.Unstructured],
+	target check.Target,
 ) error {
 	notebooks := req.Items
 	log := newDebugLogger(req.IO, req.Debug)
 
+	if c.OnlyRunning {
+		notebooks = slices.DeleteFunc(slices.Clone(notebooks), func(nb *unstructured.Unstructured) bool {
+			return isStopped(nb)
+		})
+
+		log.logf("[notebook] Skipping %d stopped notebook(s)", len(req.Items)-len(notebooks))
+	}
+
 	log.logf("[notebook] Analyzing %d notebook(s)", len(notebooks))
 
 	if len(notebooks) == 0 {
+		message := "No Notebook (workbench) instances found"
+		if c.OnlyRunning {
+			message = "No running Notebook (workbench) instances found"
+		}
+
 		req.Result.SetCondition(check.NewCondition(
 			ConditionTypeNotebooksCompatible,
 			metav1.ConditionTrue,
 			check.WithReason(check.ReasonVersionCompatible),
-			check.WithMessage("No Notebook (workbench) instances found"),
+			check.WithMessage("%s", message),
 		))
 
 		return nil
 	}
 
-	// Resolve the applications namespace from DSCInitialization.
-	appNS, err := client.GetApplicationsNamespace(ctx, req.Client)
-	if err != nil {
-		return fmt.Errorf("getting applications namespace: %w", err)
-	}
-
 	// Discover OOTB ImageStreams.
-	ootbImages, imageStreamData, err := c.discoverOOTBImageStreams(ctx, req.Client, appNS, log)
+	ootbImages, imageStreamData, err := c.discoverImageStreams(ctx, req.Client, target.ImageStreamNamespaces, log)
 	if err != nil {
-		return fmt.Errorf("discovering OOTB ImageStreams: %w", err)
+		return err
 	}
 
+	inspector := newImageInspector(target.Registry)
+
 	log.logf("[notebook] Discovered %d OOTB ImageStreams, %d total ImageStreams",
 		len(ootbImages), len(imageStreamData))
 
@@ -285,7 +420,9 @@ func (c *ImpactedWorkloadsCheck) analyzeNotebooks(
 	var analyses []notebookAnalysis
 
 	for _, nb := range notebooks {
-		analysis := c.analyzeNotebook(ctx, req.Client, nb, ootbImages, imageStreamData, appNS, log)
+		analysis := c.analyzeNotebook(ctx, req.Client, nb, ootbImages, imageStreamData, inspector, log)
+		analysis.Running = !isStopped(nb)
+		analysis.LastActivity = lastActivity(nb)
 		analyses = append(analyses, analysis)
 	}
 
@@ -298,15 +435,50 @@ func (c *ImpactedWorkloadsCheck) analyzeNotebooks(
 	return nil
 }
 
-// discoverOOTBImageStreams fetches ImageStreams with the OOTB label and determines their notebook types.
+// discoverImageStreams discovers the OOTB ImageStreams of each of namespaces, or of the
+// applications namespace from DSCInitialization when namespaces is empty.
+func (c *ImpactedWorkloadsCheck) discoverImageStreams(
+	ctx context.Context,
+	reader client.Reader,
+	namespaces []string,
+	log debugLogger,
+) (map[string]ootbImageStream, []*unstructured.Unstructured, error) {
+	if len(namespaces) == 0 {
+		appNS, err := client.GetApplicationsNamespace(ctx, reader)
+		if err != nil {
+			return nil, nil, fmt.Errorf("getting applications namespace: %w", err)
+		}
+
+		namespaces = []string{appNS}
+	}
+
+	ootbImages := make(map[string]ootbImageStream)
+
+	var imageStreamData []*unstructured.Unstructured
+
+	for _, ns := range namespaces {
+		nsImages, nsData, err := c.discoverOOTBImageStreams(ctx, reader, ns, log)
+		if err != nil {
+			return nil, nil, fmt.Errorf("discovering OOTB ImageStreams in %s: %w", ns, err)
+		}
+
+		maps.Copy(ootbImages, nsImages)
+		imageStreamData = append(imageStreamData, nsData...)
+	}
+
+	return ootbImages, imageStreamData, nil
+}
+
+// discoverOOTBImageStreams fetches the ImageStreams of namespace with the OOTB label and determines
+// their notebook types. The OOTB ImageStreams are keyed by imageStreamKey.
 func (c *ImpactedWorkloadsCheck) discoverOOTBImageStreams(
 	ctx context.Context,
 	reader client.Reader,
-	appNS string,
+	namespace string,
 	log debugLogger,
 ) (map[string]ootbImageStream, []*unstructured.Unstructured, error) {
 	imageStreams, err := reader.List(ctx, resources.ImageStream,
-		client.WithNamespace(appNS),
+		client.WithNamespace(namespace),
 		client.WithLabelSelector(ootbLabel),
 	)
 	if err != nil {
@@ -339,18 +511,25 @@ func (c *ImpactedWorkloadsCheck) discoverOOTBImageStreams(
 
 		nbType := c.determineNotebookType(is)
 		dockerRepo, _ := jq.Query[string](is, ".status.dockerImageRepository")
-		ootbImages[name] = ootbImageStream{
+		ootbImages[imageStreamKey(namespace, name)] = ootbImageStream{
+			Namespace:             namespace,
 			Name:                  name,
 			Type:                  nbType,
 			DockerImageRepository: dockerRepo,
 		}
 
-		log.logf("[notebook]   ImageStream %s: type=%s, dockerRepo=%s", name, nbType, dockerRepo)
+		log.logf("[notebook]   ImageStream %s/%s: type=%s, dockerRepo=%s", namespace, name, nbType, dockerRepo)
 	}
 
 	return ootbImages, imageStreams, nil
 }
 
+// imageStreamKey returns the key of an ImageStream in the OOTB ImageStreams map. ImageStreams
+// of the same name may exist in several of the looked up namespaces.
+func imageStreamKey(namespace string, name string) string {
+	return namespace + "/" + name
+}
+
 // determineNotebookType determines the notebook type from ImageStream annotations.
 // Parses the JSON annotation values for precise matching.
 func (c *ImpactedWorkloadsCheck) determineNotebookType(is *unstructured.Unstructured) NotebookType {
@@ -401,7 +580,7 @@ func (c *ImpactedWorkloadsCheck) analyzeNotebook(
 	nb *unstructured.Unstructured,
 	ootbImages map[string]ootbImageStream,
 	imageStreamData []*unstructured.Unstructured,
-	appNS string,
+	inspector *imageInspector,
 	log debugLogger,
 ) notebookAnalysis {
 	ns := nb.GetNamespace()
@@ -454,7 +633,7 @@ func (c *ImpactedWorkloadsCheck) analyzeNotebook(
 			continue
 		}
 
-		analysis := c.analyzeImage(ctx, reader, image, ootbImages, imageStreamData, appNS, log)
+		analysis := c.analyzeImage(ctx, reader, image, ootbImages, imageStreamData, inspector, log)
 		analysis.ContainerName = containerName
 		analysis.ImageRef = image
 
@@ -473,14 +652,15 @@ func (c *ImpactedWorkloadsCheck) analyzeNotebook(
 // 1. dockerImageReference: Exact match against .status.tags[*].items[*].dockerImageReference
 // 2. SHA lookup: Match SHA against .status.tags[*].items[*].image
 // 3. dockerImageRepository: Match path against .status.dockerImageRepository (internal registry)
-// If none match, the image is classified as CUSTOM (user-provided image requiring manual verification).
+// If none match, the image is classified from its registry metadata when inspector is set, and
+// otherwise as CUSTOM (user-provided image requiring manual verification).
 func (c *ImpactedWorkloadsCheck) analyzeImage(
 	ctx context.Context,
 	reader client.Reader,
 	image string,
 	ootbImages map[string]ootbImageStream,
 	imageStreamData []*unstructured.Unstructured,
-	appNS string,
+	inspector *imageInspector,
 	log debugLogger,
 ) imageAnalysis {
 	// Parse image reference to get name, tag, SHA, and full path.
@@ -494,17 +674,18 @@ func (c *ImpactedWorkloadsCheck) analyzeImage(
 	// Against ImageStream's: .status.tags[*].items[*].dockerImageReference
 	lookup := c.findImageStreamByDockerImageRef(image, imageStreamData)
 	if lookup.Found {
-		ootbIS, isOOTB := ootbImages[lookup.ImageStreamName]
+		ootbIS, isOOTB := ootbImages[imageStreamKey(lookup.Namespace, lookup.ImageStreamName)]
 		if isOOTB {
 			log.logf("[notebook]     Strategy 1 (dockerImageRef) matched: is=%s tag=%s type=%s",
 				lookup.ImageStreamName, lookup.Tag, ootbIS.Type)
 
 			return c.analyzeOOTBImage(ctx, reader, ootbImageInput{
+				Namespace:       lookup.Namespace,
 				ImageStreamName: lookup.ImageStreamName,
 				Tag:             lookup.Tag,
 				SHA:             ref.SHA,
 				Type:            ootbIS.Type,
-			}, imageStreamData, appNS, log)
+			}, imageStreamData, log)
 		}
 
 		log.logf("[notebook]     Strategy 1 matched is=%s but not in OOTB map (possibly runtime image)",
@@ -517,16 +698,17 @@ func (c *ImpactedWorkloadsCheck) analyzeImage(
 		log.logf("[notebook]     Strategy 2 skipped: no SHA in image reference")
 	} else if lookup := c.findImageStreamForSHA(ref.SHA, imageStreamData); !lookup.Found {
 		log.logf("[notebook]     Strategy 2 (SHA lookup): no match for sha=%s", truncateSHA(ref.SHA))
-	} else if ootbIS, isOOTB := ootbImages[lookup.ImageStreamName]; isOOTB {
+	} else if ootbIS, isOOTB := ootbImages[imageStreamKey(lookup.Namespace, lookup.ImageStreamName)]; isOOTB {
 		log.logf("[notebook]     Strategy 2 (SHA lookup) matched: is=%s tag=%s type=%s",
 			lookup.ImageStreamName, lookup.Tag, ootbIS.Type)
 
 		return c.analyzeOOTBImage(ctx, reader, ootbImageInput{
+			Namespace:       lookup.Namespace,
 			ImageStreamName: lookup.ImageStreamName,
 			Tag:             lookup.Tag,
 			SHA:             ref.SHA,
 			Type:            ootbIS.Type,
-		}, imageStreamData, appNS, log)
+		}, imageStreamData, log)
 	} else {
 		log.logf("[notebook]     Strategy 2 matched is=%s but not in OOTB map",
 			lookup.ImageStreamName)
@@ -540,11 +722,12 @@ func (c *ImpactedWorkloadsCheck) analyzeImage(
 			ootbIS.Name, ref.Tag, ootbIS.Type)
 
 		return c.analyzeOOTBImage(ctx, reader, ootbImageInput{
+			Namespace:       ootbIS.Namespace,
 			ImageStreamName: ootbIS.Name,
 			Tag:             ref.Tag,
 			SHA:             ref.SHA,
 			Type:            ootbIS.Type,
-		}, imageStreamData, appNS, log)
+		}, imageStreamData, log)
 	}
 
 	log.logf("[notebook]     Strategy 3 (dockerImageRepo): no match for path=%s", ref.FullPath)
@@ -552,6 +735,12 @@ func (c *ImpactedWorkloadsCheck) analyzeImage(
 	// No OOTB correlation found - mark as custom image requiring user verification.
 	// We intentionally do NOT use name-based matching as a fallback because an image
 	// from any registry could coincidentally have the same name as an OOTB ImageStream.
+	if inspector != nil {
+		if analysis, ok := inspector.inspect(ctx, image, log); ok {
+			return analysis
+		}
+	}
+
 	log.logf("[notebook]     All strategies failed -> CUSTOM")
 
 	return imageAnalysis{
@@ -566,7 +755,6 @@ func (c *ImpactedWorkloadsCheck) analyzeOOTBImage(
 	reader client.Reader,
 	input ootbImageInput,
 	imageStreamData []*unstructured.Unstructured,
-	appNS string,
 	log debugLogger,
 ) imageAnalysis {
 	log.logf("[notebook]     analyzeOOTBImage: is=%s tag=%s sha=%s type=%s",
@@ -586,7 +774,7 @@ func (c *ImpactedWorkloadsCheck) analyzeOOTBImage(
 	if input.Type == NotebookTypeRStudio {
 		log.logf("[notebook]     -> checking RStudio build reference")
 
-		return c.analyzeRStudioImageCompat(ctx, reader, input.ImageStreamName, input.Tag, input.SHA, appNS, log)
+		return c.analyzeRStudioImageCompat(ctx, reader, input.Namespace, input.ImageStreamName, input.Tag, input.SHA, log)
 	}
 
 	// For CodeServer and other non-Jupyter images, check tag version.
@@ -597,6 +785,7 @@ func (c *ImpactedWorkloadsCheck) analyzeOOTBImage(
 
 // imageLookupResult contains the result of looking up an image in ImageStreams.
 type imageLookupResult struct {
+	Namespace       string
 	ImageStreamName string
 	Tag             string
 	Found           bool
@@ -638,6 +827,7 @@ func (c *ImpactedWorkloadsCheck) findImageStreamByDockerImageRef(
 				dockerImageRef, _ := itemMap["dockerImageReference"].(string)
 				if dockerImageRef == imageRef {
 					return imageLookupResult{
+						Namespace:       is.GetNamespace(),
 						ImageStreamName: isName,
 						Tag:             tagName,
 						Found:           true,
@@ -687,6 +877,7 @@ func (c *ImpactedWorkloadsCheck) findImageStreamForSHA(
 				// Compare SHA values - both should be in format "sha256:xxx..."
 				if itemImage == sha {
 					return imageLookupResult{
+						Namespace:       is.GetNamespace(),
 						ImageStreamName: isName,
 						Tag:             tagName,
 						Found:           true,
@@ -802,8 +993,8 @@ func (c *ImpactedWorkloadsCheck) aggregateImageAnalyses(
 func (c *ImpactedWorkloadsCheck) analyzeRStudioImageCompat(
 	ctx context.Context,
 	reader client.Reader,
+	namespace string,
 	imageName, imageTag, imageSHA string,
-	appNS string,
 	log debugLogger,
 ) imageAnalysis {
 	// Look up the ImageStreamTag to get build reference.
@@ -816,7 +1007,7 @@ func (c *ImpactedWorkloadsCheck) analyzeRStudioImageCompat(
 	istName := imageName + ":" + tag
 
 	ist, err := reader.GetResource(ctx, resources.ImageStreamTag, istName,
-		client.InNamespace(appNS))
+		client.InNamespace(namespace))
 	if err != nil {
 		log.logf("[notebook]     RStudio: VERIFY_FAILED - could not fetch ImageStreamTag %s: %v", istName, err)
 
@@ -1131,7 +1322,7 @@ func (c *ImpactedWorkloadsCheck) setConditions(
 			check.WithReason(check.ReasonWorkloadsImpacted),
 			check.WithMessage("%s", message),
 			check.WithImpact(result.ImpactAdvisory),
-			check.WithRemediation("Verify custom images are compatible with RHOAI 3.x before upgrading"),
+			check.WithRemediation("Verify custom images are compatible with RHOAI 3.x before upgrading, or run with --inspect-registry to classify them from their registry metadata"),
 		))
 
 	default:
@@ -1160,16 +1351,25 @@ func (c *ImpactedWorkloadsCheck) setImpactedObjects(
 			continue
 		}
 
+		annotations := map[string]string{
+			"check.opendatahub.io/image-status": string(a.Status),
+			"check.opendatahub.io/image-ref":    a.ImageRef,
+			"check.opendatahub.io/reason":       a.Reason,
+			annotationRunning:                   strconv.FormatBool(a.Running),
+		}
+
+		// The last activity lets operators prioritize the workbenches in active use.
+		if a.LastActivity != nil {
+			annotations[annotationLastActivity] = a.LastActivity.UTC().Format(time.RFC3339)
+			annotations[annotationLastActivityAge] = duration.HumanDuration(time.Since(*a.LastActivity))
+		}
+
 		impacted = append(impacted, metav1.PartialObjectMetadata{
 			TypeMeta: resources.Notebook.TypeMeta(),
 			ObjectMeta: metav1.ObjectMeta{
-				Namespace: a.Namespace,
-				Name:      a.Name,
-				Annotations: map[string]string{
-					"check.opendatahub.io/image-status": string(a.Status),
-					"check.opendatahub.io/image-ref":    a.ImageRef,
-					"check.opendatahub.io/reason":       a.Reason,
-				},
+				Namespace:   a.Namespace,
+				Name:        a.Name,
+				Annotations: annotations,
 			},
 		})
 	}
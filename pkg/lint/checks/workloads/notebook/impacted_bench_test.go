@@ -0,0 +1,68 @@
+package notebook_test
+
+import (
+	"fmt"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/notebook"
+)
+
+// benchmarkImages are the Notebook images of the benchmark fixtures, covering every lookup
+// strategy: compatible and incompatible SHAs and tags, external references and custom images.
+//
+//nolint:gochecknoglobals
+var benchmarkImages = []string{
+	jupyterCompatibleSHA,
+	codeserverIncompatibleSHA,
+	rstudioCompatibleSHA,
+	rstudioIncompatibleSHA,
+	jupyterCompatibleTag,
+	codeserverIncompatibleTag,
+	jupyterExternalCompatible,
+	codeserverExternalIncompatible,
+	customImageTag,
+	customImageSHA,
+}
+
+// BenchmarkImpactedWorkloadsCheck_Validate benchmarks the image analysis of 1k and 10k
+// Notebooks spread over 100 namespaces.
+func BenchmarkImpactedWorkloadsCheck_Validate(b *testing.B) {
+	for _, size := range []int{1000, 10000} {
+		b.Run(fmt.Sprintf("%dk", size/1000), func(b *testing.B) {
+			objects := []*unstructured.Unstructured{
+				testutil.NewDSCI(applicationsNS),
+				newImageStream(isJupyterDatascience, "jupyter"),
+				newImageStream(isCodeserverDatascience, "codeserver"),
+				newImageStream(isRstudioRhel9, "rstudio"),
+				newRStudioImageStreamTag(isRstudioRhel9, buildRefIncompatible, shaRstudioIncompatible),
+			}
+
+			for i := range size {
+				objects = append(objects, newNotebook(
+					fmt.Sprintf("ns-%d", i%100),
+					fmt.Sprintf("notebook-%d", i),
+					benchmarkImages[i%len(benchmarkImages)],
+				))
+			}
+
+			target := testutil.NewTarget(b, testutil.TargetConfig{
+				ListKinds:      listKinds,
+				Objects:        objects,
+				CurrentVersion: "2.17.0",
+				TargetVersion:  "3.0.0",
+			})
+
+			impactedCheck := notebook.NewImpactedWorkloadsCheck()
+			ctx := b.Context()
+
+			for b.Loop() {
+				if _, err := impactedCheck.Validate(ctx, target); err != nil {
+					b.Fatalf("Validate failed: %v", err)
+				}
+			}
+		})
+	}
+}
@@ -423,6 +423,56 @@ func TestImpactedWorkloadsCheck_SingleNotebook(t *testing.T) {
 	}
 }
 
+func TestImpactedWorkloadsCheck_ImageStreamNamespaces(t *testing.T) {
+	// The OOTB ImageStream lives outside the applications namespace of the DSCInitialization.
+	newObjects := func() []*unstructured.Unstructured {
+		imageStream := newImageStream(isCodeserverDatascience, "codeserver")
+		imageStream.SetNamespace("opendatahub")
+
+		return []*unstructured.Unstructured{
+			imageStream,
+			newNotebook("test-ns", "codeserver-nb", codeserverIncompatibleSHA),
+			testutil.NewDSCI(applicationsNS),
+		}
+	}
+
+	tests := []struct {
+		name           string
+		namespaces     []string
+		expectedImpact resultpkg.Impact
+	}{
+		{
+			name:           "ApplicationsNamespace_ImageNotFound",
+			expectedImpact: resultpkg.ImpactAdvisory,
+		},
+		{
+			name:           "Override_ImageFound",
+			namespaces:     []string{applicationsNS, "opendatahub"},
+			expectedImpact: resultpkg.ImpactBlocking,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			target := testutil.NewTarget(t, testutil.TargetConfig{
+				ListKinds:      listKinds,
+				Objects:        newObjects(),
+				CurrentVersion: "2.17.0",
+				TargetVersion:  "3.0.0",
+			})
+			target.ImageStreamNamespaces = tc.namespaces
+
+			result, err := notebook.NewImpactedWorkloadsCheck().Validate(t.Context(), target)
+
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(result.Status.Conditions).To(HaveLen(1))
+			g.Expect(result.Status.Conditions[0].Impact).To(Equal(tc.expectedImpact))
+		})
+	}
+}
+
 func TestImpactedWorkloadsCheck_MultiContainer(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -940,3 +990,85 @@ func TestImpactedWorkloadsCheck_InfrastructureContainerFiltering(t *testing.T) {
 		})
 	}
 }
+
+func TestImpactedWorkloadsCheck_Activity(t *testing.T) {
+	newObjects := func() []*unstructured.Unstructured {
+		running := newNotebook("test-ns", "running-nb", codeserverIncompatibleSHA)
+		running.SetAnnotations(map[string]string{
+			"notebooks.kubeflow.org/last-activity": "2025-06-01T10:00:00Z",
+		})
+
+		stopped := newNotebook("test-ns", "stopped-nb", codeserverIncompatibleSHA)
+		stopped.SetAnnotations(map[string]string{
+			"kubeflow-resource-stopped": "2025-05-01T10:00:00Z",
+		})
+
+		return []*unstructured.Unstructured{
+			newImageStream(isCodeserverDatascience, "codeserver"),
+			running,
+			stopped,
+			testutil.NewDSCI(applicationsNS),
+		}
+	}
+
+	newTarget := func(t *testing.T) check.Target {
+		t.Helper()
+
+		return testutil.NewTarget(t, testutil.TargetConfig{
+			ListKinds:      listKinds,
+			Objects:        newObjects(),
+			CurrentVersion: "2.17.0",
+			TargetVersion:  "3.0.0",
+		})
+	}
+
+	t.Run("should annotate running state and last activity", func(t *testing.T) {
+		g := NewWithT(t)
+
+		result, err := notebook.NewImpactedWorkloadsCheck().Validate(t.Context(), newTarget(t))
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.ImpactedObjects).To(ConsistOf(
+			MatchFields(IgnoreExtras, Fields{
+				"ObjectMeta": MatchFields(IgnoreExtras, Fields{
+					"Name": Equal("running-nb"),
+					"Annotations": SatisfyAll(
+						HaveKeyWithValue("check.opendatahub.io/running", "true"),
+						HaveKeyWithValue("check.opendatahub.io/last-activity", "2025-06-01T10:00:00Z"),
+						HaveKey("check.opendatahub.io/last-activity-age"),
+					),
+				}),
+			}),
+			MatchFields(IgnoreExtras, Fields{
+				"ObjectMeta": MatchFields(IgnoreExtras, Fields{
+					"Name": Equal("stopped-nb"),
+					"Annotations": SatisfyAll(
+						HaveKeyWithValue("check.opendatahub.io/running", "false"),
+						Not(HaveKey("check.opendatahub.io/last-activity")),
+					),
+				}),
+			}),
+		))
+	})
+
+	t.Run("should leave out stopped notebooks with onlyRunning", func(t *testing.T) {
+		g := NewWithT(t)
+
+		impactedCheck := notebook.NewImpactedWorkloadsCheck()
+		g.Expect(impactedCheck.SetParameters([]byte(`{"onlyRunning":true}`))).To(Succeed())
+		g.Expect(impactedCheck.OnlyRunning).To(BeTrue())
+
+		result, err := impactedCheck.Validate(t.Context(), newTarget(t))
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.ImpactedObjects).To(HaveLen(1))
+		g.Expect(result.ImpactedObjects[0].Name).To(Equal("running-nb"))
+	})
+
+	t.Run("should reject unknown parameters", func(t *testing.T) {
+		g := NewWithT(t)
+
+		err := notebook.NewImpactedWorkloadsCheck().SetParameters([]byte(`{"running":true}`))
+		g.Expect(err).To(MatchError(ContainSubstring(`unknown field "running"`)))
+	})
+}
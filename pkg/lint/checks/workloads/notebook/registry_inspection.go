@@ -0,0 +1,152 @@
+package notebook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/opendatahub-io/odh-cli/pkg/util/registry"
+)
+
+const (
+	// Image labels carrying the same notebook software annotations as OOTB ImageStream tags.
+	labelNotebookPythonDependencies = "opendatahub.io/notebook-python-dependencies"
+	labelNotebookSoftware           = "opendatahub.io/notebook-software"
+
+	// envBuildReference is set by OpenShift builds to the source reference of the image.
+	envBuildReference = "OPENSHIFT_BUILD_REFERENCE"
+)
+
+// imageInspector classifies the images not correlated to an OOTB ImageStream from the labels
+// and environment of their registry image config (lint --inspect-registry). Inspections are
+// cached per image for the run.
+type imageInspector struct {
+	registry *registry.Client
+	results  map[string]imageAnalysis
+}
+
+// newImageInspector returns an inspector using client, or nil when client is nil.
+func newImageInspector(client *registry.Client) *imageInspector {
+	if client == nil {
+		return nil
+	}
+
+	return &imageInspector{registry: client, results: make(map[string]imageAnalysis)}
+}
+
+// inspect returns the analysis of image from its registry metadata, and whether the metadata
+// was conclusive. Images that cannot be inspected stay CUSTOM.
+func (i *imageInspector) inspect(ctx context.Context, image string, log debugLogger) (imageAnalysis, bool) {
+	if analysis, ok := i.results[image]; ok {
+		return analysis, analysis.Status != ImageStatusCustom
+	}
+
+	analysis := imageAnalysis{Status: ImageStatusCustom}
+
+	ref, err := registry.ParseReference(image)
+	if err == nil {
+		var config *registry.ImageConfig
+
+		config, err = i.registry.InspectConfig(ctx, ref)
+		if err == nil {
+			analysis = classifyImageConfig(ref.Tag, config)
+		}
+	}
+
+	if err != nil {
+		log.logf("[notebook]     Registry inspection of %s failed: %v", image, err)
+	} else {
+		log.logf("[notebook]     Registry inspection of %s: status=%s reason=%q", image, analysis.Status, analysis.Reason)
+	}
+
+	i.results[image] = analysis
+
+	return analysis, analysis.Status != ImageStatusCustom
+}
+
+// classifyImageConfig classifies an image from its config: Jupyter images are compatible, images
+// built by OpenShift are classified by their build reference like OOTB RStudio images, and
+// code-server and RStudio images by their version tag like OOTB images. Otherwise the image
+// stays CUSTOM.
+func classifyImageConfig(tag string, config *registry.ImageConfig) imageAnalysis {
+	nbType := notebookTypeFromLabels(config.Labels)
+
+	if nbType == NotebookTypeJupyter {
+		return imageAnalysis{
+			Status: ImageStatusGood,
+			Reason: "Jupyter-based image (registry metadata, nginx compatible)",
+		}
+	}
+
+	if buildRef, found := config.EnvValue(envBuildReference); found && buildRef != "" {
+		if isCompliantBuildRef(buildRef) {
+			return imageAnalysis{
+				Status: ImageStatusGood,
+				Reason: fmt.Sprintf("Image built from %s (registry metadata, >= rhoai-%s, has nginx fix)",
+					buildRef, nginxFixMinRHOAIVersion),
+			}
+		}
+
+		return imageAnalysis{
+			Status: ImageStatusProblematic,
+			Reason: fmt.Sprintf("Image built from %s (registry metadata, < rhoai-%s, lacks nginx fix)",
+				buildRef, nginxFixMinRHOAIVersion),
+		}
+	}
+
+	if nbType != NotebookTypeUnknown && isValidVersionTag(tag) {
+		if isTagGTE(tag, nginxFixMinTag) {
+			return imageAnalysis{
+				Status: ImageStatusGood,
+				Reason: fmt.Sprintf("%s image with tag %s (registry metadata, >= %s, has nginx fix)", nbType, tag, nginxFixMinTag),
+			}
+		}
+
+		return imageAnalysis{
+			Status: ImageStatusProblematic,
+			Reason: fmt.Sprintf("%s image with tag %s (registry metadata, < %s, lacks nginx fix)", nbType, tag, nginxFixMinTag),
+		}
+	}
+
+	return imageAnalysis{
+		Status: ImageStatusCustom,
+		Reason: "Registry metadata does not identify a notebook image",
+	}
+}
+
+// notebookTypeFromLabels determines the notebook type from the notebook software labels of an
+// image, as determineNotebookType does from ImageStream annotations.
+func notebookTypeFromLabels(labels map[string]string) NotebookType {
+	switch {
+	case labelHasName(labels, labelNotebookPythonDependencies, "jupyterlab"):
+		return NotebookTypeJupyter
+	case labelHasName(labels, labelNotebookSoftware, "code-server"),
+		labelHasName(labels, labelNotebookPythonDependencies, "code-server"):
+		return NotebookTypeCodeServer
+	case labelHasName(labels, labelNotebookSoftware, "R"):
+		return NotebookTypeRStudio
+	default:
+		return NotebookTypeUnknown
+	}
+}
+
+// labelHasName returns whether the JSON array in label key, like [{"name":"jupyterlab"}], has
+// an element with the given name (case-insensitive).
+func labelHasName(labels map[string]string, key string, name string) bool {
+	var entries []struct {
+		Name string `json:"name"`
+	}
+
+	if err := json.Unmarshal([]byte(labels[key]), &entries); err != nil {
+		return false
+	}
+
+	for _, entry := range entries {
+		if strings.EqualFold(entry.Name, name) {
+			return true
+		}
+	}
+
+	return false
+}
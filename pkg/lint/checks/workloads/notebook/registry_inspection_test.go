@@ -0,0 +1,94 @@
+package notebook_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/notebook"
+	"github.com/opendatahub-io/odh-cli/pkg/util/registry"
+
+	. "github.com/onsi/gomega"
+)
+
+// newImageConfigRegistry serves, for each repository, a manifest whose image config has the
+// given labels and environment (as JSON).
+func newImageConfigRegistry(t *testing.T, configs map[string]string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/v2/")
+
+		for repository, config := range configs {
+			switch {
+			case strings.HasPrefix(path, repository+"/manifests/"):
+				_, _ = fmt.Fprintf(w, `{"config":{"digest":"sha256:%s"}}`, strings.ReplaceAll(repository, "/", "-"))
+
+				return
+			case strings.HasPrefix(path, repository+"/blobs/"):
+				_, _ = fmt.Fprintf(w, `{"config":%s}`, config)
+
+				return
+			}
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+	}))
+
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestImpactedWorkloadsCheck_InspectRegistry(t *testing.T) {
+	server := newImageConfigRegistry(t, map[string]string{
+		"myorg/jupyter":  `{"Labels":{"opendatahub.io/notebook-python-dependencies":"[{\"name\":\"JupyterLab\",\"version\":\"4.2\"}]"}}`,
+		"myorg/old":      `{"Env":["PATH=/usr/bin","OPENSHIFT_BUILD_REFERENCE=rhoai-2.22"]}`,
+		"myorg/new":      `{"Env":["OPENSHIFT_BUILD_REFERENCE=rhoai-2.25"]}`,
+		"myorg/codeserv": `{"Labels":{"opendatahub.io/notebook-software":"[{\"name\":\"code-server\"}]"}}`,
+		"myorg/plain":    `{"Labels":{"maintainer":"me"}}`,
+	})
+	host := strings.TrimPrefix(server.URL, "https://")
+
+	tests := []struct {
+		name           string
+		image          string
+		expectedImpact resultpkg.Impact
+	}{
+		{name: "Jupyter_Good", image: host + "/myorg/jupyter:v1", expectedImpact: resultpkg.ImpactNone},
+		{name: "OldBuildReference_Problematic", image: host + "/myorg/old:v1", expectedImpact: resultpkg.ImpactBlocking},
+		{name: "NewBuildReference_Good", image: host + "/myorg/new:v1", expectedImpact: resultpkg.ImpactNone},
+		{name: "CodeServerOldTag_Problematic", image: host + "/myorg/codeserv:2025.1", expectedImpact: resultpkg.ImpactBlocking},
+		{name: "NoNotebookMetadata_Custom", image: host + "/myorg/plain:v1", expectedImpact: resultpkg.ImpactAdvisory},
+		{name: "NotInRegistry_Custom", image: host + "/myorg/missing:v1", expectedImpact: resultpkg.ImpactAdvisory},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			target := testutil.NewTarget(t, testutil.TargetConfig{
+				ListKinds: listKinds,
+				Objects: []*unstructured.Unstructured{
+					newNotebook("test-ns", "custom-nb", tc.image),
+					testutil.NewDSCI(applicationsNS),
+				},
+				CurrentVersion: "2.17.0",
+				TargetVersion:  "3.0.0",
+			})
+			target.Registry = registry.NewClient(registry.WithHTTPClient(server.Client()))
+
+			result, err := notebook.NewImpactedWorkloadsCheck().Validate(t.Context(), target)
+
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(result.Status.Conditions).To(HaveLen(1))
+			g.Expect(result.Status.Conditions[0].Impact).To(Equal(tc.expectedImpact))
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package notebook
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/constants"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/components"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+const (
+	// ConditionTypeNotebookStorageCompatible indicates whether the storage of workbenches survives
+	// the pod rescheduling of the 3.x workbench controllers.
+	ConditionTypeNotebookStorageCompatible = "NotebookStorageCompatible"
+
+	checkTypeStorage = "storage"
+
+	// maxStorageMessageDetails bounds the per-workbench details listed in the condition message.
+	maxStorageMessageDetails = 10
+)
+
+// Annotations recorded on the workbenches impacted by storage findings.
+const (
+	AnnotationStorageIssues = "check.opendatahub.io/storage-issues"
+	AnnotationStorageReason = "check.opendatahub.io/reason"
+)
+
+// StorageIssue is a storage problem of a workbench volume.
+type StorageIssue string
+
+const (
+	// StorageIssueMissingPVC means a volume references a PVC that does not exist.
+	StorageIssueMissingPVC StorageIssue = "missing-pvc"
+
+	// StorageIssueUnbound means a volume references a PVC that is not Bound.
+	StorageIssueUnbound StorageIssue = "unbound-pvc"
+
+	// StorageIssueDeprecatedStorageClass means a PVC uses a StorageClass with a deprecated
+	// in-tree provisioner, or a StorageClass that no longer exists.
+	StorageIssueDeprecatedStorageClass StorageIssue = "deprecated-storageclass"
+
+	// StorageIssueSharedReadWriteOnce means a ReadWriteOnce PVC is mounted by several workbenches.
+	StorageIssueSharedReadWriteOnce StorageIssue = "shared-rwo"
+)
+
+// Examples rendered by 'lint explain'.
+const (
+	storageFailingExample = `apiVersion: kubeflow.org/v1
+kind: Notebook
+metadata:
+  name: wb
+  namespace: team-a
+spec:
+  template:
+    spec:
+      volumes:
+        - name: data
+          persistentVolumeClaim:
+            claimName: shared-data   # ReadWriteOnce, also mounted by workbench wb-2`
+
+	storagePassingExample = `apiVersion: kubeflow.org/v1
+kind: Notebook
+metadata:
+  name: wb
+  namespace: team-a
+spec:
+  template:
+    spec:
+      volumes:
+        - name: wb-storage
+          persistentVolumeClaim:
+            claimName: wb   # Bound, CSI StorageClass, mounted by this workbench only`
+)
+
+// StorageCheck inspects the PersistentVolumeClaims mounted by workbenches. The 3.x workbench
+// controllers reschedule workbench pods during the upgrade, and pods whose claims are missing,
+// unbound, provisioned by deprecated in-tree StorageClasses or shared ReadWriteOnce across
+// workbenches fail to start on their new node.
+type StorageCheck struct {
+	check.BaseCheck
+}
+
+func NewStorageCheck() *StorageCheck {
+	return &StorageCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupWorkload,
+			Kind:             kind,
+			Type:             checkTypeStorage,
+			CheckID:          "workloads.notebook.storage",
+			CheckName:        "Workloads :: Notebook :: Storage (3.x)",
+			CheckDescription: "Detects workbenches mounting missing or unbound PersistentVolumeClaims, claims on deprecated StorageClasses, or ReadWriteOnce claims shared with other workbenches, which break when the 3.x workbench controllers reschedule their pods",
+			CheckRemediation: "Recreate or bind the missing claims, migrate data off deprecated StorageClasses, and give each workbench its own ReadWriteOnce claim (or use ReadWriteMany storage for shared data) before upgrading",
+			CheckResources: []resources.ResourceType{
+				resources.DataScienceCluster,
+				resources.Notebook,
+				resources.PersistentVolumeClaim,
+				resources.StorageClass,
+			},
+			CheckVersionGate:    check.VersionGateUpgrade2xTo3x,
+			CheckKnowledgeLinks: []string{check.DocsDataScienceProjects},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "The persistentVolumeClaim volumes of every Notebook pod template, the phase, access modes and StorageClass of the referenced PersistentVolumeClaims, and the provisioner of those StorageClasses. The check only runs when upgrading from 2.x to 3.x with Workbenches Managed.",
+				Rationale:      "The 3.x workbench controllers recreate workbench pods, which may land on another node. A pod mounting a missing or Pending claim never starts, a ReadWriteOnce claim still attached to another workbench's node fails with a Multi-Attach error, and in-tree provisioners are migrated to CSI drivers that may not be installed.",
+				FailingExample: storageFailingExample,
+				PassingExample: storagePassingExample,
+				RemediationCommands: []string{
+					"kubectl get pvc -A -o custom-columns=NAMESPACE:.metadata.namespace,NAME:.metadata.name,STATUS:.status.phase,ACCESS:.spec.accessModes,CLASS:.spec.storageClassName",
+					"kubectl get storageclasses -o custom-columns=NAME:.metadata.name,PROVISIONER:.provisioner",
+				},
+			},
+		},
+	}
+}
+
+// CanApply returns whether this check should run for the given target.
+// Only applies when upgrading from 2.x to 3.x and Workbenches is Managed.
+func (c *StorageCheck) CanApply(ctx context.Context, target check.Target) (bool, error) {
+	if !version.IsUpgradeFrom2xTo3x(target.CurrentVersion, target.TargetVersion) {
+		return false, nil
+	}
+
+	dsc, err := client.GetDataScienceCluster(ctx, target.Client)
+	if err != nil {
+		return false, fmt.Errorf("getting DataScienceCluster: %w", err)
+	}
+
+	return components.HasManagementState(dsc, "workbenches", constants.ManagementStateManaged), nil
+}
+
+// Validate executes the check against the provided target.
+func (c *StorageCheck) Validate(
+	ctx context.Context,
+	target check.Target,
+) (*result.DiagnosticResult, error) {
+	dr := c.NewResult()
+
+	if target.TargetVersion != nil {
+		dr.Annotations[check.AnnotationCheckTargetVersion] = target.TargetVersion.String()
+	}
+
+	findings, total, err := FindNotebookStorageIssues(ctx, target.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	dr.Annotations[check.AnnotationImpactedWorkloadCount] = strconv.Itoa(len(findings))
+	dr.SetCondition(c.newCondition(total, findings))
+
+	if len(findings) > 0 {
+		impacted := make([]metav1.PartialObjectMetadata, 0, len(findings))
+
+		for _, f := range findings {
+			impacted = append(impacted, metav1.PartialObjectMetadata{
+				TypeMeta: resources.Notebook.TypeMeta(),
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: f.Namespace,
+					Name:      f.Name,
+					Annotations: map[string]string{
+						AnnotationStorageIssues: f.issueList(),
+						AnnotationStorageReason: f.reason(),
+					},
+				},
+			})
+		}
+
+		dr.ImpactedObjects = impacted
+	}
+
+	return dr, nil
+}
+
+func (c *StorageCheck) newCondition(total int, findings []NotebookStorageFinding) result.Condition {
+	if len(findings) == 0 {
+		return check.NewCondition(
+			ConditionTypeNotebookStorageCompatible,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonRequirementsMet),
+			check.WithMessage("Storage of all %d workbench(es) is bound and not shared", total),
+		)
+	}
+
+	var details strings.Builder
+
+	for i, f := range findings {
+		if i == maxStorageMessageDetails {
+			_, _ = fmt.Fprintf(&details, "\n  ... and %d more", len(findings)-maxStorageMessageDetails)
+
+			break
+		}
+
+		_, _ = fmt.Fprintf(&details, "\n  - %s/%s: %s", f.Namespace, f.Name, f.reason())
+	}
+
+	return check.NewCondition(
+		ConditionTypeNotebookStorageCompatible,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonWorkloadsImpacted),
+		check.WithMessage("Found %d of %d workbench(es) with storage that may break when their pods are rescheduled:%s", len(findings), total, details.String()),
+		check.WithImpact(result.ImpactAdvisory),
+		check.WithRemediation(c.CheckRemediation),
+	)
+}
@@ -0,0 +1,168 @@
+package notebook
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+)
+
+// deprecatedProvisioners are the in-tree volume plugins migrated to CSI drivers.
+//
+//nolint:gochecknoglobals // Read-only provisioner list
+var deprecatedProvisioners = []string{
+	"kubernetes.io/aws-ebs",
+	"kubernetes.io/azure-disk",
+	"kubernetes.io/azure-file",
+	"kubernetes.io/cinder",
+	"kubernetes.io/gce-pd",
+	"kubernetes.io/vsphere-volume",
+}
+
+// NotebookStorageFinding lists the storage issues of a single workbench.
+type NotebookStorageFinding struct {
+	Namespace string
+	Name      string
+	Issues    []StorageIssue
+	Details   []string
+}
+
+func (f *NotebookStorageFinding) add(issue StorageIssue, format string, args ...any) {
+	if !slices.Contains(f.Issues, issue) {
+		f.Issues = append(f.Issues, issue)
+	}
+
+	f.Details = append(f.Details, fmt.Sprintf(format, args...))
+}
+
+func (f *NotebookStorageFinding) issueList() string {
+	issues := make([]string, 0, len(f.Issues))
+	for _, issue := range f.Issues {
+		issues = append(issues, string(issue))
+	}
+
+	return strings.Join(issues, ",")
+}
+
+func (f *NotebookStorageFinding) reason() string {
+	return strings.Join(f.Details, "; ")
+}
+
+// FindNotebookStorageIssues returns the workbenches whose PersistentVolumeClaim volumes have
+// storage issues, and the number of workbenches inspected.
+func FindNotebookStorageIssues(ctx context.Context, r client.Reader) ([]NotebookStorageFinding, int, error) {
+	notebooks, err := r.List(ctx, resources.Notebook)
+	if err != nil {
+		if client.IsResourceTypeNotFound(err) {
+			return nil, 0, nil
+		}
+
+		return nil, 0, fmt.Errorf("listing Notebooks: %w", err)
+	}
+
+	if len(notebooks) == 0 {
+		return nil, 0, nil
+	}
+
+	claims, err := client.ListByNamespacedName(ctx, r, resources.PersistentVolumeClaim)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	classes, err := client.ListByNamespacedName(ctx, r, resources.StorageClass)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	mounts := make(map[types.NamespacedName][]string, len(notebooks))
+	volumes := make(map[*unstructured.Unstructured][]string, len(notebooks))
+
+	for _, nb := range notebooks {
+		names, err := jq.Query[[]string](nb, "[.spec.template.spec.volumes[]?.persistentVolumeClaim.claimName // empty]")
+		if err != nil {
+			return nil, 0, fmt.Errorf("reading volumes of Notebook %s/%s: %w", nb.GetNamespace(), nb.GetName(), err)
+		}
+
+		volumes[nb] = names
+
+		for _, name := range names {
+			key := types.NamespacedName{Namespace: nb.GetNamespace(), Name: name}
+			mounts[key] = append(mounts[key], nb.GetName())
+		}
+	}
+
+	var findings []NotebookStorageFinding
+
+	for _, nb := range notebooks {
+		finding := NotebookStorageFinding{Namespace: nb.GetNamespace(), Name: nb.GetName()}
+
+		for _, name := range volumes[nb] {
+			key := types.NamespacedName{Namespace: nb.GetNamespace(), Name: name}
+			inspectClaim(&finding, claims[key], name, classes, mounts[key])
+		}
+
+		if len(finding.Issues) > 0 {
+			findings = append(findings, finding)
+		}
+	}
+
+	return findings, len(notebooks), nil
+}
+
+// inspectClaim records the issues of the claim mounted by a workbench. pvc is nil when the claim
+// does not exist; mountedBy lists the workbenches of the namespace mounting the claim.
+func inspectClaim(
+	f *NotebookStorageFinding,
+	pvc *unstructured.Unstructured,
+	name string,
+	classes map[types.NamespacedName]*unstructured.Unstructured,
+	mountedBy []string,
+) {
+	if pvc == nil {
+		f.add(StorageIssueMissingPVC, "PVC %s not found", name)
+
+		return
+	}
+
+	if phase, _ := jq.Query[string](pvc, ".status.phase"); phase != "Bound" {
+		if phase == "" {
+			phase = "unknown"
+		}
+
+		f.add(StorageIssueUnbound, "PVC %s is not Bound (phase %s)", name, phase)
+	}
+
+	if className, _ := jq.Query[string](pvc, ".spec.storageClassName"); className != "" {
+		class, ok := classes[types.NamespacedName{Name: className}]
+
+		switch {
+		case !ok:
+			f.add(StorageIssueDeprecatedStorageClass, "PVC %s uses StorageClass %s, which no longer exists", name, className)
+		default:
+			if provisioner, _ := jq.Query[string](class, ".provisioner"); slices.Contains(deprecatedProvisioners, provisioner) {
+				f.add(StorageIssueDeprecatedStorageClass, "PVC %s uses StorageClass %s with deprecated in-tree provisioner %s", name, className, provisioner)
+			}
+		}
+	}
+
+	if len(mountedBy) < 2 {
+		return
+	}
+
+	modes, _ := jq.Query[[]string](pvc, "[.spec.accessModes[]?]")
+	if slices.Contains(modes, "ReadWriteMany") || slices.Contains(modes, "ReadOnlyMany") {
+		return
+	}
+
+	others := slices.DeleteFunc(slices.Clone(mountedBy), func(n string) bool { return n == f.Name })
+	slices.Sort(others)
+
+	f.add(StorageIssueSharedReadWriteOnce, "ReadWriteOnce PVC %s is also mounted by workbench(es) %s", name, strings.Join(others, ", "))
+}
@@ -0,0 +1,264 @@
+package notebook_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/notebook"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+//nolint:gochecknoglobals
+var storageListKinds = map[schema.GroupVersionResource]string{
+	resources.Notebook.GVR():              resources.Notebook.ListKind(),
+	resources.PersistentVolumeClaim.GVR(): resources.PersistentVolumeClaim.ListKind(),
+	resources.StorageClass.GVR():          resources.StorageClass.ListKind(),
+	resources.DataScienceCluster.GVR():    resources.DataScienceCluster.ListKind(),
+}
+
+func newStorageNotebook(name string, claims ...string) *unstructured.Unstructured {
+	volumes := make([]any, 0, len(claims)+1)
+	volumes = append(volumes, map[string]any{"name": "shm", "emptyDir": map[string]any{"medium": "Memory"}})
+
+	for _, claim := range claims {
+		volumes = append(volumes, map[string]any{
+			"name":                  claim,
+			"persistentVolumeClaim": map[string]any{"claimName": claim},
+		})
+	}
+
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.Notebook.APIVersion(),
+			"kind":       resources.Notebook.Kind,
+			"metadata": map[string]any{
+				"name":      name,
+				"namespace": "team-a",
+			},
+			"spec": map[string]any{
+				"template": map[string]any{
+					"spec": map[string]any{"volumes": volumes},
+				},
+			},
+		},
+	}
+}
+
+func newClaim(name string, phase string, storageClass string, accessMode string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.PersistentVolumeClaim.APIVersion(),
+			"kind":       resources.PersistentVolumeClaim.Kind,
+			"metadata": map[string]any{
+				"name":      name,
+				"namespace": "team-a",
+			},
+			"spec": map[string]any{
+				"storageClassName": storageClass,
+				"accessModes":      []any{accessMode},
+			},
+			"status": map[string]any{"phase": phase},
+		},
+	}
+}
+
+func newStorageClassObject(name string, provisioner string) *unstructured.Unstructured {
+	obj := resources.StorageClass.Unstructured()
+	obj.SetName(name)
+	obj.Object["provisioner"] = provisioner
+
+	return &obj
+}
+
+func newStorageTarget(t *testing.T, objects ...*unstructured.Unstructured) check.Target {
+	t.Helper()
+
+	return testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: storageListKinds,
+		Objects: append(objects,
+			newStorageClassObject("gp3-csi", "ebs.csi.aws.com"),
+			newStorageClassObject("gp2", "kubernetes.io/aws-ebs"),
+		),
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+	})
+}
+
+func TestStorageCheck_NoIssues(t *testing.T) {
+	g := NewWithT(t)
+
+	target := newStorageTarget(t,
+		newStorageNotebook("wb", "wb"),
+		newClaim("wb", "Bound", "gp3-csi", "ReadWriteOnce"),
+		newStorageNotebook("wb-2", "wb-2", "shared"),
+		newStorageNotebook("wb-3", "shared"),
+		newClaim("wb-2", "Bound", "gp3-csi", "ReadWriteOnce"),
+		newClaim("shared", "Bound", "gp3-csi", "ReadWriteMany"),
+	)
+
+	result, err := notebook.NewStorageCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Status.Conditions).To(HaveLen(1))
+	g.Expect(result.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":    Equal(notebook.ConditionTypeNotebookStorageCompatible),
+		"Status":  Equal(metav1.ConditionTrue),
+		"Reason":  Equal(check.ReasonRequirementsMet),
+		"Message": ContainSubstring("all 3 workbench(es)"),
+	}))
+	g.Expect(result.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "0"))
+	g.Expect(result.ImpactedObjects).To(BeEmpty())
+}
+
+func TestStorageCheck_Issues(t *testing.T) {
+	tests := []struct {
+		name           string
+		objects        []*unstructured.Unstructured
+		expectedIssues string
+		expectedReason string
+	}{
+		{
+			name:           "MissingPVC",
+			objects:        []*unstructured.Unstructured{newStorageNotebook("wb", "wb")},
+			expectedIssues: string(notebook.StorageIssueMissingPVC),
+			expectedReason: "PVC wb not found",
+		},
+		{
+			name: "UnboundPVC",
+			objects: []*unstructured.Unstructured{
+				newStorageNotebook("wb", "wb"),
+				newClaim("wb", "Pending", "gp3-csi", "ReadWriteOnce"),
+			},
+			expectedIssues: string(notebook.StorageIssueUnbound),
+			expectedReason: "PVC wb is not Bound (phase Pending)",
+		},
+		{
+			name: "InTreeProvisioner",
+			objects: []*unstructured.Unstructured{
+				newStorageNotebook("wb", "wb"),
+				newClaim("wb", "Bound", "gp2", "ReadWriteOnce"),
+			},
+			expectedIssues: string(notebook.StorageIssueDeprecatedStorageClass),
+			expectedReason: "PVC wb uses StorageClass gp2 with deprecated in-tree provisioner kubernetes.io/aws-ebs",
+		},
+		{
+			name: "StorageClassRemoved",
+			objects: []*unstructured.Unstructured{
+				newStorageNotebook("wb", "wb"),
+				newClaim("wb", "Bound", "standard", "ReadWriteOnce"),
+			},
+			expectedIssues: string(notebook.StorageIssueDeprecatedStorageClass),
+			expectedReason: "PVC wb uses StorageClass standard, which no longer exists",
+		},
+		{
+			name: "MultipleIssues",
+			objects: []*unstructured.Unstructured{
+				newStorageNotebook("wb", "wb", "data"),
+				newClaim("wb", "Lost", "gp2", "ReadWriteOnce"),
+			},
+			expectedIssues: "unbound-pvc,deprecated-storageclass,missing-pvc",
+			expectedReason: "PVC wb is not Bound (phase Lost); PVC wb uses StorageClass gp2 with deprecated in-tree provisioner kubernetes.io/aws-ebs; PVC data not found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			result, err := notebook.NewStorageCheck().Validate(t.Context(), newStorageTarget(t, tc.objects...))
+
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(result.Status.Conditions).To(HaveLen(1))
+			g.Expect(result.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+				"Status":  Equal(metav1.ConditionFalse),
+				"Reason":  Equal(check.ReasonWorkloadsImpacted),
+				"Message": ContainSubstring("team-a/wb: " + tc.expectedReason),
+			}))
+			g.Expect(result.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactAdvisory))
+			g.Expect(result.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "1"))
+			g.Expect(result.ImpactedObjects).To(HaveLen(1))
+			g.Expect(result.ImpactedObjects[0].Kind).To(Equal(resources.Notebook.Kind))
+			g.Expect(result.ImpactedObjects[0].Annotations).To(And(
+				HaveKeyWithValue(notebook.AnnotationStorageIssues, tc.expectedIssues),
+				HaveKeyWithValue(notebook.AnnotationStorageReason, tc.expectedReason),
+			))
+		})
+	}
+}
+
+func TestStorageCheck_SharedReadWriteOnce(t *testing.T) {
+	g := NewWithT(t)
+
+	target := newStorageTarget(t,
+		newStorageNotebook("wb", "shared"),
+		newStorageNotebook("wb-2", "shared"),
+		newStorageNotebook("wb-3", "wb-3"),
+		newClaim("shared", "Bound", "gp3-csi", "ReadWriteOnce"),
+		newClaim("wb-3", "Bound", "gp3-csi", "ReadWriteOnce"),
+	)
+
+	result, err := notebook.NewStorageCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Status.Conditions[0].Message).To(HavePrefix("Found 2 of 3 workbench(es)"))
+	g.Expect(result.ImpactedObjects).To(HaveLen(2))
+	g.Expect(result.ImpactedObjects).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+		"ObjectMeta": MatchFields(IgnoreExtras, Fields{
+			"Name": Equal("wb"),
+			"Annotations": And(
+				HaveKeyWithValue(notebook.AnnotationStorageIssues, string(notebook.StorageIssueSharedReadWriteOnce)),
+				HaveKeyWithValue(notebook.AnnotationStorageReason, "ReadWriteOnce PVC shared is also mounted by workbench(es) wb-2"),
+			),
+		}),
+	})))
+}
+
+func TestStorageCheck_Metadata(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := notebook.NewStorageCheck()
+
+	g.Expect(chk.ID()).To(Equal("workloads.notebook.storage"))
+	g.Expect(chk.Name()).To(Equal("Workloads :: Notebook :: Storage (3.x)"))
+	g.Expect(chk.Group()).To(Equal(check.GroupWorkload))
+	g.Expect(chk.Description()).ToNot(BeEmpty())
+}
+
+func TestStorageCheck_CanApply(t *testing.T) {
+	tests := []struct {
+		name           string
+		targetVersion  string
+		workbenches    string
+		expectedResult bool
+	}{
+		{name: "Upgrade2xTo3x_Managed", targetVersion: "3.0.0", workbenches: "Managed", expectedResult: true},
+		{name: "Upgrade2xTo3x_Removed", targetVersion: "3.0.0", workbenches: "Removed", expectedResult: false},
+		{name: "Within2x", targetVersion: "2.25.1", workbenches: "Managed", expectedResult: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			target := testutil.NewTarget(t, testutil.TargetConfig{
+				ListKinds:      storageListKinds,
+				Objects:        []*unstructured.Unstructured{testutil.NewDSC(map[string]string{"workbenches": tc.workbenches})},
+				CurrentVersion: "2.25.0",
+				TargetVersion:  tc.targetVersion,
+			})
+
+			canApply, err := notebook.NewStorageCheck().CanApply(t.Context(), target)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(canApply).To(Equal(tc.expectedResult))
+		})
+	}
+}
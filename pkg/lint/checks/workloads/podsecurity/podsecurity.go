@@ -0,0 +1,246 @@
+package podsecurity
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+const (
+	kind      = "podsecurity"
+	checkType = "admission"
+
+	// ConditionTypePodSecurityCompliant indicates whether workload pod specs satisfy the Pod Security level of their namespace.
+	ConditionTypePodSecurityCompliant = "PodSecurityCompliant"
+
+	// AnnotationLevel is the Pod Security level an impacted workload was evaluated against.
+	AnnotationLevel = "podsecurity.opendatahub.io/level"
+
+	// AnnotationViolations lists the pod spec fields of an impacted workload breaking that level.
+	AnnotationViolations = "podsecurity.opendatahub.io/violations"
+)
+
+// Examples rendered by 'lint explain'.
+const (
+	admissionFailingExample = `# Namespace team-a has no pod-security.kubernetes.io/enforce label (3.x default: restricted)
+apiVersion: kubeflow.org/v1
+kind: Notebook
+metadata:
+  name: wb
+  namespace: team-a
+spec:
+  template:
+    spec:
+      containers:
+        - name: wb
+          securityContext:
+            privileged: true`
+
+	admissionPassingExample = `apiVersion: kubeflow.org/v1
+kind: Notebook
+metadata:
+  name: wb
+  namespace: team-a
+spec:
+  template:
+    spec:
+      containers:
+        - name: wb
+          securityContext:
+            allowPrivilegeEscalation: false
+            runAsNonRoot: true
+            capabilities:
+              drop: ["ALL"]
+            seccompProfile:
+              type: RuntimeDefault`
+)
+
+// AdmissionCheck evaluates the pod specs of ODH workloads (Notebooks, InferenceServices and
+// RayClusters) against the Pod Security Admission level enforced on their namespace. Namespaces
+// without an enforce label are evaluated against the restricted profile, the 3.x default for
+// workload namespaces. Pods are only admitted on creation, so running workloads are unaffected
+// until they restart.
+type AdmissionCheck struct {
+	check.BaseCheck
+}
+
+func NewAdmissionCheck() *AdmissionCheck {
+	return &AdmissionCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupWorkload,
+			Kind:             kind,
+			Type:             checkType,
+			CheckID:          "workloads.podsecurity.admission",
+			CheckName:        "Workloads :: Pod Security :: Admission Compliance (3.x)",
+			CheckDescription: "Evaluates workload pod specs against the Pod Security Admission level of their namespace, and the restricted profile 3.x applies to unlabelled workload namespaces, flagging workloads whose pods will be rejected on restart",
+			CheckRemediation: "Remove the listed securityContext, host namespace, host port and volume settings from the workload pod specs, or label the namespace with a less restrictive pod-security.kubernetes.io/enforce level if the workload requires them",
+			CheckResources: []resources.ResourceType{
+				resources.Namespace,
+				resources.Notebook,
+				resources.InferenceService,
+				resources.RayCluster,
+			},
+			CheckVersionGate:    check.VersionGateUpgrade2xTo3x,
+			CheckKnowledgeLinks: []string{check.DocsUpgrading},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "The pod-security.kubernetes.io/enforce label of every Namespace, and the pod specs of Notebooks, InferenceServices and RayClusters evaluated against that level: securityContext, host namespaces, host ports and volume types. Unlabelled namespaces are evaluated against restricted. The check only runs when upgrading from 2.x to 3.x.",
+				Rationale:      "Pod Security Admission rejects pods that violate the level of their namespace when they are created, so an affected workload keeps running until its next restart and then fails to start. Violations of an explicit enforce label are blocking; violations of the 3.x restricted default for unlabelled namespaces are advisory.",
+				FailingExample: admissionFailingExample,
+				PassingExample: admissionPassingExample,
+				RemediationCommands: []string{
+					"kubectl get namespaces -L pod-security.kubernetes.io/enforce",
+					"kubectl label namespace team-a --overwrite pod-security.kubernetes.io/enforce=baseline",
+				},
+			},
+		},
+	}
+}
+
+// CanApply returns whether this check should run for the given target.
+// Only applies when upgrading from 2.x to 3.x.
+func (c *AdmissionCheck) CanApply(_ context.Context, target check.Target) (bool, error) {
+	return version.IsUpgradeFrom2xTo3x(target.CurrentVersion, target.TargetVersion), nil
+}
+
+// namespaceLevel is the Pod Security level a namespace enforces.
+type namespaceLevel struct {
+	level string
+	// labelled is false when the level is the 3.x default rather than a namespace label.
+	labelled bool
+}
+
+// Validate executes the check against the provided target.
+func (c *AdmissionCheck) Validate(
+	ctx context.Context,
+	target check.Target,
+) (*result.DiagnosticResult, error) {
+	dr := c.NewResult()
+
+	if target.TargetVersion != nil {
+		dr.Annotations[check.AnnotationCheckTargetVersion] = target.TargetVersion.String()
+	}
+
+	levels, err := namespaceLevels(ctx, target.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	enforced := 0
+
+	for _, rt := range []resources.ResourceType{resources.Notebook, resources.InferenceService, resources.RayCluster} {
+		workloads, err := target.Client.List(ctx, rt)
+		if err != nil {
+			if client.IsResourceTypeNotFound(err) {
+				continue
+			}
+
+			return nil, fmt.Errorf("listing %s: %w", rt.Kind, err)
+		}
+
+		for _, w := range workloads {
+			nsLevel, ok := levels[w.GetNamespace()]
+			if !ok {
+				nsLevel = namespaceLevel{level: LevelRestricted}
+			}
+
+			if nsLevel.level == LevelPrivileged {
+				continue
+			}
+
+			specs, err := podSpecs(rt, w)
+			if err != nil {
+				return nil, fmt.Errorf("reading pod specs of %s %s/%s: %w", rt.Kind, w.GetNamespace(), w.GetName(), err)
+			}
+
+			var described []string
+
+			for _, ps := range specs {
+				for _, v := range evaluate(ps, nsLevel.level) {
+					described = append(described, v.String())
+				}
+			}
+
+			if len(described) == 0 {
+				continue
+			}
+
+			if nsLevel.labelled {
+				enforced++
+			}
+
+			dr.ImpactedObjects = append(dr.ImpactedObjects, metav1.PartialObjectMetadata{
+				TypeMeta: rt.TypeMeta(),
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: w.GetNamespace(),
+					Name:      w.GetName(),
+					Annotations: map[string]string{
+						AnnotationLevel:      nsLevel.level,
+						AnnotationViolations: strings.Join(described, "; "),
+					},
+				},
+			})
+		}
+	}
+
+	dr.Annotations[check.AnnotationImpactedWorkloadCount] = strconv.Itoa(len(dr.ImpactedObjects))
+	dr.SetCondition(c.newCondition(len(dr.ImpactedObjects), enforced))
+
+	return dr, nil
+}
+
+// namespaceLevels maps each namespace with a valid enforce label to its level.
+func namespaceLevels(ctx context.Context, r client.Reader) (map[string]namespaceLevel, error) {
+	namespaces, err := r.List(ctx, resources.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("listing Namespaces: %w", err)
+	}
+
+	levels := make(map[string]namespaceLevel, len(namespaces))
+
+	for _, ns := range namespaces {
+		level := ns.GetLabels()[labelEnforce]
+		if _, known := levelRank[level]; !known {
+			continue
+		}
+
+		levels[ns.GetName()] = namespaceLevel{level: level, labelled: true}
+	}
+
+	return levels, nil
+}
+
+func (c *AdmissionCheck) newCondition(impacted int, enforced int) result.Condition {
+	if impacted == 0 {
+		return check.NewCondition(
+			ConditionTypePodSecurityCompliant,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonRequirementsMet),
+			check.WithMessage("All workload pod specs satisfy the Pod Security level of their namespace"),
+		)
+	}
+
+	// Workloads breaking an explicitly enforced level are rejected on their next restart
+	// regardless of the upgrade; the 3.x default only affects unlabelled namespaces.
+	impact := result.ImpactAdvisory
+	if enforced > 0 {
+		impact = result.ImpactBlocking
+	}
+
+	return check.NewCondition(
+		ConditionTypePodSecurityCompliant,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonWorkloadsImpacted),
+		check.WithMessage("Found %d workload(s) whose pods will be rejected by Pod Security Admission on restart (%d in namespaces with an enforce label, %d under the 3.x restricted default)", impacted, enforced, impacted-enforced),
+		check.WithImpact(impact),
+		check.WithRemediation(c.CheckRemediation),
+	)
+}
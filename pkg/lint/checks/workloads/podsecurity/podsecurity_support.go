@@ -0,0 +1,286 @@
+package podsecurity
+
+import (
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+)
+
+// Pod Security Standards levels, from least to most restrictive.
+const (
+	LevelPrivileged = "privileged"
+	LevelBaseline   = "baseline"
+	LevelRestricted = "restricted"
+)
+
+// labelEnforce is the namespace label selecting the Pod Security Admission level enforced on pod creation.
+const labelEnforce = "pod-security.kubernetes.io/enforce"
+
+//nolint:gochecknoglobals // Level ordering used for comparisons
+var levelRank = map[string]int{
+	LevelPrivileged: 0,
+	LevelBaseline:   1,
+	LevelRestricted: 2,
+}
+
+// baselineCapabilities are the capabilities the baseline profile allows containers to add.
+//
+//nolint:gochecknoglobals // Fixed by the Pod Security Standards
+var baselineCapabilities = []corev1.Capability{
+	"AUDIT_WRITE", "CHOWN", "DAC_OVERRIDE", "FOWNER", "FSETID", "KILL", "MKNOD",
+	"NET_BIND_SERVICE", "SETFCAP", "SETGID", "SETPCAP", "SETUID", "SYS_CHROOT",
+}
+
+// violation is a pod spec field breaking a Pod Security Standards level.
+type violation struct {
+	// level is the least restrictive level the field breaks; it breaks every stricter level too.
+	level   string
+	path    string
+	message string
+}
+
+func (v violation) String() string {
+	return v.path + " " + v.message
+}
+
+// container is a container of a pod spec with its field path.
+type container struct {
+	path string
+	spec corev1.Container
+}
+
+// podSpec is one pod spec of a workload with its field path and all of its containers.
+type podSpec struct {
+	path       string
+	spec       corev1.PodSpec
+	containers []container
+}
+
+// podSpecs returns the pod specs of a Notebook, InferenceService or RayCluster.
+func podSpecs(rt resources.ResourceType, obj *unstructured.Unstructured) ([]podSpec, error) {
+	switch rt.Kind {
+	case resources.Notebook.Kind:
+		return parsePodSpecs(obj.Object, "spec.template.spec", "spec", "template", "spec")
+
+	case resources.InferenceService.Kind:
+		// The predictor inlines pod spec fields; the model container is declared next to them.
+		specs, err := parsePodSpecs(obj.Object, "spec.predictor", "spec", "predictor")
+		if err != nil || len(specs) == 0 {
+			return specs, err
+		}
+
+		if raw, ok, _ := unstructured.NestedMap(obj.Object, "spec", "predictor", "model"); ok {
+			var model corev1.Container
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw, &model); err != nil {
+				return nil, fmt.Errorf("parsing model container: %w", err)
+			}
+
+			specs[0].containers = append(specs[0].containers, container{path: "spec.predictor.model", spec: model})
+		}
+
+		return specs, nil
+
+	case resources.RayCluster.Kind:
+		specs, err := parsePodSpecs(obj.Object, "spec.headGroupSpec.template.spec", "spec", "headGroupSpec", "template", "spec")
+		if err != nil {
+			return nil, err
+		}
+
+		groups, _, _ := unstructured.NestedSlice(obj.Object, "spec", "workerGroupSpecs")
+		for i, group := range groups {
+			groupMap, ok := group.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			worker, err := parsePodSpecs(groupMap, fmt.Sprintf("spec.workerGroupSpecs[%d].template.spec", i), "template", "spec")
+			if err != nil {
+				return nil, err
+			}
+
+			specs = append(specs, worker...)
+		}
+
+		return specs, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported workload kind %s", rt.Kind)
+	}
+}
+
+// parsePodSpecs reads the pod spec at the given fields, returning nothing if it is absent.
+func parsePodSpecs(obj map[string]any, path string, fields ...string) ([]podSpec, error) {
+	raw, ok, _ := unstructured.NestedMap(obj, fields...)
+	if !ok {
+		return nil, nil
+	}
+
+	ps := podSpec{path: path}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw, &ps.spec); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+
+	for i, c := range ps.spec.InitContainers {
+		ps.containers = append(ps.containers, container{path: fmt.Sprintf("%s.initContainers[%d]", path, i), spec: c})
+	}
+
+	for i, c := range ps.spec.Containers {
+		ps.containers = append(ps.containers, container{path: fmt.Sprintf("%s.containers[%d]", path, i), spec: c})
+	}
+
+	return []podSpec{ps}, nil
+}
+
+// evaluate returns the fields of a pod spec that break the given level.
+//
+// Only explicitly set fields are evaluated: on OpenShift the restricted-v2 SecurityContextConstraint
+// defaults unset fields (seccomp profile, non-root user, dropped capabilities, privilege escalation)
+// to compliant values before Pod Security Admission runs, so only explicit overrides get pods rejected.
+func evaluate(ps podSpec, level string) []violation {
+	var all []violation
+
+	all = append(all, podViolations(ps)...)
+
+	for _, c := range ps.containers {
+		all = append(all, containerViolations(c)...)
+	}
+
+	var breaking []violation
+
+	for _, v := range all {
+		if levelRank[v.level] <= levelRank[level] {
+			breaking = append(breaking, v)
+		}
+	}
+
+	return breaking
+}
+
+func podViolations(ps podSpec) []violation {
+	var vs []violation
+
+	add := func(level string, field string, message string) {
+		vs = append(vs, violation{level: level, path: ps.path + "." + field, message: message})
+	}
+
+	if ps.spec.HostNetwork {
+		add(LevelBaseline, "hostNetwork", "must not be true")
+	}
+
+	if ps.spec.HostPID {
+		add(LevelBaseline, "hostPID", "must not be true")
+	}
+
+	if ps.spec.HostIPC {
+		add(LevelBaseline, "hostIPC", "must not be true")
+	}
+
+	for i, vol := range ps.spec.Volumes {
+		field := fmt.Sprintf("volumes[%d]", i)
+
+		switch {
+		case vol.HostPath != nil:
+			add(LevelBaseline, field+".hostPath", "must not be used")
+		case !restrictedVolume(vol.VolumeSource):
+			add(LevelRestricted, field, "must use an allowed volume type (configMap, csi, downwardAPI, emptyDir, ephemeral, persistentVolumeClaim, projected, secret)")
+		}
+	}
+
+	if sc := ps.spec.SecurityContext; sc != nil {
+		if sc.SeccompProfile != nil {
+			vs = append(vs, seccompViolations(ps.path+".securityContext.seccompProfile.type", sc.SeccompProfile)...)
+		}
+
+		if sc.RunAsNonRoot != nil && !*sc.RunAsNonRoot {
+			add(LevelRestricted, "securityContext.runAsNonRoot", "must not be false")
+		}
+
+		if sc.RunAsUser != nil && *sc.RunAsUser == 0 {
+			add(LevelRestricted, "securityContext.runAsUser", "must not be 0")
+		}
+	}
+
+	return vs
+}
+
+func containerViolations(c container) []violation {
+	var vs []violation
+
+	add := func(level string, field string, message string) {
+		vs = append(vs, violation{level: level, path: c.path + "." + field, message: message})
+	}
+
+	for i, port := range c.spec.Ports {
+		if port.HostPort != 0 {
+			add(LevelBaseline, fmt.Sprintf("ports[%d].hostPort", i), "must not be set")
+		}
+	}
+
+	sc := c.spec.SecurityContext
+	if sc == nil {
+		return vs
+	}
+
+	if sc.Privileged != nil && *sc.Privileged {
+		add(LevelBaseline, "securityContext.privileged", "must not be true")
+	}
+
+	if sc.Capabilities != nil {
+		for _, capability := range sc.Capabilities.Add {
+			switch {
+			case !slices.Contains(baselineCapabilities, capability):
+				add(LevelBaseline, "securityContext.capabilities.add", fmt.Sprintf("must not add %s", capability))
+			case capability != "NET_BIND_SERVICE":
+				add(LevelRestricted, "securityContext.capabilities.add", fmt.Sprintf("must not add %s (only NET_BIND_SERVICE)", capability))
+			}
+		}
+	}
+
+	if sc.SeccompProfile != nil {
+		vs = append(vs, seccompViolations(c.path+".securityContext.seccompProfile.type", sc.SeccompProfile)...)
+	}
+
+	if sc.AllowPrivilegeEscalation != nil && *sc.AllowPrivilegeEscalation {
+		add(LevelRestricted, "securityContext.allowPrivilegeEscalation", "must not be true")
+	}
+
+	if sc.RunAsNonRoot != nil && !*sc.RunAsNonRoot {
+		add(LevelRestricted, "securityContext.runAsNonRoot", "must not be false")
+	}
+
+	if sc.RunAsUser != nil && *sc.RunAsUser == 0 {
+		add(LevelRestricted, "securityContext.runAsUser", "must not be 0")
+	}
+
+	return vs
+}
+
+// seccompViolations checks an explicit seccomp profile: baseline forbids Unconfined,
+// restricted only allows RuntimeDefault and Localhost.
+func seccompViolations(path string, profile *corev1.SeccompProfile) []violation {
+	switch profile.Type {
+	case corev1.SeccompProfileTypeRuntimeDefault, corev1.SeccompProfileTypeLocalhost:
+		return nil
+	case corev1.SeccompProfileTypeUnconfined:
+		return []violation{{level: LevelBaseline, path: path, message: "must not be Unconfined"}}
+	default:
+		return []violation{{level: LevelRestricted, path: path, message: "must be RuntimeDefault or Localhost"}}
+	}
+}
+
+// restrictedVolume reports whether a volume source is one of the types the restricted profile allows.
+func restrictedVolume(src corev1.VolumeSource) bool {
+	return src.ConfigMap != nil ||
+		src.CSI != nil ||
+		src.DownwardAPI != nil ||
+		src.EmptyDir != nil ||
+		src.Ephemeral != nil ||
+		src.PersistentVolumeClaim != nil ||
+		src.Projected != nil ||
+		src.Secret != nil
+}
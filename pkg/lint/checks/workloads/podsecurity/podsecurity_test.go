@@ -0,0 +1,200 @@
+package podsecurity_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/podsecurity"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+//nolint:gochecknoglobals
+var listKinds = map[schema.GroupVersionResource]string{
+	resources.Namespace.GVR():        resources.Namespace.ListKind(),
+	resources.Notebook.GVR():         resources.Notebook.ListKind(),
+	resources.InferenceService.GVR(): resources.InferenceService.ListKind(),
+	resources.RayCluster.GVR():       resources.RayCluster.ListKind(),
+}
+
+func newNamespace(name string, enforce string) *unstructured.Unstructured {
+	obj := resources.Namespace.Unstructured()
+	obj.SetName(name)
+
+	if enforce != "" {
+		obj.SetLabels(map[string]string{"pod-security.kubernetes.io/enforce": enforce})
+	}
+
+	return &obj
+}
+
+func newNotebook(namespace string, name string, podSpec map[string]any) *unstructured.Unstructured {
+	obj := resources.Notebook.Unstructured()
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	_ = unstructured.SetNestedMap(obj.Object, podSpec, "spec", "template", "spec")
+
+	return &obj
+}
+
+func containerWith(securityContext map[string]any) map[string]any {
+	return map[string]any{
+		"containers": []any{
+			map[string]any{"name": "main", "image": "quay.io/example/workbench:latest", "securityContext": securityContext},
+		},
+	}
+}
+
+func TestAdmissionCheck_Compliant(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: listKinds,
+		Objects: []*unstructured.Unstructured{
+			newNamespace("team-a", ""),
+			newNotebook("team-a", "defaults", containerWith(nil)),
+			newNotebook("team-a", "hardened", containerWith(map[string]any{
+				"allowPrivilegeEscalation": false,
+				"runAsNonRoot":             true,
+				"seccompProfile":           map[string]any{"type": "RuntimeDefault"},
+				"capabilities":             map[string]any{"drop": []any{"ALL"}, "add": []any{"NET_BIND_SERVICE"}},
+			})),
+		},
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := podsecurity.NewAdmissionCheck().Validate(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(dr.ImpactedObjects).To(BeEmpty())
+	g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":   Equal(podsecurity.ConditionTypePodSecurityCompliant),
+		"Status": Equal(metav1.ConditionTrue),
+	}))
+}
+
+func TestAdmissionCheck_RestrictedDefault(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: listKinds,
+		Objects: []*unstructured.Unstructured{
+			newNamespace("team-a", ""),
+			newNotebook("team-a", "escalating", containerWith(map[string]any{
+				"allowPrivilegeEscalation": true,
+				"seccompProfile":           map[string]any{"type": "Unconfined"},
+			})),
+		},
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := podsecurity.NewAdmissionCheck().Validate(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(dr.Status.Conditions[0]).To(MatchFields(IgnoreExtras, Fields{
+		"Condition": MatchFields(IgnoreExtras, Fields{
+			"Status":  Equal(metav1.ConditionFalse),
+			"Reason":  Equal(check.ReasonWorkloadsImpacted),
+			"Message": ContainSubstring("0 in namespaces with an enforce label, 1 under the 3.x restricted default"),
+		}),
+		"Impact": Equal(resultpkg.ImpactAdvisory),
+	}))
+
+	g.Expect(dr.ImpactedObjects).To(HaveLen(1))
+	g.Expect(dr.ImpactedObjects[0].Annotations).To(HaveKeyWithValue(podsecurity.AnnotationLevel, podsecurity.LevelRestricted))
+	g.Expect(dr.ImpactedObjects[0].Annotations).To(HaveKeyWithValue(podsecurity.AnnotationViolations,
+		"spec.template.spec.containers[0].securityContext.seccompProfile.type must not be Unconfined; "+
+			"spec.template.spec.containers[0].securityContext.allowPrivilegeEscalation must not be true"))
+}
+
+func TestAdmissionCheck_NamespaceLevels(t *testing.T) {
+	g := NewWithT(t)
+
+	hostPath := map[string]any{
+		"hostNetwork": true,
+		"containers":  []any{map[string]any{"name": "main", "image": "quay.io/example/workbench:latest"}},
+		"volumes":     []any{map[string]any{"name": "host", "hostPath": map[string]any{"path": "/var"}}},
+	}
+	escalating := containerWith(map[string]any{"allowPrivilegeEscalation": true})
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: listKinds,
+		Objects: []*unstructured.Unstructured{
+			newNamespace("privileged", "privileged"),
+			newNamespace("baseline", "baseline"),
+			newNotebook("privileged", "host", hostPath),
+			newNotebook("baseline", "escalating", escalating),
+			newNotebook("baseline", "host", hostPath),
+		},
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := podsecurity.NewAdmissionCheck().Validate(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	// Baseline does not restrict privilege escalation, and privileged namespaces are exempt.
+	g.Expect(dr.ImpactedObjects).To(HaveLen(1))
+	g.Expect(dr.ImpactedObjects[0].Name).To(Equal("host"))
+	g.Expect(dr.ImpactedObjects[0].Namespace).To(Equal("baseline"))
+	g.Expect(dr.ImpactedObjects[0].Annotations).To(HaveKeyWithValue(podsecurity.AnnotationViolations,
+		"spec.template.spec.hostNetwork must not be true; spec.template.spec.volumes[0].hostPath must not be used"))
+	g.Expect(dr.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactBlocking))
+}
+
+func TestAdmissionCheck_InferenceServiceAndRayCluster(t *testing.T) {
+	g := NewWithT(t)
+
+	isvc := resources.InferenceService.Unstructured()
+	isvc.SetNamespace("models")
+	isvc.SetName("llm")
+	_ = unstructured.SetNestedMap(isvc.Object, map[string]any{
+		"model": map[string]any{
+			"modelFormat":     map[string]any{"name": "vllm"},
+			"securityContext": map[string]any{"privileged": true},
+		},
+	}, "spec", "predictor")
+
+	ray := resources.RayCluster.Unstructured()
+	ray.SetNamespace("models")
+	ray.SetName("ray")
+	_ = unstructured.SetNestedSlice(ray.Object, []any{
+		map[string]any{"template": map[string]any{"spec": containerWith(map[string]any{"runAsUser": int64(0)})}},
+	}, "spec", "workerGroupSpecs")
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      listKinds,
+		Objects:        []*unstructured.Unstructured{newNamespace("models", "restricted"), &isvc, &ray},
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	dr, err := podsecurity.NewAdmissionCheck().Validate(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(dr.ImpactedObjects).To(HaveLen(2))
+	g.Expect(dr.ImpactedObjects[0].Annotations).To(HaveKeyWithValue(podsecurity.AnnotationViolations,
+		"spec.predictor.model.securityContext.privileged must not be true"))
+	g.Expect(dr.ImpactedObjects[1].Annotations).To(HaveKeyWithValue(podsecurity.AnnotationViolations,
+		"spec.workerGroupSpecs[0].template.spec.containers[0].securityContext.runAsUser must not be 0"))
+}
+
+func TestAdmissionCheck_CanApply(t *testing.T) {
+	g := NewWithT(t)
+
+	lint := testutil.NewTarget(t, testutil.TargetConfig{ListKinds: listKinds, CurrentVersion: "3.0.0", TargetVersion: "3.0.0"})
+
+	canApply, err := podsecurity.NewAdmissionCheck().CanApply(t.Context(), lint)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeFalse())
+}
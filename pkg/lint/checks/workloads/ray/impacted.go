@@ -26,6 +26,24 @@ const (
 	ConditionTypeCodeFlareRayClusterCompatible = "CodeFlareRayClustersCompatible"
 )
 
+// Examples rendered by 'lint explain'.
+const (
+	impactedFailingExample = `apiVersion: ray.io/v1
+kind: RayCluster
+metadata:
+  name: ray-demo
+  namespace: team-a
+  finalizers:
+    - ray.openshift.ai/oauth-finalizer`
+
+	impactedPassingExample = `apiVersion: ray.io/v1
+kind: RayCluster
+metadata:
+  name: ray-demo
+  namespace: team-a
+  finalizers: []`
+)
+
 // ImpactedWorkloadsCheck lists RayClusters managed by CodeFlare.
 type ImpactedWorkloadsCheck struct {
 	check.BaseCheck
@@ -41,6 +59,22 @@ func NewImpactedWorkloadsCheck() *ImpactedWorkloadsCheck {
 			CheckName:        "Workloads :: Ray :: Impacted Workloads (3.x)",
 			CheckDescription: "Lists RayClusters managed by CodeFlare that will be impacted in RHOAI 3.x (CodeFlare not available)",
 			CheckRemediation: "Delete or back up CodeFlare-managed RayClusters before upgrading, as CodeFlare will not be available in RHOAI 3.x",
+			CheckResources: []resources.ResourceType{
+				resources.DataScienceCluster,
+				resources.RayCluster,
+			},
+			CheckVersionGate:    check.VersionGateUpgrade2xTo3x,
+			CheckKnowledgeLinks: []string{check.DocsDistributedWorkloads},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "RayClusters in all namespaces that carry the CodeFlare finalizer ray.openshift.ai/oauth-finalizer. The check only runs when upgrading from 2.x to 3.x.",
+				Rationale:      "The finalizer is added by the CodeFlare operator, which RHOAI 3.x no longer ships. Without the operator nothing removes the finalizer, so these RayClusters cannot be deleted and their oauth-proxy setup is no longer maintained.",
+				FailingExample: impactedFailingExample,
+				PassingExample: impactedPassingExample,
+				RemediationCommands: []string{
+					"kubectl odh backup --output-dir ./backup",
+					"kubectl delete raycluster <name> -n <namespace>",
+				},
+			},
 		},
 	}
 }
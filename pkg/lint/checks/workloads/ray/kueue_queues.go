@@ -0,0 +1,212 @@
+package ray
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/constants"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/components"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+const (
+	// ConditionTypeRayClusterKueueQueuesValid indicates whether the Kueue queues RayClusters are
+	// submitted to exist and are active.
+	ConditionTypeRayClusterKueueQueuesValid = "RayClusterKueueQueuesValid"
+
+	checkTypeKueueQueues = "kueue-queues"
+
+	// labelKueueQueueName is the label submitting a workload to a Kueue LocalQueue.
+	labelKueueQueueName = "kueue.x-k8s.io/queue-name"
+
+	// maxQueueMessageDetails bounds the per-cluster details listed in the condition message.
+	maxQueueMessageDetails = 10
+)
+
+// Annotations recorded on the RayClusters impacted by queue findings.
+const (
+	AnnotationQueueIssue  = "check.opendatahub.io/kueue-queue-issue"
+	AnnotationQueueReason = "check.opendatahub.io/reason"
+)
+
+// QueueIssue is a problem with the Kueue queue a RayCluster is submitted to.
+type QueueIssue string
+
+const (
+	// QueueIssueMissingLocalQueue means the queue-name label names a LocalQueue that does not
+	// exist in the namespace of the RayCluster.
+	QueueIssueMissingLocalQueue QueueIssue = "missing-localqueue"
+
+	// QueueIssueMissingClusterQueue means the LocalQueue points to a ClusterQueue that does not
+	// exist.
+	QueueIssueMissingClusterQueue QueueIssue = "missing-clusterqueue"
+
+	// QueueIssueInactiveClusterQueue means the ClusterQueue exists but is not Active, e.g.
+	// because its flavors or cohort are misconfigured.
+	QueueIssueInactiveClusterQueue QueueIssue = "inactive-clusterqueue"
+)
+
+// Examples rendered by 'lint explain'.
+const (
+	kueueQueuesFailingExample = `apiVersion: ray.io/v1
+kind: RayCluster
+metadata:
+  name: ray-demo
+  namespace: team-a
+  labels:
+    kueue.x-k8s.io/queue-name: team-a-queue   # no LocalQueue team-a-queue in team-a`
+
+	kueueQueuesPassingExample = `apiVersion: kueue.x-k8s.io/v1beta1
+kind: LocalQueue
+metadata:
+  name: team-a-queue
+  namespace: team-a
+spec:
+  clusterQueue: cluster-queue   # exists, with condition Active=True`
+)
+
+// KueueQueuesCheck verifies that the Kueue queues RayClusters are submitted to exist and are
+// backed by an active ClusterQueue. After the upgrade Kueue admits the migrated RayClusters
+// again, and a cluster whose queue is missing or inactive stays Suspended.
+type KueueQueuesCheck struct {
+	check.BaseCheck
+}
+
+func NewKueueQueuesCheck() *KueueQueuesCheck {
+	return &KueueQueuesCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupWorkload,
+			Kind:             kind,
+			Type:             checkTypeKueueQueues,
+			CheckID:          "workloads.ray.kueue-queues",
+			CheckName:        "Workloads :: Ray :: Kueue Queues (3.x)",
+			CheckDescription: "Detects RayClusters whose kueue.x-k8s.io/queue-name label names a missing LocalQueue, or a LocalQueue backed by a missing or inactive ClusterQueue, which leaves the clusters Suspended after the upgrade",
+			CheckRemediation: "Create the missing LocalQueues and ClusterQueues, or fix the ClusterQueues that are not Active, before upgrading; alternatively relabel the RayClusters with an existing queue",
+			CheckResources: []resources.ResourceType{
+				resources.DataScienceCluster,
+				resources.RayCluster,
+				resources.LocalQueue,
+				resources.ClusterQueue,
+			},
+			CheckVersionGate:    check.VersionGateUpgrade2xTo3x,
+			CheckKnowledgeLinks: []string{check.DocsDistributedWorkloads},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "The kueue.x-k8s.io/queue-name label of every RayCluster, the LocalQueue it names in the namespace of the cluster, and the Active condition of the ClusterQueue that LocalQueue points to. The check only runs when upgrading from 2.x to 3.x with Ray Managed.",
+				Rationale:      "Kueue keeps a queued RayCluster suspended until its workload is admitted through its LocalQueue and ClusterQueue. When the migrated RayClusters are re-admitted after the upgrade, a cluster whose queue is missing or whose ClusterQueue is not Active is never admitted and stays Suspended.",
+				FailingExample: kueueQueuesFailingExample,
+				PassingExample: kueueQueuesPassingExample,
+				RemediationCommands: []string{
+					"kubectl get rayclusters -A -L kueue.x-k8s.io/queue-name",
+					"kubectl get localqueues -A -o custom-columns=NAMESPACE:.metadata.namespace,NAME:.metadata.name,CLUSTERQUEUE:.spec.clusterQueue",
+					"kubectl get clusterqueues -o custom-columns=NAME:.metadata.name,ACTIVE:.status.conditions[?(@.type==\"Active\")].status",
+				},
+			},
+		},
+	}
+}
+
+// CanApply returns whether this check should run for the given target.
+// Only applies when upgrading from 2.x to 3.x and Ray is Managed.
+func (c *KueueQueuesCheck) CanApply(ctx context.Context, target check.Target) (bool, error) {
+	if !version.IsUpgradeFrom2xTo3x(target.CurrentVersion, target.TargetVersion) {
+		return false, nil
+	}
+
+	dsc, err := client.GetDataScienceCluster(ctx, target.Client)
+	if err != nil {
+		return false, fmt.Errorf("getting DataScienceCluster: %w", err)
+	}
+
+	return components.HasManagementState(dsc, kind, constants.ManagementStateManaged), nil
+}
+
+// Validate executes the check against the provided target.
+func (c *KueueQueuesCheck) Validate(
+	ctx context.Context,
+	target check.Target,
+) (*result.DiagnosticResult, error) {
+	dr := c.NewResult()
+
+	if target.TargetVersion != nil {
+		dr.Annotations[check.AnnotationCheckTargetVersion] = target.TargetVersion.String()
+	}
+
+	findings, total, err := FindRayClusterQueueIssues(ctx, target.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	dr.Annotations[check.AnnotationImpactedWorkloadCount] = strconv.Itoa(len(findings))
+	dr.SetCondition(c.newCondition(total, findings))
+
+	if len(findings) > 0 {
+		impacted := make([]metav1.PartialObjectMetadata, 0, len(findings))
+
+		for _, f := range findings {
+			impacted = append(impacted, metav1.PartialObjectMetadata{
+				TypeMeta: resources.RayCluster.TypeMeta(),
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: f.Namespace,
+					Name:      f.Name,
+					Annotations: map[string]string{
+						AnnotationQueueIssue:  string(f.Issue),
+						AnnotationQueueReason: f.Detail,
+					},
+				},
+			})
+		}
+
+		dr.ImpactedObjects = impacted
+	}
+
+	return dr, nil
+}
+
+func (c *KueueQueuesCheck) newCondition(total int, findings []RayClusterQueueFinding) result.Condition {
+	if total == 0 {
+		return check.NewCondition(
+			ConditionTypeRayClusterKueueQueuesValid,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonRequirementsMet),
+			check.WithMessage("No RayClusters are submitted to Kueue queues"),
+		)
+	}
+
+	if len(findings) == 0 {
+		return check.NewCondition(
+			ConditionTypeRayClusterKueueQueuesValid,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonRequirementsMet),
+			check.WithMessage("Kueue queues of all %d queued RayCluster(s) exist and are active", total),
+		)
+	}
+
+	var details strings.Builder
+
+	for i, f := range findings {
+		if i == maxQueueMessageDetails {
+			_, _ = fmt.Fprintf(&details, "\n  ... and %d more", len(findings)-maxQueueMessageDetails)
+
+			break
+		}
+
+		_, _ = fmt.Fprintf(&details, "\n  - %s/%s: %s", f.Namespace, f.Name, f.Detail)
+	}
+
+	return check.NewCondition(
+		ConditionTypeRayClusterKueueQueuesValid,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonWorkloadsImpacted),
+		check.WithMessage("Found %d of %d queued RayCluster(s) that will stay Suspended after the upgrade:%s", len(findings), total, details.String()),
+		check.WithImpact(result.ImpactAdvisory),
+		check.WithRemediation(c.CheckRemediation),
+	)
+}
@@ -0,0 +1,107 @@
+package ray
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+)
+
+// RayClusterQueueFinding is the queue issue of a single RayCluster.
+type RayClusterQueueFinding struct {
+	Namespace string
+	Name      string
+	Queue     string
+	Issue     QueueIssue
+	Detail    string
+}
+
+// FindRayClusterQueueIssues returns the RayClusters whose kueue.x-k8s.io/queue-name label
+// names a missing LocalQueue, or a LocalQueue backed by a missing or inactive ClusterQueue,
+// and the number of RayClusters carrying the label.
+func FindRayClusterQueueIssues(ctx context.Context, r client.Reader) ([]RayClusterQueueFinding, int, error) {
+	clusters, err := r.ListMetadata(ctx, resources.RayCluster, client.WithLabelSelector(labelKueueQueueName))
+	if err != nil {
+		if client.IsResourceTypeNotFound(err) {
+			return nil, 0, nil
+		}
+
+		return nil, 0, fmt.Errorf("listing RayClusters: %w", err)
+	}
+
+	if len(clusters) == 0 {
+		return nil, 0, nil
+	}
+
+	localQueues, err := client.ListByNamespacedName(ctx, r, resources.LocalQueue)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	clusterQueues, err := client.ListByNamespacedName(ctx, r, resources.ClusterQueue)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var findings []RayClusterQueueFinding
+
+	for _, cluster := range clusters {
+		finding := RayClusterQueueFinding{
+			Namespace: cluster.GetNamespace(),
+			Name:      cluster.GetName(),
+			Queue:     cluster.GetLabels()[labelKueueQueueName],
+		}
+
+		if inspectQueue(&finding, localQueues, clusterQueues) {
+			findings = append(findings, finding)
+		}
+	}
+
+	return findings, len(clusters), nil
+}
+
+// inspectQueue records the issue of the LocalQueue of a RayCluster and its ClusterQueue, and
+// reports whether there is one.
+func inspectQueue(
+	f *RayClusterQueueFinding,
+	localQueues map[types.NamespacedName]*unstructured.Unstructured,
+	clusterQueues map[types.NamespacedName]*unstructured.Unstructured,
+) bool {
+	lq, ok := localQueues[types.NamespacedName{Namespace: f.Namespace, Name: f.Queue}]
+	if !ok {
+		f.Issue = QueueIssueMissingLocalQueue
+		f.Detail = fmt.Sprintf("LocalQueue %s not found", f.Queue)
+
+		return true
+	}
+
+	cqName, _ := jq.Query[string](lq, ".spec.clusterQueue")
+
+	cq, ok := clusterQueues[types.NamespacedName{Name: cqName}]
+	if !ok {
+		f.Issue = QueueIssueMissingClusterQueue
+		f.Detail = fmt.Sprintf("LocalQueue %s points to ClusterQueue %s, which does not exist", f.Queue, cqName)
+
+		return true
+	}
+
+	active, _ := jq.Query[string](cq, `.status.conditions[]? | select(.type == "Active") | .status`)
+	if active != "True" {
+		reason, _ := jq.Query[string](cq, `.status.conditions[]? | select(.type == "Active") | .reason`)
+		if reason == "" {
+			reason = "no Active condition"
+		}
+
+		f.Issue = QueueIssueInactiveClusterQueue
+		f.Detail = fmt.Sprintf("ClusterQueue %s of LocalQueue %s is not active (%s)", cqName, f.Queue, reason)
+
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,210 @@
+package ray_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/ray"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+//nolint:gochecknoglobals // Test fixture - shared across test functions
+var queueListKinds = map[schema.GroupVersionResource]string{
+	resources.RayCluster.GVR():         resources.RayCluster.ListKind(),
+	resources.LocalQueue.GVR():         resources.LocalQueue.ListKind(),
+	resources.ClusterQueue.GVR():       resources.ClusterQueue.ListKind(),
+	resources.DataScienceCluster.GVR(): resources.DataScienceCluster.ListKind(),
+}
+
+func newQueuedRayCluster(name string, queue string) *unstructured.Unstructured {
+	metadata := map[string]any{
+		"name":      name,
+		"namespace": "team-a",
+	}
+
+	if queue != "" {
+		metadata["labels"] = map[string]any{"kueue.x-k8s.io/queue-name": queue}
+	}
+
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.RayCluster.APIVersion(),
+			"kind":       resources.RayCluster.Kind,
+			"metadata":   metadata,
+		},
+	}
+}
+
+func newLocalQueue(name string, clusterQueue string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.LocalQueue.APIVersion(),
+			"kind":       resources.LocalQueue.Kind,
+			"metadata": map[string]any{
+				"name":      name,
+				"namespace": "team-a",
+			},
+			"spec": map[string]any{"clusterQueue": clusterQueue},
+		},
+	}
+}
+
+func newClusterQueue(name string, active string, reason string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.ClusterQueue.APIVersion(),
+			"kind":       resources.ClusterQueue.Kind,
+			"metadata":   map[string]any{"name": name},
+			"status": map[string]any{
+				"conditions": []any{
+					map[string]any{"type": "Active", "status": active, "reason": reason},
+				},
+			},
+		},
+	}
+}
+
+func newQueueTarget(t *testing.T, objects ...*unstructured.Unstructured) check.Target {
+	t.Helper()
+
+	return testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: queueListKinds,
+		Objects: append(objects,
+			newLocalQueue("team-a-queue", "cluster-queue"),
+			newClusterQueue("cluster-queue", "True", "Ready"),
+		),
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+	})
+}
+
+func TestKueueQueuesCheck_NoIssues(t *testing.T) {
+	g := NewWithT(t)
+
+	target := newQueueTarget(t,
+		newQueuedRayCluster("queued", "team-a-queue"),
+		newQueuedRayCluster("unqueued", ""),
+	)
+
+	result, err := ray.NewKueueQueuesCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Status.Conditions).To(HaveLen(1))
+	g.Expect(result.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":    Equal(ray.ConditionTypeRayClusterKueueQueuesValid),
+		"Status":  Equal(metav1.ConditionTrue),
+		"Reason":  Equal(check.ReasonRequirementsMet),
+		"Message": ContainSubstring("all 1 queued RayCluster(s)"),
+	}))
+	g.Expect(result.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "0"))
+	g.Expect(result.ImpactedObjects).To(BeEmpty())
+}
+
+func TestKueueQueuesCheck_Issues(t *testing.T) {
+	tests := []struct {
+		name           string
+		objects        []*unstructured.Unstructured
+		expectedIssue  ray.QueueIssue
+		expectedReason string
+	}{
+		{
+			name:           "MissingLocalQueue",
+			objects:        []*unstructured.Unstructured{newQueuedRayCluster("ray", "other-queue")},
+			expectedIssue:  ray.QueueIssueMissingLocalQueue,
+			expectedReason: "LocalQueue other-queue not found",
+		},
+		{
+			name: "MissingClusterQueue",
+			objects: []*unstructured.Unstructured{
+				newQueuedRayCluster("ray", "orphan-queue"),
+				newLocalQueue("orphan-queue", "removed"),
+			},
+			expectedIssue:  ray.QueueIssueMissingClusterQueue,
+			expectedReason: "LocalQueue orphan-queue points to ClusterQueue removed, which does not exist",
+		},
+		{
+			name: "InactiveClusterQueue",
+			objects: []*unstructured.Unstructured{
+				newQueuedRayCluster("ray", "gpu-queue"),
+				newLocalQueue("gpu-queue", "gpu"),
+				newClusterQueue("gpu", "False", "FlavorNotFound"),
+			},
+			expectedIssue:  ray.QueueIssueInactiveClusterQueue,
+			expectedReason: "ClusterQueue gpu of LocalQueue gpu-queue is not active (FlavorNotFound)",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			result, err := ray.NewKueueQueuesCheck().Validate(t.Context(), newQueueTarget(t, tc.objects...))
+
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(result.Status.Conditions).To(HaveLen(1))
+			g.Expect(result.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+				"Status":  Equal(metav1.ConditionFalse),
+				"Reason":  Equal(check.ReasonWorkloadsImpacted),
+				"Message": ContainSubstring("team-a/ray: " + tc.expectedReason),
+			}))
+			g.Expect(result.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactAdvisory))
+			g.Expect(result.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "1"))
+			g.Expect(result.ImpactedObjects).To(HaveLen(1))
+			g.Expect(result.ImpactedObjects[0].Kind).To(Equal(resources.RayCluster.Kind))
+			g.Expect(result.ImpactedObjects[0].Annotations).To(And(
+				HaveKeyWithValue(ray.AnnotationQueueIssue, string(tc.expectedIssue)),
+				HaveKeyWithValue(ray.AnnotationQueueReason, tc.expectedReason),
+			))
+		})
+	}
+}
+
+func TestKueueQueuesCheck_Metadata(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := ray.NewKueueQueuesCheck()
+
+	g.Expect(chk.ID()).To(Equal("workloads.ray.kueue-queues"))
+	g.Expect(chk.Name()).To(Equal("Workloads :: Ray :: Kueue Queues (3.x)"))
+	g.Expect(chk.Group()).To(Equal(check.GroupWorkload))
+	g.Expect(chk.Description()).ToNot(BeEmpty())
+}
+
+func TestKueueQueuesCheck_CanApply(t *testing.T) {
+	tests := []struct {
+		name           string
+		targetVersion  string
+		ray            string
+		expectedResult bool
+	}{
+		{name: "Upgrade2xTo3x_Managed", targetVersion: "3.0.0", ray: "Managed", expectedResult: true},
+		{name: "Upgrade2xTo3x_Removed", targetVersion: "3.0.0", ray: "Removed", expectedResult: false},
+		{name: "Within2x", targetVersion: "2.25.1", ray: "Managed", expectedResult: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			target := testutil.NewTarget(t, testutil.TargetConfig{
+				ListKinds:      queueListKinds,
+				Objects:        []*unstructured.Unstructured{testutil.NewDSC(map[string]string{"ray": tc.ray})},
+				CurrentVersion: "2.25.0",
+				TargetVersion:  tc.targetVersion,
+			})
+
+			canApply, err := ray.NewKueueQueuesCheck().CanApply(t.Context(), target)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(canApply).To(Equal(tc.expectedResult))
+		})
+	}
+}
@@ -0,0 +1,286 @@
+package servingruntime
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/constants"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/components"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+const (
+	kind = "servingruntime"
+
+	checkTypeTemplateDrift = "template-drift"
+
+	// ConditionTypeServingRuntimesMatchTemplates indicates whether ServingRuntimes cloned from
+	// templates still match a template shipped in 3.x.
+	ConditionTypeServingRuntimesMatchTemplates = "ServingRuntimesMatchTemplates"
+
+	// Annotation the dashboard sets on ServingRuntimes created from a template.
+	annotationTemplateName = "opendatahub.io/template-name"
+
+	// Label of the ServingRuntime templates shown by the dashboard.
+	dashboardLabel = "opendatahub.io/dashboard=true"
+
+	// maxMessageDetails bounds the per-runtime details listed in the condition message.
+	maxMessageDetails = 10
+)
+
+// Annotations recorded on the impacted ServingRuntimes.
+const (
+	AnnotationTemplate = "check.opendatahub.io/template"
+	AnnotationDrift    = "check.opendatahub.io/drift"
+	AnnotationReason   = "check.opendatahub.io/reason"
+)
+
+// Drift is how a ServingRuntime differs from the template it was cloned from.
+type Drift string
+
+const (
+	// DriftTemplateRemoved means the template is not shipped in 3.x, with no replacement.
+	DriftTemplateRemoved Drift = "template-removed"
+
+	// DriftTemplateRenamed means the template is shipped under another name in 3.x.
+	DriftTemplateRenamed Drift = "template-renamed"
+
+	// DriftTemplateNotFound means the template is not in the applications namespace.
+	DriftTemplateNotFound Drift = "template-not-found"
+
+	// DriftImage means the runtime containers run other images than the template ships.
+	DriftImage Drift = "image"
+)
+
+// templates3x maps the OOTB ServingRuntime templates that are not shipped in 3.x to the template
+// replacing them; an empty replacement means the template is removed.
+//
+//nolint:gochecknoglobals // Read-only template mapping
+var templates3x = map[string]string{
+	"ovms":                               "",
+	"caikit-standalone-serving-template": "",
+	"caikit-tgis-serving-template":       "",
+	"tgis-grpc-serving-template":         "",
+	"vllm-runtime-template":              "vllm-cuda-runtime-template",
+}
+
+// Examples rendered by 'lint explain'.
+const (
+	templateDriftFailingExample = `apiVersion: serving.kserve.io/v1alpha1
+kind: ServingRuntime
+metadata:
+  name: caikit-tgis-runtime
+  namespace: team-a
+  annotations:
+    opendatahub.io/template-name: caikit-tgis-serving-template  # removed in 3.x`
+
+	templateDriftPassingExample = `apiVersion: serving.kserve.io/v1alpha1
+kind: ServingRuntime
+metadata:
+  name: vllm-cuda-runtime
+  namespace: team-a
+  annotations:
+    opendatahub.io/template-name: vllm-cuda-runtime-template
+spec:
+  containers:
+    - name: kserve-container
+      image: <image of the vllm-cuda-runtime-template ServingRuntime>`
+)
+
+// runtimeDrift is the drift of a single ServingRuntime.
+type runtimeDrift struct {
+	Namespace string
+	Name      string
+	Template  string
+	Drift     Drift
+	Details   string
+}
+
+// TemplateDriftCheck compares the ServingRuntimes created from dashboard templates with the
+// templates shipped in the applications namespace, and flags the runtimes cloned from templates
+// removed or renamed in 3.x, or whose images drifted from their template.
+type TemplateDriftCheck struct {
+	check.BaseCheck
+}
+
+func NewTemplateDriftCheck() *TemplateDriftCheck {
+	return &TemplateDriftCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.GroupWorkload,
+			Kind:             kind,
+			Type:             checkTypeTemplateDrift,
+			CheckID:          "workloads.servingruntime.template-drift",
+			CheckName:        "Workloads :: ServingRuntime :: Template Drift (3.x)",
+			CheckDescription: "Compares ServingRuntimes created from dashboard templates with the OOTB templates and flags runtimes cloned from templates removed or renamed in RHOAI 3.x, or whose images drifted from their template",
+			CheckRemediation: "Re-create ServingRuntimes cloned from removed or renamed templates from a template shipped in 3.x, and update drifted runtimes to the images of their template before upgrading",
+			CheckResources: []resources.ResourceType{
+				resources.DataScienceCluster,
+				resources.DSCInitialization,
+				resources.ServingRuntime,
+				resources.Template,
+			},
+			CheckVersionGate:    check.VersionGateUpgrade2xTo3x,
+			CheckKnowledgeLinks: []string{check.DocsServingModels},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "ServingRuntimes carrying the opendatahub.io/template-name annotation the dashboard sets when a runtime is created from a template, and the ServingRuntimes of the dashboard templates in the applications namespace, compared container by container. The check only runs when upgrading from 2.x to 3.x with KServe or ModelMesh Managed.",
+				Rationale:      "ServingRuntimes are copies of their template and are not updated by the upgrade. Runtimes cloned from templates removed in 3.x (OVMS for ModelMesh, Caikit, TGIS) keep running unsupported images, runtimes from renamed templates no longer match any template, and runtimes whose images drifted from their template miss the fixes shipped with it.",
+				FailingExample: templateDriftFailingExample,
+				PassingExample: templateDriftPassingExample,
+				RemediationCommands: []string{
+					"kubectl get servingruntimes -A -o custom-columns=NAMESPACE:.metadata.namespace,NAME:.metadata.name,TEMPLATE:.metadata.annotations.opendatahub\\.io/template-name",
+					"kubectl get templates -n <applications-namespace> -l opendatahub.io/dashboard=true",
+				},
+			},
+		},
+	}
+}
+
+// CanApply returns whether this check should run for the given target.
+// Only applies when upgrading FROM 2.x TO 3.x and KServe or ModelMesh is Managed.
+func (c *TemplateDriftCheck) CanApply(ctx context.Context, target check.Target) (bool, error) {
+	if !version.IsUpgradeFrom2xTo3x(target.CurrentVersion, target.TargetVersion) {
+		return false, nil
+	}
+
+	dsc, err := client.GetDataScienceCluster(ctx, target.Client)
+	if err != nil {
+		return false, fmt.Errorf("getting DataScienceCluster: %w", err)
+	}
+
+	return components.HasManagementState(dsc, constants.ComponentKServe, constants.ManagementStateManaged) ||
+		components.HasManagementState(dsc, "modelmeshserving", constants.ManagementStateManaged), nil
+}
+
+// Validate executes the check against the provided target.
+func (c *TemplateDriftCheck) Validate(
+	ctx context.Context,
+	target check.Target,
+) (*result.DiagnosticResult, error) {
+	return validate.Workloads(c, target, resources.ServingRuntime).
+		Filter(isClonedFromTemplate).
+		Run(ctx, func(ctx context.Context, req *validate.WorkloadRequest[*unstructured.Unstructured]) error {
+			return c.analyzeServingRuntimes(ctx, req)
+		})
+}
+
+// isClonedFromTemplate returns true for ServingRuntimes created from a dashboard template.
+func isClonedFromTemplate(sr *unstructured.Unstructured) (bool, error) {
+	return kube.GetAnnotation(sr, annotationTemplateName) != "", nil
+}
+
+// analyzeServingRuntimes compares the ServingRuntimes with their templates and sets the
+// condition and impacted objects.
+func (c *TemplateDriftCheck) analyzeServingRuntimes(
+	ctx context.Context,
+	req *validate.WorkloadRequest[*unstructured.Unstructured],
+) error {
+	if len(req.Items) == 0 {
+		req.Result.SetCondition(check.NewCondition(
+			ConditionTypeServingRuntimesMatchTemplates,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonRequirementsMet),
+			check.WithMessage("No ServingRuntimes created from templates found"),
+		))
+
+		return nil
+	}
+
+	appNS, err := client.GetApplicationsNamespace(ctx, req.Client)
+	if err != nil {
+		return fmt.Errorf("getting applications namespace: %w", err)
+	}
+
+	templates, err := listTemplateRuntimes(ctx, req.Client, appNS)
+	if err != nil {
+		return err
+	}
+
+	var drifts []runtimeDrift
+
+	for _, sr := range req.Items {
+		if d, ok := compareWithTemplate(sr, templates); ok {
+			drifts = append(drifts, d)
+		}
+	}
+
+	c.setCondition(req.Result, len(req.Items), drifts)
+	setImpactedObjects(req.Result, drifts)
+
+	return nil
+}
+
+// setCondition sets the diagnostic condition, listing the drift of each runtime.
+func (c *TemplateDriftCheck) setCondition(dr *result.DiagnosticResult, total int, drifts []runtimeDrift) {
+	if len(drifts) == 0 {
+		dr.SetCondition(check.NewCondition(
+			ConditionTypeServingRuntimesMatchTemplates,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonRequirementsMet),
+			check.WithMessage("All %d ServingRuntime(s) created from templates match a template shipped in 3.x", total),
+		))
+
+		return
+	}
+
+	impact := result.ImpactAdvisory
+	reason := check.ReasonWorkloadsImpacted
+
+	var details strings.Builder
+
+	for i, d := range drifts {
+		if d.Drift == DriftTemplateRemoved {
+			impact = result.ImpactBlocking
+			reason = check.ReasonFeatureRemoved
+		}
+
+		if i < maxMessageDetails {
+			_, _ = fmt.Fprintf(&details, "\n  - %s/%s: %s", d.Namespace, d.Name, d.Details)
+		}
+	}
+
+	if len(drifts) > maxMessageDetails {
+		_, _ = fmt.Fprintf(&details, "\n  ... and %d more", len(drifts)-maxMessageDetails)
+	}
+
+	dr.SetCondition(check.NewCondition(
+		ConditionTypeServingRuntimesMatchTemplates,
+		metav1.ConditionFalse,
+		check.WithReason(reason),
+		check.WithMessage("Found %d of %d ServingRuntime(s) drifting from their template:%s", len(drifts), total, details.String()),
+		check.WithImpact(impact),
+		check.WithRemediation(c.CheckRemediation),
+	))
+}
+
+// setImpactedObjects sets the ImpactedObjects to the drifting ServingRuntimes.
+// Uses an empty slice (not nil) to prevent validate.Workloads from auto-populating.
+func setImpactedObjects(dr *result.DiagnosticResult, drifts []runtimeDrift) {
+	impacted := make([]metav1.PartialObjectMetadata, 0, len(drifts))
+
+	for _, d := range drifts {
+		impacted = append(impacted, metav1.PartialObjectMetadata{
+			TypeMeta: resources.ServingRuntime.TypeMeta(),
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: d.Namespace,
+				Name:      d.Name,
+				Annotations: map[string]string{
+					AnnotationTemplate: d.Template,
+					AnnotationDrift:    string(d.Drift),
+					AnnotationReason:   d.Details,
+				},
+			},
+		})
+	}
+
+	dr.ImpactedObjects = impacted
+}
@@ -0,0 +1,111 @@
+package servingruntime
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube"
+)
+
+// container is a container name and image of a ServingRuntime.
+type container struct {
+	Name  string `json:"name"`
+	Image string `json:"image"`
+}
+
+// listTemplateRuntimes returns the containers of the ServingRuntime of each dashboard template in
+// the applications namespace, indexed by template name.
+func listTemplateRuntimes(
+	ctx context.Context,
+	reader client.Reader,
+	appNS string,
+) (map[string][]container, error) {
+	templates, err := reader.List(ctx, resources.Template,
+		client.WithNamespace(appNS),
+		client.WithLabelSelector(dashboardLabel),
+	)
+	if err != nil && !client.IsResourceTypeNotFound(err) {
+		return nil, fmt.Errorf("listing Templates: %w", err)
+	}
+
+	runtimes := make(map[string][]container, len(templates))
+
+	for _, tpl := range templates {
+		containers, err := jq.Query[[]container](tpl,
+			`[.objects[]? | select(.kind == "`+resources.ServingRuntime.Kind+`") | .spec.containers[]?]`)
+		if err != nil {
+			return nil, fmt.Errorf("reading containers of Template %s: %w", tpl.GetName(), err)
+		}
+
+		if len(containers) == 0 {
+			continue
+		}
+
+		runtimes[tpl.GetName()] = containers
+	}
+
+	return runtimes, nil
+}
+
+// compareWithTemplate returns the drift of a ServingRuntime from the template it was cloned from,
+// and false when it matches the template.
+func compareWithTemplate(
+	sr *unstructured.Unstructured,
+	templates map[string][]container,
+) (runtimeDrift, bool) {
+	name := kube.GetAnnotation(sr, annotationTemplateName)
+
+	drift := runtimeDrift{
+		Namespace: sr.GetNamespace(),
+		Name:      sr.GetName(),
+		Template:  name,
+	}
+
+	if replacement, ok := templates3x[name]; ok {
+		if replacement == "" {
+			drift.Drift = DriftTemplateRemoved
+			drift.Details = fmt.Sprintf("template %s is removed in 3.x", name)
+		} else {
+			drift.Drift = DriftTemplateRenamed
+			drift.Details = fmt.Sprintf("template %s is renamed to %s in 3.x", name, replacement)
+		}
+
+		return drift, true
+	}
+
+	tpl, ok := templates[name]
+	if !ok {
+		drift.Drift = DriftTemplateNotFound
+		drift.Details = fmt.Sprintf("template %s not found", name)
+
+		return drift, true
+	}
+
+	// A runtime without readable containers has no image to compare
+	containers, _ := jq.Query[[]container](sr, "[.spec.containers[]?]")
+
+	var changes []string
+
+	for _, c := range containers {
+		for _, want := range tpl {
+			if want.Name == c.Name && want.Image != c.Image {
+				changes = append(changes, fmt.Sprintf("container %s runs %s, template %s ships %s", c.Name, c.Image, name, want.Image))
+			}
+		}
+	}
+
+	if len(changes) == 0 {
+		return drift, false
+	}
+
+	drift.Drift = DriftImage
+	drift.Details = strings.Join(changes, "; ")
+
+	return drift, true
+}
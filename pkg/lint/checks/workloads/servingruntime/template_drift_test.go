@@ -0,0 +1,249 @@
+package servingruntime_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/servingruntime"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	fixtures "github.com/opendatahub-io/odh-cli/pkg/util/test/testutil"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+//nolint:gochecknoglobals
+var listKinds = map[schema.GroupVersionResource]string{
+	resources.ServingRuntime.GVR():     resources.ServingRuntime.ListKind(),
+	resources.Template.GVR():           resources.Template.ListKind(),
+	resources.DataScienceCluster.GVR(): resources.DataScienceCluster.ListKind(),
+	resources.DSCInitialization.GVR():  resources.DSCInitialization.ListKind(),
+}
+
+const (
+	applicationsNS = "redhat-ods-applications"
+
+	vllmTemplate = "vllm-cuda-runtime-template"
+	vllmImage    = "registry.redhat.io/rhoai/odh-vllm-cuda-rhel9@sha256:current"
+	vllmOldImage = "registry.redhat.io/rhoai/odh-vllm-cuda-rhel9@sha256:previous"
+)
+
+// Helper functions to create test fixtures.
+
+func newServingRuntime(name string, template string, image string) *unstructured.Unstructured {
+	obj := fixtures.NewServingRuntime("team-a", name, image)
+
+	if template != "" {
+		obj.SetAnnotations(map[string]string{"opendatahub.io/template-name": template})
+	}
+
+	return obj
+}
+
+func newTemplate(name string, image string) *unstructured.Unstructured {
+	return fixtures.NewServingRuntimeTemplate(applicationsNS, name, "runtime", image)
+}
+
+func newTarget(t *testing.T, objects ...*unstructured.Unstructured) check.Target {
+	t.Helper()
+
+	return testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      listKinds,
+		Objects:        append(objects, testutil.NewDSCI(applicationsNS)),
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+	})
+}
+
+func TestTemplateDriftCheck_NoClonedRuntimes(t *testing.T) {
+	g := NewWithT(t)
+
+	// Runtimes without the template annotation are not compared
+	target := newTarget(t, newServingRuntime("custom", "", "quay.io/myorg/runtime:v1"))
+
+	result, err := servingruntime.NewTemplateDriftCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Status.Conditions).To(HaveLen(1))
+	g.Expect(result.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":    Equal(servingruntime.ConditionTypeServingRuntimesMatchTemplates),
+		"Status":  Equal(metav1.ConditionTrue),
+		"Message": ContainSubstring("No ServingRuntimes created from templates found"),
+	}))
+	g.Expect(result.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "0"))
+	g.Expect(result.ImpactedObjects).To(BeEmpty())
+}
+
+func TestTemplateDriftCheck_Drift(t *testing.T) {
+	tests := []struct {
+		name           string
+		objects        []*unstructured.Unstructured
+		expectedDrift  servingruntime.Drift
+		expectedImpact resultpkg.Impact
+		expectedDetail string
+	}{
+		{
+			name: "MatchesTemplate",
+			objects: []*unstructured.Unstructured{
+				newTemplate(vllmTemplate, vllmImage),
+				newServingRuntime("vllm", vllmTemplate, vllmImage),
+			},
+			expectedImpact: resultpkg.ImpactNone,
+		},
+		{
+			name: "ImageDrift",
+			objects: []*unstructured.Unstructured{
+				newTemplate(vllmTemplate, vllmImage),
+				newServingRuntime("vllm", vllmTemplate, vllmOldImage),
+			},
+			expectedDrift:  servingruntime.DriftImage,
+			expectedImpact: resultpkg.ImpactAdvisory,
+			expectedDetail: "container kserve-container runs " + vllmOldImage + ", template " + vllmTemplate + " ships " + vllmImage,
+		},
+		{
+			name: "TemplateRemoved",
+			objects: []*unstructured.Unstructured{
+				newTemplate("caikit-tgis-serving-template", "quay.io/caikit:v1"),
+				newServingRuntime("caikit", "caikit-tgis-serving-template", "quay.io/caikit:v1"),
+			},
+			expectedDrift:  servingruntime.DriftTemplateRemoved,
+			expectedImpact: resultpkg.ImpactBlocking,
+			expectedDetail: "template caikit-tgis-serving-template is removed in 3.x",
+		},
+		{
+			name: "TemplateRenamed",
+			objects: []*unstructured.Unstructured{
+				newServingRuntime("vllm", "vllm-runtime-template", vllmImage),
+			},
+			expectedDrift:  servingruntime.DriftTemplateRenamed,
+			expectedImpact: resultpkg.ImpactAdvisory,
+			expectedDetail: "template vllm-runtime-template is renamed to vllm-cuda-runtime-template in 3.x",
+		},
+		{
+			name: "TemplateNotFound",
+			objects: []*unstructured.Unstructured{
+				newServingRuntime("mine", "my-deleted-template", vllmImage),
+			},
+			expectedDrift:  servingruntime.DriftTemplateNotFound,
+			expectedImpact: resultpkg.ImpactAdvisory,
+			expectedDetail: "template my-deleted-template not found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			result, err := servingruntime.NewTemplateDriftCheck().Validate(t.Context(), newTarget(t, tc.objects...))
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(result.Status.Conditions).To(HaveLen(1))
+			g.Expect(result.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "1"))
+
+			condition := result.Status.Conditions[0]
+			g.Expect(condition.Impact).To(Equal(tc.expectedImpact))
+
+			if tc.expectedImpact == resultpkg.ImpactNone {
+				g.Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+				g.Expect(result.ImpactedObjects).To(BeEmpty())
+
+				return
+			}
+
+			g.Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+			g.Expect(result.ImpactedObjects).To(HaveLen(1))
+			g.Expect(condition.Message).To(ContainSubstring("team-a/" + result.ImpactedObjects[0].Name + ": " + tc.expectedDetail))
+			g.Expect(result.ImpactedObjects[0].Kind).To(Equal(resources.ServingRuntime.Kind))
+			g.Expect(result.ImpactedObjects[0].Annotations).To(And(
+				HaveKeyWithValue(servingruntime.AnnotationDrift, string(tc.expectedDrift)),
+				HaveKeyWithValue(servingruntime.AnnotationReason, tc.expectedDetail),
+			))
+		})
+	}
+}
+
+func TestTemplateDriftCheck_RemovedTemplateBlocks(t *testing.T) {
+	g := NewWithT(t)
+
+	target := newTarget(t,
+		newTemplate(vllmTemplate, vllmImage),
+		newServingRuntime("vllm", vllmTemplate, vllmOldImage),
+		newServingRuntime("ovms", "ovms", "quay.io/ovms:v1"),
+		newServingRuntime("current", vllmTemplate, vllmImage),
+	)
+
+	result, err := servingruntime.NewTemplateDriftCheck().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Reason":  Equal(check.ReasonFeatureRemoved),
+		"Message": HavePrefix("Found 2 of 3 ServingRuntime(s) drifting from their template:"),
+	}))
+	g.Expect(result.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactBlocking))
+	g.Expect(result.ImpactedObjects).To(HaveLen(2))
+}
+
+func TestTemplateDriftCheck_Metadata(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := servingruntime.NewTemplateDriftCheck()
+
+	g.Expect(chk.ID()).To(Equal("workloads.servingruntime.template-drift"))
+	g.Expect(chk.Name()).To(Equal("Workloads :: ServingRuntime :: Template Drift (3.x)"))
+	g.Expect(chk.Group()).To(Equal(check.GroupWorkload))
+	g.Expect(chk.Description()).ToNot(BeEmpty())
+}
+
+func TestTemplateDriftCheck_CanApply(t *testing.T) {
+	tests := []struct {
+		name           string
+		currentVersion string
+		targetVersion  string
+		kserve         string
+		expected       bool
+	}{
+		{
+			name:           "LintMode_SameVersion",
+			currentVersion: "2.25.0",
+			targetVersion:  "2.25.0",
+			kserve:         "Managed",
+			expected:       false,
+		},
+		{
+			name:           "Upgrade2xTo3x_Managed",
+			currentVersion: "2.25.0",
+			targetVersion:  "3.0.0",
+			kserve:         "Managed",
+			expected:       true,
+		},
+		{
+			name:           "Upgrade2xTo3x_Removed",
+			currentVersion: "2.25.0",
+			targetVersion:  "3.0.0",
+			kserve:         "Removed",
+			expected:       false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			target := testutil.NewTarget(t, testutil.TargetConfig{
+				ListKinds:      listKinds,
+				Objects:        []*unstructured.Unstructured{testutil.NewDSC(map[string]string{"kserve": tc.kserve})},
+				CurrentVersion: tc.currentVersion,
+				TargetVersion:  tc.targetVersion,
+			})
+
+			canApply, err := servingruntime.NewTemplateDriftCheck().CanApply(t.Context(), target)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(canApply).To(Equal(tc.expected))
+		})
+	}
+}
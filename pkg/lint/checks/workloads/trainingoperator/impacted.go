@@ -21,6 +21,29 @@ const (
 	ConditionTypePyTorchJobsCompatible = "PyTorchJobsCompatible"
 )
 
+// Examples rendered by 'lint explain'.
+const (
+	impactedFailingExample = `apiVersion: kubeflow.org/v1
+kind: PyTorchJob
+metadata:
+  name: finetune
+  namespace: team-a
+status:
+  conditions:
+    - type: Running
+      status: "True"`
+
+	impactedPassingExample = `apiVersion: kubeflow.org/v1
+kind: PyTorchJob
+metadata:
+  name: finetune
+  namespace: team-a
+status:
+  conditions:
+    - type: Succeeded
+      status: "True"`
+)
+
 type ImpactedWorkloadsCheck struct {
 	check.BaseCheck
 }
@@ -35,6 +58,22 @@ func NewImpactedWorkloadsCheck() *ImpactedWorkloadsCheck {
 			CheckName:        "Workloads :: TrainingOperator :: Impacted Workloads (3.3+)",
 			CheckDescription: "Lists PyTorchJobs using deprecated TrainingOperator (Kubeflow v1) that will be impacted by transition to Trainer v2",
 			CheckRemediation: "Complete or delete active PyTorchJobs before upgrading; plan migration to Trainer v2 API",
+			CheckResources: []resources.ResourceType{
+				resources.DataScienceCluster,
+				resources.PyTorchJob,
+			},
+			CheckVersionGate:    check.VersionGateTarget33,
+			CheckKnowledgeLinks: []string{check.DocsDistributedWorkloads},
+			CheckDocumentation: check.Documentation{
+				Inspects:       "PyTorchJobs in all namespaces, split into active and completed jobs. The check only runs when the target version is 3.3 or later and TrainingOperator is Managed.",
+				Rationale:      "The Kubeflow v1 TrainingOperator is deprecated in favour of Trainer v2. Active PyTorchJobs may be interrupted by the transition, while completed jobs only need to be cleaned up or migrated to the TrainJob API.",
+				FailingExample: impactedFailingExample,
+				PassingExample: impactedPassingExample,
+				RemediationCommands: []string{
+					"kubectl get pytorchjobs --all-namespaces",
+					"kubectl delete pytorchjob <name> -n <namespace>",
+				},
+			},
 		},
 	}
 }
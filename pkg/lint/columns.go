@@ -0,0 +1,199 @@
+package lint
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/itchyny/gojq"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/printer/table"
+)
+
+// columnNone is shown for custom column expressions that yield nothing or null.
+const columnNone = "<none>"
+
+// builtinColumns maps the column names usable without an expression in --columns to
+// JQ expressions evaluated against a DiagnosticResult.
+//
+//nolint:gochecknoglobals // Fixed set of named columns
+var builtinColumns = map[string]string{
+	"GROUP":       ".group",
+	"KIND":        ".kind",
+	"CHECK":       ".name",
+	"STATUS":      `[.status.conditions[].impact] | if any(. == "blocking") then "fail" elif any(. == "advisory") then "warn" else "pass" end`,
+	"IMPACT":      `[.status.conditions[].impact] | if any(. == "blocking") then "blocking" elif any(. == "advisory") then "advisory" else "none" end`,
+	"MESSAGE":     `[.status.conditions[].message] | join("\n")`,
+	"COUNT":       `.impactedObjects // [] | length`,
+	"DESCRIPTION": ".spec.description",
+	"REMEDIATION": `[.status.conditions[].remediation // empty] | first // ""`,
+}
+
+// CustomColumn is a table column defined by --columns.
+type CustomColumn struct {
+	// Name is the column header.
+	Name string
+
+	// Expression is the JQ expression evaluated against each DiagnosticResult.
+	Expression string
+}
+
+// ParseColumns parses a kubectl-style custom columns spec: a comma-separated list of
+// NAME:EXPRESSION pairs, where EXPRESSION is a JQ expression evaluated against each
+// DiagnosticResult. NAME alone selects a built-in column (e.g. "CHECK,STATUS,IMPACT,COUNT").
+// Commas inside brackets, parentheses or strings do not separate columns.
+func ParseColumns(spec string) ([]CustomColumn, error) {
+	entries := splitColumns(spec)
+	if len(entries) == 0 {
+		return nil, errors.New("no columns specified")
+	}
+
+	columns := make([]CustomColumn, 0, len(entries))
+
+	for _, entry := range entries {
+		name, expression, hasExpression := strings.Cut(entry, ":")
+		name = strings.TrimSpace(name)
+		expression = strings.TrimSpace(expression)
+
+		if name == "" {
+			return nil, fmt.Errorf("invalid column %q: missing name", entry)
+		}
+
+		if !hasExpression {
+			builtin, ok := builtinColumns[strings.ToUpper(name)]
+			if !ok {
+				return nil, fmt.Errorf("unknown column %q: use NAME:EXPRESSION or one of %s", name, builtinColumnNames())
+			}
+
+			name = strings.ToUpper(name)
+			expression = builtin
+		}
+
+		if expression == "" {
+			return nil, fmt.Errorf("invalid column %q: missing expression", entry)
+		}
+
+		if slices.ContainsFunc(columns, func(c CustomColumn) bool { return strings.EqualFold(c.Name, name) }) {
+			return nil, fmt.Errorf("duplicate column %q", name)
+		}
+
+		if _, err := gojq.Parse(expression); err != nil {
+			return nil, fmt.Errorf("invalid expression for column %q: %w", name, err)
+		}
+
+		columns = append(columns, CustomColumn{Name: name, Expression: expression})
+	}
+
+	return columns, nil
+}
+
+// splitColumns splits spec on commas outside of brackets, parentheses, braces and strings.
+func splitColumns(spec string) []string {
+	var (
+		entries []string
+		current strings.Builder
+		depth   int
+		quoted  bool
+		escaped bool
+	)
+
+	flush := func() {
+		if entry := strings.TrimSpace(current.String()); entry != "" {
+			entries = append(entries, entry)
+		}
+
+		current.Reset()
+	}
+
+	for _, r := range spec {
+		switch {
+		case escaped:
+			escaped = false
+		case quoted && r == '\\':
+			escaped = true
+		case r == '"':
+			quoted = !quoted
+		case quoted:
+		case r == '(' || r == '[' || r == '{':
+			depth++
+		case r == ')' || r == ']' || r == '}':
+			depth--
+		case r == ',' && depth == 0:
+			flush()
+
+			continue
+		}
+
+		current.WriteRune(r)
+	}
+
+	flush()
+
+	return entries
+}
+
+// builtinColumnNames returns the built-in column names, sorted.
+func builtinColumnNames() string {
+	names := make([]string, 0, len(builtinColumns))
+	for name := range builtinColumns {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return strings.Join(names, ", ")
+}
+
+// formatColumnValue renders a JQ result for a table cell.
+func formatColumnValue(value any) any {
+	switch v := value.(type) {
+	case string, bool, int, float64:
+		return v
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return err.Error()
+		}
+
+		return string(data)
+	}
+}
+
+// outputCustomColumns renders one row per check result with the given columns.
+func outputCustomColumns(out io.Writer, results []check.CheckExecution, columns []CustomColumn) error {
+	headers := make([]string, 0, len(columns))
+	opts := []table.Option[*result.DiagnosticResult]{
+		table.WithWriter[*result.DiagnosticResult](out),
+		table.WithTableOptions[*result.DiagnosticResult](table.DefaultTableOptions...),
+	}
+
+	for _, col := range columns {
+		headers = append(headers, col.Name)
+
+		// Collect the outputs so an empty or null result shows as <none>, as kubectl does.
+		query := fmt.Sprintf(`[%s] | if length == 0 or .[0] == null then %q else .[0] end`, col.Expression, columnNone)
+		opts = append(opts, table.WithFormatter[*result.DiagnosticResult](col.Name,
+			table.ChainFormatters(table.JQFormatter(query), formatColumnValue)))
+	}
+
+	opts = append(opts, table.WithHeaders[*result.DiagnosticResult](headers...))
+	renderer := table.NewRenderer(opts...)
+
+	for _, exec := range results {
+		if err := renderer.Append(exec.Result); err != nil {
+			return fmt.Errorf("appending table row: %w", err)
+		}
+	}
+
+	if err := renderer.Render(); err != nil {
+		return fmt.Errorf("rendering table: %w", err)
+	}
+
+	return nil
+}
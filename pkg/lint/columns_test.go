@@ -0,0 +1,68 @@
+package lint_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestParseColumns(t *testing.T) {
+	g := NewWithT(t)
+
+	columns, err := lint.ParseColumns(`check,STATUS,NS:[.impactedObjects[].metadata.namespace] | unique | join(","),FIRST:.impactedObjects[0].metadata.name`)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(columns).To(HaveLen(4))
+
+	g.Expect(columns[0].Name).To(Equal("CHECK"))
+	g.Expect(columns[0].Expression).To(Equal(".name"))
+	g.Expect(columns[1].Name).To(Equal("STATUS"))
+	g.Expect(columns[2].Name).To(Equal("NS"))
+	g.Expect(columns[2].Expression).To(Equal(`[.impactedObjects[].metadata.namespace] | unique | join(",")`))
+	g.Expect(columns[3].Expression).To(Equal(".impactedObjects[0].metadata.name"))
+}
+
+func TestParseColumns_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    string
+		wantErr string
+	}{
+		{name: "empty", spec: " , ", wantErr: "no columns specified"},
+		{name: "unknown builtin", spec: "CHECK,OWNER", wantErr: `unknown column "OWNER"`},
+		{name: "missing name", spec: ":.name", wantErr: "missing name"},
+		{name: "missing expression", spec: "NAME:", wantErr: "missing expression"},
+		{name: "invalid expression", spec: "NAME:.name |", wantErr: "invalid expression"},
+		{name: "duplicate", spec: "CHECK,check:.name", wantErr: "duplicate column"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			_, err := lint.ParseColumns(tt.spec)
+			g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+		})
+	}
+}
+
+func TestOutputTable_CustomColumns(t *testing.T) {
+	g := NewWithT(t)
+
+	columns, err := lint.ParseColumns("CHECK,STATUS,IMPACT,COUNT,OWNER:.annotations.owner")
+	g.Expect(err).ToNot(HaveOccurred())
+
+	var out bytes.Buffer
+
+	err = lint.OutputTable(&out, assignmentResults(), lint.TableOutputOptions{Columns: columns})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	output := out.String()
+	g.Expect(output).To(ContainSubstring("CHECK"))
+	g.Expect(output).To(ContainSubstring("COUNT"))
+	g.Expect(output).To(MatchRegexp(`impacted-workloads\s+fail\s+blocking\s+5\s+<none>`))
+	g.Expect(output).ToNot(ContainSubstring("check failed"))
+	g.Expect(output).To(ContainSubstring("Total: 1 | Passed: 0 | Warnings: 0 | Failed: 1"))
+}
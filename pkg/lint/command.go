@@ -4,13 +4,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
 
 	"github.com/blang/semver/v4"
 	"github.com/spf13/pflag"
 
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 
+	"github.com/opendatahub-io/odh-cli/pkg/backup"
 	"github.com/opendatahub-io/odh-cli/pkg/cmd"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
 	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
@@ -20,23 +26,44 @@ import (
 	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/components/kserve"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/components/kueue"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/components/modelmesh"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/components/platform"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/components/trainingoperator"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/dependencies/authorino"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/dependencies/certmanager"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/dependencies/etcd"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/dependencies/gatewayapi"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/dependencies/openshift"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/dependencies/operatorskew"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/dependencies/operatorsubscription"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/dependencies/servicemeshoperator"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/dependencies/storageclass"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/dependencies/trustedca"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/services/managedservice"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/services/monitoring"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/services/servicemesh"
 	codeflareworkloads "github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/codeflare"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/crossnamespace"
 	datasciencepipelinesworkloads "github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/datasciencepipelines"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/gpu"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/guardrails"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/inferenceservice"
 	kserveworkloads "github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/kserve"
 	llamastackworkloads "github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/llamastack"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/notebook"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/podsecurity"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/ray"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/servingruntime"
 	trainingoperatorworkloads "github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/trainingoperator"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/history"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/plugin"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/record"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/telemetry"
 	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/rules"
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
 	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
 	"github.com/opendatahub-io/odh-cli/pkg/util/kube/discovery"
+	"github.com/opendatahub-io/odh-cli/pkg/util/registry"
 	"github.com/opendatahub-io/odh-cli/pkg/util/version"
 )
 
@@ -50,14 +77,175 @@ type Command struct {
 	// TargetVersion is the optional target version for upgrade assessment.
 	// If empty, runs in lint mode (validates current state).
 	// If set, runs in upgrade mode (assesses upgrade readiness to target version).
+	// A comma-separated list assesses each target version in one run.
 	TargetVersion string
 
+	// AllSupportedTargets assesses every supported target version newer than the current
+	// version in one run.
+	AllSupportedTargets bool
+
+	// RemediationScript is the optional path of a shell script to generate from the
+	// machine-applicable remediation commands of failing checks.
+	RemediationScript string
+
+	// Assignments is the optional path of a file mapping namespaces to owning teams and deadlines.
+	Assignments string
+
+	// ExportImpacted is the optional directory to write per-check worklists of impacted objects to.
+	ExportImpacted string
+
+	// ExportFormat is the file format of the ExportImpacted worklists (csv, json).
+	ExportFormat WorklistFormat
+
+	// ExportGroupBy is the optional namespace annotation whose value groups the ExportImpacted
+	// worklists into per-owner directories (e.g. openshift.io/requester).
+	ExportGroupBy string
+
+	// assignments is the parsed Assignments file.
+	assignments *Assignments
+
+	// Config is the optional path of a lint configuration file overriding check impacts,
+	// disabling checks and setting check parameters.
+	Config string
+
+	// Columns is the optional custom columns spec for table output, e.g. "CHECK,STATUS,IMPACT,COUNT".
+	Columns string
+
+	// columns is the parsed Columns spec.
+	columns []CustomColumn
+
+	// ShowTimings adds the duration and Kubernetes API request count of each check to the table output.
+	ShowTimings bool
+
+	// DB is the optional path of the run history database the run is recorded in.
+	DB string
+
+	// Record writes the results of the run to a ConfigMap in RecordNamespace (lint history).
+	Record bool
+
+	// RecordNamespace is the namespace runs are recorded in, the operator namespace by default.
+	RecordNamespace string
+
+	// Plan prints which checks would run or be skipped, and why, without executing them.
+	Plan bool
+
+	// Explain is the ID of a check whose documentation is printed instead of running checks.
+	Explain string
+
+	// RetryUnknown retries checks that returned Unknown because of transient API errors
+	// once at the end of the run.
+	RetryUnknown bool
+
+	// Coverage prints which discovered resource types and components were assessed
+	// by at least one applicable check.
+	Coverage bool
+
+	// Telemetry posts anonymized check statistics of the run to TelemetryEndpoint.
+	Telemetry bool
+
+	// TelemetryEndpoint is the URL telemetry reports are posted to.
+	TelemetryEndpoint string
+
+	// MetricsFile is the optional path check results are written to as Prometheus metrics,
+	// for the node_exporter textfile collector.
+	MetricsFile string
+
+	// PushgatewayURL is the optional URL of a Prometheus Pushgateway check results are pushed to.
+	PushgatewayURL string
+
+	// SummaryFile is the optional path of a JSON run summary written regardless of --output.
+	SummaryFile string
+
+	// Save is the optional path the results of the run are saved to as JSON, for a later --diff.
+	Save string
+
+	// Diff is the optional path of results saved by a previous run; only the checks whose
+	// results changed since then are output.
+	Diff string
+
+	// previous is the parsed Diff results, or in watch mode the results of the previous run.
+	previous *resultpkg.DiagnosticResultList
+
+	// Watch re-runs the checks every WatchInterval, or when the DataScienceCluster or
+	// DSCInitialization changes, printing only the results that changed, until interrupted.
+	Watch bool
+
+	// WatchInterval is the time between two runs in watch mode.
+	WatchInterval time.Duration
+
+	// lastResults are the results written by the last run, in watch mode.
+	lastResults *resultpkg.DiagnosticResultList
+
+	// startedAt is the start time of the run, for the run summary.
+	startedAt time.Time
+
+	// Fix applies the remediation of failing checks implementing check.Fixable, after a preview
+	// and confirmation.
+	Fix bool
+
+	// FixDryRun previews the changes --fix would make without making them.
+	FixDryRun bool
+
+	// Yes skips the confirmation prompts of --fix.
+	Yes bool
+
+	// CurrentVersion is the version the --from-backup backup or --from-snapshot snapshot was
+	// taken from, used when it cannot be detected from them.
+	CurrentVersion string
+
+	// parsedCurrentVersion is the parsed CurrentVersion.
+	parsedCurrentVersion *semver.Version
+
+	// InspectRegistry classifies custom workbench images from their metadata in the image
+	// registry, instead of asking users to verify them manually.
+	InspectRegistry bool
+
+	// RegistryConfig is the optional Docker config.json with the credentials --inspect-registry
+	// uses in addition to the cluster global pull secret.
+	RegistryConfig string
+
+	// imageRegistry is the registry client of --inspect-registry (populated during Run)
+	imageRegistry *registry.Client
+
+	// Plugins registers the checks of the odh-lint-check-* plugin executables on PATH.
+	Plugins bool
+
+	// PluginTimeout bounds each invocation of a plugin that declares no timeout of its own.
+	PluginTimeout time.Duration
+
+	// ChecksDir is a directory of YAML check definitions registered with the built-in checks.
+	ChecksDir string
+
+	// telemetryPreview prints the telemetry report instead of the results (telemetry preview).
+	telemetryPreview bool
+
+	// gitOpsComment posts the results as a pull request comment instead of printing them (gitops-comment).
+	gitOpsComment *GitOpsCommentCommand
+
+	// httpClient posts telemetry reports and pushes metrics.
+	httpClient *http.Client
+
 	// parsedTargetVersion is the parsed semver version (upgrade mode only)
 	parsedTargetVersion *semver.Version
 
+	// parsedTargetVersions are the parsed target versions when several are assessed in one run,
+	// in ascending order
+	parsedTargetVersions []semver.Version
+
 	// currentClusterVersion stores the detected cluster version (populated during Run)
 	currentClusterVersion string
 
+	// flavor is the detected management flavor (populated during Run)
+	flavor version.Flavor
+
+	// cache serves the reads of the checks of a run from memory, so each resource is fetched
+	// from the API server once per run (populated during Run)
+	cache *client.CachingReader
+
+	// assessedTarget is the target component and service checks are executed against,
+	// used to explain why selected checks were skipped (populated during Run)
+	assessedTarget check.Target
+
 	// registry is the check registry for this command instance.
 	// Explicitly populated to avoid global state and enable test isolation.
 	registry *check.CheckRegistry
@@ -73,66 +261,148 @@ func NewCommand(
 	options ...CommandOption,
 ) *Command {
 	shared := NewSharedOptions(streams, configFlags)
+	registry := NewRegistry()
+
+	c := &Command{
+		SharedOptions:   shared,
+		RetryUnknown:    true,
+		WatchInterval:   DefaultWatchInterval,
+		ExportFormat:    WorklistFormatCSV,
+		PluginTimeout:   plugin.DefaultTimeout,
+		RecordNamespace: record.DefaultNamespace,
+		registry:        registry,
+	}
+
+	// Apply functional options
+	for _, opt := range options {
+		opt(c)
+	}
+
+	return c
+}
+
+// NewRegistry creates a check registry populated with all lint checks.
+// Shared by the lint command, its subcommands (e.g. lint graph) and doctor permissions.
+func NewRegistry() *check.CheckRegistry {
 	registry := check.NewRegistry()
 
 	// Explicitly register all checks (no global state, full test isolation)
-	// Components (9)
+	// Components (12)
 	registry.MustRegister(codeflare.NewRemovalCheck())
 	registry.MustRegister(dashboard.NewAcceleratorProfileMigrationCheck())
+	registry.MustRegister(dashboard.NewCustomResourcesMigrationCheck())
 	registry.MustRegister(dashboard.NewHardwareProfileMigrationCheck())
 	registry.MustRegister(datasciencepipelines.NewRenamingCheck())
 	registry.MustRegister(kserve.NewServerlessRemovalCheck())
 	registry.MustRegister(kueue.NewManagementStateCheck())
 	registry.MustRegister(kueue.NewOperatorInstalledCheck())
 	registry.MustRegister(modelmesh.NewRemovalCheck())
+	registry.MustRegister(platform.NewDeprecatedFieldsCheck())
+	registry.MustRegister(platform.NewReleaseSkewCheck())
 	registry.MustRegister(trainingoperator.NewDeprecationCheck())
 
-	// Dependencies (3)
+	// Dependencies (11)
+	registry.MustRegister(authorino.NewCheck())
 	registry.MustRegister(certmanager.NewCheck())
+	registry.MustRegister(certmanager.NewVersionCheck())
+	registry.MustRegister(etcd.NewObjectCountCheck())
+	registry.MustRegister(gatewayapi.NewCheck())
 	registry.MustRegister(openshift.NewCheck())
+	registry.MustRegister(operatorskew.NewVersionSkewCheck())
+	registry.MustRegister(operatorsubscription.NewCheck())
 	registry.MustRegister(servicemeshoperator.NewCheck())
+	registry.MustRegister(storageclass.NewCheck())
+	registry.MustRegister(trustedca.NewPropagationCheck())
 
-	// Services (1)
+	// Services (4)
 	registry.MustRegister(servicemesh.NewRemovalCheck())
+	registry.MustRegister(managedservice.NewAddonParametersCheck())
+	registry.MustRegister(managedservice.NewHiveNamespacesCheck())
+	registry.MustRegister(monitoring.NewMigrationReadinessCheck())
 
-	// Workloads (13)
+	// Workloads (24)
 	registry.MustRegister(codeflareworkloads.NewImpactedWorkloadsCheck())
+	registry.MustRegister(crossnamespace.NewReferencesCheck())
 	registry.MustRegister(datasciencepipelinesworkloads.NewInstructLabRemovalCheck())
 	registry.MustRegister(datasciencepipelinesworkloads.NewStoredVersionRemovalCheck())
+	registry.MustRegister(gpu.NewSchedulingCheck())
+	registry.MustRegister(guardrails.NewDetectorImagesCheck())
 	registry.MustRegister(guardrails.NewImpactedWorkloadsCheck())
 	registry.MustRegister(guardrails.NewOtelMigrationCheck())
+	registry.MustRegister(inferenceservice.NewImpactedWorkloadsCheck())
 	registry.MustRegister(kserveworkloads.NewInferenceServiceConfigCheck())
 	registry.MustRegister(kserveworkloads.NewAcceleratorMigrationCheck())
 	registry.MustRegister(kserveworkloads.NewImpactedWorkloadsCheck())
+	registry.MustRegister(kserveworkloads.NewRuntimeProtocolCheck())
 	registry.MustRegister(llamastackworkloads.NewConfigCheck())
 	registry.MustRegister(notebook.NewAcceleratorMigrationCheck())
+	registry.MustRegister(notebook.NewDedicatedNodesCheck())
+	registry.MustRegister(notebook.NewImageTagRefreshCheck())
 	registry.MustRegister(notebook.NewImpactedWorkloadsCheck())
+	registry.MustRegister(notebook.NewStorageCheck())
+	registry.MustRegister(podsecurity.NewAdmissionCheck())
 	registry.MustRegister(ray.NewImpactedWorkloadsCheck())
+	registry.MustRegister(ray.NewKueueQueuesCheck())
+	registry.MustRegister(servingruntime.NewTemplateDriftCheck())
 	registry.MustRegister(trainingoperatorworkloads.NewImpactedWorkloadsCheck())
 
-	c := &Command{
-		SharedOptions: shared,
-		registry:      registry,
-	}
-
-	// Apply functional options
-	for _, opt := range options {
-		opt(c)
-	}
-
-	return c
+	return registry
 }
 
 // AddFlags registers command-specific flags with the provided FlagSet.
 func (c *Command) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&c.TargetVersion, "target-version", "", flagDescTargetVersion)
-	fs.StringVarP((*string)(&c.OutputFormat), "output", "o", string(OutputFormatTable), flagDescOutput)
+	fs.BoolVar(&c.AllSupportedTargets, "all-supported-targets", false, flagDescAllTargets)
+	fs.StringArrayVarP(&c.OutputSpecs, "output", "o", nil, flagDescOutput)
 	fs.StringArrayVar(&c.CheckSelectors, "checks", []string{"*"}, flagDescChecks)
+	fs.StringVar(&c.ChecksFromFile, "checks-from-file", "", flagDescChecksFromFile)
 	fs.BoolVar(&c.FailOnCritical, "fail-on-critical", true, flagDescFailCritical)
 	fs.BoolVar(&c.FailOnWarning, "fail-on-warning", false, flagDescFailWarning)
+	cmd.FailOnVar(fs, &c.FailOnCritical, &c.FailOnWarning, flagDescFailOn)
 	fs.BoolVarP(&c.Verbose, "verbose", "v", false, flagDescVerbose)
 	fs.BoolVar(&c.Debug, "debug", false, flagDescDebug)
 	fs.DurationVar(&c.Timeout, "timeout", c.Timeout, flagDescTimeout)
+	fs.IntVar(&c.Concurrency, "concurrency", c.Concurrency, flagDescConcurrency)
+	fs.DurationVar(&c.CheckTimeout, "check-timeout", 0, flagDescCheckTimeout)
+	fs.BoolVar(&c.Strict, "strict", false, flagDescStrict)
+	fs.BoolVar(&c.Probe, "probe", false, flagDescProbe)
+	fs.StringSliceVar(&c.ImageStreamNamespaces, "imagestream-namespace", nil, flagDescImageStreamNamespace)
+	fs.BoolVar(&c.InspectRegistry, "inspect-registry", false, flagDescInspectRegistry)
+	fs.StringVar(&c.RegistryConfig, "registry-config", "", flagDescRegistryConfig)
+	fs.BoolVar(&c.Plugins, "plugins", false, flagDescPlugins)
+	fs.DurationVar(&c.PluginTimeout, "plugin-timeout", c.PluginTimeout, flagDescPluginTimeout)
+	fs.StringVar(&c.ChecksDir, "checks-dir", "", flagDescChecksDir)
+	fs.BoolVar(&c.ShowTimings, "show-timings", false, flagDescShowTimings)
+	fs.BoolVar(&c.Trace, "trace", false, flagDescTrace)
+	fs.StringVar(&c.RemediationScript, "emit-remediation-script", "", flagDescRemediation)
+	fs.BoolVar(&c.Coverage, "coverage", false, flagDescCoverage)
+	fs.StringVar(&c.Assignments, "assignments", "", flagDescAssignments)
+	fs.StringVar(&c.ExportImpacted, "export-impacted", "", flagDescExportImpacted)
+	fs.StringVar((*string)(&c.ExportFormat), "export-format", string(WorklistFormatCSV), flagDescExportFormat)
+	fs.StringVar(&c.ExportGroupBy, "export-group-by", "", flagDescExportGroupBy)
+	fs.StringVar(&c.Config, "config", "", flagDescConfig)
+	fs.StringVar(&c.Columns, "columns", "", flagDescColumns)
+	fs.StringVar(&c.DB, "db", "", flagDescDB)
+	fs.BoolVar(&c.Record, "record", false, flagDescRecord)
+	fs.StringVar(&c.RecordNamespace, "record-namespace", c.RecordNamespace, flagDescRecordNamespace)
+	fs.BoolVar(&c.Plan, "plan", false, flagDescPlan)
+	fs.StringVar(&c.Explain, "explain", "", flagDescExplain)
+	fs.BoolVar(&c.RetryUnknown, "retry-unknown", c.RetryUnknown, flagDescRetryUnknown)
+	fs.BoolVar(&c.Telemetry, "telemetry", false, flagDescTelemetry)
+	fs.StringVar(&c.TelemetryEndpoint, "telemetry-endpoint", "", flagDescTelemetryEndpoint)
+	fs.StringVar(&c.SummaryFile, "summary-file", "", flagDescSummaryFile)
+	fs.StringVar(&c.MetricsFile, "metrics-file", "", flagDescMetricsFile)
+	fs.StringVar(&c.PushgatewayURL, "pushgateway-url", "", flagDescPushgatewayURL)
+	fs.StringVar(&c.Save, "save", "", flagDescSave)
+	fs.StringVar(&c.Diff, "diff", "", flagDescDiff)
+	fs.BoolVar(&c.Watch, "watch", false, flagDescWatch)
+	fs.DurationVar(&c.WatchInterval, "watch-interval", c.WatchInterval, flagDescWatchInterval)
+	fs.BoolVar(&c.Fix, "fix", false, flagDescFix)
+	fs.BoolVar(&c.FixDryRun, "dry-run", false, flagDescFixDryRun)
+	fs.BoolVarP(&c.Yes, "yes", "y", false, flagDescFixYes)
+	fs.StringVar(&c.FromBackup, "from-backup", "", flagDescFromBackup)
+	fs.StringVar(&c.FromSnapshot, "from-snapshot", "", flagDescFromSnapshot)
+	fs.StringVar(&c.CurrentVersion, "current-version", "", flagDescCurrentVersion)
 
 	// Throttling settings
 	fs.Float32Var(&c.QPS, "qps", c.QPS, flagDescQPS)
@@ -141,6 +411,11 @@ func (c *Command) AddFlags(fs *pflag.FlagSet) {
 
 // Complete populates Options and performs pre-validation setup.
 func (c *Command) Complete() error {
+	// Explaining a check does not contact the cluster
+	if c.Explain != "" {
+		return nil
+	}
+
 	// Complete shared options (creates client)
 	if err := c.SharedOptions.Complete(); err != nil {
 		return fmt.Errorf("completing shared options: %w", err)
@@ -151,46 +426,254 @@ func (c *Command) Complete() error {
 		c.IO = iostreams.NewQuietWrapper(c.IO)
 	}
 
+	// Use compatibility data from an installed rules bundle, if any
+	if _, err := rules.ApplyInstalled(); err != nil {
+		c.IO.Errorf("Warning: ignoring installed rules bundle: %v", err)
+	}
+
+	if c.Assignments != "" {
+		assignments, err := LoadAssignments(c.Assignments)
+		if err != nil {
+			return err
+		}
+
+		c.assignments = assignments
+	}
+
+	if c.Config != "" {
+		if err := c.applyConfig(); err != nil {
+			return err
+		}
+	}
+
+	c.completeTelemetry()
+
+	if c.Diff != "" {
+		previous, err := LoadBaseline(c.Diff)
+		if err != nil {
+			return fmt.Errorf("loading --diff results: %w", err)
+		}
+
+		c.previous = previous
+	}
+
+	if c.Columns != "" {
+		columns, err := ParseColumns(c.Columns)
+		if err != nil {
+			return fmt.Errorf("invalid --columns: %w", err)
+		}
+
+		c.columns = columns
+	}
+
 	// Parse target version if provided (upgrade mode)
 	if c.TargetVersion != "" {
-		// Use ParseTolerant to accept partial versions (e.g., "3.0" → "3.0.0")
-		targetVer, err := semver.ParseTolerant(c.TargetVersion)
+		targets, err := parseTargetVersions(c.TargetVersion)
 		if err != nil {
-			return fmt.Errorf("invalid target version %q: %w", c.TargetVersion, err)
+			return err
+		}
+
+		if len(targets) == 1 {
+			c.parsedTargetVersion = &targets[0]
+		} else {
+			c.parsedTargetVersions = targets
 		}
-		c.parsedTargetVersion = &targetVer
 	}
 	// If no target version provided, we're in lint mode (will use current version)
 
+	if c.CurrentVersion != "" {
+		currentVer, err := semver.ParseTolerant(c.CurrentVersion)
+		if err != nil {
+			return fmt.Errorf("invalid current version %q: %w", c.CurrentVersion, err)
+		}
+		c.parsedCurrentVersion = &currentVer
+	}
+
+	// A snapshot records the version detected when it was taken
+	if c.parsedCurrentVersion == nil && c.snapshotManifest != nil && c.snapshotManifest.ClusterVersion != "" {
+		currentVer, err := semver.ParseTolerant(c.snapshotManifest.ClusterVersion)
+		if err != nil {
+			return fmt.Errorf("invalid snapshot cluster version %q: %w", c.snapshotManifest.ClusterVersion, err)
+		}
+		c.parsedCurrentVersion = &currentVer
+	}
+
 	return nil
 }
 
 // Validate checks that all required options are valid.
 func (c *Command) Validate() error {
+	if c.Explain != "" {
+		return nil
+	}
+
 	// Validate shared options
 	if err := c.SharedOptions.Validate(); err != nil {
 		return fmt.Errorf("validating shared options: %w", err)
 	}
 
+	if c.Telemetry && c.TelemetryEndpoint == "" {
+		return fmt.Errorf("--telemetry requires --telemetry-endpoint or the %s environment variable", telemetry.EnvEndpoint)
+	}
+
+	if (c.FixDryRun || c.Yes) && !c.Fix {
+		return errors.New("--dry-run and --yes require --fix")
+	}
+
+	if c.RegistryConfig != "" && !c.InspectRegistry {
+		return errors.New("--registry-config requires --inspect-registry")
+	}
+
+	for _, format := range []OutputFormat{OutputFormatJUnit, OutputFormatHTML, OutputFormatMarkdown} {
+		if c.Plan && c.writesFormat(format) {
+			return fmt.Errorf("--output %s is not supported with --plan", format)
+		}
+
+		if c.Diff != "" && c.writesFormat(format) {
+			return fmt.Errorf("--output %s is not supported with --diff", format)
+		}
+
+		if c.Watch && c.writesFormat(format) {
+			return fmt.Errorf("--output %s is not supported with --watch", format)
+		}
+	}
+
+	if err := c.validateWatch(); err != nil {
+		return err
+	}
+
+	if err := c.validateMultiTarget(); err != nil {
+		return err
+	}
+
+	if c.Plan && (c.Save != "" || c.Diff != "") {
+		return errors.New("--save and --diff are not supported with --plan")
+	}
+
+	if c.Plan && (c.MetricsFile != "" || c.PushgatewayURL != "") {
+		return errors.New("--metrics-file and --pushgateway-url are not supported with --plan")
+	}
+
+	if c.FromBackup != "" && c.FromSnapshot != "" {
+		return errors.New("--from-backup and --from-snapshot are mutually exclusive")
+	}
+
+	if c.Record && (c.Plan || c.FromBackup != "" || c.FromSnapshot != "") {
+		return errors.New("--record requires cluster access and is not supported with --plan, --from-backup or --from-snapshot")
+	}
+
+	if c.FromBackup != "" && (c.Fix || c.Coverage) {
+		return errors.New("--fix and --coverage require cluster access and are not supported with --from-backup")
+	}
+
+	if c.FromSnapshot != "" && (c.Fix || c.Coverage) {
+		return errors.New("--fix and --coverage require cluster access and are not supported with --from-snapshot")
+	}
+
+	if c.CurrentVersion != "" && c.FromBackup == "" && c.FromSnapshot == "" {
+		return errors.New("--current-version requires --from-backup or --from-snapshot")
+	}
+
+	return c.validateExport()
+}
+
+// validateExport validates the --export-impacted flags.
+func (c *Command) validateExport() error {
+	if c.ExportImpacted == "" {
+		if c.ExportGroupBy != "" {
+			return errors.New("--export-group-by requires --export-impacted")
+		}
+
+		return nil
+	}
+
+	if c.ExportFormat != WorklistFormatCSV && c.ExportFormat != WorklistFormatJSON {
+		return fmt.Errorf("invalid --export-format %q (must be '%s' or '%s')", c.ExportFormat, WorklistFormatCSV, WorklistFormatJSON)
+	}
+
+	if c.Plan {
+		return errors.New("--export-impacted is not supported with --plan")
+	}
+
 	return nil
 }
 
 // Run executes the lint command in either lint or upgrade mode.
 func (c *Command) Run(ctx context.Context) error {
+	// Plugin and declarative checks can be explained and selected like the built-in ones
+	if c.Plugins {
+		c.loadPlugins(ctx)
+	}
+
+	if c.ChecksDir != "" {
+		if err := c.loadCheckDefinitions(); err != nil {
+			return err
+		}
+	}
+
+	if c.Explain != "" {
+		explain := &ExplainCommand{IO: c.IO, CheckID: c.Explain, OutputFormat: ExplainOutputFormatText, registry: c.registry}
+		if err := explain.Complete(); err != nil {
+			return err
+		}
+
+		return explain.Run(ctx)
+	}
+
+	if c.Watch {
+		return c.runWatch(ctx)
+	}
+
+	return c.runOnce(ctx)
+}
+
+// runOnce runs the selected checks once and writes their results.
+func (c *Command) runOnce(ctx context.Context) error {
+	c.startedAt = time.Now()
+
 	// Create context with timeout to prevent hanging on slow clusters
 	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
 	defer cancel()
 
+	// Checks share one cache per run; watch runs start with an empty one
+	c.cache = client.NewCachingReader(c.Reader)
+	defer c.reportCache()
+
 	// Detect current cluster version (needed for both modes)
-	currentVersion, err := version.Detect(ctx, c.Client)
+	currentVersion, err := c.detectVersion(ctx)
 	if err != nil {
-		return fmt.Errorf("detecting cluster version: %w", err)
+		return err
 	}
 
 	// Store current version for output formatting
 	c.currentClusterVersion = currentVersion.String()
 
-	// Determine mode: upgrade (with --target-version) or lint (without --target-version)
+	// Managed cloud service installations gate checks and remediations; a failed detection
+	// only disables the flavor-specific behavior.
+	flavor, err := version.DetectFlavor(ctx, c.cache)
+	if err != nil {
+		c.IO.Errorf("Warning: failed to detect the management flavor: %v", err)
+	}
+
+	c.flavor = flavor
+
+	if c.InspectRegistry {
+		c.imageRegistry, err = c.newImageRegistry(ctx, c.cache)
+		if err != nil {
+			return err
+		}
+	}
+
+	if c.Plan {
+		return c.runPlan(ctx, currentVersion)
+	}
+
+	// Determine mode: upgrade against several targets, upgrade (with --target-version) or
+	// lint (without --target-version)
+	if c.multiTarget() {
+		return c.runMultiTarget(ctx, currentVersion)
+	}
+
 	if c.TargetVersion != "" {
 		return c.runUpgradeMode(ctx, currentVersion)
 	}
@@ -198,25 +681,72 @@ func (c *Command) Run(ctx context.Context) error {
 	return c.runLintMode(ctx, currentVersion)
 }
 
-// runLintMode validates current cluster state.
-func (c *Command) runLintMode(ctx context.Context, clusterVersion *semver.Version) error {
-	c.IO.Errorf("Detected OpenShift AI version: %s\n", clusterVersion.String())
+// applyConfig loads the --config file and applies it to the checks of the run.
+func (c *Command) applyConfig() error {
+	cfg, err := LoadConfig(c.Config)
+	if err != nil {
+		return err
+	}
 
-	// Discover components and services
-	c.IO.Errorf("Discovering OpenShift AI components and services...")
-	components, err := discovery.DiscoverComponentsAndServices(ctx, c.Client)
+	registry, overrides, err := cfg.Apply(c.registry)
 	if err != nil {
-		return fmt.Errorf("discovering components and services: %w", err)
+		return fmt.Errorf("applying config %s: %w", c.Config, err)
+	}
+
+	c.registry = registry
+	c.impactOverrides = overrides
+
+	return nil
+}
+
+// detectVersion returns the installed OpenShift AI version. A backup keeps no status, so
+// with --from-backup the version given by --current-version is used unless the backup holds
+// the operator ClusterServiceVersion.
+func (c *Command) detectVersion(ctx context.Context) (*semver.Version, error) {
+	currentVersion, err := version.Detect(ctx, c.cache)
+	if err == nil {
+		return currentVersion, nil
 	}
-	c.IO.Errorf("Found %d API groups", len(components))
-	for _, comp := range components {
-		c.IO.Errorf("  - %s/%s (%d resources)", comp.APIGroup, comp.Version, len(comp.Resources))
+
+	if c.parsedCurrentVersion != nil {
+		return c.parsedCurrentVersion, nil
+	}
+
+	if c.FromBackup != "" {
+		return nil, fmt.Errorf("detecting version from backup %s (set it with --current-version): %w", c.FromBackup, err)
+	}
+
+	if c.FromSnapshot != "" {
+		return nil, fmt.Errorf("detecting version from snapshot %s (set it with --current-version): %w", c.FromSnapshot, err)
+	}
+
+	return nil, fmt.Errorf("detecting cluster version: %w", err)
+}
+
+// runLintMode validates current cluster state.
+func (c *Command) runLintMode(ctx context.Context, clusterVersion *semver.Version) error {
+	c.IO.Errorf("Detected OpenShift AI version: %s (%s)\n", clusterVersion.String(), c.flavorDescription())
+
+	var components []discovery.ComponentAndService
+
+	// Discover components and services; a backup has no discovery API to query
+	if c.Client != nil {
+		c.IO.Errorf("Discovering OpenShift AI components and services...")
+		discovered, err := discovery.DiscoverComponentsAndServices(ctx, c.Client)
+		if err != nil {
+			return fmt.Errorf("discovering components and services: %w", err)
+		}
+		components = discovered
+		c.IO.Errorf("Found %d API groups", len(components))
+		for _, comp := range components {
+			c.IO.Errorf("  - %s/%s (%d resources)", comp.APIGroup, comp.Version, len(comp.Resources))
+		}
+		c.IO.Fprintln()
 	}
-	c.IO.Fprintln()
 
 	// Discover workloads
 	c.IO.Errorf("Discovering workload custom resources...")
-	workloads, err := discovery.DiscoverWorkloads(ctx, c.Client)
+	workloads, err := c.discoverWorkloads(ctx)
 	if err != nil {
 		return fmt.Errorf("discovering workloads: %w", err)
 	}
@@ -229,15 +759,20 @@ func (c *Command) runLintMode(ctx context.Context, clusterVersion *semver.Versio
 	// Execute component and service checks (Resource: nil)
 	c.IO.Errorf("Running component and service checks...")
 	componentTarget := check.Target{
-		Client:         c.Client,
-		CurrentVersion: clusterVersion, // For lint mode, current = target
-		TargetVersion:  clusterVersion,
-		Resource:       nil, // No specific resource for component/service checks
-		IO:             c.IO,
-		Debug:          c.Debug,
+		Client:                c.cache,
+		CurrentVersion:        clusterVersion, // For lint mode, current = target
+		TargetVersion:         clusterVersion,
+		Flavor:                c.flavor,
+		Resource:              nil, // No specific resource for component/service checks
+		IO:                    c.IO,
+		Debug:                 c.Debug,
+		Probe:                 c.Probe,
+		ImageStreamNamespaces: c.ImageStreamNamespaces,
+		Registry:              c.imageRegistry,
 	}
 
-	executor := check.NewExecutor(c.registry, c.IO)
+	c.assessedTarget = componentTarget
+	executor := c.NewExecutor(c.registry)
 
 	// Execute checks in canonical order: dependencies → services → components → workloads
 	// Store results by group for later organization
@@ -266,7 +801,7 @@ func (c *Command) runLintMode(ctx context.Context, clusterVersion *semver.Versio
 
 	for _, gvr := range workloads {
 		// List all instances of this workload type
-		instances, err := c.Client.ListResources(ctx, gvr)
+		instances, err := c.cache.ListResources(ctx, gvr)
 		if err != nil {
 			// Skip workloads we can't access
 			c.IO.Errorf("Warning: Failed to list %s: %v", gvr.Resource, err)
@@ -277,12 +812,16 @@ func (c *Command) runLintMode(ctx context.Context, clusterVersion *semver.Versio
 		// Run workload checks for each instance
 		for i := range instances {
 			workloadTarget := check.Target{
-				Client:         c.Client,
-				CurrentVersion: clusterVersion, // For lint mode, current = target
-				TargetVersion:  clusterVersion,
-				Resource:       instances[i],
-				IO:             c.IO,
-				Debug:          c.Debug,
+				Client:                c.cache,
+				CurrentVersion:        clusterVersion, // For lint mode, current = target
+				TargetVersion:         clusterVersion,
+				Flavor:                c.flavor,
+				Resource:              instances[i],
+				IO:                    c.IO,
+				Debug:                 c.Debug,
+				Probe:                 c.Probe,
+				ImageStreamNamespaces: c.ImageStreamNamespaces,
+				Registry:              c.imageRegistry,
 			}
 
 			results, err := executor.ExecuteSelective(ctx, workloadTarget, c.CheckSelectors, check.GroupWorkload)
@@ -297,18 +836,89 @@ func (c *Command) runLintMode(ctx context.Context, clusterVersion *semver.Versio
 	// Add workload results to the results map
 	resultsByGroup[check.GroupWorkload] = workloadResults
 
+	c.retryUnknown(ctx, executor, resultsByGroup)
+	c.applyFixes(ctx, executor, resultsByGroup)
+
+	if c.telemetryPreview {
+		return c.previewTelemetry(ctx, "", resultsByGroup)
+	}
+
+	if c.gitOpsComment != nil {
+		return c.gitOpsComment.publish(ctx, resultsByGroup)
+	}
+
 	// Format and output results based on output format
 	if err := c.formatAndOutputResults(ctx, resultsByGroup); err != nil {
 		return err
 	}
 
+	c.sendTelemetry(ctx, "", resultsByGroup)
+
+	if err := c.writeMetrics(ctx, "", resultsByGroup); err != nil {
+		return err
+	}
+
+	if c.Coverage {
+		dsc, err := c.getDataScienceCluster(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := c.writeCoverage(NewClusterSurface(components, workloads, dsc), resultsByGroup); err != nil {
+			return err
+		}
+	}
+
 	// Determine exit code based on fail-on flags
-	return c.determineExitCode(resultsByGroup)
+	gateErr := c.determineExitCode(resultsByGroup)
+
+	if err := c.writeSummary("", resultsByGroup, gateErr); err != nil {
+		return err
+	}
+
+	return gateErr
+}
+
+// discoverWorkloads returns the workload resource types to run workload checks against. With
+// --from-backup, these are the ODH resource types present in the backup, as the labeled CRDs
+// identifying workloads on a cluster are not part of it. With --from-snapshot, these are the
+// workload types discovered when the snapshot was taken.
+func (c *Command) discoverWorkloads(ctx context.Context) ([]schema.GroupVersionResource, error) {
+	if c.snapshotManifest != nil {
+		return c.snapshotManifest.WorkloadResources()
+	}
+
+	r, ok := c.Reader.(*backup.Reader)
+	if !ok {
+		return discovery.DiscoverWorkloads(ctx, c.Client)
+	}
+
+	var workloads []schema.GroupVersionResource
+
+	for _, gvr := range r.GroupVersionResources() {
+		if isBackupWorkload(gvr) {
+			workloads = append(workloads, gvr)
+		}
+	}
+
+	return workloads, nil
+}
+
+// isBackupWorkload reports whether a resource type of a backup is a workload: it belongs to an
+// ODH API group and is not a platform singleton or OLM resource.
+func isBackupWorkload(gvr schema.GroupVersionResource) bool {
+	switch gvr.GroupResource() {
+	case resources.DataScienceCluster.GVR().GroupResource(),
+		resources.DSCInitialization.GVR().GroupResource():
+		return false
+	}
+
+	return discovery.IsOpenShiftAIGroup(gvr.Group)
 }
 
 // runUpgradeMode assesses upgrade readiness for a target version.
 func (c *Command) runUpgradeMode(ctx context.Context, currentVersion *semver.Version) error {
-	c.IO.Errorf("Current OpenShift AI version: %s", currentVersion.String())
+	c.IO.Errorf("Current OpenShift AI version: %s (%s)", currentVersion.String(), c.flavorDescription())
 	c.IO.Errorf("Target OpenShift AI version: %s\n", c.TargetVersion)
 
 	// Check if target version is greater than or equal to current
@@ -319,20 +929,31 @@ func (c *Command) runUpgradeMode(ctx context.Context, currentVersion *semver.Ver
 
 	c.IO.Errorf("Assessing upgrade readiness: %s → %s\n", currentVersion.String(), c.TargetVersion)
 
+	if version.IsUpgradeWithin2x(currentVersion, c.parsedTargetVersion) {
+		c.IO.Errorf("Upgrade profile: z-stream (%s); select only its checks with --checks=%s\n",
+			check.VersionGateUpgradeWithin2x, check.SelectorZStream)
+	}
+
 	// Execute checks using target version for applicability filtering
 	c.IO.Errorf("Running upgrade compatibility checks...")
-	executor := check.NewExecutor(c.registry, c.IO)
+	executor := c.NewExecutor(c.registry)
 
 	// Create check target with BOTH current and target versions for upgrade checks
 	checkTarget := check.Target{
-		Client:         c.Client,
-		CurrentVersion: currentVersion,        // The version we're upgrading FROM
-		TargetVersion:  c.parsedTargetVersion, // The version we're upgrading TO
-		Resource:       nil,
-		IO:             c.IO,
-		Debug:          c.Debug,
+		Client:                c.cache,
+		CurrentVersion:        currentVersion,        // The version we're upgrading FROM
+		TargetVersion:         c.parsedTargetVersion, // The version we're upgrading TO
+		Flavor:                c.flavor,
+		Resource:              nil,
+		IO:                    c.IO,
+		Debug:                 c.Debug,
+		Probe:                 c.Probe,
+		ImageStreamNamespaces: c.ImageStreamNamespaces,
+		Registry:              c.imageRegistry,
 	}
 
+	c.assessedTarget = checkTarget
+
 	// Execute checks in canonical order: dependencies → services → components → workloads
 	resultsByGroup := make(map[check.CheckGroup][]check.CheckExecution)
 
@@ -345,38 +966,182 @@ func (c *Command) runUpgradeMode(ctx context.Context, currentVersion *semver.Ver
 		resultsByGroup[group] = results
 	}
 
+	c.retryUnknown(ctx, executor, resultsByGroup)
+	c.applyFixes(ctx, executor, resultsByGroup)
+
+	if c.telemetryPreview {
+		return c.previewTelemetry(ctx, c.TargetVersion, resultsByGroup)
+	}
+
+	if c.gitOpsComment != nil {
+		return c.gitOpsComment.publish(ctx, resultsByGroup)
+	}
+
 	// Format and output results
 	if err := c.formatAndOutputUpgradeResults(ctx, currentVersion.String(), resultsByGroup); err != nil {
 		return err
 	}
 
-	// Determine if upgrade is recommended
-	blockingIssues := 0
-	for _, executions := range resultsByGroup {
-		for _, exec := range executions {
-			impact := exec.Result.GetImpact()
-			if exec.Result.IsFailing() && impact != nil && *impact == string(resultpkg.ImpactBlocking) {
-				blockingIssues++
-			}
+	c.sendTelemetry(ctx, c.TargetVersion, resultsByGroup)
+
+	if err := c.writeMetrics(ctx, c.TargetVersion, resultsByGroup); err != nil {
+		return err
+	}
+
+	// Upgrade mode does not discover the cluster surface for its checks, so do it only for --coverage
+	if c.Coverage {
+		surface, err := c.discoverSurface(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := c.writeCoverage(surface, resultsByGroup); err != nil {
+			return err
 		}
 	}
 
-	if blockingIssues > 0 {
-		c.IO.Errorf("\n⚠️  Recommendation: Address %d blocking issue(s) before upgrading", blockingIssues)
+	// State whether the upgrade is recommended
+	readiness := resultpkg.NewReadinessSummary(diagnosticResults(FlattenResults(resultsByGroup)))
+	verdict := ReadinessVerdict(readiness, currentVersion.String(), c.TargetVersion)
+
+	if readiness.Ready() {
+		c.IO.Errorf("\n✅ %s", verdict)
 	} else {
-		c.IO.Errorf("\n✅ Cluster is ready for upgrade to %s", c.TargetVersion)
+		c.IO.Errorf("\n⚠️  %s; address them before upgrading", verdict)
 	}
 
 	// Determine exit code based on fail-on flags
-	return c.determineExitCode(resultsByGroup)
+	gateErr := c.determineExitCode(resultsByGroup)
+
+	if err := c.writeSummary(c.TargetVersion, resultsByGroup, gateErr); err != nil {
+		return err
+	}
+
+	return gateErr
+}
+
+// reportCache prints, in debug mode, how many reads of the run the cache served.
+func (c *Command) reportCache() {
+	if !c.Debug || c.cache == nil {
+		return
+	}
+
+	hits, misses := c.cache.Stats()
+	c.IO.Errorf("Served %d of %d Kubernetes API reads from the run cache", hits, hits+misses)
 }
 
-// determineExitCode returns an error if fail-on conditions are met.
+// flavorDescription describes the detected management flavor for the run header.
+func (c *Command) flavorDescription() string {
+	switch c.flavor {
+	case version.FlavorManaged:
+		return "managed cloud service"
+	case version.FlavorSelfManaged:
+		return "self-managed"
+	default:
+		return "management flavor unknown"
+	}
+}
+
+// retryUnknown gives checks that returned Unknown because of transient API errors one more
+// attempt, within the time left of the run, so that a busy API server does not leave gaps
+// in the report.
+func (c *Command) retryUnknown(
+	ctx context.Context,
+	executor *check.Executor,
+	resultsByGroup map[check.CheckGroup][]check.CheckExecution,
+) {
+	if !c.RetryUnknown {
+		return
+	}
+
+	retried := 0
+	recovered := 0
+
+	for _, group := range check.CanonicalGroupOrder {
+		r, ok := executor.RetryUnknown(ctx, resultsByGroup[group])
+		retried += r
+		recovered += ok
+	}
+
+	if retried > 0 {
+		c.IO.Errorf("Retried %d check(s) that returned Unknown because of transient API errors; %d recovered",
+			retried, recovered)
+	}
+}
+
+// runPlan prints which checks a run would execute or skip, without executing Validate.
+// CanApply is evaluated without a specific workload resource, as workloads are not discovered.
+func (c *Command) runPlan(ctx context.Context, currentVersion *semver.Version) error {
+	targetVersion := currentVersion
+	if c.parsedTargetVersion != nil {
+		targetVersion = c.parsedTargetVersion
+	}
+
+	c.IO.Errorf("Planning checks: %s → %s\n", currentVersion.String(), targetVersion.String())
+
+	plan, err := BuildPlan(ctx, c.registry, check.Target{
+		Client:                c.cache,
+		CurrentVersion:        currentVersion,
+		TargetVersion:         targetVersion,
+		Flavor:                c.flavor,
+		IO:                    c.IO,
+		Debug:                 c.Debug,
+		Probe:                 c.Probe,
+		ImageStreamNamespaces: c.ImageStreamNamespaces,
+		Registry:              c.imageRegistry,
+	}, c.CheckSelectors)
+	if err != nil {
+		return err
+	}
+
+	destinations, err := c.OutputDestinations()
+	if err != nil {
+		return err
+	}
+
+	for _, dest := range destinations {
+		render := func(out io.Writer) error {
+			return OutputPlan(out, dest.Format, plan)
+		}
+
+		if dest.Path == "" {
+			if err := render(c.IO.Out()); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if err := writeOutputFile(dest.Path, render); err != nil {
+			return fmt.Errorf("writing %s plan: %w", dest.Format, err)
+		}
+
+		c.IO.Errorf("Wrote %s plan to %s", dest.Format, dest.Path)
+	}
+
+	return nil
+}
+
+// determineExitCode returns the error determining the exit code of the run: an ExitError with
+// cmd.ExitCodeBlocking or cmd.ExitCodeAdvisory if fail-on conditions are met, or with
+// cmd.ExitCodePartial if checks failed to execute (with --strict, returned invalid results).
 func (c *Command) determineExitCode(resultsByGroup map[check.CheckGroup][]check.CheckExecution) error {
+	if c.Strict {
+		if err := strictViolations(resultsByGroup); err != nil {
+			return cmd.NewExitError(cmd.ExitCodePartial, err)
+		}
+	}
+
 	var hasBlocking, hasAdvisory bool
 
+	failed := 0
+
 	for _, results := range resultsByGroup {
 		for _, exec := range results {
+			if exec.Error != nil {
+				failed++
+			}
+
 			impact := exec.Result.GetImpact()
 			if impact != nil {
 				switch *impact {
@@ -392,16 +1157,38 @@ func (c *Command) determineExitCode(resultsByGroup map[check.CheckGroup][]check.
 	}
 
 	if c.FailOnCritical && hasBlocking {
-		return errors.New("blocking findings detected")
+		return cmd.NewExitError(cmd.ExitCodeBlocking, errors.New("blocking findings detected"))
+	}
+
+	if c.FailOnCritical && failed > 0 {
+		return cmd.NewExitError(cmd.ExitCodePartial, fmt.Errorf("%d check(s) failed to execute", failed))
 	}
 
 	if c.FailOnWarning && hasAdvisory {
-		return errors.New("advisory findings detected")
+		return cmd.NewExitError(cmd.ExitCodeAdvisory, errors.New("advisory findings detected"))
 	}
 
 	return nil
 }
 
+// strictViolations returns an error listing the checks whose results failed strict validation.
+func strictViolations(resultsByGroup map[check.CheckGroup][]check.CheckExecution) error {
+	var violations []string
+
+	for _, exec := range FlattenResults(resultsByGroup) {
+		if errors.Is(exec.Error, check.ErrInvalidResult) {
+			violations = append(violations, exec.Error.Error())
+		}
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("strict validation failed: %d check(s) returned invalid results:\n  %s",
+		len(violations), strings.Join(violations, "\n  "))
+}
+
 // formatAndOutputResults formats and outputs check results based on the output format.
 func (c *Command) formatAndOutputResults(
 	ctx context.Context,
@@ -415,40 +1202,59 @@ func (c *Command) formatAndOutputResults(
 
 	// Flatten results to sorted array
 	flatResults := FlattenResults(resultsByGroup)
+	c.applyAssignments(ctx, flatResults)
 
-	switch c.OutputFormat {
-	case OutputFormatTable:
-		return c.outputTable(ctx, flatResults)
-	case OutputFormatJSON:
-		if err := OutputJSON(c.IO.Out(), flatResults, clusterVer, targetVer); err != nil {
-			return fmt.Errorf("outputting JSON: %w", err)
-		}
+	if err := c.exportImpacted(ctx, flatResults); err != nil {
+		return err
+	}
 
-		return nil
-	case OutputFormatYAML:
-		if err := OutputYAML(c.IO.Out(), flatResults, clusterVer, targetVer); err != nil {
-			return fmt.Errorf("outputting YAML: %w", err)
-		}
+	if err := c.emitRemediationScript(flatResults, clusterVer, targetVer); err != nil {
+		return err
+	}
 
-		return nil
-	default:
-		return fmt.Errorf("unsupported output format: %s", c.OutputFormat)
+	if err := c.recordHistory(flatResults, clusterVer, targetVer); err != nil {
+		return err
 	}
+
+	if err := c.recordInCluster(ctx, flatResults, clusterVer, targetVer); err != nil {
+		return err
+	}
+
+	if err := c.saveResults(flatResults, clusterVer, targetVer); err != nil {
+		return err
+	}
+
+	if c.Watch {
+		c.lastResults = newResultList(flatResults, clusterVer, targetVer)
+	}
+
+	if c.previous != nil {
+		return c.writeDiff(flatResults)
+	}
+
+	return c.writeOutputs(flatResults, clusterVer, targetVer, func(out io.Writer) error {
+		return c.outputTable(ctx, out, flatResults)
+	})
 }
 
 // outputTable outputs results in table format.
-func (c *Command) outputTable(ctx context.Context, results []check.CheckExecution) error {
-	c.IO.Fprintln()
-	c.IO.Fprintln("Check Results:")
-	c.IO.Fprintln("==============")
-
-	opts := TableOutputOptions{ShowImpactedObjects: c.Verbose}
+func (c *Command) outputTable(ctx context.Context, out io.Writer, results []check.CheckExecution) error {
+	_, _ = fmt.Fprintln(out)
+	_, _ = fmt.Fprintln(out, "Check Results:")
+	_, _ = fmt.Fprintln(out, "==============")
+
+	opts := TableOutputOptions{
+		ShowImpactedObjects: c.Verbose,
+		ShowTeamRollup:      c.assignments != nil,
+		Columns:             c.columns,
+		ShowTimings:         c.ShowTimings,
+	}
 
 	if c.Verbose {
-		opts.NamespaceRequesters = collectNamespaceRequesters(ctx, c.Client, results)
+		opts.NamespaceRequesters = collectNamespaceRequesters(ctx, c.Reader, results)
 	}
 
-	if err := OutputTable(c.IO.Out(), results, opts); err != nil {
+	if err := OutputTable(out, results, opts); err != nil {
 		return fmt.Errorf("outputting table: %w", err)
 	}
 
@@ -466,42 +1272,145 @@ func (c *Command) formatAndOutputUpgradeResults(
 
 	// Flatten results to sorted array
 	flatResults := FlattenResults(resultsByGroup)
+	c.applyAssignments(ctx, flatResults)
 
-	switch c.OutputFormat {
-	case OutputFormatTable:
-		return c.outputUpgradeTable(ctx, currentVer, flatResults)
-	case OutputFormatJSON:
-		if err := OutputJSON(c.IO.Out(), flatResults, clusterVer, targetVer); err != nil {
-			return fmt.Errorf("outputting JSON: %w", err)
-		}
+	if err := c.exportImpacted(ctx, flatResults); err != nil {
+		return err
+	}
 
-		return nil
-	case OutputFormatYAML:
-		if err := OutputYAML(c.IO.Out(), flatResults, clusterVer, targetVer); err != nil {
-			return fmt.Errorf("outputting YAML: %w", err)
-		}
+	if err := c.emitRemediationScript(flatResults, clusterVer, targetVer); err != nil {
+		return err
+	}
 
-		return nil
-	default:
-		return fmt.Errorf("unsupported output format: %s", c.OutputFormat)
+	if err := c.recordHistory(flatResults, clusterVer, targetVer); err != nil {
+		return err
+	}
+
+	if err := c.recordInCluster(ctx, flatResults, clusterVer, targetVer); err != nil {
+		return err
+	}
+
+	if err := c.saveResults(flatResults, clusterVer, targetVer); err != nil {
+		return err
 	}
+
+	if c.Watch {
+		c.lastResults = newResultList(flatResults, clusterVer, targetVer)
+	}
+
+	if c.previous != nil {
+		return c.writeDiff(flatResults)
+	}
+
+	return c.writeOutputs(flatResults, clusterVer, targetVer, func(out io.Writer) error {
+		return c.outputUpgradeTable(ctx, out, currentVer, c.TargetVersion, flatResults)
+	})
 }
 
-// outputUpgradeTable outputs upgrade results in table format with header.
-func (c *Command) outputUpgradeTable(ctx context.Context, _ string, results []check.CheckExecution) error {
-	c.IO.Fprintln()
+// outputUpgradeTable outputs upgrade results in table format, followed by the readiness
+// verdict.
+func (c *Command) outputUpgradeTable(
+	ctx context.Context,
+	out io.Writer,
+	currentVer string,
+	targetVer string,
+	results []check.CheckExecution,
+) error {
+	_, _ = fmt.Fprintln(out)
 
-	opts := TableOutputOptions{ShowImpactedObjects: c.Verbose}
+	opts := TableOutputOptions{
+		ShowImpactedObjects: c.Verbose,
+		ShowTeamRollup:      c.assignments != nil,
+		Columns:             c.columns,
+		ShowTimings:         c.ShowTimings,
+	}
 
 	if c.Verbose {
-		opts.NamespaceRequesters = collectNamespaceRequesters(ctx, c.Client, results)
+		opts.NamespaceRequesters = collectNamespaceRequesters(ctx, c.Reader, results)
 	}
 
 	// Reuse the lint table output logic
-	if err := OutputTable(c.IO.Out(), results, opts); err != nil {
+	if err := OutputTable(out, results, opts); err != nil {
 		return fmt.Errorf("outputting table: %w", err)
 	}
 
+	return OutputReadiness(out, resultpkg.NewReadinessSummary(diagnosticResults(results)), currentVer, targetVer)
+}
+
+// emitRemediationScript writes the remediation script when --emit-remediation-script is set.
+func (c *Command) emitRemediationScript(
+	results []check.CheckExecution,
+	clusterVersion *string,
+	targetVersion *string,
+) error {
+	if c.RemediationScript == "" {
+		return nil
+	}
+
+	if err := WriteRemediationScriptFile(c.RemediationScript, results, clusterVersion, targetVersion); err != nil {
+		return fmt.Errorf("emitting remediation script: %w", err)
+	}
+
+	c.IO.Errorf("Remediation script written to %s", c.RemediationScript)
+
+	return nil
+}
+
+// recordHistory stores the run in the run history database when --db is set.
+func (c *Command) recordHistory(
+	results []check.CheckExecution,
+	clusterVersion *string,
+	targetVersion *string,
+) error {
+	if c.DB == "" {
+		return nil
+	}
+
+	var target string
+	if targetVersion != nil {
+		target = *targetVersion
+	}
+
+	store, err := history.Open(c.DB)
+	if err != nil {
+		return err
+	}
+
+	run := history.NewRun(results, *clusterVersion, target, time.Now())
+	recordErr := store.Record(run)
+
+	if err := store.Close(); err != nil && recordErr == nil {
+		return err
+	}
+
+	if recordErr != nil {
+		return recordErr
+	}
+
+	c.IO.Errorf("Run %s recorded in %s", run.ID, c.DB)
+
+	return nil
+}
+
+// recordInCluster writes the results to a ConfigMap in the operator namespace when --record is
+// set, so prior runs can be listed and fetched with 'lint history'.
+func (c *Command) recordInCluster(
+	ctx context.Context,
+	results []check.CheckExecution,
+	clusterVersion *string,
+	targetVersion *string,
+) error {
+	if !c.Record {
+		return nil
+	}
+
+	run, err := record.Write(ctx, c.Client.Dynamic(), c.RecordNamespace, newResultList(results, clusterVersion, targetVersion), time.Now())
+	if err != nil {
+		return fmt.Errorf("recording results: %w", err)
+	}
+
+	c.IO.Errorf("Run recorded in ConfigMap %s/%s", run.Namespace, run.Name)
+
 	return nil
 }
 
@@ -511,6 +1420,17 @@ func collectNamespaceRequesters(
 	ctx context.Context,
 	reader client.Reader,
 	results []check.CheckExecution,
+) map[string]string {
+	return collectNamespaceAnnotation(ctx, reader, results, "openshift.io/requester")
+}
+
+// collectNamespaceAnnotation fetches the annotation key for each unique namespace referenced
+// by impacted objects in the results. Namespaces without it, or that cannot be read, are omitted.
+func collectNamespaceAnnotation(
+	ctx context.Context,
+	reader client.Reader,
+	results []check.CheckExecution,
+	key string,
 ) map[string]string {
 	// Collect unique namespaces from impacted objects.
 	namespaces := make(map[string]struct{})
@@ -527,7 +1447,7 @@ func collectNamespaceRequesters(
 		return nil
 	}
 
-	requesters := make(map[string]string, len(namespaces))
+	values := make(map[string]string, len(namespaces))
 
 	for ns := range namespaces {
 		meta, err := reader.GetResourceMetadata(ctx, resources.Namespace, ns)
@@ -535,10 +1455,10 @@ func collectNamespaceRequesters(
 			continue
 		}
 
-		if requester, ok := meta.Annotations["openshift.io/requester"]; ok {
-			requesters[ns] = requester
+		if value, ok := meta.Annotations[key]; ok {
+			values[ns] = value
 		}
 	}
 
-	return requesters
+	return values
 }
@@ -0,0 +1,130 @@
+package lint_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/selftest"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+)
+
+const (
+	// benchmarkScenario seeds the benchmark clusters with the components and workloads that
+	// trigger the most checks.
+	benchmarkScenario = "upgrade-blocked"
+
+	// benchmarkWorkloadsPerNamespace spreads the fixture workloads over namespaces.
+	benchmarkWorkloadsPerNamespace = 100
+)
+
+// benchmarkSizes are the numbers of workloads of the fixture clusters.
+//
+//nolint:gochecknoglobals
+var benchmarkSizes = []int{1000, 10000}
+
+// benchmarkWorkloadKinds are the kinds of the scenario objects replicated into fixture
+// workloads.
+//
+//nolint:gochecknoglobals
+var benchmarkWorkloadKinds = map[string]bool{
+	resources.Notebook.Kind:         true,
+	resources.InferenceService.Kind: true,
+	resources.RayCluster.Kind:       true,
+	resources.AppWrapper.Kind:       true,
+	resources.PyTorchJob.Kind:       true,
+}
+
+// benchmarkCluster returns a simulated cluster seeded with the benchmark scenario, whose
+// workloads are replicated round-robin up to the given number of workloads.
+func benchmarkCluster(b *testing.B, workloads int) (client.Client, string) {
+	b.Helper()
+
+	var scenario selftest.Scenario
+
+	for _, s := range selftest.Scenarios() {
+		if s.Name == benchmarkScenario {
+			scenario = s
+		}
+	}
+
+	base, err := scenario.Objects()
+	if err != nil {
+		b.Fatalf("loading scenario %s: %v", benchmarkScenario, err)
+	}
+
+	var templates []*unstructured.Unstructured
+
+	objects := make([]*unstructured.Unstructured, 0, len(base)+workloads+workloads/benchmarkWorkloadsPerNamespace)
+
+	for _, obj := range base {
+		if benchmarkWorkloadKinds[obj.GetKind()] {
+			templates = append(templates, obj)
+
+			continue
+		}
+
+		objects = append(objects, obj)
+	}
+
+	for i := range workloads {
+		namespace := fmt.Sprintf("bench-%d", i/benchmarkWorkloadsPerNamespace)
+
+		if i%benchmarkWorkloadsPerNamespace == 0 {
+			ns := &unstructured.Unstructured{}
+			ns.SetAPIVersion(resources.Namespace.APIVersion())
+			ns.SetKind(resources.Namespace.Kind)
+			ns.SetName(namespace)
+			objects = append(objects, ns)
+		}
+
+		obj := templates[i%len(templates)].DeepCopy()
+		obj.SetNamespace(namespace)
+		obj.SetName(fmt.Sprintf("%s-%d", obj.GetName(), i))
+		objects = append(objects, obj)
+	}
+
+	cluster, err := selftest.NewCluster(objects)
+	if err != nil {
+		b.Fatalf("creating cluster: %v", err)
+	}
+
+	return cluster, scenario.TargetVersion
+}
+
+// BenchmarkLint_UpgradeMode benchmarks the full check registry in upgrade mode, including
+// version detection and JSON rendering, against clusters of 1k and 10k workloads.
+func BenchmarkLint_UpgradeMode(b *testing.B) {
+	for _, size := range benchmarkSizes {
+		b.Run(fmt.Sprintf("%dk", size/1000), func(b *testing.B) {
+			cluster, targetVersion := benchmarkCluster(b, size)
+			ctx := b.Context()
+
+			for b.Loop() {
+				streams := genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: io.Discard, ErrOut: io.Discard}
+
+				command := lint.NewCommand(streams, genericclioptions.NewConfigFlags(false),
+					lint.WithClient(cluster),
+					lint.WithTargetVersion(targetVersion),
+				)
+				command.OutputFormat = lint.OutputFormatJSON
+				command.FailOnCritical = false
+
+				if err := command.Complete(); err != nil {
+					b.Fatalf("Complete failed: %v", err)
+				}
+
+				if err := command.Run(ctx); err != nil {
+					b.Fatalf("Run failed: %v", err)
+				}
+			}
+		})
+	}
+}
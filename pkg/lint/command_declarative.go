@@ -0,0 +1,25 @@
+package lint
+
+import (
+	"fmt"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/declarative"
+)
+
+// loadCheckDefinitions registers the checks defined in the YAML files of --checks-dir with the
+// registry of the run. Unlike plugins, an invalid definition fails the run: the files are
+// maintained by the cluster admin running the command, so mistakes should not go unnoticed.
+func (c *Command) loadCheckDefinitions() error {
+	checks, err := declarative.LoadDir(c.ChecksDir)
+	if err != nil {
+		return fmt.Errorf("loading --checks-dir: %w", err)
+	}
+
+	for _, chk := range checks {
+		if err := c.registry.Register(chk); err != nil {
+			return fmt.Errorf("loading --checks-dir: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,150 @@
+package lint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/printer/json"
+	"github.com/opendatahub-io/odh-cli/pkg/printer/yaml"
+	"github.com/opendatahub-io/odh-cli/pkg/rules"
+	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+)
+
+var _ cmd.Command = (*ExplainCommand)(nil)
+
+// ExplainOutputFormat represents the output format of the lint explain command.
+type ExplainOutputFormat string
+
+const (
+	ExplainOutputFormatText ExplainOutputFormat = "text"
+	ExplainOutputFormatJSON ExplainOutputFormat = "json"
+	ExplainOutputFormatYAML ExplainOutputFormat = "yaml"
+)
+
+// Validate checks if the explain output format is valid.
+func (o ExplainOutputFormat) Validate() error {
+	switch o {
+	case ExplainOutputFormatText, ExplainOutputFormatJSON, ExplainOutputFormatYAML:
+		return nil
+	default:
+		return fmt.Errorf("invalid output format: %s (must be one of: text, json, yaml)", o)
+	}
+}
+
+// ExplainCommand prints the documentation of a single check. It does not contact the cluster.
+type ExplainCommand struct {
+	IO iostreams.Interface
+
+	// CheckID is the ID of the check to explain.
+	CheckID string
+
+	// OutputFormat specifies the explanation output format (text, json, yaml)
+	OutputFormat ExplainOutputFormat
+
+	// registry is the check registry for this command instance.
+	registry *check.CheckRegistry
+}
+
+// NewExplainCommand creates a new ExplainCommand populated with all lint checks.
+func NewExplainCommand(streams genericiooptions.IOStreams) *ExplainCommand {
+	return &ExplainCommand{
+		IO:           iostreams.NewIOStreams(streams.In, streams.Out, streams.ErrOut),
+		OutputFormat: ExplainOutputFormatText,
+		registry:     NewRegistry(),
+	}
+}
+
+// AddFlags registers command-specific flags with the provided FlagSet.
+func (c *ExplainCommand) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVarP((*string)(&c.OutputFormat), "output", "o", string(ExplainOutputFormatText), flagDescExplainOutput)
+}
+
+// Complete performs pre-validation setup.
+func (c *ExplainCommand) Complete() error {
+	// Knowledge links may be overridden by an installed rules bundle
+	if _, err := rules.ApplyInstalled(); err != nil {
+		c.IO.Errorf("Warning: ignoring installed rules bundle: %v", err)
+	}
+
+	return nil
+}
+
+// Validate checks that all required options are valid.
+func (c *ExplainCommand) Validate() error {
+	if c.CheckID == "" {
+		return errors.New("a check ID is required")
+	}
+
+	return c.OutputFormat.Validate()
+}
+
+// Run looks up the check and writes its explanation in the requested format.
+func (c *ExplainCommand) Run(_ context.Context) error {
+	chk, ok := c.registry.Get(c.CheckID)
+	if !ok {
+		return c.unknownCheckError()
+	}
+
+	explanation := ExplainCheck(chk)
+
+	switch c.OutputFormat {
+	case ExplainOutputFormatJSON:
+		renderer := json.NewRenderer[*CheckExplanation](json.WithWriter[*CheckExplanation](c.IO.Out()))
+		if err := renderer.Render(explanation); err != nil {
+			return fmt.Errorf("rendering explanation: %w", err)
+		}
+
+		return nil
+	case ExplainOutputFormatYAML:
+		renderer := yaml.NewRenderer[*CheckExplanation](yaml.WithWriter[*CheckExplanation](c.IO.Out()))
+		if err := renderer.Render(explanation); err != nil {
+			return fmt.Errorf("rendering explanation: %w", err)
+		}
+
+		return nil
+	case ExplainOutputFormatText:
+		return explanation.WriteText(c.IO.Out())
+	default:
+		return fmt.Errorf("unsupported output format: %s", c.OutputFormat)
+	}
+}
+
+// CheckIDs returns the IDs of all registered checks, sorted, for shell completion.
+func (c *ExplainCommand) CheckIDs() []string {
+	checks := c.registry.ListAll()
+
+	ids := make([]string, 0, len(checks))
+	for _, chk := range checks {
+		ids = append(ids, chk.ID())
+	}
+
+	slices.Sort(ids)
+
+	return ids
+}
+
+// unknownCheckError reports an unknown check ID, suggesting the IDs that contain it.
+func (c *ExplainCommand) unknownCheckError() error {
+	var suggestions []string
+
+	for _, id := range c.CheckIDs() {
+		if strings.Contains(id, c.CheckID) {
+			suggestions = append(suggestions, id)
+		}
+	}
+
+	if len(suggestions) == 0 {
+		return fmt.Errorf("unknown check %q (list the check IDs with 'kubectl odh lint graph -o json')", c.CheckID)
+	}
+
+	return fmt.Errorf("unknown check %q, did you mean: %s", c.CheckID, strings.Join(suggestions, ", "))
+}
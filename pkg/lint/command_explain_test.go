@@ -0,0 +1,120 @@
+package lint_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+
+	. "github.com/onsi/gomega"
+)
+
+func newExplainCommand(out *bytes.Buffer, id string) *lint.ExplainCommand {
+	command := lint.NewExplainCommand(genericiooptions.IOStreams{Out: out, ErrOut: &bytes.Buffer{}})
+	command.CheckID = id
+
+	return command
+}
+
+func TestExplainCommand_AllChecksDocumented(t *testing.T) {
+	g := NewWithT(t)
+
+	ids := newExplainCommand(&bytes.Buffer{}, "").CheckIDs()
+	g.Expect(ids).ToNot(BeEmpty())
+
+	for _, id := range ids {
+		var out bytes.Buffer
+
+		command := newExplainCommand(&out, id)
+		command.OutputFormat = lint.ExplainOutputFormatJSON
+
+		g.Expect(command.Validate()).To(Succeed())
+		g.Expect(command.Run(t.Context())).To(Succeed())
+
+		var explanation lint.CheckExplanation
+		g.Expect(json.Unmarshal(out.Bytes(), &explanation)).To(Succeed())
+
+		g.Expect(explanation.ID).To(Equal(id))
+		g.Expect(explanation.Inspects).ToNot(BeEmpty(), "check %s has no Inspects documentation", id)
+		g.Expect(explanation.Rationale).ToNot(BeEmpty(), "check %s has no Rationale documentation", id)
+		g.Expect(explanation.FailingExample).ToNot(BeEmpty(), "check %s has no failing example", id)
+		g.Expect(explanation.PassingExample).ToNot(BeEmpty(), "check %s has no passing example", id)
+		// Every finding, blocking ones in particular, must point the user at further guidance
+		g.Expect(explanation.KnowledgeLinks).ToNot(BeEmpty(), "check %s has no knowledge links", id)
+	}
+}
+
+func TestExplainCommand_Text(t *testing.T) {
+	g := NewWithT(t)
+
+	var out bytes.Buffer
+
+	command := newExplainCommand(&out, "components.codeflare.removal")
+
+	g.Expect(command.Complete()).To(Succeed())
+	g.Expect(command.Validate()).To(Succeed())
+	g.Expect(command.Run(t.Context())).To(Succeed())
+
+	text := out.String()
+	g.Expect(text).To(HavePrefix("components.codeflare.removal\n"))
+	g.Expect(text).To(ContainSubstring("Resources: DataScienceCluster (datasciencecluster.opendatahub.io/v1)"))
+
+	for _, section := range []string{"DESCRIPTION", "WHAT IT INSPECTS", "WHY IT MATTERS", "FAILING EXAMPLE", "PASSING EXAMPLE", "REMEDIATION", "KNOWLEDGE BASE"} {
+		g.Expect(text).To(ContainSubstring("\n" + section + "\n"))
+	}
+
+	g.Expect(text).To(ContainSubstring("      managementState: Removed"))
+	g.Expect(text).To(ContainSubstring("  $ kubectl odh lint --target-version 3.0 --checks components.codeflare.removal --fix"))
+	g.Expect(text).To(ContainSubstring("  " + check.DocsDistributedWorkloads + "\n"))
+}
+
+func TestCommand_ExplainFlag(t *testing.T) {
+	g := NewWithT(t)
+
+	var out bytes.Buffer
+
+	command := lint.NewCommand(genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: &out, ErrOut: &bytes.Buffer{}}, testConfigFlags())
+	command.Explain = "workloads.ray.impacted-workloads"
+
+	// Explaining a check needs no cluster connection
+	g.Expect(command.Complete()).To(Succeed())
+	g.Expect(command.Validate()).To(Succeed())
+	g.Expect(command.Run(t.Context())).To(Succeed())
+
+	g.Expect(out.String()).To(HavePrefix("workloads.ray.impacted-workloads\n"))
+	g.Expect(out.String()).To(ContainSubstring("ray.openshift.ai/oauth-finalizer"))
+}
+
+func TestExplainCommand_UnknownCheck(t *testing.T) {
+	t.Run("suggests checks containing the ID", func(t *testing.T) {
+		g := NewWithT(t)
+
+		err := newExplainCommand(&bytes.Buffer{}, "codeflare").Run(t.Context())
+		g.Expect(err).To(MatchError(And(
+			ContainSubstring(`unknown check "codeflare", did you mean`),
+			ContainSubstring("components.codeflare.removal"),
+			ContainSubstring("workloads.codeflare.impacted-workloads"),
+		)))
+	})
+
+	t.Run("points at the check list without a match", func(t *testing.T) {
+		g := NewWithT(t)
+
+		err := newExplainCommand(&bytes.Buffer{}, "does-not-exist").Run(t.Context())
+		g.Expect(err).To(MatchError(ContainSubstring("lint graph")))
+	})
+}
+
+func TestExplainCommand_Validate(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(newExplainCommand(&bytes.Buffer{}, "").Validate()).To(MatchError(ContainSubstring("check ID is required")))
+
+	command := newExplainCommand(&bytes.Buffer{}, "components.codeflare.removal")
+	command.OutputFormat = "table"
+	g.Expect(command.Validate()).To(MatchError(ContainSubstring("invalid output format")))
+}
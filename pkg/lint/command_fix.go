@@ -0,0 +1,142 @@
+package lint
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/util/confirmation"
+)
+
+// fixReport is the outcome of --fix for one check execution.
+type fixReport struct {
+	checkID string
+	changes []check.Change
+	err     error
+	skipped bool
+}
+
+// applyFixes applies the remediation of failing checks implementing check.Fixable when --fix is
+// set. Each fix is previewed and confirmed before it is applied, and fixed checks are executed
+// again so the results reflect the fixed state. Previews and the report are written to stderr
+// even in quiet mode, as they describe changes to the cluster.
+func (c *Command) applyFixes(
+	ctx context.Context,
+	executor *check.Executor,
+	resultsByGroup map[check.CheckGroup][]check.CheckExecution,
+) {
+	if !c.Fix {
+		return
+	}
+
+	out := c.IO.ErrOut()
+
+	var reports []fixReport
+
+	for _, group := range check.CanonicalGroupOrder {
+		executions := resultsByGroup[group]
+
+		for i, exec := range executions {
+			fixable, ok := exec.Check.(check.Fixable)
+			if !ok || exec.Result == nil || !exec.Result.IsFailing() {
+				continue
+			}
+
+			report, applied := c.applyFix(ctx, fixable, exec)
+			if report == nil {
+				continue
+			}
+
+			reports = append(reports, *report)
+
+			if applied {
+				// The fix changed the cluster, so the checks read it again
+				c.cache.Invalidate()
+
+				if rerun, ok := executor.Revalidate(ctx, exec); ok {
+					executions[i] = rerun
+				}
+			}
+		}
+	}
+
+	_, _ = fmt.Fprintln(out)
+
+	if len(reports) == 0 {
+		_, _ = fmt.Fprintln(out, "No failing checks can be fixed automatically")
+		_, _ = fmt.Fprintln(out)
+
+		return
+	}
+
+	if c.FixDryRun {
+		_, _ = fmt.Fprintln(out, "Fixes (dry run, nothing changed):")
+	} else {
+		_, _ = fmt.Fprintln(out, "Fixes:")
+	}
+
+	for _, r := range reports {
+		switch {
+		case r.err != nil:
+			_, _ = fmt.Fprintf(out, "  %s: not fixed: %v\n", r.checkID, r.err)
+		case r.skipped:
+			_, _ = fmt.Fprintf(out, "  %s: skipped\n", r.checkID)
+		default:
+			for _, change := range r.changes {
+				_, _ = fmt.Fprintf(out, "  %s: %s\n", r.checkID, change)
+			}
+		}
+	}
+
+	_, _ = fmt.Fprintln(out)
+}
+
+// applyFix previews, confirms and applies the fix of one failing check execution. It returns a
+// nil report when there is nothing to fix, and whether changes were made.
+func (c *Command) applyFix(
+	ctx context.Context,
+	fixable check.Fixable,
+	exec check.CheckExecution,
+) (*fixReport, bool) {
+	out := c.IO.ErrOut()
+	report := &fixReport{checkID: exec.Check.ID()}
+
+	planned, err := fixable.Fix(ctx, check.FixTarget{Client: c.Client, DryRun: true}, exec.Result)
+	if err != nil {
+		report.err = err
+
+		return report, false
+	}
+
+	if len(planned) == 0 {
+		return nil, false
+	}
+
+	report.changes = planned
+
+	if c.FixDryRun {
+		return report, false
+	}
+
+	_, _ = fmt.Fprintf(out, "\n%s would make the following changes:\n", exec.Check.ID())
+	for _, change := range planned {
+		_, _ = fmt.Fprintf(out, "  - %s\n", change)
+	}
+
+	if !c.Yes && !confirmation.Prompt(c.IO, "Apply these changes?") {
+		report.skipped = true
+
+		return report, false
+	}
+
+	changes, err := fixable.Fix(ctx, check.FixTarget{Client: c.Client}, exec.Result)
+	if err != nil {
+		report.err = err
+
+		return report, false
+	}
+
+	report.changes = changes
+
+	return report, len(changes) > 0
+}
@@ -0,0 +1,198 @@
+package lint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/gitops"
+	"github.com/opendatahub-io/odh-cli/pkg/util/manifest"
+)
+
+// gitOpsRequestTimeout bounds each request to the GitHub or GitLab API.
+const gitOpsRequestTimeout = 30 * time.Second
+
+var _ cmd.Command = (*GitOpsCommentCommand)(nil)
+
+// GitOpsCommentCommand runs the lint checks like "lint" and posts the findings impacting
+// objects declared in the manifests changed by a pull request as a comment on it, updating
+// the comment of a previous run in place.
+type GitOpsCommentCommand struct {
+	*Command
+
+	// PR is the web URL of the GitHub pull request or GitLab merge request.
+	PR string
+
+	// RepoDir is the checkout of the pull request the changed manifests are read from.
+	RepoDir string
+
+	// DryRun prints the comment instead of posting it.
+	DryRun bool
+
+	// token authenticates API requests (populated during Complete).
+	token string
+
+	// provider accesses the pull request (populated during Complete).
+	provider gitops.Provider
+}
+
+// NewGitOpsCommentCommand creates a new GitOpsCommentCommand populated with all lint checks.
+func NewGitOpsCommentCommand(
+	streams genericiooptions.IOStreams,
+	configFlags *genericclioptions.ConfigFlags,
+	options ...CommandOption,
+) *GitOpsCommentCommand {
+	g := &GitOpsCommentCommand{
+		Command: NewCommand(streams, configFlags, options...),
+		RepoDir: ".",
+	}
+	g.gitOpsComment = g
+
+	return g
+}
+
+// AddFlags registers the pull request flags and the flags that change which checks run.
+func (g *GitOpsCommentCommand) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&g.PR, "pr", "", flagDescGitOpsPR)
+	fs.StringVar(&g.RepoDir, "repo-dir", g.RepoDir, flagDescGitOpsRepoDir)
+	fs.BoolVar(&g.DryRun, "dry-run", false, flagDescGitOpsDryRun)
+	fs.StringVar(&g.TargetVersion, "target-version", "", flagDescTargetVersion)
+	fs.StringArrayVar(&g.CheckSelectors, "checks", []string{"*"}, flagDescChecks)
+	fs.BoolVarP(&g.Verbose, "verbose", "v", false, flagDescVerbose)
+	fs.BoolVar(&g.Debug, "debug", false, flagDescDebug)
+	fs.DurationVar(&g.Timeout, "timeout", g.Timeout, flagDescTimeout)
+	fs.IntVar(&g.Concurrency, "concurrency", g.Concurrency, flagDescConcurrency)
+	fs.DurationVar(&g.CheckTimeout, "check-timeout", 0, flagDescCheckTimeout)
+
+	// Throttling settings
+	fs.Float32Var(&g.QPS, "qps", g.QPS, flagDescQPS)
+	fs.IntVar(&g.Burst, "burst", g.Burst, flagDescBurst)
+}
+
+// Complete creates the client and resolves the pull request provider and token.
+func (g *GitOpsCommentCommand) Complete() error {
+	if err := g.Command.Complete(); err != nil {
+		return err
+	}
+
+	if g.PR == "" {
+		return nil
+	}
+
+	pr, err := gitops.ParsePullRequestURL(g.PR)
+	if err != nil {
+		return err
+	}
+
+	g.token = gitops.Token(pr.Provider)
+
+	provider, err := gitops.NewProvider(pr, g.token, &http.Client{Timeout: gitOpsRequestTimeout})
+	if err != nil {
+		return fmt.Errorf("creating %s client: %w", pr.Provider, err)
+	}
+
+	g.provider = provider
+
+	return nil
+}
+
+// Validate checks that all required options are valid.
+func (g *GitOpsCommentCommand) Validate() error {
+	if g.PR == "" {
+		return errors.New("--pr is required")
+	}
+
+	if err := g.Command.Validate(); err != nil {
+		return err
+	}
+
+	if !g.DryRun && g.token == "" {
+		return fmt.Errorf("posting a comment requires an API token in %s, %s or %s (or use --dry-run)",
+			gitops.EnvToken, gitops.EnvGitHubToken, gitops.EnvGitLabToken)
+	}
+
+	return nil
+}
+
+// publish posts (or, with --dry-run, prints) the findings of a run impacting objects declared
+// in the manifests changed by the pull request.
+func (g *GitOpsCommentCommand) publish(
+	ctx context.Context,
+	resultsByGroup map[check.CheckGroup][]check.CheckExecution,
+) error {
+	files, err := g.provider.ChangedFiles(ctx)
+	if err != nil {
+		return fmt.Errorf("reading pull request %s: %w", g.PR, err)
+	}
+
+	docs := g.loadManifests(files)
+
+	findings := gitops.MatchFindings(FlattenResults(resultsByGroup), docs)
+	body := gitops.RenderComment(findings, gitops.CommentOptions{
+		ClusterVersion: g.currentClusterVersion,
+		TargetVersion:  g.TargetVersion,
+		Objects:        len(docs),
+	})
+
+	g.IO.Errorf("Found %d finding(s) for %d object(s) in %d changed file(s)", len(findings), len(docs), len(files))
+
+	if g.DryRun {
+		g.IO.Fprintf("%s", body)
+
+		return nil
+	}
+
+	commentURL, created, err := g.provider.UpsertComment(ctx, body)
+	if err != nil {
+		return fmt.Errorf("commenting on %s: %w", g.PR, err)
+	}
+
+	if created {
+		g.IO.Errorf("Posted comment %s", commentURL)
+	} else {
+		g.IO.Errorf("Updated comment %s", commentURL)
+	}
+
+	return nil
+}
+
+// loadManifests decodes the changed manifest files from the checkout. Files missing from the
+// checkout or failing to decode are reported as warnings and skipped.
+func (g *GitOpsCommentCommand) loadManifests(files []string) []manifest.Document {
+	var docs []manifest.Document
+
+	for _, file := range files {
+		if !manifest.IsManifestFile(file) {
+			continue
+		}
+
+		f, err := os.Open(filepath.Join(g.RepoDir, filepath.FromSlash(file)))
+		if err != nil {
+			g.IO.Errorf("Warning: skipping %s: %v", file, err)
+
+			continue
+		}
+
+		fileDocs, err := manifest.Decode(f, file)
+		_ = f.Close()
+
+		if err != nil {
+			g.IO.Errorf("Warning: %v", err)
+		}
+
+		docs = append(docs, fileDocs...)
+	}
+
+	return docs
+}
@@ -0,0 +1,104 @@
+package lint
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/pflag"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/printer/json"
+	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+)
+
+var _ cmd.Command = (*GraphCommand)(nil)
+
+// GraphOutputFormat represents the output format of the lint graph command.
+type GraphOutputFormat string
+
+const (
+	GraphOutputFormatDOT  GraphOutputFormat = "dot"
+	GraphOutputFormatJSON GraphOutputFormat = "json"
+)
+
+// Validate checks if the graph output format is valid.
+func (o GraphOutputFormat) Validate() error {
+	switch o {
+	case GraphOutputFormatDOT, GraphOutputFormatJSON:
+		return nil
+	default:
+		return fmt.Errorf("invalid output format: %s (must be one of: dot, json)", o)
+	}
+}
+
+// GraphCommand emits the check registry as a dependency/applicability graph.
+// It does not contact the cluster.
+type GraphCommand struct {
+	IO iostreams.Interface
+
+	// OutputFormat specifies the graph output format (dot, json)
+	OutputFormat GraphOutputFormat
+
+	// CheckSelectors filters which checks are included (glob patterns, repeatable)
+	CheckSelectors []string
+
+	// registry is the check registry for this command instance.
+	registry *check.CheckRegistry
+}
+
+// NewGraphCommand creates a new GraphCommand populated with all lint checks.
+func NewGraphCommand(streams genericiooptions.IOStreams) *GraphCommand {
+	return &GraphCommand{
+		IO:             iostreams.NewIOStreams(streams.In, streams.Out, streams.ErrOut),
+		OutputFormat:   GraphOutputFormatDOT,
+		CheckSelectors: []string{"*"},
+		registry:       NewRegistry(),
+	}
+}
+
+// AddFlags registers command-specific flags with the provided FlagSet.
+func (c *GraphCommand) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVarP((*string)(&c.OutputFormat), "output", "o", string(GraphOutputFormatDOT), flagDescGraphOutput)
+	fs.StringArrayVar(&c.CheckSelectors, "checks", []string{"*"}, flagDescChecks)
+}
+
+// Complete performs pre-validation setup. The graph command has no derived state.
+func (c *GraphCommand) Complete() error {
+	return nil
+}
+
+// Validate checks that all required options are valid.
+func (c *GraphCommand) Validate() error {
+	if err := c.OutputFormat.Validate(); err != nil {
+		return err
+	}
+
+	return ValidateCheckSelectors(c.CheckSelectors)
+}
+
+// Run builds the check graph and writes it in the requested format.
+func (c *GraphCommand) Run(_ context.Context) error {
+	checks, err := c.registry.ListByPatterns(c.CheckSelectors, "")
+	if err != nil {
+		return fmt.Errorf("selecting checks: %w", err)
+	}
+
+	graph := BuildCheckGraph(checks)
+
+	switch c.OutputFormat {
+	case GraphOutputFormatJSON:
+		renderer := json.NewRenderer[*CheckGraph](json.WithWriter[*CheckGraph](c.IO.Out()))
+		if err := renderer.Render(graph); err != nil {
+			return fmt.Errorf("rendering graph: %w", err)
+		}
+
+		return nil
+	case GraphOutputFormatDOT:
+		return graph.WriteDOT(c.IO.Out())
+	default:
+		return fmt.Errorf("unsupported output format: %s", c.OutputFormat)
+	}
+}
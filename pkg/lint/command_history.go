@@ -0,0 +1,227 @@
+package lint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/record"
+	"github.com/opendatahub-io/odh-cli/pkg/printer/json"
+	"github.com/opendatahub-io/odh-cli/pkg/printer/table"
+	"github.com/opendatahub-io/odh-cli/pkg/printer/yaml"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+)
+
+var _ cmd.Command = (*HistoryCommand)(nil)
+
+// HistoryOutputFormat represents the output format of the lint history command.
+type HistoryOutputFormat string
+
+const (
+	HistoryOutputFormatTable HistoryOutputFormat = "table"
+	HistoryOutputFormatJSON  HistoryOutputFormat = "json"
+	HistoryOutputFormatYAML  HistoryOutputFormat = "yaml"
+)
+
+// Validate checks if the history output format is valid.
+func (o HistoryOutputFormat) Validate() error {
+	switch o {
+	case HistoryOutputFormatTable, HistoryOutputFormatJSON, HistoryOutputFormatYAML:
+		return nil
+	default:
+		return fmt.Errorf("invalid output format: %s (must be one of: table, json, yaml)", o)
+	}
+}
+
+// HistoryCommand lists the runs recorded in the cluster with lint --record, or fetches the
+// results of one of them.
+type HistoryCommand struct {
+	IO iostreams.Interface
+
+	// ConfigFlags provides access to kubeconfig and context.
+	ConfigFlags *genericclioptions.ConfigFlags
+
+	// Client is the cluster client. Created from ConfigFlags when nil.
+	Client client.Client
+
+	// Name is the recorded run to fetch; all runs are listed when empty.
+	Name string
+
+	// Namespace is the namespace runs are recorded in.
+	Namespace string
+
+	// OutputFormat specifies the output format (table, json, yaml).
+	OutputFormat HistoryOutputFormat
+
+	// Verbose lists the impacted objects of a fetched run.
+	Verbose bool
+}
+
+// NewHistoryCommand creates a new HistoryCommand with defaults.
+func NewHistoryCommand(streams genericiooptions.IOStreams, configFlags *genericclioptions.ConfigFlags) *HistoryCommand {
+	return &HistoryCommand{
+		IO:           iostreams.NewIOStreams(streams.In, streams.Out, streams.ErrOut),
+		ConfigFlags:  configFlags,
+		Namespace:    record.DefaultNamespace,
+		OutputFormat: HistoryOutputFormatTable,
+	}
+}
+
+// AddFlags registers command-specific flags with the provided FlagSet.
+func (c *HistoryCommand) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&c.Namespace, "record-namespace", c.Namespace, flagDescRecordNamespace)
+	fs.StringVarP((*string)(&c.OutputFormat), "output", "o", string(HistoryOutputFormatTable), flagDescHistoryOutput)
+	fs.BoolVarP(&c.Verbose, "verbose", "v", false, flagDescVerbose)
+}
+
+// Complete creates the cluster client.
+func (c *HistoryCommand) Complete() error {
+	if c.Client != nil {
+		return nil
+	}
+
+	cl, err := client.NewClient(c.ConfigFlags)
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	c.Client = cl
+
+	return nil
+}
+
+// Validate checks that all required options are valid.
+func (c *HistoryCommand) Validate() error {
+	if c.Namespace == "" {
+		return errors.New("--record-namespace is required")
+	}
+
+	return c.OutputFormat.Validate()
+}
+
+// Run lists the recorded runs, or writes the results of the named run.
+func (c *HistoryCommand) Run(ctx context.Context) error {
+	if c.Name == "" {
+		runs, err := record.List(ctx, c.Client.Dynamic(), c.Namespace)
+		if err != nil {
+			return err
+		}
+
+		return c.outputRuns(runs)
+	}
+
+	run, list, err := record.Get(ctx, c.Client.Dynamic(), c.Namespace, c.Name)
+	if err != nil {
+		return err
+	}
+
+	return c.outputRun(run, list)
+}
+
+// historyRow is a single row of the recorded runs table.
+type historyRow struct {
+	Name     string `mapstructure:"NAME"`
+	Recorded string `mapstructure:"RECORDED"`
+	Cluster  string `mapstructure:"CLUSTER VERSION"`
+	Target   string `mapstructure:"TARGET VERSION"`
+	Checks   int    `mapstructure:"CHECKS"`
+	Failing  int    `mapstructure:"FAILING"`
+}
+
+func (c *HistoryCommand) outputRuns(runs []record.Run) error {
+	if c.OutputFormat != HistoryOutputFormatTable {
+		return renderHistory(c.IO.Out(), c.OutputFormat, runs)
+	}
+
+	if len(runs) == 0 {
+		c.IO.Errorf("No runs recorded in namespace %s", c.Namespace)
+
+		return nil
+	}
+
+	renderer := table.NewRenderer(
+		table.WithWriter[historyRow](c.IO.Out()),
+		table.WithHeaders[historyRow]("NAME", "RECORDED", "CLUSTER VERSION", "TARGET VERSION", "CHECKS", "FAILING"),
+		table.WithTableOptions[historyRow](table.DefaultTableOptions...),
+	)
+
+	for _, run := range runs {
+		row := historyRow{
+			Name:     run.Name,
+			Recorded: run.Timestamp.Local().Format(time.DateTime),
+			Cluster:  "-",
+			Target:   "-",
+			Checks:   run.Checks,
+			Failing:  run.Failing,
+		}
+
+		if run.ClusterVersion != "" {
+			row.Cluster = run.ClusterVersion
+		}
+
+		if run.TargetVersion != "" {
+			row.Target = run.TargetVersion
+		}
+
+		if err := renderer.Append(row); err != nil {
+			return fmt.Errorf("appending recorded run row: %w", err)
+		}
+	}
+
+	if err := renderer.Render(); err != nil {
+		return fmt.Errorf("rendering recorded runs: %w", err)
+	}
+
+	return nil
+}
+
+// renderHistory renders v as JSON or YAML.
+func renderHistory[T any](out io.Writer, format HistoryOutputFormat, v T) error {
+	var err error
+
+	if format == HistoryOutputFormatYAML {
+		err = yaml.NewRenderer[T](yaml.WithWriter[T](out)).Render(v)
+	} else {
+		err = json.NewRenderer[T](json.WithWriter[T](out)).Render(v)
+	}
+
+	if err != nil {
+		return fmt.Errorf("rendering %s output: %w", format, err)
+	}
+
+	return nil
+}
+
+func (c *HistoryCommand) outputRun(run *record.Run, list *result.DiagnosticResultList) error {
+	if c.OutputFormat != HistoryOutputFormatTable {
+		return renderHistory(c.IO.Out(), c.OutputFormat, list)
+	}
+
+	out := c.IO.Out()
+
+	_, _ = fmt.Fprintf(out, "Run %s recorded at %s\n", run.Name, run.Timestamp.Local().Format(time.DateTime))
+
+	executions := make([]check.CheckExecution, 0, len(list.Results))
+	for _, r := range list.Results {
+		if r != nil {
+			executions = append(executions, check.CheckExecution{Result: r})
+		}
+	}
+
+	if err := OutputTable(out, executions, TableOutputOptions{ShowImpactedObjects: c.Verbose}); err != nil {
+		return fmt.Errorf("outputting table: %w", err)
+	}
+
+	return nil
+}
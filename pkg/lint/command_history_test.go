@@ -0,0 +1,111 @@
+package lint_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/record"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+func newHistoryCommand(t *testing.T, out *bytes.Buffer) *lint.HistoryCommand {
+	t.Helper()
+
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(
+		runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{resources.ConfigMap.GVR(): resources.ConfigMap.ListKind()},
+	)
+
+	clusterVersion := "2.25.0"
+	targetVersion := "3.0.0"
+	list := result.NewDiagnosticResultList(&clusterVersion, &targetVersion)
+
+	dr := result.New("workload", "notebook", "impacted-workloads", "Notebooks compatible with 3.0")
+	dr.SetCondition(result.Condition{
+		Condition: metav1.Condition{Type: "Compatible", Status: metav1.ConditionFalse, Message: "2 notebooks use removed images"},
+		Impact:    result.ImpactBlocking,
+	})
+	list.Results = append(list.Results, dr)
+
+	_, err := record.Write(t.Context(), dynamicClient, record.DefaultNamespace, list, time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	command := lint.NewHistoryCommand(genericiooptions.IOStreams{Out: out, ErrOut: &bytes.Buffer{}}, nil)
+	command.Client = client.NewForTesting(client.TestClientConfig{Dynamic: dynamicClient})
+
+	return command
+}
+
+func TestHistoryCommand_List(t *testing.T) {
+	g := NewWithT(t)
+
+	var out bytes.Buffer
+
+	command := newHistoryCommand(t, &out)
+
+	g.Expect(command.Complete()).To(Succeed())
+	g.Expect(command.Validate()).To(Succeed())
+	g.Expect(command.Run(t.Context())).To(Succeed())
+
+	g.Expect(out.String()).To(ContainSubstring("odh-lint-report-20260301t100000z"))
+	g.Expect(out.String()).To(ContainSubstring("2.25.0"))
+	g.Expect(out.String()).To(ContainSubstring("3.0.0"))
+}
+
+func TestHistoryCommand_FetchJSON(t *testing.T) {
+	g := NewWithT(t)
+
+	var out bytes.Buffer
+
+	command := newHistoryCommand(t, &out)
+	command.Name = "odh-lint-report-20260301t100000z"
+	command.OutputFormat = lint.HistoryOutputFormatJSON
+
+	g.Expect(command.Complete()).To(Succeed())
+	g.Expect(command.Validate()).To(Succeed())
+	g.Expect(command.Run(t.Context())).To(Succeed())
+
+	var list result.DiagnosticResultList
+	g.Expect(json.Unmarshal(out.Bytes(), &list)).To(Succeed())
+	g.Expect(list.Results).To(HaveLen(1))
+	g.Expect(list.Results[0].Status.Conditions[0].Message).To(Equal("2 notebooks use removed images"))
+}
+
+func TestHistoryCommand_FetchTable(t *testing.T) {
+	g := NewWithT(t)
+
+	var out bytes.Buffer
+
+	command := newHistoryCommand(t, &out)
+	command.Name = "odh-lint-report-20260301t100000z"
+
+	g.Expect(command.Run(t.Context())).To(Succeed())
+	g.Expect(out.String()).To(ContainSubstring("2 notebooks use removed images"))
+}
+
+func TestHistoryCommand_Validate(t *testing.T) {
+	g := NewWithT(t)
+
+	command := lint.NewHistoryCommand(genericiooptions.IOStreams{}, nil)
+	command.OutputFormat = "csv"
+	g.Expect(command.Validate()).To(MatchError(ContainSubstring("invalid output format")))
+
+	command.OutputFormat = lint.HistoryOutputFormatTable
+	command.Namespace = ""
+	g.Expect(command.Validate()).To(MatchError(ContainSubstring("--record-namespace is required")))
+}
@@ -0,0 +1,226 @@
+package lint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/blang/semver/v4"
+	"github.com/spf13/pflag"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/printer/json"
+	"github.com/opendatahub-io/odh-cli/pkg/printer/yaml"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+var _ cmd.Command = (*ListCommand)(nil)
+
+// ListOutputFormat represents the output format of the lint list command.
+type ListOutputFormat string
+
+const (
+	ListOutputFormatTable ListOutputFormat = "table"
+	ListOutputFormatJSON  ListOutputFormat = "json"
+	ListOutputFormatYAML  ListOutputFormat = "yaml"
+)
+
+// Validate checks if the list output format is valid.
+func (o ListOutputFormat) Validate() error {
+	switch o {
+	case ListOutputFormatTable, ListOutputFormatJSON, ListOutputFormatYAML:
+		return nil
+	default:
+		return fmt.Errorf("invalid output format: %s (must be one of: table, json, yaml)", o)
+	}
+}
+
+// ListCommand lists the registered checks and whether they would run for the given versions.
+// It only contacts the cluster with --cluster.
+type ListCommand struct {
+	IO iostreams.Interface
+
+	// ConfigFlags provides access to kubeconfig and context for --cluster.
+	ConfigFlags *genericclioptions.ConfigFlags
+
+	// Reader is the cluster reader CanApply is evaluated against with --cluster.
+	// Created from ConfigFlags when nil.
+	Reader client.Reader
+
+	// CurrentVersion is the OpenShift AI version to evaluate applicability for; detected
+	// from the cluster with --cluster when empty.
+	CurrentVersion string
+
+	// TargetVersion is the version being upgraded to; defaults to CurrentVersion.
+	TargetVersion string
+
+	// Cluster evaluates applicability against the live cluster.
+	Cluster bool
+
+	// OutputFormat specifies the list output format (table, json, yaml)
+	OutputFormat ListOutputFormat
+
+	// CheckSelectors filters which checks are listed (glob patterns, repeatable)
+	CheckSelectors []string
+
+	// registry is the check registry for this command instance.
+	registry *check.CheckRegistry
+
+	parsedCurrentVersion *semver.Version
+	parsedTargetVersion  *semver.Version
+}
+
+// NewListCommand creates a new ListCommand populated with all lint checks.
+func NewListCommand(streams genericiooptions.IOStreams, configFlags *genericclioptions.ConfigFlags) *ListCommand {
+	return &ListCommand{
+		IO:             iostreams.NewIOStreams(streams.In, streams.Out, streams.ErrOut),
+		ConfigFlags:    configFlags,
+		OutputFormat:   ListOutputFormatTable,
+		CheckSelectors: []string{"*"},
+		registry:       NewRegistry(),
+	}
+}
+
+// AddFlags registers command-specific flags with the provided FlagSet.
+func (c *ListCommand) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVarP((*string)(&c.OutputFormat), "output", "o", string(ListOutputFormatTable), flagDescListOutput)
+	fs.StringArrayVar(&c.CheckSelectors, "checks", []string{"*"}, flagDescChecks)
+	fs.StringVar(&c.CurrentVersion, "current-version", "", flagDescListCurrentVersion)
+	fs.StringVar(&c.TargetVersion, "target-version", "", flagDescListTargetVersion)
+	fs.BoolVar(&c.Cluster, "cluster", false, flagDescListCluster)
+}
+
+// Complete parses the versions and, with --cluster, creates the cluster client.
+func (c *ListCommand) Complete() error {
+	if c.CurrentVersion != "" {
+		v, err := semver.ParseTolerant(c.CurrentVersion)
+		if err != nil {
+			return fmt.Errorf("invalid current version %q: %w", c.CurrentVersion, err)
+		}
+
+		c.parsedCurrentVersion = &v
+	}
+
+	if c.TargetVersion != "" {
+		v, err := semver.ParseTolerant(c.TargetVersion)
+		if err != nil {
+			return fmt.Errorf("invalid target version %q: %w", c.TargetVersion, err)
+		}
+
+		c.parsedTargetVersion = &v
+	}
+
+	if !c.Cluster || c.Reader != nil {
+		return nil
+	}
+
+	cl, err := client.NewClient(c.ConfigFlags)
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	c.Reader = cl
+
+	return nil
+}
+
+// Validate checks that all required options are valid.
+func (c *ListCommand) Validate() error {
+	if err := c.OutputFormat.Validate(); err != nil {
+		return err
+	}
+
+	if !c.Cluster && c.TargetVersion != "" && c.CurrentVersion == "" {
+		return errors.New("--target-version requires --current-version or --cluster")
+	}
+
+	return ValidateCheckSelectors(c.CheckSelectors)
+}
+
+// Run lists the selected checks, evaluating their applicability when versions are known,
+// and writes the list in the requested format.
+func (c *ListCommand) Run(ctx context.Context) error {
+	checks, err := c.registry.ListByPatterns(c.CheckSelectors, "")
+	if err != nil {
+		return fmt.Errorf("selecting checks: %w", err)
+	}
+
+	target, err := c.target(ctx)
+	if err != nil {
+		return err
+	}
+
+	entries, err := BuildCheckList(ctx, checks, target)
+	if err != nil {
+		return err
+	}
+
+	switch c.OutputFormat {
+	case ListOutputFormatJSON:
+		renderer := json.NewRenderer[[]CheckListEntry](json.WithWriter[[]CheckListEntry](c.IO.Out()))
+		if err := renderer.Render(entries); err != nil {
+			return fmt.Errorf("rendering checks: %w", err)
+		}
+
+		return nil
+	case ListOutputFormatYAML:
+		renderer := yaml.NewRenderer[[]CheckListEntry](yaml.WithWriter[[]CheckListEntry](c.IO.Out()))
+		if err := renderer.Render(entries); err != nil {
+			return fmt.Errorf("rendering checks: %w", err)
+		}
+
+		return nil
+	case ListOutputFormatTable:
+		return outputCheckListTable(c.IO.Out(), entries, target != nil)
+	default:
+		return fmt.Errorf("unsupported output format: %s", c.OutputFormat)
+	}
+}
+
+// target returns the target applicability is evaluated against, or nil when neither
+// versions nor --cluster are given. Without --cluster the target has no client.
+func (c *ListCommand) target(ctx context.Context) (*check.Target, error) {
+	if !c.Cluster && c.parsedCurrentVersion == nil {
+		return nil, nil //nolint:nilnil // No target means applicability is not evaluated
+	}
+
+	target := &check.Target{
+		CurrentVersion: c.parsedCurrentVersion,
+		IO:             c.IO,
+	}
+
+	if c.Cluster {
+		target.Client = c.Reader
+
+		if target.CurrentVersion == nil {
+			currentVersion, err := version.Detect(ctx, c.Reader)
+			if err != nil {
+				return nil, fmt.Errorf("detecting cluster version: %w", err)
+			}
+
+			target.CurrentVersion = currentVersion
+		}
+
+		flavor, err := version.DetectFlavor(ctx, c.Reader)
+		if err != nil {
+			c.IO.Errorf("Warning: failed to detect the management flavor: %v", err)
+		}
+
+		target.Flavor = flavor
+	}
+
+	target.TargetVersion = target.CurrentVersion
+	if c.parsedTargetVersion != nil {
+		target.TargetVersion = c.parsedTargetVersion
+	}
+
+	c.IO.Errorf("Evaluating applicability: %s → %s\n", target.CurrentVersion.String(), target.TargetVersion.String())
+
+	return target, nil
+}
@@ -0,0 +1,140 @@
+package lint_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+)
+
+func newListCommand(out *bytes.Buffer) *lint.ListCommand {
+	return lint.NewListCommand(genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: out, ErrOut: &bytes.Buffer{}}, testConfigFlags())
+}
+
+func runListCommand(t *testing.T, command *lint.ListCommand) map[string]lint.CheckListEntry {
+	t.Helper()
+
+	g := NewWithT(t)
+
+	command.OutputFormat = lint.ListOutputFormatJSON
+	out, ok := command.IO.Out().(*bytes.Buffer)
+	g.Expect(ok).To(BeTrue())
+
+	g.Expect(command.Complete()).To(Succeed())
+	g.Expect(command.Validate()).To(Succeed())
+	g.Expect(command.Run(t.Context())).To(Succeed())
+
+	var entries []lint.CheckListEntry
+	g.Expect(json.Unmarshal(out.Bytes(), &entries)).To(Succeed())
+
+	byID := make(map[string]lint.CheckListEntry, len(entries))
+	for _, entry := range entries {
+		byID[entry.ID] = entry
+	}
+
+	return byID
+}
+
+func TestListCommand_WithoutVersions(t *testing.T) {
+	g := NewWithT(t)
+
+	var out bytes.Buffer
+
+	command := newListCommand(&out)
+	command.CheckSelectors = []string{"components.*"}
+
+	g.Expect(command.Complete()).To(Succeed())
+	g.Expect(command.Validate()).To(Succeed())
+	g.Expect(command.Run(t.Context())).To(Succeed())
+
+	text := out.String()
+	g.Expect(text).To(ContainSubstring("DESCRIPTION"))
+	g.Expect(text).To(ContainSubstring("components.codeflare.removal"))
+	g.Expect(text).ToNot(ContainSubstring("workloads."))
+	g.Expect(text).ToNot(ContainSubstring("APPLICABLE"))
+
+	out.Reset()
+
+	entries := runListCommand(t, command)
+	g.Expect(entries).To(HaveKey("components.codeflare.removal"))
+
+	entry := entries["components.codeflare.removal"]
+	g.Expect(entry.Group).To(Equal("component"))
+	g.Expect(entry.Kind).To(Equal("codeflare"))
+	g.Expect(entry.Type).To(Equal("removal"))
+	g.Expect(entry.Description).ToNot(BeEmpty())
+	g.Expect(entry.Applicability).To(BeEmpty())
+}
+
+func TestListCommand_OfflineVersions(t *testing.T) {
+	g := NewWithT(t)
+
+	command := newListCommand(&bytes.Buffer{})
+	command.CurrentVersion = "2.25"
+	command.TargetVersion = "3.0"
+
+	entries := runListCommand(t, command)
+
+	// Gated on the versions alone
+	g.Expect(entries["dependencies.certmanager.installed"].Applicability).To(Equal(lint.ApplicabilityYes))
+	g.Expect(entries["components.trainingoperator.deprecation"].Applicability).To(Equal(lint.ApplicabilityNo))
+	g.Expect(entries["components.trainingoperator.deprecation"].Reason).To(ContainSubstring("version gate not met"))
+
+	// Gated on cluster state
+	g.Expect(entries["components.codeflare.removal"].Applicability).To(Equal(lint.ApplicabilityUnknown))
+	g.Expect(entries["components.codeflare.removal"].Reason).To(ContainSubstring("--cluster"))
+	g.Expect(entries["services.managed-service.hive-namespaces"].Applicability).To(Equal(lint.ApplicabilityUnknown))
+}
+
+func TestListCommand_Cluster(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: map[schema.GroupVersionResource]string{
+			resources.DataScienceCluster.GVR(): resources.DataScienceCluster.ListKind(),
+			resources.DSCInitialization.GVR():  resources.DSCInitialization.ListKind(),
+		},
+		Objects: []*unstructured.Unstructured{
+			testutil.NewDSCI("redhat-ods-applications"),
+			testutil.NewDSC(map[string]string{"codeflare": "Managed", "modelmeshserving": "Removed"}),
+		},
+	})
+
+	command := newListCommand(&bytes.Buffer{})
+	command.Reader = target.Client
+	command.Cluster = true
+	command.CurrentVersion = "2.25"
+	command.TargetVersion = "3.0"
+	command.CheckSelectors = []string{"components.*"}
+
+	entries := runListCommand(t, command)
+
+	g.Expect(entries["components.codeflare.removal"].Applicability).To(Equal(lint.ApplicabilityYes))
+	g.Expect(entries["components.modelmesh.removal"].Applicability).To(Equal(lint.ApplicabilityNo))
+	g.Expect(entries["components.modelmesh.removal"].Reason).To(Equal("not applicable to the cluster configuration"))
+}
+
+func TestListCommand_Validate(t *testing.T) {
+	g := NewWithT(t)
+
+	command := newListCommand(&bytes.Buffer{})
+	command.TargetVersion = "3.0"
+	g.Expect(command.Validate()).To(MatchError(ContainSubstring("--target-version requires --current-version or --cluster")))
+
+	command = newListCommand(&bytes.Buffer{})
+	command.OutputFormat = "junit"
+	g.Expect(command.Validate()).To(MatchError(ContainSubstring("invalid output format")))
+
+	command = newListCommand(&bytes.Buffer{})
+	command.CurrentVersion = "latest"
+	g.Expect(command.Complete()).To(MatchError(ContainSubstring("invalid current version")))
+}
@@ -0,0 +1,48 @@
+package lint
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/opendatahub-io/odh-cli/internal/version"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/metrics"
+)
+
+// writeMetrics writes the check results of a run as Prometheus metrics to --metrics-file and
+// pushes them to --pushgateway-url, when set. Failing to write the file fails the run; failing
+// to push is reported as a warning, as for telemetry.
+func (c *Command) writeMetrics(
+	ctx context.Context,
+	targetVersion string,
+	resultsByGroup map[check.CheckGroup][]check.CheckExecution,
+) error {
+	if c.MetricsFile == "" && c.PushgatewayURL == "" {
+		return nil
+	}
+
+	run := metrics.Run{
+		CLIVersion:     version.GetVersion(),
+		ClusterVersion: c.currentClusterVersion,
+		TargetVersion:  targetVersion,
+		Timestamp:      time.Now(),
+	}
+	executions := FlattenResults(resultsByGroup)
+
+	if c.MetricsFile != "" {
+		if err := metrics.WriteFile(c.MetricsFile, run, executions); err != nil {
+			return fmt.Errorf("writing metrics: %w", err)
+		}
+
+		c.IO.Errorf("Wrote metrics to %s", c.MetricsFile)
+	}
+
+	if c.PushgatewayURL != "" {
+		if err := metrics.Push(ctx, c.httpClient, c.PushgatewayURL, run, executions); err != nil {
+			c.IO.Errorf("Warning: metrics not pushed: %v", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,309 @@
+package lint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/blang/semver/v4"
+	"github.com/spf13/pflag"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/printer/json"
+	"github.com/opendatahub-io/odh-cli/pkg/printer/yaml"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/rules"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+var _ cmd.Command = (*ObjectCommand)(nil)
+
+// ObjectOutputFormat represents the output format of the lint object command.
+type ObjectOutputFormat string
+
+const (
+	ObjectOutputFormatTable ObjectOutputFormat = "table"
+	ObjectOutputFormatJSON  ObjectOutputFormat = "json"
+	ObjectOutputFormatYAML  ObjectOutputFormat = "yaml"
+)
+
+// Validate checks if the object output format is valid.
+func (o ObjectOutputFormat) Validate() error {
+	switch o {
+	case ObjectOutputFormatTable, ObjectOutputFormatJSON, ObjectOutputFormatYAML:
+		return nil
+	default:
+		return fmt.Errorf("invalid output format: %s (must be one of: table, json, yaml)", o)
+	}
+}
+
+// ObjectCommand runs the workload checks that apply to a single named object and reports
+// their outcome for that object.
+type ObjectCommand struct {
+	IO iostreams.Interface
+
+	// ConfigFlags provides access to kubeconfig and context.
+	ConfigFlags *genericclioptions.ConfigFlags
+
+	// Reader is the cluster reader. Created from ConfigFlags when nil.
+	Reader client.Reader
+
+	// Object is the object to analyze as kind/namespace/name.
+	Object string
+
+	// TargetVersion is the version being upgraded to; without it the object is linted
+	// against the current version.
+	TargetVersion string
+
+	// OutputFormat specifies the report output format (table, json, yaml)
+	OutputFormat ObjectOutputFormat
+
+	// CheckSelectors filters which of the routed checks are run (glob patterns, repeatable)
+	CheckSelectors []string
+
+	// FailOnCritical exits with non-zero code if the object has blocking findings
+	FailOnCritical bool
+
+	// FailOnWarning exits with non-zero code if the object has advisory findings
+	FailOnWarning bool
+
+	// Verbose enables progress messages
+	Verbose bool
+
+	// Timeout is the maximum duration for command execution
+	Timeout time.Duration
+
+	// ImageStreamNamespaces overrides the namespaces workbench ImageStreams are looked up in
+	ImageStreamNamespaces []string
+
+	// registry is the check registry for this command instance.
+	registry *check.CheckRegistry
+
+	ref                 ObjectRef
+	resourceTypes       []resources.ResourceType
+	parsedTargetVersion *semver.Version
+}
+
+// NewObjectCommand creates a new ObjectCommand populated with all lint checks.
+func NewObjectCommand(streams genericiooptions.IOStreams, configFlags *genericclioptions.ConfigFlags) *ObjectCommand {
+	return &ObjectCommand{
+		IO:             iostreams.NewIOStreams(streams.In, streams.Out, streams.ErrOut),
+		ConfigFlags:    configFlags,
+		OutputFormat:   ObjectOutputFormatTable,
+		CheckSelectors: []string{"*"},
+		FailOnCritical: true,
+		Timeout:        DefaultTimeout,
+		registry:       NewRegistry(),
+	}
+}
+
+// AddFlags registers command-specific flags with the provided FlagSet.
+func (c *ObjectCommand) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&c.TargetVersion, "target-version", "", flagDescTargetVersion)
+	fs.StringVarP((*string)(&c.OutputFormat), "output", "o", string(ObjectOutputFormatTable), flagDescObjectOutput)
+	fs.StringArrayVar(&c.CheckSelectors, "checks", []string{"*"}, flagDescChecks)
+	fs.BoolVar(&c.FailOnCritical, "fail-on-critical", true, flagDescFailCritical)
+	fs.BoolVar(&c.FailOnWarning, "fail-on-warning", false, flagDescFailWarning)
+	cmd.FailOnVar(fs, &c.FailOnCritical, &c.FailOnWarning, flagDescFailOn)
+	fs.BoolVarP(&c.Verbose, "verbose", "v", false, flagDescVerbose)
+	fs.DurationVar(&c.Timeout, "timeout", c.Timeout, flagDescTimeout)
+	fs.StringSliceVar(&c.ImageStreamNamespaces, "imagestream-namespace", nil, flagDescImageStreamNamespace)
+}
+
+// Complete parses the object reference and the target version, and creates the cluster client.
+func (c *ObjectCommand) Complete() error {
+	ref, err := ParseObjectRef(c.Object)
+	if err != nil {
+		return err
+	}
+
+	c.ref = ref
+
+	types, err := ResolveObjectKind(ref.Kind)
+	if err != nil {
+		return err
+	}
+
+	c.resourceTypes = types
+
+	if c.TargetVersion != "" {
+		v, err := semver.ParseTolerant(c.TargetVersion)
+		if err != nil {
+			return fmt.Errorf("invalid target version %q: %w", c.TargetVersion, err)
+		}
+
+		c.parsedTargetVersion = &v
+	}
+
+	if !c.Verbose {
+		c.IO = iostreams.NewQuietWrapper(c.IO)
+	}
+
+	if _, err := rules.ApplyInstalled(); err != nil {
+		c.IO.Errorf("Warning: ignoring installed rules bundle: %v", err)
+	}
+
+	if c.Reader != nil {
+		return nil
+	}
+
+	cl, err := client.NewClient(c.ConfigFlags)
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	c.Reader = cl
+
+	return nil
+}
+
+// Validate checks that all required options are valid.
+func (c *ObjectCommand) Validate() error {
+	if err := c.OutputFormat.Validate(); err != nil {
+		return err
+	}
+
+	if c.Timeout <= 0 {
+		return errors.New("timeout must be greater than 0")
+	}
+
+	return ValidateCheckSelectors(c.CheckSelectors)
+}
+
+// Run fetches the object, runs the workload checks routed to its kind against it and writes
+// the object report.
+func (c *ObjectCommand) Run(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+
+	obj, err := c.fetchObject(ctx)
+	if err != nil {
+		return err
+	}
+
+	target, err := c.target(ctx, obj)
+	if err != nil {
+		return err
+	}
+
+	selected, err := c.registry.ListByPatterns(c.CheckSelectors, check.GroupWorkload)
+	if err != nil {
+		return fmt.Errorf("selecting checks: %w", err)
+	}
+
+	routed := RouteObjectChecks(selected, c.resourceTypes)
+	c.IO.Errorf("Running %d checks routed to %s...\n", len(routed), obj.GetKind())
+
+	var executions []check.CheckExecution
+
+	if len(routed) > 0 {
+		ids := make([]string, 0, len(routed))
+		for _, chk := range routed {
+			ids = append(ids, chk.ID())
+		}
+
+		executions, err = check.NewExecutor(c.registry, c.IO).ExecuteSelective(ctx, target, ids, check.GroupWorkload)
+		if err != nil {
+			return fmt.Errorf("executing checks: %w", err)
+		}
+	}
+
+	report := BuildObjectReport(obj, routed, executions, target)
+
+	if err := c.output(report); err != nil {
+		return err
+	}
+
+	switch {
+	case c.FailOnCritical && report.Impact == result.ImpactBlocking:
+		return cmd.NewExitError(cmd.ExitCodeBlocking, errors.New("blocking findings detected"))
+	case c.FailOnWarning && report.Impact != result.ImpactNone:
+		return cmd.NewExitError(cmd.ExitCodeAdvisory, errors.New("advisory findings detected"))
+	default:
+		return nil
+	}
+}
+
+// fetchObject gets the object, trying each served version of its kind in turn.
+func (c *ObjectCommand) fetchObject(ctx context.Context) (*unstructured.Unstructured, error) {
+	for _, rt := range c.resourceTypes {
+		obj, err := c.Reader.GetResource(ctx, rt, c.ref.Name, client.InNamespace(c.ref.Namespace))
+
+		switch {
+		case client.IsResourceTypeNotFound(err):
+			continue
+		case err != nil:
+			return nil, fmt.Errorf("getting %s: %w", c.ref, err)
+		case obj == nil:
+			return nil, fmt.Errorf("getting %s: permission denied", c.ref)
+		default:
+			return obj, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s %s/%s not found", c.resourceTypes[0].Kind, c.ref.Namespace, c.ref.Name)
+}
+
+// target returns the target the routed checks are run against: the detected versions and
+// flavor, the object, and a reader scoped to it.
+func (c *ObjectCommand) target(ctx context.Context, obj *unstructured.Unstructured) (check.Target, error) {
+	currentVersion, err := version.Detect(ctx, c.Reader)
+	if err != nil {
+		return check.Target{}, fmt.Errorf("detecting cluster version: %w", err)
+	}
+
+	flavor, err := version.DetectFlavor(ctx, c.Reader)
+	if err != nil {
+		c.IO.Errorf("Warning: failed to detect the management flavor: %v", err)
+	}
+
+	target := check.Target{
+		Client:         &objectScopedReader{Reader: c.Reader, object: obj},
+		CurrentVersion: currentVersion,
+		TargetVersion:  currentVersion,
+		Flavor:         flavor,
+		Resource:       obj,
+		IO:             c.IO,
+
+		ImageStreamNamespaces: c.ImageStreamNamespaces,
+	}
+
+	if c.parsedTargetVersion != nil {
+		target.TargetVersion = c.parsedTargetVersion
+	}
+
+	return target, nil
+}
+
+// output writes the report in the requested format.
+func (c *ObjectCommand) output(report ObjectReport) error {
+	switch c.OutputFormat {
+	case ObjectOutputFormatJSON:
+		renderer := json.NewRenderer[ObjectReport](json.WithWriter[ObjectReport](c.IO.Out()))
+		if err := renderer.Render(report); err != nil {
+			return fmt.Errorf("rendering object report: %w", err)
+		}
+
+		return nil
+	case ObjectOutputFormatYAML:
+		renderer := yaml.NewRenderer[ObjectReport](yaml.WithWriter[ObjectReport](c.IO.Out()))
+		if err := renderer.Render(report); err != nil {
+			return fmt.Errorf("rendering object report: %w", err)
+		}
+
+		return nil
+	case ObjectOutputFormatTable:
+		return outputObjectReportTable(c.IO.Out(), report)
+	default:
+		return fmt.Errorf("unsupported output format: %s", c.OutputFormat)
+	}
+}
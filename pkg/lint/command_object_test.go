@@ -0,0 +1,139 @@
+package lint_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/selftest"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+// newObjectCommand returns an object command against the cluster of a self-test scenario.
+func newObjectCommand(t *testing.T, scenario string, out *bytes.Buffer) *lint.ObjectCommand {
+	t.Helper()
+
+	var cluster client.Client
+
+	for _, s := range selftest.Scenarios() {
+		if s.Name != scenario {
+			continue
+		}
+
+		objects, err := s.Objects()
+		if err != nil {
+			t.Fatalf("loading scenario %s: %v", scenario, err)
+		}
+
+		cluster, err = selftest.NewCluster(objects)
+		if err != nil {
+			t.Fatalf("creating cluster: %v", err)
+		}
+	}
+
+	command := lint.NewObjectCommand(genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: out, ErrOut: &bytes.Buffer{}}, testConfigFlags())
+	command.Reader = cluster
+
+	return command
+}
+
+func TestObjectCommand_Upgrade(t *testing.T) {
+	g := NewWithT(t)
+
+	var out bytes.Buffer
+
+	command := newObjectCommand(t, "upgrade-blocked", &out)
+	command.Object = "notebook/team-a/workbench"
+	command.TargetVersion = "3.0"
+	command.OutputFormat = lint.ObjectOutputFormatJSON
+	command.FailOnCritical = false
+
+	g.Expect(command.Complete()).To(Succeed())
+	g.Expect(command.Validate()).To(Succeed())
+	g.Expect(command.Run(t.Context())).To(Succeed())
+
+	var report lint.ObjectReport
+	g.Expect(json.Unmarshal(out.Bytes(), &report)).To(Succeed())
+
+	g.Expect(report.Kind).To(Equal("Notebook"))
+	g.Expect(report.Namespace).To(Equal("team-a"))
+	g.Expect(report.Name).To(Equal("workbench"))
+	g.Expect(report.CurrentVersion).To(Equal("2.25.0"))
+	g.Expect(report.TargetVersion).To(Equal("3.0.0"))
+
+	statuses := make(map[string]lint.ObjectStatus, len(report.Checks))
+	for _, entry := range report.Checks {
+		g.Expect(entry.CheckID).To(HavePrefix("workloads."))
+		statuses[entry.CheckID] = entry.Status
+	}
+
+	// Only checks reading Notebooks are routed to the object
+	g.Expect(statuses).To(HaveKeyWithValue("workloads.notebook.accelerator-migration", lint.ObjectStatusImpacted))
+	g.Expect(statuses).ToNot(HaveKey("workloads.ray.impacted-workloads"))
+	g.Expect(statuses).ToNot(HaveKey("workloads.kserve.impacted-workloads"))
+}
+
+func TestObjectCommand_Table(t *testing.T) {
+	g := NewWithT(t)
+
+	var out bytes.Buffer
+
+	command := newObjectCommand(t, "upgrade-blocked", &out)
+	command.Object = "notebooks.kubeflow.org/team-a/workbench"
+	command.TargetVersion = "3.0"
+	command.CheckSelectors = []string{"workloads.notebook.accelerator-migration"}
+	command.FailOnWarning = true
+
+	g.Expect(command.Complete()).To(Succeed())
+	g.Expect(command.Validate()).To(Succeed())
+
+	err := command.Run(t.Context())
+	g.Expect(err).To(MatchError("advisory findings detected"))
+	g.Expect(cmd.ExitCode(err)).To(Equal(cmd.ExitCodeAdvisory))
+
+	text := out.String()
+	g.Expect(text).To(ContainSubstring("Notebook team-a/workbench"))
+	g.Expect(text).To(ContainSubstring("Upgrade: 2.25.0 → 3.0.0"))
+	g.Expect(text).To(ContainSubstring("workloads.notebook.accelerator-migration"))
+	g.Expect(text).To(ContainSubstring("Remediation:"))
+	g.Expect(text).To(ContainSubstring("Findings: 0 blocking, 1 advisory"))
+}
+
+func TestObjectCommand_Errors(t *testing.T) {
+	g := NewWithT(t)
+
+	command := newObjectCommand(t, "upgrade-blocked", &bytes.Buffer{})
+	command.Object = "notebook/team-a"
+	g.Expect(command.Complete()).To(MatchError(ContainSubstring("must be kind/namespace/name")))
+
+	command = newObjectCommand(t, "upgrade-blocked", &bytes.Buffer{})
+	command.Object = "imagestream/redhat-ods-applications/pytorch"
+	g.Expect(command.Complete()).To(MatchError(ContainSubstring(`unsupported kind "imagestream"`)))
+
+	command = newObjectCommand(t, "upgrade-blocked", &bytes.Buffer{})
+	command.Object = "notebook/team-a/missing"
+	g.Expect(command.Complete()).To(Succeed())
+	g.Expect(command.Run(t.Context())).To(MatchError("Notebook team-a/missing not found"))
+}
+
+func TestResolveObjectKind(t *testing.T) {
+	g := NewWithT(t)
+
+	for _, kind := range []string{"notebook", "Notebook", "notebooks", "notebooks.kubeflow.org", "Notebook.kubeflow.org"} {
+		types, err := lint.ResolveObjectKind(kind)
+		g.Expect(err).ToNot(HaveOccurred(), "kind %q", kind)
+		g.Expect(types).To(HaveLen(1))
+		g.Expect(types[0].Kind).To(Equal("Notebook"))
+	}
+
+	// Every served version of a kind is tried
+	types, err := lint.ResolveObjectKind("datasciencepipelinesapplication")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(types).To(HaveLen(2))
+}
@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"io"
 	"path"
+	"slices"
 	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/fatih/color"
@@ -14,11 +17,13 @@ import (
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 
+	"github.com/opendatahub-io/odh-cli/pkg/backup"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	printerjson "github.com/opendatahub-io/odh-cli/pkg/printer/json"
 	"github.com/opendatahub-io/odh-cli/pkg/printer/table"
 	printeryaml "github.com/opendatahub-io/odh-cli/pkg/printer/yaml"
+	"github.com/opendatahub-io/odh-cli/pkg/snapshot"
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
 	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
 )
@@ -27,12 +32,18 @@ import (
 type OutputFormat string
 
 const (
-	OutputFormatTable OutputFormat = "table"
-	OutputFormatJSON  OutputFormat = "json"
-	OutputFormatYAML  OutputFormat = "yaml"
+	OutputFormatTable    OutputFormat = "table"
+	OutputFormatJSON     OutputFormat = "json"
+	OutputFormatYAML     OutputFormat = "yaml"
+	OutputFormatJUnit    OutputFormat = "junit"
+	OutputFormatHTML     OutputFormat = "html"
+	OutputFormatMarkdown OutputFormat = "markdown"
 
 	// DefaultTimeout is the default timeout for lint commands.
 	DefaultTimeout = 5 * time.Minute
+
+	// DefaultWatchInterval is the default time between two runs of lint --watch.
+	DefaultWatchInterval = 5 * time.Minute
 )
 
 //nolint:gochecknoglobals
@@ -49,16 +60,58 @@ var (
 
 	// Table headers.
 	tableHeaders = []string{"STATUS", "GROUP", "KIND", "CHECK", "IMPACT", "MESSAGE"}
+
+	// timingHeaders are appended to tableHeaders with --show-timings.
+	timingHeaders = []string{"DURATION", "API CALLS"}
 )
 
 // Validate checks if the output format is valid.
 func (o OutputFormat) Validate() error {
 	switch o {
-	case OutputFormatTable, OutputFormatJSON, OutputFormatYAML:
+	case OutputFormatTable, OutputFormatJSON, OutputFormatYAML, OutputFormatJUnit, OutputFormatHTML,
+		OutputFormatMarkdown:
 		return nil
 	default:
-		return fmt.Errorf("invalid output format: %s (must be one of: table, json, yaml)", o)
+		return fmt.Errorf("invalid output format: %s (must be one of: table, json, yaml, junit, html, markdown)", o)
+	}
+}
+
+// OutputDestination is a single rendering target for lint results.
+type OutputDestination struct {
+	// Format is the output format rendered to this destination.
+	Format OutputFormat
+
+	// Path is the file the results are written to; empty means stdout.
+	Path string
+}
+
+// String returns the destination in flag syntax (format or format=path).
+func (d OutputDestination) String() string {
+	if d.Path == "" {
+		return string(d.Format)
+	}
+
+	return string(d.Format) + "=" + d.Path
+}
+
+// ParseOutputDestination parses an --output value of the form format or format=path.
+func ParseOutputDestination(spec string) (OutputDestination, error) {
+	format, path, hasPath := strings.Cut(spec, "=")
+
+	dest := OutputDestination{
+		Format: OutputFormat(strings.TrimSpace(format)),
+		Path:   strings.TrimSpace(path),
+	}
+
+	if err := dest.Format.Validate(); err != nil {
+		return OutputDestination{}, err
+	}
+
+	if hasPath && dest.Path == "" {
+		return OutputDestination{}, fmt.Errorf("invalid output %q: missing file path after '='", spec)
 	}
+
+	return dest, nil
 }
 
 // SharedOptions contains options common to all lint subcommands.
@@ -70,11 +123,20 @@ type SharedOptions struct {
 	ConfigFlags *genericclioptions.ConfigFlags
 
 	// OutputFormat specifies the output format (table, json, yaml)
+	// used when no OutputSpecs are given.
 	OutputFormat OutputFormat
 
+	// OutputSpecs lists output destinations as format or format=path (repeatable).
+	// At most one destination may write to stdout.
+	OutputSpecs []string
+
 	// CheckSelectors filters which checks to run (glob patterns, repeatable)
 	CheckSelectors []string
 
+	// ChecksFromFile is the optional file of check selectors, one per line, merged with
+	// CheckSelectors during Complete
+	ChecksFromFile string
+
 	// FailOnCritical exits with non-zero code if critical findings detected
 	FailOnCritical bool
 
@@ -90,9 +152,43 @@ type SharedOptions struct {
 	// Timeout is the maximum duration for command execution
 	Timeout time.Duration
 
-	// Client is the Kubernetes client (populated during Complete)
+	// Concurrency is the maximum number of checks executed concurrently
+	Concurrency int
+
+	// CheckTimeout bounds the execution of each check; zero means bounded by Timeout only
+	CheckTimeout time.Duration
+
+	// Strict fails the run when a check returns a result violating DiagnosticResult.ValidateStrict
+	Strict bool
+
+	// Probe enables opt-in checks that connect to workload endpoints
+	Probe bool
+
+	// ImageStreamNamespaces overrides the namespaces workbench ImageStreams are looked up in
+	ImageStreamNamespaces []string
+
+	// Trace logs each Kubernetes API request made during a check
+	Trace bool
+
+	// FromBackup is the optional backup directory checks are run against instead of the cluster
+	FromBackup string
+
+	// FromSnapshot is the optional snapshot archive checks are run against instead of the cluster
+	FromSnapshot string
+
+	// Client is the Kubernetes client (populated during Complete, nil with FromBackup or FromSnapshot)
 	Client client.Client
 
+	// Reader serves the checks: the Client, or the backup or snapshot (populated during Complete)
+	Reader client.Reader
+
+	// snapshotManifest is the manifest of the FromSnapshot archive (populated during Complete)
+	snapshotManifest *snapshot.Manifest
+
+	// impactOverrides replaces the impact of check findings, keyed by check ID (populated from
+	// the lint configuration file)
+	impactOverrides map[string]check.ImpactOverride
+
 	// Throttling settings for Kubernetes API client
 	QPS   float32
 	Burst int
@@ -111,6 +207,7 @@ func NewSharedOptions(
 		FailOnCritical: true,           // Exit with error on critical findings (default)
 		FailOnWarning:  false,          // Don't exit on warnings by default
 		Timeout:        DefaultTimeout, // Default timeout to prevent hanging on slow clusters
+		Concurrency:    check.DefaultConcurrency,
 		IO:             iostreams.NewIOStreams(streams.In, streams.Out, streams.ErrOut),
 		QPS:            client.DefaultQPS,
 		Burst:          client.DefaultBurst,
@@ -118,13 +215,57 @@ func NewSharedOptions(
 }
 
 // Complete populates the client and performs pre-validation setup.
+// A Client that is already set (e.g. via WithClient) is kept. With FromBackup or FromSnapshot,
+// no client is created and checks read the backup directory or snapshot archive.
 func (o *SharedOptions) Complete() error {
+	if o.ChecksFromFile != "" {
+		selectors, err := LoadCheckSelectors(o.ChecksFromFile)
+		if err != nil {
+			return fmt.Errorf("loading --checks-from-file: %w", err)
+		}
+
+		o.CheckSelectors = mergeCheckSelectors(o.CheckSelectors, selectors)
+	}
+
+	if o.FromSnapshot != "" {
+		r, manifest, err := snapshot.Open(o.FromSnapshot)
+		if err != nil {
+			return fmt.Errorf("loading --from-snapshot: %w", err)
+		}
+
+		o.Reader = r
+		o.snapshotManifest = manifest
+
+		return nil
+	}
+
+	if o.FromBackup != "" {
+		r, err := backup.NewReader(o.FromBackup)
+		if err != nil {
+			return err
+		}
+
+		o.Reader = r
+
+		return nil
+	}
+
+	if o.Client != nil {
+		o.Reader = o.Client
+
+		return nil
+	}
+
 	// Create REST config with user-specified throttling
 	restConfig, err := client.NewRESTConfig(o.ConfigFlags, o.QPS, o.Burst)
 	if err != nil {
 		return fmt.Errorf("failed to create REST config: %w", err)
 	}
 
+	if spec, ok := client.ActiveChaos(); ok {
+		o.IO.Errorf("Warning: injecting Kubernetes API faults (%s=%s); results are not meaningful", client.EnvChaos, spec)
+	}
+
 	// Create client with configured throttling
 	c, err := client.NewClientWithConfig(restConfig)
 	if err != nil {
@@ -132,14 +273,15 @@ func (o *SharedOptions) Complete() error {
 	}
 
 	o.Client = c
+	o.Reader = c
 
 	return nil
 }
 
 // Validate checks that all required options are valid.
 func (o *SharedOptions) Validate() error {
-	// Validate output format
-	if err := o.OutputFormat.Validate(); err != nil {
+	// Validate output destinations
+	if _, err := o.OutputDestinations(); err != nil {
 		return err
 	}
 
@@ -153,9 +295,94 @@ func (o *SharedOptions) Validate() error {
 		return errors.New("timeout must be greater than 0")
 	}
 
+	if o.Concurrency < 1 {
+		return errors.New("concurrency must be at least 1")
+	}
+
+	if o.CheckTimeout < 0 {
+		return errors.New("check timeout must not be negative")
+	}
+
 	return nil
 }
 
+// NewExecutor creates a check executor configured with the concurrency, per-check timeout,
+// strict validation and request tracing.
+func (o *SharedOptions) NewExecutor(registry *check.CheckRegistry) *check.Executor {
+	opts := []check.ExecutorOption{
+		check.WithConcurrency(o.Concurrency),
+		check.WithCheckTimeout(o.CheckTimeout),
+	}
+
+	if o.Strict {
+		opts = append(opts, check.WithStrictValidation())
+	}
+
+	if len(o.impactOverrides) > 0 {
+		opts = append(opts, check.WithImpactOverrides(o.impactOverrides))
+	}
+
+	if o.Trace {
+		opts = append(opts, check.WithRequestTrace())
+	}
+
+	return check.NewExecutor(registry, o.IO, opts...)
+}
+
+// OutputDestinations resolves OutputSpecs into output destinations.
+// When no specs are given, results are rendered to stdout in OutputFormat.
+func (o *SharedOptions) OutputDestinations() ([]OutputDestination, error) {
+	if len(o.OutputSpecs) == 0 {
+		if err := o.OutputFormat.Validate(); err != nil {
+			return nil, err
+		}
+
+		return []OutputDestination{{Format: o.OutputFormat}}, nil
+	}
+
+	destinations := make([]OutputDestination, 0, len(o.OutputSpecs))
+	stdout := ""
+	paths := make(map[string]bool)
+
+	for _, spec := range o.OutputSpecs {
+		dest, err := ParseOutputDestination(spec)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case dest.Path == "" && stdout != "":
+			return nil, fmt.Errorf("outputs %q and %q both write to stdout; add =<path> to one of them", stdout, spec)
+		case dest.Path == "":
+			stdout = spec
+		case paths[dest.Path]:
+			return nil, fmt.Errorf("output path %s is used more than once", dest.Path)
+		default:
+			paths[dest.Path] = true
+		}
+
+		destinations = append(destinations, dest)
+	}
+
+	return destinations, nil
+}
+
+// writesFormat reports whether any output destination renders the given format.
+func (o *SharedOptions) writesFormat(format OutputFormat) bool {
+	destinations, err := o.OutputDestinations()
+	if err != nil {
+		return false
+	}
+
+	for _, dest := range destinations {
+		if dest.Format == format {
+			return true
+		}
+	}
+
+	return false
+}
+
 // ValidateCheckSelectors validates all check selector patterns.
 func ValidateCheckSelectors(selectors []string) error {
 	if len(selectors) == 0 {
@@ -213,6 +440,14 @@ func WithTargetVersion(version string) CommandOption {
 	}
 }
 
+// WithClient returns a CommandOption that uses the given client instead of one built
+// from ConfigFlags, e.g. to run the check suite against a simulated cluster.
+func WithClient(c client.Client) CommandOption {
+	return func(cmd *Command) {
+		cmd.Client = c
+	}
+}
+
 // CheckResultOutput represents a check result for JSON/YAML output.
 type CheckResultOutput struct {
 	CheckID     string         `json:"checkId"               yaml:"checkId"`
@@ -235,6 +470,8 @@ type CheckResultTableRow struct {
 	Impact      string
 	Message     string
 	Description string
+	Duration    string
+	APICalls    string `mapstructure:"API CALLS"`
 }
 
 // LintOutput represents the full lint output for JSON/YAML.
@@ -309,20 +546,35 @@ type TableOutputOptions struct {
 	// NamespaceRequesters maps namespace names to their openshift.io/requester annotation value.
 	// Used when ShowImpactedObjects is true to display the requester for each namespace group.
 	NamespaceRequesters map[string]string
+
+	// ShowTeamRollup appends the per-team remediation rollup of assigned impacted objects.
+	ShowTeamRollup bool
+
+	// Columns replaces the per-condition table with one row per check result and these columns.
+	Columns []CustomColumn
+
+	// ShowTimings adds the duration and Kubernetes API request count of each check.
+	ShowTimings bool
 }
 
 // OutputTable is a shared function for outputting check results in table format.
 // When opts.ShowImpactedObjects is true, impacted objects are listed after the summary.
+// When opts.Columns is set, the table has one row per check result with those columns.
 func OutputTable(out io.Writer, results []check.CheckExecution, opts TableOutputOptions) error {
 	totalChecks := 0
 	totalPassed := 0
 	totalWarnings := 0
 	totalFailed := 0
 
+	headers := tableHeaders
+	if opts.ShowTimings {
+		headers = append(slices.Clone(tableHeaders), timingHeaders...)
+	}
+
 	// Create single table renderer for all results
 	renderer := table.NewRenderer[CheckResultTableRow](
 		table.WithWriter[CheckResultTableRow](out),
-		table.WithHeaders[CheckResultTableRow](tableHeaders...),
+		table.WithHeaders[CheckResultTableRow](headers...),
 		table.WithTableOptions[CheckResultTableRow](table.DefaultTableOptions...),
 	)
 
@@ -364,13 +616,26 @@ func OutputTable(out io.Writer, results []check.CheckExecution, opts TableOutput
 				Description: exec.Result.Spec.Description,
 			}
 
+			if exec.Timing != nil {
+				row.Duration = exec.Timing.Duration().Round(time.Millisecond).String()
+				row.APICalls = strconv.FormatInt(exec.Timing.APICalls, 10)
+			}
+
+			if len(opts.Columns) > 0 {
+				continue
+			}
+
 			if err := renderer.Append(row); err != nil {
 				return fmt.Errorf("appending table row: %w", err)
 			}
 		}
 	}
 
-	if err := renderer.Render(); err != nil {
+	if len(opts.Columns) > 0 {
+		if err := outputCustomColumns(out, results, opts.Columns); err != nil {
+			return err
+		}
+	} else if err := renderer.Render(); err != nil {
 		return fmt.Errorf("rendering table: %w", err)
 	}
 
@@ -378,13 +643,51 @@ func OutputTable(out io.Writer, results []check.CheckExecution, opts TableOutput
 	_, _ = fmt.Fprintln(out, "Summary:")
 	_, _ = fmt.Fprintf(out, "  Total: %d | Passed: %d | Warnings: %d | Failed: %d\n", totalChecks, totalPassed, totalWarnings, totalFailed)
 
+	outputKnowledgeLinks(out, results)
+
 	if opts.ShowImpactedObjects {
 		outputImpactedObjects(out, results, opts.NamespaceRequesters)
+		outputObjectRollup(out, results)
+	}
+
+	if opts.ShowTeamRollup {
+		if err := outputTeamRollup(out, results, time.Now()); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
+// outputKnowledgeLinks prints the knowledge links of the checks with findings, so a finding
+// can be followed straight to the matching knowledge base article.
+func outputKnowledgeLinks(out io.Writer, results []check.CheckExecution) {
+	printed := false
+
+	for _, exec := range results {
+		if len(exec.Result.Spec.KnowledgeLinks) == 0 {
+			continue
+		}
+
+		if impact := exec.Result.GetImpact(); impact == nil || *impact == string(result.ImpactNone) {
+			continue
+		}
+
+		if !printed {
+			_, _ = fmt.Fprintln(out)
+			_, _ = fmt.Fprintln(out, "Knowledge Base:")
+
+			printed = true
+		}
+
+		_, _ = fmt.Fprintf(out, "  %s / %s / %s:\n", exec.Result.Group, exec.Result.Kind, exec.Result.Name)
+
+		for _, link := range exec.Result.Spec.KnowledgeLinks {
+			_, _ = fmt.Fprintf(out, "    - %s\n", link)
+		}
+	}
+}
+
 // impactedGroup holds aggregated impacted objects for a specific check.
 type impactedGroup struct {
 	group     check.CheckGroup
@@ -491,14 +794,58 @@ func outputImpactedObjects(
 	}
 }
 
+// diagnosticResults returns the diagnostic results of the executions.
+func diagnosticResults(results []check.CheckExecution) []*result.DiagnosticResult {
+	diagnostics := make([]*result.DiagnosticResult, 0, len(results))
+	for _, exec := range results {
+		diagnostics = append(diagnostics, exec.Result)
+	}
+
+	return diagnostics
+}
+
+// outputObjectRollup prints, for each object reported by more than one check, all of its
+// findings, since such objects are remediated once for all checks.
+func outputObjectRollup(out io.Writer, results []check.CheckExecution) {
+	rollups := result.NewObjectIndex(diagnosticResults(results)).Rollup(2)
+	if len(rollups) == 0 {
+		return
+	}
+
+	_, _ = fmt.Fprintln(out)
+	_, _ = fmt.Fprintln(out, "Objects with Multiple Findings:")
+
+	for _, rollup := range rollups {
+		name := rollup.Name
+		if rollup.Namespace != "" {
+			name = rollup.Namespace + "/" + rollup.Name
+		}
+
+		if rollup.Kind != "" {
+			name = fmt.Sprintf("%s (%s)", name, rollup.Kind)
+		}
+
+		_, _ = fmt.Fprintf(out, "  %s:\n", name)
+
+		for _, f := range rollup.Findings {
+			impact := string(f.Impact)
+			if impact == "" {
+				impact = "info"
+			}
+
+			_, _ = fmt.Fprintf(out, "    - [%s] %s / %s / %s: %s\n", impact, f.Group, f.Kind, f.Name, f.Message)
+		}
+	}
+}
+
 // formatImpactedObject returns the display string for an impacted object.
 // Includes the Kind from TypeMeta when available to help identify the resource type.
 func formatImpactedObject(obj metav1.PartialObjectMetadata) string {
 	if obj.Kind != "" {
-		return fmt.Sprintf("%s (%s)", obj.Name, obj.Kind)
+		return fmt.Sprintf("%s (%s)%s", obj.Name, obj.Kind, formatAssignment(obj.Annotations))
 	}
 
-	return obj.Name
+	return obj.Name + formatAssignment(obj.Annotations)
 }
 
 // groupByNamespace sub-groups objects by namespace, sorted alphabetically.
@@ -529,9 +876,14 @@ func groupByNamespace(objects []metav1.PartialObjectMetadata) []namespaceGroup {
 	return groups
 }
 
-// OutputJSON outputs diagnostic results in List format.
-func OutputJSON(out io.Writer, results []check.CheckExecution, clusterVersion *string, targetVersion *string) error {
-	// Create the list
+// newResultList converts the executions into the DiagnosticResultList rendered by the
+// document output formats. Upgrade assessments (with a target version) carry the readiness
+// verdict.
+func newResultList(
+	results []check.CheckExecution,
+	clusterVersion *string,
+	targetVersion *string,
+) *result.DiagnosticResultList {
 	list := result.NewDiagnosticResultList(clusterVersion, targetVersion)
 
 	// Add all results in execution order
@@ -539,6 +891,20 @@ func OutputJSON(out io.Writer, results []check.CheckExecution, clusterVersion *s
 		list.Results = append(list.Results, exec.Result)
 	}
 
+	list.Flavor = resultsFlavor(results)
+	list.IndexObjects()
+
+	if targetVersion != nil && *targetVersion != "" {
+		list.AssessReadiness()
+	}
+
+	return list
+}
+
+// OutputJSON outputs diagnostic results in List format.
+func OutputJSON(out io.Writer, results []check.CheckExecution, clusterVersion *string, targetVersion *string) error {
+	list := newResultList(results, clusterVersion, targetVersion)
+
 	renderer := printerjson.NewRenderer[*result.DiagnosticResultList](
 		printerjson.WithWriter[*result.DiagnosticResultList](out),
 	)
@@ -550,16 +916,26 @@ func OutputJSON(out io.Writer, results []check.CheckExecution, clusterVersion *s
 	return nil
 }
 
-// OutputYAML outputs diagnostic results in List format.
-func OutputYAML(out io.Writer, results []check.CheckExecution, clusterVersion *string, targetVersion *string) error {
-	// Create the list
-	list := result.NewDiagnosticResultList(clusterVersion, targetVersion)
-
-	// Add all results in execution order
+// resultsFlavor returns the management flavor the results were produced for, as annotated
+// by the executor, or an empty string when it was not detected.
+func resultsFlavor(results []check.CheckExecution) string {
 	for _, exec := range results {
-		list.Results = append(list.Results, exec.Result)
+		if exec.Result == nil {
+			continue
+		}
+
+		if flavor, ok := exec.Result.Annotations[check.AnnotationClusterFlavor]; ok {
+			return flavor
+		}
 	}
 
+	return ""
+}
+
+// OutputYAML outputs diagnostic results in List format.
+func OutputYAML(out io.Writer, results []check.CheckExecution, clusterVersion *string, targetVersion *string) error {
+	list := newResultList(results, clusterVersion, targetVersion)
+
 	renderer := printeryaml.NewRenderer[*result.DiagnosticResultList](
 		printeryaml.WithWriter[*result.DiagnosticResultList](out),
 	)
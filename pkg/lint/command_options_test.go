@@ -4,8 +4,11 @@ import (
 	"bytes"
 	"fmt"
 	"testing"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
 
 	"github.com/opendatahub-io/odh-cli/pkg/lint"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
@@ -257,6 +260,61 @@ func TestOutputTable_VerboseNoImpactedObjects(t *testing.T) {
 	g.Expect(output).ToNot(ContainSubstring("Impacted Objects:"))
 }
 
+func TestOutputTable_KnowledgeLinks(t *testing.T) {
+	g := NewWithT(t)
+
+	results := remediationExecutions()
+	results[0].Result.Spec.KnowledgeLinks = []string{check.KnowledgeBaseURL + "7012345", check.DocsDistributedWorkloads}
+	results = append(results, check.CheckExecution{
+		Result: &result.DiagnosticResult{
+			Group:  "components",
+			Kind:   "dashboard",
+			Name:   "version-check",
+			Spec:   result.DiagnosticSpec{KnowledgeLinks: []string{check.DocsUpgrading}},
+			Status: result.DiagnosticStatus{Conditions: []result.Condition{passCondition()}},
+		},
+	})
+
+	var buf bytes.Buffer
+	g.Expect(lint.OutputTable(&buf, results, lint.TableOutputOptions{})).To(Succeed())
+
+	output := buf.String()
+	g.Expect(output).To(ContainSubstring("Knowledge Base:\n  components / codeflare / removal:\n" +
+		"    - " + check.KnowledgeBaseURL + "7012345\n" +
+		"    - " + check.DocsDistributedWorkloads + "\n"))
+	// Passing checks have nothing to look up
+	g.Expect(output).ToNot(ContainSubstring(check.DocsUpgrading))
+}
+
+func TestOutputTable_ShowTimings(t *testing.T) {
+	g := NewWithT(t)
+
+	startedAt := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	results := []check.CheckExecution{{
+		Result: &result.DiagnosticResult{
+			Group:  "components",
+			Kind:   "dashboard",
+			Name:   "version-check",
+			Status: result.DiagnosticStatus{Conditions: []result.Condition{passCondition()}},
+		},
+		Timing: &result.Timing{
+			StartedAt:       startedAt,
+			FinishedAt:      startedAt.Add(1500 * time.Millisecond),
+			DurationSeconds: 1.5,
+			APICalls:        7,
+		},
+	}}
+
+	var buf bytes.Buffer
+	g.Expect(lint.OutputTable(&buf, results, lint.TableOutputOptions{})).To(Succeed())
+	g.Expect(buf.String()).ToNot(ContainSubstring("DURATION"))
+
+	buf.Reset()
+	g.Expect(lint.OutputTable(&buf, results, lint.TableOutputOptions{ShowTimings: true})).To(Succeed())
+	g.Expect(buf.String()).To(MatchRegexp(`DURATION\s+API CALLS`))
+	g.Expect(buf.String()).To(MatchRegexp(`1\.5s\s+7`))
+}
+
 func TestOutputTable_NonVerboseHidesImpactedObjects(t *testing.T) {
 	g := NewWithT(t)
 
@@ -468,3 +526,127 @@ func indexOf(s, substr string) int {
 
 	return -1
 }
+
+func TestParseOutputDestination(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     string
+		expected lint.OutputDestination
+		wantErr  string
+	}{
+		{name: "stdout format", spec: "table", expected: lint.OutputDestination{Format: lint.OutputFormatTable}},
+		{name: "file destination", spec: "json=results.json", expected: lint.OutputDestination{Format: lint.OutputFormatJSON, Path: "results.json"}},
+		{name: "invalid format", spec: "xml=out.xml", wantErr: "invalid output format"},
+		{name: "missing path", spec: "yaml=", wantErr: "missing file path"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			dest, err := lint.ParseOutputDestination(tt.spec)
+
+			if tt.wantErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+
+				return
+			}
+
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(dest).To(Equal(tt.expected))
+			g.Expect(dest.String()).To(Equal(tt.spec))
+		})
+	}
+}
+
+func TestSharedOptions_OutputDestinations(t *testing.T) {
+	newOptions := func(specs ...string) *lint.SharedOptions {
+		opts := lint.NewSharedOptions(genericiooptions.IOStreams{}, genericclioptions.NewConfigFlags(true))
+		opts.OutputSpecs = specs
+
+		return opts
+	}
+
+	t.Run("defaults to OutputFormat on stdout", func(t *testing.T) {
+		g := NewWithT(t)
+
+		opts := newOptions()
+		opts.OutputFormat = lint.OutputFormatYAML
+
+		dests, err := opts.OutputDestinations()
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(dests).To(Equal([]lint.OutputDestination{{Format: lint.OutputFormatYAML}}))
+	})
+
+	t.Run("combines stdout and file destinations", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dests, err := newOptions("table", "json=results.json", "yaml=results.yaml").OutputDestinations()
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(dests).To(HaveLen(3))
+		g.Expect(dests[1]).To(Equal(lint.OutputDestination{Format: lint.OutputFormatJSON, Path: "results.json"}))
+	})
+
+	t.Run("rejects two stdout destinations", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := newOptions("table", "json").OutputDestinations()
+
+		g.Expect(err).To(MatchError(ContainSubstring("both write to stdout")))
+	})
+
+	t.Run("rejects duplicate paths", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := newOptions("json=out", "yaml=out").OutputDestinations()
+
+		g.Expect(err).To(MatchError(ContainSubstring("used more than once")))
+	})
+}
+
+func TestOutputTable_VerboseObjectsWithMultipleFindings(t *testing.T) {
+	g := NewWithT(t)
+
+	notebook := metav1.PartialObjectMetadata{
+		TypeMeta:   metav1.TypeMeta{Kind: "Notebook", APIVersion: "kubeflow.org/v1"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "notebook-1"},
+	}
+	other := metav1.PartialObjectMetadata{
+		TypeMeta:   metav1.TypeMeta{Kind: "Notebook", APIVersion: "kubeflow.org/v1"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "notebook-2"},
+	}
+
+	results := []check.CheckExecution{
+		{
+			Result: &result.DiagnosticResult{
+				Group:           "workloads",
+				Kind:            "notebook",
+				Name:            "image-tag-refresh",
+				Status:          result.DiagnosticStatus{Conditions: []result.Condition{passCondition()}},
+				ImpactedObjects: []metav1.PartialObjectMetadata{notebook, other},
+			},
+		},
+		{
+			Result: &result.DiagnosticResult{
+				Group:           "workloads",
+				Kind:            "podsecurity",
+				Name:            "admission",
+				Status:          result.DiagnosticStatus{Conditions: []result.Condition{passCondition()}},
+				ImpactedObjects: []metav1.PartialObjectMetadata{notebook},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	err := lint.OutputTable(&buf, results, lint.TableOutputOptions{ShowImpactedObjects: true})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	output := buf.String()
+	g.Expect(output).To(ContainSubstring("Objects with Multiple Findings:"))
+	g.Expect(output).To(ContainSubstring("  ns1/notebook-1 (Notebook):"))
+	g.Expect(output).To(ContainSubstring("workloads / notebook / image-tag-refresh: check passed"))
+	g.Expect(output).To(ContainSubstring("workloads / podsecurity / admission: check passed"))
+	g.Expect(output).ToNot(ContainSubstring("ns1/notebook-2"))
+}
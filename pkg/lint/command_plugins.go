@@ -0,0 +1,40 @@
+package lint
+
+import (
+	"context"
+	"os"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/plugin"
+)
+
+// loadPlugins registers the checks of the plugin executables on PATH with the registry of the
+// run. A plugin that cannot be loaded is reported and skipped, so a broken plugin does not
+// prevent the built-in checks from running.
+func (c *Command) loadPlugins(ctx context.Context) {
+	opts := plugin.Options{Timeout: c.PluginTimeout}
+
+	if c.ConfigFlags != nil {
+		if c.ConfigFlags.KubeConfig != nil {
+			opts.Kubeconfig = *c.ConfigFlags.KubeConfig
+		}
+
+		if c.ConfigFlags.Context != nil {
+			opts.Context = *c.ConfigFlags.Context
+		}
+	}
+
+	for _, path := range plugin.Discover(os.Getenv("PATH")) {
+		checks, err := plugin.Load(ctx, path, opts)
+		if err != nil {
+			c.IO.Errorf("Warning: skipping lint plugin %s: %v", path, err)
+
+			continue
+		}
+
+		for _, chk := range checks {
+			if err := c.registry.Register(chk); err != nil {
+				c.IO.Errorf("Warning: skipping check of lint plugin %s: %v", path, err)
+			}
+		}
+	}
+}
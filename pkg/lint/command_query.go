@@ -0,0 +1,282 @@
+package lint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/history"
+	"github.com/opendatahub-io/odh-cli/pkg/printer/json"
+	"github.com/opendatahub-io/odh-cli/pkg/printer/table"
+	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+)
+
+var _ cmd.Command = (*QueryCommand)(nil)
+
+// QueryOutputFormat represents the output format of the lint query command.
+type QueryOutputFormat string
+
+const (
+	QueryOutputFormatTable QueryOutputFormat = "table"
+	QueryOutputFormatJSON  QueryOutputFormat = "json"
+)
+
+// sinceDateLayout is the date-only form accepted by --since.
+const sinceDateLayout = "2006-01-02"
+
+// Validate checks if the query output format is valid.
+func (o QueryOutputFormat) Validate() error {
+	switch o {
+	case QueryOutputFormatTable, QueryOutputFormatJSON:
+		return nil
+	default:
+		return fmt.Errorf("invalid output format: %s (must be one of: table, json)", o)
+	}
+}
+
+// QueryCommand queries the run history recorded with lint --db.
+// It does not contact the cluster.
+type QueryCommand struct {
+	IO iostreams.Interface
+
+	// DB is the path of the run history database.
+	DB string
+
+	// Namespace restricts findings to impacted objects in this namespace.
+	Namespace string
+
+	// Since restricts the query to runs recorded at or after this date (YYYY-MM-DD or RFC 3339).
+	Since string
+
+	// Check is a glob pattern restricting the query to matching check IDs.
+	Check string
+
+	// Flipped lists checks whose status changed between consecutive runs instead of findings.
+	Flipped bool
+
+	// All includes passing checks in the findings.
+	All bool
+
+	// OutputFormat specifies the query output format (table, json).
+	OutputFormat QueryOutputFormat
+
+	// since is the parsed Since value.
+	since time.Time
+}
+
+// NewQueryCommand creates a new QueryCommand with defaults.
+func NewQueryCommand(streams genericiooptions.IOStreams) *QueryCommand {
+	return &QueryCommand{
+		IO:           iostreams.NewIOStreams(streams.In, streams.Out, streams.ErrOut),
+		OutputFormat: QueryOutputFormatTable,
+	}
+}
+
+// AddFlags registers command-specific flags with the provided FlagSet.
+// The namespace filter is the global --namespace flag, set by the caller.
+func (c *QueryCommand) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&c.DB, "db", "", flagDescQueryDB)
+	fs.StringVar(&c.Since, "since", "", flagDescQuerySince)
+	fs.StringVar(&c.Check, "check", "", flagDescQueryCheck)
+	fs.BoolVar(&c.Flipped, "flipped", false, flagDescQueryFlipped)
+	fs.BoolVar(&c.All, "all", false, flagDescQueryAll)
+	fs.StringVarP((*string)(&c.OutputFormat), "output", "o", string(QueryOutputFormatTable), flagDescQueryOutput)
+}
+
+// Complete parses the --since value.
+func (c *QueryCommand) Complete() error {
+	if c.Since == "" {
+		return nil
+	}
+
+	since, err := parseSince(c.Since)
+	if err != nil {
+		return err
+	}
+
+	c.since = since
+
+	return nil
+}
+
+// Validate checks that all required options are valid.
+func (c *QueryCommand) Validate() error {
+	if c.DB == "" {
+		return errors.New("--db is required")
+	}
+
+	if err := c.OutputFormat.Validate(); err != nil {
+		return err
+	}
+
+	if c.Flipped && c.Namespace != "" {
+		return errors.New("--namespace cannot be combined with --flipped")
+	}
+
+	return history.ValidateCheckPattern(c.Check)
+}
+
+// Run reads the matching runs and writes the findings or flipped checks.
+func (c *QueryCommand) Run(_ context.Context) error {
+	store, err := history.OpenExisting(c.DB)
+	if err != nil {
+		return err
+	}
+
+	runs, err := store.Runs(c.since)
+	closeErr := store.Close()
+
+	if err != nil {
+		return err
+	}
+
+	if closeErr != nil {
+		return closeErr
+	}
+
+	if c.Flipped {
+		return c.outputFlips(history.Flips(runs, c.Check))
+	}
+
+	return c.outputFindings(history.Findings(runs, history.Query{
+		Namespace:      c.Namespace,
+		Check:          c.Check,
+		IncludePassing: c.All,
+	}))
+}
+
+// findingRow is a single row of the query findings table.
+type findingRow struct {
+	Run     string `mapstructure:"RUN"`
+	Check   string `mapstructure:"CHECK"`
+	Status  string `mapstructure:"STATUS"`
+	Object  string `mapstructure:"OBJECT"`
+	Message string `mapstructure:"MESSAGE"`
+}
+
+// flipRow is a single row of the query flipped checks table.
+type flipRow struct {
+	Run     string `mapstructure:"RUN"`
+	Check   string `mapstructure:"CHECK"`
+	From    string `mapstructure:"FROM"`
+	To      string `mapstructure:"TO"`
+	Cluster string `mapstructure:"CLUSTER"`
+}
+
+func (c *QueryCommand) outputFindings(rows []history.FindingRow) error {
+	if c.OutputFormat == QueryOutputFormatJSON {
+		return renderQueryJSON(c.IO, rows)
+	}
+
+	renderer := table.NewRenderer(
+		table.WithWriter[findingRow](c.IO.Out()),
+		table.WithHeaders[findingRow]("RUN", "CHECK", "STATUS", "OBJECT", "MESSAGE"),
+		table.WithTableOptions[findingRow](table.DefaultTableOptions...),
+	)
+
+	for _, r := range rows {
+		row := findingRow{
+			Run:     r.Timestamp.Local().Format(time.DateTime),
+			Check:   r.CheckID,
+			Status:  r.Status,
+			Object:  "-",
+			Message: r.Message,
+		}
+
+		if r.Object != nil {
+			row.Object = formatHistoryObject(*r.Object)
+		}
+
+		if err := renderer.Append(row); err != nil {
+			return fmt.Errorf("appending finding row: %w", err)
+		}
+	}
+
+	if err := renderer.Render(); err != nil {
+		return fmt.Errorf("rendering findings: %w", err)
+	}
+
+	return nil
+}
+
+func (c *QueryCommand) outputFlips(flips []history.Flip) error {
+	if c.OutputFormat == QueryOutputFormatJSON {
+		return renderQueryJSON(c.IO, flips)
+	}
+
+	renderer := table.NewRenderer(
+		table.WithWriter[flipRow](c.IO.Out()),
+		table.WithHeaders[flipRow]("RUN", "CHECK", "FROM", "TO", "CLUSTER"),
+		table.WithTableOptions[flipRow](table.DefaultTableOptions...),
+	)
+
+	for _, f := range flips {
+		row := flipRow{
+			Run:     f.ToTimestamp.Local().Format(time.DateTime),
+			Check:   f.CheckID,
+			From:    f.FromStatus,
+			To:      f.ToStatus,
+			Cluster: "-",
+		}
+
+		if f.ClusterChange != "" {
+			row.Cluster = f.ClusterChange
+		}
+
+		if err := renderer.Append(row); err != nil {
+			return fmt.Errorf("appending flip row: %w", err)
+		}
+	}
+
+	if err := renderer.Render(); err != nil {
+		return fmt.Errorf("rendering flipped checks: %w", err)
+	}
+
+	return nil
+}
+
+func renderQueryJSON[T any](streams iostreams.Interface, rows []T) error {
+	if rows == nil {
+		rows = []T{}
+	}
+
+	renderer := json.NewRenderer[[]T](json.WithWriter[[]T](streams.Out()))
+	if err := renderer.Render(rows); err != nil {
+		return fmt.Errorf("rendering query results: %w", err)
+	}
+
+	return nil
+}
+
+func formatHistoryObject(obj history.Object) string {
+	name := obj.Name
+	if obj.Namespace != "" {
+		name = obj.Namespace + "/" + name
+	}
+
+	if obj.Kind != "" {
+		name = obj.Kind + "/" + name
+	}
+
+	return name
+}
+
+// parseSince accepts a date (YYYY-MM-DD, local time) or an RFC 3339 timestamp.
+func parseSince(value string) (time.Time, error) {
+	if since, err := time.ParseInLocation(sinceDateLayout, value, time.Local); err == nil {
+		return since, nil
+	}
+
+	since, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid --since %q: expected YYYY-MM-DD or RFC 3339 timestamp", value)
+	}
+
+	return since, nil
+}
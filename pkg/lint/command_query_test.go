@@ -0,0 +1,109 @@
+package lint_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/history"
+
+	. "github.com/onsi/gomega"
+)
+
+func newHistoryDB(t *testing.T) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "history.db")
+
+	store, err := history.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() { _ = store.Close() }()
+
+	// The same findings recorded a week ago and today
+	for _, timestamp := range []time.Time{time.Now().AddDate(0, 0, -7), time.Now()} {
+		if err := store.Record(history.NewRun(assignmentResults(), "2.25.0", "3.0.0", timestamp)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return path
+}
+
+func TestQueryCommand_Findings(t *testing.T) {
+	g := NewWithT(t)
+
+	var out bytes.Buffer
+
+	command := lint.NewQueryCommand(genericiooptions.IOStreams{Out: &out, ErrOut: &bytes.Buffer{}})
+	command.DB = newHistoryDB(t)
+	command.Namespace = "ml-train"
+	command.Since = time.Now().AddDate(0, 0, -1).Format("2006-01-02")
+
+	g.Expect(command.Complete()).To(Succeed())
+	g.Expect(command.Validate()).To(Succeed())
+	g.Expect(command.Run(t.Context())).To(Succeed())
+
+	g.Expect(out.String()).To(ContainSubstring("workloads.notebook.impacted-workloads"))
+	g.Expect(out.String()).To(ContainSubstring("Notebook/ml-train/nb-1"))
+	g.Expect(out.String()).ToNot(ContainSubstring("ml-serve"))
+}
+
+func TestQueryCommand_FlippedJSON(t *testing.T) {
+	g := NewWithT(t)
+
+	var out bytes.Buffer
+
+	command := lint.NewQueryCommand(genericiooptions.IOStreams{Out: &out, ErrOut: &bytes.Buffer{}})
+	command.DB = newHistoryDB(t)
+	command.Flipped = true
+	command.OutputFormat = lint.QueryOutputFormatJSON
+
+	g.Expect(command.Complete()).To(Succeed())
+	g.Expect(command.Validate()).To(Succeed())
+	g.Expect(command.Run(t.Context())).To(Succeed())
+
+	var flips []history.Flip
+	g.Expect(json.Unmarshal(out.Bytes(), &flips)).To(Succeed())
+	g.Expect(flips).To(BeEmpty())
+}
+
+func TestQueryCommand_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(c *lint.QueryCommand)
+		wantErr string
+	}{
+		{name: "missing db", setup: func(*lint.QueryCommand) {}, wantErr: "--db is required"},
+		{name: "invalid output", setup: func(c *lint.QueryCommand) { c.DB = "h.db"; c.OutputFormat = "yaml" }, wantErr: "invalid output format"},
+		{name: "namespace with flipped", setup: func(c *lint.QueryCommand) { c.DB = "h.db"; c.Flipped = true; c.Namespace = "a" }, wantErr: "cannot be combined"},
+		{name: "invalid check pattern", setup: func(c *lint.QueryCommand) { c.DB = "h.db"; c.Check = "[" }, wantErr: "invalid check pattern"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			command := lint.NewQueryCommand(genericiooptions.IOStreams{})
+			tt.setup(command)
+
+			g.Expect(command.Validate()).To(MatchError(ContainSubstring(tt.wantErr)))
+		})
+	}
+}
+
+func TestQueryCommand_InvalidSince(t *testing.T) {
+	g := NewWithT(t)
+
+	command := lint.NewQueryCommand(genericiooptions.IOStreams{})
+	command.Since = "last week"
+
+	g.Expect(command.Complete()).To(MatchError(ContainSubstring("invalid --since")))
+}
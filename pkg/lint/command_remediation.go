@@ -0,0 +1,203 @@
+package lint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	"github.com/spf13/pflag"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+var _ cmd.Command = (*RemediationStatusCommand)(nil)
+
+// RemediationStatusCommand re-evaluates only the checks that produced findings in a baseline
+// lint report and reports, per finding, whether it was fixed or persists.
+type RemediationStatusCommand struct {
+	*SharedOptions
+
+	// Baseline is the path of a lint JSON or YAML report to compare against.
+	Baseline string
+
+	// baseline is the parsed Baseline report.
+	baseline *result.DiagnosticResultList
+
+	// registry is the check registry for this command instance.
+	registry *check.CheckRegistry
+}
+
+// NewRemediationStatusCommand creates a new RemediationStatusCommand populated with all lint checks.
+func NewRemediationStatusCommand(
+	streams genericiooptions.IOStreams,
+	configFlags *genericclioptions.ConfigFlags,
+) *RemediationStatusCommand {
+	return &RemediationStatusCommand{
+		SharedOptions: NewSharedOptions(streams, configFlags),
+		registry:      NewRegistry(),
+	}
+}
+
+// AddFlags registers command-specific flags with the provided FlagSet.
+func (c *RemediationStatusCommand) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&c.Baseline, "baseline", "", flagDescBaseline)
+	fs.StringArrayVarP(&c.OutputSpecs, "output", "o", nil, flagDescStatusOutput)
+	fs.BoolVarP(&c.Verbose, "verbose", "v", false, flagDescVerbose)
+	fs.BoolVar(&c.Debug, "debug", false, flagDescDebug)
+	fs.DurationVar(&c.Timeout, "timeout", c.Timeout, flagDescTimeout)
+	fs.IntVar(&c.Concurrency, "concurrency", c.Concurrency, flagDescConcurrency)
+	fs.DurationVar(&c.CheckTimeout, "check-timeout", 0, flagDescCheckTimeout)
+
+	// Throttling settings
+	fs.Float32Var(&c.QPS, "qps", c.QPS, flagDescQPS)
+	fs.IntVar(&c.Burst, "burst", c.Burst, flagDescBurst)
+}
+
+// Complete creates the client and loads the baseline report.
+func (c *RemediationStatusCommand) Complete() error {
+	if err := c.SharedOptions.Complete(); err != nil {
+		return fmt.Errorf("completing shared options: %w", err)
+	}
+
+	if !c.Verbose && !c.Debug {
+		c.IO = iostreams.NewQuietWrapper(c.IO)
+	}
+
+	if c.Baseline == "" {
+		return nil
+	}
+
+	baseline, err := LoadBaseline(c.Baseline)
+	if err != nil {
+		return err
+	}
+
+	c.baseline = baseline
+
+	return nil
+}
+
+// Validate checks that all required options are valid.
+func (c *RemediationStatusCommand) Validate() error {
+	if c.Baseline == "" {
+		return errors.New("--baseline is required")
+	}
+
+	if err := c.SharedOptions.Validate(); err != nil {
+		return fmt.Errorf("validating shared options: %w", err)
+	}
+
+	for _, format := range []OutputFormat{OutputFormatJUnit, OutputFormatHTML, OutputFormatMarkdown} {
+		if c.writesFormat(format) {
+			return fmt.Errorf("--output %s is not supported by remediation status", format)
+		}
+	}
+
+	return nil
+}
+
+// Run re-evaluates the baseline checks and writes the status of each finding.
+// Checks run against the target version of the baseline, so an upgrade assessment is
+// re-evaluated as an upgrade assessment.
+func (c *RemediationStatusCommand) Run(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+
+	currentVersion, err := version.Detect(ctx, c.Reader)
+	if err != nil {
+		return fmt.Errorf("detecting cluster version: %w", err)
+	}
+
+	flavor, err := version.DetectFlavor(ctx, c.Reader)
+	if err != nil {
+		c.IO.Errorf("Warning: failed to detect the management flavor: %v", err)
+	}
+
+	targetVersion := currentVersion
+	if c.baseline.TargetVersion != nil && *c.baseline.TargetVersion != "" {
+		parsed, err := semver.ParseTolerant(*c.baseline.TargetVersion)
+		if err != nil {
+			return fmt.Errorf("invalid baseline target version %q: %w", *c.baseline.TargetVersion, err)
+		}
+
+		targetVersion = &parsed
+	}
+
+	checks, unknown := BaselineChecks(c.registry, c.baseline)
+	if len(unknown) > 0 {
+		c.IO.Errorf("Warning: no registered check produces baseline results %s; their findings are not re-evaluated",
+			strings.Join(unknown, ", "))
+	}
+
+	ids := make([]string, 0, len(checks))
+	for _, chk := range checks {
+		ids = append(ids, chk.ID())
+	}
+
+	c.IO.Errorf("Re-evaluating %d check(s) with baseline findings: %s → %s\n",
+		len(ids), currentVersion.String(), targetVersion.String())
+
+	executor := c.NewExecutor(c.registry)
+	target := check.Target{
+		Client:                c.Reader,
+		CurrentVersion:        currentVersion,
+		TargetVersion:         targetVersion,
+		Flavor:                flavor,
+		IO:                    c.IO,
+		Debug:                 c.Debug,
+		Probe:                 c.Probe,
+		ImageStreamNamespaces: c.ImageStreamNamespaces,
+	}
+
+	var current []check.CheckExecution
+
+	if len(ids) > 0 {
+		for _, group := range check.CanonicalGroupOrder {
+			results, err := executor.ExecuteSelective(ctx, target, ids, group)
+			if err != nil {
+				return fmt.Errorf("executing %s checks: %w", group, err)
+			}
+
+			current = append(current, results...)
+		}
+	}
+
+	findings := CompareFindings(c.baseline, current)
+
+	destinations, err := c.OutputDestinations()
+	if err != nil {
+		return err
+	}
+
+	for _, dest := range destinations {
+		render := func(out io.Writer) error {
+			return OutputRemediationStatus(out, dest.Format, findings)
+		}
+
+		if dest.Path == "" {
+			if err := render(c.IO.Out()); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if err := writeOutputFile(dest.Path, render); err != nil {
+			return fmt.Errorf("writing %s remediation status: %w", dest.Format, err)
+		}
+
+		c.IO.Errorf("Wrote %s remediation status to %s", dest.Format, dest.Path)
+	}
+
+	return nil
+}
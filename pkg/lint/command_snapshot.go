@@ -0,0 +1,215 @@
+package lint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	cliversion "github.com/opendatahub-io/odh-cli/internal/version"
+	"github.com/opendatahub-io/odh-cli/pkg/backup"
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/snapshot"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube/discovery"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+var _ cmd.Command = (*SnapshotCreateCommand)(nil)
+
+// snapshotBaseResources are captured in every snapshot: the platform resources version and
+// flavor detection read, whichever checks are selected.
+//
+//nolint:gochecknoglobals // Fixed set of platform resources
+var snapshotBaseResources = []resources.ResourceType{
+	resources.DataScienceCluster,
+	resources.DSCInitialization,
+	resources.Subscription,
+	resources.ClusterServiceVersion,
+}
+
+// SnapshotCreateCommand captures the objects the selected checks read into a snapshot
+// archive, which "lint --from-snapshot" runs against later.
+type SnapshotCreateCommand struct {
+	*SharedOptions
+
+	// Output is the path of the snapshot archive to write.
+	Output string
+
+	// registry is the check registry whose required resources are captured.
+	registry *check.CheckRegistry
+}
+
+// NewSnapshotCreateCommand creates a new SnapshotCreateCommand populated with all lint checks.
+func NewSnapshotCreateCommand(
+	streams genericiooptions.IOStreams,
+	configFlags *genericclioptions.ConfigFlags,
+) *SnapshotCreateCommand {
+	return &SnapshotCreateCommand{
+		SharedOptions: NewSharedOptions(streams, configFlags),
+		registry:      NewRegistry(),
+	}
+}
+
+// AddFlags registers command-specific flags with the provided FlagSet.
+func (c *SnapshotCreateCommand) AddFlags(fs *pflag.FlagSet) {
+	fs.StringArrayVar(&c.CheckSelectors, "checks", []string{"*"}, flagDescSnapshotChecks)
+	fs.DurationVar(&c.Timeout, "timeout", c.Timeout, flagDescTimeout)
+
+	// Throttling settings
+	fs.Float32Var(&c.QPS, "qps", c.QPS, flagDescQPS)
+	fs.IntVar(&c.Burst, "burst", c.Burst, flagDescBurst)
+}
+
+// Complete populates the client.
+func (c *SnapshotCreateCommand) Complete() error {
+	return c.SharedOptions.Complete()
+}
+
+// Validate checks that all required options are valid.
+func (c *SnapshotCreateCommand) Validate() error {
+	if c.Output == "" {
+		return errors.New("snapshot output path is required")
+	}
+
+	if !backup.IsArchivePath(c.Output) {
+		return snapshot.ErrUnsupportedFormat
+	}
+
+	if err := c.SharedOptions.Validate(); err != nil {
+		return fmt.Errorf("validating shared options: %w", err)
+	}
+
+	return nil
+}
+
+// Run lists the resources the selected checks require and writes them to the snapshot.
+func (c *SnapshotCreateCommand) Run(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+
+	checks, err := c.registry.ListByPatterns(c.CheckSelectors, "")
+	if err != nil {
+		return fmt.Errorf("selecting checks: %w", err)
+	}
+
+	workloads, err := discovery.DiscoverWorkloads(ctx, c.Client)
+	if err != nil {
+		return fmt.Errorf("discovering workloads: %w", err)
+	}
+
+	manifest := &snapshot.Manifest{
+		CreatedAt:  time.Now().UTC(),
+		CLIVersion: cliversion.GetVersion(),
+		Checks:     make([]string, 0, len(checks)),
+	}
+
+	for _, chk := range checks {
+		manifest.Checks = append(manifest.Checks, chk.ID())
+	}
+
+	for _, gvr := range workloads {
+		manifest.Workloads = append(manifest.Workloads, snapshot.ResourceName(gvr))
+	}
+
+	slices.Sort(manifest.Workloads)
+
+	if clusterVersion, err := version.Detect(ctx, c.Client); err == nil {
+		manifest.ClusterVersion = clusterVersion.String()
+	} else {
+		c.IO.Errorf("Warning: detecting cluster version: %v", err)
+	}
+
+	objects := snapshot.Objects{}
+	total := 0
+
+	for _, gvr := range snapshotResources(checks, workloads) {
+		entry := snapshot.Resource{Resource: snapshot.ResourceName(gvr)}
+
+		items, err := c.listSnapshotResource(ctx, gvr)
+		if err != nil {
+			c.IO.Errorf("Warning: listing %s: %v", entry.Resource, err)
+			entry.Error = err.Error()
+		}
+
+		entry.Count = len(items)
+		total += len(items)
+		objects[gvr] = items
+		manifest.Resources = append(manifest.Resources, entry)
+	}
+
+	if err := snapshot.Write(c.Output, manifest, objects); err != nil {
+		return fmt.Errorf("writing snapshot: %w", err)
+	}
+
+	c.IO.Errorf("Wrote %d objects of %d resource types for %d checks to %s",
+		total, len(manifest.Resources), len(manifest.Checks), c.Output)
+
+	return nil
+}
+
+// listSnapshotResource lists all objects of a resource type. A resource type that is not
+// served by the cluster has no objects.
+func (c *SnapshotCreateCommand) listSnapshotResource(
+	ctx context.Context,
+	gvr schema.GroupVersionResource,
+) ([]*unstructured.Unstructured, error) {
+	items, err := c.Client.ListResources(ctx, gvr)
+	if err != nil {
+		if client.IsResourceTypeNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("listing resources: %w", err)
+	}
+
+	return items, nil
+}
+
+// snapshotResources returns the resource types a snapshot of checks captures, sorted by
+// manifest name: the base resources, those the checks declare, and the discovered workloads.
+func snapshotResources(checks []check.Check, workloads []schema.GroupVersionResource) []schema.GroupVersionResource {
+	seen := make(map[schema.GroupVersionResource]bool)
+
+	var gvrs []schema.GroupVersionResource
+
+	add := func(gvr schema.GroupVersionResource) {
+		if !seen[gvr] {
+			seen[gvr] = true
+			gvrs = append(gvrs, gvr)
+		}
+	}
+
+	for _, rt := range snapshotBaseResources {
+		add(rt.GVR())
+	}
+
+	for _, chk := range checks {
+		if describer, ok := chk.(check.GraphDescriber); ok {
+			for _, rt := range describer.RequiredResources() {
+				add(rt.GVR())
+			}
+		}
+	}
+
+	for _, gvr := range workloads {
+		add(gvr)
+	}
+
+	slices.SortFunc(gvrs, func(a, b schema.GroupVersionResource) int {
+		return strings.Compare(snapshot.ResourceName(a), snapshot.ResourceName(b))
+	})
+
+	return gvrs
+}
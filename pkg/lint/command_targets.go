@@ -0,0 +1,297 @@
+package lint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/blang/semver/v4"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	printerjson "github.com/opendatahub-io/odh-cli/pkg/printer/json"
+	printeryaml "github.com/opendatahub-io/odh-cli/pkg/printer/yaml"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+// MultiTargetResultList groups the results of an upgrade assessment against several target
+// versions, one result list (with its readiness verdict) per target in ascending order.
+type MultiTargetResultList struct {
+	ClusterVersion *string                        `json:"clusterVersion,omitempty" yaml:"clusterVersion,omitempty"`
+	Targets        []*result.DiagnosticResultList `json:"targets"                  yaml:"targets"`
+}
+
+// TargetAssessment is the results of the checks run against one target version.
+type TargetAssessment struct {
+	TargetVersion string
+	Results       []check.CheckExecution
+}
+
+// parseTargetVersions parses a comma-separated list of target versions, accepting partial
+// versions (e.g. "3.0" → "3.0.0"), and returns them deduplicated in ascending order.
+func parseTargetVersions(spec string) ([]semver.Version, error) {
+	var targets []semver.Version
+
+	for entry := range strings.SplitSeq(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		target, err := semver.ParseTolerant(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid target version %q: %w", entry, err)
+		}
+
+		targets = append(targets, target)
+	}
+
+	if len(targets) == 0 {
+		return nil, fmt.Errorf("invalid target version %q: no version given", spec)
+	}
+
+	semver.Sort(targets)
+
+	unique := targets[:1]
+	for _, target := range targets[1:] {
+		if !target.EQ(unique[len(unique)-1]) {
+			unique = append(unique, target)
+		}
+	}
+
+	return unique, nil
+}
+
+// multiTarget returns whether the run assesses several target versions.
+func (c *Command) multiTarget() bool {
+	return c.AllSupportedTargets || len(c.parsedTargetVersions) > 1
+}
+
+// validateMultiTarget checks that an assessment of several target versions is combined with
+// options that apply to a single set of results.
+func (c *Command) validateMultiTarget() error {
+	if c.AllSupportedTargets && c.TargetVersion != "" {
+		return errors.New("--all-supported-targets and --target-version are mutually exclusive")
+	}
+
+	if !c.multiTarget() {
+		return nil
+	}
+
+	unsupported := []struct {
+		flag string
+		set  bool
+	}{
+		{"--plan", c.Plan},
+		{"--watch", c.Watch},
+		{"--fix", c.Fix},
+		{"--save", c.Save != ""},
+		{"--diff", c.Diff != ""},
+		{"--db", c.DB != ""},
+		{"--record", c.Record},
+		{"--coverage", c.Coverage},
+		{"--emit-remediation-script", c.RemediationScript != ""},
+		{"--summary-file", c.SummaryFile != ""},
+		{"--metrics-file", c.MetricsFile != ""},
+		{"--pushgateway-url", c.PushgatewayURL != ""},
+		{"--telemetry", c.Telemetry || c.telemetryPreview},
+		{"gitops-comment", c.gitOpsComment != nil},
+	}
+
+	for _, option := range unsupported {
+		if option.set {
+			return fmt.Errorf("%s is not supported with multiple target versions", option.flag)
+		}
+	}
+
+	for _, format := range []OutputFormat{OutputFormatJUnit, OutputFormatHTML, OutputFormatMarkdown} {
+		if c.writesFormat(format) {
+			return fmt.Errorf("--output %s is not supported with multiple target versions", format)
+		}
+	}
+
+	return nil
+}
+
+// runMultiTarget assesses upgrade readiness for each target version in one run. All targets
+// read the cluster through the run cache, so every resource is listed once however many
+// targets CanApply and Validate run for.
+func (c *Command) runMultiTarget(ctx context.Context, currentVersion *semver.Version) error {
+	targets := c.parsedTargetVersions
+
+	if c.AllSupportedTargets {
+		targets = version.SupportedTargets(currentVersion)
+		if len(targets) == 0 {
+			return fmt.Errorf("no supported target version is newer than current version %s", currentVersion.String())
+		}
+	}
+
+	for _, target := range targets {
+		if target.LT(*currentVersion) {
+			return fmt.Errorf("target version %s is older than current version %s (downgrades not supported)",
+				target.String(), currentVersion.String())
+		}
+	}
+
+	c.IO.Errorf("Current OpenShift AI version: %s (%s)", currentVersion.String(), c.flavorDescription())
+	c.IO.Errorf("Target OpenShift AI versions: %s\n", joinVersions(targets))
+
+	executor := c.NewExecutor(c.registry)
+
+	assessments := make([]TargetAssessment, 0, len(targets))
+	allResults := make(map[check.CheckGroup][]check.CheckExecution)
+
+	for _, target := range targets {
+		c.IO.Errorf("Assessing upgrade readiness: %s → %s", currentVersion.String(), target.String())
+
+		checkTarget := check.Target{
+			Client:                c.cache,
+			CurrentVersion:        currentVersion,
+			TargetVersion:         &target,
+			Flavor:                c.flavor,
+			IO:                    c.IO,
+			Debug:                 c.Debug,
+			Probe:                 c.Probe,
+			ImageStreamNamespaces: c.ImageStreamNamespaces,
+			Registry:              c.imageRegistry,
+		}
+
+		resultsByGroup := make(map[check.CheckGroup][]check.CheckExecution)
+
+		for _, group := range check.CanonicalGroupOrder {
+			results, err := executor.ExecuteSelective(ctx, checkTarget, c.CheckSelectors, group)
+			if err != nil {
+				return fmt.Errorf("executing %s checks for target %s: %w", group, target.String(), err)
+			}
+
+			resultsByGroup[group] = results
+		}
+
+		c.retryUnknown(ctx, executor, resultsByGroup)
+
+		flatResults := FlattenResults(resultsByGroup)
+		c.applyAssignments(ctx, flatResults)
+
+		assessments = append(assessments, TargetAssessment{TargetVersion: target.String(), Results: flatResults})
+
+		for group, results := range resultsByGroup {
+			allResults[group] = append(allResults[group], results...)
+		}
+	}
+
+	if err := c.writeMultiTargetOutputs(ctx, assessments); err != nil {
+		return err
+	}
+
+	c.IO.Errorf("\nUpgrade readiness:")
+
+	for _, assessment := range assessments {
+		readiness := result.NewReadinessSummary(diagnosticResults(assessment.Results))
+		verdict := ReadinessVerdict(readiness, currentVersion.String(), assessment.TargetVersion)
+
+		if readiness.Ready() {
+			c.IO.Errorf("  ✅ %s", verdict)
+		} else {
+			c.IO.Errorf("  ⚠️  %s", verdict)
+		}
+	}
+
+	return c.determineExitCode(allResults)
+}
+
+// writeMultiTargetOutputs renders the assessments to every configured output destination.
+func (c *Command) writeMultiTargetOutputs(ctx context.Context, assessments []TargetAssessment) error {
+	destinations, err := c.OutputDestinations()
+	if err != nil {
+		return err
+	}
+
+	for _, dest := range destinations {
+		render := func(out io.Writer) error {
+			if dest.Format == OutputFormatTable {
+				return c.outputMultiTargetTable(ctx, out, assessments)
+			}
+
+			return OutputMultiTarget(out, dest.Format, c.currentClusterVersion, assessments)
+		}
+
+		if dest.Path == "" {
+			if err := render(c.IO.Out()); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if err := writeOutputFile(dest.Path, render); err != nil {
+			return fmt.Errorf("writing %s output: %w", dest.Format, err)
+		}
+
+		c.IO.Errorf("Wrote %s results to %s", dest.Format, dest.Path)
+	}
+
+	return nil
+}
+
+// outputMultiTargetTable renders, for each target version, the results table and readiness.
+func (c *Command) outputMultiTargetTable(ctx context.Context, out io.Writer, assessments []TargetAssessment) error {
+	for _, assessment := range assessments {
+		_, _ = fmt.Fprintln(out)
+		_, _ = fmt.Fprintf(out, "Target Version %s:\n", assessment.TargetVersion)
+		_, _ = fmt.Fprintln(out, strings.Repeat("=", len("Target Version :")+len(assessment.TargetVersion)))
+
+		if err := c.outputUpgradeTable(ctx, out, c.currentClusterVersion, assessment.TargetVersion, assessment.Results); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// OutputMultiTarget renders the assessments of several target versions as JSON or YAML, one
+// result list per target.
+func OutputMultiTarget(out io.Writer, format OutputFormat, clusterVersion string, assessments []TargetAssessment) error {
+	list := &MultiTargetResultList{
+		ClusterVersion: &clusterVersion,
+		Targets:        make([]*result.DiagnosticResultList, 0, len(assessments)),
+	}
+
+	for _, assessment := range assessments {
+		list.Targets = append(list.Targets, newResultList(assessment.Results, &clusterVersion, &assessment.TargetVersion))
+	}
+
+	switch format {
+	case OutputFormatJSON:
+		renderer := printerjson.NewRenderer[*MultiTargetResultList](
+			printerjson.WithWriter[*MultiTargetResultList](out),
+		)
+
+		if err := renderer.Render(list); err != nil {
+			return fmt.Errorf("rendering JSON output: %w", err)
+		}
+	case OutputFormatYAML:
+		renderer := printeryaml.NewRenderer[*MultiTargetResultList](
+			printeryaml.WithWriter[*MultiTargetResultList](out),
+		)
+
+		if err := renderer.Render(list); err != nil {
+			return fmt.Errorf("rendering YAML output: %w", err)
+		}
+	default:
+		return fmt.Errorf("unsupported output format for multiple target versions: %s", format)
+	}
+
+	return nil
+}
+
+// joinVersions returns the versions as a comma-separated list.
+func joinVersions(versions []semver.Version) string {
+	names := make([]string, 0, len(versions))
+	for _, v := range versions {
+		names = append(names, v.String())
+	}
+
+	return strings.Join(names, ", ")
+}
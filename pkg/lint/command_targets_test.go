@@ -0,0 +1,86 @@
+package lint_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/backup"
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestCommand_MultipleTargetVersions(t *testing.T) {
+	g := NewWithT(t)
+
+	dir := t.TempDir()
+	g.Expect(backup.WriteResourceToFile(dir, resources.DSCInitialization.GVR(), testutil.NewDSCI("redhat-ods-applications"))).To(Succeed())
+	g.Expect(backup.WriteResourceToFile(dir, resources.DataScienceCluster.GVR(), testutil.NewDSC(map[string]string{
+		"codeflare": "Managed",
+	}))).To(Succeed())
+
+	var out bytes.Buffer
+
+	streams := genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: &out, ErrOut: &bytes.Buffer{}}
+
+	command := lint.NewCommand(streams, testConfigFlags(), lint.WithTargetVersion("3.0,2.25.0,3.0.0"))
+	command.FromBackup = dir
+	command.CurrentVersion = "2.19"
+	command.OutputFormat = lint.OutputFormatJSON
+	command.CheckSelectors = []string{"components"}
+	command.FailOnCritical = false
+
+	g.Expect(command.Complete()).To(Succeed())
+	g.Expect(command.Validate()).To(Succeed())
+	g.Expect(command.Run(t.Context())).To(Succeed())
+
+	var list lint.MultiTargetResultList
+	g.Expect(json.Unmarshal(out.Bytes(), &list)).To(Succeed())
+	g.Expect(list.Targets).To(HaveLen(2))
+	g.Expect(*list.Targets[0].TargetVersion).To(Equal("2.25.0"))
+	g.Expect(*list.Targets[1].TargetVersion).To(Equal("3.0.0"))
+
+	// CodeFlare is Managed and removed in 3.x only.
+	g.Expect(*list.Targets[0].Readiness).To(BeTrue())
+	g.Expect(*list.Targets[1].Readiness).To(BeFalse())
+}
+
+func TestCommand_MultipleTargetVersionsValidate(t *testing.T) {
+	g := NewWithT(t)
+
+	streams := genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: &bytes.Buffer{}, ErrOut: &bytes.Buffer{}}
+
+	command := lint.NewCommand(streams, testConfigFlags(), lint.WithTargetVersion("3.0,3.1"))
+	command.FromBackup = t.TempDir()
+	command.CurrentVersion = "2.19"
+
+	g.Expect(command.Complete()).To(Succeed())
+	g.Expect(command.Validate()).To(Succeed())
+
+	command.Save = "results.json"
+	g.Expect(command.Validate()).To(MatchError("--save is not supported with multiple target versions"))
+
+	command.Save = ""
+	command.OutputSpecs = []string{"junit=results.xml"}
+	g.Expect(command.Validate()).To(MatchError("--output junit is not supported with multiple target versions"))
+
+	command.OutputSpecs = nil
+	command.AllSupportedTargets = true
+	g.Expect(command.Validate()).To(MatchError("--all-supported-targets and --target-version are mutually exclusive"))
+}
+
+func TestCommand_InvalidTargetVersionList(t *testing.T) {
+	g := NewWithT(t)
+
+	streams := genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: &bytes.Buffer{}, ErrOut: &bytes.Buffer{}}
+
+	command := lint.NewCommand(streams, testConfigFlags(), lint.WithTargetVersion("3.0,next"))
+	command.FromBackup = t.TempDir()
+
+	g.Expect(command.Complete()).To(MatchError(ContainSubstring(`invalid target version "next"`)))
+}
@@ -0,0 +1,126 @@
+package lint
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/internal/version"
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/telemetry"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+)
+
+// telemetryRequestTimeout bounds the time spent posting telemetry, so an unreachable
+// endpoint does not delay the end of a lint run.
+const telemetryRequestTimeout = 10 * time.Second
+
+var _ cmd.Command = (*TelemetryPreviewCommand)(nil)
+
+// TelemetryPreviewCommand runs the lint checks like "lint --telemetry" and prints the
+// telemetry report that would be sent, without sending it.
+type TelemetryPreviewCommand struct {
+	*Command
+}
+
+// NewTelemetryPreviewCommand creates a new TelemetryPreviewCommand populated with all lint checks.
+func NewTelemetryPreviewCommand(
+	streams genericiooptions.IOStreams,
+	configFlags *genericclioptions.ConfigFlags,
+) *TelemetryPreviewCommand {
+	c := NewCommand(streams, configFlags)
+	c.telemetryPreview = true
+
+	return &TelemetryPreviewCommand{Command: c}
+}
+
+// AddFlags registers the flags that change which checks run, and so the report contents.
+func (c *TelemetryPreviewCommand) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&c.TargetVersion, "target-version", "", flagDescTargetVersion)
+	fs.StringArrayVar(&c.CheckSelectors, "checks", []string{"*"}, flagDescChecks)
+	fs.StringVar(&c.Config, "config", "", flagDescConfig)
+	fs.BoolVarP(&c.Verbose, "verbose", "v", false, flagDescVerbose)
+	fs.BoolVar(&c.Debug, "debug", false, flagDescDebug)
+	fs.DurationVar(&c.Timeout, "timeout", c.Timeout, flagDescTimeout)
+	fs.IntVar(&c.Concurrency, "concurrency", c.Concurrency, flagDescConcurrency)
+	fs.DurationVar(&c.CheckTimeout, "check-timeout", 0, flagDescCheckTimeout)
+	fs.BoolVar(&c.RetryUnknown, "retry-unknown", c.RetryUnknown, flagDescRetryUnknown)
+
+	// Throttling settings
+	fs.Float32Var(&c.QPS, "qps", c.QPS, flagDescQPS)
+	fs.IntVar(&c.Burst, "burst", c.Burst, flagDescBurst)
+}
+
+// completeTelemetry resolves the telemetry endpoint from the environment when not set by flag.
+func (c *Command) completeTelemetry() {
+	if c.Telemetry && c.TelemetryEndpoint == "" {
+		c.TelemetryEndpoint = os.Getenv(telemetry.EnvEndpoint)
+	}
+
+	if c.httpClient == nil {
+		c.httpClient = &http.Client{Timeout: telemetryRequestTimeout}
+	}
+}
+
+// telemetryReport builds the anonymized telemetry report of a run.
+func (c *Command) telemetryReport(
+	ctx context.Context,
+	targetVersion string,
+	resultsByGroup map[check.CheckGroup][]check.CheckExecution,
+) *telemetry.Report {
+	return telemetry.NewReport(
+		FlattenResults(resultsByGroup),
+		version.GetVersion(),
+		c.currentClusterVersion,
+		targetVersion,
+		c.clusterSize(ctx),
+	)
+}
+
+// clusterSize returns the size bucket of the cluster, by node count.
+func (c *Command) clusterSize(ctx context.Context) string {
+	nodes, err := c.Reader.ListMetadata(ctx, resources.Node)
+	if err != nil {
+		return telemetry.SizeUnknown
+	}
+
+	return telemetry.SizeBucket(len(nodes))
+}
+
+// previewTelemetry writes the telemetry report of a run to stdout instead of sending it.
+func (c *Command) previewTelemetry(
+	ctx context.Context,
+	targetVersion string,
+	resultsByGroup map[check.CheckGroup][]check.CheckExecution,
+) error {
+	return telemetry.Write(c.IO.Out(), c.telemetryReport(ctx, targetVersion, resultsByGroup))
+}
+
+// sendTelemetry posts the telemetry report of a run when --telemetry is set.
+// Failing to send is reported as a warning and never fails the run.
+func (c *Command) sendTelemetry(
+	ctx context.Context,
+	targetVersion string,
+	resultsByGroup map[check.CheckGroup][]check.CheckExecution,
+) {
+	if !c.Telemetry {
+		return
+	}
+
+	report := c.telemetryReport(ctx, targetVersion, resultsByGroup)
+
+	if err := telemetry.Send(ctx, c.httpClient, c.TelemetryEndpoint, report); err != nil {
+		c.IO.Errorf("Warning: telemetry not sent: %v", err)
+
+		return
+	}
+
+	c.IO.Errorf("Sent anonymized statistics of %d check(s) to %s", len(report.Checks), c.TelemetryEndpoint)
+}
@@ -2,6 +2,7 @@ package lint_test
 
 import (
 	"bytes"
+	"path/filepath"
 	"testing"
 
 	"github.com/spf13/pflag"
@@ -9,8 +10,12 @@ import (
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 
+	"github.com/opendatahub-io/odh-cli/pkg/backup"
 	"github.com/opendatahub-io/odh-cli/pkg/cmd"
 	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/snapshot"
 
 	. "github.com/onsi/gomega"
 )
@@ -198,6 +203,27 @@ func TestCommand_AddFlags(t *testing.T) {
 		g.Expect(fs.Lookup("fail-on-critical")).ToNot(BeNil())
 		g.Expect(fs.Lookup("fail-on-warning")).ToNot(BeNil())
 		g.Expect(fs.Lookup("timeout")).ToNot(BeNil())
+		g.Expect(fs.Lookup("strict")).ToNot(BeNil())
+		g.Expect(fs.Lookup("fail-on").DefValue).To(Equal(string(cmd.FailOnBlocking)))
+	})
+
+	t.Run("--fail-on should set the legacy fail-on flags", func(t *testing.T) {
+		g := NewWithT(t)
+
+		command := lint.NewCommand(genericiooptions.NewTestIOStreamsDiscard(), testConfigFlags())
+
+		fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		command.AddFlags(fs)
+
+		g.Expect(fs.Parse([]string{"--fail-on", "advisory"})).To(Succeed())
+		g.Expect(command.FailOnCritical).To(BeTrue())
+		g.Expect(command.FailOnWarning).To(BeTrue())
+
+		g.Expect(fs.Parse([]string{"--fail-on", "none"})).To(Succeed())
+		g.Expect(command.FailOnCritical).To(BeFalse())
+		g.Expect(command.FailOnWarning).To(BeFalse())
+
+		g.Expect(fs.Parse([]string{"--fail-on", "critical"})).To(MatchError(ContainSubstring("invalid --fail-on value")))
 	})
 }
 
@@ -265,3 +291,186 @@ func TestCommand_FunctionalOptions(t *testing.T) {
 		g.Expect(command.IO).ToNot(BeNil())
 	})
 }
+
+func TestCommand_TelemetryRequiresEndpoint(t *testing.T) {
+	g := NewWithT(t)
+
+	streams := genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: &bytes.Buffer{}, ErrOut: &bytes.Buffer{}}
+
+	command := lint.NewCommand(streams, testConfigFlags())
+	command.Telemetry = true
+
+	g.Expect(command.Validate()).To(MatchError(ContainSubstring("--telemetry requires --telemetry-endpoint")))
+
+	command.TelemetryEndpoint = "https://telemetry.example.com/v1/reports"
+	g.Expect(command.Validate()).To(Succeed())
+}
+
+func TestCommand_MetricsNotSupportedWithPlan(t *testing.T) {
+	g := NewWithT(t)
+
+	streams := genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: &bytes.Buffer{}, ErrOut: &bytes.Buffer{}}
+
+	command := lint.NewCommand(streams, testConfigFlags())
+	command.MetricsFile = "odh_lint.prom"
+	command.PushgatewayURL = "http://pushgateway:9091"
+
+	g.Expect(command.Validate()).To(Succeed())
+
+	command.Plan = true
+	g.Expect(command.Validate()).To(MatchError(ContainSubstring("--metrics-file and --pushgateway-url are not supported with --plan")))
+}
+
+func TestCommand_FixFlagsRequireFix(t *testing.T) {
+	g := NewWithT(t)
+
+	streams := genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: &bytes.Buffer{}, ErrOut: &bytes.Buffer{}}
+
+	command := lint.NewCommand(streams, testConfigFlags())
+	command.FixDryRun = true
+
+	g.Expect(command.Validate()).To(MatchError(ContainSubstring("--dry-run and --yes require --fix")))
+
+	command.Fix = true
+	g.Expect(command.Validate()).To(Succeed())
+}
+
+func TestCommand_FromBackup(t *testing.T) {
+	g := NewWithT(t)
+
+	dir := t.TempDir()
+	g.Expect(backup.WriteResourceToFile(dir, resources.DSCInitialization.GVR(), testutil.NewDSCI("redhat-ods-applications"))).To(Succeed())
+	g.Expect(backup.WriteResourceToFile(dir, resources.DataScienceCluster.GVR(), testutil.NewDSC(map[string]string{
+		"workbenches": "Managed",
+		"codeflare":   "Managed",
+	}))).To(Succeed())
+
+	var out bytes.Buffer
+
+	streams := genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: &out, ErrOut: &bytes.Buffer{}}
+
+	command := lint.NewCommand(streams, testConfigFlags(), lint.WithTargetVersion("3.0"))
+	command.FromBackup = dir
+	command.OutputFormat = lint.OutputFormatJSON
+	command.FailOnCritical = false
+
+	// Backups strip .status, so the version cannot be detected.
+	g.Expect(command.Complete()).To(Succeed())
+	g.Expect(command.Validate()).To(Succeed())
+	g.Expect(command.Run(t.Context())).To(MatchError(ContainSubstring("--current-version")))
+
+	command.CurrentVersion = "2.25"
+	g.Expect(command.Complete()).To(Succeed())
+	g.Expect(command.Run(t.Context())).To(Succeed())
+	g.Expect(command.Client).To(BeNil())
+	// CodeFlare is Managed in the backed-up DataScienceCluster and removed in 3.x.
+	g.Expect(out.String()).To(ContainSubstring(`"reason": "VersionIncompatible"`))
+}
+
+func TestCommand_FromBackupRequiresClusterFlags(t *testing.T) {
+	g := NewWithT(t)
+
+	streams := genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: &bytes.Buffer{}, ErrOut: &bytes.Buffer{}}
+
+	command := lint.NewCommand(streams, testConfigFlags())
+	command.CurrentVersion = "2.25"
+
+	g.Expect(command.Validate()).To(MatchError(ContainSubstring("--current-version requires --from-backup")))
+
+	command.FromBackup = t.TempDir()
+	command.Coverage = true
+
+	g.Expect(command.Validate()).To(MatchError(ContainSubstring("not supported with --from-backup")))
+
+	command.Coverage = false
+	command.Record = true
+
+	g.Expect(command.Validate()).To(MatchError(ContainSubstring("--record requires cluster access")))
+}
+
+func TestCommand_FromSnapshot(t *testing.T) {
+	g := NewWithT(t)
+
+	path := filepath.Join(t.TempDir(), "cluster.tar.gz")
+	g.Expect(snapshot.Write(path, &snapshot.Manifest{ClusterVersion: "2.25.0"}, snapshot.Objects{
+		resources.DSCInitialization.GVR(): {testutil.NewDSCI("redhat-ods-applications")},
+		resources.DataScienceCluster.GVR(): {testutil.NewDSC(map[string]string{
+			"workbenches": "Managed",
+			"codeflare":   "Managed",
+		})},
+	})).To(Succeed())
+
+	var out bytes.Buffer
+
+	streams := genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: &out, ErrOut: &bytes.Buffer{}}
+
+	command := lint.NewCommand(streams, testConfigFlags(), lint.WithTargetVersion("3.0"))
+	command.FromSnapshot = path
+	command.OutputFormat = lint.OutputFormatJSON
+	command.FailOnCritical = false
+
+	// The version recorded in the snapshot manifest is used
+	g.Expect(command.Complete()).To(Succeed())
+	g.Expect(command.Validate()).To(Succeed())
+	g.Expect(command.Run(t.Context())).To(Succeed())
+	g.Expect(command.Client).To(BeNil())
+	g.Expect(out.String()).To(ContainSubstring(`"reason": "VersionIncompatible"`))
+}
+
+func TestCommand_FromSnapshotRequiresClusterFlags(t *testing.T) {
+	g := NewWithT(t)
+
+	streams := genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: &bytes.Buffer{}, ErrOut: &bytes.Buffer{}}
+
+	command := lint.NewCommand(streams, testConfigFlags())
+	command.FromSnapshot = "cluster.tar.gz"
+	command.FromBackup = t.TempDir()
+
+	g.Expect(command.Validate()).To(MatchError(ContainSubstring("mutually exclusive")))
+
+	command.FromBackup = ""
+	command.Fix = true
+
+	g.Expect(command.Validate()).To(MatchError(ContainSubstring("not supported with --from-snapshot")))
+}
+
+func TestSnapshotCreateCommand_Validate(t *testing.T) {
+	g := NewWithT(t)
+
+	streams := genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: &bytes.Buffer{}, ErrOut: &bytes.Buffer{}}
+
+	command := lint.NewSnapshotCreateCommand(streams, testConfigFlags())
+	command.Output = "cluster.tar.zst"
+
+	g.Expect(command.Validate()).To(MatchError(snapshot.ErrUnsupportedFormat))
+
+	command.Output = "cluster.tar.gz"
+	g.Expect(command.Validate()).To(Succeed())
+}
+
+func TestCommand_DiffRejectsJUnit(t *testing.T) {
+	g := NewWithT(t)
+
+	streams := genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: &bytes.Buffer{}, ErrOut: &bytes.Buffer{}}
+
+	command := lint.NewCommand(streams, testConfigFlags())
+	command.Diff = "results.json"
+	command.OutputSpecs = []string{"table", "junit=report.xml"}
+
+	g.Expect(command.Validate()).To(MatchError(ContainSubstring("--output junit is not supported with --diff")))
+}
+
+func TestGitOpsCommentCommand_Validate(t *testing.T) {
+	g := NewWithT(t)
+
+	streams := genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: &bytes.Buffer{}, ErrOut: &bytes.Buffer{}}
+
+	command := lint.NewGitOpsCommentCommand(streams, testConfigFlags())
+	g.Expect(command.Validate()).To(MatchError("--pr is required"))
+
+	command.PR = "https://github.com/org/repo/pull/1"
+	g.Expect(command.Validate()).To(MatchError(ContainSubstring("requires an API token in ODH_GITOPS_TOKEN")))
+
+	command.DryRun = true
+	g.Expect(command.Validate()).To(Succeed())
+}
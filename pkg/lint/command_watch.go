@@ -0,0 +1,125 @@
+package lint
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+)
+
+// validateWatch checks that --watch is combined with options that can be re-evaluated.
+func (c *Command) validateWatch() error {
+	if !c.Watch {
+		return nil
+	}
+
+	switch {
+	case c.WatchInterval <= 0:
+		return errors.New("--watch-interval must be greater than 0")
+	case c.Plan:
+		return errors.New("--watch is not supported with --plan")
+	case c.Fix:
+		return errors.New("--watch is not supported with --fix")
+	case c.FromBackup != "" || c.FromSnapshot != "":
+		return errors.New("--watch requires cluster access and is not supported with --from-backup or --from-snapshot")
+	default:
+		return nil
+	}
+}
+
+// runWatch runs the checks every WatchInterval, or as soon as the DataScienceCluster or
+// DSCInitialization changes, until interrupted. The first run writes all results (or the
+// changes since the --diff results); later runs write only the checks whose results changed
+// since the previous run. The fail-on gates do not stop the watch; a run that fails before
+// producing results stops it only when it is the first one, so an API server restarting
+// during an upgrade does not end the watch.
+func (c *Command) runWatch(ctx context.Context) error {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	changed := c.watchPlatform(ctx)
+
+	for first := true; ; first = false {
+		c.lastResults = nil
+
+		err := c.runOnce(ctx)
+
+		switch {
+		case ctx.Err() != nil:
+			return nil
+		case c.lastResults != nil:
+			c.previous = c.lastResults
+		case first:
+			return err
+		case err != nil:
+			c.IO.Errorf("Warning: run failed, retrying in %s: %v", c.WatchInterval, err)
+		}
+
+		timer := time.NewTimer(c.WatchInterval)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return nil
+		case <-changed:
+			timer.Stop()
+			c.IO.Errorf("Platform configuration changed, re-running checks")
+		case <-timer.C:
+		}
+	}
+}
+
+// watchPlatform returns a channel signaled when the DataScienceCluster or DSCInitialization is
+// updated or deleted, so a change made to remediate a finding is re-evaluated without waiting
+// for the next interval. Changes made while checks run are coalesced into a single signal.
+func (c *Command) watchPlatform(ctx context.Context) <-chan struct{} {
+	changed := make(chan struct{}, 1)
+
+	notify := func() {
+		select {
+		case changed <- struct{}{}:
+		default:
+		}
+	}
+
+	factory := dynamicinformer.NewDynamicSharedInformerFactory(c.Client.Dynamic(), 0)
+
+	for _, rt := range []resources.ResourceType{resources.DataScienceCluster, resources.DSCInitialization} {
+		informer := factory.ForResource(rt.GVR()).Informer()
+
+		_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			UpdateFunc: func(oldObj any, newObj any) {
+				// Relists report unchanged objects as updated
+				if resourceVersion(oldObj) != resourceVersion(newObj) {
+					notify()
+				}
+			},
+			DeleteFunc: func(_ any) { notify() },
+		})
+		if err != nil {
+			c.IO.Errorf("Warning: not watching %s changes: %v", rt.Kind, err)
+		}
+	}
+
+	factory.Start(ctx.Done())
+
+	return changed
+}
+
+func resourceVersion(obj any) string {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return ""
+	}
+
+	return accessor.GetResourceVersion()
+}
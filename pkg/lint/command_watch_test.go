@@ -0,0 +1,140 @@
+package lint_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/selftest"
+
+	. "github.com/onsi/gomega"
+)
+
+// syncBuffer is a buffer written by a running watch and read by the test.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.buf.String()
+}
+
+func TestCommand_WatchReportsTransitions(t *testing.T) {
+	g := NewWithT(t)
+
+	var objects []*unstructured.Unstructured
+
+	for _, s := range selftest.Scenarios() {
+		if s.Name == "upgrade-ready" {
+			var err error
+
+			objects, err = s.Objects()
+			g.Expect(err).ToNot(HaveOccurred())
+		}
+	}
+
+	cluster, err := selftest.NewCluster(objects)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	out := &syncBuffer{}
+	streams := genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: out, ErrOut: io.Discard}
+
+	command := lint.NewCommand(streams, testConfigFlags(), lint.WithClient(cluster), lint.WithTargetVersion("3.0"))
+	command.Watch = true
+	command.WatchInterval = 100 * time.Millisecond
+	command.FailOnCritical = false
+
+	g.Expect(command.Complete()).To(Succeed())
+	g.Expect(command.Validate()).To(Succeed())
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	done := make(chan error, 1)
+
+	go func() { done <- command.Run(ctx) }()
+
+	g.Eventually(out.String).Should(ContainSubstring("migration-readiness"))
+	g.Expect(out.String()).ToNot(ContainSubstring("new-failure"))
+
+	// Enabling CodeFlare is reported as a new failure by the next run
+	dscs := cluster.Dynamic().Resource(resources.DataScienceCluster.GVR())
+
+	list, err := dscs.List(ctx, metav1.ListOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(list.Items).To(HaveLen(1))
+
+	dsc := list.Items[0]
+	g.Expect(unstructured.SetNestedField(dsc.Object, "Managed", "spec", "components", "codeflare", "managementState")).To(Succeed())
+	dsc.SetResourceVersion("2")
+
+	_, err = dscs.Update(ctx, &dsc, metav1.UpdateOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Eventually(out.String).Should(MatchRegexp(`new-failure\s+components\.codeflare\.removal`))
+
+	cancel()
+	g.Eventually(done).Should(Receive(BeNil()))
+}
+
+func TestCommand_WatchValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(c *lint.Command)
+		wantErr string
+	}{
+		{
+			name:    "zero interval",
+			mutate:  func(c *lint.Command) { c.WatchInterval = 0 },
+			wantErr: "--watch-interval must be greater than 0",
+		},
+		{
+			name:    "plan",
+			mutate:  func(c *lint.Command) { c.Plan = true },
+			wantErr: "--watch is not supported with --plan",
+		},
+		{
+			name:    "fix",
+			mutate:  func(c *lint.Command) { c.Fix = true },
+			wantErr: "--watch is not supported with --fix",
+		},
+		{
+			name:    "junit output",
+			mutate:  func(c *lint.Command) { c.OutputFormat = lint.OutputFormatJUnit },
+			wantErr: "--output junit is not supported with --watch",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			streams := genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: &bytes.Buffer{}, ErrOut: &bytes.Buffer{}}
+			command := lint.NewCommand(streams, testConfigFlags())
+			command.Watch = true
+			tt.mutate(command)
+
+			g.Expect(command.Validate()).To(MatchError(ContainSubstring(tt.wantErr)))
+		})
+	}
+}
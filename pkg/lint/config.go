@@ -0,0 +1,155 @@
+package lint
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+)
+
+// Config is the content of a --config file (e.g. odh-lint.yaml), the lint policy of an
+// environment.
+type Config struct {
+	// Overrides replace the impact of the findings of the matching checks. When several
+	// overrides match a check, the last one wins.
+	Overrides []ImpactOverrideRule `json:"overrides,omitempty"`
+
+	// Disable lists patterns of checks that are not run, as for --checks.
+	Disable []string `json:"disable,omitempty"`
+
+	// Parameters holds check-specific parameters, keyed by check ID.
+	Parameters map[string]json.RawMessage `json:"parameters,omitempty"`
+}
+
+// ImpactOverrideRule assigns an impact to the findings of the checks matching a pattern.
+type ImpactOverrideRule struct {
+	// Check is a check ID or pattern, as for --checks.
+	Check string `json:"check"`
+
+	// Impact is blocking, advisory or ignore.
+	Impact check.ImpactOverride `json:"impact"`
+}
+
+// LoadConfig reads and validates a lint configuration file.
+func LoadConfig(file string) (*Config, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("reading config file: %w", err)
+	}
+
+	return ParseConfig(data)
+}
+
+// ParseConfig parses and validates a lint configuration.
+func ParseConfig(data []byte) (*Config, error) {
+	var cfg Config
+	if err := yaml.UnmarshalStrict(data, &cfg); err != nil {
+		return nil, fmt.Errorf("parsing config: %w", err)
+	}
+
+	for i, rule := range cfg.Overrides {
+		if rule.Check == "" {
+			return nil, fmt.Errorf("override %d: check is required", i+1)
+		}
+
+		if err := ValidateCheckSelector(rule.Check); err != nil {
+			return nil, fmt.Errorf("override %d: %w", i+1, err)
+		}
+
+		if err := rule.Impact.Validate(); err != nil {
+			return nil, fmt.Errorf("override %d: %w", i+1, err)
+		}
+	}
+
+	for _, pattern := range cfg.Disable {
+		if err := ValidateCheckSelector(pattern); err != nil {
+			return nil, fmt.Errorf("disable: %w", err)
+		}
+	}
+
+	return &cfg, nil
+}
+
+// Apply applies the configuration to the checks of registry: it sets the check parameters and
+// returns a registry without the disabled checks, and the impact overrides keyed by check ID.
+// Overrides, disable patterns and parameters must each match at least one check, so typos in
+// the file do not go unnoticed.
+func (cfg *Config) Apply(registry *check.CheckRegistry) (*check.CheckRegistry, map[string]check.ImpactOverride, error) {
+	for _, id := range slices.Sorted(maps.Keys(cfg.Parameters)) {
+		chk, ok := registry.Get(id)
+		if !ok {
+			return nil, nil, fmt.Errorf("parameters: unknown check %q", id)
+		}
+
+		parameterized, ok := chk.(check.Parameterized)
+		if !ok {
+			return nil, nil, fmt.Errorf("parameters: check %q has no parameters", id)
+		}
+
+		if err := parameterized.SetParameters(cfg.Parameters[id]); err != nil {
+			return nil, nil, fmt.Errorf("parameters: check %q: %w", id, err)
+		}
+	}
+
+	overrides := make(map[string]check.ImpactOverride)
+
+	for i, rule := range cfg.Overrides {
+		matched, err := registry.ListByPatterns([]string{rule.Check}, "")
+		if err != nil {
+			return nil, nil, fmt.Errorf("override %d: %w", i+1, err)
+		}
+
+		if len(matched) == 0 {
+			return nil, nil, fmt.Errorf("override %d: %q matches no check", i+1, rule.Check)
+		}
+
+		for _, chk := range matched {
+			overrides[chk.ID()] = rule.Impact
+		}
+	}
+
+	if len(cfg.Disable) == 0 {
+		return registry, overrides, nil
+	}
+
+	disabled := make(map[string]bool)
+
+	for _, pattern := range cfg.Disable {
+		matched, err := registry.ListByPatterns([]string{pattern}, "")
+		if err != nil {
+			return nil, nil, fmt.Errorf("disable: %w", err)
+		}
+
+		if len(matched) == 0 {
+			return nil, nil, fmt.Errorf("disable: %q matches no check", pattern)
+		}
+
+		for _, chk := range matched {
+			disabled[chk.ID()] = true
+		}
+	}
+
+	enabled := check.NewRegistry()
+
+	for _, chk := range registry.ListAll() {
+		if disabled[chk.ID()] {
+			continue
+		}
+
+		if err := enabled.Register(chk); err != nil {
+			return nil, nil, fmt.Errorf("registering check %s: %w", chk.ID(), err)
+		}
+	}
+
+	if len(enabled.ListAll()) == 0 {
+		return nil, nil, errors.New("disable: all checks are disabled")
+	}
+
+	return enabled, overrides, nil
+}
@@ -0,0 +1,141 @@
+package lint_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/dependencies/etcd"
+
+	. "github.com/onsi/gomega"
+)
+
+const testConfig = `overrides:
+  - check: "workloads.*"
+    impact: advisory
+  - check: workloads.notebook.impacted-workloads
+    impact: blocking
+  - check: components.trainingoperator.deprecation
+    impact: ignore
+disable:
+  - "services.managed-service.*"
+parameters:
+  dependencies.etcd.object-count:
+    threshold: 5000
+`
+
+func TestConfig_Apply(t *testing.T) {
+	g := NewWithT(t)
+
+	cfg, err := lint.ParseConfig([]byte(testConfig))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	registry := check.NewRegistry()
+	objectCount := etcd.NewObjectCountCheck()
+	registry.MustRegister(objectCount)
+
+	for id, group := range map[string]check.CheckGroup{
+		"workloads.ray.impacted-workloads":         check.GroupWorkload,
+		"workloads.notebook.impacted-workloads":    check.GroupWorkload,
+		"components.trainingoperator.deprecation":  check.GroupComponent,
+		"services.managed-service.hive-namespaces": check.GroupService,
+	} {
+		registry.MustRegister(&planTestCheck{BaseCheck: check.BaseCheck{CheckGroup: group, CheckID: id}})
+	}
+
+	enabled, overrides, err := cfg.Apply(registry)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	// Later overrides win
+	g.Expect(overrides).To(Equal(map[string]check.ImpactOverride{
+		"workloads.ray.impacted-workloads":        check.ImpactOverrideAdvisory,
+		"workloads.notebook.impacted-workloads":   check.ImpactOverrideBlocking,
+		"components.trainingoperator.deprecation": check.ImpactOverrideIgnore,
+	}))
+
+	g.Expect(enabled.ListAll()).To(HaveLen(4))
+	_, ok := enabled.Get("services.managed-service.hive-namespaces")
+	g.Expect(ok).To(BeFalse())
+
+	g.Expect(objectCount.Threshold).To(Equal(5000))
+}
+
+func TestConfig_ApplyErrors(t *testing.T) {
+	tests := map[string]struct {
+		config string
+		err    string
+	}{
+		"override without match": {
+			config: "overrides:\n  - check: components.unknown.*\n    impact: advisory\n",
+			err:    `override 1: "components.unknown.*" matches no check`,
+		},
+		"disable without match": {
+			config: "disable: [\"components.unknown\"]\n",
+			err:    `disable: "components.unknown" matches no check`,
+		},
+		"parameters of unknown check": {
+			config: "parameters:\n  components.unknown.removal: {}\n",
+			err:    `parameters: unknown check "components.unknown.removal"`,
+		},
+		"parameters of check without parameters": {
+			config: "parameters:\n  components.codeflare.removal: {}\n",
+			err:    `parameters: check "components.codeflare.removal" has no parameters`,
+		},
+		"invalid parameters": {
+			config: "parameters:\n  dependencies.etcd.object-count: {threshold: -1}\n",
+			err:    "threshold must be positive",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			cfg, err := lint.ParseConfig([]byte(tt.config))
+			g.Expect(err).ToNot(HaveOccurred())
+
+			registry := check.NewRegistry()
+			registry.MustRegister(etcd.NewObjectCountCheck())
+			registry.MustRegister(&planTestCheck{BaseCheck: check.BaseCheck{
+				CheckGroup: check.GroupComponent,
+				CheckID:    "components.codeflare.removal",
+			}})
+
+			_, _, err = cfg.Apply(registry)
+			g.Expect(err).To(MatchError(ContainSubstring(tt.err)))
+		})
+	}
+}
+
+func TestParseConfig_Invalid(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := lint.ParseConfig([]byte("overrides:\n  - check: workloads.*\n    impact: critical\n"))
+	g.Expect(err).To(MatchError(ContainSubstring(`override 1: invalid impact "critical"`)))
+
+	_, err = lint.ParseConfig([]byte("overrides:\n  - impact: advisory\n"))
+	g.Expect(err).To(MatchError(ContainSubstring("override 1: check is required")))
+
+	_, err = lint.ParseConfig([]byte("severity: {}\n"))
+	g.Expect(err).To(MatchError(ContainSubstring("parsing config")))
+
+	_, err = lint.ParseConfig([]byte("disable: [\"workloads.[\"]\n"))
+	g.Expect(err).To(MatchError(ContainSubstring("disable:")))
+}
+
+func TestCommand_ConfigFile(t *testing.T) {
+	g := NewWithT(t)
+
+	file := filepath.Join(t.TempDir(), "odh-lint.yaml")
+	g.Expect(os.WriteFile(file, []byte("disable: [\"components.unknown\"]\n"), 0o600)).To(Succeed())
+
+	command := lint.NewCommand(genericiooptions.NewTestIOStreamsDiscard(), testConfigFlags())
+	command.FromBackup = t.TempDir()
+	command.Config = file
+
+	g.Expect(command.Complete()).To(MatchError(ContainSubstring(`applying config ` + file + `: disable: "components.unknown" matches no check`)))
+}
@@ -2,15 +2,79 @@ package lint
 
 // Flag descriptions for the lint command.
 const (
-	flagDescTargetVersion = "target version for upgrade readiness checks (e.g., 2.25.0, 3.0.0)"
-	flagDescOutput        = "output format (table|json|yaml)"
-	flagDescFailCritical  = "exit with error if critical findings are detected"
-	flagDescFailWarning   = "exit with error if warning or critical findings are detected"
-	flagDescVerbose       = "show impacted objects and summary information"
-	flagDescDebug         = "show detailed diagnostic logs for troubleshooting"
-	flagDescTimeout       = "operation timeout (e.g., 10m, 30m)"
-	flagDescQPS           = "Kubernetes API QPS limit (queries per second)"
-	flagDescBurst         = "Kubernetes API burst capacity"
+	flagDescTargetVersion        = "target version for upgrade readiness checks (e.g., 2.25.0, 3.0.0); a comma-separated list assesses each target in one run (e.g., 3.0.0,3.1.0)"
+	flagDescAllTargets           = "assess upgrade readiness for every supported target version newer than the current version in one run"
+	flagDescOutput               = "output format (table|json|yaml|junit|html|markdown), optionally written to a file as format=path; repeatable, at most one to stdout (default table)"
+	flagDescFailCritical         = "exit with error if critical findings are detected (deprecated: use --fail-on)"
+	flagDescFailWarning          = "exit with error if warning or critical findings are detected (deprecated: use --fail-on)"
+	flagDescFailOn               = "lowest impact of findings failing the run: none, blocking (exit code 3, or 5 if checks fail to execute) or advisory (also exit code 2)"
+	flagDescVerbose              = "show impacted objects and summary information"
+	flagDescDebug                = "show detailed diagnostic logs for troubleshooting"
+	flagDescTimeout              = "operation timeout (e.g., 10m, 30m)"
+	flagDescQPS                  = "Kubernetes API QPS limit (queries per second)"
+	flagDescBurst                = "Kubernetes API burst capacity"
+	flagDescGraphOutput          = "graph output format (dot|json)"
+	flagDescListOutput           = "check list output format (table|json|yaml)"
+	flagDescListCurrentVersion   = "OpenShift AI version to evaluate check applicability for; detected from the cluster with --cluster"
+	flagDescListTargetVersion    = "target version of the upgrade to evaluate check applicability for (default: the current version)"
+	flagDescListCluster          = "evaluate check applicability against the live cluster; without it, checks depending on cluster state are reported as unknown"
+	flagDescExplainOutput        = "explanation output format (text|json|yaml)"
+	flagDescObjectOutput         = "object report output format (table|json|yaml)"
+	flagDescExplain              = "print the documentation of the check with this ID instead of running checks (same as 'lint explain')"
+	flagDescCoverage             = "print which discovered resource types and components were assessed by at least one applicable check"
+	flagDescAssignments          = "YAML file mapping namespaces (names, globs or label selectors) to owning teams and deadlines; adds owners to impacted objects and a per-team rollup"
+	flagDescConfig               = "YAML lint configuration file (e.g. odh-lint.yaml) overriding the impact of checks (blocking, advisory or ignore), disabling checks by ID or pattern and setting check parameters"
+	flagDescColumns              = "custom table columns as NAME or NAME:JQ-EXPRESSION pairs evaluated against each check result (e.g. CHECK,STATUS,IMPACT,COUNT); built-in names: GROUP, KIND, CHECK, STATUS, IMPACT, MESSAGE, COUNT, DESCRIPTION, REMEDIATION"
+	flagDescRemediation          = "write machine-applicable remediation commands to an executable shell script at this path instead of applying them"
+	flagDescPlan                 = "resolve --checks and evaluate check applicability without running checks; prints which checks would run, which are skipped and why"
+	flagDescDB                   = "record run metadata and findings in the run history database at this path (query with 'lint query')"
+	flagDescRecord               = "record the results in the cluster as a ConfigMap labeled with the run timestamp and versions (list and fetch with 'lint history')"
+	flagDescRecordNamespace      = "namespace recorded runs are written to (--record) and read from (lint history)"
+	flagDescQueryDB              = "path of the run history database recorded with 'lint --db'"
+	flagDescQuerySince           = "only include runs recorded at or after this date (YYYY-MM-DD or RFC 3339 timestamp)"
+	flagDescQueryCheck           = "only include checks whose ID matches this glob pattern (e.g. 'workloads.*')"
+	flagDescQueryFlipped         = "list checks whose status changed between consecutive runs instead of findings"
+	flagDescQueryAll             = "include passing checks in the findings"
+	flagDescBaseline             = "lint JSON or YAML report (e.g. from 'lint -o json=first-run.json') whose findings are re-evaluated"
+	flagDescQueryOutput          = "query output format (table|json)"
+	flagDescHistoryOutput        = "output format of the recorded runs, or of the results of a fetched run (table|json|yaml)"
+	flagDescStatusOutput         = "output format (table|json|yaml), optionally written to a file as format=path; repeatable, at most one to stdout (default table)"
+	flagDescConcurrency          = "maximum number of checks executed concurrently; results are reported in the same order regardless"
+	flagDescCheckTimeout         = "maximum duration of each check (e.g. 1m), so one slow check cannot use up --timeout; 0 bounds checks by --timeout only"
+	flagDescProbe                = "run opt-in checks that send requests to workload endpoints, e.g. the gRPC health and metadata APIs of a sample of exposed models"
+	flagDescImageStreamNamespace = "namespace of the out-of-the-box workbench ImageStreams (repeatable or comma-separated, e.g. redhat-ods-applications,opendatahub); defaults to the applications namespace of the DSCInitialization"
+	flagDescInspectRegistry      = "classify custom workbench images from their labels and environment (OPENSHIFT_BUILD_REFERENCE, notebook software) in the image registry, authenticating with the cluster pull secret"
+	flagDescRegistryConfig       = "Docker config.json (or .dockerconfigjson pull secret payload) with credentials for --inspect-registry, in addition to the cluster pull secret"
+	flagDescPlugins              = "register the checks of the odh-lint-check-* plugin executables found on PATH, namespaced as plugin.<name>.<check-id>"
+	flagDescPluginTimeout        = "maximum duration of each plugin invocation for plugins that declare no timeout of their own"
+	flagDescChecksDir            = "directory of YAML check definitions (resource type, label selector, JQ expression, condition) registered as custom.<id> alongside the built-in checks"
+	flagDescShowTimings          = "add the duration and Kubernetes API request count of each check to the table output (always included in JSON and YAML as status.timing)"
+	flagDescTrace                = "log each Kubernetes API request made during a check, with the check ID, status and latency, to stderr to debug slow runs"
+	flagDescStrict               = "fail the run when a check returns a result that would break serializers (missing impacts, impacted objects without apiVersion/kind or name, annotation keys without a domain)"
+	flagDescRetryUnknown         = "retry checks that returned Unknown because of transient API errors once at the end of the run, within the remaining --timeout"
+	flagDescTelemetry            = "opt in to posting anonymized check statistics (check IDs, pass/fail counts, cluster size bucket, versions; no names or namespaces) to the telemetry endpoint; see 'telemetry preview'"
+	flagDescTelemetryEndpoint    = "URL telemetry reports are posted to (default: $ODH_TELEMETRY_ENDPOINT)"
+	flagDescWatch                = "keep running, re-running the checks every --watch-interval or when the DataScienceCluster or DSCInitialization changes, and print only the results that changed, until interrupted"
+	flagDescWatchInterval        = "time between two runs with --watch"
+	flagDescMetricsFile          = "write check results as Prometheus metrics (odh_lint_check_status, odh_lint_impacted_objects_total) to this path, for the node_exporter textfile collector"
+	flagDescPushgatewayURL       = "push check results as Prometheus metrics to the Pushgateway at this URL (job odh-lint)"
+	flagDescSummaryFile          = "write a small JSON summary of the run (totals, fail-on gate state, duration, versions, command line) to this path, regardless of --output"
+	flagDescFix                  = "apply the remediation of failing checks that support automatic fixes (e.g. setting a component managementState), after previewing the changes and asking for confirmation; fixed checks are run again"
+	flagDescFixDryRun            = "with --fix, preview the changes without making them"
+	flagDescFixYes               = "with --fix, apply fixes without asking for confirmation"
+	flagDescSave                 = "save the results of the run as JSON to this file, for a later --diff"
+	flagDescDiff                 = "compare against results saved with --save (or a JSON/YAML report) and only output the checks whose results changed: new failures, resolved failures and changed conditions or impacted objects"
+	flagDescFromBackup           = "run the checks against a backup directory written by 'kubectl odh backup --output-dir' instead of the cluster; checks only see the backed-up resources"
+	flagDescFromSnapshot         = "run the checks against a snapshot archive written by 'kubectl odh snapshot create' instead of the cluster, reproducing the run it was taken for"
+	flagDescCurrentVersion       = "with --from-backup or --from-snapshot, the OpenShift AI version the resources were taken from when it cannot be detected (backups strip .status, which version detection reads)"
+	flagDescGitOpsPR             = "web URL of the GitHub pull request or GitLab merge request to comment on (e.g. https://github.com/org/repo/pull/42)"
+	flagDescGitOpsRepoDir        = "checkout of the pull request the changed manifests are read from"
+	flagDescGitOpsDryRun         = "print the comment instead of posting it"
+	flagDescSnapshotChecks       = "patterns of the checks whose required resources are captured, as for lint --checks (can be specified multiple times)"
+	flagDescExportImpacted       = "directory to write a remediation worklist of impacted objects to, one file per check (namespace, name, kind, reason, suggested remediation, owner)"
+	flagDescExportFormat         = "file format of the --export-impacted worklists: csv or json"
+	flagDescExportGroupBy        = "namespace annotation (e.g. openshift.io/requester) whose value groups the --export-impacted worklists into one directory per owner"
+	flagDescChecksFromFile       = "file of check selectors, one per line as for --checks, with '#' comments; added to the --checks selectors (the default '*' applies only when neither is given)"
 )
 
 const flagDescChecks = `check selector patterns (glob patterns or categories):
@@ -19,6 +83,7 @@ const flagDescChecks = `check selector patterns (glob patterns or categories):
   - 'services.*'    : all service checks
   - 'workloads.*'   : all workload checks
   - 'dependencies.*': all dependency checks
+  - 'zstream'       : z-stream upgrade profile (checks for upgrades between 2.x releases)
   - '*dashboard*'   : all checks with 'dashboard' in ID
   - 'exact.id'      : exact check ID
 Can be specified multiple times`
@@ -0,0 +1,293 @@
+package lint
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/constants"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/printer/table"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/components"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube/discovery"
+)
+
+// CoverageItemKind is the kind of cluster surface item tracked by the coverage report.
+type CoverageItemKind string
+
+const (
+	// CoverageItemResource is a discovered ODH resource type (group/resource).
+	CoverageItemResource CoverageItemKind = "resource"
+
+	// CoverageItemComponent is a DSC component that is Managed or Unmanaged.
+	CoverageItemComponent CoverageItemKind = "component"
+)
+
+// ClusterSurface is the set of ODH resource types and components present on the cluster.
+type ClusterSurface struct {
+	Resources  []schema.GroupResource
+	Components []string
+}
+
+// CoverageItem records which executed checks covered a single surface item.
+type CoverageItem struct {
+	Kind   CoverageItemKind
+	Name   string
+	Checks []string
+}
+
+// Covered reports whether at least one applicable check was executed for the item.
+func (i CoverageItem) Covered() bool {
+	return len(i.Checks) > 0
+}
+
+// CoverageReport summarizes which parts of the cluster surface were assessed by lint checks.
+type CoverageReport struct {
+	Items []CoverageItem
+}
+
+// coverageRow is a single row of the coverage table.
+type coverageRow struct {
+	Type    string
+	Name    string
+	Covered string
+	Checks  string
+}
+
+// BuildCoverageReport matches the executed checks against the cluster surface.
+// A resource type is covered when an executed check declares it in RequiredResources
+// (see check.GraphDescriber); a component is covered when an executed check has the
+// component key as its kind. Executions only contain checks whose CanApply returned true.
+func BuildCoverageReport(surface ClusterSurface, executions []check.CheckExecution) *CoverageReport {
+	byResource := make(map[schema.GroupResource]map[string]bool)
+	byKind := make(map[string]map[string]bool)
+
+	add := func(m map[string]bool, id string) map[string]bool {
+		if m == nil {
+			m = make(map[string]bool)
+		}
+		m[id] = true
+
+		return m
+	}
+
+	for _, exec := range executions {
+		if exec.Check == nil {
+			continue
+		}
+
+		id := exec.Check.ID()
+		byKind[exec.Check.CheckKind()] = add(byKind[exec.Check.CheckKind()], id)
+
+		describer, ok := exec.Check.(check.GraphDescriber)
+		if !ok {
+			continue
+		}
+
+		for _, rt := range describer.RequiredResources() {
+			gr := rt.GVR().GroupResource()
+			byResource[gr] = add(byResource[gr], id)
+		}
+	}
+
+	report := &CoverageReport{}
+
+	resources := make(map[schema.GroupResource]bool, len(surface.Resources))
+	for _, gr := range surface.Resources {
+		resources[gr] = true
+	}
+
+	for gr := range resources {
+		report.Items = append(report.Items, CoverageItem{
+			Kind:   CoverageItemResource,
+			Name:   gr.String(),
+			Checks: sortedKeys(byResource[gr]),
+		})
+	}
+
+	for _, component := range surface.Components {
+		report.Items = append(report.Items, CoverageItem{
+			Kind:   CoverageItemComponent,
+			Name:   component,
+			Checks: sortedKeys(byKind[component]),
+		})
+	}
+
+	sort.Slice(report.Items, func(i, j int) bool {
+		if report.Items[i].Kind != report.Items[j].Kind {
+			return report.Items[i].Kind == CoverageItemComponent
+		}
+
+		return report.Items[i].Name < report.Items[j].Name
+	})
+
+	return report
+}
+
+// Counts returns the number of covered and total items of the given kind.
+func (r *CoverageReport) Counts(kind CoverageItemKind) (int, int) {
+	covered, total := 0, 0
+
+	for _, item := range r.Items {
+		if item.Kind != kind {
+			continue
+		}
+
+		total++
+
+		if item.Covered() {
+			covered++
+		}
+	}
+
+	return covered, total
+}
+
+// Write renders the coverage table followed by a one-line summary.
+func (r *CoverageReport) Write(out io.Writer) error {
+	if _, err := fmt.Fprintln(out, "\nCoverage:"); err != nil {
+		return fmt.Errorf("writing coverage header: %w", err)
+	}
+
+	renderer := table.NewRenderer(
+		table.WithWriter[coverageRow](out),
+		table.WithHeaders[coverageRow]("TYPE", "NAME", "COVERED", "CHECKS"),
+		table.WithTableOptions[coverageRow](table.DefaultTableOptions...),
+	)
+
+	for _, item := range r.Items {
+		row := coverageRow{
+			Type:    string(item.Kind),
+			Name:    item.Name,
+			Covered: statusFail,
+			Checks:  "-",
+		}
+
+		if item.Covered() {
+			row.Covered = statusPass
+			row.Checks = strings.Join(item.Checks, ", ")
+		}
+
+		if err := renderer.Append(row); err != nil {
+			return fmt.Errorf("appending coverage row: %w", err)
+		}
+	}
+
+	if err := renderer.Render(); err != nil {
+		return fmt.Errorf("rendering coverage table: %w", err)
+	}
+
+	coveredComponents, totalComponents := r.Counts(CoverageItemComponent)
+	coveredResources, totalResources := r.Counts(CoverageItemResource)
+
+	percent := 100.0
+	if total := totalComponents + totalResources; total > 0 {
+		percent = float64(coveredComponents+coveredResources) * 100 / float64(total)
+	}
+
+	_, err := fmt.Fprintf(out, "Covered %d/%d components and %d/%d resource types (%.0f%%)\n",
+		coveredComponents, totalComponents, coveredResources, totalResources, percent)
+	if err != nil {
+		return fmt.Errorf("writing coverage summary: %w", err)
+	}
+
+	return nil
+}
+
+// NewClusterSurface builds the cluster surface from discovery results and the DataScienceCluster.
+// Subresources are ignored; dsc may be nil when no DataScienceCluster exists.
+func NewClusterSurface(
+	discovered []discovery.ComponentAndService,
+	workloads []schema.GroupVersionResource,
+	dsc *unstructured.Unstructured,
+) ClusterSurface {
+	var surface ClusterSurface
+
+	for _, comp := range discovered {
+		for _, res := range comp.Resources {
+			if strings.Contains(res.Name, "/") {
+				continue
+			}
+
+			surface.Resources = append(surface.Resources, schema.GroupResource{Group: comp.APIGroup, Resource: res.Name})
+		}
+	}
+
+	for _, gvr := range workloads {
+		surface.Resources = append(surface.Resources, gvr.GroupResource())
+	}
+
+	if dsc == nil {
+		return surface
+	}
+
+	specComponents, _, _ := unstructured.NestedMap(dsc.Object, "spec", "components")
+	for key := range specComponents {
+		if components.HasManagementState(dsc, key, constants.ManagementStateManaged, constants.ManagementStateUnmanaged) {
+			surface.Components = append(surface.Components, key)
+		}
+	}
+
+	return surface
+}
+
+// discoverSurface runs the discovery needed for --coverage in modes that do not already discover.
+func (c *Command) discoverSurface(ctx context.Context) (ClusterSurface, error) {
+	discovered, err := discovery.DiscoverComponentsAndServices(ctx, c.Client)
+	if err != nil {
+		return ClusterSurface{}, fmt.Errorf("discovering components and services: %w", err)
+	}
+
+	workloads, err := discovery.DiscoverWorkloads(ctx, c.Client)
+	if err != nil {
+		return ClusterSurface{}, fmt.Errorf("discovering workloads: %w", err)
+	}
+
+	dsc, err := c.getDataScienceCluster(ctx)
+	if err != nil {
+		return ClusterSurface{}, err
+	}
+
+	return NewClusterSurface(discovered, workloads, dsc), nil
+}
+
+// getDataScienceCluster returns the DataScienceCluster, or nil if none exists.
+func (c *Command) getDataScienceCluster(ctx context.Context) (*unstructured.Unstructured, error) {
+	dsc, err := client.GetDataScienceCluster(ctx, c.Client)
+
+	switch {
+	case client.IsResourceTypeNotFound(err):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("getting DataScienceCluster: %w", err)
+	default:
+		return dsc, nil
+	}
+}
+
+// writeCoverage prints the coverage report to stderr so it never mixes with
+// machine-readable results on stdout.
+func (c *Command) writeCoverage(surface ClusterSurface, resultsByGroup map[check.CheckGroup][]check.CheckExecution) error {
+	return BuildCoverageReport(surface, FlattenResults(resultsByGroup)).Write(c.IO.ErrOut())
+}
+
+func sortedKeys(m map[string]bool) []string {
+	if len(m) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,92 @@
+package lint_test
+
+import (
+	"bytes"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube/discovery"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestNewClusterSurface(t *testing.T) {
+	g := NewWithT(t)
+
+	dsc := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"components": map[string]any{
+				"kserve":    map[string]any{"managementState": "Managed"},
+				"kueue":     map[string]any{"managementState": "Unmanaged"},
+				"modelmesh": map[string]any{"managementState": "Removed"},
+			},
+		},
+	}}
+
+	surface := lint.NewClusterSurface(
+		[]discovery.ComponentAndService{{
+			APIGroup: "dashboard.opendatahub.io",
+			Version:  "v1",
+			Resources: []metav1.APIResource{
+				{Name: "acceleratorprofiles"},
+				{Name: "acceleratorprofiles/status"},
+			},
+		}},
+		[]schema.GroupVersionResource{resources.Notebook.GVR()},
+		dsc,
+	)
+
+	g.Expect(surface.Resources).To(ConsistOf(
+		schema.GroupResource{Group: "dashboard.opendatahub.io", Resource: "acceleratorprofiles"},
+		resources.Notebook.GVR().GroupResource(),
+	))
+	g.Expect(surface.Components).To(ConsistOf("kserve", "kueue"))
+}
+
+func TestBuildCoverageReport(t *testing.T) {
+	g := NewWithT(t)
+
+	kserve := newGraphTestCheck(check.GroupComponent, "components.kserve.a", "")
+	kserve.(*graphTestCheck).Kind = "kserve"
+
+	notebook := newGraphTestCheck(check.GroupWorkload, "workloads.notebook.a", "", resources.Notebook)
+
+	report := lint.BuildCoverageReport(
+		lint.ClusterSurface{
+			Resources: []schema.GroupResource{
+				resources.Notebook.GVR().GroupResource(),
+				resources.Notebook.GVR().GroupResource(),
+				resources.RayCluster.GVR().GroupResource(),
+			},
+			Components: []string{"kserve", "kueue"},
+		},
+		[]check.CheckExecution{
+			{Check: kserve},
+			// Workload checks run once per instance; each check is listed once.
+			{Check: notebook},
+			{Check: notebook},
+		},
+	)
+
+	g.Expect(report.Items).To(Equal([]lint.CoverageItem{
+		{Kind: lint.CoverageItemComponent, Name: "kserve", Checks: []string{"components.kserve.a"}},
+		{Kind: lint.CoverageItemComponent, Name: "kueue"},
+		{Kind: lint.CoverageItemResource, Name: "notebooks.kubeflow.org", Checks: []string{"workloads.notebook.a"}},
+		{Kind: lint.CoverageItemResource, Name: "rayclusters.ray.io"},
+	}))
+
+	covered, total := report.Counts(lint.CoverageItemResource)
+	g.Expect(covered).To(Equal(1))
+	g.Expect(total).To(Equal(2))
+
+	var out bytes.Buffer
+	g.Expect(report.Write(&out)).To(Succeed())
+	g.Expect(out.String()).To(ContainSubstring("rayclusters.ray.io"))
+	g.Expect(out.String()).To(ContainSubstring("Covered 1/2 components and 1/2 resource types (50%)"))
+}
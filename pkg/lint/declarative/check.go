@@ -0,0 +1,203 @@
+package declarative
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+)
+
+// AnnotationDeclarative is set on the results of declarative checks to the ID of their
+// definition.
+const AnnotationDeclarative = "check.opendatahub.io/declarative"
+
+// Check is a check defined in YAML.
+type Check struct {
+	check.BaseCheck
+
+	definition Definition
+	resource   resources.ResourceType
+	message    *template.Template
+}
+
+func newCheck(def Definition) (*Check, error) {
+	message, err := parseMessage(def.Condition.Message)
+	if err != nil {
+		return nil, fmt.Errorf("invalid condition.message: %w", err)
+	}
+
+	resource := def.resourceType()
+
+	name := def.Name
+	if name == "" {
+		name = "Custom :: " + def.Resource.Kind + " :: " + def.ID
+	}
+
+	return &Check{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       check.CheckGroup(def.Group),
+			Kind:             strings.ToLower(def.Resource.Kind),
+			Type:             check.CheckType(def.ID),
+			CheckID:          IDPrefix + def.ID,
+			CheckName:        name,
+			CheckDescription: def.Description,
+			CheckRemediation: def.Remediation,
+			CheckResources:   []resources.ResourceType{resource},
+			CheckDocumentation: check.Documentation{
+				Inspects: fmt.Sprintf("%s objects matching the JQ expression: %s", def.Resource.Kind, def.JQ),
+			},
+		},
+		definition: def,
+		resource:   resource,
+		message:    message,
+	}, nil
+}
+
+// CanApply returns true: declarative checks apply to any version.
+func (c *Check) CanApply(_ context.Context, _ check.Target) (bool, error) {
+	return true, nil
+}
+
+// Validate evaluates the expression of the definition against each object of its resource
+// type and reports the matching objects as impacted.
+func (c *Check) Validate(
+	ctx context.Context,
+	target check.Target,
+) (*result.DiagnosticResult, error) {
+	dr := c.NewResult()
+	dr.Annotations[AnnotationDeclarative] = c.definition.ID
+
+	var opts []client.ListResourcesOption
+	if c.definition.Namespace != "" {
+		opts = append(opts, client.WithNamespace(c.definition.Namespace))
+	}
+
+	if c.definition.LabelSelector != "" {
+		opts = append(opts, client.WithLabelSelector(c.definition.LabelSelector))
+	}
+
+	items, err := target.Client.ListResources(ctx, c.resource.GVR(), opts...)
+	if err != nil {
+		if client.IsResourceTypeNotFound(err) {
+			dr.SetCondition(check.NewCondition(
+				c.definition.Condition.Type,
+				metav1.ConditionTrue,
+				check.WithReason(check.ReasonResourceNotFound),
+				check.WithMessage("%s is not installed", c.resource.Resource),
+			))
+
+			return dr, nil
+		}
+
+		return nil, fmt.Errorf("listing %s: %w", c.resource.Resource, err)
+	}
+
+	var objects []string
+
+	for _, item := range items {
+		matched, err := c.matches(item)
+		if err != nil {
+			return nil, fmt.Errorf("evaluating %s: %w", objectName(item), err)
+		}
+
+		if !matched {
+			continue
+		}
+
+		dr.ImpactedObjects = append(dr.ImpactedObjects, metav1.PartialObjectMetadata{
+			TypeMeta: c.resource.TypeMeta(),
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: item.GetNamespace(),
+				Name:      item.GetName(),
+			},
+		})
+
+		objects = append(objects, objectName(item))
+	}
+
+	if len(objects) == 0 {
+		dr.SetCondition(check.NewCondition(
+			c.definition.Condition.Type,
+			metav1.ConditionTrue,
+			check.WithReason(check.ReasonRequirementsMet),
+			check.WithMessage("None of the %d %s match the check", len(items), c.resource.Resource),
+		))
+
+		return dr, nil
+	}
+
+	var message bytes.Buffer
+
+	err = c.message.Execute(&message, TemplateData{
+		Count:    len(objects),
+		Total:    len(items),
+		Resource: c.resource.Resource,
+		Objects:  objects,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("rendering condition message: %w", err)
+	}
+
+	reason := c.definition.Condition.Reason
+	if reason == "" {
+		reason = check.ReasonConfigurationInvalid
+	}
+
+	impact := c.definition.Condition.Impact
+	if impact == result.ImpactNone {
+		impact = result.ImpactAdvisory
+	}
+
+	dr.SetCondition(check.NewCondition(
+		c.definition.Condition.Type,
+		metav1.ConditionFalse,
+		check.WithReason(reason),
+		check.WithMessage("%s", message.String()),
+		check.WithImpact(impact),
+		check.WithRemediation(c.definition.Remediation),
+	))
+
+	return dr, nil
+}
+
+// matches evaluates the expression of the definition against obj. Missing fields and
+// non-boolean results do not match, as for jq.Predicate.
+func (c *Check) matches(obj *unstructured.Unstructured) (bool, error) {
+	// Unstructured objects hold integers as int64, which JQ does not support
+	data, err := json.Marshal(obj.Object)
+	if err != nil {
+		return false, fmt.Errorf("encoding object: %w", err)
+	}
+
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return false, fmt.Errorf("decoding object: %w", err)
+	}
+
+	matched, err := jq.Query[bool](value, c.definition.JQ)
+	if err != nil {
+		return false, nil //nolint:nilerr // Missing field means no match.
+	}
+
+	return matched, nil
+}
+
+// objectName returns namespace/name for namespaced objects and name otherwise.
+func objectName(obj *unstructured.Unstructured) string {
+	if obj.GetNamespace() == "" {
+		return obj.GetName()
+	}
+
+	return obj.GetNamespace() + "/" + obj.GetName()
+}
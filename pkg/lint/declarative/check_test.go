@@ -0,0 +1,128 @@
+package declarative_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/declarative"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+//nolint:gochecknoglobals
+var listKinds = map[schema.GroupVersionResource]string{
+	resources.Notebook.GVR(): resources.Notebook.ListKind(),
+}
+
+func newNotebook(namespace string, name string, gpus int64, dashboard bool) *unstructured.Unstructured {
+	obj := resources.Notebook.Unstructured()
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+
+	if dashboard {
+		obj.SetLabels(map[string]string{"opendatahub.io/dashboard": "true"})
+	}
+
+	_ = unstructured.SetNestedField(obj.Object, gpus, "spec", "gpus")
+
+	return &obj
+}
+
+func TestCheck_Validate(t *testing.T) {
+	t.Run("should report the objects matching the expression", func(t *testing.T) {
+		g := NewWithT(t)
+
+		chk, err := declarative.Parse([]byte(notebookDefinition))
+		g.Expect(err).ToNot(HaveOccurred())
+
+		target := testutil.NewTarget(t, testutil.TargetConfig{
+			ListKinds: listKinds,
+			Objects: []*unstructured.Unstructured{
+				newNotebook("team-a", "training", 4, true),
+				newNotebook("team-a", "notebook", 1, true),
+				newNotebook("team-b", "eval", 2, true),
+				// Not selected by the label selector
+				newNotebook("team-c", "pipeline", 8, false),
+			},
+		})
+
+		result, err := chk.Validate(t.Context(), target)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		g.Expect(result.Annotations).To(HaveKeyWithValue(declarative.AnnotationDeclarative, "notebooks.gpu-limits"))
+		g.Expect(result.Status.Conditions).To(HaveExactElements(MatchFields(IgnoreExtras, Fields{
+			"Condition": MatchFields(IgnoreExtras, Fields{
+				"Type":    Equal("GPULimitsCompliant"),
+				"Status":  Equal(metav1.ConditionFalse),
+				"Reason":  Equal("TooManyGPUs"),
+				"Message": Equal("2 of 3 notebooks request more than one GPU: team-a/training, team-b/eval"),
+			}),
+			"Impact":      Equal(resultpkg.ImpactBlocking),
+			"Remediation": Equal("Reduce the GPU limits of the listed notebooks"),
+		})))
+		g.Expect(result.ImpactedObjects).To(HaveLen(2))
+		g.Expect(result.ImpactedObjects[0].Kind).To(Equal("Notebook"))
+		g.Expect(result.ImpactedObjects[0].Namespace).To(Equal("team-a"))
+		g.Expect(result.ImpactedObjects[0].Name).To(Equal("training"))
+	})
+
+	t.Run("should pass when no object matches", func(t *testing.T) {
+		g := NewWithT(t)
+
+		chk, err := declarative.Parse([]byte(notebookDefinition))
+		g.Expect(err).ToNot(HaveOccurred())
+
+		target := testutil.NewTarget(t, testutil.TargetConfig{
+			ListKinds: listKinds,
+			Objects:   []*unstructured.Unstructured{newNotebook("team-a", "notebook", 1, true)},
+		})
+
+		result, err := chk.Validate(t.Context(), target)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		g.Expect(result.Status.Conditions).To(HaveExactElements(MatchFields(IgnoreExtras, Fields{
+			"Condition": MatchFields(IgnoreExtras, Fields{
+				"Type":    Equal("GPULimitsCompliant"),
+				"Status":  Equal(metav1.ConditionTrue),
+				"Message": Equal("None of the 1 notebooks match the check"),
+			}),
+		})))
+		g.Expect(result.ImpactedObjects).To(BeEmpty())
+	})
+
+	t.Run("should default to an advisory impact and the default message", func(t *testing.T) {
+		g := NewWithT(t)
+
+		chk, err := declarative.Parse([]byte("id: gpu\ngroup: workload\n" +
+			"resource: {group: kubeflow.org, version: v1, resource: notebooks, kind: Notebook}\n" +
+			"namespace: team-b\njq: '.spec.gpus > 1'\ncondition: {type: GPULimitsCompliant}\n"))
+		g.Expect(err).ToNot(HaveOccurred())
+
+		target := testutil.NewTarget(t, testutil.TargetConfig{
+			ListKinds: listKinds,
+			Objects: []*unstructured.Unstructured{
+				newNotebook("team-a", "training", 4, true),
+				newNotebook("team-b", "eval", 2, false),
+			},
+		})
+
+		result, err := chk.Validate(t.Context(), target)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		g.Expect(result.Status.Conditions).To(HaveExactElements(MatchFields(IgnoreExtras, Fields{
+			"Condition": MatchFields(IgnoreExtras, Fields{
+				"Status":  Equal(metav1.ConditionFalse),
+				"Reason":  Equal("ConfigurationInvalid"),
+				"Message": Equal("Found 1 of 1 notebooks matching the check: team-b/eval"),
+			}),
+			"Impact": Equal(resultpkg.ImpactAdvisory),
+		})))
+	})
+}
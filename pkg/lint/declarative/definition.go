@@ -0,0 +1,245 @@
+// Package declarative loads lint checks defined in YAML files. A definition names a resource
+// type, an optional label selector and namespace, and a JQ expression evaluated against each
+// object: the objects for which it is true are impacted, and reported in one condition whose
+// message is rendered from a template. The checks are registered as custom.<id>.
+package declarative
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/itchyny/gojq"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/yaml"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+)
+
+const (
+	// IDPrefix namespaces the IDs of declarative checks.
+	IDPrefix = "custom."
+
+	// defaultMessage is the message template of definitions that declare none.
+	defaultMessage = "Found {{.Count}} of {{.Total}} {{.Resource}} matching the check: {{join .Objects \", \"}}"
+)
+
+// validID matches the IDs of definitions.
+var validID = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?(\.[a-z0-9]([a-z0-9-]*[a-z0-9])?)*$`)
+
+//nolint:gochecknoglobals
+var definitionGroups = []check.CheckGroup{
+	check.GroupComponent,
+	check.GroupService,
+	check.GroupWorkload,
+	check.GroupDependency,
+}
+
+// Definition is a check defined in YAML.
+//
+// Example:
+//
+//	id: notebooks.gpu-limits
+//	group: workload
+//	name: 'Workloads :: Notebook :: GPU limits'
+//	description: Notebooks must not request more than one GPU
+//	resource:
+//	  group: kubeflow.org
+//	  version: v1
+//	  resource: notebooks
+//	  kind: Notebook
+//	labelSelector: opendatahub.io/dashboard=true
+//	jq: '[.spec.template.spec.containers[].resources.limits["nvidia.com/gpu"] // "0" | tonumber] | add > 1'
+//	condition:
+//	  type: GPULimitsCompliant
+//	  reason: TooManyGPUs
+//	  message: '{{.Count}} notebooks request more than one GPU'
+//	  impact: advisory
+type Definition struct {
+	// ID identifies the check; it is registered as custom.<id>.
+	ID string `json:"id"`
+
+	// Group is one of component, service, workload or dependency.
+	Group string `json:"group"`
+
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Remediation string `json:"remediation,omitempty"`
+
+	// Resource is the resource type whose objects are evaluated.
+	Resource Resource `json:"resource"`
+
+	// LabelSelector and Namespace optionally restrict the evaluated objects.
+	LabelSelector string `json:"labelSelector,omitempty"`
+	Namespace     string `json:"namespace,omitempty"`
+
+	// JQ is evaluated against each object; objects for which it is true are impacted. Missing
+	// fields and non-boolean results do not match.
+	JQ string `json:"jq"`
+
+	Condition Condition `json:"condition"`
+}
+
+// Resource identifies the resource type of a definition.
+type Resource struct {
+	Group    string `json:"group,omitempty"`
+	Version  string `json:"version"`
+	Resource string `json:"resource"`
+	Kind     string `json:"kind"`
+}
+
+// Condition describes the condition reported by a definition.
+type Condition struct {
+	// Type is the condition type, e.g. GPULimitsCompliant.
+	Type string `json:"type"`
+
+	// Reason is the reason of the failing condition; defaults to ConfigurationInvalid.
+	Reason string `json:"reason,omitempty"`
+
+	// Message is a Go template of the failing condition message, executed with the
+	// Count of impacted objects, the Total of evaluated objects, the Resource name and the
+	// impacted Objects as namespace/name.
+	Message string `json:"message,omitempty"`
+
+	// Impact of the failing condition, advisory (default) or blocking.
+	Impact result.Impact `json:"impact,omitempty"`
+}
+
+// TemplateData is the data the message template of a definition is executed with.
+type TemplateData struct {
+	Count    int
+	Total    int
+	Resource string
+	Objects  []string
+}
+
+// LoadDir loads the definitions of the .yaml and .yml files of dir, in file name order, and
+// returns their checks.
+func LoadDir(dir string) ([]check.Check, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading checks directory: %w", err)
+	}
+
+	var checks []check.Check
+
+	for _, entry := range entries {
+		ext := filepath.Ext(entry.Name())
+		if entry.IsDir() || (ext != ".yaml" && ext != ".yml") {
+			continue
+		}
+
+		c, err := LoadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		checks = append(checks, c)
+	}
+
+	return checks, nil
+}
+
+// LoadFile loads the definition of file and returns its check.
+func LoadFile(file string) (*Check, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("reading check definition: %w", err)
+	}
+
+	c, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+
+	return c, nil
+}
+
+// Parse parses and validates a definition and returns its check.
+func Parse(data []byte) (*Check, error) {
+	var def Definition
+	if err := yaml.UnmarshalStrict(data, &def); err != nil {
+		return nil, fmt.Errorf("parsing check definition: %w", err)
+	}
+
+	if err := def.Validate(); err != nil {
+		return nil, err
+	}
+
+	return newCheck(def)
+}
+
+// Validate checks that the definition is complete and its expressions compile.
+func (d *Definition) Validate() error {
+	if !validID.MatchString(d.ID) {
+		return fmt.Errorf("invalid id %q: must be dot-separated lowercase alphanumerics and '-'", d.ID)
+	}
+
+	if !slices.Contains(definitionGroups, check.CheckGroup(d.Group)) {
+		return fmt.Errorf("invalid group %q (must be one of: component, service, workload, dependency)", d.Group)
+	}
+
+	if d.Resource.Version == "" || d.Resource.Resource == "" || d.Resource.Kind == "" {
+		return errors.New("resource requires version, resource and kind")
+	}
+
+	if d.LabelSelector != "" {
+		if _, err := labels.Parse(d.LabelSelector); err != nil {
+			return fmt.Errorf("invalid labelSelector: %w", err)
+		}
+	}
+
+	if d.JQ == "" {
+		return errors.New("jq is required")
+	}
+
+	if _, err := gojq.Parse(d.JQ); err != nil {
+		return fmt.Errorf("invalid jq expression: %w", err)
+	}
+
+	if d.Condition.Type == "" {
+		return errors.New("condition.type is required")
+	}
+
+	switch d.Condition.Impact {
+	case result.ImpactNone, result.ImpactAdvisory, result.ImpactBlocking:
+	default:
+		return fmt.Errorf("invalid condition.impact %q (must be advisory or blocking)", d.Condition.Impact)
+	}
+
+	if _, err := parseMessage(d.Condition.Message); err != nil {
+		return fmt.Errorf("invalid condition.message: %w", err)
+	}
+
+	return nil
+}
+
+// resourceType returns the resource type of the definition.
+func (d *Definition) resourceType() resources.ResourceType {
+	return resources.ResourceType{
+		Group:    d.Resource.Group,
+		Version:  d.Resource.Version,
+		Kind:     d.Resource.Kind,
+		Resource: d.Resource.Resource,
+	}
+}
+
+// parseMessage parses a message template, the default one when empty.
+func parseMessage(message string) (*template.Template, error) {
+	if message == "" {
+		message = defaultMessage
+	}
+
+	return template.New("message").
+		Funcs(template.FuncMap{"join": strings.Join}).
+		Option("missingkey=error").
+		Parse(message)
+}
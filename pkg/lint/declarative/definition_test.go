@@ -0,0 +1,102 @@
+package declarative_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/declarative"
+
+	. "github.com/onsi/gomega"
+)
+
+const notebookDefinition = `
+id: notebooks.gpu-limits
+group: workload
+name: 'Workloads :: Notebook :: GPU limits'
+description: Notebooks must not request more than one GPU
+remediation: Reduce the GPU limits of the listed notebooks
+resource:
+  group: kubeflow.org
+  version: v1
+  resource: notebooks
+  kind: Notebook
+labelSelector: opendatahub.io/dashboard=true
+jq: '.spec.gpus > 1'
+condition:
+  type: GPULimitsCompliant
+  reason: TooManyGPUs
+  message: '{{.Count}} of {{.Total}} notebooks request more than one GPU: {{join .Objects ", "}}'
+  impact: blocking
+`
+
+func TestParse(t *testing.T) {
+	g := NewWithT(t)
+
+	chk, err := declarative.Parse([]byte(notebookDefinition))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(chk.ID()).To(Equal("custom.notebooks.gpu-limits"))
+	g.Expect(chk.Name()).To(Equal("Workloads :: Notebook :: GPU limits"))
+	g.Expect(chk.Group()).To(Equal(check.GroupWorkload))
+	g.Expect(chk.CheckKind()).To(Equal("notebook"))
+	g.Expect(chk.Remediation()).To(Equal("Reduce the GPU limits of the listed notebooks"))
+	g.Expect(chk.RequiredResources()).To(HaveLen(1))
+	g.Expect(chk.RequiredResources()[0].GVR().Resource).To(Equal("notebooks"))
+}
+
+func TestParse_Errors(t *testing.T) {
+	valid := "id: gpu\ngroup: workload\nresource: {version: v1, resource: pods, kind: Pod}\n" +
+		"jq: '.spec.nodeName == null'\ncondition: {type: Scheduled}\n"
+
+	tests := []struct {
+		name       string
+		definition string
+		errMatch   string
+	}{
+		{name: "unknown field", definition: valid + "selector: app=x\n", errMatch: "unknown field"},
+		{name: "invalid id", definition: "id: GPU\n" + valid[len("id: gpu\n"):], errMatch: `invalid id "GPU"`},
+		{name: "invalid group", definition: "id: gpu\ngroup: health\n" + valid[len("id: gpu\ngroup: workload\n"):], errMatch: `invalid group "health"`},
+		{name: "invalid jq", definition: "id: gpu\ngroup: workload\nresource: {version: v1, resource: pods, kind: Pod}\njq: '.spec |'\ncondition: {type: Scheduled}\n", errMatch: "invalid jq expression"},
+		{name: "missing jq", definition: "id: gpu\ngroup: workload\nresource: {version: v1, resource: pods, kind: Pod}\ncondition: {type: Scheduled}\n", errMatch: "jq is required"},
+		{name: "missing resource kind", definition: "id: gpu\ngroup: workload\nresource: {version: v1, resource: pods}\njq: 'true'\ncondition: {type: Scheduled}\n", errMatch: "resource requires version, resource and kind"},
+		{name: "invalid label selector", definition: valid + "labelSelector: 'a in (b'\n", errMatch: "invalid labelSelector"},
+		{name: "missing condition type", definition: "id: gpu\ngroup: workload\nresource: {version: v1, resource: pods, kind: Pod}\njq: 'true'\n", errMatch: "condition.type is required"},
+		{name: "invalid impact", definition: "id: gpu\ngroup: workload\nresource: {version: v1, resource: pods, kind: Pod}\njq: 'true'\ncondition: {type: Scheduled, impact: critical}\n", errMatch: `invalid condition.impact "critical"`},
+		{name: "invalid message template", definition: "id: gpu\ngroup: workload\nresource: {version: v1, resource: pods, kind: Pod}\njq: 'true'\ncondition: {type: Scheduled, message: '{{.Count'}\n", errMatch: "invalid condition.message"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			_, err := declarative.Parse([]byte(tt.definition))
+			g.Expect(err).To(MatchError(ContainSubstring(tt.errMatch)))
+		})
+	}
+}
+
+func TestLoadDir(t *testing.T) {
+	g := NewWithT(t)
+
+	dir := t.TempDir()
+	pods := "id: pods.unscheduled\ngroup: workload\nresource: {version: v1, resource: pods, kind: Pod}\n" +
+		"jq: '.spec.nodeName == null'\ncondition: {type: Scheduled}\n"
+
+	g.Expect(os.WriteFile(filepath.Join(dir, "b-notebooks.yaml"), []byte(notebookDefinition), 0o600)).To(Succeed())
+	g.Expect(os.WriteFile(filepath.Join(dir, "a-pods.yml"), []byte(pods), 0o600)).To(Succeed())
+	g.Expect(os.WriteFile(filepath.Join(dir, "README.md"), []byte("not a check"), 0o600)).To(Succeed())
+
+	checks, err := declarative.LoadDir(dir)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(checks).To(HaveLen(2))
+	g.Expect(checks[0].ID()).To(Equal("custom.pods.unscheduled"))
+	g.Expect(checks[1].ID()).To(Equal("custom.notebooks.gpu-limits"))
+
+	// Invalid definitions name their file
+	g.Expect(os.WriteFile(filepath.Join(dir, "c-invalid.yaml"), []byte("id: x\n"), 0o600)).To(Succeed())
+
+	_, err = declarative.LoadDir(dir)
+	g.Expect(err).To(MatchError(ContainSubstring("c-invalid.yaml")))
+}
@@ -0,0 +1,366 @@
+package lint
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	printerjson "github.com/opendatahub-io/odh-cli/pkg/printer/json"
+	"github.com/opendatahub-io/odh-cli/pkg/printer/table"
+	printeryaml "github.com/opendatahub-io/odh-cli/pkg/printer/yaml"
+)
+
+// ChangeType is how the result of a check changed since a previous run.
+type ChangeType string
+
+const (
+	// ChangeNewFailure means the check fails now but passed, or was not reported, previously.
+	ChangeNewFailure ChangeType = "new-failure"
+
+	// ChangeResolved means the check failed previously and passes now, or is no longer reported.
+	ChangeResolved ChangeType = "resolved"
+
+	// ChangeChanged means the check fails in both runs with different conditions or impacted objects.
+	ChangeChanged ChangeType = "changed"
+)
+
+// ResultChange is a check whose status, conditions or impacted objects changed since a previous run.
+type ResultChange struct {
+	Change          ChangeType        `json:"change" yaml:"change"`
+	CheckID         string            `json:"checkID" yaml:"checkID"`
+	Message         string            `json:"message,omitempty" yaml:"message,omitempty"`
+	Conditions      []ConditionChange `json:"conditions,omitempty" yaml:"conditions,omitempty"`
+	NewObjects      []string          `json:"newObjects,omitempty" yaml:"newObjects,omitempty"`
+	ResolvedObjects []string          `json:"resolvedObjects,omitempty" yaml:"resolvedObjects,omitempty"`
+}
+
+// ConditionChange is a condition whose status, reason or impact changed. Previous or Current is
+// empty when the condition was not reported in that run.
+type ConditionChange struct {
+	Type     string `json:"type" yaml:"type"`
+	Previous string `json:"previous,omitempty" yaml:"previous,omitempty"`
+	Current  string `json:"current,omitempty" yaml:"current,omitempty"`
+}
+
+// resultState is the state of a check in one run. Lint mode repeats workload check results per
+// discovered instance, so the results of a check are merged: it fails if any result fails.
+type resultState struct {
+	failing    bool
+	message    string
+	conditions map[string]result.Condition
+	objects    map[string]bool
+}
+
+func (s *resultState) add(r *result.DiagnosticResult) {
+	if failing := r.IsFailing(); failing && !s.failing {
+		s.failing = true
+		s.message = r.GetMessage()
+	} else if s.message == "" {
+		s.message = r.GetMessage()
+	}
+
+	for _, cond := range r.Status.Conditions {
+		// A failing instance outweighs passing ones.
+		if prev, ok := s.conditions[cond.Type]; ok && prev.Status != metav1.ConditionTrue {
+			continue
+		}
+
+		s.conditions[cond.Type] = cond
+	}
+
+	for _, obj := range r.ImpactedObjects {
+		s.objects[impactedObjectLabel(obj)] = true
+	}
+}
+
+func collectResultStates(results []*result.DiagnosticResult) map[string]*resultState {
+	states := make(map[string]*resultState)
+
+	for _, r := range results {
+		if r == nil {
+			continue
+		}
+
+		key := resultKey(r.Group, r.Kind, r.Name)
+
+		state, ok := states[key]
+		if !ok {
+			state = &resultState{
+				conditions: make(map[string]result.Condition),
+				objects:    make(map[string]bool),
+			}
+			states[key] = state
+		}
+
+		state.add(r)
+	}
+
+	return states
+}
+
+// DiffResults returns the checks whose result changed between a previous run and the current
+// one: new failures, resolved failures, and failures whose conditions or impacted objects
+// changed. Checks passing in both runs, or reported in neither, are omitted.
+func DiffResults(previous *result.DiagnosticResultList, current []check.CheckExecution) []ResultChange {
+	checkIDs := make(map[string]string, len(current))
+	currentResults := make([]*result.DiagnosticResult, 0, len(current))
+
+	for _, exec := range current {
+		if exec.Result == nil {
+			continue
+		}
+
+		checkIDs[resultKey(exec.Result.Group, exec.Result.Kind, exec.Result.Name)] = exec.Check.ID()
+		currentResults = append(currentResults, exec.Result)
+	}
+
+	before := collectResultStates(previous.Results)
+	after := collectResultStates(currentResults)
+
+	keys := make(map[string]bool, len(before)+len(after))
+	for key := range before {
+		keys[key] = true
+	}
+
+	for key := range after {
+		keys[key] = true
+	}
+
+	var changes []ResultChange
+
+	for key := range keys {
+		prev, cur := before[key], after[key]
+
+		change := ResultChange{CheckID: key}
+		if id, ok := checkIDs[key]; ok {
+			change.CheckID = id
+		}
+
+		prevFailing := prev != nil && prev.failing
+		curFailing := cur != nil && cur.failing
+
+		switch {
+		case curFailing && !prevFailing:
+			change.Change = ChangeNewFailure
+		case prevFailing && !curFailing:
+			change.Change = ChangeResolved
+		case curFailing:
+			change.Change = ChangeChanged
+		default:
+			continue
+		}
+
+		change.Conditions = diffConditions(prev, cur)
+		change.NewObjects, change.ResolvedObjects = diffObjects(prev, cur)
+
+		if change.Change == ChangeChanged && len(change.Conditions) == 0 &&
+			len(change.NewObjects) == 0 && len(change.ResolvedObjects) == 0 {
+			continue
+		}
+
+		change.Message = "No longer reported: the check did not run or does not apply"
+		if cur != nil {
+			change.Message = cur.message
+		}
+
+		changes = append(changes, change)
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Change != changes[j].Change {
+			return changeOrder(changes[i].Change) < changeOrder(changes[j].Change)
+		}
+
+		return changes[i].CheckID < changes[j].CheckID
+	})
+
+	return changes
+}
+
+// changeOrder lists new failures first, then changed and resolved checks.
+func changeOrder(c ChangeType) int {
+	switch c {
+	case ChangeNewFailure:
+		return 0
+	case ChangeChanged:
+		return 1
+	default:
+		return 2
+	}
+}
+
+func diffConditions(prev *resultState, cur *resultState) []ConditionChange {
+	types := make(map[string]bool)
+
+	for _, state := range []*resultState{prev, cur} {
+		if state == nil {
+			continue
+		}
+
+		for t := range state.conditions {
+			types[t] = true
+		}
+	}
+
+	var changes []ConditionChange
+
+	for t := range types {
+		before, after := conditionState(prev, t), conditionState(cur, t)
+		if before != after {
+			changes = append(changes, ConditionChange{Type: t, Previous: before, Current: after})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Type < changes[j].Type
+	})
+
+	return changes
+}
+
+// conditionState summarizes a condition as "Status Reason (impact)", or "" when not reported.
+func conditionState(state *resultState, conditionType string) string {
+	if state == nil {
+		return ""
+	}
+
+	cond, ok := state.conditions[conditionType]
+	if !ok {
+		return ""
+	}
+
+	s := string(cond.Status) + " " + cond.Reason
+	if cond.Impact != result.ImpactNone {
+		s += " (" + string(cond.Impact) + ")"
+	}
+
+	return s
+}
+
+func diffObjects(prev *resultState, cur *resultState) ([]string, []string) {
+	var added, removed []string
+
+	if cur != nil {
+		for obj := range cur.objects {
+			if prev == nil || !prev.objects[obj] {
+				added = append(added, obj)
+			}
+		}
+	}
+
+	if prev != nil {
+		for obj := range prev.objects {
+			if cur == nil || !cur.objects[obj] {
+				removed = append(removed, obj)
+			}
+		}
+	}
+
+	sort.Strings(added)
+	sort.Strings(removed)
+
+	return added, removed
+}
+
+// diffRow is a single row of the diff table.
+type diffRow struct {
+	Change  string `mapstructure:"CHANGE"`
+	Check   string `mapstructure:"CHECK"`
+	Objects string `mapstructure:"OBJECTS"`
+	Message string `mapstructure:"MESSAGE"`
+}
+
+// OutputDiff renders the changes in the given format; tables list the added and removed
+// impacted objects below the table, followed by a summary.
+func OutputDiff(out io.Writer, format OutputFormat, changes []ResultChange) error {
+	switch format {
+	case OutputFormatJSON:
+		renderer := printerjson.NewRenderer[[]ResultChange](printerjson.WithWriter[[]ResultChange](out))
+		if err := renderer.Render(changes); err != nil {
+			return fmt.Errorf("rendering JSON diff: %w", err)
+		}
+
+		return nil
+	case OutputFormatYAML:
+		renderer := printeryaml.NewRenderer[[]ResultChange](printeryaml.WithWriter[[]ResultChange](out))
+		if err := renderer.Render(changes); err != nil {
+			return fmt.Errorf("rendering YAML diff: %w", err)
+		}
+
+		return nil
+	case OutputFormatTable:
+		return outputDiffTable(out, changes)
+	default:
+		return fmt.Errorf("unsupported output format: %s", format)
+	}
+}
+
+func outputDiffTable(out io.Writer, changes []ResultChange) error {
+	if len(changes) == 0 {
+		_, _ = fmt.Fprintln(out, "No check results changed since the previous run")
+
+		return nil
+	}
+
+	renderer := table.NewRenderer(
+		table.WithWriter[diffRow](out),
+		table.WithHeaders[diffRow]("CHANGE", "CHECK", "OBJECTS", "MESSAGE"),
+		table.WithTableOptions[diffRow](table.DefaultTableOptions...),
+	)
+
+	counts := make(map[ChangeType]int)
+
+	for _, c := range changes {
+		counts[c.Change]++
+
+		var objects []string
+		if len(c.NewObjects) > 0 {
+			objects = append(objects, fmt.Sprintf("+%d", len(c.NewObjects)))
+		}
+
+		if len(c.ResolvedObjects) > 0 {
+			objects = append(objects, fmt.Sprintf("-%d", len(c.ResolvedObjects)))
+		}
+
+		row := diffRow{
+			Change:  string(c.Change),
+			Check:   c.CheckID,
+			Objects: strings.Join(objects, " "),
+			Message: c.Message,
+		}
+
+		if err := renderer.Append(row); err != nil {
+			return fmt.Errorf("appending diff row: %w", err)
+		}
+	}
+
+	if err := renderer.Render(); err != nil {
+		return fmt.Errorf("rendering diff: %w", err)
+	}
+
+	for _, c := range changes {
+		if len(c.NewObjects) == 0 && len(c.ResolvedObjects) == 0 {
+			continue
+		}
+
+		_, _ = fmt.Fprintf(out, "\n%s:\n", c.CheckID)
+
+		for _, obj := range c.NewObjects {
+			_, _ = fmt.Fprintf(out, "  + %s\n", obj)
+		}
+
+		for _, obj := range c.ResolvedObjects {
+			_, _ = fmt.Fprintf(out, "  - %s\n", obj)
+		}
+	}
+
+	_, _ = fmt.Fprintf(out, "\nDiff: %d new failure(s), %d changed, %d resolved\n",
+		counts[ChangeNewFailure], counts[ChangeChanged], counts[ChangeResolved])
+
+	return nil
+}
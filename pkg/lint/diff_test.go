@@ -0,0 +1,106 @@
+package lint_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestDiffResults(t *testing.T) {
+	g := NewWithT(t)
+
+	notebooks := newRemediationCheck(check.GroupWorkload, "workloads.notebook.impacted", "notebook")
+	ray := newRemediationCheck(check.GroupWorkload, "workloads.ray.impacted", "ray")
+	kserve := newRemediationCheck(check.GroupWorkload, "workloads.kserve.impacted", "kserve")
+	pipelines := newRemediationCheck(check.GroupWorkload, "workloads.pipelines.impacted", "pipelines")
+	removed := newRemediationCheck(check.GroupWorkload, "workloads.removed.impacted", "removed")
+
+	previous := &result.DiagnosticResultList{Results: []*result.DiagnosticResult{
+		newRemediationResult(notebooks, "Compatible", "nb-1", "nb-2"),
+		newRemediationResult(ray, "Compatible", "cluster"),
+		newRemediationResult(kserve, "Compatible"),
+		newRemediationResult(pipelines, "Compatible", "dspa"),
+		newRemediationResult(removed, "Compatible", "obj"),
+	}}
+
+	current := []check.CheckExecution{
+		{Check: notebooks, Result: newRemediationResult(notebooks, "Compatible", "nb-2", "nb-3")},
+		{Check: ray, Result: newRemediationResult(ray, "Compatible")},
+		{Check: kserve, Result: newRemediationResult(kserve, "Compatible", "isvc")},
+		{Check: pipelines, Result: newRemediationResult(pipelines, "Compatible", "dspa")},
+	}
+
+	changes := lint.DiffResults(previous, current)
+
+	g.Expect(changes).To(Equal([]lint.ResultChange{
+		{
+			Change:     lint.ChangeNewFailure,
+			CheckID:    "workloads.kserve.impacted",
+			Message:    "Found impacted workloads",
+			NewObjects: []string{"Notebook team-a/isvc"},
+			Conditions: []lint.ConditionChange{{
+				Type:     "Compatible",
+				Previous: "True RequirementsMet",
+				Current:  "False WorkloadsImpacted (blocking)",
+			}},
+		},
+		{
+			Change:          lint.ChangeChanged,
+			CheckID:         "workloads.notebook.impacted",
+			Message:         "Found impacted workloads",
+			NewObjects:      []string{"Notebook team-a/nb-3"},
+			ResolvedObjects: []string{"Notebook team-a/nb-1"},
+		},
+		{
+			Change:          lint.ChangeResolved,
+			CheckID:         "workload/removed/impacted-workloads",
+			Message:         "No longer reported: the check did not run or does not apply",
+			ResolvedObjects: []string{"Notebook team-a/obj"},
+			Conditions: []lint.ConditionChange{{
+				Type:     "Compatible",
+				Previous: "False WorkloadsImpacted (blocking)",
+			}},
+		},
+		{
+			Change:          lint.ChangeResolved,
+			CheckID:         "workloads.ray.impacted",
+			ResolvedObjects: []string{"Notebook team-a/cluster"},
+			Conditions: []lint.ConditionChange{{
+				Type:     "Compatible",
+				Previous: "False WorkloadsImpacted (blocking)",
+				Current:  "True RequirementsMet",
+			}},
+		},
+	}))
+}
+
+func TestOutputDiff_Table(t *testing.T) {
+	g := NewWithT(t)
+
+	var out bytes.Buffer
+
+	g.Expect(lint.OutputDiff(&out, lint.OutputFormatTable, []lint.ResultChange{{
+		Change:          lint.ChangeChanged,
+		CheckID:         "workloads.notebook.impacted",
+		Message:         "Found impacted workloads",
+		NewObjects:      []string{"Notebook team-a/nb-3"},
+		ResolvedObjects: []string{"Notebook team-a/nb-1"},
+	}})).To(Succeed())
+
+	g.Expect(out.String()).To(And(
+		ContainSubstring("+1 -1"),
+		ContainSubstring("  + Notebook team-a/nb-3"),
+		ContainSubstring("  - Notebook team-a/nb-1"),
+		ContainSubstring("Diff: 0 new failure(s), 1 changed, 0 resolved"),
+	))
+
+	out.Reset()
+
+	g.Expect(lint.OutputDiff(&out, lint.OutputFormatTable, nil)).To(Succeed())
+	g.Expect(out.String()).To(ContainSubstring("No check results changed since the previous run"))
+}
@@ -0,0 +1,111 @@
+package lint
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+)
+
+// explainIndent indents section bodies of the text explanation.
+const explainIndent = "  "
+
+// CheckExplanation is the documentation of a single check rendered by 'lint explain'.
+type CheckExplanation struct {
+	ID             string   `json:"id"                       yaml:"id"`
+	Name           string   `json:"name"                     yaml:"name"`
+	Group          string   `json:"group"                    yaml:"group"`
+	Description    string   `json:"description"              yaml:"description"`
+	Remediation    string   `json:"remediation,omitempty"    yaml:"remediation,omitempty"`
+	VersionGate    string   `json:"versionGate,omitempty"    yaml:"versionGate,omitempty"`
+	Resources      []string `json:"resources,omitempty"      yaml:"resources,omitempty"`
+	KnowledgeLinks []string `json:"knowledgeLinks,omitempty" yaml:"knowledgeLinks,omitempty"`
+
+	check.Documentation `json:",inline" yaml:",inline"`
+}
+
+// ExplainCheck builds the explanation of chk from its metadata and documentation.
+func ExplainCheck(chk check.Check) *CheckExplanation {
+	explanation := &CheckExplanation{
+		ID:             chk.ID(),
+		Name:           chk.Name(),
+		Group:          string(chk.Group()),
+		Description:    chk.Description(),
+		Documentation:  chk.Documentation(),
+		KnowledgeLinks: check.KnowledgeLinks(chk),
+	}
+
+	if r, ok := chk.(interface{ Remediation() string }); ok {
+		explanation.Remediation = r.Remediation()
+	}
+
+	if describer, ok := chk.(check.GraphDescriber); ok {
+		explanation.VersionGate = describer.VersionGate()
+
+		for _, rt := range describer.RequiredResources() {
+			explanation.Resources = append(explanation.Resources, rt.Kind+" ("+rt.APIVersion()+")")
+		}
+	}
+
+	return explanation
+}
+
+// WriteText writes the explanation as sections for terminal reading. Empty sections are omitted.
+func (e *CheckExplanation) WriteText(w io.Writer) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%s\n%s\n\n", e.ID, e.Name)
+	fmt.Fprintf(&b, "Group:     %s\n", e.Group)
+
+	versionGate := e.VersionGate
+	if versionGate == "" {
+		versionGate = "any version"
+	}
+
+	fmt.Fprintf(&b, "Applies:   %s\n", versionGate)
+
+	if len(e.Resources) > 0 {
+		fmt.Fprintf(&b, "Resources: %s\n", strings.Join(e.Resources, ", "))
+	}
+
+	writeSection(&b, "DESCRIPTION", e.Description)
+	writeSection(&b, "WHAT IT INSPECTS", e.Inspects)
+	writeSection(&b, "WHY IT MATTERS", e.Rationale)
+	writeSection(&b, "FAILING EXAMPLE", e.FailingExample)
+	writeSection(&b, "PASSING EXAMPLE", e.PassingExample)
+
+	remediation := e.Remediation
+	for _, command := range e.RemediationCommands {
+		remediation += "\n\n$ " + command
+	}
+
+	writeSection(&b, "REMEDIATION", strings.TrimSpace(remediation))
+	writeSection(&b, "KNOWLEDGE BASE", strings.Join(e.KnowledgeLinks, "\n"))
+
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf("writing explanation: %w", err)
+	}
+
+	return nil
+}
+
+// writeSection writes a titled section with body indented, unless body is empty.
+func writeSection(b *strings.Builder, title string, body string) {
+	body = strings.Trim(body, "\n")
+	if body == "" {
+		return
+	}
+
+	fmt.Fprintf(b, "\n%s\n", title)
+
+	for line := range strings.SplitSeq(body, "\n") {
+		if line == "" {
+			b.WriteString("\n")
+
+			continue
+		}
+
+		b.WriteString(explainIndent + line + "\n")
+	}
+}
@@ -0,0 +1,111 @@
+package gitops
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const (
+	// pageSize is the number of items requested per page of list endpoints.
+	pageSize = 100
+
+	// maxPages bounds pagination, so a misbehaving server cannot loop forever.
+	maxPages = 50
+
+	// maxErrorBody bounds the response body quoted in errors.
+	maxErrorBody = 512
+)
+
+// apiClient performs authenticated JSON requests against a GitHub or GitLab REST API.
+type apiClient struct {
+	httpClient *http.Client
+	token      string
+	provider   ProviderType
+}
+
+// do sends in (if not nil) as the JSON body and decodes the response into out (if not nil).
+func (a *apiClient) do(ctx context.Context, method string, url string, in any, out any) error {
+	var body io.Reader
+
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("encoding request body: %w", err)
+		}
+
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return fmt.Errorf("creating request for %s: %w", url, err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	if a.token != "" {
+		switch a.provider {
+		case ProviderGitLab:
+			req.Header.Set("PRIVATE-TOKEN", a.token)
+		default:
+			req.Header.Set("Authorization", "Bearer "+a.token)
+		}
+	}
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s %s: %w", method, url, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		snippet, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+
+		return fmt.Errorf("%s %s: %s: %s", method, url, resp.Status, strings.TrimSpace(string(snippet)))
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decoding response of %s %s: %w", method, url, err)
+	}
+
+	return nil
+}
+
+// listPages fetches the pages of a list endpoint, passing each decoded page to add, until a
+// page has fewer than pageSize items.
+func listPages[T any](ctx context.Context, a *apiClient, url string, add func([]T)) error {
+	separator := "?"
+	if strings.Contains(url, "?") {
+		separator = "&"
+	}
+
+	for page := 1; page <= maxPages; page++ {
+		var items []T
+
+		pageURL := fmt.Sprintf("%s%sper_page=%d&page=%d", url, separator, pageSize, page)
+		if err := a.do(ctx, http.MethodGet, pageURL, nil, &items); err != nil {
+			return err
+		}
+
+		add(items)
+
+		if len(items) < pageSize {
+			return nil
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,189 @@
+package gitops
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/util/manifest"
+)
+
+// Marker identifies the lint comment of a pull request, so later runs update it in place.
+const Marker = "<!-- odh-lint-gitops-comment -->"
+
+// Finding is a failing check result impacting an object declared in a changed manifest.
+type Finding struct {
+	CheckID  string
+	Impact   string
+	Object   string
+	Manifest string
+	Message  string
+}
+
+// MatchFindings returns the findings of the failing results whose impacted objects are declared
+// in docs, matched by kind, namespace and name. A manifest without a namespace matches the
+// object in any namespace, as the namespace is often set at deploy time (kustomize, Argo CD).
+func MatchFindings(results []check.CheckExecution, docs []manifest.Document) []Finding {
+	var findings []Finding
+
+	seen := make(map[string]bool)
+
+	for _, exec := range results {
+		if exec.Result == nil || !exec.Result.IsFailing() {
+			continue
+		}
+
+		impact := ""
+		if i := exec.Result.GetImpact(); i != nil {
+			impact = *i
+		}
+
+		for _, obj := range exec.Result.ImpactedObjects {
+			doc, ok := declaring(docs, obj)
+			if !ok {
+				continue
+			}
+
+			object := obj.Kind + " " + obj.Name
+			if obj.Namespace != "" {
+				object = obj.Kind + " " + obj.Namespace + "/" + obj.Name
+			}
+
+			// Lint mode repeats workload check results per discovered instance.
+			key := exec.Check.ID() + "|" + object
+			if seen[key] {
+				continue
+			}
+
+			seen[key] = true
+
+			findings = append(findings, Finding{
+				CheckID:  exec.Check.ID(),
+				Impact:   impact,
+				Object:   object,
+				Manifest: doc.Source,
+				Message:  failingMessage(exec.Result),
+			})
+		}
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		if impactOrder(findings[i].Impact) != impactOrder(findings[j].Impact) {
+			return impactOrder(findings[i].Impact) < impactOrder(findings[j].Impact)
+		}
+
+		if findings[i].CheckID != findings[j].CheckID {
+			return findings[i].CheckID < findings[j].CheckID
+		}
+
+		return findings[i].Object < findings[j].Object
+	})
+
+	return findings
+}
+
+// declaring returns the document declaring obj.
+func declaring(docs []manifest.Document, obj metav1.PartialObjectMetadata) (manifest.Document, bool) {
+	for _, doc := range docs {
+		if doc.Object.GetKind() != obj.Kind || doc.Object.GetName() != obj.Name {
+			continue
+		}
+
+		if ns := doc.Object.GetNamespace(); ns != "" && ns != obj.Namespace {
+			continue
+		}
+
+		return doc, true
+	}
+
+	return manifest.Document{}, false
+}
+
+func failingMessage(r *result.DiagnosticResult) string {
+	for _, cond := range r.Status.Conditions {
+		if cond.Status != metav1.ConditionTrue {
+			return cond.Message
+		}
+	}
+
+	return r.GetMessage()
+}
+
+func impactOrder(impact string) int {
+	switch result.Impact(impact) {
+	case result.ImpactBlocking:
+		return 0
+	case result.ImpactAdvisory:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// CommentOptions describes the run a comment reports on.
+type CommentOptions struct {
+	// ClusterVersion is the OpenShift AI version of the assessed cluster.
+	ClusterVersion string
+
+	// TargetVersion is the upgrade target; empty for a lint of the current state.
+	TargetVersion string
+
+	// Objects is the number of objects declared in the changed manifests.
+	Objects int
+}
+
+// RenderComment renders the Markdown body of the pull request comment, starting with Marker.
+func RenderComment(findings []Finding, opts CommentOptions) string {
+	var b strings.Builder
+
+	b.WriteString(Marker + "\n")
+
+	if opts.TargetVersion != "" {
+		fmt.Fprintf(&b, "### OpenShift AI upgrade readiness: %s → %s\n\n", opts.ClusterVersion, opts.TargetVersion)
+	} else {
+		fmt.Fprintf(&b, "### OpenShift AI lint: %s\n\n", opts.ClusterVersion)
+	}
+
+	if len(findings) == 0 {
+		fmt.Fprintf(&b, "No findings for the %d object(s) declared in the manifests changed by this pull request.\n", opts.Objects)
+	} else {
+		blocking := 0
+
+		for _, f := range findings {
+			if result.Impact(f.Impact) == result.ImpactBlocking {
+				blocking++
+			}
+		}
+
+		fmt.Fprintf(&b, "%d finding(s), %d blocking, for the %d object(s) declared in the manifests changed by this pull request:\n\n",
+			len(findings), blocking, opts.Objects)
+
+		b.WriteString("| Impact | Check | Object | Manifest | Message |\n")
+		b.WriteString("|---|---|---|---|---|\n")
+
+		for _, f := range findings {
+			impact := f.Impact
+			if impact == "" {
+				impact = "-"
+			}
+
+			fmt.Fprintf(&b, "| %s | `%s` | %s | `%s` | %s |\n",
+				impact, f.CheckID, escapeCell(f.Object), f.Manifest, escapeCell(f.Message))
+		}
+	}
+
+	b.WriteString("\n<sub>Generated by <code>kubectl odh lint gitops-comment</code>; updated on every run.</sub>\n")
+
+	return b.String()
+}
+
+// escapeCell makes text safe for a Markdown table cell.
+func escapeCell(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,84 @@
+package gitops
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// githubFile is an item of the pull request files endpoint.
+type githubFile struct {
+	Filename string `json:"filename"`
+	Status   string `json:"status"`
+}
+
+// githubComment is an item of the issue comments endpoint.
+type githubComment struct {
+	ID      int64  `json:"id"`
+	Body    string `json:"body"`
+	HTMLURL string `json:"html_url"`
+}
+
+// gitHub implements Provider with the GitHub REST API.
+type gitHub struct {
+	pr  *PullRequest
+	api *apiClient
+}
+
+func (g *gitHub) repoURL() string {
+	return g.pr.APIBase + "/repos/" + g.pr.Project
+}
+
+func (g *gitHub) ChangedFiles(ctx context.Context) ([]string, error) {
+	var files []string
+
+	err := listPages(ctx, g.api, fmt.Sprintf("%s/pulls/%d/files", g.repoURL(), g.pr.Number), func(page []githubFile) {
+		for _, f := range page {
+			if f.Status != "removed" {
+				files = append(files, f.Filename)
+			}
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing pull request files: %w", err)
+	}
+
+	return files, nil
+}
+
+func (g *gitHub) UpsertComment(ctx context.Context, body string) (string, bool, error) {
+	var existing *githubComment
+
+	// Pull request comments are issue comments on GitHub.
+	err := listPages(ctx, g.api, fmt.Sprintf("%s/issues/%d/comments", g.repoURL(), g.pr.Number), func(page []githubComment) {
+		for i := range page {
+			if existing == nil && strings.Contains(page[i].Body, Marker) {
+				existing = &page[i]
+			}
+		}
+	})
+	if err != nil {
+		return "", false, fmt.Errorf("listing pull request comments: %w", err)
+	}
+
+	var comment githubComment
+
+	payload := map[string]string{"body": body}
+
+	if existing != nil {
+		url := fmt.Sprintf("%s/issues/comments/%d", g.repoURL(), existing.ID)
+		if err := g.api.do(ctx, http.MethodPatch, url, payload, &comment); err != nil {
+			return "", false, fmt.Errorf("updating comment: %w", err)
+		}
+
+		return comment.HTMLURL, false, nil
+	}
+
+	url := fmt.Sprintf("%s/issues/%d/comments", g.repoURL(), g.pr.Number)
+	if err := g.api.do(ctx, http.MethodPost, url, payload, &comment); err != nil {
+		return "", false, fmt.Errorf("posting comment: %w", err)
+	}
+
+	return comment.HTMLURL, true, nil
+}
@@ -0,0 +1,88 @@
+package gitops
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// gitlabDiff is an item of the merge request diffs endpoint.
+type gitlabDiff struct {
+	NewPath     string `json:"new_path"`
+	DeletedFile bool   `json:"deleted_file"`
+}
+
+// gitlabNote is an item of the merge request notes endpoint.
+type gitlabNote struct {
+	ID   int64  `json:"id"`
+	Body string `json:"body"`
+}
+
+// gitLab implements Provider with the GitLab REST API.
+type gitLab struct {
+	pr  *PullRequest
+	api *apiClient
+}
+
+func (g *gitLab) mergeRequestURL() string {
+	// The project path is passed URL-encoded as the project ID.
+	return fmt.Sprintf("%s/projects/%s/merge_requests/%d", g.pr.APIBase, url.PathEscape(g.pr.Project), g.pr.Number)
+}
+
+func (g *gitLab) ChangedFiles(ctx context.Context) ([]string, error) {
+	var files []string
+
+	err := listPages(ctx, g.api, g.mergeRequestURL()+"/diffs", func(page []gitlabDiff) {
+		for _, d := range page {
+			if !d.DeletedFile {
+				files = append(files, d.NewPath)
+			}
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing merge request changes: %w", err)
+	}
+
+	return files, nil
+}
+
+func (g *gitLab) UpsertComment(ctx context.Context, body string) (string, bool, error) {
+	var existing *gitlabNote
+
+	err := listPages(ctx, g.api, g.mergeRequestURL()+"/notes", func(page []gitlabNote) {
+		for i := range page {
+			if existing == nil && strings.Contains(page[i].Body, Marker) {
+				existing = &page[i]
+			}
+		}
+	})
+	if err != nil {
+		return "", false, fmt.Errorf("listing merge request notes: %w", err)
+	}
+
+	var note gitlabNote
+
+	payload := map[string]string{"body": body}
+
+	if existing != nil {
+		noteURL := fmt.Sprintf("%s/notes/%d", g.mergeRequestURL(), existing.ID)
+		if err := g.api.do(ctx, http.MethodPut, noteURL, payload, &note); err != nil {
+			return "", false, fmt.Errorf("updating note: %w", err)
+		}
+
+		return g.noteURL(note.ID), false, nil
+	}
+
+	if err := g.api.do(ctx, http.MethodPost, g.mergeRequestURL()+"/notes", payload, &note); err != nil {
+		return "", false, fmt.Errorf("posting note: %w", err)
+	}
+
+	return g.noteURL(note.ID), true, nil
+}
+
+// noteURL returns the web URL of a note, as the API does not return it.
+func (g *gitLab) noteURL(id int64) string {
+	return fmt.Sprintf("%s#note_%d", strings.TrimSuffix(g.pr.URL, "/"), id)
+}
@@ -0,0 +1,364 @@
+package gitops_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/gitops"
+	"github.com/opendatahub-io/odh-cli/pkg/util/manifest"
+
+	. "github.com/onsi/gomega"
+)
+
+type testCheck struct {
+	check.BaseCheck
+}
+
+func (c *testCheck) CanApply(_ context.Context, _ check.Target) (bool, error) {
+	return true, nil
+}
+
+func (c *testCheck) Validate(_ context.Context, _ check.Target) (*result.DiagnosticResult, error) {
+	return c.NewResult(), nil
+}
+
+func newExecution(id string, impact result.Impact, objects ...metav1.PartialObjectMetadata) check.CheckExecution {
+	chk := &testCheck{BaseCheck: check.BaseCheck{
+		CheckGroup: check.GroupWorkload,
+		CheckID:    id,
+		Kind:       "notebook",
+		Type:       "impacted-workloads",
+	}}
+
+	dr := chk.NewResult()
+	if len(objects) == 0 {
+		dr.SetCondition(check.NewCondition("Compatible", metav1.ConditionTrue, check.WithReason(check.ReasonRequirementsMet)))
+	} else {
+		dr.SetCondition(check.NewCondition("Compatible", metav1.ConditionFalse,
+			check.WithReason(check.ReasonWorkloadsImpacted),
+			check.WithMessage("Found impacted | workloads"),
+			check.WithImpact(impact)))
+		dr.ImpactedObjects = objects
+	}
+
+	return check.CheckExecution{Check: chk, Result: dr}
+}
+
+func object(kind string, namespace string, name string) metav1.PartialObjectMetadata {
+	return metav1.PartialObjectMetadata{
+		TypeMeta:   metav1.TypeMeta{Kind: kind},
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+	}
+}
+
+func decode(t *testing.T, source string, data string) []manifest.Document {
+	t.Helper()
+
+	docs, err := manifest.Decode(strings.NewReader(data), source)
+	NewWithT(t).Expect(err).ToNot(HaveOccurred())
+
+	return docs
+}
+
+func TestParsePullRequestURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		url      string
+		expected *gitops.PullRequest
+	}{
+		{
+			name: "github.com",
+			url:  "https://github.com/org/repo/pull/42",
+			expected: &gitops.PullRequest{
+				Provider: gitops.ProviderGitHub,
+				URL:      "https://github.com/org/repo/pull/42",
+				APIBase:  "https://api.github.com",
+				Project:  "org/repo",
+				Number:   42,
+			},
+		},
+		{
+			name: "github enterprise with files tab",
+			url:  "https://git.example.com/org/repo/pull/7/files",
+			expected: &gitops.PullRequest{
+				Provider: gitops.ProviderGitHub,
+				URL:      "https://git.example.com/org/repo/pull/7/files",
+				APIBase:  "https://git.example.com/api/v3",
+				Project:  "org/repo",
+				Number:   7,
+			},
+		},
+		{
+			name: "gitlab subgroup",
+			url:  "https://gitlab.com/group/sub/project/-/merge_requests/3",
+			expected: &gitops.PullRequest{
+				Provider: gitops.ProviderGitLab,
+				URL:      "https://gitlab.com/group/sub/project/-/merge_requests/3",
+				APIBase:  "https://gitlab.com/api/v4",
+				Project:  "group/sub/project",
+				Number:   3,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			pr, err := gitops.ParsePullRequestURL(tt.url)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(pr).To(Equal(tt.expected))
+		})
+	}
+
+	for _, invalid := range []string{
+		"github.com/org/repo/pull/1",
+		"https://github.com/org/repo/issues/1",
+		"https://github.com/org/repo/pull/abc",
+		"https://gitlab.com/group/project/-/merge_requests/0",
+	} {
+		t.Run("invalid "+invalid, func(t *testing.T) {
+			_, err := gitops.ParsePullRequestURL(invalid)
+			NewWithT(t).Expect(err).To(HaveOccurred())
+		})
+	}
+}
+
+// fakeAPI serves canned list responses and records the comment writes.
+type fakeAPI struct {
+	mu       sync.Mutex
+	lists    map[string]any
+	writes   []string
+	authName string
+	auth     string
+}
+
+func (f *fakeAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.auth = r.Header.Get(f.authName)
+
+	if r.Method == http.MethodGet {
+		body, ok := f.lists[r.URL.Path]
+		if !ok || r.URL.Query().Get("page") != "1" {
+			body = []any{}
+		}
+
+		_ = json.NewEncoder(w).Encode(body)
+
+		return
+	}
+
+	var payload map[string]string
+	_ = json.NewDecoder(r.Body).Decode(&payload)
+
+	f.writes = append(f.writes, r.Method+" "+r.URL.Path)
+
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"id":       99,
+		"body":     payload["body"],
+		"html_url": "https://example.com/comment/99",
+	})
+}
+
+func TestGitHubProvider(t *testing.T) {
+	g := NewWithT(t)
+
+	api := &fakeAPI{
+		authName: "Authorization",
+		lists: map[string]any{
+			"/api/v3/repos/org/repo/pulls/1/files": []map[string]string{
+				{"filename": "apps/notebook.yaml", "status": "modified"},
+				{"filename": "apps/old.yaml", "status": "removed"},
+				{"filename": "README.md", "status": "added"},
+			},
+		},
+	}
+
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+
+	pr, err := gitops.ParsePullRequestURL(server.URL + "/org/repo/pull/1")
+	g.Expect(err).ToNot(HaveOccurred())
+
+	provider, err := gitops.NewProvider(pr, "secret", server.Client())
+	g.Expect(err).ToNot(HaveOccurred())
+
+	files, err := provider.ChangedFiles(t.Context())
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(files).To(Equal([]string{"apps/notebook.yaml", "README.md"}))
+	g.Expect(api.auth).To(Equal("Bearer secret"))
+
+	commentURL, created, err := provider.UpsertComment(t.Context(), gitops.Marker+"\nfirst")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(created).To(BeTrue())
+	g.Expect(commentURL).To(Equal("https://example.com/comment/99"))
+
+	api.lists["/api/v3/repos/org/repo/issues/1/comments"] = []map[string]any{
+		{"id": 5, "body": "LGTM"},
+		{"id": 7, "body": gitops.Marker + "\nfirst"},
+	}
+
+	_, created, err = provider.UpsertComment(t.Context(), gitops.Marker+"\nsecond")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(created).To(BeFalse())
+
+	g.Expect(api.writes).To(Equal([]string{
+		"POST /api/v3/repos/org/repo/issues/1/comments",
+		"PATCH /api/v3/repos/org/repo/issues/comments/7",
+	}))
+}
+
+func TestGitLabProvider(t *testing.T) {
+	g := NewWithT(t)
+
+	api := &fakeAPI{
+		authName: "PRIVATE-TOKEN",
+		lists: map[string]any{
+			"/api/v4/projects/group/project/merge_requests/3/diffs": []map[string]any{
+				{"new_path": "apps/notebook.yaml"},
+				{"new_path": "apps/old.yaml", "deleted_file": true},
+			},
+			"/api/v4/projects/group/project/merge_requests/3/notes": []map[string]any{
+				{"id": 11, "body": gitops.Marker},
+			},
+		},
+	}
+
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+
+	pr, err := gitops.ParsePullRequestURL(server.URL + "/group/project/-/merge_requests/3")
+	g.Expect(err).ToNot(HaveOccurred())
+
+	provider, err := gitops.NewProvider(pr, "secret", server.Client())
+	g.Expect(err).ToNot(HaveOccurred())
+
+	files, err := provider.ChangedFiles(t.Context())
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(files).To(Equal([]string{"apps/notebook.yaml"}))
+	g.Expect(api.auth).To(Equal("secret"))
+
+	noteURL, created, err := provider.UpsertComment(t.Context(), gitops.Marker+"\nupdated")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(created).To(BeFalse())
+	g.Expect(noteURL).To(Equal(server.URL + "/group/project/-/merge_requests/3#note_99"))
+	g.Expect(api.writes).To(Equal([]string{"PUT /api/v4/projects/group/project/merge_requests/3/notes/11"}))
+}
+
+func TestProviderAPIError(t *testing.T) {
+	g := NewWithT(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, `{"message":"Bad credentials"}`, http.StatusUnauthorized)
+	}))
+	t.Cleanup(server.Close)
+
+	pr, err := gitops.ParsePullRequestURL(server.URL + "/org/repo/pull/1")
+	g.Expect(err).ToNot(HaveOccurred())
+
+	provider, err := gitops.NewProvider(pr, "", server.Client())
+	g.Expect(err).ToNot(HaveOccurred())
+
+	_, err = provider.ChangedFiles(t.Context())
+	g.Expect(err).To(MatchError(ContainSubstring("401 Unauthorized")))
+	g.Expect(err).To(MatchError(ContainSubstring("Bad credentials")))
+}
+
+func TestMatchFindings(t *testing.T) {
+	g := NewWithT(t)
+
+	docs := decode(t, "apps/notebooks.yaml", `
+apiVersion: kubeflow.org/v1
+kind: Notebook
+metadata:
+  name: nb-1
+  namespace: team-a
+---
+apiVersion: ray.io/v1
+kind: RayCluster
+metadata:
+  name: ray
+`)
+
+	results := []check.CheckExecution{
+		newExecution("workloads.notebook.advisory", result.ImpactAdvisory, object("Notebook", "team-a", "nb-1")),
+		newExecution("workloads.notebook.impacted", result.ImpactBlocking,
+			object("Notebook", "team-a", "nb-1"),
+			object("Notebook", "team-b", "nb-1"),
+			object("Notebook", "team-a", "nb-2")),
+		// Repeated per workload instance in lint mode.
+		newExecution("workloads.notebook.impacted", result.ImpactBlocking, object("Notebook", "team-a", "nb-1")),
+		newExecution("workloads.ray.impacted", result.ImpactBlocking, object("RayCluster", "team-c", "ray")),
+		newExecution("workloads.passing", ""),
+	}
+
+	findings := gitops.MatchFindings(results, docs)
+
+	g.Expect(findings).To(Equal([]gitops.Finding{
+		{
+			CheckID:  "workloads.notebook.impacted",
+			Impact:   "blocking",
+			Object:   "Notebook team-a/nb-1",
+			Manifest: "apps/notebooks.yaml",
+			Message:  "Found impacted | workloads",
+		},
+		{
+			CheckID:  "workloads.ray.impacted",
+			Impact:   "blocking",
+			Object:   "RayCluster team-c/ray",
+			Manifest: "apps/notebooks.yaml",
+			Message:  "Found impacted | workloads",
+		},
+		{
+			CheckID:  "workloads.notebook.advisory",
+			Impact:   "advisory",
+			Object:   "Notebook team-a/nb-1",
+			Manifest: "apps/notebooks.yaml",
+			Message:  "Found impacted | workloads",
+		},
+	}))
+}
+
+func TestRenderComment(t *testing.T) {
+	g := NewWithT(t)
+
+	body := gitops.RenderComment([]gitops.Finding{
+		{
+			CheckID:  "workloads.notebook.impacted",
+			Impact:   "blocking",
+			Object:   "Notebook team-a/nb-1",
+			Manifest: "apps/notebooks.yaml",
+			Message:  "Found impacted | workloads",
+		},
+		{
+			CheckID:  "workloads.notebook.advisory",
+			Impact:   "advisory",
+			Object:   "Notebook team-a/nb-1",
+			Manifest: "apps/notebooks.yaml",
+			Message:  "Review\nsettings",
+		},
+	}, gitops.CommentOptions{ClusterVersion: "2.25.0", TargetVersion: "3.0.0", Objects: 2})
+
+	g.Expect(body).To(HavePrefix(gitops.Marker + "\n"))
+	g.Expect(body).To(ContainSubstring("### OpenShift AI upgrade readiness: 2.25.0 → 3.0.0"))
+	g.Expect(body).To(ContainSubstring("2 finding(s), 1 blocking, for the 2 object(s)"))
+	g.Expect(body).To(ContainSubstring(
+		"| blocking | `workloads.notebook.impacted` | Notebook team-a/nb-1 | `apps/notebooks.yaml` | Found impacted \\| workloads |\n"))
+	g.Expect(body).To(ContainSubstring("| Review settings |\n"))
+
+	empty := gitops.RenderComment(nil, gitops.CommentOptions{ClusterVersion: "2.25.0", Objects: 3})
+	g.Expect(empty).To(ContainSubstring("### OpenShift AI lint: 2.25.0"))
+	g.Expect(empty).To(ContainSubstring(fmt.Sprintf("No findings for the %d object(s)", 3)))
+}
@@ -0,0 +1,161 @@
+// Package gitops posts lint findings relevant to the manifests changed by a pull request
+// (GitHub) or merge request (GitLab) as a review comment.
+package gitops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	// EnvToken is the API token used for any provider; it takes precedence over the
+	// provider-specific variables.
+	EnvToken = "ODH_GITOPS_TOKEN"
+
+	// EnvGitHubToken is the GitHub API token, as set by GitHub Actions.
+	EnvGitHubToken = "GITHUB_TOKEN"
+
+	// EnvGitLabToken is the GitLab API token.
+	EnvGitLabToken = "GITLAB_TOKEN"
+
+	githubHost = "github.com"
+	githubAPI  = "https://api.github.com"
+)
+
+// ProviderType is the code hosting service of a pull request.
+type ProviderType string
+
+const (
+	ProviderGitHub ProviderType = "github"
+	ProviderGitLab ProviderType = "gitlab"
+)
+
+// PullRequest identifies a GitHub pull request or GitLab merge request.
+type PullRequest struct {
+	// Provider is the code hosting service.
+	Provider ProviderType
+
+	// URL is the web URL the pull request was parsed from.
+	URL string
+
+	// APIBase is the REST API root of the service, e.g. https://api.github.com.
+	APIBase string
+
+	// Project is owner/repo on GitHub, or the full project path on GitLab.
+	Project string
+
+	// Number is the pull request number, or the merge request IID.
+	Number int
+}
+
+// ParsePullRequestURL parses a GitHub pull request URL (https://github.com/owner/repo/pull/1)
+// or GitLab merge request URL (https://gitlab.com/group/project/-/merge_requests/1). Other
+// hosts are treated as GitHub Enterprise (/api/v3) or self-managed GitLab (/api/v4).
+func ParsePullRequestURL(raw string) (*PullRequest, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pull request URL %q: %w", raw, err)
+	}
+
+	if u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("invalid pull request URL %q: expected an absolute URL", raw)
+	}
+
+	path := strings.Trim(u.Path, "/")
+	root := u.Scheme + "://" + u.Host
+
+	// GitLab: <project path>/-/merge_requests/<iid>
+	if project, rest, ok := strings.Cut(path, "/-/merge_requests/"); ok {
+		number, err := parseNumber(rest)
+		if err != nil {
+			return nil, fmt.Errorf("invalid merge request URL %q: %w", raw, err)
+		}
+
+		return &PullRequest{
+			Provider: ProviderGitLab,
+			URL:      raw,
+			APIBase:  root + "/api/v4",
+			Project:  project,
+			Number:   number,
+		}, nil
+	}
+
+	// GitHub: <owner>/<repo>/pull/<number>
+	parts := strings.Split(path, "/")
+	if len(parts) >= 4 && parts[2] == "pull" {
+		number, err := parseNumber(parts[3])
+		if err != nil {
+			return nil, fmt.Errorf("invalid pull request URL %q: %w", raw, err)
+		}
+
+		apiBase := root + "/api/v3"
+		if u.Host == githubHost {
+			apiBase = githubAPI
+		}
+
+		return &PullRequest{
+			Provider: ProviderGitHub,
+			URL:      raw,
+			APIBase:  apiBase,
+			Project:  parts[0] + "/" + parts[1],
+			Number:   number,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported pull request URL %q: expected .../<owner>/<repo>/pull/<number> or .../<project>/-/merge_requests/<iid>", raw)
+}
+
+func parseNumber(s string) (int, error) {
+	s, _, _ = strings.Cut(s, "/")
+
+	number, err := strconv.Atoi(s)
+	if err != nil || number <= 0 {
+		return 0, fmt.Errorf("invalid number %q", s)
+	}
+
+	return number, nil
+}
+
+// Token returns the API token for the provider from the environment, or an empty string.
+func Token(provider ProviderType) string {
+	if token := os.Getenv(EnvToken); token != "" {
+		return token
+	}
+
+	if provider == ProviderGitLab {
+		return os.Getenv(EnvGitLabToken)
+	}
+
+	return os.Getenv(EnvGitHubToken)
+}
+
+// Provider reads the changed files of a pull request and maintains its lint comment.
+type Provider interface {
+	// ChangedFiles returns the repository paths of the files added or modified by the pull
+	// request; deleted files are omitted.
+	ChangedFiles(ctx context.Context) ([]string, error)
+
+	// UpsertComment updates the comment containing Marker, or posts a new one, and returns
+	// its web URL and whether it was created.
+	UpsertComment(ctx context.Context, body string) (string, bool, error)
+}
+
+// NewProvider returns the Provider of the pull request, authenticated with token.
+func NewProvider(pr *PullRequest, token string, httpClient *http.Client) (Provider, error) {
+	api := &apiClient{httpClient: httpClient, token: token, provider: pr.Provider}
+
+	switch pr.Provider {
+	case ProviderGitHub:
+		return &gitHub{pr: pr, api: api}, nil
+	case ProviderGitLab:
+		return &gitLab{pr: pr, api: api}, nil
+	default:
+		return nil, errors.New("unsupported provider " + string(pr.Provider))
+	}
+}
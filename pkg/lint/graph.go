@@ -0,0 +1,143 @@
+package lint
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+)
+
+// GraphNodeKind classifies the nodes of the check graph.
+type GraphNodeKind string
+
+const (
+	GraphNodeGroup       GraphNodeKind = "group"
+	GraphNodeCheck       GraphNodeKind = "check"
+	GraphNodeResource    GraphNodeKind = "resource"
+	GraphNodeVersionGate GraphNodeKind = "version-gate"
+)
+
+// GraphNode is a single node of the check graph.
+type GraphNode struct {
+	ID    string        `json:"id"`
+	Kind  GraphNodeKind `json:"kind"`
+	Label string        `json:"label"`
+}
+
+// GraphEdge connects two nodes of the check graph by ID.
+type GraphEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// CheckGraph describes the check registry as a graph:
+// groups → checks → required resources and version gates.
+type CheckGraph struct {
+	Nodes []GraphNode `json:"nodes"`
+	Edges []GraphEdge `json:"edges"`
+}
+
+// BuildCheckGraph builds the dependency/applicability graph of the given checks.
+// Groups follow check.CanonicalGroupOrder and checks are sorted by ID so the output is stable.
+// Checks that do not implement check.GraphDescriber contribute only their group edge.
+func BuildCheckGraph(checks []check.Check) *CheckGraph {
+	graph := &CheckGraph{
+		Nodes: []GraphNode{},
+		Edges: []GraphEdge{},
+	}
+	seen := make(map[string]bool)
+
+	addNode := func(node GraphNode) {
+		if seen[node.ID] {
+			return
+		}
+
+		seen[node.ID] = true
+		graph.Nodes = append(graph.Nodes, node)
+	}
+
+	sorted := slices.Clone(checks)
+	slices.SortFunc(sorted, func(a, b check.Check) int {
+		if d := groupIndex(a.Group()) - groupIndex(b.Group()); d != 0 {
+			return d
+		}
+
+		return strings.Compare(a.ID(), b.ID())
+	})
+
+	for _, chk := range sorted {
+		groupID := "group:" + string(chk.Group())
+		checkID := "check:" + chk.ID()
+
+		addNode(GraphNode{ID: groupID, Kind: GraphNodeGroup, Label: string(chk.Group())})
+		addNode(GraphNode{ID: checkID, Kind: GraphNodeCheck, Label: chk.ID()})
+		graph.Edges = append(graph.Edges, GraphEdge{From: groupID, To: checkID})
+
+		describer, ok := chk.(check.GraphDescriber)
+		if !ok {
+			continue
+		}
+
+		for _, rt := range describer.RequiredResources() {
+			resourceID := "resource:" + rt.APIVersion() + "/" + rt.Kind
+
+			addNode(GraphNode{ID: resourceID, Kind: GraphNodeResource, Label: rt.Kind + " (" + rt.APIVersion() + ")"})
+			graph.Edges = append(graph.Edges, GraphEdge{From: checkID, To: resourceID})
+		}
+
+		if gate := describer.VersionGate(); gate != "" {
+			gateID := "gate:" + gate
+
+			addNode(GraphNode{ID: gateID, Kind: GraphNodeVersionGate, Label: gate})
+			graph.Edges = append(graph.Edges, GraphEdge{From: checkID, To: gateID})
+		}
+	}
+
+	return graph
+}
+
+// groupIndex returns the position of a group in check.CanonicalGroupOrder.
+// Unknown groups sort last.
+func groupIndex(group check.CheckGroup) int {
+	if idx := slices.Index(check.CanonicalGroupOrder, group); idx != -1 {
+		return idx
+	}
+
+	return len(check.CanonicalGroupOrder)
+}
+
+// graphNodeShapes maps each node kind to its Graphviz shape.
+//
+//nolint:gochecknoglobals
+var graphNodeShapes = map[GraphNodeKind]string{
+	GraphNodeGroup:       "folder",
+	GraphNodeCheck:       "box",
+	GraphNodeResource:    "ellipse",
+	GraphNodeVersionGate: "diamond",
+}
+
+// WriteDOT renders the graph in Graphviz DOT format.
+func (g *CheckGraph) WriteDOT(out io.Writer) error {
+	var b strings.Builder
+
+	b.WriteString("digraph lint {\n")
+	b.WriteString("  rankdir=LR;\n")
+
+	for _, node := range g.Nodes {
+		fmt.Fprintf(&b, "  %q [label=%q, shape=%s];\n", node.ID, node.Label, graphNodeShapes[node.Kind])
+	}
+
+	for _, edge := range g.Edges {
+		fmt.Fprintf(&b, "  %q -> %q;\n", edge.From, edge.To)
+	}
+
+	b.WriteString("}\n")
+
+	if _, err := io.WriteString(out, b.String()); err != nil {
+		return fmt.Errorf("writing DOT output: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package lint_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+)
+
+type graphTestCheck struct {
+	check.BaseCheck
+}
+
+func (c *graphTestCheck) CanApply(_ context.Context, _ check.Target) (bool, error) {
+	return true, nil
+}
+
+func (c *graphTestCheck) Validate(_ context.Context, _ check.Target) (*result.DiagnosticResult, error) {
+	return c.NewResult(), nil
+}
+
+func newGraphTestCheck(group check.CheckGroup, id string, gate string, rts ...resources.ResourceType) check.Check {
+	return &graphTestCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       group,
+			CheckID:          id,
+			CheckResources:   rts,
+			CheckVersionGate: gate,
+		},
+	}
+}
+
+func TestBuildCheckGraph(t *testing.T) {
+	g := NewWithT(t)
+
+	graph := lint.BuildCheckGraph([]check.Check{
+		newGraphTestCheck(check.GroupWorkload, "workloads.b", check.VersionGateUpgrade2xTo3x, resources.Notebook),
+		newGraphTestCheck(check.GroupWorkload, "workloads.a", check.VersionGateUpgrade2xTo3x, resources.Notebook, resources.DataScienceCluster),
+		newGraphTestCheck(check.GroupDependency, "dependencies.a", ""),
+	})
+
+	ids := make([]string, 0, len(graph.Nodes))
+	for _, node := range graph.Nodes {
+		ids = append(ids, node.ID)
+	}
+
+	// Groups follow the canonical order, checks are sorted, shared nodes are emitted once.
+	g.Expect(ids).To(Equal([]string{
+		"group:dependency",
+		"check:dependencies.a",
+		"group:workload",
+		"check:workloads.a",
+		"resource:kubeflow.org/v1/Notebook",
+		"resource:datasciencecluster.opendatahub.io/v1/DataScienceCluster",
+		"gate:" + check.VersionGateUpgrade2xTo3x,
+		"check:workloads.b",
+	}))
+
+	g.Expect(graph.Edges).To(ContainElements(
+		lint.GraphEdge{From: "group:workload", To: "check:workloads.b"},
+		lint.GraphEdge{From: "check:workloads.b", To: "resource:kubeflow.org/v1/Notebook"},
+		lint.GraphEdge{From: "check:workloads.b", To: "gate:" + check.VersionGateUpgrade2xTo3x},
+	))
+	g.Expect(graph.Edges).To(HaveLen(8))
+}
+
+func TestCheckGraph_WriteDOT(t *testing.T) {
+	g := NewWithT(t)
+
+	graph := lint.BuildCheckGraph([]check.Check{
+		newGraphTestCheck(check.GroupService, "services.a", check.VersionGate3x, resources.DSCInitialization),
+	})
+
+	var out bytes.Buffer
+	g.Expect(graph.WriteDOT(&out)).To(Succeed())
+
+	g.Expect(out.String()).To(HavePrefix("digraph lint {\n"))
+	g.Expect(out.String()).To(ContainSubstring(`"check:services.a" [label="services.a", shape=box];`))
+	g.Expect(out.String()).To(ContainSubstring(`"group:service" -> "check:services.a";`))
+	g.Expect(out.String()).To(ContainSubstring(`"check:services.a" -> "gate:current or target 3.x";`))
+	g.Expect(out.String()).To(HaveSuffix("}\n"))
+}
+
+func TestGraphCommand_JSON(t *testing.T) {
+	g := NewWithT(t)
+
+	var out bytes.Buffer
+	command := lint.NewGraphCommand(genericiooptions.IOStreams{
+		In:     &bytes.Buffer{},
+		Out:    &out,
+		ErrOut: &bytes.Buffer{},
+	})
+	command.OutputFormat = lint.GraphOutputFormatJSON
+	command.CheckSelectors = []string{"workloads.*"}
+
+	g.Expect(command.Complete()).To(Succeed())
+	g.Expect(command.Validate()).To(Succeed())
+	g.Expect(command.Run(t.Context())).To(Succeed())
+
+	var graph lint.CheckGraph
+	g.Expect(json.Unmarshal(out.Bytes(), &graph)).To(Succeed())
+
+	// Every registered check declares at least one required resource.
+	checks := 0
+	withResources := make(map[string]bool)
+	for _, node := range graph.Nodes {
+		g.Expect(node.ID).ToNot(HavePrefix("group:" + string(check.GroupComponent)))
+
+		if node.Kind == lint.GraphNodeCheck {
+			checks++
+		}
+	}
+
+	for _, edge := range graph.Edges {
+		if strings.HasPrefix(edge.To, "resource:") {
+			withResources[edge.From] = true
+		}
+	}
+
+	g.Expect(checks).To(BeNumerically(">", 0))
+	g.Expect(withResources).To(HaveLen(checks))
+}
+
+func TestGraphCommand_InvalidOutput(t *testing.T) {
+	g := NewWithT(t)
+
+	command := lint.NewGraphCommand(genericiooptions.IOStreams{
+		In:     &bytes.Buffer{},
+		Out:    &bytes.Buffer{},
+		ErrOut: &bytes.Buffer{},
+	})
+	command.OutputFormat = "svg"
+
+	g.Expect(command.Validate()).To(MatchError(ContainSubstring("invalid output format")))
+}
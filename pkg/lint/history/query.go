@@ -0,0 +1,193 @@
+package history
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"time"
+)
+
+// Query filters the findings of stored runs.
+type Query struct {
+	// Namespace restricts findings to those with impacted objects in this namespace.
+	Namespace string
+
+	// Check is a glob pattern matched against check IDs; empty matches all checks.
+	Check string
+
+	// IncludePassing includes checks that passed.
+	IncludePassing bool
+}
+
+// FindingRow is one finding of one run, expanded per impacted object.
+type FindingRow struct {
+	RunID     string    `json:"runID"`
+	Timestamp time.Time `json:"timestamp"`
+	CheckID   string    `json:"checkID"`
+	Status    string    `json:"status"`
+	Impact    string    `json:"impact"`
+	Object    *Object   `json:"object,omitempty"`
+	Message   string    `json:"message,omitempty"`
+}
+
+// Flip is a check whose status changed between two consecutive runs.
+type Flip struct {
+	CheckID       string    `json:"checkID"`
+	FromRunID     string    `json:"fromRunID"`
+	ToRunID       string    `json:"toRunID"`
+	ToTimestamp   time.Time `json:"toTimestamp"`
+	FromStatus    string    `json:"fromStatus"`
+	ToStatus      string    `json:"toStatus"`
+	ClusterChange string    `json:"clusterChange,omitempty"`
+}
+
+// ValidateCheckPattern reports whether a check pattern is a valid glob.
+func ValidateCheckPattern(pattern string) error {
+	if pattern == "" {
+		return nil
+	}
+
+	if _, err := path.Match(pattern, ""); err != nil {
+		return fmt.Errorf("invalid check pattern %q: %w", pattern, err)
+	}
+
+	return nil
+}
+
+// Findings returns the findings of runs matching the query, in run order.
+func Findings(runs []Run, query Query) []FindingRow {
+	var rows []FindingRow
+
+	for _, run := range runs {
+		for _, finding := range run.Findings {
+			if !query.IncludePassing && finding.Status == StatusPass {
+				continue
+			}
+
+			if !matchesCheck(query.Check, finding.checkID()) {
+				continue
+			}
+
+			row := FindingRow{
+				RunID:     run.ID,
+				Timestamp: run.Timestamp,
+				CheckID:   finding.checkID(),
+				Status:    finding.Status,
+				Impact:    finding.Impact,
+				Message:   finding.Message,
+			}
+
+			if len(finding.ImpactedObjects) == 0 {
+				if query.Namespace == "" {
+					rows = append(rows, row)
+				}
+
+				continue
+			}
+
+			for _, obj := range finding.ImpactedObjects {
+				if query.Namespace != "" && obj.Namespace != query.Namespace {
+					continue
+				}
+
+				objRow := row
+				objRow.Object = &obj
+				rows = append(rows, objRow)
+			}
+		}
+	}
+
+	return rows
+}
+
+// Flips returns the checks matching pattern whose status changed between consecutive runs.
+// Workload checks run once per resource in lint mode, so each check's status in a run is
+// the most severe status across its executions. Checks missing from either run are ignored.
+func Flips(runs []Run, pattern string) []Flip {
+	var flips []Flip
+
+	for i := 1; i < len(runs); i++ {
+		previous := checkStatuses(runs[i-1], pattern)
+		current := checkStatuses(runs[i], pattern)
+
+		ids := make([]string, 0, len(current))
+		for id := range current {
+			ids = append(ids, id)
+		}
+
+		sort.Strings(ids)
+
+		for _, id := range ids {
+			before, ok := previous[id]
+			if !ok || before == current[id] {
+				continue
+			}
+
+			flip := Flip{
+				CheckID:     id,
+				FromRunID:   runs[i-1].ID,
+				ToRunID:     runs[i].ID,
+				ToTimestamp: runs[i].Timestamp,
+				FromStatus:  before,
+				ToStatus:    current[id],
+			}
+
+			if runs[i-1].ClusterVersion != runs[i].ClusterVersion {
+				flip.ClusterChange = runs[i-1].ClusterVersion + " → " + runs[i].ClusterVersion
+			}
+
+			flips = append(flips, flip)
+		}
+	}
+
+	return flips
+}
+
+func checkStatuses(run Run, pattern string) map[string]string {
+	statuses := make(map[string]string)
+
+	for _, finding := range run.Findings {
+		id := finding.checkID()
+		if !matchesCheck(pattern, id) {
+			continue
+		}
+
+		if statusRank(finding.Status) >= statusRank(statuses[id]) {
+			statuses[id] = finding.Status
+		}
+	}
+
+	return statuses
+}
+
+func statusRank(status string) int {
+	switch status {
+	case StatusFail:
+		return 3
+	case StatusWarn:
+		return 2
+	case StatusPass:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func matchesCheck(pattern string, id string) bool {
+	if pattern == "" {
+		return true
+	}
+
+	matched, err := path.Match(pattern, id)
+
+	return err == nil && matched
+}
+
+// checkID returns the check ID, falling back to group.kind.name for findings recorded without one.
+func (f Finding) checkID() string {
+	if f.CheckID != "" {
+		return f.CheckID
+	}
+
+	return f.Group + "." + f.Kind + "." + f.Name
+}
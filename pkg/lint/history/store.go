@@ -0,0 +1,235 @@
+package history
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+)
+
+const (
+	// runIDLayout sorts lexically in chronological order, which keeps runs ordered in the bucket.
+	runIDLayout = "20060102T150405.000000000Z"
+
+	dirMode  = 0o755
+	fileMode = 0o600
+
+	openTimeout = 5 * time.Second
+)
+
+// Finding statuses, derived from the most severe condition impact of a check result.
+const (
+	StatusPass = "pass"
+	StatusWarn = "warn"
+	StatusFail = "fail"
+)
+
+//nolint:gochecknoglobals // Bucket names are fixed
+var runsBucket = []byte("runs")
+
+// Run is the metadata and findings of one lint invocation.
+type Run struct {
+	ID             string    `json:"id"`
+	Timestamp      time.Time `json:"timestamp"`
+	ClusterVersion string    `json:"clusterVersion,omitempty"`
+	TargetVersion  string    `json:"targetVersion,omitempty"`
+	Findings       []Finding `json:"findings"`
+}
+
+// Finding is the recorded outcome of one check execution.
+type Finding struct {
+	CheckID         string   `json:"checkID"`
+	Group           string   `json:"group"`
+	Kind            string   `json:"kind"`
+	Name            string   `json:"name"`
+	Status          string   `json:"status"`
+	Impact          string   `json:"impact"`
+	Message         string   `json:"message,omitempty"`
+	ImpactedObjects []Object `json:"impactedObjects,omitempty"`
+}
+
+// Object is a resource reported as impacted by a finding.
+type Object struct {
+	Kind      string `json:"kind,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+}
+
+// NewRun builds a Run from flattened check results.
+func NewRun(
+	results []check.CheckExecution,
+	clusterVersion string,
+	targetVersion string,
+	timestamp time.Time,
+) Run {
+	timestamp = timestamp.UTC()
+
+	run := Run{
+		ID:             timestamp.Format(runIDLayout),
+		Timestamp:      timestamp,
+		ClusterVersion: clusterVersion,
+		TargetVersion:  targetVersion,
+		Findings:       make([]Finding, 0, len(results)),
+	}
+
+	for _, exec := range results {
+		if exec.Result == nil {
+			continue
+		}
+
+		finding := Finding{
+			Group:  exec.Result.Group,
+			Kind:   exec.Result.Kind,
+			Name:   exec.Result.Name,
+			Status: StatusPass,
+			Impact: string(result.ImpactNone),
+		}
+
+		if exec.Check != nil {
+			finding.CheckID = exec.Check.ID()
+		}
+
+		for _, cond := range exec.Result.Status.Conditions {
+			switch cond.Impact {
+			case result.ImpactBlocking:
+				finding.Status = StatusFail
+				finding.Impact = string(result.ImpactBlocking)
+			case result.ImpactAdvisory:
+				if finding.Status != StatusFail {
+					finding.Status = StatusWarn
+					finding.Impact = string(result.ImpactAdvisory)
+				}
+			case result.ImpactNone:
+			}
+
+			if cond.Message != "" {
+				if finding.Message != "" {
+					finding.Message += "\n"
+				}
+
+				finding.Message += cond.Message
+			}
+		}
+
+		for _, obj := range exec.Result.ImpactedObjects {
+			finding.ImpactedObjects = append(finding.ImpactedObjects, Object{
+				Kind:      obj.Kind,
+				Namespace: obj.Namespace,
+				Name:      obj.Name,
+			})
+		}
+
+		run.Findings = append(run.Findings, finding)
+	}
+
+	return run
+}
+
+// Store is a local bbolt database of lint runs.
+type Store struct {
+	db *bolt.DB
+}
+
+// Open opens the database at path, creating it and its parent directory if needed.
+func Open(path string) (*Store, error) {
+	if dir := filepath.Dir(path); dir != "" {
+		if err := os.MkdirAll(dir, dirMode); err != nil {
+			return nil, fmt.Errorf("creating %s: %w", dir, err)
+		}
+	}
+
+	db, err := bolt.Open(path, fileMode, &bolt.Options{Timeout: openTimeout})
+	if err != nil {
+		return nil, fmt.Errorf("opening history database %s: %w", path, err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(runsBucket)
+
+		return err //nolint:wrapcheck // Wrapped below
+	})
+	if err != nil {
+		_ = db.Close()
+
+		return nil, fmt.Errorf("initializing history database %s: %w", path, err)
+	}
+
+	return &Store{db: db}, nil
+}
+
+// OpenExisting opens the database at path, failing if it does not exist.
+func OpenExisting(path string) (*Store, error) {
+	if _, err := os.Stat(path); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("history database %s does not exist (record runs with lint --db)", path)
+		}
+
+		return nil, fmt.Errorf("reading history database %s: %w", path, err)
+	}
+
+	return Open(path)
+}
+
+// Close releases the database file lock.
+func (s *Store) Close() error {
+	if err := s.db.Close(); err != nil {
+		return fmt.Errorf("closing history database: %w", err)
+	}
+
+	return nil
+}
+
+// Record stores a run. A run with the same ID replaces the existing one.
+func (s *Store) Record(run Run) error {
+	data, err := json.Marshal(run)
+	if err != nil {
+		return fmt.Errorf("encoding run %s: %w", run.ID, err)
+	}
+
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(runsBucket).Put([]byte(run.ID), data) //nolint:wrapcheck // Wrapped below
+	})
+	if err != nil {
+		return fmt.Errorf("recording run %s: %w", run.ID, err)
+	}
+
+	return nil
+}
+
+// Runs returns the stored runs recorded at or after since, oldest first.
+// A zero since returns all runs.
+func (s *Store) Runs(since time.Time) ([]Run, error) {
+	var runs []Run
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		cursor := tx.Bucket(runsBucket).Cursor()
+
+		key, value := cursor.First()
+		if !since.IsZero() {
+			key, value = cursor.Seek([]byte(since.UTC().Format(runIDLayout)))
+		}
+
+		for ; key != nil; key, value = cursor.Next() {
+			var run Run
+			if err := json.Unmarshal(value, &run); err != nil {
+				return fmt.Errorf("decoding run %s: %w", key, err)
+			}
+
+			runs = append(runs, run)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("reading runs: %w", err)
+	}
+
+	return runs, nil
+}
@@ -0,0 +1,164 @@
+package history_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/history"
+
+	. "github.com/onsi/gomega"
+)
+
+func execution(kind string, impact result.Impact, namespaces ...string) check.CheckExecution {
+	dr := &result.DiagnosticResult{
+		Group: "workloads",
+		Kind:  kind,
+		Name:  "impacted-workloads",
+		Status: result.DiagnosticStatus{Conditions: []result.Condition{{
+			Condition: metav1.Condition{Type: "Compatible", Message: kind + " message"},
+			Impact:    impact,
+		}}},
+	}
+
+	for _, ns := range namespaces {
+		dr.ImpactedObjects = append(dr.ImpactedObjects, metav1.PartialObjectMetadata{
+			TypeMeta:   metav1.TypeMeta{Kind: "Notebook"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: "nb"},
+		})
+	}
+
+	return check.CheckExecution{Result: dr}
+}
+
+func TestNewRun(t *testing.T) {
+	g := NewWithT(t)
+
+	timestamp := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	run := history.NewRun([]check.CheckExecution{
+		execution("notebook", result.ImpactBlocking, "team-a"),
+		execution("ray", result.ImpactAdvisory),
+		execution("kserve", result.ImpactNone),
+	}, "2.25.0", "3.0.0", timestamp)
+
+	g.Expect(run.ID).To(Equal("20260301T100000.000000000Z"))
+	g.Expect(run.ClusterVersion).To(Equal("2.25.0"))
+	g.Expect(run.TargetVersion).To(Equal("3.0.0"))
+	g.Expect(run.Findings).To(HaveLen(3))
+
+	g.Expect(run.Findings[0].Status).To(Equal(history.StatusFail))
+	g.Expect(run.Findings[0].Impact).To(Equal("blocking"))
+	g.Expect(run.Findings[0].Message).To(Equal("notebook message"))
+	g.Expect(run.Findings[0].ImpactedObjects).To(Equal([]history.Object{{Kind: "Notebook", Namespace: "team-a", Name: "nb"}}))
+	g.Expect(run.Findings[1].Status).To(Equal(history.StatusWarn))
+	g.Expect(run.Findings[2].Status).To(Equal(history.StatusPass))
+}
+
+func TestStore_RecordAndRuns(t *testing.T) {
+	g := NewWithT(t)
+
+	path := filepath.Join(t.TempDir(), "nested", "history.db")
+
+	store, err := history.Open(path)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 12, 0, 0, 0, time.UTC) }
+
+	// Recorded out of order; runs are returned oldest first
+	for _, d := range []int{3, 1, 2} {
+		g.Expect(store.Record(history.NewRun(nil, "2.25.0", "", day(d)))).To(Succeed())
+	}
+
+	g.Expect(store.Close()).To(Succeed())
+
+	store, err = history.OpenExisting(path)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	defer func() { _ = store.Close() }()
+
+	runs, err := store.Runs(time.Time{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(runs).To(HaveLen(3))
+	g.Expect(runs[0].Timestamp).To(BeTemporally("==", day(1)))
+	g.Expect(runs[2].Timestamp).To(BeTemporally("==", day(3)))
+
+	runs, err = store.Runs(day(2))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(runs).To(HaveLen(2))
+	g.Expect(runs[0].Timestamp).To(BeTemporally("==", day(2)))
+}
+
+func TestOpenExisting_Missing(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := history.OpenExisting(filepath.Join(t.TempDir(), "missing.db"))
+	g.Expect(err).To(MatchError(ContainSubstring("does not exist")))
+}
+
+func TestFindings(t *testing.T) {
+	g := NewWithT(t)
+
+	run := history.NewRun([]check.CheckExecution{
+		execution("notebook", result.ImpactBlocking, "team-a", "team-b"),
+		execution("ray", result.ImpactAdvisory),
+		execution("kserve", result.ImpactNone),
+	}, "2.25.0", "", time.Now())
+
+	rows := history.Findings([]history.Run{run}, history.Query{})
+	g.Expect(rows).To(HaveLen(3))
+
+	rows = history.Findings([]history.Run{run}, history.Query{Namespace: "team-b"})
+	g.Expect(rows).To(HaveLen(1))
+	g.Expect(rows[0].CheckID).To(Equal("workloads.notebook.impacted-workloads"))
+	g.Expect(rows[0].Object).To(Equal(&history.Object{Kind: "Notebook", Namespace: "team-b", Name: "nb"}))
+
+	rows = history.Findings([]history.Run{run}, history.Query{Check: "*.kserve.*", IncludePassing: true})
+	g.Expect(rows).To(HaveLen(1))
+	g.Expect(rows[0].Status).To(Equal(history.StatusPass))
+}
+
+func TestFlips(t *testing.T) {
+	g := NewWithT(t)
+
+	now := time.Now()
+
+	runs := []history.Run{
+		history.NewRun([]check.CheckExecution{
+			execution("notebook", result.ImpactNone),
+			execution("notebook", result.ImpactAdvisory),
+			execution("ray", result.ImpactBlocking),
+			execution("kserve", result.ImpactNone),
+		}, "2.25.0", "", now.Add(-2*time.Hour)),
+		history.NewRun([]check.CheckExecution{
+			execution("notebook", result.ImpactBlocking),
+			execution("ray", result.ImpactBlocking),
+		}, "2.25.0", "", now.Add(-time.Hour)),
+		history.NewRun([]check.CheckExecution{
+			execution("notebook", result.ImpactNone),
+			execution("ray", result.ImpactNone),
+		}, "3.0.0", "", now),
+	}
+
+	flips := history.Flips(runs, "")
+	g.Expect(flips).To(HaveLen(3))
+
+	// Per-run status is the most severe across executions of the check: warn → fail
+	g.Expect(flips[0].CheckID).To(Equal("workloads.notebook.impacted-workloads"))
+	g.Expect(flips[0].FromStatus).To(Equal(history.StatusWarn))
+	g.Expect(flips[0].ToStatus).To(Equal(history.StatusFail))
+	g.Expect(flips[0].ClusterChange).To(BeEmpty())
+
+	g.Expect(flips[1].CheckID).To(Equal("workloads.notebook.impacted-workloads"))
+	g.Expect(flips[1].ToStatus).To(Equal(history.StatusPass))
+	g.Expect(flips[1].ClusterChange).To(Equal("2.25.0 → 3.0.0"))
+
+	g.Expect(flips[2].CheckID).To(Equal("workloads.ray.impacted-workloads"))
+	g.Expect(flips[2].FromStatus).To(Equal(history.StatusFail))
+
+	g.Expect(history.Flips(runs, "*.ray.*")).To(HaveLen(1))
+}
@@ -0,0 +1,237 @@
+package lint
+
+import (
+	_ "embed"
+	"fmt"
+	"html/template"
+	"io"
+	"slices"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	printerhtml "github.com/opendatahub-io/odh-cli/pkg/printer/html"
+)
+
+//go:embed html_report.tmpl
+var htmlReportTemplate string
+
+// htmlTemplate is the standalone report page: styles and the namespace filter script are
+// inlined, so the file can be attached to a ticket and opened offline.
+//
+//nolint:gochecknoglobals // Parsed once from the embedded template
+var htmlTemplate = template.Must(template.New("report").Parse(htmlReportTemplate))
+
+// HTMLCheckStatus is the outcome of a check in the HTML report.
+type HTMLCheckStatus string
+
+const (
+	HTMLCheckStatusBlocking HTMLCheckStatus = "blocking"
+	HTMLCheckStatusAdvisory HTMLCheckStatus = "advisory"
+	HTMLCheckStatusPassed   HTMLCheckStatus = "passed"
+	HTMLCheckStatusSkipped  HTMLCheckStatus = "skipped"
+)
+
+// HTMLReport is the content of the HTML report of a lint or upgrade assessment.
+type HTMLReport struct {
+	GeneratedAt    time.Time
+	ClusterVersion string
+	TargetVersion  string
+	Flavor         string
+
+	// Totals counts the checks of all groups.
+	Totals HTMLGroupCounts
+
+	Groups []HTMLGroup
+}
+
+// HTMLGroupCounts counts the checks of a group by outcome.
+type HTMLGroupCounts struct {
+	Blocking int
+	Advisory int
+	Passed   int
+	Skipped  int
+}
+
+// HTMLGroup is the summary card and the checks of one check group.
+type HTMLGroup struct {
+	Name   string
+	Counts HTMLGroupCounts
+	Checks []HTMLCheck
+}
+
+// HTMLCheck is a single check of the report, with its conditions and impacted objects.
+type HTMLCheck struct {
+	ID          string
+	Name        string
+	Description string
+	Status      HTMLCheckStatus
+
+	// Message is the first failing condition message, the first condition message of a
+	// passing check, or why the check was skipped.
+	Message string
+
+	Conditions     []result.Condition
+	KnowledgeLinks []string
+	Objects        []HTMLObject
+
+	// Namespaces are the distinct namespaces of Objects, offered as filters.
+	Namespaces []string
+}
+
+// HTMLObject is a row of the impacted objects table of a check.
+type HTMLObject struct {
+	Namespace string
+	Kind      string
+	Name      string
+}
+
+// NewHTMLReport builds the HTML report with one group per check group, in execution order.
+// Executions of the same check against several objects (workload checks in lint mode) are
+// merged into a single check, as for JUnit.
+func NewHTMLReport(
+	results []check.CheckExecution,
+	skipped []SkippedCheck,
+	clusterVersion *string,
+	targetVersion *string,
+) *HTMLReport {
+	report := &HTMLReport{Flavor: resultsFlavor(results)}
+
+	if clusterVersion != nil {
+		report.ClusterVersion = *clusterVersion
+	}
+
+	if targetVersion != nil {
+		report.TargetVersion = *targetVersion
+	}
+
+	for _, group := range check.CanonicalGroupOrder {
+		htmlGroup := HTMLGroup{Name: string(group)}
+		index := make(map[string]int)
+
+		for _, exec := range results {
+			if exec.Check.Group() != group || exec.Result == nil {
+				continue
+			}
+
+			i, ok := index[exec.Check.ID()]
+			if !ok {
+				i = len(htmlGroup.Checks)
+				index[exec.Check.ID()] = i
+				htmlGroup.Checks = append(htmlGroup.Checks, HTMLCheck{
+					ID:          exec.Check.ID(),
+					Name:        exec.Check.Name(),
+					Description: exec.Result.Spec.Description,
+					Status:      HTMLCheckStatusPassed,
+				})
+			}
+
+			addHTMLResult(&htmlGroup.Checks[i], exec.Result)
+		}
+
+		for _, s := range skipped {
+			if s.Group != group {
+				continue
+			}
+
+			htmlGroup.Checks = append(htmlGroup.Checks, HTMLCheck{
+				ID:      s.ID,
+				Name:    s.Name,
+				Status:  HTMLCheckStatusSkipped,
+				Message: s.Reason,
+			})
+		}
+
+		if len(htmlGroup.Checks) == 0 {
+			continue
+		}
+
+		for _, c := range htmlGroup.Checks {
+			htmlGroup.Counts.add(c.Status)
+			report.Totals.add(c.Status)
+		}
+
+		report.Groups = append(report.Groups, htmlGroup)
+	}
+
+	return report
+}
+
+func (c *HTMLGroupCounts) add(status HTMLCheckStatus) {
+	switch status {
+	case HTMLCheckStatusBlocking:
+		c.Blocking++
+	case HTMLCheckStatusAdvisory:
+		c.Advisory++
+	case HTMLCheckStatusPassed:
+		c.Passed++
+	case HTMLCheckStatusSkipped:
+		c.Skipped++
+	}
+}
+
+// addHTMLResult merges a result of the check into hc: its conditions, impacted objects and
+// knowledge links, raising the status to the highest impact seen.
+func addHTMLResult(hc *HTMLCheck, dr *result.DiagnosticResult) {
+	for _, condition := range dr.Status.Conditions {
+		hc.Conditions = append(hc.Conditions, condition)
+
+		if condition.Status == metav1.ConditionTrue {
+			if hc.Message == "" {
+				hc.Message = condition.Message
+			}
+
+			continue
+		}
+
+		switch {
+		case condition.Impact == result.ImpactBlocking && hc.Status != HTMLCheckStatusBlocking:
+			hc.Status = HTMLCheckStatusBlocking
+			hc.Message = condition.Message
+		case condition.Impact == result.ImpactAdvisory && hc.Status == HTMLCheckStatusPassed:
+			hc.Status = HTMLCheckStatusAdvisory
+			hc.Message = condition.Message
+		}
+	}
+
+	for _, link := range dr.Spec.KnowledgeLinks {
+		if !slices.Contains(hc.KnowledgeLinks, link) {
+			hc.KnowledgeLinks = append(hc.KnowledgeLinks, link)
+		}
+	}
+
+	for _, obj := range dr.ImpactedObjects {
+		hc.Objects = append(hc.Objects, HTMLObject{Namespace: obj.Namespace, Kind: obj.Kind, Name: obj.Name})
+
+		if obj.Namespace != "" && !slices.Contains(hc.Namespaces, obj.Namespace) {
+			hc.Namespaces = append(hc.Namespaces, obj.Namespace)
+		}
+	}
+
+	slices.Sort(hc.Namespaces)
+}
+
+// OutputHTML outputs diagnostic results as a standalone HTML report.
+func OutputHTML(
+	out io.Writer,
+	results []check.CheckExecution,
+	skipped []SkippedCheck,
+	clusterVersion *string,
+	targetVersion *string,
+) error {
+	report := NewHTMLReport(results, skipped, clusterVersion, targetVersion)
+	report.GeneratedAt = time.Now().UTC()
+
+	renderer := printerhtml.NewRenderer[*HTMLReport](
+		printerhtml.WithWriter[*HTMLReport](out),
+		printerhtml.WithTemplate[*HTMLReport](htmlTemplate),
+	)
+
+	if err := renderer.Render(report); err != nil {
+		return fmt.Errorf("rendering HTML output: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package lint_test
+
+import (
+	"bytes"
+	"html"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestNewHTMLReport(t *testing.T) {
+	g := NewWithT(t)
+
+	clusterVer := testClusterVersion
+	targetVer := testTargetVersion
+
+	report := lint.NewHTMLReport(remediationExecutions(), junitSkipped(), &clusterVer, &targetVer)
+
+	g.Expect(report.ClusterVersion).To(Equal(testClusterVersion))
+	g.Expect(report.TargetVersion).To(Equal(testTargetVersion))
+	g.Expect(report.Totals).To(Equal(lint.HTMLGroupCounts{Blocking: 1, Advisory: 1, Skipped: 1}))
+	g.Expect(report.Groups).To(HaveLen(1))
+
+	group := report.Groups[0]
+	g.Expect(group.Name).To(Equal(string(check.GroupComponent)))
+	g.Expect(group.Checks).To(HaveLen(3))
+
+	codeflare := group.Checks[0]
+	g.Expect(codeflare.ID).To(Equal("components.codeflare.removal"))
+	g.Expect(codeflare.Status).To(Equal(lint.HTMLCheckStatusBlocking))
+	g.Expect(codeflare.Message).To(Equal("CodeFlare is enabled"))
+	g.Expect(codeflare.Conditions).To(HaveLen(1))
+
+	g.Expect(group.Checks[1].Status).To(Equal(lint.HTMLCheckStatusAdvisory))
+	g.Expect(group.Checks[2].Status).To(Equal(lint.HTMLCheckStatusSkipped))
+	g.Expect(group.Checks[2].Message).To(Equal("not applicable to the cluster configuration"))
+}
+
+func TestNewHTMLReport_MergesObjectsOfSameCheck(t *testing.T) {
+	g := NewWithT(t)
+
+	executions := remediationExecutions()[:1]
+	second := *executions[0].Result
+	executions[0].Result.ImpactedObjects = []metav1.PartialObjectMetadata{
+		{TypeMeta: metav1.TypeMeta{Kind: "Notebook"}, ObjectMeta: metav1.ObjectMeta{Namespace: "team-b", Name: "wb-1"}},
+	}
+	second.ImpactedObjects = []metav1.PartialObjectMetadata{
+		{TypeMeta: metav1.TypeMeta{Kind: "Notebook"}, ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "wb-2"}},
+		{TypeMeta: metav1.TypeMeta{Kind: "Notebook"}, ObjectMeta: metav1.ObjectMeta{Namespace: "team-b", Name: "wb-3"}},
+	}
+	executions = append(executions, check.CheckExecution{Check: executions[0].Check, Result: &second})
+
+	report := lint.NewHTMLReport(executions, nil, nil, nil)
+
+	g.Expect(report.Totals.Blocking).To(Equal(1))
+
+	codeflare := report.Groups[0].Checks[0]
+	g.Expect(codeflare.Objects).To(HaveLen(3))
+	g.Expect(codeflare.Namespaces).To(Equal([]string{"team-a", "team-b"}))
+}
+
+func TestOutputHTML(t *testing.T) {
+	g := NewWithT(t)
+
+	clusterVer := testClusterVersion
+	targetVer := testTargetVersion
+
+	executions := remediationExecutions()
+	executions[0].Result.ImpactedObjects = []metav1.PartialObjectMetadata{
+		{TypeMeta: metav1.TypeMeta{Kind: "Notebook"}, ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "<wb>"}},
+	}
+
+	var buf bytes.Buffer
+	g.Expect(lint.OutputHTML(&buf, executions, junitSkipped(), &clusterVer, &targetVer)).To(Succeed())
+
+	page := buf.String()
+	g.Expect(page).To(HavePrefix("<!DOCTYPE html>"))
+	g.Expect(page).To(ContainSubstring("Upgrade assessment"))
+	g.Expect(page).To(ContainSubstring("Target version: " + testTargetVersion))
+	g.Expect(page).To(ContainSubstring(`<details id="check-components.codeflare.removal" open>`))
+	g.Expect(page).To(ContainSubstring("<code>" + html.EscapeString(testPatchCommand) + "</code>"))
+	g.Expect(page).To(ContainSubstring(`<option value="team-a">team-a</option>`))
+	g.Expect(page).To(ContainSubstring("&lt;wb&gt;"))
+	g.Expect(page).ToNot(ContainSubstring("<wb>"))
+}
+
+func TestCommand_HTMLNotSupportedWithDiff(t *testing.T) {
+	g := NewWithT(t)
+
+	streams := genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: &bytes.Buffer{}, ErrOut: &bytes.Buffer{}}
+
+	command := lint.NewCommand(streams, testConfigFlags())
+	command.OutputSpecs = []string{"table", "html=report.html"}
+
+	g.Expect(command.Validate()).To(Succeed())
+
+	command.Diff = "previous.json"
+	g.Expect(command.Validate()).To(MatchError(ContainSubstring("--output html is not supported with --diff")))
+}
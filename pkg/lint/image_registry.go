@@ -0,0 +1,67 @@
+package lint
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"maps"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/registry"
+)
+
+// The cluster-wide pull secret nodes pull images with.
+const (
+	globalPullSecretNamespace = "openshift-config"
+	globalPullSecretName      = "pull-secret"
+	dockerConfigJSONKey       = ".dockerconfigjson"
+)
+
+// newImageRegistry creates the registry client --inspect-registry inspects images with. It
+// authenticates with the credentials of the cluster global pull secret, when readable, and of
+// --registry-config, which take precedence for the registries they both have.
+func (c *Command) newImageRegistry(ctx context.Context, reader client.Reader) (*registry.Client, error) {
+	credentials, err := pullSecretCredentials(ctx, reader)
+	if err != nil {
+		c.IO.Errorf("Warning: registry inspection without the cluster pull secret: %v", err)
+
+		credentials = make(map[string]registry.Credential)
+	}
+
+	if c.RegistryConfig != "" {
+		fileCredentials, err := registry.LoadDockerConfig(c.RegistryConfig)
+		if err != nil {
+			return nil, fmt.Errorf("loading registry credentials: %w", err)
+		}
+
+		maps.Copy(credentials, fileCredentials)
+	}
+
+	return registry.NewClient(registry.WithCredentials(credentials)), nil
+}
+
+// pullSecretCredentials returns the registry credentials of the cluster global pull secret.
+func pullSecretCredentials(ctx context.Context, reader client.Reader) (map[string]registry.Credential, error) {
+	secret, err := reader.GetResource(ctx, resources.Secret, globalPullSecretName,
+		client.InNamespace(globalPullSecretNamespace))
+	if err != nil {
+		return nil, fmt.Errorf("getting %s/%s: %w", globalPullSecretNamespace, globalPullSecretName, err)
+	}
+
+	encoded, _, _ := unstructured.NestedString(secret.Object, "data", dockerConfigJSONKey)
+
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("decoding %s/%s: %w", globalPullSecretNamespace, globalPullSecretName, err)
+	}
+
+	credentials, err := registry.ParseDockerConfig(data)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s/%s: %w", globalPullSecretNamespace, globalPullSecretName, err)
+	}
+
+	return credentials, nil
+}
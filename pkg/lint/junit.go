@@ -0,0 +1,255 @@
+package lint
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+)
+
+// junitSuitesName is the name of the top-level JUnit testsuites element.
+const junitSuitesName = "odh-cli lint"
+
+// SkippedCheck is a selected check that was not executed because it does not apply to the target.
+type SkippedCheck struct {
+	ID     string
+	Name   string
+	Group  check.CheckGroup
+	Reason string
+}
+
+// JUnitTestSuites is the root element of a JUnit XML report.
+type JUnitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Suites   []JUnitTestSuite `xml:"testsuite"`
+}
+
+// JUnitTestSuite groups the testcases of one check group.
+type JUnitTestSuite struct {
+	Name       string          `xml:"name,attr"`
+	Tests      int             `xml:"tests,attr"`
+	Failures   int             `xml:"failures,attr"`
+	Skipped    int             `xml:"skipped,attr"`
+	Properties []JUnitProperty `xml:"properties>property,omitempty"`
+	TestCases  []JUnitTestCase `xml:"testcase"`
+}
+
+// JUnitProperty is a name/value property of a test suite.
+type JUnitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+// JUnitTestCase is a single check.
+type JUnitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *JUnitFailure `xml:"failure,omitempty"`
+	Skipped   *JUnitSkipped `xml:"skipped,omitempty"`
+}
+
+// JUnitFailure describes the failing conditions of a check.
+type JUnitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// JUnitSkipped records why a check was not executed.
+type JUnitSkipped struct {
+	Message string `xml:"message,attr,omitempty"`
+}
+
+// NewJUnitReport builds a JUnit report with one testsuite per check group and one testcase per
+// check. Failing conditions become the failure of their testcase, with the remediation text;
+// skipped checks become skipped testcases. Executions of the same check against several objects
+// (workload checks in lint mode) are reported as a single testcase.
+func NewJUnitReport(
+	results []check.CheckExecution,
+	skipped []SkippedCheck,
+	clusterVersion *string,
+	targetVersion *string,
+) *JUnitTestSuites {
+	var properties []JUnitProperty
+
+	if clusterVersion != nil && *clusterVersion != "" {
+		properties = append(properties, JUnitProperty{Name: "clusterVersion", Value: *clusterVersion})
+	}
+
+	if targetVersion != nil && *targetVersion != "" {
+		properties = append(properties, JUnitProperty{Name: "targetVersion", Value: *targetVersion})
+	}
+
+	if flavor := resultsFlavor(results); flavor != "" {
+		properties = append(properties, JUnitProperty{Name: "flavor", Value: flavor})
+	}
+
+	report := &JUnitTestSuites{Name: junitSuitesName}
+
+	for _, group := range check.CanonicalGroupOrder {
+		suite := JUnitTestSuite{Name: string(group), Properties: properties}
+		index := make(map[string]int)
+
+		for _, exec := range results {
+			if exec.Check.Group() != group || exec.Result == nil {
+				continue
+			}
+
+			i, ok := index[exec.Check.ID()]
+			if !ok {
+				i = len(suite.TestCases)
+				index[exec.Check.ID()] = i
+				suite.TestCases = append(suite.TestCases, JUnitTestCase{
+					Name:      exec.Check.Name(),
+					ClassName: exec.Check.ID(),
+				})
+			}
+
+			addJUnitFailure(&suite.TestCases[i], exec.Result)
+		}
+
+		for _, s := range skipped {
+			if s.Group != group {
+				continue
+			}
+
+			suite.TestCases = append(suite.TestCases, JUnitTestCase{
+				Name:      s.Name,
+				ClassName: s.ID,
+				Skipped:   &JUnitSkipped{Message: s.Reason},
+			})
+		}
+
+		if len(suite.TestCases) == 0 {
+			continue
+		}
+
+		for _, tc := range suite.TestCases {
+			suite.Tests++
+
+			switch {
+			case tc.Failure != nil:
+				suite.Failures++
+			case tc.Skipped != nil:
+				suite.Skipped++
+			}
+		}
+
+		report.Tests += suite.Tests
+		report.Failures += suite.Failures
+		report.Skipped += suite.Skipped
+		report.Suites = append(report.Suites, suite)
+	}
+
+	return report
+}
+
+// addJUnitFailure adds the failing conditions of dr to the failure of tc. The failure type is
+// the highest impact across them, so CI can tell blocking from advisory findings.
+func addJUnitFailure(tc *JUnitTestCase, dr *result.DiagnosticResult) {
+	var text strings.Builder
+
+	for _, condition := range dr.Status.Conditions {
+		if condition.Status == metav1.ConditionTrue {
+			continue
+		}
+
+		if tc.Failure == nil {
+			tc.Failure = &JUnitFailure{Message: condition.Message, Type: string(condition.Impact)}
+		} else if condition.Impact == result.ImpactBlocking {
+			tc.Failure.Type = string(result.ImpactBlocking)
+		}
+
+		_, _ = fmt.Fprintf(&text, "%s (%s, %s): %s\n",
+			condition.Type, condition.Status, condition.Reason, condition.Message)
+
+		if condition.Remediation != "" {
+			_, _ = fmt.Fprintf(&text, "Remediation: %s\n", condition.Remediation)
+		}
+
+		for _, command := range condition.RemediationCommands {
+			_, _ = fmt.Fprintf(&text, "  $ %s\n", command)
+		}
+	}
+
+	if tc.Failure == nil {
+		return
+	}
+
+	for _, link := range dr.Spec.KnowledgeLinks {
+		_, _ = fmt.Fprintf(&text, "See: %s\n", link)
+	}
+
+	tc.Failure.Text += text.String()
+}
+
+// OutputJUnit outputs diagnostic results as a JUnit XML report.
+func OutputJUnit(
+	out io.Writer,
+	results []check.CheckExecution,
+	skipped []SkippedCheck,
+	clusterVersion *string,
+	targetVersion *string,
+) error {
+	if _, err := io.WriteString(out, xml.Header); err != nil {
+		return fmt.Errorf("writing JUnit header: %w", err)
+	}
+
+	encoder := xml.NewEncoder(out)
+	encoder.Indent("", "  ")
+
+	if err := encoder.Encode(NewJUnitReport(results, skipped, clusterVersion, targetVersion)); err != nil {
+		return fmt.Errorf("encoding JUnit report: %w", err)
+	}
+
+	if _, err := io.WriteString(out, "\n"); err != nil {
+		return fmt.Errorf("writing JUnit report: %w", err)
+	}
+
+	return nil
+}
+
+// skippedChecks returns the checks selected by --checks that produced no result, with the reason
+// they did not apply to the assessed target.
+func (c *Command) skippedChecks(results []check.CheckExecution) []SkippedCheck {
+	executed := make(map[string]bool, len(results))
+	for _, exec := range results {
+		executed[exec.Check.ID()] = true
+	}
+
+	selected, err := c.registry.ListByPatterns(c.CheckSelectors, "")
+	if err != nil {
+		return nil
+	}
+
+	var skipped []SkippedCheck
+
+	for _, chk := range selected {
+		if executed[chk.ID()] {
+			continue
+		}
+
+		reason := notApplicableReason(chk, c.assessedTarget)
+		if !check.AppliesToFlavor(chk, c.assessedTarget.Flavor) {
+			reason = notApplicableFlavorReason(chk, c.assessedTarget)
+		}
+
+		skipped = append(skipped, SkippedCheck{
+			ID:     chk.ID(),
+			Name:   chk.Name(),
+			Group:  chk.Group(),
+			Reason: reason,
+		})
+	}
+
+	return skipped
+}
@@ -0,0 +1,107 @@
+package lint_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"testing"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+
+	. "github.com/onsi/gomega"
+)
+
+func junitSkipped() []lint.SkippedCheck {
+	return []lint.SkippedCheck{{
+		ID:     "components.kueue.management-state",
+		Name:   "Components :: Kueue :: Management State",
+		Group:  check.GroupComponent,
+		Reason: "not applicable to the cluster configuration",
+	}}
+}
+
+func TestNewJUnitReport(t *testing.T) {
+	g := NewWithT(t)
+
+	clusterVer := testClusterVersion
+	targetVer := testTargetVersion
+
+	report := lint.NewJUnitReport(remediationExecutions(), junitSkipped(), &clusterVer, &targetVer)
+
+	g.Expect(report.Tests).To(Equal(3))
+	g.Expect(report.Failures).To(Equal(2))
+	g.Expect(report.Skipped).To(Equal(1))
+	g.Expect(report.Suites).To(HaveLen(1))
+
+	suite := report.Suites[0]
+	g.Expect(suite.Name).To(Equal(string(check.GroupComponent)))
+	g.Expect(suite.Properties).To(ContainElement(lint.JUnitProperty{Name: "targetVersion", Value: testTargetVersion}))
+	g.Expect(suite.TestCases).To(HaveLen(3))
+
+	codeflare := suite.TestCases[0]
+	g.Expect(codeflare.ClassName).To(Equal("components.codeflare.removal"))
+	g.Expect(codeflare.Failure).ToNot(BeNil())
+	g.Expect(codeflare.Failure.Type).To(Equal("blocking"))
+	g.Expect(codeflare.Failure.Message).To(Equal("CodeFlare is enabled"))
+	g.Expect(codeflare.Failure.Text).To(ContainSubstring("Remediation: Disable CodeFlare"))
+	g.Expect(codeflare.Failure.Text).To(ContainSubstring("$ " + testPatchCommand))
+
+	skipped := suite.TestCases[2]
+	g.Expect(skipped.Failure).To(BeNil())
+	g.Expect(skipped.Skipped).To(Equal(&lint.JUnitSkipped{Message: "not applicable to the cluster configuration"}))
+}
+
+func TestNewJUnitReport_MergesExecutionsOfSameCheck(t *testing.T) {
+	g := NewWithT(t)
+
+	executions := remediationExecutions()
+	executions = append(executions, executions[0])
+
+	report := lint.NewJUnitReport(executions, nil, nil, nil)
+
+	g.Expect(report.Tests).To(Equal(2))
+	g.Expect(report.Suites[0].Properties).To(BeEmpty())
+}
+
+func TestNewJUnitReport_KnowledgeLinks(t *testing.T) {
+	g := NewWithT(t)
+
+	executions := remediationExecutions()
+	executions[0].Result.Spec.KnowledgeLinks = []string{check.KnowledgeBaseURL + "7012345"}
+
+	report := lint.NewJUnitReport(executions, nil, nil, nil)
+
+	g.Expect(report.Suites[0].TestCases[0].Failure.Text).To(ContainSubstring("See: " + check.KnowledgeBaseURL + "7012345"))
+	g.Expect(report.Suites[0].TestCases[1].Failure.Text).ToNot(ContainSubstring("See:"))
+}
+
+func TestOutputJUnit(t *testing.T) {
+	g := NewWithT(t)
+
+	var buf bytes.Buffer
+	g.Expect(lint.OutputJUnit(&buf, remediationExecutions(), junitSkipped(), nil, nil)).To(Succeed())
+
+	g.Expect(buf.String()).To(HavePrefix(xml.Header))
+
+	var decoded lint.JUnitTestSuites
+	g.Expect(xml.Unmarshal(buf.Bytes(), &decoded)).To(Succeed())
+	g.Expect(decoded.Name).To(Equal("odh-cli lint"))
+	g.Expect(decoded.Tests).To(Equal(3))
+	g.Expect(decoded.Suites[0].TestCases[2].Skipped).ToNot(BeNil())
+}
+
+func TestCommand_JUnitNotSupportedWithPlan(t *testing.T) {
+	g := NewWithT(t)
+
+	streams := genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: &bytes.Buffer{}, ErrOut: &bytes.Buffer{}}
+
+	command := lint.NewCommand(streams, testConfigFlags())
+	command.OutputSpecs = []string{"junit=report.xml"}
+
+	g.Expect(command.Validate()).To(Succeed())
+
+	command.Plan = true
+	g.Expect(command.Validate()).To(MatchError(ContainSubstring("--output junit is not supported with --plan")))
+}
@@ -0,0 +1,287 @@
+package lint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/printer/table"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+)
+
+// Applicability is whether a check would run for the given versions and cluster.
+type Applicability string
+
+const (
+	ApplicabilityYes     Applicability = "yes"
+	ApplicabilityNo      Applicability = "no"
+	ApplicabilityUnknown Applicability = "unknown"
+)
+
+// errClusterRequired is returned by the offline reader for every API call, so checks whose
+// applicability depends on cluster state are reported as unknown rather than not applicable.
+var errClusterRequired = errors.New("cluster access required")
+
+// CheckListEntry describes one registered check and, when evaluated, its applicability.
+type CheckListEntry struct {
+	ID            string        `json:"id"                      yaml:"id"`
+	Group         string        `json:"group"                   yaml:"group"`
+	Kind          string        `json:"kind"                    yaml:"kind"`
+	Type          string        `json:"type"                    yaml:"type"`
+	Description   string        `json:"description"             yaml:"description"`
+	Applicability Applicability `json:"applicability,omitempty" yaml:"applicability,omitempty"`
+	Reason        string        `json:"reason,omitempty"        yaml:"reason,omitempty"`
+}
+
+// BuildCheckList lists the checks in canonical group order, then by ID. With a non-nil
+// target, CanApply is evaluated for each check; a target without a client is evaluated
+// offline, reporting checks that need to read the cluster as unknown.
+func BuildCheckList(ctx context.Context, checks []check.Check, target *check.Target) ([]CheckListEntry, error) {
+	sorted := make([]check.Check, 0, len(checks))
+
+	for _, group := range check.CanonicalGroupOrder {
+		start := len(sorted)
+
+		for _, chk := range checks {
+			if chk.Group() == group {
+				sorted = append(sorted, chk)
+			}
+		}
+
+		inGroup := sorted[start:]
+		sort.Slice(inGroup, func(i, j int) bool { return inGroup[i].ID() < inGroup[j].ID() })
+	}
+
+	entries := make([]CheckListEntry, 0, len(sorted))
+
+	for _, chk := range sorted {
+		entry := CheckListEntry{
+			ID:          chk.ID(),
+			Group:       string(chk.Group()),
+			Kind:        chk.CheckKind(),
+			Type:        chk.CheckType(),
+			Description: chk.Description(),
+		}
+
+		if target != nil {
+			if err := check.CheckContextError(ctx); err != nil {
+				return nil, err //nolint:wrapcheck // Already contextualized
+			}
+
+			entry.Applicability, entry.Reason = evaluateApplicability(ctx, chk, *target)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// evaluateApplicability evaluates the flavor gate and CanApply of a check against the target.
+func evaluateApplicability(ctx context.Context, chk check.Check, target check.Target) (Applicability, string) {
+	offline := target.Client == nil
+	if offline {
+		target.Client = offlineReader{}
+	}
+
+	if !check.AppliesToFlavor(chk, target.Flavor) {
+		if offline {
+			return ApplicabilityUnknown, "depends on the management flavor of the cluster (evaluate with --cluster)"
+		}
+
+		return ApplicabilityNo, notApplicableFlavorReason(chk, target)
+	}
+
+	canApply, err := chk.CanApply(ctx, target)
+
+	switch {
+	case errors.Is(err, errClusterRequired):
+		return ApplicabilityUnknown, "depends on cluster state (evaluate with --cluster)"
+	case err != nil:
+		return ApplicabilityUnknown, fmt.Sprintf("applicability check failed: %v", err)
+	case !canApply:
+		reason := notApplicableReason(chk, target)
+		if offline && reason == reasonNotApplicableToCluster {
+			// Rejected without reading the cluster, so on the versions alone
+			reason = fmt.Sprintf("not applicable to %s → %s",
+				formatVersion(target.CurrentVersion), formatVersion(target.TargetVersion))
+		}
+
+		return ApplicabilityNo, reason
+	default:
+		return ApplicabilityYes, ""
+	}
+}
+
+// checkListRow is a single row of the check list table.
+type checkListRow struct {
+	ID          string `mapstructure:"ID"`
+	Group       string `mapstructure:"GROUP"`
+	Kind        string `mapstructure:"KIND"`
+	Type        string `mapstructure:"TYPE"`
+	Description string `mapstructure:"DESCRIPTION"`
+	Applicable  string `mapstructure:"APPLICABLE"`
+	Reason      string `mapstructure:"REASON"`
+}
+
+// outputCheckListTable renders the check list as a table. The applicability columns are
+// only shown when applicability was evaluated.
+func outputCheckListTable(out io.Writer, entries []CheckListEntry, evaluated bool) error {
+	headers := []string{"ID", "GROUP", "KIND", "TYPE", "DESCRIPTION"}
+	if evaluated {
+		headers = append(headers, "APPLICABLE", "REASON")
+	}
+
+	renderer := table.NewRenderer(
+		table.WithWriter[checkListRow](out),
+		table.WithHeaders[checkListRow](headers...),
+		table.WithTableOptions[checkListRow](table.DefaultTableOptions...),
+	)
+
+	counts := make(map[Applicability]int)
+
+	for _, entry := range entries {
+		counts[entry.Applicability]++
+
+		reason := entry.Reason
+		if reason == "" {
+			reason = "-"
+		}
+
+		row := checkListRow{
+			ID:          entry.ID,
+			Group:       entry.Group,
+			Kind:        entry.Kind,
+			Type:        entry.Type,
+			Description: entry.Description,
+			Applicable:  string(entry.Applicability),
+			Reason:      reason,
+		}
+
+		if err := renderer.Append(row); err != nil {
+			return fmt.Errorf("appending check row: %w", err)
+		}
+	}
+
+	if err := renderer.Render(); err != nil {
+		return fmt.Errorf("rendering checks: %w", err)
+	}
+
+	if evaluated {
+		_, _ = fmt.Fprintf(out, "\nChecks: %d applicable, %d not applicable, %d unknown\n",
+			counts[ApplicabilityYes], counts[ApplicabilityNo], counts[ApplicabilityUnknown])
+	}
+
+	return nil
+}
+
+// offlineReader fails every API call with errClusterRequired. It stands in for the cluster
+// when applicability is evaluated from the given versions only.
+type offlineReader struct{}
+
+var _ client.Reader = offlineReader{}
+
+func (offlineReader) List(
+	_ context.Context,
+	_ resources.ResourceType,
+	_ ...client.ListResourcesOption,
+) ([]*unstructured.Unstructured, error) {
+	return nil, errClusterRequired
+}
+
+func (offlineReader) ListMetadata(
+	_ context.Context,
+	_ resources.ResourceType,
+	_ ...client.ListResourcesOption,
+) ([]*metav1.PartialObjectMetadata, error) {
+	return nil, errClusterRequired
+}
+
+func (offlineReader) ListResources(
+	_ context.Context,
+	_ schema.GroupVersionResource,
+	_ ...client.ListResourcesOption,
+) ([]*unstructured.Unstructured, error) {
+	return nil, errClusterRequired
+}
+
+func (offlineReader) Get(
+	_ context.Context,
+	_ schema.GroupVersionResource,
+	_ string,
+	_ ...client.GetOption,
+) (*unstructured.Unstructured, error) {
+	return nil, errClusterRequired
+}
+
+func (offlineReader) GetResource(
+	_ context.Context,
+	_ resources.ResourceType,
+	_ string,
+	_ ...client.GetOption,
+) (*unstructured.Unstructured, error) {
+	return nil, errClusterRequired
+}
+
+func (offlineReader) GetResourceMetadata(
+	_ context.Context,
+	_ resources.ResourceType,
+	_ string,
+	_ ...client.GetOption,
+) (*metav1.PartialObjectMetadata, error) {
+	return nil, errClusterRequired
+}
+
+func (offlineReader) OLM() client.OLMReader {
+	return offlineOLMReader{}
+}
+
+// offlineOLMReader reports OLM as available so operator checks surface errClusterRequired
+// instead of treating operators as missing.
+type offlineOLMReader struct{}
+
+func (offlineOLMReader) Available() bool {
+	return true
+}
+
+func (offlineOLMReader) Subscriptions(_ string) client.SubscriptionReader {
+	return offlineSubscriptionReader{}
+}
+
+func (offlineOLMReader) ClusterServiceVersions(_ string) client.CSVReader {
+	return offlineCSVReader{}
+}
+
+type offlineSubscriptionReader struct{}
+
+func (offlineSubscriptionReader) List(_ context.Context, _ metav1.ListOptions) (*operatorsv1alpha1.SubscriptionList, error) {
+	return nil, errClusterRequired
+}
+
+func (offlineSubscriptionReader) Get(_ context.Context, _ string, _ metav1.GetOptions) (*operatorsv1alpha1.Subscription, error) {
+	return nil, errClusterRequired
+}
+
+type offlineCSVReader struct{}
+
+func (offlineCSVReader) List(_ context.Context, _ metav1.ListOptions) (*operatorsv1alpha1.ClusterServiceVersionList, error) {
+	return nil, errClusterRequired
+}
+
+func (offlineCSVReader) Get(
+	_ context.Context,
+	_ string,
+	_ metav1.GetOptions,
+) (*operatorsv1alpha1.ClusterServiceVersion, error) {
+	return nil, errClusterRequired
+}
@@ -0,0 +1,283 @@
+package lint
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+)
+
+// markdownCheck is the merged results of one check in the Markdown report.
+type markdownCheck struct {
+	id         string
+	impact     result.Impact
+	message    string
+	conditions []result.Condition
+	links      []string
+	objects    []metav1.PartialObjectMetadata
+}
+
+// markdownCounts counts the checks of a group by impact.
+type markdownCounts struct {
+	checks   int
+	blocking int
+	advisory int
+}
+
+// OutputMarkdown outputs diagnostic results as a Markdown document, for runbooks kept in Git:
+// a summary table, then a section per check group with the remediation of each finding.
+func OutputMarkdown(out io.Writer, results []check.CheckExecution, clusterVersion *string, targetVersion *string) error {
+	list := newResultList(results, clusterVersion, targetVersion)
+
+	var md strings.Builder
+
+	writeMarkdownReport(&md, list, results)
+
+	if _, err := io.WriteString(out, md.String()); err != nil {
+		return fmt.Errorf("writing Markdown output: %w", err)
+	}
+
+	return nil
+}
+
+// writeMarkdownReport writes the report of the results; the list provides the versions and
+// flavor. Executions of the same check against several objects (workload checks in lint mode)
+// are merged into a single check, as for JUnit.
+func writeMarkdownReport(md *strings.Builder, list *result.DiagnosticResultList, results []check.CheckExecution) {
+	clusterVersion := ptrValue(list.ClusterVersion)
+	targetVersion := ptrValue(list.TargetVersion)
+
+	if targetVersion != "" && targetVersion != clusterVersion {
+		md.WriteString("# Upgrade assessment\n\n")
+	} else {
+		md.WriteString("# Lint report\n\n")
+	}
+
+	if clusterVersion != "" {
+		_, _ = fmt.Fprintf(md, "- Cluster version: %s\n", clusterVersion)
+	}
+
+	if targetVersion != "" && targetVersion != clusterVersion {
+		_, _ = fmt.Fprintf(md, "- Target version: %s\n", targetVersion)
+	}
+
+	if list.Flavor != "" {
+		_, _ = fmt.Fprintf(md, "- Flavor: %s\n", list.Flavor)
+	}
+
+	groups := make(map[check.CheckGroup][]*markdownCheck)
+
+	for _, exec := range results {
+		if exec.Result == nil {
+			continue
+		}
+
+		group := exec.Check.Group()
+		id := exec.Check.ID()
+
+		i := slices.IndexFunc(groups[group], func(mc *markdownCheck) bool { return mc.id == id })
+		if i < 0 {
+			i = len(groups[group])
+			groups[group] = append(groups[group], &markdownCheck{id: id})
+		}
+
+		groups[group][i].add(exec.Result)
+	}
+
+	md.WriteString("\n## Summary\n\n")
+	md.WriteString("| Group | Checks | Blocking | Advisory | Passed |\n")
+	md.WriteString("|---|---:|---:|---:|---:|\n")
+
+	var total markdownCounts
+
+	for _, group := range check.CanonicalGroupOrder {
+		if len(groups[group]) == 0 {
+			continue
+		}
+
+		counts := countMarkdownChecks(groups[group])
+		total.checks += counts.checks
+		total.blocking += counts.blocking
+		total.advisory += counts.advisory
+
+		_, _ = fmt.Fprintf(md, "| [%s](#%s) | %d | %d | %d | %d |\n", group, group,
+			counts.checks, counts.blocking, counts.advisory, counts.checks-counts.blocking-counts.advisory)
+	}
+
+	_, _ = fmt.Fprintf(md, "| **Total** | %d | %d | %d | %d |\n",
+		total.checks, total.blocking, total.advisory, total.checks-total.blocking-total.advisory)
+
+	for _, group := range check.CanonicalGroupOrder {
+		if len(groups[group]) == 0 {
+			continue
+		}
+
+		writeMarkdownGroup(md, group, groups[group])
+	}
+}
+
+func writeMarkdownGroup(md *strings.Builder, group check.CheckGroup, checks []*markdownCheck) {
+	_, _ = fmt.Fprintf(md, "\n## %s\n\n", group)
+	md.WriteString("| Check | Impact | Message |\n")
+	md.WriteString("|---|---|---|\n")
+
+	for _, mc := range checks {
+		impact := string(mc.impact)
+		if impact == "" {
+			impact = "-"
+		}
+
+		_, _ = fmt.Fprintf(md, "| `%s` | %s | %s |\n", mc.id, impact, markdownCell(mc.message))
+	}
+
+	for _, mc := range checks {
+		if mc.impact == result.ImpactNone {
+			continue
+		}
+
+		_, _ = fmt.Fprintf(md, "\n### %s\n\n", mc.id)
+		_, _ = fmt.Fprintf(md, "**Impact:** %s\n", mc.impact)
+
+		for _, condition := range mc.conditions {
+			_, _ = fmt.Fprintf(md, "\n- **%s** (%s, %s): %s\n", condition.Type, condition.Impact, condition.Reason, condition.Message)
+
+			if condition.Remediation != "" {
+				_, _ = fmt.Fprintf(md, "\n  Remediation: %s\n", condition.Remediation)
+			}
+
+			if len(condition.RemediationCommands) > 0 {
+				fence := markdownFence(condition.RemediationCommands)
+
+				_, _ = fmt.Fprintf(md, "\n  %ssh\n", fence)
+
+				for _, command := range condition.RemediationCommands {
+					_, _ = fmt.Fprintf(md, "  %s\n", command)
+				}
+
+				_, _ = fmt.Fprintf(md, "  %s\n", fence)
+			}
+		}
+
+		if len(mc.links) > 0 {
+			md.WriteString("\nSee:\n\n")
+
+			for _, link := range mc.links {
+				_, _ = fmt.Fprintf(md, "- %s\n", link)
+			}
+		}
+
+		if len(mc.objects) > 0 {
+			_, _ = fmt.Fprintf(md, "\n<details>\n<summary>%d impacted objects</summary>\n\n", len(mc.objects))
+
+			for _, obj := range mc.objects {
+				name := obj.Name
+				if obj.Namespace != "" {
+					name = obj.Namespace + "/" + obj.Name
+				}
+
+				if obj.Kind != "" {
+					_, _ = fmt.Fprintf(md, "- `%s` (%s)\n", name, obj.Kind)
+				} else {
+					_, _ = fmt.Fprintf(md, "- `%s`\n", name)
+				}
+			}
+
+			md.WriteString("\n</details>\n")
+		}
+	}
+}
+
+// add merges a result of the check: its failing conditions, knowledge links and impacted
+// objects, raising the impact to the highest seen.
+func (mc *markdownCheck) add(dr *result.DiagnosticResult) {
+	for _, condition := range dr.Status.Conditions {
+		if condition.Status == metav1.ConditionTrue {
+			if mc.message == "" {
+				mc.message = condition.Message
+			}
+
+			continue
+		}
+
+		mc.conditions = append(mc.conditions, condition)
+
+		switch {
+		case condition.Impact == result.ImpactBlocking && mc.impact != result.ImpactBlocking:
+			mc.impact = result.ImpactBlocking
+			mc.message = condition.Message
+		case condition.Impact == result.ImpactAdvisory && mc.impact == result.ImpactNone:
+			mc.impact = result.ImpactAdvisory
+			mc.message = condition.Message
+		}
+	}
+
+	for _, link := range dr.Spec.KnowledgeLinks {
+		if !slices.Contains(mc.links, link) {
+			mc.links = append(mc.links, link)
+		}
+	}
+
+	mc.objects = append(mc.objects, dr.ImpactedObjects...)
+}
+
+func countMarkdownChecks(checks []*markdownCheck) markdownCounts {
+	counts := markdownCounts{checks: len(checks)}
+
+	for _, mc := range checks {
+		switch mc.impact {
+		case result.ImpactBlocking:
+			counts.blocking++
+		case result.ImpactAdvisory:
+			counts.advisory++
+		case result.ImpactNone:
+			// Passed
+		}
+	}
+
+	return counts
+}
+
+// markdownCell escapes text for a Markdown table cell.
+func markdownCell(text string) string {
+	if text == "" {
+		return "-"
+	}
+
+	return strings.NewReplacer("|", `\|`, "\r\n", "<br>", "\n", "<br>").Replace(text)
+}
+
+// markdownFence returns a code fence longer than any backtick run in the lines, so commands
+// containing backticks cannot close the block early.
+func markdownFence(lines []string) string {
+	longest := 0
+
+	for _, line := range lines {
+		run := 0
+
+		for _, r := range line {
+			if r != '`' {
+				run = 0
+
+				continue
+			}
+
+			run++
+			longest = max(longest, run)
+		}
+	}
+
+	return strings.Repeat("`", max(3, longest+1))
+}
+
+func ptrValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
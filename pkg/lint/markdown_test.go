@@ -0,0 +1,53 @@
+package lint_test
+
+import (
+	"bytes"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestOutputMarkdown(t *testing.T) {
+	g := NewWithT(t)
+
+	clusterVer := testClusterVersion
+	targetVer := testTargetVersion
+
+	executions := remediationExecutions()
+	executions[0].Result.Status.Conditions[0].Message = "CodeFlare | is enabled"
+	executions[0].Result.ImpactedObjects = []metav1.PartialObjectMetadata{
+		{TypeMeta: metav1.TypeMeta{Kind: "DataScienceCluster"}, ObjectMeta: metav1.ObjectMeta{Name: "default-dsc"}},
+	}
+
+	var buf bytes.Buffer
+	g.Expect(lint.OutputMarkdown(&buf, executions, &clusterVer, &targetVer)).To(Succeed())
+
+	md := buf.String()
+	g.Expect(md).To(HavePrefix("# Upgrade assessment\n"))
+	g.Expect(md).To(ContainSubstring("- Target version: " + testTargetVersion))
+	g.Expect(md).To(ContainSubstring("| [component](#component) | 2 | 1 | 1 | 0 |"))
+	g.Expect(md).To(ContainSubstring("| **Total** | 2 | 1 | 1 | 0 |"))
+	g.Expect(md).To(ContainSubstring("| `components.codeflare.removal` | blocking | CodeFlare \\| is enabled |"))
+	g.Expect(md).To(ContainSubstring("### components.codeflare.removal"))
+	g.Expect(md).To(ContainSubstring("  Remediation: Disable CodeFlare\n"))
+	g.Expect(md).To(ContainSubstring("  ```sh\n  " + testPatchCommand + "\n  ```\n"))
+	g.Expect(md).To(ContainSubstring("<summary>1 impacted objects</summary>\n\n- `default-dsc` (DataScienceCluster)\n"))
+}
+
+func TestOutputMarkdown_FenceLongerThanBackticks(t *testing.T) {
+	g := NewWithT(t)
+
+	executions := remediationExecutions()[:1]
+	executions[0].Result.Status.Conditions[0].RemediationCommands = []string{"echo ```"}
+
+	var buf bytes.Buffer
+	g.Expect(lint.OutputMarkdown(&buf, executions, nil, nil)).To(Succeed())
+
+	md := buf.String()
+	g.Expect(md).To(HavePrefix("# Lint report\n"))
+	g.Expect(md).To(ContainSubstring("  ````sh\n  echo ```\n  ````\n"))
+}
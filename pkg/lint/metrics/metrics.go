@@ -0,0 +1,219 @@
+// Package metrics exposes the results of lint runs as Prometheus metrics, written to a file for
+// the node_exporter textfile collector or pushed to a Pushgateway, so scheduled runs produce
+// trend data that can be alerted on.
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+)
+
+const (
+	// Job is the Pushgateway job the metrics are pushed under.
+	Job = "odh-lint"
+
+	// ImpactNone is the impact label of passing checks.
+	ImpactNone = "none"
+
+	// ImpactError is the impact label of checks that failed to execute.
+	ImpactError = "error"
+
+	contentType = "text/plain; version=0.0.4; charset=utf-8"
+	fileMode    = 0o644
+)
+
+// impacts are the values of the impact label of odh_lint_check_status. Every check exposes one series per impact, so series do not appear and disappear as the
+// status of a check changes.
+//
+//nolint:gochecknoglobals // Read-only label values
+var impacts = []string{string(result.ImpactBlocking), string(result.ImpactAdvisory), ImpactNone, ImpactError}
+
+// Run identifies the lint run the metrics are produced for.
+type Run struct {
+	CLIVersion     string
+	ClusterVersion string
+	TargetVersion  string
+	Timestamp      time.Time
+}
+
+// checkMetrics are the aggregated results of one check.
+type checkMetrics struct {
+	id      string
+	group   string
+	kind    string
+	impact  string
+	objects int
+}
+
+// Write writes the metrics of the executions in the Prometheus text exposition format.
+// Workload checks are executed once per workload: their executions are aggregated into one
+// status with the highest impact, and the sum of their impacted objects.
+func Write(w io.Writer, run Run, executions []check.CheckExecution) error {
+	var buf bytes.Buffer
+
+	buf.WriteString("# HELP odh_lint_info Information about the lint run.\n")
+	buf.WriteString("# TYPE odh_lint_info gauge\n")
+	_, _ = fmt.Fprintf(&buf, "odh_lint_info{cli_version=%s,cluster_version=%s,target_version=%s} 1\n",
+		quote(run.CLIVersion), quote(run.ClusterVersion), quote(run.TargetVersion))
+
+	buf.WriteString("# HELP odh_lint_last_run_timestamp_seconds Time the lint run completed.\n")
+	buf.WriteString("# TYPE odh_lint_last_run_timestamp_seconds gauge\n")
+	_, _ = fmt.Fprintf(&buf, "odh_lint_last_run_timestamp_seconds %d\n", run.Timestamp.Unix())
+
+	checks := aggregate(executions)
+
+	buf.WriteString("# HELP odh_lint_check_status Status of a lint check: 1 for its current impact, 0 otherwise.\n")
+	buf.WriteString("# TYPE odh_lint_check_status gauge\n")
+
+	for _, cm := range checks {
+		for _, impact := range impacts {
+			value := 0
+			if impact == cm.impact {
+				value = 1
+			}
+
+			_, _ = fmt.Fprintf(&buf, "odh_lint_check_status{check_id=%s,group=%s,kind=%s,impact=%s} %d\n",
+				quote(cm.id), quote(cm.group), quote(cm.kind), quote(impact), value)
+		}
+	}
+
+	buf.WriteString("# HELP odh_lint_impacted_objects_total Number of objects impacted by the findings of a lint check.\n")
+	buf.WriteString("# TYPE odh_lint_impacted_objects_total gauge\n")
+
+	for _, cm := range checks {
+		_, _ = fmt.Fprintf(&buf, "odh_lint_impacted_objects_total{check_id=%s,group=%s,kind=%s} %d\n",
+			quote(cm.id), quote(cm.group), quote(cm.kind), cm.objects)
+	}
+
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		return fmt.Errorf("writing metrics: %w", err)
+	}
+
+	return nil
+}
+
+// WriteFile writes the metrics to path. The file is replaced atomically, so the textfile
+// collector never reads a partially written file.
+func WriteFile(path string, run Run, executions []check.CheckExecution) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return fmt.Errorf("creating metrics file: %w", err)
+	}
+
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if err := Write(tmp, run, executions); err != nil {
+		_ = tmp.Close()
+
+		return err
+	}
+
+	if err := tmp.Chmod(fileMode); err != nil {
+		_ = tmp.Close()
+
+		return fmt.Errorf("setting metrics file mode: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("closing metrics file: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("replacing %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// Push replaces the metrics of the Job group on the Pushgateway at gatewayURL.
+func Push(ctx context.Context, httpClient *http.Client, gatewayURL string, run Run, executions []check.CheckExecution) error {
+	endpoint, err := url.JoinPath(gatewayURL, "metrics", "job", Job)
+	if err != nil {
+		return fmt.Errorf("invalid Pushgateway URL %s: %w", gatewayURL, err)
+	}
+
+	var body bytes.Buffer
+	if err := Write(&body, run, executions); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, endpoint, &body)
+	if err != nil {
+		return fmt.Errorf("creating request for %s: %w", endpoint, err)
+	}
+
+	req.Header.Set("Content-Type", contentType)
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("pushing metrics to %s: %w", endpoint, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("pushing metrics to %s: %s", endpoint, resp.Status)
+	}
+
+	return nil
+}
+
+// aggregate merges the executions of each check, sorted by check ID.
+func aggregate(executions []check.CheckExecution) []*checkMetrics {
+	var checks []*checkMetrics
+
+	for _, exec := range executions {
+		i := slices.IndexFunc(checks, func(cm *checkMetrics) bool { return cm.id == exec.Check.ID() })
+		if i < 0 {
+			i = len(checks)
+			checks = append(checks, &checkMetrics{
+				id:     exec.Check.ID(),
+				group:  string(exec.Check.Group()),
+				kind:   exec.Check.CheckKind(),
+				impact: ImpactNone,
+			})
+		}
+
+		cm := checks[i]
+
+		// A finding in another execution of the check takes precedence over an error
+		if exec.Error != nil || exec.Result == nil {
+			if cm.impact == ImpactNone {
+				cm.impact = ImpactError
+			}
+
+			continue
+		}
+
+		impact := exec.Result.GetImpact()
+		if impact == nil || *impact == string(result.ImpactNone) {
+			continue
+		}
+
+		cm.objects += len(exec.Result.ImpactedObjects)
+
+		if cm.impact == ImpactNone || cm.impact == ImpactError || *impact == string(result.ImpactBlocking) {
+			cm.impact = *impact
+		}
+	}
+
+	slices.SortFunc(checks, func(a, b *checkMetrics) int { return strings.Compare(a.id, b.id) })
+
+	return checks
+}
+
+// quote returns a label value quoted and escaped for the text exposition format.
+func quote(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value) + `"`
+}
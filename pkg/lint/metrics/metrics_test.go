@@ -0,0 +1,170 @@
+package metrics_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/metrics"
+
+	. "github.com/onsi/gomega"
+)
+
+// stubCheck is a check with a fixed ID, group and kind.
+type stubCheck struct {
+	check.BaseCheck
+}
+
+func (c *stubCheck) CanApply(_ context.Context, _ check.Target) (bool, error) {
+	return true, nil
+}
+
+func (c *stubCheck) Validate(_ context.Context, _ check.Target) (*result.DiagnosticResult, error) {
+	return c.NewResult(), nil
+}
+
+func newStubCheck(id string) *stubCheck {
+	return &stubCheck{BaseCheck: check.BaseCheck{CheckGroup: check.GroupWorkload, Kind: "notebook", CheckID: id}}
+}
+
+func execution(id string, impact result.Impact, objects ...string) check.CheckExecution {
+	chk := newStubCheck(id)
+
+	status := metav1.ConditionFalse
+	if impact == result.ImpactNone {
+		status = metav1.ConditionTrue
+	}
+
+	dr := chk.NewResult()
+	dr.Status.Conditions = []result.Condition{
+		check.NewCondition(check.ConditionTypeValidated, status, check.WithReason(check.ReasonRequirementsMet),
+			check.WithImpact(impact)),
+	}
+
+	for _, name := range objects {
+		dr.ImpactedObjects = append(dr.ImpactedObjects, metav1.PartialObjectMetadata{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: name},
+		})
+	}
+
+	return check.CheckExecution{Check: chk, Result: dr}
+}
+
+func testRun() metrics.Run {
+	return metrics.Run{
+		CLIVersion:     "1.2.0",
+		ClusterVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+		Timestamp:      time.Unix(1700000000, 0),
+	}
+}
+
+func TestWrite(t *testing.T) {
+	g := NewWithT(t)
+
+	var buf bytes.Buffer
+	g.Expect(metrics.Write(&buf, testRun(), []check.CheckExecution{
+		execution("workloads.notebook.impacted", result.ImpactAdvisory, "wb-1"),
+		execution("workloads.notebook.impacted", result.ImpactBlocking, "wb-2", "wb-3"),
+		execution("workloads.notebook.impacted", result.ImpactNone),
+		execution("workloads.notebook.accelerator", result.ImpactNone),
+		{Check: newStubCheck("workloads.notebook.image"), Error: errors.New("boom")},
+	})).To(Succeed())
+
+	out := buf.String()
+	g.Expect(out).To(ContainSubstring(`odh_lint_info{cli_version="1.2.0",cluster_version="2.25.0",target_version="3.0.0"} 1`))
+	g.Expect(out).To(ContainSubstring("odh_lint_last_run_timestamp_seconds 1700000000\n"))
+	g.Expect(out).To(ContainSubstring("# TYPE odh_lint_check_status gauge\n"))
+
+	const impacted = `check_id="workloads.notebook.impacted",group="workload",kind="notebook"`
+	g.Expect(out).To(ContainSubstring(`odh_lint_check_status{` + impacted + `,impact="blocking"} 1`))
+	g.Expect(out).To(ContainSubstring(`odh_lint_check_status{` + impacted + `,impact="advisory"} 0`))
+	g.Expect(out).To(ContainSubstring(`odh_lint_check_status{` + impacted + `,impact="none"} 0`))
+	g.Expect(out).To(ContainSubstring(`odh_lint_impacted_objects_total{` + impacted + `} 3`))
+
+	g.Expect(out).To(ContainSubstring(`odh_lint_check_status{check_id="workloads.notebook.accelerator",group="workload",kind="notebook",impact="none"} 1`))
+	g.Expect(out).To(ContainSubstring(`odh_lint_check_status{check_id="workloads.notebook.image",group="workload",kind="notebook",impact="error"} 1`))
+
+	// Checks are sorted by ID
+	g.Expect(out).To(MatchRegexp(`(?s)accelerator.*image.*impacted.*# HELP odh_lint_impacted_objects_total`))
+}
+
+func TestWrite_EscapesLabelValues(t *testing.T) {
+	g := NewWithT(t)
+
+	run := testRun()
+	run.ClusterVersion = "2.25\"\n\\"
+
+	var buf bytes.Buffer
+	g.Expect(metrics.Write(&buf, run, nil)).To(Succeed())
+	g.Expect(buf.String()).To(ContainSubstring(`cluster_version="2.25\"\n\\"`))
+}
+
+func TestWriteFile(t *testing.T) {
+	g := NewWithT(t)
+
+	path := filepath.Join(t.TempDir(), "odh_lint.prom")
+	g.Expect(os.WriteFile(path, []byte("stale"), 0o600)).To(Succeed())
+
+	g.Expect(metrics.WriteFile(path, testRun(), []check.CheckExecution{
+		execution("workloads.notebook.impacted", result.ImpactBlocking, "wb-1"),
+	})).To(Succeed())
+
+	data, err := os.ReadFile(path)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(data)).To(HavePrefix("# HELP odh_lint_info"))
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(entries).To(HaveLen(1))
+}
+
+func TestPush(t *testing.T) {
+	g := NewWithT(t)
+
+	var (
+		path string
+		body []byte
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		g.Expect(r.Method).To(Equal(http.MethodPut))
+		g.Expect(r.Header.Get("Content-Type")).To(HavePrefix("text/plain; version=0.0.4"))
+
+		path = r.URL.Path
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	g.Expect(metrics.Push(t.Context(), server.Client(), server.URL+"/", testRun(), []check.CheckExecution{
+		execution("workloads.notebook.impacted", result.ImpactBlocking, "wb-1"),
+	})).To(Succeed())
+
+	g.Expect(path).To(Equal("/metrics/job/odh-lint"))
+	g.Expect(string(body)).To(ContainSubstring("odh_lint_impacted_objects_total"))
+}
+
+func TestPush_ReportsHTTPErrors(t *testing.T) {
+	g := NewWithT(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	err := metrics.Push(t.Context(), server.Client(), server.URL, testRun(), nil)
+
+	g.Expect(err).To(MatchError(ContainSubstring("503")))
+}
@@ -0,0 +1,390 @@
+package lint
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/printer/table"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+)
+
+// objectKinds are the user workload kinds that can be analyzed with lint object. Types the
+// workload checks only read as dependencies (ImageStreams, ServingRuntimes, profiles) are
+// not included: scoping a check to one of them would hide the rest from it.
+//
+//nolint:gochecknoglobals // Static list of the workload kinds analyzed per object
+var objectKinds = []resources.ResourceType{
+	resources.Notebook,
+	resources.InferenceService,
+	resources.RayCluster,
+	resources.PyTorchJob,
+	resources.AppWrapper,
+	resources.DataSciencePipelinesApplicationV1,
+	resources.DataSciencePipelinesApplicationV1Alpha1,
+	resources.GuardrailsOrchestrator,
+	resources.LlamaStackDistribution,
+}
+
+// ObjectRef identifies a single workload object as kind/namespace/name.
+type ObjectRef struct {
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+// ParseObjectRef parses a kind/namespace/name reference, e.g. notebook/my-ns/my-workbench.
+func ParseObjectRef(ref string) (ObjectRef, error) {
+	parts := strings.Split(ref, "/")
+	if len(parts) != 3 || slices.Contains(parts, "") {
+		return ObjectRef{}, fmt.Errorf("invalid object %q: must be kind/namespace/name (e.g. notebook/my-ns/my-workbench)", ref)
+	}
+
+	return ObjectRef{Kind: parts[0], Namespace: parts[1], Name: parts[2]}, nil
+}
+
+// String returns the reference in kind/namespace/name form.
+func (r ObjectRef) String() string {
+	return r.Kind + "/" + r.Namespace + "/" + r.Name
+}
+
+// ResolveObjectKind returns the resource types of the workload kind named by kind, one per
+// served version in order of preference. The kind is matched case-insensitively against the
+// kind and the plural resource name, optionally qualified with the API group (e.g. notebook,
+// Notebooks or notebooks.kubeflow.org).
+func ResolveObjectKind(kind string) ([]resources.ResourceType, error) {
+	var matched []resources.ResourceType
+
+	for _, rt := range objectKinds {
+		names := []string{rt.Kind, rt.Resource, rt.Kind + "." + rt.Group, rt.Resource + "." + rt.Group}
+		if slices.ContainsFunc(names, func(name string) bool { return strings.EqualFold(name, kind) }) {
+			matched = append(matched, rt)
+		}
+	}
+
+	if len(matched) == 0 {
+		supported := make([]string, 0, len(objectKinds))
+
+		for _, rt := range objectKinds {
+			if name := strings.ToLower(rt.Kind); !slices.Contains(supported, name) {
+				supported = append(supported, name)
+			}
+		}
+
+		return nil, fmt.Errorf("unsupported kind %q (supported: %s)", kind, strings.Join(supported, ", "))
+	}
+
+	for _, rt := range matched[1:] {
+		if rt.GVK().GroupKind() != matched[0].GVK().GroupKind() {
+			return nil, fmt.Errorf("ambiguous kind %q: qualify it with the API group (e.g. %s.%s)",
+				kind, strings.ToLower(rt.Resource), rt.Group)
+		}
+	}
+
+	return matched, nil
+}
+
+// RouteObjectChecks returns the workload checks that read any of the given resource types,
+// i.e. the checks that can report findings for an object of that kind.
+func RouteObjectChecks(checks []check.Check, types []resources.ResourceType) []check.Check {
+	var routed []check.Check
+
+	for _, chk := range checks {
+		describer, ok := chk.(check.GraphDescriber)
+		if !ok || chk.Group() != check.GroupWorkload {
+			continue
+		}
+
+		reads := slices.ContainsFunc(describer.RequiredResources(), func(rt resources.ResourceType) bool {
+			return slices.ContainsFunc(types, func(t resources.ResourceType) bool {
+				return rt.GVK().GroupKind() == t.GVK().GroupKind()
+			})
+		})
+
+		if reads {
+			routed = append(routed, chk)
+		}
+	}
+
+	return routed
+}
+
+// ObjectStatus is the outcome of one check for the analyzed object.
+type ObjectStatus string
+
+const (
+	ObjectStatusImpacted ObjectStatus = "impacted"
+	ObjectStatusOK       ObjectStatus = "ok"
+	ObjectStatusSkipped  ObjectStatus = "skipped"
+	ObjectStatusError    ObjectStatus = "error"
+)
+
+// ObjectCheckResult is the outcome of one routed check for the analyzed object.
+type ObjectCheckResult struct {
+	CheckID string       `json:"checkID" yaml:"checkID"`
+	Name    string       `json:"name"    yaml:"name"`
+	Status  ObjectStatus `json:"status"  yaml:"status"`
+
+	// Impact is the impact of the findings for the object; empty unless impacted.
+	Impact result.Impact `json:"impact,omitempty" yaml:"impact,omitempty"`
+
+	// Message is the finding for an impacted object, the error, or why the check was skipped.
+	Message string `json:"message,omitempty" yaml:"message,omitempty"`
+
+	Remediation    string   `json:"remediation,omitempty"    yaml:"remediation,omitempty"`
+	KnowledgeLinks []string `json:"knowledgeLinks,omitempty" yaml:"knowledgeLinks,omitempty"`
+}
+
+// ObjectReport is the object-centric report of lint object: the outcome of every check
+// routed to the object.
+type ObjectReport struct {
+	APIVersion     string `json:"apiVersion"               yaml:"apiVersion"`
+	Kind           string `json:"kind"                     yaml:"kind"`
+	Namespace      string `json:"namespace"                yaml:"namespace"`
+	Name           string `json:"name"                     yaml:"name"`
+	CurrentVersion string `json:"currentVersion,omitempty" yaml:"currentVersion,omitempty"`
+	TargetVersion  string `json:"targetVersion,omitempty"  yaml:"targetVersion,omitempty"`
+
+	// Impact is the highest impact of the findings for the object.
+	Impact result.Impact `json:"impact,omitempty" yaml:"impact,omitempty"`
+
+	Checks []ObjectCheckResult `json:"checks" yaml:"checks"`
+}
+
+// BuildObjectReport builds the report for obj from the executions of the routed checks.
+// Routed checks without an execution were not applicable to the target. A failing result
+// impacts the object when it lists the object among its impacted objects, or lists none:
+// the checks were run with a reader scoped to the object, so such findings are about it.
+func BuildObjectReport(
+	obj *unstructured.Unstructured,
+	routed []check.Check,
+	executions []check.CheckExecution,
+	target check.Target,
+) ObjectReport {
+	report := ObjectReport{
+		APIVersion:     obj.GetAPIVersion(),
+		Kind:           obj.GetKind(),
+		Namespace:      obj.GetNamespace(),
+		Name:           obj.GetName(),
+		CurrentVersion: formatVersion(target.CurrentVersion),
+		TargetVersion:  formatVersion(target.TargetVersion),
+		Checks:         make([]ObjectCheckResult, 0, len(routed)),
+	}
+
+	byID := make(map[string]check.CheckExecution, len(executions))
+	for _, exec := range executions {
+		byID[exec.Check.ID()] = exec
+	}
+
+	for _, chk := range routed {
+		entry := ObjectCheckResult{CheckID: chk.ID(), Name: chk.Name()}
+
+		exec, ran := byID[chk.ID()]
+
+		switch {
+		case !ran && !check.AppliesToFlavor(chk, target.Flavor):
+			entry.Status = ObjectStatusSkipped
+			entry.Message = notApplicableFlavorReason(chk, target)
+		case !ran:
+			entry.Status = ObjectStatusSkipped
+			entry.Message = notApplicableReason(chk, target)
+		case exec.Error != nil:
+			entry.Status = ObjectStatusError
+			entry.Message = exec.Error.Error()
+		case impactsObject(exec.Result, obj):
+			entry.Status = ObjectStatusImpacted
+			entry.Impact = result.Impact(*exec.Result.GetImpact())
+			entry.Message = exec.Result.GetMessage()
+			entry.Remediation = exec.Result.GetRemediation()
+			entry.KnowledgeLinks = exec.Result.Spec.KnowledgeLinks
+
+			if report.Impact != result.ImpactBlocking {
+				report.Impact = entry.Impact
+			}
+		default:
+			entry.Status = ObjectStatusOK
+		}
+
+		report.Checks = append(report.Checks, entry)
+	}
+
+	return report
+}
+
+// impactsObject returns whether a result has a blocking or advisory finding for obj.
+func impactsObject(dr *result.DiagnosticResult, obj *unstructured.Unstructured) bool {
+	if dr == nil {
+		return false
+	}
+
+	impact := dr.GetImpact()
+	if impact == nil || result.Impact(*impact) == result.ImpactNone {
+		return false
+	}
+
+	if len(dr.ImpactedObjects) == 0 {
+		return true
+	}
+
+	return slices.ContainsFunc(dr.ImpactedObjects, func(o metav1.PartialObjectMetadata) bool {
+		return isObject(o.GroupVersionKind().GroupKind(), o.Namespace, o.Name, obj)
+	})
+}
+
+// isObject returns whether the group kind, namespace and name identify obj. The version is
+// ignored, as checks may report an object under any served version.
+func isObject(gk schema.GroupKind, namespace string, name string, obj *unstructured.Unstructured) bool {
+	return gk == obj.GroupVersionKind().GroupKind() && namespace == obj.GetNamespace() && name == obj.GetName()
+}
+
+// objectRow is a single row of the object report table.
+type objectRow struct {
+	Check   string `mapstructure:"CHECK"`
+	Status  string `mapstructure:"STATUS"`
+	Impact  string `mapstructure:"IMPACT"`
+	Message string `mapstructure:"MESSAGE"`
+}
+
+// outputObjectReportTable renders the object report as a table, followed by the remediation
+// of the findings and a summary line.
+func outputObjectReportTable(out io.Writer, report ObjectReport) error {
+	_, _ = fmt.Fprintf(out, "%s %s/%s (%s)\n", report.Kind, report.Namespace, report.Name, report.APIVersion)
+
+	if report.CurrentVersion == report.TargetVersion {
+		_, _ = fmt.Fprintf(out, "Version: %s\n\n", report.CurrentVersion)
+	} else {
+		_, _ = fmt.Fprintf(out, "Upgrade: %s → %s\n\n", report.CurrentVersion, report.TargetVersion)
+	}
+
+	if len(report.Checks) == 0 {
+		_, _ = fmt.Fprintln(out, "No check applies to this kind of object.")
+
+		return nil
+	}
+
+	renderer := table.NewRenderer(
+		table.WithWriter[objectRow](out),
+		table.WithHeaders[objectRow]("CHECK", "STATUS", "IMPACT", "MESSAGE"),
+		table.WithTableOptions[objectRow](table.DefaultTableOptions...),
+	)
+
+	counts := make(map[ObjectStatus]int)
+	impacts := make(map[result.Impact]int)
+
+	for _, entry := range report.Checks {
+		counts[entry.Status]++
+		impacts[entry.Impact]++
+
+		row := objectRow{
+			Check:   entry.CheckID,
+			Status:  string(entry.Status),
+			Impact:  string(entry.Impact),
+			Message: entry.Message,
+		}
+
+		if row.Impact == "" {
+			row.Impact = "-"
+		}
+
+		if row.Message == "" {
+			row.Message = "-"
+		}
+
+		if err := renderer.Append(row); err != nil {
+			return fmt.Errorf("appending object row: %w", err)
+		}
+	}
+
+	if err := renderer.Render(); err != nil {
+		return fmt.Errorf("rendering object report: %w", err)
+	}
+
+	if counts[ObjectStatusImpacted] > 0 {
+		_, _ = fmt.Fprintln(out, "\nRemediation:")
+
+		for _, entry := range report.Checks {
+			if entry.Status != ObjectStatusImpacted {
+				continue
+			}
+
+			_, _ = fmt.Fprintf(out, "  %s: %s\n", entry.CheckID, entry.Remediation)
+
+			for _, link := range entry.KnowledgeLinks {
+				_, _ = fmt.Fprintf(out, "    %s\n", link)
+			}
+		}
+	}
+
+	_, _ = fmt.Fprintf(out, "\nFindings: %d blocking, %d advisory (%d checks ok, %d skipped, %d errors)\n",
+		impacts[result.ImpactBlocking], impacts[result.ImpactAdvisory],
+		counts[ObjectStatusOK], counts[ObjectStatusSkipped], counts[ObjectStatusError])
+
+	return nil
+}
+
+// objectScopedReader restricts the listings of the analyzed object's kind to the object, so
+// routed checks only evaluate it. Reads of other kinds, such as the object's dependencies,
+// are passed through.
+type objectScopedReader struct {
+	client.Reader
+
+	object *unstructured.Unstructured
+}
+
+var _ client.Reader = (*objectScopedReader)(nil)
+
+func (r *objectScopedReader) List(
+	ctx context.Context,
+	resourceType resources.ResourceType,
+	opts ...client.ListResourcesOption,
+) ([]*unstructured.Unstructured, error) {
+	items, err := r.Reader.List(ctx, resourceType, opts...)
+	if err != nil || resourceType.GVK().GroupKind() != r.object.GroupVersionKind().GroupKind() {
+		return items, err //nolint:wrapcheck // Passed through unchanged
+	}
+
+	return slices.DeleteFunc(items, func(item *unstructured.Unstructured) bool {
+		return item.GetNamespace() != r.object.GetNamespace() || item.GetName() != r.object.GetName()
+	}), nil
+}
+
+func (r *objectScopedReader) ListMetadata(
+	ctx context.Context,
+	resourceType resources.ResourceType,
+	opts ...client.ListResourcesOption,
+) ([]*metav1.PartialObjectMetadata, error) {
+	items, err := r.Reader.ListMetadata(ctx, resourceType, opts...)
+	if err != nil || resourceType.GVK().GroupKind() != r.object.GroupVersionKind().GroupKind() {
+		return items, err //nolint:wrapcheck // Passed through unchanged
+	}
+
+	return slices.DeleteFunc(items, func(item *metav1.PartialObjectMetadata) bool {
+		return item.Namespace != r.object.GetNamespace() || item.Name != r.object.GetName()
+	}), nil
+}
+
+func (r *objectScopedReader) ListResources(
+	ctx context.Context,
+	gvr schema.GroupVersionResource,
+	opts ...client.ListResourcesOption,
+) ([]*unstructured.Unstructured, error) {
+	items, err := r.Reader.ListResources(ctx, gvr, opts...)
+	if err != nil {
+		return items, err //nolint:wrapcheck // Passed through unchanged
+	}
+
+	return slices.DeleteFunc(items, func(item *unstructured.Unstructured) bool {
+		gk := item.GroupVersionKind().GroupKind()
+
+		return gk == r.object.GroupVersionKind().GroupKind() && !isObject(gk, item.GetNamespace(), item.GetName(), r.object)
+	}), nil
+}
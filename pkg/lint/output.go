@@ -0,0 +1,184 @@
+package lint
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+)
+
+// outputFileMode is the permission mode of result files written by --output format=path.
+const outputFileMode = 0o644
+
+// writeOutputs renders the results to every configured output destination, so a single
+// run can serve both the terminal and CI artifacts (e.g. -o table -o json=results.json).
+// renderTable renders the mode-specific table view, which needs command state.
+func (c *Command) writeOutputs(
+	results []check.CheckExecution,
+	clusterVersion *string,
+	targetVersion *string,
+	renderTable func(out io.Writer) error,
+) error {
+	destinations, err := c.OutputDestinations()
+	if err != nil {
+		return err
+	}
+
+	skipped := c.skippedChecks(results)
+
+	for _, dest := range destinations {
+		render := func(out io.Writer) error {
+			return renderOutput(out, dest.Format, results, skipped, clusterVersion, targetVersion, renderTable)
+		}
+
+		if dest.Path == "" {
+			if err := render(c.IO.Out()); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if err := writeOutputFile(dest.Path, render); err != nil {
+			return fmt.Errorf("writing %s output: %w", dest.Format, err)
+		}
+
+		c.IO.Errorf("Wrote %s results to %s", dest.Format, dest.Path)
+	}
+
+	return nil
+}
+
+// saveResults writes the results as JSON to the --save path, for a later --diff.
+func (c *Command) saveResults(
+	results []check.CheckExecution,
+	clusterVersion *string,
+	targetVersion *string,
+) error {
+	if c.Save == "" {
+		return nil
+	}
+
+	err := writeOutputFile(c.Save, func(out io.Writer) error {
+		return OutputJSON(out, results, clusterVersion, targetVersion)
+	})
+	if err != nil {
+		return fmt.Errorf("saving results: %w", err)
+	}
+
+	c.IO.Errorf("Saved results to %s", c.Save)
+
+	return nil
+}
+
+// writeDiff renders, to every configured output destination, the checks whose results changed
+// since the --diff results instead of all results.
+func (c *Command) writeDiff(results []check.CheckExecution) error {
+	destinations, err := c.OutputDestinations()
+	if err != nil {
+		return err
+	}
+
+	changes := DiffResults(c.previous, results)
+
+	// Watch runs without changes print nothing, so only the transitions are shown
+	if c.Watch && len(changes) == 0 {
+		return nil
+	}
+
+	for _, dest := range destinations {
+		render := func(out io.Writer) error {
+			if c.Watch && dest.Format == OutputFormatTable {
+				_, _ = fmt.Fprintf(out, "\n%s: %d check results changed\n", time.Now().Format(time.RFC3339), len(changes))
+			}
+
+			return OutputDiff(out, dest.Format, changes)
+		}
+
+		if dest.Path == "" {
+			if err := render(c.IO.Out()); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if err := writeOutputFile(dest.Path, render); err != nil {
+			return fmt.Errorf("writing %s diff: %w", dest.Format, err)
+		}
+
+		c.IO.Errorf("Wrote %s diff to %s", dest.Format, dest.Path)
+	}
+
+	return nil
+}
+
+// renderOutput renders results in a single format.
+func renderOutput(
+	out io.Writer,
+	format OutputFormat,
+	results []check.CheckExecution,
+	skipped []SkippedCheck,
+	clusterVersion *string,
+	targetVersion *string,
+	renderTable func(out io.Writer) error,
+) error {
+	switch format {
+	case OutputFormatTable:
+		return renderTable(out)
+	case OutputFormatJSON:
+		if err := OutputJSON(out, results, clusterVersion, targetVersion); err != nil {
+			return fmt.Errorf("outputting JSON: %w", err)
+		}
+
+		return nil
+	case OutputFormatYAML:
+		if err := OutputYAML(out, results, clusterVersion, targetVersion); err != nil {
+			return fmt.Errorf("outputting YAML: %w", err)
+		}
+
+		return nil
+	case OutputFormatJUnit:
+		if err := OutputJUnit(out, results, skipped, clusterVersion, targetVersion); err != nil {
+			return fmt.Errorf("outputting JUnit: %w", err)
+		}
+
+		return nil
+	case OutputFormatHTML:
+		if err := OutputHTML(out, results, skipped, clusterVersion, targetVersion); err != nil {
+			return fmt.Errorf("outputting HTML: %w", err)
+		}
+
+		return nil
+	case OutputFormatMarkdown:
+		if err := OutputMarkdown(out, results, clusterVersion, targetVersion); err != nil {
+			return fmt.Errorf("outputting Markdown: %w", err)
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unsupported output format: %s", format)
+	}
+}
+
+// writeOutputFile creates (or truncates) path and renders into it.
+func writeOutputFile(path string, render func(out io.Writer) error) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, outputFileMode)
+	if err != nil {
+		return fmt.Errorf("creating %s: %w", path, err)
+	}
+
+	if err := render(f); err != nil {
+		_ = f.Close()
+
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("closing %s: %w", path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package lint_test
+
+import (
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/blang/semver/v4"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	codeflareworkloads "github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/codeflare"
+	kserveworkloads "github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/kserve"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/notebook"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/ray"
+	trainingoperatorworkloads "github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/trainingoperator"
+)
+
+// benchmarkResults returns the results of the impacted workload checks against a benchmark
+// cluster, each impacted object of the cluster listed by one of them.
+func benchmarkResults(b *testing.B, workloads int) []check.CheckExecution {
+	b.Helper()
+
+	registry := check.NewRegistry()
+	registry.MustRegister(codeflareworkloads.NewImpactedWorkloadsCheck())
+	registry.MustRegister(kserveworkloads.NewImpactedWorkloadsCheck())
+	registry.MustRegister(notebook.NewImpactedWorkloadsCheck())
+	registry.MustRegister(ray.NewImpactedWorkloadsCheck())
+	registry.MustRegister(trainingoperatorworkloads.NewImpactedWorkloadsCheck())
+
+	cluster, targetVersion := benchmarkCluster(b, workloads)
+
+	currentVer := semver.MustParse("2.25.0")
+	targetVer := semver.MustParse(targetVersion)
+
+	results := check.NewExecutor(registry, nil).ExecuteAll(b.Context(), check.Target{
+		Client:         cluster,
+		CurrentVersion: &currentVer,
+		TargetVersion:  &targetVer,
+	})
+
+	for _, exec := range results {
+		if exec.Error != nil {
+			b.Fatalf("check %s failed: %v", exec.Check.ID(), exec.Error)
+		}
+	}
+
+	return results
+}
+
+// BenchmarkOutput benchmarks rendering the results of 1k and 10k impacted workloads in each
+// output format.
+func BenchmarkOutput(b *testing.B) {
+	clusterVersion := "2.25.0"
+	targetVersion := "3.0.0"
+
+	formats := []struct {
+		name   string
+		render func(out io.Writer, results []check.CheckExecution) error
+	}{
+		{"table", func(out io.Writer, results []check.CheckExecution) error {
+			return lint.OutputTable(out, results, lint.TableOutputOptions{ShowImpactedObjects: true})
+		}},
+		{"json", func(out io.Writer, results []check.CheckExecution) error {
+			return lint.OutputJSON(out, results, &clusterVersion, &targetVersion)
+		}},
+		{"yaml", func(out io.Writer, results []check.CheckExecution) error {
+			return lint.OutputYAML(out, results, &clusterVersion, &targetVersion)
+		}},
+		{"junit", func(out io.Writer, results []check.CheckExecution) error {
+			return lint.OutputJUnit(out, results, nil, &clusterVersion, &targetVersion)
+		}},
+	}
+
+	for _, size := range benchmarkSizes {
+		results := benchmarkResults(b, size)
+
+		for _, format := range formats {
+			b.Run(fmt.Sprintf("%s/%dk", format.name, size/1000), func(b *testing.B) {
+				for b.Loop() {
+					if err := format.render(io.Discard, results); err != nil {
+						b.Fatalf("rendering %s failed: %v", format.name, err)
+					}
+				}
+			})
+		}
+	}
+}
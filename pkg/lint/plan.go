@@ -0,0 +1,237 @@
+package lint
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/blang/semver/v4"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	printerjson "github.com/opendatahub-io/odh-cli/pkg/printer/json"
+	"github.com/opendatahub-io/odh-cli/pkg/printer/table"
+	printeryaml "github.com/opendatahub-io/odh-cli/pkg/printer/yaml"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+// PlanAction is what a lint run would do with a check.
+type PlanAction string
+
+const (
+	PlanActionRun   PlanAction = "run"
+	PlanActionSkip  PlanAction = "skip"
+	PlanActionError PlanAction = "error"
+)
+
+// PlanEntry is the planned outcome of one registered check.
+type PlanEntry struct {
+	CheckID string     `json:"checkID" yaml:"checkID"`
+	Group   string     `json:"group" yaml:"group"`
+	Name    string     `json:"name" yaml:"name"`
+	Action  PlanAction `json:"action" yaml:"action"`
+	Reason  string     `json:"reason,omitempty" yaml:"reason,omitempty"`
+}
+
+// BuildPlan resolves the selectors and evaluates CanApply for every registered check
+// against the target, without executing Validate. Checks are listed in canonical group
+// order, then by ID.
+func BuildPlan(
+	ctx context.Context,
+	registry *check.CheckRegistry,
+	target check.Target,
+	selectors []string,
+) ([]PlanEntry, error) {
+	var plan []PlanEntry
+
+	for _, group := range check.CanonicalGroupOrder {
+		selected, err := registry.ListByPatterns(selectors, group)
+		if err != nil {
+			return nil, fmt.Errorf("selecting checks: %w", err)
+		}
+
+		selectedIDs := make(map[string]bool, len(selected))
+		for _, chk := range selected {
+			selectedIDs[chk.ID()] = true
+		}
+
+		checks := registry.ListByGroup(group)
+		sort.Slice(checks, func(i, j int) bool { return checks[i].ID() < checks[j].ID() })
+
+		for _, chk := range checks {
+			if err := check.CheckContextError(ctx); err != nil {
+				return nil, err //nolint:wrapcheck // Already contextualized
+			}
+
+			entry := PlanEntry{
+				CheckID: chk.ID(),
+				Group:   string(chk.Group()),
+				Name:    chk.Name(),
+			}
+
+			if !selectedIDs[chk.ID()] {
+				entry.Action = PlanActionSkip
+				entry.Reason = "not selected by --checks"
+				plan = append(plan, entry)
+
+				continue
+			}
+
+			if !check.AppliesToFlavor(chk, target.Flavor) {
+				entry.Action = PlanActionSkip
+				entry.Reason = notApplicableFlavorReason(chk, target)
+				plan = append(plan, entry)
+
+				continue
+			}
+
+			switch canApply, err := chk.CanApply(ctx, target); {
+			case err != nil:
+				entry.Action = PlanActionError
+				entry.Reason = fmt.Sprintf("applicability check failed: %v", err)
+			case !canApply:
+				entry.Action = PlanActionSkip
+				entry.Reason = notApplicableReason(chk, target)
+			default:
+				entry.Action = PlanActionRun
+			}
+
+			plan = append(plan, entry)
+		}
+	}
+
+	return plan, nil
+}
+
+// reasonNotApplicableToCluster is the reason given for checks rejected on cluster state.
+const reasonNotApplicableToCluster = "not applicable to the cluster configuration"
+
+// notApplicableReason explains why CanApply rejected a check. A check whose version gate
+// holds for the target was rejected on cluster state, such as a component not being Managed.
+func notApplicableReason(chk check.Check, target check.Target) string {
+	gate := ""
+	if describer, ok := chk.(check.GraphDescriber); ok {
+		gate = describer.VersionGate()
+	}
+
+	if gate != "" && !versionGateHolds(gate, target) {
+		return fmt.Sprintf("version gate not met: %s (current %s, target %s)",
+			gate, formatVersion(target.CurrentVersion), formatVersion(target.TargetVersion))
+	}
+
+	return reasonNotApplicableToCluster
+}
+
+// notApplicableFlavorReason explains why a check restricted to some management flavors does
+// not run for the target.
+func notApplicableFlavorReason(chk check.Check, target check.Target) string {
+	var flavors []string
+
+	if gated, ok := chk.(check.FlavorGated); ok {
+		for _, flavor := range gated.Flavors() {
+			flavors = append(flavors, string(flavor))
+		}
+	}
+
+	current := string(target.Flavor)
+	if current == "" {
+		current = "unknown"
+	}
+
+	return fmt.Sprintf("flavor gate not met: %s (cluster %s)", strings.Join(flavors, ", "), current)
+}
+
+// versionGateHolds evaluates a version gate against the target. Unknown gates are assumed to hold.
+func versionGateHolds(gate string, target check.Target) bool {
+	switch gate {
+	case check.VersionGateUpgrade2xTo3x:
+		return version.IsUpgradeFrom2xTo3x(target.CurrentVersion, target.TargetVersion)
+	case check.VersionGate3x:
+		return version.IsVersion3x(target.CurrentVersion) || version.IsVersion3x(target.TargetVersion)
+	case check.VersionGateTarget33:
+		//nolint:mnd // Version numbers 3.3
+		return version.IsVersionAtLeast(target.TargetVersion, 3, 3)
+	default:
+		return true
+	}
+}
+
+func formatVersion(v *semver.Version) string {
+	if v == nil {
+		return "unknown"
+	}
+
+	return v.String()
+}
+
+// planRow is a single row of the plan table.
+type planRow struct {
+	Group  string `mapstructure:"GROUP"`
+	Check  string `mapstructure:"CHECK"`
+	Action string `mapstructure:"ACTION"`
+	Reason string `mapstructure:"REASON"`
+}
+
+// OutputPlan renders the plan in the given format, followed by a summary for tables.
+func OutputPlan(out io.Writer, format OutputFormat, plan []PlanEntry) error {
+	switch format {
+	case OutputFormatJSON:
+		renderer := printerjson.NewRenderer[[]PlanEntry](printerjson.WithWriter[[]PlanEntry](out))
+		if err := renderer.Render(plan); err != nil {
+			return fmt.Errorf("rendering JSON plan: %w", err)
+		}
+
+		return nil
+	case OutputFormatYAML:
+		renderer := printeryaml.NewRenderer[[]PlanEntry](printeryaml.WithWriter[[]PlanEntry](out))
+		if err := renderer.Render(plan); err != nil {
+			return fmt.Errorf("rendering YAML plan: %w", err)
+		}
+
+		return nil
+	case OutputFormatTable:
+		return outputPlanTable(out, plan)
+	default:
+		return fmt.Errorf("unsupported output format: %s", format)
+	}
+}
+
+func outputPlanTable(out io.Writer, plan []PlanEntry) error {
+	renderer := table.NewRenderer(
+		table.WithWriter[planRow](out),
+		table.WithHeaders[planRow]("GROUP", "CHECK", "ACTION", "REASON"),
+		table.WithTableOptions[planRow](table.DefaultTableOptions...),
+	)
+
+	counts := make(map[PlanAction]int)
+
+	for _, entry := range plan {
+		counts[entry.Action]++
+
+		reason := entry.Reason
+		if reason == "" {
+			reason = "-"
+		}
+
+		row := planRow{
+			Group:  entry.Group,
+			Check:  entry.CheckID,
+			Action: string(entry.Action),
+			Reason: reason,
+		}
+
+		if err := renderer.Append(row); err != nil {
+			return fmt.Errorf("appending plan row: %w", err)
+		}
+	}
+
+	if err := renderer.Render(); err != nil {
+		return fmt.Errorf("rendering plan: %w", err)
+	}
+
+	_, _ = fmt.Fprintf(out, "\nPlan: %d to run, %d skipped, %d errors\n",
+		counts[PlanActionRun], counts[PlanActionSkip], counts[PlanActionError])
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package lint_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/blang/semver/v4"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+
+	. "github.com/onsi/gomega"
+)
+
+type planTestCheck struct {
+	check.BaseCheck
+
+	canApply func(target check.Target) (bool, error)
+}
+
+func (c *planTestCheck) CanApply(_ context.Context, target check.Target) (bool, error) {
+	return c.canApply(target)
+}
+
+func (c *planTestCheck) Validate(_ context.Context, _ check.Target) (*result.DiagnosticResult, error) {
+	panic("Validate must not be called while planning")
+}
+
+func newPlanTestCheck(group check.CheckGroup, id string, gate string, canApply func(check.Target) (bool, error)) check.Check {
+	return &planTestCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       group,
+			CheckID:          id,
+			CheckName:        id,
+			CheckVersionGate: gate,
+		},
+		canApply: canApply,
+	}
+}
+
+func TestBuildPlan(t *testing.T) {
+	g := NewWithT(t)
+
+	upgradeOnly := func(target check.Target) (bool, error) {
+		return version.IsUpgradeFrom2xTo3x(target.CurrentVersion, target.TargetVersion), nil
+	}
+
+	registry := check.NewRegistry()
+	registry.MustRegister(newPlanTestCheck(check.GroupWorkload, "workloads.b", check.VersionGateUpgrade2xTo3x, upgradeOnly))
+	registry.MustRegister(newPlanTestCheck(check.GroupWorkload, "workloads.a", "", func(check.Target) (bool, error) {
+		return false, nil
+	}))
+	registry.MustRegister(newPlanTestCheck(check.GroupComponent, "components.a", "", func(check.Target) (bool, error) {
+		return false, errors.New("boom")
+	}))
+	registry.MustRegister(newPlanTestCheck(check.GroupDependency, "dependencies.a", "", func(check.Target) (bool, error) {
+		return true, nil
+	}))
+
+	current := semver.MustParse("3.0.0")
+	target := check.Target{CurrentVersion: &current, TargetVersion: &current}
+
+	plan, err := lint.BuildPlan(t.Context(), registry, target, []string{"workloads.*", "components.*"})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(plan).To(Equal([]lint.PlanEntry{
+		{CheckID: "dependencies.a", Group: "dependency", Name: "dependencies.a", Action: lint.PlanActionSkip, Reason: "not selected by --checks"},
+		{CheckID: "components.a", Group: "component", Name: "components.a", Action: lint.PlanActionError, Reason: "applicability check failed: boom"},
+		{CheckID: "workloads.a", Group: "workload", Name: "workloads.a", Action: lint.PlanActionSkip, Reason: "not applicable to the cluster configuration"},
+		{CheckID: "workloads.b", Group: "workload", Name: "workloads.b", Action: lint.PlanActionSkip, Reason: "version gate not met: upgrade 2.x -> 3.x (current 3.0.0, target 3.0.0)"},
+	}))
+
+	from := semver.MustParse("2.25.0")
+	target.CurrentVersion = &from
+
+	plan, err = lint.BuildPlan(t.Context(), registry, target, []string{"workloads.b"})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(plan[len(plan)-1]).To(Equal(lint.PlanEntry{
+		CheckID: "workloads.b", Group: "workload", Name: "workloads.b", Action: lint.PlanActionRun,
+	}))
+}
+
+func TestBuildPlan_FlavorGate(t *testing.T) {
+	g := NewWithT(t)
+
+	registry := check.NewRegistry()
+	registry.MustRegister(&planTestCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:   check.GroupService,
+			CheckID:      "services.managed",
+			CheckName:    "services.managed",
+			CheckFlavors: []version.Flavor{version.FlavorManaged},
+		},
+		canApply: func(check.Target) (bool, error) { return true, nil },
+	})
+
+	current := semver.MustParse("2.25.0")
+	target := check.Target{CurrentVersion: &current, TargetVersion: &current, Flavor: version.FlavorSelfManaged}
+
+	plan, err := lint.BuildPlan(t.Context(), registry, target, []string{"*"})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(plan).To(HaveLen(1))
+	g.Expect(plan[0].Action).To(Equal(lint.PlanActionSkip))
+	g.Expect(plan[0].Reason).To(Equal("flavor gate not met: managed (cluster self-managed)"))
+
+	target.Flavor = version.FlavorManaged
+
+	plan, err = lint.BuildPlan(t.Context(), registry, target, []string{"*"})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(plan[0].Action).To(Equal(lint.PlanActionRun))
+}
+
+func TestOutputPlan(t *testing.T) {
+	g := NewWithT(t)
+
+	plan := []lint.PlanEntry{
+		{CheckID: "workloads.a", Group: "workload", Action: lint.PlanActionRun},
+		{CheckID: "workloads.b", Group: "workload", Action: lint.PlanActionSkip, Reason: "not selected by --checks"},
+	}
+
+	var buf bytes.Buffer
+	g.Expect(lint.OutputPlan(&buf, lint.OutputFormatTable, plan)).To(Succeed())
+	g.Expect(buf.String()).To(And(
+		ContainSubstring("not selected by --checks"),
+		ContainSubstring("Plan: 1 to run, 1 skipped, 0 errors"),
+	))
+
+	buf.Reset()
+	g.Expect(lint.OutputPlan(&buf, lint.OutputFormatJSON, plan)).To(Succeed())
+
+	var decoded []lint.PlanEntry
+	g.Expect(json.Unmarshal(buf.Bytes(), &decoded)).To(Succeed())
+	g.Expect(decoded).To(Equal(plan))
+}
@@ -0,0 +1,169 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+)
+
+// AnnotationPlugin is set on the results of plugin checks to the plugin name.
+const AnnotationPlugin = "check.opendatahub.io/plugin"
+
+//nolint:gochecknoglobals
+var pluginGroups = []check.CheckGroup{
+	check.GroupComponent,
+	check.GroupService,
+	check.GroupWorkload,
+	check.GroupDependency,
+}
+
+// Check is a check provided by a plugin. Each validation invokes the plugin.
+type Check struct {
+	check.BaseCheck
+
+	plugin *Plugin
+
+	// pluginID is the ID of the check within the plugin.
+	pluginID string
+}
+
+func newCheck(p *Plugin, d CheckDescription) (*Check, error) {
+	if !validName.MatchString(d.ID) {
+		return nil, fmt.Errorf("invalid check ID %q: must be dot-separated lowercase alphanumerics and '-'", d.ID)
+	}
+
+	group := check.CheckGroup(d.Group)
+	if !slices.Contains(pluginGroups, group) {
+		return nil, fmt.Errorf("check %s has invalid group %q (must be one of: component, service, workload, dependency)", d.ID, d.Group)
+	}
+
+	kind := d.Kind
+	if kind == "" {
+		kind = p.Name
+	}
+
+	name := d.Name
+	if name == "" {
+		name = fmt.Sprintf("Plugin :: %s :: %s", p.Name, d.ID)
+	}
+
+	return &Check{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       group,
+			Kind:             kind,
+			Type:             check.CheckType(d.ID),
+			CheckID:          IDPrefix + p.Name + "." + d.ID,
+			CheckName:        name,
+			CheckDescription: d.Description,
+			CheckRemediation: d.Remediation,
+			CheckDocumentation: check.Documentation{
+				Inspects: fmt.Sprintf("Provided by the plugin %s (%s).", p.Name, p.Path),
+			},
+		},
+		plugin:   p,
+		pluginID: d.ID,
+	}, nil
+}
+
+// CanApply returns true: plugins decide themselves whether their checks apply to the versions
+// of the run, and report a passing condition when they do not.
+func (c *Check) CanApply(_ context.Context, _ check.Target) (bool, error) {
+	return true, nil
+}
+
+// Validate invokes the plugin and converts its response into a diagnostic result.
+func (c *Check) Validate(
+	ctx context.Context,
+	target check.Target,
+) (*result.DiagnosticResult, error) {
+	req := Request{
+		Operation: OperationValidate,
+		Check:     c.pluginID,
+	}
+
+	if target.CurrentVersion != nil {
+		req.CurrentVersion = target.CurrentVersion.String()
+	}
+
+	if target.TargetVersion != nil {
+		req.TargetVersion = target.TargetVersion.String()
+	}
+
+	var res Result
+	if err := c.plugin.invoke(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	if res.Error != "" {
+		return nil, fmt.Errorf("plugin %s: %s", c.plugin.Name, res.Error)
+	}
+
+	if len(res.Conditions) == 0 {
+		return nil, errors.New("plugin " + c.plugin.Name + " returned no conditions")
+	}
+
+	dr := c.NewResult()
+	dr.Annotations[AnnotationPlugin] = c.plugin.Name
+
+	for _, pc := range res.Conditions {
+		cond, err := toCondition(pc)
+		if err != nil {
+			return nil, fmt.Errorf("plugin %s returned an invalid condition %s: %w", c.plugin.Name, pc.Type, err)
+		}
+
+		dr.Status.Conditions = append(dr.Status.Conditions, cond)
+	}
+
+	for _, obj := range res.ImpactedObjects {
+		impacted := metav1.PartialObjectMetadata{
+			TypeMeta: metav1.TypeMeta{APIVersion: obj.APIVersion, Kind: obj.Kind},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: obj.Namespace,
+				Name:      obj.Name,
+			},
+		}
+
+		if obj.Reason != "" {
+			impacted.Annotations = map[string]string{check.AnnotationImpactReason: obj.Reason}
+		}
+
+		dr.ImpactedObjects = append(dr.ImpactedObjects, impacted)
+	}
+
+	return dr, nil
+}
+
+// toCondition converts a plugin condition, deriving its impact from its status when unset.
+func toCondition(pc Condition) (result.Condition, error) {
+	if pc.Type == "" {
+		return result.Condition{}, errors.New("missing type")
+	}
+
+	cond := result.Condition{
+		Condition: metav1.Condition{
+			Type:               pc.Type,
+			Status:             metav1.ConditionStatus(pc.Status),
+			Reason:             pc.Reason,
+			Message:            pc.Message,
+			LastTransitionTime: metav1.Now(),
+		},
+		Impact:      result.Impact(pc.Impact),
+		Remediation: pc.Remediation,
+	}
+
+	if cond.Impact == result.ImpactNone && cond.Status != metav1.ConditionTrue {
+		cond.Impact = result.ImpactAdvisory
+	}
+
+	if err := cond.Validate(); err != nil {
+		return result.Condition{}, err
+	}
+
+	return cond, nil
+}
@@ -0,0 +1,286 @@
+// Package plugin runs lint checks shipped out of tree as executables. A plugin is an
+// executable named odh-lint-check-<name> found on PATH. It reads one JSON Request from stdin
+// and writes one JSON response to stdout: a Description of its checks for the describe
+// operation, a Result for the validate operation. The checks of a plugin are registered as
+// plugin.<name>.<check-id>.
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+)
+
+const (
+	// BinaryPrefix is the name prefix of plugin executables.
+	BinaryPrefix = "odh-lint-check-"
+
+	// APIVersion is the version of the protocol spoken with plugins.
+	APIVersion = "lint.opendatahub.io/v1"
+
+	// IDPrefix namespaces the IDs of plugin checks, followed by the plugin name.
+	IDPrefix = "plugin."
+
+	// DefaultTimeout bounds each invocation of a plugin that declares no timeout.
+	DefaultTimeout = 30 * time.Second
+
+	// OperationDescribe asks a plugin for the checks it provides.
+	OperationDescribe = "describe"
+
+	// OperationValidate asks a plugin to run one of its checks.
+	OperationValidate = "validate"
+
+	// maxStderr is how much of the stderr of a failing plugin is reported.
+	maxStderr = 1024
+)
+
+// validName matches plugin names and plugin check IDs.
+var validName = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?(\.[a-z0-9]([a-z0-9-]*[a-z0-9])?)*$`)
+
+// Request is written to the stdin of a plugin.
+type Request struct {
+	APIVersion string `json:"apiVersion"`
+	Operation  string `json:"operation"`
+
+	// Check is the ID of the check to validate, as described by the plugin.
+	Check string `json:"check,omitempty"`
+
+	// CurrentVersion and TargetVersion are the versions of the run; TargetVersion equals
+	// CurrentVersion in lint mode.
+	CurrentVersion string `json:"currentVersion,omitempty"`
+	TargetVersion  string `json:"targetVersion,omitempty"`
+
+	// Kubeconfig and Context are the --kubeconfig and --context of the run, if set. Plugins
+	// otherwise use their environment (KUBECONFIG, in-cluster configuration).
+	Kubeconfig string `json:"kubeconfig,omitempty"`
+	Context    string `json:"context,omitempty"`
+}
+
+// Description is the response of a plugin to the describe operation.
+type Description struct {
+	APIVersion string `json:"apiVersion"`
+
+	// Timeout optionally overrides the timeout of each invocation of the plugin, as a Go
+	// duration (e.g. "2m").
+	Timeout string `json:"timeout,omitempty"`
+
+	Checks []CheckDescription `json:"checks"`
+}
+
+// CheckDescription describes a check provided by a plugin.
+type CheckDescription struct {
+	// ID identifies the check within the plugin, e.g. "quota.gpu".
+	ID string `json:"id"`
+
+	// Group is one of component, service, workload or dependency.
+	Group string `json:"group"`
+
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+
+	// Kind is the kind of resource being checked; defaults to the plugin name.
+	Kind        string `json:"kind,omitempty"`
+	Remediation string `json:"remediation,omitempty"`
+}
+
+// Result is the response of a plugin to the validate operation.
+type Result struct {
+	Conditions      []Condition      `json:"conditions"`
+	ImpactedObjects []ImpactedObject `json:"impactedObjects,omitempty"`
+
+	// Error reports that the check could not be executed.
+	Error string `json:"error,omitempty"`
+}
+
+// Condition is a condition of a plugin check result. Impact defaults to none for True and
+// advisory for False and Unknown conditions.
+type Condition struct {
+	Type        string `json:"type"`
+	Status      string `json:"status"`
+	Reason      string `json:"reason,omitempty"`
+	Message     string `json:"message,omitempty"`
+	Impact      string `json:"impact,omitempty"`
+	Remediation string `json:"remediation,omitempty"`
+}
+
+// ImpactedObject is an object impacted by the findings of a plugin check.
+type ImpactedObject struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+	Reason     string `json:"reason,omitempty"`
+}
+
+// Options configure how plugins are invoked.
+type Options struct {
+	// Timeout bounds each invocation of a plugin that declares no timeout of its own.
+	// DefaultTimeout when zero.
+	Timeout time.Duration
+
+	// Kubeconfig and Context are passed to plugins in each Request.
+	Kubeconfig string
+	Context    string
+}
+
+// Plugin is an executable providing checks.
+type Plugin struct {
+	// Name is the plugin name, the executable name without BinaryPrefix.
+	Name string
+
+	// Path is the path of the executable.
+	Path string
+
+	// Timeout bounds each invocation of the plugin.
+	Timeout time.Duration
+
+	options Options
+}
+
+// Discover returns the paths of the plugin executables in the directories of path, a
+// PATH-style list. When several directories hold a plugin of the same name, the first one
+// wins, as for commands.
+func Discover(path string) []string {
+	var found []string
+
+	seen := make(map[string]bool)
+
+	for _, dir := range filepath.SplitList(path) {
+		if dir == "" {
+			continue
+		}
+
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+
+		for _, entry := range entries {
+			name := entry.Name()
+			if !strings.HasPrefix(name, BinaryPrefix) || seen[name] {
+				continue
+			}
+
+			info, err := os.Stat(filepath.Join(dir, name))
+			if err != nil || !info.Mode().IsRegular() || info.Mode().Perm()&0o111 == 0 {
+				continue
+			}
+
+			seen[name] = true
+			found = append(found, filepath.Join(dir, name))
+		}
+	}
+
+	return found
+}
+
+// Load describes the plugin at path and returns its checks.
+func Load(ctx context.Context, path string, opts Options) ([]check.Check, error) {
+	name := strings.TrimPrefix(filepath.Base(path), BinaryPrefix)
+	if !validName.MatchString(name) {
+		return nil, fmt.Errorf("invalid plugin name %q: must consist of lowercase alphanumerics and '-'", name)
+	}
+
+	if opts.Timeout <= 0 {
+		opts.Timeout = DefaultTimeout
+	}
+
+	p := &Plugin{
+		Name:    name,
+		Path:    path,
+		Timeout: opts.Timeout,
+		options: opts,
+	}
+
+	var desc Description
+	if err := p.invoke(ctx, Request{Operation: OperationDescribe}, &desc); err != nil {
+		return nil, err
+	}
+
+	if desc.APIVersion != APIVersion {
+		return nil, fmt.Errorf("plugin %s speaks %q, expected %q", name, desc.APIVersion, APIVersion)
+	}
+
+	if desc.Timeout != "" {
+		timeout, err := time.ParseDuration(desc.Timeout)
+		if err != nil || timeout <= 0 {
+			return nil, fmt.Errorf("plugin %s declares an invalid timeout %q", name, desc.Timeout)
+		}
+
+		p.Timeout = timeout
+	}
+
+	checks := make([]check.Check, 0, len(desc.Checks))
+
+	for _, d := range desc.Checks {
+		c, err := newCheck(p, d)
+		if err != nil {
+			return nil, fmt.Errorf("plugin %s: %w", name, err)
+		}
+
+		checks = append(checks, c)
+	}
+
+	return checks, nil
+}
+
+// invoke runs the plugin with req on stdin and decodes its stdout into out.
+func (p *Plugin) invoke(ctx context.Context, req Request, out any) error {
+	req.APIVersion = APIVersion
+	req.Kubeconfig = p.options.Kubeconfig
+	req.Context = p.options.Context
+
+	input, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("encoding plugin request: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, p.Timeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, p.Path)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	cmd.WaitDelay = time.Second
+
+	if err := cmd.Run(); err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return fmt.Errorf("plugin %s %s timed out after %s", p.Name, req.Operation, p.Timeout)
+		}
+
+		return fmt.Errorf("plugin %s %s failed: %w%s", p.Name, req.Operation, err, stderrSuffix(stderr.Bytes()))
+	}
+
+	if err := json.Unmarshal(stdout.Bytes(), out); err != nil {
+		return fmt.Errorf("decoding %s response of plugin %s: %w", req.Operation, p.Name, err)
+	}
+
+	return nil
+}
+
+// stderrSuffix formats the end of the stderr of a failing plugin for an error message.
+func stderrSuffix(stderr []byte) string {
+	msg := strings.TrimSpace(string(stderr))
+	if msg == "" {
+		return ""
+	}
+
+	if len(msg) > maxStderr {
+		msg = "..." + msg[len(msg)-maxStderr:]
+	}
+
+	return ": " + msg
+}
@@ -0,0 +1,272 @@
+package plugin_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/blang/semver/v4"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/plugin"
+
+	. "github.com/onsi/gomega"
+)
+
+// describeQuota is the describe response of the quota test plugin.
+const describeQuota = `{"apiVersion":"lint.opendatahub.io/v1","checks":[` +
+	`{"id":"gpu","group":"workload","name":"GPU quota","description":"Checks GPU quota"},` +
+	`{"id":"namespaces.labels","group":"service"}]}`
+
+// validateQuota is the validate response of the quota test plugin.
+const validateQuota = `{"conditions":[{"type":"QuotaAvailable","status":"False","reason":"Exceeded","message":"GPU quota exceeded"}],` +
+	`"impactedObjects":[{"apiVersion":"v1","kind":"ResourceQuota","namespace":"team-a","name":"gpu","reason":"limit reached"}]}`
+
+// writePlugin writes an executable shell script plugin into dir. The script saves its request
+// in <name>.request and answers describe and validate requests with the given responses.
+func writePlugin(t *testing.T, dir string, name string, describe string, validate string) string {
+	t.Helper()
+
+	path := filepath.Join(dir, plugin.BinaryPrefix+name)
+	script := "#!/bin/sh\n" +
+		"input=$(cat)\n" +
+		"echo \"$input\" > '" + path + ".request'\n" +
+		"case \"$input\" in\n" +
+		"  *'\"operation\":\"describe\"'*) echo '" + describe + "' ;;\n" +
+		"  *) " + validate + " ;;\n" +
+		"esac\n"
+
+	if err := os.WriteFile(path, []byte(script), 0o755); err != nil { //nolint:gosec // test plugins must be executable
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func TestDiscover(t *testing.T) {
+	g := NewWithT(t)
+
+	first := t.TempDir()
+	second := t.TempDir()
+
+	writePlugin(t, first, "quota", describeQuota, "true")
+	writePlugin(t, second, "quota", describeQuota, "true")
+	writePlugin(t, second, "network", describeQuota, "true")
+	g.Expect(os.WriteFile(filepath.Join(second, plugin.BinaryPrefix+"disabled"), nil, 0o600)).To(Succeed())
+	g.Expect(os.WriteFile(filepath.Join(second, "kubectl-odh"), nil, 0o755)).To(Succeed()) //nolint:gosec // executable fixture
+
+	found := plugin.Discover(first + string(os.PathListSeparator) + second)
+
+	// The first directory wins; non-executable and unprefixed files are ignored
+	g.Expect(found).To(ConsistOf(
+		filepath.Join(first, plugin.BinaryPrefix+"quota"),
+		filepath.Join(second, plugin.BinaryPrefix+"network"),
+	))
+}
+
+func TestLoad_NamespacesCheckIDs(t *testing.T) {
+	g := NewWithT(t)
+
+	path := writePlugin(t, t.TempDir(), "quota", describeQuota, "true")
+
+	checks, err := plugin.Load(t.Context(), path, plugin.Options{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(checks).To(HaveLen(2))
+
+	g.Expect(checks[0].ID()).To(Equal("plugin.quota.gpu"))
+	g.Expect(checks[0].Name()).To(Equal("GPU quota"))
+	g.Expect(checks[0].Group()).To(Equal(check.GroupWorkload))
+	g.Expect(checks[1].ID()).To(Equal("plugin.quota.namespaces.labels"))
+	g.Expect(checks[1].Name()).To(Equal("Plugin :: quota :: namespaces.labels"))
+	g.Expect(checks[1].Group()).To(Equal(check.GroupService))
+
+	// Plugin checks cannot collide with built-in checks of the registry
+	registry := check.NewRegistry()
+	for _, chk := range checks {
+		g.Expect(registry.Register(chk)).To(Succeed())
+	}
+
+	g.Expect(registry.Register(checks[0])).ToNot(Succeed())
+}
+
+func TestLoad_SendsRequest(t *testing.T) {
+	g := NewWithT(t)
+
+	path := writePlugin(t, t.TempDir(), "quota", describeQuota, "true")
+
+	_, err := plugin.Load(t.Context(), path, plugin.Options{Kubeconfig: "/tmp/kubeconfig", Context: "admin"})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	request, err := os.ReadFile(path + ".request")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(request).To(MatchJSON(`{"apiVersion":"lint.opendatahub.io/v1","operation":"describe",` +
+		`"kubeconfig":"/tmp/kubeconfig","context":"admin"}`))
+}
+
+func TestLoad_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		plugin   string
+		describe string
+		validate string
+		errMatch string
+	}{
+		{
+			name:     "unsupported protocol version",
+			plugin:   "quota",
+			describe: `{"apiVersion":"lint.opendatahub.io/v2","checks":[]}`,
+			errMatch: `expected "lint.opendatahub.io/v1"`,
+		},
+		{
+			name:     "invalid check group",
+			plugin:   "quota",
+			describe: `{"apiVersion":"lint.opendatahub.io/v1","checks":[{"id":"gpu","group":"health"}]}`,
+			errMatch: `invalid group "health"`,
+		},
+		{
+			name:     "invalid check ID",
+			plugin:   "quota",
+			describe: `{"apiVersion":"lint.opendatahub.io/v1","checks":[{"id":"GPU Quota","group":"workload"}]}`,
+			errMatch: `invalid check ID "GPU Quota"`,
+		},
+		{
+			name:     "invalid declared timeout",
+			plugin:   "quota",
+			describe: `{"apiVersion":"lint.opendatahub.io/v1","timeout":"soon","checks":[]}`,
+			errMatch: `invalid timeout "soon"`,
+		},
+		{
+			name:     "invalid plugin name",
+			plugin:   "Quota",
+			describe: describeQuota,
+			errMatch: `invalid plugin name "Quota"`,
+		},
+		{
+			name:     "malformed response",
+			plugin:   "quota",
+			describe: `not json`,
+			errMatch: "decoding describe response of plugin quota",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			path := writePlugin(t, t.TempDir(), tt.plugin, tt.describe, "true")
+
+			_, err := plugin.Load(t.Context(), path, plugin.Options{})
+			g.Expect(err).To(MatchError(ContainSubstring(tt.errMatch)))
+		})
+	}
+}
+
+func TestCheck_Validate(t *testing.T) {
+	g := NewWithT(t)
+
+	path := writePlugin(t, t.TempDir(), "quota", describeQuota, "echo '"+validateQuota+"'")
+
+	checks, err := plugin.Load(t.Context(), path, plugin.Options{})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	current := semver.MustParse("2.25.0")
+	target := semver.MustParse("3.0.0")
+
+	dr, err := checks[0].Validate(t.Context(), check.Target{CurrentVersion: &current, TargetVersion: &target})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	request, err := os.ReadFile(path + ".request")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(request).To(MatchJSON(`{"apiVersion":"lint.opendatahub.io/v1","operation":"validate","check":"gpu",` +
+		`"currentVersion":"2.25.0","targetVersion":"3.0.0"}`))
+
+	g.Expect(dr.Annotations).To(HaveKeyWithValue(plugin.AnnotationPlugin, "quota"))
+	g.Expect(dr.Status.Conditions).To(HaveLen(1))
+	g.Expect(dr.Status.Conditions[0].Type).To(Equal("QuotaAvailable"))
+	g.Expect(dr.Status.Conditions[0].Status).To(Equal(metav1.ConditionFalse))
+	g.Expect(dr.Status.Conditions[0].Message).To(Equal("GPU quota exceeded"))
+	// Failing conditions without an impact are advisory
+	g.Expect(dr.Status.Conditions[0].Impact).To(Equal(result.ImpactAdvisory))
+
+	g.Expect(dr.ImpactedObjects).To(HaveLen(1))
+	g.Expect(dr.ImpactedObjects[0].Kind).To(Equal("ResourceQuota"))
+	g.Expect(dr.ImpactedObjects[0].Namespace).To(Equal("team-a"))
+	g.Expect(dr.ImpactedObjects[0].Name).To(Equal("gpu"))
+	g.Expect(dr.ImpactedObjects[0].Annotations).To(HaveKeyWithValue(check.AnnotationImpactReason, "limit reached"))
+}
+
+func TestCheck_ValidateErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		validate string
+		errMatch string
+	}{
+		{
+			name:     "reported error",
+			validate: `echo '{"error":"cannot reach the quota API"}'`,
+			errMatch: "plugin quota: cannot reach the quota API",
+		},
+		{
+			name:     "no conditions",
+			validate: `echo '{"conditions":[]}'`,
+			errMatch: "returned no conditions",
+		},
+		{
+			name:     "passing condition with impact",
+			validate: `echo '{"conditions":[{"type":"Ready","status":"True","impact":"blocking"}]}'`,
+			errMatch: "invalid condition Ready",
+		},
+		{
+			name:     "non-zero exit",
+			validate: `echo 'quota API unavailable' >&2; exit 2`,
+			errMatch: "plugin quota validate failed: exit status 2: quota API unavailable",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			path := writePlugin(t, t.TempDir(), "quota", describeQuota, tt.validate)
+
+			checks, err := plugin.Load(t.Context(), path, plugin.Options{})
+			g.Expect(err).ToNot(HaveOccurred())
+
+			_, err = checks[0].Validate(t.Context(), check.Target{})
+			g.Expect(err).To(MatchError(ContainSubstring(tt.errMatch)))
+		})
+	}
+}
+
+func TestCheck_ValidateTimeout(t *testing.T) {
+	g := NewWithT(t)
+
+	path := writePlugin(t, t.TempDir(), "quota", describeQuota, "sleep 5")
+
+	checks, err := plugin.Load(t.Context(), path, plugin.Options{Timeout: 200 * time.Millisecond})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	start := time.Now()
+
+	_, err = checks[0].Validate(t.Context(), check.Target{})
+	g.Expect(err).To(MatchError(ContainSubstring("plugin quota validate timed out after 200ms")))
+	g.Expect(time.Since(start)).To(BeNumerically("<", 3*time.Second))
+}
+
+func TestCheck_ValidateDeclaredTimeout(t *testing.T) {
+	g := NewWithT(t)
+
+	describe := `{"apiVersion":"lint.opendatahub.io/v1","timeout":"200ms","checks":[{"id":"gpu","group":"workload"}]}`
+	path := writePlugin(t, t.TempDir(), "quota", describe, "sleep 5")
+
+	// The timeout declared by the plugin overrides --plugin-timeout
+	checks, err := plugin.Load(t.Context(), path, plugin.Options{Timeout: time.Minute})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	_, err = checks[0].Validate(t.Context(), check.Target{})
+	g.Expect(err).To(MatchError(ContainSubstring("timed out after 200ms")))
+}
@@ -0,0 +1,74 @@
+package lint
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/printer/table"
+)
+
+// readinessRow is a row of the per-component upgrade gate table.
+type readinessRow struct {
+	Gate     string
+	Group    string
+	Kind     string
+	Checks   string
+	Blocking string
+	Advisory string
+}
+
+// ReadinessVerdict returns the sentence stating whether the cluster is ready for the upgrade.
+func ReadinessVerdict(summary *result.ReadinessSummary, currentVersion string, targetVersion string) string {
+	if summary.Ready() {
+		return fmt.Sprintf("Cluster is ready to upgrade from %s to %s", currentVersion, targetVersion)
+	}
+
+	return fmt.Sprintf("Cluster is not ready to upgrade from %s to %s: %d blocking finding(s)",
+		currentVersion, targetVersion, summary.Blocking)
+}
+
+// OutputReadiness renders the per-component gate table, the blocking and advisory counts and
+// the readiness verdict of an upgrade assessment.
+func OutputReadiness(out io.Writer, summary *result.ReadinessSummary, currentVersion string, targetVersion string) error {
+	_, _ = fmt.Fprintln(out)
+	_, _ = fmt.Fprintln(out, "Upgrade Readiness:")
+
+	renderer := table.NewRenderer(
+		table.WithWriter[readinessRow](out),
+		table.WithHeaders[readinessRow]("GATE", "GROUP", "KIND", "CHECKS", "BLOCKING", "ADVISORY"),
+		table.WithTableOptions[readinessRow](table.DefaultTableOptions...),
+	)
+
+	for _, gate := range summary.Components {
+		row := readinessRow{
+			Gate:     statusPass,
+			Group:    gate.Group,
+			Kind:     gate.Kind,
+			Checks:   strconv.Itoa(gate.Checks),
+			Blocking: strconv.Itoa(gate.Blocking),
+			Advisory: strconv.Itoa(gate.Advisory),
+		}
+
+		switch {
+		case !gate.Passed:
+			row.Gate = statusFail
+		case gate.Advisory > 0:
+			row.Gate = statusWarn
+		}
+
+		if err := renderer.Append(row); err != nil {
+			return fmt.Errorf("appending readiness row: %w", err)
+		}
+	}
+
+	if err := renderer.Render(); err != nil {
+		return fmt.Errorf("rendering readiness table: %w", err)
+	}
+
+	_, _ = fmt.Fprintf(out, "  Blocking: %d | Advisory: %d\n", summary.Blocking, summary.Advisory)
+	_, _ = fmt.Fprintf(out, "  Verdict: %s\n", ReadinessVerdict(summary, currentVersion, targetVersion))
+
+	return nil
+}
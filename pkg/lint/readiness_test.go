@@ -0,0 +1,59 @@
+package lint_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestOutputReadiness(t *testing.T) {
+	g := NewWithT(t)
+
+	results := remediationExecutions()
+	summary := result.NewReadinessSummary([]*result.DiagnosticResult{results[0].Result, results[1].Result})
+
+	var buf bytes.Buffer
+	g.Expect(lint.OutputReadiness(&buf, summary, testClusterVersion, testTargetVersion)).To(Succeed())
+
+	output := buf.String()
+	g.Expect(output).To(ContainSubstring("Upgrade Readiness:"))
+	g.Expect(output).To(ContainSubstring("codeflare"))
+	g.Expect(output).To(ContainSubstring("trainingoperator"))
+	g.Expect(output).To(ContainSubstring("Blocking: 1 | Advisory: 1"))
+	g.Expect(output).To(ContainSubstring("Verdict: Cluster is not ready to upgrade from 2.25.0 to 3.0.0: 1 blocking finding(s)"))
+}
+
+func TestReadinessVerdict_Ready(t *testing.T) {
+	g := NewWithT(t)
+
+	summary := result.NewReadinessSummary(nil)
+
+	g.Expect(lint.ReadinessVerdict(summary, testClusterVersion, testTargetVersion)).
+		To(Equal("Cluster is ready to upgrade from 2.25.0 to 3.0.0"))
+}
+
+func TestOutputJSON_Readiness(t *testing.T) {
+	g := NewWithT(t)
+
+	clusterVersion := testClusterVersion
+	targetVersion := testTargetVersion
+
+	var buf bytes.Buffer
+	g.Expect(lint.OutputJSON(&buf, remediationExecutions(), &clusterVersion, &targetVersion)).To(Succeed())
+
+	var list result.DiagnosticResultList
+	g.Expect(json.Unmarshal(buf.Bytes(), &list)).To(Succeed())
+	g.Expect(list.Readiness).ToNot(BeNil())
+	g.Expect(*list.Readiness).To(BeFalse())
+	g.Expect(list.ReadinessSummary.Blocking).To(Equal(1))
+	g.Expect(list.ReadinessSummary.Components).To(HaveLen(2))
+
+	buf.Reset()
+	g.Expect(lint.OutputJSON(&buf, remediationExecutions(), &clusterVersion, nil)).To(Succeed())
+	g.Expect(buf.String()).ToNot(ContainSubstring("readiness"))
+}
@@ -0,0 +1,320 @@
+// Package record stores lint results in the cluster for auditability. Each recorded run is a
+// ConfigMap in the operator namespace holding the DiagnosticResultList as JSON, labeled with
+// its timestamp and the cluster and target versions so prior runs can be listed and fetched.
+package record
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+)
+
+const (
+	// DefaultNamespace is the namespace of the OpenShift AI operator, where runs are recorded.
+	DefaultNamespace = "redhat-ods-operator"
+
+	// NamePrefix is the name prefix of the ConfigMaps of recorded runs.
+	NamePrefix = "odh-lint-report-"
+
+	// LabelReport marks the ConfigMaps of recorded runs.
+	LabelReport = "lint.opendatahub.io/report"
+
+	// LabelRecordedAt is the UTC time of the run, in TimestampLayout.
+	LabelRecordedAt = "lint.opendatahub.io/recorded-at"
+
+	// LabelClusterVersion and LabelTargetVersion are the versions of the run, sanitized to
+	// label values.
+	LabelClusterVersion = "lint.opendatahub.io/cluster-version"
+	LabelTargetVersion  = "lint.opendatahub.io/target-version"
+
+	// AnnotationTimestamp is the RFC 3339 time of the run.
+	AnnotationTimestamp = "lint.opendatahub.io/timestamp"
+
+	// AnnotationChecks and AnnotationFailing count the results and failing results of the run.
+	AnnotationChecks  = "lint.opendatahub.io/checks"
+	AnnotationFailing = "lint.opendatahub.io/failing"
+
+	// TimestampLayout formats the time of a run in names and labels.
+	TimestampLayout = "20060102t150405z"
+
+	// DataKey holds the DiagnosticResultList as JSON; CompressedDataKey holds it gzipped when
+	// the JSON does not fit in a ConfigMap.
+	DataKey           = "results.json"
+	CompressedDataKey = "results.json.gz"
+
+	// maxDataSize keeps the ConfigMap below the 1 MiB object size limit, with room for metadata.
+	maxDataSize = 1000 * 1024
+)
+
+// invalidLabelChars matches characters not allowed in label values.
+var invalidLabelChars = regexp.MustCompile(`[^A-Za-z0-9._-]`)
+
+// ErrNotFound is returned by Get when no run is recorded under the name.
+var ErrNotFound = errors.New("recorded run not found")
+
+// Run describes a recorded run.
+type Run struct {
+	Name           string    `json:"name"`
+	Namespace      string    `json:"namespace"`
+	Timestamp      time.Time `json:"timestamp"`
+	ClusterVersion string    `json:"clusterVersion,omitempty"`
+	TargetVersion  string    `json:"targetVersion,omitempty"`
+	Checks         int       `json:"checks"`
+	Failing        int       `json:"failing"`
+}
+
+// Write records list in a new ConfigMap in namespace and returns the recorded run.
+func Write(
+	ctx context.Context,
+	client dynamic.Interface,
+	namespace string,
+	list *result.DiagnosticResultList,
+	timestamp time.Time,
+) (*Run, error) {
+	data, err := json.Marshal(list)
+	if err != nil {
+		return nil, fmt.Errorf("encoding results: %w", err)
+	}
+
+	timestamp = timestamp.UTC()
+
+	run := &Run{
+		Name:      NamePrefix + timestamp.Format(TimestampLayout),
+		Namespace: namespace,
+		Timestamp: timestamp,
+		Checks:    len(list.Results),
+	}
+
+	for _, r := range list.Results {
+		if r != nil && r.IsFailing() {
+			run.Failing++
+		}
+	}
+
+	if list.ClusterVersion != nil {
+		run.ClusterVersion = *list.ClusterVersion
+	}
+
+	if list.TargetVersion != nil {
+		run.TargetVersion = *list.TargetVersion
+	}
+
+	cm := &corev1.ConfigMap{
+		TypeMeta: resources.ConfigMap.TypeMeta(),
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      run.Name,
+			Namespace: namespace,
+			Labels: map[string]string{
+				LabelReport:     "true",
+				LabelRecordedAt: timestamp.Format(TimestampLayout),
+			},
+			Annotations: map[string]string{
+				AnnotationTimestamp: timestamp.Format(time.RFC3339),
+				AnnotationChecks:    strconv.Itoa(run.Checks),
+				AnnotationFailing:   strconv.Itoa(run.Failing),
+			},
+		},
+	}
+
+	if run.ClusterVersion != "" {
+		cm.Labels[LabelClusterVersion] = labelValue(run.ClusterVersion)
+	}
+
+	if run.TargetVersion != "" {
+		cm.Labels[LabelTargetVersion] = labelValue(run.TargetVersion)
+	}
+
+	if len(data) <= maxDataSize {
+		cm.Data = map[string]string{DataKey: string(data)}
+	} else {
+		compressed, err := compress(data)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(compressed) > maxDataSize {
+			return nil, fmt.Errorf("results of %d bytes exceed the ConfigMap size limit even compressed", len(data))
+		}
+
+		cm.BinaryData = map[string][]byte{CompressedDataKey: compressed}
+	}
+
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(cm)
+	if err != nil {
+		return nil, fmt.Errorf("converting ConfigMap: %w", err)
+	}
+
+	_, err = client.Resource(resources.ConfigMap.GVR()).Namespace(namespace).
+		Create(ctx, &unstructured.Unstructured{Object: obj}, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("creating ConfigMap %s/%s: %w", namespace, run.Name, err)
+	}
+
+	return run, nil
+}
+
+// List returns the runs recorded in namespace, newest first.
+func List(ctx context.Context, client dynamic.Interface, namespace string) ([]Run, error) {
+	list, err := client.Resource(resources.ConfigMap.GVR()).Namespace(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: LabelReport + "=true",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing recorded runs: %w", err)
+	}
+
+	runs := make([]Run, 0, len(list.Items))
+
+	for i := range list.Items {
+		cm, err := toConfigMap(&list.Items[i])
+		if err != nil {
+			return nil, err
+		}
+
+		runs = append(runs, newRun(cm))
+	}
+
+	slices.SortFunc(runs, func(a Run, b Run) int {
+		if c := b.Timestamp.Compare(a.Timestamp); c != 0 {
+			return c
+		}
+
+		return strings.Compare(b.Name, a.Name)
+	})
+
+	return runs, nil
+}
+
+// Get returns the run recorded in namespace under name and its results.
+func Get(
+	ctx context.Context,
+	client dynamic.Interface,
+	namespace string,
+	name string,
+) (*Run, *result.DiagnosticResultList, error) {
+	obj, err := client.Resource(resources.ConfigMap.GVR()).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil, fmt.Errorf("%w: %s/%s", ErrNotFound, namespace, name)
+		}
+
+		return nil, nil, fmt.Errorf("getting recorded run %s/%s: %w", namespace, name, err)
+	}
+
+	if obj.GetLabels()[LabelReport] != "true" {
+		return nil, nil, fmt.Errorf("%w: ConfigMap %s/%s is not a recorded run", ErrNotFound, namespace, name)
+	}
+
+	cm, err := toConfigMap(obj)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data := []byte(cm.Data[DataKey])
+
+	if compressed, ok := cm.BinaryData[CompressedDataKey]; ok {
+		data, err = decompress(compressed)
+		if err != nil {
+			return nil, nil, fmt.Errorf("reading recorded run %s/%s: %w", namespace, name, err)
+		}
+	}
+
+	var list result.DiagnosticResultList
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, nil, fmt.Errorf("decoding recorded run %s/%s: %w", namespace, name, err)
+	}
+
+	run := newRun(cm)
+
+	return &run, &list, nil
+}
+
+// newRun describes the run recorded in cm from its labels and annotations.
+func newRun(cm *corev1.ConfigMap) Run {
+	run := Run{
+		Name:           cm.Name,
+		Namespace:      cm.Namespace,
+		ClusterVersion: cm.Labels[LabelClusterVersion],
+		TargetVersion:  cm.Labels[LabelTargetVersion],
+	}
+
+	if timestamp, err := time.Parse(time.RFC3339, cm.Annotations[AnnotationTimestamp]); err == nil {
+		run.Timestamp = timestamp
+	} else if timestamp, err := time.Parse(TimestampLayout, cm.Labels[LabelRecordedAt]); err == nil {
+		run.Timestamp = timestamp
+	}
+
+	run.Checks, _ = strconv.Atoi(cm.Annotations[AnnotationChecks])
+	run.Failing, _ = strconv.Atoi(cm.Annotations[AnnotationFailing])
+
+	return run
+}
+
+// labelValue replaces the characters of value not allowed in label values, such as the '+' of
+// semver build metadata, and trims it to the label value length limit.
+func labelValue(value string) string {
+	value = invalidLabelChars.ReplaceAllString(value, "_")
+	if len(value) > 63 {
+		value = value[:63]
+	}
+
+	return strings.Trim(value, "._-")
+}
+
+func toConfigMap(obj *unstructured.Unstructured) (*corev1.ConfigMap, error) {
+	var cm corev1.ConfigMap
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &cm); err != nil {
+		return nil, fmt.Errorf("converting ConfigMap %s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
+	}
+
+	return &cm, nil
+}
+
+func compress(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(data); err != nil {
+		return nil, fmt.Errorf("compressing results: %w", err)
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("compressing results: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func decompress(data []byte) ([]byte, error) {
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("decompressing results: %w", err)
+	}
+
+	defer func() { _ = zr.Close() }()
+
+	out, err := io.ReadAll(zr)
+	if err != nil {
+		return nil, fmt.Errorf("decompressing results: %w", err)
+	}
+
+	return out, nil
+}
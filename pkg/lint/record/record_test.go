@@ -0,0 +1,140 @@
+package record_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/record"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+)
+
+const namespace = "redhat-ods-operator"
+
+func newClient() *dynamicfake.FakeDynamicClient {
+	return dynamicfake.NewSimpleDynamicClientWithCustomListKinds(
+		runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{resources.ConfigMap.GVR(): resources.ConfigMap.ListKind()},
+	)
+}
+
+func newResultList(clusterVersion string, targetVersion string, failing int) *result.DiagnosticResultList {
+	list := result.NewDiagnosticResultList(&clusterVersion, &targetVersion)
+
+	for i := range 3 {
+		dr := result.New("workload", "notebook", fmt.Sprintf("check-%d", i), "description")
+		status := metav1.ConditionTrue
+		impact := result.ImpactNone
+
+		if i < failing {
+			status = metav1.ConditionFalse
+			impact = result.ImpactBlocking
+		}
+
+		dr.SetCondition(result.Condition{
+			Condition: metav1.Condition{Type: "Compatible", Status: status, Message: "message"},
+			Impact:    impact,
+		})
+
+		list.Results = append(list.Results, dr)
+	}
+
+	return list
+}
+
+func TestWriteAndGet(t *testing.T) {
+	g := NewWithT(t)
+
+	client := newClient()
+	timestamp := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	run, err := record.Write(t.Context(), client, namespace, newResultList("2.25.0", "3.0.0+build.1", 1), timestamp)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(run.Name).To(Equal("odh-lint-report-20260301t100000z"))
+	g.Expect(run.Checks).To(Equal(3))
+	g.Expect(run.Failing).To(Equal(1))
+
+	cm, err := client.Resource(resources.ConfigMap.GVR()).Namespace(namespace).Get(t.Context(), run.Name, metav1.GetOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(cm.GetLabels()).To(And(
+		HaveKeyWithValue(record.LabelReport, "true"),
+		HaveKeyWithValue(record.LabelRecordedAt, "20260301t100000z"),
+		HaveKeyWithValue(record.LabelClusterVersion, "2.25.0"),
+		// '+' is not allowed in label values
+		HaveKeyWithValue(record.LabelTargetVersion, "3.0.0_build.1"),
+	))
+	g.Expect(cm.GetAnnotations()).To(HaveKeyWithValue(record.AnnotationTimestamp, "2026-03-01T10:00:00Z"))
+
+	fetched, list, err := record.Get(t.Context(), client, namespace, run.Name)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(fetched.Timestamp).To(BeTemporally("==", timestamp))
+	g.Expect(fetched.Failing).To(Equal(1))
+	g.Expect(*list.ClusterVersion).To(Equal("2.25.0"))
+	g.Expect(*list.TargetVersion).To(Equal("3.0.0+build.1"))
+	g.Expect(list.Results).To(HaveLen(3))
+	g.Expect(list.Results[0].Status.Conditions[0].Impact).To(Equal(result.ImpactBlocking))
+}
+
+func TestWrite_CompressesLargeResults(t *testing.T) {
+	g := NewWithT(t)
+
+	client := newClient()
+
+	list := newResultList("2.25.0", "3.0.0", 1)
+	list.Results[0].Status.Conditions[0].Message = strings.Repeat("impacted notebook ", 100_000)
+
+	run, err := record.Write(t.Context(), client, namespace, list, time.Now())
+	g.Expect(err).ToNot(HaveOccurred())
+
+	cm, err := client.Resource(resources.ConfigMap.GVR()).Namespace(namespace).Get(t.Context(), run.Name, metav1.GetOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(cm.Object).To(HaveKey("binaryData"))
+	g.Expect(cm.Object).ToNot(HaveKey("data"))
+
+	_, fetched, err := record.Get(t.Context(), client, namespace, run.Name)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(fetched.Results[0].Status.Conditions[0].Message).To(Equal(list.Results[0].Status.Conditions[0].Message))
+}
+
+func TestList(t *testing.T) {
+	g := NewWithT(t)
+
+	client := newClient()
+
+	// Recorded out of order; runs are listed newest first
+	for _, day := range []int{2, 3, 1} {
+		_, err := record.Write(t.Context(), client, namespace, newResultList("2.25.0", "", day-1),
+			time.Date(2026, 3, day, 10, 0, 0, 0, time.UTC))
+		g.Expect(err).ToNot(HaveOccurred())
+	}
+
+	// ConfigMaps that are not recorded runs are ignored
+	other := resources.ConfigMap.Unstructured()
+	other.SetName("odh-lint-config")
+	other.SetNamespace(namespace)
+	_, err := client.Resource(resources.ConfigMap.GVR()).Namespace(namespace).Create(t.Context(), &other, metav1.CreateOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	runs, err := record.List(t.Context(), client, namespace)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(runs).To(HaveLen(3))
+	g.Expect(runs[0].Name).To(Equal("odh-lint-report-20260303t100000z"))
+	g.Expect(runs[0].Failing).To(Equal(2))
+	g.Expect(runs[0].TargetVersion).To(BeEmpty())
+	g.Expect(runs[2].Name).To(Equal("odh-lint-report-20260301t100000z"))
+
+	_, _, err = record.Get(t.Context(), client, namespace, "odh-lint-config")
+	g.Expect(err).To(MatchError(record.ErrNotFound))
+
+	_, _, err = record.Get(t.Context(), client, namespace, "odh-lint-report-missing")
+	g.Expect(err).To(MatchError(record.ErrNotFound))
+}
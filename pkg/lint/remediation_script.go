@@ -0,0 +1,184 @@
+package lint
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/remediation"
+)
+
+const (
+	// remediationScriptMode makes the generated script executable by its owner only,
+	// since it carries cluster-mutating commands.
+	remediationScriptMode = 0o700
+
+	// remediationAssumeYesEnv lets change-management tooling run the script unattended.
+	remediationAssumeYesEnv = "ODH_REMEDIATION_ASSUME_YES"
+)
+
+const remediationScriptHeader = `#!/usr/bin/env bash
+#
+# Remediation script generated by 'odh lint'.
+#
+# Cluster version: %s
+# Target version:  %s
+#
+# Review every command before running this script. Each step asks for
+# confirmation; set %s=1 to apply all steps non-interactively.
+# Steps are ordered as the checks ran: dependencies, services, components, workloads.
+
+set -euo pipefail
+
+confirm() {
+  if [[ "${%s:-}" == "1" ]]; then
+    return 0
+  fi
+
+  local answer
+  read -r -p "$1 [y/N] " answer
+  [[ "${answer}" == "y" || "${answer}" == "Y" ]]
+}
+
+echo "Current kubectl context: $(kubectl config current-context)"
+confirm "Apply remediation steps against this cluster?" || exit 1
+`
+
+// remediationStep is a single machine-applicable remediation derived from a failing check.
+type remediationStep struct {
+	checkID     string
+	impact      string
+	message     string
+	remediation string
+	commands    []string
+}
+
+// WriteRemediationScript renders the machine-applicable remediation commands of failing
+// results as an ordered, commented shell script with per-step confirmation prompts.
+// Results without remediation commands are listed as manual follow-ups at the end.
+func WriteRemediationScript(
+	out io.Writer,
+	results []check.CheckExecution,
+	clusterVersion *string,
+	targetVersion *string,
+) error {
+	steps, manual := collectRemediationSteps(results)
+
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, remediationScriptHeader,
+		valueOrUnknown(clusterVersion), valueOrUnknown(targetVersion),
+		remediationAssumeYesEnv, remediationAssumeYesEnv)
+
+	if len(steps) == 0 {
+		sb.WriteString("\necho \"No machine-applicable remediation steps found.\"\n")
+	}
+
+	for i, step := range steps {
+		stepLabel := fmt.Sprintf("%d/%d", i+1, len(steps))
+
+		fmt.Fprintf(&sb, "\n# [%s] %s (%s)\n", stepLabel, step.checkID, step.impact)
+		writeComment(&sb, step.message)
+
+		if step.remediation != "" {
+			writeComment(&sb, "Remediation: "+step.remediation)
+		}
+
+		fmt.Fprintf(&sb, "if confirm %s; then\n",
+			remediation.Quote(fmt.Sprintf("Apply step %s (%s)?", stepLabel, step.checkID)))
+
+		for _, command := range step.commands {
+			fmt.Fprintf(&sb, "  %s\n", command)
+		}
+
+		sb.WriteString("fi\n")
+	}
+
+	if len(manual) > 0 {
+		sb.WriteString("\n# The following findings require manual remediation:\n")
+
+		for _, step := range manual {
+			writeComment(&sb, fmt.Sprintf("  - %s (%s): %s", step.checkID, step.impact, step.remediation))
+		}
+	}
+
+	if _, err := io.WriteString(out, sb.String()); err != nil {
+		return fmt.Errorf("writing remediation script: %w", err)
+	}
+
+	return nil
+}
+
+// WriteRemediationScriptFile writes the remediation script to path with owner-only execute permissions.
+func WriteRemediationScriptFile(
+	path string,
+	results []check.CheckExecution,
+	clusterVersion *string,
+	targetVersion *string,
+) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, remediationScriptMode)
+	if err != nil {
+		return fmt.Errorf("creating remediation script %s: %w", path, err)
+	}
+
+	if err := WriteRemediationScript(f, results, clusterVersion, targetVersion); err != nil {
+		_ = f.Close()
+
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("closing remediation script %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// collectRemediationSteps splits failing results into steps with machine-applicable commands
+// and manual follow-ups that only carry remediation guidance.
+func collectRemediationSteps(results []check.CheckExecution) ([]remediationStep, []remediationStep) {
+	var steps, manual []remediationStep
+
+	for _, exec := range results {
+		if exec.Result == nil || !exec.Result.IsFailing() {
+			continue
+		}
+
+		step := remediationStep{
+			checkID:     exec.Check.ID(),
+			message:     exec.Result.GetMessage(),
+			remediation: exec.Result.GetRemediation(),
+			commands:    exec.Result.GetRemediationCommands(),
+		}
+
+		if impact := exec.Result.GetImpact(); impact != nil {
+			step.impact = *impact
+		}
+
+		switch {
+		case len(step.commands) > 0:
+			steps = append(steps, step)
+		case step.remediation != "":
+			manual = append(manual, step)
+		}
+	}
+
+	return steps, manual
+}
+
+// writeComment writes text as shell comment lines, preserving embedded newlines.
+func writeComment(sb *strings.Builder, text string) {
+	for line := range strings.SplitSeq(text, "\n") {
+		fmt.Fprintf(sb, "# %s\n", line)
+	}
+}
+
+func valueOrUnknown(v *string) string {
+	if v == nil || *v == "" {
+		return "unknown"
+	}
+
+	return *v
+}
@@ -0,0 +1,134 @@
+package lint_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/components/codeflare"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/components/trainingoperator"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	testPatchCommand   = `kubectl patch datascienceclusters.datasciencecluster.opendatahub.io 'default-dsc' --type=merge -p '{"spec":{"components":{"codeflare":{"managementState":"Removed"}}}}'`
+	testClusterVersion = "2.25.0"
+	testTargetVersion  = "3.0.0"
+)
+
+func remediationExecutions() []check.CheckExecution {
+	return []check.CheckExecution{
+		{
+			Check: codeflare.NewRemovalCheck(),
+			Result: &result.DiagnosticResult{
+				Group: "components",
+				Kind:  "codeflare",
+				Name:  "removal",
+				Status: result.DiagnosticStatus{
+					Conditions: []result.Condition{
+						check.NewCondition(
+							check.ConditionTypeCompatible,
+							metav1.ConditionFalse,
+							check.WithReason(check.ReasonVersionIncompatible),
+							check.WithMessage("CodeFlare is enabled"),
+							check.WithImpact(result.ImpactBlocking),
+							check.WithRemediation("Disable CodeFlare"),
+							check.WithRemediationCommands(testPatchCommand),
+						),
+					},
+				},
+			},
+		},
+		{
+			Check: trainingoperator.NewDeprecationCheck(),
+			Result: &result.DiagnosticResult{
+				Group: "components",
+				Kind:  "trainingoperator",
+				Name:  "deprecation",
+				Status: result.DiagnosticStatus{
+					Conditions: []result.Condition{
+						check.NewCondition(
+							check.ConditionTypeCompatible,
+							metav1.ConditionFalse,
+							check.WithReason(check.ReasonDeprecated),
+							check.WithMessage("TrainingOperator is deprecated"),
+							check.WithRemediation("Plan migration to Trainer v2"),
+						),
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestWriteRemediationScript(t *testing.T) {
+	g := NewWithT(t)
+
+	var buf bytes.Buffer
+	clusterVer := testClusterVersion
+	targetVer := testTargetVersion
+
+	err := lint.WriteRemediationScript(&buf, remediationExecutions(), &clusterVer, &targetVer)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	script := buf.String()
+	g.Expect(script).To(HavePrefix("#!/usr/bin/env bash\n"))
+	g.Expect(script).To(ContainSubstring("set -euo pipefail"))
+	g.Expect(script).To(ContainSubstring("# Cluster version: 2.25.0"))
+	g.Expect(script).To(ContainSubstring("# Target version:  3.0.0"))
+	g.Expect(script).To(ContainSubstring("# [1/1] components.codeflare.removal (blocking)"))
+	g.Expect(script).To(ContainSubstring("# CodeFlare is enabled"))
+	g.Expect(script).To(ContainSubstring("if confirm 'Apply step 1/1 (components.codeflare.removal)?'; then\n  " + testPatchCommand + "\nfi\n"))
+	g.Expect(script).To(ContainSubstring("# The following findings require manual remediation:"))
+	g.Expect(script).To(ContainSubstring("components.trainingoperator.deprecation (advisory): Plan migration to Trainer v2"))
+}
+
+func TestWriteRemediationScript_SkipsPassingResults(t *testing.T) {
+	g := NewWithT(t)
+
+	results := []check.CheckExecution{
+		{
+			Check: codeflare.NewRemovalCheck(),
+			Result: &result.DiagnosticResult{
+				Group: "components",
+				Kind:  "codeflare",
+				Name:  "removal",
+				Status: result.DiagnosticStatus{
+					Conditions: []result.Condition{passCondition()},
+				},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+
+	err := lint.WriteRemediationScript(&buf, results, nil, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(buf.String()).To(ContainSubstring("# Cluster version: unknown"))
+	g.Expect(buf.String()).To(ContainSubstring("No machine-applicable remediation steps found."))
+	g.Expect(buf.String()).ToNot(ContainSubstring("if confirm 'Apply step"))
+}
+
+func TestWriteRemediationScriptFile(t *testing.T) {
+	g := NewWithT(t)
+
+	path := filepath.Join(t.TempDir(), "fix.sh")
+
+	err := lint.WriteRemediationScriptFile(path, remediationExecutions(), nil, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	info, err := os.Stat(path)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(info.Mode().Perm()).To(Equal(os.FileMode(0o700)))
+
+	content, err := os.ReadFile(path)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(content)).To(ContainSubstring(testPatchCommand))
+}
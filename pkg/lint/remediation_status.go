@@ -0,0 +1,325 @@
+package lint
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	printerjson "github.com/opendatahub-io/odh-cli/pkg/printer/json"
+	"github.com/opendatahub-io/odh-cli/pkg/printer/table"
+	printeryaml "github.com/opendatahub-io/odh-cli/pkg/printer/yaml"
+)
+
+// FindingStatus is the remediation status of a baseline finding after re-evaluation.
+type FindingStatus string
+
+const (
+	// FindingStatusFixed means the finding is no longer reported.
+	FindingStatusFixed FindingStatus = "fixed"
+
+	// FindingStatusPersisting means the finding is still reported.
+	FindingStatusPersisting FindingStatus = "persisting"
+
+	// FindingStatusNew means the finding was not in the baseline, e.g. introduced by a fix.
+	FindingStatusNew FindingStatus = "new"
+
+	// FindingStatusNotApplicable means the check no longer applies to the cluster, so the
+	// finding could not be re-evaluated.
+	FindingStatusNotApplicable FindingStatus = "not-applicable"
+)
+
+// RemediationFinding is one finding of the baseline or the re-evaluation, with its status.
+type RemediationFinding struct {
+	Status    FindingStatus `json:"status" yaml:"status"`
+	CheckID   string        `json:"checkID" yaml:"checkID"`
+	Condition string        `json:"condition,omitempty" yaml:"condition,omitempty"`
+	Impact    string        `json:"impact,omitempty" yaml:"impact,omitempty"`
+	Object    string        `json:"object,omitempty" yaml:"object,omitempty"`
+	Message   string        `json:"message" yaml:"message"`
+}
+
+// LoadBaseline reads a lint JSON or YAML report.
+func LoadBaseline(path string) (*result.DiagnosticResultList, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading baseline: %w", err)
+	}
+
+	var list result.DiagnosticResultList
+
+	// JSON is a subset of YAML, so both lint output formats are accepted.
+	if err := yaml.Unmarshal(data, &list); err != nil {
+		return nil, fmt.Errorf("parsing baseline %s: %w", path, err)
+	}
+
+	if len(list.Results) == 0 {
+		return nil, fmt.Errorf("baseline %s contains no results", path)
+	}
+
+	return &list, nil
+}
+
+// resultKey identifies the check that produced a result.
+func resultKey(group string, kind string, name string) string {
+	return group + "/" + kind + "/" + name
+}
+
+// BaselineChecks returns the registered checks that produced findings in the baseline, and the
+// keys of failing baseline results that no registered check produces.
+func BaselineChecks(registry *check.CheckRegistry, baseline *result.DiagnosticResultList) ([]check.Check, []string) {
+	byKey := make(map[string][]check.Check)
+	for _, chk := range registry.ListAll() {
+		key := resultKey(string(chk.Group()), chk.CheckKind(), chk.CheckType())
+		byKey[key] = append(byKey[key], chk)
+	}
+
+	var checks []check.Check
+
+	var unknown []string
+
+	seen := make(map[string]bool)
+
+	for _, r := range baseline.Results {
+		key := resultKey(r.Group, r.Kind, r.Name)
+		if !r.IsFailing() || seen[key] {
+			continue
+		}
+
+		seen[key] = true
+
+		matched, ok := byKey[key]
+		if !ok {
+			unknown = append(unknown, key)
+
+			continue
+		}
+
+		checks = append(checks, matched...)
+	}
+
+	return checks, unknown
+}
+
+// impactedObjectLabel identifies an impacted object as "Kind namespace/name".
+func impactedObjectLabel(obj metav1.PartialObjectMetadata) string {
+	if obj.Namespace == "" {
+		return obj.Kind + " " + obj.Name
+	}
+
+	return obj.Kind + " " + obj.Namespace + "/" + obj.Name
+}
+
+// finding is a single failing condition, or a single impacted object, of a result.
+type finding struct {
+	key    string
+	result RemediationFinding
+}
+
+// resultFindings splits a failing result into findings: one per impacted object, or one per
+// failing condition when the check reports no objects.
+func resultFindings(r *result.DiagnosticResult, checkID string) []finding {
+	var failing []result.Condition
+
+	for _, cond := range r.Status.Conditions {
+		if cond.Status != metav1.ConditionTrue {
+			failing = append(failing, cond)
+		}
+	}
+
+	if len(failing) == 0 {
+		return nil
+	}
+
+	key := resultKey(r.Group, r.Kind, r.Name)
+
+	var findings []finding
+
+	if len(r.ImpactedObjects) > 0 {
+		impact := ""
+		if i := r.GetImpact(); i != nil {
+			impact = *i
+		}
+
+		for _, obj := range r.ImpactedObjects {
+			object := impactedObjectLabel(obj)
+
+			findings = append(findings, finding{
+				key: key + "|" + object,
+				result: RemediationFinding{
+					CheckID: checkID,
+					Impact:  impact,
+					Object:  object,
+					Message: failing[0].Message,
+				},
+			})
+		}
+
+		return findings
+	}
+
+	for _, cond := range failing {
+		findings = append(findings, finding{
+			key: key + "|" + cond.Type,
+			result: RemediationFinding{
+				CheckID:   checkID,
+				Condition: cond.Type,
+				Impact:    string(cond.Impact),
+				Message:   cond.Message,
+			},
+		})
+	}
+
+	return findings
+}
+
+// CompareFindings classifies the findings of the baseline against the re-evaluated results of
+// the checks that produced them. Baseline findings of checks that no longer apply are reported
+// as not applicable; findings only in the re-evaluation are reported as new.
+func CompareFindings(baseline *result.DiagnosticResultList, current []check.CheckExecution) []RemediationFinding {
+	checkIDs := make(map[string]string, len(current))
+	currentFindings := make(map[string]finding)
+
+	var currentOrder []string
+
+	for _, exec := range current {
+		key := resultKey(exec.Result.Group, exec.Result.Kind, exec.Result.Name)
+		checkIDs[key] = exec.Check.ID()
+
+		for _, f := range resultFindings(exec.Result, exec.Check.ID()) {
+			if _, dup := currentFindings[f.key]; !dup {
+				currentOrder = append(currentOrder, f.key)
+			}
+
+			currentFindings[f.key] = f
+		}
+	}
+
+	var findings []RemediationFinding
+
+	inBaseline := make(map[string]bool)
+
+	for _, r := range baseline.Results {
+		key := resultKey(r.Group, r.Kind, r.Name)
+
+		checkID, reevaluated := checkIDs[key]
+		if !reevaluated {
+			checkID = key
+		}
+
+		for _, f := range resultFindings(r, checkID) {
+			// Lint mode repeats workload check results per discovered instance.
+			if inBaseline[f.key] {
+				continue
+			}
+
+			inBaseline[f.key] = true
+
+			switch cur, persisting := currentFindings[f.key]; {
+			case !reevaluated:
+				f.result.Status = FindingStatusNotApplicable
+			case persisting:
+				f.result = cur.result
+				f.result.Status = FindingStatusPersisting
+			default:
+				f.result.Status = FindingStatusFixed
+			}
+
+			findings = append(findings, f.result)
+		}
+	}
+
+	for _, key := range currentOrder {
+		if inBaseline[key] {
+			continue
+		}
+
+		f := currentFindings[key].result
+		f.Status = FindingStatusNew
+		findings = append(findings, f)
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		return findings[i].CheckID < findings[j].CheckID
+	})
+
+	return findings
+}
+
+// remediationRow is a single row of the remediation status table.
+type remediationRow struct {
+	Status  string `mapstructure:"STATUS"`
+	Check   string `mapstructure:"CHECK"`
+	Object  string `mapstructure:"OBJECT"`
+	Impact  string `mapstructure:"IMPACT"`
+	Message string `mapstructure:"MESSAGE"`
+}
+
+// OutputRemediationStatus renders the findings in the given format, followed by a summary for tables.
+func OutputRemediationStatus(out io.Writer, format OutputFormat, findings []RemediationFinding) error {
+	switch format {
+	case OutputFormatJSON:
+		renderer := printerjson.NewRenderer[[]RemediationFinding](printerjson.WithWriter[[]RemediationFinding](out))
+		if err := renderer.Render(findings); err != nil {
+			return fmt.Errorf("rendering JSON remediation status: %w", err)
+		}
+
+		return nil
+	case OutputFormatYAML:
+		renderer := printeryaml.NewRenderer[[]RemediationFinding](printeryaml.WithWriter[[]RemediationFinding](out))
+		if err := renderer.Render(findings); err != nil {
+			return fmt.Errorf("rendering YAML remediation status: %w", err)
+		}
+
+		return nil
+	case OutputFormatTable:
+		return outputRemediationTable(out, findings)
+	default:
+		return fmt.Errorf("unsupported output format: %s", format)
+	}
+}
+
+func outputRemediationTable(out io.Writer, findings []RemediationFinding) error {
+	renderer := table.NewRenderer(
+		table.WithWriter[remediationRow](out),
+		table.WithHeaders[remediationRow]("STATUS", "CHECK", "OBJECT", "IMPACT", "MESSAGE"),
+		table.WithTableOptions[remediationRow](table.DefaultTableOptions...),
+	)
+
+	counts := make(map[FindingStatus]int)
+
+	for _, f := range findings {
+		counts[f.Status]++
+
+		object := f.Object
+		if object == "" {
+			object = f.Condition
+		}
+
+		row := remediationRow{
+			Status:  string(f.Status),
+			Check:   f.CheckID,
+			Object:  object,
+			Impact:  f.Impact,
+			Message: f.Message,
+		}
+
+		if err := renderer.Append(row); err != nil {
+			return fmt.Errorf("appending remediation row: %w", err)
+		}
+	}
+
+	if err := renderer.Render(); err != nil {
+		return fmt.Errorf("rendering remediation status: %w", err)
+	}
+
+	_, _ = fmt.Fprintf(out, "\nRemediation: %d fixed, %d persisting, %d new, %d not applicable\n",
+		counts[FindingStatusFixed], counts[FindingStatusPersisting], counts[FindingStatusNew], counts[FindingStatusNotApplicable])
+
+	return nil
+}
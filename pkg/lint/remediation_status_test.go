@@ -0,0 +1,160 @@
+package lint_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+
+	. "github.com/onsi/gomega"
+)
+
+func newRemediationCheck(group check.CheckGroup, id string, kind string) *graphTestCheck {
+	return &graphTestCheck{
+		BaseCheck: check.BaseCheck{
+			CheckGroup: group,
+			CheckID:    id,
+			Kind:       kind,
+			Type:       "impacted-workloads",
+		},
+	}
+}
+
+func newRemediationResult(chk *graphTestCheck, conditionType string, objects ...string) *result.DiagnosticResult {
+	dr := chk.NewResult()
+
+	if len(objects) == 0 {
+		dr.SetCondition(check.NewCondition(conditionType, metav1.ConditionTrue, check.WithReason(check.ReasonRequirementsMet)))
+
+		return dr
+	}
+
+	dr.SetCondition(check.NewCondition(conditionType, metav1.ConditionFalse,
+		check.WithReason(check.ReasonWorkloadsImpacted),
+		check.WithMessage("Found impacted workloads"),
+		check.WithImpact(result.ImpactBlocking)))
+
+	for _, name := range objects {
+		dr.ImpactedObjects = append(dr.ImpactedObjects, metav1.PartialObjectMetadata{
+			TypeMeta:   metav1.TypeMeta{Kind: "Notebook"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: name},
+		})
+	}
+
+	return dr
+}
+
+func TestBaselineChecks(t *testing.T) {
+	g := NewWithT(t)
+
+	failing := newRemediationCheck(check.GroupWorkload, "workloads.notebook.impacted", "notebook")
+	passing := newRemediationCheck(check.GroupWorkload, "workloads.ray.impacted", "ray")
+
+	registry := check.NewRegistry()
+	registry.MustRegister(failing)
+	registry.MustRegister(passing)
+
+	removed := newRemediationCheck(check.GroupWorkload, "workloads.removed.impacted", "removed")
+
+	baseline := &result.DiagnosticResultList{Results: []*result.DiagnosticResult{
+		newRemediationResult(failing, "Compatible", "nb-1"),
+		newRemediationResult(failing, "Compatible", "nb-2"),
+		newRemediationResult(passing, "Compatible"),
+		newRemediationResult(removed, "Compatible", "nb-3"),
+	}}
+
+	checks, unknown := lint.BaselineChecks(registry, baseline)
+	g.Expect(checks).To(HaveLen(1))
+	g.Expect(checks[0].ID()).To(Equal("workloads.notebook.impacted"))
+	g.Expect(unknown).To(Equal([]string{"workload/removed/impacted-workloads"}))
+}
+
+func TestCompareFindings(t *testing.T) {
+	g := NewWithT(t)
+
+	notebooks := newRemediationCheck(check.GroupWorkload, "workloads.notebook.impacted", "notebook")
+	ray := newRemediationCheck(check.GroupWorkload, "workloads.ray.impacted", "ray")
+
+	baseline := &result.DiagnosticResultList{Results: []*result.DiagnosticResult{
+		newRemediationResult(notebooks, "Compatible", "fixed-nb", "stuck-nb"),
+		newRemediationResult(ray, "Compatible", "cluster"),
+	}}
+
+	// The ray check no longer applies, so it is not part of the re-evaluation.
+	current := []check.CheckExecution{
+		{Check: notebooks, Result: newRemediationResult(notebooks, "Compatible", "stuck-nb", "new-nb")},
+	}
+
+	findings := lint.CompareFindings(baseline, current)
+
+	statuses := make(map[string]lint.FindingStatus, len(findings))
+	for _, f := range findings {
+		statuses[f.Object] = f.Status
+	}
+
+	g.Expect(statuses).To(Equal(map[string]lint.FindingStatus{
+		"Notebook team-a/fixed-nb": lint.FindingStatusFixed,
+		"Notebook team-a/stuck-nb": lint.FindingStatusPersisting,
+		"Notebook team-a/new-nb":   lint.FindingStatusNew,
+		"Notebook team-a/cluster":  lint.FindingStatusNotApplicable,
+	}))
+
+	var buf bytes.Buffer
+	g.Expect(lint.OutputRemediationStatus(&buf, lint.OutputFormatTable, findings)).To(Succeed())
+	g.Expect(buf.String()).To(ContainSubstring("Remediation: 1 fixed, 1 persisting, 1 new, 1 not applicable"))
+}
+
+func TestCompareFindings_ConditionsWithoutObjects(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := newRemediationCheck(check.GroupComponent, "components.kserve.config", "kserve")
+
+	failing := chk.NewResult()
+	failing.SetCondition(check.NewCondition("Configured", metav1.ConditionFalse,
+		check.WithReason(check.ReasonConfigurationInvalid), check.WithMessage("not configured")))
+
+	baseline := &result.DiagnosticResultList{Results: []*result.DiagnosticResult{failing}}
+	current := []check.CheckExecution{{Check: chk, Result: newRemediationResult(chk, "Configured")}}
+
+	findings := lint.CompareFindings(baseline, current)
+	g.Expect(findings).To(Equal([]lint.RemediationFinding{{
+		Status:    lint.FindingStatusFixed,
+		CheckID:   "components.kserve.config",
+		Condition: "Configured",
+		Impact:    string(result.ImpactAdvisory),
+		Message:   "not configured",
+	}}))
+}
+
+func TestLoadBaseline(t *testing.T) {
+	g := NewWithT(t)
+
+	chk := newRemediationCheck(check.GroupWorkload, "workloads.notebook.impacted", "notebook")
+	clusterVersion, targetVersion := "2.25.0", "3.0.0"
+
+	var buf bytes.Buffer
+	g.Expect(lint.OutputJSON(&buf, []check.CheckExecution{
+		{Check: chk, Result: newRemediationResult(chk, "Compatible", "nb")},
+	}, &clusterVersion, &targetVersion)).To(Succeed())
+
+	path := filepath.Join(t.TempDir(), "first-run.json")
+	g.Expect(os.WriteFile(path, buf.Bytes(), 0o600)).To(Succeed())
+
+	baseline, err := lint.LoadBaseline(path)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(*baseline.TargetVersion).To(Equal("3.0.0"))
+	g.Expect(baseline.Results).To(HaveLen(1))
+	g.Expect(baseline.Results[0].ImpactedObjects).To(HaveLen(1))
+
+	empty := filepath.Join(t.TempDir(), "empty.json")
+	g.Expect(os.WriteFile(empty, []byte(`{"results":[]}`), 0o600)).To(Succeed())
+
+	_, err = lint.LoadBaseline(empty)
+	g.Expect(err).To(MatchError(ContainSubstring("contains no results")))
+}
@@ -0,0 +1,77 @@
+package lint
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+)
+
+// LoadCheckSelectors reads a --checks-from-file selector list.
+func LoadCheckSelectors(file string) ([]string, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("reading checks file: %w", err)
+	}
+
+	selectors, err := ParseCheckSelectors(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+
+	return selectors, nil
+}
+
+// ParseCheckSelectors parses a selector list: one check selector per line, as accepted by
+// --checks. Blank lines and comments, from "#" to the end of the line, are ignored.
+func ParseCheckSelectors(data []byte) ([]string, error) {
+	var selectors []string
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+
+	for line := 1; scanner.Scan(); line++ {
+		selector, _, _ := strings.Cut(scanner.Text(), "#")
+
+		selector = strings.TrimSpace(selector)
+		if selector == "" {
+			continue
+		}
+
+		if err := ValidateCheckSelector(selector); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		selectors = append(selectors, selector)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("parsing check selectors: %w", err)
+	}
+
+	if len(selectors) == 0 {
+		return nil, errors.New("no check selectors found")
+	}
+
+	return selectors, nil
+}
+
+// mergeCheckSelectors adds the selectors read from a file to those of --checks. The default
+// "*" of --checks is dropped, so the file alone selects the checks unless --checks is set.
+func mergeCheckSelectors(flagSelectors []string, fileSelectors []string) []string {
+	if slices.Equal(flagSelectors, []string{"*"}) {
+		flagSelectors = nil
+	}
+
+	merged := slices.Clone(flagSelectors)
+
+	for _, selector := range fileSelectors {
+		if !slices.Contains(merged, selector) {
+			merged = append(merged, selector)
+		}
+	}
+
+	return merged
+}
@@ -0,0 +1,102 @@
+package lint_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+const testChecksFile = `# Curated checks for the 3.0 upgrade
+components.*
+
+workloads.notebook.*   # workbenches only
+dependencies.certmanager.installed
+`
+
+func TestParseCheckSelectors(t *testing.T) {
+	t.Run("should skip comments and blank lines", func(t *testing.T) {
+		g := NewWithT(t)
+
+		selectors, err := lint.ParseCheckSelectors([]byte(testChecksFile))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(selectors).To(Equal([]string{
+			"components.*",
+			"workloads.notebook.*",
+			"dependencies.certmanager.installed",
+		}))
+	})
+
+	t.Run("should report the line of an invalid selector", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := lint.ParseCheckSelectors([]byte("components.*\n\nworkloads.[\n"))
+		g.Expect(err).To(MatchError(ContainSubstring("line 3: invalid check selector pattern")))
+	})
+
+	t.Run("should reject a file without selectors", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := lint.ParseCheckSelectors([]byte("# nothing selected\n\n"))
+		g.Expect(err).To(MatchError("no check selectors found"))
+	})
+}
+
+func TestSharedOptions_ChecksFromFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "checks.txt")
+	if err := os.WriteFile(file, []byte(testChecksFile), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	newOptions := func(selectors ...string) *lint.SharedOptions {
+		opts := lint.NewSharedOptions(genericiooptions.NewTestIOStreamsDiscard(), testConfigFlags())
+		opts.Client = client.NewForTesting(client.TestClientConfig{})
+		opts.ChecksFromFile = file
+
+		if len(selectors) > 0 {
+			opts.CheckSelectors = selectors
+		}
+
+		return opts
+	}
+
+	t.Run("should replace the default selector", func(t *testing.T) {
+		g := NewWithT(t)
+
+		opts := newOptions()
+		g.Expect(opts.Complete()).To(Succeed())
+		g.Expect(opts.CheckSelectors).To(Equal([]string{
+			"components.*",
+			"workloads.notebook.*",
+			"dependencies.certmanager.installed",
+		}))
+		g.Expect(opts.Validate()).To(Succeed())
+	})
+
+	t.Run("should merge with --checks selectors", func(t *testing.T) {
+		g := NewWithT(t)
+
+		opts := newOptions("services.*", "components.*")
+		g.Expect(opts.Complete()).To(Succeed())
+		g.Expect(opts.CheckSelectors).To(Equal([]string{
+			"services.*",
+			"components.*",
+			"workloads.notebook.*",
+			"dependencies.certmanager.installed",
+		}))
+	})
+
+	t.Run("should fail on a missing file", func(t *testing.T) {
+		g := NewWithT(t)
+
+		opts := newOptions()
+		opts.ChecksFromFile = filepath.Join(t.TempDir(), "missing.txt")
+		g.Expect(opts.Complete()).To(MatchError(ContainSubstring("loading --checks-from-file")))
+	})
+}
@@ -0,0 +1,156 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/opendatahub-io/odh-cli/internal/version"
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+)
+
+// redacted replaces the values of sensitive flags in the recorded command line.
+const redacted = "REDACTED"
+
+// sensitiveFlags are the flags whose values are never recorded in the run summary.
+//
+//nolint:gochecknoglobals // Read-only lookup table
+var sensitiveFlags = []string{"--token", "--password"}
+
+// RunSummary is the small, format-independent summary of a lint run written by --summary-file,
+// so CI systems can gate on it whatever the main output format is.
+type RunSummary struct {
+	CommandLine     []string      `json:"commandLine"`
+	CLIVersion      string        `json:"cliVersion"`
+	ClusterVersion  string        `json:"clusterVersion"`
+	TargetVersion   string        `json:"targetVersion,omitempty"`
+	StartedAt       time.Time     `json:"startedAt"`
+	DurationSeconds float64       `json:"durationSeconds"`
+	Totals          SummaryTotals `json:"totals"`
+	Gate            SummaryGate   `json:"gate"`
+}
+
+// SummaryTotals counts conditions by outcome, as in the table output summary.
+type SummaryTotals struct {
+	Total    int `json:"total"`
+	Passed   int `json:"passed"`
+	Warnings int `json:"warnings"`
+	Failed   int `json:"failed"`
+}
+
+// SummaryGate is the state of the --fail-on gate, which determines the exit code of the run.
+type SummaryGate struct {
+	Passed         bool       `json:"passed"`
+	FailOn         cmd.FailOn `json:"failOn"`
+	FailOnCritical bool       `json:"failOnCritical"`
+	FailOnWarning  bool       `json:"failOnWarning"`
+	ExitCode       int        `json:"exitCode"`
+	Reason         string     `json:"reason,omitempty"`
+}
+
+// NewSummaryTotals counts the conditions of results by impact: blocking conditions are
+// failures, advisory ones warnings and all others passes.
+func NewSummaryTotals(results []check.CheckExecution) SummaryTotals {
+	var totals SummaryTotals
+
+	for _, exec := range results {
+		if exec.Result == nil {
+			continue
+		}
+
+		for _, condition := range exec.Result.Status.Conditions {
+			totals.Total++
+
+			switch condition.Impact {
+			case result.ImpactBlocking:
+				totals.Failed++
+			case result.ImpactAdvisory:
+				totals.Warnings++
+			default:
+				totals.Passed++
+			}
+		}
+	}
+
+	return totals
+}
+
+// RedactCommandLine returns args with the values of sensitive flags (e.g. --token) replaced.
+func RedactCommandLine(args []string) []string {
+	redactedArgs := make([]string, len(args))
+	copy(redactedArgs, args)
+
+	for i := 0; i < len(redactedArgs); i++ {
+		for _, flag := range sensitiveFlags {
+			switch {
+			case redactedArgs[i] == flag && i+1 < len(redactedArgs):
+				i++
+				redactedArgs[i] = redacted
+			case strings.HasPrefix(redactedArgs[i], flag+"="):
+				redactedArgs[i] = flag + "=" + redacted
+			}
+		}
+	}
+
+	return redactedArgs
+}
+
+// WriteRunSummary writes the summary as indented JSON.
+func WriteRunSummary(w io.Writer, summary *RunSummary) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(summary); err != nil {
+		return fmt.Errorf("encoding run summary: %w", err)
+	}
+
+	return nil
+}
+
+// writeSummary writes the run summary to --summary-file, if set. gateErr is the outcome of
+// the fail-on gate, i.e. the error determining the exit code of the run.
+func (c *Command) writeSummary(
+	targetVersion string,
+	resultsByGroup map[check.CheckGroup][]check.CheckExecution,
+	gateErr error,
+) error {
+	if c.SummaryFile == "" {
+		return nil
+	}
+
+	summary := &RunSummary{
+		CommandLine:     RedactCommandLine(os.Args),
+		CLIVersion:      version.GetVersion(),
+		ClusterVersion:  c.currentClusterVersion,
+		TargetVersion:   targetVersion,
+		StartedAt:       c.startedAt.UTC(),
+		DurationSeconds: time.Since(c.startedAt).Seconds(),
+		Totals:          NewSummaryTotals(FlattenResults(resultsByGroup)),
+		Gate: SummaryGate{
+			Passed:         gateErr == nil,
+			FailOn:         cmd.NewFailOn(c.FailOnCritical, c.FailOnWarning),
+			FailOnCritical: c.FailOnCritical,
+			FailOnWarning:  c.FailOnWarning,
+			ExitCode:       cmd.ExitCode(gateErr),
+		},
+	}
+
+	if gateErr != nil {
+		summary.Gate.Reason = gateErr.Error()
+	}
+
+	if err := writeOutputFile(c.SummaryFile, func(out io.Writer) error {
+		return WriteRunSummary(out, summary)
+	}); err != nil {
+		return fmt.Errorf("writing run summary: %w", err)
+	}
+
+	c.IO.Errorf("Wrote run summary to %s", c.SummaryFile)
+
+	return nil
+}
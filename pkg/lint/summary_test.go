@@ -0,0 +1,53 @@
+package lint_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestNewSummaryTotals(t *testing.T) {
+	g := NewWithT(t)
+
+	totals := lint.NewSummaryTotals(remediationExecutions())
+
+	g.Expect(totals.Total).To(Equal(2))
+	g.Expect(totals.Failed).To(Equal(1))
+	g.Expect(totals.Passed + totals.Warnings + totals.Failed).To(Equal(totals.Total))
+}
+
+func TestRedactCommandLine(t *testing.T) {
+	g := NewWithT(t)
+
+	args := []string{"kubectl-odh", "lint", "--token", "sha256~secret", "--password=hunter2", "--target-version", "3.0"}
+
+	g.Expect(lint.RedactCommandLine(args)).To(Equal([]string{
+		"kubectl-odh", "lint", "--token", "REDACTED", "--password=REDACTED", "--target-version", "3.0",
+	}))
+	g.Expect(args[3]).To(Equal("sha256~secret"))
+}
+
+func TestWriteRunSummary(t *testing.T) {
+	g := NewWithT(t)
+
+	summary := &lint.RunSummary{
+		CommandLine:    []string{"kubectl-odh", "lint", "-o", "table"},
+		ClusterVersion: testClusterVersion,
+		TargetVersion:  testTargetVersion,
+		Totals:         lint.NewSummaryTotals(remediationExecutions()),
+		Gate:           lint.SummaryGate{FailOnCritical: true, Reason: "blocking findings detected"},
+	}
+
+	var buf bytes.Buffer
+	g.Expect(lint.WriteRunSummary(&buf, summary)).To(Succeed())
+
+	var decoded map[string]any
+	g.Expect(json.Unmarshal(buf.Bytes(), &decoded)).To(Succeed())
+	g.Expect(decoded).To(HaveKeyWithValue("targetVersion", testTargetVersion))
+	g.Expect(decoded).To(HaveKeyWithValue("gate", HaveKeyWithValue("passed", false)))
+	g.Expect(decoded).To(HaveKeyWithValue("totals", HaveKeyWithValue("failed", BeNumerically("==", 1))))
+}
@@ -0,0 +1,184 @@
+// Package telemetry builds and sends the opt-in, anonymized statistics of lint runs.
+//
+// A report only contains check IDs, per-check pass/fail counts, a cluster size bucket and
+// versions: no object names, namespaces, messages or cluster identifiers are collected. Checks
+// of out-of-tree plugins and declarative checks loaded with --checks-dir are left out, as their
+// IDs can name the organization using them.
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/declarative"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/plugin"
+)
+
+const (
+	// SchemaVersion is the version of the report format.
+	SchemaVersion = "v1"
+
+	// EnvEndpoint sets the endpoint reports are posted to when --telemetry-endpoint is not set.
+	EnvEndpoint = "ODH_TELEMETRY_ENDPOINT"
+
+	// SizeUnknown is the cluster size bucket used when nodes cannot be listed.
+	SizeUnknown = "unknown"
+
+	statusPass  = "Pass"
+	statusFail  = "Fail"
+	contentType = "application/json"
+)
+
+// sizeBuckets are the upper bounds (inclusive) of the cluster size buckets, by node count.
+//
+//nolint:gochecknoglobals // Read-only lookup table
+var sizeBuckets = []struct {
+	max  int
+	name string
+}{
+	{max: 3, name: "1-3"},
+	{max: 10, name: "4-10"},
+	{max: 50, name: "11-50"},
+	{max: 200, name: "51-200"},
+}
+
+// Report is the payload sent for a lint run.
+type Report struct {
+	SchemaVersion  string       `json:"schemaVersion"`
+	CLIVersion     string       `json:"cliVersion"`
+	ClusterVersion string       `json:"clusterVersion"`
+	TargetVersion  string       `json:"targetVersion,omitempty"`
+	ClusterSize    string       `json:"clusterSize"`
+	Checks         []CheckStats `json:"checks"`
+}
+
+// CheckStats are the aggregated results of one check. Workload checks are executed once per
+// workload, so Executed may be greater than one.
+type CheckStats struct {
+	ID       string `json:"id"`
+	Executed int    `json:"executed"`
+	Passed   int    `json:"passed"`
+	Failed   int    `json:"failed"`
+	Errored  int    `json:"errored"`
+}
+
+// SizeBucket returns the cluster size bucket of a node count.
+func SizeBucket(nodes int) string {
+	if nodes <= 0 {
+		return SizeUnknown
+	}
+
+	for _, bucket := range sizeBuckets {
+		if nodes <= bucket.max {
+			return bucket.name
+		}
+	}
+
+	return fmt.Sprintf("%d+", sizeBuckets[len(sizeBuckets)-1].max+1)
+}
+
+// NewReport aggregates the executions of built-in checks into a report, sorted by check ID.
+func NewReport(
+	executions []check.CheckExecution,
+	cliVersion string,
+	clusterVersion string,
+	targetVersion string,
+	clusterSize string,
+) *Report {
+	byID := make(map[string]*CheckStats)
+
+	for _, exec := range executions {
+		id := exec.Check.ID()
+		if !builtIn(id) {
+			continue
+		}
+
+		stats, ok := byID[id]
+		if !ok {
+			stats = &CheckStats{ID: id}
+			byID[id] = stats
+		}
+
+		stats.Executed++
+
+		switch {
+		case exec.Result == nil:
+			stats.Errored++
+		case exec.Result.GetStatusString() == statusPass:
+			stats.Passed++
+		case exec.Result.GetStatusString() == statusFail:
+			stats.Failed++
+		default:
+			stats.Errored++
+		}
+	}
+
+	checks := make([]CheckStats, 0, len(byID))
+	for _, stats := range byID {
+		checks = append(checks, *stats)
+	}
+
+	sort.Slice(checks, func(i, j int) bool {
+		return checks[i].ID < checks[j].ID
+	})
+
+	return &Report{
+		SchemaVersion:  SchemaVersion,
+		CLIVersion:     cliVersion,
+		ClusterVersion: clusterVersion,
+		TargetVersion:  targetVersion,
+		ClusterSize:    clusterSize,
+		Checks:         checks,
+	}
+}
+
+// builtIn returns whether id is the ID of a check compiled into the CLI.
+func builtIn(id string) bool {
+	return !strings.HasPrefix(id, plugin.IDPrefix) && !strings.HasPrefix(id, declarative.IDPrefix)
+}
+
+// Write writes the report as indented JSON, exactly as it is sent.
+func Write(w io.Writer, report *Report) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(report); err != nil {
+		return fmt.Errorf("encoding telemetry report: %w", err)
+	}
+
+	return nil
+}
+
+// Send posts the report to endpoint.
+func Send(ctx context.Context, httpClient *http.Client, endpoint string, report *Report) error {
+	var body bytes.Buffer
+	if err := Write(&body, report); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, &body)
+	if err != nil {
+		return fmt.Errorf("creating request for %s: %w", endpoint, err)
+	}
+
+	req.Header.Set("Content-Type", contentType)
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("posting telemetry to %s: %w", endpoint, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("posting telemetry to %s: %s", endpoint, resp.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package telemetry_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/declarative"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/plugin"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/telemetry"
+
+	. "github.com/onsi/gomega"
+)
+
+// stubCheck is a check with a fixed ID; only ID is used by the telemetry report.
+type stubCheck struct {
+	check.BaseCheck
+}
+
+func (c *stubCheck) CanApply(_ context.Context, _ check.Target) (bool, error) {
+	return true, nil
+}
+
+func (c *stubCheck) Validate(_ context.Context, _ check.Target) (*result.DiagnosticResult, error) {
+	return c.NewResult(), nil
+}
+
+func execution(id string, status metav1.ConditionStatus) check.CheckExecution {
+	chk := &stubCheck{BaseCheck: check.BaseCheck{CheckID: id}}
+
+	dr := chk.NewResult()
+	dr.Status.Conditions = []result.Condition{
+		check.NewCondition(check.ConditionTypeValidated, status, check.WithReason(check.ReasonRequirementsMet),
+			check.WithMessage("notebook team-a/secret-name is impacted")),
+	}
+	dr.ImpactedObjects = []metav1.PartialObjectMetadata{
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "secret-name"}},
+	}
+
+	return check.CheckExecution{Check: chk, Result: dr}
+}
+
+func TestSizeBucket(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(telemetry.SizeBucket(0)).To(Equal(telemetry.SizeUnknown))
+	g.Expect(telemetry.SizeBucket(3)).To(Equal("1-3"))
+	g.Expect(telemetry.SizeBucket(4)).To(Equal("4-10"))
+	g.Expect(telemetry.SizeBucket(200)).To(Equal("51-200"))
+	g.Expect(telemetry.SizeBucket(201)).To(Equal("201+"))
+}
+
+func TestNewReport_AggregatesPerCheck(t *testing.T) {
+	g := NewWithT(t)
+
+	report := telemetry.NewReport([]check.CheckExecution{
+		execution("workloads.notebook.impacted", metav1.ConditionFalse),
+		execution("components.kserve.serverless", metav1.ConditionTrue),
+		execution("workloads.notebook.impacted", metav1.ConditionTrue),
+		execution("workloads.notebook.impacted", metav1.ConditionUnknown),
+		{Check: &stubCheck{BaseCheck: check.BaseCheck{CheckID: "services.servicemesh"}}},
+	}, "1.2.0", "2.25.0", "3.0.0", "4-10")
+
+	g.Expect(report.SchemaVersion).To(Equal(telemetry.SchemaVersion))
+	g.Expect(report.TargetVersion).To(Equal("3.0.0"))
+	g.Expect(report.Checks).To(Equal([]telemetry.CheckStats{
+		{ID: "components.kserve.serverless", Executed: 1, Passed: 1},
+		{ID: "services.servicemesh", Executed: 1, Errored: 1},
+		{ID: "workloads.notebook.impacted", Executed: 3, Passed: 1, Failed: 1, Errored: 1},
+	}))
+}
+
+func TestNewReport_OmitsPluginAndDeclarativeChecks(t *testing.T) {
+	g := NewWithT(t)
+
+	report := telemetry.NewReport([]check.CheckExecution{
+		execution("components.kserve.serverless", metav1.ConditionTrue),
+		execution(plugin.IDPrefix+"acme-quota.gpu", metav1.ConditionFalse),
+		execution(declarative.IDPrefix+"acme.notebooks.gpu-limits", metav1.ConditionFalse),
+	}, "1.2.0", "2.25.0", "3.0.0", "4-10")
+
+	g.Expect(report.Checks).To(Equal([]telemetry.CheckStats{
+		{ID: "components.kserve.serverless", Executed: 1, Passed: 1},
+	}))
+
+	var body bytes.Buffer
+	g.Expect(telemetry.Write(&body, report)).To(Succeed())
+	g.Expect(body.String()).ToNot(ContainSubstring("acme"))
+}
+
+func TestSend_PostsAnonymizedReport(t *testing.T) {
+	g := NewWithT(t)
+
+	var body []byte
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		g.Expect(r.Method).To(Equal(http.MethodPost))
+		g.Expect(r.Header.Get("Content-Type")).To(Equal("application/json"))
+
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	report := telemetry.NewReport([]check.CheckExecution{
+		execution("workloads.notebook.impacted", metav1.ConditionFalse),
+	}, "1.2.0", "2.25.0", "", "1-3")
+
+	g.Expect(telemetry.Send(t.Context(), server.Client(), server.URL, report)).To(Succeed())
+
+	var sent map[string]any
+	g.Expect(json.Unmarshal(body, &sent)).To(Succeed())
+	g.Expect(sent).To(HaveKeyWithValue("clusterSize", "1-3"))
+	g.Expect(sent).ToNot(HaveKey("targetVersion"))
+	g.Expect(string(body)).ToNot(ContainSubstring("team-a"))
+	g.Expect(string(body)).ToNot(ContainSubstring("secret-name"))
+}
+
+func TestSend_ReportsHTTPErrors(t *testing.T) {
+	g := NewWithT(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	err := telemetry.Send(t.Context(), server.Client(), server.URL, telemetry.NewReport(nil, "dev", "2.25.0", "", "1-3"))
+
+	g.Expect(err).To(MatchError(ContainSubstring("503")))
+}
@@ -0,0 +1,236 @@
+package lint
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+)
+
+// WorklistFormat is the file format of the impacted-object worklists written by --export-impacted.
+type WorklistFormat string
+
+const (
+	// WorklistFormatCSV writes one CSV file per check, with a header row.
+	WorklistFormatCSV WorklistFormat = "csv"
+
+	// WorklistFormatJSON writes one JSON array per check.
+	WorklistFormatJSON WorklistFormat = "json"
+)
+
+const (
+	// worklistDirMode is the permission mode of the directories written by --export-impacted.
+	worklistDirMode = 0o755
+
+	// unownedWorklist is the group of impacted objects whose namespace lacks the
+	// --export-group-by annotation, including cluster-scoped objects.
+	unownedWorklist = "_unowned"
+)
+
+// worklistHeader is the header row of CSV worklists, in WorklistItem field order.
+//
+//nolint:gochecknoglobals // Read-only header row
+var worklistHeader = []string{
+	"check", "impact", "apiVersion", "kind", "namespace", "name", "reason", "remediation", "owner", "deadline",
+}
+
+// unsafePathChars matches the characters replaced in worklist group directory names.
+//
+//nolint:gochecknoglobals // Compiled once
+var unsafePathChars = regexp.MustCompile(`[^A-Za-z0-9._@-]`)
+
+// WorklistItem is an impacted object to remediate, with the finding that reported it.
+type WorklistItem struct {
+	Check       string `json:"check"`
+	Impact      string `json:"impact"`
+	APIVersion  string `json:"apiVersion"`
+	Kind        string `json:"kind"`
+	Namespace   string `json:"namespace,omitempty"`
+	Name        string `json:"name"`
+	Reason      string `json:"reason,omitempty"`
+	Remediation string `json:"remediation,omitempty"`
+
+	// Owner and Deadline are the --assignments owner and deadline of the object.
+	Owner    string `json:"owner,omitempty"`
+	Deadline string `json:"deadline,omitempty"`
+}
+
+// BuildWorklists lists the impacted objects of the blocking and advisory results, keyed by
+// check ID. The reason of an object is its check.AnnotationImpactReason annotation, and its
+// remediation the remediation of the result, or its commands.
+func BuildWorklists(results []check.CheckExecution) map[string][]WorklistItem {
+	worklists := make(map[string][]WorklistItem)
+
+	for _, exec := range results {
+		if exec.Result == nil || len(exec.Result.ImpactedObjects) == 0 {
+			continue
+		}
+
+		impact := exec.Result.GetImpact()
+		if impact == nil || *impact == string(resultpkg.ImpactNone) {
+			continue
+		}
+
+		remediation := exec.Result.GetRemediation()
+		if remediation == "" {
+			remediation = strings.Join(exec.Result.GetRemediationCommands(), "; ")
+		}
+
+		checkID := exec.Check.ID()
+
+		for _, obj := range exec.Result.ImpactedObjects {
+			worklists[checkID] = append(worklists[checkID], WorklistItem{
+				Check:       checkID,
+				Impact:      *impact,
+				APIVersion:  obj.APIVersion,
+				Kind:        obj.Kind,
+				Namespace:   obj.Namespace,
+				Name:        obj.Name,
+				Reason:      obj.Annotations[check.AnnotationImpactReason],
+				Remediation: remediation,
+				Owner:       obj.Annotations[AnnotationAssignmentOwner],
+				Deadline:    obj.Annotations[AnnotationAssignmentDeadline],
+			})
+		}
+	}
+
+	return worklists
+}
+
+// WriteWorklists writes one file per check of worklists into dir, named after the check ID.
+// With namespaceOwners, the objects are first grouped into a subdirectory per owner of their
+// namespace; objects without an owner are grouped under "_unowned". It returns the paths of
+// the written files.
+func WriteWorklists(
+	dir string,
+	format WorklistFormat,
+	worklists map[string][]WorklistItem,
+	namespaceOwners map[string]string,
+) ([]string, error) {
+	groups := map[string]map[string][]WorklistItem{"": worklists}
+
+	if namespaceOwners != nil {
+		groups = make(map[string]map[string][]WorklistItem)
+
+		for checkID, items := range worklists {
+			for _, item := range items {
+				owner := unownedWorklist
+				if value := namespaceOwners[item.Namespace]; value != "" && item.Namespace != "" {
+					owner = worklistGroupDir(value)
+				}
+
+				if groups[owner] == nil {
+					groups[owner] = make(map[string][]WorklistItem)
+				}
+
+				groups[owner][checkID] = append(groups[owner][checkID], item)
+			}
+		}
+	}
+
+	var written []string
+
+	for _, group := range slices.Sorted(maps.Keys(groups)) {
+		groupDir := filepath.Join(dir, group)
+
+		if err := os.MkdirAll(groupDir, worklistDirMode); err != nil {
+			return written, fmt.Errorf("creating %s: %w", groupDir, err)
+		}
+
+		for _, checkID := range slices.Sorted(maps.Keys(groups[group])) {
+			path := filepath.Join(groupDir, checkID+"."+string(format))
+			items := groups[group][checkID]
+
+			if err := writeOutputFile(path, func(out io.Writer) error {
+				return writeWorklist(out, format, items)
+			}); err != nil {
+				return written, fmt.Errorf("writing worklist %s: %w", path, err)
+			}
+
+			written = append(written, path)
+		}
+	}
+
+	return written, nil
+}
+
+// worklistGroupDir returns the directory name of the worklists of an owner, replacing the
+// characters unsafe in file names.
+func worklistGroupDir(owner string) string {
+	dir := unsafePathChars.ReplaceAllString(owner, "_")
+	if dir == "." || dir == ".." {
+		return strings.Repeat("_", len(dir))
+	}
+
+	return dir
+}
+
+// writeWorklist renders a worklist in format.
+func writeWorklist(out io.Writer, format WorklistFormat, items []WorklistItem) error {
+	switch format {
+	case WorklistFormatJSON:
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+
+		if err := encoder.Encode(items); err != nil {
+			return fmt.Errorf("encoding worklist: %w", err)
+		}
+
+		return nil
+	case WorklistFormatCSV:
+		w := csv.NewWriter(out)
+
+		_ = w.Write(worklistHeader)
+
+		for _, item := range items {
+			_ = w.Write([]string{
+				item.Check, item.Impact, item.APIVersion, item.Kind, item.Namespace, item.Name,
+				item.Reason, item.Remediation, item.Owner, item.Deadline,
+			})
+		}
+
+		w.Flush()
+
+		if err := w.Error(); err != nil {
+			return fmt.Errorf("writing worklist: %w", err)
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unsupported worklist format: %s", format)
+	}
+}
+
+// exportImpacted writes the impacted-object worklists when --export-impacted is set.
+func (c *Command) exportImpacted(ctx context.Context, results []check.CheckExecution) error {
+	if c.ExportImpacted == "" {
+		return nil
+	}
+
+	var namespaceOwners map[string]string
+	if c.ExportGroupBy != "" {
+		namespaceOwners = collectNamespaceAnnotation(ctx, c.Reader, results, c.ExportGroupBy)
+		if namespaceOwners == nil {
+			namespaceOwners = map[string]string{}
+		}
+	}
+
+	written, err := WriteWorklists(c.ExportImpacted, c.ExportFormat, BuildWorklists(results), namespaceOwners)
+	if err != nil {
+		return fmt.Errorf("exporting impacted objects: %w", err)
+	}
+
+	c.IO.Errorf("Wrote %d impacted-object worklist(s) to %s", len(written), c.ExportImpacted)
+
+	return nil
+}
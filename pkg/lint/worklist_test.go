@@ -0,0 +1,126 @@
+package lint_test
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/notebook"
+
+	. "github.com/onsi/gomega"
+)
+
+func impactedNotebook(namespace string, name string, reason string) metav1.PartialObjectMetadata {
+	return metav1.PartialObjectMetadata{
+		TypeMeta: metav1.TypeMeta{APIVersion: "kubeflow.org/v1", Kind: "Notebook"},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   namespace,
+			Name:        name,
+			Annotations: map[string]string{check.AnnotationImpactReason: reason},
+		},
+	}
+}
+
+func worklistExecutions() []check.CheckExecution {
+	executions := remediationExecutions()
+
+	executions = append(executions, check.CheckExecution{
+		Check: notebook.NewImpactedWorkloadsCheck(),
+		Result: &result.DiagnosticResult{
+			Group: "workloads",
+			Kind:  "notebook",
+			Name:  "impacted-workloads",
+			Status: result.DiagnosticStatus{
+				Conditions: []result.Condition{
+					check.NewCondition(
+						check.ConditionTypeCompatible,
+						metav1.ConditionFalse,
+						check.WithReason(check.ReasonVersionIncompatible),
+						check.WithMessage("Found 2 workbenches with images removed in 3.0"),
+						check.WithImpact(result.ImpactBlocking),
+						check.WithRemediation("Update the workbench image"),
+					),
+				},
+			},
+			ImpactedObjects: []metav1.PartialObjectMetadata{
+				impactedNotebook("team-a", "wb-1", "image removed"),
+				impactedNotebook("team-b", "wb-2", "image removed"),
+			},
+		},
+	})
+
+	return executions
+}
+
+func TestBuildWorklists(t *testing.T) {
+	g := NewWithT(t)
+
+	worklists := lint.BuildWorklists(worklistExecutions())
+
+	checkID := notebook.NewImpactedWorkloadsCheck().ID()
+	g.Expect(worklists).To(HaveLen(1))
+	g.Expect(worklists[checkID]).To(ConsistOf(
+		lint.WorklistItem{
+			Check: checkID, Impact: "blocking", APIVersion: "kubeflow.org/v1", Kind: "Notebook",
+			Namespace: "team-a", Name: "wb-1", Reason: "image removed", Remediation: "Update the workbench image",
+		},
+		lint.WorklistItem{
+			Check: checkID, Impact: "blocking", APIVersion: "kubeflow.org/v1", Kind: "Notebook",
+			Namespace: "team-b", Name: "wb-2", Reason: "image removed", Remediation: "Update the workbench image",
+		},
+	))
+}
+
+func TestWriteWorklists(t *testing.T) {
+	checkID := notebook.NewImpactedWorkloadsCheck().ID()
+
+	t.Run("should write a CSV file per check", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dir := t.TempDir()
+
+		written, err := lint.WriteWorklists(dir, lint.WorklistFormatCSV, lint.BuildWorklists(worklistExecutions()), nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(written).To(Equal([]string{filepath.Join(dir, checkID+".csv")}))
+
+		f, err := os.Open(written[0])
+		g.Expect(err).ToNot(HaveOccurred())
+
+		defer func() { _ = f.Close() }()
+
+		rows, err := csv.NewReader(f).ReadAll()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(rows).To(HaveLen(3))
+		g.Expect(rows[0][:6]).To(Equal([]string{"check", "impact", "apiVersion", "kind", "namespace", "name"}))
+		g.Expect(rows[1][4:8]).To(Equal([]string{"team-a", "wb-1", "image removed", "Update the workbench image"}))
+	})
+
+	t.Run("should group JSON files by namespace owner", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dir := t.TempDir()
+		owners := map[string]string{"team-a": "alice@example.com", "other": "bob"}
+
+		written, err := lint.WriteWorklists(dir, lint.WorklistFormatJSON, lint.BuildWorklists(worklistExecutions()), owners)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(written).To(Equal([]string{
+			filepath.Join(dir, "_unowned", checkID+".json"),
+			filepath.Join(dir, "alice@example.com", checkID+".json"),
+		}))
+
+		data, err := os.ReadFile(written[1])
+		g.Expect(err).ToNot(HaveOccurred())
+
+		var items []lint.WorklistItem
+		g.Expect(json.Unmarshal(data, &items)).To(Succeed())
+		g.Expect(items).To(HaveLen(1))
+		g.Expect(items[0].Name).To(Equal("wb-1"))
+	})
+}
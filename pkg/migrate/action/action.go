@@ -2,10 +2,12 @@ package action
 
 import (
 	"context"
+	"errors"
 
 	"github.com/blang/semver/v4"
 
 	"github.com/opendatahub-io/odh-cli/pkg/migrate/action/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
 	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
 )
@@ -34,12 +36,36 @@ type Action interface {
 	// Actions can use target.CurrentVersion, target.TargetVersion, or target.Client for filtering.
 	CanApply(target Target) bool
 
+	// DependsOn returns the IDs of the actions that must run before this one when they apply.
+	DependsOn() []string
+
 	// Prepare returns the Task for the preparation phase (e.g., backups, pre-migration setup).
 	// Returns nil if this action has no prepare phase.
 	Prepare() Task
 
 	// Run returns the Task for the migration execution phase.
 	Run() Task
+
+	// Rollback reverts a completed run phase, restoring the resources saved by the prepare
+	// phase in target.OutputDir and recording each step to target.Recorder. Actions that
+	// cannot be rolled back return ErrRollbackNotSupported.
+	Rollback(ctx context.Context, target Target) error
+}
+
+// ErrRollbackNotSupported is returned by the Rollback of actions that cannot be rolled back.
+var ErrRollbackNotSupported = errors.New("rollback not supported")
+
+// Optional is implemented by actions that only run when selected by ID, never as part of all
+// the migrations applicable to a target.
+type Optional interface {
+	Optional() bool
+}
+
+// PermissionRequirer is implemented by actions that declare the RBAC permissions their
+// prepare, run and rollback phases need, evaluated by 'doctor permissions'.
+type PermissionRequirer interface {
+	// RequiredPermissions returns the permissions the action needs, cluster-wide.
+	RequiredPermissions() []resources.Permission
 }
 
 // Target holds all context needed for executing migration actions.
@@ -0,0 +1,54 @@
+package action
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/action/result"
+)
+
+// CleanupTimeout bounds the cleanup of an action, which runs even after the run was cancelled.
+const CleanupTimeout = 2 * time.Minute
+
+// Cleaner is implemented by actions that can undo a partially applied run phase, e.g. delete a
+// half-created Subscription or restore a DataScienceCluster field, so an aborted migration does
+// not leave the cluster in a mixed state. Cleanup must only undo what the run changed and be
+// safe to call whatever step the run stopped at.
+type Cleaner interface {
+	Cleanup(ctx context.Context, target Target) error
+}
+
+// needsCleanup returns whether the run phase of an action stopped part way: it returned an
+// error, reported a failed step, or was cancelled. Dry runs change nothing to clean up.
+func needsCleanup(ctx context.Context, target Target, actionResult *result.ActionResult, err error) bool {
+	if target.DryRun {
+		return false
+	}
+
+	return err != nil || ctx.Err() != nil || (actionResult != nil && actionResult.Failed())
+}
+
+// RunCleanup invokes the cleanup hook of a, if any, with a fresh context bounded by
+// CleanupTimeout: the hook runs even when ctx was cancelled, e.g. by Ctrl-C.
+func RunCleanup(ctx context.Context, target Target, a Action) error {
+	cleaner, ok := a.(Cleaner)
+	if !ok {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), CleanupTimeout)
+	defer cancel()
+
+	step := target.Recorder.Child("cleanup", "Undo the changes of the interrupted run")
+
+	if err := cleaner.Cleanup(ctx, target); err != nil {
+		step.Complete(result.StepFailed, "Cleanup failed: %v", err)
+
+		return fmt.Errorf("cleaning up %s: %w", a.ID(), err)
+	}
+
+	step.Complete(result.StepCompleted, "Changes of the interrupted run undone")
+
+	return nil
+}
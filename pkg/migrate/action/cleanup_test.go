@@ -0,0 +1,150 @@
+package action_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/action"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/action/result"
+
+	. "github.com/onsi/gomega"
+)
+
+type cleanupTestAction struct {
+	// run simulates the run task.
+	run func(ctx context.Context, target action.Target) (*result.ActionResult, error)
+
+	cleanupErr error
+	cleanedUp  bool
+
+	// cleanupCtxErr is the error of the context the cleanup hook was called with.
+	cleanupCtxErr error
+}
+
+func (a *cleanupTestAction) ID() string                  { return "test.cleanup" }
+func (a *cleanupTestAction) Name() string                { return "Cleanup test" }
+func (a *cleanupTestAction) Description() string         { return "Fails part way" }
+func (a *cleanupTestAction) Group() action.ActionGroup   { return action.GroupMigration }
+func (a *cleanupTestAction) CanApply(action.Target) bool { return true }
+func (a *cleanupTestAction) DependsOn() []string         { return nil }
+func (a *cleanupTestAction) Prepare() action.Task        { return nil }
+func (a *cleanupTestAction) Rollback(context.Context, action.Target) error {
+	return action.ErrRollbackNotSupported
+}
+
+func (a *cleanupTestAction) Run() action.Task { return a }
+
+func (a *cleanupTestAction) Validate(context.Context, action.Target) (*result.ActionResult, error) {
+	return result.New("migration", a.ID(), a.Name(), a.Description()), nil
+}
+
+func (a *cleanupTestAction) Execute(ctx context.Context, target action.Target) (*result.ActionResult, error) {
+	return a.run(ctx, target)
+}
+
+func (a *cleanupTestAction) Cleanup(ctx context.Context, _ action.Target) error {
+	a.cleanedUp = true
+	a.cleanupCtxErr = ctx.Err()
+
+	return a.cleanupErr
+}
+
+// recordStep records a step with status and returns the built result.
+func recordStep(target action.Target, status result.StepStatus) *result.ActionResult {
+	target.Recorder.Child("apply", "Apply changes").Complete(status, "done")
+
+	root, _ := target.Recorder.(action.RootRecorder)
+
+	return root.Build()
+}
+
+func TestExecuteRun_Cleanup(t *testing.T) {
+	newTarget := func() action.Target {
+		return action.Target{Recorder: action.NewRootRecorder()}
+	}
+
+	t.Run("should not clean up a successful run", func(t *testing.T) {
+		g := NewWithT(t)
+
+		a := &cleanupTestAction{run: func(_ context.Context, target action.Target) (*result.ActionResult, error) {
+			return recordStep(target, result.StepCompleted), nil
+		}}
+
+		actionResult, err := action.ExecuteRun(t.Context(), newTarget(), a)
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(a.cleanedUp).To(BeFalse())
+		g.Expect(actionResult.Status.Completed).To(BeTrue())
+	})
+
+	t.Run("should clean up after a failed step and mark the result incomplete", func(t *testing.T) {
+		g := NewWithT(t)
+
+		a := &cleanupTestAction{run: func(_ context.Context, target action.Target) (*result.ActionResult, error) {
+			return recordStep(target, result.StepFailed), nil
+		}}
+
+		actionResult, err := action.ExecuteRun(t.Context(), newTarget(), a)
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(a.cleanedUp).To(BeTrue())
+		g.Expect(actionResult.Status.Completed).To(BeFalse())
+		g.Expect(actionResult.Status.Steps).To(HaveLen(2))
+		g.Expect(actionResult.Status.Steps[1].Name).To(Equal("cleanup"))
+		g.Expect(actionResult.Status.Steps[1].Status).To(Equal(result.StepCompleted))
+	})
+
+	t.Run("should clean up with a fresh context after cancellation", func(t *testing.T) {
+		g := NewWithT(t)
+
+		ctx, cancel := context.WithCancel(t.Context())
+
+		a := &cleanupTestAction{run: func(ctx context.Context, _ action.Target) (*result.ActionResult, error) {
+			cancel()
+
+			return nil, ctx.Err()
+		}}
+
+		_, err := action.ExecuteRun(ctx, newTarget(), a)
+
+		g.Expect(err).To(MatchError(context.Canceled))
+		g.Expect(a.cleanedUp).To(BeTrue())
+		g.Expect(a.cleanupCtxErr).ToNot(HaveOccurred())
+	})
+
+	t.Run("should report cleanup failures with the run error", func(t *testing.T) {
+		g := NewWithT(t)
+
+		runErr := errors.New("subscription never became ready")
+		cleanupErr := errors.New("deleting subscription: forbidden")
+
+		a := &cleanupTestAction{
+			run: func(context.Context, action.Target) (*result.ActionResult, error) {
+				return nil, runErr
+			},
+			cleanupErr: cleanupErr,
+		}
+
+		_, err := action.ExecuteRun(t.Context(), newTarget(), a)
+
+		g.Expect(err).To(MatchError(runErr))
+		g.Expect(err).To(MatchError(cleanupErr))
+	})
+
+	t.Run("should not clean up a dry run", func(t *testing.T) {
+		g := NewWithT(t)
+
+		a := &cleanupTestAction{run: func(_ context.Context, target action.Target) (*result.ActionResult, error) {
+			return recordStep(target, result.StepFailed), nil
+		}}
+
+		target := newTarget()
+		target.DryRun = true
+
+		_, err := action.ExecuteRun(t.Context(), target, a)
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(a.cleanedUp).To(BeFalse())
+	})
+}
@@ -87,7 +87,7 @@ func (e *Executor) executeAction(
 		}
 	}
 
-	actionResult, err := runTask.Execute(ctx, target)
+	actionResult, err := ExecuteRun(ctx, target, action)
 
 	if err != nil {
 		errorResult := result.New(
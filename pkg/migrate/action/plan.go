@@ -0,0 +1,266 @@
+package action
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/blang/semver/v4"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/yaml"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/action/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+)
+
+const (
+	PlanAPIVersion = "migrate.opendatahub.io/v1alpha1"
+	PlanKind       = "MigrationPlan"
+
+	planFileMode = 0o644
+)
+
+// Plan is the reviewable record of the migrations a run will perform: the actions in execution
+// order, the resources each one may change at their planned resourceVersion, and the outcome of
+// each step of its dry run.
+type Plan struct {
+	APIVersion     string          `json:"apiVersion"`
+	Kind           string          `json:"kind"`
+	GeneratedAt    time.Time       `json:"generatedAt"`
+	CurrentVersion string          `json:"currentVersion"`
+	TargetVersion  string          `json:"targetVersion"`
+	Actions        []PlannedAction `json:"actions"`
+}
+
+// PlannedAction is a migration of a plan.
+type PlannedAction struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Resources   []PlannedResource `json:"resources"`
+	Changes     []PlannedChange   `json:"changes"`
+}
+
+// PlannedResource is a resource a planned migration may change.
+type PlannedResource struct {
+	Group     string `json:"group,omitempty"`
+	Version   string `json:"version"`
+	Kind      string `json:"kind"`
+	Resource  string `json:"resource"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+
+	ResourceVersion string `json:"resourceVersion,omitempty"`
+
+	// Missing is set when the resource did not exist when the plan was generated.
+	Missing bool `json:"missing,omitempty"`
+}
+
+// PlannedChange is the dry-run outcome of a step of a planned migration. Step is the path of
+// the step in the step tree, e.g. "refresh-certificates/team-a/ray".
+type PlannedChange struct {
+	Step    string            `json:"step"`
+	Status  result.StepStatus `json:"status"`
+	Message string            `json:"message,omitempty"`
+}
+
+// Drift is a planned resource whose live state no longer matches the plan.
+type Drift struct {
+	Resource PlannedResource
+
+	// ResourceVersion is the live resourceVersion.
+	ResourceVersion string
+
+	// Deleted is set when the resource no longer exists.
+	Deleted bool
+}
+
+func (d Drift) String() string {
+	name := d.Resource.Name
+	if d.Resource.Namespace != "" {
+		name = d.Resource.Namespace + "/" + name
+	}
+
+	switch {
+	case d.Resource.Missing:
+		return fmt.Sprintf("%s %s was created after the plan was generated", d.Resource.Kind, name)
+	case d.Deleted:
+		return fmt.Sprintf("%s %s was deleted after the plan was generated", d.Resource.Kind, name)
+	default:
+		return fmt.Sprintf("%s %s changed: planned resourceVersion %s, live %s",
+			d.Resource.Kind, name, d.Resource.ResourceVersion, d.ResourceVersion)
+	}
+}
+
+// NewPlan returns an empty plan of a migration from currentVersion to targetVersion.
+func NewPlan(currentVersion *semver.Version, targetVersion *semver.Version) *Plan {
+	return &Plan{
+		APIVersion:     PlanAPIVersion,
+		Kind:           PlanKind,
+		GeneratedAt:    time.Now().UTC(),
+		CurrentVersion: currentVersion.String(),
+		TargetVersion:  targetVersion.String(),
+		Actions:        make([]PlannedAction, 0),
+	}
+}
+
+// PlanAction dry-runs an action and returns it as planned. target.DryRun must be set; the
+// action fails to plan when a step of its dry run fails, as the plan could not be applied.
+func PlanAction(ctx context.Context, target Target, a Action) (*PlannedAction, error) {
+	if !target.DryRun {
+		return nil, fmt.Errorf("planning %s requires a dry-run target", a.ID())
+	}
+
+	affected, err := affectedResources(ctx, target, a)
+	if err != nil {
+		return nil, err
+	}
+
+	actionResult, err := ExecuteRun(ctx, target, a)
+	if err != nil {
+		return nil, fmt.Errorf("dry-running %s: %w", a.ID(), err)
+	}
+
+	if actionResult.Failed() {
+		return nil, fmt.Errorf("dry run of %s failed; resolve the failed steps before planning", a.ID())
+	}
+
+	planned := &PlannedAction{
+		ID:          a.ID(),
+		Name:        a.Name(),
+		Description: a.Description(),
+		Resources:   make([]PlannedResource, 0, len(affected)),
+		Changes:     make([]PlannedChange, 0),
+	}
+
+	for _, res := range affected {
+		pr := PlannedResource{
+			Group:     res.Ref.Type.Group,
+			Version:   res.Ref.Type.Version,
+			Kind:      res.Ref.Type.Kind,
+			Resource:  res.Ref.Type.Resource,
+			Namespace: res.Ref.Namespace,
+			Name:      res.Ref.Name,
+		}
+
+		if res.Object != nil {
+			pr.ResourceVersion = res.Object.GetResourceVersion()
+		} else {
+			pr.Missing = true
+		}
+
+		planned.Resources = append(planned.Resources, pr)
+	}
+
+	planned.Changes = appendChanges(planned.Changes, "", actionResult.Status.Steps)
+
+	return planned, nil
+}
+
+// appendChanges appends the steps and their children, depth first, prefixing their names with
+// the path of their parent.
+func appendChanges(changes []PlannedChange, parent string, steps []result.ActionStep) []PlannedChange {
+	for _, step := range steps {
+		path := step.Name
+		if parent != "" {
+			path = parent + "/" + step.Name
+		}
+
+		changes = append(changes, PlannedChange{Step: path, Status: step.Status, Message: step.Message})
+		changes = appendChanges(changes, path, step.Children)
+	}
+
+	return changes
+}
+
+// MigrationIDs returns the IDs of the planned actions in execution order.
+func (p *Plan) MigrationIDs() []string {
+	ids := make([]string, 0, len(p.Actions))
+	for _, a := range p.Actions {
+		ids = append(ids, a.ID)
+	}
+
+	return ids
+}
+
+// Drift returns the planned resources whose resourceVersion changed, that were created or that
+// were deleted since the plan was generated.
+func (p *Plan) Drift(ctx context.Context, r client.Reader) ([]Drift, error) {
+	var drifts []Drift
+
+	for _, a := range p.Actions {
+		for _, res := range a.Resources {
+			rt := resources.ResourceType{Group: res.Group, Version: res.Version, Kind: res.Kind, Resource: res.Resource}
+
+			live, err := r.GetResource(ctx, rt, res.Name, client.InNamespace(res.Namespace))
+
+			switch {
+			case apierrors.IsNotFound(err) || client.IsResourceTypeNotFound(err):
+				if !res.Missing {
+					drifts = append(drifts, Drift{Resource: res, Deleted: true})
+				}
+			case err != nil:
+				return nil, fmt.Errorf("getting %s %s: %w", res.Kind, res.Name, err)
+			case live == nil:
+				return nil, fmt.Errorf("not permitted to read %s %s", res.Kind, res.Name)
+			case res.Missing || live.GetResourceVersion() != res.ResourceVersion:
+				drifts = append(drifts, Drift{Resource: res, ResourceVersion: live.GetResourceVersion()})
+			}
+		}
+	}
+
+	return drifts, nil
+}
+
+// Write writes the plan as YAML to path.
+func (p *Plan) Write(path string) error {
+	data, err := yaml.Marshal(p)
+	if err != nil {
+		return fmt.Errorf("marshaling plan: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, planFileMode); err != nil {
+		return fmt.Errorf("writing plan %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// ReadPlan reads a plan written by Plan.Write.
+func ReadPlan(path string) (*Plan, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading plan: %w", err)
+	}
+
+	var p Plan
+	if err := yaml.UnmarshalStrict(data, &p); err != nil {
+		return nil, fmt.Errorf("parsing plan %s: %w", path, err)
+	}
+
+	if p.APIVersion != PlanAPIVersion || p.Kind != PlanKind {
+		return nil, fmt.Errorf("%s is not a migration plan (apiVersion %q, kind %q)", path, p.APIVersion, p.Kind)
+	}
+
+	if len(p.Actions) == 0 {
+		return nil, errors.New("plan has no migrations")
+	}
+
+	return &p, nil
+}
+
+// DriftError returns the error refusing to apply a plan the cluster no longer matches.
+func DriftError(drifts []Drift) error {
+	lines := make([]string, 0, len(drifts))
+	for _, d := range drifts {
+		lines = append(lines, "  - "+d.String())
+	}
+
+	return fmt.Errorf("cluster state has drifted since the plan was generated; generate a new plan:\n%s",
+		strings.Join(lines, "\n"))
+}
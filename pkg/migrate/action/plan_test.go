@@ -0,0 +1,141 @@
+package action_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/action"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/action/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+)
+
+// planTestAction records a dry-run step for the ConfigMap it mutates.
+type planTestAction struct {
+	snapshotTestAction
+
+	fail bool
+}
+
+func (a *planTestAction) Run() action.Task { return a }
+
+func (a *planTestAction) Execute(_ context.Context, target action.Target) (*result.ActionResult, error) {
+	step := target.Recorder.Child("update-config", "Update the ConfigMap")
+	child := step.Child("apps/config", "ConfigMap apps/config")
+
+	if a.fail {
+		child.Complete(result.StepFailed, "ConfigMap is invalid")
+	} else {
+		child.Complete(result.StepSkipped, "Would set key to value")
+	}
+
+	step.Complete(result.StepSkipped, "Would update 1 ConfigMap")
+
+	root, _ := target.Recorder.(action.RootRecorder)
+
+	return root.Build(), nil
+}
+
+func TestPlanAction(t *testing.T) {
+	g := NewWithT(t)
+
+	target := snapshotTarget(t, true)
+
+	planned, err := action.PlanAction(t.Context(), target, &planTestAction{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(planned.ID).To(Equal("test.snapshot"))
+
+	g.Expect(planned.Resources).To(HaveLen(4))
+	g.Expect(planned.Resources[2]).To(Equal(action.PlannedResource{
+		Version:  "v1",
+		Kind:     "ConfigMap",
+		Resource: "configmaps",
+
+		Namespace: "apps",
+		Name:      "config",
+	}))
+	g.Expect(planned.Resources[3].Name).To(Equal("created-by-action"))
+	g.Expect(planned.Resources[3].Missing).To(BeTrue())
+
+	g.Expect(planned.Changes).To(Equal([]action.PlannedChange{
+		{Step: "update-config", Status: result.StepSkipped, Message: "Would update 1 ConfigMap"},
+		{Step: "update-config/apps/config", Status: result.StepSkipped, Message: "Would set key to value"},
+	}))
+
+	// Planning never snapshots or mutates the cluster
+	entries, err := os.ReadDir(target.OutputDir)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(entries).To(BeEmpty())
+
+	_, err = action.PlanAction(t.Context(), snapshotTarget(t, true), &planTestAction{fail: true})
+	g.Expect(err).To(MatchError(ContainSubstring("dry run of test.snapshot failed")))
+
+	_, err = action.PlanAction(t.Context(), snapshotTarget(t, false), &planTestAction{})
+	g.Expect(err).To(MatchError(ContainSubstring("requires a dry-run target")))
+}
+
+func TestPlan_WriteReadAndDrift(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	target := snapshotTarget(t, true)
+	configMaps := target.Client.Dynamic().Resource(resources.ConfigMap.GVR()).Namespace("apps")
+
+	config, err := configMaps.Get(ctx, "config", metav1.GetOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+	config.SetResourceVersion("10")
+	_, err = configMaps.Update(ctx, config, metav1.UpdateOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	planned, err := action.PlanAction(ctx, target, &planTestAction{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(planned.Resources[2].ResourceVersion).To(Equal("10"))
+
+	plan := action.NewPlan(target.CurrentVersion, target.TargetVersion)
+	plan.Actions = append(plan.Actions, *planned)
+
+	path := filepath.Join(t.TempDir(), "plan.yaml")
+	g.Expect(plan.Write(path)).To(Succeed())
+
+	read, err := action.ReadPlan(path)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(read.MigrationIDs()).To(Equal([]string{"test.snapshot"}))
+	g.Expect(read.TargetVersion).To(Equal("3.0.0"))
+
+	drifts, err := read.Drift(ctx, target.Client)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(drifts).To(BeEmpty())
+
+	// Change the planned ConfigMap and create the one planned as missing
+	config.SetResourceVersion("11")
+	_, err = configMaps.Update(ctx, config, metav1.UpdateOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	created := resources.ConfigMap.Unstructured()
+	created.SetNamespace("apps")
+	created.SetName("created-by-action")
+	_, err = configMaps.Create(ctx, &created, metav1.CreateOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	drifts, err = read.Drift(ctx, target.Client)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(drifts).To(HaveLen(2))
+	g.Expect(drifts[0].String()).To(Equal("ConfigMap apps/config changed: planned resourceVersion 10, live 11"))
+	g.Expect(drifts[1].String()).To(Equal("ConfigMap apps/created-by-action was created after the plan was generated"))
+	g.Expect(action.DriftError(drifts)).To(MatchError(ContainSubstring("cluster state has drifted")))
+}
+
+func TestReadPlan_Invalid(t *testing.T) {
+	g := NewWithT(t)
+
+	path := filepath.Join(t.TempDir(), "plan.yaml")
+	g.Expect(os.WriteFile(path, []byte("apiVersion: v1\nkind: ConfigMap\n"), 0o600)).To(Succeed())
+
+	_, err := action.ReadPlan(path)
+	g.Expect(err).To(MatchError(ContainSubstring("is not a migration plan")))
+}
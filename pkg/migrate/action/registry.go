@@ -93,3 +93,88 @@ func (r *ActionRegistry) ListByPattern(
 
 	return matched, nil
 }
+
+// Applicable returns the registered migration actions that apply to the target, excluding
+// Optional ones, ordered so each runs after the applicable actions it depends on. Actions
+// without a dependency between them run in ID order. An action depending on an unregistered
+// action, or a dependency cycle, is an error.
+func (r *ActionRegistry) Applicable(target Target) ([]Action, error) {
+	var applicable []Action
+
+	for _, a := range r.ListAll() {
+		if a.Group() != GroupMigration || !a.CanApply(target) {
+			continue
+		}
+
+		if optional, ok := a.(Optional); ok && optional.Optional() {
+			continue
+		}
+
+		applicable = append(applicable, a)
+	}
+
+	return r.orderByDependencies(applicable)
+}
+
+// orderByDependencies sorts actions, already in ID order, topologically by their dependencies
+// among them. Dependencies on registered actions that are not part of actions are ignored.
+func (r *ActionRegistry) orderByDependencies(actions []Action) ([]Action, error) {
+	selected := make(map[string]bool, len(actions))
+	for _, a := range actions {
+		selected[a.ID()] = true
+	}
+
+	pending := make(map[string]int, len(actions))
+	dependents := make(map[string][]string, len(actions))
+
+	for _, a := range actions {
+		for _, dep := range a.DependsOn() {
+			if _, ok := r.Get(dep); !ok {
+				return nil, fmt.Errorf("action %q depends on unknown action %q", a.ID(), dep)
+			}
+
+			if selected[dep] {
+				pending[a.ID()]++
+				dependents[dep] = append(dependents[dep], a.ID())
+			}
+		}
+	}
+
+	ordered := make([]Action, 0, len(actions))
+	done := make(map[string]bool, len(actions))
+
+	// Repeatedly take the first action in ID order whose dependencies all ran
+	for len(ordered) < len(actions) {
+		next := -1
+
+		for i, a := range actions {
+			if !done[a.ID()] && pending[a.ID()] == 0 {
+				next = i
+
+				break
+			}
+		}
+
+		if next < 0 {
+			var cycle []string
+
+			for _, a := range actions {
+				if !done[a.ID()] {
+					cycle = append(cycle, a.ID())
+				}
+			}
+
+			return nil, fmt.Errorf("dependency cycle between actions %v", cycle)
+		}
+
+		a := actions[next]
+		done[a.ID()] = true
+		ordered = append(ordered, a)
+
+		for _, dependent := range dependents[a.ID()] {
+			pending[dependent]--
+		}
+	}
+
+	return ordered, nil
+}
@@ -0,0 +1,98 @@
+package action_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/blang/semver/v4"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/action"
+
+	. "github.com/onsi/gomega"
+)
+
+// chainTestAction is an action with configurable applicability and dependencies.
+type chainTestAction struct {
+	id        string
+	dependsOn []string
+	applies   bool
+	optional  bool
+}
+
+func (a *chainTestAction) ID() string                  { return a.id }
+func (a *chainTestAction) Name() string                { return a.id }
+func (a *chainTestAction) Description() string         { return a.id }
+func (a *chainTestAction) Group() action.ActionGroup   { return action.GroupMigration }
+func (a *chainTestAction) CanApply(action.Target) bool { return a.applies }
+func (a *chainTestAction) DependsOn() []string         { return a.dependsOn }
+func (a *chainTestAction) Prepare() action.Task        { return nil }
+func (a *chainTestAction) Rollback(context.Context, action.Target) error {
+	return action.ErrRollbackNotSupported
+}
+
+func (a *chainTestAction) Run() action.Task { return nil }
+func (a *chainTestAction) Optional() bool   { return a.optional }
+
+func newChainTestAction(id string, deps ...string) *chainTestAction {
+	return &chainTestAction{id: id, dependsOn: deps, applies: true}
+}
+
+func applicableIDs(g Gomega, registry *action.ActionRegistry) []string {
+	actions, err := registry.Applicable(action.Target{
+		CurrentVersion: &semver.Version{Major: 2, Minor: 25},
+		TargetVersion:  &semver.Version{Major: 3},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	ids := make([]string, 0, len(actions))
+	for _, a := range actions {
+		ids = append(ids, a.ID())
+	}
+
+	return ids
+}
+
+func TestActionRegistry_Applicable(t *testing.T) {
+	g := NewWithT(t)
+
+	notApplicable := newChainTestAction("b.not-applicable")
+	notApplicable.applies = false
+
+	optional := newChainTestAction("c.optional")
+	optional.optional = true
+
+	registry := action.NewActionRegistry()
+	registry.MustRegister(newChainTestAction("a.depends-on-d", "d.base"))
+	registry.MustRegister(notApplicable)
+	registry.MustRegister(optional)
+	registry.MustRegister(newChainTestAction("d.base"))
+	registry.MustRegister(newChainTestAction("e.depends-on-not-applicable", "b.not-applicable"))
+	registry.MustRegister(newChainTestAction("f.independent"))
+
+	// Dependencies run first; independent actions keep ID order
+	g.Expect(applicableIDs(g, registry)).To(Equal([]string{
+		"d.base",
+		"a.depends-on-d",
+		"e.depends-on-not-applicable",
+		"f.independent",
+	}))
+}
+
+func TestActionRegistry_Applicable_InvalidDependencies(t *testing.T) {
+	g := NewWithT(t)
+	target := action.Target{CurrentVersion: &semver.Version{Major: 2, Minor: 25}, TargetVersion: &semver.Version{Major: 3}}
+
+	unknown := action.NewActionRegistry()
+	unknown.MustRegister(newChainTestAction("a", "missing"))
+
+	_, err := unknown.Applicable(target)
+	g.Expect(err).To(MatchError(ContainSubstring(`depends on unknown action "missing"`)))
+
+	cycle := action.NewActionRegistry()
+	cycle.MustRegister(newChainTestAction("a", "b"))
+	cycle.MustRegister(newChainTestAction("b", "a"))
+	cycle.MustRegister(newChainTestAction("c"))
+
+	_, err = cycle.Applicable(target)
+	g.Expect(err).To(MatchError(ContainSubstring("dependency cycle between actions [a b]")))
+}
@@ -1,6 +1,7 @@
 package result
 
 import (
+	"slices"
 	"time"
 )
 
@@ -36,6 +37,15 @@ type ActionStatus struct {
 	Steps     []ActionStep
 	Completed bool
 	Error     string
+
+	// Snapshot lists the resources saved before the run phase mutated the cluster.
+	Snapshot *ActionSnapshot `json:"snapshot,omitempty" yaml:"snapshot,omitempty"`
+}
+
+// ActionSnapshot records where the pre-run safety snapshot of an action was written.
+type ActionSnapshot struct {
+	Dir   string
+	Files []string
 }
 
 type ActionStep struct {
@@ -87,3 +97,13 @@ func NewStep(
 		Details:     make(map[string]any),
 	}
 }
+
+// Failed returns whether any step of the result, at any depth, failed.
+func (r *ActionResult) Failed() bool {
+	return slices.ContainsFunc(r.Status.Steps, ActionStep.failed)
+}
+
+// failed returns whether the step or any of its children failed.
+func (s ActionStep) failed() bool {
+	return s.Status == StepFailed || slices.ContainsFunc(s.Children, ActionStep.failed)
+}
@@ -0,0 +1,172 @@
+package action
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/backup"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/action/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+)
+
+// ResourceRef identifies a single resource. Namespace is empty for cluster-scoped resources.
+type ResourceRef struct {
+	Type      resources.ResourceType
+	Namespace string
+	Name      string
+}
+
+// Mutator is implemented by actions whose run phase modifies existing resources other than
+// the DataScienceCluster and DSCInitialization, so they are included in the safety snapshot.
+type Mutator interface {
+	MutatedResources(ctx context.Context, target Target) ([]ResourceRef, error)
+}
+
+// TakeSnapshot writes the DataScienceCluster, the DSCInitialization and the resources the action
+// declares as mutated to a timestamped subdirectory of target.OutputDir. Resources that do not
+// exist yet are skipped, as there is nothing to restore for them.
+func TakeSnapshot(ctx context.Context, target Target, a Action) (*result.ActionSnapshot, error) {
+	snapshot := &result.ActionSnapshot{
+		Dir: filepath.Join(target.OutputDir, fmt.Sprintf("snapshot-%s-%s", time.Now().Format("20060102-150405"), a.ID())),
+	}
+
+	affected, err := affectedResources(ctx, target, a)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, res := range affected {
+		if res.Object == nil {
+			continue
+		}
+
+		if err := backup.WriteResourceToFile(snapshot.Dir, res.Ref.Type.GVR(), res.Object); err != nil {
+			return nil, fmt.Errorf("writing %s %s: %w", res.Ref.Type.Kind, res.Object.GetName(), err)
+		}
+
+		snapshot.Files = append(snapshot.Files, backup.ResourceFilePath(snapshot.Dir, res.Ref.Type.GVR(), res.Object))
+	}
+
+	return snapshot, nil
+}
+
+// affectedResource is a resource the run phase of an action may change, as currently in the
+// cluster. Object is nil when the resource does not exist yet.
+type affectedResource struct {
+	Ref    ResourceRef
+	Object *unstructured.Unstructured
+}
+
+// affectedResources returns the DataScienceCluster, the DSCInitialization and the resources the
+// action declares as mutated, read from the cluster.
+func affectedResources(ctx context.Context, target Target, a Action) ([]affectedResource, error) {
+	var affected []affectedResource
+
+	for _, rt := range []resources.ResourceType{resources.DSCInitialization, resources.DataScienceCluster} {
+		obj, err := client.GetSingleton(ctx, target.Client, rt)
+		if client.IsResourceTypeNotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("getting %s: %w", rt.Kind, err)
+		}
+
+		affected = append(affected, affectedResource{
+			Ref:    ResourceRef{Type: rt, Name: obj.GetName()},
+			Object: obj,
+		})
+	}
+
+	mutator, ok := a.(Mutator)
+	if !ok {
+		return affected, nil
+	}
+
+	refs, err := mutator.MutatedResources(ctx, target)
+	if err != nil {
+		return nil, fmt.Errorf("listing resources mutated by %s: %w", a.ID(), err)
+	}
+
+	for _, ref := range refs {
+		obj, err := target.Client.GetResource(ctx, ref.Type, ref.Name, client.InNamespace(ref.Namespace))
+		if apierrors.IsNotFound(err) {
+			affected = append(affected, affectedResource{Ref: ref})
+
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("getting %s %s: %w", ref.Type.Kind, ref.Name, err)
+		}
+
+		// Get returns no object when reading is forbidden; a partial snapshot is not safe to rely on
+		if obj == nil {
+			return nil, fmt.Errorf("not permitted to read %s %s", ref.Type.Kind, ref.Name)
+		}
+
+		affected = append(affected, affectedResource{Ref: ref, Object: obj})
+	}
+
+	return affected, nil
+}
+
+// ExecuteRun executes the run task of an action. Unless target.DryRun is set or target.OutputDir
+// is empty, a safety snapshot is taken first and recorded in the result; the run task is not
+// executed when the snapshot fails. When the run fails or is cancelled, the cleanup hook of a
+// Cleaner action runs with a fresh context and the result is marked incomplete.
+func ExecuteRun(ctx context.Context, target Target, a Action) (*result.ActionResult, error) {
+	runTask := a.Run()
+	if runTask == nil {
+		return nil, fmt.Errorf("action %s has no run task", a.ID())
+	}
+
+	var snapshot *result.ActionSnapshot
+
+	if !target.DryRun && target.OutputDir != "" {
+		var err error
+
+		snapshot, err = TakeSnapshot(ctx, target, a)
+		if err != nil {
+			return nil, fmt.Errorf("taking safety snapshot: %w", err)
+		}
+	}
+
+	actionResult, err := runTask.Execute(ctx, target)
+
+	if needsCleanup(ctx, target, actionResult, err) {
+		cleanupErr := RunCleanup(ctx, target, a)
+
+		if err != nil {
+			return nil, errors.Join(err, cleanupErr)
+		}
+
+		if _, ok := a.(Cleaner); ok {
+			actionResult.Status.Completed = false
+
+			// Include the cleanup step, recorded after the task built its result
+			if root, ok := target.Recorder.(RootRecorder); ok {
+				actionResult.Status.Steps = root.Build().Status.Steps
+			}
+		}
+
+		if cleanupErr != nil {
+			actionResult.Status.Error = cleanupErr.Error()
+		}
+	}
+
+	if err != nil {
+		return nil, err //nolint:wrapcheck // Task errors are returned as-is to callers
+	}
+
+	actionResult.Status.Snapshot = snapshot
+
+	return actionResult, nil
+}
@@ -0,0 +1,152 @@
+package action_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/blang/semver/v4"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/action"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/action/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+type snapshotTestAction struct {
+	executed bool
+}
+
+func (a *snapshotTestAction) ID() string                { return "test.snapshot" }
+func (a *snapshotTestAction) Name() string              { return "Snapshot test" }
+func (a *snapshotTestAction) Description() string       { return "Mutates a ConfigMap" }
+func (a *snapshotTestAction) Group() action.ActionGroup { return action.GroupMigration }
+func (a *snapshotTestAction) CanApply(action.Target) bool {
+	return true
+}
+func (a *snapshotTestAction) DependsOn() []string  { return nil }
+func (a *snapshotTestAction) Prepare() action.Task { return nil }
+func (a *snapshotTestAction) Rollback(context.Context, action.Target) error {
+	return action.ErrRollbackNotSupported
+}
+
+func (a *snapshotTestAction) Run() action.Task { return a }
+
+func (a *snapshotTestAction) MutatedResources(context.Context, action.Target) ([]action.ResourceRef, error) {
+	return []action.ResourceRef{
+		{Type: resources.ConfigMap, Namespace: "apps", Name: "config"},
+		{Type: resources.ConfigMap, Namespace: "apps", Name: "created-by-action"},
+	}, nil
+}
+
+func (a *snapshotTestAction) Validate(context.Context, action.Target) (*result.ActionResult, error) {
+	return result.New("migration", a.ID(), a.Name(), a.Description()), nil
+}
+
+func (a *snapshotTestAction) Execute(context.Context, action.Target) (*result.ActionResult, error) {
+	a.executed = true
+	r := result.New("migration", a.ID(), a.Name(), a.Description())
+	r.Status.Completed = true
+
+	return r, nil
+}
+
+func newSnapshotTestClient() client.Client {
+	object := func(rt resources.ResourceType, namespace string, name string) *unstructured.Unstructured {
+		obj := rt.Unstructured()
+		obj.SetNamespace(namespace)
+		obj.SetName(name)
+
+		return &obj
+	}
+
+	listKinds := map[schema.GroupVersionResource]string{
+		resources.DataScienceCluster.GVR(): resources.DataScienceCluster.ListKind(),
+		resources.DSCInitialization.GVR():  resources.DSCInitialization.ListKind(),
+		resources.ConfigMap.GVR():          resources.ConfigMap.ListKind(),
+	}
+
+	dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), listKinds,
+		object(resources.DataScienceCluster, "", "default-dsc"),
+		object(resources.DSCInitialization, "", "default-dsci"),
+		object(resources.ConfigMap, "apps", "config"),
+	)
+
+	return client.NewForTesting(client.TestClientConfig{Dynamic: dynamic})
+}
+
+func snapshotTarget(t *testing.T, dryRun bool) action.Target {
+	t.Helper()
+
+	return action.Target{
+		Client:         newSnapshotTestClient(),
+		CurrentVersion: &semver.Version{Major: 2, Minor: 25},
+		TargetVersion:  &semver.Version{Major: 3},
+		DryRun:         dryRun,
+		OutputDir:      t.TempDir(),
+		Recorder:       action.NewRootRecorder(),
+	}
+}
+
+func TestExecuteRun_TakesSnapshot(t *testing.T) {
+	g := NewWithT(t)
+
+	target := snapshotTarget(t, false)
+	a := &snapshotTestAction{}
+
+	actionResult, err := action.ExecuteRun(t.Context(), target, a)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(a.executed).To(BeTrue())
+
+	snapshot := actionResult.Status.Snapshot
+	g.Expect(snapshot).ToNot(BeNil())
+	g.Expect(filepath.Dir(snapshot.Dir)).To(Equal(target.OutputDir))
+	g.Expect(filepath.Base(snapshot.Dir)).To(HavePrefix("snapshot-"))
+	g.Expect(filepath.Base(snapshot.Dir)).To(HaveSuffix("-test.snapshot"))
+
+	// The resource the action creates does not exist yet and is not part of the snapshot
+	g.Expect(snapshot.Files).To(ConsistOf(
+		filepath.Join(snapshot.Dir, "cluster-scoped", "dscinitializations.dscinitialization.opendatahub.io-default-dsci.yaml"),
+		filepath.Join(snapshot.Dir, "cluster-scoped", "datascienceclusters.datasciencecluster.opendatahub.io-default-dsc.yaml"),
+		filepath.Join(snapshot.Dir, "apps", "configmaps-config.yaml"),
+	))
+
+	for _, file := range snapshot.Files {
+		g.Expect(file).To(BeARegularFile())
+	}
+}
+
+func TestExecuteRun_DryRunSkipsSnapshot(t *testing.T) {
+	g := NewWithT(t)
+
+	target := snapshotTarget(t, true)
+
+	actionResult, err := action.ExecuteRun(t.Context(), target, &snapshotTestAction{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(actionResult.Status.Snapshot).To(BeNil())
+
+	entries, err := os.ReadDir(target.OutputDir)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(entries).To(BeEmpty())
+}
+
+func TestExecutor_SnapshotsRegisteredActions(t *testing.T) {
+	g := NewWithT(t)
+
+	registry := action.NewActionRegistry()
+	registry.MustRegister(&snapshotTestAction{})
+
+	executions := action.NewExecutor(registry).ExecuteAll(t.Context(), snapshotTarget(t, false))
+	g.Expect(executions).To(HaveLen(1))
+	g.Expect(executions[0].Error).ToNot(HaveOccurred())
+	g.Expect(executions[0].Result.Status.Snapshot).ToNot(BeNil())
+	g.Expect(executions[0].Result.Status.Snapshot.Files).To(HaveLen(3))
+}
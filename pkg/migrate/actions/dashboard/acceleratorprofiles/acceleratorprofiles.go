@@ -0,0 +1,254 @@
+package acceleratorprofiles
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/components/dashboard"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/action"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/action/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/confirmation"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+const (
+	actionID          = dashboard.AcceleratorProfileMigrationID
+	actionName        = "Convert AcceleratorProfiles to HardwareProfiles"
+	actionDescription = "Creates an infrastructure.opendatahub.io HardwareProfile for each AcceleratorProfile, carrying its identifier and tolerations"
+)
+
+// AcceleratorProfileMigrationAction converts the AcceleratorProfiles (dashboard.opendatahub.io)
+// into the HardwareProfiles (infrastructure.opendatahub.io) the 3.x dashboard reads. The
+// AcceleratorProfiles are backed up in the prepare phase and left in place.
+type AcceleratorProfileMigrationAction struct{}
+
+// conversion is the HardwareProfile generated for an AcceleratorProfile.
+type conversion struct {
+	source  *unstructured.Unstructured
+	profile *unstructured.Unstructured
+}
+
+func (c *conversion) name() string {
+	return c.source.GetNamespace() + "/" + c.source.GetName()
+}
+
+func (a *AcceleratorProfileMigrationAction) ID() string {
+	return actionID
+}
+
+func (a *AcceleratorProfileMigrationAction) Name() string {
+	return actionName
+}
+
+func (a *AcceleratorProfileMigrationAction) Description() string {
+	return actionDescription
+}
+
+func (a *AcceleratorProfileMigrationAction) Group() action.ActionGroup {
+	return action.GroupMigration
+}
+
+func (a *AcceleratorProfileMigrationAction) CanApply(target action.Target) bool {
+	return version.IsUpgradeFrom2xTo3x(target.CurrentVersion, target.TargetVersion)
+}
+
+func (a *AcceleratorProfileMigrationAction) DependsOn() []string {
+	return nil
+}
+
+func (a *AcceleratorProfileMigrationAction) Prepare() action.Task {
+	return &prepareTask{action: a}
+}
+
+func (a *AcceleratorProfileMigrationAction) Run() action.Task {
+	return &runTask{action: a}
+}
+
+// Rollback is not supported: restore the safety snapshot taken before the run instead.
+func (a *AcceleratorProfileMigrationAction) Rollback(context.Context, action.Target) error {
+	return action.ErrRollbackNotSupported
+}
+
+// RequiredPermissions returns the permissions to read AcceleratorProfiles and create the
+// HardwareProfiles converted from them.
+// Implements action.PermissionRequirer.
+func (a *AcceleratorProfileMigrationAction) RequiredPermissions() []resources.Permission {
+	return append(
+		resources.ReadPermissions(resources.AcceleratorProfile, resources.InfrastructureHardwareProfile),
+		resources.InfrastructureHardwareProfile.Permission(resources.VerbCreate),
+	)
+}
+
+// findProfiles returns the AcceleratorProfiles of the cluster.
+func (a *AcceleratorProfileMigrationAction) findProfiles(
+	ctx context.Context,
+	target action.Target,
+) ([]*unstructured.Unstructured, bool) {
+	step := target.Recorder.Child(
+		"find-acceleratorprofiles",
+		"Find AcceleratorProfiles",
+	)
+
+	profiles, err := target.Client.List(ctx, resources.AcceleratorProfile)
+	if err != nil {
+		if client.IsResourceTypeNotFound(err) {
+			step.Complete(result.StepSkipped, "AcceleratorProfile CRD is not installed")
+
+			return nil, false
+		}
+
+		step.Complete(result.StepFailed, "Failed to list AcceleratorProfiles: %v", err)
+
+		return nil, false
+	}
+
+	step.Complete(result.StepCompleted, "Found %d AcceleratorProfile(s)", len(profiles))
+
+	return profiles, true
+}
+
+// validateConversions converts each AcceleratorProfile and returns the HardwareProfiles to
+// create. AcceleratorProfiles that cannot be converted are recorded as failed, and those whose
+// HardwareProfile already exists are skipped.
+func (a *AcceleratorProfileMigrationAction) validateConversions(
+	ctx context.Context,
+	target action.Target,
+	profiles []*unstructured.Unstructured,
+) []*conversion {
+	step := target.Recorder.Child(
+		"validate-conversions",
+		"Validate HardwareProfile conversions",
+	)
+
+	if len(profiles) == 0 {
+		step.Complete(result.StepSkipped, "No AcceleratorProfiles to convert")
+
+		return nil
+	}
+
+	var conversions []*conversion
+
+	existing, invalid := 0, 0
+
+	for _, ap := range profiles {
+		c := &conversion{source: ap}
+		apStep := step.Child(c.name(), "AcceleratorProfile "+c.name())
+
+		hwp, err := Convert(ap)
+		if err != nil {
+			apStep.Complete(result.StepFailed, "Cannot convert: %v", err)
+			invalid++
+
+			continue
+		}
+
+		_, err = target.Client.GetResource(ctx, resources.InfrastructureHardwareProfile, ap.GetName(),
+			client.InNamespace(ap.GetNamespace()))
+
+		switch {
+		case err == nil:
+			apStep.Complete(result.StepSkipped, "HardwareProfile %s already exists", c.name())
+			existing++
+
+			continue
+		case !apierrors.IsNotFound(err) && !client.IsResourceTypeNotFound(err):
+			apStep.Complete(result.StepFailed, "Failed to get HardwareProfile %s: %v", c.name(), err)
+			invalid++
+
+			continue
+		}
+
+		c.profile = hwp
+		conversions = append(conversions, c)
+
+		apStep.Complete(result.StepCompleted, "Converts to HardwareProfile %s", c.name())
+	}
+
+	if invalid > 0 {
+		step.Complete(result.StepFailed, "%d AcceleratorProfile(s) cannot be converted, %d to convert, %d already converted",
+			invalid, len(conversions), existing)
+	} else {
+		step.Complete(result.StepCompleted, "%d AcceleratorProfile(s) to convert, %d already converted", len(conversions), existing)
+	}
+
+	return conversions
+}
+
+// createProfiles creates the HardwareProfiles, or records them as YAML in dry-run mode.
+// A failed creation is recorded and the next profile created.
+func (a *AcceleratorProfileMigrationAction) createProfiles(
+	ctx context.Context,
+	target action.Target,
+	conversions []*conversion,
+) {
+	step := target.Recorder.Child(
+		"create-hardwareprofiles",
+		"Create HardwareProfiles",
+	)
+
+	if len(conversions) == 0 {
+		step.Complete(result.StepSkipped, "No HardwareProfiles to create")
+
+		return
+	}
+
+	if !target.DryRun && !target.SkipConfirm {
+		target.IO.Fprintln()
+		target.IO.Errorf("About to create %d HardwareProfile(s) from AcceleratorProfiles", len(conversions))
+		if !confirmation.Prompt(target.IO, "Proceed with HardwareProfile creation?") {
+			step.Complete(result.StepSkipped, "User cancelled creation")
+
+			return
+		}
+		target.IO.Fprintln()
+	}
+
+	created, failed := 0, 0
+
+	for _, c := range conversions {
+		hwpStep := step.Child(c.name(), "HardwareProfile "+c.name())
+
+		if target.DryRun {
+			data, err := yaml.Marshal(c.profile.Object)
+			if err != nil {
+				hwpStep.Complete(result.StepFailed, "Failed to encode HardwareProfile: %v", err)
+				failed++
+
+				continue
+			}
+
+			hwpStep.AddDetail("hardwareProfile", string(data))
+			hwpStep.Complete(result.StepSkipped, "Would create HardwareProfile %s:\n%s", c.name(), data)
+
+			continue
+		}
+
+		_, err := target.Client.Dynamic().Resource(resources.InfrastructureHardwareProfile.GVR()).
+			Namespace(c.profile.GetNamespace()).
+			Create(ctx, c.profile, metav1.CreateOptions{})
+		if err != nil {
+			hwpStep.Complete(result.StepFailed, "Failed to create HardwareProfile: %v", err)
+			failed++
+
+			continue
+		}
+
+		hwpStep.Complete(result.StepCompleted, "Created HardwareProfile %s", c.name())
+		created++
+	}
+
+	switch {
+	case failed > 0:
+		step.Complete(result.StepFailed, "%d HardwareProfile(s) failed, %d created", failed, created)
+	case target.DryRun:
+		step.Complete(result.StepSkipped, "Would create %d HardwareProfile(s)", len(conversions))
+	default:
+		step.Complete(result.StepCompleted, "Created %d HardwareProfile(s)", created)
+	}
+}
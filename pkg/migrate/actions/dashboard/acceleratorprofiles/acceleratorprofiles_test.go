@@ -0,0 +1,218 @@
+package acceleratorprofiles_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/blang/semver/v4"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/action"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/action/result"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/actions/dashboard/acceleratorprofiles"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+const appNamespace = "redhat-ods-applications"
+
+//nolint:gochecknoglobals
+var listKinds = map[schema.GroupVersionResource]string{
+	resources.AcceleratorProfile.GVR():            resources.AcceleratorProfile.ListKind(),
+	resources.InfrastructureHardwareProfile.GVR(): resources.InfrastructureHardwareProfile.ListKind(),
+}
+
+func newAcceleratorProfile(name string, spec map[string]any) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{"spec": spec}}
+	obj.SetGroupVersionKind(resources.AcceleratorProfile.GVK())
+	obj.SetNamespace(appNamespace)
+	obj.SetName(name)
+
+	return obj
+}
+
+func nvidiaProfile() *unstructured.Unstructured {
+	return newAcceleratorProfile("nvidia-gpu", map[string]any{
+		"displayName": "NVIDIA GPU",
+		"description": "Default NVIDIA GPU profile",
+		"enabled":     true,
+		"identifier":  "nvidia.com/gpu",
+		"tolerations": []any{
+			map[string]any{"key": "nvidia.com/gpu", "operator": "Exists", "effect": "NoSchedule"},
+		},
+	})
+}
+
+func newTarget(t *testing.T, dryRun bool, objects ...runtime.Object) (action.Target, *dynamicfake.FakeDynamicClient) {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	_ = metav1.AddMetaToScheme(scheme)
+
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme, listKinds, objects...)
+
+	current := semver.MustParse("2.25.0")
+	target := semver.MustParse("3.0.0")
+
+	return action.Target{
+		Client:         client.NewForTesting(client.TestClientConfig{Dynamic: dynamicClient}),
+		CurrentVersion: &current,
+		TargetVersion:  &target,
+		DryRun:         dryRun,
+		SkipConfirm:    true,
+		OutputDir:      t.TempDir(),
+		Recorder:       action.NewRootRecorder(),
+	}, dynamicClient
+}
+
+func TestConvert(t *testing.T) {
+	g := NewWithT(t)
+
+	hwp, err := acceleratorprofiles.Convert(nvidiaProfile())
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(hwp.GroupVersionKind()).To(Equal(resources.InfrastructureHardwareProfile.GVK()))
+	g.Expect(hwp.GetNamespace()).To(Equal(appNamespace))
+	g.Expect(hwp.GetName()).To(Equal("nvidia-gpu"))
+	g.Expect(hwp.GetAnnotations()).To(And(
+		HaveKeyWithValue("opendatahub.io/display-name", "NVIDIA GPU"),
+		HaveKeyWithValue("opendatahub.io/description", "Default NVIDIA GPU profile"),
+		HaveKeyWithValue("opendatahub.io/disabled", "false"),
+		HaveKeyWithValue("opendatahub.io/migrated-from", "acceleratorprofiles.dashboard.opendatahub.io/nvidia-gpu"),
+	))
+
+	identifiers, _, _ := unstructured.NestedSlice(hwp.Object, "spec", "identifiers")
+	g.Expect(identifiers).To(HaveLen(3))
+	g.Expect(identifiers[2]).To(MatchKeys(IgnoreExtras, Keys{
+		"identifier":   Equal("nvidia.com/gpu"),
+		"resourceType": Equal("Accelerator"),
+	}))
+
+	tolerations, _, _ := unstructured.NestedSlice(hwp.Object, "spec", "scheduling", "node", "tolerations")
+	g.Expect(tolerations).To(HaveLen(1))
+
+	nodeSelector, _, _ := unstructured.NestedStringMap(hwp.Object, "spec", "scheduling", "node", "nodeSelector")
+	g.Expect(nodeSelector).To(Equal(map[string]string{"nvidia.com/gpu.present": "true"}))
+}
+
+func TestConvert_DisabledWithoutScheduling(t *testing.T) {
+	g := NewWithT(t)
+
+	hwp, err := acceleratorprofiles.Convert(newAcceleratorProfile("custom", map[string]any{
+		"enabled":    false,
+		"identifier": "example.com/fpga",
+	}))
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(hwp.GetAnnotations()).To(And(
+		HaveKeyWithValue("opendatahub.io/display-name", "custom"),
+		HaveKeyWithValue("opendatahub.io/disabled", "true"),
+	))
+
+	_, found, _ := unstructured.NestedMap(hwp.Object, "spec", "scheduling")
+	g.Expect(found).To(BeFalse())
+}
+
+func TestConvert_MissingIdentifier(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := acceleratorprofiles.Convert(newAcceleratorProfile("broken", map[string]any{"displayName": "Broken"}))
+
+	g.Expect(err).To(MatchError(ContainSubstring("spec.identifier is not set")))
+}
+
+func TestAcceleratorProfileMigrationAction_Run(t *testing.T) {
+	g := NewWithT(t)
+
+	existing := resources.InfrastructureHardwareProfile.Unstructured()
+	existing.SetNamespace(appNamespace)
+	existing.SetName("already-converted")
+
+	target, dynamicClient := newTarget(t, false,
+		nvidiaProfile(),
+		newAcceleratorProfile("already-converted", map[string]any{"identifier": "amd.com/gpu"}),
+		&existing,
+	)
+	a := &acceleratorprofiles.AcceleratorProfileMigrationAction{}
+
+	g.Expect(a.CanApply(target)).To(BeTrue())
+
+	res, err := a.Run().Execute(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.Status.Steps).To(HaveLen(3))
+	g.Expect(res.Status.Steps[1].Message).To(Equal("1 AcceleratorProfile(s) to convert, 1 already converted"))
+	g.Expect(res.Status.Steps[2].Status).To(Equal(result.StepCompleted))
+
+	hwp, err := dynamicClient.Resource(resources.InfrastructureHardwareProfile.GVR()).Namespace(appNamespace).
+		Get(t.Context(), "nvidia-gpu", metav1.GetOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(hwp.GetAnnotations()).To(HaveKeyWithValue("opendatahub.io/display-name", "NVIDIA GPU"))
+}
+
+func TestAcceleratorProfileMigrationAction_DryRun(t *testing.T) {
+	g := NewWithT(t)
+
+	target, dynamicClient := newTarget(t, true, nvidiaProfile())
+
+	res, err := (&acceleratorprofiles.AcceleratorProfileMigrationAction{}).Run().Execute(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.Status.Steps).To(HaveLen(3))
+	g.Expect(res.Status.Steps[2]).To(MatchFields(IgnoreExtras, Fields{
+		"Status":  Equal(result.StepSkipped),
+		"Message": Equal("Would create 1 HardwareProfile(s)"),
+	}))
+	g.Expect(res.Status.Steps[2].Children[0].Message).To(And(
+		ContainSubstring("Would create HardwareProfile "+appNamespace+"/nvidia-gpu"),
+		ContainSubstring("kind: HardwareProfile"),
+		ContainSubstring("nvidia.com/gpu.present"),
+	))
+
+	hwps, err := dynamicClient.Resource(resources.InfrastructureHardwareProfile.GVR()).Namespace(appNamespace).
+		List(t.Context(), metav1.ListOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(hwps.Items).To(BeEmpty())
+}
+
+func TestAcceleratorProfileMigrationAction_ValidateInvalidProfile(t *testing.T) {
+	g := NewWithT(t)
+
+	target, _ := newTarget(t, false, newAcceleratorProfile("broken", map[string]any{"displayName": "Broken"}))
+
+	res, err := (&acceleratorprofiles.AcceleratorProfileMigrationAction{}).Run().Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.Status.Steps).To(HaveLen(2))
+	g.Expect(res.Status.Steps[1].Status).To(Equal(result.StepFailed))
+	g.Expect(res.Status.Steps[1].Children[0].Message).To(ContainSubstring("spec.identifier is not set"))
+}
+
+func TestAcceleratorProfileMigrationAction_PrepareBacksUpProfiles(t *testing.T) {
+	g := NewWithT(t)
+
+	target, _ := newTarget(t, false, nvidiaProfile())
+
+	res, err := (&acceleratorprofiles.AcceleratorProfileMigrationAction{}).Prepare().Execute(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.Status.Steps).To(HaveLen(2))
+	g.Expect(res.Status.Steps[1].Status).To(Equal(result.StepCompleted))
+
+	files, err := filepath.Glob(filepath.Join(target.OutputDir, appNamespace, "*.yaml"))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(files).To(HaveLen(1))
+
+	data, err := os.ReadFile(files[0])
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(data)).To(ContainSubstring("identifier: nvidia.com/gpu"))
+}
@@ -0,0 +1,103 @@
+package acceleratorprofiles
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+)
+
+const (
+	annotationDisplayName  = "opendatahub.io/display-name"
+	annotationDescription  = "opendatahub.io/description"
+	annotationDisabled     = "opendatahub.io/disabled"
+	annotationMigratedFrom = "opendatahub.io/migrated-from"
+
+	labelDashboard = "opendatahub.io/dashboard"
+)
+
+// nodeSelectors are the node labels set by the device operators of well-known accelerators,
+// used as the node selector of the HardwareProfile generated for their identifier.
+// AcceleratorProfiles have no node selector of their own.
+//
+//nolint:gochecknoglobals // Read-only identifier mapping
+var nodeSelectors = map[string]map[string]string{
+	"nvidia.com/gpu": {"nvidia.com/gpu.present": "true"},
+	"amd.com/gpu":    {"feature.node.kubernetes.io/amd-gpu": "true"},
+}
+
+// Convert returns the HardwareProfile (infrastructure.opendatahub.io) replacing an
+// AcceleratorProfile: same namespace and name, the CPU and memory identifiers of the 3.x default
+// profile plus the accelerator identifier, and node scheduling carrying the tolerations of the
+// AcceleratorProfile and the node selector of well-known accelerators.
+func Convert(ap *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	identifier, _, _ := unstructured.NestedString(ap.Object, "spec", "identifier")
+	if identifier == "" {
+		return nil, errors.New("spec.identifier is not set")
+	}
+
+	displayName, _, _ := unstructured.NestedString(ap.Object, "spec", "displayName")
+	if displayName == "" {
+		displayName = ap.GetName()
+	}
+
+	enabled, found, _ := unstructured.NestedBool(ap.Object, "spec", "enabled")
+	if !found {
+		enabled = true
+	}
+
+	tolerations, _, err := unstructured.NestedSlice(ap.Object, "spec", "tolerations")
+	if err != nil {
+		return nil, fmt.Errorf("reading spec.tolerations: %w", err)
+	}
+
+	annotations := map[string]string{
+		annotationDisplayName:  displayName,
+		annotationDisabled:     strconv.FormatBool(!enabled),
+		annotationMigratedFrom: resources.AcceleratorProfile.GVR().GroupResource().String() + "/" + ap.GetName(),
+	}
+
+	if description, _, _ := unstructured.NestedString(ap.Object, "spec", "description"); description != "" {
+		annotations[annotationDescription] = description
+	}
+
+	hwp := resources.InfrastructureHardwareProfile.Unstructured()
+	hwp.SetNamespace(ap.GetNamespace())
+	hwp.SetName(ap.GetName())
+	hwp.SetAnnotations(annotations)
+	hwp.SetLabels(map[string]string{labelDashboard: "true"})
+
+	spec := map[string]any{
+		"identifiers": []any{
+			map[string]any{"displayName": "CPU", "identifier": "cpu", "resourceType": "CPU", "minCount": int64(1), "defaultCount": int64(2)},
+			map[string]any{"displayName": "Memory", "identifier": "memory", "resourceType": "Memory", "minCount": "2Gi", "defaultCount": "4Gi"},
+			map[string]any{"displayName": displayName, "identifier": identifier, "resourceType": "Accelerator", "minCount": int64(1), "defaultCount": int64(1)},
+		},
+	}
+
+	node := map[string]any{}
+
+	if len(tolerations) > 0 {
+		node["tolerations"] = tolerations
+	}
+
+	if selector, ok := nodeSelectors[identifier]; ok {
+		nodeSelector := make(map[string]any, len(selector))
+		for k, v := range selector {
+			nodeSelector[k] = v
+		}
+
+		node["nodeSelector"] = nodeSelector
+	}
+
+	if len(node) > 0 {
+		spec["scheduling"] = map[string]any{"type": "Node", "node": node}
+	}
+
+	hwp.Object["spec"] = spec
+
+	return &hwp, nil
+}
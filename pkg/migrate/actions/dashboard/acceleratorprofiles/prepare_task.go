@@ -0,0 +1,80 @@
+package acceleratorprofiles
+
+import (
+	"context"
+	"errors"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/backup"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/action"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/action/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+)
+
+type prepareTask struct {
+	action *AcceleratorProfileMigrationAction
+}
+
+func (t *prepareTask) Validate(
+	ctx context.Context,
+	target action.Target,
+) (*result.ActionResult, error) {
+	t.action.findProfiles(ctx, target)
+
+	rootRecorder, ok := target.Recorder.(action.RootRecorder)
+	if !ok {
+		return nil, errors.New("recorder is not a RootRecorder")
+	}
+
+	return rootRecorder.Build(), nil
+}
+
+func (t *prepareTask) Execute(
+	ctx context.Context,
+	target action.Target,
+) (*result.ActionResult, error) {
+	if profiles, ok := t.action.findProfiles(ctx, target); ok {
+		t.backupProfiles(target, profiles)
+	}
+
+	rootRecorder, ok := target.Recorder.(action.RootRecorder)
+	if !ok {
+		return nil, errors.New("recorder is not a RootRecorder")
+	}
+
+	return rootRecorder.Build(), nil
+}
+
+// backupProfiles writes the AcceleratorProfiles to their namespace directory of the output
+// directory, so they can be restored once the upgrade no longer serves them.
+func (t *prepareTask) backupProfiles(
+	target action.Target,
+	profiles []*unstructured.Unstructured,
+) {
+	step := target.Recorder.Child(
+		"backup-acceleratorprofiles",
+		"Backup AcceleratorProfiles",
+	)
+
+	if len(profiles) == 0 {
+		step.Complete(result.StepSkipped, "No AcceleratorProfiles found")
+
+		return
+	}
+
+	if target.DryRun {
+		step.Complete(result.StepSkipped, "Would backup %d AcceleratorProfile(s) to %s", len(profiles), target.OutputDir)
+
+		return
+	}
+
+	// Namespaced resources are written to their namespace directory
+	if err := backup.WriteResourcesToDir(target.OutputDir, resources.AcceleratorProfile.GVR(), profiles); err != nil {
+		step.Complete(result.StepFailed, "Failed to write AcceleratorProfiles: %v", err)
+
+		return
+	}
+
+	step.Complete(result.StepCompleted, "Backed up %d AcceleratorProfile(s) to %s", len(profiles), target.OutputDir)
+}
@@ -0,0 +1,46 @@
+package acceleratorprofiles
+
+import (
+	"context"
+	"errors"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/action"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/action/result"
+)
+
+type runTask struct {
+	action *AcceleratorProfileMigrationAction
+}
+
+func (t *runTask) Validate(
+	ctx context.Context,
+	target action.Target,
+) (*result.ActionResult, error) {
+	if profiles, ok := t.action.findProfiles(ctx, target); ok {
+		t.action.validateConversions(ctx, target, profiles)
+	}
+
+	rootRecorder, ok := target.Recorder.(action.RootRecorder)
+	if !ok {
+		return nil, errors.New("recorder is not a RootRecorder")
+	}
+
+	return rootRecorder.Build(), nil
+}
+
+func (t *runTask) Execute(
+	ctx context.Context,
+	target action.Target,
+) (*result.ActionResult, error) {
+	if profiles, ok := t.action.findProfiles(ctx, target); ok {
+		conversions := t.action.validateConversions(ctx, target, profiles)
+		t.action.createProfiles(ctx, target, conversions)
+	}
+
+	rootRecorder, ok := target.Recorder.(action.RootRecorder)
+	if !ok {
+		return nil, errors.New("recorder is not a RootRecorder")
+	}
+
+	return rootRecorder.Build(), nil
+}
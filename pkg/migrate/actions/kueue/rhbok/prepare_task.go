@@ -68,12 +68,13 @@ func (t *prepareTask) backupKueueResources(
 	)
 
 	if target.DryRun {
-		step.Complete(result.StepSkipped, "Would backup ClusterQueues and ConfigMap to %s", target.OutputDir)
+		step.Complete(result.StepSkipped, "Would backup ClusterQueues, LocalQueues and ConfigMap to %s", target.OutputDir)
 
 		return
 	}
 
 	t.backupClusterQueues(ctx, target, step)
+	t.backupLocalQueues(ctx, target, step)
 	t.backupConfigMap(ctx, target, step)
 
 	step.Complete(result.StepCompleted, "Backup complete in %s", target.OutputDir)
@@ -118,6 +119,46 @@ func (t *prepareTask) backupClusterQueues(
 	step.Complete(result.StepCompleted, "Backed up %d ClusterQueues to %s", len(clusterQueues), target.OutputDir)
 }
 
+// backupLocalQueues writes the LocalQueues into the directory of their namespace, for a
+// rollback to restore them.
+func (t *prepareTask) backupLocalQueues(
+	ctx context.Context,
+	target action.Target,
+	parentStep action.StepRecorder,
+) {
+	step := parentStep.Child(
+		"backup-localqueues",
+		"Backup LocalQueues",
+	)
+
+	localQueues, err := target.Client.ListResources(ctx, resources.LocalQueue.GVR())
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			step.Complete(result.StepSkipped, "No LocalQueue CRD found")
+
+			return
+		}
+
+		step.Complete(result.StepFailed, "Failed to list LocalQueues: %v", err)
+
+		return
+	}
+
+	if len(localQueues) == 0 {
+		step.Complete(result.StepSkipped, "No LocalQueues found")
+
+		return
+	}
+
+	if err := backup.WriteResourcesToDir(target.OutputDir, resources.LocalQueue.GVR(), localQueues); err != nil {
+		step.Complete(result.StepFailed, "Failed to write LocalQueues: %v", err)
+
+		return
+	}
+
+	step.Complete(result.StepCompleted, "Backed up %d LocalQueues to %s", len(localQueues), target.OutputDir)
+}
+
 func (t *prepareTask) backupConfigMap(
 	ctx context.Context,
 	target action.Target,
@@ -8,7 +8,7 @@ import (
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/opendatahub-io/odh-cli/pkg/migrate/action"
 	"github.com/opendatahub-io/odh-cli/pkg/migrate/action/result"
@@ -29,17 +29,16 @@ const (
 	subscriptionName    = "kueue-operator"
 	subscriptionPackage = "kueue-operator"
 
-	// Retry configuration for conflict resolution.
-	retryInitialDuration = 500 * time.Millisecond
-	retryFactor          = 2.0
-	retryJitter          = 0.1
-	retryMaxSteps        = 5
-	subscriptionChannel  = "stable-v1.1"
-	subscriptionSource   = "redhat-operators"
-	sourceNamespace      = "openshift-marketplace"
-	csvNamePrefix        = "kueue-operator"
-	operatorTimeout      = 5 * time.Minute
-	operatorPollPeriod   = 10 * time.Second
+	subscriptionChannel = "stable-v1.1"
+	subscriptionSource  = "redhat-operators"
+	sourceNamespace     = "openshift-marketplace"
+	csvNamePrefix       = "kueue-operator"
+	operatorTimeout     = 5 * time.Minute
+	operatorPollPeriod  = 10 * time.Second
+
+	// subscriptionCreatedByAnnotation marks the Subscription the migration created, so rollback
+	// only removes an operator the migration installed.
+	subscriptionCreatedByAnnotation = "opendatahub.io/created-by"
 
 	// DataScienceCluster constants.
 	managementStateManaged   = "Managed"
@@ -53,7 +52,20 @@ const (
 	configMapAnnotationValue = "false"
 )
 
-type RHBOKMigrationAction struct{}
+var (
+	_ action.Mutator = (*RHBOKMigrationAction)(nil)
+	_ action.Cleaner = (*RHBOKMigrationAction)(nil)
+)
+
+type RHBOKMigrationAction struct {
+	// changes records what the current run changed, for Cleanup to undo.
+	changes runChanges
+
+	// admissionTimeout and admissionPollPeriod override how long and how often the admission
+	// of the existing Workloads is checked; zero uses the defaults.
+	admissionTimeout    time.Duration
+	admissionPollPeriod time.Duration
+}
 
 func (a *RHBOKMigrationAction) ID() string {
 	return actionID
@@ -75,6 +87,10 @@ func (a *RHBOKMigrationAction) CanApply(target action.Target) bool {
 	return target.CurrentVersion.Major == 2 && target.CurrentVersion.Minor >= 25
 }
 
+func (a *RHBOKMigrationAction) DependsOn() []string {
+	return nil
+}
+
 func (a *RHBOKMigrationAction) Prepare() action.Task {
 	return &prepareTask{action: a}
 }
@@ -83,6 +99,42 @@ func (a *RHBOKMigrationAction) Run() action.Task {
 	return &runTask{action: a}
 }
 
+// RequiredPermissions returns the permissions to switch Kueue to RHBOK, verify the admission
+// of the existing Workloads and, on rollback, recreate the saved queues and uninstall RHBOK.
+// Implements action.PermissionRequirer.
+func (a *RHBOKMigrationAction) RequiredPermissions() []resources.Permission {
+	permissions := resources.ReadPermissions(
+		resources.DataScienceCluster,
+		resources.ConfigMap,
+		resources.Subscription,
+		resources.ClusterServiceVersion,
+		resources.ClusterQueue,
+		resources.LocalQueue,
+		resources.Workload,
+		resources.Pod,
+	)
+
+	permissions = append(permissions, resources.DataScienceCluster.Permission(resources.VerbUpdate))
+	permissions = append(permissions, resources.ConfigMap.Permission(resources.VerbUpdate))
+	permissions = append(permissions, resources.Subscription.Permissions(resources.VerbCreate, resources.VerbDelete)...)
+	permissions = append(permissions, resources.ClusterServiceVersion.Permission(resources.VerbDelete))
+	permissions = append(permissions, resources.ClusterQueue.Permission(resources.VerbCreate))
+
+	return append(permissions, resources.LocalQueue.Permission(resources.VerbCreate))
+}
+
+// MutatedResources returns the Kueue ConfigMap annotated and the RHBOK Subscription
+// installed or updated by the run phase.
+func (a *RHBOKMigrationAction) MutatedResources(
+	_ context.Context,
+	_ action.Target,
+) ([]action.ResourceRef, error) {
+	return []action.ResourceRef{
+		{Type: resources.ConfigMap, Namespace: applicationsNamespace, Name: configMapName},
+		{Type: resources.Subscription, Namespace: operatorNamespace, Name: subscriptionName},
+	}, nil
+}
+
 func (a *RHBOKMigrationAction) checkKueueManaged(
 	ctx context.Context,
 	target action.Target,
@@ -162,41 +214,42 @@ func (a *RHBOKMigrationAction) preserveKueueConfig(
 		return
 	}
 
-	annotations, err := jq.Query[map[string]any](configMap, ".metadata.annotations")
-	if err != nil || annotations == nil {
-		annotations = make(map[string]any)
-	}
-
-	annotations[configMapAnnotationKey] = configMapAnnotationValue
+	_, annotated := configMap.GetAnnotations()[configMapAnnotationKey]
+	a.changes.annotatedConfigMap = !annotated
 
-	annotationsJSON, err := json.Marshal(annotations)
+	// Re-read the ConfigMap on conflicts so concurrent operator writes are not lost
+	_, err = client.UpdateWithConflictRetry(ctx, target.Client, resources.ConfigMap.GVR(), configMapName,
+		annotateConfigMap, client.InNamespace(applicationsNamespace))
 	if err != nil {
-		annotateStep.Complete(result.StepFailed, "Failed to marshal annotations: %v", err)
+		annotateStep.Complete(result.StepFailed, "Failed to update ConfigMap: %v", err)
 		step.Complete(result.StepFailed, "Failed to annotate ConfigMap")
 
 		return
 	}
 
-	if err := jq.Transform(configMap, ".metadata.annotations = %s", annotationsJSON); err != nil {
-		annotateStep.Complete(result.StepFailed, "Failed to set annotations: %v", err)
-		step.Complete(result.StepFailed, "Failed to annotate ConfigMap")
+	annotateStep.Complete(result.StepCompleted, "Annotation applied successfully")
+	step.Complete(result.StepCompleted, "ConfigMap %s annotated for preservation", configMapName)
+}
 
-		return
+// annotateConfigMap marks the Kueue ConfigMap as no longer managed by the operator.
+func annotateConfigMap(configMap *unstructured.Unstructured) error {
+	annotations, err := jq.Query[map[string]any](configMap, ".metadata.annotations")
+	if err != nil || annotations == nil {
+		annotations = make(map[string]any)
 	}
 
-	_, err = target.Client.Dynamic().Resource(resources.ConfigMap.GVR()).
-		Namespace(applicationsNamespace).
-		Update(ctx, configMap, metav1.UpdateOptions{})
+	annotations[configMapAnnotationKey] = configMapAnnotationValue
 
+	annotationsJSON, err := json.Marshal(annotations)
 	if err != nil {
-		annotateStep.Complete(result.StepFailed, "Failed to update ConfigMap: %v", err)
-		step.Complete(result.StepFailed, "Failed to annotate ConfigMap")
+		return fmt.Errorf("marshaling annotations: %w", err)
+	}
 
-		return
+	if err := jq.Transform(configMap, ".metadata.annotations = %s", annotationsJSON); err != nil {
+		return fmt.Errorf("setting annotations: %w", err)
 	}
 
-	annotateStep.Complete(result.StepCompleted, "Annotation applied successfully")
-	step.Complete(result.StepCompleted, "ConfigMap %s annotated for preservation", configMapName)
+	return nil
 }
 
 func (a *RHBOKMigrationAction) installRHBOKOperator(
@@ -226,6 +279,8 @@ func (a *RHBOKMigrationAction) installRHBOKOperator(
 		}
 	}
 
+	a.changes.createdSubscription = !target.DryRun && !subscriptionExists
+
 	err := olm.EnsureOperatorInstalled(ctx, target.Client, olm.InstallConfig{
 		Name:            subscriptionName,
 		Namespace:       operatorNamespace,
@@ -234,6 +289,7 @@ func (a *RHBOKMigrationAction) installRHBOKOperator(
 		Source:          subscriptionSource,
 		SourceNamespace: sourceNamespace,
 		CSVNamePrefix:   csvNamePrefix,
+		Annotations:     map[string]string{subscriptionCreatedByAnnotation: actionID},
 		PollInterval:    operatorPollPeriod,
 		Timeout:         operatorTimeout,
 		DryRun:          target.DryRun,
@@ -297,39 +353,19 @@ func (a *RHBOKMigrationAction) updateDataScienceCluster(
 		target.IO.Fprintln()
 	}
 
-	// Retry update with exponential backoff in case of conflicts
-	err = wait.ExponentialBackoff(wait.Backoff{
-		Duration: retryInitialDuration,
-		Factor:   retryFactor,
-		Jitter:   retryJitter,
-		Steps:    retryMaxSteps,
-	}, func() (bool, error) {
-		// Get latest version
-		latestDSC, err := client.GetDataScienceCluster(ctx, target.Client)
-		if err != nil {
-			return false, fmt.Errorf("failed to get DataScienceCluster: %w", err)
-		}
-
-		// Apply the change
-		if err := jq.Transform(latestDSC, ".spec.components.kueue.managementState = %q", managementStateUnmanaged); err != nil {
-			return false, fmt.Errorf("failed to set managementState: %w", err)
-		}
+	if currentState != "" {
+		a.changes.previousKueueState = currentState
+	}
 
-		// Attempt update
-		_, err = target.Client.Dynamic().Resource(resources.DataScienceCluster.GVR()).
-			Update(ctx, latestDSC, metav1.UpdateOptions{})
-		if err != nil {
-			// Retry on conflict errors
-			if apierrors.IsConflict(err) {
-				return false, nil // Retry
+	// Re-read the DataScienceCluster on conflicts so concurrent operator writes are not lost
+	_, err = client.UpdateWithConflictRetry(ctx, target.Client, resources.DataScienceCluster.GVR(), dsc.GetName(),
+		func(latest *unstructured.Unstructured) error {
+			if err := jq.Transform(latest, ".spec.components.kueue.managementState = %q", managementStateUnmanaged); err != nil {
+				return fmt.Errorf("setting managementState: %w", err)
 			}
 
-			return false, fmt.Errorf("failed to update DataScienceCluster: %w", err)
-		}
-
-		return true, nil // Success
-	})
-
+			return nil
+		})
 	if err != nil {
 		step.Complete(result.StepFailed, "Failed to update DataScienceCluster: %v", err)
 
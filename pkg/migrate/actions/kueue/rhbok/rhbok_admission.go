@@ -0,0 +1,184 @@
+package rhbok
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/action"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/action/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+)
+
+const (
+	// admissionTimeout bounds how long the RHBOK controller is given to admit the existing
+	// Workloads once it manages Kueue.
+	admissionTimeout    = 3 * time.Minute
+	admissionPollPeriod = 10 * time.Second
+
+	// queueNameLabel submits a pod to a Kueue LocalQueue.
+	queueNameLabel = "kueue.x-k8s.io/queue-name"
+
+	// admissionGate is the scheduling gate Kueue holds queued pods with until they are admitted.
+	admissionGate = "kueue.x-k8s.io/admission"
+)
+
+// admissionState is the admission state of the Kueue resources, each entry formatted as
+// "<namespace>/<name>: <reason>".
+type admissionState struct {
+	unadmittedWorkloads   []string
+	inactiveClusterQueues []string
+	gatedPods             []string
+}
+
+func (s *admissionState) settled() bool {
+	return len(s.unadmittedWorkloads) == 0 && len(s.inactiveClusterQueues) == 0 && len(s.gatedPods) == 0
+}
+
+// verifyWorkloadsAdmitted waits for the RHBOK controller to admit the existing Workloads once
+// Kueue is Unmanaged, and fails with the Workloads still unadmitted, the ClusterQueues not
+// active and the pods still gated when it does not within admissionTimeout.
+func (a *RHBOKMigrationAction) verifyWorkloadsAdmitted(
+	ctx context.Context,
+	target action.Target,
+) {
+	step := target.Recorder.Child(
+		"verify-workloads-admitted",
+		"Verify the RHBOK controller admits existing Workloads",
+	)
+
+	if target.DryRun {
+		step.Complete(result.StepSkipped, "Would verify existing Workloads are admitted by the RHBOK controller")
+
+		return
+	}
+
+	dsc, err := client.GetDataScienceCluster(ctx, target.Client)
+	if err != nil {
+		step.Complete(result.StepFailed, "Failed to get DataScienceCluster: %v", err)
+
+		return
+	}
+
+	if state, _ := jq.Query[string](dsc, kueueComponentPath); state != managementStateUnmanaged {
+		step.Complete(result.StepSkipped, "Kueue is not Unmanaged (managementState=%s), RHBOK does not manage the Workloads yet", state)
+
+		return
+	}
+
+	// The migration is applied: Workloads stuck after the switch are reported for the
+	// administrator to resolve, not rolled back by the cleanup of the run.
+	a.changes = runChanges{}
+
+	timeout, period := a.admissionTimeout, a.admissionPollPeriod
+	if timeout == 0 {
+		timeout, period = admissionTimeout, admissionPollPeriod
+	}
+
+	var state *admissionState
+
+	err = wait.PollUntilContextTimeout(ctx, period, timeout, true, func(ctx context.Context) (bool, error) {
+		state, err = readAdmissionState(ctx, target.Client)
+		if err != nil {
+			return false, err
+		}
+
+		return state.settled(), nil
+	})
+
+	if state == nil {
+		step.Complete(result.StepFailed, "Failed to read Kueue admission state: %v", err)
+
+		return
+	}
+
+	if state.settled() {
+		step.Complete(result.StepCompleted, "All existing Workloads are admitted or finished")
+
+		return
+	}
+
+	step.AddDetail("unadmittedWorkloads", state.unadmittedWorkloads)
+	step.AddDetail("inactiveClusterQueues", state.inactiveClusterQueues)
+	step.AddDetail("gatedPods", state.gatedPods)
+
+	step.Complete(result.StepFailed,
+		"After %s, %d Workload(s) are not admitted, %d ClusterQueue(s) are not active and %d pod(s) are waiting for admission",
+		timeout, len(state.unadmittedWorkloads), len(state.inactiveClusterQueues), len(state.gatedPods))
+}
+
+// readAdmissionState lists the Workloads neither admitted nor finished, the ClusterQueues not
+// active and the queued pods still held by the admission gate.
+func readAdmissionState(ctx context.Context, r client.Reader) (*admissionState, error) {
+	state := &admissionState{}
+
+	workloads, err := r.List(ctx, resources.Workload)
+	if err != nil && !client.IsResourceTypeNotFound(err) {
+		return nil, fmt.Errorf("listing Workloads: %w", err)
+	}
+
+	for _, wl := range workloads {
+		if conditionTrue(wl, "Admitted") || conditionTrue(wl, "Finished") {
+			continue
+		}
+
+		reason := conditionMessage(wl, "QuotaReserved")
+		if reason == "" {
+			reason = "not admitted"
+		}
+
+		state.unadmittedWorkloads = append(state.unadmittedWorkloads, fmt.Sprintf("%s/%s: %s", wl.GetNamespace(), wl.GetName(), reason))
+	}
+
+	clusterQueues, err := r.List(ctx, resources.ClusterQueue)
+	if err != nil && !client.IsResourceTypeNotFound(err) {
+		return nil, fmt.Errorf("listing ClusterQueues: %w", err)
+	}
+
+	for _, cq := range clusterQueues {
+		if conditionTrue(cq, "Active") {
+			continue
+		}
+
+		reason := conditionMessage(cq, "Active")
+		if reason == "" {
+			reason = "not active"
+		}
+
+		state.inactiveClusterQueues = append(state.inactiveClusterQueues, fmt.Sprintf("%s: %s", cq.GetName(), reason))
+	}
+
+	pods, err := r.List(ctx, resources.Pod, client.WithLabelSelector(queueNameLabel))
+	if err != nil {
+		return nil, fmt.Errorf("listing queued pods: %w", err)
+	}
+
+	for _, pod := range pods {
+		gates, _ := jq.Query[[]string](pod, "[.spec.schedulingGates[]?.name]")
+		if slices.Contains(gates, admissionGate) {
+			state.gatedPods = append(state.gatedPods, fmt.Sprintf("%s/%s: waiting for admission", pod.GetNamespace(), pod.GetName()))
+		}
+	}
+
+	return state, nil
+}
+
+// conditionTrue reports whether the status condition of the given type is True.
+func conditionTrue(obj *unstructured.Unstructured, conditionType string) bool {
+	status, _ := jq.Query[string](obj, fmt.Sprintf(".status.conditions[]? | select(.type == %q) | .status", conditionType))
+
+	return status == "True"
+}
+
+// conditionMessage returns the message of the status condition of the given type, or "".
+func conditionMessage(obj *unstructured.Unstructured, conditionType string) string {
+	message, _ := jq.Query[string](obj, fmt.Sprintf(".status.conditions[]? | select(.type == %q) | .message", conditionType))
+
+	return message
+}
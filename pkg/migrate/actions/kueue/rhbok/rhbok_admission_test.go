@@ -0,0 +1,147 @@
+//nolint:testpackage // Tests internal implementation (admission polling overrides)
+package rhbok
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/action"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/action/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+
+	. "github.com/onsi/gomega"
+)
+
+func newKueueObject(rt resources.ResourceType, namespace string, name string, conditions ...map[string]any) *unstructured.Unstructured {
+	obj := rt.Unstructured()
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+
+	items := make([]any, 0, len(conditions))
+	for _, c := range conditions {
+		items = append(items, c)
+	}
+
+	obj.Object["status"] = map[string]any{"conditions": items}
+
+	return &obj
+}
+
+func condition(conditionType string, status string, message string) map[string]any {
+	return map[string]any{"type": conditionType, "status": status, "message": message}
+}
+
+func newGatedPod(name string) *unstructured.Unstructured {
+	pod := resources.Pod.Unstructured()
+	pod.SetNamespace("team-a")
+	pod.SetName(name)
+	pod.SetLabels(map[string]string{queueNameLabel: "team-a-queue"})
+	pod.Object["spec"] = map[string]any{
+		"schedulingGates": []any{map[string]any{"name": admissionGate}},
+	}
+
+	return &pod
+}
+
+func newAdmissionTarget(kueueState string, objects ...runtime.Object) action.Target {
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			resources.DataScienceCluster.GVR(): resources.DataScienceCluster.ListKind(),
+			resources.Workload.GVR():           resources.Workload.ListKind(),
+			resources.ClusterQueue.GVR():       resources.ClusterQueue.ListKind(),
+			resources.Pod.GVR():                resources.Pod.ListKind(),
+		},
+		append(objects, testutil.NewDSC(map[string]string{"kueue": kueueState}))...)
+
+	return action.Target{
+		Client:   client.NewForTesting(client.TestClientConfig{Dynamic: dynamicClient}),
+		Recorder: action.NewRootRecorder(),
+		IO:       iostreams.NewIOStreams(nil, nil, nil),
+	}
+}
+
+func verifyAdmission(t *testing.T, a *RHBOKMigrationAction, target action.Target) result.ActionStep {
+	t.Helper()
+
+	a.verifyWorkloadsAdmitted(t.Context(), target)
+
+	root, _ := target.Recorder.(action.RootRecorder)
+	steps := root.Build().Status.Steps
+
+	NewWithT(t).Expect(steps).To(HaveLen(1))
+
+	return steps[0]
+}
+
+func TestVerifyWorkloadsAdmitted(t *testing.T) {
+	t.Run("should pass when every Workload is admitted or finished", func(t *testing.T) {
+		g := NewWithT(t)
+
+		target := newAdmissionTarget(managementStateUnmanaged,
+			newKueueObject(resources.Workload, "team-a", "admitted", condition("Admitted", "True", "")),
+			newKueueObject(resources.Workload, "team-a", "finished", condition("Finished", "True", "")),
+			newKueueObject(resources.ClusterQueue, "", "cluster-queue", condition("Active", "True", "")),
+		)
+
+		a := &RHBOKMigrationAction{changes: runChanges{createdSubscription: true}}
+
+		step := verifyAdmission(t, a, target)
+		g.Expect(step.Status).To(Equal(result.StepCompleted))
+
+		// A migration whose switch completed is not rolled back by the cleanup
+		g.Expect(a.changes).To(Equal(runChanges{}))
+	})
+
+	t.Run("should fail with the workloads stuck unadmitted", func(t *testing.T) {
+		g := NewWithT(t)
+
+		target := newAdmissionTarget(managementStateUnmanaged,
+			newKueueObject(resources.Workload, "team-a", "stuck",
+				condition("QuotaReserved", "False", "couldn't assign flavors to pod set main")),
+			newKueueObject(resources.ClusterQueue, "", "cluster-queue",
+				condition("Active", "False", "Can't admit new workloads: FlavorNotFound")),
+			newGatedPod("job-pod"),
+		)
+
+		a := &RHBOKMigrationAction{admissionTimeout: 20 * time.Millisecond, admissionPollPeriod: 5 * time.Millisecond}
+
+		step := verifyAdmission(t, a, target)
+		g.Expect(step.Status).To(Equal(result.StepFailed))
+		g.Expect(step.Message).To(ContainSubstring("1 Workload(s) are not admitted, 1 ClusterQueue(s) are not active and 1 pod(s) are waiting for admission"))
+		g.Expect(step.Details).To(HaveKeyWithValue("unadmittedWorkloads",
+			[]string{"team-a/stuck: couldn't assign flavors to pod set main"}))
+		g.Expect(step.Details).To(HaveKeyWithValue("inactiveClusterQueues",
+			[]string{"cluster-queue: Can't admit new workloads: FlavorNotFound"}))
+		g.Expect(step.Details).To(HaveKeyWithValue("gatedPods",
+			[]string{"team-a/job-pod: waiting for admission"}))
+	})
+
+	t.Run("should skip while Kueue is not Unmanaged", func(t *testing.T) {
+		g := NewWithT(t)
+
+		target := newAdmissionTarget(managementStateManaged)
+		a := &RHBOKMigrationAction{changes: runChanges{createdSubscription: true}}
+
+		step := verifyAdmission(t, a, target)
+		g.Expect(step.Status).To(Equal(result.StepSkipped))
+		g.Expect(a.changes.createdSubscription).To(BeTrue())
+	})
+
+	t.Run("should skip in dry-run mode", func(t *testing.T) {
+		g := NewWithT(t)
+
+		target := newAdmissionTarget(managementStateUnmanaged)
+		target.DryRun = true
+
+		step := verifyAdmission(t, &RHBOKMigrationAction{}, target)
+		g.Expect(step.Status).To(Equal(result.StepSkipped))
+	})
+}
@@ -0,0 +1,121 @@
+package rhbok
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/action"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+)
+
+// runChanges are the changes a run made to the cluster.
+type runChanges struct {
+	// annotatedConfigMap is set when the run added the preservation annotation to the Kueue
+	// ConfigMap.
+	annotatedConfigMap bool
+
+	// createdSubscription is set when the run created the RHBOK Subscription.
+	createdSubscription bool
+
+	// previousKueueState is the DataScienceCluster Kueue managementState the run replaced.
+	previousKueueState string
+}
+
+// Cleanup undoes the changes of an interrupted run, in reverse order: the DataScienceCluster
+// Kueue managementState is restored, the Subscription the run created is deleted with the CSV
+// it installed, and the preservation annotation is removed from the Kueue ConfigMap.
+func (a *RHBOKMigrationAction) Cleanup(ctx context.Context, target action.Target) error {
+	var errs []error
+
+	if state := a.changes.previousKueueState; state != "" {
+		target.IO.Errorf("Restoring DataScienceCluster Kueue managementState to %s", state)
+
+		if err := restoreKueueState(ctx, target.Client, state); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if a.changes.createdSubscription {
+		target.IO.Errorf("Deleting Subscription %s/%s", operatorNamespace, subscriptionName)
+
+		if err := deleteSubscription(ctx, target.Client); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if a.changes.annotatedConfigMap {
+		target.IO.Errorf("Removing annotation %s from ConfigMap %s/%s", configMapAnnotationKey, applicationsNamespace, configMapName)
+
+		_, err := client.UpdateWithConflictRetry(ctx, target.Client, resources.ConfigMap.GVR(), configMapName,
+			func(configMap *unstructured.Unstructured) error {
+				annotations := configMap.GetAnnotations()
+				delete(annotations, configMapAnnotationKey)
+				configMap.SetAnnotations(annotations)
+
+				return nil
+			}, client.InNamespace(applicationsNamespace))
+		if err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("removing ConfigMap annotation: %w", err))
+		}
+	}
+
+	a.changes = runChanges{}
+
+	return errors.Join(errs...)
+}
+
+// restoreKueueState sets the DataScienceCluster Kueue managementState back to state.
+func restoreKueueState(ctx context.Context, c client.Client, state string) error {
+	dsc, err := client.GetDataScienceCluster(ctx, c)
+	if err != nil {
+		return fmt.Errorf("getting DataScienceCluster: %w", err)
+	}
+
+	_, err = client.UpdateWithConflictRetry(ctx, c, resources.DataScienceCluster.GVR(), dsc.GetName(),
+		func(latest *unstructured.Unstructured) error {
+			if err := jq.Transform(latest, kueueComponentPath+" = %q", state); err != nil {
+				return fmt.Errorf("setting managementState: %w", err)
+			}
+
+			return nil
+		})
+	if err != nil {
+		return fmt.Errorf("restoring DataScienceCluster: %w", err)
+	}
+
+	return nil
+}
+
+// deleteSubscription deletes the RHBOK Subscription and the CSV it installed, if any.
+func deleteSubscription(ctx context.Context, c client.Client) error {
+	subscriptions := c.OLMClient().OperatorsV1alpha1().Subscriptions(operatorNamespace)
+
+	sub, err := subscriptions.Get(ctx, subscriptionName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("getting Subscription: %w", err)
+	}
+
+	if err := subscriptions.Delete(ctx, subscriptionName, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("deleting Subscription: %w", err)
+	}
+
+	if csv := sub.Status.InstalledCSV; csv != "" {
+		err := c.OLMClient().OperatorsV1alpha1().ClusterServiceVersions(operatorNamespace).Delete(ctx, csv, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("deleting ClusterServiceVersion %s: %w", csv, err)
+		}
+	}
+
+	return nil
+}
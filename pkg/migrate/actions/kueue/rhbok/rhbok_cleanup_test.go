@@ -0,0 +1,113 @@
+//nolint:testpackage // Tests internal implementation (recorded run changes)
+package rhbok
+
+import (
+	"testing"
+
+	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	operatorfake "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/clientset/versioned/fake"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/action"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+
+	. "github.com/onsi/gomega"
+)
+
+const installedCSV = "kueue-operator.v1.1.0"
+
+func newCleanupClient() client.Client {
+	configMap := resources.ConfigMap.Unstructured()
+	configMap.SetNamespace(applicationsNamespace)
+	configMap.SetName(configMapName)
+	configMap.SetAnnotations(map[string]string{configMapAnnotationKey: configMapAnnotationValue, "keep": "true"})
+
+	scheme := runtime.NewScheme()
+	_ = metav1.AddMetaToScheme(scheme)
+
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme,
+		map[schema.GroupVersionResource]string{
+			resources.DataScienceCluster.GVR(): resources.DataScienceCluster.ListKind(),
+			resources.ConfigMap.GVR():          resources.ConfigMap.ListKind(),
+		},
+		testutil.NewDSC(map[string]string{"kueue": managementStateUnmanaged}), &configMap)
+
+	//nolint:staticcheck // NewClientset requires generated apply configs not available in OLM
+	olmClient := operatorfake.NewSimpleClientset(
+		&operatorsv1alpha1.Subscription{
+			ObjectMeta: metav1.ObjectMeta{Name: subscriptionName, Namespace: operatorNamespace},
+			Status:     operatorsv1alpha1.SubscriptionStatus{InstalledCSV: installedCSV},
+		},
+		&operatorsv1alpha1.ClusterServiceVersion{
+			ObjectMeta: metav1.ObjectMeta{Name: installedCSV, Namespace: operatorNamespace},
+		},
+	)
+
+	return client.NewForTesting(client.TestClientConfig{Dynamic: dynamicClient, OLM: olmClient})
+}
+
+func TestCleanup(t *testing.T) {
+	t.Run("should undo every change of the run", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx := t.Context()
+
+		c := newCleanupClient()
+		a := &RHBOKMigrationAction{changes: runChanges{
+			annotatedConfigMap:  true,
+			createdSubscription: true,
+			previousKueueState:  managementStateManaged,
+		}}
+
+		err := a.Cleanup(ctx, action.Target{Client: c, IO: iostreams.NewIOStreams(nil, nil, nil)})
+		g.Expect(err).ToNot(HaveOccurred())
+
+		dsc, err := client.GetDataScienceCluster(ctx, c)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(jq.Query[string](dsc, kueueComponentPath)).To(Equal(managementStateManaged))
+
+		_, err = c.OLMClient().OperatorsV1alpha1().Subscriptions(operatorNamespace).Get(ctx, subscriptionName, metav1.GetOptions{})
+		g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+		_, err = c.OLMClient().OperatorsV1alpha1().ClusterServiceVersions(operatorNamespace).Get(ctx, installedCSV, metav1.GetOptions{})
+		g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+		configMap, err := c.Dynamic().Resource(resources.ConfigMap.GVR()).Namespace(applicationsNamespace).
+			Get(ctx, configMapName, metav1.GetOptions{})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(configMap.GetAnnotations()).To(Equal(map[string]string{"keep": "true"}))
+
+		g.Expect(a.changes).To(Equal(runChanges{}))
+	})
+
+	t.Run("should leave resources the run did not change", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx := t.Context()
+
+		c := newCleanupClient()
+		a := &RHBOKMigrationAction{}
+
+		err := a.Cleanup(ctx, action.Target{Client: c, IO: iostreams.NewIOStreams(nil, nil, nil)})
+		g.Expect(err).ToNot(HaveOccurred())
+
+		dsc, err := client.GetDataScienceCluster(ctx, c)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(jq.Query[string](dsc, kueueComponentPath)).To(Equal(managementStateUnmanaged))
+
+		_, err = c.OLMClient().OperatorsV1alpha1().Subscriptions(operatorNamespace).Get(ctx, subscriptionName, metav1.GetOptions{})
+		g.Expect(err).ToNot(HaveOccurred())
+
+		configMap, err := c.Dynamic().Resource(resources.ConfigMap.GVR()).Namespace(applicationsNamespace).
+			Get(ctx, configMapName, metav1.GetOptions{})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(configMap.GetAnnotations()).To(HaveKey(configMapAnnotationKey))
+	})
+}
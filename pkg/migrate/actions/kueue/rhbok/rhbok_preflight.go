@@ -5,12 +5,14 @@ import (
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/opendatahub-io/odh-cli/pkg/migrate/action"
 	"github.com/opendatahub-io/odh-cli/pkg/migrate/action/result"
 	"github.com/opendatahub-io/odh-cli/pkg/resources"
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
 	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kueue"
 )
 
 func (a *RHBOKMigrationAction) checkCurrentKueueState(
@@ -61,11 +63,11 @@ func (a *RHBOKMigrationAction) checkNoRHBOKConflicts(
 	)
 
 	subscription, err := target.Client.Dynamic().Resource(resources.Subscription.GVR()).
-		Namespace("openshift-kueue-operator").
-		Get(ctx, "kueue-operator", metav1.GetOptions{})
+		Namespace(kueue.RHBOKOperatorNamespace).
+		Get(ctx, kueue.RHBOKSubscriptionName, metav1.GetOptions{})
 
 	if err == nil && subscription != nil {
-		step.Complete(result.StepCompleted, "RHBOK operator already installed - migration may be partially complete")
+		a.checkInstalledRHBOKVersion(step, target, subscription)
 
 		return
 	}
@@ -79,6 +81,30 @@ func (a *RHBOKMigrationAction) checkNoRHBOKConflicts(
 	step.Complete(result.StepCompleted, "No RHBOK conflicts detected")
 }
 
+// checkInstalledRHBOKVersion validates an already-installed RHBOK operator against the
+// support matrix of the target version, so an outdated operator is upgraded before migrating.
+func (a *RHBOKMigrationAction) checkInstalledRHBOKVersion(
+	step action.StepRecorder,
+	target action.Target,
+	subscription *unstructured.Unstructured,
+) {
+	installedCSV, _ := jq.Query[string](subscription, ".status.installedCSV")
+	channel, _ := jq.Query[string](subscription, ".spec.channel")
+
+	step.AddDetail("installedCSV", installedCSV)
+	step.AddDetail("channel", channel)
+
+	compat := kueue.EvaluateRHBOK(target.TargetVersion, installedCSV, channel)
+	if !compat.Compatible {
+		step.Complete(result.StepFailed, "%s", compat.Message)
+
+		return
+	}
+
+	step.Complete(result.StepCompleted,
+		"RHBOK operator already installed (%s) - migration may be partially complete", installedCSV)
+}
+
 func (a *RHBOKMigrationAction) verifyKueueResources(
 	ctx context.Context,
 	target action.Target,
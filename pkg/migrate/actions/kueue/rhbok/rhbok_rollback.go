@@ -0,0 +1,267 @@
+package rhbok
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/backup"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/action"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/action/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/confirmation"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+)
+
+// queueBackup is the Kueue queues saved by the prepare phase.
+type queueBackup struct {
+	clusterQueues []*unstructured.Unstructured
+	localQueues   []*unstructured.Unstructured
+}
+
+// Rollback reverts the migration to the built-in Kueue: the RHBOK Subscription is deleted with
+// its CSV when the migration created it, the DataScienceCluster Kueue managementState is set back to Managed, and the
+// ClusterQueues and LocalQueues backed up by the prepare phase in target.OutputDir are
+// re-created where missing. The rollback stops at the first failed step and returns its error.
+func (a *RHBOKMigrationAction) Rollback(ctx context.Context, target action.Target) error {
+	saved, err := loadQueueBackup(ctx, target)
+	if err != nil {
+		return err
+	}
+
+	if !target.DryRun && !target.SkipConfirm {
+		target.IO.Fprintln()
+		target.IO.Errorf("About to uninstall Red Hat Build of Kueue Operator and restore the built-in Kueue")
+		if !confirmation.Prompt(target.IO, "Proceed with rollback?") {
+			step := target.Recorder.Child("confirm-rollback", "Confirm rollback")
+			step.Complete(result.StepSkipped, "User cancelled rollback")
+
+			return errors.New("rollback cancelled")
+		}
+		target.IO.Fprintln()
+	}
+
+	if err := removeRHBOKOperator(ctx, target); err != nil {
+		return err
+	}
+
+	if err := restoreKueueManaged(ctx, target); err != nil {
+		return err
+	}
+
+	return restoreQueues(ctx, target, saved)
+}
+
+// loadQueueBackup reads the ClusterQueues and LocalQueues of the backup in target.OutputDir.
+func loadQueueBackup(ctx context.Context, target action.Target) (*queueBackup, error) {
+	step := target.Recorder.Child(
+		"load-backup",
+		"Load Kueue resources backup",
+	)
+
+	reader, err := backup.NewReader(target.OutputDir)
+	if err != nil {
+		step.Complete(result.StepFailed, "Failed to read backup (run 'migrate prepare' first): %v", err)
+
+		return nil, fmt.Errorf("reading Kueue backup: %w", err)
+	}
+
+	saved := &queueBackup{}
+
+	saved.clusterQueues, err = reader.List(ctx, resources.ClusterQueue)
+	if err != nil {
+		step.Complete(result.StepFailed, "Failed to read backed up ClusterQueues: %v", err)
+
+		return nil, fmt.Errorf("reading backed up ClusterQueues: %w", err)
+	}
+
+	saved.localQueues, err = reader.List(ctx, resources.LocalQueue)
+	if err != nil {
+		step.Complete(result.StepFailed, "Failed to read backed up LocalQueues: %v", err)
+
+		return nil, fmt.Errorf("reading backed up LocalQueues: %w", err)
+	}
+
+	step.Complete(result.StepCompleted, "Found %d ClusterQueues and %d LocalQueues in %s",
+		len(saved.clusterQueues), len(saved.localQueues), target.OutputDir)
+
+	return saved, nil
+}
+
+// removeRHBOKOperator deletes the RHBOK Subscription and the CSV it installed. A Subscription
+// without the annotation of the migration existed before it and is kept.
+func removeRHBOKOperator(ctx context.Context, target action.Target) error {
+	step := target.Recorder.Child(
+		"remove-rhbok-operator",
+		"Remove Red Hat Build of Kueue Operator",
+	)
+
+	subscription, err := target.Client.OLMClient().OperatorsV1alpha1().Subscriptions(operatorNamespace).
+		Get(ctx, subscriptionName, metav1.GetOptions{})
+
+	switch {
+	case apierrors.IsNotFound(err):
+		step.Complete(result.StepSkipped, "Subscription %s/%s not found", operatorNamespace, subscriptionName)
+
+		return nil
+	case err != nil:
+		step.Complete(result.StepFailed, "Failed to get Subscription: %v", err)
+
+		return fmt.Errorf("getting Subscription: %w", err)
+	case subscription.GetAnnotations()[subscriptionCreatedByAnnotation] != actionID:
+		step.Complete(result.StepSkipped, "Subscription %s/%s was not created by the migration, keeping it",
+			operatorNamespace, subscriptionName)
+
+		return nil
+	case target.DryRun:
+		step.Complete(result.StepSkipped, "Would delete Subscription %s/%s and its CSV", operatorNamespace, subscriptionName)
+
+		return nil
+	}
+
+	if err := deleteSubscription(ctx, target.Client); err != nil {
+		step.Complete(result.StepFailed, "Failed to remove operator: %v", err)
+
+		return err
+	}
+
+	step.Complete(result.StepCompleted, "Deleted Subscription %s/%s and its CSV", operatorNamespace, subscriptionName)
+
+	return nil
+}
+
+// restoreKueueManaged sets the DataScienceCluster Kueue managementState back to Managed.
+func restoreKueueManaged(ctx context.Context, target action.Target) error {
+	step := target.Recorder.Child(
+		"restore-datasciencecluster",
+		"Restore DataScienceCluster Kueue managementState",
+	)
+
+	dsc, err := client.GetDataScienceCluster(ctx, target.Client)
+	if err != nil {
+		step.Complete(result.StepFailed, "Failed to get DataScienceCluster: %v", err)
+
+		return fmt.Errorf("getting DataScienceCluster: %w", err)
+	}
+
+	currentState, err := jq.Query[string](dsc, kueueComponentPath)
+	if err == nil && currentState == managementStateManaged {
+		step.Complete(result.StepSkipped, "DataScienceCluster Kueue already set to Managed")
+
+		return nil
+	}
+
+	if target.DryRun {
+		step.Complete(result.StepSkipped, "Would set %s=%s", kueueComponentPath, managementStateManaged)
+
+		return nil
+	}
+
+	if err := restoreKueueState(ctx, target.Client, managementStateManaged); err != nil {
+		step.Complete(result.StepFailed, "Failed to update DataScienceCluster: %v", err)
+
+		return err
+	}
+
+	step.Complete(result.StepCompleted, "DataScienceCluster Kueue set to Managed")
+
+	return nil
+}
+
+// restoreQueues re-creates the backed up ClusterQueues, then LocalQueues, that are missing from
+// the cluster. Queues that exist are left as they are.
+func restoreQueues(ctx context.Context, target action.Target, saved *queueBackup) error {
+	step := target.Recorder.Child(
+		"restore-kueue-resources",
+		"Restore ClusterQueue and LocalQueue resources",
+	)
+
+	restored := 0
+	existing := 0
+
+	for _, group := range []struct {
+		resourceType resources.ResourceType
+		objects      []*unstructured.Unstructured
+	}{
+		{resources.ClusterQueue, saved.clusterQueues},
+		{resources.LocalQueue, saved.localQueues},
+	} {
+		for _, obj := range group.objects {
+			created, err := restoreQueue(ctx, target, group.resourceType, obj)
+			if err != nil {
+				step.Complete(result.StepFailed, "Failed to restore %s %s: %v", group.resourceType.Kind, queueName(obj), err)
+
+				return fmt.Errorf("restoring %s %s: %w", group.resourceType.Kind, queueName(obj), err)
+			}
+
+			if created {
+				restored++
+			} else {
+				existing++
+			}
+		}
+	}
+
+	if target.DryRun {
+		step.Complete(result.StepSkipped, "Would restore %d queues (%d already exist)", restored, existing)
+
+		return nil
+	}
+
+	step.Complete(result.StepCompleted, "Restored %d queues (%d already exist)", restored, existing)
+
+	return nil
+}
+
+// restoreQueue creates a backed up queue if it is missing from the cluster and reports whether
+// it was, or in dry-run mode would be, created.
+func restoreQueue(
+	ctx context.Context,
+	target action.Target,
+	resourceType resources.ResourceType,
+	saved *unstructured.Unstructured,
+) (bool, error) {
+	_, err := target.Client.GetResource(ctx, resourceType, saved.GetName(), client.InNamespace(saved.GetNamespace()))
+	if err == nil {
+		return false, nil
+	}
+
+	if !apierrors.IsNotFound(err) {
+		return false, fmt.Errorf("getting %s: %w", resourceType.Kind, err)
+	}
+
+	if target.DryRun {
+		return true, nil
+	}
+
+	// Re-create the queue without server-populated fields
+	obj := saved.DeepCopy()
+	unstructured.RemoveNestedField(obj.Object, "status")
+	obj.SetResourceVersion("")
+	obj.SetUID("")
+	obj.SetCreationTimestamp(metav1.Time{})
+	obj.SetGeneration(0)
+	obj.SetManagedFields(nil)
+
+	_, err = target.Client.Dynamic().Resource(resourceType.GVR()).Namespace(obj.GetNamespace()).
+		Create(ctx, obj, metav1.CreateOptions{})
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return false, fmt.Errorf("creating %s: %w", resourceType.Kind, err)
+	}
+
+	return true, nil
+}
+
+// queueName returns the namespace/name of a namespaced queue, or the name of a cluster-scoped one.
+func queueName(obj *unstructured.Unstructured) string {
+	if obj.GetNamespace() == "" {
+		return obj.GetName()
+	}
+
+	return obj.GetNamespace() + "/" + obj.GetName()
+}
@@ -0,0 +1,191 @@
+//nolint:testpackage // Tests internal implementation (queue restore from the prepare backup)
+package rhbok
+
+import (
+	"testing"
+
+	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	operatorfake "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/clientset/versioned/fake"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/opendatahub-io/odh-cli/pkg/backup"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/action"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+
+	. "github.com/onsi/gomega"
+)
+
+// newRollbackTarget returns a target for a migrated cluster: Kueue Unmanaged, the RHBOK
+// Subscription installed, created by the migration unless preinstalled, and the given live
+// objects.
+func newRollbackTarget(t *testing.T, dryRun bool, preinstalled bool, objects ...runtime.Object) action.Target {
+	t.Helper()
+
+	subscription := &operatorsv1alpha1.Subscription{
+		ObjectMeta: metav1.ObjectMeta{Name: subscriptionName, Namespace: operatorNamespace},
+		Status:     operatorsv1alpha1.SubscriptionStatus{InstalledCSV: installedCSV},
+	}
+
+	if !preinstalled {
+		subscription.SetAnnotations(map[string]string{subscriptionCreatedByAnnotation: actionID})
+	}
+
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			resources.DataScienceCluster.GVR(): resources.DataScienceCluster.ListKind(),
+			resources.ClusterQueue.GVR():       resources.ClusterQueue.ListKind(),
+			resources.LocalQueue.GVR():         resources.LocalQueue.ListKind(),
+		},
+		append(objects, testutil.NewDSC(map[string]string{"kueue": managementStateUnmanaged}))...)
+
+	//nolint:staticcheck // NewClientset requires generated apply configs not available in OLM
+	olmClient := operatorfake.NewSimpleClientset(
+		subscription,
+		&operatorsv1alpha1.ClusterServiceVersion{
+			ObjectMeta: metav1.ObjectMeta{Name: installedCSV, Namespace: operatorNamespace},
+		},
+	)
+
+	return action.Target{
+		Client:      client.NewForTesting(client.TestClientConfig{Dynamic: dynamicClient, OLM: olmClient}),
+		DryRun:      dryRun,
+		SkipConfirm: true,
+		OutputDir:   t.TempDir(),
+		Recorder:    action.NewRootRecorder(),
+		IO:          iostreams.NewIOStreams(nil, nil, nil),
+	}
+}
+
+// writeQueueBackup writes the queues the way the prepare phase backs them up.
+func writeQueueBackup(t *testing.T, dir string) {
+	t.Helper()
+
+	clusterQueue := newKueueObject(resources.ClusterQueue, "", "cluster-queue")
+	clusterQueue.SetResourceVersion("42")
+	clusterQueue.SetUID("cq-uid")
+
+	err := backup.WriteResourcesToDir(dir, resources.ClusterQueue.GVR(),
+		[]*unstructured.Unstructured{clusterQueue, newKueueObject(resources.ClusterQueue, "", "existing-queue")})
+	NewWithT(t).Expect(err).ToNot(HaveOccurred())
+
+	err = backup.WriteResourcesToDir(dir, resources.LocalQueue.GVR(),
+		[]*unstructured.Unstructured{newKueueObject(resources.LocalQueue, "team-a", "team-a-queue")})
+	NewWithT(t).Expect(err).ToNot(HaveOccurred())
+}
+
+func TestRollback(t *testing.T) {
+	t.Run("should remove RHBOK, restore Kueue and re-create missing queues", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx := t.Context()
+
+		existing := newKueueObject(resources.ClusterQueue, "", "existing-queue")
+		existing.SetLabels(map[string]string{"live": "true"})
+
+		target := newRollbackTarget(t, false, false, existing)
+		writeQueueBackup(t, target.OutputDir)
+
+		g.Expect((&RHBOKMigrationAction{}).Rollback(ctx, target)).To(Succeed())
+
+		_, err := target.Client.OLMClient().OperatorsV1alpha1().Subscriptions(operatorNamespace).
+			Get(ctx, subscriptionName, metav1.GetOptions{})
+		g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+		_, err = target.Client.OLMClient().OperatorsV1alpha1().ClusterServiceVersions(operatorNamespace).
+			Get(ctx, installedCSV, metav1.GetOptions{})
+		g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+		dsc, err := client.GetDataScienceCluster(ctx, target.Client)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(jq.Query[string](dsc, kueueComponentPath)).To(Equal(managementStateManaged))
+
+		clusterQueue, err := target.Client.GetResource(ctx, resources.ClusterQueue, "cluster-queue")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(clusterQueue.GetUID()).To(BeEmpty())
+		g.Expect(clusterQueue.Object).ToNot(HaveKey("status"))
+
+		kept, err := target.Client.GetResource(ctx, resources.ClusterQueue, "existing-queue")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(kept.GetLabels()).To(HaveKeyWithValue("live", "true"))
+
+		_, err = target.Client.GetResource(ctx, resources.LocalQueue, "team-a-queue", client.InNamespace("team-a"))
+		g.Expect(err).ToNot(HaveOccurred())
+	})
+
+	t.Run("should keep a Subscription the migration did not create", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx := t.Context()
+
+		target := newRollbackTarget(t, false, true)
+		writeQueueBackup(t, target.OutputDir)
+
+		g.Expect((&RHBOKMigrationAction{}).Rollback(ctx, target)).To(Succeed())
+
+		_, err := target.Client.OLMClient().OperatorsV1alpha1().Subscriptions(operatorNamespace).
+			Get(ctx, subscriptionName, metav1.GetOptions{})
+		g.Expect(err).ToNot(HaveOccurred())
+
+		_, err = target.Client.OLMClient().OperatorsV1alpha1().ClusterServiceVersions(operatorNamespace).
+			Get(ctx, installedCSV, metav1.GetOptions{})
+		g.Expect(err).ToNot(HaveOccurred())
+
+		root, _ := target.Recorder.(action.RootRecorder)
+		steps := root.Build().Status.Steps
+		g.Expect(steps[1].Message).To(ContainSubstring("was not created by the migration, keeping it"))
+
+		// The rest of the rollback still runs
+		dsc, err := client.GetDataScienceCluster(ctx, target.Client)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(jq.Query[string](dsc, kueueComponentPath)).To(Equal(managementStateManaged))
+	})
+
+	t.Run("should change nothing in dry-run mode", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx := t.Context()
+
+		target := newRollbackTarget(t, true, false)
+		writeQueueBackup(t, target.OutputDir)
+
+		g.Expect((&RHBOKMigrationAction{}).Rollback(ctx, target)).To(Succeed())
+
+		_, err := target.Client.OLMClient().OperatorsV1alpha1().Subscriptions(operatorNamespace).
+			Get(ctx, subscriptionName, metav1.GetOptions{})
+		g.Expect(err).ToNot(HaveOccurred())
+
+		dsc, err := client.GetDataScienceCluster(ctx, target.Client)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(jq.Query[string](dsc, kueueComponentPath)).To(Equal(managementStateUnmanaged))
+
+		_, err = target.Client.GetResource(ctx, resources.ClusterQueue, "cluster-queue")
+		g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+		root, _ := target.Recorder.(action.RootRecorder)
+		steps := root.Build().Status.Steps
+		g.Expect(steps).To(HaveLen(4))
+		g.Expect(steps[3].Message).To(Equal("Would restore 3 queues (0 already exist)"))
+	})
+
+	t.Run("should fail before any change without a backup", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx := t.Context()
+
+		target := newRollbackTarget(t, false, false)
+		target.OutputDir += "/missing"
+
+		err := (&RHBOKMigrationAction{}).Rollback(ctx, target)
+		g.Expect(err).To(MatchError(ContainSubstring("reading Kueue backup")))
+
+		_, err = target.Client.OLMClient().OperatorsV1alpha1().Subscriptions(operatorNamespace).
+			Get(ctx, subscriptionName, metav1.GetOptions{})
+		g.Expect(err).ToNot(HaveOccurred())
+	})
+}
@@ -32,6 +32,8 @@ func (t *runTask) Execute(
 	ctx context.Context,
 	target action.Target,
 ) (*result.ActionResult, error) {
+	t.action.changes = runChanges{}
+
 	kueueManaged := t.action.checkKueueManaged(ctx, target)
 
 	if kueueManaged {
@@ -41,6 +43,7 @@ func (t *runTask) Execute(
 	t.action.installRHBOKOperator(ctx, target)
 	t.action.updateDataScienceCluster(ctx, target)
 	t.action.verifyResourcesPreserved(ctx, target)
+	t.action.verifyWorkloadsAdmitted(ctx, target)
 
 	rootRecorder, ok := target.Recorder.(action.RootRecorder)
 	if !ok {
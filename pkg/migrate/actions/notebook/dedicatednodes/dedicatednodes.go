@@ -0,0 +1,317 @@
+package dedicatednodes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/components/dashboard"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/notebook"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/action"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/action/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/confirmation"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+const (
+	actionID          = notebook.DedicatedNodesMigrationID
+	actionName        = "Migrate workbench dedicated node tolerations"
+	actionDescription = "Assigns a HardwareProfile carrying the 2.x dashboard notebook pod toleration to workbenches relying on it"
+)
+
+var _ action.Mutator = (*DedicatedNodesMigrationAction)(nil)
+
+// DedicatedNodesMigrationAction schedules the workbenches that rely on the toleration injected by
+// the 2.x dashboard "notebook pod tolerations" setting with a HardwareProfile carrying it, so they
+// keep landing on the dedicated notebook nodes once the 3.x dashboard stops injecting it.
+type DedicatedNodesMigrationAction struct{}
+
+func (a *DedicatedNodesMigrationAction) ID() string {
+	return actionID
+}
+
+func (a *DedicatedNodesMigrationAction) Name() string {
+	return actionName
+}
+
+func (a *DedicatedNodesMigrationAction) Description() string {
+	return actionDescription
+}
+
+func (a *DedicatedNodesMigrationAction) Group() action.ActionGroup {
+	return action.GroupMigration
+}
+
+func (a *DedicatedNodesMigrationAction) CanApply(target action.Target) bool {
+	return version.IsUpgradeFrom2xTo3x(target.CurrentVersion, target.TargetVersion)
+}
+
+// DependsOn returns the AcceleratorProfile conversion, as the HardwareProfiles it creates may
+// carry the dedicated node toleration the workbenches are migrated to.
+func (a *DedicatedNodesMigrationAction) DependsOn() []string {
+	return []string{dashboard.AcceleratorProfileMigrationID}
+}
+
+// Prepare returns nil: the safety snapshot taken before the run phase captures the workbenches.
+func (a *DedicatedNodesMigrationAction) Prepare() action.Task {
+	return nil
+}
+
+func (a *DedicatedNodesMigrationAction) Run() action.Task {
+	return &runTask{action: a}
+}
+
+// Rollback is not supported: restore the safety snapshot taken before the run instead.
+func (a *DedicatedNodesMigrationAction) Rollback(context.Context, action.Target) error {
+	return action.ErrRollbackNotSupported
+}
+
+// RequiredPermissions returns the permissions to read the toleration setting and the
+// HardwareProfiles, and update the workbenches relying on the toleration.
+// Implements action.PermissionRequirer.
+func (a *DedicatedNodesMigrationAction) RequiredPermissions() []resources.Permission {
+	return append(
+		resources.ReadPermissions(
+			resources.DSCInitialization,
+			resources.OdhDashboardConfig,
+			resources.HardwareProfile,
+			resources.InfrastructureHardwareProfile,
+			resources.Notebook,
+		),
+		resources.Notebook.Permission(resources.VerbUpdate),
+	)
+}
+
+// MutatedResources returns the workbenches the run phase assigns a HardwareProfile to.
+func (a *DedicatedNodesMigrationAction) MutatedResources(
+	ctx context.Context,
+	target action.Target,
+) ([]action.ResourceRef, error) {
+	scheduling, err := notebook.LoadDedicatedNodeScheduling(ctx, target.Client)
+	if err != nil {
+		return nil, fmt.Errorf("reading dashboard notebook toleration setting: %w", err)
+	}
+
+	if scheduling.TolerationKey == "" {
+		return nil, nil
+	}
+
+	notebooks, err := notebook.FindDedicatedNodeNotebooks(ctx, target.Client, scheduling)
+	if err != nil {
+		return nil, fmt.Errorf("finding workbenches relying on the dashboard toleration: %w", err)
+	}
+
+	refs := make([]action.ResourceRef, 0, len(notebooks))
+
+	for _, nb := range notebooks {
+		// Workbenches scheduled with another HardwareProfile are not modified
+		if _, ok := scheduling.ProfileReference(nb); ok {
+			continue
+		}
+
+		refs = append(refs, action.ResourceRef{Type: resources.Notebook, Namespace: nb.GetNamespace(), Name: nb.GetName()})
+	}
+
+	return refs, nil
+}
+
+// loadScheduling reads the toleration setting and the HardwareProfiles carrying it. It returns
+// nil when there is nothing to migrate or no HardwareProfile to migrate to.
+func (a *DedicatedNodesMigrationAction) loadScheduling(
+	ctx context.Context,
+	target action.Target,
+) *notebook.DedicatedNodeScheduling {
+	step := target.Recorder.Child(
+		"check-toleration-setting",
+		"Check dashboard notebook pod toleration setting",
+	)
+
+	scheduling, err := notebook.LoadDedicatedNodeScheduling(ctx, target.Client)
+	if err != nil {
+		step.Complete(result.StepFailed, "Failed to read dashboard notebook toleration setting: %v", err)
+
+		return nil
+	}
+
+	if scheduling.TolerationKey == "" {
+		step.Complete(result.StepSkipped, "Dashboard notebook pod toleration setting is not enabled")
+
+		return nil
+	}
+
+	step.Complete(result.StepCompleted, "Workbenches tolerate the %q taint", scheduling.TolerationKey)
+
+	profileStep := target.Recorder.Child(
+		"verify-hardware-profile",
+		"Verify a HardwareProfile tolerates the dedicated node taint",
+	)
+
+	if len(scheduling.Profiles) == 0 {
+		profileStep.Complete(result.StepFailed,
+			"No HardwareProfile tolerates the %q taint: create one with that toleration and a node selector for the dedicated notebook nodes",
+			scheduling.TolerationKey)
+
+		return nil
+	}
+
+	profileStep.AddDetail("hardwareProfiles", profileNames(scheduling.Profiles))
+	profileStep.Complete(result.StepCompleted, "HardwareProfile %s tolerates the %q taint",
+		scheduling.Profiles[0], scheduling.TolerationKey)
+
+	return scheduling
+}
+
+// findNotebooks returns the workbenches relying on the dashboard toleration that are not
+// scheduled with a HardwareProfile carrying it.
+func (a *DedicatedNodesMigrationAction) findNotebooks(
+	ctx context.Context,
+	target action.Target,
+	scheduling *notebook.DedicatedNodeScheduling,
+) ([]*unstructured.Unstructured, bool) {
+	step := target.Recorder.Child(
+		"find-workbenches",
+		"Find workbenches relying on the dashboard-injected toleration",
+	)
+
+	notebooks, err := notebook.FindDedicatedNodeNotebooks(ctx, target.Client, scheduling)
+	if err != nil {
+		step.Complete(result.StepFailed, "Failed to list workbenches: %v", err)
+
+		return nil, false
+	}
+
+	step.Complete(result.StepCompleted, "Found %d workbench(es) to migrate", len(notebooks))
+
+	return notebooks, true
+}
+
+// assignProfile annotates each workbench with the first HardwareProfile tolerating the taint.
+// Workbenches already scheduled with another HardwareProfile are left for manual review, as
+// replacing their profile would drop its resources and scheduling rules.
+func (a *DedicatedNodesMigrationAction) assignProfile(
+	ctx context.Context,
+	target action.Target,
+	scheduling *notebook.DedicatedNodeScheduling,
+	notebooks []*unstructured.Unstructured,
+) {
+	step := target.Recorder.Child(
+		"assign-hardware-profile",
+		"Assign HardwareProfile "+scheduling.Profiles[0].String()+" to workbenches",
+	)
+
+	if len(notebooks) == 0 {
+		step.Complete(result.StepSkipped, "No workbenches to migrate")
+
+		return
+	}
+
+	profile := scheduling.Profiles[0]
+
+	if !target.DryRun && !target.SkipConfirm {
+		target.IO.Fprintln()
+		target.IO.Errorf("About to assign HardwareProfile %s to %d workbench(es)", profile, len(notebooks))
+		if !confirmation.Prompt(target.IO, "Proceed with workbench update?") {
+			step.Complete(result.StepSkipped, "User cancelled update")
+
+			return
+		}
+		target.IO.Fprintln()
+	}
+
+	assigned, review, failed := 0, 0, 0
+
+	for _, nb := range notebooks {
+		nbStep := step.Child(nb.GetNamespace()+"/"+nb.GetName(), "Workbench "+nb.GetNamespace()+"/"+nb.GetName())
+
+		if current, ok := scheduling.ProfileReference(nb); ok {
+			nbStep.Complete(result.StepSkipped,
+				"Scheduled with HardwareProfile %s, which does not tolerate %q: add the toleration to that profile or reassign the workbench manually",
+				current, scheduling.TolerationKey)
+
+			review++
+
+			continue
+		}
+
+		if target.DryRun {
+			patch, err := patchCommand(nb, profile)
+			if err != nil {
+				nbStep.Complete(result.StepFailed, "Failed to build patch: %v", err)
+				failed++
+
+				continue
+			}
+
+			nbStep.AddDetail("patch", patch)
+			nbStep.Complete(result.StepSkipped, "Would assign HardwareProfile %s: %s", profile, patch)
+
+			continue
+		}
+
+		_, err := client.UpdateWithConflictRetry(ctx, target.Client, resources.Notebook.GVR(), nb.GetName(),
+			func(latest *unstructured.Unstructured) error {
+				annotations := latest.GetAnnotations()
+				if annotations == nil {
+					annotations = make(map[string]string)
+				}
+
+				annotations[notebook.AnnotationHardwareProfileName] = profile.Name
+				annotations[notebook.AnnotationHardwareProfileNamespace] = profile.Namespace
+				latest.SetAnnotations(annotations)
+
+				return nil
+			}, client.InNamespace(nb.GetNamespace()))
+		if err != nil {
+			nbStep.Complete(result.StepFailed, "Failed to update workbench: %v", err)
+			failed++
+
+			continue
+		}
+
+		nbStep.Complete(result.StepCompleted, "Assigned HardwareProfile %s", profile)
+		assigned++
+	}
+
+	switch {
+	case failed > 0:
+		step.Complete(result.StepFailed, "%d workbench(es) failed, %d assigned, %d need manual review", failed, assigned, review)
+	case target.DryRun:
+		step.Complete(result.StepSkipped, "Would assign HardwareProfile %s to %d workbench(es), %d need manual review",
+			profile, len(notebooks)-review, review)
+	default:
+		step.Complete(result.StepCompleted, "Assigned HardwareProfile %s to %d workbench(es), %d need manual review",
+			profile, assigned, review)
+	}
+}
+
+// patchCommand returns the kubectl command assigning profile to nb, for dry runs.
+func patchCommand(nb *unstructured.Unstructured, profile types.NamespacedName) (string, error) {
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]string{
+				notebook.AnnotationHardwareProfileName:      profile.Name,
+				notebook.AnnotationHardwareProfileNamespace: profile.Namespace,
+			},
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("encoding patch: %w", err)
+	}
+
+	return fmt.Sprintf("kubectl patch %s %s -n %s --type=merge -p '%s'",
+		resources.Notebook.GVR().GroupResource(), nb.GetName(), nb.GetNamespace(), patch), nil
+}
+
+func profileNames(profiles []types.NamespacedName) []string {
+	names := make([]string, 0, len(profiles))
+	for _, profile := range profiles {
+		names = append(names, profile.String())
+	}
+
+	return names
+}
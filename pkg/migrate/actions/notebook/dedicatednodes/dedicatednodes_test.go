@@ -0,0 +1,165 @@
+package dedicatednodes_test
+
+import (
+	"testing"
+
+	"github.com/blang/semver/v4"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/notebook"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/action"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/action/result"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/actions/notebook/dedicatednodes"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+const (
+	appNamespace = "redhat-ods-applications"
+	taintKey     = "NotebooksOnly"
+)
+
+//nolint:gochecknoglobals
+var listKinds = map[schema.GroupVersionResource]string{
+	resources.Notebook.GVR():                      resources.Notebook.ListKind(),
+	resources.OdhDashboardConfig.GVR():            resources.OdhDashboardConfig.ListKind(),
+	resources.HardwareProfile.GVR():               resources.HardwareProfile.ListKind(),
+	resources.InfrastructureHardwareProfile.GVR(): resources.InfrastructureHardwareProfile.ListKind(),
+	resources.DSCInitialization.GVR():             resources.DSCInitialization.ListKind(),
+}
+
+func newObject(rt resources.ResourceType, namespace string, name string, spec map[string]any) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{"spec": spec}}
+	obj.SetAPIVersion(rt.APIVersion())
+	obj.SetKind(rt.Kind)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+
+	return obj
+}
+
+func newNotebook(name string, profile string) *unstructured.Unstructured {
+	nb := newObject(resources.Notebook, "user-ns", name, map[string]any{
+		"template": map[string]any{
+			"spec": map[string]any{
+				"tolerations": []any{
+					map[string]any{"key": taintKey, "operator": "Exists", "effect": "NoSchedule"},
+				},
+			},
+		},
+	})
+
+	if profile != "" {
+		nb.SetAnnotations(map[string]string{notebook.AnnotationHardwareProfileName: profile})
+	}
+
+	return nb
+}
+
+func newTarget(t *testing.T, dryRun bool) (action.Target, *dynamicfake.FakeDynamicClient) {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	_ = metav1.AddMetaToScheme(scheme)
+
+	objects := []runtime.Object{
+		newObject(resources.DSCInitialization, "", "default-dsci", map[string]any{"applicationsNamespace": appNamespace}),
+		newObject(resources.OdhDashboardConfig, appNamespace, notebook.DashboardConfigName, map[string]any{
+			"notebookController": map[string]any{
+				"notebookTolerationSettings": map[string]any{"enabled": true, "key": taintKey},
+			},
+		}),
+		newObject(resources.HardwareProfile, appNamespace, "dedicated", map[string]any{
+			"tolerations": []any{map[string]any{"key": taintKey, "operator": "Exists"}},
+		}),
+		newNotebook("unassigned", ""),
+		newNotebook("other-profile", "small"),
+	}
+
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme, listKinds, objects...)
+
+	current := semver.MustParse("2.25.0")
+	target := semver.MustParse("3.0.0")
+
+	return action.Target{
+		Client:         client.NewForTesting(client.TestClientConfig{Dynamic: dynamicClient}),
+		CurrentVersion: &current,
+		TargetVersion:  &target,
+		DryRun:         dryRun,
+		SkipConfirm:    true,
+		Recorder:       action.NewRootRecorder(),
+	}, dynamicClient
+}
+
+func getAnnotations(t *testing.T, dynamicClient *dynamicfake.FakeDynamicClient, name string) map[string]string {
+	t.Helper()
+
+	nb, err := dynamicClient.Resource(resources.Notebook.GVR()).Namespace("user-ns").Get(t.Context(), name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return nb.GetAnnotations()
+}
+
+func TestDedicatedNodesMigrationAction_Run(t *testing.T) {
+	g := NewWithT(t)
+
+	target, dynamicClient := newTarget(t, false)
+	a := &dedicatednodes.DedicatedNodesMigrationAction{}
+
+	g.Expect(a.CanApply(target)).To(BeTrue())
+
+	res, err := a.Run().Execute(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.Status.Steps).To(HaveLen(4))
+	g.Expect(res.Status.Steps[3].Status).To(Equal(result.StepCompleted))
+
+	g.Expect(getAnnotations(t, dynamicClient, "unassigned")).To(Equal(map[string]string{
+		notebook.AnnotationHardwareProfileName:      "dedicated",
+		notebook.AnnotationHardwareProfileNamespace: appNamespace,
+	}))
+
+	// Workbenches scheduled with another profile are left for manual review.
+	g.Expect(getAnnotations(t, dynamicClient, "other-profile")).To(Equal(map[string]string{
+		notebook.AnnotationHardwareProfileName: "small",
+	}))
+}
+
+func TestDedicatedNodesMigrationAction_DryRun(t *testing.T) {
+	g := NewWithT(t)
+
+	target, dynamicClient := newTarget(t, true)
+
+	res, err := (&dedicatednodes.DedicatedNodesMigrationAction{}).Run().Execute(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.Status.Steps[3].Status).To(Equal(result.StepSkipped))
+	g.Expect(res.Status.Steps[3].Children).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+		"Name":    Equal("user-ns/unassigned"),
+		"Details": HaveKeyWithValue("patch", ContainSubstring("kubectl patch notebooks.kubeflow.org unassigned -n user-ns")),
+	})))
+	g.Expect(getAnnotations(t, dynamicClient, "unassigned")).To(BeEmpty())
+}
+
+func TestDedicatedNodesMigrationAction_MutatedResources(t *testing.T) {
+	g := NewWithT(t)
+
+	target, _ := newTarget(t, false)
+
+	refs, err := (&dedicatednodes.DedicatedNodesMigrationAction{}).MutatedResources(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(refs).To(ConsistOf(
+		action.ResourceRef{Type: resources.Notebook, Namespace: "user-ns", Name: "unassigned"},
+	))
+}
@@ -0,0 +1,47 @@
+package dedicatednodes
+
+import (
+	"context"
+	"errors"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/action"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/action/result"
+)
+
+type runTask struct {
+	action *DedicatedNodesMigrationAction
+}
+
+func (t *runTask) Validate(
+	ctx context.Context,
+	target action.Target,
+) (*result.ActionResult, error) {
+	if scheduling := t.action.loadScheduling(ctx, target); scheduling != nil {
+		t.action.findNotebooks(ctx, target, scheduling)
+	}
+
+	rootRecorder, ok := target.Recorder.(action.RootRecorder)
+	if !ok {
+		return nil, errors.New("recorder is not a RootRecorder")
+	}
+
+	return rootRecorder.Build(), nil
+}
+
+func (t *runTask) Execute(
+	ctx context.Context,
+	target action.Target,
+) (*result.ActionResult, error) {
+	if scheduling := t.action.loadScheduling(ctx, target); scheduling != nil {
+		if notebooks, ok := t.action.findNotebooks(ctx, target, scheduling); ok {
+			t.action.assignProfile(ctx, target, scheduling, notebooks)
+		}
+	}
+
+	rootRecorder, ok := target.Recorder.(action.RootRecorder)
+	if !ok {
+		return nil, errors.New("recorder is not a RootRecorder")
+	}
+
+	return rootRecorder.Build(), nil
+}
@@ -0,0 +1,184 @@
+package refreshcerts
+
+import (
+	"context"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/action"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/action/result"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/ray"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/confirmation"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+const (
+	actionID          = "ray.refresh-certs.migrate"
+	actionName        = "Regenerate RayCluster oauth-proxy certificates"
+	actionDescription = "Deletes the oauth-proxy serving certificate Secrets of RayClusters for regeneration and restarts their head pods"
+)
+
+// RefreshCertsAction regenerates the oauth-proxy serving certificates of the RayClusters of an
+// upgraded cluster. RayClusters upgraded in place can keep stale certificate Secrets, making
+// their dashboard route answer 502. The action is optional: it only runs when selected.
+type RefreshCertsAction struct{}
+
+func (a *RefreshCertsAction) ID() string {
+	return actionID
+}
+
+func (a *RefreshCertsAction) Name() string {
+	return actionName
+}
+
+func (a *RefreshCertsAction) Description() string {
+	return actionDescription
+}
+
+func (a *RefreshCertsAction) Group() action.ActionGroup {
+	return action.GroupMigration
+}
+
+// CanApply returns true once the cluster runs 3.x, as the stale certificates are left behind
+// by the upgrade.
+func (a *RefreshCertsAction) CanApply(target action.Target) bool {
+	return version.IsVersion3x(target.CurrentVersion)
+}
+
+func (a *RefreshCertsAction) DependsOn() []string {
+	return nil
+}
+
+// Optional returns true: refreshing restarts the head pods of the RayClusters, so the action
+// only runs when selected.
+func (a *RefreshCertsAction) Optional() bool {
+	return true
+}
+
+// Prepare returns nil: the Secrets are regenerated by the service CA operator and the head pods
+// re-created by KubeRay, so there is nothing to back up.
+func (a *RefreshCertsAction) Prepare() action.Task {
+	return nil
+}
+
+func (a *RefreshCertsAction) Run() action.Task {
+	return &runTask{action: a}
+}
+
+// Rollback is not supported: restore the safety snapshot taken before the run instead.
+func (a *RefreshCertsAction) Rollback(context.Context, action.Target) error {
+	return action.ErrRollbackNotSupported
+}
+
+// RequiredPermissions returns the permissions to find the serving certificate Secrets and head
+// pods of RayClusters, and delete them.
+// Implements action.PermissionRequirer.
+func (a *RefreshCertsAction) RequiredPermissions() []resources.Permission {
+	return append(
+		resources.ReadPermissions(resources.RayCluster, resources.Service, resources.Secret, resources.Pod),
+		resources.Secret.Permission(resources.VerbDelete),
+		resources.Pod.Permission(resources.VerbDelete),
+	)
+}
+
+// planRefreshes returns the refresh of each RayCluster with serving certificate Secrets.
+func (a *RefreshCertsAction) planRefreshes(ctx context.Context, target action.Target) ([]*ray.CertRefresh, bool) {
+	step := target.Recorder.Child(
+		"find-rayclusters",
+		"Find RayClusters with oauth-proxy serving certificates",
+	)
+
+	clusters, err := target.Client.List(ctx, resources.RayCluster)
+	if err != nil {
+		if client.IsResourceTypeNotFound(err) {
+			step.Complete(result.StepSkipped, "RayCluster CRD is not installed")
+
+			return nil, false
+		}
+
+		step.Complete(result.StepFailed, "Failed to list RayClusters: %v", err)
+
+		return nil, false
+	}
+
+	var refreshes []*ray.CertRefresh
+
+	for _, cluster := range clusters {
+		r, err := ray.PlanCertRefresh(ctx, target.Client, cluster)
+		if err != nil {
+			step.Complete(result.StepFailed, "%v", err)
+
+			return nil, false
+		}
+
+		if len(r.Secrets) > 0 {
+			refreshes = append(refreshes, r)
+		}
+	}
+
+	step.Complete(result.StepCompleted, "Found %d of %d RayCluster(s) with serving certificate Secrets",
+		len(refreshes), len(clusters))
+
+	return refreshes, true
+}
+
+// refresh regenerates the certificates of each RayCluster, or records what would be done in
+// dry-run mode. A failed cluster is recorded and the next one refreshed.
+func (a *RefreshCertsAction) refresh(ctx context.Context, target action.Target, refreshes []*ray.CertRefresh) {
+	step := target.Recorder.Child(
+		"refresh-certificates",
+		"Regenerate serving certificates and restart head pods",
+	)
+
+	if len(refreshes) == 0 {
+		step.Complete(result.StepSkipped, "No RayClusters to refresh")
+
+		return
+	}
+
+	if !target.DryRun && !target.SkipConfirm {
+		target.IO.Fprintln()
+		target.IO.Errorf("About to delete the serving certificate Secrets and restart the head pods of %d RayCluster(s)", len(refreshes))
+		if !confirmation.Prompt(target.IO, "Proceed? Running Ray jobs are interrupted.") {
+			step.Complete(result.StepSkipped, "User cancelled refresh")
+
+			return
+		}
+		target.IO.Fprintln()
+	}
+
+	refreshed, failed := 0, 0
+
+	for _, r := range refreshes {
+		name := r.Cluster.GetNamespace() + "/" + r.Cluster.GetName()
+		clusterStep := step.Child(name, "RayCluster "+name)
+		clusterStep.AddDetail("secrets", r.Secrets)
+		clusterStep.AddDetail("headPods", r.HeadPods)
+
+		if target.DryRun {
+			clusterStep.Complete(result.StepSkipped, "Would delete Secret(s) %v and restart head pod(s) %v", r.Secrets, r.HeadPods)
+
+			continue
+		}
+
+		if err := r.Apply(ctx, target.Client); err != nil {
+			clusterStep.Complete(result.StepFailed, "%v", err)
+			failed++
+
+			continue
+		}
+
+		clusterStep.Complete(result.StepCompleted, "Regenerated %d Secret(s) and restarted %d head pod(s)",
+			len(r.Secrets), len(r.HeadPods))
+		refreshed++
+	}
+
+	switch {
+	case failed > 0:
+		step.Complete(result.StepFailed, "%d RayCluster(s) failed, %d refreshed", failed, refreshed)
+	case target.DryRun:
+		step.Complete(result.StepSkipped, "Would refresh %d RayCluster(s)", len(refreshes))
+	default:
+		step.Complete(result.StepCompleted, "Refreshed %d RayCluster(s)", refreshed)
+	}
+}
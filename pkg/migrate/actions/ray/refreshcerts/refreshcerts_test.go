@@ -0,0 +1,119 @@
+package refreshcerts_test
+
+import (
+	"testing"
+
+	"github.com/blang/semver/v4"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/action"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/action/result"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/actions/ray/refreshcerts"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/ray"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+//nolint:gochecknoglobals
+var listKinds = map[schema.GroupVersionResource]string{
+	resources.RayCluster.GVR(): resources.RayCluster.ListKind(),
+	resources.Service.GVR():    resources.Service.ListKind(),
+	resources.Secret.GVR():     resources.Secret.ListKind(),
+	resources.Pod.GVR():        resources.Pod.ListKind(),
+}
+
+func newObject(rt resources.ResourceType, name string, labels map[string]string, annotations map[string]string) *unstructured.Unstructured {
+	obj := rt.Unstructured()
+	obj.SetNamespace("user-ns")
+	obj.SetName(name)
+	obj.SetLabels(labels)
+	obj.SetAnnotations(annotations)
+
+	return &obj
+}
+
+func newTarget(t *testing.T, currentVersion string, dryRun bool) (action.Target, *dynamicfake.FakeDynamicClient) {
+	t.Helper()
+
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), listKinds,
+		newObject(resources.RayCluster, "train", nil, nil),
+		newObject(resources.Service, "train-head-svc", map[string]string{ray.LabelCluster: "train"},
+			map[string]string{ray.AnnotationServingCertSecret: "train-proxy-tls"}),
+		newObject(resources.Secret, "train-proxy-tls", nil, nil),
+		newObject(resources.Pod, "train-head", map[string]string{ray.LabelCluster: "train", ray.LabelNodeType: ray.NodeTypeHead}, nil),
+	)
+
+	// The service CA operator regenerates deleted Secrets: keep them to simulate it
+	dynamicClient.PrependReactor("delete", "secrets", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, nil
+	})
+
+	current := semver.MustParse(currentVersion)
+	target := semver.MustParse("3.0.0")
+
+	return action.Target{
+		Client:         client.NewForTesting(client.TestClientConfig{Dynamic: dynamicClient}),
+		CurrentVersion: &current,
+		TargetVersion:  &target,
+		DryRun:         dryRun,
+		SkipConfirm:    true,
+		Recorder:       action.NewRootRecorder(),
+	}, dynamicClient
+}
+
+func TestRefreshCertsAction_CanApply(t *testing.T) {
+	g := NewWithT(t)
+
+	a := &refreshcerts.RefreshCertsAction{}
+
+	upgraded, _ := newTarget(t, "3.0.0", false)
+	g.Expect(a.CanApply(upgraded)).To(BeTrue())
+
+	notUpgraded, _ := newTarget(t, "2.25.0", false)
+	g.Expect(a.CanApply(notUpgraded)).To(BeFalse())
+}
+
+func TestRefreshCertsAction_Run(t *testing.T) {
+	g := NewWithT(t)
+
+	target, dynamicClient := newTarget(t, "3.0.0", false)
+
+	res, err := (&refreshcerts.RefreshCertsAction{}).Run().Execute(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.Status.Steps).To(HaveLen(2))
+	g.Expect(res.Status.Steps[1].Status).To(Equal(result.StepCompleted))
+	g.Expect(dynamicClient.Actions()).To(ContainElement(WithTransform(
+		func(a k8stesting.Action) bool { return a.Matches("delete", "secrets") }, BeTrue())))
+
+	_, err = dynamicClient.Resource(resources.Pod.GVR()).Namespace("user-ns").Get(t.Context(), "train-head", metav1.GetOptions{})
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+}
+
+func TestRefreshCertsAction_DryRun(t *testing.T) {
+	g := NewWithT(t)
+
+	target, dynamicClient := newTarget(t, "3.0.0", true)
+
+	res, err := (&refreshcerts.RefreshCertsAction{}).Run().Execute(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.Status.Steps[1].Status).To(Equal(result.StepSkipped))
+	g.Expect(res.Status.Steps[1].Children).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+		"Name":    Equal("user-ns/train"),
+		"Details": HaveKeyWithValue("secrets", ConsistOf("train-proxy-tls")),
+	})))
+
+	_, err = dynamicClient.Resource(resources.Pod.GVR()).Namespace("user-ns").Get(t.Context(), "train-head", metav1.GetOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+}
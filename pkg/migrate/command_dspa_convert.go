@@ -0,0 +1,321 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/yaml"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/dspa"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/confirmation"
+	"github.com/opendatahub-io/odh-cli/pkg/util/manifest"
+)
+
+var _ cmd.Command = (*DSPAConvertCommand)(nil)
+
+// dspaCRDName is the name of the DataSciencePipelinesApplication CRD.
+const dspaCRDName = "datasciencepipelinesapplications.datasciencepipelinesapplications.opendatahub.io"
+
+// DSPAConvertCommand converts DataSciencePipelinesApplication resources from v1alpha1 to v1.
+// In file mode it converts manifests and writes them to stdout; in cluster mode it
+// rewrites the DSPAs in place through the v1 API.
+type DSPAConvertCommand struct {
+	*SharedOptions
+
+	Filename       string
+	CRDFile        string
+	SkipValidation bool
+	DryRun         bool
+	Yes            bool
+
+	converter *dspa.Converter
+}
+
+func NewDSPAConvertCommand(streams genericiooptions.IOStreams) *DSPAConvertCommand {
+	return &DSPAConvertCommand{
+		SharedOptions: NewSharedOptions(streams),
+		converter:     dspa.NewConverter(),
+	}
+}
+
+func (c *DSPAConvertCommand) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVarP(&c.Filename, "filename", "f", "", flagDescDSPAFilename)
+	fs.StringVar(&c.CRDFile, "crd-file", "", flagDescDSPACRDFile)
+	fs.BoolVar(&c.SkipValidation, "skip-validation", false, flagDescDSPASkipValidation)
+	fs.BoolVar(&c.DryRun, "dry-run", false, flagDescDSPADryRun)
+	fs.BoolVarP(&c.Yes, "yes", "y", false, flagDescDSPAYes)
+	fs.DurationVar(&c.Timeout, "timeout", c.Timeout, flagDescDSPATimeout)
+
+	// Throttling settings
+	fs.Float32Var(&c.QPS, "qps", c.QPS, "Kubernetes API QPS limit (queries per second)")
+	fs.IntVar(&c.Burst, "burst", c.Burst, "Kubernetes API burst capacity")
+}
+
+// Complete creates the Kubernetes client unless file mode can run fully offline
+// (a local CRD file is provided or validation is skipped).
+func (c *DSPAConvertCommand) Complete() error {
+	if c.Filename != "" && (c.CRDFile != "" || c.SkipValidation) {
+		return nil
+	}
+
+	if err := c.SharedOptions.Complete(); err != nil {
+		return fmt.Errorf("completing shared options: %w", err)
+	}
+
+	return nil
+}
+
+func (c *DSPAConvertCommand) Validate() error {
+	if err := c.SharedOptions.Validate(); err != nil {
+		return fmt.Errorf("validating shared options: %w", err)
+	}
+
+	if c.SkipValidation && c.CRDFile != "" {
+		return errors.New("--crd-file and --skip-validation are mutually exclusive")
+	}
+
+	return nil
+}
+
+func (c *DSPAConvertCommand) Run(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+
+	var validator *dspa.Validator
+
+	if !c.SkipValidation {
+		crd, err := c.loadCRD(ctx)
+		if err != nil {
+			return err
+		}
+
+		validator, err = dspa.NewValidator(crd)
+		if err != nil {
+			return fmt.Errorf("preparing v1 schema validation: %w", err)
+		}
+	}
+
+	objects, err := c.loadObjects(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(objects) == 0 {
+		c.IO.Errorf("No DataSciencePipelinesApplication resources found")
+
+		return nil
+	}
+
+	converted := make([]*unstructured.Unstructured, 0, len(objects))
+	invalid := 0
+
+	for _, obj := range objects {
+		out, ok, err := c.convertObject(obj, validator)
+		if err != nil {
+			return err
+		}
+
+		if !ok {
+			invalid++
+
+			continue
+		}
+
+		converted = append(converted, out)
+	}
+
+	if invalid > 0 {
+		return fmt.Errorf("%d DataSciencePipelinesApplication(s) failed v1 schema validation", invalid)
+	}
+
+	if c.Filename != "" {
+		return writeManifests(c.IO.Out(), converted)
+	}
+
+	return c.apply(ctx, converted)
+}
+
+// convertObject converts a single object, prints its changes and diff, and validates the result.
+// Returns false when the converted object does not satisfy the v1 schema.
+func (c *DSPAConvertCommand) convertObject(
+	obj *unstructured.Unstructured,
+	validator *dspa.Validator,
+) (*unstructured.Unstructured, bool, error) {
+	out, changes, err := c.converter.Convert(obj)
+	if err != nil {
+		return nil, false, fmt.Errorf("converting DataSciencePipelinesApplication: %w", err)
+	}
+
+	c.IO.Errorf("%s/%s: %d change(s)", obj.GetNamespace(), obj.GetName(), len(changes))
+
+	for _, change := range changes {
+		c.IO.Errorf("  - %s", change)
+	}
+
+	diff, err := dspa.Diff(obj, out)
+	if err != nil {
+		return nil, false, fmt.Errorf("computing diff for %s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
+	}
+
+	if diff != "" {
+		_, _ = fmt.Fprint(c.IO.ErrOut(), diff)
+	}
+
+	if validator == nil {
+		return out, true, nil
+	}
+
+	problems := validator.Validate(out)
+	for _, problem := range problems {
+		c.IO.Errorf("  ! %s", problem)
+	}
+
+	return out, len(problems) == 0, nil
+}
+
+// apply rewrites the converted objects through the v1 API after confirmation.
+// Objects without field changes are rewritten too, which migrates their stored version to v1.
+func (c *DSPAConvertCommand) apply(ctx context.Context, objects []*unstructured.Unstructured) error {
+	if c.DryRun {
+		c.IO.Errorf("\nDry run: %d DataSciencePipelinesApplication(s) would be converted, no changes applied", len(objects))
+
+		return nil
+	}
+
+	if !c.Yes && !confirmation.Prompt(c.IO, fmt.Sprintf("\nConvert %d DataSciencePipelinesApplication(s) to v1?", len(objects))) {
+		c.IO.Errorf("Conversion cancelled")
+
+		return nil
+	}
+
+	for _, obj := range objects {
+		// On conflicts the latest object is re-read and converted again
+		_, err := client.UpdateWithConflictRetry(ctx, c.Client, resources.DataSciencePipelinesApplicationV1.GVR(), obj.GetName(),
+			c.reconvert, client.InNamespace(obj.GetNamespace()))
+		if err != nil {
+			return fmt.Errorf("updating DataSciencePipelinesApplication %s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
+		}
+
+		c.IO.Errorf("Converted %s/%s", obj.GetNamespace(), obj.GetName())
+	}
+
+	return nil
+}
+
+// reconvert converts a freshly fetched object in place for UpdateWithConflictRetry.
+func (c *DSPAConvertCommand) reconvert(latest *unstructured.Unstructured) error {
+	out, _, err := c.converter.Convert(latest)
+	if err != nil {
+		return fmt.Errorf("converting DataSciencePipelinesApplication: %w", err)
+	}
+
+	latest.Object = out.Object
+
+	return nil
+}
+
+// loadCRD reads the DataSciencePipelinesApplication CRD from --crd-file or the cluster.
+func (c *DSPAConvertCommand) loadCRD(ctx context.Context) (*apiextensionsv1.CustomResourceDefinition, error) {
+	if c.CRDFile != "" {
+		data, err := os.ReadFile(c.CRDFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading CRD file: %w", err)
+		}
+
+		var crd apiextensionsv1.CustomResourceDefinition
+		if err := yaml.Unmarshal(data, &crd); err != nil {
+			return nil, fmt.Errorf("parsing CRD file %s: %w", c.CRDFile, err)
+		}
+
+		return &crd, nil
+	}
+
+	crd, err := c.Client.APIExtensions().ApiextensionsV1().CustomResourceDefinitions().Get(ctx, dspaCRDName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("getting CRD %s: %w", dspaCRDName, err)
+	}
+
+	return crd, nil
+}
+
+// loadObjects reads DSPAs from --filename (file mode) or lists them from the cluster.
+// In cluster mode, the --namespace flag restricts the listing to a single namespace.
+func (c *DSPAConvertCommand) loadObjects(ctx context.Context) ([]*unstructured.Unstructured, error) {
+	if c.Filename != "" {
+		return c.readManifests()
+	}
+
+	var opts []client.ListResourcesOption
+	if c.ConfigFlags.Namespace != nil && *c.ConfigFlags.Namespace != "" {
+		opts = append(opts, client.WithNamespace(*c.ConfigFlags.Namespace))
+	}
+
+	objects, err := c.Client.List(ctx, resources.DataSciencePipelinesApplicationV1Alpha1, opts...)
+	if err != nil {
+		if client.IsResourceTypeNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("listing DataSciencePipelinesApplications: %w", err)
+	}
+
+	return objects, nil
+}
+
+// readManifests decodes all DataSciencePipelinesApplication documents from --filename, which may be
+// a file, a directory, a glob pattern or "-" for stdin.
+func (c *DSPAConvertCommand) readManifests() ([]*unstructured.Unstructured, error) {
+	docs, err := manifest.Load(c.IO.In(), c.Filename)
+	if err != nil {
+		return nil, fmt.Errorf("reading manifests: %w", err)
+	}
+
+	var objects []*unstructured.Unstructured
+
+	for _, doc := range docs {
+		obj := doc.Object
+		if obj.GetKind() != resources.DataSciencePipelinesApplicationV1.Kind {
+			c.IO.Errorf("Skipping %s %s (%s, document %d): not a DataSciencePipelinesApplication",
+				obj.GetKind(), obj.GetName(), doc.Source, doc.Index)
+
+			continue
+		}
+
+		objects = append(objects, obj)
+	}
+
+	return objects, nil
+}
+
+// writeManifests writes the converted objects as a multi-document YAML stream.
+func writeManifests(out io.Writer, objects []*unstructured.Unstructured) error {
+	docs := make([]string, 0, len(objects))
+
+	for _, obj := range objects {
+		data, err := yaml.Marshal(obj.Object)
+		if err != nil {
+			return fmt.Errorf("marshaling %s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
+		}
+
+		docs = append(docs, string(data))
+	}
+
+	if _, err := io.WriteString(out, strings.Join(docs, "---\n")); err != nil {
+		return fmt.Errorf("writing converted manifests: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package migrate_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate"
+
+	. "github.com/onsi/gomega"
+)
+
+const testDSPAManifests = `apiVersion: datasciencepipelinesapplications.opendatahub.io/v1alpha1
+kind: DataSciencePipelinesApplication
+metadata:
+  name: dspa
+  namespace: project
+spec:
+  dspVersion: v2
+  apiServer:
+    deploy: true
+    managedPipelines:
+      instructLab:
+        state: Managed
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: unrelated
+`
+
+func TestDSPAConvertCommand_FileMode(t *testing.T) {
+	g := NewWithT(t)
+
+	path := filepath.Join(t.TempDir(), "dspa.yaml")
+	g.Expect(os.WriteFile(path, []byte(testDSPAManifests), 0o600)).To(Succeed())
+
+	var out, errOut bytes.Buffer
+	cmd := migrate.NewDSPAConvertCommand(genericiooptions.IOStreams{
+		In:     &bytes.Buffer{},
+		Out:    &out,
+		ErrOut: &errOut,
+	})
+	cmd.Filename = path
+	cmd.SkipValidation = true
+
+	g.Expect(cmd.Complete()).To(Succeed())
+	g.Expect(cmd.Validate()).To(Succeed())
+	g.Expect(cmd.Run(t.Context())).To(Succeed())
+
+	g.Expect(out.String()).To(ContainSubstring("apiVersion: datasciencepipelinesapplications.opendatahub.io/v1\n"))
+	g.Expect(out.String()).ToNot(ContainSubstring("dspVersion"))
+	g.Expect(out.String()).ToNot(ContainSubstring("managedPipelines"))
+	g.Expect(out.String()).ToNot(ContainSubstring("ConfigMap"))
+
+	g.Expect(errOut.String()).To(ContainSubstring("project/dspa: 2 change(s)"))
+	g.Expect(errOut.String()).To(ContainSubstring("removed .spec.dspVersion"))
+	g.Expect(errOut.String()).To(ContainSubstring("Skipping ConfigMap unrelated"))
+}
+
+func TestDSPAConvertCommand_Validate(t *testing.T) {
+	g := NewWithT(t)
+
+	cmd := migrate.NewDSPAConvertCommand(genericiooptions.IOStreams{})
+	cmd.CRDFile = "crd.yaml"
+	cmd.SkipValidation = true
+
+	g.Expect(cmd.Validate()).To(MatchError(ContainSubstring("mutually exclusive")))
+}
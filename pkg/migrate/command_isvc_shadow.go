@@ -0,0 +1,367 @@
+package migrate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/yaml"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/isvc"
+	"github.com/opendatahub-io/odh-cli/pkg/printer/table"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/confirmation"
+)
+
+var _ cmd.Command = (*ISVCShadowCommand)(nil)
+
+const (
+	// defaultShadowGateway is the Gateway that exposes OpenShift AI model endpoints.
+	defaultShadowGateway = "openshift-ingress/data-science-gateway"
+
+	// defaultProbePath is the KServe v1 model status endpoint; {name} is the InferenceService name.
+	defaultProbePath = "/v1/models/{name}"
+
+	// probeRequestTimeout bounds a single probe request.
+	probeRequestTimeout = 10 * time.Second
+)
+
+// ISVCShadowCommand mirrors traffic from a Serverless InferenceService to its RawDeployment
+// replacement and compares both endpoints, so teams can validate the new path before
+// deleting the serverless one.
+type ISVCShadowCommand struct {
+	*SharedOptions
+
+	From         string
+	To           string
+	Gateway      string
+	Percent      int32
+	ProbeCount   int
+	ProbePath    string
+	ProbePayload string
+	Remove       bool
+	DryRun       bool
+	Yes          bool
+
+	namespace  string
+	httpClient *http.Client
+}
+
+func NewISVCShadowCommand(streams genericiooptions.IOStreams) *ISVCShadowCommand {
+	return &ISVCShadowCommand{
+		SharedOptions: NewSharedOptions(streams),
+		Gateway:       defaultShadowGateway,
+		Percent:       100,
+		ProbePath:     defaultProbePath,
+		httpClient:    &http.Client{Timeout: probeRequestTimeout},
+	}
+}
+
+func (c *ISVCShadowCommand) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&c.From, "from", "", flagDescShadowFrom)
+	fs.StringVar(&c.To, "to", "", flagDescShadowTo)
+	fs.StringVar(&c.Gateway, "gateway", c.Gateway, flagDescShadowGateway)
+	fs.Int32Var(&c.Percent, "percent", c.Percent, flagDescShadowPercent)
+	fs.IntVar(&c.ProbeCount, "probe-count", 0, flagDescShadowProbeCount)
+	fs.StringVar(&c.ProbePath, "probe-path", c.ProbePath, flagDescShadowProbePath)
+	fs.StringVar(&c.ProbePayload, "probe-payload", "", flagDescShadowProbePayload)
+	fs.StringVarP((*string)(&c.OutputFormat), "output", "o", string(OutputFormatTable), flagDescShadowOutput)
+	fs.BoolVar(&c.Remove, "remove", false, flagDescShadowRemove)
+	fs.BoolVar(&c.DryRun, "dry-run", false, flagDescShadowDryRun)
+	fs.BoolVarP(&c.Yes, "yes", "y", false, flagDescShadowYes)
+	fs.DurationVar(&c.Timeout, "timeout", c.Timeout, flagDescShadowTimeout)
+
+	// Throttling settings
+	fs.Float32Var(&c.QPS, "qps", c.QPS, "Kubernetes API QPS limit (queries per second)")
+	fs.IntVar(&c.Burst, "burst", c.Burst, "Kubernetes API burst capacity")
+}
+
+func (c *ISVCShadowCommand) Complete() error {
+	if err := c.SharedOptions.Complete(); err != nil {
+		return fmt.Errorf("completing shared options: %w", err)
+	}
+
+	namespace, _, err := c.ConfigFlags.ToRawKubeConfigLoader().Namespace()
+	if err != nil {
+		return fmt.Errorf("resolving namespace: %w", err)
+	}
+
+	c.namespace = namespace
+
+	return nil
+}
+
+func (c *ISVCShadowCommand) Validate() error {
+	if err := c.SharedOptions.Validate(); err != nil {
+		return fmt.Errorf("validating shared options: %w", err)
+	}
+
+	if c.From == "" {
+		return errors.New("--from is required")
+	}
+
+	if c.Remove {
+		return nil
+	}
+
+	if c.To == "" {
+		return errors.New("--to is required")
+	}
+
+	if c.Percent < 1 || c.Percent > 100 {
+		return fmt.Errorf("--percent must be between 1 and 100, got %d", c.Percent)
+	}
+
+	if c.ProbeCount < 0 {
+		return fmt.Errorf("--probe-count must not be negative, got %d", c.ProbeCount)
+	}
+
+	if _, _, ok := strings.Cut(c.Gateway, "/"); !ok {
+		return fmt.Errorf("--gateway must be in namespace/name form, got %q", c.Gateway)
+	}
+
+	return nil
+}
+
+func (c *ISVCShadowCommand) Run(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+
+	if c.Remove {
+		return c.removeShadow(ctx)
+	}
+
+	from, err := c.getInferenceService(ctx, c.From)
+	if err != nil {
+		return err
+	}
+
+	to, err := c.getInferenceService(ctx, c.To)
+	if err != nil {
+		return err
+	}
+
+	if err := isvc.ValidateShadowPair(from, to); err != nil {
+		return fmt.Errorf("validating InferenceServices: %w", err)
+	}
+
+	gatewayNamespace, gatewayName, _ := strings.Cut(c.Gateway, "/")
+
+	route, err := isvc.NewShadowRoute(isvc.ShadowRouteOptions{
+		From:             from,
+		To:               to,
+		GatewayNamespace: gatewayNamespace,
+		GatewayName:      gatewayName,
+		Percent:          c.Percent,
+	})
+	if err != nil {
+		return fmt.Errorf("building shadow route: %w", err)
+	}
+
+	if err := c.applyRoute(ctx, route); err != nil {
+		return err
+	}
+
+	if c.ProbeCount == 0 {
+		return nil
+	}
+
+	return c.compareEndpoints(ctx, from, to)
+}
+
+// applyRoute creates or updates the shadow HTTPRoute after confirmation.
+func (c *ISVCShadowCommand) applyRoute(ctx context.Context, route *unstructured.Unstructured) error {
+	if c.DryRun {
+		data, err := yaml.Marshal(route.Object)
+		if err != nil {
+			return fmt.Errorf("marshaling shadow route: %w", err)
+		}
+
+		c.IO.Errorf("Dry run: the following HTTPRoute would be applied")
+		_, _ = c.IO.Out().Write(data)
+
+		return nil
+	}
+
+	prompt := fmt.Sprintf("\nMirror %d%% of %s traffic to %s via HTTPRoute %s/%s?",
+		c.Percent, c.From, c.To, route.GetNamespace(), route.GetName())
+	if !c.Yes && !confirmation.Prompt(c.IO, prompt) {
+		c.IO.Errorf("Shadowing cancelled")
+
+		return nil
+	}
+
+	_, err := c.Client.Dynamic().Resource(resources.HTTPRoute.GVR()).
+		Namespace(route.GetNamespace()).
+		Create(ctx, route, metav1.CreateOptions{})
+
+	switch {
+	case err == nil:
+		c.IO.Errorf("Created HTTPRoute %s/%s", route.GetNamespace(), route.GetName())
+	case apierrors.IsAlreadyExists(err):
+		_, err = client.UpdateWithConflictRetry(ctx, c.Client, resources.HTTPRoute.GVR(), route.GetName(),
+			func(latest *unstructured.Unstructured) error {
+				latest.SetLabels(route.GetLabels())
+				latest.Object["spec"] = route.Object["spec"]
+
+				return nil
+			},
+			client.InNamespace(route.GetNamespace()))
+		if err != nil {
+			return fmt.Errorf("updating shadow route: %w", err)
+		}
+
+		c.IO.Errorf("Updated HTTPRoute %s/%s", route.GetNamespace(), route.GetName())
+	default:
+		return fmt.Errorf("creating shadow route: %w", err)
+	}
+
+	c.IO.Errorf("Remove it with: kubectl odh migrate inferenceservice shadow --from %s --remove", c.From)
+
+	return nil
+}
+
+// removeShadow deletes the shadow HTTPRoute of --from.
+func (c *ISVCShadowCommand) removeShadow(ctx context.Context) error {
+	name := isvc.ShadowRouteName(c.From)
+
+	if c.DryRun {
+		c.IO.Errorf("Dry run: HTTPRoute %s/%s would be deleted", c.namespace, name)
+
+		return nil
+	}
+
+	err := c.Client.Dynamic().Resource(resources.HTTPRoute.GVR()).
+		Namespace(c.namespace).
+		Delete(ctx, name, metav1.DeleteOptions{})
+	if apierrors.IsNotFound(err) {
+		c.IO.Errorf("No shadow route found for %s", c.From)
+
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting shadow route %s: %w", name, err)
+	}
+
+	c.IO.Errorf("Deleted HTTPRoute %s/%s", c.namespace, name)
+
+	return nil
+}
+
+// shadowRow is a single row of the endpoint comparison table.
+type shadowRow struct {
+	Role        string `json:"role"                mapstructure:"ROLE"`
+	Name        string `json:"name"                mapstructure:"NAME"`
+	Endpoint    string `json:"endpoint"            mapstructure:"ENDPOINT"`
+	SuccessRate string `json:"successRate"         mapstructure:"SUCCESS"`
+	Latency     string `json:"averageLatency"      mapstructure:"AVG LATENCY"`
+	LastError   string `json:"lastError,omitempty" mapstructure:"LAST ERROR"`
+}
+
+// compareEndpoints probes both InferenceServices and prints their success rates side by side.
+func (c *ISVCShadowCommand) compareEndpoints(ctx context.Context, from *unstructured.Unstructured, to *unstructured.Unstructured) error {
+	var payload []byte
+
+	if c.ProbePayload != "" {
+		data, err := os.ReadFile(c.ProbePayload)
+		if err != nil {
+			return fmt.Errorf("reading probe payload: %w", err)
+		}
+
+		payload = data
+	}
+
+	rows := make([]shadowRow, 0, 2)
+
+	for _, target := range []struct {
+		role string
+		obj  *unstructured.Unstructured
+	}{{"from", from}, {"to", to}} {
+		base, err := isvc.URL(target.obj)
+		if err != nil {
+			return fmt.Errorf("resolving endpoint: %w", err)
+		}
+
+		endpoint := strings.TrimSuffix(base, "/") + strings.ReplaceAll(c.ProbePath, "{name}", target.obj.GetName())
+		res := isvc.Probe(ctx, c.httpClient, endpoint, payload, c.ProbeCount)
+
+		rows = append(rows, shadowRow{
+			Role:        target.role,
+			Name:        target.obj.GetName(),
+			Endpoint:    res.Endpoint,
+			SuccessRate: fmt.Sprintf("%d/%d (%.0f%%)", res.Succeeded, res.Requests, res.SuccessRate()),
+			Latency:     res.AverageLatency().Round(time.Millisecond).String(),
+			LastError:   res.LastError,
+		})
+	}
+
+	switch c.OutputFormat {
+	case OutputFormatTable:
+		return c.printComparisonTable(rows)
+	case OutputFormatJSON:
+		data, err := json.MarshalIndent(rows, "", "  ")
+		if err != nil {
+			return fmt.Errorf("marshaling JSON: %w", err)
+		}
+
+		c.IO.Fprintf("%s\n", string(data))
+	case OutputFormatYAML:
+		data, err := yaml.Marshal(rows)
+		if err != nil {
+			return fmt.Errorf("marshaling YAML: %w", err)
+		}
+
+		c.IO.Fprintf("%s", string(data))
+	default:
+		return fmt.Errorf("unsupported output format: %s", c.OutputFormat)
+	}
+
+	return nil
+}
+
+func (c *ISVCShadowCommand) printComparisonTable(rows []shadowRow) error {
+	renderer := table.NewRenderer(
+		table.WithWriter[shadowRow](c.IO.Out()),
+		table.WithHeaders[shadowRow]("ROLE", "NAME", "ENDPOINT", "SUCCESS", "AVG LATENCY", "LAST ERROR"),
+		table.WithTableOptions[shadowRow](table.DefaultTableOptions...),
+	)
+
+	for _, row := range rows {
+		if err := renderer.Append(row); err != nil {
+			return fmt.Errorf("appending comparison row: %w", err)
+		}
+	}
+
+	if err := renderer.Render(); err != nil {
+		return fmt.Errorf("rendering comparison table: %w", err)
+	}
+
+	return nil
+}
+
+func (c *ISVCShadowCommand) getInferenceService(ctx context.Context, name string) (*unstructured.Unstructured, error) {
+	obj, err := c.Client.GetResource(ctx, resources.InferenceService, name, client.InNamespace(c.namespace))
+	if err != nil {
+		return nil, fmt.Errorf("getting InferenceService %s/%s: %w", c.namespace, name, err)
+	}
+
+	if obj == nil {
+		return nil, fmt.Errorf("InferenceService %s/%s is not accessible", c.namespace, name)
+	}
+
+	return obj, nil
+}
@@ -0,0 +1,139 @@
+package migrate_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/isvc"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/rules"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+// newShadowServer serves the model status endpoint of "model" and fails every request for "model-raw".
+func newShadowServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/models/model" {
+			w.WriteHeader(http.StatusOK)
+
+			return
+		}
+
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func shadowInferenceService(name string, mode string, url string) *unstructured.Unstructured {
+	obj := toRawInferenceService("project", name, mode)
+	obj.Object["status"] = map[string]any{"url": url}
+
+	return obj
+}
+
+func newShadowCommand(t *testing.T, format migrate.OutputFormat) (*migrate.ISVCShadowCommand, *bytes.Buffer) {
+	t.Helper()
+
+	server := newShadowServer(t)
+
+	dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			resources.InferenceService.GVR(): resources.InferenceService.ListKind(),
+			resources.HTTPRoute.GVR():        resources.HTTPRoute.ListKind(),
+		},
+		shadowInferenceService("model", isvc.DeploymentModeServerless, server.URL),
+		shadowInferenceService("model-raw", isvc.DeploymentModeRawDeployment, server.URL))
+
+	var out bytes.Buffer
+
+	namespace := "project"
+	apiServer := "https://127.0.0.1:6443"
+
+	// Complete resolves the namespace and builds a client; the fake client replaces it afterwards.
+	t.Setenv(rules.EnvConfigDir, t.TempDir())
+
+	command := migrate.NewISVCShadowCommand(genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: &out, ErrOut: &bytes.Buffer{}})
+	command.ConfigFlags = genericclioptions.NewConfigFlags(false)
+	command.ConfigFlags.Namespace = &namespace
+	command.ConfigFlags.APIServer = &apiServer
+	command.From = "model"
+	command.To = "model-raw"
+	command.ProbeCount = 2
+	command.OutputFormat = format
+	command.Yes = true
+
+	g := NewWithT(t)
+	g.Expect(command.Complete()).To(Succeed())
+	g.Expect(command.Validate()).To(Succeed())
+
+	command.Client = client.NewForTesting(client.TestClientConfig{Dynamic: dynamic})
+
+	return command, &out
+}
+
+func TestISVCShadowCommand_ComparisonTable(t *testing.T) {
+	g := NewWithT(t)
+
+	command, out := newShadowCommand(t, migrate.OutputFormatTable)
+
+	g.Expect(command.Run(t.Context())).To(Succeed())
+
+	// The table is boxed: border, header, separator, one line per endpoint, border.
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	g.Expect(lines).To(HaveLen(6))
+
+	fields := func(line string) []string {
+		return strings.Fields(strings.Trim(line, "│ "))
+	}
+
+	g.Expect(fields(lines[1])).To(Equal([]string{"ROLE", "NAME", "ENDPOINT", "SUCCESS", "AVG", "LATENCY", "LAST", "ERROR"}))
+	g.Expect(fields(lines[3])).To(HaveExactElements(
+		"from", "model", HaveSuffix("/v1/models/model"), "2/2", "(100%)", Not(BeEmpty())))
+	g.Expect(fields(lines[4])).To(HaveExactElements(
+		"to", "model-raw", HaveSuffix("/v1/models/model-raw"), "0/2", "(0%)", Not(BeEmpty()), "503", "Service", "Unavailable"))
+}
+
+func TestISVCShadowCommand_ComparisonJSON(t *testing.T) {
+	g := NewWithT(t)
+
+	command, out := newShadowCommand(t, migrate.OutputFormatJSON)
+
+	g.Expect(command.Run(t.Context())).To(Succeed())
+
+	var rows []map[string]string
+
+	g.Expect(json.Unmarshal(out.Bytes(), &rows)).To(Succeed())
+	g.Expect(rows).To(HaveLen(2))
+	g.Expect(rows[0]).To(And(
+		HaveKeyWithValue("role", "from"),
+		HaveKeyWithValue("name", "model"),
+		HaveKeyWithValue("endpoint", HaveSuffix("/v1/models/model")),
+		HaveKeyWithValue("successRate", "2/2 (100%)"),
+		HaveKey("averageLatency"),
+		Not(HaveKey("lastError")),
+	))
+	g.Expect(rows[1]).To(And(
+		HaveKeyWithValue("role", "to"),
+		HaveKeyWithValue("name", "model-raw"),
+		HaveKeyWithValue("successRate", "0/2 (0%)"),
+		HaveKeyWithValue("lastError", "503 Service Unavailable"),
+	))
+}
@@ -0,0 +1,305 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/backup"
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/isvc"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/confirmation"
+)
+
+var _ cmd.Command = (*ISVCToRawCommand)(nil)
+
+// isvcPollInterval is the interval at which deletion and predictor readiness are polled.
+const isvcPollInterval = 5 * time.Second
+
+// ISVCToRawCommand migrates Serverless InferenceServices to the RawDeployment mode. KServe does
+// not allow changing the deployment mode of an existing InferenceService, so each one is backed
+// up, deleted and re-created in RawDeployment mode, and its new predictor pods are awaited.
+type ISVCToRawCommand struct {
+	*SharedOptions
+
+	// Names restricts the migration to these InferenceServices.
+	Names []string
+
+	// BackupDir is where the original InferenceServices are saved before they are deleted.
+	BackupDir string
+
+	DryRun bool
+	Yes    bool
+}
+
+// isvcMigration is an InferenceService and its RawDeployment replacement.
+type isvcMigration struct {
+	original  *unstructured.Unstructured
+	converted *unstructured.Unstructured
+}
+
+func NewISVCToRawCommand(streams genericiooptions.IOStreams) *ISVCToRawCommand {
+	return &ISVCToRawCommand{
+		SharedOptions: NewSharedOptions(streams),
+		BackupDir:     DefaultSnapshotDir,
+	}
+}
+
+func (c *ISVCToRawCommand) AddFlags(fs *pflag.FlagSet) {
+	fs.StringArrayVar(&c.Names, "name", nil, flagDescToRawName)
+	fs.StringVar(&c.BackupDir, "backup-dir", c.BackupDir, flagDescToRawBackupDir)
+	fs.BoolVar(&c.DryRun, "dry-run", false, flagDescToRawDryRun)
+	fs.BoolVarP(&c.Yes, "yes", "y", false, flagDescToRawYes)
+	fs.DurationVar(&c.Timeout, "timeout", c.Timeout, flagDescToRawTimeout)
+
+	// Throttling settings
+	fs.Float32Var(&c.QPS, "qps", c.QPS, "Kubernetes API QPS limit (queries per second)")
+	fs.IntVar(&c.Burst, "burst", c.Burst, "Kubernetes API burst capacity")
+}
+
+func (c *ISVCToRawCommand) Complete() error {
+	if err := c.SharedOptions.Complete(); err != nil {
+		return fmt.Errorf("completing shared options: %w", err)
+	}
+
+	return nil
+}
+
+func (c *ISVCToRawCommand) Validate() error {
+	if err := c.SharedOptions.Validate(); err != nil {
+		return fmt.Errorf("validating shared options: %w", err)
+	}
+
+	if c.BackupDir == "" && !c.DryRun {
+		return errors.New("--backup-dir must not be empty: the original InferenceServices are deleted")
+	}
+
+	if len(c.Names) > 0 && c.namespace() == "" {
+		return errors.New("--name requires --namespace")
+	}
+
+	return nil
+}
+
+func (c *ISVCToRawCommand) Run(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+
+	objects, err := c.loadInferenceServices(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(objects) == 0 {
+		c.IO.Errorf("No Serverless InferenceServices found")
+
+		return nil
+	}
+
+	migrations := make([]isvcMigration, 0, len(objects))
+
+	for _, obj := range objects {
+		converted, changes, err := isvc.ConvertToRaw(obj)
+		if err != nil {
+			return fmt.Errorf("converting InferenceService: %w", err)
+		}
+
+		c.IO.Errorf("%s/%s: %d change(s)", obj.GetNamespace(), obj.GetName(), len(changes))
+
+		for _, change := range changes {
+			c.IO.Errorf("  - %s", change)
+		}
+
+		migrations = append(migrations, isvcMigration{original: obj, converted: converted})
+	}
+
+	if c.DryRun {
+		converted := make([]*unstructured.Unstructured, 0, len(migrations))
+		for _, m := range migrations {
+			converted = append(converted, m.converted)
+		}
+
+		c.IO.Errorf("\nDry run: %d InferenceService(s) would be re-created in %s mode, no changes applied",
+			len(migrations), isvc.DeploymentModeRawDeployment)
+
+		return writeManifests(c.IO.Out(), converted)
+	}
+
+	prompt := fmt.Sprintf("\nDelete and re-create %d InferenceService(s) in %s mode? Each model is unavailable until its new predictor is ready.",
+		len(migrations), isvc.DeploymentModeRawDeployment)
+	if !c.Yes && !confirmation.Prompt(c.IO, prompt) {
+		c.IO.Errorf("Migration cancelled")
+
+		return nil
+	}
+
+	dir, err := c.backupOriginals(migrations)
+	if err != nil {
+		return err
+	}
+
+	c.IO.Errorf("Original InferenceServices saved to: %s", dir)
+
+	for _, m := range migrations {
+		if err := c.replaceInferenceService(ctx, m); err != nil {
+			c.IO.Errorf("To roll back, delete the RawDeployment InferenceService and run 'migrate restore-snapshot %s'.", dir)
+
+			return fmt.Errorf("migrating InferenceService %s/%s: %w", m.original.GetNamespace(), m.original.GetName(), err)
+		}
+	}
+
+	return nil
+}
+
+// replaceInferenceService replaces an InferenceService with its RawDeployment conversion and
+// waits for the new predictor pods to be ready.
+func (o *SharedOptions) replaceInferenceService(ctx context.Context, m isvcMigration) error {
+	namespace, name := m.original.GetNamespace(), m.original.GetName()
+	isvcs := o.Client.Dynamic().Resource(resources.InferenceService.GVR()).Namespace(namespace)
+
+	// Foreground deletion removes the serving resources and pods before the new predictor starts
+	propagation := metav1.DeletePropagationForeground
+	uid := m.original.GetUID()
+
+	err := isvcs.Delete(ctx, name, metav1.DeleteOptions{
+		PropagationPolicy: &propagation,
+		Preconditions:     &metav1.Preconditions{UID: &uid},
+	})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("deleting InferenceService: %w", err)
+	}
+
+	o.IO.Errorf("Deleted %s/%s, waiting for its removal", namespace, name)
+
+	if err := poll(ctx, func(ctx context.Context) (bool, error) {
+		_, err := isvcs.Get(ctx, name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+
+		return false, err
+	}); err != nil {
+		return fmt.Errorf("waiting for the original InferenceService to be removed: %w", err)
+	}
+
+	if _, err := isvcs.Create(ctx, m.converted, metav1.CreateOptions{}); err != nil {
+		return fmt.Errorf("creating RawDeployment InferenceService: %w", err)
+	}
+
+	o.IO.Errorf("Created %s/%s in %s mode, waiting for predictor pods", namespace, name, isvc.DeploymentModeRawDeployment)
+
+	if err := poll(ctx, func(ctx context.Context) (bool, error) {
+		pods, err := o.Client.List(ctx, resources.Pod,
+			client.WithNamespace(namespace),
+			client.WithLabelSelector(isvc.PredictorPodSelector(name)))
+		if err != nil {
+			return false, err
+		}
+
+		return isvc.PodsReady(pods), nil
+	}); err != nil {
+		return fmt.Errorf("waiting for predictor pods to be ready: %w", err)
+	}
+
+	o.IO.Errorf("Migrated %s/%s: predictor pods are ready", namespace, name)
+
+	return nil
+}
+
+// poll runs condition until it is done or ctx expires; transient API errors are retried.
+func poll(ctx context.Context, condition wait.ConditionWithContextFunc) error {
+	//nolint:wrapcheck // Callers add context
+	return wait.PollUntilContextCancel(ctx, isvcPollInterval, true, func(ctx context.Context) (bool, error) {
+		done, err := condition(ctx)
+		if err != nil && client.IsUnrecoverableError(err) {
+			return false, err
+		}
+
+		return done && err == nil, nil
+	})
+}
+
+// backupOriginals writes the original InferenceServices to a timestamped subdirectory of
+// --backup-dir, in the snapshot layout read by migrate restore-snapshot.
+func (c *ISVCToRawCommand) backupOriginals(migrations []isvcMigration) (string, error) {
+	dir := filepath.Join(c.BackupDir, "snapshot-"+time.Now().Format("20060102-150405")+"-isvc-to-raw")
+
+	for _, m := range migrations {
+		if err := backup.WriteResourceToFile(dir, resources.InferenceService.GVR(), m.original); err != nil {
+			return "", fmt.Errorf("backing up InferenceService %s/%s: %w", m.original.GetNamespace(), m.original.GetName(), err)
+		}
+	}
+
+	return dir, nil
+}
+
+// loadInferenceServices lists the Serverless InferenceServices, restricted to the --namespace
+// flag and --name when set. Named InferenceServices that are missing or not Serverless are errors.
+func (c *ISVCToRawCommand) loadInferenceServices(ctx context.Context) ([]*unstructured.Unstructured, error) {
+	var opts []client.ListResourcesOption
+	if namespace := c.namespace(); namespace != "" {
+		opts = append(opts, client.WithNamespace(namespace))
+	}
+
+	objects, err := c.Client.List(ctx, resources.InferenceService, opts...)
+	if err != nil {
+		if client.IsResourceTypeNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("listing InferenceServices: %w", err)
+	}
+
+	var selected []*unstructured.Unstructured
+
+	found := make(map[string]bool)
+
+	for _, obj := range objects {
+		if len(c.Names) > 0 && !slices.Contains(c.Names, obj.GetName()) {
+			continue
+		}
+
+		found[obj.GetName()] = true
+
+		if !isvc.IsServerless(obj) {
+			if len(c.Names) > 0 {
+				return nil, fmt.Errorf("InferenceService %s/%s does not use %s=%s", obj.GetNamespace(), obj.GetName(),
+					isvc.AnnotationDeploymentMode, isvc.DeploymentModeServerless)
+			}
+
+			continue
+		}
+
+		selected = append(selected, obj)
+	}
+
+	for _, name := range c.Names {
+		if !found[name] {
+			return nil, fmt.Errorf("InferenceService %s/%s not found", c.namespace(), name)
+		}
+	}
+
+	return selected, nil
+}
+
+// namespace returns the --namespace flag, or empty to migrate all namespaces.
+func (o *SharedOptions) namespace() string {
+	if o.ConfigFlags.Namespace == nil {
+		return ""
+	}
+
+	return *o.ConfigFlags.Namespace
+}
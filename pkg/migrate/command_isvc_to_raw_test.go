@@ -0,0 +1,126 @@
+package migrate_test
+
+import (
+	"bytes"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/isvc"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+func toRawInferenceService(namespace string, name string, mode string) *unstructured.Unstructured {
+	obj := resources.InferenceService.Unstructured()
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	obj.SetAnnotations(map[string]string{isvc.AnnotationDeploymentMode: mode})
+	obj.Object["spec"] = map[string]any{"predictor": map[string]any{}}
+
+	return &obj
+}
+
+func readyPredictorPod(namespace string, name string) *unstructured.Unstructured {
+	pod := resources.Pod.Unstructured()
+	pod.SetNamespace(namespace)
+	pod.SetName(name + "-predictor-abc")
+	pod.SetLabels(map[string]string{isvc.LabelInferenceService: name, isvc.LabelComponent: "predictor"})
+	pod.Object["status"] = map[string]any{
+		"conditions": []any{map[string]any{"type": "Ready", "status": "True"}},
+	}
+
+	return &pod
+}
+
+func newToRawCommand(namespace string, objects ...runtime.Object) (*migrate.ISVCToRawCommand, *dynamicfake.FakeDynamicClient, *bytes.Buffer, *bytes.Buffer) {
+	dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			resources.InferenceService.GVR(): resources.InferenceService.ListKind(),
+			resources.Pod.GVR():              resources.Pod.ListKind(),
+		}, objects...)
+
+	var out, errOut bytes.Buffer
+
+	command := migrate.NewISVCToRawCommand(genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: &out, ErrOut: &errOut})
+	command.Client = client.NewForTesting(client.TestClientConfig{Dynamic: dynamic})
+	command.ConfigFlags = genericclioptions.NewConfigFlags(false)
+	command.ConfigFlags.Namespace = &namespace
+
+	return command, dynamic, &out, &errOut
+}
+
+func TestISVCToRawCommand_Run(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	command, dynamic, _, errOut := newToRawCommand("project",
+		toRawInferenceService("project", "model", isvc.DeploymentModeServerless),
+		toRawInferenceService("project", "raw", isvc.DeploymentModeRawDeployment),
+		toRawInferenceService("other", "model", isvc.DeploymentModeServerless),
+		readyPredictorPod("project", "model"))
+	command.BackupDir = t.TempDir()
+	command.Yes = true
+
+	g.Expect(command.Validate()).To(Succeed())
+	g.Expect(command.Run(ctx)).To(Succeed())
+	g.Expect(errOut.String()).To(ContainSubstring("Migrated project/model: predictor pods are ready"))
+	g.Expect(errOut.String()).ToNot(ContainSubstring("project/raw"))
+
+	migrated, err := dynamic.Resource(resources.InferenceService.GVR()).Namespace("project").Get(ctx, "model", metav1.GetOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(isvc.DeploymentMode(migrated)).To(Equal(isvc.DeploymentModeRawDeployment))
+
+	untouched, err := dynamic.Resource(resources.InferenceService.GVR()).Namespace("other").Get(ctx, "model", metav1.GetOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(isvc.DeploymentMode(untouched)).To(Equal(isvc.DeploymentModeServerless))
+
+	// The original is saved in the snapshot layout read by restore-snapshot
+	snapshots, err := migrate.LoadSnapshot(command.BackupDir)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(snapshots).To(HaveLen(1))
+	g.Expect(isvc.DeploymentMode(snapshots[0])).To(Equal(isvc.DeploymentModeServerless))
+}
+
+func TestISVCToRawCommand_DryRun(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	command, dynamic, out, _ := newToRawCommand("",
+		toRawInferenceService("project", "model", isvc.DeploymentModeServerless))
+	command.DryRun = true
+
+	g.Expect(command.Run(ctx)).To(Succeed())
+	g.Expect(out.String()).To(ContainSubstring("serving.kserve.io/deploymentMode: RawDeployment"))
+
+	live, err := dynamic.Resource(resources.InferenceService.GVR()).Namespace("project").Get(ctx, "model", metav1.GetOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(isvc.DeploymentMode(live)).To(Equal(isvc.DeploymentModeServerless))
+}
+
+func TestISVCToRawCommand_Names(t *testing.T) {
+	g := NewWithT(t)
+
+	command, _, _, _ := newToRawCommand("project",
+		toRawInferenceService("project", "raw", isvc.DeploymentModeRawDeployment))
+	command.DryRun = true
+
+	command.Names = []string{"missing"}
+	g.Expect(command.Run(t.Context())).To(MatchError(ContainSubstring("InferenceService project/missing not found")))
+
+	command.Names = []string{"raw"}
+	g.Expect(command.Run(t.Context())).To(MatchError(ContainSubstring("does not use serving.kserve.io/deploymentMode=Serverless")))
+
+	empty := ""
+	command.ConfigFlags.Namespace = &empty
+	g.Expect(command.Validate()).To(MatchError("--name requires --namespace"))
+}
@@ -0,0 +1,299 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/backup"
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/isvc"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/modelmesh"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/confirmation"
+)
+
+var _ cmd.Command = (*ModelMeshCommand)(nil)
+
+// ModelMeshCommand migrates ModelMesh InferenceServices to KServe RawDeployment ones. Each
+// multi-model ServingRuntime in use gets a single-model copy, then each InferenceService is
+// backed up, deleted and re-created in RawDeployment mode on that copy, and its new predictor
+// pods are awaited. The ModelMesh ServingRuntimes are left in place.
+type ModelMeshCommand struct {
+	*SharedOptions
+
+	// Names restricts the migration to these InferenceServices.
+	Names []string
+
+	// BackupDir is where the original InferenceServices and ServingRuntimes are saved.
+	BackupDir string
+
+	DryRun bool
+	Yes    bool
+}
+
+func NewModelMeshCommand(streams genericiooptions.IOStreams) *ModelMeshCommand {
+	return &ModelMeshCommand{
+		SharedOptions: NewSharedOptions(streams),
+		BackupDir:     DefaultSnapshotDir,
+	}
+}
+
+func (c *ModelMeshCommand) AddFlags(fs *pflag.FlagSet) {
+	fs.StringArrayVar(&c.Names, "name", nil, flagDescModelMeshName)
+	fs.StringVar(&c.BackupDir, "backup-dir", c.BackupDir, flagDescModelMeshBackupDir)
+	fs.BoolVar(&c.DryRun, "dry-run", false, flagDescModelMeshDryRun)
+	fs.BoolVarP(&c.Yes, "yes", "y", false, flagDescModelMeshYes)
+	fs.DurationVar(&c.Timeout, "timeout", c.Timeout, flagDescModelMeshTimeout)
+
+	// Throttling settings
+	fs.Float32Var(&c.QPS, "qps", c.QPS, "Kubernetes API QPS limit (queries per second)")
+	fs.IntVar(&c.Burst, "burst", c.Burst, "Kubernetes API burst capacity")
+}
+
+func (c *ModelMeshCommand) Complete() error {
+	if err := c.SharedOptions.Complete(); err != nil {
+		return fmt.Errorf("completing shared options: %w", err)
+	}
+
+	return nil
+}
+
+func (c *ModelMeshCommand) Validate() error {
+	if err := c.SharedOptions.Validate(); err != nil {
+		return fmt.Errorf("validating shared options: %w", err)
+	}
+
+	if c.BackupDir == "" && !c.DryRun {
+		return errors.New("--backup-dir must not be empty: the original InferenceServices are deleted")
+	}
+
+	if len(c.Names) > 0 && c.namespace() == "" {
+		return errors.New("--name requires --namespace")
+	}
+
+	return nil
+}
+
+func (c *ModelMeshCommand) Run(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+
+	objects, err := c.loadInferenceServices(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(objects) == 0 {
+		c.IO.Errorf("No ModelMesh InferenceServices found")
+
+		return nil
+	}
+
+	runtimes, err := c.loadServingRuntimes(ctx)
+	if err != nil {
+		return err
+	}
+
+	var (
+		originalRuntimes  []*unstructured.Unstructured
+		convertedRuntimes []*unstructured.Unstructured
+		migrations        = make([]isvcMigration, 0, len(objects))
+	)
+
+	for _, obj := range objects {
+		runtime, err := modelmesh.SelectRuntime(obj, runtimes)
+		if err != nil {
+			return fmt.Errorf("selecting ServingRuntime: %w", err)
+		}
+
+		if !slices.Contains(originalRuntimes, runtime) {
+			converted, changes, err := modelmesh.ConvertRuntime(runtime)
+			if err != nil {
+				return fmt.Errorf("converting ServingRuntime: %w", err)
+			}
+
+			c.printChanges("ServingRuntime", runtime, changes)
+
+			originalRuntimes = append(originalRuntimes, runtime)
+			convertedRuntimes = append(convertedRuntimes, converted)
+		}
+
+		converted, changes, err := modelmesh.ConvertInferenceService(obj, runtime)
+		if err != nil {
+			return fmt.Errorf("converting InferenceService: %w", err)
+		}
+
+		c.printChanges("InferenceService", obj, changes)
+
+		migrations = append(migrations, isvcMigration{original: obj, converted: converted})
+	}
+
+	if c.DryRun {
+		converted := slices.Clone(convertedRuntimes)
+		for _, m := range migrations {
+			converted = append(converted, m.converted)
+		}
+
+		c.IO.Errorf("\nDry run: %d ServingRuntime(s) would be created and %d InferenceService(s) re-created in %s mode, no changes applied",
+			len(convertedRuntimes), len(migrations), isvc.DeploymentModeRawDeployment)
+
+		return writeManifests(c.IO.Out(), converted)
+	}
+
+	prompt := fmt.Sprintf("\nCreate %d ServingRuntime(s), then delete and re-create %d InferenceService(s) in %s mode? Each model is unavailable until its new predictor is ready.",
+		len(convertedRuntimes), len(migrations), isvc.DeploymentModeRawDeployment)
+	if !c.Yes && !confirmation.Prompt(c.IO, prompt) {
+		c.IO.Errorf("Migration cancelled")
+
+		return nil
+	}
+
+	dir, err := c.backupOriginals(migrations, originalRuntimes)
+	if err != nil {
+		return err
+	}
+
+	c.IO.Errorf("Original InferenceServices and ServingRuntimes saved to: %s", dir)
+
+	for _, runtime := range convertedRuntimes {
+		if err := c.createRuntime(ctx, runtime); err != nil {
+			return err
+		}
+	}
+
+	for i, m := range migrations {
+		c.IO.Errorf("[%d/%d] Migrating InferenceService %s/%s", i+1, len(migrations), m.original.GetNamespace(), m.original.GetName())
+
+		if err := c.replaceInferenceService(ctx, m); err != nil {
+			c.IO.Errorf("To roll back, delete the RawDeployment InferenceService and run 'migrate restore-snapshot %s'.", dir)
+
+			return fmt.Errorf("migrating InferenceService %s/%s: %w", m.original.GetNamespace(), m.original.GetName(), err)
+		}
+	}
+
+	c.IO.Errorf("\nThe ModelMesh ServingRuntimes were kept; delete them once no ModelMesh InferenceService uses them.")
+
+	return nil
+}
+
+func (c *ModelMeshCommand) printChanges(kind string, obj *unstructured.Unstructured, changes []string) {
+	c.IO.Errorf("%s %s/%s: %d change(s)", kind, obj.GetNamespace(), obj.GetName(), len(changes))
+
+	for _, change := range changes {
+		c.IO.Errorf("  - %s", change)
+	}
+}
+
+// createRuntime creates the single-model copy of a ModelMesh ServingRuntime, keeping an existing
+// one, e.g. from an earlier run.
+func (c *ModelMeshCommand) createRuntime(ctx context.Context, runtime *unstructured.Unstructured) error {
+	_, err := c.Client.Dynamic().Resource(resources.ServingRuntime.GVR()).Namespace(runtime.GetNamespace()).
+		Create(ctx, runtime, metav1.CreateOptions{})
+
+	switch {
+	case apierrors.IsAlreadyExists(err):
+		c.IO.Errorf("ServingRuntime %s/%s already exists, keeping it", runtime.GetNamespace(), runtime.GetName())
+	case err != nil:
+		return fmt.Errorf("creating ServingRuntime %s/%s: %w", runtime.GetNamespace(), runtime.GetName(), err)
+	default:
+		c.IO.Errorf("Created ServingRuntime %s/%s", runtime.GetNamespace(), runtime.GetName())
+	}
+
+	return nil
+}
+
+// backupOriginals writes the original InferenceServices and ServingRuntimes to a timestamped
+// subdirectory of --backup-dir, in the snapshot layout read by migrate restore-snapshot.
+func (c *ModelMeshCommand) backupOriginals(migrations []isvcMigration, runtimes []*unstructured.Unstructured) (string, error) {
+	dir := filepath.Join(c.BackupDir, "snapshot-"+time.Now().Format("20060102-150405")+"-modelmesh")
+
+	for _, runtime := range runtimes {
+		if err := backup.WriteResourceToFile(dir, resources.ServingRuntime.GVR(), runtime); err != nil {
+			return "", fmt.Errorf("backing up ServingRuntime %s/%s: %w", runtime.GetNamespace(), runtime.GetName(), err)
+		}
+	}
+
+	for _, m := range migrations {
+		if err := backup.WriteResourceToFile(dir, resources.InferenceService.GVR(), m.original); err != nil {
+			return "", fmt.Errorf("backing up InferenceService %s/%s: %w", m.original.GetNamespace(), m.original.GetName(), err)
+		}
+	}
+
+	return dir, nil
+}
+
+// loadInferenceServices lists the ModelMesh InferenceServices, restricted to the --namespace
+// flag and --name when set. Named InferenceServices that are missing or not ModelMesh are errors.
+func (c *ModelMeshCommand) loadInferenceServices(ctx context.Context) ([]*unstructured.Unstructured, error) {
+	var opts []client.ListResourcesOption
+	if namespace := c.namespace(); namespace != "" {
+		opts = append(opts, client.WithNamespace(namespace))
+	}
+
+	objects, err := c.Client.List(ctx, resources.InferenceService, opts...)
+	if err != nil {
+		if client.IsResourceTypeNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("listing InferenceServices: %w", err)
+	}
+
+	var selected []*unstructured.Unstructured
+
+	found := make(map[string]bool)
+
+	for _, obj := range objects {
+		if len(c.Names) > 0 && !slices.Contains(c.Names, obj.GetName()) {
+			continue
+		}
+
+		found[obj.GetName()] = true
+
+		if !modelmesh.IsModelMesh(obj) {
+			if len(c.Names) > 0 {
+				return nil, fmt.Errorf("InferenceService %s/%s does not use %s=%s", obj.GetNamespace(), obj.GetName(),
+					isvc.AnnotationDeploymentMode, isvc.DeploymentModeModelMesh)
+			}
+
+			continue
+		}
+
+		selected = append(selected, obj)
+	}
+
+	for _, name := range c.Names {
+		if !found[name] {
+			return nil, fmt.Errorf("InferenceService %s/%s not found", c.namespace(), name)
+		}
+	}
+
+	return selected, nil
+}
+
+// loadServingRuntimes lists the ServingRuntimes in the --namespace scope.
+func (c *ModelMeshCommand) loadServingRuntimes(ctx context.Context) ([]*unstructured.Unstructured, error) {
+	var opts []client.ListResourcesOption
+	if namespace := c.namespace(); namespace != "" {
+		opts = append(opts, client.WithNamespace(namespace))
+	}
+
+	runtimes, err := c.Client.List(ctx, resources.ServingRuntime, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("listing ServingRuntimes: %w", err)
+	}
+
+	return runtimes, nil
+}
@@ -0,0 +1,142 @@
+package migrate_test
+
+import (
+	"bytes"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/isvc"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/modelmesh"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+func modelMeshRuntime(namespace string, name string) *unstructured.Unstructured {
+	obj := resources.ServingRuntime.Unstructured()
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	obj.Object["spec"] = map[string]any{
+		"multiModel":            true,
+		"grpcEndpoint":          "port:8085",
+		"supportedModelFormats": []any{map[string]any{"name": "onnx", "autoSelect": true}},
+	}
+
+	return &obj
+}
+
+func modelMeshInferenceService(namespace string, name string) *unstructured.Unstructured {
+	obj := toRawInferenceService(namespace, name, isvc.DeploymentModeModelMesh)
+	obj.Object["spec"] = map[string]any{
+		"predictor": map[string]any{"model": map[string]any{"modelFormat": map[string]any{"name": "onnx"}}},
+	}
+
+	return obj
+}
+
+func newModelMeshCommand(namespace string, objects ...runtime.Object) (*migrate.ModelMeshCommand, *dynamicfake.FakeDynamicClient, *bytes.Buffer, *bytes.Buffer) {
+	dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			resources.InferenceService.GVR(): resources.InferenceService.ListKind(),
+			resources.ServingRuntime.GVR():   resources.ServingRuntime.ListKind(),
+			resources.Pod.GVR():              resources.Pod.ListKind(),
+		}, objects...)
+
+	var out, errOut bytes.Buffer
+
+	command := migrate.NewModelMeshCommand(genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: &out, ErrOut: &errOut})
+	command.Client = client.NewForTesting(client.TestClientConfig{Dynamic: dynamic})
+	command.ConfigFlags = genericclioptions.NewConfigFlags(false)
+	command.ConfigFlags.Namespace = &namespace
+
+	return command, dynamic, &out, &errOut
+}
+
+func TestModelMeshCommand_Run(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	command, dynamic, _, errOut := newModelMeshCommand("project",
+		modelMeshRuntime("project", "ovms"),
+		modelMeshInferenceService("project", "model-a"),
+		modelMeshInferenceService("project", "model-b"),
+		toRawInferenceService("project", "raw", isvc.DeploymentModeRawDeployment),
+		readyPredictorPod("project", "model-a"),
+		readyPredictorPod("project", "model-b"))
+	command.BackupDir = t.TempDir()
+	command.Yes = true
+
+	g.Expect(command.Validate()).To(Succeed())
+	g.Expect(command.Run(ctx)).To(Succeed())
+	g.Expect(errOut.String()).To(ContainSubstring("Created ServingRuntime project/ovms-raw"))
+	g.Expect(errOut.String()).To(ContainSubstring("[2/2] Migrating InferenceService project/model-b"))
+	g.Expect(errOut.String()).ToNot(ContainSubstring("project/raw"))
+
+	runtime, err := dynamic.Resource(resources.ServingRuntime.GVR()).Namespace("project").Get(ctx, "ovms-raw", metav1.GetOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(modelmesh.IsMultiModel(runtime)).To(BeFalse())
+
+	// The ModelMesh runtime is kept
+	_, err = dynamic.Resource(resources.ServingRuntime.GVR()).Namespace("project").Get(ctx, "ovms", metav1.GetOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	migrated, err := dynamic.Resource(resources.InferenceService.GVR()).Namespace("project").Get(ctx, "model-a", metav1.GetOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(isvc.DeploymentMode(migrated)).To(Equal(isvc.DeploymentModeRawDeployment))
+
+	runtimeName, _, _ := unstructured.NestedString(migrated.Object, "spec", "predictor", "model", "runtime")
+	g.Expect(runtimeName).To(Equal("ovms-raw"))
+
+	// The originals are saved in the snapshot layout read by restore-snapshot
+	snapshots, err := migrate.LoadSnapshot(command.BackupDir)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(snapshots).To(HaveLen(3))
+}
+
+func TestModelMeshCommand_DryRun(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	command, dynamic, out, _ := newModelMeshCommand("",
+		modelMeshRuntime("project", "ovms"),
+		modelMeshInferenceService("project", "model"))
+	command.DryRun = true
+
+	g.Expect(command.Run(ctx)).To(Succeed())
+	g.Expect(out.String()).To(ContainSubstring("name: ovms-raw"))
+	g.Expect(out.String()).To(ContainSubstring("serving.kserve.io/deploymentMode: RawDeployment"))
+
+	_, err := dynamic.Resource(resources.ServingRuntime.GVR()).Namespace("project").Get(ctx, "ovms-raw", metav1.GetOptions{})
+	g.Expect(err).To(HaveOccurred())
+
+	live, err := dynamic.Resource(resources.InferenceService.GVR()).Namespace("project").Get(ctx, "model", metav1.GetOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(modelmesh.IsModelMesh(live)).To(BeTrue())
+}
+
+func TestModelMeshCommand_MissingRuntime(t *testing.T) {
+	g := NewWithT(t)
+
+	command, _, _, _ := newModelMeshCommand("project", modelMeshInferenceService("project", "model"))
+	command.DryRun = true
+
+	g.Expect(command.Run(t.Context())).To(MatchError(ContainSubstring(`no ModelMesh ServingRuntime in namespace project auto-selects model format "onnx"`)))
+}
+
+func TestModelMeshCommand_Validate(t *testing.T) {
+	g := NewWithT(t)
+
+	command, _, _, _ := newModelMeshCommand("")
+	command.Names = []string{"model"}
+
+	g.Expect(command.Validate()).To(MatchError("--name requires --namespace"))
+}
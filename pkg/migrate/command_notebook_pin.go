@@ -0,0 +1,205 @@
+package migrate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/pflag"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/notebook"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/registry"
+)
+
+var _ cmd.Command = (*NotebookPinDigestsCommand)(nil)
+
+// NotebookPinDigestsCommand resolves the current registry digest of custom workbench images
+// referenced by a floating tag (e.g. :latest) and prints patches pinning the workbenches to
+// those digests, so restarts during the upgrade cannot pull a different image.
+type NotebookPinDigestsCommand struct {
+	*SharedOptions
+
+	RegistryConfig string
+
+	registryClient *registry.Client
+}
+
+// jsonPatchOperation is a single RFC 6902 JSON Patch operation.
+type jsonPatchOperation struct {
+	Op    string `json:"op"`
+	Path  string `json:"path"`
+	Value string `json:"value"`
+}
+
+// pinnedImage is a container image of a notebook pinned to its current digest.
+type pinnedImage struct {
+	image  notebook.CustomImage
+	pinned string
+}
+
+func NewNotebookPinDigestsCommand(streams genericiooptions.IOStreams) *NotebookPinDigestsCommand {
+	return &NotebookPinDigestsCommand{
+		SharedOptions: NewSharedOptions(streams),
+	}
+}
+
+func (c *NotebookPinDigestsCommand) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&c.RegistryConfig, "registry-config", "", flagDescPinRegistryConfig)
+	fs.DurationVar(&c.Timeout, "timeout", c.Timeout, flagDescPinTimeout)
+
+	// Throttling settings
+	fs.Float32Var(&c.QPS, "qps", c.QPS, "Kubernetes API QPS limit (queries per second)")
+	fs.IntVar(&c.Burst, "burst", c.Burst, "Kubernetes API burst capacity")
+}
+
+func (c *NotebookPinDigestsCommand) Complete() error {
+	if err := c.SharedOptions.Complete(); err != nil {
+		return fmt.Errorf("completing shared options: %w", err)
+	}
+
+	var opts []registry.Option
+
+	if c.RegistryConfig != "" {
+		credentials, err := registry.LoadDockerConfig(c.RegistryConfig)
+		if err != nil {
+			return fmt.Errorf("loading registry credentials: %w", err)
+		}
+
+		opts = append(opts, registry.WithCredentials(credentials))
+	}
+
+	c.registryClient = registry.NewClient(opts...)
+
+	return nil
+}
+
+func (c *NotebookPinDigestsCommand) Validate() error {
+	if err := c.SharedOptions.Validate(); err != nil {
+		return fmt.Errorf("validating shared options: %w", err)
+	}
+
+	return nil
+}
+
+// Run writes a kubectl patch command per workbench to stdout and a summary to stderr.
+// Images already pinned to a digest are left alone; images whose digest cannot be resolved
+// are reported and make the command fail after the resolvable ones are printed.
+func (c *NotebookPinDigestsCommand) Run(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+
+	var opts []client.ListResourcesOption
+	if c.ConfigFlags.Namespace != nil && *c.ConfigFlags.Namespace != "" {
+		opts = append(opts, client.WithNamespace(*c.ConfigFlags.Namespace))
+	}
+
+	notebooks, err := c.Client.List(ctx, resources.Notebook, opts...)
+	if err != nil && !client.IsResourceTypeNotFound(err) {
+		return fmt.Errorf("listing Notebooks: %w", err)
+	}
+
+	custom, err := notebook.FindCustomImages(ctx, c.Client, notebooks)
+	if err != nil {
+		return fmt.Errorf("classifying workbench images: %w", err)
+	}
+
+	var pins []pinnedImage
+
+	digests := make(map[string]string)
+	alreadyPinned, failed := 0, 0
+
+	for _, img := range custom {
+		ref, err := registry.ParseReference(img.Image)
+		if err != nil {
+			c.IO.Errorf("%s/%s: container %s: %v", img.Namespace, img.Name, img.ContainerName, err)
+			failed++
+
+			continue
+		}
+
+		if ref.IsPinned() {
+			alreadyPinned++
+
+			continue
+		}
+
+		digest, ok := digests[ref.String()]
+		if !ok {
+			digest, err = c.registryClient.ResolveDigest(ctx, ref)
+			if err != nil {
+				c.IO.Errorf("%s/%s: container %s: resolving %s: %v",
+					img.Namespace, img.Name, img.ContainerName, img.Image, err)
+				failed++
+
+				continue
+			}
+
+			digests[ref.String()] = digest
+		}
+
+		pins = append(pins, pinnedImage{image: img, pinned: registry.PinImage(img.Image, digest)})
+	}
+
+	if err := c.writePatches(pins); err != nil {
+		return err
+	}
+
+	c.IO.Errorf("%d custom image(s) pinned, %d already pinned to a digest, %d not resolved",
+		len(pins), alreadyPinned, failed)
+
+	if failed > 0 {
+		return fmt.Errorf("%d custom workbench image(s) could not be pinned", failed)
+	}
+
+	return nil
+}
+
+// writePatches prints one kubectl patch command per notebook. Each replace is preceded by a test
+// of the current image, so the patch fails instead of pinning a workbench that changed since.
+func (c *NotebookPinDigestsCommand) writePatches(pins []pinnedImage) error {
+	var (
+		order   []string
+		byObj   = make(map[string][]pinnedImage)
+		patches = make(map[string][]jsonPatchOperation)
+	)
+
+	for _, pin := range pins {
+		key := pin.image.Namespace + "/" + pin.image.Name
+		if _, ok := byObj[key]; !ok {
+			order = append(order, key)
+		}
+
+		byObj[key] = append(byObj[key], pin)
+
+		path := "/spec/template/spec/containers/" + strconv.Itoa(pin.image.ContainerIndex) + "/image"
+		patches[key] = append(patches[key],
+			jsonPatchOperation{Op: "test", Path: path, Value: pin.image.Image},
+			jsonPatchOperation{Op: "replace", Path: path, Value: pin.pinned},
+		)
+	}
+
+	for _, key := range order {
+		objPins := byObj[key]
+		first := objPins[0].image
+
+		data, err := json.Marshal(patches[key])
+		if err != nil {
+			return fmt.Errorf("encoding patch for %s: %w", key, err)
+		}
+
+		for _, pin := range objPins {
+			c.IO.Fprintf("# %s container %s: %s -> %s", key, pin.image.ContainerName, pin.image.Image, pin.pinned)
+		}
+
+		c.IO.Fprintf("kubectl patch %s %s -n %s --type=json -p '%s'",
+			resources.Notebook.GVR().GroupResource(), first.Name, first.Namespace, data)
+	}
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 
+	"github.com/opendatahub-io/odh-cli/pkg/rules"
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
 	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
 )
@@ -20,6 +21,9 @@ const (
 	OutputFormatYAML  OutputFormat = "yaml"
 
 	DefaultTimeout = 10 * time.Minute
+
+	// DefaultSnapshotDir is where migrate run writes safety snapshots by default.
+	DefaultSnapshotDir = "backup-migrate-snapshots"
 )
 
 func (o OutputFormat) Validate() error {
@@ -70,6 +74,11 @@ func (o *SharedOptions) Complete() error {
 
 	o.Client = c
 
+	// Use compatibility data from an installed rules bundle, if any
+	if _, err := rules.ApplyInstalled(); err != nil {
+		o.IO.Errorf("Warning: ignoring installed rules bundle: %v", err)
+	}
+
 	return nil
 }
 
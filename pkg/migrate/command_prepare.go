@@ -14,7 +14,6 @@ import (
 
 	"github.com/opendatahub-io/odh-cli/pkg/cmd"
 	"github.com/opendatahub-io/odh-cli/pkg/migrate/action"
-	"github.com/opendatahub-io/odh-cli/pkg/migrate/actions/kueue/rhbok"
 	"github.com/opendatahub-io/odh-cli/pkg/util/version"
 )
 
@@ -38,10 +37,7 @@ type PrepareCommand struct {
 
 func NewPrepareCommand(streams genericiooptions.IOStreams) *PrepareCommand {
 	shared := NewSharedOptions(streams)
-	registry := action.NewActionRegistry()
-
-	// Explicitly register all actions (no global state, full test isolation)
-	registry.MustRegister(&rhbok.RHBOKMigrationAction{})
+	registry := NewRegistry()
 
 	return &PrepareCommand{
 		SharedOptions: shared,
@@ -164,7 +160,7 @@ func (c *PrepareCommand) Run(ctx context.Context) error {
 		if !actionResult.Status.Completed {
 			c.IO.Errorf("Preparation %s incomplete - please review the output above", migrationID)
 
-			return fmt.Errorf("preparation halted: %s", migrationID)
+			return cmd.NewExitError(cmd.ExitCodePartial, fmt.Errorf("preparation halted: %s", migrationID))
 		}
 		c.IO.Errorf("Preparation %s completed successfully!", migrationID)
 	}
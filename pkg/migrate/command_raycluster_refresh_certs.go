@@ -0,0 +1,463 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/ray"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/confirmation"
+)
+
+// dashboardRequestTimeout bounds each request to a Ray dashboard, so an unreachable dashboard
+// does not stall the planning of the refresh.
+const dashboardRequestTimeout = 10 * time.Second
+
+var _ cmd.Command = (*RayClusterRefreshCertsCommand)(nil)
+
+// RayClusterRefreshCertsCommand regenerates the oauth-proxy serving certificates of
+// RayClusters. Clusters upgraded in place can keep stale certificate Secrets, making their
+// dashboard route answer 502: each cluster's serving certificate Secrets are deleted, the
+// regenerated ones are awaited, and its head pods are restarted to load them. The Ray dashboard
+// of each cluster is asked for active jobs and alive actors, which the restart interrupts.
+type RayClusterRefreshCertsCommand struct {
+	*SharedOptions
+	RayClusterStateOptions
+
+	// Names restricts the refresh to these RayClusters.
+	Names []string
+
+	// MaxParallel is the number of RayClusters refreshed concurrently.
+	MaxParallel int
+
+	// SkipBusy skips the RayClusters with active jobs or alive actors, and those whose
+	// activity cannot be queried.
+	SkipBusy bool
+
+	// DashboardURL is the dashboard of the single --name RayCluster, e.g. a port-forward to its
+	// head service. The dashboard route of each cluster is used when empty.
+	DashboardURL string
+
+	DryRun bool
+	Yes    bool
+
+	httpClient *http.Client
+
+	// report collects the result of each RayCluster for --output json|yaml.
+	report *ray.MigrationReport
+}
+
+func NewRayClusterRefreshCertsCommand(streams genericiooptions.IOStreams) *RayClusterRefreshCertsCommand {
+	return &RayClusterRefreshCertsCommand{
+		SharedOptions: NewSharedOptions(streams),
+		MaxParallel:   1,
+		httpClient:    &http.Client{Timeout: dashboardRequestTimeout},
+	}
+}
+
+func (c *RayClusterRefreshCertsCommand) AddFlags(fs *pflag.FlagSet) {
+	fs.StringArrayVar(&c.Names, "name", nil, flagDescRefreshCertsName)
+	fs.IntVar(&c.MaxParallel, "max-parallel", c.MaxParallel, flagDescRefreshCertsMaxParallel)
+	fs.BoolVar(&c.SkipBusy, "skip-busy", false, flagDescRefreshCertsSkipBusy)
+	fs.StringVar(&c.DashboardURL, "dashboard-url", "", flagDescRefreshCertsDashboardURL)
+	fs.BoolVar(&c.DryRun, "dry-run", false, flagDescRefreshCertsDryRun)
+	fs.StringVarP((*string)(&c.OutputFormat), "output", "o", string(OutputFormatTable), flagDescRayClusterOutput)
+	c.RayClusterStateOptions.addFlags(fs)
+	fs.BoolVarP(&c.Yes, "yes", "y", false, flagDescRefreshCertsYes)
+	fs.DurationVar(&c.Timeout, "timeout", c.Timeout, flagDescRefreshCertsTimeout)
+
+	// Throttling settings
+	fs.Float32Var(&c.QPS, "qps", c.QPS, "Kubernetes API QPS limit (queries per second)")
+	fs.IntVar(&c.Burst, "burst", c.Burst, "Kubernetes API burst capacity")
+}
+
+func (c *RayClusterRefreshCertsCommand) Complete() error {
+	if err := c.SharedOptions.Complete(); err != nil {
+		return fmt.Errorf("completing shared options: %w", err)
+	}
+
+	return nil
+}
+
+func (c *RayClusterRefreshCertsCommand) Validate() error {
+	if err := c.SharedOptions.Validate(); err != nil {
+		return fmt.Errorf("validating shared options: %w", err)
+	}
+
+	if len(c.Names) > 0 && c.namespace() == "" {
+		return errors.New("--name requires --namespace")
+	}
+
+	if c.DashboardURL != "" && len(c.Names) != 1 {
+		return errors.New("--dashboard-url requires a single --name")
+	}
+
+	if err := c.RayClusterStateOptions.validate(); err != nil {
+		return err
+	}
+
+	if c.MaxParallel < 1 {
+		return fmt.Errorf("--max-parallel must be at least 1, got %d", c.MaxParallel)
+	}
+
+	return nil
+}
+
+// Run prints the refresh of each RayCluster and, unless in dry-run mode, applies them with up
+// to --max-parallel clusters at a time. A failed cluster is reported and the others refreshed.
+// When ctx is cancelled (e.g. on Ctrl+C), no further cluster is started but the clusters in
+// flight are refreshed to completion, within the timeout, so none is left without certificates.
+// With --output json|yaml, a MigrationReport of the run is written to stdout.
+func (c *RayClusterRefreshCertsCommand) Run(ctx context.Context) error {
+	c.report = ray.NewMigrationReport(ray.OperationRefreshCerts, c.DryRun)
+
+	if err := c.loadState(); err != nil {
+		return err
+	}
+
+	err := c.run(ctx)
+
+	return errors.Join(err, printMigrationReport(c.IO, c.OutputFormat, c.report))
+}
+
+func (c *RayClusterRefreshCertsCommand) run(ctx context.Context) error {
+	planCtx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+
+	refreshes, err := c.planRefreshes(planCtx)
+	if err != nil {
+		return err
+	}
+
+	if len(refreshes) == 0 {
+		c.IO.Errorf("No RayClusters with serving certificate Secrets found")
+
+		return nil
+	}
+
+	activities := c.queryActivities(planCtx, refreshes)
+
+	for i, r := range refreshes {
+		c.IO.Errorf("%s", r)
+		c.IO.Errorf("  Ray activity: %s", activities[i])
+	}
+
+	if c.SkipBusy {
+		refreshes, activities = c.skipBusy(refreshes, activities)
+
+		if len(refreshes) == 0 {
+			c.IO.Errorf("No idle RayClusters to refresh")
+
+			return nil
+		}
+	}
+
+	if c.DryRun {
+		for _, r := range refreshes {
+			c.report.Record(r.Cluster, ray.ClusterPlanned, 0, "", nil)
+		}
+
+		c.IO.Errorf("\nDry run: the certificates of %d RayCluster(s) would be regenerated, no changes applied", len(refreshes))
+
+		return nil
+	}
+
+	c.IO.Errorf("\n%s", interruptionWarning(refreshes, activities))
+
+	prompt := fmt.Sprintf("Delete the serving certificate Secrets and restart the head pods of %d RayCluster(s)?", len(refreshes))
+	if !c.Yes && !confirmation.Prompt(c.IO, prompt) {
+		c.IO.Errorf("Refresh cancelled")
+
+		for _, r := range refreshes {
+			c.report.Record(r.Cluster, ray.ClusterNotStarted, 0, "", nil)
+		}
+
+		return nil
+	}
+
+	summary := c.applyRefreshes(ctx, refreshes)
+
+	c.IO.Errorf("\nRefreshed %d of %d RayCluster(s): %d failed, %d not started",
+		summary.refreshed, len(refreshes), len(summary.failed), summary.notStarted)
+
+	for _, name := range summary.failed {
+		c.IO.Errorf("  failed: %s", name)
+	}
+
+	switch {
+	case summary.notStarted > 0:
+		return fmt.Errorf("refresh interrupted: %d of %d RayCluster(s) not started: %w",
+			summary.notStarted, len(refreshes), context.Cause(ctx))
+	case len(summary.failed) > 0:
+		return fmt.Errorf("refreshing certificates of %d of %d RayCluster(s) failed", len(summary.failed), len(refreshes))
+	}
+
+	return nil
+}
+
+// clusterActivity is the activity reported by the dashboard of a RayCluster, or the error
+// querying it.
+type clusterActivity struct {
+	activity *ray.Activity
+	err      error
+}
+
+func (a clusterActivity) String() string {
+	if a.err != nil {
+		return "unknown (" + a.err.Error() + ")"
+	}
+
+	return a.activity.String()
+}
+
+// busy reports whether a restart may interrupt work: clusters whose activity is unknown may be
+// running jobs.
+func (a clusterActivity) busy() bool {
+	return a.err != nil || a.activity.Busy()
+}
+
+// queryActivities asks the dashboard of each RayCluster for its activity, through --dashboard-url
+// or the dashboard route of the cluster.
+func (c *RayClusterRefreshCertsCommand) queryActivities(ctx context.Context, refreshes []*ray.CertRefresh) []clusterActivity {
+	activities := make([]clusterActivity, 0, len(refreshes))
+
+	for _, r := range refreshes {
+		baseURL := c.DashboardURL
+		if baseURL == "" {
+			baseURL = ray.DashboardRouteURL(ctx, c.Client, r.Cluster)
+		}
+
+		if baseURL == "" {
+			activities = append(activities, clusterActivity{err: errors.New("no dashboard route")})
+
+			continue
+		}
+
+		activity, err := ray.QueryActivity(ctx, c.httpClient, baseURL)
+		activities = append(activities, clusterActivity{activity: activity, err: err})
+	}
+
+	return activities
+}
+
+// skipBusy drops the busy RayClusters from the refreshes, reporting them as skipped.
+func (c *RayClusterRefreshCertsCommand) skipBusy(
+	refreshes []*ray.CertRefresh,
+	activities []clusterActivity,
+) ([]*ray.CertRefresh, []clusterActivity) {
+	var (
+		idle           []*ray.CertRefresh
+		idleActivities []clusterActivity
+	)
+
+	for i, r := range refreshes {
+		if activities[i].busy() {
+			c.IO.Errorf("RayCluster %s/%s: %s, skipping", r.Cluster.GetNamespace(), r.Cluster.GetName(), activities[i])
+			c.report.Record(r.Cluster, ray.ClusterSkipped, 0, "", nil)
+
+			continue
+		}
+
+		idle = append(idle, r)
+		idleActivities = append(idleActivities, activities[i])
+	}
+
+	return idle, idleActivities
+}
+
+// interruptionWarning describes the work the refreshes interrupt, per busy RayCluster.
+func interruptionWarning(refreshes []*ray.CertRefresh, activities []clusterActivity) string {
+	var busy []string
+
+	for i, r := range refreshes {
+		if activities[i].busy() {
+			busy = append(busy, fmt.Sprintf("%s/%s: %s", r.Cluster.GetNamespace(), r.Cluster.GetName(), activities[i]))
+		}
+	}
+
+	if len(busy) == 0 {
+		return "None of the RayClusters has active jobs or alive actors."
+	}
+
+	return fmt.Sprintf("Restarting the head pods interrupts the work running on %d RayCluster(s):\n  %s",
+		len(busy), strings.Join(busy, "\n  "))
+}
+
+// refreshSummary aggregates the outcome of the refreshes of a run.
+type refreshSummary struct {
+	refreshed  int
+	notStarted int
+
+	// failed are the namespaced names of the clusters whose refresh failed, sorted.
+	failed []string
+}
+
+// applyRefreshes applies the refreshes on a pool of --max-parallel workers. Progress lines
+// are prefixed with the cluster name and written one at a time, so concurrent refreshes do not
+// interleave within a line. Refreshes are started until ctx is done; started refreshes run
+// under a context detached from ctx's cancellation and bounded by the timeout.
+func (c *RayClusterRefreshCertsCommand) applyRefreshes(ctx context.Context, refreshes []*ray.CertRefresh) refreshSummary {
+	applyCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), c.Timeout)
+	defer cancel()
+
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		summary refreshSummary
+		started = make([]bool, len(refreshes))
+	)
+
+	logf := func(format string, args ...any) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		c.IO.Errorf(format, args...)
+	}
+
+	type job struct {
+		index   int
+		refresh *ray.CertRefresh
+	}
+
+	jobs := make(chan job)
+
+	for range min(c.MaxParallel, len(refreshes)) {
+		wg.Go(func() {
+			for j := range jobs {
+				// Jobs handed over as ctx was cancelled are not started.
+				if ctx.Err() != nil {
+					continue
+				}
+
+				mu.Lock()
+				started[j.index] = true
+				mu.Unlock()
+
+				begin := time.Now()
+				name := j.refresh.Cluster.GetNamespace() + "/" + j.refresh.Cluster.GetName()
+
+				logf("[%d/%d] Refreshing RayCluster %s", j.index+1, len(refreshes), name)
+
+				err := j.refresh.ApplyWithProgress(applyCtx, c.Client, func(format string, args ...any) {
+					logf("[%s] "+format, append([]any{name}, args...)...)
+				})
+
+				dashboardURL := ""
+				if err == nil {
+					dashboardURL = ray.DashboardRouteURL(applyCtx, c.Client, j.refresh.Cluster)
+				}
+
+				mu.Lock()
+				if err != nil {
+					c.IO.Errorf("Warning: RayCluster %s: %v", name, err)
+					summary.failed = append(summary.failed, name)
+				} else {
+					c.IO.Errorf("[%s] Refreshed", name)
+					summary.refreshed++
+				}
+
+				c.report.Record(j.refresh.Cluster, ray.ClusterSucceeded, time.Since(begin), dashboardURL, err)
+				c.recordState(c.IO, ray.OperationRefreshCerts, j.refresh.Cluster, ray.ClusterSucceeded, err)
+				mu.Unlock()
+			}
+		})
+	}
+
+dispatch:
+	for i, r := range refreshes {
+		select {
+		case <-ctx.Done():
+			break dispatch
+		case jobs <- job{index: i, refresh: r}:
+		}
+	}
+
+	close(jobs)
+	wg.Wait()
+
+	for i, r := range refreshes {
+		if !started[i] {
+			summary.notStarted++
+			c.report.Record(r.Cluster, ray.ClusterNotStarted, 0, "", nil)
+		}
+	}
+
+	if summary.notStarted > 0 {
+		c.IO.Errorf("Interrupted: waited for the RayClusters in progress, %d not started", summary.notStarted)
+	}
+	slices.Sort(summary.failed)
+
+	return summary
+}
+
+// planRefreshes returns the refresh of each RayCluster in the --namespace scope, restricted to
+// --name when set. Clusters without serving certificate Secrets are skipped; named RayClusters
+// that are missing are errors.
+func (c *RayClusterRefreshCertsCommand) planRefreshes(ctx context.Context) ([]*ray.CertRefresh, error) {
+	var opts []client.ListResourcesOption
+	if namespace := c.namespace(); namespace != "" {
+		opts = append(opts, client.WithNamespace(namespace))
+	}
+
+	clusters, err := c.Client.List(ctx, resources.RayCluster, opts...)
+	if err != nil {
+		if client.IsResourceTypeNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("listing RayClusters: %w", err)
+	}
+
+	var refreshes []*ray.CertRefresh
+
+	found := make(map[string]bool)
+
+	for _, cluster := range clusters {
+		if len(c.Names) > 0 && !slices.Contains(c.Names, cluster.GetName()) {
+			continue
+		}
+
+		found[cluster.GetName()] = true
+
+		if c.completed(ray.OperationRefreshCerts, cluster) {
+			c.IO.Errorf("RayCluster %s/%s: already refreshed according to the state file, skipping", cluster.GetNamespace(), cluster.GetName())
+			c.report.Record(cluster, ray.ClusterSkipped, 0, "", nil)
+
+			continue
+		}
+
+		r, err := ray.PlanCertRefresh(ctx, c.Client, cluster)
+		if err != nil {
+			return nil, err //nolint:wrapcheck // Already contextualized
+		}
+
+		if len(r.Secrets) == 0 {
+			c.IO.Errorf("RayCluster %s/%s: no serving certificate Secrets, skipping", cluster.GetNamespace(), cluster.GetName())
+			c.report.Record(cluster, ray.ClusterSkipped, 0, "", nil)
+
+			continue
+		}
+
+		refreshes = append(refreshes, r)
+	}
+
+	for _, name := range c.Names {
+		if !found[name] {
+			return nil, fmt.Errorf("RayCluster %s/%s not found", c.namespace(), name)
+		}
+	}
+
+	return refreshes, nil
+}
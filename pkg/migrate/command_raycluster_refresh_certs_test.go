@@ -0,0 +1,398 @@
+package migrate_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/ray"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+
+	. "github.com/onsi/gomega"
+)
+
+func rayObject(rt resources.ResourceType, name string, labels map[string]string, annotations map[string]string) *unstructured.Unstructured {
+	obj := rt.Unstructured()
+	obj.SetNamespace("project")
+	obj.SetName(name)
+	obj.SetLabels(labels)
+	obj.SetAnnotations(annotations)
+
+	return &obj
+}
+
+// rayClusterObjects returns a RayCluster with a serving certificate Secret and a head pod.
+func rayClusterObjects(name string) []runtime.Object {
+	return []runtime.Object{
+		rayObject(resources.RayCluster, name, nil, nil),
+		rayObject(resources.Service, name+"-head-svc", map[string]string{ray.LabelCluster: name},
+			map[string]string{ray.AnnotationServingCertSecret: name + "-proxy-tls"}),
+		rayObject(resources.Secret, name+"-proxy-tls", nil, nil),
+		rayObject(resources.Pod, name+"-head", map[string]string{ray.LabelCluster: name, ray.LabelNodeType: ray.NodeTypeHead}, nil),
+	}
+}
+
+func newRefreshCertsCommand(namespace string, objects ...runtime.Object) (*migrate.RayClusterRefreshCertsCommand, *dynamicfake.FakeDynamicClient, *bytes.Buffer) {
+	dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			resources.RayCluster.GVR(): resources.RayCluster.ListKind(),
+			resources.Service.GVR():    resources.Service.ListKind(),
+			resources.Secret.GVR():     resources.Secret.ListKind(),
+			resources.Pod.GVR():        resources.Pod.ListKind(),
+		}, objects...)
+
+	// The service CA operator regenerates deleted Secrets: keep them to simulate it
+	dynamic.PrependReactor("delete", "secrets", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, nil
+	})
+
+	var errOut bytes.Buffer
+
+	command := migrate.NewRayClusterRefreshCertsCommand(genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: &bytes.Buffer{}, ErrOut: &errOut})
+	command.Client = client.NewForTesting(client.TestClientConfig{Dynamic: dynamic})
+	command.ConfigFlags = genericclioptions.NewConfigFlags(false)
+	command.ConfigFlags.Namespace = &namespace
+
+	return command, dynamic, &errOut
+}
+
+// newRayDashboard serves the Ray Jobs and State APIs with the given jobs and alive actor count.
+func newRayDashboard(t *testing.T, jobs string, actors int) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/jobs/", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(jobs))
+	})
+	mux.HandleFunc("/api/v0/actors", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"result": true,
+			"data":   map[string]any{"result": map[string]any{"total": actors}},
+		})
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func deletedSecrets(dynamic *dynamicfake.FakeDynamicClient) []string {
+	var names []string
+
+	for _, a := range dynamic.Actions() {
+		if a.Matches("delete", "secrets") {
+			names = append(names, a.(k8stesting.DeleteAction).GetName())
+		}
+	}
+
+	return names
+}
+
+func TestRayClusterRefreshCertsCommand_Run(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	objects := append(rayClusterObjects("train"), rayClusterObjects("serve")...)
+	objects = append(objects, rayObject(resources.RayCluster, "plain", nil, nil))
+
+	command, dynamic, errOut := newRefreshCertsCommand("project", objects...)
+	command.Names = []string{"train", "plain"}
+	command.Yes = true
+
+	g.Expect(command.Validate()).To(Succeed())
+	g.Expect(command.Run(ctx)).To(Succeed())
+	g.Expect(errOut.String()).To(ContainSubstring("RayCluster project/plain: no serving certificate Secrets, skipping"))
+	g.Expect(errOut.String()).To(ContainSubstring("[1/1] Refreshing RayCluster project/train"))
+	g.Expect(deletedSecrets(dynamic)).To(Equal([]string{"train-proxy-tls"}))
+
+	pods := dynamic.Resource(resources.Pod.GVR()).Namespace("project")
+
+	_, err := pods.Get(ctx, "train-head", metav1.GetOptions{})
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+	_, err = pods.Get(ctx, "serve-head", metav1.GetOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+}
+
+func TestRayClusterRefreshCertsCommand_RunParallel(t *testing.T) {
+	g := NewWithT(t)
+
+	var objects []runtime.Object
+	for _, name := range []string{"alpha", "beta", "gamma"} {
+		objects = append(objects, rayClusterObjects(name)...)
+	}
+
+	command, dynamic, errOut := newRefreshCertsCommand("", objects...)
+	command.MaxParallel = 3
+	command.Yes = true
+
+	g.Expect(command.Validate()).To(Succeed())
+	g.Expect(command.Run(t.Context())).To(Succeed())
+	g.Expect(deletedSecrets(dynamic)).To(ConsistOf("alpha-proxy-tls", "beta-proxy-tls", "gamma-proxy-tls"))
+	g.Expect(errOut.String()).To(ContainSubstring("[project/beta] Deleting 1 serving certificate Secret(s)"))
+	g.Expect(errOut.String()).To(ContainSubstring("[project/beta] Restarting 1 head pod(s)"))
+	g.Expect(errOut.String()).To(ContainSubstring("[project/gamma] Refreshed"))
+	g.Expect(errOut.String()).To(ContainSubstring("Refreshed 3 of 3 RayCluster(s): 0 failed, 0 not started"))
+}
+
+func TestRayClusterRefreshCertsCommand_InterruptFinishesInFlight(t *testing.T) {
+	g := NewWithT(t)
+
+	var objects []runtime.Object
+	for _, name := range []string{"alpha", "beta", "gamma"} {
+		objects = append(objects, rayClusterObjects(name)...)
+	}
+
+	command, dynamic, errOut := newRefreshCertsCommand("", objects...)
+	command.Yes = true
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	// Interrupt while the first cluster is being refreshed.
+	dynamic.PrependReactor("delete", "secrets", func(k8stesting.Action) (bool, runtime.Object, error) {
+		cancel()
+
+		return false, nil, nil
+	})
+
+	err := command.Run(ctx)
+	g.Expect(err).To(MatchError(ContainSubstring("refresh interrupted: 2 of 3 RayCluster(s) not started")))
+	g.Expect(deletedSecrets(dynamic)).To(HaveLen(1))
+	g.Expect(errOut.String()).To(ContainSubstring("Refreshed 1 of 3 RayCluster(s): 0 failed, 2 not started"))
+
+	// The in-flight cluster was refreshed to completion.
+	pods, listErr := dynamic.Resource(resources.Pod.GVR()).Namespace("project").List(t.Context(), metav1.ListOptions{})
+	g.Expect(listErr).ToNot(HaveOccurred())
+	g.Expect(pods.Items).To(HaveLen(2))
+}
+
+func TestRayClusterRefreshCertsCommand_OutputJSON(t *testing.T) {
+	g := NewWithT(t)
+
+	objects := append(rayClusterObjects("train"), rayObject(resources.RayCluster, "plain", nil, nil))
+
+	command, _, _ := newRefreshCertsCommand("project", objects...)
+	command.OutputFormat = migrate.OutputFormatJSON
+	command.Yes = true
+
+	var out bytes.Buffer
+
+	command.IO = iostreams.NewIOStreams(&bytes.Buffer{}, &out, &bytes.Buffer{})
+
+	g.Expect(command.Validate()).To(Succeed())
+	g.Expect(command.Run(t.Context())).To(Succeed())
+
+	var report ray.MigrationReport
+
+	g.Expect(json.Unmarshal(out.Bytes(), &report)).To(Succeed())
+	g.Expect(report.Operation).To(Equal("refresh-certs"))
+	g.Expect(report.Summary).To(Equal(ray.ReportSummary{Total: 2, Succeeded: 1, Skipped: 1}))
+	g.Expect(report.Clusters).To(ConsistOf(
+		And(HaveField("Name", "train"), HaveField("Namespace", "project"),
+			HaveField("Status", ray.ClusterSucceeded), HaveField("Duration", Not(BeEmpty()))),
+		And(HaveField("Name", "plain"), HaveField("Status", ray.ClusterSkipped)),
+	))
+}
+
+func TestRayClusterRefreshCertsCommand_Resume(t *testing.T) {
+	g := NewWithT(t)
+
+	stateFile := filepath.Join(t.TempDir(), "state.json")
+
+	command, dynamic, _ := newRefreshCertsCommand("", rayClusterObjects("train")...)
+	command.StateFile = stateFile
+	command.Yes = true
+
+	g.Expect(command.Run(t.Context())).To(Succeed())
+	g.Expect(deletedSecrets(dynamic)).To(HaveLen(1))
+
+	// A resumed run skips the cluster the first run refreshed.
+	command, dynamic, errOut := newRefreshCertsCommand("", append(rayClusterObjects("train"), rayClusterObjects("serve")...)...)
+	command.StateFile = stateFile
+	command.Resume = true
+	command.Yes = true
+
+	g.Expect(command.Validate()).To(Succeed())
+	g.Expect(command.Run(t.Context())).To(Succeed())
+	g.Expect(errOut.String()).To(ContainSubstring("RayCluster project/train: already refreshed according to the state file, skipping"))
+	g.Expect(deletedSecrets(dynamic)).To(Equal([]string{"serve-proxy-tls"}))
+
+	state, err := ray.LoadState(stateFile)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(state.Entries).To(HaveLen(2))
+
+	command, _, _ = newRefreshCertsCommand("")
+	command.Resume = true
+
+	g.Expect(command.Validate()).To(MatchError(ContainSubstring("--resume requires --state-file")))
+}
+
+func TestRayClusterStatusCommand_Run(t *testing.T) {
+	g := NewWithT(t)
+
+	stateFile := filepath.Join(t.TempDir(), "state.json")
+
+	state, err := ray.LoadState(stateFile)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(state.Record(ray.OperationRollback, rayObject(resources.RayCluster, "train", nil, nil),
+		ray.ClusterSucceeded, errors.New("route not admitted"))).To(Succeed())
+
+	var out, errOut bytes.Buffer
+
+	command := migrate.NewRayClusterStatusCommand(genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: &out, ErrOut: &errOut})
+	command.StateFile = stateFile
+
+	g.Expect(command.Validate()).To(Succeed())
+	g.Expect(command.Run(t.Context())).To(Succeed())
+	g.Expect(out.String()).To(ContainSubstring("rollback"))
+	g.Expect(out.String()).To(ContainSubstring("route not admitted"))
+	g.Expect(errOut.String()).To(ContainSubstring("0 of 1 RayCluster(s) succeeded"))
+
+	command.StateFile = filepath.Join(t.TempDir(), "missing.json")
+	g.Expect(command.Run(t.Context())).To(MatchError(ContainSubstring("reading state file")))
+}
+
+func TestRayClusterRefreshCertsCommand_DryRun(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	command, dynamic, errOut := newRefreshCertsCommand("", rayClusterObjects("train")...)
+	command.DryRun = true
+
+	g.Expect(command.Run(ctx)).To(Succeed())
+	g.Expect(errOut.String()).To(ContainSubstring(
+		"RayCluster project/train: delete 1 serving certificate Secret(s) [train-proxy-tls], restart 1 head pod(s) [train-head]"))
+	g.Expect(errOut.String()).To(ContainSubstring("Dry run: the certificates of 1 RayCluster(s) would be regenerated"))
+	g.Expect(deletedSecrets(dynamic)).To(BeEmpty())
+
+	_, err := dynamic.Resource(resources.Pod.GVR()).Namespace("project").Get(ctx, "train-head", metav1.GetOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+}
+
+func TestRayClusterRefreshCertsCommand_Validate(t *testing.T) {
+	g := NewWithT(t)
+
+	command, _, _ := newRefreshCertsCommand("")
+	command.Names = []string{"train"}
+
+	g.Expect(command.Validate()).To(MatchError(ContainSubstring("--name requires --namespace")))
+
+	command, _, _ = newRefreshCertsCommand("")
+	command.MaxParallel = 0
+
+	g.Expect(command.Validate()).To(MatchError(ContainSubstring("--max-parallel must be at least 1")))
+
+	command, _, _ = newRefreshCertsCommand("project")
+	command.DashboardURL = "http://localhost:8265"
+
+	g.Expect(command.Validate()).To(MatchError(ContainSubstring("--dashboard-url requires a single --name")))
+
+	command, _, _ = newRefreshCertsCommand("project", rayClusterObjects("train")...)
+	command.Names = []string{"missing"}
+
+	g.Expect(command.Run(t.Context())).To(MatchError(ContainSubstring("RayCluster project/missing not found")))
+}
+
+func TestRayClusterRefreshCertsCommand_PromptShowsActivity(t *testing.T) {
+	g := NewWithT(t)
+
+	server := newRayDashboard(t, `[{"submission_id":"train-job","status":"RUNNING"},{"submission_id":"old","status":"SUCCEEDED"}]`, 2)
+
+	command, dynamic, errOut := newRefreshCertsCommand("project", rayClusterObjects("train")...)
+	command.Names = []string{"train"}
+	command.DashboardURL = server.URL
+	command.IO = iostreams.NewIOStreams(strings.NewReader("n\n"), &bytes.Buffer{}, errOut)
+
+	g.Expect(command.Validate()).To(Succeed())
+	g.Expect(command.Run(t.Context())).To(Succeed())
+	g.Expect(errOut.String()).To(ContainSubstring("Ray activity: 1 active jobs (train-job), 2 alive actors"))
+	g.Expect(errOut.String()).To(ContainSubstring(
+		"Restarting the head pods interrupts the work running on 1 RayCluster(s):\n  project/train: 1 active jobs (train-job), 2 alive actors"))
+	g.Expect(errOut.String()).To(ContainSubstring("restart the head pods of 1 RayCluster(s)? [y/N]"))
+	g.Expect(errOut.String()).To(ContainSubstring("Refresh cancelled"))
+	g.Expect(errOut.String()).ToNot(ContainSubstring("Running Ray jobs are interrupted"))
+	g.Expect(deletedSecrets(dynamic)).To(BeEmpty())
+}
+
+func TestRayClusterRefreshCertsCommand_SkipBusy(t *testing.T) {
+	tests := []struct {
+		name      string
+		jobs      string
+		actors    int
+		dashboard bool
+		refreshed bool
+		output    string
+	}{
+		{
+			name:      "busy cluster is skipped",
+			jobs:      `[{"submission_id":"train-job","status":"PENDING"}]`,
+			dashboard: true,
+			output:    "RayCluster project/train: 1 active jobs (train-job), 0 alive actors, skipping",
+		},
+		{
+			name:      "cluster with alive actors is skipped",
+			jobs:      `[]`,
+			actors:    1,
+			dashboard: true,
+			output:    "RayCluster project/train: 0 active jobs, 1 alive actors, skipping",
+		},
+		{
+			name:   "cluster with unknown activity is skipped",
+			output: "RayCluster project/train: unknown (no dashboard route), skipping",
+		},
+		{
+			name:      "idle cluster is refreshed",
+			jobs:      `[{"submission_id":"old","status":"SUCCEEDED"}]`,
+			dashboard: true,
+			refreshed: true,
+			output:    "None of the RayClusters has active jobs or alive actors.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			command, dynamic, errOut := newRefreshCertsCommand("project", rayClusterObjects("train")...)
+			command.Names = []string{"train"}
+			command.SkipBusy = true
+			command.Yes = true
+
+			if tt.dashboard {
+				command.DashboardURL = newRayDashboard(t, tt.jobs, tt.actors).URL
+			}
+
+			g.Expect(command.Validate()).To(Succeed())
+			g.Expect(command.Run(t.Context())).To(Succeed())
+			g.Expect(errOut.String()).To(ContainSubstring(tt.output))
+
+			if tt.refreshed {
+				g.Expect(deletedSecrets(dynamic)).To(Equal([]string{"train-proxy-tls"}))
+			} else {
+				g.Expect(errOut.String()).To(ContainSubstring("No idle RayClusters to refresh"))
+				g.Expect(deletedSecrets(dynamic)).To(BeEmpty())
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package migrate
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/ray"
+	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+)
+
+// printMigrationReport writes a RayCluster migration report to stdout as JSON or YAML. The
+// table format writes nothing: its results are the progress lines already logged to stderr.
+func printMigrationReport(io iostreams.Interface, format OutputFormat, report *ray.MigrationReport) error {
+	switch format {
+	case OutputFormatTable:
+		return nil
+	case OutputFormatJSON:
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return fmt.Errorf("marshaling JSON: %w", err)
+		}
+
+		io.Fprintf("%s\n", string(data))
+	case OutputFormatYAML:
+		data, err := yaml.Marshal(report)
+		if err != nil {
+			return fmt.Errorf("marshaling YAML: %w", err)
+		}
+
+		io.Fprintf("%s", string(data))
+	default:
+		return fmt.Errorf("unsupported output format: %s", format)
+	}
+
+	return nil
+}
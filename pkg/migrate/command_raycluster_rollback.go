@@ -0,0 +1,249 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/backup"
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/ray"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/confirmation"
+)
+
+var _ cmd.Command = (*RayClusterRollbackCommand)(nil)
+
+// RayClusterRollbackCommand restores RayClusters from the backup taken before the upgrade:
+// each migrated cluster is deleted and re-created from the backup with the CodeFlare
+// ServiceAccounts it is missing, and its dashboard Route is verified.
+type RayClusterRollbackCommand struct {
+	*SharedOptions
+	RayClusterStateOptions
+
+	// BackupDir is the backup directory; its rhoai-2.x subdirectory is read when present.
+	BackupDir string
+
+	// Names restricts the rollback to these RayClusters.
+	Names []string
+
+	DryRun bool
+	Yes    bool
+
+	// report collects the result of each RayCluster for --output json|yaml.
+	report *ray.MigrationReport
+}
+
+func NewRayClusterRollbackCommand(streams genericiooptions.IOStreams) *RayClusterRollbackCommand {
+	return &RayClusterRollbackCommand{
+		SharedOptions: NewSharedOptions(streams),
+	}
+}
+
+func (c *RayClusterRollbackCommand) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&c.BackupDir, "backup-dir", "", flagDescRollbackBackupDir)
+	fs.StringArrayVar(&c.Names, "name", nil, flagDescRollbackName)
+	fs.BoolVar(&c.DryRun, "dry-run", false, flagDescRollbackDryRun)
+	fs.StringVarP((*string)(&c.OutputFormat), "output", "o", string(OutputFormatTable), flagDescRayClusterOutput)
+	c.RayClusterStateOptions.addFlags(fs)
+	fs.BoolVarP(&c.Yes, "yes", "y", false, flagDescRollbackYes)
+	fs.DurationVar(&c.Timeout, "timeout", c.Timeout, flagDescRollbackTimeout)
+
+	// Throttling settings
+	fs.Float32Var(&c.QPS, "qps", c.QPS, "Kubernetes API QPS limit (queries per second)")
+	fs.IntVar(&c.Burst, "burst", c.Burst, "Kubernetes API burst capacity")
+}
+
+func (c *RayClusterRollbackCommand) Complete() error {
+	if err := c.SharedOptions.Complete(); err != nil {
+		return fmt.Errorf("completing shared options: %w", err)
+	}
+
+	return nil
+}
+
+func (c *RayClusterRollbackCommand) Validate() error {
+	if err := c.SharedOptions.Validate(); err != nil {
+		return fmt.Errorf("validating shared options: %w", err)
+	}
+
+	if c.BackupDir == "" {
+		return errors.New("--backup-dir is required")
+	}
+
+	if len(c.Names) > 0 && c.namespace() == "" {
+		return errors.New("--name requires --namespace")
+	}
+
+	if err := c.RayClusterStateOptions.validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run prints the rollback of each backed up RayCluster and, unless in dry-run mode, applies
+// them one cluster at a time. A failed cluster is reported and the next one rolled back.
+// With --output json|yaml, a MigrationReport of the run is written to stdout.
+func (c *RayClusterRollbackCommand) Run(ctx context.Context) error {
+	c.report = ray.NewMigrationReport(ray.OperationRollback, c.DryRun)
+
+	if err := c.loadState(); err != nil {
+		return err
+	}
+
+	err := c.run(ctx)
+
+	return errors.Join(err, printMigrationReport(c.IO, c.OutputFormat, c.report))
+}
+
+func (c *RayClusterRollbackCommand) run(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+
+	rollbacks, err := c.planRollbacks(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(rollbacks) == 0 {
+		if c.report.Summary.Skipped > 0 {
+			c.IO.Errorf("All RayClusters in backup %s are already restored", c.backupDir())
+		} else {
+			c.IO.Errorf("No RayClusters found in backup %s", c.backupDir())
+		}
+
+		return nil
+	}
+
+	for _, r := range rollbacks {
+		c.IO.Errorf("%s", r)
+	}
+
+	if c.DryRun {
+		for _, r := range rollbacks {
+			c.report.Record(r.Saved, ray.ClusterPlanned, 0, "", nil)
+		}
+
+		c.IO.Errorf("\nDry run: %d RayCluster(s) would be restored from the backup, no changes applied", len(rollbacks))
+
+		return nil
+	}
+
+	prompt := fmt.Sprintf("\nDelete and re-create %d RayCluster(s) from the backup? Running Ray jobs are interrupted.",
+		len(rollbacks))
+	if !c.Yes && !confirmation.Prompt(c.IO, prompt) {
+		c.IO.Errorf("Rollback cancelled")
+
+		for _, r := range rollbacks {
+			c.report.Record(r.Saved, ray.ClusterNotStarted, 0, "", nil)
+		}
+
+		return nil
+	}
+
+	failed := 0
+
+	for i, r := range rollbacks {
+		name := r.Saved.GetNamespace() + "/" + r.Saved.GetName()
+
+		c.IO.Errorf("[%d/%d] Rolling back RayCluster %s", i+1, len(rollbacks), name)
+
+		begin := time.Now()
+
+		err := r.Apply(ctx, c.Client, func(format string, args ...any) {
+			c.IO.Errorf("[%s] "+format, append([]any{name}, args...)...)
+		})
+		if err != nil {
+			c.IO.Errorf("Warning: RayCluster %s: %v", name, err)
+			failed++
+		}
+
+		dashboardURL := ""
+		if err == nil {
+			dashboardURL = ray.DashboardRouteURL(ctx, c.Client, r.Saved)
+		}
+
+		c.report.Record(r.Saved, ray.ClusterSucceeded, time.Since(begin), dashboardURL, err)
+		c.recordState(c.IO, ray.OperationRollback, r.Saved, ray.ClusterSucceeded, err)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("rolling back %d of %d RayCluster(s) failed", failed, len(rollbacks))
+	}
+
+	return nil
+}
+
+// backupDir returns the directory the RayClusters are read from: the rhoai-2.x subdirectory
+// of --backup-dir when present, --backup-dir itself otherwise.
+func (c *RayClusterRollbackCommand) backupDir() string {
+	subdir := filepath.Join(c.BackupDir, ray.BackupSubdir)
+	if info, err := os.Stat(subdir); err == nil && info.IsDir() {
+		return subdir
+	}
+
+	return c.BackupDir
+}
+
+// planRollbacks returns the rollback of each backed up RayCluster in the --namespace scope,
+// restricted to --name when set. Named RayClusters missing from the backup are errors.
+func (c *RayClusterRollbackCommand) planRollbacks(ctx context.Context) ([]*ray.Rollback, error) {
+	reader, err := backup.NewReader(c.backupDir())
+	if err != nil {
+		return nil, fmt.Errorf("loading backup: %w", err)
+	}
+
+	var opts []client.ListResourcesOption
+	if namespace := c.namespace(); namespace != "" {
+		opts = append(opts, client.WithNamespace(namespace))
+	}
+
+	clusters, err := reader.List(ctx, resources.RayCluster, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("listing backed up RayClusters: %w", err)
+	}
+
+	var rollbacks []*ray.Rollback
+
+	found := make(map[string]bool)
+
+	for _, cluster := range clusters {
+		if len(c.Names) > 0 && !slices.Contains(c.Names, cluster.GetName()) {
+			continue
+		}
+
+		found[cluster.GetName()] = true
+
+		if c.completed(ray.OperationRollback, cluster) {
+			c.IO.Errorf("RayCluster %s/%s: already restored according to the state file, skipping", cluster.GetNamespace(), cluster.GetName())
+			c.report.Record(cluster, ray.ClusterSkipped, 0, "", nil)
+
+			continue
+		}
+
+		r, err := ray.PlanRollback(ctx, c.Client, reader, cluster)
+		if err != nil {
+			return nil, err //nolint:wrapcheck // Already contextualized
+		}
+
+		rollbacks = append(rollbacks, r)
+	}
+
+	for _, name := range c.Names {
+		if !found[name] {
+			return nil, fmt.Errorf("RayCluster %s/%s not found in backup %s", c.namespace(), name, c.backupDir())
+		}
+	}
+
+	return rollbacks, nil
+}
@@ -0,0 +1,189 @@
+package migrate_test
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"sigs.k8s.io/yaml"
+
+	"github.com/opendatahub-io/odh-cli/pkg/backup"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/ray"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+
+	. "github.com/onsi/gomega"
+)
+
+// admittedRoute returns the dashboard Route of a RayCluster, admitted by a router.
+func admittedRoute(cluster string) *unstructured.Unstructured {
+	route := rayObject(resources.Route, ray.DashboardRouteName(cluster), nil, nil)
+	_ = unstructured.SetNestedSlice(route.Object, []any{
+		map[string]any{"conditions": []any{map[string]any{"type": "Admitted", "status": "True"}}},
+	}, "status", "ingress")
+
+	return route
+}
+
+// writeRayBackup writes a backed up RayCluster with its oauth-proxy ServiceAccount to the
+// rhoai-2.x subdirectory of a new backup directory.
+func writeRayBackup(t *testing.T, names ...string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	subdir := filepath.Join(dir, ray.BackupSubdir)
+
+	for _, name := range names {
+		cluster := rayObject(resources.RayCluster, name, nil, nil)
+		cluster.SetResourceVersion("42")
+		cluster.SetUID("old-uid")
+
+		sa := rayObject(resources.ServiceAccount, name+"-oauth-proxy", nil, nil)
+		sa.SetOwnerReferences([]metav1.OwnerReference{{
+			APIVersion: resources.RayCluster.APIVersion(),
+			Kind:       resources.RayCluster.Kind,
+			Name:       name,
+			UID:        "old-uid",
+		}})
+
+		if err := backup.WriteResourceToFile(subdir, resources.RayCluster.GVR(), cluster); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := backup.WriteResourceToFile(subdir, resources.ServiceAccount.GVR(), sa); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
+
+func newRollbackCommand(namespace string, backupDir string, objects ...runtime.Object) (*migrate.RayClusterRollbackCommand, *dynamicfake.FakeDynamicClient, *bytes.Buffer) {
+	dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			resources.RayCluster.GVR():     resources.RayCluster.ListKind(),
+			resources.ServiceAccount.GVR(): resources.ServiceAccount.ListKind(),
+			resources.Route.GVR():          resources.Route.ListKind(),
+		}, objects...)
+
+	var errOut bytes.Buffer
+
+	command := migrate.NewRayClusterRollbackCommand(genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: &bytes.Buffer{}, ErrOut: &errOut})
+	command.Client = client.NewForTesting(client.TestClientConfig{Dynamic: dynamic})
+	command.ConfigFlags = genericclioptions.NewConfigFlags(false)
+	command.ConfigFlags.Namespace = &namespace
+	command.BackupDir = backupDir
+
+	return command, dynamic, &errOut
+}
+
+func TestRayClusterRollbackCommand_Run(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	migrated := rayObject(resources.RayCluster, "train", map[string]string{"migrated": "true"}, nil)
+
+	command, dynamic, errOut := newRollbackCommand("project", writeRayBackup(t, "train", "serve"),
+		migrated, admittedRoute("train"))
+	command.Names = []string{"train"}
+	command.Yes = true
+
+	g.Expect(command.Validate()).To(Succeed())
+	g.Expect(command.Run(ctx)).To(Succeed())
+	g.Expect(errOut.String()).To(ContainSubstring("[1/1] Rolling back RayCluster project/train"))
+	g.Expect(errOut.String()).To(ContainSubstring("[project/train] Deleting the migrated RayCluster"))
+	g.Expect(errOut.String()).To(ContainSubstring("[project/train] Re-creating 1 ServiceAccount(s)"))
+	g.Expect(errOut.String()).To(ContainSubstring("[project/train] Verifying the dashboard Route ray-dashboard-train"))
+
+	// The RayCluster is re-created from the backup without server-populated fields.
+	cluster, err := dynamic.Resource(resources.RayCluster.GVR()).Namespace("project").Get(ctx, "train", metav1.GetOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(cluster.GetLabels()).ToNot(HaveKey("migrated"))
+	g.Expect(cluster.GetResourceVersion()).ToNot(Equal("42"))
+
+	// The ServiceAccount is owned by the re-created RayCluster.
+	sa, err := dynamic.Resource(resources.ServiceAccount.GVR()).Namespace("project").Get(ctx, "train-oauth-proxy", metav1.GetOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(sa.GetOwnerReferences()).To(HaveLen(1))
+	g.Expect(sa.GetOwnerReferences()[0].UID).To(Equal(cluster.GetUID()))
+	g.Expect(sa.GetOwnerReferences()[0].UID).ToNot(Equal(types.UID("old-uid")))
+
+	_, err = dynamic.Resource(resources.RayCluster.GVR()).Namespace("project").Get(ctx, "serve", metav1.GetOptions{})
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestRayClusterRollbackCommand_OutputYAML(t *testing.T) {
+	g := NewWithT(t)
+
+	route := admittedRoute("train")
+	_ = unstructured.SetNestedField(route.Object, "ray-dashboard-train.apps.example.com", "spec", "host")
+
+	command, _, _ := newRollbackCommand("", writeRayBackup(t, "train"), route)
+	command.OutputFormat = migrate.OutputFormatYAML
+	command.Yes = true
+
+	var out bytes.Buffer
+
+	command.IO = iostreams.NewIOStreams(&bytes.Buffer{}, &out, &bytes.Buffer{})
+
+	g.Expect(command.Run(t.Context())).To(Succeed())
+
+	var report ray.MigrationReport
+
+	g.Expect(yaml.Unmarshal(out.Bytes(), &report)).To(Succeed())
+	g.Expect(report.Operation).To(Equal("rollback"))
+	g.Expect(report.Summary).To(Equal(ray.ReportSummary{Total: 1, Succeeded: 1}))
+	g.Expect(report.Clusters).To(HaveLen(1))
+	g.Expect(report.Clusters[0].DashboardURL).To(Equal("https://ray-dashboard-train.apps.example.com"))
+	g.Expect(report.Clusters[0].Error).To(BeEmpty())
+}
+
+func TestRayClusterRollbackCommand_DryRun(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	existing := rayObject(resources.ServiceAccount, "serve-oauth-proxy", nil, nil)
+
+	command, dynamic, errOut := newRollbackCommand("", writeRayBackup(t, "train", "serve"),
+		rayObject(resources.RayCluster, "train", nil, nil), existing)
+	command.DryRun = true
+
+	g.Expect(command.Run(ctx)).To(Succeed())
+	g.Expect(errOut.String()).To(ContainSubstring(
+		"RayCluster project/train: delete and re-create from backup, re-create 1 ServiceAccount(s) [train-oauth-proxy], verify Route ray-dashboard-train"))
+	g.Expect(errOut.String()).To(ContainSubstring(
+		"RayCluster project/serve: create from backup, re-create 0 ServiceAccount(s) [], verify Route ray-dashboard-serve"))
+	g.Expect(errOut.String()).To(ContainSubstring("Dry run: 2 RayCluster(s) would be restored from the backup"))
+
+	for _, a := range dynamic.Actions() {
+		g.Expect(a.GetVerb()).To(BeElementOf("get", "list"))
+	}
+}
+
+func TestRayClusterRollbackCommand_Validate(t *testing.T) {
+	g := NewWithT(t)
+
+	command, _, _ := newRollbackCommand("", "")
+
+	g.Expect(command.Validate()).To(MatchError(ContainSubstring("--backup-dir is required")))
+
+	command, _, _ = newRollbackCommand("", t.TempDir())
+	command.Names = []string{"train"}
+
+	g.Expect(command.Validate()).To(MatchError(ContainSubstring("--name requires --namespace")))
+
+	command, _, _ = newRollbackCommand("project", writeRayBackup(t, "train"))
+	command.Names = []string{"missing"}
+
+	g.Expect(command.Run(t.Context())).To(MatchError(ContainSubstring("RayCluster project/missing not found in backup")))
+}
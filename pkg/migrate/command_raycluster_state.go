@@ -0,0 +1,79 @@
+package migrate
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/pflag"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/ray"
+	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+)
+
+// RayClusterStateOptions are the state file options of the RayCluster migration commands.
+// With a state file, the outcome of each cluster is recorded as soon as it completes, and
+// --resume skips the clusters a previous run already migrated.
+type RayClusterStateOptions struct {
+	// StateFile is the path of the state file; empty disables state recording.
+	StateFile string
+
+	// Resume skips the RayClusters recorded as succeeded in the state file.
+	Resume bool
+
+	state *ray.State
+}
+
+func (o *RayClusterStateOptions) addFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.StateFile, "state-file", "", flagDescRayClusterStateFile)
+	fs.BoolVar(&o.Resume, "resume", false, flagDescRayClusterResume)
+}
+
+func (o *RayClusterStateOptions) validate() error {
+	if o.Resume && o.StateFile == "" {
+		return errors.New("--resume requires --state-file")
+	}
+
+	return nil
+}
+
+// loadState reads the state file, if any.
+func (o *RayClusterStateOptions) loadState() error {
+	if o.StateFile == "" {
+		return nil
+	}
+
+	state, err := ray.LoadState(o.StateFile)
+	if err != nil {
+		return fmt.Errorf("loading state: %w", err)
+	}
+
+	o.state = state
+
+	return nil
+}
+
+// completed reports whether the cluster is skipped on --resume: a previous run recorded
+// operation on it as succeeded.
+func (o *RayClusterStateOptions) completed(operation string, cluster *unstructured.Unstructured) bool {
+	return o.Resume && o.state != nil && o.state.Completed(operation, cluster)
+}
+
+// recordState records the outcome of a cluster in the state file, if any. A state file that
+// cannot be written is reported without failing the cluster, which was migrated already.
+func (o *RayClusterStateOptions) recordState(
+	io iostreams.Interface,
+	operation string,
+	cluster *unstructured.Unstructured,
+	status ray.ClusterStatus,
+	err error,
+) {
+	if o.state == nil {
+		return
+	}
+
+	if serr := o.state.Record(operation, cluster, status, err); serr != nil {
+		io.Errorf("Warning: recording RayCluster %s/%s in the state file: %v", cluster.GetNamespace(), cluster.GetName(), serr)
+	}
+}
@@ -0,0 +1,144 @@
+package migrate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/yaml"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/ray"
+	"github.com/opendatahub-io/odh-cli/pkg/printer/table"
+)
+
+var _ cmd.Command = (*RayClusterStatusCommand)(nil)
+
+type stateRow struct {
+	Operation string
+	Namespace string
+	Name      string
+	Status    string
+	Updated   string
+	Error     string
+}
+
+// RayClusterStatusCommand prints the state file of the RayCluster migration commands. It
+// reads the file only and does not connect to the cluster.
+type RayClusterStatusCommand struct {
+	*SharedOptions
+
+	// StateFile is the state file written by refresh-certs or rollback with --state-file.
+	StateFile string
+}
+
+func NewRayClusterStatusCommand(streams genericiooptions.IOStreams) *RayClusterStatusCommand {
+	return &RayClusterStatusCommand{
+		SharedOptions: NewSharedOptions(streams),
+	}
+}
+
+func (c *RayClusterStatusCommand) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&c.StateFile, "state-file", "", flagDescRayClusterStatusStateFile)
+	fs.StringVarP((*string)(&c.OutputFormat), "output", "o", string(OutputFormatTable), flagDescListOutput)
+}
+
+// Complete does nothing: the state file is read without cluster access.
+func (c *RayClusterStatusCommand) Complete() error {
+	return nil
+}
+
+func (c *RayClusterStatusCommand) Validate() error {
+	if err := c.OutputFormat.Validate(); err != nil {
+		return err
+	}
+
+	if c.StateFile == "" {
+		return errors.New("--state-file is required")
+	}
+
+	return nil
+}
+
+func (c *RayClusterStatusCommand) Run(_ context.Context) error {
+	if _, err := os.Stat(c.StateFile); err != nil {
+		return fmt.Errorf("reading state file: %w", err)
+	}
+
+	state, err := ray.LoadState(c.StateFile)
+	if err != nil {
+		return err //nolint:wrapcheck // Already contextualized
+	}
+
+	switch c.OutputFormat {
+	case OutputFormatTable:
+		return c.printTable(state)
+	case OutputFormatJSON:
+		data, err := json.MarshalIndent(state, "", "  ")
+		if err != nil {
+			return fmt.Errorf("marshaling JSON: %w", err)
+		}
+
+		c.IO.Fprintf("%s\n", string(data))
+	case OutputFormatYAML:
+		data, err := yaml.Marshal(state)
+		if err != nil {
+			return fmt.Errorf("marshaling YAML: %w", err)
+		}
+
+		c.IO.Fprintf("%s", string(data))
+	default:
+		return fmt.Errorf("unsupported output format: %s", c.OutputFormat)
+	}
+
+	return nil
+}
+
+func (c *RayClusterStatusCommand) printTable(state *ray.State) error {
+	if len(state.Entries) == 0 {
+		c.IO.Errorf("No RayClusters recorded in %s", c.StateFile)
+
+		return nil
+	}
+
+	renderer := table.NewRenderer(
+		table.WithWriter[stateRow](c.IO.Out()),
+		table.WithHeaders[stateRow]("OPERATION", "NAMESPACE", "NAME", "STATUS", "UPDATED", "ERROR"),
+		table.WithTableOptions[stateRow](table.DefaultTableOptions...),
+	)
+
+	succeeded := 0
+
+	for _, entry := range state.Entries {
+		if entry.Status == ray.ClusterSucceeded {
+			succeeded++
+		}
+
+		row := stateRow{
+			Operation: entry.Operation,
+			Namespace: entry.Namespace,
+			Name:      entry.Name,
+			Status:    string(entry.Status),
+			Updated:   entry.UpdatedAt.Local().Format(time.RFC3339),
+			Error:     entry.Error,
+		}
+
+		if err := renderer.Append(row); err != nil {
+			return fmt.Errorf("failed to append row: %w", err)
+		}
+	}
+
+	if err := renderer.Render(); err != nil {
+		return fmt.Errorf("failed to render table: %w", err)
+	}
+
+	c.IO.Errorf("\n%d of %d RayCluster(s) succeeded", succeeded, len(state.Entries))
+
+	return nil
+}
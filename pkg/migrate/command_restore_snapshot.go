@@ -0,0 +1,212 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/pflag"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/confirmation"
+	"github.com/opendatahub-io/odh-cli/pkg/util/manifest"
+)
+
+var _ cmd.Command = (*RestoreSnapshotCommand)(nil)
+
+// RestoreSnapshotCommand reapplies the resources of a safety snapshot taken by migrate run.
+type RestoreSnapshotCommand struct {
+	*SharedOptions
+
+	// Dir is the snapshot directory printed by migrate run.
+	Dir string
+
+	DryRun bool
+	Yes    bool
+}
+
+func NewRestoreSnapshotCommand(streams genericiooptions.IOStreams) *RestoreSnapshotCommand {
+	return &RestoreSnapshotCommand{
+		SharedOptions: NewSharedOptions(streams),
+	}
+}
+
+func (c *RestoreSnapshotCommand) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&c.DryRun, "dry-run", false, flagDescRestoreDryRun)
+	fs.BoolVarP(&c.Yes, "yes", "y", false, flagDescRestoreYes)
+	fs.DurationVar(&c.Timeout, "timeout", c.Timeout, flagDescRestoreTimeout)
+}
+
+func (c *RestoreSnapshotCommand) Complete() error {
+	if err := c.SharedOptions.Complete(); err != nil {
+		return fmt.Errorf("completing shared options: %w", err)
+	}
+
+	return nil
+}
+
+func (c *RestoreSnapshotCommand) Validate() error {
+	if err := c.SharedOptions.Validate(); err != nil {
+		return fmt.Errorf("validating shared options: %w", err)
+	}
+
+	if c.Dir == "" {
+		return errors.New("snapshot directory is required")
+	}
+
+	info, err := os.Stat(c.Dir)
+	if err != nil {
+		return fmt.Errorf("reading snapshot directory: %w", err)
+	}
+
+	if !info.IsDir() {
+		return fmt.Errorf("%s is not a directory", c.Dir)
+	}
+
+	return nil
+}
+
+func (c *RestoreSnapshotCommand) Run(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+
+	objects, err := LoadSnapshot(c.Dir)
+	if err != nil {
+		return err
+	}
+
+	if len(objects) == 0 {
+		return fmt.Errorf("no resources found in snapshot %s", c.Dir)
+	}
+
+	c.IO.Errorf("Snapshot %s contains %d resources:", c.Dir, len(objects))
+
+	for _, obj := range objects {
+		c.IO.Errorf("  %s", describeObject(obj))
+	}
+
+	if c.DryRun {
+		c.IO.Errorf("\nDry run: no changes applied")
+
+		return nil
+	}
+
+	if !c.Yes && !confirmation.Prompt(c.IO, fmt.Sprintf("\nRestore %d resources from the snapshot?", len(objects))) {
+		c.IO.Errorf("Restore cancelled")
+
+		return nil
+	}
+
+	for _, obj := range objects {
+		if err := c.restore(ctx, obj); err != nil {
+			return fmt.Errorf("restoring %s: %w", describeObject(obj), err)
+		}
+
+		c.IO.Errorf("Restored %s", describeObject(obj))
+	}
+
+	return nil
+}
+
+// restore updates a live object to the snapshot content, or re-creates it when it was deleted.
+func (c *RestoreSnapshotCommand) restore(ctx context.Context, saved *unstructured.Unstructured) error {
+	gvk := saved.GroupVersionKind()
+
+	mapping, err := c.Client.RESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return fmt.Errorf("resolving resource type: %w", err)
+	}
+
+	var opts []client.GetOption
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		opts = append(opts, client.InNamespace(saved.GetNamespace()))
+	}
+
+	_, err = client.UpdateWithConflictRetry(ctx, c.Client, mapping.Resource, saved.GetName(), restoreFrom(saved), opts...)
+	if !apierrors.IsNotFound(err) {
+		return err //nolint:wrapcheck // Already contextualized by UpdateWithConflictRetry
+	}
+
+	// The object was deleted after the snapshot; re-create it without server-populated fields
+	obj := saved.DeepCopy()
+	unstructured.RemoveNestedField(obj.Object, "status")
+	obj.SetResourceVersion("")
+	obj.SetUID("")
+	obj.SetCreationTimestamp(metav1.Time{})
+	obj.SetGeneration(0)
+	obj.SetManagedFields(nil)
+
+	resource := c.Client.Dynamic().Resource(mapping.Resource)
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		_, err = resource.Namespace(obj.GetNamespace()).Create(ctx, obj, metav1.CreateOptions{})
+	} else {
+		_, err = resource.Create(ctx, obj, metav1.CreateOptions{})
+	}
+
+	if err != nil {
+		return fmt.Errorf("creating resource: %w", err)
+	}
+
+	return nil
+}
+
+// restoreFrom returns a mutation that replaces the labels, annotations and content of a live object
+// (everything but metadata and status) with those of the saved object.
+func restoreFrom(saved *unstructured.Unstructured) client.MutateFunc {
+	return func(latest *unstructured.Unstructured) error {
+		for key := range latest.Object {
+			if !isServerOwnedField(key) {
+				delete(latest.Object, key)
+			}
+		}
+
+		for key, value := range saved.Object {
+			if !isServerOwnedField(key) {
+				latest.Object[key] = runtime.DeepCopyJSONValue(value)
+			}
+		}
+
+		latest.SetLabels(saved.GetLabels())
+		latest.SetAnnotations(saved.GetAnnotations())
+
+		return nil
+	}
+}
+
+// isServerOwnedField reports whether a top-level field is kept from the live object on restore.
+func isServerOwnedField(key string) bool {
+	switch key {
+	case "apiVersion", "kind", "metadata", "status":
+		return true
+	default:
+		return false
+	}
+}
+
+// LoadSnapshot reads the resources of a snapshot directory, in file path order.
+func LoadSnapshot(dir string) ([]*unstructured.Unstructured, error) {
+	docs, err := manifest.Load(nil, dir)
+	if err != nil {
+		return nil, fmt.Errorf("loading snapshot %s: %w", dir, err)
+	}
+
+	return manifest.Objects(docs), nil
+}
+
+// describeObject returns "Kind namespace/name", or "Kind name" for cluster-scoped resources.
+func describeObject(obj *unstructured.Unstructured) string {
+	if obj.GetNamespace() == "" {
+		return obj.GetKind() + " " + obj.GetName()
+	}
+
+	return fmt.Sprintf("%s %s/%s", obj.GetKind(), obj.GetNamespace(), obj.GetName())
+}
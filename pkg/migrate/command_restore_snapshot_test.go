@@ -0,0 +1,106 @@
+package migrate_test
+
+import (
+	"bytes"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/opendatahub-io/odh-cli/pkg/backup"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+func snapshotConfigMap(name string, value string) *unstructured.Unstructured {
+	obj := resources.ConfigMap.Unstructured()
+	obj.SetNamespace("apps")
+	obj.SetName(name)
+	obj.SetResourceVersion("42")
+	obj.SetLabels(map[string]string{"app": "kueue"})
+	_ = unstructured.SetNestedField(obj.Object, value, "data", "key")
+
+	return &obj
+}
+
+func TestRestoreSnapshotCommand_Run(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	dir := t.TempDir()
+	g.Expect(backup.WriteResourcesToDir(dir, resources.ConfigMap.GVR(), []*unstructured.Unstructured{
+		snapshotConfigMap("changed", "original"),
+		snapshotConfigMap("deleted", "original"),
+	})).To(Succeed())
+
+	// The migration changed one ConfigMap and deleted the other
+	live := snapshotConfigMap("changed", "migrated")
+	live.SetLabels(map[string]string{"migrated": "true"})
+
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(resources.ConfigMap.GVK(), meta.RESTScopeNamespace)
+
+	dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{resources.ConfigMap.GVR(): resources.ConfigMap.ListKind()}, live)
+
+	var errOut bytes.Buffer
+
+	command := migrate.NewRestoreSnapshotCommand(genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: &bytes.Buffer{}, ErrOut: &errOut})
+	command.Client = client.NewForTesting(client.TestClientConfig{Dynamic: dynamic, RESTMapper: mapper})
+	command.Dir = dir
+	command.Yes = true
+
+	g.Expect(command.Validate()).To(Succeed())
+	g.Expect(command.Run(ctx)).To(Succeed())
+	g.Expect(errOut.String()).To(ContainSubstring("Restored ConfigMap apps/changed"))
+	g.Expect(errOut.String()).To(ContainSubstring("Restored ConfigMap apps/deleted"))
+
+	configMaps := dynamic.Resource(resources.ConfigMap.GVR()).Namespace("apps")
+
+	for _, name := range []string{"changed", "deleted"} {
+		restored, err := configMaps.Get(ctx, name, metav1.GetOptions{})
+		g.Expect(err).ToNot(HaveOccurred())
+
+		value, _, _ := unstructured.NestedString(restored.Object, "data", "key")
+		g.Expect(value).To(Equal("original"))
+		g.Expect(restored.GetLabels()).To(Equal(map[string]string{"app": "kueue"}))
+	}
+}
+
+func TestRestoreSnapshotCommand_DryRun(t *testing.T) {
+	g := NewWithT(t)
+
+	dir := t.TempDir()
+	g.Expect(backup.WriteResourceToFile(dir, resources.ConfigMap.GVR(), snapshotConfigMap("cm", "original"))).To(Succeed())
+
+	dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{resources.ConfigMap.GVR(): resources.ConfigMap.ListKind()})
+
+	command := migrate.NewRestoreSnapshotCommand(genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: &bytes.Buffer{}, ErrOut: &bytes.Buffer{}})
+	command.Client = client.NewForTesting(client.TestClientConfig{Dynamic: dynamic})
+	command.Dir = dir
+	command.DryRun = true
+
+	g.Expect(command.Run(t.Context())).To(Succeed())
+
+	_, err := dynamic.Resource(resources.ConfigMap.GVR()).Namespace("apps").Get(t.Context(), "cm", metav1.GetOptions{})
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+}
+
+func TestRestoreSnapshotCommand_Validate(t *testing.T) {
+	g := NewWithT(t)
+
+	command := migrate.NewRestoreSnapshotCommand(genericiooptions.IOStreams{})
+	command.Dir = t.TempDir() + "/missing"
+
+	g.Expect(command.Validate()).To(MatchError(ContainSubstring("reading snapshot directory")))
+}
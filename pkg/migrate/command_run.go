@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/blang/semver/v4"
 	"github.com/spf13/pflag"
@@ -12,7 +14,6 @@ import (
 
 	"github.com/opendatahub-io/odh-cli/pkg/cmd"
 	"github.com/opendatahub-io/odh-cli/pkg/migrate/action"
-	"github.com/opendatahub-io/odh-cli/pkg/migrate/actions/kueue/rhbok"
 	"github.com/opendatahub-io/odh-cli/pkg/util/version"
 )
 
@@ -26,8 +27,29 @@ type RunCommand struct {
 	MigrationIDs  []string
 	TargetVersion string
 
+	// All selects every applicable migration instead of MigrationIDs.
+	All bool
+
+	// BackupDir is where a safety snapshot is written before each migration mutates the cluster.
+	BackupDir string
+
+	// PlanFile is where the plan of the migrations is written, in plan mode.
+	PlanFile string
+
+	// ApplyFile is the plan whose migrations are executed, in apply mode.
+	ApplyFile string
+
+	// Rollback reverts the migrations instead of running them, in rollback mode.
+	Rollback bool
+
+	// RestoreFrom is the backup written by 'migrate prepare' that a rollback restores from.
+	RestoreFrom string
+
 	parsedTargetVersion *semver.Version
 
+	// plan is the plan read from ApplyFile.
+	plan *action.Plan
+
 	// registry is the action registry for this command instance.
 	// Explicitly populated to avoid global state and enable test isolation.
 	registry *action.ActionRegistry
@@ -35,13 +57,11 @@ type RunCommand struct {
 
 func NewRunCommand(streams genericiooptions.IOStreams) *RunCommand {
 	shared := NewSharedOptions(streams)
-	registry := action.NewActionRegistry()
-
-	// Explicitly register all actions (no global state, full test isolation)
-	registry.MustRegister(&rhbok.RHBOKMigrationAction{})
+	registry := NewRegistry()
 
 	return &RunCommand{
 		SharedOptions: shared,
+		BackupDir:     DefaultSnapshotDir,
 		registry:      registry,
 	}
 }
@@ -52,7 +72,13 @@ func (c *RunCommand) AddFlags(fs *pflag.FlagSet) {
 	fs.BoolVar(&c.DryRun, "dry-run", false, flagDescRunDryRun)
 	fs.BoolVarP(&c.Yes, "yes", "y", false, flagDescRunYes)
 	fs.StringArrayVarP(&c.MigrationIDs, "migration", "m", []string{}, flagDescRunMigration)
+	fs.BoolVar(&c.All, "all", false, flagDescRunAll)
 	fs.StringVar(&c.TargetVersion, "target-version", "", flagDescRunTargetVersion)
+	fs.StringVar(&c.BackupDir, "backup-dir", c.BackupDir, flagDescRunBackupDir)
+	fs.StringVar(&c.PlanFile, "plan", "", flagDescRunPlan)
+	fs.StringVar(&c.ApplyFile, "apply", "", flagDescRunApply)
+	fs.BoolVar(&c.Rollback, "rollback", false, flagDescRunRollback)
+	fs.StringVar(&c.RestoreFrom, "restore-from", "", flagDescRunRestoreFrom)
 
 	// Throttling settings
 	fs.Float32Var(&c.QPS, "qps", c.QPS, "Kubernetes API QPS limit (queries per second)")
@@ -76,6 +102,21 @@ func (c *RunCommand) Complete() error {
 		c.parsedTargetVersion = &targetVer
 	}
 
+	if c.ApplyFile != "" {
+		plan, err := action.ReadPlan(c.ApplyFile)
+		if err != nil {
+			return fmt.Errorf("loading plan: %w", err)
+		}
+
+		targetVer, err := semver.Parse(plan.TargetVersion)
+		if err != nil {
+			return fmt.Errorf("invalid target version %q in plan: %w", plan.TargetVersion, err)
+		}
+
+		c.plan = plan
+		c.parsedTargetVersion = &targetVer
+	}
+
 	return nil
 }
 
@@ -84,7 +125,19 @@ func (c *RunCommand) Validate() error {
 		return fmt.Errorf("validating shared options: %w", err)
 	}
 
-	if len(c.MigrationIDs) == 0 {
+	if c.ApplyFile != "" {
+		return c.validateApply()
+	}
+
+	if c.Rollback {
+		return c.validateRollback()
+	}
+
+	if c.All && len(c.MigrationIDs) > 0 {
+		return errors.New("--all and --migration are mutually exclusive")
+	}
+
+	if !c.All && len(c.MigrationIDs) == 0 {
 		return errors.New("--migration flag is required")
 	}
 
@@ -92,6 +145,44 @@ func (c *RunCommand) Validate() error {
 		return errors.New("--target-version flag is required")
 	}
 
+	if c.BackupDir == "" {
+		return errors.New("--backup-dir must not be empty")
+	}
+
+	return nil
+}
+
+// validateApply validates apply mode, where the migrations and the target version come from
+// the plan.
+func (c *RunCommand) validateApply() error {
+	switch {
+	case c.PlanFile != "":
+		return errors.New("--plan and --apply are mutually exclusive")
+	case c.DryRun:
+		return errors.New("--dry-run cannot be used with --apply; the plan is the dry run")
+	case c.Rollback:
+		return errors.New("--rollback cannot be used with --apply")
+	case c.All || len(c.MigrationIDs) > 0 || c.TargetVersion != "":
+		return errors.New("--all, --migration and --target-version cannot be used with --apply; they are read from the plan")
+	case c.BackupDir == "":
+		return errors.New("--backup-dir must not be empty")
+	}
+
+	return nil
+}
+
+// validateRollback validates rollback mode, which reverts the selected migrations from the
+// backup of their prepare phase and needs no target version.
+func (c *RunCommand) validateRollback() error {
+	switch {
+	case c.PlanFile != "" || c.All:
+		return errors.New("--plan and --all cannot be used with --rollback")
+	case len(c.MigrationIDs) == 0:
+		return errors.New("--migration flag is required with --rollback")
+	case c.RestoreFrom == "":
+		return errors.New("--restore-from flag is required with --rollback")
+	}
+
 	return nil
 }
 
@@ -104,7 +195,182 @@ func (c *RunCommand) Run(ctx context.Context) error {
 		return fmt.Errorf("detecting cluster version: %w", err)
 	}
 
-	return c.runMigrationMode(ctx, currentVersion, c.parsedTargetVersion, c.registry)
+	if c.All {
+		ids, err := c.applicableMigrations(currentVersion)
+		if err != nil {
+			return err
+		}
+
+		if len(ids) == 0 {
+			c.IO.Errorf("No migrations apply to an upgrade from %s to %s", currentVersion, c.parsedTargetVersion)
+
+			return nil
+		}
+
+		c.MigrationIDs = ids
+	}
+
+	switch {
+	case c.Rollback:
+		return c.runRollbackMode(ctx, currentVersion, c.registry)
+	case c.PlanFile != "":
+		return c.runPlanMode(ctx, currentVersion, c.parsedTargetVersion, c.registry)
+	case c.plan != nil:
+		return c.runApplyMode(ctx, currentVersion, c.registry)
+	default:
+		return c.runMigrationMode(ctx, currentVersion, c.parsedTargetVersion, c.registry)
+	}
+}
+
+// applicableMigrations returns the IDs of the migrations applicable to the current and target
+// versions, in dependency order.
+func (c *RunCommand) applicableMigrations(currentVersion *semver.Version) ([]string, error) {
+	actions, err := c.registry.Applicable(action.Target{
+		Client:         c.Client,
+		CurrentVersion: currentVersion,
+		TargetVersion:  c.parsedTargetVersion,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("selecting applicable migrations: %w", err)
+	}
+
+	ids := make([]string, 0, len(actions))
+	for _, a := range actions {
+		ids = append(ids, a.ID())
+	}
+
+	if len(ids) > 0 {
+		c.IO.Errorf("Applicable migrations, in order: %s", strings.Join(ids, ", "))
+	}
+
+	return ids, nil
+}
+
+// runPlanMode dry-runs the migrations in order and writes them, with the resources they may
+// change and the outcome of each step, to the plan file.
+func (c *RunCommand) runPlanMode(
+	ctx context.Context,
+	currentVersion *semver.Version,
+	targetVersion *semver.Version,
+	registry *action.ActionRegistry,
+) error {
+	c.IO.Errorf("Current OpenShift AI version: %s", currentVersion.String())
+	c.IO.Errorf("Target OpenShift AI version: %s\n", targetVersion.String())
+	c.IO.Errorf("PLAN MODE: No changes will be made to the cluster\n")
+
+	plan := action.NewPlan(currentVersion, targetVersion)
+
+	for _, migrationID := range c.MigrationIDs {
+		selectedAction, ok := registry.Get(migrationID)
+		if !ok {
+			return fmt.Errorf("migration %q not found", migrationID)
+		}
+
+		c.IO.Errorf("\n%s:\n", migrationID)
+
+		target := action.Target{
+			Client:         c.Client,
+			CurrentVersion: currentVersion,
+			TargetVersion:  targetVersion,
+			DryRun:         true,
+			SkipConfirm:    true,
+			Recorder:       action.NewVerboseRootRecorder(c.IO),
+			IO:             c.IO,
+		}
+
+		planned, err := action.PlanAction(ctx, target, selectedAction)
+		if err != nil {
+			return fmt.Errorf("planning migration: %w", err)
+		}
+
+		plan.Actions = append(plan.Actions, *planned)
+	}
+
+	if err := plan.Write(c.PlanFile); err != nil {
+		return err //nolint:wrapcheck // Write errors name the plan file
+	}
+
+	c.IO.Fprintln()
+	c.IO.Errorf("Plan of %d migration(s) written to: %s", len(plan.Actions), c.PlanFile)
+	c.IO.Errorf("Review it, then run 'migrate run --apply %s' to execute it.", c.PlanFile)
+
+	return nil
+}
+
+// runApplyMode executes the migrations of the plan in order, refusing to start when the
+// cluster version or any planned resource changed since the plan was generated.
+func (c *RunCommand) runApplyMode(
+	ctx context.Context,
+	currentVersion *semver.Version,
+	registry *action.ActionRegistry,
+) error {
+	if currentVersion.String() != c.plan.CurrentVersion {
+		return fmt.Errorf("cluster version is %s but the plan was generated for %s; generate a new plan",
+			currentVersion, c.plan.CurrentVersion)
+	}
+
+	drifts, err := c.plan.Drift(ctx, c.Client)
+	if err != nil {
+		return fmt.Errorf("checking the plan against the cluster: %w", err)
+	}
+
+	if len(drifts) > 0 {
+		return action.DriftError(drifts)
+	}
+
+	c.IO.Errorf("Applying plan %s generated at %s", c.ApplyFile, c.plan.GeneratedAt.Format(time.RFC3339))
+	c.MigrationIDs = c.plan.MigrationIDs()
+
+	return c.runMigrationMode(ctx, currentVersion, c.parsedTargetVersion, registry)
+}
+
+// runRollbackMode reverts the migrations in the reverse of the order they were given, restoring
+// the resources saved by their prepare phase in RestoreFrom.
+func (c *RunCommand) runRollbackMode(
+	ctx context.Context,
+	currentVersion *semver.Version,
+	registry *action.ActionRegistry,
+) error {
+	c.IO.Errorf("Current OpenShift AI version: %s\n", currentVersion.String())
+
+	if c.DryRun {
+		c.IO.Errorf("DRY RUN MODE: No changes will be made to the cluster\n")
+	}
+
+	for i := len(c.MigrationIDs) - 1; i >= 0; i-- {
+		migrationID := c.MigrationIDs[i]
+
+		selectedAction, ok := registry.Get(migrationID)
+		if !ok {
+			return fmt.Errorf("migration %q not found", migrationID)
+		}
+
+		c.IO.Errorf("\nRolling back %s from %s:\n", migrationID, c.RestoreFrom)
+
+		target := action.Target{
+			Client:         c.Client,
+			CurrentVersion: currentVersion,
+			DryRun:         c.DryRun,
+			SkipConfirm:    c.Yes,
+			OutputDir:      c.RestoreFrom,
+			Recorder:       action.NewVerboseRootRecorder(c.IO),
+			IO:             c.IO,
+		}
+
+		err := selectedAction.Rollback(ctx, target)
+		if errors.Is(err, action.ErrRollbackNotSupported) {
+			return fmt.Errorf("migration %s does not support rollback", migrationID)
+		}
+
+		if err != nil {
+			return fmt.Errorf("rollback of %s failed: %w", migrationID, err)
+		}
+
+		c.IO.Fprintln()
+		c.IO.Errorf("Rollback of %s completed successfully!", migrationID)
+	}
+
+	return nil
 }
 
 func (c *RunCommand) runMigrationMode(
@@ -116,7 +382,14 @@ func (c *RunCommand) runMigrationMode(
 	c.IO.Errorf("Current OpenShift AI version: %s", currentVersion.String())
 	c.IO.Errorf("Target OpenShift AI version: %s\n", targetVersion.String())
 
+	outcomes := make([]migrationOutcome, len(c.MigrationIDs))
+	if len(c.MigrationIDs) > 1 {
+		defer c.printSummary(outcomes)
+	}
+
 	for idx, migrationID := range c.MigrationIDs {
+		outcomes[idx].id = migrationID
+
 		if len(c.MigrationIDs) > 1 {
 			c.IO.Errorf("\n=== Migration %d/%d: %s ===\n", idx+1, len(c.MigrationIDs), migrationID)
 		}
@@ -136,6 +409,7 @@ func (c *RunCommand) runMigrationMode(
 			TargetVersion:  targetVersion,
 			DryRun:         c.DryRun,
 			SkipConfirm:    c.Yes,
+			OutputDir:      c.BackupDir,
 			Recorder:       recorder,
 			IO:             c.IO,
 		}
@@ -148,24 +422,31 @@ func (c *RunCommand) runMigrationMode(
 			c.IO.Errorf("Preparing migration: %s\n", migrationID)
 		}
 
-		runTask := selectedAction.Run()
-		if runTask == nil {
-			return fmt.Errorf("migration %q has no run task", migrationID)
-		}
+		start := time.Now()
+		actionResult, err := action.ExecuteRun(ctx, target, selectedAction)
+		outcomes[idx].duration = time.Since(start)
 
-		actionResult, err := runTask.Execute(ctx, target)
 		if err != nil {
+			outcomes[idx].status = "failed"
+
 			return fmt.Errorf("migration failed: %w", err)
 		}
 
 		// Output has already been streamed during execution, no need to render again
 		c.IO.Fprintln()
+		if snapshot := actionResult.Status.Snapshot; snapshot != nil {
+			c.IO.Errorf("Safety snapshot of %d resources saved to: %s", len(snapshot.Files), snapshot.Dir)
+			c.IO.Errorf("Run 'migrate restore-snapshot %s' to reapply them.", snapshot.Dir)
+		}
+
 		if !actionResult.Status.Completed {
 			c.IO.Errorf("Migration %s incomplete - please review the output above", migrationID)
+			outcomes[idx].status = "incomplete"
 
-			return fmt.Errorf("migration halted: %s", migrationID)
+			return cmd.NewExitError(cmd.ExitCodePartial, fmt.Errorf("migration halted: %s", migrationID))
 		}
 		c.IO.Errorf("Migration %s completed successfully!", migrationID)
+		outcomes[idx].status = "completed"
 	}
 
 	c.IO.Fprintln()
@@ -173,3 +454,31 @@ func (c *RunCommand) runMigrationMode(
 
 	return nil
 }
+
+// migrationOutcome is the result of a migration of a run, for the summary.
+type migrationOutcome struct {
+	id       string
+	status   string
+	duration time.Duration
+}
+
+// printSummary prints the outcome of each migration of the run, including those not run
+// because an earlier one stopped the run.
+func (c *RunCommand) printSummary(outcomes []migrationOutcome) {
+	c.IO.Fprintln()
+	c.IO.Errorf("Summary:")
+
+	for i, o := range outcomes {
+		id := o.id
+		if id == "" {
+			id = c.MigrationIDs[i]
+		}
+
+		switch o.status {
+		case "":
+			c.IO.Errorf("  %-40s not run", id)
+		default:
+			c.IO.Errorf("  %-40s %s (%s)", id, o.status, o.duration.Round(time.Second))
+		}
+	}
+}
@@ -1,11 +1,15 @@
 package migrate_test
 
 import (
+	"path/filepath"
 	"testing"
 
+	"github.com/blang/semver/v4"
+
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 
 	"github.com/opendatahub-io/odh-cli/pkg/migrate"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/action"
 
 	. "github.com/onsi/gomega"
 )
@@ -45,6 +49,49 @@ func TestRunCommand_Validate(t *testing.T) {
 		g.Expect(err).ToNot(HaveOccurred())
 	})
 
+	t.Run("should accept --all instead of migration IDs", func(t *testing.T) {
+		cmd := migrate.NewRunCommand(genericiooptions.IOStreams{})
+		cmd.All = true
+		cmd.TargetVersion = "3.0.0"
+
+		g.Expect(cmd.Validate()).To(Succeed())
+	})
+
+	t.Run("should reject --all with migration IDs", func(t *testing.T) {
+		cmd := migrate.NewRunCommand(genericiooptions.IOStreams{})
+		cmd.All = true
+		cmd.MigrationIDs = []string{"test.migration"}
+		cmd.TargetVersion = "3.0.0"
+
+		g.Expect(cmd.Validate()).To(MatchError(ContainSubstring("mutually exclusive")))
+	})
+
+	t.Run("should accept --rollback without target version", func(t *testing.T) {
+		cmd := migrate.NewRunCommand(genericiooptions.IOStreams{})
+		cmd.Rollback = true
+		cmd.MigrationIDs = []string{"kueue.rhbok.migrate"}
+		cmd.RestoreFrom = "backup-migrate"
+
+		g.Expect(cmd.Validate()).To(Succeed())
+	})
+
+	t.Run("should require --restore-from with --rollback", func(t *testing.T) {
+		cmd := migrate.NewRunCommand(genericiooptions.IOStreams{})
+		cmd.Rollback = true
+		cmd.MigrationIDs = []string{"kueue.rhbok.migrate"}
+
+		g.Expect(cmd.Validate()).To(MatchError(ContainSubstring("--restore-from")))
+	})
+
+	t.Run("should reject --all with --rollback", func(t *testing.T) {
+		cmd := migrate.NewRunCommand(genericiooptions.IOStreams{})
+		cmd.Rollback = true
+		cmd.All = true
+		cmd.RestoreFrom = "backup-migrate"
+
+		g.Expect(cmd.Validate()).To(MatchError(ContainSubstring("cannot be used with --rollback")))
+	})
+
 	t.Run("should accept multiple migration IDs", func(t *testing.T) {
 		cmd := migrate.NewRunCommand(genericiooptions.IOStreams{})
 		cmd.MigrationIDs = []string{"migration1", "migration2", "migration3"}
@@ -99,3 +146,46 @@ func TestRunCommand_Complete(t *testing.T) {
 		g.Expect(cmd.Verbose).To(BeTrue()) // Always enabled for migrate run
 	})
 }
+
+func TestRunCommand_Apply(t *testing.T) {
+	g := NewWithT(t)
+
+	planFile := filepath.Join(t.TempDir(), "plan.yaml")
+
+	plan := action.NewPlan(&semver.Version{Major: 2, Minor: 25}, &semver.Version{Major: 3})
+	plan.Actions = append(plan.Actions, action.PlannedAction{ID: "kueue.rhbok.migrate"})
+	g.Expect(plan.Write(planFile)).To(Succeed())
+
+	t.Run("should read migrations and target version from the plan", func(t *testing.T) {
+		cmd := migrate.NewRunCommand(genericiooptions.IOStreams{})
+		cmd.ApplyFile = planFile
+
+		g.Expect(cmd.Complete()).To(Succeed())
+		g.Expect(cmd.Validate()).To(Succeed())
+	})
+
+	t.Run("should reject migrations given with the plan", func(t *testing.T) {
+		cmd := migrate.NewRunCommand(genericiooptions.IOStreams{})
+		cmd.ApplyFile = planFile
+		cmd.MigrationIDs = []string{"kueue.rhbok.migrate"}
+
+		g.Expect(cmd.Complete()).To(Succeed())
+		g.Expect(cmd.Validate()).To(MatchError(ContainSubstring("read from the plan")))
+	})
+
+	t.Run("should reject --plan with --apply", func(t *testing.T) {
+		cmd := migrate.NewRunCommand(genericiooptions.IOStreams{})
+		cmd.ApplyFile = planFile
+		cmd.PlanFile = planFile
+
+		g.Expect(cmd.Complete()).To(Succeed())
+		g.Expect(cmd.Validate()).To(MatchError(ContainSubstring("mutually exclusive")))
+	})
+
+	t.Run("should reject a missing plan", func(t *testing.T) {
+		cmd := migrate.NewRunCommand(genericiooptions.IOStreams{})
+		cmd.ApplyFile = filepath.Join(t.TempDir(), "missing.yaml")
+
+		g.Expect(cmd.Complete()).To(MatchError(ContainSubstring("loading plan")))
+	})
+}
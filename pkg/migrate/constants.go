@@ -16,6 +16,19 @@ const (
 	flagDescRunYes           = "Skip confirmation prompts"
 	flagDescRunMigration     = "Migration ID to execute (can be specified multiple times)"
 	flagDescRunTargetVersion = "Target version for migration (required)"
+	flagDescRunBackupDir     = "Directory for the safety snapshot taken before each migration (a timestamped subdirectory per migration)"
+	flagDescRunAll           = "Run every migration applicable to the current and target versions, in dependency order"
+	flagDescRunPlan          = "Write the plan of the migrations to this file without changing the cluster"
+	flagDescRunApply         = "Execute the migrations of a plan file written with --plan, refusing if the cluster changed since"
+	flagDescRunRollback      = "Revert the migrations given with --migration, in reverse order, restoring the backup given with --restore-from"
+	flagDescRunRestoreFrom   = "Backup directory written by 'migrate prepare' that --rollback restores from"
+)
+
+// Flag descriptions for the migrate restore-snapshot command.
+const (
+	flagDescRestoreDryRun  = "Show which resources would be restored without making changes"
+	flagDescRestoreYes     = "Skip confirmation prompts"
+	flagDescRestoreTimeout = "Operation timeout (e.g., 10m, 30m)"
 )
 
 // Flag descriptions for the migrate prepare command.
@@ -28,3 +41,82 @@ const (
 	flagDescPrepareMigration     = "Migration ID to prepare (can be specified multiple times)"
 	flagDescPrepareTargetVersion = "Target version for migration (required)"
 )
+
+// Flag descriptions for the migrate dspa convert command.
+const (
+	flagDescDSPAFilename       = "Convert DataSciencePipelinesApplication manifests from this file, directory or glob instead of the cluster ('-' reads stdin)"
+	flagDescDSPACRDFile        = "Validate against the v1 schema of this CRD manifest instead of the cluster CRD"
+	flagDescDSPASkipValidation = "Skip validation of converted objects against the v1 CRD schema"
+	flagDescDSPADryRun         = "Show per-object changes and diffs without updating the cluster"
+	flagDescDSPAYes            = "Skip confirmation prompts"
+	flagDescDSPATimeout        = "Operation timeout (e.g., 10m, 30m)"
+)
+
+// Flag descriptions for the migrate inferenceservice shadow command.
+const (
+	flagDescShadowFrom         = "Serverless InferenceService whose traffic is mirrored (required)"
+	flagDescShadowTo           = "RawDeployment InferenceService that receives the mirrored traffic (required unless --remove)"
+	flagDescShadowGateway      = "Gateway the shadow HTTPRoute attaches to, as namespace/name"
+	flagDescShadowPercent      = "Percentage of requests mirrored to the target (1-100)"
+	flagDescShadowProbeCount   = "Send this many requests to each endpoint and compare success rates (0 disables probing)"
+	flagDescShadowProbePath    = "Request path used for probing; {name} is replaced by the InferenceService name"
+	flagDescShadowProbePayload = "JSON file sent as a POST body when probing (default: GET requests)"
+	flagDescShadowOutput       = "Output format of the probe comparison (table|json|yaml)"
+	flagDescShadowRemove       = "Remove the shadow HTTPRoute of --from"
+	flagDescShadowDryRun       = "Print the shadow HTTPRoute without applying it"
+	flagDescShadowYes          = "Skip confirmation prompts"
+	flagDescShadowTimeout      = "Operation timeout (e.g., 10m, 30m)"
+)
+
+// Flag descriptions for the migrate inferenceservice to-raw command.
+const (
+	flagDescToRawName      = "Only migrate this InferenceService (can be specified multiple times; requires --namespace)"
+	flagDescToRawBackupDir = "Directory the original InferenceServices are saved to before they are deleted (a timestamped subdirectory per run)"
+	flagDescToRawDryRun    = "Show per-object changes and print the converted InferenceServices without changing the cluster"
+	flagDescToRawYes       = "Skip confirmation prompts"
+	flagDescToRawTimeout   = "Operation timeout, including waiting for the new predictor pods (e.g., 10m, 30m)"
+)
+
+// Flag descriptions for the migrate notebook pin-digests command.
+const (
+	flagDescPinRegistryConfig = "Docker config.json (or .dockerconfigjson pull secret payload) with credentials for private registries"
+	flagDescPinTimeout        = "Operation timeout (e.g., 10m, 30m)"
+)
+
+// Flag descriptions for the migrate modelmesh command.
+const (
+	flagDescModelMeshName      = "Only migrate this InferenceService (can be specified multiple times; requires --namespace)"
+	flagDescModelMeshBackupDir = "Directory the original InferenceServices and ServingRuntimes are saved to before the migration (a timestamped subdirectory per run)"
+	flagDescModelMeshDryRun    = "Show per-object changes and print the converted ServingRuntimes and InferenceServices without changing the cluster"
+	flagDescModelMeshYes       = "Skip confirmation prompts"
+	flagDescModelMeshTimeout   = "Operation timeout, including waiting for the new predictor pods (e.g., 10m, 30m)"
+)
+
+// Flag descriptions for the migrate raycluster refresh-certs command.
+const (
+	flagDescRefreshCertsName         = "Only refresh this RayCluster (can be specified multiple times; requires --namespace)"
+	flagDescRefreshCertsMaxParallel  = "Number of RayClusters refreshed concurrently"
+	flagDescRefreshCertsSkipBusy     = "Skip the RayClusters whose dashboard reports active jobs or alive actors, or cannot be queried"
+	flagDescRefreshCertsDashboardURL = "Ray dashboard URL of the --name RayCluster, e.g. a port-forward to its head service (default: its dashboard route)"
+	flagDescRefreshCertsDryRun       = "Show the Secrets that would be deleted and the head pods that would be restarted per RayCluster without changing the cluster"
+	flagDescRefreshCertsYes          = "Skip confirmation prompts"
+	flagDescRefreshCertsTimeout      = "Operation timeout, including waiting for the regenerated Secrets (e.g., 10m, 30m)"
+)
+
+// Flag descriptions for the migrate raycluster rollback command.
+const (
+	flagDescRollbackBackupDir = "Backup directory the RayClusters are restored from; its rhoai-2.x subdirectory is used when present"
+	flagDescRollbackName      = "Only roll back this RayCluster (can be specified multiple times; requires --namespace)"
+	flagDescRollbackDryRun    = "Show the RayClusters that would be re-created and the ServiceAccounts that would be restored without changing the cluster"
+	flagDescRollbackYes       = "Skip confirmation prompts"
+	flagDescRollbackTimeout   = "Operation timeout, including waiting for deletion and the dashboard Routes (e.g., 10m, 30m)"
+)
+
+// Flag descriptions shared by the migrate raycluster commands.
+const (
+	flagDescRayClusterOutput    = "Output format (table|json|yaml); json and yaml write a report of the result of each RayCluster to stdout"
+	flagDescRayClusterStateFile = "File recording the outcome of each RayCluster as it completes, for --resume and 'migrate raycluster status'"
+	flagDescRayClusterResume    = "Skip the RayClusters recorded as succeeded in --state-file by a previous run"
+
+	flagDescRayClusterStatusStateFile = "State file written by refresh-certs or rollback with --state-file"
+)
@@ -0,0 +1,173 @@
+// Package dspa converts DataSciencePipelinesApplication resources from the v1alpha1 to the v1 schema.
+package dspa
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+)
+
+// Operation is the kind of change a conversion rule applies.
+type Operation string
+
+const (
+	// OperationRemove deletes a field that no longer exists in v1.
+	OperationRemove Operation = "remove"
+
+	// OperationMove relocates a field to its v1 location.
+	OperationMove Operation = "move"
+)
+
+// Rule describes a single v1alpha1 to v1 field transformation.
+// Paths are dot-separated field paths relative to the object root (e.g. "spec.dspVersion").
+type Rule struct {
+	Operation Operation
+	Path      string
+	To        string
+	Reason    string
+}
+
+// Change records a rule that was applied to an object during conversion.
+type Change struct {
+	Operation Operation `json:"operation"`
+	Path      string    `json:"path"`
+	To        string    `json:"to,omitempty"`
+	Reason    string    `json:"reason"`
+}
+
+// String returns a one-line human-readable description of the change.
+func (c Change) String() string {
+	if c.Operation == OperationMove {
+		return fmt.Sprintf("moved .%s to .%s (%s)", c.Path, c.To, c.Reason)
+	}
+
+	return fmt.Sprintf("removed .%s (%s)", c.Path, c.Reason)
+}
+
+const (
+	reasonTekton   = "Tekton-based v1 pipelines are not supported by the v1 API"
+	reasonUI       = "the standalone ML Pipelines UI is not deployed by the v1 API; use the dashboard"
+	reasonVersion  = "the v1 API only supports Data Science Pipelines 2.0"
+	reasonInstruct = "the InstructLab managed pipeline was removed"
+)
+
+// DefaultRules returns the rules converting a v1alpha1 DataSciencePipelinesApplication to v1.
+func DefaultRules() []Rule {
+	rules := []Rule{
+		{Operation: OperationRemove, Path: "spec.dspVersion", Reason: reasonVersion},
+		{Operation: OperationRemove, Path: "spec.mlpipelineUI", Reason: reasonUI},
+		{Operation: OperationRemove, Path: "spec.apiServer.managedPipelines.instructLab", Reason: reasonInstruct},
+	}
+
+	tektonFields := []string{
+		"applyTektonCustomResource",
+		"archiveLogs",
+		"artifactImage",
+		"artifactScriptConfigMap",
+		"autoUpdatePipelineDefaultVersion",
+		"cacheImage",
+		"dbConfigConMaxLifetimeSec",
+		"injectDefaultScript",
+		"moveResultsImage",
+		"stripEOF",
+		"terminateStatus",
+		"trackArtifacts",
+	}
+
+	for _, f := range tektonFields {
+		rules = append(rules, Rule{Operation: OperationRemove, Path: "spec.apiServer." + f, Reason: reasonTekton})
+	}
+
+	return rules
+}
+
+// Converter applies conversion rules to DataSciencePipelinesApplication objects.
+type Converter struct {
+	rules []Rule
+}
+
+// NewConverter creates a Converter for the given rules, or DefaultRules when none are provided.
+func NewConverter(rules ...Rule) *Converter {
+	if len(rules) == 0 {
+		rules = DefaultRules()
+	}
+
+	return &Converter{rules: rules}
+}
+
+// Convert returns a v1 copy of the given DataSciencePipelinesApplication along with the
+// changes applied. The input object is not modified. Objects that are already v1 are
+// still passed through the rules, since v1 objects may carry fields removed in later releases.
+func (c *Converter) Convert(obj *unstructured.Unstructured) (*unstructured.Unstructured, []Change, error) {
+	if obj.GetKind() != resources.DataSciencePipelinesApplicationV1.Kind {
+		return nil, nil, fmt.Errorf("unsupported kind %q: expected %s", obj.GetKind(), resources.DataSciencePipelinesApplicationV1.Kind)
+	}
+
+	out := obj.DeepCopy()
+	out.SetAPIVersion(resources.DataSciencePipelinesApplicationV1.APIVersion())
+
+	var changes []Change
+
+	for _, rule := range c.rules {
+		applied, err := applyRule(out.Object, rule)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
+		}
+
+		if applied {
+			changes = append(changes, Change(rule))
+		}
+	}
+
+	return out, changes, nil
+}
+
+// applyRule applies a single rule to the object, returning whether the source field was present.
+func applyRule(obj map[string]any, rule Rule) (bool, error) {
+	fields := strings.Split(rule.Path, ".")
+
+	value, found, err := unstructured.NestedFieldNoCopy(obj, fields...)
+	if err != nil {
+		return false, fmt.Errorf("reading .%s: %w", rule.Path, err)
+	}
+
+	if !found {
+		return false, nil
+	}
+
+	switch rule.Operation {
+	case OperationRemove:
+	case OperationMove:
+		to := strings.Split(rule.To, ".")
+
+		if _, exists, _ := unstructured.NestedFieldNoCopy(obj, to...); exists {
+			return false, fmt.Errorf("cannot move .%s to .%s: destination is already set", rule.Path, rule.To)
+		}
+
+		if err := unstructured.SetNestedField(obj, value, to...); err != nil {
+			return false, fmt.Errorf("setting .%s: %w", rule.To, err)
+		}
+	default:
+		return false, fmt.Errorf("unsupported operation %q", rule.Operation)
+	}
+
+	unstructured.RemoveNestedField(obj, fields...)
+	pruneEmptyParents(obj, fields[:len(fields)-1])
+
+	return true, nil
+}
+
+// pruneEmptyParents removes parent maps left empty by a removal, stopping at the top-level field.
+func pruneEmptyParents(obj map[string]any, fields []string) {
+	for i := len(fields); i > 1; i-- {
+		parent, found, err := unstructured.NestedMap(obj, fields[:i]...)
+		if err != nil || !found || len(parent) > 0 {
+			return
+		}
+
+		unstructured.RemoveNestedField(obj, fields[:i]...)
+	}
+}
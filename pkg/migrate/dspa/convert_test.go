@@ -0,0 +1,225 @@
+package dspa_test
+
+import (
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/dspa"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+)
+
+func newV1Alpha1DSPA() *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.DataSciencePipelinesApplicationV1Alpha1.APIVersion(),
+			"kind":       resources.DataSciencePipelinesApplicationV1Alpha1.Kind,
+			"metadata": map[string]any{
+				"name":      "dspa",
+				"namespace": "project",
+			},
+			"spec": map[string]any{
+				"dspVersion": "v2",
+				"apiServer": map[string]any{
+					"deploy":      true,
+					"cacheImage":  "registry.example.com/cache:latest",
+					"stripEOF":    true,
+					"enableOauth": true,
+					"managedPipelines": map[string]any{
+						"instructLab": map[string]any{"state": "Managed"},
+					},
+				},
+				"mlpipelineUI": map[string]any{
+					"image": "registry.example.com/ui:latest",
+				},
+				"objectStorage": map[string]any{
+					"minio": map[string]any{"deploy": true},
+				},
+			},
+		},
+	}
+}
+
+func TestConverter_Convert(t *testing.T) {
+	g := NewWithT(t)
+
+	in := newV1Alpha1DSPA()
+
+	out, changes, err := dspa.NewConverter().Convert(in)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(out.GetAPIVersion()).To(Equal(resources.DataSciencePipelinesApplicationV1.APIVersion()))
+	g.Expect(out.Object["spec"]).To(Equal(map[string]any{
+		"apiServer": map[string]any{
+			"deploy":      true,
+			"enableOauth": true,
+		},
+		"objectStorage": map[string]any{
+			"minio": map[string]any{"deploy": true},
+		},
+	}))
+	g.Expect(changes).To(HaveLen(5))
+	g.Expect(changes).To(ContainElement(HaveField("Path", "spec.apiServer.managedPipelines.instructLab")))
+
+	// The input object is left untouched.
+	g.Expect(in.GetAPIVersion()).To(Equal(resources.DataSciencePipelinesApplicationV1Alpha1.APIVersion()))
+	g.Expect(in.Object["spec"]).To(HaveKey("dspVersion"))
+}
+
+func TestConverter_Move(t *testing.T) {
+	g := NewWithT(t)
+
+	converter := dspa.NewConverter(dspa.Rule{
+		Operation: dspa.OperationMove,
+		Path:      "spec.mlpipelineUI.image",
+		To:        "spec.ui.image",
+		Reason:    "renamed",
+	})
+
+	out, changes, err := converter.Convert(newV1Alpha1DSPA())
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(changes).To(HaveLen(1))
+	g.Expect(changes[0].String()).To(Equal("moved .spec.mlpipelineUI.image to .spec.ui.image (renamed)"))
+
+	image, found, err := unstructured.NestedString(out.Object, "spec", "ui", "image")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(found).To(BeTrue())
+	g.Expect(image).To(Equal("registry.example.com/ui:latest"))
+	g.Expect(out.Object["spec"]).ToNot(HaveKey("mlpipelineUI"))
+}
+
+func TestConverter_MoveConflict(t *testing.T) {
+	g := NewWithT(t)
+
+	converter := dspa.NewConverter(dspa.Rule{
+		Operation: dspa.OperationMove,
+		Path:      "spec.apiServer.cacheImage",
+		To:        "spec.apiServer.deploy",
+		Reason:    "conflict",
+	})
+
+	_, _, err := converter.Convert(newV1Alpha1DSPA())
+
+	g.Expect(err).To(MatchError(ContainSubstring("destination is already set")))
+}
+
+func TestConverter_UnsupportedKind(t *testing.T) {
+	g := NewWithT(t)
+
+	obj := &unstructured.Unstructured{Object: map[string]any{"apiVersion": "v1", "kind": "ConfigMap"}}
+
+	_, _, err := dspa.NewConverter().Convert(obj)
+
+	g.Expect(err).To(MatchError(ContainSubstring("unsupported kind")))
+}
+
+func TestDiff(t *testing.T) {
+	g := NewWithT(t)
+
+	in := newV1Alpha1DSPA()
+	out, _, err := dspa.NewConverter().Convert(in)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	diff, err := dspa.Diff(in, out)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(diff).To(ContainSubstring("--- project/dspa (datasciencepipelinesapplications.opendatahub.io/v1alpha1)"))
+	g.Expect(diff).To(ContainSubstring("- apiVersion: datasciencepipelinesapplications.opendatahub.io/v1alpha1"))
+	g.Expect(diff).To(ContainSubstring("+ apiVersion: datasciencepipelinesapplications.opendatahub.io/v1"))
+	g.Expect(diff).To(ContainSubstring("-   dspVersion: v2"))
+
+	identical, err := dspa.Diff(out, out)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(identical).To(BeEmpty())
+}
+
+func newTestCRD() *apiextensionsv1.CustomResourceDefinition {
+	str := apiextensionsv1.JSONSchemaProps{Type: "string"}
+	boolean := apiextensionsv1.JSONSchemaProps{Type: "boolean"}
+
+	return &apiextensionsv1.CustomResourceDefinition{
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{{
+				Name: "v1",
+				Schema: &apiextensionsv1.CustomResourceValidation{
+					OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+						Type: "object",
+						Properties: map[string]apiextensionsv1.JSONSchemaProps{
+							"spec": {
+								Type:     "object",
+								Required: []string{"objectStorage"},
+								Properties: map[string]apiextensionsv1.JSONSchemaProps{
+									"apiServer": {
+										Type: "object",
+										Properties: map[string]apiextensionsv1.JSONSchemaProps{
+											"deploy":      boolean,
+											"enableOauth": boolean,
+										},
+									},
+									"objectStorage": {
+										Type: "object",
+										Properties: map[string]apiextensionsv1.JSONSchemaProps{
+											"minio": {
+												Type:       "object",
+												Properties: map[string]apiextensionsv1.JSONSchemaProps{"deploy": boolean, "image": str},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}},
+		},
+	}
+}
+
+func TestValidator(t *testing.T) {
+	g := NewWithT(t)
+
+	validator, err := dspa.NewValidator(newTestCRD())
+	g.Expect(err).ToNot(HaveOccurred())
+
+	t.Run("converted object is valid", func(t *testing.T) {
+		out, _, err := dspa.NewConverter().Convert(newV1Alpha1DSPA())
+		g.Expect(err).ToNot(HaveOccurred())
+
+		g.Expect(validator.Validate(out)).To(BeEmpty())
+	})
+
+	t.Run("unconverted object reports unknown fields", func(t *testing.T) {
+		problems := validator.Validate(newV1Alpha1DSPA())
+
+		g.Expect(problems).To(ContainElements(
+			ContainSubstring(".spec.dspVersion: unknown field"),
+			ContainSubstring(".spec.mlpipelineUI: unknown field"),
+			ContainSubstring(".spec.apiServer.cacheImage: unknown field"),
+		))
+	})
+
+	t.Run("reports type mismatches and missing required fields", func(t *testing.T) {
+		obj := &unstructured.Unstructured{Object: map[string]any{
+			"spec": map[string]any{
+				"apiServer": map[string]any{"deploy": "yes"},
+			},
+		}}
+
+		g.Expect(validator.Validate(obj)).To(ConsistOf(
+			".spec.objectStorage: required field is missing",
+			".spec.apiServer.deploy: expected boolean, got string",
+		))
+	})
+
+	t.Run("rejects CRD without v1", func(t *testing.T) {
+		crd := newTestCRD()
+		crd.Spec.Versions[0].Name = "v1alpha1"
+
+		_, err := dspa.NewValidator(crd)
+		g.Expect(err).To(MatchError(ContainSubstring("does not define version v1")))
+	})
+}
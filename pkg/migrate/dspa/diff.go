@@ -0,0 +1,126 @@
+package dspa
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+// diffContext is the number of unchanged lines shown around each change.
+const diffContext = 3
+
+// Diff renders a unified-style line diff between the YAML forms of two objects.
+// Returns an empty string when the objects are identical.
+func Diff(before *unstructured.Unstructured, after *unstructured.Unstructured) (string, error) {
+	from, err := yaml.Marshal(before.Object)
+	if err != nil {
+		return "", fmt.Errorf("marshaling original object: %w", err)
+	}
+
+	to, err := yaml.Marshal(after.Object)
+	if err != nil {
+		return "", fmt.Errorf("marshaling converted object: %w", err)
+	}
+
+	lines := diffLines(splitLines(string(from)), splitLines(string(to)))
+
+	var b strings.Builder
+
+	name := before.GetNamespace() + "/" + before.GetName()
+	fmt.Fprintf(&b, "--- %s (%s)\n", name, before.GetAPIVersion())
+	fmt.Fprintf(&b, "+++ %s (%s)\n", name, after.GetAPIVersion())
+
+	changed := false
+	lastPrinted := -1
+
+	for i, line := range lines {
+		if !nearChange(lines, i) {
+			continue
+		}
+
+		if lastPrinted != -1 && i > lastPrinted+1 {
+			b.WriteString("@@\n")
+		}
+
+		if line.op != ' ' {
+			changed = true
+		}
+
+		fmt.Fprintf(&b, "%c %s\n", line.op, line.text)
+		lastPrinted = i
+	}
+
+	if !changed {
+		return "", nil
+	}
+
+	return b.String(), nil
+}
+
+type diffLine struct {
+	op   byte
+	text string
+}
+
+// nearChange reports whether line i is a change or within diffContext lines of one.
+func nearChange(lines []diffLine, i int) bool {
+	for j := max(0, i-diffContext); j <= min(len(lines)-1, i+diffContext); j++ {
+		if lines[j].op != ' ' {
+			return true
+		}
+	}
+
+	return false
+}
+
+func splitLines(s string) []string {
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// diffLines computes a line diff using the longest common subsequence of the inputs.
+func diffLines(a []string, b []string) []diffLine {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	result := make([]diffLine, 0, len(a)+len(b))
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			result = append(result, diffLine{op: ' ', text: a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			result = append(result, diffLine{op: '-', text: a[i]})
+			i++
+		default:
+			result = append(result, diffLine{op: '+', text: b[j]})
+			j++
+		}
+	}
+
+	for ; i < len(a); i++ {
+		result = append(result, diffLine{op: '-', text: a[i]})
+	}
+
+	for ; j < len(b); j++ {
+		result = append(result, diffLine{op: '+', text: b[j]})
+	}
+
+	return result
+}
@@ -0,0 +1,218 @@
+package dspa
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+)
+
+// Validator checks converted objects against the v1 schema of the DataSciencePipelinesApplication CRD.
+//
+// It covers the structural rules that matter for conversion: fields unknown to the schema
+// (which the API server would silently prune), basic type mismatches, missing required
+// fields and enum violations. CEL rules and formats are left to the API server.
+type Validator struct {
+	schema *apiextensionsv1.JSONSchemaProps
+}
+
+// NewValidator builds a Validator from the v1 version of the given CRD.
+func NewValidator(crd *apiextensionsv1.CustomResourceDefinition) (*Validator, error) {
+	idx := slices.IndexFunc(crd.Spec.Versions, func(v apiextensionsv1.CustomResourceDefinitionVersion) bool {
+		return v.Name == resources.DataSciencePipelinesApplicationV1.Version
+	})
+	if idx == -1 {
+		return nil, fmt.Errorf("CRD %s does not define version %s", crd.Name, resources.DataSciencePipelinesApplicationV1.Version)
+	}
+
+	version := crd.Spec.Versions[idx]
+	if version.Schema == nil || version.Schema.OpenAPIV3Schema == nil {
+		return nil, fmt.Errorf("CRD %s version %s has no OpenAPI schema", crd.Name, version.Name)
+	}
+
+	return &Validator{schema: version.Schema.OpenAPIV3Schema}, nil
+}
+
+// Validate returns the schema violations of the object.
+// An empty result means the object is valid against the v1 schema.
+func (v *Validator) Validate(obj *unstructured.Unstructured) []string {
+	var problems []string
+
+	for _, field := range []string{"spec", "status"} {
+		value, found := obj.Object[field]
+		if !found {
+			continue
+		}
+
+		fieldSchema, ok := v.schema.Properties[field]
+		if !ok {
+			problems = append(problems, fmt.Sprintf(".%s: unknown field", field))
+
+			continue
+		}
+
+		problems = append(problems, validateValue("."+field, value, &fieldSchema)...)
+	}
+
+	return problems
+}
+
+// validateValue recursively validates a value against its schema.
+func validateValue(path string, value any, schema *apiextensionsv1.JSONSchemaProps) []string {
+	if value == nil {
+		if schema.Nullable {
+			return nil
+		}
+
+		return []string{path + ": must not be null"}
+	}
+
+	if schema.XIntOrString {
+		switch value.(type) {
+		case string, int64, float64:
+			return nil
+		default:
+			return []string{fmt.Sprintf("%s: expected int-or-string, got %T", path, value)}
+		}
+	}
+
+	if problem := checkType(path, value, schema.Type); problem != "" {
+		return []string{problem}
+	}
+
+	if problem := checkEnum(path, value, schema.Enum); problem != "" {
+		return []string{problem}
+	}
+
+	switch typed := value.(type) {
+	case map[string]any:
+		return validateObject(path, typed, schema)
+	case []any:
+		if schema.Items == nil || schema.Items.Schema == nil {
+			return nil
+		}
+
+		var problems []string
+		for i, item := range typed {
+			problems = append(problems, validateValue(fmt.Sprintf("%s[%d]", path, i), item, schema.Items.Schema)...)
+		}
+
+		return problems
+	default:
+		return nil
+	}
+}
+
+// validateObject validates the fields of an object value, including required and unknown fields.
+func validateObject(path string, obj map[string]any, schema *apiextensionsv1.JSONSchemaProps) []string {
+	var problems []string
+
+	for _, required := range schema.Required {
+		if _, found := obj[required]; !found {
+			problems = append(problems, fmt.Sprintf("%s.%s: required field is missing", path, required))
+		}
+	}
+
+	if schema.XEmbeddedResource {
+		return problems
+	}
+
+	keys := make([]string, 0, len(obj))
+	for key := range obj {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		fieldPath := path + "." + key
+
+		if fieldSchema, ok := schema.Properties[key]; ok {
+			problems = append(problems, validateValue(fieldPath, obj[key], &fieldSchema)...)
+
+			continue
+		}
+
+		if schema.AdditionalProperties != nil {
+			if schema.AdditionalProperties.Schema != nil {
+				problems = append(problems, validateValue(fieldPath, obj[key], schema.AdditionalProperties.Schema)...)
+			}
+
+			continue
+		}
+
+		if schema.XPreserveUnknownFields != nil && *schema.XPreserveUnknownFields {
+			continue
+		}
+
+		problems = append(problems, fieldPath+": unknown field (would be pruned by the API server)")
+	}
+
+	return problems
+}
+
+// checkType verifies that a value matches an OpenAPI type. An empty type accepts any value.
+func checkType(path string, value any, openAPIType string) string {
+	ok := true
+
+	switch openAPIType {
+	case "object":
+		_, ok = value.(map[string]any)
+	case "array":
+		_, ok = value.([]any)
+	case "string":
+		_, ok = value.(string)
+	case "boolean":
+		_, ok = value.(bool)
+	case "integer":
+		switch n := value.(type) {
+		case int64, int:
+		case float64:
+			ok = n == float64(int64(n))
+		default:
+			ok = false
+		}
+	case "number":
+		switch value.(type) {
+		case int64, int, float64:
+		default:
+			ok = false
+		}
+	}
+
+	if ok {
+		return ""
+	}
+
+	return fmt.Sprintf("%s: expected %s, got %T", path, openAPIType, value)
+}
+
+// checkEnum verifies that a scalar value is one of the allowed enum values.
+func checkEnum(path string, value any, enum []apiextensionsv1.JSON) string {
+	if len(enum) == 0 {
+		return ""
+	}
+
+	str, ok := value.(string)
+	if !ok {
+		return ""
+	}
+
+	allowed := make([]string, 0, len(enum))
+
+	for _, e := range enum {
+		// Enum values are raw JSON; string values are quoted.
+		quoted := string(e.Raw)
+		if quoted == fmt.Sprintf("%q", str) {
+			return ""
+		}
+
+		allowed = append(allowed, quoted)
+	}
+
+	return fmt.Sprintf("%s: unsupported value %q, expected one of %v", path, str, allowed)
+}
@@ -0,0 +1,93 @@
+package isvc
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+// ProbeResult summarizes a series of requests sent to one inference endpoint.
+type ProbeResult struct {
+	Endpoint  string
+	Requests  int
+	Succeeded int
+
+	// TotalLatency is the summed latency of all completed requests.
+	TotalLatency time.Duration
+
+	// LastError is the most recent failure, if any.
+	LastError string
+}
+
+// SuccessRate returns the share of successful requests in percent.
+func (r ProbeResult) SuccessRate() float64 {
+	if r.Requests == 0 {
+		return 0
+	}
+
+	return float64(r.Succeeded) * 100 / float64(r.Requests)
+}
+
+// AverageLatency returns the mean latency of the requests sent.
+func (r ProbeResult) AverageLatency() time.Duration {
+	if r.Requests == 0 {
+		return 0
+	}
+
+	return r.TotalLatency / time.Duration(r.Requests)
+}
+
+// Probe sends count requests to endpoint and records how many returned a 2xx status.
+// Requests are GETs when payload is empty and JSON POSTs otherwise.
+func Probe(ctx context.Context, httpClient *http.Client, endpoint string, payload []byte, count int) ProbeResult {
+	res := ProbeResult{Endpoint: endpoint}
+
+	method := http.MethodGet
+	if len(payload) > 0 {
+		method = http.MethodPost
+	}
+
+	for range count {
+		if ctx.Err() != nil {
+			res.LastError = ctx.Err().Error()
+
+			break
+		}
+
+		res.Requests++
+
+		req, err := http.NewRequestWithContext(ctx, method, endpoint, bytes.NewReader(payload))
+		if err != nil {
+			res.LastError = err.Error()
+
+			continue
+		}
+
+		if len(payload) > 0 {
+			req.Header.Set("Content-Type", "application/json")
+		}
+
+		start := time.Now()
+		resp, err := httpClient.Do(req)
+		res.TotalLatency += time.Since(start)
+
+		if err != nil {
+			res.LastError = err.Error()
+
+			continue
+		}
+
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+
+		if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+			res.Succeeded++
+		} else {
+			res.LastError = resp.Status
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,221 @@
+package isvc
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// LabelKnativeVisibility restricts a Serverless InferenceService to the cluster network.
+	LabelKnativeVisibility = "networking.knative.dev/visibility"
+
+	// LabelKServeVisibility exposes a RawDeployment InferenceService outside the cluster.
+	LabelKServeVisibility = "networking.kserve.io/visibility"
+
+	// LabelInferenceService and LabelComponent select the predictor pods of an InferenceService.
+	LabelInferenceService = "serving.kserve.io/inferenceservice"
+	LabelComponent        = "component"
+
+	visibilityClusterLocal = "cluster-local"
+	visibilityExposed      = "exposed"
+	componentPredictor     = "predictor"
+
+	annotationLastApplied = "kubectl.kubernetes.io/last-applied-configuration"
+)
+
+// knativeAnnotationPrefixes are the annotation prefixes only honored by the Knative-based
+// Serverless mode; they are dropped when converting to RawDeployment.
+var knativeAnnotationPrefixes = []string{
+	"serving.knative.dev/",
+	"serving.knative.openshift.io/",
+	"autoscaling.knative.dev/",
+	"sidecar.istio.io/",
+}
+
+// replicaAnnotations map the Knative scale bounds to the predictor fields honored in RawDeployment mode.
+var replicaAnnotations = []struct {
+	annotations []string
+	field       string
+}{
+	{annotations: []string{"autoscaling.knative.dev/min-scale", "autoscaling.knative.dev/minScale"}, field: "minReplicas"},
+	{annotations: []string{"autoscaling.knative.dev/max-scale", "autoscaling.knative.dev/maxScale"}, field: "maxReplicas"},
+}
+
+// IsServerless reports whether an InferenceService is annotated for the Serverless mode.
+func IsServerless(obj *unstructured.Unstructured) bool {
+	return DeploymentMode(obj) == DeploymentModeServerless
+}
+
+// ConvertToRaw returns the RawDeployment replacement of a Serverless InferenceService, ready
+// to be created once the original is deleted (KServe does not allow changing the deployment
+// mode of an existing InferenceService), along with a description of each change. The input
+// object is not modified.
+func ConvertToRaw(obj *unstructured.Unstructured) (*unstructured.Unstructured, []string, error) {
+	spec, ok := obj.Object["spec"].(map[string]any)
+	if !ok {
+		return nil, nil, fmt.Errorf("InferenceService %s/%s has no spec", obj.GetNamespace(), obj.GetName())
+	}
+
+	out := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": obj.GetAPIVersion(),
+		"kind":       obj.GetKind(),
+		"spec":       runtime.DeepCopyJSONValue(spec),
+	}}
+	out.SetName(obj.GetName())
+	out.SetNamespace(obj.GetNamespace())
+	out.SetOwnerReferences(obj.GetOwnerReferences())
+
+	var changes []string
+
+	annotations := make(map[string]string, len(obj.GetAnnotations()))
+	for key, value := range obj.GetAnnotations() {
+		annotations[key] = value
+	}
+
+	labels := make(map[string]string, len(obj.GetLabels()))
+	for key, value := range obj.GetLabels() {
+		labels[key] = value
+	}
+
+	annotations[AnnotationDeploymentMode] = DeploymentModeRawDeployment
+	changes = append(changes, fmt.Sprintf("set annotation %s=%s", AnnotationDeploymentMode, DeploymentModeRawDeployment))
+
+	for _, mapping := range replicaAnnotations {
+		change, err := moveScaleAnnotation(out, annotations, mapping.annotations, mapping.field)
+		if err != nil {
+			return nil, nil, fmt.Errorf("InferenceService %s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
+		}
+
+		if change != "" {
+			changes = append(changes, change)
+		}
+	}
+
+	var dropped []string
+
+	for key := range annotations {
+		if hasKnativePrefix(key) {
+			dropped = append(dropped, key)
+			delete(annotations, key)
+		}
+	}
+
+	sort.Strings(dropped)
+
+	for _, key := range dropped {
+		changes = append(changes, "removed Serverless-only annotation "+key)
+	}
+
+	if _, ok := annotations[annotationLastApplied]; ok {
+		delete(annotations, annotationLastApplied)
+		changes = append(changes, "removed annotation "+annotationLastApplied+" (it still declares the Serverless mode)")
+	}
+
+	// Serverless InferenceServices get an external route unless marked cluster-local, while
+	// RawDeployment ones are only exposed when labeled so.
+	if labels[LabelKnativeVisibility] == visibilityClusterLocal {
+		delete(labels, LabelKnativeVisibility)
+		changes = append(changes, fmt.Sprintf("removed label %s=%s (RawDeployment is cluster-local by default)",
+			LabelKnativeVisibility, visibilityClusterLocal))
+	} else if labels[LabelKServeVisibility] != visibilityExposed {
+		delete(labels, LabelKnativeVisibility)
+		labels[LabelKServeVisibility] = visibilityExposed
+		changes = append(changes, fmt.Sprintf("set label %s=%s to keep the external route", LabelKServeVisibility, visibilityExposed))
+	}
+
+	if _, found, _ := unstructured.NestedFieldNoCopy(out.Object, "spec", "predictor", "canaryTrafficPercent"); found {
+		unstructured.RemoveNestedField(out.Object, "spec", "predictor", "canaryTrafficPercent")
+		changes = append(changes, "removed .spec.predictor.canaryTrafficPercent (canary rollouts require Serverless)")
+	}
+
+	out.SetAnnotations(annotations)
+	out.SetLabels(labels)
+
+	return out, changes, nil
+}
+
+// moveScaleAnnotation sets a predictor replica field from the first Knative scale annotation
+// present, unless the field is already set.
+func moveScaleAnnotation(
+	out *unstructured.Unstructured,
+	annotations map[string]string,
+	keys []string,
+	field string,
+) (string, error) {
+	for _, key := range keys {
+		value, ok := annotations[key]
+		if !ok {
+			continue
+		}
+
+		if _, found, _ := unstructured.NestedFieldNoCopy(out.Object, "spec", "predictor", field); found {
+			return "", nil
+		}
+
+		replicas, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return "", fmt.Errorf("invalid %s annotation %q: %w", key, value, err)
+		}
+
+		if err := unstructured.SetNestedField(out.Object, replicas, "spec", "predictor", field); err != nil {
+			return "", fmt.Errorf("setting .spec.predictor.%s: %w", field, err)
+		}
+
+		return fmt.Sprintf("set .spec.predictor.%s=%d from annotation %s", field, replicas, key), nil
+	}
+
+	return "", nil
+}
+
+func hasKnativePrefix(key string) bool {
+	for _, prefix := range knativeAnnotationPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// PredictorPodSelector returns the label selector of the predictor pods of an InferenceService.
+func PredictorPodSelector(name string) string {
+	return fmt.Sprintf("%s=%s,%s=%s", LabelInferenceService, name, LabelComponent, componentPredictor)
+}
+
+// PodsReady reports whether there is at least one running predictor pod and all running
+// (non-terminating) pods are Ready.
+func PodsReady(pods []*unstructured.Unstructured) bool {
+	running := 0
+
+	for _, pod := range pods {
+		if pod.GetDeletionTimestamp() != nil {
+			continue
+		}
+
+		running++
+
+		if !podReady(pod) {
+			return false
+		}
+	}
+
+	return running > 0
+}
+
+func podReady(pod *unstructured.Unstructured) bool {
+	conditions, _, _ := unstructured.NestedSlice(pod.Object, "status", "conditions")
+
+	for _, c := range conditions {
+		condition, ok := c.(map[string]any)
+		if ok && condition["type"] == "Ready" {
+			return condition["status"] == "True"
+		}
+	}
+
+	return false
+}
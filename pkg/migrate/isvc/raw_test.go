@@ -0,0 +1,120 @@
+package isvc_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/isvc"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestConvertToRaw(t *testing.T) {
+	g := NewWithT(t)
+
+	obj := newInferenceService("model", isvc.DeploymentModeServerless, "https://model-project.apps.example.com")
+	obj.SetUID("uid-1")
+	obj.SetResourceVersion("42")
+	obj.SetAnnotations(map[string]string{
+		isvc.AnnotationDeploymentMode:                      isvc.DeploymentModeServerless,
+		"autoscaling.knative.dev/min-scale":                "2",
+		"autoscaling.knative.dev/target":                   "10",
+		"serving.knative.openshift.io/enablePassthrough":   "true",
+		"sidecar.istio.io/inject":                          "true",
+		"security.opendatahub.io/enable-auth":              "true",
+		"kubectl.kubernetes.io/last-applied-configuration": "{}",
+	})
+	obj.SetLabels(map[string]string{"opendatahub.io/dashboard": "true"})
+	obj.Object["spec"] = map[string]any{
+		"predictor": map[string]any{
+			"maxReplicas":          int64(4),
+			"canaryTrafficPercent": int64(10),
+			"model":                map[string]any{"modelFormat": map[string]any{"name": "onnx"}},
+		},
+	}
+
+	out, changes, err := isvc.ConvertToRaw(obj)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(out.GetName()).To(Equal("model"))
+	g.Expect(out.GetNamespace()).To(Equal("project"))
+	g.Expect(out.GetUID()).To(BeEmpty())
+	g.Expect(out.GetResourceVersion()).To(BeEmpty())
+	g.Expect(out.Object).ToNot(HaveKey("status"))
+
+	g.Expect(out.GetAnnotations()).To(Equal(map[string]string{
+		isvc.AnnotationDeploymentMode:         isvc.DeploymentModeRawDeployment,
+		"security.opendatahub.io/enable-auth": "true",
+	}))
+	g.Expect(out.GetLabels()).To(Equal(map[string]string{
+		"opendatahub.io/dashboard": "true",
+		isvc.LabelKServeVisibility: "exposed",
+	}))
+
+	predictor, _, _ := unstructured.NestedMap(out.Object, "spec", "predictor")
+	g.Expect(predictor).To(HaveKeyWithValue("minReplicas", int64(2)))
+	g.Expect(predictor).To(HaveKeyWithValue("maxReplicas", int64(4)))
+	g.Expect(predictor).ToNot(HaveKey("canaryTrafficPercent"))
+
+	g.Expect(changes).To(ContainElements(
+		"set .spec.predictor.minReplicas=2 from annotation autoscaling.knative.dev/min-scale",
+		"removed Serverless-only annotation autoscaling.knative.dev/target",
+		"removed Serverless-only annotation sidecar.istio.io/inject",
+	))
+
+	// The input is not modified
+	g.Expect(isvc.DeploymentMode(obj)).To(Equal(isvc.DeploymentModeServerless))
+	g.Expect(obj.Object["spec"]).To(HaveKeyWithValue("predictor", HaveKey("canaryTrafficPercent")))
+}
+
+func TestConvertToRaw_ClusterLocal(t *testing.T) {
+	g := NewWithT(t)
+
+	obj := newInferenceService("model", isvc.DeploymentModeServerless, "")
+	obj.SetLabels(map[string]string{isvc.LabelKnativeVisibility: "cluster-local"})
+	obj.Object["spec"] = map[string]any{"predictor": map[string]any{}}
+
+	out, _, err := isvc.ConvertToRaw(obj)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(out.GetLabels()).To(BeEmpty())
+}
+
+func TestConvertToRaw_InvalidScale(t *testing.T) {
+	g := NewWithT(t)
+
+	obj := newInferenceService("model", isvc.DeploymentModeServerless, "")
+	obj.SetAnnotations(map[string]string{"autoscaling.knative.dev/maxScale": "many"})
+	obj.Object["spec"] = map[string]any{"predictor": map[string]any{}}
+
+	_, _, err := isvc.ConvertToRaw(obj)
+	g.Expect(err).To(MatchError(ContainSubstring(`invalid autoscaling.knative.dev/maxScale annotation "many"`)))
+}
+
+func newPod(ready string, terminating bool) *unstructured.Unstructured {
+	pod := resources.Pod.Unstructured()
+	pod.SetName("model-predictor")
+
+	if terminating {
+		now := metav1.Now()
+		pod.SetDeletionTimestamp(&now)
+	}
+
+	pod.Object["status"] = map[string]any{
+		"conditions": []any{map[string]any{"type": "Ready", "status": ready}},
+	}
+
+	return &pod
+}
+
+func TestPodsReady(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(isvc.PodsReady(nil)).To(BeFalse())
+	g.Expect(isvc.PodsReady([]*unstructured.Unstructured{newPod("True", false)})).To(BeTrue())
+	g.Expect(isvc.PodsReady([]*unstructured.Unstructured{newPod("True", false), newPod("False", false)})).To(BeFalse())
+	g.Expect(isvc.PodsReady([]*unstructured.Unstructured{newPod("True", false), newPod("False", true)})).To(BeTrue())
+	g.Expect(isvc.PodsReady([]*unstructured.Unstructured{newPod("False", true)})).To(BeFalse())
+}
@@ -0,0 +1,174 @@
+// Package isvc provides helpers for migrating KServe InferenceServices from the
+// Serverless to the RawDeployment mode.
+package isvc
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube"
+)
+
+const (
+	// AnnotationDeploymentMode selects the KServe deployment mode of an InferenceService.
+	AnnotationDeploymentMode = "serving.kserve.io/deploymentMode"
+
+	// DeploymentModeServerless is the Knative-based deployment mode.
+	DeploymentModeServerless = "Serverless"
+
+	// DeploymentModeRawDeployment is the plain Deployment-based deployment mode.
+	DeploymentModeRawDeployment = "RawDeployment"
+
+	// DeploymentModeModelMesh is the multi-model ModelMesh serving mode, removed in 3.x.
+	DeploymentModeModelMesh = "ModelMesh"
+
+	// LabelShadowFrom and LabelShadowTo record the InferenceServices a shadow route mirrors between.
+	LabelShadowFrom = "opendatahub.io/shadow-from"
+	LabelShadowTo   = "opendatahub.io/shadow-to"
+
+	// LabelManagedBy marks resources created by the CLI.
+	LabelManagedBy = "app.kubernetes.io/managed-by"
+
+	// ManagedByValue is the LabelManagedBy value for resources created by the CLI.
+	ManagedByValue = "odh-cli"
+
+	// predictorServicePort is the HTTP port of the KServe predictor Service.
+	predictorServicePort = 80
+)
+
+// ShadowRouteOptions configures the HTTPRoute that mirrors traffic between two InferenceServices.
+type ShadowRouteOptions struct {
+	// From is the serving InferenceService (Serverless) whose traffic is mirrored.
+	From *unstructured.Unstructured
+
+	// To is the RawDeployment InferenceService that receives the mirrored traffic.
+	To *unstructured.Unstructured
+
+	// GatewayNamespace and GatewayName identify the Gateway the route attaches to.
+	GatewayNamespace string
+	GatewayName      string
+
+	// Percent is the share of requests mirrored to To (1-100).
+	Percent int32
+}
+
+// DeploymentMode returns the deployment mode annotation of an InferenceService, or empty if unset.
+func DeploymentMode(isvc *unstructured.Unstructured) string {
+	return kube.GetAnnotation(isvc, AnnotationDeploymentMode)
+}
+
+// ShadowRouteName returns the name of the shadow HTTPRoute for an InferenceService.
+func ShadowRouteName(from string) string {
+	return from + "-shadow"
+}
+
+// PredictorServiceName returns the name of the predictor Service KServe creates for an InferenceService.
+func PredictorServiceName(isvc string) string {
+	return isvc + "-predictor"
+}
+
+// URL returns the URL reported in the InferenceService status.
+func URL(isvc *unstructured.Unstructured) (string, error) {
+	value, err := jq.Query[string](isvc, ".status.url")
+	if err != nil {
+		if errors.Is(err, jq.ErrNotFound) {
+			return "", fmt.Errorf("InferenceService %s has no status.url (is it ready?)", isvc.GetName())
+		}
+
+		return "", fmt.Errorf("querying status.url of %s: %w", isvc.GetName(), err)
+	}
+
+	return value, nil
+}
+
+// ValidateShadowPair checks that traffic can be shadowed from a Serverless to a RawDeployment InferenceService.
+func ValidateShadowPair(from *unstructured.Unstructured, to *unstructured.Unstructured) error {
+	if from.GetNamespace() != to.GetNamespace() {
+		return fmt.Errorf("InferenceServices %s and %s must be in the same namespace", from.GetName(), to.GetName())
+	}
+
+	if from.GetName() == to.GetName() {
+		return errors.New("--from and --to must be different InferenceServices")
+	}
+
+	if DeploymentMode(from) == DeploymentModeRawDeployment {
+		return fmt.Errorf("InferenceService %s already uses %s: expected the serverless source", from.GetName(), DeploymentModeRawDeployment)
+	}
+
+	if mode := DeploymentMode(to); mode != DeploymentModeRawDeployment {
+		return fmt.Errorf("InferenceService %s must use %s=%s, got %q", to.GetName(), AnnotationDeploymentMode, DeploymentModeRawDeployment, mode)
+	}
+
+	return nil
+}
+
+// NewShadowRoute builds an HTTPRoute that serves the From hostname from its predictor and
+// mirrors the configured share of requests to the To predictor. Mirrored responses are
+// discarded by the gateway, so clients only ever see responses from From.
+func NewShadowRoute(opts ShadowRouteOptions) (*unstructured.Unstructured, error) {
+	if opts.Percent < 1 || opts.Percent > 100 {
+		return nil, fmt.Errorf("mirror percent must be between 1 and 100, got %d", opts.Percent)
+	}
+
+	fromURL, err := URL(opts.From)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := url.Parse(fromURL)
+	if err != nil {
+		return nil, fmt.Errorf("parsing URL of %s: %w", opts.From.GetName(), err)
+	}
+
+	if parsed.Hostname() == "" {
+		return nil, fmt.Errorf("URL %q of %s has no host", fromURL, opts.From.GetName())
+	}
+
+	route := resources.HTTPRoute.Unstructured()
+	route.SetName(ShadowRouteName(opts.From.GetName()))
+	route.SetNamespace(opts.From.GetNamespace())
+	route.SetLabels(map[string]string{
+		LabelManagedBy:  ManagedByValue,
+		LabelShadowFrom: opts.From.GetName(),
+		LabelShadowTo:   opts.To.GetName(),
+	})
+
+	route.Object["spec"] = map[string]any{
+		"parentRefs": []any{
+			map[string]any{
+				"name":      opts.GatewayName,
+				"namespace": opts.GatewayNamespace,
+			},
+		},
+		"hostnames": []any{parsed.Hostname()},
+		"rules": []any{
+			map[string]any{
+				"backendRefs": []any{
+					map[string]any{
+						"name": PredictorServiceName(opts.From.GetName()),
+						"port": int64(predictorServicePort),
+					},
+				},
+				"filters": []any{
+					map[string]any{
+						"type": "RequestMirror",
+						"requestMirror": map[string]any{
+							"backendRef": map[string]any{
+								"name": PredictorServiceName(opts.To.GetName()),
+								"port": int64(predictorServicePort),
+							},
+							"percent": int64(opts.Percent),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return &route, nil
+}
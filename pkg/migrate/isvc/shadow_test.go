@@ -0,0 +1,128 @@
+package isvc_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/isvc"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+)
+
+func newInferenceService(name string, mode string, url string) *unstructured.Unstructured {
+	obj := resources.InferenceService.Unstructured()
+	obj.SetName(name)
+	obj.SetNamespace("project")
+
+	if mode != "" {
+		obj.SetAnnotations(map[string]string{isvc.AnnotationDeploymentMode: mode})
+	}
+
+	if url != "" {
+		obj.Object["status"] = map[string]any{"url": url}
+	}
+
+	return &obj
+}
+
+func TestValidateShadowPair(t *testing.T) {
+	serverless := newInferenceService("model", isvc.DeploymentModeServerless, "")
+	raw := newInferenceService("model-raw", isvc.DeploymentModeRawDeployment, "")
+
+	tests := []struct {
+		name    string
+		from    *unstructured.Unstructured
+		to      *unstructured.Unstructured
+		wantErr string
+	}{
+		{name: "serverless to raw", from: serverless, to: raw},
+		{name: "unannotated source", from: newInferenceService("model", "", ""), to: raw},
+		{name: "raw source", from: raw, to: newInferenceService("other", isvc.DeploymentModeRawDeployment, ""), wantErr: "expected the serverless source"},
+		{name: "serverless target", from: serverless, to: newInferenceService("other", isvc.DeploymentModeServerless, ""), wantErr: "must use"},
+		{name: "same service", from: raw, to: raw, wantErr: "must be different"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			err := isvc.ValidateShadowPair(tt.from, tt.to)
+			if tt.wantErr == "" {
+				g.Expect(err).ToNot(HaveOccurred())
+
+				return
+			}
+
+			g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+		})
+	}
+}
+
+func TestNewShadowRoute(t *testing.T) {
+	g := NewWithT(t)
+
+	route, err := isvc.NewShadowRoute(isvc.ShadowRouteOptions{
+		From:             newInferenceService("model", isvc.DeploymentModeServerless, "https://model-project.apps.example.com"),
+		To:               newInferenceService("model-raw", isvc.DeploymentModeRawDeployment, ""),
+		GatewayNamespace: "openshift-ingress",
+		GatewayName:      "data-science-gateway",
+		Percent:          50,
+	})
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(route.GetName()).To(Equal("model-shadow"))
+	g.Expect(route.GetNamespace()).To(Equal("project"))
+	g.Expect(route.GetLabels()).To(HaveKeyWithValue(isvc.LabelShadowTo, "model-raw"))
+
+	hostnames, _, _ := unstructured.NestedStringSlice(route.Object, "spec", "hostnames")
+	g.Expect(hostnames).To(Equal([]string{"model-project.apps.example.com"}))
+
+	rules, _, _ := unstructured.NestedSlice(route.Object, "spec", "rules")
+	g.Expect(rules).To(HaveLen(1))
+
+	filters, _, _ := unstructured.NestedSlice(rules[0].(map[string]any), "filters")
+	mirror, _, _ := unstructured.NestedMap(filters[0].(map[string]any), "requestMirror")
+	g.Expect(mirror).To(HaveKeyWithValue("percent", int64(50)))
+	g.Expect(mirror).To(HaveKeyWithValue("backendRef", HaveKeyWithValue("name", "model-raw-predictor")))
+}
+
+func TestNewShadowRoute_NotReady(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := isvc.NewShadowRoute(isvc.ShadowRouteOptions{
+		From:    newInferenceService("model", "", ""),
+		To:      newInferenceService("model-raw", isvc.DeploymentModeRawDeployment, ""),
+		Percent: 100,
+	})
+
+	g.Expect(err).To(MatchError(ContainSubstring("has no status.url")))
+}
+
+func TestProbe(t *testing.T) {
+	g := NewWithT(t)
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		if r.Method != http.MethodPost || requests%2 == 0 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	res := isvc.Probe(t.Context(), server.Client(), server.URL, []byte(`{"instances":[]}`), 4)
+
+	g.Expect(res.Requests).To(Equal(4))
+	g.Expect(res.Succeeded).To(Equal(2))
+	g.Expect(res.SuccessRate()).To(Equal(50.0))
+	g.Expect(res.LastError).To(ContainSubstring("503"))
+}
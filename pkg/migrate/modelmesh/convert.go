@@ -0,0 +1,218 @@
+package modelmesh
+
+import (
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/isvc"
+)
+
+const (
+	// AnnotationEnableRoute and AnnotationEnableAuth are set on ModelMesh ServingRuntimes to
+	// expose their models with a Route and protect them with authentication.
+	AnnotationEnableRoute = "enable-route"
+	AnnotationEnableAuth  = "enable-auth"
+
+	// AnnotationKServeEnableAuth protects a KServe InferenceService with authentication.
+	AnnotationKServeEnableAuth = "security.opendatahub.io/enable-auth"
+
+	// RawRuntimeSuffix is appended to the name of a ModelMesh ServingRuntime to name its
+	// single-model copy.
+	RawRuntimeSuffix = "-raw"
+
+	annotationLastApplied = "kubectl.kubernetes.io/last-applied-configuration"
+)
+
+// modelMeshRuntimeFields are the ServingRuntime spec fields only used by the ModelMesh adapter.
+var modelMeshRuntimeFields = []string{"grpcEndpoint", "grpcDataEndpoint", "builtInAdapter"}
+
+// IsModelMesh reports whether an InferenceService is annotated for the ModelMesh mode.
+func IsModelMesh(obj *unstructured.Unstructured) bool {
+	return isvc.DeploymentMode(obj) == isvc.DeploymentModeModelMesh
+}
+
+// IsMultiModel reports whether a ServingRuntime serves ModelMesh models.
+func IsMultiModel(sr *unstructured.Unstructured) bool {
+	multiModel, _, _ := unstructured.NestedBool(sr.Object, "spec", "multiModel")
+
+	return multiModel
+}
+
+// RawRuntimeName returns the name of the single-model copy of a ModelMesh ServingRuntime.
+func RawRuntimeName(name string) string {
+	return name + RawRuntimeSuffix
+}
+
+// SelectRuntime returns the ModelMesh ServingRuntime serving an InferenceService: the one named
+// by .spec.predictor.model.runtime, or else the first multi-model runtime of its namespace
+// (by name) auto-selecting its model format, as ModelMesh does.
+func SelectRuntime(obj *unstructured.Unstructured, runtimes []*unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	name, _, _ := unstructured.NestedString(obj.Object, "spec", "predictor", "model", "runtime")
+	format, _, _ := unstructured.NestedString(obj.Object, "spec", "predictor", "model", "modelFormat", "name")
+
+	candidates := make([]*unstructured.Unstructured, 0, len(runtimes))
+	for _, r := range runtimes {
+		if r.GetNamespace() == obj.GetNamespace() && IsMultiModel(r) {
+			candidates = append(candidates, r)
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].GetName() < candidates[j].GetName()
+	})
+
+	if name != "" {
+		for _, r := range candidates {
+			if r.GetName() == name {
+				return r, nil
+			}
+		}
+
+		return nil, fmt.Errorf("InferenceService %s/%s references ServingRuntime %q, which is not a ModelMesh runtime of its namespace",
+			obj.GetNamespace(), obj.GetName(), name)
+	}
+
+	for _, r := range candidates {
+		if autoSelects(r, format) {
+			return r, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no ModelMesh ServingRuntime in namespace %s auto-selects model format %q of InferenceService %s",
+		obj.GetNamespace(), format, obj.GetName())
+}
+
+func autoSelects(sr *unstructured.Unstructured, format string) bool {
+	formats, _, _ := unstructured.NestedSlice(sr.Object, "spec", "supportedModelFormats")
+
+	for _, f := range formats {
+		supported, ok := f.(map[string]any)
+		if ok && supported["name"] == format && supported["autoSelect"] == true {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ConvertRuntime returns the single-model copy of a ModelMesh ServingRuntime, named with
+// RawRuntimeName, along with a description of each change. The input object is not modified.
+func ConvertRuntime(sr *unstructured.Unstructured) (*unstructured.Unstructured, []string, error) {
+	spec, ok := sr.Object["spec"].(map[string]any)
+	if !ok {
+		return nil, nil, fmt.Errorf("ServingRuntime %s/%s has no spec", sr.GetNamespace(), sr.GetName())
+	}
+
+	out := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": sr.GetAPIVersion(),
+		"kind":       sr.GetKind(),
+		"spec":       runtime.DeepCopyJSONValue(spec),
+	}}
+	out.SetName(RawRuntimeName(sr.GetName()))
+	out.SetNamespace(sr.GetNamespace())
+
+	changes := []string{
+		fmt.Sprintf("copied ServingRuntime %s to %s", sr.GetName(), out.GetName()),
+		"set .spec.multiModel=false",
+	}
+
+	if err := unstructured.SetNestedField(out.Object, false, "spec", "multiModel"); err != nil {
+		return nil, nil, fmt.Errorf("setting .spec.multiModel: %w", err)
+	}
+
+	for _, field := range modelMeshRuntimeFields {
+		if _, found := spec[field]; found {
+			unstructured.RemoveNestedField(out.Object, "spec", field)
+			changes = append(changes, fmt.Sprintf("removed .spec.%s (ModelMesh adapter only)", field))
+		}
+	}
+
+	annotations := make(map[string]string, len(sr.GetAnnotations()))
+	for key, value := range sr.GetAnnotations() {
+		switch key {
+		case AnnotationEnableRoute, AnnotationEnableAuth, annotationLastApplied:
+			continue
+		default:
+			annotations[key] = value
+		}
+	}
+
+	labels := make(map[string]string, len(sr.GetLabels())+1)
+	for key, value := range sr.GetLabels() {
+		labels[key] = value
+	}
+
+	labels[isvc.LabelManagedBy] = isvc.ManagedByValue
+
+	out.SetAnnotations(annotations)
+	out.SetLabels(labels)
+
+	// The containers of ModelMesh runtimes load models through the adapter; single-model
+	// runtimes get them mounted at /mnt/models, which the server arguments may need to point to.
+	changes = append(changes, "kept .spec.containers unchanged: review the server arguments for single-model serving from /mnt/models")
+
+	return out, changes, nil
+}
+
+// ConvertInferenceService returns the RawDeployment replacement of a ModelMesh InferenceService
+// served by the ServingRuntime sr, ready to be created once the original is deleted, along with a
+// description of each change. The route and authentication settings of the runtime are carried
+// over to the InferenceService. The input objects are not modified.
+func ConvertInferenceService(obj *unstructured.Unstructured, sr *unstructured.Unstructured) (*unstructured.Unstructured, []string, error) {
+	spec, ok := obj.Object["spec"].(map[string]any)
+	if !ok {
+		return nil, nil, fmt.Errorf("InferenceService %s/%s has no spec", obj.GetNamespace(), obj.GetName())
+	}
+
+	out := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": obj.GetAPIVersion(),
+		"kind":       obj.GetKind(),
+		"spec":       runtime.DeepCopyJSONValue(spec),
+	}}
+	out.SetName(obj.GetName())
+	out.SetNamespace(obj.GetNamespace())
+	out.SetOwnerReferences(obj.GetOwnerReferences())
+
+	annotations := make(map[string]string, len(obj.GetAnnotations()))
+	for key, value := range obj.GetAnnotations() {
+		annotations[key] = value
+	}
+
+	labels := make(map[string]string, len(obj.GetLabels()))
+	for key, value := range obj.GetLabels() {
+		labels[key] = value
+	}
+
+	annotations[isvc.AnnotationDeploymentMode] = isvc.DeploymentModeRawDeployment
+	changes := []string{fmt.Sprintf("set annotation %s=%s", isvc.AnnotationDeploymentMode, isvc.DeploymentModeRawDeployment)}
+
+	if _, ok := annotations[annotationLastApplied]; ok {
+		delete(annotations, annotationLastApplied)
+		changes = append(changes, "removed annotation "+annotationLastApplied+" (it still declares the ModelMesh mode)")
+	}
+
+	rawRuntime := RawRuntimeName(sr.GetName())
+	if err := unstructured.SetNestedField(out.Object, rawRuntime, "spec", "predictor", "model", "runtime"); err != nil {
+		return nil, nil, fmt.Errorf("setting .spec.predictor.model.runtime: %w", err)
+	}
+
+	changes = append(changes, "set .spec.predictor.model.runtime="+rawRuntime)
+
+	if sr.GetAnnotations()[AnnotationEnableRoute] == "true" {
+		labels[isvc.LabelKServeVisibility] = "exposed"
+		changes = append(changes, fmt.Sprintf("set label %s=exposed from ServingRuntime annotation %s", isvc.LabelKServeVisibility, AnnotationEnableRoute))
+	}
+
+	if sr.GetAnnotations()[AnnotationEnableAuth] == "true" {
+		annotations[AnnotationKServeEnableAuth] = "true"
+		changes = append(changes, fmt.Sprintf("set annotation %s=true from ServingRuntime annotation %s", AnnotationKServeEnableAuth, AnnotationEnableAuth))
+	}
+
+	out.SetAnnotations(annotations)
+	out.SetLabels(labels)
+
+	return out, changes, nil
+}
@@ -0,0 +1,152 @@
+package modelmesh_test
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/isvc"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/modelmesh"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+)
+
+func newRuntime(namespace string, name string, multiModel bool, formats ...string) *unstructured.Unstructured {
+	obj := resources.ServingRuntime.Unstructured()
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+
+	supported := make([]any, 0, len(formats))
+	for _, f := range formats {
+		supported = append(supported, map[string]any{"name": f, "autoSelect": true})
+	}
+
+	obj.Object["spec"] = map[string]any{
+		"multiModel":            multiModel,
+		"supportedModelFormats": supported,
+	}
+
+	return &obj
+}
+
+func newInferenceService(namespace string, name string, runtime string, format string) *unstructured.Unstructured {
+	obj := resources.InferenceService.Unstructured()
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	obj.SetAnnotations(map[string]string{isvc.AnnotationDeploymentMode: isvc.DeploymentModeModelMesh})
+
+	model := map[string]any{"modelFormat": map[string]any{"name": format}}
+	if runtime != "" {
+		model["runtime"] = runtime
+	}
+
+	obj.Object["spec"] = map[string]any{"predictor": map[string]any{"model": model}}
+
+	return &obj
+}
+
+func TestSelectRuntime(t *testing.T) {
+	g := NewWithT(t)
+
+	runtimes := []*unstructured.Unstructured{
+		newRuntime("project", "ovms", true, "openvino_ir", "onnx"),
+		newRuntime("project", "a-single", false, "onnx"),
+		newRuntime("other", "a-triton", true, "onnx"),
+		newRuntime("project", "triton", true, "onnx"),
+	}
+
+	selected, err := modelmesh.SelectRuntime(newInferenceService("project", "model", "triton", "onnx"), runtimes)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(selected.GetName()).To(Equal("triton"))
+
+	// Auto-selection only considers multi-model runtimes of the namespace, by name
+	selected, err = modelmesh.SelectRuntime(newInferenceService("project", "model", "", "onnx"), runtimes)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(selected.GetName()).To(Equal("ovms"))
+
+	_, err = modelmesh.SelectRuntime(newInferenceService("project", "model", "a-single", "onnx"), runtimes)
+	g.Expect(err).To(MatchError(ContainSubstring(`references ServingRuntime "a-single", which is not a ModelMesh runtime`)))
+
+	_, err = modelmesh.SelectRuntime(newInferenceService("project", "model", "", "sklearn"), runtimes)
+	g.Expect(err).To(MatchError(ContainSubstring(`auto-selects model format "sklearn"`)))
+}
+
+func TestConvertRuntime(t *testing.T) {
+	g := NewWithT(t)
+
+	runtime := newRuntime("project", "ovms", true, "onnx")
+	runtime.SetAnnotations(map[string]string{
+		modelmesh.AnnotationEnableRoute:  "true",
+		"opendatahub.io/template-name":   "ovms",
+		"openshift.io/display-name":      "OVMS",
+		modelmesh.AnnotationEnableAuth:   "true",
+		"kubectl.kubernetes.io/whatever": "kept",
+	})
+	runtime.Object["spec"].(map[string]any)["grpcEndpoint"] = "port:8085"
+	runtime.Object["spec"].(map[string]any)["builtInAdapter"] = map[string]any{"serverType": "ovms"}
+
+	out, changes, err := modelmesh.ConvertRuntime(runtime)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(out.GetName()).To(Equal("ovms-raw"))
+	g.Expect(out.GetNamespace()).To(Equal("project"))
+	g.Expect(out.GetLabels()).To(HaveKeyWithValue(isvc.LabelManagedBy, isvc.ManagedByValue))
+	g.Expect(out.GetAnnotations()).To(Equal(map[string]string{
+		"opendatahub.io/template-name":   "ovms",
+		"openshift.io/display-name":      "OVMS",
+		"kubectl.kubernetes.io/whatever": "kept",
+	}))
+	g.Expect(modelmesh.IsMultiModel(out)).To(BeFalse())
+	g.Expect(out.Object["spec"]).ToNot(HaveKey("grpcEndpoint"))
+	g.Expect(out.Object["spec"]).ToNot(HaveKey("builtInAdapter"))
+	g.Expect(out.Object["spec"]).To(HaveKey("supportedModelFormats"))
+
+	g.Expect(changes).To(ContainElements(
+		"set .spec.multiModel=false",
+		"removed .spec.grpcEndpoint (ModelMesh adapter only)",
+		"removed .spec.builtInAdapter (ModelMesh adapter only)",
+	))
+
+	// The input is not modified
+	g.Expect(modelmesh.IsMultiModel(runtime)).To(BeTrue())
+	g.Expect(runtime.Object["spec"]).To(HaveKey("builtInAdapter"))
+}
+
+func TestConvertInferenceService(t *testing.T) {
+	g := NewWithT(t)
+
+	runtime := newRuntime("project", "ovms", true, "onnx")
+	runtime.SetAnnotations(map[string]string{
+		modelmesh.AnnotationEnableRoute: "true",
+		modelmesh.AnnotationEnableAuth:  "true",
+	})
+
+	obj := newInferenceService("project", "model", "", "onnx")
+	obj.SetUID("uid-1")
+	obj.SetLabels(map[string]string{"opendatahub.io/dashboard": "true"})
+	g.Expect(unstructured.SetNestedMap(obj.Object, map[string]any{"key": "aws-connection-s3", "path": "models/onnx"},
+		"spec", "predictor", "model", "storage")).To(Succeed())
+
+	out, changes, err := modelmesh.ConvertInferenceService(obj, runtime)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(out.GetUID()).To(BeEmpty())
+	g.Expect(modelmesh.IsModelMesh(out)).To(BeFalse())
+	g.Expect(isvc.DeploymentMode(out)).To(Equal(isvc.DeploymentModeRawDeployment))
+	g.Expect(out.GetAnnotations()).To(HaveKeyWithValue(modelmesh.AnnotationKServeEnableAuth, "true"))
+	g.Expect(out.GetLabels()).To(Equal(map[string]string{
+		"opendatahub.io/dashboard": "true",
+		isvc.LabelKServeVisibility: "exposed",
+	}))
+
+	model, _, _ := unstructured.NestedMap(out.Object, "spec", "predictor", "model")
+	g.Expect(model).To(HaveKeyWithValue("runtime", "ovms-raw"))
+	g.Expect(model).To(HaveKeyWithValue("storage", HaveKeyWithValue("path", "models/onnx")))
+
+	g.Expect(changes).To(ContainElement("set .spec.predictor.model.runtime=ovms-raw"))
+
+	// The input is not modified
+	g.Expect(modelmesh.IsModelMesh(obj)).To(BeTrue())
+	g.Expect(obj.Object["spec"]).To(HaveKeyWithValue("predictor", HaveKeyWithValue("model", Not(HaveKey("runtime")))))
+}
@@ -0,0 +1,175 @@
+package ray
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+)
+
+const (
+	// LabelCluster is the label KubeRay sets on the pods and services of a RayCluster.
+	LabelCluster = "ray.io/cluster"
+
+	// LabelNodeType is the label KubeRay sets on RayCluster pods to their node type.
+	LabelNodeType = "ray.io/node-type"
+
+	// NodeTypeHead is the LabelNodeType value of head pods.
+	NodeTypeHead = "head"
+
+	// AnnotationServingCertSecret names the Secret the OpenShift service CA operator writes
+	// the serving certificate of a Service to, and regenerates when it is deleted.
+	AnnotationServingCertSecret = "service.beta.openshift.io/serving-cert-secret-name"
+
+	// annotationServingCertSecretAlpha is the deprecated form of AnnotationServingCertSecret.
+	annotationServingCertSecretAlpha = "service.alpha.openshift.io/serving-cert-secret-name"
+
+	// secretPollInterval is the interval at which regenerated Secrets are polled.
+	secretPollInterval = 5 * time.Second
+)
+
+// CertRefresh is the refresh of the oauth-proxy serving certificates of a RayCluster: its
+// serving certificate Secrets are deleted for the service CA operator to regenerate, then its
+// head pods are restarted to load them.
+type CertRefresh struct {
+	Cluster *unstructured.Unstructured
+
+	// Secrets are the serving certificate Secrets of the cluster's Services, sorted.
+	Secrets []string
+
+	// HeadPods are the head pods of the cluster, sorted.
+	HeadPods []string
+}
+
+// String summarizes the refresh for dry-run output.
+func (r *CertRefresh) String() string {
+	return fmt.Sprintf("RayCluster %s/%s: delete %d serving certificate Secret(s) %v, restart %d head pod(s) %v",
+		r.Cluster.GetNamespace(), r.Cluster.GetName(), len(r.Secrets), r.Secrets, len(r.HeadPods), r.HeadPods)
+}
+
+// PlanCertRefresh returns the refresh of a RayCluster's serving certificates from the Services
+// and head pods labeled with its name.
+func PlanCertRefresh(ctx context.Context, c client.Reader, cluster *unstructured.Unstructured) (*CertRefresh, error) {
+	selector := LabelCluster + "=" + cluster.GetName()
+
+	services, err := c.List(ctx, resources.Service, client.WithNamespace(cluster.GetNamespace()), client.WithLabelSelector(selector))
+	if err != nil {
+		return nil, fmt.Errorf("listing services of RayCluster %s/%s: %w", cluster.GetNamespace(), cluster.GetName(), err)
+	}
+
+	pods, err := c.List(ctx, resources.Pod, client.WithNamespace(cluster.GetNamespace()),
+		client.WithLabelSelector(selector+","+LabelNodeType+"="+NodeTypeHead))
+	if err != nil {
+		return nil, fmt.Errorf("listing head pods of RayCluster %s/%s: %w", cluster.GetNamespace(), cluster.GetName(), err)
+	}
+
+	refresh := &CertRefresh{Cluster: cluster}
+
+	for _, svc := range services {
+		if name := ServingCertSecret(svc); name != "" && !slices.Contains(refresh.Secrets, name) {
+			refresh.Secrets = append(refresh.Secrets, name)
+		}
+	}
+
+	for _, pod := range pods {
+		refresh.HeadPods = append(refresh.HeadPods, pod.GetName())
+	}
+
+	slices.Sort(refresh.Secrets)
+	slices.Sort(refresh.HeadPods)
+
+	return refresh, nil
+}
+
+// ServingCertSecret returns the serving certificate Secret annotated on a Service, if any.
+func ServingCertSecret(svc *unstructured.Unstructured) string {
+	annotations := svc.GetAnnotations()
+	if name := annotations[AnnotationServingCertSecret]; name != "" {
+		return name
+	}
+
+	return annotations[annotationServingCertSecretAlpha]
+}
+
+// DeleteSecrets deletes the serving certificate Secrets of the refresh. Secrets already gone
+// are ignored.
+func (r *CertRefresh) DeleteSecrets(ctx context.Context, c client.Client) error {
+	secrets := c.Dynamic().Resource(resources.Secret.GVR()).Namespace(r.Cluster.GetNamespace())
+
+	for _, name := range r.Secrets {
+		if err := secrets.Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("deleting Secret %s/%s: %w", r.Cluster.GetNamespace(), name, err)
+		}
+	}
+
+	return nil
+}
+
+// WaitForSecrets waits until the service CA operator has regenerated the Secrets of the
+// refresh, polling every interval until ctx is done.
+func (r *CertRefresh) WaitForSecrets(ctx context.Context, c client.Client, interval time.Duration) error {
+	secrets := c.Dynamic().Resource(resources.Secret.GVR()).Namespace(r.Cluster.GetNamespace())
+
+	for _, name := range r.Secrets {
+		err := wait.PollUntilContextCancel(ctx, interval, true, func(ctx context.Context) (bool, error) {
+			_, err := secrets.Get(ctx, name, metav1.GetOptions{})
+			if apierrors.IsNotFound(err) {
+				return false, nil
+			}
+
+			return err == nil, err
+		})
+		if err != nil {
+			return fmt.Errorf("waiting for Secret %s/%s to be regenerated: %w", r.Cluster.GetNamespace(), name, err)
+		}
+	}
+
+	return nil
+}
+
+// RestartHeadPods deletes the head pods of the refresh for KubeRay to re-create them with the
+// regenerated certificates. Pods already gone are ignored.
+func (r *CertRefresh) RestartHeadPods(ctx context.Context, c client.Client) error {
+	pods := c.Dynamic().Resource(resources.Pod.GVR()).Namespace(r.Cluster.GetNamespace())
+
+	for _, name := range r.HeadPods {
+		if err := pods.Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("deleting head pod %s/%s: %w", r.Cluster.GetNamespace(), name, err)
+		}
+	}
+
+	return nil
+}
+
+// Apply deletes the serving certificate Secrets of the refresh, waits for them to be
+// regenerated and restarts the head pods.
+func (r *CertRefresh) Apply(ctx context.Context, c client.Client) error {
+	return r.ApplyWithProgress(ctx, c, func(string, ...any) {})
+}
+
+// ApplyWithProgress is Apply reporting each step to progress before it starts.
+func (r *CertRefresh) ApplyWithProgress(ctx context.Context, c client.Client, progress func(format string, args ...any)) error {
+	progress("Deleting %d serving certificate Secret(s)", len(r.Secrets))
+
+	if err := r.DeleteSecrets(ctx, c); err != nil {
+		return err
+	}
+
+	progress("Waiting for the regenerated Secrets")
+
+	if err := r.WaitForSecrets(ctx, c, secretPollInterval); err != nil {
+		return err
+	}
+
+	progress("Restarting %d head pod(s)", len(r.HeadPods))
+
+	return r.RestartHeadPods(ctx, c)
+}
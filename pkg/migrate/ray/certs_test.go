@@ -0,0 +1,116 @@
+package ray_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/ray"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+func newObject(rt resources.ResourceType, name string, labels map[string]string, annotations map[string]string) *unstructured.Unstructured {
+	obj := rt.Unstructured()
+	obj.SetNamespace("project")
+	obj.SetName(name)
+	obj.SetLabels(labels)
+	obj.SetAnnotations(annotations)
+
+	return &obj
+}
+
+func newCertObjects() []runtime.Object {
+	clusterLabels := map[string]string{ray.LabelCluster: "train"}
+	headLabels := map[string]string{ray.LabelCluster: "train", ray.LabelNodeType: ray.NodeTypeHead}
+	workerLabels := map[string]string{ray.LabelCluster: "train", ray.LabelNodeType: "worker"}
+
+	return []runtime.Object{
+		newObject(resources.Service, "train-head-svc", clusterLabels,
+			map[string]string{ray.AnnotationServingCertSecret: "train-proxy-tls"}),
+		newObject(resources.Service, "train-dashboard", clusterLabels,
+			map[string]string{"service.alpha.openshift.io/serving-cert-secret-name": "train-dashboard-tls"}),
+		newObject(resources.Service, "other-svc", map[string]string{ray.LabelCluster: "other"},
+			map[string]string{ray.AnnotationServingCertSecret: "other-proxy-tls"}),
+		newObject(resources.Secret, "train-proxy-tls", nil, nil),
+		newObject(resources.Secret, "train-dashboard-tls", nil, nil),
+		newObject(resources.Pod, "train-head-abcde", headLabels, nil),
+		newObject(resources.Pod, "train-worker-fghij", workerLabels, nil),
+	}
+}
+
+func newCertClient(objects ...runtime.Object) (client.Client, *dynamicfake.FakeDynamicClient) {
+	dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			resources.Service.GVR(): resources.Service.ListKind(),
+			resources.Secret.GVR():  resources.Secret.ListKind(),
+			resources.Pod.GVR():     resources.Pod.ListKind(),
+		}, objects...)
+
+	return client.NewForTesting(client.TestClientConfig{Dynamic: dynamic}), dynamic
+}
+
+func TestPlanCertRefresh(t *testing.T) {
+	g := NewWithT(t)
+
+	c, _ := newCertClient(newCertObjects()...)
+	cluster := newObject(resources.RayCluster, "train", nil, nil)
+
+	refresh, err := ray.PlanCertRefresh(t.Context(), c, cluster)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(refresh.Secrets).To(Equal([]string{"train-dashboard-tls", "train-proxy-tls"}))
+	g.Expect(refresh.HeadPods).To(Equal([]string{"train-head-abcde"}))
+	g.Expect(refresh.String()).To(Equal(
+		"RayCluster project/train: delete 2 serving certificate Secret(s) [train-dashboard-tls train-proxy-tls], restart 1 head pod(s) [train-head-abcde]"))
+}
+
+func TestCertRefresh_Apply(t *testing.T) {
+	g := NewWithT(t)
+
+	c, dynamic := newCertClient(newCertObjects()...)
+
+	// The service CA operator regenerates deleted Secrets: keep them to simulate it
+	var deletedSecrets []string
+
+	dynamic.PrependReactor("delete", "secrets", func(a k8stesting.Action) (bool, runtime.Object, error) {
+		deletedSecrets = append(deletedSecrets, a.(k8stesting.DeleteAction).GetName())
+
+		return true, nil, nil
+	})
+
+	refresh, err := ray.PlanCertRefresh(t.Context(), c, newObject(resources.RayCluster, "train", nil, nil))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(refresh.Apply(t.Context(), c)).To(Succeed())
+	g.Expect(deletedSecrets).To(Equal([]string{"train-dashboard-tls", "train-proxy-tls"}))
+
+	pods := dynamic.Resource(resources.Pod.GVR()).Namespace("project")
+
+	_, err = pods.Get(t.Context(), "train-head-abcde", metav1.GetOptions{})
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+	_, err = pods.Get(t.Context(), "train-worker-fghij", metav1.GetOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+}
+
+func TestCertRefresh_WaitForSecretsCancelled(t *testing.T) {
+	g := NewWithT(t)
+
+	c, _ := newCertClient()
+	refresh := &ray.CertRefresh{Cluster: newObject(resources.RayCluster, "train", nil, nil), Secrets: []string{"train-proxy-tls"}}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	err := refresh.WaitForSecrets(ctx, c, time.Millisecond)
+	g.Expect(err).To(MatchError(ContainSubstring("waiting for Secret project/train-proxy-tls to be regenerated")))
+}
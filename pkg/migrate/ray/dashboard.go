@@ -0,0 +1,134 @@
+package ray
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	// jobsPath is the Ray Jobs API endpoint listing submitted jobs.
+	jobsPath = "/api/jobs/"
+
+	// actorsPath is the Ray State API endpoint listing actors.
+	actorsPath = "/api/v0/actors"
+
+	jobStatusPending = "PENDING"
+	jobStatusRunning = "RUNNING"
+	actorStateAlive  = "ALIVE"
+)
+
+// Job is a Ray job as listed by the Jobs API.
+type Job struct {
+	SubmissionID string `json:"submission_id"`
+	Entrypoint   string `json:"entrypoint"`
+	Status       string `json:"status"`
+}
+
+// Activity describes the work running on a RayCluster, as reported by its dashboard.
+type Activity struct {
+	// ActiveJobs are the jobs in PENDING or RUNNING state.
+	ActiveJobs []Job
+
+	// AliveActors is the number of actors in ALIVE state.
+	AliveActors int
+}
+
+// Busy reports whether the cluster has active jobs or alive actors that a restart would lose.
+func (a *Activity) Busy() bool {
+	return len(a.ActiveJobs) > 0 || a.AliveActors > 0
+}
+
+// String summarizes the activity for confirmation prompts, e.g. "2 active jobs (train, eval), 3 alive actors".
+func (a *Activity) String() string {
+	if !a.Busy() {
+		return "no active jobs or alive actors"
+	}
+
+	ids := make([]string, 0, len(a.ActiveJobs))
+	for _, job := range a.ActiveJobs {
+		ids = append(ids, job.SubmissionID)
+	}
+
+	summary := fmt.Sprintf("%d active jobs", len(a.ActiveJobs))
+	if len(ids) > 0 {
+		summary += " (" + strings.Join(ids, ", ") + ")"
+	}
+
+	return fmt.Sprintf("%s, %d alive actors", summary, a.AliveActors)
+}
+
+// QueryActivity asks the Ray dashboard at baseURL, a dashboard route or a port-forward to the
+// head service, for active jobs and alive actors.
+func QueryActivity(ctx context.Context, httpClient *http.Client, baseURL string) (*Activity, error) {
+	base := strings.TrimSuffix(baseURL, "/")
+
+	var jobs []Job
+	if err := getJSON(ctx, httpClient, base+jobsPath, &jobs); err != nil {
+		return nil, fmt.Errorf("listing Ray jobs: %w", err)
+	}
+
+	activity := &Activity{}
+
+	for _, job := range jobs {
+		if job.Status == jobStatusPending || job.Status == jobStatusRunning {
+			activity.ActiveJobs = append(activity.ActiveJobs, job)
+		}
+	}
+
+	query := url.Values{
+		"filter_keys":       {"state"},
+		"filter_predicates": {"="},
+		"filter_values":     {actorStateAlive},
+		"detail":            {"false"},
+	}
+
+	var actors struct {
+		Result bool   `json:"result"`
+		Msg    string `json:"msg"`
+		Data   struct {
+			Result struct {
+				Total int `json:"total"`
+			} `json:"result"`
+		} `json:"data"`
+	}
+
+	if err := getJSON(ctx, httpClient, base+actorsPath+"?"+query.Encode(), &actors); err != nil {
+		return nil, fmt.Errorf("listing Ray actors: %w", err)
+	}
+
+	if !actors.Result {
+		return nil, fmt.Errorf("listing Ray actors: %s", actors.Msg)
+	}
+
+	activity.AliveActors = actors.Data.Result.Total
+
+	return activity, nil
+}
+
+// getJSON issues a GET request and decodes the JSON response into out.
+func getJSON(ctx context.Context, httpClient *http.Client, endpoint string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("requesting %s: %w", endpoint, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("requesting %s: %s", endpoint, resp.Status)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decoding response from %s: %w", endpoint, err)
+	}
+
+	return nil
+}
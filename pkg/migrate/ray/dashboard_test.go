@@ -0,0 +1,74 @@
+package ray_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/ray"
+
+	. "github.com/onsi/gomega"
+)
+
+func newDashboard(t *testing.T, jobs string, actors string) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/jobs/", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(jobs))
+	})
+	mux.HandleFunc("/api/v0/actors", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("filter_values") != "ALIVE" {
+			http.Error(w, "missing filter", http.StatusBadRequest)
+
+			return
+		}
+
+		_, _ = w.Write([]byte(actors))
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestQueryActivity(t *testing.T) {
+	g := NewWithT(t)
+
+	server := newDashboard(t,
+		`[{"submission_id":"train","status":"RUNNING"},{"submission_id":"old","status":"SUCCEEDED"},{"submission_id":"next","status":"PENDING"}]`,
+		`{"result":true,"msg":"","data":{"result":{"total":3,"result":[]}}}`,
+	)
+
+	activity, err := ray.QueryActivity(t.Context(), server.Client(), server.URL+"/")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(activity.Busy()).To(BeTrue())
+	g.Expect(activity.AliveActors).To(Equal(3))
+	g.Expect(activity.String()).To(Equal("2 active jobs (train, next), 3 alive actors"))
+}
+
+func TestQueryActivity_Idle(t *testing.T) {
+	g := NewWithT(t)
+
+	server := newDashboard(t, `[]`, `{"result":true,"data":{"result":{"total":0}}}`)
+
+	activity, err := ray.QueryActivity(t.Context(), server.Client(), server.URL)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(activity.Busy()).To(BeFalse())
+	g.Expect(activity.String()).To(Equal("no active jobs or alive actors"))
+}
+
+func TestQueryActivity_Errors(t *testing.T) {
+	g := NewWithT(t)
+
+	server := newDashboard(t, `[]`, `{"result":false,"msg":"state API disabled"}`)
+
+	_, err := ray.QueryActivity(t.Context(), server.Client(), server.URL)
+	g.Expect(err).To(MatchError(ContainSubstring("state API disabled")))
+
+	server.Close()
+
+	_, err = ray.QueryActivity(t.Context(), server.Client(), server.URL)
+	g.Expect(err).To(MatchError(ContainSubstring("listing Ray jobs")))
+}
@@ -0,0 +1,142 @@
+package ray
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+)
+
+// Operations of the RayCluster migration commands, as recorded in reports and state files.
+const (
+	OperationRefreshCerts = "refresh-certs"
+	OperationRollback     = "rollback"
+)
+
+// ClusterStatus is the outcome of the migration of a RayCluster.
+type ClusterStatus string
+
+const (
+	// ClusterSucceeded is a RayCluster migrated successfully.
+	ClusterSucceeded ClusterStatus = "Succeeded"
+
+	// ClusterFailed is a RayCluster whose migration failed; ClusterReport.Error has the cause.
+	ClusterFailed ClusterStatus = "Failed"
+
+	// ClusterSkipped is a RayCluster the migration does not apply to.
+	ClusterSkipped ClusterStatus = "Skipped"
+
+	// ClusterPlanned is a RayCluster that would be migrated, in dry-run mode.
+	ClusterPlanned ClusterStatus = "Planned"
+
+	// ClusterNotStarted is a RayCluster whose migration was not started because the run was
+	// interrupted or cancelled.
+	ClusterNotStarted ClusterStatus = "NotStarted"
+)
+
+// ClusterReport is the migration result of one RayCluster.
+type ClusterReport struct {
+	Name      string        `json:"name"`
+	Namespace string        `json:"namespace"`
+	Status    ClusterStatus `json:"status"`
+
+	// Duration is how long the migration of the cluster took, e.g. "1m30s".
+	Duration string `json:"duration,omitempty"`
+
+	// DashboardURL is the URL of the dashboard Route of the cluster, when it has one.
+	DashboardURL string `json:"dashboardURL,omitempty"`
+
+	Error string `json:"error,omitempty"`
+}
+
+// ReportSummary counts the RayClusters of a report by status.
+type ReportSummary struct {
+	Total      int `json:"total"`
+	Succeeded  int `json:"succeeded"`
+	Failed     int `json:"failed"`
+	Skipped    int `json:"skipped"`
+	Planned    int `json:"planned"`
+	NotStarted int `json:"notStarted"`
+}
+
+// MigrationReport is the machine-readable result of a RayCluster migration command, one entry
+// per RayCluster in the order the clusters were processed.
+type MigrationReport struct {
+	// Operation is the migration performed, e.g. "refresh-certs" or "rollback".
+	Operation string `json:"operation"`
+	DryRun    bool   `json:"dryRun"`
+
+	Summary  ReportSummary   `json:"summary"`
+	Clusters []ClusterReport `json:"clusters"`
+}
+
+// NewMigrationReport returns an empty report of an operation.
+func NewMigrationReport(operation string, dryRun bool) *MigrationReport {
+	return &MigrationReport{
+		Operation: operation,
+		DryRun:    dryRun,
+		Clusters:  make([]ClusterReport, 0),
+	}
+}
+
+// Record adds the result of a RayCluster to the report. A non-nil err records the cluster as
+// failed regardless of status. Record is not safe for concurrent use.
+func (r *MigrationReport) Record(
+	cluster *unstructured.Unstructured,
+	status ClusterStatus,
+	duration time.Duration,
+	dashboardURL string,
+	err error,
+) {
+	entry := ClusterReport{
+		Name:         cluster.GetName(),
+		Namespace:    cluster.GetNamespace(),
+		Status:       status,
+		DashboardURL: dashboardURL,
+	}
+
+	if duration > 0 {
+		entry.Duration = duration.Round(time.Millisecond).String()
+	}
+
+	if err != nil {
+		entry.Status = ClusterFailed
+		entry.Error = err.Error()
+	}
+
+	r.Clusters = append(r.Clusters, entry)
+	r.Summary.Total++
+
+	switch entry.Status {
+	case ClusterSucceeded:
+		r.Summary.Succeeded++
+	case ClusterFailed:
+		r.Summary.Failed++
+	case ClusterSkipped:
+		r.Summary.Skipped++
+	case ClusterPlanned:
+		r.Summary.Planned++
+	case ClusterNotStarted:
+		r.Summary.NotStarted++
+	}
+}
+
+// DashboardRouteURL returns the https URL of the dashboard Route of a RayCluster, or "" when
+// the Route does not exist or cannot be read.
+func DashboardRouteURL(ctx context.Context, c client.Reader, cluster *unstructured.Unstructured) string {
+	route, err := c.GetResource(ctx, resources.Route, DashboardRouteName(cluster.GetName()),
+		client.InNamespace(cluster.GetNamespace()))
+	if err != nil {
+		return ""
+	}
+
+	host, _, _ := unstructured.NestedString(route.Object, "spec", "host")
+	if host == "" {
+		return ""
+	}
+
+	return "https://" + host
+}
@@ -0,0 +1,36 @@
+package ray_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/ray"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestMigrationReport_Record(t *testing.T) {
+	g := NewWithT(t)
+
+	report := ray.NewMigrationReport("refresh-certs", false)
+
+	report.Record(newObject(resources.RayCluster, "train", nil, nil), ray.ClusterSucceeded,
+		1500*time.Millisecond, "https://ray-dashboard-train.apps", nil)
+	report.Record(newObject(resources.RayCluster, "serve", nil, nil), ray.ClusterSucceeded,
+		time.Second, "", errors.New("deleting Secret: forbidden"))
+	report.Record(newObject(resources.RayCluster, "idle", nil, nil), ray.ClusterNotStarted, 0, "", nil)
+
+	g.Expect(report.Summary).To(Equal(ray.ReportSummary{Total: 3, Succeeded: 1, Failed: 1, NotStarted: 1}))
+	g.Expect(report.Clusters[0]).To(Equal(ray.ClusterReport{
+		Name:         "train",
+		Namespace:    "project",
+		Status:       ray.ClusterSucceeded,
+		Duration:     "1.5s",
+		DashboardURL: "https://ray-dashboard-train.apps",
+	}))
+	g.Expect(report.Clusters[1].Status).To(Equal(ray.ClusterFailed))
+	g.Expect(report.Clusters[1].Error).To(Equal("deleting Secret: forbidden"))
+	g.Expect(report.Clusters[2].Duration).To(BeEmpty())
+}
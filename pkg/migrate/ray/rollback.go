@@ -0,0 +1,280 @@
+package ray
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+)
+
+const (
+	// BackupSubdir is the subdirectory of a backup holding the resources saved from the 2.x
+	// release before the upgrade.
+	BackupSubdir = "rhoai-2.x"
+
+	// dashboardRoutePrefix prefixes the name of the Route the CodeFlare operator exposes the
+	// dashboard of a RayCluster with.
+	dashboardRoutePrefix = "ray-dashboard-"
+
+	// oauthServiceAccountSuffix suffixes the name of the ServiceAccount the CodeFlare operator
+	// runs the oauth-proxy of a RayCluster with.
+	oauthServiceAccountSuffix = "-oauth-proxy"
+
+	// rollbackPollInterval is the interval at which deletion and the dashboard Route are polled.
+	rollbackPollInterval = 5 * time.Second
+)
+
+// Rollback is the restore of a RayCluster from its 2.x backup: the live cluster, if any, is
+// deleted, the backed up one re-created with the CodeFlare ServiceAccounts that are missing,
+// and its dashboard Route awaited.
+type Rollback struct {
+	// Saved is the RayCluster as backed up.
+	Saved *unstructured.Unstructured
+
+	// Live reports whether the RayCluster exists in the cluster and is deleted first.
+	Live bool
+
+	// ServiceAccounts are the backed up CodeFlare ServiceAccounts of the cluster that are
+	// missing from the cluster, re-created once the RayCluster is.
+	ServiceAccounts []*unstructured.Unstructured
+}
+
+// String summarizes the rollback for dry-run output.
+func (r *Rollback) String() string {
+	action := "create"
+	if r.Live {
+		action = "delete and re-create"
+	}
+
+	names := make([]string, 0, len(r.ServiceAccounts))
+	for _, sa := range r.ServiceAccounts {
+		names = append(names, sa.GetName())
+	}
+
+	return fmt.Sprintf("RayCluster %s/%s: %s from backup, re-create %d ServiceAccount(s) %v, verify Route %s",
+		r.Saved.GetNamespace(), r.Saved.GetName(), action, len(names), names, DashboardRouteName(r.Saved.GetName()))
+}
+
+// DashboardRouteName returns the name of the dashboard Route of a RayCluster.
+func DashboardRouteName(cluster string) string {
+	return dashboardRoutePrefix + cluster
+}
+
+// PlanRollback returns the rollback of a backed up RayCluster. backup reads the backup the
+// RayCluster was loaded from, c the live cluster.
+func PlanRollback(
+	ctx context.Context,
+	c client.Reader,
+	backup client.Reader,
+	saved *unstructured.Unstructured,
+) (*Rollback, error) {
+	r := &Rollback{Saved: saved}
+
+	_, err := c.GetResource(ctx, resources.RayCluster, saved.GetName(), client.InNamespace(saved.GetNamespace()))
+
+	switch {
+	case err == nil:
+		r.Live = true
+	case !apierrors.IsNotFound(err) && !client.IsResourceTypeNotFound(err):
+		return nil, fmt.Errorf("getting RayCluster %s/%s: %w", saved.GetNamespace(), saved.GetName(), err)
+	}
+
+	accounts, err := backup.List(ctx, resources.ServiceAccount, client.WithNamespace(saved.GetNamespace()))
+	if err != nil {
+		return nil, fmt.Errorf("listing backed up ServiceAccounts of RayCluster %s/%s: %w", saved.GetNamespace(), saved.GetName(), err)
+	}
+
+	for _, sa := range accounts {
+		if !ownedBy(sa, saved) {
+			continue
+		}
+
+		_, err := c.GetResource(ctx, resources.ServiceAccount, sa.GetName(), client.InNamespace(sa.GetNamespace()))
+
+		switch {
+		case apierrors.IsNotFound(err):
+			r.ServiceAccounts = append(r.ServiceAccounts, sa)
+		case err != nil:
+			return nil, fmt.Errorf("getting ServiceAccount %s/%s: %w", sa.GetNamespace(), sa.GetName(), err)
+		}
+	}
+
+	return r, nil
+}
+
+// ownedBy reports whether a backed up ServiceAccount belongs to a RayCluster: it is owned by
+// the RayCluster or named after it the way the CodeFlare operator names oauth-proxy accounts.
+func ownedBy(sa *unstructured.Unstructured, cluster *unstructured.Unstructured) bool {
+	if sa.GetName() == cluster.GetName()+oauthServiceAccountSuffix {
+		return true
+	}
+
+	for _, ref := range sa.GetOwnerReferences() {
+		if ref.Kind == resources.RayCluster.Kind && ref.Name == cluster.GetName() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// newFromBackup returns a copy of a backed up object without the fields the API server
+// populates, ready to be created.
+func newFromBackup(saved *unstructured.Unstructured) *unstructured.Unstructured {
+	obj := saved.DeepCopy()
+	unstructured.RemoveNestedField(obj.Object, "status")
+	obj.SetResourceVersion("")
+	obj.SetUID("")
+	obj.SetCreationTimestamp(metav1.Time{})
+	obj.SetGeneration(0)
+	obj.SetManagedFields(nil)
+
+	return obj
+}
+
+// DeleteCluster deletes the live RayCluster and waits until it is gone.
+func (r *Rollback) DeleteCluster(ctx context.Context, c client.Client, interval time.Duration) error {
+	clusters := c.Dynamic().Resource(resources.RayCluster.GVR()).Namespace(r.Saved.GetNamespace())
+	name := r.Saved.GetName()
+
+	if err := clusters.Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("deleting RayCluster %s/%s: %w", r.Saved.GetNamespace(), name, err)
+	}
+
+	err := wait.PollUntilContextCancel(ctx, interval, true, func(ctx context.Context) (bool, error) {
+		_, err := clusters.Get(ctx, name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+
+		return false, err
+	})
+	if err != nil {
+		return fmt.Errorf("waiting for RayCluster %s/%s to be deleted (check its finalizers): %w", r.Saved.GetNamespace(), name, err)
+	}
+
+	return nil
+}
+
+// CreateCluster re-creates the RayCluster from the backup and returns it as created.
+func (r *Rollback) CreateCluster(ctx context.Context, c client.Client) (*unstructured.Unstructured, error) {
+	created, err := c.Dynamic().Resource(resources.RayCluster.GVR()).Namespace(r.Saved.GetNamespace()).
+		Create(ctx, newFromBackup(r.Saved), metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("creating RayCluster %s/%s: %w", r.Saved.GetNamespace(), r.Saved.GetName(), err)
+	}
+
+	return created, nil
+}
+
+// CreateServiceAccounts re-creates the missing ServiceAccounts, pointing their RayCluster
+// owner references to the re-created cluster so they are not garbage collected. Accounts
+// created meanwhile, e.g. by the CodeFlare operator, are left as they are.
+func (r *Rollback) CreateServiceAccounts(ctx context.Context, c client.Client, cluster *unstructured.Unstructured) error {
+	accounts := c.Dynamic().Resource(resources.ServiceAccount.GVR()).Namespace(r.Saved.GetNamespace())
+
+	for _, saved := range r.ServiceAccounts {
+		sa := newFromBackup(saved)
+
+		refs := sa.GetOwnerReferences()
+		for i := range refs {
+			if refs[i].Kind == resources.RayCluster.Kind && refs[i].Name == cluster.GetName() {
+				refs[i].UID = cluster.GetUID()
+			}
+		}
+
+		sa.SetOwnerReferences(refs)
+
+		if _, err := accounts.Create(ctx, sa, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("creating ServiceAccount %s/%s: %w", sa.GetNamespace(), sa.GetName(), err)
+		}
+	}
+
+	return nil
+}
+
+// WaitForDashboardRoute waits until the dashboard Route of the RayCluster exists and is
+// admitted by a router, polling every interval until ctx is done.
+func (r *Rollback) WaitForDashboardRoute(ctx context.Context, c client.Client, interval time.Duration) error {
+	routes := c.Dynamic().Resource(resources.Route.GVR()).Namespace(r.Saved.GetNamespace())
+	name := DashboardRouteName(r.Saved.GetName())
+
+	err := wait.PollUntilContextCancel(ctx, interval, true, func(ctx context.Context) (bool, error) {
+		route, err := routes.Get(ctx, name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		return routeAdmitted(route), nil
+	})
+	if err != nil {
+		return fmt.Errorf("waiting for dashboard Route %s/%s to be admitted: %w", r.Saved.GetNamespace(), name, err)
+	}
+
+	return nil
+}
+
+// routeAdmitted reports whether a router admitted the Route.
+func routeAdmitted(route *unstructured.Unstructured) bool {
+	ingresses, _, _ := unstructured.NestedSlice(route.Object, "status", "ingress")
+
+	for _, ingress := range ingresses {
+		entry, ok := ingress.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		conditions, _, _ := unstructured.NestedSlice(entry, "conditions")
+
+		for _, condition := range conditions {
+			cond, ok := condition.(map[string]any)
+			if ok && cond["type"] == "Admitted" && cond["status"] == string(metav1.ConditionTrue) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Apply deletes the live RayCluster, re-creates it and its missing ServiceAccounts from the
+// backup and waits for its dashboard Route, reporting each step to progress before it starts.
+func (r *Rollback) Apply(ctx context.Context, c client.Client, progress func(format string, args ...any)) error {
+	if r.Live {
+		progress("Deleting the migrated RayCluster")
+
+		if err := r.DeleteCluster(ctx, c, rollbackPollInterval); err != nil {
+			return err
+		}
+	}
+
+	progress("Re-creating the RayCluster from the backup")
+
+	cluster, err := r.CreateCluster(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	if len(r.ServiceAccounts) > 0 {
+		progress("Re-creating %d ServiceAccount(s)", len(r.ServiceAccounts))
+
+		if err := r.CreateServiceAccounts(ctx, c, cluster); err != nil {
+			return err
+		}
+	}
+
+	progress("Verifying the dashboard Route %s", DashboardRouteName(r.Saved.GetName()))
+
+	return r.WaitForDashboardRoute(ctx, c, rollbackPollInterval)
+}
@@ -0,0 +1,69 @@
+package ray_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/ray"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+func newRollbackClient(objects ...runtime.Object) client.Client {
+	dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			resources.RayCluster.GVR():     resources.RayCluster.ListKind(),
+			resources.ServiceAccount.GVR(): resources.ServiceAccount.ListKind(),
+			resources.Route.GVR():          resources.Route.ListKind(),
+		}, objects...)
+
+	return client.NewForTesting(client.TestClientConfig{Dynamic: dynamic})
+}
+
+func TestPlanRollback(t *testing.T) {
+	g := NewWithT(t)
+
+	saved := newObject(resources.RayCluster, "train", nil, nil)
+
+	owned := newObject(resources.ServiceAccount, "train-sa", nil, nil)
+	owned.SetOwnerReferences([]metav1.OwnerReference{{Kind: resources.RayCluster.Kind, Name: "train"}})
+
+	backup := newRollbackClient(saved,
+		newObject(resources.ServiceAccount, "train-oauth-proxy", nil, nil),
+		owned,
+		newObject(resources.ServiceAccount, "other-oauth-proxy", nil, nil),
+	)
+
+	// The oauth-proxy ServiceAccount still exists, the owned one is missing.
+	live := newRollbackClient(newObject(resources.ServiceAccount, "train-oauth-proxy", nil, nil))
+
+	r, err := ray.PlanRollback(t.Context(), live, backup, saved)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(r.Live).To(BeFalse())
+	g.Expect(r.ServiceAccounts).To(HaveLen(1))
+	g.Expect(r.ServiceAccounts[0].GetName()).To(Equal("train-sa"))
+}
+
+func TestRollback_WaitForDashboardRoute(t *testing.T) {
+	g := NewWithT(t)
+
+	r := &ray.Rollback{Saved: newObject(resources.RayCluster, "train", nil, nil)}
+
+	// A Route no router has admitted is not ready.
+	c := newRollbackClient(newObject(resources.Route, "ray-dashboard-train", nil, nil))
+
+	ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+	defer cancel()
+
+	err := r.WaitForDashboardRoute(ctx, c, 10*time.Millisecond)
+	g.Expect(err).To(MatchError(ContainSubstring("waiting for dashboard Route project/ray-dashboard-train to be admitted")))
+}
@@ -0,0 +1,144 @@
+package ray
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const stateFileMode = 0o600
+
+// StateEntry is the last recorded outcome of an operation on a RayCluster.
+type StateEntry struct {
+	Operation string        `json:"operation"`
+	Namespace string        `json:"namespace"`
+	Name      string        `json:"name"`
+	Status    ClusterStatus `json:"status"`
+	Error     string        `json:"error,omitempty"`
+	UpdatedAt time.Time     `json:"updatedAt"`
+}
+
+// State is the state file of the RayCluster migration commands: the outcome of each cluster,
+// recorded as soon as the cluster completes so a failed or interrupted run can be resumed
+// without repeating the clusters already migrated. State is safe for concurrent use.
+type State struct {
+	path string
+
+	mu      sync.Mutex
+	Entries []StateEntry `json:"clusters"`
+}
+
+// LoadState reads the state file at path. A missing file is an empty state, created on the
+// first Record.
+func LoadState(path string) (*State, error) {
+	s := &State{path: path, Entries: make([]StateEntry, 0)}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return s, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("reading state file: %w", err)
+	}
+
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, fmt.Errorf("parsing state file %s: %w", path, err)
+	}
+
+	return s, nil
+}
+
+// Completed reports whether operation succeeded on the RayCluster in a recorded run.
+func (s *State) Completed(operation string, cluster *unstructured.Unstructured) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.find(operation, cluster)
+
+	return i >= 0 && s.Entries[i].Status == ClusterSucceeded
+}
+
+// Record sets the outcome of operation on the RayCluster, failed when err is non-nil, and
+// saves the state file.
+func (s *State) Record(operation string, cluster *unstructured.Unstructured, status ClusterStatus, err error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry := StateEntry{
+		Operation: operation,
+		Namespace: cluster.GetNamespace(),
+		Name:      cluster.GetName(),
+		Status:    status,
+		UpdatedAt: time.Now().UTC(),
+	}
+
+	if err != nil {
+		entry.Status = ClusterFailed
+		entry.Error = err.Error()
+	}
+
+	if i := s.find(operation, cluster); i >= 0 {
+		s.Entries[i] = entry
+	} else {
+		s.Entries = append(s.Entries, entry)
+	}
+
+	return s.save()
+}
+
+// find returns the index of the entry of operation on the RayCluster, or -1. The caller holds mu.
+func (s *State) find(operation string, cluster *unstructured.Unstructured) int {
+	for i, entry := range s.Entries {
+		if entry.Operation == operation && entry.Namespace == cluster.GetNamespace() && entry.Name == cluster.GetName() {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// save writes the state file. The file is replaced atomically, so an interrupted run never
+// leaves a partially written file. The caller holds mu.
+func (s *State) save() error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling state: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), "."+filepath.Base(s.path)+".*")
+	if err != nil {
+		return fmt.Errorf("creating state file: %w", err)
+	}
+
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		_ = tmp.Close()
+
+		return fmt.Errorf("writing state file: %w", err)
+	}
+
+	if err := tmp.Chmod(stateFileMode); err != nil {
+		_ = tmp.Close()
+
+		return fmt.Errorf("setting state file mode: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("closing state file: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("replacing %s: %w", s.path, err)
+	}
+
+	return nil
+}
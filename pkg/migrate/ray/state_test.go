@@ -0,0 +1,56 @@
+package ray_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/ray"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestState_RecordAndReload(t *testing.T) {
+	g := NewWithT(t)
+
+	path := filepath.Join(t.TempDir(), "state.json")
+
+	state, err := ray.LoadState(path)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(state.Entries).To(BeEmpty())
+
+	train := newObject(resources.RayCluster, "train", nil, nil)
+	serve := newObject(resources.RayCluster, "serve", nil, nil)
+
+	g.Expect(state.Record(ray.OperationRefreshCerts, train, ray.ClusterSucceeded, nil)).To(Succeed())
+	g.Expect(state.Record(ray.OperationRefreshCerts, serve, ray.ClusterSucceeded, errors.New("timed out"))).To(Succeed())
+
+	// A later outcome replaces the earlier one.
+	g.Expect(state.Record(ray.OperationRefreshCerts, train, ray.ClusterSucceeded, nil)).To(Succeed())
+
+	info, err := os.Stat(path)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(info.Mode().Perm()).To(Equal(os.FileMode(0o600)))
+
+	reloaded, err := ray.LoadState(path)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(reloaded.Entries).To(HaveLen(2))
+	g.Expect(reloaded.Entries[1].Status).To(Equal(ray.ClusterFailed))
+	g.Expect(reloaded.Entries[1].Error).To(Equal("timed out"))
+
+	g.Expect(reloaded.Completed(ray.OperationRefreshCerts, train)).To(BeTrue())
+	g.Expect(reloaded.Completed(ray.OperationRefreshCerts, serve)).To(BeFalse())
+	g.Expect(reloaded.Completed(ray.OperationRollback, train)).To(BeFalse())
+}
+
+func TestLoadState_InvalidFile(t *testing.T) {
+	g := NewWithT(t)
+
+	path := filepath.Join(t.TempDir(), "state.json")
+	g.Expect(os.WriteFile(path, []byte("not json"), 0o600)).To(Succeed())
+
+	_, err := ray.LoadState(path)
+	g.Expect(err).To(MatchError(ContainSubstring("parsing state file")))
+}
@@ -0,0 +1,23 @@
+package migrate
+
+import (
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/action"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/actions/dashboard/acceleratorprofiles"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/actions/kueue/rhbok"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/actions/notebook/dedicatednodes"
+	"github.com/opendatahub-io/odh-cli/pkg/migrate/actions/ray/refreshcerts"
+)
+
+// NewRegistry creates an action registry populated with all migration actions.
+// Shared by the migrate commands and doctor permissions.
+func NewRegistry() *action.ActionRegistry {
+	registry := action.NewActionRegistry()
+
+	// Explicitly register all actions (no global state, full test isolation)
+	registry.MustRegister(&rhbok.RHBOKMigrationAction{})
+	registry.MustRegister(&dedicatednodes.DedicatedNodesMigrationAction{})
+	registry.MustRegister(&refreshcerts.RefreshCertsAction{})
+	registry.MustRegister(&acceleratorprofiles.AcceleratorProfileMigrationAction{})
+
+	return registry
+}
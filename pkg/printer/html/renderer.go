@@ -0,0 +1,67 @@
+package html
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"os"
+
+	"github.com/opendatahub-io/odh-cli/pkg/util"
+)
+
+// Renderer provides a generic interface for rendering values as HTML documents from a template.
+type Renderer[T any] struct {
+	writer   io.Writer
+	template *template.Template
+}
+
+// Option is a functional option for configuring a Renderer.
+type Option[T any] = util.Option[Renderer[T]]
+
+// NewRenderer creates a new HTML renderer with the given options.
+func NewRenderer[T any](opts ...Option[T]) *Renderer[T] {
+	r := &Renderer[T]{
+		writer: os.Stdout,
+	}
+
+	for _, opt := range opts {
+		opt.ApplyTo(r)
+	}
+
+	return r
+}
+
+// WithWriter sets the output writer for the HTML renderer.
+func WithWriter[T any](w io.Writer) Option[T] {
+	return util.FunctionalOption[Renderer[T]](func(r *Renderer[T]) {
+		r.writer = w
+	})
+}
+
+// WithTemplate sets the template the value is rendered with.
+func WithTemplate[T any](tmpl *template.Template) Option[T] {
+	return util.FunctionalOption[Renderer[T]](func(r *Renderer[T]) {
+		r.template = tmpl
+	})
+}
+
+// Render executes the template with the value and writes the document to the configured
+// writer. Nothing is written when the template fails, so a broken report is never emitted.
+func (r *Renderer[T]) Render(value T) error {
+	if r.template == nil {
+		return errors.New("no HTML template configured")
+	}
+
+	var buf bytes.Buffer
+	if err := r.template.Execute(&buf, value); err != nil {
+		return fmt.Errorf("failed to execute HTML template: %w", err)
+	}
+
+	if _, err := r.writer.Write(buf.Bytes()); err != nil {
+		return fmt.Errorf("failed to write HTML output: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package html_test
+
+import (
+	"bytes"
+	"html/template"
+	"testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/printer/html"
+
+	. "github.com/onsi/gomega"
+)
+
+type testStruct struct {
+	Name string
+}
+
+func TestRenderer_Render(t *testing.T) {
+	g := NewWithT(t)
+
+	tmpl := template.Must(template.New("test").Parse(`<p>{{ .Name }}</p>`))
+
+	var buf bytes.Buffer
+	renderer := html.NewRenderer[testStruct](
+		html.WithWriter[testStruct](&buf),
+		html.WithTemplate[testStruct](tmpl),
+	)
+
+	g.Expect(renderer.Render(testStruct{Name: "<script>alert(1)</script>"})).To(Succeed())
+	g.Expect(buf.String()).To(Equal("<p>&lt;script&gt;alert(1)&lt;/script&gt;</p>"))
+}
+
+func TestRenderer_Render_Errors(t *testing.T) {
+	t.Run("no template", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+		renderer := html.NewRenderer[testStruct](html.WithWriter[testStruct](&buf))
+
+		g.Expect(renderer.Render(testStruct{})).To(MatchError(ContainSubstring("no HTML template")))
+	})
+
+	t.Run("template failure writes nothing", func(t *testing.T) {
+		g := NewWithT(t)
+
+		tmpl := template.Must(template.New("test").Parse(`<p>{{ .Name }}</p>{{ .Missing }}`))
+
+		var buf bytes.Buffer
+		renderer := html.NewRenderer[testStruct](
+			html.WithWriter[testStruct](&buf),
+			html.WithTemplate[testStruct](tmpl),
+		)
+
+		g.Expect(renderer.Render(testStruct{Name: "x"})).To(MatchError(ContainSubstring("execute HTML template")))
+		g.Expect(buf.Len()).To(BeZero())
+	})
+}
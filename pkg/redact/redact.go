@@ -0,0 +1,201 @@
+// Package redact masks credentials in the objects and text the CLI writes out: backups,
+// support bundles and their logs.
+package redact
+
+import (
+	"regexp"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// AnnotationRedact overrides the redaction of an object: "false" writes it as is, "true"
+	// on a ConfigMap masks all its values.
+	AnnotationRedact = "backup.opendatahub.io/redact"
+
+	// AnnotationRedacted marks a written object whose values were masked, so it is not
+	// restored over the live object.
+	AnnotationRedacted = "backup.opendatahub.io/redacted"
+
+	// RedactedValue replaces the credentials found in text and kubeconfigs.
+	RedactedValue = "<redacted>"
+
+	// LastAppliedAnnotation holds a full copy of the object as last applied by kubectl.
+	LastAppliedAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+)
+
+// Options configures the redaction of objects.
+type Options struct {
+	// IncludeSecretValues keeps the data values of Secrets.
+	IncludeSecretValues bool
+}
+
+// kubeconfigCredentialKeys are the kubeconfig user fields holding credentials.
+//
+//nolint:gochecknoglobals // Fixed set of kubeconfig fields
+var kubeconfigCredentialKeys = map[string]bool{
+	"token":           true,
+	"password":        true,
+	"client-key-data": true,
+	"id-token":        true,
+	"refresh-token":   true,
+	"access-token":    true,
+}
+
+// credentialPatterns match credentials in logs, event messages and reports. The first
+// group, kept, is the text preceding the credential.
+//
+//nolint:gochecknoglobals // Compiled once
+var credentialPatterns = []*regexp.Regexp{
+	// Authorization headers: "Bearer <token>", "Basic <credentials>"
+	regexp.MustCompile(`(?i)((?:bearer|basic)\s+)[A-Za-z0-9\-._~+/]+=*`),
+	// Key-value pairs: password=..., "token": "...", apiKey: ...
+	regexp.MustCompile(`(?i)((?:password|passwd|secret|token|api[_-]?key|access[_-]?key)["']?\s*[:=]\s*["']?)[^\s"',}]+`),
+	// JSON Web Tokens
+	regexp.MustCompile(`()eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+`),
+}
+
+// Object returns a copy of obj safe to write out. The managed fields and the
+// last-applied-configuration annotation, which duplicate the object, are removed; unless
+// the object is annotated AnnotationRedact "false", Secret data values are emptied
+// (see Options.IncludeSecretValues) and the credentials of kubeconfigs held in ConfigMaps
+// are masked. Objects whose values were masked are annotated AnnotationRedacted.
+func Object(obj *unstructured.Unstructured, opts Options) *unstructured.Unstructured {
+	out := obj.DeepCopy()
+	out.SetManagedFields(nil)
+
+	annotations := out.GetAnnotations()
+	delete(annotations, LastAppliedAnnotation)
+
+	mode := annotations[AnnotationRedact]
+	redacted := false
+
+	switch {
+	case mode == "false":
+	case out.GetAPIVersion() != "v1":
+	case out.GetKind() == "Secret" && !opts.IncludeSecretValues:
+		redacted = maskValues(out, "data", "stringData")
+	case out.GetKind() == "ConfigMap" && mode == "true":
+		redacted = maskValues(out, "data", "binaryData")
+	case out.GetKind() == "ConfigMap":
+		redacted = maskKubeconfigs(out)
+	}
+
+	if redacted {
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+
+		annotations[AnnotationRedacted] = "true"
+	}
+
+	if len(annotations) == 0 {
+		annotations = nil
+	}
+
+	out.SetAnnotations(annotations)
+
+	return out
+}
+
+// IsRedacted reports whether the values of an object written by Object were masked.
+func IsRedacted(obj *unstructured.Unstructured) bool {
+	return obj.GetAnnotations()[AnnotationRedacted] == "true"
+}
+
+// Text replaces the credentials found in data.
+func Text(data []byte) []byte {
+	for _, pattern := range credentialPatterns {
+		data = pattern.ReplaceAll(data, []byte("${1}"+RedactedValue))
+	}
+
+	return data
+}
+
+// maskValues empties the values of the given map fields, keeping their keys, and reports
+// whether any value was masked.
+func maskValues(obj *unstructured.Unstructured, fields ...string) bool {
+	masked := false
+
+	for _, field := range fields {
+		data, ok := obj.Object[field].(map[string]any)
+		if !ok {
+			continue
+		}
+
+		for key := range data {
+			data[key] = ""
+			masked = true
+		}
+	}
+
+	return masked
+}
+
+// maskKubeconfigs masks the user credentials of the ConfigMap values holding a kubeconfig
+// and reports whether any credential was masked.
+func maskKubeconfigs(obj *unstructured.Unstructured) bool {
+	data, ok := obj.Object["data"].(map[string]any)
+	if !ok {
+		return false
+	}
+
+	masked := false
+
+	for key, value := range data {
+		text, ok := value.(string)
+		if !ok || !strings.Contains(text, "users:") {
+			continue
+		}
+
+		var kubeconfig map[string]any
+		if err := yaml.Unmarshal([]byte(text), &kubeconfig); err != nil {
+			continue
+		}
+
+		users, ok := kubeconfig["users"].([]any)
+		if !ok || !maskCredentials(users) {
+			continue
+		}
+
+		redactedConfig, err := yaml.Marshal(kubeconfig)
+		if err != nil {
+			data[key] = ""
+		} else {
+			data[key] = string(redactedConfig)
+		}
+
+		masked = true
+	}
+
+	return masked
+}
+
+// maskCredentials masks the credential fields found anywhere in v and reports whether any
+// was masked.
+func maskCredentials(v any) bool {
+	masked := false
+
+	switch value := v.(type) {
+	case map[string]any:
+		for key, field := range value {
+			if _, isString := field.(string); isString && kubeconfigCredentialKeys[key] {
+				value[key] = RedactedValue
+				masked = true
+
+				continue
+			}
+
+			masked = maskCredentials(field) || masked
+		}
+	case []any:
+		for _, item := range value {
+			masked = maskCredentials(item) || masked
+		}
+	}
+
+	return masked
+}
@@ -0,0 +1,87 @@
+package resources
+
+import (
+	"slices"
+	"strings"
+)
+
+// Verbs of the RBAC permissions on resources.
+const (
+	VerbGet    = "get"
+	VerbList   = "list"
+	VerbCreate = "create"
+	VerbUpdate = "update"
+	VerbPatch  = "patch"
+	VerbDelete = "delete"
+)
+
+// Permission is an RBAC permission to perform a verb on a resource, cluster-wide.
+type Permission struct {
+	Group       string
+	Resource    string
+	Subresource string
+	Verb        string
+}
+
+// Permission returns the permission to perform verb on this resource.
+func (r ResourceType) Permission(verb string) Permission {
+	return Permission{Group: r.Group, Resource: r.Resource, Verb: verb}
+}
+
+// Permissions returns the permissions to perform each of verbs on this resource.
+func (r ResourceType) Permissions(verbs ...string) []Permission {
+	permissions := make([]Permission, 0, len(verbs))
+	for _, verb := range verbs {
+		permissions = append(permissions, r.Permission(verb))
+	}
+
+	return permissions
+}
+
+// ReadPermissions returns the get and list permissions on each of the resource types.
+func ReadPermissions(types ...ResourceType) []Permission {
+	permissions := make([]Permission, 0, 2*len(types)) //nolint:mnd // get and list
+
+	for _, rt := range types {
+		permissions = append(permissions, rt.Permissions(VerbGet, VerbList)...)
+	}
+
+	return permissions
+}
+
+// GroupResource returns the resource in the kubectl form "resource[/subresource].group", e.g.
+// "notebooks.kubeflow.org" or "pods/log".
+func (p Permission) GroupResource() string {
+	resource := p.Resource
+	if p.Subresource != "" {
+		resource += "/" + p.Subresource
+	}
+
+	if p.Group == "" {
+		return resource
+	}
+
+	return resource + "." + p.Group
+}
+
+// String returns the permission as "verb resource[/subresource].group".
+func (p Permission) String() string {
+	return p.Verb + " " + p.GroupResource()
+}
+
+// ComparePermissions orders permissions by resource, then verb.
+func ComparePermissions(a Permission, b Permission) int {
+	if c := strings.Compare(a.GroupResource(), b.GroupResource()); c != 0 {
+		return c
+	}
+
+	return strings.Compare(a.Verb, b.Verb)
+}
+
+// UniquePermissions returns the permissions without duplicates, sorted by ComparePermissions.
+func UniquePermissions(permissions []Permission) []Permission {
+	unique := slices.Clone(permissions)
+	slices.SortFunc(unique, ComparePermissions)
+
+	return slices.Compact(unique)
+}
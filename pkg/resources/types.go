@@ -106,6 +106,30 @@ var (
 		Resource: "deployments",
 	}
 
+	// ValidatingWebhookConfiguration is the Kubernetes ValidatingWebhookConfiguration resource.
+	ValidatingWebhookConfiguration = ResourceType{
+		Group:    "admissionregistration.k8s.io",
+		Version:  "v1",
+		Kind:     "ValidatingWebhookConfiguration",
+		Resource: "validatingwebhookconfigurations",
+	}
+
+	// MutatingWebhookConfiguration is the Kubernetes MutatingWebhookConfiguration resource.
+	MutatingWebhookConfiguration = ResourceType{
+		Group:    "admissionregistration.k8s.io",
+		Version:  "v1",
+		Kind:     "MutatingWebhookConfiguration",
+		Resource: "mutatingwebhookconfigurations",
+	}
+
+	// HorizontalPodAutoscaler is the Kubernetes HorizontalPodAutoscaler resource.
+	HorizontalPodAutoscaler = ResourceType{
+		Group:    "autoscaling",
+		Version:  "v2",
+		Kind:     "HorizontalPodAutoscaler",
+		Resource: "horizontalpodautoscalers",
+	}
+
 	// Namespace is the core Kubernetes Namespace resource.
 	Namespace = ResourceType{
 		Group:    "",
@@ -114,6 +138,22 @@ var (
 		Resource: "namespaces",
 	}
 
+	// Node is the core Kubernetes Node resource.
+	Node = ResourceType{
+		Group:    "",
+		Version:  "v1",
+		Kind:     "Node",
+		Resource: "nodes",
+	}
+
+	// Event is the core Kubernetes Event resource.
+	Event = ResourceType{
+		Group:    "",
+		Version:  "v1",
+		Kind:     "Event",
+		Resource: "events",
+	}
+
 	Pod = ResourceType{
 		Group:    "",
 		Version:  "v1",
@@ -128,6 +168,14 @@ var (
 		Resource: "services",
 	}
 
+	// EndpointSlice is the Kubernetes EndpointSlice resource, listing the endpoints of a Service.
+	EndpointSlice = ResourceType{
+		Group:    "discovery.k8s.io",
+		Version:  "v1",
+		Kind:     "EndpointSlice",
+		Resource: "endpointslices",
+	}
+
 	ConfigMap = ResourceType{
 		Group:    "",
 		Version:  "v1",
@@ -142,6 +190,14 @@ var (
 		Resource: "secrets",
 	}
 
+	// ServiceAccount is the core Kubernetes ServiceAccount resource.
+	ServiceAccount = ResourceType{
+		Group:    "",
+		Version:  "v1",
+		Kind:     "ServiceAccount",
+		Resource: "serviceaccounts",
+	}
+
 	PersistentVolumeClaim = ResourceType{
 		Group:    "",
 		Version:  "v1",
@@ -149,6 +205,14 @@ var (
 		Resource: "persistentvolumeclaims",
 	}
 
+	// StorageClass is the Kubernetes storage class resource.
+	StorageClass = ResourceType{
+		Group:    "storage.k8s.io",
+		Version:  "v1",
+		Kind:     "StorageClass",
+		Resource: "storageclasses",
+	}
+
 	// Notebook is the Kubeflow Notebook resource.
 	Notebook = ResourceType{
 		Group:    "kubeflow.org",
@@ -203,6 +267,14 @@ var (
 		Resource: "localqueues",
 	}
 
+	// Workload is the Kueue Workload resource, the unit of admission of a queued job.
+	Workload = ResourceType{
+		Group:    "kueue.x-k8s.io",
+		Version:  "v1beta1",
+		Kind:     "Workload",
+		Resource: "workloads",
+	}
+
 	// InferenceService is the KServe InferenceService resource.
 	InferenceService = ResourceType{
 		Group:    "serving.kserve.io",
@@ -211,6 +283,22 @@ var (
 		Resource: "inferenceservices",
 	}
 
+	// HTTPRoute is the Gateway API HTTPRoute resource.
+	HTTPRoute = ResourceType{
+		Group:    "gateway.networking.k8s.io",
+		Version:  "v1",
+		Kind:     "HTTPRoute",
+		Resource: "httproutes",
+	}
+
+	// GatewayClass is the Gateway API GatewayClass resource.
+	GatewayClass = ResourceType{
+		Group:    "gateway.networking.k8s.io",
+		Version:  "v1",
+		Kind:     "GatewayClass",
+		Resource: "gatewayclasses",
+	}
+
 	// ServingRuntime is the KServe ServingRuntime resource.
 	ServingRuntime = ResourceType{
 		Group:    "serving.kserve.io",
@@ -219,6 +307,14 @@ var (
 		Resource: "servingruntimes",
 	}
 
+	// ClusterServingRuntime is the cluster-scoped KServe ServingRuntime resource.
+	ClusterServingRuntime = ResourceType{
+		Group:    "serving.kserve.io",
+		Version:  "v1alpha1",
+		Kind:     "ClusterServingRuntime",
+		Resource: "clusterservingruntimes",
+	}
+
 	// RayCluster is the Ray RayCluster resource.
 	RayCluster = ResourceType{
 		Group:    "ray.io",
@@ -227,6 +323,14 @@ var (
 		Resource: "rayclusters",
 	}
 
+	// Route is the OpenShift Route resource.
+	Route = ResourceType{
+		Group:    "route.openshift.io",
+		Version:  "v1",
+		Kind:     "Route",
+		Resource: "routes",
+	}
+
 	// PyTorchJob is the Kubeflow Training PyTorchJob resource.
 	PyTorchJob = ResourceType{
 		Group:    "kubeflow.org",
@@ -259,6 +363,22 @@ var (
 		Resource: "clusterversions",
 	}
 
+	// ClusterOperator is the OpenShift cluster operator status resource.
+	ClusterOperator = ResourceType{
+		Group:    "config.openshift.io",
+		Version:  "v1",
+		Kind:     "ClusterOperator",
+		Resource: "clusteroperators",
+	}
+
+	// Proxy is the OpenShift cluster-wide proxy configuration resource.
+	Proxy = ResourceType{
+		Group:    "config.openshift.io",
+		Version:  "v1",
+		Kind:     "Proxy",
+		Resource: "proxies",
+	}
+
 	// AcceleratorProfile is the OpenShift AI AcceleratorProfile resource.
 	AcceleratorProfile = ResourceType{
 		Group:    "dashboard.opendatahub.io",
@@ -276,6 +396,46 @@ var (
 		Resource: "hardwareprofiles",
 	}
 
+	// InfrastructureHardwareProfile is the OpenShift AI HardwareProfile resource used by 3.x.
+	InfrastructureHardwareProfile = ResourceType{
+		Group:    "infrastructure.opendatahub.io",
+		Version:  "v1",
+		Kind:     "HardwareProfile",
+		Resource: "hardwareprofiles",
+	}
+
+	// OdhApplication is the dashboard application tile resource.
+	OdhApplication = ResourceType{
+		Group:    "dashboard.opendatahub.io",
+		Version:  "v1",
+		Kind:     "OdhApplication",
+		Resource: "odhapplications",
+	}
+
+	// OdhDocument is the dashboard documentation resource.
+	OdhDocument = ResourceType{
+		Group:    "dashboard.opendatahub.io",
+		Version:  "v1",
+		Kind:     "OdhDocument",
+		Resource: "odhdocuments",
+	}
+
+	// OdhQuickStart is the dashboard quick start tutorial resource.
+	OdhQuickStart = ResourceType{
+		Group:    "console.openshift.io",
+		Version:  "v1",
+		Kind:     "OdhQuickStart",
+		Resource: "odhquickstarts",
+	}
+
+	// OdhDashboardConfig is the OpenShift AI dashboard configuration resource.
+	OdhDashboardConfig = ResourceType{
+		Group:    "opendatahub.io",
+		Version:  "v1alpha",
+		Kind:     "OdhDashboardConfig",
+		Resource: "odhdashboardconfigs",
+	}
+
 	// LlamaStackDistribution is the LlamaStack distribution configuration resource.
 	LlamaStackDistribution = ResourceType{
 		Group:    "llamastack.io",
@@ -292,6 +452,31 @@ var (
 		Resource: "imagestreams",
 	}
 
+	// ImageDigestMirrorSet is the OpenShift digest-based image mirror configuration.
+	ImageDigestMirrorSet = ResourceType{
+		Group:    "config.openshift.io",
+		Version:  "v1",
+		Kind:     "ImageDigestMirrorSet",
+		Resource: "imagedigestmirrorsets",
+	}
+
+	// ImageTagMirrorSet is the OpenShift tag-based image mirror configuration.
+	ImageTagMirrorSet = ResourceType{
+		Group:    "config.openshift.io",
+		Version:  "v1",
+		Kind:     "ImageTagMirrorSet",
+		Resource: "imagetagmirrorsets",
+	}
+
+	// ImageContentSourcePolicy is the legacy OpenShift image mirror configuration
+	// superseded by ImageDigestMirrorSet.
+	ImageContentSourcePolicy = ResourceType{
+		Group:    "operator.openshift.io",
+		Version:  "v1alpha1",
+		Kind:     "ImageContentSourcePolicy",
+		Resource: "imagecontentsourcepolicies",
+	}
+
 	// ImageStreamTag is the OpenShift ImageStreamTag resource.
 	// Note: ImageStreamTag names are in the format "imagestream:tag".
 	ImageStreamTag = ResourceType{
@@ -300,4 +485,28 @@ var (
 		Kind:     "ImageStreamTag",
 		Resource: "imagestreamtags",
 	}
+
+	// Template is the OpenShift Template resource, which ships the out-of-the-box ServingRuntimes.
+	Template = ResourceType{
+		Group:    "template.openshift.io",
+		Version:  "v1",
+		Kind:     "Template",
+		Resource: "templates",
+	}
+
+	// PrometheusRule is the Prometheus Operator alerting and recording rule resource.
+	PrometheusRule = ResourceType{
+		Group:    "monitoring.coreos.com",
+		Version:  "v1",
+		Kind:     "PrometheusRule",
+		Resource: "prometheusrules",
+	}
+
+	// GrafanaDashboard is the Grafana Operator dashboard resource.
+	GrafanaDashboard = ResourceType{
+		Group:    "grafana.integreatly.org",
+		Version:  "v1beta1",
+		Kind:     "GrafanaDashboard",
+		Resource: "grafanadashboards",
+	}
 )
@@ -0,0 +1,244 @@
+package restore_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/backup"
+	"github.com/opendatahub-io/odh-cli/pkg/redact"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/restore"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+func newResource(rt resources.ResourceType, namespace string, name string, labels map[string]string) *unstructured.Unstructured {
+	obj := rt.Unstructured()
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	obj.SetLabels(labels)
+	obj.SetResourceVersion("42")
+	obj.SetUID("uid")
+
+	return &obj
+}
+
+func writeBackup(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	for _, w := range []struct {
+		rt  resources.ResourceType
+		obj *unstructured.Unstructured
+	}{
+		{resources.Notebook, newResource(resources.Notebook, "team-a", "nb", map[string]string{"app": "demo"})},
+		{resources.ConfigMap, newResource(resources.ConfigMap, "team-a", "nb-config", map[string]string{"app": "demo"})},
+		{resources.Namespace, newResource(resources.Namespace, "", "team-a", nil)},
+		{resources.ConfigMap, newResource(resources.ConfigMap, "team-b", "other", nil)},
+	} {
+		if err := backup.WriteResourceToFile(dir, w.rt.GVR(), w.obj); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
+
+func newFakeDynamic(objects ...runtime.Object) *dynamicfake.FakeDynamicClient {
+	return dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			resources.Namespace.GVR(): resources.Namespace.ListKind(),
+			resources.ConfigMap.GVR(): resources.ConfigMap.ListKind(),
+			resources.Notebook.GVR():  resources.Notebook.ListKind(),
+		}, objects...)
+}
+
+func TestCommand_Run(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	existing := newResource(resources.ConfigMap, "team-b", "other", map[string]string{"live": "true"})
+	dynamic := newFakeDynamic(existing)
+
+	var errOut bytes.Buffer
+
+	command := restore.NewCommand(genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: &bytes.Buffer{}, ErrOut: &errOut})
+	command.Client = client.NewForTesting(client.TestClientConfig{Dynamic: dynamic})
+	command.Dir = writeBackup(t)
+	command.Yes = true
+
+	g.Expect(command.Validate()).To(Succeed())
+	g.Expect(command.Run(ctx)).To(Succeed())
+
+	// Namespaces first, then core resources, then custom resources
+	g.Expect(errOut.String()).To(MatchRegexp(`(?s)Created Namespace team-a.*Created ConfigMap team-a/nb-config.*Created Notebook team-a/nb`))
+	g.Expect(errOut.String()).To(ContainSubstring("ConfigMap team-b/other already exists, skipping"))
+
+	nb, err := dynamic.Resource(resources.Notebook.GVR()).Namespace("team-a").Get(ctx, "nb", metav1.GetOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(nb.GetUID()).To(BeEmpty())
+
+	other, err := dynamic.Resource(resources.ConfigMap.GVR()).Namespace("team-b").Get(ctx, "other", metav1.GetOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(other.GetLabels()).To(HaveKeyWithValue("live", "true"))
+}
+
+func TestCommand_Filters(t *testing.T) {
+	g := NewWithT(t)
+
+	var errOut bytes.Buffer
+
+	command := restore.NewCommand(genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: &bytes.Buffer{}, ErrOut: &errOut})
+	command.Client = client.NewForTesting(client.TestClientConfig{Dynamic: newFakeDynamic()})
+	command.Dir = writeBackup(t)
+	command.DryRun = true
+	command.Namespaces = []string{"team-a"}
+	command.Selector = "app=demo"
+	command.Excludes = []string{"notebooks.kubeflow.org"}
+
+	g.Expect(command.Validate()).To(Succeed())
+	g.Expect(command.Run(t.Context())).To(Succeed())
+
+	g.Expect(errOut.String()).To(ContainSubstring("1 resources to restore"))
+	g.Expect(errOut.String()).To(ContainSubstring("ConfigMap team-a/nb-config"))
+	g.Expect(errOut.String()).ToNot(ContainSubstring("Notebook"))
+	g.Expect(errOut.String()).To(ContainSubstring("Dry run: no changes applied"))
+}
+
+func TestCommand_DryRun(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	dynamic := newFakeDynamic()
+
+	command := restore.NewCommand(genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: &bytes.Buffer{}, ErrOut: &bytes.Buffer{}})
+	command.Client = client.NewForTesting(client.TestClientConfig{Dynamic: dynamic})
+	command.Dir = writeBackup(t)
+	command.DryRun = true
+
+	g.Expect(command.Validate()).To(Succeed())
+	g.Expect(command.Run(ctx)).To(Succeed())
+
+	list, err := dynamic.Resource(resources.ConfigMap.GVR()).Namespace("team-a").List(ctx, metav1.ListOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(list.Items).To(BeEmpty())
+}
+
+func TestCommand_SkipsRedacted(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	dir := t.TempDir()
+
+	secret := newResource(resources.Secret, "team-a", "creds", nil)
+	secret.SetAnnotations(map[string]string{redact.AnnotationRedacted: "true"})
+	g.Expect(backup.WriteResourceToFile(dir, resources.Secret.GVR(), secret)).To(Succeed())
+
+	dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{resources.Secret.GVR(): resources.Secret.ListKind()})
+
+	var errOut bytes.Buffer
+
+	command := restore.NewCommand(genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: &bytes.Buffer{}, ErrOut: &errOut})
+	command.Client = client.NewForTesting(client.TestClientConfig{Dynamic: dynamic})
+	command.Dir = dir
+	command.Mode = restore.ModeApply
+	command.Yes = true
+
+	g.Expect(command.Validate()).To(Succeed())
+	g.Expect(command.Run(ctx)).To(Succeed())
+	g.Expect(errOut.String()).To(ContainSubstring("Secret team-a/creds was redacted by the backup, skipping"))
+
+	list, err := dynamic.Resource(resources.Secret.GVR()).Namespace("team-a").List(ctx, metav1.ListOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(list.Items).To(BeEmpty())
+}
+
+func TestCommand_Validate(t *testing.T) {
+	g := NewWithT(t)
+
+	command := restore.NewCommand(genericiooptions.IOStreams{})
+	command.Dir = t.TempDir()
+	command.Mode = "replace"
+
+	g.Expect(command.Validate()).To(MatchError(ContainSubstring("invalid mode")))
+
+	command.Mode = restore.ModeApply
+	command.Selector = "app in ("
+
+	g.Expect(command.Validate()).To(MatchError(ContainSubstring("invalid --selector")))
+}
+
+func TestCommand_RunApply(t *testing.T) {
+	g := NewWithT(t)
+
+	dynamic := newFakeDynamic()
+
+	// The fake client does not implement server-side apply; record the apply patches
+	var applied []string
+
+	dynamic.PrependReactor("patch", "configmaps", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		patch, ok := action.(clienttesting.PatchAction)
+		g.Expect(ok).To(BeTrue())
+		g.Expect(patch.GetPatchType()).To(Equal(types.ApplyPatchType))
+		g.Expect(string(patch.GetPatch())).ToNot(ContainSubstring("resourceVersion"))
+
+		applied = append(applied, patch.GetNamespace()+"/"+patch.GetName())
+
+		return true, newResource(resources.ConfigMap, patch.GetNamespace(), patch.GetName(), nil), nil
+	})
+
+	var errOut bytes.Buffer
+
+	command := restore.NewCommand(genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: &bytes.Buffer{}, ErrOut: &errOut})
+	command.Client = client.NewForTesting(client.TestClientConfig{Dynamic: dynamic})
+	command.Dir = writeBackup(t)
+	command.Mode = restore.ModeApply
+	command.Includes = []string{"configmaps"}
+	command.Yes = true
+
+	g.Expect(command.Validate()).To(Succeed())
+	g.Expect(command.Run(t.Context())).To(Succeed())
+	g.Expect(applied).To(Equal([]string{"team-a/nb-config", "team-b/other"}))
+	g.Expect(errOut.String()).To(ContainSubstring("Applied ConfigMap team-a/nb-config"))
+}
+
+func TestCommand_RunArchive(t *testing.T) {
+	g := NewWithT(t)
+
+	dir := writeBackup(t)
+	manifest, err := backup.BuildManifest(dir)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(backup.WriteManifest(dir, manifest)).To(Succeed())
+
+	path := filepath.Join(t.TempDir(), "backup.tar.gz")
+	f, err := os.Create(path)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(backup.WriteArchive(f, dir)).To(Succeed())
+	g.Expect(f.Close()).To(Succeed())
+
+	var errOut bytes.Buffer
+
+	command := restore.NewCommand(genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: &bytes.Buffer{}, ErrOut: &errOut})
+	command.Client = client.NewForTesting(client.TestClientConfig{Dynamic: newFakeDynamic()})
+	command.Dir = path
+	command.DryRun = true
+
+	g.Expect(command.Validate()).To(Succeed())
+	g.Expect(command.Run(t.Context())).To(Succeed())
+	g.Expect(errOut.String()).To(ContainSubstring("Verified backup archive"))
+	g.Expect(errOut.String()).To(ContainSubstring("4 resources to restore"))
+}
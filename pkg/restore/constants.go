@@ -0,0 +1,15 @@
+package restore
+
+// Flag descriptions for the restore command.
+const (
+	flagDescMode       = "how resources are restored: create (skip resources that already exist) or apply (server-side apply, taking ownership of conflicting fields)"
+	flagDescNamespaces = "only restore resources of these namespaces (repeatable); cluster-scoped resources are skipped"
+	flagDescSelector   = "only restore resources matching this label selector (e.g. app=demo)"
+	flagDescIncludes   = "only restore these resource types (repeatable, e.g. --includes notebooks.kubeflow.org)"
+	flagDescExcludes   = "resource types not to restore (repeatable)"
+	flagDescDryRun     = "print the resources that would be restored, in order, without changing the cluster"
+	flagDescYes        = "skip the confirmation prompt"
+	flagDescTimeout    = "timeout for the restore"
+	flagDescQPS        = "Kubernetes API QPS limit (queries per second)"
+	flagDescBurst      = "Kubernetes API burst capacity"
+)
@@ -0,0 +1,241 @@
+// Package rules manages the compatibility data bundle used by lint checks and migrations.
+//
+// Compatibility data (such as the RHBOK support matrix, the z-stream upgrade matrix and the
+// knowledge links of lint checks) is
+// compiled into the binary and can be replaced by a newer signed bundle installed with `odh rules update`, so disconnected
+// clusters can pick up compatibility updates without a new CLI release.
+package rules
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/blang/semver/v4"
+	"sigs.k8s.io/yaml"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+)
+
+const (
+	// SchemaVersion is the bundle schema version understood by this CLI.
+	SchemaVersion = 1
+
+	// BundleFile is the name of the bundle document inside the archive and the config dir.
+	BundleFile = "bundle.yaml"
+
+	// SignatureFile is the name of the detached base64 ed25519 signature of BundleFile.
+	SignatureFile = "bundle.yaml.sig"
+
+	// KeyFile is the name of the base64 ed25519 public key the installed bundle was verified
+	// with, saved in the config dir so the bundle is verified against it when loaded.
+	KeyFile = "bundle.pub"
+
+	// maxBundleFileSize bounds the size of files read from an archive.
+	maxBundleFileSize = 1 << 20
+)
+
+// TrustedPublicKey is the base64-encoded ed25519 key that signs official bundles.
+// It is set by ldflags at build time.
+//
+//nolint:gochecknoglobals // Set by ldflags during build.
+var TrustedPublicKey = ""
+
+// ErrSignature is returned when a bundle signature does not match the public key.
+var ErrSignature = errors.New("bundle signature verification failed")
+
+// Bundle is a versioned set of compatibility data.
+type Bundle struct {
+	// SchemaVersion is the format version of the bundle document.
+	SchemaVersion int `json:"schemaVersion"`
+
+	// Version identifies the bundle release (semver, e.g. 2026.10.1).
+	Version string `json:"version"`
+
+	// CreatedAt is when the bundle was built.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// RHBOKSupportMatrix lists the minimum RHBOK operator version per RHOAI release.
+	RHBOKSupportMatrix []RHBOKRequirement `json:"rhbokSupportMatrix,omitempty"`
+
+	// ZStreamMatrix holds the version-gated data of the z-stream (2.x -> 2.y) upgrade checks.
+	ZStreamMatrix *ZStreamMatrix `json:"zStreamMatrix,omitempty"`
+
+	// KnowledgeLinks replaces the knowledge links of lint checks, keyed by check ID. Links are
+	// knowledge base (KCS) article IDs or documentation URLs.
+	KnowledgeLinks map[string][]string `json:"knowledgeLinks,omitempty"`
+}
+
+// RHBOKRequirement is a support matrix entry in bundle form.
+type RHBOKRequirement struct {
+	// RHOAI is the first RHOAI release the requirement applies to (major.minor).
+	RHOAI string `json:"rhoai"`
+
+	// MinVersion is the oldest RHBOK operator version supported by that release.
+	MinVersion string `json:"minVersion"`
+}
+
+// Parse decodes a bundle document and validates its schema version and content.
+func Parse(data []byte) (*Bundle, error) {
+	var b Bundle
+	if err := yaml.UnmarshalStrict(data, &b); err != nil {
+		return nil, fmt.Errorf("parsing bundle: %w", err)
+	}
+
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &b, nil
+}
+
+// Validate checks the bundle schema version and content.
+func (b *Bundle) Validate() error {
+	switch {
+	case b.SchemaVersion == 0:
+		return errors.New("bundle has no schemaVersion")
+	case b.SchemaVersion > SchemaVersion:
+		return fmt.Errorf("bundle schema version %d is newer than supported version %d: upgrade odh-cli", b.SchemaVersion, SchemaVersion)
+	}
+
+	if _, err := b.SemVer(); err != nil {
+		return err
+	}
+
+	for _, req := range b.RHBOKSupportMatrix {
+		if _, err := semver.ParseTolerant(req.RHOAI); err != nil {
+			return fmt.Errorf("invalid RHOAI version %q in rhbokSupportMatrix: %w", req.RHOAI, err)
+		}
+
+		if _, err := semver.ParseTolerant(req.MinVersion); err != nil {
+			return fmt.Errorf("invalid minVersion %q in rhbokSupportMatrix: %w", req.MinVersion, err)
+		}
+	}
+
+	if b.ZStreamMatrix != nil {
+		if _, err := b.ZStreamMatrix.toMatrix(); err != nil {
+			return err
+		}
+	}
+
+	for id, links := range b.KnowledgeLinks {
+		if len(links) == 0 {
+			return fmt.Errorf("knowledgeLinks: check %q has no links", id)
+		}
+
+		for _, link := range links {
+			if err := check.ValidateKnowledgeLink(link); err != nil {
+				return fmt.Errorf("knowledgeLinks: check %q: %w", id, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// SemVer returns the parsed bundle version.
+func (b *Bundle) SemVer() (semver.Version, error) {
+	v, err := semver.ParseTolerant(b.Version)
+	if err != nil {
+		return semver.Version{}, fmt.Errorf("invalid bundle version %q: %w", b.Version, err)
+	}
+
+	return v, nil
+}
+
+// Verify checks the detached base64 signature of data against the base64-encoded ed25519 public key.
+func Verify(data []byte, signature []byte, publicKey string) error {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(publicKey))
+	if err != nil {
+		return fmt.Errorf("decoding public key: %w", err)
+	}
+
+	if len(key) != ed25519.PublicKeySize {
+		return fmt.Errorf("public key must be %d bytes, got %d", ed25519.PublicKeySize, len(key))
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(signature)))
+	if err != nil {
+		return fmt.Errorf("decoding signature: %w", err)
+	}
+
+	if !ed25519.Verify(ed25519.PublicKey(key), data, sig) {
+		return ErrSignature
+	}
+
+	return nil
+}
+
+// Archive holds the raw bundle document and signature read from a bundle archive.
+type Archive struct {
+	Data      []byte
+	Signature []byte
+}
+
+// ReadArchive extracts the bundle document and signature from a tar.gz archive.
+// Files may be at the archive root or inside a single top-level directory.
+func ReadArchive(r io.Reader) (*Archive, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("opening gzip stream: %w", err)
+	}
+	defer func() { _ = gz.Close() }()
+
+	archive := &Archive{}
+	tr := tar.NewReader(gz)
+
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("reading archive: %w", err)
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		var target *[]byte
+
+		switch path.Base(header.Name) {
+		case BundleFile:
+			target = &archive.Data
+		case SignatureFile:
+			target = &archive.Signature
+		default:
+			continue
+		}
+
+		var buf bytes.Buffer
+		if _, err := io.Copy(&buf, io.LimitReader(tr, maxBundleFileSize+1)); err != nil {
+			return nil, fmt.Errorf("reading %s: %w", header.Name, err)
+		}
+
+		if buf.Len() > maxBundleFileSize {
+			return nil, fmt.Errorf("%s exceeds the maximum size of %d bytes", header.Name, maxBundleFileSize)
+		}
+
+		*target = buf.Bytes()
+	}
+
+	if archive.Data == nil {
+		return nil, fmt.Errorf("archive does not contain %s", BundleFile)
+	}
+
+	if archive.Signature == nil {
+		return nil, fmt.Errorf("archive does not contain %s", SignatureFile)
+	}
+
+	return archive, nil
+}
@@ -0,0 +1,436 @@
+package rules_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/blang/semver/v4"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/components/codeflare"
+	"github.com/opendatahub-io/odh-cli/pkg/rules"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kueue"
+	"github.com/opendatahub-io/odh-cli/pkg/util/zstream"
+
+	. "github.com/onsi/gomega"
+)
+
+const testBundle = `schemaVersion: 1
+version: 2026.10.1
+createdAt: "2026-10-01T00:00:00Z"
+rhbokSupportMatrix:
+  - rhoai: "2.25"
+    minVersion: 1.0.0
+  - rhoai: "3.3"
+    minVersion: 1.2.0
+`
+
+func newKey(t *testing.T) (string, ed25519.PrivateKey) {
+	t.Helper()
+
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return base64.StdEncoding.EncodeToString(pub), priv
+}
+
+// trustKey makes pub the key installed bundles are verified against when loaded.
+func trustKey(t *testing.T, pub string) {
+	t.Helper()
+
+	original := rules.TrustedPublicKey
+	rules.TrustedPublicKey = pub
+
+	t.Cleanup(func() { rules.TrustedPublicKey = original })
+}
+
+func newArchive(t *testing.T, priv ed25519.PrivateKey, bundle string) []byte {
+	t.Helper()
+
+	sig := base64.StdEncoding.EncodeToString(ed25519.Sign(priv, []byte(bundle)))
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+
+	for name, content := range map[string]string{
+		"odh-rules/" + rules.BundleFile:    bundle,
+		"odh-rules/" + rules.SignatureFile: sig,
+	} {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(content)), Typeflag: tar.TypeReg}); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := tw.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.Bytes()
+}
+
+func readArchive(t *testing.T, data []byte) *rules.Archive {
+	t.Helper()
+
+	archive, err := rules.ReadArchive(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return archive
+}
+
+func TestInstall(t *testing.T) {
+	g := NewWithT(t)
+
+	// Bundles installed with their own key load without a built-in key
+	pub, priv := newKey(t)
+	trustKey(t, "")
+
+	dir := t.TempDir()
+
+	bundle, err := rules.Install(dir, readArchive(t, newArchive(t, priv, testBundle)), pub, false)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(bundle.Version).To(Equal("2026.10.1"))
+
+	loaded, err := rules.Load(dir)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(loaded.RHBOKSupportMatrix).To(HaveLen(2))
+}
+
+func TestLoad_VerifiesSignature(t *testing.T) {
+	pub, priv := newKey(t)
+	otherPub, _ := newKey(t)
+
+	tests := []struct {
+		name    string
+		trusted string
+		tamper  map[string]string
+		wantErr string
+	}{
+		{
+			name:    "installed key without a built-in key",
+			trusted: "",
+		},
+		{
+			name:    "installed key over the built-in key",
+			trusted: otherPub,
+		},
+		{
+			name:    "tampered bundle",
+			tamper:  map[string]string{rules.BundleFile: strings.Replace(testBundle, "minVersion: 1.2.0", "minVersion: 0.1.0", 1)},
+			wantErr: "signature verification failed",
+		},
+		{
+			name:    "replaced installed key",
+			tamper:  map[string]string{rules.KeyFile: otherPub},
+			wantErr: "signature verification failed",
+		},
+		{
+			name:    "no installed key, untrusted built-in key",
+			trusted: otherPub,
+			tamper:  map[string]string{rules.KeyFile: ""},
+			wantErr: "signature verification failed",
+		},
+		{
+			name:    "no installed key, trusted built-in key",
+			trusted: pub,
+			tamper:  map[string]string{rules.KeyFile: ""},
+		},
+		{
+			name:    "no key at all",
+			tamper:  map[string]string{rules.KeyFile: ""},
+			wantErr: "no public key",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			dir := t.TempDir()
+
+			_, err := rules.Install(dir, readArchive(t, newArchive(t, priv, testBundle)), pub, false)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			// An empty content removes the file
+			for name, content := range tt.tamper {
+				if content == "" {
+					g.Expect(os.Remove(filepath.Join(dir, name))).To(Succeed())
+				} else {
+					g.Expect(os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600)).To(Succeed())
+				}
+			}
+
+			trustKey(t, tt.trusted)
+
+			bundle, err := rules.Load(dir)
+			if tt.wantErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+
+				return
+			}
+
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(bundle.Version).To(Equal("2026.10.1"))
+		})
+	}
+}
+
+func TestInstall_ReplacesUnverifiableBundle(t *testing.T) {
+	g := NewWithT(t)
+
+	pub, priv := newKey(t)
+	dir := t.TempDir()
+
+	_, err := rules.Install(dir, readArchive(t, newArchive(t, priv, testBundle)), pub, false)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(os.Remove(filepath.Join(dir, rules.KeyFile))).To(Succeed())
+
+	trustKey(t, "")
+
+	// The installed bundle cannot be verified, so the older bundle replaces it
+	_, err = rules.Install(dir, readArchive(t, newArchive(t, priv, "schemaVersion: 1\nversion: 2026.1.0\n")), pub, false)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	bundle, err := rules.Load(dir)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(bundle.Version).To(Equal("2026.1.0"))
+}
+
+func TestInstall_RejectsInvalidBundles(t *testing.T) {
+	pub, priv := newKey(t)
+	otherPub, _ := newKey(t)
+
+	tests := []struct {
+		name    string
+		bundle  string
+		key     string
+		wantErr string
+	}{
+		{name: "wrong key", bundle: testBundle, key: otherPub, wantErr: "signature verification failed"},
+		{name: "newer schema", bundle: "schemaVersion: 2\nversion: 1.0.0\n", key: pub, wantErr: "upgrade odh-cli"},
+		{name: "missing schema", bundle: "version: 1.0.0\n", key: pub, wantErr: "no schemaVersion"},
+		{name: "unknown field", bundle: "schemaVersion: 1\nversion: 1.0.0\nextra: true\n", key: pub, wantErr: "unknown field"},
+		{name: "invalid matrix", bundle: "schemaVersion: 1\nversion: 1.0.0\nrhbokSupportMatrix:\n  - rhoai: x\n    minVersion: 1.0.0\n", key: pub, wantErr: "invalid RHOAI version"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			dir := t.TempDir()
+
+			_, err := rules.Install(dir, readArchive(t, newArchive(t, priv, tt.bundle)), tt.key, false)
+			g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+
+			_, statErr := os.Stat(filepath.Join(dir, rules.BundleFile))
+			g.Expect(os.IsNotExist(statErr)).To(BeTrue())
+		})
+	}
+}
+
+func TestInstall_Downgrade(t *testing.T) {
+	g := NewWithT(t)
+
+	pub, priv := newKey(t)
+	dir := t.TempDir()
+
+	_, err := rules.Install(dir, readArchive(t, newArchive(t, priv, testBundle)), pub, false)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	older := readArchive(t, newArchive(t, priv, "schemaVersion: 1\nversion: 2026.1.0\n"))
+
+	_, err = rules.Install(dir, older, pub, false)
+	g.Expect(err).To(MatchError(ContainSubstring("older than the installed bundle")))
+
+	_, err = rules.Install(dir, older, pub, true)
+	g.Expect(err).ToNot(HaveOccurred())
+}
+
+func TestReadArchive_MissingSignature(t *testing.T) {
+	g := NewWithT(t)
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	g.Expect(tw.WriteHeader(&tar.Header{Name: rules.BundleFile, Mode: 0o644, Size: 1, Typeflag: tar.TypeReg})).To(Succeed())
+	_, _ = tw.Write([]byte("x"))
+	g.Expect(tw.Close()).To(Succeed())
+	g.Expect(gz.Close()).To(Succeed())
+
+	_, err := rules.ReadArchive(&buf)
+	g.Expect(err).To(MatchError(ContainSubstring(rules.SignatureFile)))
+}
+
+func TestBundle_Apply(t *testing.T) {
+	g := NewWithT(t)
+
+	original := kueue.RHBOKSupportMatrix()
+	t.Cleanup(func() { kueue.SetRHBOKSupportMatrix(original) })
+
+	bundle, err := rules.Parse([]byte(testBundle))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(bundle.Apply()).To(Succeed())
+
+	target := semver.MustParse("3.4.0")
+	req := kueue.RHBOKRequirementFor(&target)
+	g.Expect(req).ToNot(BeNil())
+	g.Expect(req.MinVersion.String()).To(Equal("1.2.0"))
+}
+
+const testZStreamBundle = `schemaVersion: 1
+version: 2026.10.2
+createdAt: "2026-10-02T00:00:00Z"
+zStreamMatrix:
+  deprecatedFields:
+    - rhoai: "2.22"
+      apiVersion: datasciencecluster.opendatahub.io/v1
+      kind: DataScienceCluster
+      resource: datascienceclusters
+      path: spec.components.example
+      message: example is deprecated
+  imageTagRefreshes:
+    - rhoai: "2.22"
+      tags: ["2024.2"]
+  operatorRequirements:
+    - rhoai: "2.22"
+      subscription: serverless-operator
+      minVersion: 1.36.0
+`
+
+func TestBundle_ApplyZStreamMatrix(t *testing.T) {
+	g := NewWithT(t)
+
+	original := zstream.SupportMatrix()
+	t.Cleanup(func() { zstream.SetMatrix(original) })
+
+	bundle, err := rules.Parse([]byte(testZStreamBundle))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(bundle.Apply()).To(Succeed())
+
+	from := semver.MustParse("2.19.0")
+	to := semver.MustParse("2.22.0")
+
+	fields := zstream.DeprecatedFieldsFor(&from, &to)
+	g.Expect(fields).To(HaveLen(1))
+	g.Expect(fields[0].Resource.Group).To(Equal("datasciencecluster.opendatahub.io"))
+	g.Expect(fields[0].Path).To(Equal("spec.components.example"))
+
+	g.Expect(zstream.RemovedImageTagsFor(&from, &to)).To(HaveKey("2024.2"))
+
+	reqs := zstream.OperatorRequirementsFor(&to)
+	g.Expect(reqs).To(HaveLen(1))
+	g.Expect(reqs[0].MinVersion.String()).To(Equal("1.36.0"))
+}
+
+func TestBundle_InvalidZStreamMatrix(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := rules.Parse([]byte(`schemaVersion: 1
+version: 2026.10.2
+zStreamMatrix:
+  operatorRequirements:
+    - rhoai: "2.22"
+      subscription: serverless-operator
+      minVersion: latest
+`))
+	g.Expect(err).To(MatchError(ContainSubstring("invalid minVersion")))
+}
+
+func TestBundle_ApplyKnowledgeLinks(t *testing.T) {
+	g := NewWithT(t)
+
+	t.Cleanup(func() { check.SetKnowledgeLinkOverrides(nil) })
+
+	bundle, err := rules.Parse([]byte(`schemaVersion: 1
+version: 2026.10.3
+knowledgeLinks:
+  components.codeflare.removal: ["7012345", "https://example.com/codeflare"]
+`))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(bundle.Apply()).To(Succeed())
+
+	g.Expect(check.KnowledgeLinks(codeflare.NewRemovalCheck())).To(Equal([]string{
+		check.KnowledgeBaseURL + "7012345",
+		"https://example.com/codeflare",
+	}))
+}
+
+func TestBundle_InvalidKnowledgeLinks(t *testing.T) {
+	for name, links := range map[string]string{
+		"has no links":           `components.codeflare.removal: []`,
+		"invalid knowledge link": `components.codeflare.removal: ["kcs-7012345"]`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			_, err := rules.Parse([]byte("schemaVersion: 1\nversion: 2026.10.3\nknowledgeLinks:\n  " + links + "\n"))
+			g.Expect(err).To(MatchError(ContainSubstring(name)))
+		})
+	}
+}
+
+func TestUpdateAndShowCommands(t *testing.T) {
+	g := NewWithT(t)
+
+	original := kueue.RHBOKSupportMatrix()
+	t.Cleanup(func() { kueue.SetRHBOKSupportMatrix(original) })
+
+	pub, priv := newKey(t)
+	trustKey(t, "")
+
+	dir := t.TempDir()
+
+	archivePath := filepath.Join(t.TempDir(), "rules.tar.gz")
+	g.Expect(os.WriteFile(archivePath, newArchive(t, priv, testBundle), 0o600)).To(Succeed())
+
+	keyPath := filepath.Join(t.TempDir(), "key.pub")
+	g.Expect(os.WriteFile(keyPath, []byte(pub), 0o600)).To(Succeed())
+
+	var out bytes.Buffer
+	streams := genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: &out, ErrOut: &bytes.Buffer{}}
+
+	update := rules.NewUpdateCommand(streams)
+	update.Dir = dir
+	update.From = archivePath
+	update.PublicKeyFile = keyPath
+
+	g.Expect(update.Complete()).To(Succeed())
+	g.Expect(update.Validate()).To(Succeed())
+	g.Expect(update.Run(t.Context())).To(Succeed())
+	g.Expect(out.String()).To(ContainSubstring("Installed rules bundle 2026.10.1"))
+
+	out.Reset()
+
+	show := rules.NewShowCommand(streams)
+	show.Dir = dir
+	show.OutputFormat = rules.OutputFormatTable
+
+	g.Expect(show.Complete()).To(Succeed())
+	g.Expect(show.Validate()).To(Succeed())
+	g.Expect(show.Run(t.Context())).To(Succeed())
+	g.Expect(out.String()).To(ContainSubstring("Rules bundle: 2026.10.1"))
+	g.Expect(out.String()).To(ContainSubstring("1.2.0"))
+	g.Expect(out.String()).To(ContainSubstring("Z-stream (2.x -> 2.y) upgrade matrix"))
+}
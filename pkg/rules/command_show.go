@@ -0,0 +1,237 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	printerjson "github.com/opendatahub-io/odh-cli/pkg/printer/json"
+	"github.com/opendatahub-io/odh-cli/pkg/printer/table"
+	printeryaml "github.com/opendatahub-io/odh-cli/pkg/printer/yaml"
+	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kueue"
+	"github.com/opendatahub-io/odh-cli/pkg/util/zstream"
+)
+
+var _ cmd.Command = (*ShowCommand)(nil)
+
+// OutputFormat is the output format of rules show.
+type OutputFormat string
+
+const (
+	OutputFormatTable OutputFormat = "table"
+	OutputFormatJSON  OutputFormat = "json"
+	OutputFormatYAML  OutputFormat = "yaml"
+
+	// SourceBuiltin and SourceInstalled identify where the effective data comes from.
+	SourceBuiltin   = "built-in"
+	SourceInstalled = "installed"
+)
+
+// Status describes the effective compatibility data.
+type Status struct {
+	Source             string              `json:"source"`
+	Path               string              `json:"path,omitempty"`
+	Version            string              `json:"version,omitempty"`
+	SchemaVersion      int                 `json:"schemaVersion"`
+	CreatedAt          *time.Time          `json:"createdAt,omitempty"`
+	RHBOKSupportMatrix []RHBOKRequirement  `json:"rhbokSupportMatrix"`
+	ZStreamMatrix      *ZStreamMatrix      `json:"zStreamMatrix"`
+	KnowledgeLinks     map[string][]string `json:"knowledgeLinks,omitempty"`
+}
+
+// ShowCommand reports the installed bundle and the effective compatibility data.
+type ShowCommand struct {
+	IO           iostreams.Interface
+	OutputFormat OutputFormat
+
+	// Dir is the install directory; defaults to DefaultDir.
+	Dir string
+}
+
+// NewShowCommand creates a new ShowCommand.
+func NewShowCommand(streams genericiooptions.IOStreams) *ShowCommand {
+	return &ShowCommand{
+		IO:           iostreams.NewIOStreams(streams.In, streams.Out, streams.ErrOut),
+		OutputFormat: OutputFormatTable,
+	}
+}
+
+func (c *ShowCommand) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVarP((*string)(&c.OutputFormat), "output", "o", string(OutputFormatTable), flagDescShowOutput)
+}
+
+func (c *ShowCommand) Complete() error {
+	if c.Dir != "" {
+		return nil
+	}
+
+	dir, err := DefaultDir()
+	if err != nil {
+		return err
+	}
+
+	c.Dir = dir
+
+	return nil
+}
+
+func (c *ShowCommand) Validate() error {
+	switch c.OutputFormat {
+	case OutputFormatTable, OutputFormatJSON, OutputFormatYAML:
+		return nil
+	default:
+		return fmt.Errorf("invalid output format: %s (must be one of: table, json, yaml)", c.OutputFormat)
+	}
+}
+
+func (c *ShowCommand) Run(_ context.Context) error {
+	bundle, err := Load(c.Dir)
+	if err != nil {
+		return err
+	}
+
+	status := Status{Source: SourceBuiltin, SchemaVersion: SchemaVersion}
+
+	if bundle != nil {
+		if err := bundle.Apply(); err != nil {
+			return err
+		}
+
+		status.Source = SourceInstalled
+		status.Path = c.Dir
+		status.Version = bundle.Version
+		status.SchemaVersion = bundle.SchemaVersion
+		status.CreatedAt = &bundle.CreatedAt
+	}
+
+	for _, req := range kueue.RHBOKSupportMatrix() {
+		status.RHBOKSupportMatrix = append(status.RHBOKSupportMatrix, RHBOKRequirement{
+			RHOAI:      fmt.Sprintf("%d.%d", req.RHOAIMajor, req.RHOAIMinor),
+			MinVersion: req.MinVersion.String(),
+		})
+	}
+
+	status.ZStreamMatrix = zStreamMatrixOf(zstream.SupportMatrix())
+	status.KnowledgeLinks = check.KnowledgeLinkOverrides()
+
+	switch c.OutputFormat {
+	case OutputFormatJSON:
+		return printerjson.NewRenderer(printerjson.WithWriter[Status](c.IO.Out())).Render(status)
+	case OutputFormatYAML:
+		return printeryaml.NewRenderer(printeryaml.WithWriter[Status](c.IO.Out())).Render(status)
+	default:
+		return c.outputTable(status)
+	}
+}
+
+// matrixRow is a single row of the support matrix table.
+type matrixRow struct {
+	RHOAI      string `mapstructure:"RHOAI"`
+	MinVersion string `mapstructure:"MIN RHBOK"`
+}
+
+func (c *ShowCommand) outputTable(status Status) error {
+	if status.Source == SourceInstalled {
+		c.IO.Fprintf("Rules bundle: %s (schema v%d, created %s)\n", status.Version, status.SchemaVersion,
+			status.CreatedAt.Format(time.RFC3339))
+		c.IO.Fprintf("Location:     %s\n\n", status.Path)
+	} else {
+		c.IO.Fprintf("Rules bundle: %s (no bundle installed in %s)\n\n", SourceBuiltin, c.Dir)
+	}
+
+	c.IO.Fprintf("RHBOK support matrix:\n")
+
+	renderer := table.NewRenderer(
+		table.WithWriter[matrixRow](c.IO.Out()),
+		table.WithHeaders[matrixRow]("RHOAI", "MIN RHBOK"),
+		table.WithTableOptions[matrixRow](table.DefaultTableOptions...),
+	)
+
+	for _, req := range status.RHBOKSupportMatrix {
+		if err := renderer.Append(matrixRow{RHOAI: req.RHOAI + "+", MinVersion: req.MinVersion}); err != nil {
+			return fmt.Errorf("appending matrix row: %w", err)
+		}
+	}
+
+	if err := renderer.Render(); err != nil {
+		return fmt.Errorf("rendering support matrix: %w", err)
+	}
+
+	if err := c.outputZStreamTable(status.ZStreamMatrix); err != nil {
+		return err
+	}
+
+	c.outputKnowledgeLinks(status.KnowledgeLinks)
+
+	return nil
+}
+
+// zStreamRow is a single row of the z-stream matrix table.
+type zStreamRow struct {
+	RHOAI  string `mapstructure:"RHOAI"`
+	Type   string `mapstructure:"TYPE"`
+	Detail string `mapstructure:"DETAIL"`
+}
+
+func (c *ShowCommand) outputZStreamTable(matrix *ZStreamMatrix) error {
+	c.IO.Fprintf("\nZ-stream (2.x -> 2.y) upgrade matrix:\n")
+
+	renderer := table.NewRenderer(
+		table.WithWriter[zStreamRow](c.IO.Out()),
+		table.WithHeaders[zStreamRow]("RHOAI", "TYPE", "DETAIL"),
+		table.WithTableOptions[zStreamRow](table.DefaultTableOptions...),
+	)
+
+	var rows []zStreamRow
+
+	for _, f := range matrix.DeprecatedFields {
+		rows = append(rows, zStreamRow{RHOAI: f.RHOAI, Type: "deprecated field", Detail: f.Kind + " " + f.Path})
+	}
+
+	for _, refresh := range matrix.ImageTagRefreshes {
+		rows = append(rows, zStreamRow{RHOAI: refresh.RHOAI, Type: "removed image tags", Detail: strings.Join(refresh.Tags, ", ")})
+	}
+
+	for _, req := range matrix.OperatorRequirements {
+		rows = append(rows, zStreamRow{RHOAI: req.RHOAI + "+", Type: "min operator", Detail: req.Subscription + " " + req.MinVersion})
+	}
+
+	for _, row := range rows {
+		if err := renderer.Append(row); err != nil {
+			return fmt.Errorf("appending z-stream row: %w", err)
+		}
+	}
+
+	if err := renderer.Render(); err != nil {
+		return fmt.Errorf("rendering z-stream matrix: %w", err)
+	}
+
+	return nil
+}
+
+// outputKnowledgeLinks lists the knowledge links the bundle sets for lint checks.
+func (c *ShowCommand) outputKnowledgeLinks(links map[string][]string) {
+	if len(links) == 0 {
+		return
+	}
+
+	c.IO.Fprintf("\nKnowledge link overrides:\n")
+
+	for _, id := range slices.Sorted(maps.Keys(links)) {
+		c.IO.Fprintf("  %s:\n", id)
+
+		for _, link := range links[id] {
+			c.IO.Fprintf("    - %s\n", check.KnowledgeLinkURL(link))
+		}
+	}
+}
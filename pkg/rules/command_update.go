@@ -0,0 +1,144 @@
+package rules
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/spf13/pflag"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+)
+
+var _ cmd.Command = (*UpdateCommand)(nil)
+
+// UpdateCommand installs a signed compatibility bundle from a file or URL.
+type UpdateCommand struct {
+	IO iostreams.Interface
+
+	From           string
+	FromURL        string
+	PublicKeyFile  string
+	AllowDowngrade bool
+
+	// Dir is the install directory; defaults to DefaultDir.
+	Dir string
+
+	publicKey  string
+	httpClient *http.Client
+}
+
+// NewUpdateCommand creates a new UpdateCommand.
+func NewUpdateCommand(streams genericiooptions.IOStreams) *UpdateCommand {
+	return &UpdateCommand{
+		IO:         iostreams.NewIOStreams(streams.In, streams.Out, streams.ErrOut),
+		httpClient: http.DefaultClient,
+	}
+}
+
+func (c *UpdateCommand) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&c.From, "from", "", flagDescUpdateFrom)
+	fs.StringVar(&c.FromURL, "from-url", "", flagDescUpdateFromURL)
+	fs.StringVar(&c.PublicKeyFile, "public-key", "", flagDescUpdatePublicKey)
+	fs.BoolVar(&c.AllowDowngrade, "allow-downgrade", false, flagDescUpdateAllowDowngrade)
+}
+
+// Complete resolves the install directory and the key used to verify the bundle.
+func (c *UpdateCommand) Complete() error {
+	if c.Dir == "" {
+		dir, err := DefaultDir()
+		if err != nil {
+			return err
+		}
+
+		c.Dir = dir
+	}
+
+	c.publicKey = TrustedPublicKey
+
+	if c.PublicKeyFile != "" {
+		data, err := os.ReadFile(c.PublicKeyFile)
+		if err != nil {
+			return fmt.Errorf("reading public key: %w", err)
+		}
+
+		c.publicKey = string(data)
+	}
+
+	return nil
+}
+
+func (c *UpdateCommand) Validate() error {
+	if (c.From == "") == (c.FromURL == "") {
+		return errors.New("exactly one of --from or --from-url is required")
+	}
+
+	if c.publicKey == "" {
+		return errors.New("no trusted public key is built into this binary: provide --public-key")
+	}
+
+	return nil
+}
+
+func (c *UpdateCommand) Run(ctx context.Context) error {
+	source := c.From
+	if c.FromURL != "" {
+		source = c.FromURL
+	}
+
+	reader, err := c.open(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = reader.Close() }()
+
+	archive, err := ReadArchive(reader)
+	if err != nil {
+		return fmt.Errorf("reading bundle from %s: %w", source, err)
+	}
+
+	bundle, err := Install(c.Dir, archive, c.publicKey, c.AllowDowngrade)
+	if err != nil {
+		return fmt.Errorf("installing bundle from %s: %w", source, err)
+	}
+
+	c.IO.Fprintf("Installed rules bundle %s (schema v%d) into %s\n", bundle.Version, bundle.SchemaVersion, c.Dir)
+
+	return nil
+}
+
+// open returns a reader for the bundle archive from --from or --from-url.
+func (c *UpdateCommand) open(ctx context.Context) (io.ReadCloser, error) {
+	if c.From != "" {
+		f, err := os.Open(c.From)
+		if err != nil {
+			return nil, fmt.Errorf("opening %s: %w", c.From, err)
+		}
+
+		return f, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.FromURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating request for %s: %w", c.FromURL, err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("downloading %s: %w", c.FromURL, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		_ = resp.Body.Close()
+
+		return nil, fmt.Errorf("downloading %s: %s", c.FromURL, resp.Status)
+	}
+
+	return resp.Body, nil
+}
@@ -0,0 +1,14 @@
+package rules
+
+// Flag descriptions for the rules update command.
+const (
+	flagDescUpdateFrom           = "install the bundle from this tar.gz file (for disconnected environments)"
+	flagDescUpdateFromURL        = "download and install the bundle from this URL"
+	flagDescUpdatePublicKey      = "file with the base64 ed25519 public key used to verify the bundle signature (default: the key built into the binary)"
+	flagDescUpdateAllowDowngrade = "allow installing a bundle older than the installed one"
+)
+
+// Flag descriptions for the rules show command.
+const (
+	flagDescShowOutput = "output format (table|json|yaml)"
+)
@@ -0,0 +1,233 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/blang/semver/v4"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kueue"
+	"github.com/opendatahub-io/odh-cli/pkg/util/zstream"
+)
+
+const (
+	// EnvConfigDir overrides the directory the bundle is installed into.
+	EnvConfigDir = "ODH_CONFIG_DIR"
+
+	configDirName = "odh"
+	rulesDirName  = "rules"
+
+	dirMode  = 0o755
+	fileMode = 0o644
+)
+
+// errNoPublicKey is returned by Load for a bundle installed without a key by a binary
+// without a built-in key.
+var errNoPublicKey = errors.New("no public key to verify it")
+
+// DefaultDir returns the directory installed bundles are stored in:
+// $ODH_CONFIG_DIR/rules, or <user config dir>/odh/rules.
+func DefaultDir() (string, error) {
+	if dir := os.Getenv(EnvConfigDir); dir != "" {
+		return filepath.Join(dir, rulesDirName), nil
+	}
+
+	base, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("resolving user config dir: %w", err)
+	}
+
+	return filepath.Join(base, configDirName, rulesDirName), nil
+}
+
+// Install verifies an archive against publicKey and writes it to dir, along with publicKey
+// so the bundle is verified against the same key when loaded.
+// A bundle older than the installed one is rejected unless allowDowngrade is set.
+func Install(dir string, archive *Archive, publicKey string, allowDowngrade bool) (*Bundle, error) {
+	if err := Verify(archive.Data, archive.Signature, publicKey); err != nil {
+		return nil, err
+	}
+
+	bundle, err := Parse(archive.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	// An installed bundle that cannot be verified is replaced without the downgrade check
+	installed, err := Load(dir)
+	if err != nil && !errors.Is(err, ErrSignature) && !errors.Is(err, errNoPublicKey) {
+		return nil, err
+	}
+
+	if installed != nil && !allowDowngrade {
+		if err := checkNotOlder(bundle, installed); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := os.MkdirAll(dir, dirMode); err != nil {
+		return nil, fmt.Errorf("creating %s: %w", dir, err)
+	}
+
+	if err := writeFileAtomic(filepath.Join(dir, BundleFile), archive.Data); err != nil {
+		return nil, err
+	}
+
+	if err := writeFileAtomic(filepath.Join(dir, SignatureFile), archive.Signature); err != nil {
+		return nil, err
+	}
+
+	if err := writeFileAtomic(filepath.Join(dir, KeyFile), []byte(strings.TrimSpace(publicKey)+"\n")); err != nil {
+		return nil, err
+	}
+
+	return bundle, nil
+}
+
+// Load returns the bundle installed in dir, or nil if none is installed. The bundle is
+// verified before it is parsed against the key it was installed with, or TrustedPublicKey
+// for bundles installed without one, so a bundle modified after it was installed is rejected.
+func Load(dir string) (*Bundle, error) {
+	data, err := os.ReadFile(filepath.Join(dir, BundleFile))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("reading installed bundle: %w", err)
+	}
+
+	signature, err := os.ReadFile(filepath.Join(dir, SignatureFile))
+	if err != nil {
+		return nil, fmt.Errorf("reading installed bundle signature: %w", err)
+	}
+
+	publicKey := TrustedPublicKey
+
+	key, err := os.ReadFile(filepath.Join(dir, KeyFile))
+
+	switch {
+	case err == nil:
+		publicKey = string(key)
+	case !errors.Is(err, fs.ErrNotExist):
+		return nil, fmt.Errorf("reading installed bundle key: %w", err)
+	}
+
+	if strings.TrimSpace(publicKey) == "" {
+		return nil, fmt.Errorf("installed bundle in %s: %w: reinstall it with rules update", dir, errNoPublicKey)
+	}
+
+	if err := Verify(data, signature, publicKey); err != nil {
+		return nil, fmt.Errorf("installed bundle in %s: %w", dir, err)
+	}
+
+	bundle, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("installed bundle in %s: %w", dir, err)
+	}
+
+	return bundle, nil
+}
+
+// ApplyInstalled loads the bundle from DefaultDir, if any, and makes its data effective
+// for this process. Returns the applied bundle, or nil when the built-in data is used.
+func ApplyInstalled() (*Bundle, error) {
+	dir, err := DefaultDir()
+	if err != nil {
+		return nil, err
+	}
+
+	bundle, err := Load(dir)
+	if err != nil || bundle == nil {
+		return nil, err
+	}
+
+	if err := bundle.Apply(); err != nil {
+		return nil, err
+	}
+
+	return bundle, nil
+}
+
+// Apply replaces the built-in compatibility data with the bundle's data.
+// Sections the bundle does not define keep their built-in values.
+func (b *Bundle) Apply() error {
+	if b.ZStreamMatrix != nil {
+		matrix, err := b.ZStreamMatrix.toMatrix()
+		if err != nil {
+			return err
+		}
+
+		zstream.SetMatrix(matrix)
+	}
+
+	if len(b.KnowledgeLinks) > 0 {
+		check.SetKnowledgeLinkOverrides(b.KnowledgeLinks)
+	}
+
+	if len(b.RHBOKSupportMatrix) == 0 {
+		return nil
+	}
+
+	matrix := make([]kueue.RHBOKRequirement, 0, len(b.RHBOKSupportMatrix))
+
+	for _, req := range b.RHBOKSupportMatrix {
+		rhoai, err := semver.ParseTolerant(req.RHOAI)
+		if err != nil {
+			return fmt.Errorf("invalid RHOAI version %q: %w", req.RHOAI, err)
+		}
+
+		minVersion, err := semver.ParseTolerant(req.MinVersion)
+		if err != nil {
+			return fmt.Errorf("invalid minVersion %q: %w", req.MinVersion, err)
+		}
+
+		matrix = append(matrix, kueue.RHBOKRequirement{
+			RHOAIMajor: rhoai.Major,
+			RHOAIMinor: rhoai.Minor,
+			MinVersion: minVersion,
+		})
+	}
+
+	kueue.SetRHBOKSupportMatrix(matrix)
+
+	return nil
+}
+
+func checkNotOlder(bundle *Bundle, installed *Bundle) error {
+	next, err := bundle.SemVer()
+	if err != nil {
+		return err
+	}
+
+	current, err := installed.SemVer()
+	if err != nil {
+		return err
+	}
+
+	if next.LT(current) {
+		return fmt.Errorf("bundle %s is older than the installed bundle %s (use --allow-downgrade to install it anyway)", next, current)
+	}
+
+	return nil
+}
+
+// writeFileAtomic writes data to a temporary file and renames it into place.
+func writeFileAtomic(name string, data []byte) error {
+	tmp := name + ".tmp"
+
+	if err := os.WriteFile(tmp, data, fileMode); err != nil {
+		return fmt.Errorf("writing %s: %w", tmp, err)
+	}
+
+	if err := os.Rename(tmp, name); err != nil {
+		return fmt.Errorf("installing %s: %w", name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,150 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/blang/semver/v4"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/zstream"
+)
+
+// ZStreamMatrix is the support matrix for upgrades between 2.x releases in bundle form.
+// Each entry is gated on the RHOAI release that introduces it.
+type ZStreamMatrix struct {
+	// DeprecatedFields lists resource fields deprecated by a 2.x release.
+	DeprecatedFields []DeprecatedField `json:"deprecatedFields,omitempty"`
+
+	// ImageTagRefreshes lists the workbench image tags a 2.x release stops shipping.
+	ImageTagRefreshes []ImageTagRefresh `json:"imageTagRefreshes,omitempty"`
+
+	// OperatorRequirements lists the minimum dependent operator versions per 2.x release.
+	OperatorRequirements []OperatorRequirement `json:"operatorRequirements,omitempty"`
+}
+
+// DeprecatedField is a deprecated resource field in bundle form.
+type DeprecatedField struct {
+	RHOAI      string `json:"rhoai"`
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Resource   string `json:"resource"`
+	Path       string `json:"path"`
+	Message    string `json:"message"`
+}
+
+// ImageTagRefresh is a set of removed workbench image tags in bundle form.
+type ImageTagRefresh struct {
+	RHOAI string   `json:"rhoai"`
+	Tags  []string `json:"tags"`
+}
+
+// OperatorRequirement is a minimum dependent operator version in bundle form.
+type OperatorRequirement struct {
+	RHOAI        string `json:"rhoai"`
+	Subscription string `json:"subscription"`
+	MinVersion   string `json:"minVersion"`
+}
+
+// toMatrix converts the bundle form into the z-stream support matrix, validating each entry.
+func (m *ZStreamMatrix) toMatrix() (zstream.Matrix, error) {
+	var matrix zstream.Matrix
+
+	for _, f := range m.DeprecatedFields {
+		release, err := zstream.ParseRelease(f.RHOAI)
+		if err != nil {
+			return zstream.Matrix{}, fmt.Errorf("zStreamMatrix.deprecatedFields: %w", err)
+		}
+
+		gv, err := schema.ParseGroupVersion(f.APIVersion)
+		if err != nil {
+			return zstream.Matrix{}, fmt.Errorf("zStreamMatrix.deprecatedFields: invalid apiVersion %q: %w", f.APIVersion, err)
+		}
+
+		if f.Kind == "" || f.Resource == "" || f.Path == "" {
+			return zstream.Matrix{}, fmt.Errorf("zStreamMatrix.deprecatedFields: entry for %s requires kind, resource and path", f.RHOAI)
+		}
+
+		matrix.DeprecatedFields = append(matrix.DeprecatedFields, zstream.DeprecatedField{
+			Release: release,
+			Resource: resources.ResourceType{
+				Group:    gv.Group,
+				Version:  gv.Version,
+				Kind:     f.Kind,
+				Resource: f.Resource,
+			},
+			Path:    f.Path,
+			Message: f.Message,
+		})
+	}
+
+	for _, refresh := range m.ImageTagRefreshes {
+		release, err := zstream.ParseRelease(refresh.RHOAI)
+		if err != nil {
+			return zstream.Matrix{}, fmt.Errorf("zStreamMatrix.imageTagRefreshes: %w", err)
+		}
+
+		matrix.ImageTagRefreshes = append(matrix.ImageTagRefreshes, zstream.ImageTagRefresh{
+			Release: release,
+			Tags:    refresh.Tags,
+		})
+	}
+
+	for _, req := range m.OperatorRequirements {
+		release, err := zstream.ParseRelease(req.RHOAI)
+		if err != nil {
+			return zstream.Matrix{}, fmt.Errorf("zStreamMatrix.operatorRequirements: %w", err)
+		}
+
+		minVersion, err := semver.ParseTolerant(req.MinVersion)
+		if err != nil {
+			return zstream.Matrix{}, fmt.Errorf("zStreamMatrix.operatorRequirements: invalid minVersion %q: %w", req.MinVersion, err)
+		}
+
+		if req.Subscription == "" {
+			return zstream.Matrix{}, fmt.Errorf("zStreamMatrix.operatorRequirements: entry for %s requires subscription", req.RHOAI)
+		}
+
+		matrix.OperatorRequirements = append(matrix.OperatorRequirements, zstream.OperatorRequirement{
+			Release:      release,
+			Subscription: req.Subscription,
+			MinVersion:   minVersion,
+		})
+	}
+
+	return matrix, nil
+}
+
+// zStreamMatrixOf converts the effective z-stream support matrix into bundle form.
+func zStreamMatrixOf(m zstream.Matrix) *ZStreamMatrix {
+	out := &ZStreamMatrix{}
+
+	for _, f := range m.DeprecatedFields {
+		out.DeprecatedFields = append(out.DeprecatedFields, DeprecatedField{
+			RHOAI:      f.Release.String(),
+			APIVersion: f.Resource.APIVersion(),
+			Kind:       f.Resource.Kind,
+			Resource:   f.Resource.Resource,
+			Path:       f.Path,
+			Message:    f.Message,
+		})
+	}
+
+	for _, refresh := range m.ImageTagRefreshes {
+		out.ImageTagRefreshes = append(out.ImageTagRefreshes, ImageTagRefresh{
+			RHOAI: refresh.Release.String(),
+			Tags:  refresh.Tags,
+		})
+	}
+
+	for _, req := range m.OperatorRequirements {
+		out.OperatorRequirements = append(out.OperatorRequirements, OperatorRequirement{
+			RHOAI:        req.Release.String(),
+			Subscription: req.Subscription,
+			MinVersion:   req.MinVersion.String(),
+		})
+	}
+
+	return out
+}
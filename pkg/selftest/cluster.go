@@ -0,0 +1,151 @@
+package selftest
+
+import (
+	"fmt"
+	"sort"
+
+	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	operatorfake "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/clientset/versioned/fake"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	discoveryfake "k8s.io/client-go/discovery/fake"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	metadatafake "k8s.io/client-go/metadata/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube"
+)
+
+// knownResources are the resource types the simulated cluster serves. Checks may list
+// any of them, and the fake dynamic client requires a list kind for every listed resource.
+//
+//nolint:gochecknoglobals // Fixed set of simulated API resources
+var knownResources = []resources.ResourceType{
+	resources.DataScienceCluster,
+	resources.DSCInitialization,
+	resources.DataSciencePipelinesApplicationV1,
+	resources.DataSciencePipelinesApplicationV1Alpha1,
+	resources.Deployment,
+	resources.Namespace,
+	resources.Node,
+	resources.Pod,
+	resources.Service,
+	resources.ConfigMap,
+	resources.Secret,
+	resources.PersistentVolumeClaim,
+	resources.StorageClass,
+	resources.Notebook,
+	resources.CustomResourceDefinition,
+	resources.ClusterServiceVersion,
+	resources.Subscription,
+	resources.InstallPlan,
+	resources.ClusterQueue,
+	resources.LocalQueue,
+	resources.InferenceService,
+	resources.HTTPRoute,
+	resources.GatewayClass,
+	resources.ServingRuntime,
+	resources.RayCluster,
+	resources.PyTorchJob,
+	resources.GuardrailsOrchestrator,
+	resources.AppWrapper,
+	resources.ClusterVersion,
+	resources.ClusterOperator,
+	resources.Proxy,
+	resources.AcceleratorProfile,
+	resources.HardwareProfile,
+	resources.InfrastructureHardwareProfile,
+	resources.OdhApplication,
+	resources.OdhDocument,
+	resources.OdhQuickStart,
+	resources.LlamaStackDistribution,
+	resources.ImageStream,
+	resources.ImageDigestMirrorSet,
+	resources.ImageTagMirrorSet,
+	resources.ImageContentSourcePolicy,
+	resources.ImageStreamTag,
+	resources.Template,
+	resources.PrometheusRule,
+	resources.GrafanaDashboard,
+}
+
+// NewCluster returns a client backed by in-memory fake API servers seeded with objects.
+// CustomResourceDefinitions, Subscriptions and ClusterServiceVersions among the objects are
+// also served by the API extensions and OLM clients, so workload and operator lookups find them.
+func NewCluster(objects []*unstructured.Unstructured) (client.Client, error) {
+	scheme := runtime.NewScheme()
+	_ = metav1.AddMetaToScheme(scheme)
+
+	listKinds := make(map[schema.GroupVersionResource]string, len(knownResources))
+	apiResources := make(map[string]*metav1.APIResourceList)
+
+	for _, rt := range knownResources {
+		listKinds[rt.GVR()] = rt.ListKind()
+
+		list, ok := apiResources[rt.APIVersion()]
+		if !ok {
+			list = &metav1.APIResourceList{GroupVersion: rt.APIVersion()}
+			apiResources[rt.APIVersion()] = list
+		}
+
+		list.APIResources = append(list.APIResources, metav1.APIResource{
+			Name:    rt.Resource,
+			Kind:    rt.Kind,
+			Group:   rt.Group,
+			Version: rt.Version,
+			Verbs:   metav1.Verbs{"get", "list"},
+		})
+	}
+
+	dynamicObjs := make([]runtime.Object, 0, len(objects))
+
+	var crdObjs, olmObjs []runtime.Object
+
+	for _, obj := range objects {
+		dynamicObjs = append(dynamicObjs, obj)
+
+		var typed runtime.Object
+
+		switch obj.GroupVersionKind() {
+		case resources.CustomResourceDefinition.GVK():
+			typed = &apiextensionsv1.CustomResourceDefinition{}
+			crdObjs = append(crdObjs, typed)
+		case resources.Subscription.GVK():
+			typed = &operatorsv1alpha1.Subscription{}
+			olmObjs = append(olmObjs, typed)
+		case resources.ClusterServiceVersion.GVK():
+			typed = &operatorsv1alpha1.ClusterServiceVersion{}
+			olmObjs = append(olmObjs, typed)
+		default:
+			continue
+		}
+
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, typed); err != nil {
+			return nil, fmt.Errorf("converting %s %s: %w", obj.GetKind(), obj.GetName(), err)
+		}
+	}
+
+	discovery := &discoveryfake.FakeDiscovery{Fake: &clienttesting.Fake{}}
+	for _, list := range apiResources {
+		discovery.Resources = append(discovery.Resources, list)
+	}
+
+	sort.Slice(discovery.Resources, func(i, j int) bool {
+		return discovery.Resources[i].GroupVersion < discovery.Resources[j].GroupVersion
+	})
+
+	return client.NewForTesting(client.TestClientConfig{
+		Dynamic:       dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme, listKinds, dynamicObjs...),
+		Metadata:      metadatafake.NewSimpleMetadataClient(scheme, kube.ToPartialObjectMetadata(objects...)...),
+		Discovery:     discovery,
+		APIExtensions: apiextensionsfake.NewClientset(crdObjs...),
+		OLM:           operatorfake.NewSimpleClientset(olmObjs...), //nolint:staticcheck // NewClientset requires generated apply configs not available in OLM
+	}), nil
+}
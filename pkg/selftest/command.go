@@ -0,0 +1,297 @@
+package selftest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/yaml"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/printer/table"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+)
+
+var _ cmd.Command = (*Command)(nil)
+
+// DefaultTimeout bounds the whole self-test run.
+const DefaultTimeout = time.Minute
+
+// Command runs the full lint check suite against simulated clusters and verifies
+// that the checks execute and that every output format renders and parses back.
+type Command struct {
+	IO iostreams.Interface
+
+	// Scenarios limits the run to the named scenarios; empty runs all of them.
+	Scenarios []string
+
+	// Verbose prints the lint table output of each scenario.
+	Verbose bool
+
+	Timeout time.Duration
+
+	selected []Scenario
+}
+
+// NewCommand creates a new Command with defaults.
+func NewCommand(streams genericiooptions.IOStreams) *Command {
+	return &Command{
+		IO:      iostreams.NewIOStreams(streams.In, streams.Out, streams.ErrOut),
+		Timeout: DefaultTimeout,
+	}
+}
+
+func (c *Command) AddFlags(fs *pflag.FlagSet) {
+	fs.StringSliceVar(&c.Scenarios, "scenario", nil, flagDescScenario)
+	fs.BoolVarP(&c.Verbose, "verbose", "v", false, flagDescVerbose)
+	fs.DurationVar(&c.Timeout, "timeout", c.Timeout, flagDescTimeout)
+}
+
+func (c *Command) Complete() error {
+	all := Scenarios()
+
+	if len(c.Scenarios) == 0 {
+		c.selected = all
+
+		return nil
+	}
+
+	c.selected = nil
+
+	for _, s := range all {
+		if slices.Contains(c.Scenarios, s.Name) {
+			c.selected = append(c.selected, s)
+		}
+	}
+
+	return nil
+}
+
+func (c *Command) Validate() error {
+	if c.Timeout <= 0 {
+		return errors.New("timeout must be greater than 0")
+	}
+
+	known := make([]string, 0, len(Scenarios()))
+	for _, s := range Scenarios() {
+		known = append(known, s.Name)
+	}
+
+	for _, name := range c.Scenarios {
+		if !slices.Contains(known, name) {
+			return fmt.Errorf("unknown scenario %q (must be one of: %s)", name, strings.Join(known, ", "))
+		}
+	}
+
+	return nil
+}
+
+// scenarioRow is a single row of the self-test summary table.
+type scenarioRow struct {
+	Scenario string `mapstructure:"SCENARIO"`
+	Mode     string `mapstructure:"MODE"`
+	Checks   int    `mapstructure:"CHECKS"`
+	Blocking int    `mapstructure:"BLOCKING"`
+	Advisory int    `mapstructure:"ADVISORY"`
+	Result   string `mapstructure:"RESULT"`
+}
+
+func (c *Command) Run(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+
+	renderer := table.NewRenderer(
+		table.WithWriter[scenarioRow](c.IO.Out()),
+		table.WithHeaders[scenarioRow]("SCENARIO", "MODE", "CHECKS", "BLOCKING", "ADVISORY", "RESULT"),
+		table.WithTableOptions[scenarioRow](table.DefaultTableOptions...),
+	)
+
+	failed := 0
+
+	for _, s := range c.selected {
+		report := c.runScenario(ctx, s)
+
+		row := scenarioRow{
+			Scenario: s.Name,
+			Mode:     s.Mode(),
+			Checks:   report.Checks,
+			Blocking: report.Blocking,
+			Advisory: report.Advisory,
+			Result:   "pass",
+		}
+
+		if len(report.Problems) > 0 {
+			failed++
+			row.Result = "FAIL: " + strings.Join(report.Problems, "; ")
+		}
+
+		if err := renderer.Append(row); err != nil {
+			return fmt.Errorf("appending scenario row: %w", err)
+		}
+	}
+
+	if err := renderer.Render(); err != nil {
+		return fmt.Errorf("rendering self-test results: %w", err)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("self-test failed: %d of %d scenarios did not pass", failed, len(c.selected))
+	}
+
+	c.IO.Fprintf("\nSelf-test passed: %d scenarios\n", len(c.selected))
+
+	return nil
+}
+
+// ScenarioReport is the outcome of running the check suite for one scenario.
+type ScenarioReport struct {
+	Checks   int
+	Blocking int
+	Advisory int
+	Problems []string
+
+	// table is the table output of the run, printed with --verbose.
+	table []byte
+}
+
+// runScenario seeds a simulated cluster and verifies the scenario against it.
+func (c *Command) runScenario(ctx context.Context, s Scenario) ScenarioReport {
+	objects, err := s.Objects()
+	if err != nil {
+		return ScenarioReport{Problems: []string{err.Error()}}
+	}
+
+	cluster, err := NewCluster(objects)
+	if err != nil {
+		return ScenarioReport{Problems: []string{err.Error()}}
+	}
+
+	report := Verify(ctx, s, cluster)
+
+	if c.Verbose && report.table != nil {
+		c.IO.Fprintf("=== %s (%s)\n", s.Name, s.Description)
+		_, _ = c.IO.Out().Write(report.table)
+		c.IO.Fprintln()
+	}
+
+	return report
+}
+
+// Verify runs lint once per output format against a cluster seeded with the objects of the
+// scenario, and verifies the results: the outputs parse and agree, every check executed, and
+// blocking findings are reported if and only if the scenario expects them.
+func Verify(ctx context.Context, s Scenario, cluster client.Client) ScenarioReport {
+	var report ScenarioReport
+
+	outputs := make(map[lint.OutputFormat][]byte)
+
+	for _, format := range []lint.OutputFormat{lint.OutputFormatTable, lint.OutputFormatJSON, lint.OutputFormatYAML} {
+		out, err := runLint(ctx, cluster, s.TargetVersion, format)
+		if err != nil {
+			report.Problems = append(report.Problems, fmt.Sprintf("%s output: %v", format, err))
+
+			return report
+		}
+
+		outputs[format] = out
+	}
+
+	report.table = outputs[lint.OutputFormatTable]
+
+	if !bytes.Contains(outputs[lint.OutputFormatTable], []byte("Summary:")) {
+		report.Problems = append(report.Problems, "table output has no summary")
+	}
+
+	var fromJSON, fromYAML result.DiagnosticResultList
+
+	if err := json.Unmarshal(outputs[lint.OutputFormatJSON], &fromJSON); err != nil {
+		report.Problems = append(report.Problems, fmt.Sprintf("parsing JSON output: %v", err))
+
+		return report
+	}
+
+	if err := yaml.Unmarshal(outputs[lint.OutputFormatYAML], &fromYAML); err != nil {
+		report.Problems = append(report.Problems, fmt.Sprintf("parsing YAML output: %v", err))
+
+		return report
+	}
+
+	if len(fromJSON.Results) != len(fromYAML.Results) {
+		report.Problems = append(report.Problems,
+			fmt.Sprintf("JSON has %d results but YAML has %d", len(fromJSON.Results), len(fromYAML.Results)))
+	}
+
+	report.Checks = len(fromJSON.Results)
+	if report.Checks == 0 {
+		report.Problems = append(report.Problems, "no checks were executed")
+	}
+
+	for _, r := range fromJSON.Results {
+		for _, cond := range r.Status.Conditions {
+			if cond.Reason == check.ReasonCheckExecutionFailed {
+				report.Problems = append(report.Problems,
+					fmt.Sprintf("%s/%s/%s did not execute: %s", r.Group, r.Kind, r.Name, cond.Message))
+			}
+		}
+
+		if impact := r.GetImpact(); impact != nil {
+			switch *impact {
+			case string(result.ImpactBlocking):
+				report.Blocking++
+			case string(result.ImpactAdvisory):
+				report.Advisory++
+			}
+		}
+	}
+
+	if s.WantBlocking != (report.Blocking > 0) {
+		report.Problems = append(report.Problems,
+			fmt.Sprintf("expected blocking findings: %t, got %d", s.WantBlocking, report.Blocking))
+	}
+
+	return report
+}
+
+// runLint runs the lint command against cluster and returns what it wrote to stdout.
+func runLint(ctx context.Context, cluster client.Client, targetVersion string, format lint.OutputFormat) ([]byte, error) {
+	var out bytes.Buffer
+
+	streams := genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: &out, ErrOut: io.Discard}
+
+	command := lint.NewCommand(streams, genericclioptions.NewConfigFlags(false),
+		lint.WithClient(cluster),
+		lint.WithTargetVersion(targetVersion),
+	)
+	command.OutputFormat = format
+
+	// Findings are expected in the scenarios; they are verified from the output instead.
+	command.FailOnCritical = false
+
+	if err := command.Complete(); err != nil {
+		return nil, fmt.Errorf("completing lint: %w", err)
+	}
+
+	if err := command.Validate(); err != nil {
+		return nil, fmt.Errorf("validating lint: %w", err)
+	}
+
+	if err := command.Run(ctx); err != nil {
+		return nil, fmt.Errorf("running lint: %w", err)
+	}
+
+	return out.Bytes(), nil
+}
@@ -0,0 +1,8 @@
+package selftest
+
+// Flag descriptions for the selftest command.
+const (
+	flagDescScenario = "run only the named scenarios (repeatable or comma-separated; default: all)"
+	flagDescVerbose  = "print the lint table output of each scenario"
+	flagDescTimeout  = "maximum duration of the self-test run"
+)
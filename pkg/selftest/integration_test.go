@@ -0,0 +1,428 @@
+//go:build integration
+
+package selftest_test
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/selftest"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+// The tests in this file run the lint command against a real API server started with envtest,
+// seeded with CRDs and the objects of the self-test fixture bundles, to exercise the dynamic,
+// metadata, API extensions and OLM clients, discovery and serializers end-to-end: pagination,
+// RBAC and multi-version CRDs are not simulated by the fake clients of the unit tests.
+// Run them with `make integration-test`, which downloads the envtest binaries.
+
+// crdTypes are the custom resource types CRDs are generated for when the fixtures contain
+// objects of that type and do not define the CRD themselves.
+//
+//nolint:gochecknoglobals // Fixed set of CRD-backed resource types
+var crdTypes = []resources.ResourceType{
+	resources.DataScienceCluster,
+	resources.DSCInitialization,
+	resources.DataSciencePipelinesApplicationV1,
+	resources.Notebook,
+	resources.InferenceService,
+	resources.ServingRuntime,
+	resources.RayCluster,
+	resources.PyTorchJob,
+	resources.AppWrapper,
+	resources.GuardrailsOrchestrator,
+	resources.LlamaStackDistribution,
+	resources.ClusterVersion,
+	resources.ClusterOperator,
+	resources.Proxy,
+	resources.Subscription,
+	resources.ClusterServiceVersion,
+	resources.AcceleratorProfile,
+	resources.HardwareProfile,
+}
+
+// workloadTypes are the CRDs labeled as ODH workloads, discovered by lint mode.
+//
+//nolint:gochecknoglobals // Fixed set of workload resource types
+var workloadTypes = []resources.ResourceType{
+	resources.Notebook,
+	resources.InferenceService,
+	resources.RayCluster,
+	resources.PyTorchJob,
+	resources.AppWrapper,
+	resources.DataSciencePipelinesApplicationV1,
+}
+
+// clusterScopedTypes are the types of crdTypes that are not namespaced.
+//
+//nolint:gochecknoglobals // Fixed set of cluster-scoped resource types
+var clusterScopedTypes = []resources.ResourceType{
+	resources.DataScienceCluster,
+	resources.DSCInitialization,
+	resources.ClusterVersion,
+	resources.ClusterOperator,
+	resources.Proxy,
+}
+
+// workloadLabel marks the CRDs of ODH workloads, as set by the operator.
+const workloadLabel = "platform.opendatahub.io/part-of"
+
+// startEnvironment starts an API server with envtest and installs the CRDs. It is stopped
+// when the test ends.
+func startEnvironment(t *testing.T, crds []*apiextensionsv1.CustomResourceDefinition) *envtest.Environment {
+	t.Helper()
+
+	if os.Getenv("KUBEBUILDER_ASSETS") == "" {
+		t.Skip("KUBEBUILDER_ASSETS is not set; run with `make integration-test`")
+	}
+
+	env := &envtest.Environment{
+		CRDs:                  crds,
+		ErrorIfCRDPathMissing: true,
+	}
+
+	if _, err := env.Start(); err != nil {
+		t.Fatalf("starting envtest: %v", err)
+	}
+
+	t.Cleanup(func() {
+		if err := env.Stop(); err != nil {
+			t.Errorf("stopping envtest: %v", err)
+		}
+	})
+
+	return env
+}
+
+// generateCRD returns a CRD serving rt with a schema preserving unknown fields. The CRD has
+// no status subresource, so the status of seeded objects is kept on create.
+func generateCRD(rt resources.ResourceType, versions ...string) *apiextensionsv1.CustomResourceDefinition {
+	scope := apiextensionsv1.NamespaceScoped
+	if slices.Contains(clusterScopedTypes, rt) {
+		scope = apiextensionsv1.ClusterScoped
+	}
+
+	if len(versions) == 0 {
+		versions = []string{rt.Version}
+	}
+
+	crd := &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: rt.Resource + "." + rt.Group},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: rt.Group,
+			Names: apiextensionsv1.CustomResourceDefinitionNames{
+				Kind:     rt.Kind,
+				ListKind: rt.ListKind(),
+				Plural:   rt.Resource,
+				Singular: strings.ToLower(rt.Kind),
+			},
+			Scope: scope,
+		},
+	}
+
+	for i, version := range versions {
+		crd.Spec.Versions = append(crd.Spec.Versions, apiextensionsv1.CustomResourceDefinitionVersion{
+			Name:    version,
+			Served:  true,
+			Storage: i == 0,
+			Schema:  preserveUnknownFields(),
+		})
+	}
+
+	if slices.Contains(workloadTypes, rt) {
+		crd.Labels = map[string]string{workloadLabel: strings.ToLower(rt.Kind)}
+	}
+
+	return crd
+}
+
+// preserveUnknownFields returns a schema accepting any object.
+func preserveUnknownFields() *apiextensionsv1.CustomResourceValidation {
+	preserve := true
+
+	return &apiextensionsv1.CustomResourceValidation{
+		OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+			Type:                   "object",
+			XPreserveUnknownFields: &preserve,
+		},
+	}
+}
+
+// fixtureCRDs returns the CRDs of the fixture objects: the CRDs they define, completed with
+// a schema when they have none, and generated CRDs for the other custom resource types.
+func fixtureCRDs(t *testing.T, objects []*unstructured.Unstructured) []*apiextensionsv1.CustomResourceDefinition {
+	t.Helper()
+
+	var crds []*apiextensionsv1.CustomResourceDefinition
+
+	defined := make(map[string]bool)
+
+	for _, obj := range objects {
+		if obj.GroupVersionKind() != resources.CustomResourceDefinition.GVK() {
+			continue
+		}
+
+		crd := &apiextensionsv1.CustomResourceDefinition{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, crd); err != nil {
+			t.Fatalf("converting CRD %s: %v", obj.GetName(), err)
+		}
+
+		for i := range crd.Spec.Versions {
+			if crd.Spec.Versions[i].Schema == nil {
+				crd.Spec.Versions[i].Schema = preserveUnknownFields()
+			}
+		}
+
+		// Established conditions and stored versions are set by the API server
+		crd.Status = apiextensionsv1.CustomResourceDefinitionStatus{}
+		crds = append(crds, crd)
+		defined[crd.Spec.Group+"/"+crd.Spec.Names.Kind] = true
+	}
+
+	for _, rt := range crdTypes {
+		if defined[rt.Group+"/"+rt.Kind] {
+			continue
+		}
+
+		used := slices.ContainsFunc(objects, func(obj *unstructured.Unstructured) bool {
+			return obj.GroupVersionKind().GroupKind() == rt.GVK().GroupKind()
+		})
+
+		if used || slices.Contains(workloadTypes, rt) {
+			crds = append(crds, generateCRD(rt))
+		}
+	}
+
+	return crds
+}
+
+// seed creates the objects other than CRDs, creating missing namespaces first.
+func seed(t *testing.T, cfg *rest.Config, objects []*unstructured.Unstructured) {
+	t.Helper()
+
+	ctx := t.Context()
+
+	dyn, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		t.Fatalf("creating dynamic client: %v", err)
+	}
+
+	cl, err := client.NewClientWithConfig(cfg)
+	if err != nil {
+		t.Fatalf("creating client: %v", err)
+	}
+
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(cl.Discovery()))
+
+	for _, obj := range objects {
+		if ns := obj.GetNamespace(); ns != "" {
+			createNamespace(t, dyn, ns)
+		}
+	}
+
+	for _, obj := range objects {
+		if obj.GroupVersionKind() == resources.CustomResourceDefinition.GVK() {
+			continue
+		}
+
+		mapping, err := mapper.RESTMapping(obj.GroupVersionKind().GroupKind(), obj.GroupVersionKind().Version)
+		if err != nil {
+			t.Fatalf("mapping %s: %v", obj.GroupVersionKind(), err)
+		}
+
+		var ri dynamic.ResourceInterface = dyn.Resource(mapping.Resource)
+		if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+			ri = dyn.Resource(mapping.Resource).Namespace(obj.GetNamespace())
+		}
+
+		if _, err := ri.Create(ctx, obj.DeepCopy(), metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+			t.Fatalf("creating %s %s/%s: %v", obj.GetKind(), obj.GetNamespace(), obj.GetName(), err)
+		}
+	}
+}
+
+// createNamespace creates a namespace unless it exists.
+func createNamespace(t *testing.T, dyn dynamic.Interface, name string) {
+	t.Helper()
+
+	ns := resources.Namespace.Unstructured()
+	ns.SetName(name)
+
+	_, err := dyn.Resource(resources.Namespace.GVR()).Create(t.Context(), &ns, metav1.CreateOptions{})
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		t.Fatalf("creating namespace %s: %v", name, err)
+	}
+}
+
+// newScenarioCluster starts an API server seeded with the objects of a self-test scenario
+// and returns a client for it.
+func newScenarioCluster(t *testing.T, s selftest.Scenario) (*envtest.Environment, client.Client) {
+	t.Helper()
+
+	objects, err := s.Objects()
+	if err != nil {
+		t.Fatalf("loading scenario %s: %v", s.Name, err)
+	}
+
+	env := startEnvironment(t, fixtureCRDs(t, objects))
+	seed(t, env.Config, objects)
+
+	cl, err := client.NewClientWithConfig(env.Config)
+	if err != nil {
+		t.Fatalf("creating client: %v", err)
+	}
+
+	return env, cl
+}
+
+func TestIntegration_Scenarios(t *testing.T) {
+	for _, s := range selftest.Scenarios() {
+		t.Run(s.Name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			_, cl := newScenarioCluster(t, s)
+
+			report := selftest.Verify(t.Context(), s, cl)
+			g.Expect(report.Problems).To(BeEmpty())
+			g.Expect(report.Checks).To(BeNumerically(">", 0))
+		})
+	}
+}
+
+func TestIntegration_Pagination(t *testing.T) {
+	g := NewWithT(t)
+
+	env := startEnvironment(t, []*apiextensionsv1.CustomResourceDefinition{generateCRD(resources.Notebook)})
+
+	dyn, err := dynamic.NewForConfig(env.Config)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	createNamespace(t, dyn, "team-a")
+
+	// More than two pages
+	count := 2*client.ListPageSize + 1
+
+	for i := range count {
+		nb := resources.Notebook.Unstructured()
+		nb.SetNamespace("team-a")
+		nb.SetName(fmt.Sprintf("workbench-%d", i))
+
+		_, err := dyn.Resource(resources.Notebook.GVR()).Namespace("team-a").Create(t.Context(), &nb, metav1.CreateOptions{})
+		g.Expect(err).ToNot(HaveOccurred())
+	}
+
+	cl, err := client.NewClientWithConfig(env.Config)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	items, err := cl.List(t.Context(), resources.Notebook)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(items).To(HaveLen(count))
+
+	metadata, err := cl.ListMetadata(t.Context(), resources.Notebook)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(metadata).To(HaveLen(count))
+}
+
+func TestIntegration_RestrictedUser(t *testing.T) {
+	g := NewWithT(t)
+
+	var scenario selftest.Scenario
+
+	for _, s := range selftest.Scenarios() {
+		if s.Name == "upgrade-blocked" {
+			scenario = s
+		}
+	}
+
+	env, _ := newScenarioCluster(t, scenario)
+
+	// A user that can only read the platform singletons, as with a narrowly scoped service account
+	admin, err := kubernetes.NewForConfig(env.Config)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	_, err = admin.RbacV1().ClusterRoles().Create(t.Context(), &rbacv1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{Name: "odh-lint-restricted"},
+		Rules: []rbacv1.PolicyRule{{
+			APIGroups: []string{resources.DataScienceCluster.Group, resources.DSCInitialization.Group},
+			Resources: []string{resources.DataScienceCluster.Resource, resources.DSCInitialization.Resource},
+			Verbs:     []string{"get", "list"},
+		}},
+	}, metav1.CreateOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	_, err = admin.RbacV1().ClusterRoleBindings().Create(t.Context(), &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "odh-lint-restricted"},
+		RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: "odh-lint-restricted"},
+		Subjects:   []rbacv1.Subject{{APIGroup: rbacv1.GroupName, Kind: rbacv1.UserKind, Name: "restricted"}},
+	}, metav1.CreateOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	user, err := env.AddUser(envtest.User{Name: "restricted"}, env.Config)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	restricted, err := client.NewClientWithConfig(user.Config())
+	g.Expect(err).ToNot(HaveOccurred())
+
+	// Forbidden lists degrade to empty results or access-denied findings instead of failing the run
+	report := selftest.Verify(t.Context(), scenario, restricted)
+	g.Expect(report.Checks).To(BeNumerically(">", 0))
+	g.Expect(report.Problems).ToNot(ContainElement(ContainSubstring("output:")))
+}
+
+func TestIntegration_MultiVersionCRD(t *testing.T) {
+	g := NewWithT(t)
+
+	// DSPA served as v1alpha1 (storage) and v1, as installed by RHOAI 2.x
+	dspa := generateCRD(resources.DataSciencePipelinesApplicationV1, "v1alpha1", "v1")
+	env := startEnvironment(t, []*apiextensionsv1.CustomResourceDefinition{dspa})
+
+	dyn, err := dynamic.NewForConfig(env.Config)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	createNamespace(t, dyn, "team-a")
+
+	obj := resources.DataSciencePipelinesApplicationV1Alpha1.Unstructured()
+	obj.SetNamespace("team-a")
+	obj.SetName("pipelines")
+
+	_, err = dyn.Resource(resources.DataSciencePipelinesApplicationV1Alpha1.GVR()).Namespace("team-a").Create(t.Context(), &obj, metav1.CreateOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	cl, err := client.NewClientWithConfig(env.Config)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	// The object stored as v1alpha1 is served as v1 by the API server
+	items, err := cl.List(t.Context(), resources.DataSciencePipelinesApplicationV1)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(items).To(HaveLen(1))
+	g.Expect(items[0].GetAPIVersion()).To(Equal(resources.DataSciencePipelinesApplicationV1.APIVersion()))
+
+	// The API server records the storage version in the CRD status, which the stored version
+	// check reads
+	crd, err := cl.GetResource(t.Context(), resources.CustomResourceDefinition, dspa.Name)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	storedVersions, _, err := unstructured.NestedStringSlice(crd.Object, "status", "storedVersions")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(storedVersions).To(ConsistOf("v1alpha1"))
+}
@@ -0,0 +1,82 @@
+package selftest
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/util/manifest"
+)
+
+//go:embed fixtures/*.yaml
+var fixtures embed.FS
+
+// Scenario is a representative cluster state the check suite is run against.
+type Scenario struct {
+	// Name identifies the scenario in output and --scenario.
+	Name string
+
+	// Description summarizes the simulated cluster state.
+	Description string
+
+	// Fixture is the embedded multi-document YAML file seeding the cluster.
+	Fixture string
+
+	// TargetVersion runs the suite in upgrade mode; empty runs it in lint mode.
+	TargetVersion string
+
+	// WantBlocking is whether the suite must report at least one blocking finding.
+	WantBlocking bool
+}
+
+// Scenarios returns the built-in scenarios.
+func Scenarios() []Scenario {
+	return []Scenario{
+		{
+			Name:         "installed-3x",
+			Description:  "RHOAI 3.0 cluster in lint mode",
+			Fixture:      "installed-3x.yaml",
+			WantBlocking: false,
+		},
+		{
+			Name:          "upgrade-ready",
+			Description:   "RHOAI 2.25 cluster prepared for 3.0",
+			Fixture:       "upgrade-ready.yaml",
+			TargetVersion: "3.0.0",
+			WantBlocking:  false,
+		},
+		{
+			Name:          "upgrade-blocked",
+			Description:   "RHOAI 2.25 cluster with deprecated components and workloads",
+			Fixture:       "upgrade-blocked.yaml",
+			TargetVersion: "3.0.0",
+			WantBlocking:  true,
+		},
+	}
+}
+
+// Objects returns the objects seeding the scenario's cluster.
+func (s Scenario) Objects() ([]*unstructured.Unstructured, error) {
+	data, err := fixtures.ReadFile("fixtures/" + s.Fixture)
+	if err != nil {
+		return nil, fmt.Errorf("reading fixture %s: %w", s.Fixture, err)
+	}
+
+	docs, err := manifest.Decode(bytes.NewReader(data), s.Fixture)
+	if err != nil {
+		return nil, fmt.Errorf("parsing fixture: %w", err)
+	}
+
+	return manifest.Objects(docs), nil
+}
+
+// Mode returns the lint mode the scenario runs in.
+func (s Scenario) Mode() string {
+	if s.TargetVersion == "" {
+		return "lint"
+	}
+
+	return "upgrade to " + s.TargetVersion
+}
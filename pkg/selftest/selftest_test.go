@@ -0,0 +1,51 @@
+package selftest_test
+
+import (
+	"bytes"
+	"testing"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/selftest"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestScenarios_FixturesDecode(t *testing.T) {
+	for _, s := range selftest.Scenarios() {
+		t.Run(s.Name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			objects, err := s.Objects()
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(objects).ToNot(BeEmpty())
+
+			_, err = selftest.NewCluster(objects)
+			g.Expect(err).ToNot(HaveOccurred())
+		})
+	}
+}
+
+func TestCommand_AllScenariosPass(t *testing.T) {
+	g := NewWithT(t)
+
+	var out bytes.Buffer
+	streams := genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: &out, ErrOut: &bytes.Buffer{}}
+
+	command := selftest.NewCommand(streams)
+	g.Expect(command.Complete()).To(Succeed())
+	g.Expect(command.Validate()).To(Succeed())
+
+	g.Expect(command.Run(t.Context())).To(Succeed())
+	g.Expect(out.String()).To(ContainSubstring("Self-test passed: 3 scenarios"))
+}
+
+func TestCommand_UnknownScenario(t *testing.T) {
+	g := NewWithT(t)
+
+	command := selftest.NewCommand(genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: &bytes.Buffer{}, ErrOut: &bytes.Buffer{}})
+	command.Scenarios = []string{"missing"}
+
+	g.Expect(command.Complete()).To(Succeed())
+	g.Expect(command.Validate()).To(MatchError(ContainSubstring(`unknown scenario "missing"`)))
+}
@@ -0,0 +1,221 @@
+// Package snapshot reads and writes cluster-state snapshots: compressed archives of the
+// objects the lint checks read, so a lint run can be reproduced later without cluster access.
+package snapshot
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/backup"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+)
+
+// ManifestFile is the name of the manifest at the root of a snapshot archive.
+const ManifestFile = "snapshot.yaml"
+
+// filePermissions are the permissions of the written snapshot and its manifest.
+const filePermissions = 0o600
+
+// redactedValue replaces the values of Secret data, whose keys are kept.
+const redactedValue = ""
+
+// ErrUnsupportedFormat is returned for snapshot paths that are not gzip-compressed tarballs.
+var ErrUnsupportedFormat = errors.New("snapshots are gzip-compressed tarballs: use a .tar.gz or .tgz file name")
+
+// Manifest describes the contents of a snapshot.
+type Manifest struct {
+	// CreatedAt is when the snapshot was taken.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// CLIVersion is the version of the CLI that took the snapshot.
+	CLIVersion string `json:"cliVersion"`
+
+	// ClusterVersion is the OpenShift AI version detected when the snapshot was taken.
+	ClusterVersion string `json:"clusterVersion,omitempty"`
+
+	// Checks are the IDs of the checks whose required resources were captured.
+	Checks []string `json:"checks"`
+
+	// Resources lists each captured resource type.
+	Resources []Resource `json:"resources"`
+
+	// Workloads are the workload resource types discovered on the cluster, as
+	// resource.group/version, which lint reuses instead of discovery.
+	Workloads []string `json:"workloads,omitempty"`
+}
+
+// WorkloadResources returns the parsed Workloads of the manifest.
+func (m *Manifest) WorkloadResources() ([]schema.GroupVersionResource, error) {
+	gvrs := make([]schema.GroupVersionResource, 0, len(m.Workloads))
+
+	for _, name := range m.Workloads {
+		gvr, err := ParseResourceName(name)
+		if err != nil {
+			return nil, err
+		}
+
+		gvrs = append(gvrs, gvr)
+	}
+
+	return gvrs, nil
+}
+
+// Resource is a resource type captured in a snapshot.
+type Resource struct {
+	// Resource is the resource type, as resource.group/version.
+	Resource string `json:"resource"`
+
+	// Count is the number of captured objects.
+	Count int `json:"count"`
+
+	// Error records why the resource type could not be listed; checks see it as empty.
+	Error string `json:"error,omitempty"`
+}
+
+// Objects are the objects of a snapshot by resource type.
+type Objects map[schema.GroupVersionResource][]*unstructured.Unstructured
+
+// ResourceName returns the manifest name of a resource type: resource.group/version.
+func ResourceName(gvr schema.GroupVersionResource) string {
+	if gvr.Group == "" {
+		return gvr.Resource + "/" + gvr.Version
+	}
+
+	return gvr.Resource + "." + gvr.Group + "/" + gvr.Version
+}
+
+// ParseResourceName parses a manifest resource name written by ResourceName.
+func ParseResourceName(name string) (schema.GroupVersionResource, error) {
+	resourceGroup, ver, ok := strings.Cut(name, "/")
+	if !ok || resourceGroup == "" || ver == "" {
+		return schema.GroupVersionResource{}, fmt.Errorf("invalid resource name %q: expected resource.group/version", name)
+	}
+
+	resource, group, _ := strings.Cut(resourceGroup, ".")
+
+	return schema.GroupVersionResource{Group: group, Version: ver, Resource: resource}, nil
+}
+
+// Write writes a snapshot archive of objects with its manifest to path. Secret values are
+// redacted; their keys are kept.
+func Write(path string, manifest *Manifest, objects Objects) error {
+	if !backup.IsArchivePath(path) {
+		return ErrUnsupportedFormat
+	}
+
+	dir, err := os.MkdirTemp("", "odh-snapshot-")
+	if err != nil {
+		return fmt.Errorf("creating staging directory: %w", err)
+	}
+
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	for gvr, objs := range objects {
+		for _, obj := range objs {
+			if gvr.GroupResource() == resources.Secret.GVR().GroupResource() {
+				obj = redactSecret(obj)
+			}
+
+			if err := backup.WriteResourceToFile(dir, gvr, obj); err != nil {
+				return fmt.Errorf("writing %s %s/%s: %w", gvr.Resource, obj.GetNamespace(), obj.GetName(), err)
+			}
+		}
+	}
+
+	data, err := yaml.Marshal(manifest)
+	if err != nil {
+		return fmt.Errorf("marshaling manifest: %w", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, ManifestFile), data, filePermissions); err != nil {
+		return fmt.Errorf("writing manifest: %w", err)
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, filePermissions)
+	if err != nil {
+		return fmt.Errorf("creating snapshot: %w", err)
+	}
+
+	if err := backup.WriteArchive(f, dir); err != nil {
+		_ = f.Close()
+
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("closing snapshot: %w", err)
+	}
+
+	return nil
+}
+
+// Open loads a snapshot archive written by Write, returning a reader serving its objects
+// and its manifest.
+func Open(path string) (*backup.Reader, *Manifest, error) {
+	if !backup.IsArchivePath(path) {
+		return nil, nil, ErrUnsupportedFormat
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("opening snapshot: %w", err)
+	}
+
+	defer func() { _ = f.Close() }()
+
+	dir, err := os.MkdirTemp("", "odh-snapshot-")
+	if err != nil {
+		return nil, nil, fmt.Errorf("creating extraction directory: %w", err)
+	}
+
+	// The reader loads all objects in memory, so the extracted files are not needed afterwards
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	if err := backup.ExtractArchive(f, dir); err != nil {
+		return nil, nil, fmt.Errorf("extracting snapshot %s: %w", path, err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, ManifestFile))
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading snapshot manifest (is %s a snapshot?): %w", path, err)
+	}
+
+	var manifest Manifest
+	if err := yaml.Unmarshal(data, &manifest); err != nil {
+		return nil, nil, fmt.Errorf("parsing snapshot manifest: %w", err)
+	}
+
+	r, err := backup.NewReader(dir)
+	if err != nil {
+		return nil, nil, fmt.Errorf("loading snapshot %s: %w", path, err)
+	}
+
+	return r, &manifest, nil
+}
+
+// redactSecret returns a copy of a Secret with the values of its data emptied.
+func redactSecret(obj *unstructured.Unstructured) *unstructured.Unstructured {
+	out := obj.DeepCopy()
+
+	for _, field := range []string{"data", "stringData"} {
+		data, ok := out.Object[field].(map[string]any)
+		if !ok {
+			continue
+		}
+
+		for key := range data {
+			data[key] = redactedValue
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,100 @@
+package snapshot_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/snapshot"
+
+	. "github.com/onsi/gomega"
+)
+
+func newObject(rt resources.ResourceType, namespace string, name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{}}
+	obj.SetAPIVersion(rt.APIVersion())
+	obj.SetKind(rt.Kind)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+
+	return obj
+}
+
+func TestSnapshot_RoundTrip(t *testing.T) {
+	g := NewWithT(t)
+
+	dsc := newObject(resources.DataScienceCluster, "", "default-dsc")
+	g.Expect(unstructured.SetNestedField(dsc.Object, "2.25.0", "status", "release", "version")).To(Succeed())
+
+	secret := newObject(resources.Secret, "ns1", "creds")
+	secret.Object["data"] = map[string]any{"password": "c2VjcmV0"}
+
+	manifest := &snapshot.Manifest{
+		CreatedAt:      time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		CLIVersion:     "v1.2.3",
+		ClusterVersion: "2.25.0",
+		Checks:         []string{"components.codeflare.removal"},
+		Resources: []snapshot.Resource{
+			{Resource: snapshot.ResourceName(resources.DataScienceCluster.GVR()), Count: 1},
+			{Resource: snapshot.ResourceName(resources.Secret.GVR()), Count: 1},
+		},
+		Workloads: []string{"notebooks.kubeflow.org/v1"},
+	}
+
+	path := filepath.Join(t.TempDir(), "cluster.tar.gz")
+	g.Expect(snapshot.Write(path, manifest, snapshot.Objects{
+		resources.DataScienceCluster.GVR(): {dsc},
+		resources.Secret.GVR():             {secret},
+	})).To(Succeed())
+
+	r, loaded, err := snapshot.Open(path)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(loaded).To(Equal(manifest))
+
+	dscs, err := r.ListResources(t.Context(), resources.DataScienceCluster.GVR())
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dscs).To(HaveLen(1))
+	g.Expect(dscs[0].Object).To(HaveKeyWithValue("status", HaveKey("release")))
+
+	secrets, err := r.ListResources(t.Context(), resources.Secret.GVR())
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(secrets).To(HaveLen(1))
+	g.Expect(secrets[0].Object["data"]).To(Equal(map[string]any{"password": ""}))
+	// The written object is not modified
+	g.Expect(secret.Object["data"]).To(Equal(map[string]any{"password": "c2VjcmV0"}))
+
+	workloads, err := loaded.WorkloadResources()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(workloads).To(ConsistOf(schema.GroupVersionResource{Group: "kubeflow.org", Version: "v1", Resource: "notebooks"}))
+}
+
+func TestSnapshot_UnsupportedFormat(t *testing.T) {
+	g := NewWithT(t)
+
+	path := filepath.Join(t.TempDir(), "cluster.tar.zst")
+
+	g.Expect(snapshot.Write(path, &snapshot.Manifest{}, nil)).To(MatchError(snapshot.ErrUnsupportedFormat))
+
+	_, _, err := snapshot.Open(path)
+	g.Expect(err).To(MatchError(snapshot.ErrUnsupportedFormat))
+}
+
+func TestParseResourceName(t *testing.T) {
+	g := NewWithT(t)
+
+	for _, gvr := range []schema.GroupVersionResource{
+		resources.Secret.GVR(),
+		resources.DataScienceCluster.GVR(),
+	} {
+		parsed, err := snapshot.ParseResourceName(snapshot.ResourceName(gvr))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(parsed).To(Equal(gvr))
+	}
+
+	_, err := snapshot.ParseResourceName("secrets")
+	g.Expect(err).To(HaveOccurred())
+}
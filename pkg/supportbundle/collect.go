@@ -0,0 +1,265 @@
+package supportbundle
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/backup"
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/redact"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+)
+
+// Layout of the bundle.
+const (
+	resourcesDir   = "resources"
+	logsDir        = "logs"
+	deploymentsDir = "deployments"
+	eventsDir      = "events"
+	lintFile       = "lint.json"
+)
+
+// DeploymentStatus is the rollout status of a Deployment.
+type DeploymentStatus struct {
+	Name              string                `json:"name"`
+	Replicas          int64                 `json:"replicas"`
+	ReadyReplicas     int64                 `json:"readyReplicas"`
+	AvailableReplicas int64                 `json:"availableReplicas"`
+	UpdatedReplicas   int64                 `json:"updatedReplicas"`
+	Conditions        []DeploymentCondition `json:"conditions,omitempty"`
+}
+
+// DeploymentCondition is a condition of a Deployment status.
+type DeploymentCondition struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// EventRecord is a recorded Event, by the time it was last seen.
+type EventRecord struct {
+	LastSeen time.Time `json:"lastSeen"`
+	Type     string    `json:"type"`
+	Reason   string    `json:"reason"`
+	Object   string    `json:"object"`
+	Message  string    `json:"message"`
+	Count    int64     `json:"count,omitempty"`
+}
+
+// collectPlatform writes the DataScienceCluster and DSCInitialization.
+func (c *Command) collectPlatform(ctx context.Context, dir string) error {
+	for _, rt := range []resources.ResourceType{resources.DataScienceCluster, resources.DSCInitialization} {
+		items, err := c.Client.List(ctx, rt)
+		if err != nil && !client.IsResourceTypeNotFound(err) {
+			return fmt.Errorf("listing %s: %w", rt.Kind, err)
+		}
+
+		for _, item := range items {
+			obj := redact.Object(item, redact.Options{})
+			if err := backup.WriteResourceToFile(filepath.Join(dir, resourcesDir), rt.GVR(), obj); err != nil {
+				return fmt.Errorf("writing %s %s: %w", rt.Kind, item.GetName(), err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// collectLogs writes the last LogLines lines of the log of each container of the pods in the
+// operator namespace. A container whose log cannot be read is reported and skipped.
+func (c *Command) collectLogs(ctx context.Context, dir string, manifest *Manifest) error {
+	pods, err := c.Client.List(ctx, resources.Pod, client.WithNamespace(c.OperatorNamespace))
+	if err != nil {
+		return fmt.Errorf("listing pods in %s: %w", c.OperatorNamespace, err)
+	}
+
+	for _, pod := range pods {
+		containers, _, _ := unstructured.NestedSlice(pod.Object, "spec", "containers")
+
+		for _, container := range containers {
+			spec, ok := container.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			name, _ := spec["name"].(string)
+
+			data, err := c.Client.Logs().Tail(ctx, pod.GetNamespace(), pod.GetName(), name, c.LogLines)
+			if errors.Is(err, client.ErrLogsUnavailable) {
+				return err
+			}
+
+			if err != nil {
+				c.recordError(manifest, err)
+
+				continue
+			}
+
+			path := filepath.Join(dir, logsDir, pod.GetNamespace(), pod.GetName(), name+".log")
+			if err := writeFile(path, redact.Text(data)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// collectDeployments writes the rollout status of the Deployments of each namespace.
+func (c *Command) collectDeployments(ctx context.Context, dir string, namespace string) error {
+	deployments, err := c.Client.List(ctx, resources.Deployment, client.WithNamespace(namespace))
+	if err != nil {
+		return fmt.Errorf("listing deployments in %s: %w", namespace, err)
+	}
+
+	statuses := make([]DeploymentStatus, 0, len(deployments))
+
+	for _, d := range deployments {
+		status := DeploymentStatus{Name: d.GetName()}
+		status.Replicas, _, _ = unstructured.NestedInt64(d.Object, "spec", "replicas")
+		status.ReadyReplicas, _, _ = unstructured.NestedInt64(d.Object, "status", "readyReplicas")
+		status.AvailableReplicas, _, _ = unstructured.NestedInt64(d.Object, "status", "availableReplicas")
+		status.UpdatedReplicas, _, _ = unstructured.NestedInt64(d.Object, "status", "updatedReplicas")
+
+		conditions, _, _ := unstructured.NestedSlice(d.Object, "status", "conditions")
+		for _, condition := range conditions {
+			cond, ok := condition.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			status.Conditions = append(status.Conditions, DeploymentCondition{
+				Type:    stringField(cond, "type"),
+				Status:  stringField(cond, "status"),
+				Reason:  stringField(cond, "reason"),
+				Message: stringField(cond, "message"),
+			})
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	return writeYAML(filepath.Join(dir, deploymentsDir, namespace+".yaml"), statuses)
+}
+
+// collectEvents writes the Events of a namespace last seen within EventsSince, oldest first.
+func (c *Command) collectEvents(ctx context.Context, dir string, namespace string, now time.Time) error {
+	events, err := c.Client.List(ctx, resources.Event, client.WithNamespace(namespace))
+	if err != nil {
+		return fmt.Errorf("listing events in %s: %w", namespace, err)
+	}
+
+	records := make([]EventRecord, 0, len(events))
+
+	for _, e := range events {
+		lastSeen := eventTime(e)
+		if now.Sub(lastSeen) > c.EventsSince {
+			continue
+		}
+
+		kind, _, _ := unstructured.NestedString(e.Object, "involvedObject", "kind")
+		name, _, _ := unstructured.NestedString(e.Object, "involvedObject", "name")
+		count, _, _ := unstructured.NestedInt64(e.Object, "count")
+
+		records = append(records, EventRecord{
+			LastSeen: lastSeen,
+			Type:     stringField(e.Object, "type"),
+			Reason:   stringField(e.Object, "reason"),
+			Object:   kind + "/" + name,
+			Message:  string(redact.Text([]byte(stringField(e.Object, "message")))),
+			Count:    count,
+		})
+	}
+
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].LastSeen.Before(records[j].LastSeen)
+	})
+
+	return writeYAML(filepath.Join(dir, eventsDir, namespace+".yaml"), records)
+}
+
+// eventTime returns when an Event was last seen: its lastTimestamp, eventTime or creation
+// time, whichever is set first.
+func eventTime(e *unstructured.Unstructured) time.Time {
+	for _, field := range []string{"lastTimestamp", "eventTime"} {
+		value, _, _ := unstructured.NestedString(e.Object, field)
+		if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+			return t
+		}
+	}
+
+	return e.GetCreationTimestamp().Time
+}
+
+// collectLint runs all lint checks against the cluster and writes their results as JSON.
+// Findings do not fail the collection.
+func (c *Command) collectLint(ctx context.Context, dir string) error {
+	var out bytes.Buffer
+
+	streams := genericiooptions.IOStreams{In: &bytes.Buffer{}, Out: &out, ErrOut: io.Discard}
+
+	options := []lint.CommandOption{lint.WithClient(c.Client)}
+	if c.TargetVersion != "" {
+		options = append(options, lint.WithTargetVersion(c.TargetVersion))
+	}
+
+	command := lint.NewCommand(streams, genericclioptions.NewConfigFlags(false), options...)
+	command.OutputFormat = lint.OutputFormatJSON
+	command.FailOnCritical = false
+
+	if err := command.Complete(); err != nil {
+		return fmt.Errorf("completing lint: %w", err)
+	}
+
+	if err := command.Validate(); err != nil {
+		return fmt.Errorf("validating lint: %w", err)
+	}
+
+	if err := command.Run(ctx); err != nil {
+		return fmt.Errorf("running lint: %w", err)
+	}
+
+	return writeFile(filepath.Join(dir, lintFile), redact.Text(out.Bytes()))
+}
+
+func stringField(obj map[string]any, field string) string {
+	value, _ := obj[field].(string)
+
+	return value
+}
+
+func writeYAML(path string, v any) error {
+	data, err := yaml.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("marshaling %s: %w", filepath.Base(path), err)
+	}
+
+	return writeFile(path, data)
+}
+
+func writeFile(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), dirPermissions); err != nil {
+		return fmt.Errorf("creating directory for %s: %w", path, err)
+	}
+
+	if err := os.WriteFile(path, data, filePermissions); err != nil {
+		return fmt.Errorf("writing %s: %w", path, err)
+	}
+
+	return nil
+}
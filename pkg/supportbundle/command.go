@@ -0,0 +1,241 @@
+// Package supportbundle collects a redacted snapshot of the state support needs to
+// troubleshoot an OpenShift AI installation into a single tarball.
+package supportbundle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	cliversion "github.com/opendatahub-io/odh-cli/internal/version"
+	"github.com/opendatahub-io/odh-cli/pkg/backup"
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+var _ cmd.Command = (*Command)(nil)
+
+// ErrUnsupportedFormat is returned for output paths that are not gzip-compressed tarballs.
+var ErrUnsupportedFormat = errors.New("support bundles are gzip-compressed tarballs: use a .tar.gz or .tgz file name")
+
+// Manifest describes the contents of a support bundle.
+type Manifest struct {
+	// CreatedAt is when the bundle was collected.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// CLIVersion is the version of the CLI that collected the bundle.
+	CLIVersion string `json:"cliVersion"`
+
+	// ClusterVersion is the OpenShift AI version detected when the bundle was collected.
+	ClusterVersion string `json:"clusterVersion,omitempty"`
+
+	// Namespaces are the namespaces whose Deployments and Events were collected.
+	Namespaces []string `json:"namespaces"`
+
+	// LogLines is the number of log lines collected per container.
+	LogLines int64 `json:"logLines"`
+
+	// Errors records what could not be collected; the rest of the bundle is still written.
+	Errors []string `json:"errors,omitempty"`
+}
+
+// Command collects a support bundle.
+type Command struct {
+	IO          iostreams.Interface
+	ConfigFlags *genericclioptions.ConfigFlags
+	Client      client.Client
+
+	// Output is the path of the bundle to write.
+	Output string
+
+	LogLines              int64
+	EventsSince           time.Duration
+	OperatorNamespace     string
+	ApplicationsNamespace string
+	SkipLint              bool
+	TargetVersion         string
+	Timeout               time.Duration
+
+	// Throttling settings for Kubernetes API client
+	QPS   float32
+	Burst int
+}
+
+// NewCommand creates a new support bundle Command with defaults.
+func NewCommand(streams genericiooptions.IOStreams, configFlags *genericclioptions.ConfigFlags) *Command {
+	return &Command{
+		IO:                iostreams.NewIOStreams(streams.In, streams.Out, streams.ErrOut),
+		ConfigFlags:       configFlags,
+		LogLines:          DefaultLogLines,
+		EventsSince:       DefaultEventsSince,
+		OperatorNamespace: DefaultOperatorNamespace,
+		Timeout:           DefaultTimeout,
+		QPS:               client.DefaultQPS,
+		Burst:             client.DefaultBurst,
+	}
+}
+
+// AddFlags registers command-specific flags with the provided FlagSet.
+func (c *Command) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVarP(&c.Output, "output", "o", "", flagDescOutput)
+	fs.Int64Var(&c.LogLines, "log-lines", c.LogLines, flagDescLogLines)
+	fs.DurationVar(&c.EventsSince, "events-since", c.EventsSince, flagDescEventsSince)
+	fs.StringVar(&c.OperatorNamespace, "operator-namespace", c.OperatorNamespace, flagDescOperatorNamespace)
+	fs.StringVar(&c.ApplicationsNamespace, "applications-namespace", "", flagDescAppsNamespace)
+	fs.BoolVar(&c.SkipLint, "skip-lint", false, flagDescSkipLint)
+	fs.StringVar(&c.TargetVersion, "target-version", "", flagDescTargetVersion)
+	fs.DurationVar(&c.Timeout, "timeout", c.Timeout, flagDescTimeout)
+
+	// Throttling settings
+	fs.Float32Var(&c.QPS, "qps", c.QPS, flagDescQPS)
+	fs.IntVar(&c.Burst, "burst", c.Burst, flagDescBurst)
+}
+
+// Complete populates the client, unless one was set, and the default output path.
+func (c *Command) Complete() error {
+	if c.Output == "" {
+		c.Output = "odh-support-bundle-" + time.Now().UTC().Format("20060102-150405") + ".tar.gz"
+	}
+
+	if c.Client != nil {
+		return nil
+	}
+
+	restConfig, err := client.NewRESTConfig(c.ConfigFlags, c.QPS, c.Burst)
+	if err != nil {
+		return fmt.Errorf("failed to create REST config: %w", err)
+	}
+
+	k8sClient, err := client.NewClientWithConfig(restConfig)
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	c.Client = k8sClient
+
+	return nil
+}
+
+// Validate checks that all required options are valid.
+func (c *Command) Validate() error {
+	if !backup.IsArchivePath(c.Output) {
+		return ErrUnsupportedFormat
+	}
+
+	if c.LogLines <= 0 {
+		return errors.New("log-lines must be greater than 0")
+	}
+
+	if c.EventsSince <= 0 {
+		return errors.New("events-since must be greater than 0")
+	}
+
+	if c.OperatorNamespace == "" {
+		return errors.New("operator-namespace is required")
+	}
+
+	if c.Timeout <= 0 {
+		return errors.New("timeout must be greater than 0")
+	}
+
+	return nil
+}
+
+// Run collects the bundle. What cannot be collected is reported as a warning and recorded
+// in the manifest; only failing to write the bundle is an error.
+func (c *Command) Run(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+
+	dir, err := os.MkdirTemp("", "odh-support-bundle-")
+	if err != nil {
+		return fmt.Errorf("creating staging directory: %w", err)
+	}
+
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	now := time.Now().UTC()
+	manifest := &Manifest{
+		CreatedAt:  now,
+		CLIVersion: cliversion.GetVersion(),
+		LogLines:   c.LogLines,
+	}
+
+	if clusterVersion, err := version.Detect(ctx, c.Client); err == nil {
+		manifest.ClusterVersion = clusterVersion.String()
+	} else {
+		c.recordError(manifest, fmt.Errorf("detecting cluster version: %w", err))
+	}
+
+	manifest.Namespaces = []string{c.applicationsNamespace(ctx), c.OperatorNamespace}
+
+	c.recordError(manifest, c.collectPlatform(ctx, dir))
+	c.recordError(manifest, c.collectLogs(ctx, dir, manifest))
+
+	for _, namespace := range manifest.Namespaces {
+		c.recordError(manifest, c.collectDeployments(ctx, dir, namespace))
+		c.recordError(manifest, c.collectEvents(ctx, dir, namespace, now))
+	}
+
+	if !c.SkipLint {
+		c.recordError(manifest, c.collectLint(ctx, dir))
+	}
+
+	if err := writeYAML(filepath.Join(dir, ManifestFile), manifest); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(c.Output, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, filePermissions)
+	if err != nil {
+		return fmt.Errorf("creating support bundle: %w", err)
+	}
+
+	if err := backup.WriteArchive(f, dir); err != nil {
+		_ = f.Close()
+
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("closing support bundle: %w", err)
+	}
+
+	c.IO.Errorf("Wrote support bundle to %s (%d collection errors)", c.Output, len(manifest.Errors))
+
+	return nil
+}
+
+// applicationsNamespace returns the ApplicationsNamespace option, or else the one set in the
+// DSCInitialization, or else the default.
+func (c *Command) applicationsNamespace(ctx context.Context) string {
+	if c.ApplicationsNamespace != "" {
+		return c.ApplicationsNamespace
+	}
+
+	namespace, err := client.GetApplicationsNamespace(ctx, c.Client)
+	if err != nil || namespace == "" {
+		return DefaultApplicationsNamespace
+	}
+
+	return namespace
+}
+
+// recordError warns about a collection error and records it in the manifest.
+func (c *Command) recordError(manifest *Manifest, err error) {
+	if err == nil {
+		return
+	}
+
+	c.IO.Errorf("Warning: %v", err)
+	manifest.Errors = append(manifest.Errors, err.Error())
+}
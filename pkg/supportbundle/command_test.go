@@ -0,0 +1,205 @@
+package supportbundle_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	kubernetesfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/backup"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/supportbundle"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+const appsNamespace = "opendatahub"
+
+func newObject(rt resources.ResourceType, namespace string, name string, fields map[string]any) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: fields}
+	obj.SetAPIVersion(rt.APIVersion())
+	obj.SetKind(rt.Kind)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+
+	return obj
+}
+
+func newEvent(name string, lastSeen time.Time, message string) *unstructured.Unstructured {
+	return newObject(resources.Event, appsNamespace, name, map[string]any{
+		"type":           "Warning",
+		"reason":         "BackOff",
+		"message":        message,
+		"lastTimestamp":  lastSeen.Format(time.RFC3339),
+		"involvedObject": map[string]any{"kind": "Pod", "name": "dashboard-0"},
+	})
+}
+
+func newClient(objects ...runtime.Object) client.Client {
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			resources.DataScienceCluster.GVR(): resources.DataScienceCluster.ListKind(),
+			resources.DSCInitialization.GVR():  resources.DSCInitialization.ListKind(),
+			resources.Pod.GVR():                resources.Pod.ListKind(),
+			resources.Deployment.GVR():         resources.Deployment.ListKind(),
+			resources.Event.GVR():              resources.Event.ListKind(),
+		},
+		objects...)
+
+	return client.NewForTesting(client.TestClientConfig{
+		Dynamic:    dynamicClient,
+		Kubernetes: kubernetesfake.NewClientset(),
+	})
+}
+
+// runBundle collects a bundle without the lint checks and returns the extraction directory.
+func runBundle(t *testing.T, c client.Client) string {
+	t.Helper()
+
+	g := NewWithT(t)
+
+	output := filepath.Join(t.TempDir(), "bundle.tar.gz")
+
+	command := supportbundle.NewCommand(genericiooptions.NewTestIOStreamsDiscard(), nil)
+	command.Client = c
+	command.Output = output
+	command.SkipLint = true
+
+	g.Expect(command.Complete()).To(Succeed())
+	g.Expect(command.Validate()).To(Succeed())
+	g.Expect(command.Run(t.Context())).To(Succeed())
+
+	info, err := os.Stat(output)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(info.Mode().Perm()).To(Equal(os.FileMode(0o600)))
+
+	f, err := os.Open(output)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	defer func() { _ = f.Close() }()
+
+	dir := t.TempDir()
+	g.Expect(backup.ExtractArchive(f, dir)).To(Succeed())
+
+	return dir
+}
+
+func readManifest(t *testing.T, dir string) supportbundle.Manifest {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join(dir, supportbundle.ManifestFile))
+	NewWithT(t).Expect(err).ToNot(HaveOccurred())
+
+	var manifest supportbundle.Manifest
+	NewWithT(t).Expect(yaml.Unmarshal(data, &manifest)).To(Succeed())
+
+	return manifest
+}
+
+func TestCommand_Run(t *testing.T) {
+	g := NewWithT(t)
+
+	dsc := testutil.NewDSC(map[string]string{"dashboard": "Managed"})
+	dsc.SetAnnotations(map[string]string{"kubectl.kubernetes.io/last-applied-configuration": "{}"})
+
+	now := time.Now()
+
+	dir := runBundle(t, newClient(
+		dsc,
+		testutil.NewDSCI(appsNamespace),
+		newObject(resources.Pod, supportbundle.DefaultOperatorNamespace, "rhods-operator-abc", map[string]any{
+			"spec": map[string]any{"containers": []any{map[string]any{"name": "manager"}}},
+		}),
+		newObject(resources.Deployment, appsNamespace, "dashboard", map[string]any{
+			"spec":   map[string]any{"replicas": int64(2)},
+			"status": map[string]any{"readyReplicas": int64(1)},
+		}),
+		newEvent("recent", now.Add(-10*time.Minute), "pull failed with token=abc123"),
+		newEvent("old", now.Add(-3*time.Hour), "old event"),
+	))
+
+	manifest := readManifest(t, dir)
+	g.Expect(manifest.Namespaces).To(Equal([]string{appsNamespace, supportbundle.DefaultOperatorNamespace}))
+	g.Expect(manifest.LogLines).To(Equal(int64(supportbundle.DefaultLogLines)))
+
+	r, err := backup.NewReader(filepath.Join(dir, "resources"))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	collected, err := r.GetResource(t.Context(), resources.DataScienceCluster, "default-dsc")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(collected.GetAnnotations()).To(BeEmpty())
+
+	logs, err := os.ReadFile(filepath.Join(dir, "logs", supportbundle.DefaultOperatorNamespace, "rhods-operator-abc", "manager.log"))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(logs).ToNot(BeEmpty())
+
+	data, err := os.ReadFile(filepath.Join(dir, "deployments", appsNamespace+".yaml"))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	var deployments []supportbundle.DeploymentStatus
+	g.Expect(yaml.Unmarshal(data, &deployments)).To(Succeed())
+	g.Expect(deployments).To(HaveLen(1))
+	g.Expect(deployments[0].Replicas).To(Equal(int64(2)))
+	g.Expect(deployments[0].ReadyReplicas).To(Equal(int64(1)))
+
+	data, err = os.ReadFile(filepath.Join(dir, "events", appsNamespace+".yaml"))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	var events []supportbundle.EventRecord
+	g.Expect(yaml.Unmarshal(data, &events)).To(Succeed())
+	g.Expect(events).To(HaveLen(1))
+	g.Expect(events[0].Object).To(Equal("Pod/dashboard-0"))
+	g.Expect(events[0].Message).To(Equal("pull failed with token=<redacted>"))
+
+	g.Expect(filepath.Join(dir, "lint.json")).ToNot(BeAnExistingFile())
+}
+
+func TestCommand_RunRecordsCollectionErrors(t *testing.T) {
+	g := NewWithT(t)
+
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			resources.DataScienceCluster.GVR(): resources.DataScienceCluster.ListKind(),
+			resources.DSCInitialization.GVR():  resources.DSCInitialization.ListKind(),
+			resources.Pod.GVR():                resources.Pod.ListKind(),
+			resources.Deployment.GVR():         resources.Deployment.ListKind(),
+			resources.Event.GVR():              resources.Event.ListKind(),
+		})
+	dynamicClient.PrependReactor("list", "deployments", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("connection refused")
+	})
+
+	c := client.NewForTesting(client.TestClientConfig{Dynamic: dynamicClient})
+
+	manifest := readManifest(t, runBundle(t, c))
+
+	g.Expect(manifest.Namespaces).To(ContainElement(supportbundle.DefaultApplicationsNamespace))
+	g.Expect(manifest.Errors).To(ContainElement(ContainSubstring("listing deployments")))
+}
+
+func TestCommand_Validate(t *testing.T) {
+	g := NewWithT(t)
+
+	command := supportbundle.NewCommand(genericiooptions.NewTestIOStreamsDiscard(), nil)
+	command.Output = "bundle.zip"
+
+	g.Expect(command.Validate()).To(MatchError(supportbundle.ErrUnsupportedFormat))
+
+	command.Output = "bundle.tgz"
+	command.LogLines = 0
+
+	g.Expect(command.Validate()).To(MatchError(ContainSubstring("log-lines")))
+}
@@ -0,0 +1,41 @@
+package supportbundle
+
+import "time"
+
+const (
+	// DefaultTimeout is the default timeout of the whole collection.
+	DefaultTimeout = 5 * time.Minute
+
+	// DefaultLogLines is the default number of log lines collected per container.
+	DefaultLogLines = 500
+
+	// DefaultEventsSince is the default age of the oldest collected Event.
+	DefaultEventsSince = time.Hour
+
+	// DefaultOperatorNamespace is the namespace of the OpenShift AI operator.
+	DefaultOperatorNamespace = "redhat-ods-operator"
+
+	// DefaultApplicationsNamespace is used when the DSCInitialization does not set one.
+	DefaultApplicationsNamespace = "redhat-ods-applications"
+
+	// ManifestFile is the name of the manifest at the root of a support bundle.
+	ManifestFile = "bundle.yaml"
+)
+
+const (
+	dirPermissions  = 0o700
+	filePermissions = 0o600
+)
+
+const (
+	flagDescOutput            = "Path of the support bundle to write, a .tar.gz or .tgz file (default odh-support-bundle-<timestamp>.tar.gz)"
+	flagDescLogLines          = "Number of log lines collected per operator container"
+	flagDescEventsSince       = "Collect Events last seen within this duration"
+	flagDescOperatorNamespace = "Namespace of the OpenShift AI operator"
+	flagDescAppsNamespace     = "Applications namespace (default: read from the DSCInitialization)"
+	flagDescSkipLint          = "Do not run the lint checks"
+	flagDescTargetVersion     = "Target version for the lint checks (default: the cluster version)"
+	flagDescTimeout           = "Timeout for the whole collection"
+	flagDescQPS               = "Kubernetes API QPS limit (queries per second)"
+	flagDescBurst             = "Kubernetes API burst capacity"
+)
@@ -0,0 +1,51 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+)
+
+// ErrAccessReviewUnavailable is returned by an AccessReviewer of a client built without a
+// Kubernetes clientset.
+var ErrAccessReviewUnavailable = errors.New("access reviews are not available from this client")
+
+// accessReviewer evaluates permissions with SelfSubjectAccessReviews.
+type accessReviewer struct {
+	kubernetes kubernetes.Interface
+}
+
+func (r *accessReviewer) Review(ctx context.Context, permission resources.Permission) (bool, string, error) {
+	if r.kubernetes == nil {
+		return false, "", ErrAccessReviewUnavailable
+	}
+
+	review := &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Group:       permission.Group,
+				Resource:    permission.Resource,
+				Subresource: permission.Subresource,
+				Verb:        permission.Verb,
+			},
+		},
+	}
+
+	review, err := r.kubernetes.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, review, metav1.CreateOptions{})
+	if err != nil {
+		return false, "", fmt.Errorf("reviewing access to %s: %w", permission, err)
+	}
+
+	reason := review.Status.Reason
+	if reason == "" {
+		reason = review.Status.EvaluationError
+	}
+
+	return review.Status.Allowed, reason, nil
+}
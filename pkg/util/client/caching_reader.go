@@ -0,0 +1,371 @@
+package client
+
+import (
+	"context"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util"
+)
+
+// cacheKey identifies a read by its kind, resource, name and options.
+type cacheKey struct {
+	op     string
+	gvr    schema.GroupVersionResource
+	name   string
+	list   ListResourcesConfig
+	getCfg GetConfig
+}
+
+// CachingReader is a Reader that lists and gets each resource of the wrapped Reader once and
+// serves repeated reads from memory, keyed by GVR, name, namespace and selectors. A lint run
+// shares one CachingReader across its checks, so the DataScienceCluster, DSCInitialization and
+// workload lists many checks read are fetched once per run. Only successful reads are cached,
+// and callers receive copies, so checks cannot alter what other checks read. OLM reads are not
+// cached. Paged reads (see Pager) are served from the cache when the list is cached, and are
+// otherwise streamed from the wrapped Reader and cached once the last page was read, so paged
+// and unpaged reads of a list also fetch it once per run.
+type CachingReader struct {
+	Reader
+
+	mu      sync.Mutex
+	lists   map[cacheKey][]*unstructured.Unstructured
+	metas   map[cacheKey][]*metav1.PartialObjectMetadata
+	objects map[cacheKey]*unstructured.Unstructured
+	meta    map[cacheKey]*metav1.PartialObjectMetadata
+	hits    int
+	misses  int
+}
+
+// NewCachingReader returns a CachingReader wrapping r with an empty cache.
+func NewCachingReader(r Reader) *CachingReader {
+	c := &CachingReader{Reader: r}
+	c.Invalidate()
+
+	return c
+}
+
+// Invalidate empties the cache, e.g. after the cluster was changed, so later reads reach the
+// wrapped Reader again.
+func (c *CachingReader) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.lists = make(map[cacheKey][]*unstructured.Unstructured)
+	c.metas = make(map[cacheKey][]*metav1.PartialObjectMetadata)
+	c.objects = make(map[cacheKey]*unstructured.Unstructured)
+	c.meta = make(map[cacheKey]*metav1.PartialObjectMetadata)
+}
+
+// Stats returns the number of reads served from the cache and from the wrapped Reader.
+func (c *CachingReader) Stats() (int, int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.hits, c.misses
+}
+
+// count counts a read served from the cache (hit) or the wrapped Reader. The caller holds mu.
+func (c *CachingReader) count(hit bool) {
+	if hit {
+		c.hits++
+	} else {
+		c.misses++
+	}
+}
+
+func listKey(op string, gvr schema.GroupVersionResource, opts []ListResourcesOption) cacheKey {
+	cfg := ListResourcesConfig{}
+	util.ApplyOptions(&cfg, opts...)
+
+	return cacheKey{op: op, gvr: gvr, list: cfg}
+}
+
+func getKey(op string, gvr schema.GroupVersionResource, name string, opts []GetOption) cacheKey {
+	cfg := GetConfig{}
+	util.ApplyOptions(&cfg, opts...)
+
+	return cacheKey{op: op, gvr: gvr, name: name, getCfg: cfg}
+}
+
+func (c *CachingReader) List(
+	ctx context.Context,
+	resourceType resources.ResourceType,
+	opts ...ListResourcesOption,
+) ([]*unstructured.Unstructured, error) {
+	return c.ListResources(ctx, resourceType.GVR(), opts...)
+}
+
+func (c *CachingReader) ListResources(
+	ctx context.Context,
+	gvr schema.GroupVersionResource,
+	opts ...ListResourcesOption,
+) ([]*unstructured.Unstructured, error) {
+	key := listKey("list", gvr, opts)
+
+	c.mu.Lock()
+	items, ok := c.lists[key]
+	c.count(ok)
+	c.mu.Unlock()
+
+	if !ok {
+		var err error
+
+		items, err = c.Reader.ListResources(ctx, gvr, opts...)
+		if err != nil {
+			return nil, err //nolint:wrapcheck // Errors of the wrapped reader are returned as is
+		}
+
+		c.mu.Lock()
+		c.lists[key] = items
+		c.mu.Unlock()
+	}
+
+	copied := make([]*unstructured.Unstructured, 0, len(items))
+	for _, item := range items {
+		copied = append(copied, item.DeepCopy())
+	}
+
+	return copied, nil
+}
+
+func (c *CachingReader) ListMetadata(
+	ctx context.Context,
+	resourceType resources.ResourceType,
+	opts ...ListResourcesOption,
+) ([]*metav1.PartialObjectMetadata, error) {
+	key := listKey("metadata", resourceType.GVR(), opts)
+
+	c.mu.Lock()
+	items, ok := c.metas[key]
+	c.count(ok)
+	c.mu.Unlock()
+
+	if !ok {
+		var err error
+
+		items, err = c.Reader.ListMetadata(ctx, resourceType, opts...)
+		if err != nil {
+			return nil, err //nolint:wrapcheck // Errors of the wrapped reader are returned as is
+		}
+
+		c.mu.Lock()
+		c.metas[key] = items
+		c.mu.Unlock()
+	}
+
+	copied := make([]*metav1.PartialObjectMetadata, 0, len(items))
+	for _, item := range items {
+		copied = append(copied, item.DeepCopy())
+	}
+
+	return copied, nil
+}
+
+func (c *CachingReader) GetResource(
+	ctx context.Context,
+	resourceType resources.ResourceType,
+	name string,
+	opts ...GetOption,
+) (*unstructured.Unstructured, error) {
+	return c.Get(ctx, resourceType.GVR(), name, opts...)
+}
+
+func (c *CachingReader) Get(
+	ctx context.Context,
+	gvr schema.GroupVersionResource,
+	name string,
+	opts ...GetOption,
+) (*unstructured.Unstructured, error) {
+	key := getKey("get", gvr, name, opts)
+
+	c.mu.Lock()
+	obj, ok := c.objects[key]
+	c.count(ok)
+	c.mu.Unlock()
+
+	if !ok {
+		var err error
+
+		obj, err = c.Reader.Get(ctx, gvr, name, opts...)
+		if err != nil {
+			return nil, err //nolint:wrapcheck // Errors of the wrapped reader are returned as is
+		}
+
+		c.mu.Lock()
+		c.objects[key] = obj
+		c.mu.Unlock()
+	}
+
+	return obj.DeepCopy(), nil
+}
+
+func (c *CachingReader) GetResourceMetadata(
+	ctx context.Context,
+	resourceType resources.ResourceType,
+	name string,
+	opts ...GetOption,
+) (*metav1.PartialObjectMetadata, error) {
+	key := getKey("metadata", resourceType.GVR(), name, opts)
+
+	c.mu.Lock()
+	obj, ok := c.meta[key]
+	c.count(ok)
+	c.mu.Unlock()
+
+	if !ok {
+		var err error
+
+		obj, err = c.Reader.GetResourceMetadata(ctx, resourceType, name, opts...)
+		if err != nil {
+			return nil, err //nolint:wrapcheck // Errors of the wrapped reader are returned as is
+		}
+
+		c.mu.Lock()
+		c.meta[key] = obj
+		c.mu.Unlock()
+	}
+
+	return obj.DeepCopy(), nil
+}
+
+func (c *CachingReader) ListResourcePages(
+	ctx context.Context,
+	gvr schema.GroupVersionResource,
+	fn func([]*unstructured.Unstructured) error,
+	opts ...ListResourcesOption,
+) error {
+	key := listKey("list", gvr, opts)
+
+	c.mu.Lock()
+	items, ok := c.lists[key]
+	c.count(ok)
+	c.mu.Unlock()
+
+	if ok {
+		copied := make([]*unstructured.Unstructured, 0, len(items))
+		for _, item := range items {
+			copied = append(copied, item.DeepCopy())
+		}
+
+		return fn(copied)
+	}
+
+	pager, isPager := c.Reader.(Pager)
+	if !isPager {
+		items, err := c.Reader.ListResources(ctx, gvr, opts...)
+		if err != nil {
+			return err //nolint:wrapcheck // Errors of the wrapped reader are returned as is
+		}
+
+		c.mu.Lock()
+		c.lists[key] = items
+		c.mu.Unlock()
+
+		copied := make([]*unstructured.Unstructured, 0, len(items))
+		for _, item := range items {
+			copied = append(copied, item.DeepCopy())
+		}
+
+		return fn(copied)
+	}
+
+	// Callers receive copies of the pages, so the cached items are the ones they never see
+	var listed []*unstructured.Unstructured
+
+	err := pager.ListResourcePages(ctx, gvr, func(page []*unstructured.Unstructured) error {
+		listed = append(listed, page...)
+
+		copied := make([]*unstructured.Unstructured, 0, len(page))
+		for _, item := range page {
+			copied = append(copied, item.DeepCopy())
+		}
+
+		return fn(copied)
+	}, opts...)
+	if err != nil {
+		return err //nolint:wrapcheck // Errors of the wrapped reader are returned as is
+	}
+
+	if listed == nil {
+		listed = []*unstructured.Unstructured{}
+	}
+
+	c.mu.Lock()
+	c.lists[key] = listed
+	c.mu.Unlock()
+
+	return nil
+}
+
+func (c *CachingReader) ListMetadataPages(
+	ctx context.Context,
+	resourceType resources.ResourceType,
+	fn func([]*metav1.PartialObjectMetadata) error,
+	opts ...ListResourcesOption,
+) error {
+	key := listKey("metadata", resourceType.GVR(), opts)
+
+	c.mu.Lock()
+	items, ok := c.metas[key]
+	c.count(ok)
+	c.mu.Unlock()
+
+	if ok {
+		copied := make([]*metav1.PartialObjectMetadata, 0, len(items))
+		for _, item := range items {
+			copied = append(copied, item.DeepCopy())
+		}
+
+		return fn(copied)
+	}
+
+	pager, isPager := c.Reader.(Pager)
+	if !isPager {
+		items, err := c.Reader.ListMetadata(ctx, resourceType, opts...)
+		if err != nil {
+			return err //nolint:wrapcheck // Errors of the wrapped reader are returned as is
+		}
+
+		c.mu.Lock()
+		c.metas[key] = items
+		c.mu.Unlock()
+
+		copied := make([]*metav1.PartialObjectMetadata, 0, len(items))
+		for _, item := range items {
+			copied = append(copied, item.DeepCopy())
+		}
+
+		return fn(copied)
+	}
+
+	var listed []*metav1.PartialObjectMetadata
+
+	err := pager.ListMetadataPages(ctx, resourceType, func(page []*metav1.PartialObjectMetadata) error {
+		listed = append(listed, page...)
+
+		copied := make([]*metav1.PartialObjectMetadata, 0, len(page))
+		for _, item := range page {
+			copied = append(copied, item.DeepCopy())
+		}
+
+		return fn(copied)
+	}, opts...)
+	if err != nil {
+		return err //nolint:wrapcheck // Errors of the wrapped reader are returned as is
+	}
+
+	if listed == nil {
+		listed = []*metav1.PartialObjectMetadata{}
+	}
+
+	c.mu.Lock()
+	c.metas[key] = listed
+	c.mu.Unlock()
+
+	return nil
+}
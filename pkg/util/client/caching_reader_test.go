@@ -0,0 +1,106 @@
+package client_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestCachingReader_ServesRepeatedReadsFromMemory(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme,
+		map[schema.GroupVersionResource]string{
+			resources.DataScienceCluster.GVR(): resources.DataScienceCluster.ListKind(),
+		},
+		testutil.NewDSC(map[string]string{"workbenches": "Managed"}),
+	)
+
+	reader := client.NewCachingReader(client.NewForTesting(client.TestClientConfig{Dynamic: dynamicClient}))
+
+	for range 3 {
+		items, err := reader.List(t.Context(), resources.DataScienceCluster)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(items).To(HaveLen(1))
+
+		// Mutating a returned object does not alter what later reads return.
+		items[0].SetName("changed")
+
+		obj, err := reader.GetResource(t.Context(), resources.DataScienceCluster, "default-dsc")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(obj.GetName()).To(Equal("default-dsc"))
+	}
+
+	g.Expect(countActions(dynamicClient.Actions(), "list")).To(Equal(1))
+	g.Expect(countActions(dynamicClient.Actions(), "get")).To(Equal(1))
+
+	// Reads with other options are not served from the cache.
+	_, err := reader.List(t.Context(), resources.DataScienceCluster, client.WithLabelSelector("app=odh"))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(countActions(dynamicClient.Actions(), "list")).To(Equal(2))
+}
+
+func TestCachingReader_Invalidate(t *testing.T) {
+	g := NewWithT(t)
+
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			resources.DataScienceCluster.GVR(): resources.DataScienceCluster.ListKind(),
+		},
+		testutil.NewDSC(map[string]string{"workbenches": "Managed"}),
+	)
+
+	reader := client.NewCachingReader(client.NewForTesting(client.TestClientConfig{Dynamic: dynamicClient}))
+
+	_, err := reader.GetResource(t.Context(), resources.DataScienceCluster, "default-dsc")
+	g.Expect(err).ToNot(HaveOccurred())
+	_, err = reader.GetResource(t.Context(), resources.DataScienceCluster, "default-dsc")
+	g.Expect(err).ToNot(HaveOccurred())
+
+	hits, misses := reader.Stats()
+	g.Expect(hits).To(Equal(1))
+	g.Expect(misses).To(Equal(1))
+
+	reader.Invalidate()
+
+	_, err = reader.GetResource(t.Context(), resources.DataScienceCluster, "default-dsc")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(countActions(dynamicClient.Actions(), "get")).To(Equal(2))
+}
+
+func TestCachingReader_DoesNotCacheErrors(t *testing.T) {
+	g := NewWithT(t)
+
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+	reader := client.NewCachingReader(client.NewForTesting(client.TestClientConfig{Dynamic: dynamicClient}))
+
+	for range 2 {
+		_, err := reader.GetResource(t.Context(), resources.DataScienceCluster, "missing", client.InNamespace(metav1.NamespaceNone))
+		g.Expect(err).To(HaveOccurred())
+	}
+
+	g.Expect(countActions(dynamicClient.Actions(), "get")).To(Equal(2))
+}
+
+func countActions(actions []k8stesting.Action, verb string) int {
+	count := 0
+
+	for _, action := range actions {
+		if action.GetVerb() == verb {
+			count++
+		}
+	}
+
+	return count
+}
@@ -0,0 +1,250 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+)
+
+// EnvChaos is the environment variable holding the fault injection spec. It only takes effect
+// in binaries built with the chaos build tag (see ChaosEnabled).
+const EnvChaos = "ODH_CHAOS"
+
+// ChaosConfig describes the faults injected into Kubernetes API requests.
+type ChaosConfig struct {
+	// Latency is added to every matching request.
+	Latency time.Duration
+
+	// ThrottleRate is the fraction of matching requests answered with 429 Too Many Requests.
+	ThrottleRate float64
+
+	// RetryAfter is sent with injected 429s. client-go retries throttled requests that carry a
+	// Retry-After header, so a negative value (the default) omits it and the 429 reaches the check.
+	RetryAfter int
+
+	// ErrorRate is the fraction of matching requests answered with 503 Service Unavailable.
+	ErrorRate float64
+
+	// Match restricts injection to requests whose URL path contains it, to fail only some checks.
+	Match string
+
+	// Limit stops injecting faults after that many, so a retry can succeed. Zero means no limit.
+	Limit int
+
+	// Seed makes the injected faults reproducible. Zero picks a random seed.
+	Seed uint64
+}
+
+// ParseChaosConfig parses a comma-separated key=value fault injection spec, for example
+// "latency=200ms,throttle=0.2,error=0.1,match=/notebooks,limit=5,seed=42".
+func ParseChaosConfig(spec string) (ChaosConfig, error) {
+	cfg := ChaosConfig{RetryAfter: -1}
+
+	for entry := range strings.SplitSeq(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		key, value, ok := strings.Cut(entry, "=")
+		if !ok {
+			return ChaosConfig{}, fmt.Errorf("invalid chaos entry %q: expected key=value", entry)
+		}
+
+		var err error
+
+		switch key {
+		case "latency":
+			cfg.Latency, err = time.ParseDuration(value)
+		case "throttle":
+			cfg.ThrottleRate, err = parseRate(value)
+		case "retry-after":
+			cfg.RetryAfter, err = strconv.Atoi(value)
+		case "error":
+			cfg.ErrorRate, err = parseRate(value)
+		case "match":
+			cfg.Match = value
+		case "limit":
+			cfg.Limit, err = strconv.Atoi(value)
+		case "seed":
+			cfg.Seed, err = strconv.ParseUint(value, 10, 64)
+		default:
+			return ChaosConfig{}, fmt.Errorf("unknown chaos key %q", key)
+		}
+
+		if err != nil {
+			return ChaosConfig{}, fmt.Errorf("invalid chaos %s %q: %w", key, value, err)
+		}
+	}
+
+	return cfg, nil
+}
+
+func parseRate(value string) (float64, error) {
+	rate, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	if rate < 0 || rate > 1 {
+		return 0, fmt.Errorf("rate %v is not between 0 and 1", rate)
+	}
+
+	return rate, nil
+}
+
+// chaosTransport injects the faults of a ChaosConfig in front of another transport.
+type chaosTransport struct {
+	cfg  ChaosConfig
+	next http.RoundTripper
+
+	mu       sync.Mutex
+	rand     *rand.Rand
+	injected int
+}
+
+// NewChaosTransport returns a transport injecting the faults described by cfg into the
+// requests sent through next.
+func NewChaosTransport(cfg ChaosConfig, next http.RoundTripper) http.RoundTripper {
+	seed := cfg.Seed
+	if seed == 0 {
+		seed = rand.Uint64()
+	}
+
+	return &chaosTransport{
+		cfg:  cfg,
+		next: next,
+		rand: rand.New(rand.NewPCG(seed, seed)),
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *chaosTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.cfg.Match != "" && !strings.Contains(req.URL.Path, t.cfg.Match) {
+		return t.next.RoundTrip(req)
+	}
+
+	if t.cfg.Latency > 0 {
+		timer := time.NewTimer(t.cfg.Latency)
+
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+
+	switch t.nextFault() {
+	case http.StatusTooManyRequests:
+		resp := statusResponse(req, http.StatusTooManyRequests, metav1.StatusReasonTooManyRequests,
+			"chaos: injected throttling")
+		if t.cfg.RetryAfter >= 0 {
+			resp.Header.Set("Retry-After", strconv.Itoa(t.cfg.RetryAfter))
+		}
+
+		return resp, nil
+	case http.StatusServiceUnavailable:
+		return statusResponse(req, http.StatusServiceUnavailable, metav1.StatusReasonServiceUnavailable,
+			"chaos: injected API server failure"), nil
+	default:
+		return t.next.RoundTrip(req)
+	}
+}
+
+// nextFault returns the status code to inject for the next matching request, or 0 to pass it
+// through.
+func (t *chaosTransport) nextFault() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.cfg.Limit > 0 && t.injected >= t.cfg.Limit {
+		return 0
+	}
+
+	roll := t.rand.Float64()
+
+	var code int
+
+	switch {
+	case roll < t.cfg.ThrottleRate:
+		code = http.StatusTooManyRequests
+	case roll < t.cfg.ThrottleRate+t.cfg.ErrorRate:
+		code = http.StatusServiceUnavailable
+	default:
+		return 0
+	}
+
+	t.injected++
+
+	return code
+}
+
+// statusResponse builds an API server error response carrying a metav1.Status, so client-go
+// turns it into the same typed error a real API server would produce.
+func statusResponse(req *http.Request, code int, reason metav1.StatusReason, message string) *http.Response {
+	status := metav1.Status{
+		TypeMeta: metav1.TypeMeta{Kind: "Status", APIVersion: "v1"},
+		Status:   metav1.StatusFailure,
+		Message:  message,
+		Reason:   reason,
+		Code:     int32(code), //nolint:gosec // HTTP status codes fit in int32
+	}
+
+	body, _ := json.Marshal(status)
+
+	return &http.Response{
+		StatusCode:    code,
+		Status:        fmt.Sprintf("%d %s", code, http.StatusText(code)),
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// ActiveChaos returns the fault injection spec applied to REST configs, if any. It is always
+// empty unless the binary was built with the chaos build tag.
+func ActiveChaos() (string, bool) {
+	if !ChaosEnabled {
+		return "", false
+	}
+
+	spec := os.Getenv(EnvChaos)
+
+	return spec, spec != ""
+}
+
+// applyChaos wraps the transport of restConfig with the faults described by $ODH_CHAOS.
+func applyChaos(restConfig *rest.Config) error {
+	spec, ok := ActiveChaos()
+	if !ok {
+		return nil
+	}
+
+	cfg, err := ParseChaosConfig(spec)
+	if err != nil {
+		return fmt.Errorf("parsing %s: %w", EnvChaos, err)
+	}
+
+	restConfig.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return NewChaosTransport(cfg, rt)
+	})
+
+	return nil
+}
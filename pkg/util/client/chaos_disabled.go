@@ -0,0 +1,6 @@
+//go:build !chaos
+
+package client
+
+// ChaosEnabled reports whether this binary honours $ODH_CHAOS fault injection.
+const ChaosEnabled = false
@@ -0,0 +1,87 @@
+package client_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestParseChaosConfig(t *testing.T) {
+	g := NewWithT(t)
+
+	cfg, err := client.ParseChaosConfig("latency=200ms, throttle=0.2,error=0.1,match=/notebooks,limit=5,seed=42")
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(cfg).To(Equal(client.ChaosConfig{
+		Latency:      200 * time.Millisecond,
+		ThrottleRate: 0.2,
+		RetryAfter:   -1,
+		ErrorRate:    0.1,
+		Match:        "/notebooks",
+		Limit:        5,
+		Seed:         42,
+	}))
+}
+
+func TestParseChaosConfig_Invalid(t *testing.T) {
+	for _, spec := range []string{"latency", "latency=fast", "throttle=1.5", "error=-0.1", "jitter=1s"} {
+		t.Run(spec, func(t *testing.T) {
+			g := NewWithT(t)
+
+			_, err := client.ParseChaosConfig(spec)
+
+			g.Expect(err).To(HaveOccurred())
+		})
+	}
+}
+
+func TestChaosTransport(t *testing.T) {
+	g := NewWithT(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	transport := client.NewChaosTransport(client.ChaosConfig{
+		ErrorRate:  1,
+		RetryAfter: -1,
+		Match:      "/notebooks",
+		Limit:      1,
+	}, http.DefaultTransport)
+	httpClient := &http.Client{Transport: transport}
+
+	get := func(path string) int {
+		resp, err := httpClient.Get(server.URL + path)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		_ = resp.Body.Close()
+
+		return resp.StatusCode
+	}
+
+	g.Expect(get("/api/v1/pods")).To(Equal(http.StatusOK))
+	g.Expect(get("/apis/kubeflow.org/v1/notebooks")).To(Equal(http.StatusServiceUnavailable))
+	// The limit is reached, later requests pass through.
+	g.Expect(get("/apis/kubeflow.org/v1/notebooks")).To(Equal(http.StatusOK))
+}
+
+func TestChaosTransport_Throttle(t *testing.T) {
+	g := NewWithT(t)
+
+	transport := client.NewChaosTransport(client.ChaosConfig{ThrottleRate: 1, RetryAfter: 2}, http.DefaultTransport)
+
+	req := httptest.NewRequest(http.MethodGet, "https://api.example.com/api/v1/pods", nil)
+	resp, err := transport.RoundTrip(req)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(resp.StatusCode).To(Equal(http.StatusTooManyRequests))
+	g.Expect(resp.Header.Get("Retry-After")).To(Equal("2"))
+
+	_ = resp.Body.Close()
+}
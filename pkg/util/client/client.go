@@ -11,6 +11,7 @@ import (
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/discovery/cached/memory"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/metadata"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/restmapper"
@@ -27,6 +28,7 @@ type defaultClient struct {
 	olm           olmclientset.Interface
 	metadata      metadata.Interface
 	restMapper    meta.RESTMapper
+	kubernetes    kubernetes.Interface
 
 	olmReader OLMReader
 }
@@ -38,6 +40,8 @@ func (c *defaultClient) Metadata() metadata.Interface                    { retur
 func (c *defaultClient) RESTMapper() meta.RESTMapper                     { return c.restMapper }
 func (c *defaultClient) OLM() OLMReader                                  { return c.olmReader }
 func (c *defaultClient) OLMClient() olmclientset.Interface               { return c.olm }
+func (c *defaultClient) Logs() LogReader                                 { return &logReader{kubernetes: c.kubernetes} }
+func (c *defaultClient) Access() AccessReviewer                          { return &accessReviewer{kubernetes: c.kubernetes} }
 
 // NewClientWithConfig creates a client from a pre-configured REST config.
 // This allows callers to customize throttling settings before client creation.
@@ -67,6 +71,11 @@ func NewClientWithConfig(restConfig *rest.Config) (Client, error) {
 		return nil, fmt.Errorf("failed to create metadata client: %w", err)
 	}
 
+	kubernetesClient, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Kubernetes clientset: %w", err)
+	}
+
 	// Create RESTMapper with caching for efficient GVK->GVR mapping.
 	restMapper := restmapper.NewDeferredDiscoveryRESTMapper(
 		memory.NewMemCacheClient(discoveryClient),
@@ -79,6 +88,7 @@ func NewClientWithConfig(restConfig *rest.Config) (Client, error) {
 		olm:           olmClient,
 		metadata:      metadataClient,
 		restMapper:    restMapper,
+		kubernetes:    kubernetesClient,
 		olmReader:     newOLMReader(olmClient),
 	}, nil
 }
@@ -17,6 +17,10 @@ const (
 	// This is significantly higher than kubectl's default (10) to handle
 	// initial spikes when all workers start simultaneously.
 	DefaultBurst = 100
+
+	// ListPageSize is the number of items requested per page when listing resources, so
+	// large lists are paginated by the API server instead of returned in a single response.
+	ListPageSize = 500
 )
 
 // ConfigureThrottling configures QPS and Burst on a REST config.
@@ -50,5 +54,11 @@ func NewRESTConfig(
 	// Suppress Kubernetes API server deprecation warnings from cluttering CLI output.
 	restConfig.WarningHandler = rest.NoWarnings{}
 
+	if err := applyChaos(restConfig); err != nil {
+		return nil, err
+	}
+
+	observeRequests(restConfig)
+
 	return restConfig, nil
 }
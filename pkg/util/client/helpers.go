@@ -10,6 +10,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/opendatahub-io/odh-cli/pkg/resources"
 	"github.com/opendatahub-io/odh-cli/pkg/util"
@@ -74,6 +75,9 @@ type ListResourcesConfig struct {
 	Namespace     string
 	LabelSelector string
 	FieldSelector string
+
+	// Limit is the number of items requested per page; zero uses ListPageSize.
+	Limit int64
 }
 
 // ListResourcesOption is an option for configuring ListResources.
@@ -100,21 +104,61 @@ func WithFieldSelector(selector string) ListResourcesOption {
 	})
 }
 
+// WithLimit sets the number of items requested per page.
+func WithLimit(limit int64) ListResourcesOption {
+	return util.FunctionalOption[ListResourcesConfig](func(c *ListResourcesConfig) {
+		c.Limit = limit
+	})
+}
+
+// listOptions returns the list options requesting the page after continueToken.
+func (cfg *ListResourcesConfig) listOptions(continueToken string) metav1.ListOptions {
+	limit := cfg.Limit
+	if limit <= 0 {
+		limit = ListPageSize
+	}
+
+	return metav1.ListOptions{
+		LabelSelector: cfg.LabelSelector,
+		FieldSelector: cfg.FieldSelector,
+		Limit:         limit,
+		Continue:      continueToken,
+	}
+}
+
 // ListResources lists all instances of a resource type handling pagination automatically.
 // Returns pointers to avoid copying large objects.
 func (c *defaultClient) ListResources(ctx context.Context, gvr schema.GroupVersionResource, opts ...ListResourcesOption) ([]*unstructured.Unstructured, error) {
+	allItems := []*unstructured.Unstructured{}
+
+	err := c.ListResourcePages(ctx, gvr, func(items []*unstructured.Unstructured) error {
+		allItems = append(allItems, items...)
+
+		return nil
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return allItems, nil
+}
+
+// ListResourcePages lists all instances of a resource type one page at a time, calling fn with
+// the items of each page before the next page is requested. Items are allocated individually,
+// so a page is released as soon as fn drops the items it does not keep.
+func (c *defaultClient) ListResourcePages(
+	ctx context.Context,
+	gvr schema.GroupVersionResource,
+	fn func([]*unstructured.Unstructured) error,
+	opts ...ListResourcesOption,
+) error {
 	cfg := &ListResourcesConfig{}
 	util.ApplyOptions(cfg, opts...)
 
-	var allItems []*unstructured.Unstructured
 	continueToken := ""
 
 	for {
-		listOpts := metav1.ListOptions{
-			LabelSelector: cfg.LabelSelector,
-			FieldSelector: cfg.FieldSelector,
-			Continue:      continueToken,
-		}
+		listOpts := cfg.listOptions(continueToken)
 
 		var list *unstructured.UnstructuredList
 		var err error
@@ -126,27 +170,31 @@ func (c *defaultClient) ListResources(ctx context.Context, gvr schema.GroupVersi
 		}
 
 		if err != nil {
-			// Permission errors are non-fatal - return empty list
-			if IsPermissionError(err) {
-				return []*unstructured.Unstructured{}, nil
+			// Permission errors are non-fatal - return empty list. Once pages were delivered the
+			// list would be partial, so the error is returned for callers to detect.
+			if IsPermissionError(err) && continueToken == "" {
+				return nil
 			}
 
-			return nil, fmt.Errorf("listing resources: %w", err)
+			return fmt.Errorf("listing resources: %w", err)
 		}
 
-		// Append results (convert to pointers)
+		page := make([]*unstructured.Unstructured, 0, len(list.Items))
 		for i := range list.Items {
-			allItems = append(allItems, &list.Items[i])
+			item := list.Items[i]
+			page = append(page, &item)
+		}
+
+		if err := fn(page); err != nil {
+			return err
 		}
 
 		// Check if more pages exist
 		if list.GetContinue() == "" {
-			break
+			return nil
 		}
 		continueToken = list.GetContinue()
 	}
-
-	return allItems, nil
 }
 
 // List lists all instances of a resource type handling pagination automatically.
@@ -160,20 +208,36 @@ func (c *defaultClient) List(ctx context.Context, resourceType resources.Resourc
 // Handles pagination automatically. Returns pointers to avoid copying.
 // This is more efficient than List when only metadata fields (name, namespace, labels, annotations) are needed.
 func (c *defaultClient) ListMetadata(ctx context.Context, resourceType resources.ResourceType, opts ...ListResourcesOption) ([]*metav1.PartialObjectMetadata, error) {
+	allItems := []*metav1.PartialObjectMetadata{}
+
+	err := c.ListMetadataPages(ctx, resourceType, func(items []*metav1.PartialObjectMetadata) error {
+		allItems = append(allItems, items...)
+
+		return nil
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return allItems, nil
+}
+
+// ListMetadataPages is ListResourcePages returning only metadata.
+func (c *defaultClient) ListMetadataPages(
+	ctx context.Context,
+	resourceType resources.ResourceType,
+	fn func([]*metav1.PartialObjectMetadata) error,
+	opts ...ListResourcesOption,
+) error {
 	cfg := &ListResourcesConfig{}
 	util.ApplyOptions(cfg, opts...)
 
-	var allItems []*metav1.PartialObjectMetadata
 	continueToken := ""
 
 	gvr := resourceType.GVR()
 
 	for {
-		listOpts := metav1.ListOptions{
-			LabelSelector: cfg.LabelSelector,
-			FieldSelector: cfg.FieldSelector,
-			Continue:      continueToken,
-		}
+		listOpts := cfg.listOptions(continueToken)
 
 		var list *metav1.PartialObjectMetadataList
 		var err error
@@ -185,27 +249,31 @@ func (c *defaultClient) ListMetadata(ctx context.Context, resourceType resources
 		}
 
 		if err != nil {
-			// Permission errors are non-fatal - return empty list
-			if IsPermissionError(err) {
-				return []*metav1.PartialObjectMetadata{}, nil
+			// Permission errors are non-fatal - return empty list. Once pages were delivered the
+			// list would be partial, so the error is returned for callers to detect.
+			if IsPermissionError(err) && continueToken == "" {
+				return nil
 			}
 
-			return nil, fmt.Errorf("listing metadata for resources: %w", err)
+			return fmt.Errorf("listing metadata for resources: %w", err)
 		}
 
-		// Append results (convert to pointers)
+		page := make([]*metav1.PartialObjectMetadata, 0, len(list.Items))
 		for i := range list.Items {
-			allItems = append(allItems, &list.Items[i])
+			item := list.Items[i]
+			page = append(page, &item)
+		}
+
+		if err := fn(page); err != nil {
+			return err
 		}
 
 		// Check if more pages exist
 		if list.GetContinue() == "" {
-			break
+			return nil
 		}
 		continueToken = list.GetContinue()
 	}
-
-	return allItems, nil
 }
 
 // GetResource is a convenience wrapper around Get that accepts ResourceType.
@@ -404,6 +472,28 @@ func List[T namespacedNamer](
 	return result, nil
 }
 
+// ListByNamespacedName lists resources of the given type indexed by namespace and name.
+// Cluster-scoped resources are indexed by name with an empty namespace. CRD-not-found
+// errors are treated as an empty index.
+func ListByNamespacedName(
+	ctx context.Context,
+	r Reader,
+	resourceType resources.ResourceType,
+) (map[types.NamespacedName]*unstructured.Unstructured, error) {
+	items, err := List[*unstructured.Unstructured](ctx, r, resourceType, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[types.NamespacedName]*unstructured.Unstructured, len(items))
+
+	for _, item := range items {
+		byName[types.NamespacedName{Namespace: item.GetNamespace(), Name: item.GetName()}] = item
+	}
+
+	return byName, nil
+}
+
 // listItems dispatches to Reader.List or Reader.ListMetadata based on the concrete type of T.
 func listItems[T namespacedNamer](
 	ctx context.Context,
@@ -14,6 +14,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8stypes "k8s.io/apimachinery/pkg/types"
 	dynamicfake "k8s.io/client-go/dynamic/fake"
 	metadatafake "k8s.io/client-go/metadata/fake"
 
@@ -619,6 +620,41 @@ func TestList_CRDNotFound(t *testing.T) {
 	g.Expect(results).To(BeNil())
 }
 
+func TestListByNamespacedName(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	objects := createTestObjects(2)
+	scheme := runtime.NewScheme()
+	_ = metav1.AddMetaToScheme(scheme)
+
+	c := &defaultClient{
+		dynamic:   dynamicfake.NewSimpleDynamicClient(scheme, objects...),
+		metadata:  metadatafake.NewSimpleMetadataClient(scheme, objects...),
+		olmReader: newOLMReader(nil),
+	}
+
+	byName, err := ListByNamespacedName(ctx, c, configMapResourceType())
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(byName).To(HaveLen(2))
+	g.Expect(byName).To(HaveKey(k8stypes.NamespacedName{Namespace: testNamespace, Name: "test-cm-1"}))
+	g.Expect(byName[k8stypes.NamespacedName{Namespace: testNamespace, Name: "test-cm-2"}].GetName()).To(Equal("test-cm-2"))
+}
+
+func TestListByNamespacedName_CRDNotFound(t *testing.T) {
+	g := NewWithT(t)
+
+	c := &errorReader{
+		listErr: &meta.NoResourceMatchError{PartialResource: resources.Notebook.GVR()},
+	}
+
+	byName, err := ListByNamespacedName(t.Context(), c, resources.Notebook)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(byName).To(BeEmpty())
+}
+
 func TestList_FilterErrorPropagation(t *testing.T) {
 	g := NewWithT(t)
 	ctx := t.Context()
@@ -711,3 +747,14 @@ func (r *errorReader) OLM() OLMReader {
 
 // Compile-time check that errorReader implements Reader.
 var _ Reader = (*errorReader)(nil)
+
+func TestListResourcesConfig_ListOptions(t *testing.T) {
+	g := NewWithT(t)
+
+	cfg := &ListResourcesConfig{LabelSelector: "app=odh"}
+	g.Expect(cfg.listOptions("")).To(Equal(metav1.ListOptions{LabelSelector: "app=odh", Limit: ListPageSize}))
+
+	cfg = &ListResourcesConfig{}
+	WithLimit(10).ApplyTo(cfg)
+	g.Expect(cfg.listOptions("token")).To(Equal(metav1.ListOptions{Limit: 10, Continue: "token"}))
+}
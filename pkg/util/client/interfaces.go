@@ -71,6 +71,27 @@ type Reader interface {
 	OLM() OLMReader
 }
 
+// Pager is implemented by Readers that can list resources one page at a time, so callers
+// keeping only some of the items never hold the full list in memory. Use ForEachPage and
+// ForEachMetadataPage, which fall back to a single page for Readers that are not Pagers.
+type Pager interface {
+	// ListResourcePages calls fn with each page of the instances of a resource by GVR.
+	ListResourcePages(
+		ctx context.Context,
+		gvr schema.GroupVersionResource,
+		fn func([]*unstructured.Unstructured) error,
+		opts ...ListResourcesOption,
+	) error
+
+	// ListMetadataPages calls fn with each page of the metadata of a resource type.
+	ListMetadataPages(
+		ctx context.Context,
+		resourceType resources.ResourceType,
+		fn func([]*metav1.PartialObjectMetadata) error,
+		opts ...ListResourcesOption,
+	) error
+}
+
 // Writer provides write access to Kubernetes resources.
 // Currently empty -- write operations will be added as needed.
 type Writer any
@@ -100,6 +121,26 @@ type Client interface {
 	// OLMClient returns the full OLM clientset for write operations (subscriptions, CSVs).
 	// Use OLM() from Reader for read-only access.
 	OLMClient() olmclientset.Interface
+
+	// Logs returns read access to the logs of pod containers, for diagnostics collection.
+	Logs() LogReader
+
+	// Access returns the evaluator of the permissions of the current user.
+	Access() AccessReviewer
+}
+
+// AccessReviewer evaluates the permissions of the current user.
+type AccessReviewer interface {
+	// Review reports whether the current user has permission in all namespaces, with the
+	// reason given by the authorizer, if any.
+	Review(ctx context.Context, permission resources.Permission) (bool, string, error)
+}
+
+// LogReader provides read-only access to the logs of pod containers.
+type LogReader interface {
+	// Tail returns the last lines of the log of a container of a pod. Zero or negative lines
+	// returns the whole log.
+	Tail(ctx context.Context, namespace string, pod string, container string, lines int64) ([]byte, error)
 }
 
 // OLMReader provides read-only access to OLM resources.
@@ -0,0 +1,42 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// ErrLogsUnavailable is returned by a LogReader of a client built without a Kubernetes clientset.
+var ErrLogsUnavailable = errors.New("pod logs are not available from this client")
+
+// logReader reads pod logs through the Kubernetes clientset.
+type logReader struct {
+	kubernetes kubernetes.Interface
+}
+
+func (r *logReader) Tail(
+	ctx context.Context,
+	namespace string,
+	pod string,
+	container string,
+	lines int64,
+) ([]byte, error) {
+	if r.kubernetes == nil {
+		return nil, ErrLogsUnavailable
+	}
+
+	opts := &corev1.PodLogOptions{Container: container}
+	if lines > 0 {
+		opts.TailLines = &lines
+	}
+
+	data, err := r.kubernetes.CoreV1().Pods(namespace).GetLogs(pod, opts).DoRaw(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("reading logs of %s/%s container %s: %w", namespace, pod, container, err)
+	}
+
+	return data, nil
+}
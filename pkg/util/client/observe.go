@@ -0,0 +1,69 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"k8s.io/client-go/rest"
+)
+
+// RequestObserver is called after each Kubernetes API request made with a context carrying
+// it (see WithRequestObserver). status is zero when the request failed without a response.
+type RequestObserver func(req *http.Request, status int, elapsed time.Duration, err error)
+
+type requestObserverKey struct{}
+
+// WithRequestObserver returns a context whose Kubernetes API requests are reported to
+// observer, in addition to the observers of ctx. Only clients created with NewRESTConfig
+// report requests.
+func WithRequestObserver(ctx context.Context, observer RequestObserver) context.Context {
+	if parent := requestObserverFrom(ctx); parent != nil {
+		next := observer
+		observer = func(req *http.Request, status int, elapsed time.Duration, err error) {
+			next(req, status, elapsed, err)
+			parent(req, status, elapsed, err)
+		}
+	}
+
+	return context.WithValue(ctx, requestObserverKey{}, observer)
+}
+
+func requestObserverFrom(ctx context.Context) RequestObserver {
+	observer, _ := ctx.Value(requestObserverKey{}).(RequestObserver)
+
+	return observer
+}
+
+// observingTransport reports the requests made with a context carrying a RequestObserver.
+type observingTransport struct {
+	next http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *observingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	observer := requestObserverFrom(req.Context())
+	if observer == nil {
+		return t.next.RoundTrip(req)
+	}
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+
+	status := 0
+	if resp != nil {
+		status = resp.StatusCode
+	}
+
+	observer(req, status, time.Since(start), err)
+
+	return resp, err //nolint:wrapcheck // Transports return errors unchanged
+}
+
+// observeRequests wraps the transport of restConfig, outermost, so observers see every
+// request including injected faults.
+func observeRequests(restConfig *rest.Config) {
+	restConfig.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return &observingTransport{next: rt}
+	})
+}
@@ -0,0 +1,73 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/rest"
+
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+// newObservedHTTPClient returns an HTTP client of a REST config created by NewRESTConfig for
+// a test API server answering 200 to all requests.
+func newObservedHTTPClient(t *testing.T) (*http.Client, string) {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	configFlags := genericclioptions.NewConfigFlags(false)
+	configFlags.APIServer = &server.URL
+
+	config, err := client.NewRESTConfig(configFlags, client.DefaultQPS, client.DefaultBurst)
+	NewWithT(t).Expect(err).ToNot(HaveOccurred())
+
+	httpClient, err := rest.HTTPClientFor(config)
+	NewWithT(t).Expect(err).ToNot(HaveOccurred())
+
+	return httpClient, server.URL
+}
+
+func get(ctx context.Context, g Gomega, httpClient *http.Client, url string) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	resp, err := httpClient.Do(req)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	_ = resp.Body.Close()
+}
+
+func TestWithRequestObserver(t *testing.T) {
+	g := NewWithT(t)
+
+	httpClient, url := newObservedHTTPClient(t)
+
+	var inner, outer []string
+
+	ctx := client.WithRequestObserver(t.Context(), func(req *http.Request, status int, _ time.Duration, err error) {
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(status).To(Equal(http.StatusOK))
+
+		outer = append(outer, req.URL.Path)
+	})
+	nested := client.WithRequestObserver(ctx, func(req *http.Request, _ int, _ time.Duration, _ error) {
+		inner = append(inner, req.URL.Path)
+	})
+
+	get(nested, g, httpClient, url+"/api/v1/pods")
+	get(ctx, g, httpClient, url+"/api/v1/namespaces")
+	get(t.Context(), g, httpClient, url+"/api/v1/secrets")
+
+	g.Expect(inner).To(Equal([]string{"/api/v1/pods"}))
+	g.Expect(outer).To(Equal([]string{"/api/v1/pods", "/api/v1/namespaces"}))
+}
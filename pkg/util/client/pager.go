@@ -0,0 +1,52 @@
+package client
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+)
+
+// ForEachPage calls fn with each page of the instances of a resource type. Readers that are
+// not Pagers (e.g. backups, which are in memory already) deliver the whole list as one page.
+// An error returned by fn stops the listing and is returned as is.
+func ForEachPage(
+	ctx context.Context,
+	r Reader,
+	resourceType resources.ResourceType,
+	fn func([]*unstructured.Unstructured) error,
+	opts ...ListResourcesOption,
+) error {
+	if pager, ok := r.(Pager); ok {
+		return pager.ListResourcePages(ctx, resourceType.GVR(), fn, opts...) //nolint:wrapcheck // Returned as is
+	}
+
+	items, err := r.List(ctx, resourceType, opts...)
+	if err != nil {
+		return err //nolint:wrapcheck // Errors of the reader are returned as is
+	}
+
+	return fn(items)
+}
+
+// ForEachMetadataPage is ForEachPage returning only metadata.
+func ForEachMetadataPage(
+	ctx context.Context,
+	r Reader,
+	resourceType resources.ResourceType,
+	fn func([]*metav1.PartialObjectMetadata) error,
+	opts ...ListResourcesOption,
+) error {
+	if pager, ok := r.(Pager); ok {
+		return pager.ListMetadataPages(ctx, resourceType, fn, opts...) //nolint:wrapcheck // Returned as is
+	}
+
+	items, err := r.ListMetadata(ctx, resourceType, opts...)
+	if err != nil {
+		return err //nolint:wrapcheck // Errors of the reader are returned as is
+	}
+
+	return fn(items)
+}
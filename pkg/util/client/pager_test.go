@@ -0,0 +1,243 @@
+package client_test
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	metadatafake "k8s.io/client-go/metadata/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+// newPagingDynamicClient returns a fake dynamic client serving count notebooks in pages of two.
+// The fake client drops the limit and continue token from list actions, so pages are served in
+// sequence, restarting after the last page.
+func newPagingDynamicClient(count int) *dynamicfake.FakeDynamicClient {
+	const pageSize = 2
+
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			resources.Notebook.GVR(): resources.Notebook.ListKind(),
+		},
+	)
+
+	start := 0
+
+	dynamicClient.PrependReactor("list", resources.Notebook.Resource,
+		func(_ k8stesting.Action) (bool, runtime.Object, error) {
+			end := min(start+pageSize, count)
+
+			list := &unstructured.UnstructuredList{Object: map[string]any{
+				"apiVersion": resources.Notebook.APIVersion(),
+				"kind":       resources.Notebook.ListKind(),
+			}}
+
+			for i := start; i < end; i++ {
+				nb := unstructured.Unstructured{}
+				nb.SetGroupVersionKind(resources.Notebook.GVK())
+				nb.SetNamespace("ns")
+				nb.SetName(fmt.Sprintf("nb-%d", i))
+				list.Items = append(list.Items, nb)
+			}
+
+			start = end
+			if end < count {
+				list.SetContinue(strconv.Itoa(end))
+			} else {
+				start = 0
+			}
+
+			return true, list, nil
+		})
+
+	return dynamicClient
+}
+
+func TestForEachPage_StreamsPages(t *testing.T) {
+	g := NewWithT(t)
+
+	dynamicClient := newPagingDynamicClient(5)
+	c := client.NewForTesting(client.TestClientConfig{Dynamic: dynamicClient})
+
+	var pages []int
+
+	err := client.ForEachPage(t.Context(), c, resources.Notebook, func(items []*unstructured.Unstructured) error {
+		pages = append(pages, len(items))
+
+		return nil
+	})
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(pages).To(Equal([]int{2, 2, 1}))
+	g.Expect(countActions(dynamicClient.Actions(), "list")).To(Equal(3))
+
+	// List reads every page.
+	items, err := c.List(t.Context(), resources.Notebook)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(items).To(HaveLen(5))
+	g.Expect(items[4].GetName()).To(Equal("nb-4"))
+}
+
+func TestForEachPage_CallbackErrorStopsListing(t *testing.T) {
+	g := NewWithT(t)
+
+	dynamicClient := newPagingDynamicClient(5)
+	c := client.NewForTesting(client.TestClientConfig{Dynamic: dynamicClient})
+
+	stop := errors.New("stop")
+
+	err := client.ForEachPage(t.Context(), c, resources.Notebook, func(_ []*unstructured.Unstructured) error {
+		return stop
+	})
+
+	g.Expect(err).To(MatchError(stop))
+	g.Expect(countActions(dynamicClient.Actions(), "list")).To(Equal(1))
+}
+
+func TestForEachPage_CachingReader(t *testing.T) {
+	g := NewWithT(t)
+
+	dynamicClient := newPagingDynamicClient(5)
+	reader := client.NewCachingReader(client.NewForTesting(client.TestClientConfig{Dynamic: dynamicClient}))
+
+	count := func() int {
+		total := 0
+
+		err := client.ForEachPage(t.Context(), reader, resources.Notebook, func(items []*unstructured.Unstructured) error {
+			total += len(items)
+
+			return nil
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+
+		return total
+	}
+
+	// Uncached lists are streamed and cached once the last page was read.
+	g.Expect(count()).To(Equal(5))
+	g.Expect(countActions(dynamicClient.Actions(), "list")).To(Equal(3))
+
+	// Cached lists are served from memory, paged or not.
+	items, err := reader.List(t.Context(), resources.Notebook)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(items).To(HaveLen(5))
+
+	g.Expect(count()).To(Equal(5))
+	g.Expect(countActions(dynamicClient.Actions(), "list")).To(Equal(3))
+}
+
+func TestForEachPage_CachingReaderCallbackError(t *testing.T) {
+	g := NewWithT(t)
+
+	dynamicClient := newPagingDynamicClient(5)
+	reader := client.NewCachingReader(client.NewForTesting(client.TestClientConfig{Dynamic: dynamicClient}))
+
+	stop := errors.New("stop")
+
+	err := client.ForEachPage(t.Context(), reader, resources.Notebook, func(_ []*unstructured.Unstructured) error {
+		return stop
+	})
+	g.Expect(err).To(MatchError(stop))
+
+	// Incomplete listings are not cached, so the next read lists again.
+	_, err = reader.List(t.Context(), resources.Notebook)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(countActions(dynamicClient.Actions(), "list")).To(BeNumerically(">", 1))
+}
+
+func TestForEachPage_PermissionError(t *testing.T) {
+	t.Run("first page lists nothing", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dynamicClient := newPagingDynamicClient(5)
+		dynamicClient.PrependReactor("list", resources.Notebook.Resource,
+			func(_ k8stesting.Action) (bool, runtime.Object, error) {
+				return true, nil, apierrors.NewForbidden(resources.Notebook.GVR().GroupResource(), "", errors.New("denied"))
+			})
+
+		c := client.NewForTesting(client.TestClientConfig{Dynamic: dynamicClient})
+
+		items, err := c.List(t.Context(), resources.Notebook)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(items).To(BeEmpty())
+	})
+
+	t.Run("later page returns the error", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dynamicClient := newPagingDynamicClient(5)
+
+		calls := 0
+		dynamicClient.PrependReactor("list", resources.Notebook.Resource,
+			func(_ k8stesting.Action) (bool, runtime.Object, error) {
+				calls++
+				if calls < 2 {
+					return false, nil, nil
+				}
+
+				return true, nil, apierrors.NewForbidden(resources.Notebook.GVR().GroupResource(), "", errors.New("denied"))
+			})
+
+		c := client.NewForTesting(client.TestClientConfig{Dynamic: dynamicClient})
+
+		var delivered int
+
+		err := client.ForEachPage(t.Context(), c, resources.Notebook, func(items []*unstructured.Unstructured) error {
+			delivered += len(items)
+
+			return nil
+		})
+
+		// The first page was delivered, so the listing is partial and must not look complete.
+		g.Expect(delivered).To(Equal(2))
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(client.IsPermissionError(err)).To(BeTrue())
+	})
+}
+
+func newNotebookMetadata(name string) *metav1.PartialObjectMetadata {
+	return &metav1.PartialObjectMetadata{
+		TypeMeta:   resources.Notebook.TypeMeta(),
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: name},
+	}
+}
+
+// listOnlyReader is a Reader that is not a Pager.
+type listOnlyReader struct {
+	client.Reader
+}
+
+func TestForEachMetadataPage_FallsBackToSinglePage(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	_ = metav1.AddMetaToScheme(scheme)
+
+	metadataClient := metadatafake.NewSimpleMetadataClient(scheme,
+		newNotebookMetadata("nb-1"), newNotebookMetadata("nb-2"))
+
+	reader := &listOnlyReader{Reader: client.NewForTesting(client.TestClientConfig{Metadata: metadataClient})}
+
+	var pages []int
+
+	err := client.ForEachMetadataPage(t.Context(), reader, resources.Notebook, func(items []*metav1.PartialObjectMetadata) error {
+		pages = append(pages, len(items))
+
+		return nil
+	})
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(pages).To(Equal([]int{2}))
+}
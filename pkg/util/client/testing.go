@@ -7,6 +7,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/metadata"
 )
 
@@ -18,6 +19,7 @@ type TestClientConfig struct {
 	OLM           olmclientset.Interface
 	Metadata      metadata.Interface
 	RESTMapper    meta.RESTMapper
+	Kubernetes    kubernetes.Interface
 }
 
 // NewForTesting creates a Client for use in tests.
@@ -30,6 +32,7 @@ func NewForTesting(cfg TestClientConfig) Client {
 		olm:           cfg.OLM,
 		metadata:      cfg.Metadata,
 		restMapper:    cfg.RESTMapper,
+		kubernetes:    cfg.Kubernetes,
 		olmReader:     newOLMReader(cfg.OLM),
 	}
 }
@@ -0,0 +1,89 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/opendatahub-io/odh-cli/pkg/util"
+)
+
+// Retry configuration for resourceVersion conflicts.
+const (
+	conflictRetryInitialDuration = 500 * time.Millisecond
+	conflictRetryFactor          = 2.0
+	conflictRetryJitter          = 0.1
+	conflictRetryMaxSteps        = 5
+)
+
+// MutateFunc modifies a freshly fetched object in place before it is updated.
+type MutateFunc func(obj *unstructured.Unstructured) error
+
+// UpdateWithConflictRetry fetches the latest version of a resource, applies mutate and updates it.
+// When the update fails with a resourceVersion conflict (e.g. a concurrent operator write), the
+// object is fetched again and mutate is re-applied, with bounded exponential backoff.
+// Use InNamespace for namespaced resources. Errors from mutate are returned without retrying.
+func UpdateWithConflictRetry(
+	ctx context.Context,
+	c Client,
+	gvr schema.GroupVersionResource,
+	name string,
+	mutate MutateFunc,
+	opts ...GetOption,
+) (*unstructured.Unstructured, error) {
+	cfg := &GetConfig{}
+	util.ApplyOptions(cfg, opts...)
+
+	var resource dynamic.ResourceInterface = c.Dynamic().Resource(gvr)
+	if cfg.Namespace != "" {
+		resource = c.Dynamic().Resource(gvr).Namespace(cfg.Namespace)
+	}
+
+	backoff := wait.Backoff{
+		Duration: conflictRetryInitialDuration,
+		Factor:   conflictRetryFactor,
+		Jitter:   conflictRetryJitter,
+		Steps:    conflictRetryMaxSteps,
+	}
+
+	var updated *unstructured.Unstructured
+
+	err := wait.ExponentialBackoffWithContext(ctx, backoff, func(ctx context.Context) (bool, error) {
+		latest, err := resource.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return false, fmt.Errorf("getting %s %s: %w", gvr.Resource, name, err)
+		}
+
+		if err := mutate(latest); err != nil {
+			return false, fmt.Errorf("mutating %s %s: %w", gvr.Resource, name, err)
+		}
+
+		updated, err = resource.Update(ctx, latest, metav1.UpdateOptions{})
+		if apierrors.IsConflict(err) {
+			return false, nil
+		}
+
+		if err != nil {
+			return false, fmt.Errorf("updating %s %s: %w", gvr.Resource, name, err)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		if wait.Interrupted(err) && ctx.Err() == nil {
+			return nil, fmt.Errorf("updating %s %s: conflict persisted after %d attempts: %w",
+				gvr.Resource, name, conflictRetryMaxSteps, err)
+		}
+
+		return nil, err
+	}
+
+	return updated, nil
+}
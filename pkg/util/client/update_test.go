@@ -0,0 +1,92 @@
+//nolint:testpackage // Tests internal implementation (Client fields)
+package client
+
+import (
+	"errors"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+)
+
+func newUpdateTestClient() (*defaultClient, *dynamicfake.FakeDynamicClient) {
+	scheme := runtime.NewScheme()
+	_ = metav1.AddMetaToScheme(scheme)
+
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(scheme, createTestObjects(1)...)
+
+	return &defaultClient{dynamic: dynamicClient, olmReader: newOLMReader(nil)}, dynamicClient
+}
+
+func setLabel(obj *unstructured.Unstructured) error {
+	obj.SetLabels(map[string]string{"migrated": "true"})
+
+	return nil
+}
+
+func TestUpdateWithConflictRetry_RetriesOnConflict(t *testing.T) {
+	g := NewWithT(t)
+
+	client, dynamicClient := newUpdateTestClient()
+
+	conflicts := 0
+	dynamicClient.PrependReactor("update", "configmaps", func(_ k8stesting.Action) (bool, runtime.Object, error) {
+		if conflicts > 0 {
+			return false, nil, nil
+		}
+
+		conflicts++
+
+		return true, nil, apierrors.NewConflict(resources.ConfigMap.GVR().GroupResource(), "test-cm-1", errors.New("modified"))
+	})
+
+	mutations := 0
+	updated, err := UpdateWithConflictRetry(t.Context(), client, resources.ConfigMap.GVR(), "test-cm-1",
+		func(obj *unstructured.Unstructured) error {
+			mutations++
+
+			return setLabel(obj)
+		},
+		InNamespace(testNamespace))
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(mutations).To(Equal(2))
+	g.Expect(updated.GetLabels()).To(HaveKeyWithValue("migrated", "true"))
+
+	stored, err := client.Get(t.Context(), resources.ConfigMap.GVR(), "test-cm-1", InNamespace(testNamespace))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(stored.GetLabels()).To(HaveKeyWithValue("migrated", "true"))
+}
+
+func TestUpdateWithConflictRetry_MutateError(t *testing.T) {
+	g := NewWithT(t)
+
+	client, _ := newUpdateTestClient()
+
+	_, err := UpdateWithConflictRetry(t.Context(), client, resources.ConfigMap.GVR(), "test-cm-1",
+		func(_ *unstructured.Unstructured) error {
+			return errors.New("boom")
+		},
+		InNamespace(testNamespace))
+
+	g.Expect(err).To(MatchError(ContainSubstring("boom")))
+}
+
+func TestUpdateWithConflictRetry_NotFound(t *testing.T) {
+	g := NewWithT(t)
+
+	client, _ := newUpdateTestClient()
+
+	_, err := UpdateWithConflictRetry(t.Context(), client, resources.ConfigMap.GVR(), "missing", setLabel,
+		InNamespace(testNamespace))
+
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+}
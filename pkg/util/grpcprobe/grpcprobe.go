@@ -0,0 +1,323 @@
+// Package grpcprobe implements the few unary gRPC calls used to probe model serving runtimes:
+// the standard health check and the KServe v2 (Open Inference Protocol) server and model
+// metadata. It speaks gRPC directly over HTTP/2, so no generated stubs are needed.
+package grpcprobe
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"golang.org/x/net/http2"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// gRPC methods called by the probes.
+const (
+	MethodHealthCheck    = "/grpc.health.v1.Health/Check"
+	MethodServerMetadata = "/inference.GRPCInferenceService/ServerMetadata"
+	MethodModelMetadata  = "/inference.GRPCInferenceService/ModelMetadata"
+)
+
+// gRPC status codes reported by the probed servers.
+const (
+	CodeOK            = 0
+	CodeNotFound      = 5
+	CodeUnimplemented = 12
+	CodeUnavailable   = 14
+)
+
+// Serving statuses of the gRPC health protocol.
+const (
+	HealthUnknown        = "UNKNOWN"
+	HealthServing        = "SERVING"
+	HealthNotServing     = "NOT_SERVING"
+	HealthServiceUnknown = "SERVICE_UNKNOWN"
+)
+
+// frameHeaderSize is the size of the gRPC message prefix: a compression flag and a length.
+const frameHeaderSize = 5
+
+// maxMessageSize bounds the responses read, as probes only expect small metadata messages.
+const maxMessageSize = 4 << 20
+
+// StatusError is a non-OK gRPC status returned by the server.
+type StatusError struct {
+	Code    int
+	Message string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("grpc status %d: %s", e.Code, e.Message)
+}
+
+// IsCode reports whether err is a StatusError with the given code.
+func IsCode(err error, code int) bool {
+	var statusErr *StatusError
+
+	return errors.As(err, &statusErr) && statusErr.Code == code
+}
+
+// ServerMetadata is the KServe v2 ServerMetadataResponse.
+type ServerMetadata struct {
+	Name       string
+	Version    string
+	Extensions []string
+}
+
+// ModelMetadata is the subset of the KServe v2 ModelMetadataResponse used by the probes.
+type ModelMetadata struct {
+	Name     string
+	Versions []string
+	Platform string
+}
+
+// NewHTTPClient returns an HTTP/2 client suitable for gRPC: TLS with ALPN for https
+// endpoints and prior-knowledge HTTP/2 (h2c) for http endpoints.
+func NewHTTPClient(timeout time.Duration, tlsConfig *tls.Config) *http.Client {
+	tlsTransport := &http2.Transport{TLSClientConfig: tlsConfig}
+	plainTransport := &http2.Transport{
+		AllowHTTP: true,
+		DialTLSContext: func(ctx context.Context, network, addr string, _ *tls.Config) (net.Conn, error) {
+			var dialer net.Dialer
+
+			return dialer.DialContext(ctx, network, addr)
+		},
+	}
+
+	return &http.Client{
+		Timeout: timeout,
+		Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			if req.URL.Scheme == "http" {
+				return plainTransport.RoundTrip(req)
+			}
+
+			return tlsTransport.RoundTrip(req)
+		}),
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Invoke calls a unary gRPC method on the server at endpoint (an http or https URL, whose
+// path is ignored) and returns the encoded response message.
+func Invoke(ctx context.Context, httpClient *http.Client, endpoint string, method string, request []byte) ([]byte, error) {
+	base, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("parsing endpoint %q: %w", endpoint, err)
+	}
+
+	target := url.URL{Scheme: base.Scheme, Host: base.Host, Path: method}
+
+	body := make([]byte, frameHeaderSize, frameHeaderSize+len(request))
+	binary.BigEndian.PutUint32(body[1:], uint32(len(request))) //nolint:gosec // Probe requests are tiny
+	body = append(body, request...)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/grpc")
+	req.Header.Set("TE", "trailers")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("calling %s: %w", method, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("calling %s: unexpected HTTP status %s", method, resp.Status)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxMessageSize+frameHeaderSize))
+	if err != nil {
+		return nil, fmt.Errorf("reading %s response: %w", method, err)
+	}
+
+	// Trailers-only responses carry the status in the headers
+	if err := statusFrom(resp.Trailer, resp.Header); err != nil {
+		return nil, err
+	}
+
+	if len(data) < frameHeaderSize {
+		return nil, fmt.Errorf("reading %s response: missing message", method)
+	}
+
+	if data[0] != 0 {
+		return nil, fmt.Errorf("reading %s response: compressed messages are not supported", method)
+	}
+
+	size := binary.BigEndian.Uint32(data[1:frameHeaderSize])
+	if int(size) > len(data)-frameHeaderSize {
+		return nil, fmt.Errorf("reading %s response: truncated message", method)
+	}
+
+	return data[frameHeaderSize : frameHeaderSize+int(size)], nil
+}
+
+func statusFrom(headers ...http.Header) error {
+	for _, h := range headers {
+		value := h.Get("Grpc-Status")
+		if value == "" {
+			continue
+		}
+
+		code, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("invalid grpc-status %q: %w", value, err)
+		}
+
+		if code == CodeOK {
+			return nil
+		}
+
+		message, _ := url.PathUnescape(h.Get("Grpc-Message"))
+
+		return &StatusError{Code: code, Message: message}
+	}
+
+	return errors.New("response has no grpc-status")
+}
+
+// Health calls the standard gRPC health check for the whole server.
+func Health(ctx context.Context, httpClient *http.Client, endpoint string) (string, error) {
+	data, err := Invoke(ctx, httpClient, endpoint, MethodHealthCheck, nil)
+	if err != nil {
+		return "", err
+	}
+
+	status := HealthUnknown
+
+	err = consumeFields(data, func(num protowire.Number, typ protowire.Type, value []byte) int {
+		if num != 1 || typ != protowire.VarintType {
+			return protowire.ConsumeFieldValue(num, typ, value)
+		}
+
+		v, n := protowire.ConsumeVarint(value)
+		switch v {
+		case 1:
+			status = HealthServing
+		case 2:
+			status = HealthNotServing
+		case 3:
+			status = HealthServiceUnknown
+		}
+
+		return n
+	})
+	if err != nil {
+		return "", fmt.Errorf("decoding health response: %w", err)
+	}
+
+	return status, nil
+}
+
+// GetServerMetadata calls the KServe v2 ServerMetadata method. Servers that only implement
+// the v1 protocol return a StatusError with CodeUnimplemented.
+func GetServerMetadata(ctx context.Context, httpClient *http.Client, endpoint string) (ServerMetadata, error) {
+	data, err := Invoke(ctx, httpClient, endpoint, MethodServerMetadata, nil)
+	if err != nil {
+		return ServerMetadata{}, err
+	}
+
+	var md ServerMetadata
+
+	err = consumeFields(data, func(num protowire.Number, typ protowire.Type, value []byte) int {
+		if typ != protowire.BytesType {
+			return protowire.ConsumeFieldValue(num, typ, value)
+		}
+
+		s, n := protowire.ConsumeString(value)
+
+		switch num {
+		case 1:
+			md.Name = s
+		case 2:
+			md.Version = s
+		case 3:
+			md.Extensions = append(md.Extensions, s)
+		}
+
+		return n
+	})
+	if err != nil {
+		return ServerMetadata{}, fmt.Errorf("decoding server metadata: %w", err)
+	}
+
+	return md, nil
+}
+
+// GetModelMetadata calls the KServe v2 ModelMetadata method for a model.
+func GetModelMetadata(ctx context.Context, httpClient *http.Client, endpoint string, model string) (ModelMetadata, error) {
+	request := protowire.AppendTag(nil, 1, protowire.BytesType)
+	request = protowire.AppendString(request, model)
+
+	data, err := Invoke(ctx, httpClient, endpoint, MethodModelMetadata, request)
+	if err != nil {
+		return ModelMetadata{}, err
+	}
+
+	var md ModelMetadata
+
+	err = consumeFields(data, func(num protowire.Number, typ protowire.Type, value []byte) int {
+		if typ != protowire.BytesType || num > 3 {
+			return protowire.ConsumeFieldValue(num, typ, value)
+		}
+
+		s, n := protowire.ConsumeString(value)
+
+		switch num {
+		case 1:
+			md.Name = s
+		case 2:
+			md.Versions = append(md.Versions, s)
+		case 3:
+			md.Platform = s
+		}
+
+		return n
+	})
+	if err != nil {
+		return ModelMetadata{}, fmt.Errorf("decoding model metadata: %w", err)
+	}
+
+	return md, nil
+}
+
+// consumeFields calls fn for each field of an encoded protobuf message; fn returns the length
+// of the consumed field value, or a negative protowire error code.
+func consumeFields(data []byte, fn func(num protowire.Number, typ protowire.Type, value []byte) int) error {
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+
+		data = data[n:]
+
+		n = fn(num, typ, data)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+
+		data = data[n:]
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package grpcprobe_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/binary"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/opendatahub-io/odh-cli/pkg/util/grpcprobe"
+
+	. "github.com/onsi/gomega"
+)
+
+// reply is the response of the fake server to a method: an encoded message or a non-OK status.
+type reply struct {
+	message []byte
+	code    int
+}
+
+func newServer(t *testing.T, replies map[string]reply) (*httptest.Server, *http.Client) {
+	t.Helper()
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.ReadAll(r.Body)
+
+		w.Header().Set("Content-Type", "application/grpc")
+
+		rep, ok := replies[r.URL.Path]
+		if !ok {
+			rep = reply{code: grpcprobe.CodeUnimplemented}
+		}
+
+		if rep.code == grpcprobe.CodeOK {
+			frame := make([]byte, 5, 5+len(rep.message))
+			binary.BigEndian.PutUint32(frame[1:], uint32(len(rep.message)))
+			_, _ = w.Write(append(frame, rep.message...))
+		}
+
+		w.Header().Set(http.TrailerPrefix+"Grpc-Status", strconv.Itoa(rep.code))
+		w.Header().Set(http.TrailerPrefix+"Grpc-Message", "unknown%20method")
+	}))
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(server.Certificate())
+
+	return server, grpcprobe.NewHTTPClient(5*time.Second, &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12})
+}
+
+func stringField(b []byte, num protowire.Number, value string) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+
+	return protowire.AppendString(b, value)
+}
+
+func TestHealth(t *testing.T) {
+	g := NewWithT(t)
+
+	serving := protowire.AppendVarint(protowire.AppendTag(nil, 1, protowire.VarintType), 1)
+	server, httpClient := newServer(t, map[string]reply{grpcprobe.MethodHealthCheck: {message: serving}})
+
+	status, err := grpcprobe.Health(t.Context(), httpClient, server.URL)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(status).To(Equal(grpcprobe.HealthServing))
+}
+
+func TestGetServerMetadata(t *testing.T) {
+	g := NewWithT(t)
+
+	var md []byte
+	md = stringField(md, 1, "triton")
+	md = stringField(md, 2, "2.42.0")
+	md = stringField(md, 3, "model_repository")
+	md = stringField(md, 3, "statistics")
+
+	server, httpClient := newServer(t, map[string]reply{grpcprobe.MethodServerMetadata: {message: md}})
+
+	metadata, err := grpcprobe.GetServerMetadata(t.Context(), httpClient, server.URL+"/ignored/path")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(metadata).To(Equal(grpcprobe.ServerMetadata{
+		Name:       "triton",
+		Version:    "2.42.0",
+		Extensions: []string{"model_repository", "statistics"},
+	}))
+}
+
+func TestGetModelMetadata(t *testing.T) {
+	g := NewWithT(t)
+
+	var md []byte
+	md = stringField(md, 1, "my-model")
+	md = stringField(md, 2, "1")
+	md = stringField(md, 3, "onnxruntime_onnx")
+	// Inputs are skipped
+	md = protowire.AppendTag(md, 4, protowire.BytesType)
+	md = protowire.AppendBytes(md, stringField(nil, 1, "input-0"))
+
+	server, httpClient := newServer(t, map[string]reply{grpcprobe.MethodModelMetadata: {message: md}})
+
+	metadata, err := grpcprobe.GetModelMetadata(t.Context(), httpClient, server.URL, "my-model")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(metadata).To(Equal(grpcprobe.ModelMetadata{Name: "my-model", Versions: []string{"1"}, Platform: "onnxruntime_onnx"}))
+}
+
+func TestInvoke_Status(t *testing.T) {
+	g := NewWithT(t)
+
+	server, httpClient := newServer(t, nil)
+
+	_, err := grpcprobe.GetServerMetadata(t.Context(), httpClient, server.URL)
+	g.Expect(err).To(MatchError("grpc status 12: unknown method"))
+	g.Expect(grpcprobe.IsCode(err, grpcprobe.CodeUnimplemented)).To(BeTrue())
+	g.Expect(grpcprobe.IsCode(err, grpcprobe.CodeNotFound)).To(BeFalse())
+}
@@ -37,7 +37,7 @@ func DiscoverComponentsAndServices(_ context.Context, c client.Client) ([]Compon
 	// Filter for OpenShift AI related groups
 	for _, apiGroup := range apiGroupList.Groups {
 		// Check if this is an OpenShift AI or related group
-		if !isOpenShiftAIGroup(apiGroup.Name) {
+		if !IsOpenShiftAIGroup(apiGroup.Name) {
 			continue
 		}
 
@@ -70,8 +70,8 @@ func DiscoverComponentsAndServices(_ context.Context, c client.Client) ([]Compon
 	return discovered, nil
 }
 
-// isOpenShiftAIGroup determines if an API group belongs to OpenShift AI.
-func isOpenShiftAIGroup(group string) bool {
+// IsOpenShiftAIGroup determines if an API group belongs to OpenShift AI.
+func IsOpenShiftAIGroup(group string) bool {
 	// OpenShift AI groups
 	odhPrefixes := []string{
 		"opendatahub.io",
@@ -39,6 +39,7 @@ type InstallConfig struct {
 	Timeout             time.Duration
 	StartingCSV         string
 	InstallPlanApproval string
+	Annotations         map[string]string
 	DryRun              bool
 	Recorder            action.StepRecorder
 	IO                  iostreams.Interface
@@ -156,8 +157,9 @@ func createSubscription(
 ) error {
 	subscription := &operatorsv1alpha1.Subscription{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      config.Name,
-			Namespace: config.Namespace,
+			Name:        config.Name,
+			Namespace:   config.Namespace,
+			Annotations: config.Annotations,
 		},
 		Spec: &operatorsv1alpha1.SubscriptionSpec{
 			Channel:                config.Channel,
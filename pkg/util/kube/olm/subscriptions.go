@@ -11,9 +11,10 @@ import (
 
 // SubscriptionInfo contains the subscription fields relevant for matching.
 type SubscriptionInfo struct {
-	Name    string
-	Channel string
-	Version string
+	Name      string
+	Namespace string
+	Channel   string
+	Version   string
 }
 
 // Found returns true (always true for a non-nil receiver; nil-safe: returns false for nil).
@@ -55,9 +56,10 @@ func FindOperator(
 		}
 
 		info := &SubscriptionInfo{
-			Name:    sub.Name,
-			Channel: channel,
-			Version: sub.Status.InstalledCSV,
+			Name:      sub.Name,
+			Namespace: sub.Namespace,
+			Channel:   channel,
+			Version:   sub.Status.InstalledCSV,
 		}
 
 		if matcher(info) {
@@ -0,0 +1,167 @@
+// Package kueue provides helpers shared by the Kueue lint checks and the RHBOK migration action.
+package kueue
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/blang/semver/v4"
+
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+const (
+	// RHBOKSubscriptionName is the OLM subscription name of the Red Hat Build of Kueue operator.
+	RHBOKSubscriptionName = "kueue-operator"
+
+	// RHBOKOperatorNamespace is the namespace the RHBOK operator is installed into.
+	RHBOKOperatorNamespace = "openshift-kueue-operator"
+
+	channelPrefix = "stable-v"
+)
+
+// RHBOKRequirement describes the minimum RHBOK operator release supported by an RHOAI version.
+type RHBOKRequirement struct {
+	// RHOAIMajor and RHOAIMinor are the first RHOAI release this requirement applies to.
+	RHOAIMajor uint64
+	RHOAIMinor uint64
+
+	// MinVersion is the oldest RHBOK operator version supported by the RHOAI release.
+	MinVersion semver.Version
+}
+
+// rhbokSupportMatrix lists RHBOK requirements ordered from newest to oldest RHOAI release.
+// RHOAI 3.x dropped the embedded Kueue and relies on RHBOK 1.1+ APIs (stable-v1.1 channel).
+//
+//nolint:gochecknoglobals
+var rhbokSupportMatrix = []RHBOKRequirement{
+	{RHOAIMajor: 3, RHOAIMinor: 0, MinVersion: semver.MustParse("1.1.0")},
+	{RHOAIMajor: 2, RHOAIMinor: 25, MinVersion: semver.MustParse("1.0.0")},
+}
+
+// SetRHBOKSupportMatrix replaces the built-in support matrix, e.g. with data from an
+// installed rules bundle. Entries are sorted from newest to oldest RHOAI release.
+func SetRHBOKSupportMatrix(matrix []RHBOKRequirement) {
+	sorted := slices.Clone(matrix)
+	slices.SortFunc(sorted, func(a, b RHBOKRequirement) int {
+		if c := cmp.Compare(b.RHOAIMajor, a.RHOAIMajor); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(b.RHOAIMinor, a.RHOAIMinor)
+	})
+
+	rhbokSupportMatrix = sorted
+}
+
+// RHBOKSupportMatrix returns the effective support matrix, newest RHOAI release first.
+func RHBOKSupportMatrix() []RHBOKRequirement {
+	return slices.Clone(rhbokSupportMatrix)
+}
+
+// RHBOKCompatibility is the outcome of evaluating an installed RHBOK operator against a target RHOAI version.
+type RHBOKCompatibility struct {
+	// Compatible is false when the installed operator or its channel is older than required.
+	Compatible bool
+
+	// Requirement is the matched support matrix entry; nil when the target has no known requirement.
+	Requirement *RHBOKRequirement
+
+	// InstalledVersion is the parsed installed CSV version; nil when it cannot be determined.
+	InstalledVersion *semver.Version
+
+	// Message explains the outcome in user-facing terms.
+	Message string
+}
+
+// RHBOKRequirementFor returns the RHBOK requirement for the given RHOAI target version,
+// or nil if the support matrix has no entry covering it.
+func RHBOKRequirementFor(target *semver.Version) *RHBOKRequirement {
+	for i := range rhbokSupportMatrix {
+		req := &rhbokSupportMatrix[i]
+		if version.IsVersionAtLeast(target, req.RHOAIMajor, req.RHOAIMinor) {
+			return req
+		}
+	}
+
+	return nil
+}
+
+// EvaluateRHBOK validates an installed RHBOK operator (CSV name and subscription channel)
+// against the support matrix for the target RHOAI version.
+// Unknown CSV or channel formats are treated as compatible, since they cannot be judged reliably.
+func EvaluateRHBOK(target *semver.Version, installedCSV string, channel string) RHBOKCompatibility {
+	req := RHBOKRequirementFor(target)
+	if req == nil {
+		return RHBOKCompatibility{
+			Compatible: true,
+			Message:    "no RHBOK version requirement defined for target version",
+		}
+	}
+
+	compat := RHBOKCompatibility{
+		Compatible:  true,
+		Requirement: req,
+	}
+
+	if installed, err := ParseCSVVersion(installedCSV); err == nil {
+		compat.InstalledVersion = installed
+
+		if installed.LT(req.MinVersion) {
+			compat.Compatible = false
+			compat.Message = fmt.Sprintf(
+				"RHBOK operator %s is older than the minimum %s required by RHOAI %d.%d — upgrade RHBOK first",
+				installed, req.MinVersion, req.RHOAIMajor, req.RHOAIMinor)
+
+			return compat
+		}
+	}
+
+	if channelVersion, err := ParseChannelVersion(channel); err == nil {
+		if !version.IsVersionAtLeast(channelVersion, req.MinVersion.Major, req.MinVersion.Minor) {
+			compat.Compatible = false
+			compat.Message = fmt.Sprintf(
+				"RHBOK subscription channel %s does not provide the minimum %s required by RHOAI %d.%d — switch to %s%d.%d and upgrade RHBOK first",
+				channel, req.MinVersion, req.RHOAIMajor, req.RHOAIMinor,
+				channelPrefix, req.MinVersion.Major, req.MinVersion.Minor)
+
+			return compat
+		}
+	}
+
+	compat.Message = fmt.Sprintf("RHBOK operator %s (channel %s) satisfies the minimum %s required by RHOAI %d.%d",
+		installedCSV, channel, req.MinVersion, req.RHOAIMajor, req.RHOAIMinor)
+
+	return compat
+}
+
+// ParseCSVVersion extracts the semantic version from a CSV name such as "kueue-operator.v1.1.0".
+func ParseCSVVersion(csv string) (*semver.Version, error) {
+	idx := strings.LastIndex(csv, ".v")
+	if idx < 0 {
+		return nil, fmt.Errorf("CSV name %q does not contain a version", csv)
+	}
+
+	v, err := semver.ParseTolerant(csv[idx+len(".v"):])
+	if err != nil {
+		return nil, fmt.Errorf("parsing version from CSV name %q: %w", csv, err)
+	}
+
+	return &v, nil
+}
+
+// ParseChannelVersion extracts the version from a channel name such as "stable-v1.1".
+func ParseChannelVersion(channel string) (*semver.Version, error) {
+	if !strings.HasPrefix(channel, channelPrefix) {
+		return nil, fmt.Errorf("channel %q is not a versioned stable channel", channel)
+	}
+
+	v, err := semver.ParseTolerant(strings.TrimPrefix(channel, channelPrefix))
+	if err != nil {
+		return nil, fmt.Errorf("parsing version from channel %q: %w", channel, err)
+	}
+
+	return &v, nil
+}
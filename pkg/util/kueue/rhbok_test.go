@@ -0,0 +1,91 @@
+package kueue_test
+
+import (
+	"testing"
+
+	"github.com/blang/semver/v4"
+
+	"github.com/opendatahub-io/odh-cli/pkg/util/kueue"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestRHBOKRequirementFor(t *testing.T) {
+	g := NewWithT(t)
+
+	tests := []struct {
+		name        string
+		target      string
+		expectedMin string
+	}{
+		{name: "3.0 requires 1.1", target: "3.0.0", expectedMin: "1.1.0"},
+		{name: "later 3.x requires 1.1", target: "3.3.1", expectedMin: "1.1.0"},
+		{name: "2.25 requires 1.0", target: "2.25.0", expectedMin: "1.0.0"},
+		{name: "older release has no requirement", target: "2.17.0", expectedMin: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := semver.MustParse(tt.target)
+			req := kueue.RHBOKRequirementFor(&target)
+
+			if tt.expectedMin == "" {
+				g.Expect(req).To(BeNil())
+
+				return
+			}
+
+			g.Expect(req).ToNot(BeNil())
+			g.Expect(req.MinVersion.String()).To(Equal(tt.expectedMin))
+		})
+	}
+}
+
+func TestEvaluateRHBOK(t *testing.T) {
+	g := NewWithT(t)
+
+	target := semver.MustParse("3.0.0")
+
+	tests := []struct {
+		name         string
+		installedCSV string
+		channel      string
+		compatible   bool
+	}{
+		{name: "supported", installedCSV: "kueue-operator.v1.1.0", channel: "stable-v1.1", compatible: true},
+		{name: "newer", installedCSV: "kueue-operator.v1.2.3", channel: "stable-v1.2", compatible: true},
+		{name: "old CSV", installedCSV: "kueue-operator.v1.0.2", channel: "stable-v1.1", compatible: false},
+		{name: "old channel", installedCSV: "kueue-operator.v1.1.0", channel: "stable-v1.0", compatible: false},
+		{name: "unversioned channel", installedCSV: "kueue-operator.v1.1.0", channel: "stable", compatible: true},
+		{name: "unknown CSV", installedCSV: "", channel: "", compatible: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			compat := kueue.EvaluateRHBOK(&target, tt.installedCSV, tt.channel)
+
+			g.Expect(compat.Compatible).To(Equal(tt.compatible))
+			g.Expect(compat.Message).ToNot(BeEmpty())
+		})
+	}
+}
+
+func TestEvaluateRHBOK_NilTarget(t *testing.T) {
+	g := NewWithT(t)
+
+	compat := kueue.EvaluateRHBOK(nil, "kueue-operator.v0.1.0", "stable-v0.1")
+
+	g.Expect(compat.Compatible).To(BeTrue())
+	g.Expect(compat.Requirement).To(BeNil())
+}
+
+func TestParseCSVVersion(t *testing.T) {
+	g := NewWithT(t)
+
+	v, err := kueue.ParseCSVVersion("kueue-operator.v1.1.0")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(v.String()).To(Equal("1.1.0"))
+
+	_, err = kueue.ParseCSVVersion("kueue-operator")
+	g.Expect(err).To(HaveOccurred())
+}
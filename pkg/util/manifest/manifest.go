@@ -0,0 +1,268 @@
+package manifest
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+const (
+	// Stdin is the path that reads documents from standard input.
+	Stdin = "-"
+
+	// stdinSource is the source reported for documents read from standard input.
+	stdinSource = "<stdin>"
+
+	// decoderBufferSize is the read buffer size used when decoding multi-document YAML.
+	decoderBufferSize = 4096
+)
+
+// manifestExtensions are the file extensions read when loading a directory.
+//
+//nolint:gochecknoglobals // Read-only lookup table
+var manifestExtensions = map[string]bool{
+	".yaml": true,
+	".yml":  true,
+	".json": true,
+}
+
+// Document is a single Kubernetes object decoded from a manifest source.
+type Document struct {
+	// Source is the file the document was read from, or "<stdin>".
+	Source string
+
+	// Index is the 1-based position of the document among the non-empty documents of its
+	// source. Items of a List share the index of the List.
+	Index int
+
+	// Object is the decoded object.
+	Object *unstructured.Unstructured
+}
+
+// DocumentError reports a document that could not be decoded.
+type DocumentError struct {
+	Source string
+	Index  int
+	Err    error
+}
+
+func (e *DocumentError) Error() string {
+	return fmt.Sprintf("%s: document %d: %v", e.Source, e.Index, e.Err)
+}
+
+func (e *DocumentError) Unwrap() error {
+	return e.Err
+}
+
+// IsManifestFile reports whether path has a manifest file extension (.yaml, .yml or .json).
+func IsManifestFile(path string) bool {
+	return manifestExtensions[strings.ToLower(filepath.Ext(path))]
+}
+
+// Objects returns the objects of the documents, in order.
+func Objects(docs []Document) []*unstructured.Unstructured {
+	objects := make([]*unstructured.Unstructured, 0, len(docs))
+	for _, doc := range docs {
+		objects = append(objects, doc.Object)
+	}
+
+	return objects
+}
+
+// Load reads the documents of each path, in order. A path is a file, a directory (read recursively,
+// in lexical order, for .yaml, .yml and .json files), a glob pattern, or "-" for stdin.
+//
+// Decoding continues past documents without a kind or name, so all invalid documents are reported
+// at once; the returned error joins one DocumentError per invalid document.
+func Load(stdin io.Reader, paths ...string) ([]Document, error) {
+	var docs []Document
+
+	var errs []error
+
+	for _, path := range paths {
+		files, err := expand(path)
+		if err != nil {
+			errs = append(errs, err)
+
+			continue
+		}
+
+		for _, file := range files {
+			fileDocs, err := loadFile(stdin, file)
+			docs = append(docs, fileDocs...)
+
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	return docs, errors.Join(errs...)
+}
+
+// Decode reads all documents of a YAML or JSON stream. Empty documents are skipped and List
+// objects are expanded into their items.
+//
+// A syntax error stops decoding, since the rest of the stream cannot be located reliably.
+func Decode(r io.Reader, source string) ([]Document, error) {
+	decoder := utilyaml.NewYAMLOrJSONDecoder(r, decoderBufferSize)
+
+	var docs []Document
+
+	var errs []error
+
+	for index := 1; ; index++ {
+		obj := &unstructured.Unstructured{}
+
+		err := decoder.Decode(&obj.Object)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			errs = append(errs, &DocumentError{Source: source, Index: index, Err: err})
+
+			break
+		}
+
+		if len(obj.Object) == 0 {
+			continue
+		}
+
+		objects, err := itemsOf(obj)
+		if err != nil {
+			errs = append(errs, &DocumentError{Source: source, Index: index, Err: err})
+
+			continue
+		}
+
+		for _, item := range objects {
+			docs = append(docs, Document{Source: source, Index: index, Object: item})
+		}
+	}
+
+	return docs, errors.Join(errs...)
+}
+
+// itemsOf returns the items of a List object, or the object itself, validating that each has
+// a kind and a name.
+func itemsOf(obj *unstructured.Unstructured) ([]*unstructured.Unstructured, error) {
+	var objects []*unstructured.Unstructured
+
+	if obj.IsList() {
+		err := obj.EachListItem(func(item runtime.Object) error {
+			u, ok := item.(*unstructured.Unstructured)
+			if !ok {
+				return fmt.Errorf("unexpected list item type %T", item)
+			}
+
+			objects = append(objects, u)
+
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("reading %s items: %w", obj.GetKind(), err)
+		}
+	} else {
+		objects = append(objects, obj)
+	}
+
+	for i, item := range objects {
+		if item.GetKind() == "" || item.GetName() == "" {
+			if obj.IsList() {
+				return nil, fmt.Errorf("item %d has no kind or name", i+1)
+			}
+
+			return nil, errors.New("object has no kind or name")
+		}
+	}
+
+	return objects, nil
+}
+
+// expand resolves a path argument to the files it denotes.
+func expand(path string) ([]string, error) {
+	if path == Stdin {
+		return []string{Stdin}, nil
+	}
+
+	if !strings.ContainsAny(path, "*?[") {
+		return walk(path)
+	}
+
+	matches, err := filepath.Glob(path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern %s: %w", path, err)
+	}
+
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("no files match %s", path)
+	}
+
+	var files []string
+
+	for _, match := range matches {
+		matched, err := walk(match)
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, matched...)
+	}
+
+	return files, nil
+}
+
+// walk returns the path itself when it is a file, or the manifest files below it when it is a directory.
+func walk(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	var files []string
+
+	err = filepath.WalkDir(path, func(file string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !d.IsDir() && IsManifestFile(file) {
+			files = append(files, file)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("reading directory %s: %w", path, err)
+	}
+
+	return files, nil
+}
+
+// loadFile decodes a single file, or stdin for "-".
+func loadFile(stdin io.Reader, path string) ([]Document, error) {
+	if path == Stdin {
+		return Decode(stdin, stdinSource)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening %s: %w", path, err)
+	}
+	defer func() { _ = f.Close() }()
+
+	return Decode(f, path)
+}
@@ -0,0 +1,144 @@
+package manifest_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/util/manifest"
+
+	. "github.com/onsi/gomega"
+)
+
+const configMaps = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: first
+---
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: second
+`
+
+func names(docs []manifest.Document) []string {
+	result := make([]string, 0, len(docs))
+	for _, doc := range docs {
+		result = append(result, doc.Object.GetName())
+	}
+
+	return result
+}
+
+func writeFile(t *testing.T, path string, content string) {
+	t.Helper()
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDecode_MultiDocument(t *testing.T) {
+	g := NewWithT(t)
+
+	docs, err := manifest.Decode(strings.NewReader(configMaps), "test.yaml")
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(names(docs)).To(Equal([]string{"first", "second"}))
+	g.Expect(docs[0].Index).To(Equal(1))
+	g.Expect(docs[1].Index).To(Equal(2))
+	g.Expect(docs[1].Source).To(Equal("test.yaml"))
+}
+
+func TestDecode_ExpandsLists(t *testing.T) {
+	g := NewWithT(t)
+
+	list := `{"apiVersion": "v1", "kind": "List", "items": [
+  {"apiVersion": "v1", "kind": "Secret", "metadata": {"name": "a"}},
+  {"apiVersion": "v1", "kind": "Secret", "metadata": {"name": "b"}}
+]}`
+
+	docs, err := manifest.Decode(strings.NewReader(list), "list.json")
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(names(docs)).To(Equal([]string{"a", "b"}))
+}
+
+func TestDecode_ReportsEveryInvalidDocument(t *testing.T) {
+	g := NewWithT(t)
+
+	input := "kind: ConfigMap\n---\n" + configMaps + "---\napiVersion: v1\nmetadata:\n  name: nokind\n"
+
+	docs, err := manifest.Decode(strings.NewReader(input), "bad.yaml")
+
+	g.Expect(names(docs)).To(Equal([]string{"first", "second"}))
+	g.Expect(err).To(MatchError(ContainSubstring("bad.yaml: document 1: object has no kind or name")))
+	g.Expect(err).To(MatchError(ContainSubstring("bad.yaml: document 4: object has no kind or name")))
+
+	var docErr *manifest.DocumentError
+	g.Expect(errors.As(err, &docErr)).To(BeTrue())
+	g.Expect(docErr.Index).To(Equal(1))
+}
+
+func TestDecode_SyntaxError(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := manifest.Decode(strings.NewReader(configMaps+"---\nkind: [unterminated\n"), "broken.yaml")
+
+	g.Expect(err).To(MatchError(ContainSubstring("broken.yaml: document 3:")))
+}
+
+func TestLoad_DirectoriesGlobsAndStdin(t *testing.T) {
+	g := NewWithT(t)
+
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "b", "second.yml"), "kind: Secret\nmetadata:\n  name: from-yml\n")
+	writeFile(t, filepath.Join(dir, "a.yaml"), "kind: Secret\nmetadata:\n  name: from-yaml\n")
+	writeFile(t, filepath.Join(dir, "notes.txt"), "not a manifest")
+	writeFile(t, filepath.Join(dir, "glob", "x.json"), `{"kind": "Secret", "metadata": {"name": "from-glob"}}`)
+
+	stdin := strings.NewReader("kind: Secret\nmetadata:\n  name: from-stdin\n")
+
+	docs, err := manifest.Load(stdin, filepath.Join(dir, "a.yaml"), filepath.Join(dir, "b"), filepath.Join(dir, "glob", "*.json"), manifest.Stdin)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(names(docs)).To(Equal([]string{"from-yaml", "from-yml", "from-glob", "from-stdin"}))
+	g.Expect(docs[3].Source).To(Equal("<stdin>"))
+}
+
+func TestLoad_DirectoryIsRecursiveAndSkipsOtherFiles(t *testing.T) {
+	g := NewWithT(t)
+
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "z.yaml"), "kind: Secret\nmetadata:\n  name: z\n")
+	writeFile(t, filepath.Join(dir, "nested", "a.yaml"), "kind: Secret\nmetadata:\n  name: nested\n")
+	writeFile(t, filepath.Join(dir, "README.md"), "# docs")
+
+	docs, err := manifest.Load(nil, dir)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(names(docs)).To(Equal([]string{"nested", "z"}))
+	g.Expect(manifest.Objects(docs)).To(HaveLen(2))
+}
+
+func TestLoad_ReportsErrorsAcrossPaths(t *testing.T) {
+	g := NewWithT(t)
+
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "good.yaml"), "kind: Secret\nmetadata:\n  name: good\n")
+	writeFile(t, filepath.Join(dir, "bad.yaml"), "kind: Secret\n")
+
+	docs, err := manifest.Load(nil, filepath.Join(dir, "*.yaml"), filepath.Join(dir, "missing.yaml"), filepath.Join(dir, "*.json"))
+
+	g.Expect(names(docs)).To(Equal([]string{"good"}))
+	g.Expect(err).To(MatchError(ContainSubstring("bad.yaml: document 1: object has no kind or name")))
+	g.Expect(err).To(MatchError(ContainSubstring("missing.yaml")))
+	g.Expect(err).To(MatchError(ContainSubstring("no files match")))
+}
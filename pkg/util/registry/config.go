@@ -0,0 +1,155 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// maxDocumentSize bounds the manifests and image configs read from a registry.
+const maxDocumentSize = 4 << 20
+
+// ImageConfig is the subset of an OCI image configuration describing how the image runs.
+type ImageConfig struct {
+	Labels map[string]string
+	Env    []string
+}
+
+// EnvValue returns the value of the environment variable name set by the image, if any.
+func (c *ImageConfig) EnvValue(name string) (string, bool) {
+	for _, env := range c.Env {
+		if value, found := strings.CutPrefix(env, name+"="); found {
+			return value, true
+		}
+	}
+
+	return "", false
+}
+
+// manifest is the subset of an image manifest or index used to find the image config.
+type manifest struct {
+	MediaType string `json:"mediaType"`
+	Config    struct {
+		Digest string `json:"digest"`
+	} `json:"config"`
+	Manifests []struct {
+		Digest   string `json:"digest"`
+		Platform struct {
+			OS           string `json:"os"`
+			Architecture string `json:"architecture"`
+		} `json:"platform"`
+	} `json:"manifests"`
+}
+
+// InspectConfig returns the configuration (labels and environment) of the image ref refers
+// to. For multi-arch images, the linux/amd64 image is inspected, or the first one listed.
+func (c *Client) InspectConfig(ctx context.Context, ref Reference) (*ImageConfig, error) {
+	reference := ref.Digest
+	if reference == "" {
+		reference = ref.Tag
+	}
+
+	var authorization string
+
+	m, err := c.fetchManifest(ctx, ref, reference, &authorization)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(m.Manifests) > 0 {
+		digest := m.Manifests[0].Digest
+
+		for _, entry := range m.Manifests {
+			if entry.Platform.OS == "linux" && entry.Platform.Architecture == "amd64" {
+				digest = entry.Digest
+
+				break
+			}
+		}
+
+		if m, err = c.fetchManifest(ctx, ref, digest, &authorization); err != nil {
+			return nil, err
+		}
+	}
+
+	if m.Config.Digest == "" {
+		return nil, fmt.Errorf("%s: manifest has no image config", ref)
+	}
+
+	blobURL := fmt.Sprintf("https://%s/v2/%s/blobs/%s", ref.apiHost(), ref.Repository, m.Config.Digest)
+
+	var config struct {
+		Config ImageConfig `json:"config"`
+	}
+
+	if err := c.getDocument(ctx, ref, blobURL, &authorization, &config); err != nil {
+		return nil, err
+	}
+
+	return &config.Config, nil
+}
+
+// fetchManifest fetches the manifest or index of ref identified by reference, a tag or digest.
+func (c *Client) fetchManifest(
+	ctx context.Context,
+	ref Reference,
+	reference string,
+	authorization *string,
+) (*manifest, error) {
+	manifestURL := fmt.Sprintf("https://%s/v2/%s/manifests/%s", ref.apiHost(), ref.Repository, reference)
+
+	var m manifest
+	if err := c.getDocument(ctx, ref, manifestURL, authorization, &m); err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+// getDocument fetches a JSON document of the registry of ref into v, answering an
+// authentication challenge once. The authorization obtained is kept for later requests.
+func (c *Client) getDocument(
+	ctx context.Context,
+	ref Reference,
+	documentURL string,
+	authorization *string,
+	v any,
+) error {
+	resp, err := c.manifestRequest(ctx, http.MethodGet, documentURL, *authorization)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		_ = resp.Body.Close()
+
+		*authorization, err = c.authorize(ctx, ref, resp.Header.Get("WWW-Authenticate"))
+		if err != nil {
+			return fmt.Errorf("authenticating to %s: %w", ref.Registry, err)
+		}
+
+		resp, err = c.manifestRequest(ctx, http.MethodGet, documentURL, *authorization)
+		if err != nil {
+			return err
+		}
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return fmt.Errorf("%s: %w", ref, ErrManifestNotFound)
+	default:
+		return fmt.Errorf("fetching %s: registry returned %s", documentURL, resp.Status)
+	}
+
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxDocumentSize)).Decode(v); err != nil {
+		return fmt.Errorf("decoding %s: %w", documentURL, err)
+	}
+
+	return nil
+}
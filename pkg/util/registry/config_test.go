@@ -0,0 +1,76 @@
+package registry_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/util/registry"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	testIndex = `{"mediaType":"application/vnd.oci.image.index.v1+json","manifests":[
+		{"digest":"sha256:arm","platform":{"os":"linux","architecture":"arm64"}},
+		{"digest":"sha256:amd","platform":{"os":"linux","architecture":"amd64"}}]}`
+	testImageManifest = `{"mediaType":"application/vnd.oci.image.manifest.v1+json","config":{"digest":"sha256:cfg"}}`
+	testImageConfig   = `{"architecture":"amd64","config":{
+		"Labels":{"opendatahub.io/notebook-software":"[{\"name\":\"code-server\"}]"},
+		"Env":["PATH=/usr/bin","OPENSHIFT_BUILD_REFERENCE=rhoai-2.25"]}}`
+)
+
+// newConfigRegistry serves the multi-arch image myorg/image:latest behind a Bearer token
+// challenge.
+func newConfigRegistry(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	var server *httptest.Server
+
+	server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/token":
+			_, _ = fmt.Fprintf(w, `{"token":%q}`, testToken)
+		case r.Header.Get("Authorization") != "Bearer "+testToken:
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token"`, server.URL))
+			w.WriteHeader(http.StatusUnauthorized)
+		case r.URL.Path == "/v2/myorg/image/manifests/latest":
+			_, _ = w.Write([]byte(testIndex))
+		case r.URL.Path == "/v2/myorg/image/manifests/sha256:amd":
+			_, _ = w.Write([]byte(testImageManifest))
+		case r.URL.Path == "/v2/myorg/image/blobs/sha256:cfg":
+			_, _ = w.Write([]byte(testImageConfig))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestClient_InspectConfig(t *testing.T) {
+	server := newConfigRegistry(t)
+	client := registry.NewClient(registry.WithHTTPClient(server.Client()))
+
+	t.Run("should return the config of the linux/amd64 image", func(t *testing.T) {
+		g := NewWithT(t)
+
+		config, err := client.InspectConfig(t.Context(), parseTestReference(t, server, "myorg/image:latest"))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(config.Labels).To(HaveKey("opendatahub.io/notebook-software"))
+
+		buildRef, found := config.EnvValue("OPENSHIFT_BUILD_REFERENCE")
+		g.Expect(found).To(BeTrue())
+		g.Expect(buildRef).To(Equal("rhoai-2.25"))
+	})
+
+	t.Run("should report a missing image", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := client.InspectConfig(t.Context(), parseTestReference(t, server, "myorg/missing:latest"))
+		g.Expect(err).To(MatchError(registry.ErrManifestNotFound))
+	})
+}
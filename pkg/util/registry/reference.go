@@ -0,0 +1,132 @@
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// DockerHub is the registry of image references without a registry host.
+	DockerHub = "docker.io"
+
+	// dockerHubAPIHost serves the registry API of Docker Hub.
+	dockerHubAPIHost = "registry-1.docker.io"
+
+	// defaultTag is the tag of image references without tag or digest.
+	defaultTag = "latest"
+)
+
+// Reference is a parsed container image reference.
+type Reference struct {
+	// Registry is the registry host (and port), DockerHub when the reference has none.
+	Registry string
+
+	// Repository is the repository path within the registry, e.g. "myorg/image".
+	Repository string
+
+	// Tag is the tag, "latest" when the reference has neither tag nor digest.
+	Tag string
+
+	// Digest is the manifest digest, e.g. "sha256:abc...", if the reference is pinned.
+	Digest string
+}
+
+// ParseReference parses an image reference such as "quay.io/myorg/image:tag",
+// "registry:5000/image@sha256:..." or "ubuntu".
+func ParseReference(image string) (Reference, error) {
+	if image == "" {
+		return Reference{}, errors.New("empty image reference")
+	}
+
+	var ref Reference
+
+	name := image
+
+	if idx := strings.LastIndex(name, "@"); idx != -1 {
+		ref.Digest = name[idx+1:]
+		name = name[:idx]
+
+		if !strings.Contains(ref.Digest, ":") {
+			return Reference{}, fmt.Errorf("invalid digest in image reference %q", image)
+		}
+	}
+
+	// A colon after the last slash separates the tag; before it, it is a registry port.
+	if idx := strings.LastIndex(name, ":"); idx != -1 && !strings.Contains(name[idx+1:], "/") {
+		ref.Tag = name[idx+1:]
+		name = name[:idx]
+	}
+
+	ref.Registry = DockerHub
+	ref.Repository = name
+
+	if host, path, found := strings.Cut(name, "/"); found && isRegistryHost(host) {
+		ref.Registry = host
+		ref.Repository = path
+	}
+
+	if ref.Repository == "" || (ref.Tag == "" && strings.HasSuffix(image, ":")) {
+		return Reference{}, fmt.Errorf("invalid image reference %q", image)
+	}
+
+	if ref.Registry == DockerHub && !strings.Contains(ref.Repository, "/") {
+		ref.Repository = "library/" + ref.Repository
+	}
+
+	if ref.Tag == "" && ref.Digest == "" {
+		ref.Tag = defaultTag
+	}
+
+	return ref, nil
+}
+
+// isRegistryHost returns whether the first path component of a reference is a registry host
+// rather than a Docker Hub namespace.
+func isRegistryHost(component string) bool {
+	return component == "localhost" || strings.ContainsAny(component, ".:")
+}
+
+// IsPinned returns whether the reference identifies an image by digest.
+func (r Reference) IsPinned() bool {
+	return r.Digest != ""
+}
+
+// Name returns the registry and repository, e.g. "quay.io/myorg/image".
+func (r Reference) Name() string {
+	return r.Registry + "/" + r.Repository
+}
+
+// String returns the normalized reference.
+func (r Reference) String() string {
+	s := r.Name()
+
+	if r.Tag != "" {
+		s += ":" + r.Tag
+	}
+
+	if r.Digest != "" {
+		s += "@" + r.Digest
+	}
+
+	return s
+}
+
+// apiHost returns the host serving the registry API.
+func (r Reference) apiHost() string {
+	if r.Registry == DockerHub {
+		return dockerHubAPIHost
+	}
+
+	return r.Registry
+}
+
+// PinImage returns image pinned to digest. The tag is kept for readability; the container
+// runtime pulls by digest. The registry and repository are kept as written in image.
+func PinImage(image string, digest string) string {
+	if idx := strings.LastIndex(image, "@"); idx != -1 {
+		image = image[:idx]
+	}
+
+	return image + "@" + digest
+}
@@ -0,0 +1,77 @@
+package registry_test
+
+import (
+	"testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/util/registry"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestParseReference(t *testing.T) {
+	tests := []struct {
+		image    string
+		expected registry.Reference
+		pinned   bool
+	}{
+		{
+			image:    "quay.io/myorg/image:v1.0",
+			expected: registry.Reference{Registry: "quay.io", Repository: "myorg/image", Tag: "v1.0"},
+		},
+		{
+			image:    "ubuntu",
+			expected: registry.Reference{Registry: registry.DockerHub, Repository: "library/ubuntu", Tag: "latest"},
+		},
+		{
+			image:    "myorg/image:latest",
+			expected: registry.Reference{Registry: registry.DockerHub, Repository: "myorg/image", Tag: "latest"},
+		},
+		{
+			image:    "registry.local:5000/team/image",
+			expected: registry.Reference{Registry: "registry.local:5000", Repository: "team/image", Tag: "latest"},
+		},
+		{
+			image:    "quay.io/myorg/image@sha256:abc",
+			expected: registry.Reference{Registry: "quay.io", Repository: "myorg/image", Digest: "sha256:abc"},
+			pinned:   true,
+		},
+		{
+			image:    "quay.io/myorg/image:v1.0@sha256:abc",
+			expected: registry.Reference{Registry: "quay.io", Repository: "myorg/image", Tag: "v1.0", Digest: "sha256:abc"},
+			pinned:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.image, func(t *testing.T) {
+			g := NewWithT(t)
+
+			ref, err := registry.ParseReference(tt.image)
+
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(ref).To(Equal(tt.expected))
+			g.Expect(ref.IsPinned()).To(Equal(tt.pinned))
+		})
+	}
+}
+
+func TestParseReference_Invalid(t *testing.T) {
+	for _, image := range []string{"", "quay.io/myorg/image:", "quay.io/myorg/image@abc"} {
+		t.Run(image, func(t *testing.T) {
+			g := NewWithT(t)
+
+			_, err := registry.ParseReference(image)
+
+			g.Expect(err).To(HaveOccurred())
+		})
+	}
+}
+
+func TestPinImage(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(registry.PinImage("quay.io/myorg/image:latest", "sha256:abc")).
+		To(Equal("quay.io/myorg/image:latest@sha256:abc"))
+	g.Expect(registry.PinImage("quay.io/myorg/image:v1@sha256:old", "sha256:new")).
+		To(Equal("quay.io/myorg/image:v1@sha256:new"))
+}
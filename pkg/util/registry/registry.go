@@ -0,0 +1,344 @@
+package registry
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/opendatahub-io/odh-cli/pkg/util"
+)
+
+// DefaultRequestTimeout bounds a single registry request.
+const DefaultRequestTimeout = 30 * time.Second
+
+// ErrManifestNotFound is returned when the registry has no manifest for a reference.
+var ErrManifestNotFound = errors.New("manifest not found")
+
+// manifestMediaTypes are the manifest types accepted when resolving a digest. Index and
+// manifest list types come first, so multi-arch images resolve to the digest of the index
+// rather than of a single platform.
+//
+//nolint:gochecknoglobals // Read-only lookup table
+var manifestMediaTypes = []string{
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+}
+
+// challengeParamRegex matches the key="value" parameters of a WWW-Authenticate challenge.
+var challengeParamRegex = regexp.MustCompile(`(\w+)="([^"]*)"`)
+
+// Credential authenticates to a registry.
+type Credential struct {
+	Username string
+	Password string
+}
+
+// Client resolves image references against container registries through the OCI
+// distribution API. Anonymous access is used unless credentials for the registry are set.
+type Client struct {
+	httpClient  *http.Client
+	credentials map[string]Credential
+}
+
+// Option configures a Client.
+type Option = util.Option[Client]
+
+// WithHTTPClient sets the HTTP client used for registry requests.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return util.FunctionalOption[Client](func(c *Client) {
+		c.httpClient = httpClient
+	})
+}
+
+// WithCredentials sets the credentials used per registry host.
+func WithCredentials(credentials map[string]Credential) Option {
+	return util.FunctionalOption[Client](func(c *Client) {
+		c.credentials = credentials
+	})
+}
+
+// NewClient creates a registry client.
+func NewClient(opts ...Option) *Client {
+	c := &Client{
+		httpClient:  &http.Client{Timeout: DefaultRequestTimeout},
+		credentials: map[string]Credential{},
+	}
+
+	util.ApplyOptions(c, opts...)
+
+	return c
+}
+
+// dockerConfig is the subset of a Docker config.json (or .dockerconfigjson pull secret) used
+// for registry credentials.
+type dockerConfig struct {
+	Auths map[string]struct {
+		Auth     string `json:"auth"`
+		Username string `json:"username"`
+		Password string `json:"password"`
+	} `json:"auths"`
+}
+
+// LoadDockerConfig reads registry credentials from a Docker config.json or a
+// .dockerconfigjson pull secret payload, keyed by registry host.
+func LoadDockerConfig(path string) (map[string]Credential, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+
+	credentials, err := ParseDockerConfig(data)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+
+	return credentials, nil
+}
+
+// ParseDockerConfig parses registry credentials from the content of a Docker config.json or
+// a .dockerconfigjson pull secret, keyed by registry host.
+func ParseDockerConfig(data []byte) (map[string]Credential, error) {
+	var cfg dockerConfig
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("decoding docker config: %w", err)
+	}
+
+	credentials := make(map[string]Credential, len(cfg.Auths))
+
+	for host, entry := range cfg.Auths {
+		cred := Credential{Username: entry.Username, Password: entry.Password}
+
+		if entry.Auth != "" {
+			decoded, err := base64.StdEncoding.DecodeString(entry.Auth)
+			if err != nil {
+				return nil, fmt.Errorf("decoding auth for %s: %w", host, err)
+			}
+
+			cred.Username, cred.Password, _ = strings.Cut(string(decoded), ":")
+		}
+
+		credentials[normalizeHost(host)] = cred
+	}
+
+	return credentials, nil
+}
+
+// normalizeHost strips the scheme and path that config.json keys may carry, e.g.
+// "https://index.docker.io/v1/".
+func normalizeHost(host string) string {
+	host = strings.TrimPrefix(host, "https://")
+	host = strings.TrimPrefix(host, "http://")
+	host, _, _ = strings.Cut(host, "/")
+
+	if host == "index.docker.io" {
+		return DockerHub
+	}
+
+	return host
+}
+
+// ResolveDigest returns the digest the registry currently serves for the tag of ref.
+func (c *Client) ResolveDigest(ctx context.Context, ref Reference) (string, error) {
+	if ref.Tag == "" {
+		return ref.Digest, nil
+	}
+
+	manifestURL := fmt.Sprintf("https://%s/v2/%s/manifests/%s", ref.apiHost(), ref.Repository, ref.Tag)
+
+	var authorization string
+
+	resp, err := c.manifestRequest(ctx, http.MethodHead, manifestURL, authorization)
+	if err != nil {
+		return "", err
+	}
+
+	_ = resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		authorization, err = c.authorize(ctx, ref, resp.Header.Get("WWW-Authenticate"))
+		if err != nil {
+			return "", fmt.Errorf("authenticating to %s: %w", ref.Registry, err)
+		}
+
+		resp, err = c.manifestRequest(ctx, http.MethodHead, manifestURL, authorization)
+		if err != nil {
+			return "", err
+		}
+
+		_ = resp.Body.Close()
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		if digest := resp.Header.Get("Docker-Content-Digest"); digest != "" {
+			return digest, nil
+		}
+
+		return c.digestFromBody(ctx, manifestURL, authorization)
+	case http.StatusNotFound:
+		return "", fmt.Errorf("%s: %w", ref, ErrManifestNotFound)
+	default:
+		return "", fmt.Errorf("resolving %s: registry returned %s", ref, resp.Status)
+	}
+}
+
+// digestFromBody computes the digest of the manifest for registries that do not return
+// the Docker-Content-Digest header.
+func (c *Client) digestFromBody(ctx context.Context, manifestURL string, authorization string) (string, error) {
+	resp, err := c.manifestRequest(ctx, http.MethodGet, manifestURL, authorization)
+	if err != nil {
+		return "", err
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("fetching manifest %s: registry returned %s", manifestURL, resp.Status)
+	}
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, resp.Body); err != nil {
+		return "", fmt.Errorf("reading manifest %s: %w", manifestURL, err)
+	}
+
+	return fmt.Sprintf("sha256:%x", hash.Sum(nil)), nil
+}
+
+func (c *Client) manifestRequest(
+	ctx context.Context,
+	method string,
+	manifestURL string,
+	authorization string,
+) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, manifestURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+
+	req.Header.Set("Accept", strings.Join(manifestMediaTypes, ", "))
+
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("requesting %s: %w", manifestURL, err)
+	}
+
+	return resp, nil
+}
+
+// authorize answers a WWW-Authenticate challenge. Basic challenges use the registry credential;
+// Bearer challenges exchange it (or anonymous access) for a pull token at the token realm.
+func (c *Client) authorize(ctx context.Context, ref Reference, challenge string) (string, error) {
+	cred, hasCred := c.credentials[ref.Registry]
+
+	scheme, params, _ := strings.Cut(challenge, " ")
+
+	switch strings.ToLower(scheme) {
+	case "basic":
+		if !hasCred {
+			return "", errors.New("registry requires credentials")
+		}
+
+		return "Basic " + basicAuth(cred), nil
+	case "bearer":
+		return c.fetchToken(ctx, ref, parseChallenge(params), cred, hasCred)
+	default:
+		return "", fmt.Errorf("unsupported authentication challenge %q", challenge)
+	}
+}
+
+func (c *Client) fetchToken(
+	ctx context.Context,
+	ref Reference,
+	params map[string]string,
+	cred Credential,
+	hasCred bool,
+) (string, error) {
+	realm := params["realm"]
+	if realm == "" {
+		return "", errors.New("bearer challenge has no realm")
+	}
+
+	tokenURL, err := url.Parse(realm)
+	if err != nil {
+		return "", fmt.Errorf("parsing token realm %q: %w", realm, err)
+	}
+
+	query := tokenURL.Query()
+
+	if service := params["service"]; service != "" {
+		query.Set("service", service)
+	}
+
+	query.Set("scope", fmt.Sprintf("repository:%s:pull", ref.Repository))
+	tokenURL.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, tokenURL.String(), nil)
+	if err != nil {
+		return "", fmt.Errorf("creating token request: %w", err)
+	}
+
+	if hasCred {
+		req.SetBasicAuth(cred.Username, cred.Password)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("requesting token: %w", err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("token endpoint returned %s", resp.Status)
+	}
+
+	var token struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", fmt.Errorf("decoding token response: %w", err)
+	}
+
+	if token.Token == "" {
+		token.Token = token.AccessToken
+	}
+
+	if token.Token == "" {
+		return "", errors.New("token endpoint returned no token")
+	}
+
+	return "Bearer " + token.Token, nil
+}
+
+// parseChallenge returns the parameters of a WWW-Authenticate challenge.
+func parseChallenge(params string) map[string]string {
+	result := make(map[string]string)
+
+	for _, match := range challengeParamRegex.FindAllStringSubmatch(params, -1) {
+		result[strings.ToLower(match[1])] = match[2]
+	}
+
+	return result
+}
+
+func basicAuth(cred Credential) string {
+	return base64.StdEncoding.EncodeToString([]byte(cred.Username + ":" + cred.Password))
+}
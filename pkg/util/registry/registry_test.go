@@ -0,0 +1,123 @@
+package registry_test
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/util/registry"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	testDigest   = "sha256:0123456789abcdef"
+	testToken    = "pull-token"
+	testManifest = `{"schemaVersion":2}`
+)
+
+// newTestRegistry serves myorg/image:latest behind a Bearer token challenge. Without
+// sendDigest the Docker-Content-Digest header is omitted, as some registries do.
+func newTestRegistry(t *testing.T, sendDigest bool) (*httptest.Server, *[]string) {
+	t.Helper()
+
+	var scopes []string
+
+	var server *httptest.Server
+
+	server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/token":
+			scopes = append(scopes, r.URL.Query().Get("scope"))
+			_, _ = fmt.Fprintf(w, `{"token":%q}`, testToken)
+		case r.Header.Get("Authorization") != "Bearer "+testToken:
+			w.Header().Set("WWW-Authenticate",
+				fmt.Sprintf(`Bearer realm="%s/token",service="test-registry"`, server.URL))
+			w.WriteHeader(http.StatusUnauthorized)
+		case r.URL.Path == "/v2/myorg/image/manifests/latest":
+			if sendDigest {
+				w.Header().Set("Docker-Content-Digest", testDigest)
+			}
+
+			if r.Method == http.MethodGet {
+				_, _ = w.Write([]byte(testManifest))
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	t.Cleanup(server.Close)
+
+	return server, &scopes
+}
+
+func parseTestReference(t *testing.T, server *httptest.Server, image string) registry.Reference {
+	t.Helper()
+
+	ref, err := registry.ParseReference(strings.TrimPrefix(server.URL, "https://") + "/" + image)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return ref
+}
+
+func TestClient_ResolveDigest(t *testing.T) {
+	g := NewWithT(t)
+
+	server, scopes := newTestRegistry(t, true)
+	client := registry.NewClient(registry.WithHTTPClient(server.Client()))
+
+	digest, err := client.ResolveDigest(t.Context(), parseTestReference(t, server, "myorg/image:latest"))
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(digest).To(Equal(testDigest))
+	g.Expect(*scopes).To(Equal([]string{"repository:myorg/image:pull"}))
+}
+
+func TestClient_ResolveDigest_FromManifestBody(t *testing.T) {
+	g := NewWithT(t)
+
+	server, _ := newTestRegistry(t, false)
+	client := registry.NewClient(registry.WithHTTPClient(server.Client()))
+
+	digest, err := client.ResolveDigest(t.Context(), parseTestReference(t, server, "myorg/image:latest"))
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(digest).To(Equal(fmt.Sprintf("sha256:%x", sha256.Sum256([]byte(testManifest)))))
+}
+
+func TestClient_ResolveDigest_NotFound(t *testing.T) {
+	g := NewWithT(t)
+
+	server, _ := newTestRegistry(t, true)
+	client := registry.NewClient(registry.WithHTTPClient(server.Client()))
+
+	_, err := client.ResolveDigest(t.Context(), parseTestReference(t, server, "myorg/image:missing"))
+
+	g.Expect(err).To(MatchError(registry.ErrManifestNotFound))
+}
+
+func TestLoadDockerConfig(t *testing.T) {
+	g := NewWithT(t)
+
+	auth := base64.StdEncoding.EncodeToString([]byte("robot:secret"))
+	path := filepath.Join(t.TempDir(), "config.json")
+	content := fmt.Sprintf(`{"auths":{"quay.io":{"auth":%q},"https://index.docker.io/v1/":{"username":"u","password":"p"}}}`, auth)
+	g.Expect(os.WriteFile(path, []byte(content), 0o600)).To(Succeed())
+
+	credentials, err := registry.LoadDockerConfig(path)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(credentials).To(Equal(map[string]registry.Credential{
+		"quay.io":          {Username: "robot", Password: "secret"},
+		registry.DockerHub: {Username: "u", Password: "p"},
+	}))
+}
@@ -59,6 +59,16 @@ func (m *MockCheck) CheckType() string {
 	return args.String(0)
 }
 
+func (m *MockCheck) Documentation() check.Documentation {
+	args := m.Called()
+	documentation, ok := args.Get(0).(check.Documentation)
+	if !ok {
+		return check.Documentation{}
+	}
+
+	return documentation
+}
+
 func (m *MockCheck) CanApply(ctx context.Context, target check.Target) (bool, error) {
 	args := m.Called(ctx, target)
 
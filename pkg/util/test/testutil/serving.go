@@ -0,0 +1,55 @@
+// Package testutil provides fixtures shared by the tests of several packages.
+package testutil
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+)
+
+// ServingRuntimeObject returns the content of a ServingRuntime running image in its
+// kserve-container, as embedded in the objects of a Template.
+func ServingRuntimeObject(name string, image string) map[string]any {
+	return map[string]any{
+		"apiVersion": resources.ServingRuntime.APIVersion(),
+		"kind":       resources.ServingRuntime.Kind,
+		"metadata": map[string]any{
+			"name": name,
+		},
+		"spec": map[string]any{
+			"containers": []any{
+				map[string]any{"name": "kserve-container", "image": image},
+			},
+		},
+	}
+}
+
+// NewServingRuntime creates an unstructured ServingRuntime running image in namespace.
+func NewServingRuntime(namespace string, name string, image string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: ServingRuntimeObject(name, image)}
+	obj.SetNamespace(namespace)
+
+	return obj
+}
+
+// NewServingRuntimeTemplate creates an unstructured dashboard Template in namespace holding
+// the ServingRuntime runtimeName running image.
+func NewServingRuntimeTemplate(
+	namespace string,
+	name string,
+	runtimeName string,
+	image string,
+) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.Template.APIVersion(),
+			"kind":       resources.Template.Kind,
+			"metadata": map[string]any{
+				"name":      name,
+				"namespace": namespace,
+				"labels":    map[string]any{"opendatahub.io/dashboard": "true"},
+			},
+			"objects": []any{ServingRuntimeObject(runtimeName, image)},
+		},
+	}
+}
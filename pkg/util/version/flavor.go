@@ -0,0 +1,73 @@
+package version
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+)
+
+// Flavor is the management flavor of an OpenShift AI installation.
+type Flavor string
+
+const (
+	// FlavorSelfManaged is an installation managed by the cluster administrator through OLM.
+	FlavorSelfManaged Flavor = "self-managed"
+
+	// FlavorManaged is the managed cloud service (RHOAI on ROSA/OSD), installed and
+	// reconciled by the OpenShift AI add-on.
+	FlavorManaged Flavor = "managed"
+
+	// ManagedReleaseName is the .status.release.name reported by the managed cloud service.
+	ManagedReleaseName = "OpenShift AI Cloud Service"
+
+	// AddonNamespace is the namespace the OpenShift AI add-on installs the operator into.
+	AddonNamespace = "redhat-ods-operator"
+
+	// AddonSubscriptionName is the name of the operator Subscription created by the add-on.
+	AddonSubscriptionName = "addon-managed-odh"
+
+	// AddonParametersSecretName is the Secret holding the add-on parameters set at install time.
+	AddonParametersSecretName = "addon-managed-odh-parameters"
+)
+
+// DetectFlavor determines whether OpenShift AI is the managed cloud service or self-managed.
+// The release name reported by DSCInitialization takes precedence; without it, the presence
+// of the add-on Subscription identifies the managed service.
+func DetectFlavor(ctx context.Context, c client.Reader) (Flavor, error) {
+	dsci, err := client.GetDSCInitialization(ctx, c)
+
+	switch {
+	case err == nil:
+		name, err := jq.Query[string](dsci, ".status.release.name")
+		if err != nil && !errors.Is(err, jq.ErrNotFound) {
+			return "", fmt.Errorf("querying .status.release.name: %w", err)
+		}
+
+		if name == ManagedReleaseName {
+			return FlavorManaged, nil
+		}
+	case !apierrors.IsNotFound(err):
+		return "", fmt.Errorf("getting DSCInitialization: %w", err)
+	}
+
+	if !c.OLM().Available() {
+		return FlavorSelfManaged, nil
+	}
+
+	_, err = c.OLM().Subscriptions(AddonNamespace).Get(ctx, AddonSubscriptionName, metav1.GetOptions{})
+
+	switch {
+	case err == nil:
+		return FlavorManaged, nil
+	case apierrors.IsNotFound(err):
+		return FlavorSelfManaged, nil
+	default:
+		return "", fmt.Errorf("getting Subscription %s/%s: %w", AddonNamespace, AddonSubscriptionName, err)
+	}
+}
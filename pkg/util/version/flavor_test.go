@@ -0,0 +1,93 @@
+package version_test
+
+import (
+	"testing"
+
+	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	operatorfake "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/clientset/versioned/fake"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+
+	. "github.com/onsi/gomega"
+)
+
+func newDSCIWithRelease(name string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.DSCInitialization.APIVersion(),
+			"kind":       resources.DSCInitialization.Kind,
+			"metadata": map[string]any{
+				"name": "default-dsci",
+			},
+			"status": map[string]any{
+				"release": map[string]any{
+					"name":    name,
+					"version": "2.25.0",
+				},
+			},
+		},
+	}
+}
+
+func TestDetectFlavor(t *testing.T) {
+	addonSubscription := &operatorsv1alpha1.Subscription{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      version.AddonSubscriptionName,
+			Namespace: version.AddonNamespace,
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		objects  []runtime.Object
+		olm      []runtime.Object
+		expected version.Flavor
+	}{
+		{
+			name:     "cloud service release name",
+			objects:  []runtime.Object{newDSCIWithRelease(version.ManagedReleaseName)},
+			expected: version.FlavorManaged,
+		},
+		{
+			name:     "self-managed release name",
+			objects:  []runtime.Object{newDSCIWithRelease("OpenShift AI Self-Managed")},
+			olm:      []runtime.Object{},
+			expected: version.FlavorSelfManaged,
+		},
+		{
+			name:     "add-on subscription without DSCI",
+			olm:      []runtime.Object{addonSubscription},
+			expected: version.FlavorManaged,
+		},
+		{
+			name:     "no DSCI and no OLM",
+			expected: version.FlavorSelfManaged,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			cfg := client.TestClientConfig{
+				Dynamic: dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), listKinds, tc.objects...),
+			}
+
+			if tc.olm != nil {
+				cfg.OLM = operatorfake.NewSimpleClientset(tc.olm...) //nolint:staticcheck // NewClientset requires generated apply configs not available in OLM
+			}
+
+			flavor, err := version.DetectFlavor(t.Context(), client.NewForTesting(cfg))
+
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(flavor).To(Equal(tc.expected))
+		})
+	}
+}
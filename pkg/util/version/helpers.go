@@ -13,6 +13,17 @@ func IsUpgradeFrom2xTo3x(from *semver.Version, to *semver.Version) bool {
 	return from.Major == 2 && to.Major == 3
 }
 
+// IsUpgradeWithin2x checks if the versions represent an upgrade between two 2.x releases
+// (e.g. 2.19 -> 2.22), as opposed to the major upgrade to 3.x.
+// Returns false if either version is nil.
+func IsUpgradeWithin2x(from *semver.Version, to *semver.Version) bool {
+	if from == nil || to == nil {
+		return false
+	}
+
+	return from.Major == 2 && to.Major == 2 && from.LT(*to)
+}
+
 // IsVersion3x checks if the given version has major version 3.
 // Returns false if version is nil.
 func IsVersion3x(v *semver.Version) bool {
@@ -41,3 +52,28 @@ func IsVersionAtLeast(
 
 	return version.Major == major && version.Minor >= minor
 }
+
+// supportedTargets are the OpenShift AI releases upgrade assessments can target, oldest first.
+//
+//nolint:gochecknoglobals // Read-only release list
+var supportedTargets = []semver.Version{
+	semver.MustParse("2.25.0"),
+	semver.MustParse("3.0.0"),
+	semver.MustParse("3.1.0"),
+	semver.MustParse("3.2.0"),
+	semver.MustParse("3.3.0"),
+}
+
+// SupportedTargets returns the supported target releases newer than current, oldest first.
+// Returns all supported target releases if current is nil.
+func SupportedTargets(current *semver.Version) []semver.Version {
+	targets := make([]semver.Version, 0, len(supportedTargets))
+
+	for _, target := range supportedTargets {
+		if current == nil || target.GT(*current) {
+			targets = append(targets, target)
+		}
+	}
+
+	return targets
+}
@@ -78,6 +78,56 @@ func TestIsUpgradeFrom2xTo3x(t *testing.T) {
 	}
 }
 
+func TestIsUpgradeWithin2x(t *testing.T) {
+	tests := []struct {
+		name           string
+		from           *semver.Version
+		to             *semver.Version
+		expectedResult bool
+	}{
+		{
+			name:           "nil from version returns false",
+			from:           nil,
+			to:             toVersionPtr("2.22.0"),
+			expectedResult: false,
+		},
+		{
+			name:           "minor upgrade within 2.x returns true",
+			from:           toVersionPtr("2.19.0"),
+			to:             toVersionPtr("2.22.0"),
+			expectedResult: true,
+		},
+		{
+			name:           "patch upgrade within 2.x returns true",
+			from:           toVersionPtr("2.22.0"),
+			to:             toVersionPtr("2.22.1"),
+			expectedResult: true,
+		},
+		{
+			name:           "same version returns false",
+			from:           toVersionPtr("2.22.0"),
+			to:             toVersionPtr("2.22.0"),
+			expectedResult: false,
+		},
+		{
+			name:           "upgrade from 2.x to 3.x returns false",
+			from:           toVersionPtr("2.25.0"),
+			to:             toVersionPtr("3.0.0"),
+			expectedResult: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			result := version.IsUpgradeWithin2x(tt.from, tt.to)
+
+			g.Expect(result).To(Equal(tt.expectedResult))
+		})
+	}
+}
+
 func TestIsVersion3x(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -204,3 +254,19 @@ func toVersionPtr(versionStr string) *semver.Version {
 
 	return &v
 }
+
+func TestSupportedTargets(t *testing.T) {
+	g := NewWithT(t)
+
+	current := semver.MustParse("2.25.2")
+
+	targets := version.SupportedTargets(&current)
+	g.Expect(targets).ToNot(BeEmpty())
+	g.Expect(targets[0].String()).To(Equal("3.0.0"))
+
+	for _, target := range targets {
+		g.Expect(target.GT(current)).To(BeTrue())
+	}
+
+	g.Expect(len(version.SupportedTargets(nil))).To(BeNumerically(">", len(targets)))
+}
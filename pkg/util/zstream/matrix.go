@@ -0,0 +1,208 @@
+// Package zstream provides the support matrix for upgrades between RHOAI 2.x releases (2.x -> 2.y),
+// shared by the z-stream lint checks and the rules bundle.
+package zstream
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/blang/semver/v4"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+// Release identifies an RHOAI minor release.
+type Release struct {
+	Major uint64
+	Minor uint64
+}
+
+// ParseRelease parses a "major.minor" release, tolerating a patch version and a "v" prefix.
+func ParseRelease(s string) (Release, error) {
+	v, err := semver.ParseTolerant(s)
+	if err != nil {
+		return Release{}, fmt.Errorf("invalid release %q: %w", s, err)
+	}
+
+	return Release{Major: v.Major, Minor: v.Minor}, nil
+}
+
+func (r Release) String() string {
+	return fmt.Sprintf("%d.%d", r.Major, r.Minor)
+}
+
+// CrossedBy returns true if an upgrade from one version to another installs the release for the
+// first time, i.e. from is older than the release and to is the release or newer.
+func (r Release) CrossedBy(from *semver.Version, to *semver.Version) bool {
+	return from != nil && !version.IsVersionAtLeast(from, r.Major, r.Minor) && version.IsVersionAtLeast(to, r.Major, r.Minor)
+}
+
+// DeprecatedField is a resource field deprecated in a 2.x release.
+type DeprecatedField struct {
+	// Release is the first release the field is deprecated in.
+	Release Release
+
+	// Resource is the resource type the field belongs to.
+	Resource resources.ResourceType
+
+	// Path is the dot-separated path of the field, e.g. "spec.devFlags.manifestsUri".
+	Path string
+
+	// Message explains the deprecation and its replacement.
+	Message string
+}
+
+// ImageTagRefresh lists the workbench image tags a 2.x release stops shipping.
+type ImageTagRefresh struct {
+	// Release is the first release the tags are no longer shipped in.
+	Release Release
+
+	// Tags are the ImageStream tags (e.g. "2023.2") that are removed.
+	Tags []string
+}
+
+// OperatorRequirement is the minimum version of a dependent operator required by a 2.x release.
+type OperatorRequirement struct {
+	// Release is the first release the requirement applies to.
+	Release Release
+
+	// Subscription is the OLM subscription name of the operator.
+	Subscription string
+
+	// MinVersion is the oldest operator version supported by the release.
+	MinVersion semver.Version
+}
+
+// Matrix is the z-stream support matrix.
+type Matrix struct {
+	DeprecatedFields     []DeprecatedField
+	ImageTagRefreshes    []ImageTagRefresh
+	OperatorRequirements []OperatorRequirement
+}
+
+// matrix is the effective support matrix.
+//
+//nolint:gochecknoglobals
+var matrix = Matrix{
+	DeprecatedFields: []DeprecatedField{
+		{
+			Release:  Release{Major: 2, Minor: 16},
+			Resource: resources.DSCInitialization,
+			Path:     "spec.devFlags.manifestsUri",
+			Message:  "devFlags.manifestsUri is deprecated; custom component manifests are not supported",
+		},
+		{
+			Release:  Release{Major: 2, Minor: 19},
+			Resource: resources.DataScienceCluster,
+			Path:     "spec.components.modelmeshserving",
+			Message:  "ModelMesh serving is deprecated; migrate models to KServe",
+		},
+		{
+			Release:  Release{Major: 2, Minor: 25},
+			Resource: resources.DataScienceCluster,
+			Path:     "spec.components.kserve.serving",
+			Message:  "KServe Serverless mode is deprecated; use the RawDeployment mode",
+		},
+	},
+	ImageTagRefreshes: []ImageTagRefresh{
+		{Release: Release{Major: 2, Minor: 19}, Tags: []string{"2023.1"}},
+		{Release: Release{Major: 2, Minor: 22}, Tags: []string{"2023.2"}},
+		{Release: Release{Major: 2, Minor: 25}, Tags: []string{"2024.1"}},
+	},
+	OperatorRequirements: []OperatorRequirement{
+		{Release: Release{Major: 2, Minor: 16}, Subscription: "serverless-operator", MinVersion: semver.MustParse("1.33.0")},
+		{Release: Release{Major: 2, Minor: 19}, Subscription: "serverless-operator", MinVersion: semver.MustParse("1.35.0")},
+		{Release: Release{Major: 2, Minor: 16}, Subscription: "servicemeshoperator", MinVersion: semver.MustParse("2.5.0")},
+		{Release: Release{Major: 2, Minor: 22}, Subscription: "servicemeshoperator", MinVersion: semver.MustParse("2.6.0")},
+		{Release: Release{Major: 2, Minor: 19}, Subscription: "authorino-operator", MinVersion: semver.MustParse("1.1.0")},
+	},
+}
+
+// SetMatrix replaces the built-in support matrix, e.g. with data from an installed rules bundle.
+// Sections left empty keep their current values.
+func SetMatrix(m Matrix) {
+	if len(m.DeprecatedFields) > 0 {
+		matrix.DeprecatedFields = slices.Clone(m.DeprecatedFields)
+	}
+
+	if len(m.ImageTagRefreshes) > 0 {
+		matrix.ImageTagRefreshes = slices.Clone(m.ImageTagRefreshes)
+	}
+
+	if len(m.OperatorRequirements) > 0 {
+		matrix.OperatorRequirements = slices.Clone(m.OperatorRequirements)
+	}
+}
+
+// SupportMatrix returns the effective support matrix.
+func SupportMatrix() Matrix {
+	return Matrix{
+		DeprecatedFields:     slices.Clone(matrix.DeprecatedFields),
+		ImageTagRefreshes:    slices.Clone(matrix.ImageTagRefreshes),
+		OperatorRequirements: slices.Clone(matrix.OperatorRequirements),
+	}
+}
+
+// DeprecatedFieldsFor returns the fields deprecated by releases an upgrade from one version to
+// another crosses.
+func DeprecatedFieldsFor(from *semver.Version, to *semver.Version) []DeprecatedField {
+	var fields []DeprecatedField
+
+	for _, f := range matrix.DeprecatedFields {
+		if f.Release.CrossedBy(from, to) {
+			fields = append(fields, f)
+		}
+	}
+
+	return fields
+}
+
+// RemovedImageTagsFor returns the workbench image tags removed by releases an upgrade from one
+// version to another crosses, mapped to the release removing them.
+func RemovedImageTagsFor(from *semver.Version, to *semver.Version) map[string]Release {
+	tags := make(map[string]Release)
+
+	for _, refresh := range matrix.ImageTagRefreshes {
+		if !refresh.Release.CrossedBy(from, to) {
+			continue
+		}
+
+		for _, tag := range refresh.Tags {
+			tags[tag] = refresh.Release
+		}
+	}
+
+	return tags
+}
+
+// OperatorRequirementsFor returns, per operator subscription, the requirement of the newest release
+// not newer than the target version.
+func OperatorRequirementsFor(target *semver.Version) []OperatorRequirement {
+	newest := make(map[string]OperatorRequirement)
+
+	var order []string
+
+	for _, req := range matrix.OperatorRequirements {
+		if !version.IsVersionAtLeast(target, req.Release.Major, req.Release.Minor) {
+			continue
+		}
+
+		current, ok := newest[req.Subscription]
+		if !ok {
+			order = append(order, req.Subscription)
+		}
+
+		if !ok || version.IsVersionAtLeast(&semver.Version{Major: req.Release.Major, Minor: req.Release.Minor},
+			current.Release.Major, current.Release.Minor) {
+			newest[req.Subscription] = req
+		}
+	}
+
+	reqs := make([]OperatorRequirement, 0, len(order))
+	for _, name := range order {
+		reqs = append(reqs, newest[name])
+	}
+
+	return reqs
+}
@@ -0,0 +1,77 @@
+package zstream_test
+
+import (
+	"testing"
+
+	"github.com/blang/semver/v4"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/zstream"
+
+	. "github.com/onsi/gomega"
+)
+
+func v(s string) *semver.Version {
+	parsed := semver.MustParse(s)
+
+	return &parsed
+}
+
+func TestRelease_CrossedBy(t *testing.T) {
+	g := NewWithT(t)
+
+	release := zstream.Release{Major: 2, Minor: 19}
+
+	g.Expect(release.CrossedBy(v("2.16.0"), v("2.19.0"))).To(BeTrue())
+	g.Expect(release.CrossedBy(v("2.16.0"), v("2.22.1"))).To(BeTrue())
+	g.Expect(release.CrossedBy(v("2.19.0"), v("2.22.0"))).To(BeFalse())
+	g.Expect(release.CrossedBy(v("2.16.0"), v("2.18.2"))).To(BeFalse())
+	g.Expect(release.CrossedBy(nil, v("2.22.0"))).To(BeFalse())
+	g.Expect(release.String()).To(Equal("2.19"))
+}
+
+func TestParseRelease(t *testing.T) {
+	g := NewWithT(t)
+
+	release, err := zstream.ParseRelease("2.22")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(release).To(Equal(zstream.Release{Major: 2, Minor: 22}))
+
+	_, err = zstream.ParseRelease("latest")
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestMatrixQueries(t *testing.T) {
+	g := NewWithT(t)
+
+	original := zstream.SupportMatrix()
+	t.Cleanup(func() { zstream.SetMatrix(original) })
+
+	zstream.SetMatrix(zstream.Matrix{
+		DeprecatedFields: []zstream.DeprecatedField{
+			{Release: zstream.Release{Major: 2, Minor: 19}, Resource: resources.DataScienceCluster, Path: "spec.a"},
+			{Release: zstream.Release{Major: 2, Minor: 25}, Resource: resources.DataScienceCluster, Path: "spec.b"},
+		},
+		ImageTagRefreshes: []zstream.ImageTagRefresh{
+			{Release: zstream.Release{Major: 2, Minor: 19}, Tags: []string{"2023.1"}},
+			{Release: zstream.Release{Major: 2, Minor: 22}, Tags: []string{"2023.2"}},
+		},
+		OperatorRequirements: []zstream.OperatorRequirement{
+			{Release: zstream.Release{Major: 2, Minor: 22}, Subscription: "serverless-operator", MinVersion: semver.MustParse("1.35.0")},
+			{Release: zstream.Release{Major: 2, Minor: 16}, Subscription: "serverless-operator", MinVersion: semver.MustParse("1.33.0")},
+			{Release: zstream.Release{Major: 2, Minor: 25}, Subscription: "authorino-operator", MinVersion: semver.MustParse("1.2.0")},
+		},
+	})
+
+	fields := zstream.DeprecatedFieldsFor(v("2.16.0"), v("2.22.0"))
+	g.Expect(fields).To(HaveLen(1))
+	g.Expect(fields[0].Path).To(Equal("spec.a"))
+
+	g.Expect(zstream.RemovedImageTagsFor(v("2.19.0"), v("2.22.0"))).To(Equal(map[string]zstream.Release{
+		"2023.2": {Major: 2, Minor: 22},
+	}))
+
+	reqs := zstream.OperatorRequirementsFor(v("2.22.0"))
+	g.Expect(reqs).To(HaveLen(1))
+	g.Expect(reqs[0].MinVersion.String()).To(Equal("1.35.0"))
+}
@@ -165,6 +165,10 @@ func (c *testDiagnosticCheck) CheckType() string {
 	return "e2e-test"
 }
 
+func (c *testDiagnosticCheck) Documentation() check.Documentation {
+	return check.Documentation{}
+}
+
 func (c *testDiagnosticCheck) CanApply(_ context.Context, _ check.Target) (bool, error) {
 	return true, nil // Always apply for testing
 }